@@ -0,0 +1,214 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// AlterSequenceDataset for creating and/or executing ALTER SEQUENCE SQL statements.
+type AlterSequenceDataset struct {
+	dialect      SQLDialect
+	clauses      exp.AlterSequenceClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+// used internally by database to create a database with a specific adapter.
+func newAlterSequenceDataset(d string, queryFactory exec.QueryFactory) *AlterSequenceDataset {
+	return &AlterSequenceDataset{
+		clauses:      exp.NewAlterSequenceClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// AlterSequence creates an AlterSequenceDataset to change the options of a sequence.
+//
+//	goqu.AlterSequence("user_id_seq").RestartWith(1).IncrementBy(2)
+func AlterSequence(sequence interface{}) *AlterSequenceDataset {
+	return newAlterSequenceDataset("default", nil).Sequence(sequence)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (asd *AlterSequenceDataset) WithDialect(dl string) *AlterSequenceDataset {
+	ds := asd.copy(asd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (asd *AlterSequenceDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the AlterSequenceDataset.
+func (asd *AlterSequenceDataset) Dialect() SQLDialect {
+	return asd.dialect
+}
+
+// SetDialect returns the current adapter on the AlterSequenceDataset.
+func (asd *AlterSequenceDataset) SetDialect(dialect SQLDialect) *AlterSequenceDataset {
+	cd := asd.copy(asd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns AlterSequenceDataset as exp.Expression.
+func (asd *AlterSequenceDataset) Expression() exp.Expression {
+	return asd
+}
+
+// Clone clones the AlterSequenceDataset.
+func (asd *AlterSequenceDataset) Clone() exp.Expression {
+	return asd.copy(asd.clauses)
+}
+
+// GetClauses returns the current clauses on the AlterSequenceDataset.
+func (asd *AlterSequenceDataset) GetClauses() exp.AlterSequenceClauses {
+	return asd.clauses
+}
+
+// used internally to copy the dataset.
+func (asd *AlterSequenceDataset) copy(clauses exp.AlterSequenceClauses) *AlterSequenceDataset {
+	return &AlterSequenceDataset{
+		dialect:      asd.dialect,
+		clauses:      clauses,
+		queryFactory: asd.queryFactory,
+		err:          asd.err,
+	}
+}
+
+// Sequence sets the sequence to alter. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (asd *AlterSequenceDataset) Sequence(sequence interface{}) *AlterSequenceDataset {
+	switch t := sequence.(type) {
+	case exp.Expression:
+		return asd.copy(asd.clauses.SetSequence(t))
+	case string:
+		return asd.copy(asd.clauses.SetSequence(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedSequenceType)
+	}
+}
+
+// IfExists adds IF EXISTS to the ALTER SEQUENCE statement.
+func (asd *AlterSequenceDataset) IfExists() *AlterSequenceDataset {
+	return asd.copy(asd.clauses.SetIfExists(true))
+}
+
+// RestartWith restarts the sequence with a value (e.g. RESTART WITH 1).
+func (asd *AlterSequenceDataset) RestartWith(n int64) *AlterSequenceDataset {
+	return asd.copy(asd.clauses.SetRestartWith(&n))
+}
+
+// IncrementBy sets the value added to the sequence to create a new value (e.g. INCREMENT BY 2).
+func (asd *AlterSequenceDataset) IncrementBy(n int64) *AlterSequenceDataset {
+	opts := asd.clauses.Options()
+	opts.IncrementBy = &n
+	return asd.copy(asd.clauses.SetOptions(opts))
+}
+
+// MinValue sets the minimum value of the sequence (e.g. MINVALUE 1).
+func (asd *AlterSequenceDataset) MinValue(n int64) *AlterSequenceDataset {
+	opts := asd.clauses.Options()
+	opts.MinValue, opts.NoMinValue = &n, false
+	return asd.copy(asd.clauses.SetOptions(opts))
+}
+
+// NoMinValue uses the default minimum value of the sequence (e.g. NO MINVALUE).
+func (asd *AlterSequenceDataset) NoMinValue() *AlterSequenceDataset {
+	opts := asd.clauses.Options()
+	opts.MinValue, opts.NoMinValue = nil, true
+	return asd.copy(asd.clauses.SetOptions(opts))
+}
+
+// MaxValue sets the maximum value of the sequence (e.g. MAXVALUE 1000).
+func (asd *AlterSequenceDataset) MaxValue(n int64) *AlterSequenceDataset {
+	opts := asd.clauses.Options()
+	opts.MaxValue, opts.NoMaxValue = &n, false
+	return asd.copy(asd.clauses.SetOptions(opts))
+}
+
+// NoMaxValue uses the default maximum value of the sequence (e.g. NO MAXVALUE).
+func (asd *AlterSequenceDataset) NoMaxValue() *AlterSequenceDataset {
+	opts := asd.clauses.Options()
+	opts.MaxValue, opts.NoMaxValue = nil, true
+	return asd.copy(asd.clauses.SetOptions(opts))
+}
+
+// StartWith sets the starting value of the sequence (e.g. START WITH 1000).
+func (asd *AlterSequenceDataset) StartWith(n int64) *AlterSequenceDataset {
+	opts := asd.clauses.Options()
+	opts.StartWith = &n
+	return asd.copy(asd.clauses.SetOptions(opts))
+}
+
+// Cycle wraps the sequence around when the minimum or maximum value is reached (e.g. CYCLE).
+func (asd *AlterSequenceDataset) Cycle() *AlterSequenceDataset {
+	opts := asd.clauses.Options()
+	opts.Cycle, opts.NoCycle = true, false
+	return asd.copy(asd.clauses.SetOptions(opts))
+}
+
+// NoCycle returns an error when the minimum or maximum value of the sequence is reached (e.g. NO CYCLE).
+func (asd *AlterSequenceDataset) NoCycle() *AlterSequenceDataset {
+	opts := asd.clauses.Options()
+	opts.Cycle, opts.NoCycle = false, true
+	return asd.copy(asd.clauses.SetOptions(opts))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (asd *AlterSequenceDataset) Error() error {
+	return asd.err
+}
+
+// SetError sets an error on the AlterSequenceDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (asd *AlterSequenceDataset) SetError(err error) *AlterSequenceDataset {
+	if asd.err == nil {
+		asd.err = err
+	}
+
+	return asd
+}
+
+// ToSQL generates a ALTER SEQUENCE sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no sequence or there are no options
+//   - The dialect does not support sequences or IF EXISTS
+//   - There is an error generating the SQL
+func (asd *AlterSequenceDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return asd.alterSequenceSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (asd *AlterSequenceDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = asd.alterSequenceSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the ALTER SEQUENCE sql, and returns an Exec struct with the sql set to the ALTER SEQUENCE
+// statement.
+//
+// db.AlterSequence("test_seq").RestartWith(1).Executor().Exec()
+func (asd *AlterSequenceDataset) Executor() exec.QueryExecutor {
+	return asd.queryFactory.FromSQLBuilder(asd.alterSequenceSQLBuilder())
+}
+
+func (asd *AlterSequenceDataset) alterSequenceSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if asd.err != nil {
+		return buf.SetError(asd.err)
+	}
+	asd.dialect.ToAlterSequenceSQL(buf, asd.clauses)
+	return buf
+}
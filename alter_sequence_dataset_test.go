@@ -0,0 +1,206 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	alterSequenceTestCase struct {
+		ds      *goqu.AlterSequenceDataset
+		clauses exp.AlterSequenceClauses
+	}
+	alterSequenceDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (asds *alterSequenceDatasetSuite) assertCases(cases ...alterSequenceTestCase) {
+	for _, s := range cases {
+		asds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (asds *alterSequenceDatasetSuite) TestClone() {
+	ds := goqu.AlterSequence("test_seq")
+	asds.Equal(ds, ds.Clone())
+}
+
+func (asds *alterSequenceDatasetSuite) TestExpression() {
+	ds := goqu.AlterSequence("test_seq")
+	asds.Equal(ds, ds.Expression())
+}
+
+func (asds *alterSequenceDatasetSuite) TestDialect() {
+	ds := goqu.AlterSequence("test_seq")
+	asds.NotNil(ds.Dialect())
+}
+
+func (asds *alterSequenceDatasetSuite) TestWithDialect() {
+	ds := goqu.AlterSequence("test_seq")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	asds.Equal(md, ds.Dialect())
+	asds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (asds *alterSequenceDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.AlterSequence("test_seq")
+	asds.False(ds.IsPrepared())
+}
+
+func (asds *alterSequenceDatasetSuite) TestGetClauses() {
+	ds := goqu.AlterSequence("test_seq")
+	ce := exp.NewAlterSequenceClauses().SetSequence(goqu.I("test_seq"))
+	asds.Equal(ce, ds.GetClauses())
+}
+
+func (asds *alterSequenceDatasetSuite) TestSequence() {
+	bd := goqu.AlterSequence("test")
+	asds.assertCases(
+		alterSequenceTestCase{ds: bd.Sequence("test2"), clauses: exp.NewAlterSequenceClauses().SetSequence(goqu.I("test2"))},
+		alterSequenceTestCase{
+			ds:      bd.Sequence(goqu.S("s").Table("test2")),
+			clauses: exp.NewAlterSequenceClauses().SetSequence(goqu.S("s").Table("test2")),
+		},
+		alterSequenceTestCase{ds: bd, clauses: exp.NewAlterSequenceClauses().SetSequence(goqu.I("test"))},
+	)
+	asds.PanicsWithValue(goqu.ErrUnsupportedSequenceType, func() {
+		goqu.AlterSequence(true)
+	})
+}
+
+func (asds *alterSequenceDatasetSuite) TestOptions() {
+	one, two, ten, hundred := int64(1), int64(2), int64(10), int64(100)
+	bd := goqu.AlterSequence("test")
+	ce := bd.GetClauses()
+	asds.assertCases(
+		alterSequenceTestCase{ds: bd.IfExists(), clauses: ce.SetIfExists(true)},
+		alterSequenceTestCase{ds: bd.RestartWith(1), clauses: ce.SetRestartWith(&one)},
+		alterSequenceTestCase{ds: bd.IncrementBy(2), clauses: ce.SetOptions(exp.SequenceOptions{IncrementBy: &two})},
+		alterSequenceTestCase{ds: bd.MinValue(1), clauses: ce.SetOptions(exp.SequenceOptions{MinValue: &one})},
+		alterSequenceTestCase{ds: bd.MinValue(1).NoMinValue(), clauses: ce.SetOptions(exp.SequenceOptions{NoMinValue: true})},
+		alterSequenceTestCase{ds: bd.NoMinValue().MinValue(1), clauses: ce.SetOptions(exp.SequenceOptions{MinValue: &one})},
+		alterSequenceTestCase{ds: bd.MaxValue(100), clauses: ce.SetOptions(exp.SequenceOptions{MaxValue: &hundred})},
+		alterSequenceTestCase{ds: bd.MaxValue(100).NoMaxValue(), clauses: ce.SetOptions(exp.SequenceOptions{NoMaxValue: true})},
+		alterSequenceTestCase{ds: bd.NoMaxValue().MaxValue(100), clauses: ce.SetOptions(exp.SequenceOptions{MaxValue: &hundred})},
+		alterSequenceTestCase{ds: bd.StartWith(10), clauses: ce.SetOptions(exp.SequenceOptions{StartWith: &ten})},
+		alterSequenceTestCase{ds: bd.NoCycle().Cycle(), clauses: ce.SetOptions(exp.SequenceOptions{Cycle: true})},
+		alterSequenceTestCase{ds: bd.Cycle().NoCycle(), clauses: ce.SetOptions(exp.SequenceOptions{NoCycle: true})},
+		alterSequenceTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (asds *alterSequenceDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.AlterSequence("test_seq").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToAlterSequenceSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	asds.NoError(err)
+	asds.Empty(sql)
+	asds.Empty(args)
+	md.AssertExpectations(asds.T())
+}
+
+func (asds *alterSequenceDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.AlterSequence("test_seq").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToAlterSequenceSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	asds.Empty(sql)
+	asds.Empty(args)
+	asds.Equal(ee, err)
+	md.AssertExpectations(asds.T())
+}
+
+func (asds *alterSequenceDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	asds.NoError(err)
+
+	ds := goqu.New("mock", mDB).AlterSequence("test_seq").RestartWith(1)
+
+	asql, args, err := ds.Executor().ToSQL()
+	asds.NoError(err)
+	asds.Empty(args)
+	asds.Equal(`ALTER SEQUENCE "test_seq" RESTART WITH 1`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	asds.NoError(err)
+	asds.Empty(args)
+	asds.Equal(`ALTER SEQUENCE "test_seq" RESTART WITH 1`, asql)
+}
+
+func (asds *alterSequenceDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.AlterSequence("test_seq").SetDialect(md)
+	ds = ds.SetError(err1)
+	asds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	asds.Empty(sql)
+	asds.Empty(args)
+	asds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	asds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	asds.Empty(sql)
+	asds.Empty(args)
+	asds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.IfExists()
+	asds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	asds.Empty(sql)
+	asds.Empty(args)
+	asds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToAlterSequenceSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	asds.Empty(sql)
+	asds.Empty(args)
+	asds.Equal(err1, err)
+}
+
+func TestAlterSequenceDataset(t *testing.T) {
+	suite.Run(t, new(alterSequenceDatasetSuite))
+}
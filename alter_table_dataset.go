@@ -0,0 +1,250 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// AlterTableDataset for creating and/or executing ALTER TABLE SQL statements.
+type AlterTableDataset struct {
+	dialect      SQLDialect
+	clauses      exp.AlterTableClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedAlterTableType = errors.New(
+	"unsupported table type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newAlterTableDataset(d string, queryFactory exec.QueryFactory) *AlterTableDataset {
+	return &AlterTableDataset{
+		clauses:      exp.NewAlterTableClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// AlterTable creates an AlterTableDataset for a table.
+func AlterTable(table interface{}) *AlterTableDataset {
+	return newAlterTableDataset("default", nil).Table(table)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (atd *AlterTableDataset) WithDialect(dl string) *AlterTableDataset {
+	ds := atd.copy(atd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (atd *AlterTableDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the AlterTableDataset.
+func (atd *AlterTableDataset) Dialect() SQLDialect {
+	return atd.dialect
+}
+
+// SetDialect returns the current adapter on the AlterTableDataset.
+func (atd *AlterTableDataset) SetDialect(dialect SQLDialect) *AlterTableDataset {
+	cd := atd.copy(atd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns AlterTableDataset as exp.Expression.
+func (atd *AlterTableDataset) Expression() exp.Expression {
+	return atd
+}
+
+// Clone clones the AlterTableDataset.
+func (atd *AlterTableDataset) Clone() exp.Expression {
+	return atd.copy(atd.clauses)
+}
+
+// GetClauses returns the current clauses on the AlterTableDataset.
+func (atd *AlterTableDataset) GetClauses() exp.AlterTableClauses {
+	return atd.clauses
+}
+
+// used internally to copy the dataset.
+func (atd *AlterTableDataset) copy(clauses exp.AlterTableClauses) *AlterTableDataset {
+	return &AlterTableDataset{
+		dialect:      atd.dialect,
+		clauses:      clauses,
+		queryFactory: atd.queryFactory,
+		err:          atd.err,
+	}
+}
+
+// Table sets the table to alter. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (atd *AlterTableDataset) Table(table interface{}) *AlterTableDataset {
+	switch t := table.(type) {
+	case exp.Expression:
+		return atd.copy(atd.clauses.SetTable(t))
+	case string:
+		return atd.copy(atd.clauses.SetTable(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedAlterTableType)
+	}
+}
+
+// AddColumn appends an ADD COLUMN action.
+//
+//	goqu.AlterTable("user").AddColumn(goqu.ColumnDef("email", goqu.VarcharType(255)).NotNull().Default(""))
+func (atd *AlterTableDataset) AddColumn(column exp.ColumnDefinition) *AlterTableDataset {
+	return atd.Actions(exp.NewAddColumnAction(column))
+}
+
+// DropColumn appends a DROP COLUMN action.
+func (atd *AlterTableDataset) DropColumn(name string) *AlterTableDataset {
+	return atd.Actions(exp.NewDropColumnAction(name))
+}
+
+// RenameColumn appends a RENAME COLUMN action.
+func (atd *AlterTableDataset) RenameColumn(name, newName string) *AlterTableDataset {
+	return atd.Actions(exp.NewRenameColumnAction(name, newName))
+}
+
+// AlterColumnType appends an action to change the type of a column (e.g. ALTER COLUMN "a" TYPE BIGINT in postgres,
+// MODIFY COLUMN `a` BIGINT in mysql).
+//
+// NOTE: mysql replaces the whole definition of the column so the NOT NULL and DEFAULT of the column are dropped.
+func (atd *AlterTableDataset) AlterColumnType(name string, dataType exp.DataType) *AlterTableDataset {
+	return atd.Actions(exp.NewAlterColumnTypeAction(name, dataType))
+}
+
+// ModifyColumn appends an action to replace the whole definition of a column (e.g. mysql MODIFY COLUMN), this is
+// also how the comment of a column is changed in mysql.
+//
+//	goqu.Dialect("mysql").AlterTable("user").ModifyColumn(goqu.ColumnDef("email", goqu.VarcharType(255)).Comment("login"))
+func (atd *AlterTableDataset) ModifyColumn(column exp.ColumnDefinition) *AlterTableDataset {
+	return atd.Actions(exp.NewModifyColumnAction(column))
+}
+
+// SetDefault appends an ALTER COLUMN ... SET DEFAULT action.
+func (atd *AlterTableDataset) SetDefault(name string, val interface{}) *AlterTableDataset {
+	return atd.Actions(exp.NewSetColumnDefaultAction(name, val))
+}
+
+// DropDefault appends an ALTER COLUMN ... DROP DEFAULT action.
+func (atd *AlterTableDataset) DropDefault(name string) *AlterTableDataset {
+	return atd.Actions(exp.NewDropColumnDefaultAction(name))
+}
+
+// SetNotNull appends an ALTER COLUMN ... SET NOT NULL action.
+func (atd *AlterTableDataset) SetNotNull(name string) *AlterTableDataset {
+	return atd.Actions(exp.NewSetColumnNotNullAction(name))
+}
+
+// DropNotNull appends an ALTER COLUMN ... DROP NOT NULL action.
+func (atd *AlterTableDataset) DropNotNull(name string) *AlterTableDataset {
+	return atd.Actions(exp.NewDropColumnNotNullAction(name))
+}
+
+// AddConstraint appends an action to add a table constraint.
+//
+//	goqu.AlterTable("user").AddConstraint(goqu.Unique("email").Named("user_email_uniq"))
+func (atd *AlterTableDataset) AddConstraint(constraint exp.TableConstraint) *AlterTableDataset {
+	return atd.Actions(exp.NewAddConstraintAction(constraint))
+}
+
+// RenameTo appends an action to rename the table.
+func (atd *AlterTableDataset) RenameTo(newName string) *AlterTableDataset {
+	return atd.Actions(exp.NewRenameTableAction(newName))
+}
+
+// AttachPartition appends an action to attach a table as a partition (e.g. postgres ATTACH PARTITION).
+//
+//	goqu.AlterTable("measurement").AttachPartition("measurement_2024", goqu.ValuesFromTo("2024-01-01", "2025-01-01"))
+func (atd *AlterTableDataset) AttachPartition(table string, bound exp.PartitionBound) *AlterTableDataset {
+	return atd.Actions(exp.NewAttachPartitionAction(exp.ParseIdentifier(table), bound))
+}
+
+// DetachPartition appends an action to detach a partition, the partition becomes a standalone table
+// (e.g. postgres DETACH PARTITION).
+func (atd *AlterTableDataset) DetachPartition(table string) *AlterTableDataset {
+	return atd.Actions(exp.NewDetachPartitionAction(exp.ParseIdentifier(table)))
+}
+
+// AddPartitions appends an action to add partitions to a table that defines its partitions (e.g. mysql ADD PARTITION).
+//
+//	goqu.Dialect("mysql").AlterTable("measurement").AddPartitions(goqu.Partition("p2025", goqu.ValuesLessThan(2026)))
+func (atd *AlterTableDataset) AddPartitions(partitions ...exp.PartitionDefinition) *AlterTableDataset {
+	return atd.Actions(exp.NewAddPartitionAction(partitions...))
+}
+
+// DropPartitions appends an action to drop partitions and the rows they hold from a table that defines its
+// partitions (e.g. mysql DROP PARTITION).
+func (atd *AlterTableDataset) DropPartitions(names ...string) *AlterTableDataset {
+	idents := make([]exp.IdentifierExpression, 0, len(names))
+	for _, name := range names {
+		idents = append(idents, exp.ParseIdentifier(name))
+	}
+	return atd.Actions(exp.NewDropPartitionAction(idents...))
+}
+
+// Actions appends actions to the ALTER TABLE statement.
+func (atd *AlterTableDataset) Actions(actions ...exp.AlterTableAction) *AlterTableDataset {
+	return atd.copy(atd.clauses.ActionsAppend(actions...))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (atd *AlterTableDataset) Error() error {
+	return atd.err
+}
+
+// SetError sets an error on the AlterTableDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (atd *AlterTableDataset) SetError(err error) *AlterTableDataset {
+	if atd.err == nil {
+		atd.err = err
+	}
+
+	return atd
+}
+
+// ToSQL generates an ALTER TABLE sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no table or there are no actions
+//   - The dialect does not support an action
+//   - There is an error generating the SQL
+func (atd *AlterTableDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return atd.alterTableSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (atd *AlterTableDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = atd.alterTableSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the ALTER TABLE sql, and returns an Exec struct with the sql set to the ALTER TABLE statement.
+//
+// db.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.IntegerType())).Executor().Exec()
+func (atd *AlterTableDataset) Executor() exec.QueryExecutor {
+	return atd.queryFactory.FromSQLBuilder(atd.alterTableSQLBuilder())
+}
+
+func (atd *AlterTableDataset) alterTableSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if atd.err != nil {
+		return buf.SetError(atd.err)
+	}
+	atd.dialect.ToAlterTableSQL(buf, atd.clauses)
+	return buf
+}
@@ -0,0 +1,241 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	alterTableTestCase struct {
+		ds      *goqu.AlterTableDataset
+		clauses exp.AlterTableClauses
+	}
+	alterTableDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (atds *alterTableDatasetSuite) assertCases(cases ...alterTableTestCase) {
+	for _, s := range cases {
+		atds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (atds *alterTableDatasetSuite) TestClone() {
+	ds := goqu.AlterTable("test")
+	atds.Equal(ds, ds.Clone())
+}
+
+func (atds *alterTableDatasetSuite) TestExpression() {
+	ds := goqu.AlterTable("test")
+	atds.Equal(ds, ds.Expression())
+}
+
+func (atds *alterTableDatasetSuite) TestDialect() {
+	ds := goqu.AlterTable("test")
+	atds.NotNil(ds.Dialect())
+}
+
+func (atds *alterTableDatasetSuite) TestWithDialect() {
+	ds := goqu.AlterTable("test")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	atds.Equal(md, ds.Dialect())
+	atds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (atds *alterTableDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.AlterTable("test")
+	atds.False(ds.IsPrepared())
+}
+
+func (atds *alterTableDatasetSuite) TestGetClauses() {
+	ds := goqu.AlterTable("test")
+	ce := exp.NewAlterTableClauses().SetTable(goqu.I("test"))
+	atds.Equal(ce, ds.GetClauses())
+}
+
+func (atds *alterTableDatasetSuite) TestTable() {
+	bd := goqu.AlterTable("test")
+	atds.assertCases(
+		alterTableTestCase{
+			ds:      bd.Table("test2"),
+			clauses: exp.NewAlterTableClauses().SetTable(goqu.I("test2")),
+		},
+		alterTableTestCase{
+			ds:      bd.Table(goqu.S("s").Table("test2")),
+			clauses: exp.NewAlterTableClauses().SetTable(goqu.S("s").Table("test2")),
+		},
+		alterTableTestCase{
+			ds:      bd,
+			clauses: exp.NewAlterTableClauses().SetTable(goqu.I("test")),
+		},
+	)
+	atds.PanicsWithValue(goqu.ErrUnsupportedAlterTableType, func() {
+		goqu.AlterTable(true)
+	})
+}
+
+func (atds *alterTableDatasetSuite) TestActions() {
+	cd := goqu.ColumnDef("a", goqu.IntegerType())
+	uc := goqu.Unique("a")
+	bd := goqu.AlterTable("test")
+	ce := exp.NewAlterTableClauses().SetTable(goqu.I("test"))
+	atds.assertCases(
+		alterTableTestCase{ds: bd.AddColumn(cd), clauses: ce.ActionsAppend(exp.NewAddColumnAction(cd))},
+		alterTableTestCase{ds: bd.DropColumn("a"), clauses: ce.ActionsAppend(exp.NewDropColumnAction("a"))},
+		alterTableTestCase{
+			ds:      bd.RenameColumn("a", "b"),
+			clauses: ce.ActionsAppend(exp.NewRenameColumnAction("a", "b")),
+		},
+		alterTableTestCase{
+			ds:      bd.AlterColumnType("a", goqu.BigIntType()),
+			clauses: ce.ActionsAppend(exp.NewAlterColumnTypeAction("a", goqu.BigIntType())),
+		},
+		alterTableTestCase{ds: bd.ModifyColumn(cd), clauses: ce.ActionsAppend(exp.NewModifyColumnAction(cd))},
+		alterTableTestCase{
+			ds:      bd.SetDefault("a", 1),
+			clauses: ce.ActionsAppend(exp.NewSetColumnDefaultAction("a", 1)),
+		},
+		alterTableTestCase{ds: bd.DropDefault("a"), clauses: ce.ActionsAppend(exp.NewDropColumnDefaultAction("a"))},
+		alterTableTestCase{ds: bd.SetNotNull("a"), clauses: ce.ActionsAppend(exp.NewSetColumnNotNullAction("a"))},
+		alterTableTestCase{ds: bd.DropNotNull("a"), clauses: ce.ActionsAppend(exp.NewDropColumnNotNullAction("a"))},
+		alterTableTestCase{ds: bd.AddConstraint(uc), clauses: ce.ActionsAppend(exp.NewAddConstraintAction(uc))},
+		alterTableTestCase{ds: bd.RenameTo("b"), clauses: ce.ActionsAppend(exp.NewRenameTableAction("b"))},
+		alterTableTestCase{
+			ds:      bd.AttachPartition("s.b", goqu.ValuesIn(1)),
+			clauses: ce.ActionsAppend(exp.NewAttachPartitionAction(goqu.I("s.b"), goqu.ValuesIn(1))),
+		},
+		alterTableTestCase{
+			ds:      bd.DetachPartition("b"),
+			clauses: ce.ActionsAppend(exp.NewDetachPartitionAction(goqu.I("b"))),
+		},
+		alterTableTestCase{
+			ds:      bd.AddPartitions(goqu.Partition("p0", goqu.ValuesLessThan(10))),
+			clauses: ce.ActionsAppend(exp.NewAddPartitionAction(goqu.Partition("p0", goqu.ValuesLessThan(10)))),
+		},
+		alterTableTestCase{
+			ds:      bd.DropPartitions("p0", "p1"),
+			clauses: ce.ActionsAppend(exp.NewDropPartitionAction(goqu.I("p0"), goqu.I("p1"))),
+		},
+		alterTableTestCase{
+			ds:      bd.AddColumn(cd).DropColumn("b"),
+			clauses: ce.ActionsAppend(exp.NewAddColumnAction(cd), exp.NewDropColumnAction("b")),
+		},
+		alterTableTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (atds *alterTableDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.AlterTable("test").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToAlterTableSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	atds.NoError(err)
+	atds.Empty(sql)
+	atds.Empty(args)
+	md.AssertExpectations(atds.T())
+}
+
+func (atds *alterTableDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.AlterTable("test").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToAlterTableSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	atds.Empty(sql)
+	atds.Empty(args)
+	atds.Equal(ee, err)
+	md.AssertExpectations(atds.T())
+}
+
+func (atds *alterTableDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	atds.NoError(err)
+
+	ds := goqu.New("mock", mDB).AlterTable("test").SetDefault("a", "b")
+
+	asql, args, err := ds.Executor().ToSQL()
+	atds.NoError(err)
+	atds.Empty(args)
+	atds.Equal(`ALTER TABLE "test" ALTER COLUMN "a" SET DEFAULT 'b'`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	atds.NoError(err)
+	atds.Empty(args)
+	atds.Equal(`ALTER TABLE "test" ALTER COLUMN "a" SET DEFAULT 'b'`, asql)
+}
+
+func (atds *alterTableDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.AlterTable("test").SetDialect(md)
+	ds = ds.SetError(err1)
+	atds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	atds.Empty(sql)
+	atds.Empty(args)
+	atds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	atds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	atds.Empty(sql)
+	atds.Empty(args)
+	atds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.DropColumn("a")
+	atds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	atds.Empty(sql)
+	atds.Empty(args)
+	atds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToAlterTableSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	atds.Empty(sql)
+	atds.Empty(args)
+	atds.Equal(err1, err)
+}
+
+func TestAlterTableDataset(t *testing.T) {
+	suite.Run(t, new(alterTableDatasetSuite))
+}
@@ -0,0 +1,118 @@
+package goqu
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+)
+
+type (
+	batchExecutor interface {
+		ExecScriptContext(ctx context.Context, statements ...exec.Statement) (sql.Result, error)
+		QueryMultiContext(ctx context.Context, statements ...exec.Statement) (*sql.Rows, error)
+	}
+	// Batch aggregates multiple statements (e.g. insert, update and delete datasets) so they can be sent to the
+	// database in a single round trip. By default the statements are joined into a single script, which requires a
+	// dialect that supports multiple statements (e.g. mysql). If an exec.BatchSender is set (e.g. an adapter around
+	// pgx SendBatch) the statements are sent using the sender instead.
+	Batch struct {
+		dialect    string
+		db         batchExecutor
+		sender     exec.BatchSender
+		statements []exec.Statement
+	}
+)
+
+var errBatchResultsRequireSender = errors.New(
+	"a result for each statement requires an exec.BatchSender, use ExecScript to execute the batch as a script",
+)
+
+func newBatch(dialect string, db batchExecutor, statements []exec.Statement) *Batch {
+	return &Batch{dialect: dialect, db: db, statements: statements}
+}
+
+func (b *Batch) copy() *Batch {
+	return &Batch{
+		dialect:    b.dialect,
+		db:         b.db,
+		sender:     b.sender,
+		statements: append(make([]exec.Statement, 0, len(b.statements)), b.statements...),
+	}
+}
+
+// Returns a new Batch with the statements appended.
+func (b *Batch) Add(statements ...exec.Statement) *Batch {
+	ret := b.copy()
+	ret.statements = append(ret.statements, statements...)
+	return ret
+}
+
+// Returns the statements in the batch.
+func (b *Batch) Statements() []exec.Statement {
+	return append(make([]exec.Statement, 0, len(b.statements)), b.statements...)
+}
+
+// Returns the number of statements in the batch.
+func (b *Batch) Len() int {
+	return len(b.statements)
+}
+
+// Returns a new Batch that sends the statements using the exec.BatchSender instead of a multiple statement script.
+// Passing nil reverts to a multiple statement script. See exec.BatchSender.
+func (b *Batch) WithSender(sender exec.BatchSender) *Batch {
+	ret := b.copy()
+	ret.sender = sender
+	return ret
+}
+
+// Returns the multiple statement script that is executed when no exec.BatchSender is set. An error is returned if
+// the dialect does not support multiple statements.
+func (b *Batch) ToSQL() (sql string, args []interface{}, err error) {
+	return multiStatementSQL(b.dialect, b.statements)
+}
+
+// Executes the batch in a single round trip and returns a result for each statement in the order they were added.
+//
+// An exec.BatchSender is required for batches with more than one statement because database/sql only reports a
+// single result for a script, an error is returned without executing the batch if no sender is set. Use ExecScript to
+// execute the batch as a script when the results of each statement are not needed.
+func (b *Batch) Exec(ctx context.Context) ([]exec.BatchResult, error) {
+	if b.sender != nil {
+		return exec.SendBatch(ctx, b.sender, b.statements...)
+	}
+	if len(b.statements) > 1 {
+		return nil, errBatchResultsRequireSender
+	}
+	res, err := b.ExecScript(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rowsAffected, err := res.RowsAffected()
+	return []exec.BatchResult{{RowsAffected: rowsAffected, Err: err}}, nil
+}
+
+// Executes the batch as a single script and returns the single result reported for the script (e.g. for mysql the
+// result of the last statement). See Database#ExecScript.
+func (b *Batch) ExecScript(ctx context.Context) (sql.Result, error) {
+	return b.db.ExecScriptContext(ctx, b.statements...)
+}
+
+// Executes the batch as a single script and returns the rows, use sql.Rows#NextResultSet to move to the results of
+// the next statement. See Database#QueryMulti.
+func (b *Batch) Query(ctx context.Context) (*sql.Rows, error) {
+	return b.db.QueryMultiContext(ctx, b.statements...)
+}
+
+// Creates a new Batch for the statements. See Batch.
+//
+// statements: the datasets to add to the batch
+func (d *Database) Batch(statements ...exec.Statement) *Batch {
+	return newBatch(d.dialect, d, statements)
+}
+
+// See Database#Batch
+func (td *TxDatabase) Batch(statements ...exec.Statement) *Batch {
+	return newBatch(td.dialect, td, statements)
+}
@@ -0,0 +1,167 @@
+package goqu_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	batchTestSender struct {
+		queries []exec.BatchQuery
+	}
+	batchSuite struct {
+		suite.Suite
+	}
+)
+
+func (bts *batchTestSender) SendBatch(_ context.Context, queries []exec.BatchQuery) ([]exec.BatchResult, error) {
+	bts.queries = queries
+	results := make([]exec.BatchResult, 0, len(queries))
+	for i := range queries {
+		results = append(results, exec.BatchResult{RowsAffected: int64(i + 1)})
+	}
+	return results, nil
+}
+
+func TestBatchSuite(t *testing.T) {
+	suite.Run(t, new(batchSuite))
+}
+
+func (bs *batchSuite) TestAdd() {
+	db := goqu.New("mysql", nil)
+	b := db.Batch(db.Delete("items"))
+	b2 := b.Add(db.Delete("users"))
+	bs.Equal(1, b.Len())
+	bs.Equal(2, b2.Len())
+	bs.Len(b2.Statements(), 2)
+}
+
+func (bs *batchSuite) TestToSQL() {
+	db := goqu.New("mysql", nil)
+	query, args, err := db.Batch(
+		db.Insert("items").Rows(goqu.Record{"name": "a"}),
+		db.Update("items").Set(goqu.Record{"name": "b"}).Where(goqu.C("id").Eq(1)).Prepared(true),
+	).ToSQL()
+	bs.NoError(err)
+	bs.Equal("INSERT INTO `items` (`name`) VALUES ('a'); UPDATE `items` SET `name`=? WHERE (`id` = ?)", query)
+	bs.Equal([]interface{}{"b", int64(1)}, args)
+
+	pdb := goqu.New("postgres", nil)
+	_, _, err = pdb.Batch(pdb.Delete("items")).ToSQL()
+	bs.EqualError(err, "goqu: dialect does not support multiple statements [dialect=postgres]")
+}
+
+func (bs *batchSuite) TestExec() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	bs.NoError(err)
+	sqlMock.ExpectExec("DELETE `items` FROM `items`").
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	db := goqu.New("mysql", mDB)
+	results, err := db.Batch(db.Delete("items")).Exec(context.Background())
+	bs.NoError(err)
+	bs.Equal([]exec.BatchResult{{RowsAffected: 3}}, results)
+	bs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (bs *batchSuite) TestExec_multipleStatementsWithoutSender() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	bs.NoError(err)
+
+	db := goqu.New("mysql", mDB)
+	results, err := db.Batch(db.Delete("items"), db.Delete("users")).Exec(context.Background())
+	bs.Nil(results)
+	bs.EqualError(err, "goqu: a result for each statement requires an exec.BatchSender, "+
+		"use ExecScript to execute the batch as a script")
+	bs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (bs *batchSuite) TestExecScript() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	bs.NoError(err)
+	sqlMock.ExpectExec("DELETE `items` FROM `items`; DELETE `users` FROM `users`").
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	db := goqu.New("mysql", mDB)
+	res, err := db.Batch(db.Delete("items"), db.Delete("users")).ExecScript(context.Background())
+	bs.NoError(err)
+	rowsAffected, err := res.RowsAffected()
+	bs.NoError(err)
+	bs.Equal(int64(3), rowsAffected)
+	bs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (bs *batchSuite) TestExec_withSender() {
+	db := goqu.New("postgres", nil)
+	sender := new(batchTestSender)
+	results, err := db.Batch(db.Delete("items")).
+		Add(db.Update("items").Set(goqu.Record{"name": "b"}).Prepared(true)).
+		WithSender(sender).
+		Exec(context.Background())
+	bs.NoError(err)
+	bs.Equal([]exec.BatchResult{{RowsAffected: 1}, {RowsAffected: 2}}, results)
+	bs.Equal([]exec.BatchQuery{
+		{SQL: `DELETE FROM "items"`, Args: []interface{}{}},
+		{SQL: `UPDATE "items" SET "name"=$1`, Args: []interface{}{"b"}},
+	}, sender.queries)
+}
+
+func (bs *batchSuite) TestExec_unsupportedDialect() {
+	db := goqu.New("postgres", nil)
+	results, err := db.Batch(db.Delete("items")).Exec(context.Background())
+	bs.Nil(results)
+	bs.EqualError(err, "goqu: dialect does not support multiple statements [dialect=postgres]")
+}
+
+func (bs *batchSuite) TestQuery() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	bs.NoError(err)
+	sqlMock.ExpectQuery("SELECT `id` FROM `items`; SELECT `name` FROM `users`").
+		WithArgs().
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id"}).AddRow(1),
+			sqlmock.NewRows([]string{"name"}).AddRow("Bob"),
+		)
+
+	db := goqu.New("mysql", mDB)
+	rows, err := db.Batch(db.From("items").Select("id"), db.From("users").Select("name")).Query(context.Background())
+	bs.NoError(err)
+	defer func() { _ = rows.Close() }()
+
+	var id int64
+	bs.True(rows.Next())
+	bs.NoError(rows.Scan(&id))
+	bs.Equal(int64(1), id)
+	bs.True(rows.NextResultSet())
+
+	var name string
+	bs.True(rows.Next())
+	bs.NoError(rows.Scan(&name))
+	bs.Equal("Bob", name)
+}
+
+func (bs *batchSuite) TestExec_withTx() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	bs.NoError(err)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec("DELETE `items` FROM `items`; DELETE `users` FROM `users`").
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	db := goqu.New("mysql", mDB)
+	bs.NoError(db.WithTx(func(tx *goqu.TxDatabase) error {
+		_, err := tx.Batch(tx.Delete("items"), tx.Delete("users")).ExecScript(context.Background())
+		return err
+	}))
+	bs.NoError(sqlMock.ExpectationsWereMet())
+}
@@ -0,0 +1,171 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// CallDataset for creating and/or executing stored procedure calls (e.g. CALL "proc"(1, 2) or EXEC "proc" 1, 2).
+type CallDataset struct {
+	dialect      SQLDialect
+	clauses      exp.CallClauses
+	isPrepared   prepared
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedCallProcedureType = errors.New(
+	"unsupported procedure type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter
+func newCallDataset(d string, queryFactory exec.QueryFactory) *CallDataset {
+	return &CallDataset{
+		clauses:      exp.NewCallClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+		isPrepared:   preparedNoPreference,
+	}
+}
+
+// Call creates a CallDataset that calls a stored procedure with the given arguments. OUT parameters can be passed
+// using sql.Out, they are always bound as placeholders so the driver can set the value (e.g. sqlserver and oracle).
+//
+//	var total int64
+//	goqu.Dialect("sqlserver").Call("user_totals", 10, sql.Out{Dest: &total})
+func Call(procedure interface{}, args ...interface{}) *CallDataset {
+	return newCallDataset("default", nil).Procedure(procedure).Args(args...)
+}
+
+// Expression returns CallDataset as exp.Expression.
+func (cd *CallDataset) Expression() exp.Expression {
+	return cd
+}
+
+// Clone clones the CallDataset.
+func (cd *CallDataset) Clone() exp.Expression {
+	return cd.copy(cd.clauses)
+}
+
+// Prepared set the parameter interpolation behavior.
+//
+// prepared: If true the dataset WILL NOT interpolate the parameters.
+func (cd *CallDataset) Prepared(prepared bool) *CallDataset {
+	ret := cd.copy(cd.clauses)
+	ret.isPrepared = preparedFromBool(prepared)
+	return ret
+}
+
+// IsPrepared returns true if Prepared(true) has been called on this CallDataset.
+func (cd *CallDataset) IsPrepared() bool {
+	return cd.isPrepared.Bool()
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (cd *CallDataset) WithDialect(dl string) *CallDataset {
+	ds := cd.copy(cd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// Dialect returns the current SQLDialect on the CallDataset.
+func (cd *CallDataset) Dialect() SQLDialect {
+	return cd.dialect
+}
+
+// SetDialect sets the SQLDialect for this CallDataset.
+func (cd *CallDataset) SetDialect(dialect SQLDialect) *CallDataset {
+	ds := cd.copy(cd.GetClauses())
+	ds.dialect = dialect
+	return ds
+}
+
+// GetClauses returns the current exp.CallClauses on the CallDataset.
+func (cd *CallDataset) GetClauses() exp.CallClauses {
+	return cd.clauses
+}
+
+// used internally to copy the CallDataset.
+func (cd *CallDataset) copy(clauses exp.CallClauses) *CallDataset {
+	return &CallDataset{
+		dialect:      cd.dialect,
+		clauses:      clauses,
+		isPrepared:   cd.isPrepared,
+		queryFactory: cd.queryFactory,
+		err:          cd.err,
+	}
+}
+
+// Procedure sets the stored procedure to call. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier (e.g. "dbo.user_totals")
+// IdentifierExpression
+func (cd *CallDataset) Procedure(procedure interface{}) *CallDataset {
+	switch p := procedure.(type) {
+	case exp.IdentifierExpression:
+		return cd.copy(cd.clauses.SetProcedure(p))
+	case string:
+		return cd.copy(cd.clauses.SetProcedure(exp.ParseIdentifier(p)))
+	default:
+		panic(ErrUnsupportedCallProcedureType)
+	}
+}
+
+// Args sets the arguments passed to the stored procedure, replacing any previously set arguments.
+func (cd *CallDataset) Args(args ...interface{}) *CallDataset {
+	return cd.copy(cd.clauses.SetArgs(args))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (cd *CallDataset) Error() error {
+	return cd.err
+}
+
+// SetError sets an error on the CallDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (cd *CallDataset) SetError(err error) *CallDataset {
+	if cd.err == nil {
+		cd.err = err
+	}
+	return cd
+}
+
+// ToSQL generates a CALL sql statement,
+// if Prepared has been called with true then the parameters will not be interpolated.
+//
+// Errors:
+//   - There is no procedure
+//   - The dialect does not support stored procedures
+//   - There is an error generating the SQL
+func (cd *CallDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return cd.callSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (cd *CallDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = cd.callSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor creates an QueryExecutor to execute the call, any result sets returned by the procedure can be scanned
+// using the QueryExecutor (e.g. ScanStructs).
+//
+// db.Call("user_totals", 10).Executor().ScanStructs(&totals)
+func (cd *CallDataset) Executor() exec.QueryExecutor {
+	return cd.queryFactory.FromSQLBuilder(cd.callSQLBuilder())
+}
+
+func (cd *CallDataset) callSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(cd.isPrepared.Bool())
+	if cd.err != nil {
+		return buf.SetError(cd.err)
+	}
+	cd.dialect.ToCallSQL(buf, cd.clauses)
+	return buf
+}
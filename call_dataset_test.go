@@ -0,0 +1,250 @@
+package goqu_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	callTestCase struct {
+		ds      *goqu.CallDataset
+		clauses exp.CallClauses
+	}
+	callDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (cds *callDatasetSuite) assertCases(cases ...callTestCase) {
+	for _, s := range cases {
+		cds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (cds *callDatasetSuite) TestClone() {
+	ds := goqu.Call("test")
+	cds.Equal(ds, ds.Clone())
+}
+
+func (cds *callDatasetSuite) TestExpression() {
+	ds := goqu.Call("test")
+	cds.Equal(ds, ds.Expression())
+}
+
+func (cds *callDatasetSuite) TestDialect() {
+	ds := goqu.Call("test")
+	cds.NotNil(ds.Dialect())
+}
+
+func (cds *callDatasetSuite) TestWithDialect() {
+	ds := goqu.Call("test")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	cds.Equal(md, ds.Dialect())
+	cds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (cds *callDatasetSuite) TestPrepared() {
+	ds := goqu.Call("test")
+	preparedDs := ds.Prepared(true)
+	cds.True(preparedDs.IsPrepared())
+	cds.False(ds.IsPrepared())
+	// should apply the prepared to any datasets created from the root
+	cds.True(preparedDs.Args(1).IsPrepared())
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// should be prepared by default
+	ds = goqu.Call("test")
+	cds.True(ds.IsPrepared())
+}
+
+func (cds *callDatasetSuite) TestGetClauses() {
+	ds := goqu.Call("test", 1)
+	ce := exp.NewCallClauses().SetProcedure(goqu.I("test")).SetArgs([]interface{}{1})
+	cds.Equal(ce, ds.GetClauses())
+}
+
+func (cds *callDatasetSuite) TestProcedure() {
+	bd := goqu.Call("test")
+	ec := exp.NewCallClauses().SetArgs(nil)
+	cds.assertCases(
+		callTestCase{ds: bd.Procedure("test2"), clauses: ec.SetProcedure(goqu.I("test2"))},
+		callTestCase{ds: bd.Procedure(goqu.S("s").Table("test2")), clauses: ec.SetProcedure(goqu.S("s").Table("test2"))},
+		callTestCase{ds: bd, clauses: ec.SetProcedure(goqu.I("test"))},
+	)
+	cds.PanicsWithValue(goqu.ErrUnsupportedCallProcedureType, func() {
+		goqu.Call(true)
+	})
+}
+
+func (cds *callDatasetSuite) TestArgs() {
+	var out int64
+	bd := goqu.Call("test", 1)
+	ec := exp.NewCallClauses().SetProcedure(goqu.I("test"))
+	cds.assertCases(
+		callTestCase{ds: bd.Args("a", 2), clauses: ec.SetArgs([]interface{}{"a", 2})},
+		callTestCase{ds: bd.Args(sql.Out{Dest: &out}), clauses: ec.SetArgs([]interface{}{sql.Out{Dest: &out}})},
+		callTestCase{ds: bd.Args(), clauses: ec.SetArgs(nil)},
+		callTestCase{ds: bd, clauses: ec.SetArgs([]interface{}{1})},
+	)
+}
+
+func (cds *callDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Call("test").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCallSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Nil(err)
+	md.AssertExpectations(cds.T())
+}
+
+func (cds *callDatasetSuite) TestToSQL_Prepared() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Call("test").Prepared(true).SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(true)
+	md.On("ToCallSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Nil(err)
+	md.AssertExpectations(cds.T())
+}
+
+func (cds *callDatasetSuite) TestToSQL_WithError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Call("test").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCallSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(ee, err)
+	md.AssertExpectations(cds.T())
+}
+
+func (cds *callDatasetSuite) TestMustToSQL() {
+	sql, args := goqu.Call("test", 1, "a").MustToSQL()
+	cds.Empty(args)
+	cds.Equal(`CALL "test"(1, 'a')`, sql)
+
+	cds.Panics(func() {
+		goqu.Call("test").WithDialect("sqlite3").MustToSQL()
+	})
+}
+
+func (cds *callDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	cds.NoError(err)
+
+	var out int64
+	ds := goqu.New("mock", mDB).Call("user_totals", 10, sql.Out{Dest: &out})
+
+	csql, args, err := ds.Executor().ToSQL()
+	cds.NoError(err)
+	cds.Equal([]interface{}{sql.Out{Dest: &out}}, args)
+	cds.Equal(`CALL "user_totals"(10, ?)`, csql)
+
+	csql, args, err = ds.Prepared(true).Executor().ToSQL()
+	cds.NoError(err)
+	cds.Equal([]interface{}{int64(10), sql.Out{Dest: &out}}, args)
+	cds.Equal(`CALL "user_totals"(?, ?)`, csql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	csql, args, err = ds.Executor().ToSQL()
+	cds.NoError(err)
+	cds.Equal([]interface{}{int64(10), sql.Out{Dest: &out}}, args)
+	cds.Equal(`CALL "user_totals"(?, ?)`, csql)
+}
+
+func (cds *callDatasetSuite) TestExecutor_ScanStructs() {
+	type userTotal struct {
+		UserID int64 `db:"user_id"`
+		Total  int64 `db:"total"`
+	}
+	mDB, sqlMock, err := sqlmock.New()
+	cds.NoError(err)
+	sqlMock.ExpectQuery(`CALL "user_totals"\(10\)`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "total"}).AddRow(1, 100).AddRow(2, 200))
+
+	var totals []userTotal
+	cds.NoError(goqu.New("mock", mDB).Call("user_totals", 10).Executor().ScanStructs(&totals))
+	cds.Equal([]userTotal{{UserID: 1, Total: 100}, {UserID: 2, Total: 200}}, totals)
+	cds.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (cds *callDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.Call("test").SetDialect(md)
+	ds = ds.SetError(err1)
+	cds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	cds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.Args(1)
+	cds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCallSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+}
+
+func TestCallDataset(t *testing.T) {
+	suite.Run(t, new(callDatasetSuite))
+}
@@ -0,0 +1,110 @@
+package goqu
+
+import (
+	"sort"
+
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+type (
+	// TypedCase is a CASE builder whose branch results are all of type T. It can be used anywhere an
+	// exp.Expression is accepted (SELECT lists, ORDER BY, UPDATE SET values, WHEN conditions/results of another
+	// CASE) and is immutable, every method returns a new TypedCase.
+	TypedCase[T any] struct {
+		c exp.CaseExpression
+	}
+
+	// caseKey constrains the keys that can be used with CaseMap so they can be sorted into a deterministic
+	// order.
+	caseKey interface {
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+			~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+			~float32 | ~float64 | ~string
+	}
+)
+
+// CaseOf creates a searched CASE whose results are of type T.
+//    CaseOf[string]().When(C("a").Gt(10), "big").Else("small")
+//    // CASE WHEN ("a" > 10) THEN 'big' ELSE 'small' END
+func CaseOf[T any]() TypedCase[T] {
+	return TypedCase[T]{c: exp.NewCaseExpression()}
+}
+
+// CaseOfValue creates a simple CASE comparing value against each WHEN, whose results are of type T.
+//    CaseOfValue[int](C("status")).When("active", 1).Else(0)
+//    // CASE "status" WHEN 'active' THEN 1 ELSE 0 END
+func CaseOfValue[T any](value interface{}) TypedCase[T] {
+	return TypedCase[T]{c: exp.NewCaseExpression().Value(value)}
+}
+
+// CaseMap creates a simple CASE with a WHEN branch for every entry in results. Branches are sorted by key so the
+// generated SQL is deterministic. If value is a string it is treated as a column.
+//    CaseMap(C("id"), map[int64]string{1: "a", 2: "b"})
+//    // CASE "id" WHEN 1 THEN 'a' WHEN 2 THEN 'b' END
+func CaseMap[K caseKey, V any](value interface{}, results map[K]V) TypedCase[V] {
+	if s, ok := value.(string); ok {
+		value = I(s)
+	}
+	keys := make([]K, 0, len(results))
+	for k := range results {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	whens := make([]exp.CaseWhen, 0, len(keys))
+	for _, k := range keys {
+		whens = append(whens, exp.NewCaseWhen(k, results[k]))
+	}
+	return TypedCase[V]{c: exp.NewCaseExpression().Value(value).Whens(whens...)}
+}
+
+// When creates a WHEN branch that can be passed to exp.CaseExpression.Whens.
+func When(condition, result interface{}) exp.CaseWhen {
+	return exp.NewCaseWhen(condition, result)
+}
+
+func (tc TypedCase[T]) Clone() exp.Expression {
+	return tc
+}
+
+func (tc TypedCase[T]) Expression() exp.Expression {
+	return tc
+}
+
+// ToCase returns the underlying exp.CaseExpression.
+func (tc TypedCase[T]) ToCase() exp.CaseExpression {
+	if tc.c == nil {
+		return exp.NewCaseExpression()
+	}
+	return tc.c
+}
+
+// When adds a WHEN branch, the condition may be any value or exp.Expression (including another CASE).
+func (tc TypedCase[T]) When(condition interface{}, result T) TypedCase[T] {
+	return TypedCase[T]{c: tc.ToCase().When(condition, result)}
+}
+
+// WhenExpression adds a WHEN branch whose result is an expression (e.g. a column or a nested CASE) rather than
+// a value of type T.
+func (tc TypedCase[T]) WhenExpression(condition interface{}, result exp.Expression) TypedCase[T] {
+	return TypedCase[T]{c: tc.ToCase().When(condition, result)}
+}
+
+// Else sets the ELSE result.
+func (tc TypedCase[T]) Else(result T) TypedCase[T] {
+	return TypedCase[T]{c: tc.ToCase().Else(result)}
+}
+
+// ElseExpression sets the ELSE result to an expression (e.g. the current column value in a bulk UPDATE).
+func (tc TypedCase[T]) ElseExpression(result exp.Expression) TypedCase[T] {
+	return TypedCase[T]{c: tc.ToCase().Else(result)}
+}
+
+func (tc TypedCase[T]) As(alias interface{}) exp.AliasedExpression { return tc.ToCase().As(alias) }
+func (tc TypedCase[T]) Asc() exp.OrderedExpression                 { return tc.ToCase().Asc() }
+func (tc TypedCase[T]) Desc() exp.OrderedExpression                { return tc.ToCase().Desc() }
+func (tc TypedCase[T]) Eq(val interface{}) exp.BooleanExpression   { return tc.ToCase().Eq(val) }
+func (tc TypedCase[T]) Neq(val interface{}) exp.BooleanExpression  { return tc.ToCase().Neq(val) }
+func (tc TypedCase[T]) Gt(val interface{}) exp.BooleanExpression   { return tc.ToCase().Gt(val) }
+func (tc TypedCase[T]) Gte(val interface{}) exp.BooleanExpression  { return tc.ToCase().Gte(val) }
+func (tc TypedCase[T]) Lt(val interface{}) exp.BooleanExpression   { return tc.ToCase().Lt(val) }
+func (tc TypedCase[T]) Lte(val interface{}) exp.BooleanExpression  { return tc.ToCase().Lte(val) }
@@ -0,0 +1,112 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type typedCaseSuite struct {
+	suite.Suite
+}
+
+func TestTypedCaseSuite(t *testing.T) {
+	suite.Run(t, new(typedCaseSuite))
+}
+
+func (tcs *typedCaseSuite) assertSQL(ds exp.SQLExpression, sql string) {
+	actual, _, err := ds.ToSQL()
+	tcs.NoError(err)
+	tcs.Equal(sql, actual)
+}
+
+func (tcs *typedCaseSuite) TestCaseOf() {
+	tc := goqu.CaseOf[string]().
+		When(goqu.C("a").Gt(10), "big").
+		When(goqu.C("a").Gt(5), "medium").
+		Else("small")
+	tcs.Equal(exp.NewCaseExpression().
+		When(goqu.C("a").Gt(10), "big").
+		When(goqu.C("a").Gt(5), "medium").
+		Else("small"), tc.ToCase())
+	tcs.Equal(tc, tc.Expression())
+	tcs.Equal(tc, tc.Clone())
+
+	tcs.assertSQL(
+		goqu.From("test").Select(tc.As("size")),
+		`SELECT CASE  WHEN ("a" > 10) THEN 'big' WHEN ("a" > 5) THEN 'medium' ELSE 'small' END AS "size" FROM "test"`,
+	)
+}
+
+func (tcs *typedCaseSuite) TestCaseOfValue() {
+	tc := goqu.CaseOfValue[int](goqu.C("status")).
+		When("active", 1).
+		WhenExpression("pending", goqu.C("priority")).
+		Else(0)
+	tcs.assertSQL(
+		goqu.From("test").Select(tc),
+		`SELECT CASE "status" WHEN 'active' THEN 1 WHEN 'pending' THEN "priority" ELSE 0 END FROM "test"`,
+	)
+}
+
+func (tcs *typedCaseSuite) TestCaseMap() {
+	tc := goqu.CaseMap("id", map[int64]string{3: "c", 1: "a", 2: "b"})
+	tcs.Equal(exp.NewCaseExpression().
+		Value(goqu.I("id")).
+		Whens(goqu.When(int64(1), "a"), goqu.When(int64(2), "b"), goqu.When(int64(3), "c")), tc.ToCase())
+
+	tcs.assertSQL(
+		goqu.Update("items").
+			Set(goqu.Record{"name": tc.ElseExpression(goqu.C("name"))}).
+			Where(goqu.C("id").In(1, 2, 3)),
+		`UPDATE "items" SET "name"=CASE "id" WHEN 1 THEN 'a' WHEN 2 THEN 'b' WHEN 3 THEN 'c' ELSE "name" END `+
+			`WHERE ("id" IN (1, 2, 3))`,
+	)
+}
+
+func (tcs *typedCaseSuite) TestImmutable() {
+	base := goqu.CaseOf[int]().When(goqu.C("a").IsNull(), 0)
+	tc1 := base.When(goqu.C("a").Gt(0), 1)
+	tc2 := base.Else(-1)
+	tcs.Len(base.ToCase().GetWhens(), 1)
+	tcs.Nil(base.ToCase().GetElse())
+	tcs.Len(tc1.ToCase().GetWhens(), 2)
+	tcs.Len(tc2.ToCase().GetWhens(), 1)
+	tcs.Equal(exp.NewCaseElse(-1), tc2.ToCase().GetElse())
+}
+
+func (tcs *typedCaseSuite) TestZeroValue() {
+	var tc goqu.TypedCase[string]
+	tcs.Equal(exp.NewCaseExpression(), tc.ToCase())
+	tcs.Equal(exp.NewCaseExpression().When(true, "a"), tc.When(true, "a").ToCase())
+}
+
+func (tcs *typedCaseSuite) TestNested() {
+	inner := goqu.CaseOf[string]().When(goqu.C("b").Eq(1), "x").Else("y")
+	tc := goqu.CaseOf[string]().
+		When(goqu.C("a").Eq(1), "a").
+		WhenExpression(goqu.C("a").Eq(2), inner).
+		ElseExpression(goqu.CaseOfValue[string](goqu.C("c")).When(inner, "z"))
+	tcs.assertSQL(
+		goqu.From("test").Select(tc),
+		`SELECT CASE  WHEN ("a" = 1) THEN 'a' WHEN ("a" = 2) THEN CASE  WHEN ("b" = 1) THEN 'x' ELSE 'y' END `+
+			`ELSE CASE "c" WHEN CASE  WHEN ("b" = 1) THEN 'x' ELSE 'y' END THEN 'z' END END FROM "test"`,
+	)
+}
+
+func (tcs *typedCaseSuite) TestOrderAndCompare() {
+	tc := goqu.CaseOfValue[int](goqu.C("status")).When("open", 0).When("closed", 1).Else(2)
+	tcs.assertSQL(
+		goqu.From("test").Where(tc.Lt(2)).Order(tc.Asc(), goqu.C("id").Desc()),
+		`SELECT * FROM "test" WHERE (CASE "status" WHEN 'open' THEN 0 WHEN 'closed' THEN 1 ELSE 2 END < 2) `+
+			`ORDER BY CASE "status" WHEN 'open' THEN 0 WHEN 'closed' THEN 1 ELSE 2 END ASC, "id" DESC`,
+	)
+	tcs.Equal(tc.ToCase().Eq(1), tc.Eq(1))
+	tcs.Equal(tc.ToCase().Neq(1), tc.Neq(1))
+	tcs.Equal(tc.ToCase().Gt(1), tc.Gt(1))
+	tcs.Equal(tc.ToCase().Gte(1), tc.Gte(1))
+	tcs.Equal(tc.ToCase().Lte(1), tc.Lte(1))
+	tcs.Equal(tc.ToCase().Desc(), tc.Desc())
+}
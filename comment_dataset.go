@@ -0,0 +1,172 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// CommentDataset for creating and/or executing COMMENT ON SQL statements.
+type CommentDataset struct {
+	dialect      SQLDialect
+	clauses      exp.CommentClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedCommentObjectType = errors.New(
+	"unsupported comment object type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newCommentDataset(d string, queryFactory exec.QueryFactory) *CommentDataset {
+	return &CommentDataset{
+		clauses:      exp.NewCommentClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// CommentOnTable creates a CommentDataset to set the comment of a table.
+//
+//	goqu.CommentOnTable("user").Is("the users of the app")
+func CommentOnTable(table interface{}) *CommentDataset {
+	return newCommentDataset("default", nil).object(exp.TableCommentObject, table)
+}
+
+// CommentOnColumn creates a CommentDataset to set the comment of a column, the column should include the table.
+//
+//	goqu.CommentOnColumn("user.email").Is("the login of the user")
+func CommentOnColumn(column interface{}) *CommentDataset {
+	return newCommentDataset("default", nil).object(exp.ColumnCommentObject, column)
+}
+
+// CommentOnView creates a CommentDataset to set the comment of a view.
+//
+//	goqu.CommentOnView("active_user").Is("users that logged in during the last month")
+func CommentOnView(view interface{}) *CommentDataset {
+	return newCommentDataset("default", nil).object(exp.ViewCommentObject, view)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (cmd *CommentDataset) WithDialect(dl string) *CommentDataset {
+	ds := cmd.copy(cmd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (cmd *CommentDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the CommentDataset.
+func (cmd *CommentDataset) Dialect() SQLDialect {
+	return cmd.dialect
+}
+
+// SetDialect returns the current adapter on the CommentDataset.
+func (cmd *CommentDataset) SetDialect(dialect SQLDialect) *CommentDataset {
+	cd := cmd.copy(cmd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns CommentDataset as exp.Expression.
+func (cmd *CommentDataset) Expression() exp.Expression {
+	return cmd
+}
+
+// Clone clones the CommentDataset.
+func (cmd *CommentDataset) Clone() exp.Expression {
+	return cmd.copy(cmd.clauses)
+}
+
+// GetClauses returns the current clauses on the CommentDataset.
+func (cmd *CommentDataset) GetClauses() exp.CommentClauses {
+	return cmd.clauses
+}
+
+// used internally to copy the dataset.
+func (cmd *CommentDataset) copy(clauses exp.CommentClauses) *CommentDataset {
+	return &CommentDataset{
+		dialect:      cmd.dialect,
+		clauses:      clauses,
+		queryFactory: cmd.queryFactory,
+		err:          cmd.err,
+	}
+}
+
+// used internally to set the object to comment on. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (cmd *CommentDataset) object(t exp.CommentObjectType, object interface{}) *CommentDataset {
+	clauses := cmd.clauses.SetObjectType(t)
+	switch o := object.(type) {
+	case exp.Expression:
+		return cmd.copy(clauses.SetObject(o))
+	case string:
+		return cmd.copy(clauses.SetObject(exp.ParseIdentifier(o)))
+	default:
+		panic(ErrUnsupportedCommentObjectType)
+	}
+}
+
+// Is sets the comment, an empty comment removes the comment of the object in most dialects.
+func (cmd *CommentDataset) Is(comment string) *CommentDataset {
+	return cmd.copy(cmd.clauses.SetComment(comment))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (cmd *CommentDataset) Error() error {
+	return cmd.err
+}
+
+// SetError sets an error on the CommentDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (cmd *CommentDataset) SetError(err error) *CommentDataset {
+	if cmd.err == nil {
+		cmd.err = err
+	}
+
+	return cmd
+}
+
+// ToSQL generates a COMMENT ON sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no object
+//   - The dialect does not support comments on the type of object
+//   - There is an error generating the SQL
+func (cmd *CommentDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return cmd.commentSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (cmd *CommentDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = cmd.commentSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the COMMENT ON sql, and returns an Exec struct with the sql set to the COMMENT ON statement.
+//
+// db.CommentOnTable("user").Is("the users of the app").Executor().Exec()
+func (cmd *CommentDataset) Executor() exec.QueryExecutor {
+	return cmd.queryFactory.FromSQLBuilder(cmd.commentSQLBuilder())
+}
+
+func (cmd *CommentDataset) commentSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if cmd.err != nil {
+		return buf.SetError(cmd.err)
+	}
+	cmd.dialect.ToCommentSQL(buf, cmd.clauses)
+	return buf
+}
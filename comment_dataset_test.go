@@ -0,0 +1,206 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	commentTestCase struct {
+		ds      *goqu.CommentDataset
+		clauses exp.CommentClauses
+	}
+	commentDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (cds *commentDatasetSuite) assertCases(cases ...commentTestCase) {
+	for _, s := range cases {
+		cds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (cds *commentDatasetSuite) TestClone() {
+	ds := goqu.CommentOnTable("user")
+	cds.Equal(ds, ds.Clone())
+}
+
+func (cds *commentDatasetSuite) TestExpression() {
+	ds := goqu.CommentOnTable("user")
+	cds.Equal(ds, ds.Expression())
+}
+
+func (cds *commentDatasetSuite) TestDialect() {
+	ds := goqu.CommentOnTable("user")
+	cds.NotNil(ds.Dialect())
+}
+
+func (cds *commentDatasetSuite) TestWithDialect() {
+	ds := goqu.CommentOnTable("user")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	cds.Equal(md, ds.Dialect())
+	cds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (cds *commentDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.CommentOnTable("user")
+	cds.False(ds.IsPrepared())
+}
+
+func (cds *commentDatasetSuite) TestGetClauses() {
+	ds := goqu.CommentOnTable("user")
+	ce := exp.NewCommentClauses().SetObject(goqu.I("user"))
+	cds.Equal(ce, ds.GetClauses())
+}
+
+func (cds *commentDatasetSuite) TestObject() {
+	ce := exp.NewCommentClauses()
+	cds.assertCases(
+		commentTestCase{ds: goqu.CommentOnTable("user"), clauses: ce.SetObject(goqu.I("user"))},
+		commentTestCase{
+			ds:      goqu.CommentOnTable(goqu.S("s").Table("user")),
+			clauses: ce.SetObject(goqu.S("s").Table("user")),
+		},
+		commentTestCase{
+			ds:      goqu.CommentOnColumn("user.email"),
+			clauses: ce.SetObjectType(exp.ColumnCommentObject).SetObject(goqu.I("user.email")),
+		},
+		commentTestCase{
+			ds:      goqu.CommentOnColumn(goqu.T("user").Col("email")),
+			clauses: ce.SetObjectType(exp.ColumnCommentObject).SetObject(goqu.T("user").Col("email")),
+		},
+		commentTestCase{
+			ds:      goqu.CommentOnView("active_user"),
+			clauses: ce.SetObjectType(exp.ViewCommentObject).SetObject(goqu.I("active_user")),
+		},
+	)
+	cds.PanicsWithValue(goqu.ErrUnsupportedCommentObjectType, func() {
+		goqu.CommentOnTable(true)
+	})
+}
+
+func (cds *commentDatasetSuite) TestIs() {
+	bd := goqu.CommentOnTable("user")
+	ce := bd.GetClauses()
+	cds.assertCases(
+		commentTestCase{ds: bd.Is("the users"), clauses: ce.SetComment("the users")},
+		commentTestCase{ds: bd.Is("the users").Is(""), clauses: ce.SetComment("")},
+		commentTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cds *commentDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CommentOnTable("user").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCommentSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	cds.NoError(err)
+	cds.Empty(sql)
+	cds.Empty(args)
+	md.AssertExpectations(cds.T())
+}
+
+func (cds *commentDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CommentOnTable("user").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCommentSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(ee, err)
+	md.AssertExpectations(cds.T())
+}
+
+func (cds *commentDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	cds.NoError(err)
+
+	ds := goqu.New("mock", mDB).CommentOnTable("user").Is("the users")
+
+	asql, args, err := ds.Executor().ToSQL()
+	cds.NoError(err)
+	cds.Empty(args)
+	cds.Equal(`COMMENT ON TABLE "user" IS 'the users'`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	cds.NoError(err)
+	cds.Empty(args)
+	cds.Equal(`COMMENT ON TABLE "user" IS 'the users'`, asql)
+}
+
+func (cds *commentDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.CommentOnTable("user").SetDialect(md)
+	ds = ds.SetError(err1)
+	cds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	cds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.Is("the users")
+	cds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCommentSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+}
+
+func TestCommentDataset(t *testing.T) {
+	suite.Run(t, new(commentDatasetSuite))
+}
@@ -0,0 +1,270 @@
+package goqu
+
+import (
+	"context"
+	"io"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// CopyDataset for creating and/or executing COPY statements used to bulk load data into a table
+// (e.g. COPY "a" ("b", "c") FROM STDIN) or to bulk export a table or query (e.g. COPY "a" TO STDOUT).
+type CopyDataset struct {
+	dialect SQLDialect
+	clauses exp.CopyClauses
+	err     error
+}
+
+var (
+	ErrUnsupportedCopyTableType = errors.New(
+		"unsupported table type, a string or identifier expression is required",
+	)
+	ErrUnsupportedCopySourceType = errors.New(
+		"unsupported source type, a string, identifier expression or dataset is required",
+	)
+	errCopyFromRequired = errors.New("data can only be loaded by a COPY FROM statement, use CopyFrom")
+	errCopyToRequired   = errors.New("data can only be exported by a COPY TO statement, use CopyTo")
+	errCopyRowsTextOnly = errors.New("rows can only be copied using the text format without a custom DELIMITER or NULL")
+)
+
+// used internally by database to create a database with a specific adapter.
+func newCopyDataset(d string) *CopyDataset {
+	return &CopyDataset{
+		clauses: exp.NewCopyClauses(),
+		dialect: GetDialect(d),
+	}
+}
+
+// CopyFrom creates a CopyDataset that loads data into a table (e.g. COPY "items" FROM STDIN).
+//
+//	goqu.Dialect("postgres").CopyFrom("items").Columns("id", "name").Options(exp.CopyOptions{Format: exp.CopyFormatCSV})
+func CopyFrom(table interface{}) *CopyDataset {
+	return newCopyDataset("default").From(table)
+}
+
+// CopyTo creates a CopyDataset that exports a table or the results of a query (e.g. COPY "items" TO STDOUT).
+//
+//	goqu.Dialect("postgres").CopyTo(goqu.From("items").Where(goqu.C("id").Gt(10)))
+func CopyTo(source interface{}) *CopyDataset {
+	return newCopyDataset("default").To(source)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (cd *CopyDataset) WithDialect(dl string) *CopyDataset {
+	ds := cd.copy(cd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, COPY statements do not support placeholders so the values are always interpolated.
+func (cd *CopyDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the CopyDataset.
+func (cd *CopyDataset) Dialect() SQLDialect {
+	return cd.dialect
+}
+
+// SetDialect sets the adapter on the CopyDataset.
+func (cd *CopyDataset) SetDialect(dialect SQLDialect) *CopyDataset {
+	ds := cd.copy(cd.GetClauses())
+	ds.dialect = dialect
+	return ds
+}
+
+// Expression returns CopyDataset as exp.Expression.
+func (cd *CopyDataset) Expression() exp.Expression {
+	return cd
+}
+
+// Clone clones the CopyDataset.
+func (cd *CopyDataset) Clone() exp.Expression {
+	return cd.copy(cd.clauses)
+}
+
+// GetClauses returns the current clauses on the CopyDataset.
+func (cd *CopyDataset) GetClauses() exp.CopyClauses {
+	return cd.clauses
+}
+
+// used internally to copy the dataset.
+func (cd *CopyDataset) copy(clauses exp.CopyClauses) *CopyDataset {
+	return &CopyDataset{
+		dialect: cd.dialect,
+		clauses: clauses,
+		err:     cd.err,
+	}
+}
+
+// From sets the table to load data into. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+func (cd *CopyDataset) From(table interface{}) *CopyDataset {
+	switch t := table.(type) {
+	case exp.IdentifierExpression:
+		return cd.copy(cd.clauses.SetTable(t).SetFrom(true))
+	case string:
+		return cd.copy(cd.clauses.SetTable(exp.ParseIdentifier(t)).SetFrom(true))
+	default:
+		panic(ErrUnsupportedCopyTableType)
+	}
+}
+
+// To sets the table or query to export. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// AppendableExpression: (e.g. SelectDataset) Will be wrapped in parens (e.g. COPY (SELECT ...) TO STDOUT)
+func (cd *CopyDataset) To(source interface{}) *CopyDataset {
+	switch t := source.(type) {
+	case exp.IdentifierExpression, exp.AppendableExpression:
+		return cd.copy(cd.clauses.SetTable(t.(exp.Expression)).SetFrom(false))
+	case string:
+		return cd.copy(cd.clauses.SetTable(exp.ParseIdentifier(t)).SetFrom(false))
+	default:
+		panic(ErrUnsupportedCopySourceType)
+	}
+}
+
+// Columns sets the columns to copy, any previously set columns are replaced. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+func (cd *CopyDataset) Columns(cols ...interface{}) *CopyDataset {
+	return cd.copy(cd.clauses.SetColumns(exp.NewColumnListExpression(cols...)))
+}
+
+// Options sets the options used to read or write the data (e.g. WITH (FORMAT csv, HEADER)).
+func (cd *CopyDataset) Options(opts exp.CopyOptions) *CopyDataset {
+	return cd.copy(cd.clauses.SetOptions(opts))
+}
+
+// Source sets where the data is loaded from instead of STDIN, a string is written as a string literal.
+//
+//	goqu.Dialect("redshift").CopyFrom("items").Source("s3://bucket/items/")
+//	// COPY "items" FROM 's3://bucket/items/'
+func (cd *CopyDataset) Source(source interface{}) *CopyDataset {
+	if e, ok := source.(exp.Expression); ok {
+		return cd.copy(cd.clauses.SetSource(e))
+	}
+	return cd.copy(cd.clauses.SetSource(exp.NewLiteralExpression("?", source)))
+}
+
+// Parameters appends dialect specific parameters that are written after the options.
+//
+//	goqu.Dialect("redshift").CopyFrom("items").Source("s3://bucket/items/").
+//	    Parameters(goqu.L("IAM_ROLE ?", arn), goqu.L("FORMAT AS CSV"))
+//	// COPY "items" FROM 's3://bucket/items/' IAM_ROLE '...' FORMAT AS CSV
+func (cd *CopyDataset) Parameters(params ...exp.Expression) *CopyDataset {
+	return cd.copy(cd.clauses.ParametersAppend(params...))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (cd *CopyDataset) Error() error {
+	return cd.err
+}
+
+// SetError sets an error on the CopyDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (cd *CopyDataset) SetError(err error) *CopyDataset {
+	if cd.err == nil {
+		cd.err = err
+	}
+	return cd
+}
+
+// ToSQL generates a COPY sql statement, COPY statements are always interpolated.
+//
+// Errors:
+//   - There is no table
+//   - The dialect does not support COPY
+//   - A query is used with CopyFrom or with Columns
+//   - There is an error generating the SQL
+func (cd *CopyDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return cd.copySQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (cd *CopyDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = cd.copySQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Load streams the data read from r to the database using the COPY FROM statement and returns the number of rows
+// copied. The data must be in the format set using Options.
+//
+//	f, _ := os.Open("items.csv")
+//	n, err := goqu.Dialect("postgres").
+//		CopyFrom("items").
+//		Options(exp.CopyOptions{Format: exp.CopyFormatCSV, Header: true}).
+//		Load(ctx, pgxCopier{conn}, f)
+func (cd *CopyDataset) Load(ctx context.Context, copier exec.CopyFromer, r io.Reader) (int64, error) {
+	if !cd.clauses.IsFrom() {
+		return 0, errCopyFromRequired
+	}
+	query, _, err := cd.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	return copier.CopyFrom(ctx, r, query)
+}
+
+// LoadRows encodes the rows using the COPY text format and streams them to the database using the COPY FROM
+// statement, each row must contain a value for each column in the order set using Columns. Returns the number of
+// rows copied.
+//
+//	n, err := goqu.Dialect("postgres").
+//		CopyFrom("items").
+//		Columns("id", "name").
+//		LoadRows(ctx, pgxCopier{conn}, [][]interface{}{{1, "a"}, {2, "b"}})
+func (cd *CopyDataset) LoadRows(ctx context.Context, copier exec.CopyFromer, rows [][]interface{}) (int64, error) {
+	opts := cd.clauses.Options()
+	if (opts.Format != exp.CopyFormatDefault && opts.Format != exp.CopyFormatText) ||
+		opts.Delimiter != "" || opts.Null != "" {
+		return 0, errCopyRowsTextOnly
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(exec.EncodeCopyText(pw, rows))
+	}()
+	n, err := cd.Load(ctx, copier, pr)
+	// unblock the encoder if the copier returned before reading all rows
+	pr.Close()
+	return n, err
+}
+
+// Unload streams the table or query results to w using the COPY TO statement and returns the number of rows copied.
+//
+//	var buf bytes.Buffer
+//	n, err := goqu.Dialect("postgres").
+//		CopyTo("items").
+//		Options(exp.CopyOptions{Format: exp.CopyFormatCSV}).
+//		Unload(ctx, pgxCopier{conn}, &buf)
+func (cd *CopyDataset) Unload(ctx context.Context, copier exec.CopyToer, w io.Writer) (int64, error) {
+	if cd.clauses.IsFrom() {
+		return 0, errCopyToRequired
+	}
+	query, _, err := cd.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	return copier.CopyTo(ctx, w, query)
+}
+
+func (cd *CopyDataset) copySQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if cd.err != nil {
+		return buf.SetError(cd.err)
+	}
+	cd.dialect.ToCopySQL(buf, cd.clauses)
+	return buf
+}
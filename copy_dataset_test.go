@@ -0,0 +1,340 @@
+package goqu_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	copyTestCase struct {
+		ds      *goqu.CopyDataset
+		clauses exp.CopyClauses
+	}
+	testCopier struct {
+		sql  string
+		data string
+		err  error
+	}
+	copyDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (tc *testCopier) CopyFrom(_ context.Context, r io.Reader, sql string) (int64, error) {
+	tc.sql = sql
+	if tc.err != nil {
+		return 0, tc.err
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	tc.data = string(data)
+	return int64(strings.Count(tc.data, "\n")), nil
+}
+
+func (tc *testCopier) CopyTo(_ context.Context, w io.Writer, sql string) (int64, error) {
+	tc.sql = sql
+	if tc.err != nil {
+		return 0, tc.err
+	}
+	n, err := io.WriteString(w, tc.data)
+	return int64(n), err
+}
+
+func (cds *copyDatasetSuite) assertCases(cases ...copyTestCase) {
+	for _, s := range cases {
+		cds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (cds *copyDatasetSuite) TestClone() {
+	ds := goqu.CopyFrom("items")
+	cds.Equal(ds, ds.Clone())
+}
+
+func (cds *copyDatasetSuite) TestExpression() {
+	ds := goqu.CopyFrom("items")
+	cds.Equal(ds, ds.Expression())
+}
+
+func (cds *copyDatasetSuite) TestDialect() {
+	ds := goqu.CopyFrom("items")
+	cds.NotNil(ds.Dialect())
+}
+
+func (cds *copyDatasetSuite) TestWithDialect() {
+	ds := goqu.CopyFrom("items")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	cds.Equal(md, ds.Dialect())
+	cds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (cds *copyDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.CopyFrom("items")
+	cds.False(ds.IsPrepared())
+}
+
+func (cds *copyDatasetSuite) TestGetClauses() {
+	ds := goqu.CopyFrom("items")
+	ce := exp.NewCopyClauses().SetTable(goqu.I("items")).SetFrom(true)
+	cds.Equal(ce, ds.GetClauses())
+}
+
+func (cds *copyDatasetSuite) TestFrom() {
+	bd := goqu.CopyTo("items")
+	cds.assertCases(
+		copyTestCase{ds: bd.From("items2"), clauses: exp.NewCopyClauses().SetTable(goqu.I("items2")).SetFrom(true)},
+		copyTestCase{
+			ds:      bd.From(goqu.S("s").Table("items2")),
+			clauses: exp.NewCopyClauses().SetTable(goqu.S("s").Table("items2")).SetFrom(true),
+		},
+		copyTestCase{ds: bd, clauses: exp.NewCopyClauses().SetTable(goqu.I("items"))},
+	)
+	cds.PanicsWithValue(goqu.ErrUnsupportedCopyTableType, func() {
+		goqu.CopyFrom(goqu.From("items"))
+	})
+}
+
+func (cds *copyDatasetSuite) TestTo() {
+	bd := goqu.CopyFrom("items")
+	sd := goqu.From("items").Where(goqu.C("id").Gt(10))
+	cds.assertCases(
+		copyTestCase{ds: bd.To("items2"), clauses: exp.NewCopyClauses().SetTable(goqu.I("items2"))},
+		copyTestCase{
+			ds:      bd.To(goqu.S("s").Table("items2")),
+			clauses: exp.NewCopyClauses().SetTable(goqu.S("s").Table("items2")),
+		},
+		copyTestCase{ds: bd.To(sd), clauses: exp.NewCopyClauses().SetTable(sd)},
+		copyTestCase{ds: bd, clauses: exp.NewCopyClauses().SetTable(goqu.I("items")).SetFrom(true)},
+	)
+	cds.PanicsWithValue(goqu.ErrUnsupportedCopySourceType, func() {
+		goqu.CopyTo(true)
+	})
+}
+
+func (cds *copyDatasetSuite) TestColumns() {
+	bd := goqu.CopyFrom("items")
+	ce := bd.GetClauses()
+	cds.assertCases(
+		copyTestCase{ds: bd.Columns("a", "b"), clauses: ce.SetColumns(exp.NewColumnListExpression("a", "b"))},
+		copyTestCase{ds: bd.Columns("a").Columns("c"), clauses: ce.SetColumns(exp.NewColumnListExpression("c"))},
+		copyTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cds *copyDatasetSuite) TestOptions() {
+	bd := goqu.CopyFrom("items")
+	ce := bd.GetClauses()
+	opts := exp.CopyOptions{Format: exp.CopyFormatCSV, Header: true}
+	cds.assertCases(
+		copyTestCase{ds: bd.Options(opts), clauses: ce.SetOptions(opts)},
+		copyTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cds *copyDatasetSuite) TestSource() {
+	bd := goqu.CopyFrom("items")
+	ce := bd.GetClauses()
+	source := goqu.L("'s3://bucket/items/'")
+	cds.assertCases(
+		copyTestCase{ds: bd.Source("s3://bucket/items/"), clauses: ce.SetSource(goqu.L("?", "s3://bucket/items/"))},
+		copyTestCase{ds: bd.Source(source), clauses: ce.SetSource(source)},
+		copyTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cds *copyDatasetSuite) TestParameters() {
+	bd := goqu.CopyFrom("items")
+	ce := bd.GetClauses()
+	p1 := goqu.L("FORMAT AS CSV")
+	p2 := goqu.L("IGNOREHEADER 1")
+	cds.assertCases(
+		copyTestCase{ds: bd.Parameters(p1, p2), clauses: ce.ParametersAppend(p1, p2)},
+		copyTestCase{ds: bd.Parameters(p1).Parameters(p2), clauses: ce.ParametersAppend(p1, p2)},
+		copyTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cds *copyDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CopyFrom("items").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCopySQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	cds.NoError(err)
+	cds.Empty(sql)
+	cds.Empty(args)
+	md.AssertExpectations(cds.T())
+}
+
+func (cds *copyDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CopyFrom("items").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCopySQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(ee, err)
+	md.AssertExpectations(cds.T())
+}
+
+func (cds *copyDatasetSuite) TestMustToSQL() {
+	ds := goqu.CopyFrom("items").Columns("a", "b").Options(exp.CopyOptions{Format: exp.CopyFormatCSV})
+	sql, args := ds.MustToSQL()
+	cds.Empty(args)
+	cds.Equal(`COPY "items" ("a", "b") FROM STDIN WITH (FORMAT csv)`, sql)
+
+	cds.Panics(func() {
+		goqu.CopyFrom("items").SetError(errors.New("expected error")).MustToSQL()
+	})
+}
+
+func (cds *copyDatasetSuite) TestToSQL_Query() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// COPY statements are always interpolated
+	sql, args, err := goqu.CopyTo(goqu.From("items").Where(goqu.C("id").Gt(10))).ToSQL()
+	cds.NoError(err)
+	cds.Empty(args)
+	cds.Equal(`COPY (SELECT * FROM "items" WHERE ("id" > 10)) TO STDOUT`, sql)
+}
+
+func (cds *copyDatasetSuite) TestLoad() {
+	tc := new(testCopier)
+	ds := goqu.CopyFrom("items").Options(exp.CopyOptions{Format: exp.CopyFormatCSV})
+	n, err := ds.Load(context.Background(), tc, strings.NewReader("1,a\n2,b\n"))
+	cds.NoError(err)
+	cds.Equal(int64(2), n)
+	cds.Equal(`COPY "items" FROM STDIN WITH (FORMAT csv)`, tc.sql)
+	cds.Equal("1,a\n2,b\n", tc.data)
+
+	_, err = goqu.CopyTo("items").Load(context.Background(), tc, strings.NewReader(""))
+	cds.EqualError(err, "goqu: data can only be loaded by a COPY FROM statement, use CopyFrom")
+
+	ee := errors.New("expected error")
+	_, err = ds.SetError(ee).Load(context.Background(), tc, strings.NewReader(""))
+	cds.Equal(ee, err)
+}
+
+func (cds *copyDatasetSuite) TestLoadRows() {
+	tc := new(testCopier)
+	ds := goqu.CopyFrom("items").Columns("id", "name")
+	n, err := ds.LoadRows(context.Background(), tc, [][]interface{}{{1, "a"}, {2, nil}})
+	cds.NoError(err)
+	cds.Equal(int64(2), n)
+	cds.Equal(`COPY "items" ("id", "name") FROM STDIN`, tc.sql)
+	cds.Equal("1\ta\n2\t\\N\n", tc.data)
+
+	n, err = ds.Options(exp.CopyOptions{Format: exp.CopyFormatText}).
+		LoadRows(context.Background(), tc, [][]interface{}{{1, "a"}})
+	cds.NoError(err)
+	cds.Equal(int64(1), n)
+	cds.Equal(`COPY "items" ("id", "name") FROM STDIN WITH (FORMAT text)`, tc.sql)
+
+	expectedErr := "goqu: rows can only be copied using the text format without a custom DELIMITER or NULL"
+	_, err = ds.Options(exp.CopyOptions{Format: exp.CopyFormatCSV}).LoadRows(context.Background(), tc, nil)
+	cds.EqualError(err, expectedErr)
+	_, err = ds.Options(exp.CopyOptions{Delimiter: ","}).LoadRows(context.Background(), tc, nil)
+	cds.EqualError(err, expectedErr)
+	_, err = ds.Options(exp.CopyOptions{Null: ""}).LoadRows(context.Background(), tc, nil)
+	cds.NoError(err)
+
+	// the encoder must not block when the copier fails before reading the rows
+	ee := errors.New("expected error")
+	_, err = ds.LoadRows(context.Background(), &testCopier{err: ee}, [][]interface{}{{1, "a"}})
+	cds.Equal(ee, err)
+}
+
+func (cds *copyDatasetSuite) TestUnload() {
+	tc := &testCopier{data: "1,a\n"}
+	var buf bytes.Buffer
+	n, err := goqu.CopyTo("items").
+		Options(exp.CopyOptions{Format: exp.CopyFormatCSV}).
+		Unload(context.Background(), tc, &buf)
+	cds.NoError(err)
+	cds.Equal(int64(4), n)
+	cds.Equal(`COPY "items" TO STDOUT WITH (FORMAT csv)`, tc.sql)
+	cds.Equal("1,a\n", buf.String())
+
+	_, err = goqu.CopyFrom("items").Unload(context.Background(), tc, &buf)
+	cds.EqualError(err, "goqu: data can only be exported by a COPY TO statement, use CopyTo")
+
+	_, err = goqu.Dialect("mysql").CopyTo("items").Unload(context.Background(), tc, &buf)
+	cds.EqualError(err, "goqu: dialect does not support COPY [dialect=mysql]")
+}
+
+func (cds *copyDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.CopyFrom("items").SetDialect(md)
+	ds = ds.SetError(err1)
+	cds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	cds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.Columns("a")
+	cds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCopySQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	cds.Empty(sql)
+	cds.Empty(args)
+	cds.Equal(err1, err)
+}
+
+func TestCopyDataset(t *testing.T) {
+	suite.Run(t, new(copyDatasetSuite))
+}
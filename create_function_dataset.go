@@ -0,0 +1,189 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// CreateFunctionDataset for creating and/or executing CREATE FUNCTION SQL statements.
+type CreateFunctionDataset struct {
+	dialect      SQLDialect
+	clauses      exp.CreateFunctionClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedFunctionType = errors.New(
+	"unsupported function type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newCreateFunctionDataset(d string, queryFactory exec.QueryFactory) *CreateFunctionDataset {
+	return &CreateFunctionDataset{
+		clauses:      exp.NewCreateFunctionClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// CreateFunction creates a CreateFunctionDataset for a function.
+//
+//	goqu.CreateFunction("user_audit_fn").Returns(goqu.CustomType("TRIGGER")).Language("plpgsql").
+//		Body("BEGIN INSERT INTO audit (user_id) VALUES (NEW.id); RETURN NEW; END;")
+func CreateFunction(function interface{}) *CreateFunctionDataset {
+	return newCreateFunctionDataset("default", nil).Function(function)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (cfd *CreateFunctionDataset) WithDialect(dl string) *CreateFunctionDataset {
+	ds := cfd.copy(cfd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (cfd *CreateFunctionDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the CreateFunctionDataset.
+func (cfd *CreateFunctionDataset) Dialect() SQLDialect {
+	return cfd.dialect
+}
+
+// SetDialect returns the current adapter on the CreateFunctionDataset.
+func (cfd *CreateFunctionDataset) SetDialect(dialect SQLDialect) *CreateFunctionDataset {
+	cd := cfd.copy(cfd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns CreateFunctionDataset as exp.Expression.
+func (cfd *CreateFunctionDataset) Expression() exp.Expression {
+	return cfd
+}
+
+// Clone clones the CreateFunctionDataset.
+func (cfd *CreateFunctionDataset) Clone() exp.Expression {
+	return cfd.copy(cfd.clauses)
+}
+
+// GetClauses returns the current clauses on the CreateFunctionDataset.
+func (cfd *CreateFunctionDataset) GetClauses() exp.CreateFunctionClauses {
+	return cfd.clauses
+}
+
+// used internally to copy the dataset.
+func (cfd *CreateFunctionDataset) copy(clauses exp.CreateFunctionClauses) *CreateFunctionDataset {
+	return &CreateFunctionDataset{
+		dialect:      cfd.dialect,
+		clauses:      clauses,
+		queryFactory: cfd.queryFactory,
+		err:          cfd.err,
+	}
+}
+
+// Function sets the function to create. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (cfd *CreateFunctionDataset) Function(function interface{}) *CreateFunctionDataset {
+	switch t := function.(type) {
+	case exp.Expression:
+		return cfd.copy(cfd.clauses.SetFunction(t))
+	case string:
+		return cfd.copy(cfd.clauses.SetFunction(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedFunctionType)
+	}
+}
+
+// OrReplace replaces the function if it exists (e.g. CREATE OR REPLACE FUNCTION, sqlserver CREATE OR ALTER FUNCTION).
+func (cfd *CreateFunctionDataset) OrReplace() *CreateFunctionDataset {
+	return cfd.copy(cfd.clauses.SetOrReplace(true))
+}
+
+// Param appends a parameter to the function, the name is prefixed with @ by sqlserver.
+//
+//	goqu.CreateFunction("add").Param("a", goqu.IntegerType()).Param("b", goqu.IntegerType())
+//	// CREATE FUNCTION "add"("a" INTEGER, "b" INTEGER)
+func (cfd *CreateFunctionDataset) Param(name string, dataType exp.DataType) *CreateFunctionDataset {
+	params := cfd.clauses.Params()
+	newParams := make([]exp.FunctionParam, 0, len(params)+1)
+	newParams = append(newParams, params...)
+	newParams = append(newParams, exp.FunctionParam{Name: name, DataType: dataType})
+	return cfd.copy(cfd.clauses.SetParams(newParams))
+}
+
+// Returns sets the return type of the function, use CustomType for types that are not data types of columns
+// (e.g. goqu.CustomType("TRIGGER")).
+func (cfd *CreateFunctionDataset) Returns(dataType exp.DataType) *CreateFunctionDataset {
+	return cfd.copy(cfd.clauses.SetReturns(dataType))
+}
+
+// Language sets the language of the body of the function (e.g. plpgsql, sql), the language is written as is.
+func (cfd *CreateFunctionDataset) Language(language string) *CreateFunctionDataset {
+	return cfd.copy(cfd.clauses.SetLanguage(language))
+}
+
+// Body sets the body of the function, the body is written as is. The body is quoted with $$ by postgres, written
+// after AS by sqlserver and written as is by mysql.
+func (cfd *CreateFunctionDataset) Body(body string) *CreateFunctionDataset {
+	return cfd.copy(cfd.clauses.SetBody(body))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (cfd *CreateFunctionDataset) Error() error {
+	return cfd.err
+}
+
+// SetError sets an error on the CreateFunctionDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (cfd *CreateFunctionDataset) SetError(err error) *CreateFunctionDataset {
+	if cfd.err == nil {
+		cfd.err = err
+	}
+
+	return cfd
+}
+
+// ToSQL generates a CREATE FUNCTION sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no function or body
+//   - The dialect does not support functions, OR REPLACE or LANGUAGE
+//   - The body contains the fragment used to end the body (e.g. $$)
+//   - There is an error generating the SQL
+func (cfd *CreateFunctionDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return cfd.createFunctionSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (cfd *CreateFunctionDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = cfd.createFunctionSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the CREATE FUNCTION sql, and returns an Exec struct with the sql set to the CREATE FUNCTION
+// statement.
+//
+// db.CreateFunction("add").Param("a", goqu.IntegerType()).Returns(goqu.IntegerType()).Body("...").Executor().Exec()
+func (cfd *CreateFunctionDataset) Executor() exec.QueryExecutor {
+	return cfd.queryFactory.FromSQLBuilder(cfd.createFunctionSQLBuilder())
+}
+
+func (cfd *CreateFunctionDataset) createFunctionSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if cfd.err != nil {
+		return buf.SetError(cfd.err)
+	}
+	cfd.dialect.ToCreateFunctionSQL(buf, cfd.clauses)
+	return buf
+}
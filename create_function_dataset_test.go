@@ -0,0 +1,240 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createFunctionTestCase struct {
+		ds      *goqu.CreateFunctionDataset
+		clauses exp.CreateFunctionClauses
+	}
+	createFunctionDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (cfds *createFunctionDatasetSuite) assertCases(cases ...createFunctionTestCase) {
+	for _, s := range cases {
+		cfds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (cfds *createFunctionDatasetSuite) TestClone() {
+	ds := goqu.CreateFunction("test_function")
+	cfds.Equal(ds, ds.Clone())
+}
+
+func (cfds *createFunctionDatasetSuite) TestExpression() {
+	ds := goqu.CreateFunction("test_function")
+	cfds.Equal(ds, ds.Expression())
+}
+
+func (cfds *createFunctionDatasetSuite) TestDialect() {
+	ds := goqu.CreateFunction("test_function")
+	cfds.NotNil(ds.Dialect())
+}
+
+func (cfds *createFunctionDatasetSuite) TestWithDialect() {
+	ds := goqu.CreateFunction("test_function")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	cfds.Equal(md, ds.Dialect())
+	cfds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (cfds *createFunctionDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.CreateFunction("test_function")
+	cfds.False(ds.IsPrepared())
+}
+
+func (cfds *createFunctionDatasetSuite) TestGetClauses() {
+	ds := goqu.CreateFunction("test_function")
+	ce := exp.NewCreateFunctionClauses().SetFunction(goqu.I("test_function"))
+	cfds.Equal(ce, ds.GetClauses())
+}
+
+func (cfds *createFunctionDatasetSuite) TestFunction() {
+	bd := goqu.CreateFunction("test")
+	cfds.assertCases(
+		createFunctionTestCase{ds: bd.Function("test2"), clauses: exp.NewCreateFunctionClauses().SetFunction(goqu.I("test2"))},
+		createFunctionTestCase{
+			ds:      bd.Function(goqu.I("test2")),
+			clauses: exp.NewCreateFunctionClauses().SetFunction(goqu.I("test2")),
+		},
+		createFunctionTestCase{ds: bd, clauses: exp.NewCreateFunctionClauses().SetFunction(goqu.I("test"))},
+	)
+	cfds.PanicsWithValue(goqu.ErrUnsupportedFunctionType, func() {
+		goqu.CreateFunction(true)
+	})
+}
+
+func (cfds *createFunctionDatasetSuite) TestOrReplace() {
+	bd := goqu.CreateFunction("test")
+	ce := bd.GetClauses()
+	cfds.assertCases(
+		createFunctionTestCase{ds: bd.OrReplace(), clauses: ce.SetOrReplace(true)},
+		createFunctionTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cfds *createFunctionDatasetSuite) TestParam() {
+	bd := goqu.CreateFunction("test")
+	ce := bd.GetClauses()
+	cfds.assertCases(
+		createFunctionTestCase{
+			ds:      bd.Param("a", goqu.IntegerType()),
+			clauses: ce.SetParams([]exp.FunctionParam{{Name: "a", DataType: goqu.IntegerType()}}),
+		},
+		createFunctionTestCase{
+			ds: bd.Param("a", goqu.IntegerType()).Param("b", goqu.TextType()),
+			clauses: ce.SetParams([]exp.FunctionParam{
+				{Name: "a", DataType: goqu.IntegerType()},
+				{Name: "b", DataType: goqu.TextType()},
+			}),
+		},
+		createFunctionTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cfds *createFunctionDatasetSuite) TestReturns() {
+	bd := goqu.CreateFunction("test")
+	ce := bd.GetClauses()
+	cfds.assertCases(
+		createFunctionTestCase{ds: bd.Returns(goqu.CustomType("TRIGGER")), clauses: ce.SetReturns(goqu.CustomType("TRIGGER"))},
+		createFunctionTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cfds *createFunctionDatasetSuite) TestLanguage() {
+	bd := goqu.CreateFunction("test")
+	ce := bd.GetClauses()
+	cfds.assertCases(
+		createFunctionTestCase{ds: bd.Language("plpgsql"), clauses: ce.SetLanguage("plpgsql")},
+		createFunctionTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cfds *createFunctionDatasetSuite) TestBody() {
+	bd := goqu.CreateFunction("test")
+	ce := bd.GetClauses()
+	cfds.assertCases(
+		createFunctionTestCase{ds: bd.Body("SELECT 1"), clauses: ce.SetBody("SELECT 1")},
+		createFunctionTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cfds *createFunctionDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateFunction("test_function").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateFunctionSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	cfds.NoError(err)
+	cfds.Empty(sql)
+	cfds.Empty(args)
+	md.AssertExpectations(cfds.T())
+}
+
+func (cfds *createFunctionDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateFunction("test_function").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateFunctionSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	cfds.Empty(sql)
+	cfds.Empty(args)
+	cfds.Equal(ee, err)
+	md.AssertExpectations(cfds.T())
+}
+
+func (cfds *createFunctionDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	cfds.NoError(err)
+
+	ds := goqu.New("mock", mDB).CreateFunction("one").Returns(goqu.IntegerType()).Language("sql").Body("SELECT 1")
+
+	asql, args, err := ds.Executor().ToSQL()
+	cfds.NoError(err)
+	cfds.Empty(args)
+	cfds.Equal(`CREATE FUNCTION "one"() RETURNS INTEGER LANGUAGE sql AS $$SELECT 1$$`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	cfds.NoError(err)
+	cfds.Empty(args)
+	cfds.Equal(`CREATE FUNCTION "one"() RETURNS INTEGER LANGUAGE sql AS $$SELECT 1$$`, asql)
+}
+
+func (cfds *createFunctionDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateFunction("test_function").SetDialect(md)
+	ds = ds.SetError(err1)
+	cfds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	cfds.Empty(sql)
+	cfds.Empty(args)
+	cfds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	cfds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cfds.Empty(sql)
+	cfds.Empty(args)
+	cfds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.OrReplace()
+	cfds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cfds.Empty(sql)
+	cfds.Empty(args)
+	cfds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateFunctionSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	cfds.Empty(sql)
+	cfds.Empty(args)
+	cfds.Equal(err1, err)
+}
+
+func TestCreateFunctionDataset(t *testing.T) {
+	suite.Run(t, new(createFunctionDatasetSuite))
+}
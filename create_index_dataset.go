@@ -0,0 +1,196 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// CreateIndexDataset for creating and/or executing CREATE INDEX SQL statements.
+type CreateIndexDataset struct {
+	dialect      SQLDialect
+	clauses      exp.CreateIndexClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedCreateIndexTableType = errors.New(
+	"unsupported table type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newCreateIndexDataset(d string, queryFactory exec.QueryFactory) *CreateIndexDataset {
+	return &CreateIndexDataset{
+		clauses:      exp.NewCreateIndexClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// CreateIndex creates a CreateIndexDataset for an index, use On to set the table of the index.
+//
+//	goqu.CreateIndex("user_email_idx").On("user").Columns("email")
+func CreateIndex(name string) *CreateIndexDataset {
+	return newCreateIndexDataset("default", nil).Name(name)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (cid *CreateIndexDataset) WithDialect(dl string) *CreateIndexDataset {
+	ds := cid.copy(cid.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (cid *CreateIndexDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the CreateIndexDataset.
+func (cid *CreateIndexDataset) Dialect() SQLDialect {
+	return cid.dialect
+}
+
+// SetDialect returns the current adapter on the CreateIndexDataset.
+func (cid *CreateIndexDataset) SetDialect(dialect SQLDialect) *CreateIndexDataset {
+	cd := cid.copy(cid.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns CreateIndexDataset as exp.Expression.
+func (cid *CreateIndexDataset) Expression() exp.Expression {
+	return cid
+}
+
+// Clone clones the CreateIndexDataset.
+func (cid *CreateIndexDataset) Clone() exp.Expression {
+	return cid.copy(cid.clauses)
+}
+
+// GetClauses returns the current clauses on the CreateIndexDataset.
+func (cid *CreateIndexDataset) GetClauses() exp.CreateIndexClauses {
+	return cid.clauses
+}
+
+// used internally to copy the dataset.
+func (cid *CreateIndexDataset) copy(clauses exp.CreateIndexClauses) *CreateIndexDataset {
+	return &CreateIndexDataset{
+		dialect:      cid.dialect,
+		clauses:      clauses,
+		queryFactory: cid.queryFactory,
+		err:          cid.err,
+	}
+}
+
+// Name sets the name of the index, an empty name is only supported by postgres.
+func (cid *CreateIndexDataset) Name(name string) *CreateIndexDataset {
+	return cid.copy(cid.clauses.SetName(name))
+}
+
+// On sets the table of the index. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (cid *CreateIndexDataset) On(table interface{}) *CreateIndexDataset {
+	switch t := table.(type) {
+	case exp.Expression:
+		return cid.copy(cid.clauses.SetTable(t))
+	case string:
+		return cid.copy(cid.clauses.SetTable(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedCreateIndexTableType)
+	}
+}
+
+// Columns appends the columns of the index. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// OrderedExpression: (e.g. goqu.C("a").Desc()) Will create a sorted column
+// Any other Expression: (e.g. goqu.Func("lower", goqu.C("email"))) Will create an expression index, the
+// expression is wrapped in parens
+func (cid *CreateIndexDataset) Columns(columns ...interface{}) *CreateIndexDataset {
+	return cid.copy(cid.clauses.ColumnsAppend(exp.NewColumnListExpression(columns...)))
+}
+
+// Unique creates a UNIQUE index.
+func (cid *CreateIndexDataset) Unique() *CreateIndexDataset {
+	return cid.copy(cid.clauses.SetUnique(true))
+}
+
+// Concurrently builds the index without locking out writes on the table (e.g. postgres CREATE INDEX CONCURRENTLY).
+func (cid *CreateIndexDataset) Concurrently() *CreateIndexDataset {
+	return cid.copy(cid.clauses.SetConcurrently(true))
+}
+
+// IfNotExists adds IF NOT EXISTS to the CREATE INDEX statement.
+func (cid *CreateIndexDataset) IfNotExists() *CreateIndexDataset {
+	return cid.copy(cid.clauses.SetIfNotExists(true))
+}
+
+// Using sets the method of the index (e.g. btree, hash, gin, gist).
+//
+//	goqu.CreateIndex("doc_tags_idx").On("doc").Using("gin").Columns("tags")
+func (cid *CreateIndexDataset) Using(method string) *CreateIndexDataset {
+	return cid.copy(cid.clauses.SetMethod(method))
+}
+
+// Where adds a WHERE clause to create a partial index. Multiple calls are ANDed together.
+//
+//	goqu.CreateIndex("user_active_idx").On("user").Columns("email").Where(goqu.C("deleted_at").IsNull())
+func (cid *CreateIndexDataset) Where(expressions ...exp.Expression) *CreateIndexDataset {
+	return cid.copy(cid.clauses.WhereAppend(expressions...))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (cid *CreateIndexDataset) Error() error {
+	return cid.err
+}
+
+// SetError sets an error on the CreateIndexDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (cid *CreateIndexDataset) SetError(err error) *CreateIndexDataset {
+	if cid.err == nil {
+		cid.err = err
+	}
+
+	return cid
+}
+
+// ToSQL generates a CREATE INDEX sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no table or there are no columns
+//   - The dialect does not support a feature of the index (e.g. CONCURRENTLY)
+//   - There is an error generating the SQL
+func (cid *CreateIndexDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return cid.createIndexSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (cid *CreateIndexDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = cid.createIndexSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the CREATE INDEX sql, and returns an Exec struct with the sql set to the CREATE INDEX statement.
+//
+// db.CreateIndex("test_a_idx").On("test").Columns("a").Executor().Exec()
+func (cid *CreateIndexDataset) Executor() exec.QueryExecutor {
+	return cid.queryFactory.FromSQLBuilder(cid.createIndexSQLBuilder())
+}
+
+func (cid *CreateIndexDataset) createIndexSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if cid.err != nil {
+		return buf.SetError(cid.err)
+	}
+	cid.dialect.ToCreateIndexSQL(buf, cid.clauses)
+	return buf
+}
@@ -0,0 +1,229 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createIndexTestCase struct {
+		ds      *goqu.CreateIndexDataset
+		clauses exp.CreateIndexClauses
+	}
+	createIndexDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (cids *createIndexDatasetSuite) assertCases(cases ...createIndexTestCase) {
+	for _, s := range cases {
+		cids.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (cids *createIndexDatasetSuite) TestClone() {
+	ds := goqu.CreateIndex("test_idx")
+	cids.Equal(ds, ds.Clone())
+}
+
+func (cids *createIndexDatasetSuite) TestExpression() {
+	ds := goqu.CreateIndex("test_idx")
+	cids.Equal(ds, ds.Expression())
+}
+
+func (cids *createIndexDatasetSuite) TestDialect() {
+	ds := goqu.CreateIndex("test_idx")
+	cids.NotNil(ds.Dialect())
+}
+
+func (cids *createIndexDatasetSuite) TestWithDialect() {
+	ds := goqu.CreateIndex("test_idx")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	cids.Equal(md, ds.Dialect())
+	cids.Equal(dialect, dialectDs.Dialect())
+}
+
+func (cids *createIndexDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.CreateIndex("test_idx")
+	cids.False(ds.IsPrepared())
+}
+
+func (cids *createIndexDatasetSuite) TestGetClauses() {
+	ds := goqu.CreateIndex("test_idx")
+	ce := exp.NewCreateIndexClauses().SetName("test_idx")
+	cids.Equal(ce, ds.GetClauses())
+}
+
+func (cids *createIndexDatasetSuite) TestName() {
+	bd := goqu.CreateIndex("test_idx")
+	cids.assertCases(
+		createIndexTestCase{ds: bd.Name("test2_idx"), clauses: exp.NewCreateIndexClauses().SetName("test2_idx")},
+		createIndexTestCase{ds: bd, clauses: exp.NewCreateIndexClauses().SetName("test_idx")},
+	)
+}
+
+func (cids *createIndexDatasetSuite) TestOn() {
+	bd := goqu.CreateIndex("test_idx")
+	ce := exp.NewCreateIndexClauses().SetName("test_idx")
+	cids.assertCases(
+		createIndexTestCase{ds: bd.On("test"), clauses: ce.SetTable(goqu.I("test"))},
+		createIndexTestCase{ds: bd.On(goqu.S("s").Table("test")), clauses: ce.SetTable(goqu.S("s").Table("test"))},
+		createIndexTestCase{ds: bd, clauses: ce},
+	)
+	cids.PanicsWithValue(goqu.ErrUnsupportedCreateIndexTableType, func() {
+		goqu.CreateIndex("test_idx").On(true)
+	})
+}
+
+func (cids *createIndexDatasetSuite) TestColumns() {
+	lower := goqu.Func("lower", goqu.C("b"))
+	bd := goqu.CreateIndex("test_idx")
+	ce := exp.NewCreateIndexClauses().SetName("test_idx")
+	cids.assertCases(
+		createIndexTestCase{ds: bd.Columns("a"), clauses: ce.ColumnsAppend(exp.NewColumnListExpression("a"))},
+		createIndexTestCase{
+			ds:      bd.Columns("a", lower).Columns(goqu.C("c").Desc()),
+			clauses: ce.ColumnsAppend(exp.NewColumnListExpression("a", lower, goqu.C("c").Desc())),
+		},
+		createIndexTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cids *createIndexDatasetSuite) TestOptions() {
+	bd := goqu.CreateIndex("test_idx")
+	ce := exp.NewCreateIndexClauses().SetName("test_idx")
+	cids.assertCases(
+		createIndexTestCase{ds: bd.Unique(), clauses: ce.SetUnique(true)},
+		createIndexTestCase{ds: bd.Concurrently(), clauses: ce.SetConcurrently(true)},
+		createIndexTestCase{ds: bd.IfNotExists(), clauses: ce.SetIfNotExists(true)},
+		createIndexTestCase{ds: bd.Using("gin"), clauses: ce.SetMethod("gin")},
+		createIndexTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cids *createIndexDatasetSuite) TestWhere() {
+	w := goqu.C("a").IsNull()
+	w2 := goqu.C("b").Eq(1)
+	bd := goqu.CreateIndex("test_idx")
+	ce := exp.NewCreateIndexClauses().SetName("test_idx")
+	cids.assertCases(
+		createIndexTestCase{ds: bd.Where(w), clauses: ce.WhereAppend(w)},
+		createIndexTestCase{ds: bd.Where(w).Where(w2), clauses: ce.WhereAppend(w, w2)},
+		createIndexTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cids *createIndexDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateIndex("test_idx").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateIndexSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	cids.NoError(err)
+	cids.Empty(sql)
+	cids.Empty(args)
+	md.AssertExpectations(cids.T())
+}
+
+func (cids *createIndexDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateIndex("test_idx").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateIndexSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	cids.Empty(sql)
+	cids.Empty(args)
+	cids.Equal(ee, err)
+	md.AssertExpectations(cids.T())
+}
+
+func (cids *createIndexDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	cids.NoError(err)
+
+	ds := goqu.New("mock", mDB).CreateIndex("test_idx").On("test").Columns("a").Where(goqu.C("b").Eq("c"))
+
+	asql, args, err := ds.Executor().ToSQL()
+	cids.NoError(err)
+	cids.Empty(args)
+	cids.Equal(`CREATE INDEX "test_idx" ON "test" ("a") WHERE ("b" = 'c')`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	cids.NoError(err)
+	cids.Empty(args)
+	cids.Equal(`CREATE INDEX "test_idx" ON "test" ("a") WHERE ("b" = 'c')`, asql)
+}
+
+func (cids *createIndexDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateIndex("test_idx").SetDialect(md)
+	ds = ds.SetError(err1)
+	cids.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	cids.Empty(sql)
+	cids.Empty(args)
+	cids.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	cids.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cids.Empty(sql)
+	cids.Empty(args)
+	cids.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.Columns("a")
+	cids.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cids.Empty(sql)
+	cids.Empty(args)
+	cids.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateIndexSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	cids.Empty(sql)
+	cids.Empty(args)
+	cids.Equal(err1, err)
+}
+
+func TestCreateIndexDataset(t *testing.T) {
+	suite.Run(t, new(createIndexDatasetSuite))
+}
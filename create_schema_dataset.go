@@ -0,0 +1,192 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// CreateSchemaDataset for creating and/or executing CREATE SCHEMA and CREATE DATABASE SQL statements.
+type CreateSchemaDataset struct {
+	dialect      SQLDialect
+	clauses      exp.CreateSchemaClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var (
+	ErrUnsupportedSchemaType = errors.New(
+		"unsupported schema type, a string or identifier expression is required",
+	)
+	ErrUnsupportedAuthorizationType = errors.New(
+		"unsupported authorization type, a string or identifier expression is required",
+	)
+)
+
+// used internally by database to create a database with a specific adapter.
+func newCreateSchemaDataset(d string, queryFactory exec.QueryFactory) *CreateSchemaDataset {
+	return &CreateSchemaDataset{
+		clauses:      exp.NewCreateSchemaClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// CreateSchema creates a CreateSchemaDataset to create a schema.
+//
+//	goqu.CreateSchema("tenant_1").IfNotExists().Authorization("tenant_1_owner")
+func CreateSchema(schema interface{}) *CreateSchemaDataset {
+	return newCreateSchemaDataset("default", nil).Name(schema)
+}
+
+// CreateDatabase creates a CreateSchemaDataset to create a database.
+//
+//	goqu.CreateDatabase("tenant_1").Authorization("tenant_1_owner")
+func CreateDatabase(database interface{}) *CreateSchemaDataset {
+	return CreateSchema(database).Database()
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (csd *CreateSchemaDataset) WithDialect(dl string) *CreateSchemaDataset {
+	ds := csd.copy(csd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (csd *CreateSchemaDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the CreateSchemaDataset.
+func (csd *CreateSchemaDataset) Dialect() SQLDialect {
+	return csd.dialect
+}
+
+// SetDialect returns the current adapter on the CreateSchemaDataset.
+func (csd *CreateSchemaDataset) SetDialect(dialect SQLDialect) *CreateSchemaDataset {
+	cd := csd.copy(csd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns CreateSchemaDataset as exp.Expression.
+func (csd *CreateSchemaDataset) Expression() exp.Expression {
+	return csd
+}
+
+// Clone clones the CreateSchemaDataset.
+func (csd *CreateSchemaDataset) Clone() exp.Expression {
+	return csd.copy(csd.clauses)
+}
+
+// GetClauses returns the current clauses on the CreateSchemaDataset.
+func (csd *CreateSchemaDataset) GetClauses() exp.CreateSchemaClauses {
+	return csd.clauses
+}
+
+// used internally to copy the dataset.
+func (csd *CreateSchemaDataset) copy(clauses exp.CreateSchemaClauses) *CreateSchemaDataset {
+	return &CreateSchemaDataset{
+		dialect:      csd.dialect,
+		clauses:      clauses,
+		queryFactory: csd.queryFactory,
+		err:          csd.err,
+	}
+}
+
+// Name sets the name of the schema or database to create. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (csd *CreateSchemaDataset) Name(name interface{}) *CreateSchemaDataset {
+	switch t := name.(type) {
+	case exp.Expression:
+		return csd.copy(csd.clauses.SetName(t))
+	case string:
+		return csd.copy(csd.clauses.SetName(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedSchemaType)
+	}
+}
+
+// Database creates a database instead of a schema (e.g. CREATE DATABASE "tenant_1").
+func (csd *CreateSchemaDataset) Database() *CreateSchemaDataset {
+	return csd.copy(csd.clauses.SetDatabase(true))
+}
+
+// IfNotExists only creates the schema or database if it does not already exist.
+func (csd *CreateSchemaDataset) IfNotExists() *CreateSchemaDataset {
+	return csd.copy(csd.clauses.SetIfNotExists(true))
+}
+
+// Authorization sets the role that owns the schema (e.g. AUTHORIZATION "admin"), when creating a database the OWNER
+// of the database is set instead. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (csd *CreateSchemaDataset) Authorization(role interface{}) *CreateSchemaDataset {
+	switch t := role.(type) {
+	case exp.Expression:
+		return csd.copy(csd.clauses.SetAuthorization(t))
+	case string:
+		return csd.copy(csd.clauses.SetAuthorization(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedAuthorizationType)
+	}
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (csd *CreateSchemaDataset) Error() error {
+	return csd.err
+}
+
+// SetError sets an error on the CreateSchemaDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (csd *CreateSchemaDataset) SetError(err error) *CreateSchemaDataset {
+	if csd.err == nil {
+		csd.err = err
+	}
+
+	return csd
+}
+
+// ToSQL generates a CREATE SCHEMA or CREATE DATABASE sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no name
+//   - The dialect does not support an option (e.g. IF NOT EXISTS)
+//   - There is an error generating the SQL
+func (csd *CreateSchemaDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return csd.createSchemaSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (csd *CreateSchemaDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = csd.createSchemaSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the CREATE SCHEMA or CREATE DATABASE sql, and returns an Exec struct with the sql set to the
+// statement.
+//
+// db.CreateSchema("tenant_1").IfNotExists().Executor().Exec()
+func (csd *CreateSchemaDataset) Executor() exec.QueryExecutor {
+	return csd.queryFactory.FromSQLBuilder(csd.createSchemaSQLBuilder())
+}
+
+func (csd *CreateSchemaDataset) createSchemaSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if csd.err != nil {
+		return buf.SetError(csd.err)
+	}
+	csd.dialect.ToCreateSchemaSQL(buf, csd.clauses)
+	return buf
+}
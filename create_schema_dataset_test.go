@@ -0,0 +1,214 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createSchemaTestCase struct {
+		ds      *goqu.CreateSchemaDataset
+		clauses exp.CreateSchemaClauses
+	}
+	createSchemaDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (csds *createSchemaDatasetSuite) assertCases(cases ...createSchemaTestCase) {
+	for _, s := range cases {
+		csds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (csds *createSchemaDatasetSuite) TestClone() {
+	ds := goqu.CreateSchema("test_schema")
+	csds.Equal(ds, ds.Clone())
+}
+
+func (csds *createSchemaDatasetSuite) TestExpression() {
+	ds := goqu.CreateSchema("test_schema")
+	csds.Equal(ds, ds.Expression())
+}
+
+func (csds *createSchemaDatasetSuite) TestDialect() {
+	ds := goqu.CreateSchema("test_schema")
+	csds.NotNil(ds.Dialect())
+}
+
+func (csds *createSchemaDatasetSuite) TestWithDialect() {
+	ds := goqu.CreateSchema("test_schema")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	csds.Equal(md, ds.Dialect())
+	csds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (csds *createSchemaDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.CreateSchema("test_schema")
+	csds.False(ds.IsPrepared())
+}
+
+func (csds *createSchemaDatasetSuite) TestGetClauses() {
+	ds := goqu.CreateSchema("test_schema")
+	ce := exp.NewCreateSchemaClauses().SetName(goqu.I("test_schema"))
+	csds.Equal(ce, ds.GetClauses())
+}
+
+func (csds *createSchemaDatasetSuite) TestName() {
+	bd := goqu.CreateSchema("test")
+	csds.assertCases(
+		createSchemaTestCase{ds: bd.Name("test2"), clauses: exp.NewCreateSchemaClauses().SetName(goqu.I("test2"))},
+		createSchemaTestCase{ds: bd.Name(goqu.I("test2")), clauses: exp.NewCreateSchemaClauses().SetName(goqu.I("test2"))},
+		createSchemaTestCase{ds: bd, clauses: exp.NewCreateSchemaClauses().SetName(goqu.I("test"))},
+	)
+	csds.PanicsWithValue(goqu.ErrUnsupportedSchemaType, func() {
+		goqu.CreateSchema(true)
+	})
+}
+
+func (csds *createSchemaDatasetSuite) TestDatabase() {
+	bd := goqu.CreateSchema("test")
+	ce := bd.GetClauses()
+	csds.assertCases(
+		createSchemaTestCase{ds: bd.Database(), clauses: ce.SetDatabase(true)},
+		createSchemaTestCase{ds: goqu.CreateDatabase("test"), clauses: ce.SetDatabase(true)},
+		createSchemaTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (csds *createSchemaDatasetSuite) TestIfNotExists() {
+	bd := goqu.CreateSchema("test")
+	ce := bd.GetClauses()
+	csds.assertCases(
+		createSchemaTestCase{ds: bd.IfNotExists(), clauses: ce.SetIfNotExists(true)},
+		createSchemaTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (csds *createSchemaDatasetSuite) TestAuthorization() {
+	bd := goqu.CreateSchema("test")
+	ce := bd.GetClauses()
+	csds.assertCases(
+		createSchemaTestCase{ds: bd.Authorization("admin"), clauses: ce.SetAuthorization(goqu.I("admin"))},
+		createSchemaTestCase{ds: bd.Authorization(goqu.L("CURRENT_USER")), clauses: ce.SetAuthorization(goqu.L("CURRENT_USER"))},
+		createSchemaTestCase{ds: bd, clauses: ce},
+	)
+	csds.PanicsWithValue(goqu.ErrUnsupportedAuthorizationType, func() {
+		bd.Authorization(1)
+	})
+}
+
+func (csds *createSchemaDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateSchema("test_schema").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateSchemaSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	csds.NoError(err)
+	csds.Empty(sql)
+	csds.Empty(args)
+	md.AssertExpectations(csds.T())
+}
+
+func (csds *createSchemaDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateSchema("test_schema").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateSchemaSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	csds.Empty(sql)
+	csds.Empty(args)
+	csds.Equal(ee, err)
+	md.AssertExpectations(csds.T())
+}
+
+func (csds *createSchemaDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	csds.NoError(err)
+
+	ds := goqu.New("mock", mDB).CreateSchema("test_schema").IfNotExists().Authorization("admin")
+
+	asql, args, err := ds.Executor().ToSQL()
+	csds.NoError(err)
+	csds.Empty(args)
+	csds.Equal(`CREATE SCHEMA IF NOT EXISTS "test_schema" AUTHORIZATION "admin"`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	csds.NoError(err)
+	csds.Empty(args)
+	csds.Equal(`CREATE SCHEMA IF NOT EXISTS "test_schema" AUTHORIZATION "admin"`, asql)
+}
+
+func (csds *createSchemaDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateSchema("test_schema").SetDialect(md)
+	ds = ds.SetError(err1)
+	csds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	csds.Empty(sql)
+	csds.Empty(args)
+	csds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	csds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	csds.Empty(sql)
+	csds.Empty(args)
+	csds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.IfNotExists()
+	csds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	csds.Empty(sql)
+	csds.Empty(args)
+	csds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateSchemaSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	csds.Empty(sql)
+	csds.Empty(args)
+	csds.Equal(err1, err)
+}
+
+func TestCreateSchemaDataset(t *testing.T) {
+	suite.Run(t, new(createSchemaDatasetSuite))
+}
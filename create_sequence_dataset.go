@@ -0,0 +1,214 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// CreateSequenceDataset for creating and/or executing CREATE SEQUENCE SQL statements.
+type CreateSequenceDataset struct {
+	dialect      SQLDialect
+	clauses      exp.CreateSequenceClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedSequenceType = errors.New(
+	"unsupported sequence type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newCreateSequenceDataset(d string, queryFactory exec.QueryFactory) *CreateSequenceDataset {
+	return &CreateSequenceDataset{
+		clauses:      exp.NewCreateSequenceClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// CreateSequence creates a CreateSequenceDataset for a sequence.
+//
+//	goqu.CreateSequence("user_id_seq").StartWith(1000).IncrementBy(1)
+func CreateSequence(sequence interface{}) *CreateSequenceDataset {
+	return newCreateSequenceDataset("default", nil).Sequence(sequence)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (csd *CreateSequenceDataset) WithDialect(dl string) *CreateSequenceDataset {
+	ds := csd.copy(csd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (csd *CreateSequenceDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the CreateSequenceDataset.
+func (csd *CreateSequenceDataset) Dialect() SQLDialect {
+	return csd.dialect
+}
+
+// SetDialect returns the current adapter on the CreateSequenceDataset.
+func (csd *CreateSequenceDataset) SetDialect(dialect SQLDialect) *CreateSequenceDataset {
+	cd := csd.copy(csd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns CreateSequenceDataset as exp.Expression.
+func (csd *CreateSequenceDataset) Expression() exp.Expression {
+	return csd
+}
+
+// Clone clones the CreateSequenceDataset.
+func (csd *CreateSequenceDataset) Clone() exp.Expression {
+	return csd.copy(csd.clauses)
+}
+
+// GetClauses returns the current clauses on the CreateSequenceDataset.
+func (csd *CreateSequenceDataset) GetClauses() exp.CreateSequenceClauses {
+	return csd.clauses
+}
+
+// used internally to copy the dataset.
+func (csd *CreateSequenceDataset) copy(clauses exp.CreateSequenceClauses) *CreateSequenceDataset {
+	return &CreateSequenceDataset{
+		dialect:      csd.dialect,
+		clauses:      clauses,
+		queryFactory: csd.queryFactory,
+		err:          csd.err,
+	}
+}
+
+// Sequence sets the sequence to create. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (csd *CreateSequenceDataset) Sequence(sequence interface{}) *CreateSequenceDataset {
+	switch t := sequence.(type) {
+	case exp.Expression:
+		return csd.copy(csd.clauses.SetSequence(t))
+	case string:
+		return csd.copy(csd.clauses.SetSequence(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedSequenceType)
+	}
+}
+
+// IfNotExists adds IF NOT EXISTS to the CREATE SEQUENCE statement.
+func (csd *CreateSequenceDataset) IfNotExists() *CreateSequenceDataset {
+	return csd.copy(csd.clauses.SetIfNotExists(true))
+}
+
+// IncrementBy sets the value added to the sequence to create a new value (e.g. INCREMENT BY 2).
+func (csd *CreateSequenceDataset) IncrementBy(n int64) *CreateSequenceDataset {
+	opts := csd.clauses.Options()
+	opts.IncrementBy = &n
+	return csd.copy(csd.clauses.SetOptions(opts))
+}
+
+// MinValue sets the minimum value of the sequence (e.g. MINVALUE 1).
+func (csd *CreateSequenceDataset) MinValue(n int64) *CreateSequenceDataset {
+	opts := csd.clauses.Options()
+	opts.MinValue, opts.NoMinValue = &n, false
+	return csd.copy(csd.clauses.SetOptions(opts))
+}
+
+// NoMinValue uses the default minimum value of the sequence (e.g. NO MINVALUE).
+func (csd *CreateSequenceDataset) NoMinValue() *CreateSequenceDataset {
+	opts := csd.clauses.Options()
+	opts.MinValue, opts.NoMinValue = nil, true
+	return csd.copy(csd.clauses.SetOptions(opts))
+}
+
+// MaxValue sets the maximum value of the sequence (e.g. MAXVALUE 1000).
+func (csd *CreateSequenceDataset) MaxValue(n int64) *CreateSequenceDataset {
+	opts := csd.clauses.Options()
+	opts.MaxValue, opts.NoMaxValue = &n, false
+	return csd.copy(csd.clauses.SetOptions(opts))
+}
+
+// NoMaxValue uses the default maximum value of the sequence (e.g. NO MAXVALUE).
+func (csd *CreateSequenceDataset) NoMaxValue() *CreateSequenceDataset {
+	opts := csd.clauses.Options()
+	opts.MaxValue, opts.NoMaxValue = nil, true
+	return csd.copy(csd.clauses.SetOptions(opts))
+}
+
+// StartWith sets the starting value of the sequence (e.g. START WITH 1000).
+func (csd *CreateSequenceDataset) StartWith(n int64) *CreateSequenceDataset {
+	opts := csd.clauses.Options()
+	opts.StartWith = &n
+	return csd.copy(csd.clauses.SetOptions(opts))
+}
+
+// Cycle wraps the sequence around when the minimum or maximum value is reached (e.g. CYCLE).
+func (csd *CreateSequenceDataset) Cycle() *CreateSequenceDataset {
+	opts := csd.clauses.Options()
+	opts.Cycle, opts.NoCycle = true, false
+	return csd.copy(csd.clauses.SetOptions(opts))
+}
+
+// NoCycle returns an error when the minimum or maximum value of the sequence is reached (e.g. NO CYCLE).
+func (csd *CreateSequenceDataset) NoCycle() *CreateSequenceDataset {
+	opts := csd.clauses.Options()
+	opts.Cycle, opts.NoCycle = false, true
+	return csd.copy(csd.clauses.SetOptions(opts))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (csd *CreateSequenceDataset) Error() error {
+	return csd.err
+}
+
+// SetError sets an error on the CreateSequenceDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (csd *CreateSequenceDataset) SetError(err error) *CreateSequenceDataset {
+	if csd.err == nil {
+		csd.err = err
+	}
+
+	return csd
+}
+
+// ToSQL generates a CREATE SEQUENCE sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no sequence
+//   - The dialect does not support sequences or IF NOT EXISTS
+//   - There is an error generating the SQL
+func (csd *CreateSequenceDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return csd.createSequenceSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (csd *CreateSequenceDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = csd.createSequenceSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the CREATE SEQUENCE sql, and returns an Exec struct with the sql set to the CREATE SEQUENCE
+// statement.
+//
+// db.CreateSequence("test_seq").StartWith(1000).Executor().Exec()
+func (csd *CreateSequenceDataset) Executor() exec.QueryExecutor {
+	return csd.queryFactory.FromSQLBuilder(csd.createSequenceSQLBuilder())
+}
+
+func (csd *CreateSequenceDataset) createSequenceSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if csd.err != nil {
+		return buf.SetError(csd.err)
+	}
+	csd.dialect.ToCreateSequenceSQL(buf, csd.clauses)
+	return buf
+}
@@ -0,0 +1,205 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createSequenceTestCase struct {
+		ds      *goqu.CreateSequenceDataset
+		clauses exp.CreateSequenceClauses
+	}
+	createSequenceDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (csds *createSequenceDatasetSuite) assertCases(cases ...createSequenceTestCase) {
+	for _, s := range cases {
+		csds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (csds *createSequenceDatasetSuite) TestClone() {
+	ds := goqu.CreateSequence("test_seq")
+	csds.Equal(ds, ds.Clone())
+}
+
+func (csds *createSequenceDatasetSuite) TestExpression() {
+	ds := goqu.CreateSequence("test_seq")
+	csds.Equal(ds, ds.Expression())
+}
+
+func (csds *createSequenceDatasetSuite) TestDialect() {
+	ds := goqu.CreateSequence("test_seq")
+	csds.NotNil(ds.Dialect())
+}
+
+func (csds *createSequenceDatasetSuite) TestWithDialect() {
+	ds := goqu.CreateSequence("test_seq")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	csds.Equal(md, ds.Dialect())
+	csds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (csds *createSequenceDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.CreateSequence("test_seq")
+	csds.False(ds.IsPrepared())
+}
+
+func (csds *createSequenceDatasetSuite) TestGetClauses() {
+	ds := goqu.CreateSequence("test_seq")
+	ce := exp.NewCreateSequenceClauses().SetSequence(goqu.I("test_seq"))
+	csds.Equal(ce, ds.GetClauses())
+}
+
+func (csds *createSequenceDatasetSuite) TestSequence() {
+	bd := goqu.CreateSequence("test")
+	csds.assertCases(
+		createSequenceTestCase{ds: bd.Sequence("test2"), clauses: exp.NewCreateSequenceClauses().SetSequence(goqu.I("test2"))},
+		createSequenceTestCase{
+			ds:      bd.Sequence(goqu.S("s").Table("test2")),
+			clauses: exp.NewCreateSequenceClauses().SetSequence(goqu.S("s").Table("test2")),
+		},
+		createSequenceTestCase{ds: bd, clauses: exp.NewCreateSequenceClauses().SetSequence(goqu.I("test"))},
+	)
+	csds.PanicsWithValue(goqu.ErrUnsupportedSequenceType, func() {
+		goqu.CreateSequence(true)
+	})
+}
+
+func (csds *createSequenceDatasetSuite) TestOptions() {
+	one, two, ten, hundred := int64(1), int64(2), int64(10), int64(100)
+	bd := goqu.CreateSequence("test")
+	ce := bd.GetClauses()
+	csds.assertCases(
+		createSequenceTestCase{ds: bd.IfNotExists(), clauses: ce.SetIfNotExists(true)},
+		createSequenceTestCase{ds: bd.IncrementBy(2), clauses: ce.SetOptions(exp.SequenceOptions{IncrementBy: &two})},
+		createSequenceTestCase{ds: bd.MinValue(1), clauses: ce.SetOptions(exp.SequenceOptions{MinValue: &one})},
+		createSequenceTestCase{ds: bd.MinValue(1).NoMinValue(), clauses: ce.SetOptions(exp.SequenceOptions{NoMinValue: true})},
+		createSequenceTestCase{ds: bd.NoMinValue().MinValue(1), clauses: ce.SetOptions(exp.SequenceOptions{MinValue: &one})},
+		createSequenceTestCase{ds: bd.MaxValue(100), clauses: ce.SetOptions(exp.SequenceOptions{MaxValue: &hundred})},
+		createSequenceTestCase{ds: bd.MaxValue(100).NoMaxValue(), clauses: ce.SetOptions(exp.SequenceOptions{NoMaxValue: true})},
+		createSequenceTestCase{ds: bd.NoMaxValue().MaxValue(100), clauses: ce.SetOptions(exp.SequenceOptions{MaxValue: &hundred})},
+		createSequenceTestCase{ds: bd.StartWith(10), clauses: ce.SetOptions(exp.SequenceOptions{StartWith: &ten})},
+		createSequenceTestCase{ds: bd.NoCycle().Cycle(), clauses: ce.SetOptions(exp.SequenceOptions{Cycle: true})},
+		createSequenceTestCase{ds: bd.Cycle().NoCycle(), clauses: ce.SetOptions(exp.SequenceOptions{NoCycle: true})},
+		createSequenceTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (csds *createSequenceDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateSequence("test_seq").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateSequenceSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	csds.NoError(err)
+	csds.Empty(sql)
+	csds.Empty(args)
+	md.AssertExpectations(csds.T())
+}
+
+func (csds *createSequenceDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateSequence("test_seq").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateSequenceSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	csds.Empty(sql)
+	csds.Empty(args)
+	csds.Equal(ee, err)
+	md.AssertExpectations(csds.T())
+}
+
+func (csds *createSequenceDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	csds.NoError(err)
+
+	ds := goqu.New("mock", mDB).CreateSequence("test_seq").StartWith(10)
+
+	asql, args, err := ds.Executor().ToSQL()
+	csds.NoError(err)
+	csds.Empty(args)
+	csds.Equal(`CREATE SEQUENCE "test_seq" START WITH 10`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	csds.NoError(err)
+	csds.Empty(args)
+	csds.Equal(`CREATE SEQUENCE "test_seq" START WITH 10`, asql)
+}
+
+func (csds *createSequenceDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateSequence("test_seq").SetDialect(md)
+	ds = ds.SetError(err1)
+	csds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	csds.Empty(sql)
+	csds.Empty(args)
+	csds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	csds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	csds.Empty(sql)
+	csds.Empty(args)
+	csds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.IfNotExists()
+	csds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	csds.Empty(sql)
+	csds.Empty(args)
+	csds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateSequenceSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	csds.Empty(sql)
+	csds.Empty(args)
+	csds.Equal(err1, err)
+}
+
+func TestCreateSequenceDataset(t *testing.T) {
+	suite.Run(t, new(createSequenceDatasetSuite))
+}
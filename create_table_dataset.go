@@ -0,0 +1,214 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// CreateTableDataset for creating and/or executing CREATE TABLE SQL statements.
+type CreateTableDataset struct {
+	dialect      SQLDialect
+	clauses      exp.CreateTableClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedCreateTableType = errors.New(
+	"unsupported table type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newCreateTableDataset(d string, queryFactory exec.QueryFactory) *CreateTableDataset {
+	return &CreateTableDataset{
+		clauses:      exp.NewCreateTableClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// CreateTable creates a CreateTableDataset for a table.
+func CreateTable(table interface{}) *CreateTableDataset {
+	return newCreateTableDataset("default", nil).Table(table)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (ctd *CreateTableDataset) WithDialect(dl string) *CreateTableDataset {
+	ds := ctd.copy(ctd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (ctd *CreateTableDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the CreateTableDataset.
+func (ctd *CreateTableDataset) Dialect() SQLDialect {
+	return ctd.dialect
+}
+
+// SetDialect returns the current adapter on the CreateTableDataset.
+func (ctd *CreateTableDataset) SetDialect(dialect SQLDialect) *CreateTableDataset {
+	cd := ctd.copy(ctd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns CreateTableDataset as exp.Expression.
+func (ctd *CreateTableDataset) Expression() exp.Expression {
+	return ctd
+}
+
+// Clone clones the CreateTableDataset.
+func (ctd *CreateTableDataset) Clone() exp.Expression {
+	return ctd.copy(ctd.clauses)
+}
+
+// GetClauses returns the current clauses on the CreateTableDataset.
+func (ctd *CreateTableDataset) GetClauses() exp.CreateTableClauses {
+	return ctd.clauses
+}
+
+// used internally to copy the dataset.
+func (ctd *CreateTableDataset) copy(clauses exp.CreateTableClauses) *CreateTableDataset {
+	return &CreateTableDataset{
+		dialect:      ctd.dialect,
+		clauses:      clauses,
+		queryFactory: ctd.queryFactory,
+		err:          ctd.err,
+	}
+}
+
+// Table sets the table to create. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (ctd *CreateTableDataset) Table(table interface{}) *CreateTableDataset {
+	switch t := table.(type) {
+	case exp.Expression:
+		return ctd.copy(ctd.clauses.SetTable(t))
+	case string:
+		return ctd.copy(ctd.clauses.SetTable(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedCreateTableType)
+	}
+}
+
+// Temporary creates a temporary table (e.g. CREATE TEMPORARY TABLE), the TempTableNamePrefix of the dialect is
+// added to the name of the table (e.g. sqlserver #table).
+func (ctd *CreateTableDataset) Temporary() *CreateTableDataset {
+	return ctd.copy(ctd.clauses.SetTemporary(true))
+}
+
+// IfNotExists adds an IF NOT EXISTS clause.
+func (ctd *CreateTableDataset) IfNotExists() *CreateTableDataset {
+	return ctd.copy(ctd.clauses.SetIfNotExists(true))
+}
+
+// Columns appends column definitions to the table.
+//
+//	goqu.CreateTable("user").Columns(
+//		goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey().AutoIncrement(),
+//		goqu.ColumnDef("name", goqu.VarcharType(255)).NotNull(),
+//	)
+func (ctd *CreateTableDataset) Columns(columns ...exp.ColumnDefinition) *CreateTableDataset {
+	return ctd.copy(ctd.clauses.ColumnsAppend(columns...))
+}
+
+// Constraints appends table constraints to the table.
+//
+//	goqu.CreateTable("user_role").Constraints(goqu.PrimaryKey("user_id", "role_id"))
+func (ctd *CreateTableDataset) Constraints(constraints ...exp.TableConstraint) *CreateTableDataset {
+	return ctd.copy(ctd.clauses.ConstraintsAppend(constraints...))
+}
+
+// PartitionBy partitions the table.
+//
+//	goqu.CreateTable("measurement").Columns(...).PartitionBy(goqu.PartitionByRange("logdate"))
+func (ctd *CreateTableDataset) PartitionBy(partitionBy exp.PartitionBy) *CreateTableDataset {
+	return ctd.copy(ctd.clauses.SetPartitionBy(partitionBy))
+}
+
+// Partitions appends partitions that are defined in the CREATE TABLE statement (e.g. mysql), PartitionBy is
+// required.
+//
+//	goqu.Dialect("mysql").CreateTable("measurement").Columns(...).
+//		PartitionBy(goqu.PartitionByRange(goqu.L("YEAR(?)", goqu.C("logdate")))).
+//		Partitions(
+//			goqu.Partition("p2023", goqu.ValuesLessThan(2024)),
+//			goqu.Partition("pmax", goqu.ValuesLessThan(goqu.L("MAXVALUE"))),
+//		)
+func (ctd *CreateTableDataset) Partitions(partitions ...exp.PartitionDefinition) *CreateTableDataset {
+	return ctd.copy(ctd.clauses.PartitionsAppend(partitions...))
+}
+
+// PartitionOf creates the table as a partition of the parent table (e.g. postgres PARTITION OF), the columns of the
+// table are the columns of the parent table. The parent can be a string or an identifier expression.
+//
+//	goqu.CreateTable("measurement_2024").PartitionOf("measurement", goqu.ValuesFromTo("2024-01-01", "2025-01-01"))
+func (ctd *CreateTableDataset) PartitionOf(parent interface{}, bound exp.PartitionBound) *CreateTableDataset {
+	switch t := parent.(type) {
+	case exp.Expression:
+		return ctd.copy(ctd.clauses.SetPartitionOf(t, bound))
+	case string:
+		return ctd.copy(ctd.clauses.SetPartitionOf(exp.ParseIdentifier(t), bound))
+	default:
+		panic(ErrUnsupportedCreateTableType)
+	}
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (ctd *CreateTableDataset) Error() error {
+	return ctd.err
+}
+
+// SetError sets an error on the CreateTableDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (ctd *CreateTableDataset) SetError(err error) *CreateTableDataset {
+	if ctd.err == nil {
+		ctd.err = err
+	}
+
+	return ctd
+}
+
+// ToSQL generates a CREATE TABLE sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no table or there are no columns
+//   - The dialect does not support partitions or the bound of a partition
+//   - There is an error generating the SQL
+func (ctd *CreateTableDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return ctd.createTableSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (ctd *CreateTableDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = ctd.createTableSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the CREATE TABLE sql, and returns an Exec struct with the sql set to the CREATE TABLE
+// statement.
+//
+// db.CreateTable("test").Columns(goqu.ColumnDef("id", goqu.IntegerType())).Executor().Exec()
+func (ctd *CreateTableDataset) Executor() exec.QueryExecutor {
+	return ctd.queryFactory.FromSQLBuilder(ctd.createTableSQLBuilder())
+}
+
+func (ctd *CreateTableDataset) createTableSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if ctd.err != nil {
+		return buf.SetError(ctd.err)
+	}
+	ctd.dialect.ToCreateTableSQL(buf, ctd.clauses)
+	return buf
+}
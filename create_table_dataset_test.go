@@ -0,0 +1,312 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createTableTestCase struct {
+		ds      *goqu.CreateTableDataset
+		clauses exp.CreateTableClauses
+	}
+	createTableDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (ctds *createTableDatasetSuite) assertCases(cases ...createTableTestCase) {
+	for _, s := range cases {
+		ctds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (ctds *createTableDatasetSuite) TestClone() {
+	ds := goqu.CreateTable("test")
+	ctds.Equal(ds, ds.Clone())
+}
+
+func (ctds *createTableDatasetSuite) TestExpression() {
+	ds := goqu.CreateTable("test")
+	ctds.Equal(ds, ds.Expression())
+}
+
+func (ctds *createTableDatasetSuite) TestDialect() {
+	ds := goqu.CreateTable("test")
+	ctds.NotNil(ds.Dialect())
+}
+
+func (ctds *createTableDatasetSuite) TestWithDialect() {
+	ds := goqu.CreateTable("test")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	ctds.Equal(md, ds.Dialect())
+	ctds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (ctds *createTableDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.CreateTable("test")
+	ctds.False(ds.IsPrepared())
+}
+
+func (ctds *createTableDatasetSuite) TestGetClauses() {
+	ds := goqu.CreateTable("test")
+	ce := exp.NewCreateTableClauses().SetTable(goqu.I("test"))
+	ctds.Equal(ce, ds.GetClauses())
+}
+
+func (ctds *createTableDatasetSuite) TestTable() {
+	bd := goqu.CreateTable("test")
+	ctds.assertCases(
+		createTableTestCase{
+			ds:      bd.Table("test2"),
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test2")),
+		},
+		createTableTestCase{
+			ds:      bd.Table(goqu.S("s").Table("test2")),
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.S("s").Table("test2")),
+		},
+		createTableTestCase{
+			ds:      bd,
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")),
+		},
+	)
+	ctds.PanicsWithValue(goqu.ErrUnsupportedCreateTableType, func() {
+		goqu.CreateTable(true)
+	})
+}
+
+func (ctds *createTableDatasetSuite) TestTemporary() {
+	bd := goqu.CreateTable("test")
+	ctds.assertCases(
+		createTableTestCase{
+			ds:      bd.Temporary(),
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")).SetTemporary(true),
+		},
+		createTableTestCase{
+			ds:      bd,
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")),
+		},
+	)
+}
+
+func (ctds *createTableDatasetSuite) TestIfNotExists() {
+	bd := goqu.CreateTable("test")
+	ctds.assertCases(
+		createTableTestCase{
+			ds:      bd.IfNotExists(),
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")).SetIfNotExists(true),
+		},
+		createTableTestCase{
+			ds:      bd,
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")),
+		},
+	)
+}
+
+func (ctds *createTableDatasetSuite) TestColumns() {
+	id := goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey()
+	name := goqu.ColumnDef("name", goqu.VarcharType(255)).NotNull()
+	bd := goqu.CreateTable("test")
+	ctds.assertCases(
+		createTableTestCase{
+			ds:      bd.Columns(id),
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")).ColumnsAppend(id),
+		},
+		createTableTestCase{
+			ds:      bd.Columns(id).Columns(name),
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")).ColumnsAppend(id, name),
+		},
+		createTableTestCase{
+			ds:      bd,
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")),
+		},
+	)
+}
+
+func (ctds *createTableDatasetSuite) TestConstraints() {
+	pk := goqu.PrimaryKey("a", "b")
+	u := goqu.Unique("c").Named("c_uniq")
+	fk := goqu.ForeignKey("d").References("other", "id").OnDelete(exp.CascadeReferentialAction)
+	check := goqu.Check(goqu.C("e").Gt(0))
+	bd := goqu.CreateTable("test")
+	ctds.assertCases(
+		createTableTestCase{
+			ds:      bd.Constraints(pk, u),
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")).ConstraintsAppend(pk, u),
+		},
+		createTableTestCase{
+			ds:      bd.Constraints(fk).Constraints(check),
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")).ConstraintsAppend(fk, check),
+		},
+		createTableTestCase{
+			ds:      bd,
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")),
+		},
+	)
+}
+
+func (ctds *createTableDatasetSuite) TestPartitionBy() {
+	pb := goqu.PartitionByRange("a")
+	bd := goqu.CreateTable("test")
+	ctds.assertCases(
+		createTableTestCase{
+			ds:      bd.PartitionBy(pb),
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")).SetPartitionBy(pb),
+		},
+		createTableTestCase{
+			ds:      bd,
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")),
+		},
+	)
+}
+
+func (ctds *createTableDatasetSuite) TestPartitions() {
+	p0 := goqu.Partition("p0", goqu.ValuesLessThan(10))
+	p1 := goqu.Partition("p1", goqu.ValuesLessThan(20))
+	bd := goqu.CreateTable("test")
+	ctds.assertCases(
+		createTableTestCase{
+			ds:      bd.Partitions(p0).Partitions(p1),
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")).PartitionsAppend(p0, p1),
+		},
+		createTableTestCase{
+			ds:      bd,
+			clauses: exp.NewCreateTableClauses().SetTable(goqu.I("test")),
+		},
+	)
+}
+
+func (ctds *createTableDatasetSuite) TestPartitionOf() {
+	bound := goqu.ValuesIn("a")
+	bd := goqu.CreateTable("test_a")
+	ce := exp.NewCreateTableClauses().SetTable(goqu.I("test_a"))
+	ctds.assertCases(
+		createTableTestCase{ds: bd.PartitionOf("test", bound), clauses: ce.SetPartitionOf(goqu.I("test"), bound)},
+		createTableTestCase{
+			ds:      bd.PartitionOf(goqu.S("s").Table("test"), bound),
+			clauses: ce.SetPartitionOf(goqu.S("s").Table("test"), bound),
+		},
+		createTableTestCase{ds: bd, clauses: ce},
+	)
+	ctds.PanicsWithValue(goqu.ErrUnsupportedCreateTableType, func() {
+		bd.PartitionOf(true, bound)
+	})
+}
+
+func (ctds *createTableDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateTable("test").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateTableSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	ctds.NoError(err)
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	md.AssertExpectations(ctds.T())
+}
+
+func (ctds *createTableDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateTable("test").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateTableSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	ctds.Equal(ee, err)
+	md.AssertExpectations(ctds.T())
+}
+
+func (ctds *createTableDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	ctds.NoError(err)
+
+	ds := goqu.New("mock", mDB).CreateTable("test").Columns(
+		goqu.ColumnDef("id", goqu.IntegerType()).PrimaryKey(),
+		goqu.ColumnDef("name", goqu.TextType()).NotNull().Default(""),
+	)
+
+	csql, args, err := ds.Executor().ToSQL()
+	ctds.NoError(err)
+	ctds.Empty(args)
+	ctds.Equal(`CREATE TABLE "test" ("id" INTEGER PRIMARY KEY, "name" TEXT DEFAULT '' NOT NULL)`, csql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	csql, args, err = ds.Executor().ToSQL()
+	ctds.NoError(err)
+	ctds.Empty(args)
+	ctds.Equal(`CREATE TABLE "test" ("id" INTEGER PRIMARY KEY, "name" TEXT DEFAULT '' NOT NULL)`, csql)
+}
+
+func (ctds *createTableDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateTable("test").SetDialect(md)
+	ds = ds.SetError(err1)
+	ctds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	ctds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	ctds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	ctds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.IfNotExists()
+	ctds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	ctds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateTableSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	ctds.Equal(err1, err)
+}
+
+func TestCreateTableDataset(t *testing.T) {
+	suite.Run(t, new(createTableDatasetSuite))
+}
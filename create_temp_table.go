@@ -0,0 +1,91 @@
+package goqu
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// OnCommitAction controls what happens to the rows of a temporary table at the end of a transaction.
+	OnCommitAction = exp.OnCommitAction
+
+	// CreateTempTableAsStatement creates a temporary table populated with the results of a SelectDataset.
+	CreateTempTableAsStatement struct {
+		name     string
+		ds       *SelectDataset
+		onCommit OnCommitAction
+	}
+)
+
+const (
+	// Use the databases default behavior
+	OnCommitDefault = exp.OnCommitDefault
+	// ON COMMIT PRESERVE ROWS
+	OnCommitPreserveRows = exp.OnCommitPreserveRows
+	// ON COMMIT DELETE ROWS
+	OnCommitDeleteRows = exp.OnCommitDeleteRows
+	// ON COMMIT DROP
+	OnCommitDrop = exp.OnCommitDrop
+)
+
+var errTempTableNameRequired = errors.New("a table name is required when creating a temporary table")
+
+// CreateTempTableAs creates a new CreateTempTableAsStatement that stages the results of the dataset in a temporary
+// table. The statement uses the dialect and database of the dataset.
+//    goqu.CreateTempTableAs("adults", db.From("users").Where(goqu.C("age").Gte(18))).
+//        OnCommit(goqu.OnCommitDrop).
+//        Exec()
+//    // postgres: CREATE TEMPORARY TABLE "adults" ON COMMIT DROP AS SELECT * FROM "users" WHERE ("age" >= 18)
+//    // sqlserver (without OnCommit): SELECT * INTO "#adults" FROM (SELECT * FROM "users" WHERE ("age" >= 18)) AS "t"
+func CreateTempTableAs(name string, ds *SelectDataset) *CreateTempTableAsStatement {
+	return &CreateTempTableAsStatement{name: name, ds: ds}
+}
+
+// OnCommit sets what happens to the rows of the temporary table when the transaction commits (e.g. postgres).
+func (cts *CreateTempTableAsStatement) OnCommit(action OnCommitAction) *CreateTempTableAsStatement {
+	ret := *cts
+	ret.onCommit = action
+	return &ret
+}
+
+// ToSQL generates the sql to create and populate the temporary table.
+func (cts *CreateTempTableAsStatement) ToSQL() (sql string, args []interface{}, err error) {
+	return cts.sqlBuilder().ToSQL()
+}
+
+// Executor creates a QueryExecutor to create the temporary table.
+func (cts *CreateTempTableAsStatement) Executor() exec.QueryExecutor {
+	return cts.ds.queryFactory.FromSQLBuilder(cts.sqlBuilder())
+}
+
+// Exec creates the temporary table.
+func (cts *CreateTempTableAsStatement) Exec() (sql.Result, error) {
+	return cts.ExecContext(context.Background())
+}
+
+// ExecContext see CreateTempTableAsStatement#Exec
+func (cts *CreateTempTableAsStatement) ExecContext(ctx context.Context) (sql.Result, error) {
+	if cts.ds.queryFactory == nil {
+		return nil, ErrQueryFactoryNotFoundError
+	}
+	return cts.Executor().ExecContext(ctx)
+}
+
+func (cts *CreateTempTableAsStatement) sqlBuilder() sb.SQLBuilder {
+	b := sb.NewSQLBuilder(cts.ds.IsPrepared())
+	if cts.name == "" {
+		return b.SetError(errTempTableNameRequired)
+	}
+	clauses := exp.NewCreateTableClauses().
+		SetTable(T(cts.name)).
+		SetTemporary(true).
+		SetOnCommit(cts.onCommit).
+		SetAsSelect(cts.ds)
+	cts.ds.Dialect().ToCreateTableSQL(b, clauses)
+	return b
+}
@@ -0,0 +1,134 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	_ "github.com/doug-martin/goqu/v9/dialect/spanner"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlserver"
+	"github.com/stretchr/testify/suite"
+)
+
+type createTempTableSuite struct {
+	suite.Suite
+}
+
+func TestCreateTempTableSuite(t *testing.T) {
+	suite.Run(t, new(createTempTableSuite))
+}
+
+func (cts *createTempTableSuite) assertSQL(stmt *goqu.CreateTempTableAsStatement, expectedSQL string, expectedArgs ...interface{}) {
+	sql, args, err := stmt.ToSQL()
+	cts.NoError(err)
+	cts.Equal(expectedSQL, sql)
+	if len(expectedArgs) == 0 {
+		cts.Empty(args)
+	} else {
+		cts.Equal(expectedArgs, args)
+	}
+}
+
+func (cts *createTempTableSuite) TestToSQL() {
+	ds := goqu.From("users").Where(goqu.C("active").IsTrue())
+	cts.assertSQL(
+		goqu.CreateTempTableAs("active_users", ds),
+		`CREATE TEMPORARY TABLE "active_users" AS SELECT * FROM "users" WHERE ("active" IS TRUE)`,
+	)
+	cts.assertSQL(
+		goqu.CreateTempTableAs("active_users", ds.Where(goqu.C("age").Gt(10)).Prepared(true)),
+		`CREATE TEMPORARY TABLE "active_users" AS SELECT * FROM "users" WHERE (("active" IS TRUE) AND ("age" > ?))`,
+		int64(10),
+	)
+
+	_, _, err := goqu.CreateTempTableAs("", ds).ToSQL()
+	cts.EqualError(err, "goqu: a table name is required when creating a temporary table")
+
+	_, _, err = goqu.CreateTempTableAs("active_users", ds).OnCommit(goqu.OnCommitDrop).ToSQL()
+	cts.EqualError(err, "goqu: dialect does not support ON COMMIT for temporary tables [dialect=default]")
+}
+
+func (cts *createTempTableSuite) TestToSQL_postgres() {
+	ds := goqu.Dialect("postgres").From("users").Where(goqu.C("age").Gt(10))
+	cts.assertSQL(
+		goqu.CreateTempTableAs("stage", ds),
+		`CREATE TEMPORARY TABLE "stage" AS SELECT * FROM "users" WHERE ("age" > 10)`,
+	)
+	cts.assertSQL(
+		goqu.CreateTempTableAs("stage", ds).OnCommit(goqu.OnCommitDrop),
+		`CREATE TEMPORARY TABLE "stage" ON COMMIT DROP AS SELECT * FROM "users" WHERE ("age" > 10)`,
+	)
+	cts.assertSQL(
+		goqu.CreateTempTableAs("stage", ds).OnCommit(goqu.OnCommitDeleteRows),
+		`CREATE TEMPORARY TABLE "stage" ON COMMIT DELETE ROWS AS SELECT * FROM "users" WHERE ("age" > 10)`,
+	)
+	cts.assertSQL(
+		goqu.CreateTempTableAs("stage", ds.Prepared(true)).OnCommit(goqu.OnCommitPreserveRows),
+		`CREATE TEMPORARY TABLE "stage" ON COMMIT PRESERVE ROWS AS SELECT * FROM "users" WHERE ("age" > $1)`,
+		int64(10),
+	)
+}
+
+func (cts *createTempTableSuite) TestToSQL_mysql() {
+	ds := goqu.Dialect("mysql").From("users").Where(goqu.C("age").Gt(10))
+	cts.assertSQL(
+		goqu.CreateTempTableAs("stage", ds),
+		"CREATE TEMPORARY TABLE `stage` AS SELECT * FROM `users` WHERE (`age` > 10)",
+	)
+}
+
+func (cts *createTempTableSuite) TestToSQL_sqlserver() {
+	ds := goqu.Dialect("sqlserver").From("users").Where(goqu.C("age").Gt(10))
+	cts.assertSQL(
+		goqu.CreateTempTableAs("stage", ds),
+		`SELECT * INTO "#stage" FROM (SELECT * FROM "users" WHERE ("age" > 10)) AS "t"`,
+	)
+	cts.assertSQL(
+		goqu.CreateTempTableAs("#stage", ds.Prepared(true)),
+		`SELECT * INTO "#stage" FROM (SELECT * FROM "users" WHERE ("age" > @p1)) AS "t"`,
+		int64(10),
+	)
+
+	_, _, err := goqu.CreateTempTableAs("stage", ds).OnCommit(goqu.OnCommitDrop).ToSQL()
+	cts.EqualError(err, "goqu: dialect does not support ON COMMIT for temporary tables [dialect=sqlserver]")
+}
+
+func (cts *createTempTableSuite) TestToSQL_spanner() {
+	ds := goqu.Dialect("spanner").From("users")
+	_, _, err := goqu.CreateTempTableAs("stage", ds).ToSQL()
+	cts.EqualError(err, "goqu: dialect does not support TEMPORARY in CREATE TABLE [dialect=spanner]")
+}
+
+func (cts *createTempTableSuite) TestToSQL_withDialectOptions() {
+	opts := goqu.DefaultDialectOptions()
+	opts.CreateTempTableFragment = []byte("CREATE LOCAL TEMPORARY TABLE ")
+	opts.SupportsTempTableOnCommit = true
+	goqu.RegisterDialect("temp-table-options", opts)
+	defer goqu.DeregisterDialect("temp-table-options")
+
+	ds := goqu.Dialect("temp-table-options").From("users")
+	cts.assertSQL(
+		goqu.CreateTempTableAs("stage", ds).OnCommit(goqu.OnCommitPreserveRows),
+		`CREATE LOCAL TEMPORARY TABLE "stage" ON COMMIT PRESERVE ROWS AS SELECT * FROM "users"`,
+	)
+}
+
+func (cts *createTempTableSuite) TestExec() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	cts.Require().NoError(err)
+	sqlMock.ExpectExec(`CREATE TEMPORARY TABLE "stage" ON COMMIT DROP AS SELECT * FROM "users"`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	db := goqu.New("postgres", mDB)
+	res, err := goqu.CreateTempTableAs("stage", db.From("users")).OnCommit(goqu.OnCommitDrop).Exec()
+	cts.NoError(err)
+	affected, err := res.RowsAffected()
+	cts.NoError(err)
+	cts.Equal(int64(3), affected)
+	cts.NoError(sqlMock.ExpectationsWereMet())
+
+	_, err = goqu.CreateTempTableAs("stage", goqu.From("users")).Exec()
+	cts.Equal(goqu.ErrQueryFactoryNotFoundError, err)
+}
@@ -0,0 +1,277 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// CreateTriggerDataset for creating and/or executing CREATE TRIGGER SQL statements.
+type CreateTriggerDataset struct {
+	dialect      SQLDialect
+	clauses      exp.CreateTriggerClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var (
+	ErrUnsupportedTriggerType = errors.New(
+		"unsupported trigger type, a string or identifier expression is required",
+	)
+	ErrUnsupportedTriggerTableType = errors.New(
+		"unsupported table type, a string or identifier expression is required",
+	)
+)
+
+// used internally by database to create a database with a specific adapter.
+func newCreateTriggerDataset(d string, queryFactory exec.QueryFactory) *CreateTriggerDataset {
+	return &CreateTriggerDataset{
+		clauses:      exp.NewCreateTriggerClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// CreateTrigger creates a CreateTriggerDataset for a trigger.
+//
+//	goqu.CreateTrigger("user_audit").After().OnInsert().OnUpdate().On("user").ForEachRow().Execute("user_audit_fn")
+func CreateTrigger(trigger interface{}) *CreateTriggerDataset {
+	return newCreateTriggerDataset("default", nil).Trigger(trigger)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (ctd *CreateTriggerDataset) WithDialect(dl string) *CreateTriggerDataset {
+	ds := ctd.copy(ctd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (ctd *CreateTriggerDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the CreateTriggerDataset.
+func (ctd *CreateTriggerDataset) Dialect() SQLDialect {
+	return ctd.dialect
+}
+
+// SetDialect returns the current adapter on the CreateTriggerDataset.
+func (ctd *CreateTriggerDataset) SetDialect(dialect SQLDialect) *CreateTriggerDataset {
+	cd := ctd.copy(ctd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns CreateTriggerDataset as exp.Expression.
+func (ctd *CreateTriggerDataset) Expression() exp.Expression {
+	return ctd
+}
+
+// Clone clones the CreateTriggerDataset.
+func (ctd *CreateTriggerDataset) Clone() exp.Expression {
+	return ctd.copy(ctd.clauses)
+}
+
+// GetClauses returns the current clauses on the CreateTriggerDataset.
+func (ctd *CreateTriggerDataset) GetClauses() exp.CreateTriggerClauses {
+	return ctd.clauses
+}
+
+// used internally to copy the dataset.
+func (ctd *CreateTriggerDataset) copy(clauses exp.CreateTriggerClauses) *CreateTriggerDataset {
+	return &CreateTriggerDataset{
+		dialect:      ctd.dialect,
+		clauses:      clauses,
+		queryFactory: ctd.queryFactory,
+		err:          ctd.err,
+	}
+}
+
+// Trigger sets the trigger to create. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (ctd *CreateTriggerDataset) Trigger(trigger interface{}) *CreateTriggerDataset {
+	switch t := trigger.(type) {
+	case exp.Expression:
+		return ctd.copy(ctd.clauses.SetTrigger(t))
+	case string:
+		return ctd.copy(ctd.clauses.SetTrigger(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedTriggerType)
+	}
+}
+
+// OrReplace replaces the trigger if it exists (e.g. CREATE OR REPLACE TRIGGER, sqlserver CREATE OR ALTER TRIGGER).
+func (ctd *CreateTriggerDataset) OrReplace() *CreateTriggerDataset {
+	return ctd.copy(ctd.clauses.SetOrReplace(true))
+}
+
+// IfNotExists adds IF NOT EXISTS to the CREATE TRIGGER statement.
+func (ctd *CreateTriggerDataset) IfNotExists() *CreateTriggerDataset {
+	return ctd.copy(ctd.clauses.SetIfNotExists(true))
+}
+
+// Before fires the trigger before the event.
+func (ctd *CreateTriggerDataset) Before() *CreateTriggerDataset {
+	return ctd.copy(ctd.clauses.SetTiming(exp.BeforeTriggerTiming))
+}
+
+// After fires the trigger after the event.
+func (ctd *CreateTriggerDataset) After() *CreateTriggerDataset {
+	return ctd.copy(ctd.clauses.SetTiming(exp.AfterTriggerTiming))
+}
+
+// InsteadOf fires the trigger instead of the event, usually on a view.
+func (ctd *CreateTriggerDataset) InsteadOf() *CreateTriggerDataset {
+	return ctd.copy(ctd.clauses.SetTiming(exp.InsteadOfTriggerTiming))
+}
+
+// OnInsert fires the trigger when rows are inserted.
+func (ctd *CreateTriggerDataset) OnInsert() *CreateTriggerDataset {
+	return ctd.addEvent(exp.InsertTriggerEvent)
+}
+
+// OnUpdate fires the trigger when rows are updated, if columns are passed in the trigger is only fired when one of
+// the columns is updated (e.g. UPDATE OF "email").
+func (ctd *CreateTriggerDataset) OnUpdate(columns ...interface{}) *CreateTriggerDataset {
+	ds := ctd.addEvent(exp.UpdateTriggerEvent)
+	if len(columns) == 0 {
+		return ds
+	}
+	cols := exp.NewColumnListExpression(columns...)
+	if existing := ds.clauses.UpdateColumns(); existing != nil {
+		cols = existing.Append(cols.Columns()...)
+	}
+	return ds.copy(ds.clauses.SetUpdateColumns(cols))
+}
+
+// OnDelete fires the trigger when rows are deleted.
+func (ctd *CreateTriggerDataset) OnDelete() *CreateTriggerDataset {
+	return ctd.addEvent(exp.DeleteTriggerEvent)
+}
+
+// OnTruncate fires the trigger when the table is truncated (e.g. postgres).
+func (ctd *CreateTriggerDataset) OnTruncate() *CreateTriggerDataset {
+	return ctd.addEvent(exp.TruncateTriggerEvent)
+}
+
+// used internally to add an event to the trigger, events are only added once.
+func (ctd *CreateTriggerDataset) addEvent(event exp.TriggerEvent) *CreateTriggerDataset {
+	events := ctd.clauses.Events()
+	for _, e := range events {
+		if e == event {
+			return ctd
+		}
+	}
+	newEvents := make([]exp.TriggerEvent, 0, len(events)+1)
+	newEvents = append(newEvents, events...)
+	return ctd.copy(ctd.clauses.SetEvents(append(newEvents, event)))
+}
+
+// On sets the table or view of the trigger. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (ctd *CreateTriggerDataset) On(table interface{}) *CreateTriggerDataset {
+	switch t := table.(type) {
+	case exp.Expression:
+		return ctd.copy(ctd.clauses.SetTable(t))
+	case string:
+		return ctd.copy(ctd.clauses.SetTable(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedTriggerTableType)
+	}
+}
+
+// ForEachRow fires the trigger once for each row (e.g. FOR EACH ROW).
+func (ctd *CreateTriggerDataset) ForEachRow() *CreateTriggerDataset {
+	return ctd.copy(ctd.clauses.SetLevel(exp.RowTriggerLevel))
+}
+
+// ForEachStatement fires the trigger once for each statement (e.g. FOR EACH STATEMENT).
+func (ctd *CreateTriggerDataset) ForEachStatement() *CreateTriggerDataset {
+	return ctd.copy(ctd.clauses.SetLevel(exp.StatementTriggerLevel))
+}
+
+// When only fires the trigger when the condition is true, the condition is wrapped in parens. Use goqu.I("NEW.a")
+// and goqu.I("OLD.a") to reference the new and old values of a column.
+//
+//	goqu.CreateTrigger("user_email_audit").After().OnUpdate("email").On("user").ForEachRow().
+//		When(goqu.I("OLD.email").Neq(goqu.I("NEW.email"))).
+//		Execute("user_email_audit_fn")
+func (ctd *CreateTriggerDataset) When(condition exp.Expression) *CreateTriggerDataset {
+	return ctd.copy(ctd.clauses.SetWhen(condition))
+}
+
+// Execute sets the function executed when the trigger is fired (e.g. postgres EXECUTE FUNCTION "audit"()), it replaces
+// the Body of the trigger.
+func (ctd *CreateTriggerDataset) Execute(function string, args ...interface{}) *CreateTriggerDataset {
+	return ctd.copy(ctd.clauses.SetBody("").SetFunction(exp.NewSQLFunctionExpression(function, args...)))
+}
+
+// Body sets the statements executed when the trigger is fired, the body is written as is. The body is wrapped in
+// BEGIN and END by mysql and sqlite3 and written after AS by sqlserver, it replaces the function set with Execute.
+//
+//	goqu.Dialect("mysql").CreateTrigger("user_audit").After().OnInsert().On("user").ForEachRow().
+//		Body("INSERT INTO audit (user_id) VALUES (NEW.id);")
+//	// CREATE TRIGGER `user_audit` AFTER INSERT ON `user` FOR EACH ROW BEGIN INSERT INTO audit (user_id) VALUES (NEW.id); END
+func (ctd *CreateTriggerDataset) Body(body string) *CreateTriggerDataset {
+	return ctd.copy(ctd.clauses.SetFunction(nil).SetBody(body))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (ctd *CreateTriggerDataset) Error() error {
+	return ctd.err
+}
+
+// SetError sets an error on the CreateTriggerDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (ctd *CreateTriggerDataset) SetError(err error) *CreateTriggerDataset {
+	if ctd.err == nil {
+		ctd.err = err
+	}
+
+	return ctd
+}
+
+// ToSQL generates a CREATE TRIGGER sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no trigger, table, timing, event or action
+//   - The dialect does not support triggers or one of the features of the trigger
+//   - There is an error generating the SQL
+func (ctd *CreateTriggerDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return ctd.createTriggerSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (ctd *CreateTriggerDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = ctd.createTriggerSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the CREATE TRIGGER sql, and returns an Exec struct with the sql set to the CREATE TRIGGER
+// statement.
+//
+// db.CreateTrigger("user_audit").After().OnInsert().On("user").ForEachRow().Execute("user_audit_fn").Executor().Exec()
+func (ctd *CreateTriggerDataset) Executor() exec.QueryExecutor {
+	return ctd.queryFactory.FromSQLBuilder(ctd.createTriggerSQLBuilder())
+}
+
+func (ctd *CreateTriggerDataset) createTriggerSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if ctd.err != nil {
+		return buf.SetError(ctd.err)
+	}
+	ctd.dialect.ToCreateTriggerSQL(buf, ctd.clauses)
+	return buf
+}
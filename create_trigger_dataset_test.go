@@ -0,0 +1,278 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createTriggerTestCase struct {
+		ds      *goqu.CreateTriggerDataset
+		clauses exp.CreateTriggerClauses
+	}
+	createTriggerDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (ctds *createTriggerDatasetSuite) assertCases(cases ...createTriggerTestCase) {
+	for _, s := range cases {
+		ctds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (ctds *createTriggerDatasetSuite) TestClone() {
+	ds := goqu.CreateTrigger("test_trigger")
+	ctds.Equal(ds, ds.Clone())
+}
+
+func (ctds *createTriggerDatasetSuite) TestExpression() {
+	ds := goqu.CreateTrigger("test_trigger")
+	ctds.Equal(ds, ds.Expression())
+}
+
+func (ctds *createTriggerDatasetSuite) TestDialect() {
+	ds := goqu.CreateTrigger("test_trigger")
+	ctds.NotNil(ds.Dialect())
+}
+
+func (ctds *createTriggerDatasetSuite) TestWithDialect() {
+	ds := goqu.CreateTrigger("test_trigger")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	ctds.Equal(md, ds.Dialect())
+	ctds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (ctds *createTriggerDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.CreateTrigger("test_trigger")
+	ctds.False(ds.IsPrepared())
+}
+
+func (ctds *createTriggerDatasetSuite) TestGetClauses() {
+	ds := goqu.CreateTrigger("test_trigger")
+	ce := exp.NewCreateTriggerClauses().SetTrigger(goqu.I("test_trigger"))
+	ctds.Equal(ce, ds.GetClauses())
+}
+
+func (ctds *createTriggerDatasetSuite) TestTrigger() {
+	bd := goqu.CreateTrigger("test")
+	ctds.assertCases(
+		createTriggerTestCase{ds: bd.Trigger("test2"), clauses: exp.NewCreateTriggerClauses().SetTrigger(goqu.I("test2"))},
+		createTriggerTestCase{ds: bd.Trigger(goqu.I("test2")), clauses: exp.NewCreateTriggerClauses().SetTrigger(goqu.I("test2"))},
+		createTriggerTestCase{ds: bd, clauses: exp.NewCreateTriggerClauses().SetTrigger(goqu.I("test"))},
+	)
+	ctds.PanicsWithValue(goqu.ErrUnsupportedTriggerType, func() {
+		goqu.CreateTrigger(true)
+	})
+}
+
+func (ctds *createTriggerDatasetSuite) TestOrReplace() {
+	bd := goqu.CreateTrigger("test")
+	ce := bd.GetClauses()
+	ctds.assertCases(
+		createTriggerTestCase{ds: bd.OrReplace(), clauses: ce.SetOrReplace(true)},
+		createTriggerTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (ctds *createTriggerDatasetSuite) TestIfNotExists() {
+	bd := goqu.CreateTrigger("test")
+	ce := bd.GetClauses()
+	ctds.assertCases(
+		createTriggerTestCase{ds: bd.IfNotExists(), clauses: ce.SetIfNotExists(true)},
+		createTriggerTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (ctds *createTriggerDatasetSuite) TestTiming() {
+	bd := goqu.CreateTrigger("test")
+	ce := bd.GetClauses()
+	ctds.assertCases(
+		createTriggerTestCase{ds: bd.Before(), clauses: ce.SetTiming(exp.BeforeTriggerTiming)},
+		createTriggerTestCase{ds: bd.After(), clauses: ce.SetTiming(exp.AfterTriggerTiming)},
+		createTriggerTestCase{ds: bd.InsteadOf(), clauses: ce.SetTiming(exp.InsteadOfTriggerTiming)},
+		createTriggerTestCase{ds: bd.Before().After(), clauses: ce.SetTiming(exp.AfterTriggerTiming)},
+		createTriggerTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (ctds *createTriggerDatasetSuite) TestEvents() {
+	bd := goqu.CreateTrigger("test")
+	ce := bd.GetClauses()
+	ctds.assertCases(
+		createTriggerTestCase{ds: bd.OnInsert(), clauses: ce.SetEvents([]exp.TriggerEvent{exp.InsertTriggerEvent})},
+		createTriggerTestCase{
+			ds: bd.OnInsert().OnUpdate().OnDelete().OnTruncate().OnInsert(),
+			clauses: ce.SetEvents([]exp.TriggerEvent{
+				exp.InsertTriggerEvent, exp.UpdateTriggerEvent, exp.DeleteTriggerEvent, exp.TruncateTriggerEvent,
+			}),
+		},
+		createTriggerTestCase{
+			ds: bd.OnUpdate("a").OnUpdate("b"),
+			clauses: ce.SetEvents([]exp.TriggerEvent{exp.UpdateTriggerEvent}).
+				SetUpdateColumns(exp.NewColumnListExpression("a", "b")),
+		},
+		createTriggerTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (ctds *createTriggerDatasetSuite) TestOn() {
+	bd := goqu.CreateTrigger("test")
+	ce := bd.GetClauses()
+	ctds.assertCases(
+		createTriggerTestCase{ds: bd.On("user"), clauses: ce.SetTable(goqu.I("user"))},
+		createTriggerTestCase{ds: bd.On(goqu.T("user").Schema("app")), clauses: ce.SetTable(goqu.T("user").Schema("app"))},
+		createTriggerTestCase{ds: bd, clauses: ce},
+	)
+	ctds.PanicsWithValue(goqu.ErrUnsupportedTriggerTableType, func() {
+		bd.On(1)
+	})
+}
+
+func (ctds *createTriggerDatasetSuite) TestLevel() {
+	bd := goqu.CreateTrigger("test")
+	ce := bd.GetClauses()
+	ctds.assertCases(
+		createTriggerTestCase{ds: bd.ForEachRow(), clauses: ce.SetLevel(exp.RowTriggerLevel)},
+		createTriggerTestCase{ds: bd.ForEachStatement(), clauses: ce.SetLevel(exp.StatementTriggerLevel)},
+		createTriggerTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (ctds *createTriggerDatasetSuite) TestWhen() {
+	bd := goqu.CreateTrigger("test")
+	ce := bd.GetClauses()
+	when := goqu.I("NEW.a").Gt(1)
+	ctds.assertCases(
+		createTriggerTestCase{ds: bd.When(when), clauses: ce.SetWhen(when)},
+		createTriggerTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (ctds *createTriggerDatasetSuite) TestExecuteAndBody() {
+	bd := goqu.CreateTrigger("test")
+	ce := bd.GetClauses()
+	ctds.assertCases(
+		createTriggerTestCase{ds: bd.Execute("audit"), clauses: ce.SetFunction(goqu.Func("audit"))},
+		createTriggerTestCase{ds: bd.Execute("audit", "user"), clauses: ce.SetFunction(goqu.Func("audit", "user"))},
+		createTriggerTestCase{ds: bd.Body("DELETE FROM a;"), clauses: ce.SetBody("DELETE FROM a;")},
+		createTriggerTestCase{ds: bd.Body("DELETE FROM a;").Execute("audit"), clauses: ce.SetFunction(goqu.Func("audit"))},
+		createTriggerTestCase{ds: bd.Execute("audit").Body("DELETE FROM a;"), clauses: ce.SetBody("DELETE FROM a;")},
+		createTriggerTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (ctds *createTriggerDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateTrigger("test_trigger").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateTriggerSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	ctds.NoError(err)
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	md.AssertExpectations(ctds.T())
+}
+
+func (ctds *createTriggerDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateTrigger("test_trigger").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateTriggerSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	ctds.Equal(ee, err)
+	md.AssertExpectations(ctds.T())
+}
+
+func (ctds *createTriggerDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	ctds.NoError(err)
+
+	ds := goqu.New("mock", mDB).CreateTrigger("user_audit").After().OnInsert().On("user").ForEachRow().Execute("audit")
+
+	asql, args, err := ds.Executor().ToSQL()
+	ctds.NoError(err)
+	ctds.Empty(args)
+	ctds.Equal(`CREATE TRIGGER "user_audit" AFTER INSERT ON "user" FOR EACH ROW EXECUTE FUNCTION audit()`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	ctds.NoError(err)
+	ctds.Empty(args)
+	ctds.Equal(`CREATE TRIGGER "user_audit" AFTER INSERT ON "user" FOR EACH ROW EXECUTE FUNCTION audit()`, asql)
+}
+
+func (ctds *createTriggerDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateTrigger("test_trigger").SetDialect(md)
+	ds = ds.SetError(err1)
+	ctds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	ctds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	ctds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	ctds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.After()
+	ctds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	ctds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateTriggerSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	ctds.Empty(sql)
+	ctds.Empty(args)
+	ctds.Equal(err1, err)
+}
+
+func TestCreateTriggerDataset(t *testing.T) {
+	suite.Run(t, new(createTriggerDatasetSuite))
+}
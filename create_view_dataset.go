@@ -0,0 +1,215 @@
+package goqu
+
+import (
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// CreateViewDataset for creating and/or executing CREATE VIEW SQL statements.
+type CreateViewDataset struct {
+	dialect      SQLDialect
+	clauses      exp.CreateViewClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedCreateViewType = errors.New(
+	"unsupported view type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newCreateViewDataset(d string, queryFactory exec.QueryFactory) *CreateViewDataset {
+	return &CreateViewDataset{
+		clauses:      exp.NewCreateViewClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// CreateView creates a CreateViewDataset for a view, use As to set the query of the view.
+//
+//	goqu.CreateView("active_user").As(goqu.From("user").Where(goqu.C("active").IsTrue()))
+func CreateView(view interface{}) *CreateViewDataset {
+	return newCreateViewDataset("default", nil).View(view)
+}
+
+// CreateMaterializedView creates a CreateViewDataset for a materialized view, use As to set the query of the view.
+//
+//	goqu.CreateMaterializedView("user_totals").As(goqu.From("order").Select("user_id", goqu.SUM("total")).GroupBy("user_id"))
+func CreateMaterializedView(view interface{}) *CreateViewDataset {
+	return CreateView(view).Materialized()
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (cvd *CreateViewDataset) WithDialect(dl string) *CreateViewDataset {
+	return cvd.SetDialect(GetDialect(dl))
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (cvd *CreateViewDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the CreateViewDataset.
+func (cvd *CreateViewDataset) Dialect() SQLDialect {
+	return cvd.dialect
+}
+
+// SetDialect returns the current adapter on the CreateViewDataset.
+func (cvd *CreateViewDataset) SetDialect(dialect SQLDialect) *CreateViewDataset {
+	cd := cvd.copy(cvd.GetClauses())
+	cd.dialect = dialect
+	// the query of the view is always rendered with the dialect of the view
+	if sds, ok := cd.clauses.Query().(*SelectDataset); ok {
+		cd.clauses = cd.clauses.SetQuery(sds.SetDialect(dialect))
+	}
+	return cd
+}
+
+// Expression returns CreateViewDataset as exp.Expression.
+func (cvd *CreateViewDataset) Expression() exp.Expression {
+	return cvd
+}
+
+// Clone clones the CreateViewDataset.
+func (cvd *CreateViewDataset) Clone() exp.Expression {
+	return cvd.copy(cvd.clauses)
+}
+
+// GetClauses returns the current clauses on the CreateViewDataset.
+func (cvd *CreateViewDataset) GetClauses() exp.CreateViewClauses {
+	return cvd.clauses
+}
+
+// used internally to copy the dataset.
+func (cvd *CreateViewDataset) copy(clauses exp.CreateViewClauses) *CreateViewDataset {
+	return &CreateViewDataset{
+		dialect:      cvd.dialect,
+		clauses:      clauses,
+		queryFactory: cvd.queryFactory,
+		err:          cvd.err,
+	}
+}
+
+// View sets the view to create. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (cvd *CreateViewDataset) View(view interface{}) *CreateViewDataset {
+	switch t := view.(type) {
+	case exp.Expression:
+		return cvd.copy(cvd.clauses.SetView(t))
+	case string:
+		return cvd.copy(cvd.clauses.SetView(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedCreateViewType)
+	}
+}
+
+// OrReplace replaces the view if it already exists (e.g. CREATE OR REPLACE VIEW, CREATE OR ALTER VIEW in sqlserver).
+func (cvd *CreateViewDataset) OrReplace() *CreateViewDataset {
+	return cvd.copy(cvd.clauses.SetOrReplace(true))
+}
+
+// Temporary creates a TEMPORARY view.
+func (cvd *CreateViewDataset) Temporary() *CreateViewDataset {
+	return cvd.copy(cvd.clauses.SetTemporary(true))
+}
+
+// Materialized creates a MATERIALIZED view, materialized views do not support OrReplace, Temporary or check options.
+func (cvd *CreateViewDataset) Materialized() *CreateViewDataset {
+	return cvd.copy(cvd.clauses.SetMaterialized(true))
+}
+
+// Columns sets the names of the columns of the view.
+func (cvd *CreateViewDataset) Columns(cols ...interface{}) *CreateViewDataset {
+	return cvd.copy(cvd.clauses.SetColumns(exp.NewColumnListExpression(cols...)))
+}
+
+// As sets the query of the view. A SelectDataset is rendered with the dialect of the view, the same as
+// InsertDataset.FromQuery it panics if the SelectDataset has a different dialect that is not the default dialect.
+func (cvd *CreateViewDataset) As(query exp.AppendableExpression) *CreateViewDataset {
+	if sds, ok := query.(*SelectDataset); ok {
+		if sds.dialect != GetDialect("default") && cvd.Dialect() != sds.dialect {
+			panic(
+				fmt.Errorf(
+					"incompatible dialects for CREATE VIEW (%q) and SELECT (%q)",
+					cvd.dialect.Dialect(), sds.dialect.Dialect(),
+				),
+			)
+		}
+		query = sds.SetDialect(cvd.dialect)
+	}
+	return cvd.copy(cvd.clauses.SetQuery(query))
+}
+
+// WithCheckOption adds WITH CHECK OPTION to prevent inserting or updating rows that are not visible through the view.
+func (cvd *CreateViewDataset) WithCheckOption() *CreateViewDataset {
+	return cvd.copy(cvd.clauses.SetCheckOption(exp.DefaultViewCheckOption))
+}
+
+// WithCascadedCheckOption adds WITH CASCADED CHECK OPTION, the check option also applies to the underlying views.
+func (cvd *CreateViewDataset) WithCascadedCheckOption() *CreateViewDataset {
+	return cvd.copy(cvd.clauses.SetCheckOption(exp.CascadedViewCheckOption))
+}
+
+// WithLocalCheckOption adds WITH LOCAL CHECK OPTION, the check option only applies to the view.
+func (cvd *CreateViewDataset) WithLocalCheckOption() *CreateViewDataset {
+	return cvd.copy(cvd.clauses.SetCheckOption(exp.LocalViewCheckOption))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (cvd *CreateViewDataset) Error() error {
+	return cvd.err
+}
+
+// SetError sets an error on the CreateViewDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (cvd *CreateViewDataset) SetError(err error) *CreateViewDataset {
+	if cvd.err == nil {
+		cvd.err = err
+	}
+
+	return cvd
+}
+
+// ToSQL generates a CREATE VIEW sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no view or there is no query
+//   - The dialect does not support a feature of the view (e.g. OR REPLACE, TEMPORARY)
+//   - There is an error generating the SQL
+func (cvd *CreateViewDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return cvd.createViewSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (cvd *CreateViewDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = cvd.createViewSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the CREATE VIEW sql, and returns an Exec struct with the sql set to the CREATE VIEW statement.
+//
+// db.CreateView("test_view").As(db.From("test")).Executor().Exec()
+func (cvd *CreateViewDataset) Executor() exec.QueryExecutor {
+	return cvd.queryFactory.FromSQLBuilder(cvd.createViewSQLBuilder())
+}
+
+func (cvd *CreateViewDataset) createViewSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if cvd.err != nil {
+		return buf.SetError(cvd.err)
+	}
+	cvd.dialect.ToCreateViewSQL(buf, cvd.clauses)
+	return buf
+}
@@ -0,0 +1,258 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createViewTestCase struct {
+		ds      *goqu.CreateViewDataset
+		clauses exp.CreateViewClauses
+	}
+	createViewDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (cvds *createViewDatasetSuite) assertCases(cases ...createViewTestCase) {
+	for _, s := range cases {
+		cvds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (cvds *createViewDatasetSuite) TestClone() {
+	ds := goqu.CreateView("test")
+	cvds.Equal(ds, ds.Clone())
+}
+
+func (cvds *createViewDatasetSuite) TestExpression() {
+	ds := goqu.CreateView("test")
+	cvds.Equal(ds, ds.Expression())
+}
+
+func (cvds *createViewDatasetSuite) TestDialect() {
+	ds := goqu.CreateView("test")
+	cvds.NotNil(ds.Dialect())
+}
+
+func (cvds *createViewDatasetSuite) TestWithDialect() {
+	ds := goqu.CreateView("test")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	cvds.Equal(md, ds.Dialect())
+	cvds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (cvds *createViewDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.CreateView("test")
+	cvds.False(ds.IsPrepared())
+}
+
+func (cvds *createViewDatasetSuite) TestGetClauses() {
+	ds := goqu.CreateView("test")
+	ce := exp.NewCreateViewClauses().SetView(goqu.I("test"))
+	cvds.Equal(ce, ds.GetClauses())
+}
+
+func (cvds *createViewDatasetSuite) TestCreateMaterializedView() {
+	cvds.assertCases(
+		createViewTestCase{
+			ds:      goqu.CreateMaterializedView("test"),
+			clauses: exp.NewCreateViewClauses().SetView(goqu.I("test")).SetMaterialized(true),
+		},
+	)
+}
+
+func (cvds *createViewDatasetSuite) TestView() {
+	bd := goqu.CreateView("test")
+	cvds.assertCases(
+		createViewTestCase{ds: bd.View("test2"), clauses: exp.NewCreateViewClauses().SetView(goqu.I("test2"))},
+		createViewTestCase{
+			ds:      bd.View(goqu.S("s").Table("test2")),
+			clauses: exp.NewCreateViewClauses().SetView(goqu.S("s").Table("test2")),
+		},
+		createViewTestCase{ds: bd, clauses: exp.NewCreateViewClauses().SetView(goqu.I("test"))},
+	)
+	cvds.PanicsWithValue(goqu.ErrUnsupportedCreateViewType, func() {
+		goqu.CreateView(true)
+	})
+}
+
+func (cvds *createViewDatasetSuite) TestOptions() {
+	bd := goqu.CreateView("test")
+	ce := exp.NewCreateViewClauses().SetView(goqu.I("test"))
+	cvds.assertCases(
+		createViewTestCase{ds: bd.OrReplace(), clauses: ce.SetOrReplace(true)},
+		createViewTestCase{ds: bd.Temporary(), clauses: ce.SetTemporary(true)},
+		createViewTestCase{ds: bd.Materialized(), clauses: ce.SetMaterialized(true)},
+		createViewTestCase{ds: bd.Columns("a", "b"), clauses: ce.SetColumns(exp.NewColumnListExpression("a", "b"))},
+		createViewTestCase{ds: bd.WithCheckOption(), clauses: ce.SetCheckOption(exp.DefaultViewCheckOption)},
+		createViewTestCase{
+			ds:      bd.WithCascadedCheckOption(),
+			clauses: ce.SetCheckOption(exp.CascadedViewCheckOption),
+		},
+		createViewTestCase{ds: bd.WithLocalCheckOption(), clauses: ce.SetCheckOption(exp.LocalViewCheckOption)},
+		createViewTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (cvds *createViewDatasetSuite) TestAs() {
+	bd := goqu.CreateView("test")
+	q := goqu.From("test2").Where(goqu.C("a").Eq(1))
+	cvds.assertCases(
+		createViewTestCase{ds: bd.As(q), clauses: bd.GetClauses().SetQuery(q)},
+		createViewTestCase{ds: bd, clauses: exp.NewCreateViewClauses().SetView(goqu.I("test"))},
+	)
+}
+
+func (cvds *createViewDatasetSuite) TestAsDialectInheritance() {
+	md := new(mocks.SQLDialect)
+	md.On("Dialect").Return("dialect")
+
+	cvds.Run("ok, default dialect is replaced with view dialect", func() {
+		bd := goqu.CreateView("test").SetDialect(md).As(goqu.From("test2"))
+		cvds.Require().Equal(md, bd.GetClauses().Query().(*goqu.SelectDataset).Dialect())
+	})
+
+	cvds.Run("ok, view and select dialects coincide", func() {
+		bd := goqu.CreateView("test").SetDialect(md).As(goqu.From("test2").SetDialect(md))
+		cvds.Require().Equal(md, bd.GetClauses().Query().(*goqu.SelectDataset).Dialect())
+	})
+
+	cvds.Run("ok, view dialect is set after the query", func() {
+		bd := goqu.CreateView("test").As(goqu.From("test2")).SetDialect(md)
+		cvds.Require().Equal(md, bd.GetClauses().Query().(*goqu.SelectDataset).Dialect())
+	})
+
+	cvds.Run("panic, view and select dialects are different", func() {
+		defer func() {
+			r := recover()
+			if r == nil {
+				cvds.Fail("there should be a panic")
+			}
+			cvds.Require().Equal(
+				"incompatible dialects for CREATE VIEW (\"dialect\") and SELECT (\"other_dialect\")",
+				r.(error).Error(),
+			)
+		}()
+
+		otherDialect := new(mocks.SQLDialect)
+		otherDialect.On("Dialect").Return("other_dialect")
+		goqu.CreateView("test").SetDialect(md).As(goqu.From("test2").SetDialect(otherDialect))
+	})
+}
+
+func (cvds *createViewDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateView("test").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateViewSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	cvds.NoError(err)
+	cvds.Empty(sql)
+	cvds.Empty(args)
+	md.AssertExpectations(cvds.T())
+}
+
+func (cvds *createViewDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateView("test").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateViewSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	cvds.Empty(sql)
+	cvds.Empty(args)
+	cvds.Equal(ee, err)
+	md.AssertExpectations(cvds.T())
+}
+
+func (cvds *createViewDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	cvds.NoError(err)
+
+	ds := goqu.New("mock", mDB).CreateView("test").As(goqu.From("test2").Where(goqu.C("a").Eq("b")))
+
+	asql, args, err := ds.Executor().ToSQL()
+	cvds.NoError(err)
+	cvds.Empty(args)
+	cvds.Equal(`CREATE VIEW "test" AS SELECT * FROM "test2" WHERE ("a" = 'b')`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	cvds.NoError(err)
+	cvds.Empty(args)
+	cvds.Equal(`CREATE VIEW "test" AS SELECT * FROM "test2" WHERE ("a" = 'b')`, asql)
+}
+
+func (cvds *createViewDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.CreateView("test").SetDialect(md)
+	ds = ds.SetError(err1)
+	cvds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	cvds.Empty(sql)
+	cvds.Empty(args)
+	cvds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	cvds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cvds.Empty(sql)
+	cvds.Empty(args)
+	cvds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.OrReplace()
+	cvds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	cvds.Empty(sql)
+	cvds.Empty(args)
+	cvds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToCreateViewSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	cvds.Empty(sql)
+	cvds.Empty(args)
+	cvds.Equal(err1, err)
+}
+
+func TestCreateViewDataset(t *testing.T) {
+	suite.Run(t, new(createViewDatasetSuite))
+}
@@ -0,0 +1,187 @@
+package goqu
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+// Cursor streams the results of a SelectDataset in batches using a server-side cursor (DECLARE/FETCH/CLOSE).
+// A cursor can only be used inside of a transaction and is only supported by dialects that set SupportsCursors
+// (e.g. postgres).
+type Cursor struct {
+	td        *TxDatabase
+	name      string
+	ds        *SelectDataset
+	fetchSize uint
+}
+
+// DefaultCursorFetchSize is the number of rows fetched per batch when using a Cursor
+const DefaultCursorFetchSize = 1000
+
+var errInvalidCursorFetchSize = errors.New("cursor fetch size must be greater than 0")
+
+func errCursorsNotSupported(dialect string) error {
+	return errors.New("dialect does not support cursors [dialect=%s]", dialect)
+}
+
+// Cursor creates a new Cursor that will stream the results of the dataset in batches of DefaultCursorFetchSize rows.
+// The cursor is not declared until Cursor#Open is called.
+//    cur := tx.Cursor("users_cur", tx.From("users").Order(goqu.C("id").Asc())).WithFetchSize(500)
+//    if err := cur.Open(); err != nil {
+//        return err
+//    }
+//    defer cur.Close()
+//    for {
+//        var users []User
+//        found, err := cur.FetchStructs(&users)
+//        if err != nil {
+//            return err
+//        }
+//        if !found {
+//            break
+//        }
+//        // process the batch of users
+//    }
+func (td *TxDatabase) Cursor(name string, ds *SelectDataset) *Cursor {
+	return &Cursor{td: td, name: name, ds: ds, fetchSize: DefaultCursorFetchSize}
+}
+
+// WithFetchSize returns a copy of the Cursor that fetches size rows per batch. (DEFAULT=DefaultCursorFetchSize)
+func (c *Cursor) WithFetchSize(size uint) *Cursor {
+	ret := *c
+	ret.fetchSize = size
+	return &ret
+}
+
+// DeclareSQL returns the sql used to declare the cursor
+//    DECLARE "users_cur" CURSOR FOR SELECT * FROM "users"
+func (c *Cursor) DeclareSQL() (sql string, args []interface{}, err error) {
+	b := sb.NewSQLBuilder(false)
+	b.WriteStrings("DECLARE ")
+	c.nameSQL(b)
+	b.WriteStrings(" CURSOR FOR ")
+	query, args, err := c.ds.ToSQL()
+	if err != nil {
+		return "", nil, err
+	}
+	b.WriteStrings(query)
+	sql, _, err = b.ToSQL()
+	if err != nil {
+		return "", nil, err
+	}
+	return sql, args, nil
+}
+
+// FetchSQL returns the sql used to fetch the next batch of rows from the cursor
+//    FETCH FORWARD 1000 FROM "users_cur"
+func (c *Cursor) FetchSQL() (sql string, args []interface{}, err error) {
+	if c.fetchSize == 0 {
+		return "", nil, errInvalidCursorFetchSize
+	}
+	b := sb.NewSQLBuilder(false)
+	b.WriteStrings("FETCH FORWARD ", strconv.FormatUint(uint64(c.fetchSize), 10), " FROM ")
+	c.nameSQL(b)
+	return b.ToSQL()
+}
+
+// CloseSQL returns the sql used to close the cursor
+//    CLOSE "users_cur"
+func (c *Cursor) CloseSQL() (sql string, args []interface{}, err error) {
+	b := sb.NewSQLBuilder(false)
+	b.WriteStrings("CLOSE ")
+	c.nameSQL(b)
+	return b.ToSQL()
+}
+
+func (c *Cursor) nameSQL(b sb.SQLBuilder) {
+	opts := getDialectOptions(c.td.dialect)
+	if !opts.SupportsCursors {
+		b.SetError(errCursorsNotSupported(c.td.dialect))
+		return
+	}
+	sqlgen.NewExpressionSQLGenerator(c.td.dialect, opts).Generate(b, I(c.name))
+}
+
+// Open declares the cursor.
+func (c *Cursor) Open() error {
+	return c.OpenContext(context.Background())
+}
+
+// OpenContext see Cursor#Open
+func (c *Cursor) OpenContext(ctx context.Context) error {
+	query, args, err := c.DeclareSQL()
+	if err != nil {
+		return err
+	}
+	_, err = c.td.ExecContext(ctx, query, args...)
+	return err
+}
+
+// FetchStructs fetches the next batch of rows from the cursor into a slice of structs. The slice is reset before
+// scanning, false is returned once the cursor is exhausted.
+func (c *Cursor) FetchStructs(i interface{}) (bool, error) {
+	return c.FetchStructsContext(context.Background(), i)
+}
+
+// FetchStructsContext see Cursor#FetchStructs
+func (c *Cursor) FetchStructsContext(ctx context.Context, i interface{}) (bool, error) {
+	q, err := c.fetch(i)
+	if err != nil {
+		return false, err
+	}
+	if err := q.ScanStructsContext(ctx, i); err != nil {
+		return false, err
+	}
+	return reflect.Indirect(reflect.ValueOf(i)).Len() > 0, nil
+}
+
+// FetchVals fetches the first column of the next batch of rows from the cursor into a slice of primitive values. The
+// slice is reset before scanning, false is returned once the cursor is exhausted.
+func (c *Cursor) FetchVals(i interface{}) (bool, error) {
+	return c.FetchValsContext(context.Background(), i)
+}
+
+// FetchValsContext see Cursor#FetchVals
+func (c *Cursor) FetchValsContext(ctx context.Context, i interface{}) (bool, error) {
+	q, err := c.fetch(i)
+	if err != nil {
+		return false, err
+	}
+	if err := q.ScanValsContext(ctx, i); err != nil {
+		return false, err
+	}
+	return reflect.Indirect(reflect.ValueOf(i)).Len() > 0, nil
+}
+
+// resets the slice so each batch only contains the fetched rows and returns the executor for the FETCH
+func (c *Cursor) fetch(i interface{}) (exec.QueryExecutor, error) {
+	query, args, err := c.FetchSQL()
+	if err != nil {
+		return exec.QueryExecutor{}, err
+	}
+	if v := reflect.ValueOf(i); v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Slice {
+		v.Elem().SetLen(0)
+	}
+	return c.td.queryFactory().FromSQL(query, args...), nil
+}
+
+// Close closes the cursor, cursors are also closed when the transaction ends.
+func (c *Cursor) Close() error {
+	return c.CloseContext(context.Background())
+}
+
+// CloseContext see Cursor#Close
+func (c *Cursor) CloseContext(ctx context.Context) error {
+	query, args, err := c.CloseSQL()
+	if err != nil {
+		return err
+	}
+	_, err = c.td.ExecContext(ctx, query, args...)
+	return err
+}
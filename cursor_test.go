@@ -0,0 +1,143 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/stretchr/testify/suite"
+)
+
+type cursorSuite struct {
+	suite.Suite
+}
+
+func TestCursorSuite(t *testing.T) {
+	suite.Run(t, new(cursorSuite))
+}
+
+func (cs *cursorSuite) newTx() (*goqu.TxDatabase, sqlmock.Sqlmock) {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	cs.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	tx, err := goqu.New("postgres", mDB).Begin()
+	cs.Require().NoError(err)
+	return tx, sqlMock
+}
+
+func (cs *cursorSuite) TestSQL() {
+	tx, _ := cs.newTx()
+	ds := tx.From("items").Where(goqu.C("id").Gt(10))
+	cur := tx.Cursor("items_cur", ds)
+
+	query, args, err := cur.DeclareSQL()
+	cs.NoError(err)
+	cs.Empty(args)
+	cs.Equal(`DECLARE "items_cur" CURSOR FOR SELECT * FROM "items" WHERE ("id" > 10)`, query)
+
+	query, args, err = tx.Cursor("items_cur", ds.Prepared(true)).DeclareSQL()
+	cs.NoError(err)
+	cs.Equal([]interface{}{int64(10)}, args)
+	cs.Equal(`DECLARE "items_cur" CURSOR FOR SELECT * FROM "items" WHERE ("id" > $1)`, query)
+
+	query, args, err = cur.FetchSQL()
+	cs.NoError(err)
+	cs.Empty(args)
+	cs.Equal(`FETCH FORWARD 1000 FROM "items_cur"`, query)
+
+	query, _, err = cur.WithFetchSize(50).FetchSQL()
+	cs.NoError(err)
+	cs.Equal(`FETCH FORWARD 50 FROM "items_cur"`, query)
+
+	_, _, err = cur.WithFetchSize(0).FetchSQL()
+	cs.EqualError(err, "goqu: cursor fetch size must be greater than 0")
+
+	query, args, err = cur.CloseSQL()
+	cs.NoError(err)
+	cs.Empty(args)
+	cs.Equal(`CLOSE "items_cur"`, query)
+}
+
+func (cs *cursorSuite) TestSQL_unsupportedDialect() {
+	mDB, sqlMock, err := sqlmock.New()
+	cs.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	tx, err := goqu.New("default", mDB).Begin()
+	cs.Require().NoError(err)
+
+	cur := tx.Cursor("items_cur", tx.From("items"))
+	expectedErr := "goqu: dialect does not support cursors [dialect=default]"
+	_, _, err = cur.DeclareSQL()
+	cs.EqualError(err, expectedErr)
+	_, _, err = cur.FetchSQL()
+	cs.EqualError(err, expectedErr)
+	_, _, err = cur.CloseSQL()
+	cs.EqualError(err, expectedErr)
+	cs.EqualError(cur.Open(), expectedErr)
+}
+
+func (cs *cursorSuite) TestFetchStructs() {
+	type item struct {
+		ID   int64  `db:"id"`
+		Name string `db:"name"`
+	}
+	tx, sqlMock := cs.newTx()
+	sqlMock.ExpectExec(`DECLARE "items_cur" CURSOR FOR SELECT "id", "name" FROM "items"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectQuery(`FETCH FORWARD 2 FROM "items_cur"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).FromCSVString("1,a\n2,b"))
+	sqlMock.ExpectQuery(`FETCH FORWARD 2 FROM "items_cur"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).FromCSVString("3,c"))
+	sqlMock.ExpectQuery(`FETCH FORWARD 2 FROM "items_cur"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	sqlMock.ExpectExec(`CLOSE "items_cur"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	cur := tx.Cursor("items_cur", tx.From("items").Select("id", "name")).WithFetchSize(2)
+	cs.NoError(cur.Open())
+
+	var items []item
+	found, err := cur.FetchStructs(&items)
+	cs.NoError(err)
+	cs.True(found)
+	cs.Equal([]item{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}, items)
+
+	found, err = cur.FetchStructs(&items)
+	cs.NoError(err)
+	cs.True(found)
+	cs.Equal([]item{{ID: 3, Name: "c"}}, items)
+
+	found, err = cur.FetchStructs(&items)
+	cs.NoError(err)
+	cs.False(found)
+	cs.Empty(items)
+
+	cs.NoError(cur.Close())
+	cs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (cs *cursorSuite) TestFetchVals() {
+	tx, sqlMock := cs.newTx()
+	sqlMock.ExpectExec(`DECLARE "ids" CURSOR FOR SELECT "id" FROM "items"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectQuery(`FETCH FORWARD 1000 FROM "ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).FromCSVString("1\n2"))
+	sqlMock.ExpectQuery(`FETCH FORWARD 1000 FROM "ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	cur := tx.Cursor("ids", tx.From("items").Select("id"))
+	cs.NoError(cur.Open())
+
+	var ids []int64
+	found, err := cur.FetchVals(&ids)
+	cs.NoError(err)
+	cs.True(found)
+	cs.Equal([]int64{1, 2}, ids)
+
+	found, err = cur.FetchVals(&ids)
+	cs.NoError(err)
+	cs.False(found)
+	cs.Empty(ids)
+	cs.NoError(sqlMock.ExpectationsWereMet())
+}
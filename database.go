@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
 )
 
 type (
@@ -136,10 +137,162 @@ func (d *Database) Delete(table interface{}) *DeleteDataset {
 	return newDeleteDataset(d.dialect, d.queryFactory()).From(table)
 }
 
+func (d *Database) Merge(target interface{}) *MergeDataset {
+	return newMergeDataset(d.dialect, d.queryFactory()).Target(target)
+}
+
+func (d *Database) Call(procedure interface{}, args ...interface{}) *CallDataset {
+	return newCallDataset(d.dialect, d.queryFactory()).Procedure(procedure).Args(args...)
+}
+
+func (d *Database) CopyFrom(table interface{}) *CopyDataset {
+	return newCopyDataset(d.dialect).From(table)
+}
+
+func (d *Database) CopyTo(source interface{}) *CopyDataset {
+	return newCopyDataset(d.dialect).To(source)
+}
+
+func (d *Database) SetParam(param interface{}, values ...interface{}) *SetParamDataset {
+	return newSetParamDataset(d.dialect, d.queryFactory()).Param(param).Values(values...)
+}
+
+func (d *Database) Show(param interface{}) *ShowDataset {
+	return newShowDataset(d.dialect, d.queryFactory()).Param(param)
+}
+
+func (d *Database) Notify(channel, payload string) *NotifyStatement {
+	return newNotifyStatement(d.dialect, d.queryFactory(), channel, payload)
+}
+
+func (d *Database) Vacuum(tables ...interface{}) *MaintenanceDataset {
+	return newMaintenanceDataset(d.dialect, d.queryFactory()).Operation(exp.VacuumOperation).Table(tables...)
+}
+
+func (d *Database) Analyze(tables ...interface{}) *MaintenanceDataset {
+	return newMaintenanceDataset(d.dialect, d.queryFactory()).Operation(exp.AnalyzeOperation).Table(tables...)
+}
+
+func (d *Database) Optimize(tables ...interface{}) *MaintenanceDataset {
+	return newMaintenanceDataset(d.dialect, d.queryFactory()).Operation(exp.OptimizeOperation).Table(tables...)
+}
+
 func (d *Database) Truncate(table ...interface{}) *TruncateDataset {
 	return newTruncateDataset(d.dialect, d.queryFactory()).Table(table...)
 }
 
+func (d *Database) CreateTable(table interface{}) *CreateTableDataset {
+	return newCreateTableDataset(d.dialect, d.queryFactory()).Table(table)
+}
+
+func (d *Database) TableFromStruct(i interface{}) *CreateTableDataset {
+	return tableFromStruct(newCreateTableDataset(d.dialect, d.queryFactory()), i)
+}
+
+func (d *Database) AlterTable(table interface{}) *AlterTableDataset {
+	return newAlterTableDataset(d.dialect, d.queryFactory()).Table(table)
+}
+
+func (d *Database) CreateIndex(name string) *CreateIndexDataset {
+	return newCreateIndexDataset(d.dialect, d.queryFactory()).Name(name)
+}
+
+func (d *Database) IndexesFromStruct(i interface{}) ([]*CreateIndexDataset, error) {
+	return indexesFromStruct(i, d.CreateIndex)
+}
+
+func (d *Database) CreateView(view interface{}) *CreateViewDataset {
+	return newCreateViewDataset(d.dialect, d.queryFactory()).View(view)
+}
+
+func (d *Database) CreateMaterializedView(view interface{}) *CreateViewDataset {
+	return newCreateViewDataset(d.dialect, d.queryFactory()).View(view).Materialized()
+}
+
+func (d *Database) RefreshMaterializedView(view interface{}) *RefreshDataset {
+	return newRefreshDataset(d.dialect, d.queryFactory()).View(view)
+}
+
+func (d *Database) CreateSequence(sequence interface{}) *CreateSequenceDataset {
+	return newCreateSequenceDataset(d.dialect, d.queryFactory()).Sequence(sequence)
+}
+
+func (d *Database) AlterSequence(sequence interface{}) *AlterSequenceDataset {
+	return newAlterSequenceDataset(d.dialect, d.queryFactory()).Sequence(sequence)
+}
+
+func (d *Database) CreateSchema(schema interface{}) *CreateSchemaDataset {
+	return newCreateSchemaDataset(d.dialect, d.queryFactory()).Name(schema)
+}
+
+func (d *Database) CreateDatabase(database interface{}) *CreateSchemaDataset {
+	return newCreateSchemaDataset(d.dialect, d.queryFactory()).Name(database).Database()
+}
+
+func (d *Database) CommentOnTable(table interface{}) *CommentDataset {
+	return newCommentDataset(d.dialect, d.queryFactory()).object(exp.TableCommentObject, table)
+}
+
+func (d *Database) CommentOnColumn(column interface{}) *CommentDataset {
+	return newCommentDataset(d.dialect, d.queryFactory()).object(exp.ColumnCommentObject, column)
+}
+
+func (d *Database) CommentOnView(view interface{}) *CommentDataset {
+	return newCommentDataset(d.dialect, d.queryFactory()).object(exp.ViewCommentObject, view)
+}
+
+func (d *Database) Grant(privileges ...string) *GrantDataset {
+	return newGrantDataset(d.dialect, d.queryFactory()).Privileges(privileges...)
+}
+
+func (d *Database) Revoke(privileges ...string) *GrantDataset {
+	return newGrantDataset(d.dialect, d.queryFactory()).revoke().Privileges(privileges...)
+}
+
+func (d *Database) CreateTrigger(trigger interface{}) *CreateTriggerDataset {
+	return newCreateTriggerDataset(d.dialect, d.queryFactory()).Trigger(trigger)
+}
+
+func (d *Database) CreateFunction(function interface{}) *CreateFunctionDataset {
+	return newCreateFunctionDataset(d.dialect, d.queryFactory()).Function(function)
+}
+
+func (d *Database) DropTable(tables ...interface{}) *DropDataset {
+	return newDropDataset(d.dialect, d.queryFactory()).objectNames(exp.TableDropObject, tables...)
+}
+
+func (d *Database) DropView(views ...interface{}) *DropDataset {
+	return newDropDataset(d.dialect, d.queryFactory()).objectNames(exp.ViewDropObject, views...)
+}
+
+func (d *Database) DropMaterializedView(views ...interface{}) *DropDataset {
+	return newDropDataset(d.dialect, d.queryFactory()).objectNames(exp.MaterializedViewDropObject, views...)
+}
+
+func (d *Database) DropSequence(sequences ...interface{}) *DropDataset {
+	return newDropDataset(d.dialect, d.queryFactory()).objectNames(exp.SequenceDropObject, sequences...)
+}
+
+func (d *Database) DropSchema(schemas ...interface{}) *DropDataset {
+	return newDropDataset(d.dialect, d.queryFactory()).objectNames(exp.SchemaDropObject, schemas...)
+}
+
+func (d *Database) DropDatabase(databases ...interface{}) *DropDataset {
+	return newDropDataset(d.dialect, d.queryFactory()).objectNames(exp.DatabaseDropObject, databases...)
+}
+
+func (d *Database) DropTrigger(triggers ...interface{}) *DropDataset {
+	return newDropDataset(d.dialect, d.queryFactory()).objectNames(exp.TriggerDropObject, triggers...)
+}
+
+func (d *Database) DropFunction(functions ...interface{}) *DropDataset {
+	return newDropDataset(d.dialect, d.queryFactory()).objectNames(exp.FunctionDropObject, functions...)
+}
+
+func (d *Database) DropIndex(names ...interface{}) *DropDataset {
+	return newDropDataset(d.dialect, d.queryFactory()).objectNames(exp.IndexDropObject, names...)
+}
+
 // Sets the logger for to use when logging queries
 func (d *Database) Logger(logger Logger) {
 	d.logger = logger
@@ -487,10 +640,162 @@ func (td *TxDatabase) Delete(table interface{}) *DeleteDataset {
 	return newDeleteDataset(td.dialect, td.queryFactory()).From(table)
 }
 
+func (td *TxDatabase) Merge(target interface{}) *MergeDataset {
+	return newMergeDataset(td.dialect, td.queryFactory()).Target(target)
+}
+
+func (td *TxDatabase) Call(procedure interface{}, args ...interface{}) *CallDataset {
+	return newCallDataset(td.dialect, td.queryFactory()).Procedure(procedure).Args(args...)
+}
+
+func (td *TxDatabase) CopyFrom(table interface{}) *CopyDataset {
+	return newCopyDataset(td.dialect).From(table)
+}
+
+func (td *TxDatabase) CopyTo(source interface{}) *CopyDataset {
+	return newCopyDataset(td.dialect).To(source)
+}
+
+func (td *TxDatabase) SetParam(param interface{}, values ...interface{}) *SetParamDataset {
+	return newSetParamDataset(td.dialect, td.queryFactory()).Param(param).Values(values...)
+}
+
+func (td *TxDatabase) Show(param interface{}) *ShowDataset {
+	return newShowDataset(td.dialect, td.queryFactory()).Param(param)
+}
+
+func (td *TxDatabase) Notify(channel, payload string) *NotifyStatement {
+	return newNotifyStatement(td.dialect, td.queryFactory(), channel, payload)
+}
+
+func (td *TxDatabase) Vacuum(tables ...interface{}) *MaintenanceDataset {
+	return newMaintenanceDataset(td.dialect, td.queryFactory()).Operation(exp.VacuumOperation).Table(tables...)
+}
+
+func (td *TxDatabase) Analyze(tables ...interface{}) *MaintenanceDataset {
+	return newMaintenanceDataset(td.dialect, td.queryFactory()).Operation(exp.AnalyzeOperation).Table(tables...)
+}
+
+func (td *TxDatabase) Optimize(tables ...interface{}) *MaintenanceDataset {
+	return newMaintenanceDataset(td.dialect, td.queryFactory()).Operation(exp.OptimizeOperation).Table(tables...)
+}
+
 func (td *TxDatabase) Truncate(table ...interface{}) *TruncateDataset {
 	return newTruncateDataset(td.dialect, td.queryFactory()).Table(table...)
 }
 
+func (td *TxDatabase) CreateTable(table interface{}) *CreateTableDataset {
+	return newCreateTableDataset(td.dialect, td.queryFactory()).Table(table)
+}
+
+func (td *TxDatabase) TableFromStruct(i interface{}) *CreateTableDataset {
+	return tableFromStruct(newCreateTableDataset(td.dialect, td.queryFactory()), i)
+}
+
+func (td *TxDatabase) AlterTable(table interface{}) *AlterTableDataset {
+	return newAlterTableDataset(td.dialect, td.queryFactory()).Table(table)
+}
+
+func (td *TxDatabase) CreateIndex(name string) *CreateIndexDataset {
+	return newCreateIndexDataset(td.dialect, td.queryFactory()).Name(name)
+}
+
+func (td *TxDatabase) IndexesFromStruct(i interface{}) ([]*CreateIndexDataset, error) {
+	return indexesFromStruct(i, td.CreateIndex)
+}
+
+func (td *TxDatabase) CreateView(view interface{}) *CreateViewDataset {
+	return newCreateViewDataset(td.dialect, td.queryFactory()).View(view)
+}
+
+func (td *TxDatabase) CreateMaterializedView(view interface{}) *CreateViewDataset {
+	return newCreateViewDataset(td.dialect, td.queryFactory()).View(view).Materialized()
+}
+
+func (td *TxDatabase) RefreshMaterializedView(view interface{}) *RefreshDataset {
+	return newRefreshDataset(td.dialect, td.queryFactory()).View(view)
+}
+
+func (td *TxDatabase) CreateSequence(sequence interface{}) *CreateSequenceDataset {
+	return newCreateSequenceDataset(td.dialect, td.queryFactory()).Sequence(sequence)
+}
+
+func (td *TxDatabase) AlterSequence(sequence interface{}) *AlterSequenceDataset {
+	return newAlterSequenceDataset(td.dialect, td.queryFactory()).Sequence(sequence)
+}
+
+func (td *TxDatabase) CreateSchema(schema interface{}) *CreateSchemaDataset {
+	return newCreateSchemaDataset(td.dialect, td.queryFactory()).Name(schema)
+}
+
+func (td *TxDatabase) CreateDatabase(database interface{}) *CreateSchemaDataset {
+	return newCreateSchemaDataset(td.dialect, td.queryFactory()).Name(database).Database()
+}
+
+func (td *TxDatabase) CommentOnTable(table interface{}) *CommentDataset {
+	return newCommentDataset(td.dialect, td.queryFactory()).object(exp.TableCommentObject, table)
+}
+
+func (td *TxDatabase) CommentOnColumn(column interface{}) *CommentDataset {
+	return newCommentDataset(td.dialect, td.queryFactory()).object(exp.ColumnCommentObject, column)
+}
+
+func (td *TxDatabase) CommentOnView(view interface{}) *CommentDataset {
+	return newCommentDataset(td.dialect, td.queryFactory()).object(exp.ViewCommentObject, view)
+}
+
+func (td *TxDatabase) Grant(privileges ...string) *GrantDataset {
+	return newGrantDataset(td.dialect, td.queryFactory()).Privileges(privileges...)
+}
+
+func (td *TxDatabase) Revoke(privileges ...string) *GrantDataset {
+	return newGrantDataset(td.dialect, td.queryFactory()).revoke().Privileges(privileges...)
+}
+
+func (td *TxDatabase) CreateTrigger(trigger interface{}) *CreateTriggerDataset {
+	return newCreateTriggerDataset(td.dialect, td.queryFactory()).Trigger(trigger)
+}
+
+func (td *TxDatabase) CreateFunction(function interface{}) *CreateFunctionDataset {
+	return newCreateFunctionDataset(td.dialect, td.queryFactory()).Function(function)
+}
+
+func (td *TxDatabase) DropTable(tables ...interface{}) *DropDataset {
+	return newDropDataset(td.dialect, td.queryFactory()).objectNames(exp.TableDropObject, tables...)
+}
+
+func (td *TxDatabase) DropView(views ...interface{}) *DropDataset {
+	return newDropDataset(td.dialect, td.queryFactory()).objectNames(exp.ViewDropObject, views...)
+}
+
+func (td *TxDatabase) DropMaterializedView(views ...interface{}) *DropDataset {
+	return newDropDataset(td.dialect, td.queryFactory()).objectNames(exp.MaterializedViewDropObject, views...)
+}
+
+func (td *TxDatabase) DropSequence(sequences ...interface{}) *DropDataset {
+	return newDropDataset(td.dialect, td.queryFactory()).objectNames(exp.SequenceDropObject, sequences...)
+}
+
+func (td *TxDatabase) DropSchema(schemas ...interface{}) *DropDataset {
+	return newDropDataset(td.dialect, td.queryFactory()).objectNames(exp.SchemaDropObject, schemas...)
+}
+
+func (td *TxDatabase) DropDatabase(databases ...interface{}) *DropDataset {
+	return newDropDataset(td.dialect, td.queryFactory()).objectNames(exp.DatabaseDropObject, databases...)
+}
+
+func (td *TxDatabase) DropTrigger(triggers ...interface{}) *DropDataset {
+	return newDropDataset(td.dialect, td.queryFactory()).objectNames(exp.TriggerDropObject, triggers...)
+}
+
+func (td *TxDatabase) DropFunction(functions ...interface{}) *DropDataset {
+	return newDropDataset(td.dialect, td.queryFactory()).objectNames(exp.FunctionDropObject, functions...)
+}
+
+func (td *TxDatabase) DropIndex(names ...interface{}) *DropDataset {
+	return newDropDataset(td.dialect, td.queryFactory()).objectNames(exp.IndexDropObject, names...)
+}
+
 // Sets the logger
 func (td *TxDatabase) Logger(logger Logger) {
 	td.logger = logger
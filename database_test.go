@@ -60,6 +60,27 @@ func (ds *databaseSuite) TestLogger() {
 	}, logger.Messages)
 }
 
+func (ds *databaseSuite) TestLogger_Secret() {
+	mDB, mock, err := sqlmock.New()
+	ds.NoError(err)
+	mock.ExpectExec(`UPDATE "items" SET "password"=\? WHERE \("id" = 1\)`).
+		WithArgs("my-password").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	db := goqu.New("db-mock", mDB)
+	logger := new(dbTestMockLogger)
+	db.Logger(logger)
+	_, err = db.Update("items").
+		Set(goqu.Record{"password": goqu.Secret("my-password")}).
+		Where(goqu.C("id").Eq(1)).
+		Executor().
+		Exec()
+	ds.NoError(err)
+	ds.Equal([]string{
+		"[goqu] EXEC [query:=`UPDATE \"items\" SET \"password\"=? WHERE (\"id\" = 1)` args:=[[REDACTED]]]",
+	}, logger.Messages)
+}
+
 func (ds *databaseSuite) TestScanStructs() {
 	mDB, mock, err := sqlmock.New()
 	ds.NoError(err)
@@ -0,0 +1,143 @@
+package goqu
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+)
+
+type (
+	// DeleteBatchProgress is called after each chunk is deleted with the number of rows deleted by the chunk and the
+	// total number of rows deleted so far.
+	DeleteBatchProgress func(deleted, total int64)
+
+	// DeleteBatch deletes the rows matched by a DeleteDataset in bounded chunks so a large delete does not hold locks
+	// on every row in a single statement. Chunks are executed until a chunk deletes fewer rows than the chunk size.
+	//
+	// Dialects that support LIMIT on DELETE (e.g. mysql) execute the DELETE with a LIMIT, all other dialects delete
+	// the rows whose key is in a sub select of the next chunk of keys
+	//    DELETE FROM "items" WHERE (("expired" IS TRUE) AND ("id" IN (SELECT "id" FROM "items" WHERE ("expired" IS TRUE) LIMIT 1000)))
+	DeleteBatch struct {
+		ds        *DeleteDataset
+		key       exp.IdentifierExpression
+		chunkSize uint
+		progress  DeleteBatchProgress
+	}
+)
+
+// DefaultDeleteChunkSize is the number of rows deleted per statement when using DeleteDataset#InChunks
+const DefaultDeleteChunkSize = 1000
+
+var (
+	errInvalidDeleteChunkSize = errors.New("delete chunk size must be greater than 0")
+	errDeleteChunkKeyRequired = errors.New("a key column is required when deleting in chunks")
+)
+
+// InChunks creates a DeleteBatch that deletes the rows matched by this DeleteDataset in chunks of
+// DefaultDeleteChunkSize rows, the key should be a column that uniquely identifies a row (e.g. the primary key).
+//    deleted, err := db.Delete("items").Where(goqu.C("expired").IsTrue()).
+//        InChunks("id").
+//        WithChunkSize(500).
+//        WithProgress(func(deleted, total int64) {
+//            log.Printf("deleted %d rows (%d total)", deleted, total)
+//        }).
+//        Exec()
+func (dd *DeleteDataset) InChunks(key string) *DeleteBatch {
+	var ident exp.IdentifierExpression
+	if key != "" {
+		ident = I(key)
+	}
+	return &DeleteBatch{ds: dd, key: ident, chunkSize: DefaultDeleteChunkSize}
+}
+
+func (bd *DeleteBatch) copy() *DeleteBatch {
+	ret := *bd
+	return &ret
+}
+
+// WithChunkSize sets the maximum number of rows deleted per statement. (DEFAULT=DefaultDeleteChunkSize)
+func (bd *DeleteBatch) WithChunkSize(size uint) *DeleteBatch {
+	ret := bd.copy()
+	ret.chunkSize = size
+	return ret
+}
+
+// WithProgress sets a function that is called after each chunk is deleted.
+func (bd *DeleteBatch) WithProgress(progress DeleteBatchProgress) *DeleteBatch {
+	ret := bd.copy()
+	ret.progress = progress
+	return ret
+}
+
+// ChunkDataset returns the DeleteDataset executed for each chunk.
+func (bd *DeleteBatch) ChunkDataset() (*DeleteDataset, error) {
+	if bd.chunkSize == 0 {
+		return nil, errInvalidDeleteChunkSize
+	}
+	if bd.key == nil {
+		return nil, errDeleteChunkKeyRequired
+	}
+	if getDialectOptions(bd.ds.Dialect().Dialect()).SupportsLimitOnDelete {
+		return bd.ds.Limit(bd.chunkSize), nil
+	}
+	clauses := bd.ds.GetClauses()
+	keys := newDataset(bd.ds.Dialect().Dialect(), nil).
+		From(clauses.From()).
+		Select(bd.key).
+		Limit(bd.chunkSize)
+	if where := clauses.Where(); where != nil {
+		keys = keys.Where(where)
+	}
+	if order := clauses.Order(); order != nil {
+		keys = keys.Order(orderedExpressions(order)...)
+	}
+	return bd.ds.ClearOrder().ClearLimit().Where(exp.NewBooleanExpression(exp.InOp, bd.key, keys)), nil
+}
+
+func orderedExpressions(order exp.ColumnListExpression) []exp.OrderedExpression {
+	oes := make([]exp.OrderedExpression, 0, len(order.Columns()))
+	for _, o := range order.Columns() {
+		if oe, ok := o.(exp.OrderedExpression); ok {
+			oes = append(oes, oe)
+		}
+	}
+	return oes
+}
+
+// Exec deletes the rows in chunks and returns the total number of rows deleted.
+func (bd *DeleteBatch) Exec() (int64, error) {
+	return bd.ExecContext(context.Background())
+}
+
+// ExecContext see DeleteBatch#Exec
+func (bd *DeleteBatch) ExecContext(ctx context.Context) (int64, error) {
+	ds, err := bd.ChunkDataset()
+	if err != nil {
+		return 0, err
+	}
+	if ds.queryFactory == nil {
+		return 0, ErrQueryFactoryNotFoundError
+	}
+	var total int64
+	for {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return total, ctxErr
+		}
+		res, execErr := ds.Executor().ExecContext(ctx)
+		if execErr != nil {
+			return total, execErr
+		}
+		deleted, affectedErr := res.RowsAffected()
+		if affectedErr != nil {
+			return total, affectedErr
+		}
+		total += deleted
+		if bd.progress != nil {
+			bd.progress(deleted, total)
+		}
+		if deleted < int64(bd.chunkSize) {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package goqu_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlserver"
+	"github.com/stretchr/testify/suite"
+)
+
+type deleteBatchSuite struct {
+	suite.Suite
+}
+
+func TestDeleteBatchSuite(t *testing.T) {
+	suite.Run(t, new(deleteBatchSuite))
+}
+
+func (dbs *deleteBatchSuite) assertChunkSQL(batch *goqu.DeleteBatch, expectedSQL string, expectedArgs ...interface{}) {
+	ds, err := batch.ChunkDataset()
+	dbs.Require().NoError(err)
+	sql, args, err := ds.ToSQL()
+	dbs.NoError(err)
+	dbs.Equal(expectedSQL, sql)
+	if len(expectedArgs) == 0 {
+		dbs.Empty(args)
+	} else {
+		dbs.Equal(expectedArgs, args)
+	}
+}
+
+func (dbs *deleteBatchSuite) TestChunkDataset() {
+	ds := goqu.Delete("items").Where(goqu.C("expired").IsTrue())
+	dbs.assertChunkSQL(
+		ds.InChunks("id"),
+		`DELETE FROM "items" WHERE (("expired" IS TRUE) AND ("id" IN (SELECT "id" FROM "items" WHERE ("expired" IS TRUE) LIMIT 1000)))`,
+	)
+	dbs.assertChunkSQL(
+		goqu.Delete("items").InChunks("items.id").WithChunkSize(10),
+		`DELETE FROM "items" WHERE ("items"."id" IN (SELECT "items"."id" FROM "items" LIMIT 10))`,
+	)
+
+	_, err := ds.InChunks("id").WithChunkSize(0).ChunkDataset()
+	dbs.EqualError(err, "goqu: delete chunk size must be greater than 0")
+	_, err = ds.InChunks("").ChunkDataset()
+	dbs.EqualError(err, "goqu: a key column is required when deleting in chunks")
+}
+
+func (dbs *deleteBatchSuite) TestChunkDataset_postgres() {
+	ds := goqu.Dialect("postgres").Delete("items").Where(goqu.C("age").Gt(10))
+	dbs.assertChunkSQL(
+		ds.InChunks("id").WithChunkSize(100),
+		`DELETE FROM "items" WHERE (("age" > 10) AND ("id" IN (SELECT "id" FROM "items" WHERE ("age" > 10) LIMIT 100)))`,
+	)
+	dbs.assertChunkSQL(
+		ds.Prepared(true).InChunks("id").WithChunkSize(100),
+		`DELETE FROM "items" WHERE (("age" > $1) AND ("id" IN (SELECT "id" FROM "items" WHERE ("age" > $2) LIMIT $3)))`,
+		int64(10), int64(10), int64(100),
+	)
+}
+
+func (dbs *deleteBatchSuite) TestChunkDataset_mysql() {
+	ds := goqu.Dialect("mysql").Delete("items").Where(goqu.C("age").Gt(10))
+	dbs.assertChunkSQL(
+		ds.InChunks("id").WithChunkSize(100),
+		"DELETE FROM `items` WHERE (`age` > 10) LIMIT 100",
+	)
+	dbs.assertChunkSQL(
+		ds.Order(goqu.C("id").Asc()).Limit(5).InChunks("id").WithChunkSize(100),
+		"DELETE FROM `items` WHERE (`age` > 10) ORDER BY `id` ASC LIMIT 100",
+	)
+}
+
+func (dbs *deleteBatchSuite) TestChunkDataset_sqlserver() {
+	ds := goqu.Dialect("sqlserver").Delete("items").Where(goqu.C("age").Gt(10)).Order(goqu.C("id").Asc())
+	dbs.assertChunkSQL(
+		ds.InChunks("id").WithChunkSize(100),
+		`DELETE FROM "items" WHERE (("age" > 10) AND ("id" IN (SELECT  TOP (100) "id" FROM "items" WHERE ("age" > 10) ORDER BY "id" ASC)))`,
+	)
+}
+
+func (dbs *deleteBatchSuite) TestExec() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	dbs.Require().NoError(err)
+	query := `DELETE FROM "items" WHERE (("expired" IS TRUE) AND ("id" IN (SELECT "id" FROM "items" WHERE ("expired" IS TRUE) LIMIT 2)))`
+	sqlMock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	var progress [][2]int64
+	db := goqu.New("postgres", mDB)
+	total, err := db.Delete("items").Where(goqu.C("expired").IsTrue()).
+		InChunks("id").
+		WithChunkSize(2).
+		WithProgress(func(deleted, total int64) {
+			progress = append(progress, [2]int64{deleted, total})
+		}).
+		Exec()
+	dbs.NoError(err)
+	dbs.Equal(int64(5), total)
+	dbs.Equal([][2]int64{{2, 2}, {2, 4}, {1, 5}}, progress)
+	dbs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (dbs *deleteBatchSuite) TestExec_withError() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	dbs.Require().NoError(err)
+	sqlMock.ExpectExec("DELETE FROM `items` LIMIT 2").WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectExec("DELETE FROM `items` LIMIT 2").WillReturnError(errors.New("lock wait timeout"))
+
+	db := goqu.New("mysql", mDB)
+	total, err := db.Delete("items").InChunks("id").WithChunkSize(2).Exec()
+	dbs.EqualError(err, "lock wait timeout")
+	dbs.Equal(int64(2), total)
+	dbs.NoError(sqlMock.ExpectationsWereMet())
+
+	_, err = goqu.Delete("items").InChunks("id").Exec()
+	dbs.Equal(goqu.ErrQueryFactoryNotFoundError, err)
+}
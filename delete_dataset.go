@@ -112,8 +112,30 @@ func (dd *DeleteDataset) With(name string, subquery exp.Expression) *DeleteDatas
 // The name will refer to the results of the specified subquery. The subquery for
 // a recursive query will always end with a UNION or UNION ALL with a clause that
 // refers to the CTE by name.
-func (dd *DeleteDataset) WithRecursive(name string, subquery exp.Expression) *DeleteDataset {
-	return dd.copy(dd.clauses.CommonTablesAppend(exp.NewCommonTableExpression(true, name, subquery)))
+//
+// SEARCH and CYCLE clauses can be added by passing SearchDepthFirst, SearchBreadthFirst or Cycle expressions.
+func (dd *DeleteDataset) WithRecursive(name string, subquery exp.Expression, clauses ...exp.Expression) *DeleteDataset {
+	return dd.copy(dd.clauses.CommonTablesAppend(newRecursiveCommonTable(name, subquery, clauses)))
+}
+
+// WithCTE adds a common table expression to the WITH clause, see SelectDataset#WithCTE.
+func (dd *DeleteDataset) WithCTE(cte exp.CommonTableExpression) *DeleteDataset {
+	return dd.copy(dd.clauses.CommonTablesAppend(cte))
+}
+
+// WithMaterialized creates a WITH clause for a common table expression (CTE) that is computed once
+// (e.g. WITH name AS MATERIALIZED (...)). An error is returned when generating sql for dialects that do not support it.
+func (dd *DeleteDataset) WithMaterialized(name string, subquery exp.Expression) *DeleteDataset {
+	cte := exp.NewCommonTableExpression(false, name, subquery).SetMaterialization(exp.MaterializedCTE)
+	return dd.copy(dd.clauses.CommonTablesAppend(cte))
+}
+
+// WithNotMaterialized creates a WITH clause for a common table expression (CTE) that may be folded into the parent
+// query (e.g. WITH name AS NOT MATERIALIZED (...)). An error is returned when generating sql for dialects that do not
+// support it.
+func (dd *DeleteDataset) WithNotMaterialized(name string, subquery exp.Expression) *DeleteDataset {
+	cte := exp.NewCommonTableExpression(false, name, subquery).SetMaterialization(exp.NotMaterializedCTE)
+	return dd.copy(dd.clauses.CommonTablesAppend(cte))
 }
 
 // From adds a FROM clause. This return a new DeleteDataset with the original sources replaced.
@@ -133,11 +155,32 @@ func (dd *DeleteDataset) From(table interface{}) *DeleteDataset {
 	}
 }
 
+// Hint appends optimizer hints which are written as is in a comment after the DELETE keyword (e.g. mysql, oracle). An
+// error is returned when generating sql for dialects that do not support optimizer hints.
+//    Delete("a").Hint("NO_ICP(a)").Where(...) // DELETE /*+ NO_ICP(a) */ FROM `a` WHERE ...
+func (dd *DeleteDataset) Hint(hints ...string) *DeleteDataset {
+	return dd.copy(dd.clauses.SetHints(append(append([]string(nil), dd.clauses.Hints()...), hints...)))
+}
+
+// ClearHint removes all optimizer hints.
+func (dd *DeleteDataset) ClearHint() *DeleteDataset {
+	return dd.copy(dd.clauses.SetHints(nil))
+}
+
 // Where adds a WHERE clause.
 func (dd *DeleteDataset) Where(expressions ...exp.Expression) *DeleteDataset {
 	return dd.copy(dd.clauses.WhereAppend(expressions...))
 }
 
+// WhereIf adds a WHERE clause if cond is true, otherwise the dataset is returned unchanged.
+//    ds.WhereIf(name != "", goqu.C("name").Eq(name))
+func (dd *DeleteDataset) WhereIf(cond bool, expressions ...exp.Expression) *DeleteDataset {
+	if !cond {
+		return dd
+	}
+	return dd.Where(expressions...)
+}
+
 // ClearWhere removes the WHERE clause.
 func (dd *DeleteDataset) ClearWhere() *DeleteDataset {
 	return dd.copy(dd.clauses.ClearWhere())
@@ -193,6 +236,15 @@ func (dd *DeleteDataset) Error() error {
 	return dd.err
 }
 
+// ApplyIf returns the result of calling fn with the dataset if cond is true, see SelectDataset#ApplyIf.
+//    ds.ApplyIf(returnIDs, func(ds *DeleteDataset) *DeleteDataset { return ds.Returning("id") })
+func (dd *DeleteDataset) ApplyIf(cond bool, fn func(*DeleteDataset) *DeleteDataset) *DeleteDataset {
+	if !cond {
+		return dd
+	}
+	return fn(dd)
+}
+
 // SetError sets an error on the DeleteDataset if one has not already been set.
 // This error will be returned by a future call to Error or as part of ToSQL.
 // This can be used by end users to record errors while building up queries without having to track those separately.
@@ -222,6 +274,13 @@ func (dd *DeleteDataset) MustToSQL() (sql string, params []interface{}) {
 	return
 }
 
+// Explain creates an ExplainStatement that wraps the DELETE sql in the dialects EXPLAIN syntax.
+//
+//	plan, err := ds.Explain(exp.ExplainOptions{Analyze: true, Format: exp.ExplainFormatJSON}).Plan()
+func (dd *DeleteDataset) Explain(opts exp.ExplainOptions) *ExplainStatement {
+	return newExplainStatement(dd, dd.dialect, dd.queryFactory, dd.isPrepared.Bool(), opts)
+}
+
 // AppendSQL appends this DeleteDataset's DELETE statement to the SQLBuilder.
 // This is used internally when using deletes in CTEs.
 func (dd *DeleteDataset) AppendSQL(b sb.SQLBuilder) {
@@ -64,6 +64,23 @@ func ExampleDeleteDataset_With() {
 	// WITH check_vals(val) AS (SELECT 123) DELETE FROM "test" WHERE ("val" IN (SELECT "val" FROM "check_vals"))
 }
 
+func ExampleDeleteDataset_With_forUpdate() {
+	// delete the oldest 10 jobs without blocking on jobs locked by other workers
+	oldest := goqu.From("jobs").
+		Select("id").
+		Order(goqu.C("created_at").Asc()).
+		Limit(10).
+		ForUpdate(goqu.SkipLocked)
+	sql, _, _ := goqu.Delete("jobs").
+		With("oldest", oldest).
+		Where(goqu.C("id").Eq(goqu.From("oldest").Select("id"))).
+		ToSQL()
+	fmt.Println(sql)
+
+	// Output:
+	// WITH oldest AS (SELECT "id" FROM "jobs" ORDER BY "created_at" ASC LIMIT 10 FOR UPDATE SKIP LOCKED) DELETE FROM "jobs" WHERE ("id" IN (SELECT "id" FROM "oldest"))
+}
+
 func ExampleDeleteDataset_WithRecursive() {
 	sql, _, _ := goqu.Delete("nums").
 		WithRecursive("nums(x)",
@@ -136,6 +136,47 @@ func (dds *deleteDatasetSuite) TestWithRecursive() {
 	)
 }
 
+func (dds *deleteDatasetSuite) TestWithCTE() {
+	cte := exp.NewCommonTableExpression(true, "test-cte", goqu.From("cte"))
+	bd := goqu.Delete("items")
+	dds.assertCases(
+		deleteTestCase{
+			ds: bd.WithCTE(cte),
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")).
+				CommonTablesAppend(cte),
+		},
+		deleteTestCase{
+			ds:      bd,
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")),
+		},
+	)
+}
+
+func (dds *deleteDatasetSuite) TestWithMaterialized() {
+	from := goqu.From("cte")
+	bd := goqu.Delete("items")
+	dds.assertCases(
+		deleteTestCase{
+			ds: bd.WithMaterialized("test-cte", from),
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")).
+				CommonTablesAppend(
+					exp.NewCommonTableExpression(false, "test-cte", from).SetMaterialization(exp.MaterializedCTE),
+				),
+		},
+		deleteTestCase{
+			ds: bd.WithNotMaterialized("test-cte", from),
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")).
+				CommonTablesAppend(
+					exp.NewCommonTableExpression(false, "test-cte", from).SetMaterialization(exp.NotMaterializedCTE),
+				),
+		},
+		deleteTestCase{
+			ds:      bd,
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")),
+		},
+	)
+}
+
 func (dds *deleteDatasetSuite) TestFrom_withIdentifier() {
 	bd := goqu.Delete("items")
 	dds.assertCases(
@@ -166,6 +207,44 @@ func (dds *deleteDatasetSuite) TestFrom_withIdentifier() {
 	})
 }
 
+func (dds *deleteDatasetSuite) TestHint() {
+	bd := goqu.Delete("items")
+	dds.assertCases(
+		deleteTestCase{
+			ds: bd.Hint("NO_ICP(items)"),
+			clauses: exp.NewDeleteClauses().
+				SetFrom(goqu.C("items")).
+				SetHints([]string{"NO_ICP(items)"}),
+		},
+		deleteTestCase{
+			ds: bd.Hint("NO_ICP(items)").Hint("MAX_EXECUTION_TIME(1000)"),
+			clauses: exp.NewDeleteClauses().
+				SetFrom(goqu.C("items")).
+				SetHints([]string{"NO_ICP(items)", "MAX_EXECUTION_TIME(1000)"}),
+		},
+		deleteTestCase{
+			ds:      bd,
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")),
+		},
+	)
+}
+
+func (dds *deleteDatasetSuite) TestClearHint() {
+	bd := goqu.Delete("items").Hint("NO_ICP(items)")
+	dds.assertCases(
+		deleteTestCase{
+			ds:      bd.ClearHint(),
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")),
+		},
+		deleteTestCase{
+			ds: bd,
+			clauses: exp.NewDeleteClauses().
+				SetFrom(goqu.C("items")).
+				SetHints([]string{"NO_ICP(items)"}),
+		},
+	)
+}
+
 func (dds *deleteDatasetSuite) TestWhere() {
 	bd := goqu.Delete("items")
 	dds.assertCases(
@@ -189,6 +268,39 @@ func (dds *deleteDatasetSuite) TestWhere() {
 	)
 }
 
+func (dds *deleteDatasetSuite) TestWhereIf() {
+	bd := goqu.Delete("items")
+	dds.assertCases(
+		deleteTestCase{
+			ds:      bd.WhereIf(true, goqu.Ex{"a": 1}),
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")).WhereAppend(goqu.Ex{"a": 1}),
+		},
+		deleteTestCase{
+			ds:      bd.WhereIf(false, goqu.Ex{"a": 1}),
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")),
+		},
+		deleteTestCase{
+			ds:      bd,
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")),
+		},
+	)
+}
+
+func (dds *deleteDatasetSuite) TestApplyIf() {
+	bd := goqu.Delete("items")
+	returning := func(ds *goqu.DeleteDataset) *goqu.DeleteDataset { return ds.Returning("id") }
+	dds.assertCases(
+		deleteTestCase{
+			ds:      bd.ApplyIf(true, returning),
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")).SetReturning(exp.NewColumnListExpression("id")),
+		},
+		deleteTestCase{
+			ds:      bd.ApplyIf(false, returning),
+			clauses: exp.NewDeleteClauses().SetFrom(goqu.C("items")),
+		},
+	)
+}
+
 func (dds *deleteDatasetSuite) TestClearWhere() {
 	bd := goqu.Delete("items").Where(goqu.Ex{"a": 1})
 	dds.assertCases(
@@ -0,0 +1,71 @@
+package ansi
+
+import (
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+// DialectOptions returns options that only generate standard SQL. Vendor specific clauses (e.g. RETURNING,
+// ON CONFLICT, DISTINCT ON, UPDATE ... FROM) and operators (e.g. ILIKE, regular expressions and bitwise operators)
+// return an error instead of generating sql that other databases may not understand.
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := goqu.DefaultDialectOptions()
+
+	opts.SupportsReturn = false
+	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.SupportsConflict = false
+	opts.SupportsConflictTarget = false
+	opts.SupportsConflictUpdateWhere = false
+	opts.SupportsMultipleUpdateTables = false
+
+	opts.TruncateClause = []byte("TRUNCATE TABLE")
+	opts.SupportsMultipleTruncateTables = false
+	opts.SupportsTruncateCascade = false
+
+	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
+		exp.EqOp:      []byte("="),
+		exp.NeqOp:     []byte("<>"),
+		exp.GtOp:      []byte(">"),
+		exp.GteOp:     []byte(">="),
+		exp.LtOp:      []byte("<"),
+		exp.LteOp:     []byte("<="),
+		exp.InOp:      []byte("IN"),
+		exp.NotInOp:   []byte("NOT IN"),
+		exp.IsOp:      []byte("IS"),
+		exp.IsNotOp:   []byte("IS NOT"),
+		exp.LikeOp:    []byte("LIKE"),
+		exp.NotLikeOp: []byte("NOT LIKE"),
+
+		exp.IsDistinctFromOp:    []byte("IS DISTINCT FROM"),
+		exp.IsNotDistinctFromOp: []byte("IS NOT DISTINCT FROM"),
+	}
+	opts.BitwiseOperatorLookup = map[exp.BitwiseOperation][]byte{}
+
+	opts.FetchFragment = []byte(" FETCH FIRST ")
+	opts.SupportsFetchWithTies = true
+	opts.SupportsFetchPercent = true
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.CommonTableSQLFragment,
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.GroupBySQLFragment,
+		sqlgen.HavingSQLFragment,
+		sqlgen.WindowSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.OffsetFetchSQLFragment,
+		sqlgen.ForSQLFragment,
+	}
+	return opts
+}
+
+func init() {
+	goqu.RegisterDialect("ansi", DialectOptions())
+}
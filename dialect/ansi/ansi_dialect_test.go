@@ -0,0 +1,134 @@
+package ansi_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/ansi"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	ansiDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exp.SQLExpression
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (ads *ansiDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("ansi").From(table)
+}
+
+func (ads *ansiDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			ads.NoError(err, "test case %d failed", i)
+		} else {
+			ads.EqualError(err, c.err, "test case %d failed", i)
+		}
+		ads.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			ads.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			ads.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (ads *ansiDialectSuite) TestIdentifiersAndPlaceholders() {
+	ads.assertSQL(
+		sqlTestCase{
+			ds:  ads.GetDs("s.test").Select("a", goqu.I("test.b")).Where(goqu.C("a").Neq("it's")),
+			sql: `SELECT "a", "test"."b" FROM "s"."test" WHERE ("a" <> 'it''s')`,
+		},
+		sqlTestCase{
+			ds:         ads.GetDs("test").Prepared(true).Where(goqu.C("a").Eq(1), goqu.C("b").In([]string{"a", "b"})),
+			sql:        `SELECT * FROM "test" WHERE (("a" = ?) AND ("b" IN (?, ?)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", "b"},
+		},
+	)
+}
+
+func (ads *ansiDialectSuite) TestLimitOffset() {
+	ds := ads.GetDs("test").Order(goqu.C("a").Asc())
+	ads.assertSQL(
+		sqlTestCase{ds: ds.Limit(10), sql: `SELECT * FROM "test" ORDER BY "a" ASC FETCH FIRST 10 ROWS ONLY`},
+		sqlTestCase{
+			ds:  ds.Limit(10).Offset(20),
+			sql: `SELECT * FROM "test" ORDER BY "a" ASC OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY`,
+		},
+	)
+}
+
+func (ads *ansiDialectSuite) TestStandardClauses() {
+	ads.assertSQL(
+		sqlTestCase{
+			ds: ads.GetDs("test").
+				With("cte", ads.GetDs("other").Select("a")).
+				Select("a", goqu.COUNT("*").Over(goqu.W().PartitionBy("b"))).
+				Where(goqu.C("c").Between(goqu.Range(1, 10)), goqu.C("d").Like("a%")),
+			sql: `WITH cte AS (SELECT "a" FROM "other") SELECT "a", COUNT(*) OVER (PARTITION BY "b") FROM "test" ` +
+				`WHERE (("c" BETWEEN 1 AND 10) AND ("d" LIKE 'a%'))`,
+		},
+		sqlTestCase{
+			ds:  goqu.Dialect("ansi").Update("test").Set(goqu.Record{"a": 1}).Where(goqu.C("b").IsNull()),
+			sql: `UPDATE "test" SET "a"=1 WHERE ("b" IS NULL)`,
+		},
+		sqlTestCase{ds: goqu.Dialect("ansi").Truncate("test"), sql: `TRUNCATE TABLE "test"`},
+	)
+}
+
+func (ads *ansiDialectSuite) TestVendorExtensions() {
+	d := goqu.Dialect("ansi")
+	ads.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=ansi]",
+		},
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).OnConflict(goqu.DoNothing()),
+			err: "goqu: dialect does not support ON CONFLICT clause [dialect=ansi]",
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("test").Distinct("a"),
+			err: "goqu: dialect does not support DISTINCT ON clause [dialect=ansi]",
+		},
+		sqlTestCase{
+			ds:  d.Update("test").Set(goqu.Record{"a": 1}).From("other"),
+			err: "goqu: ansi dialect does not support multiple tables in UPDATE",
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("test").Where(goqu.C("a").ILike("a%")),
+			err: "goqu: boolean operator 'ilike' not supported",
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("test").Where(goqu.C("a").RegexpLike("a.*")),
+			err: "goqu: boolean operator 'regexplike' not supported",
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("test").Where(goqu.C("a").BitwiseAnd(1).Eq(1)),
+			err: "goqu: bitwise operator 'AND' not supported",
+		},
+		sqlTestCase{
+			ds:  d.Truncate("test", "test2"),
+			err: "goqu: dialect does not support multiple tables in TRUNCATE [dialect=ansi]",
+		},
+		sqlTestCase{
+			ds:  d.Truncate("test").Cascade(),
+			err: "goqu: dialect does not support CASCADE or RESTRICT in TRUNCATE [dialect=ansi]",
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(ansiDialectSuite))
+}
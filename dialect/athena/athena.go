@@ -0,0 +1,114 @@
+package athena
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+const (
+	timestampFormat = "2006-01-02 15:04:05.000"
+	dateFormat      = "2006-01-02"
+)
+
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := goqu.DefaultDialectOptions()
+
+	// athena does not accept query parameters so every value must be interpolated
+	opts.SupportsPlaceholders = false
+
+	opts.SupportsReturn = false
+	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.SupportsConflictTarget = false
+	opts.SupportsConflictUpdateWhere = false
+	opts.SupportsMultipleUpdateTables = false
+	opts.CopyFragment = nil
+
+	opts.TimeFormat = timestampFormat
+
+	// athena expects OFFSET before LIMIT
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.CommonTableSQLFragment,
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.GroupBySQLFragment,
+		sqlgen.HavingSQLFragment,
+		sqlgen.WindowSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.OffsetSQLFragment,
+		sqlgen.LimitSQLFragment,
+	}
+	return opts
+}
+
+// Array creates an ARRAY literal from the values
+//    athena.Array(1, 2, 3) // ARRAY[1, 2, 3]
+func Array(vals ...interface{}) exp.LiteralExpression {
+	return goqu.L("ARRAY["+placeholders(len(vals))+"]", vals...)
+}
+
+// Map creates a MAP literal from the map, the entries are sorted by key
+//    athena.Map(map[string]interface{}{"a": 1, "b": 2}) // MAP(ARRAY['a', 'b'], ARRAY[1, 2])
+func Map(m map[string]interface{}) exp.LiteralExpression {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	args := make([]interface{}, 0, len(m)*2)
+	for _, k := range keys {
+		args = append(args, k)
+	}
+	for _, k := range keys {
+		args = append(args, m[k])
+	}
+	p := placeholders(len(m))
+	return goqu.L("MAP(ARRAY["+p+"], ARRAY["+p+"])", args...)
+}
+
+// Timestamp creates a TIMESTAMP literal from the time
+//    athena.Timestamp(t) // TIMESTAMP '2021-03-04 05:06:07.000'
+func Timestamp(t time.Time) exp.LiteralExpression {
+	return goqu.L("TIMESTAMP ?", t.Format(timestampFormat))
+}
+
+// Date creates a DATE literal from the time
+//    athena.Date(t) // DATE '2021-03-04'
+func Date(t time.Time) exp.LiteralExpression {
+	return goqu.L("DATE ?", t.Format(dateFormat))
+}
+
+// DatePartitions creates an IN predicate that lists every day between from and to (inclusive) formatted using the
+// layout. Partition projection can only prune partitions when the partition column is compared to constant values,
+// so use this instead of comparing a function of the partition column to a date range.
+//    athena.DatePartitions("dt", "2006/01/02", from, to) // "dt" IN ('2021/03/01', '2021/03/02', '2021/03/03')
+func DatePartitions(col, layout string, from, to time.Time) exp.BooleanExpression {
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	var vals []string
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		vals = append(vals, d.Format(layout))
+	}
+	return goqu.C(col).In(vals)
+}
+
+func placeholders(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strings.Repeat("?, ", n-1) + "?"
+}
+
+func init() {
+	goqu.RegisterDialect("athena", DialectOptions())
+}
@@ -0,0 +1,139 @@
+package athena_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/athena"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	athenaDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds  exp.SQLExpression
+		sql string
+		err string
+	}
+)
+
+func (ads *athenaDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("athena").From(table)
+}
+
+func (ads *athenaDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			ads.NoError(err, "test case %d failed", i)
+		} else {
+			ads.EqualError(err, c.err, "test case %d failed", i)
+		}
+		ads.Equal(c.sql, actualSQL, "test case %d failed", i)
+		ads.Empty(actualArgs, "test case %d failed", i)
+	}
+}
+
+func (ads *athenaDialectSuite) TestPlaceholders() {
+	expectedErr := "goqu: dialect does not support placeholders, values must be interpolated [dialect=athena]"
+	ads.assertSQL(
+		sqlTestCase{
+			ds:  ads.GetDs("test").Where(goqu.C("a").Eq(1), goqu.C("b").In([]string{"a", "it's"})),
+			sql: `SELECT * FROM "test" WHERE (("a" = 1) AND ("b" IN ('a', 'it''s')))`,
+		},
+		sqlTestCase{ds: ads.GetDs("test").Prepared(true).Where(goqu.C("a").Eq(1)), err: expectedErr},
+		sqlTestCase{ds: ads.GetDs("test").Where(goqu.C("a").Eq(goqu.Secret("b"))), err: expectedErr},
+	)
+}
+
+func (ads *athenaDialectSuite) TestLimitOffset() {
+	ads.assertSQL(
+		sqlTestCase{ds: ads.GetDs("test").Limit(10), sql: `SELECT * FROM "test" LIMIT 10`},
+		sqlTestCase{ds: ads.GetDs("test").Limit(10).Offset(20), sql: `SELECT * FROM "test" OFFSET 20 LIMIT 10`},
+	)
+}
+
+func (ads *athenaDialectSuite) TestArrayAndMap() {
+	ads.assertSQL(
+		sqlTestCase{
+			ds:  ads.GetDs("test").Select(athena.Array(1, "a", true).As("arr")),
+			sql: `SELECT ARRAY[1, 'a', TRUE] AS "arr" FROM "test"`,
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("test").Select(athena.Array().As("arr")),
+			sql: `SELECT ARRAY[] AS "arr" FROM "test"`,
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("test").Select(athena.Map(map[string]interface{}{"b": 2, "a": 1}).As("m")),
+			sql: `SELECT MAP(ARRAY['a', 'b'], ARRAY[1, 2]) AS "m" FROM "test"`,
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("test").Select(athena.Map(nil).As("m")),
+			sql: `SELECT MAP(ARRAY[], ARRAY[]) AS "m" FROM "test"`,
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("test").Where(goqu.Func("contains", goqu.C("tags"), "a")),
+			sql: `SELECT * FROM "test" WHERE contains("tags", 'a')`,
+		},
+	)
+}
+
+func (ads *athenaDialectSuite) TestTimes() {
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 8000000, time.UTC)
+	ads.assertSQL(
+		sqlTestCase{
+			ds:  ads.GetDs("test").Where(goqu.C("created").Gt(athena.Timestamp(ts))),
+			sql: `SELECT * FROM "test" WHERE ("created" > TIMESTAMP '2021-03-04 05:06:07.008')`,
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("test").Where(goqu.C("day").Eq(athena.Date(ts))),
+			sql: `SELECT * FROM "test" WHERE ("day" = DATE '2021-03-04')`,
+		},
+	)
+}
+
+func (ads *athenaDialectSuite) TestDatePartitions() {
+	from := time.Date(2021, 2, 27, 13, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 3, 2, 1, 0, 0, 0, time.UTC)
+	ads.assertSQL(
+		sqlTestCase{
+			ds: ads.GetDs("logs").Where(athena.DatePartitions("dt", "2006/01/02", from, to)),
+			sql: `SELECT * FROM "logs" WHERE ("dt" IN ` +
+				`('2021/02/27', '2021/02/28', '2021/03/01', '2021/03/02'))`,
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("logs").Where(athena.DatePartitions("dt", "2006-01-02", to, to)),
+			sql: `SELECT * FROM "logs" WHERE ("dt" IN ('2021-03-02'))`,
+		},
+	)
+}
+
+func (ads *athenaDialectSuite) TestUnsupported() {
+	d := goqu.Dialect("athena")
+	ads.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=athena]",
+		},
+		sqlTestCase{
+			ds:  ads.GetDs("test").Distinct("a"),
+			err: "goqu: dialect does not support DISTINCT ON clause [dialect=athena]",
+		},
+	)
+}
+
+func (ads *athenaDialectSuite) TestCopy() {
+	d := goqu.Dialect("athena")
+	ads.assertSQL(
+		sqlTestCase{ds: d.CopyFrom("items"), err: "goqu: dialect does not support COPY [dialect=athena]"},
+		sqlTestCase{ds: d.CopyTo("items"), err: "goqu: dialect does not support COPY [dialect=athena]"},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(athenaDialectSuite))
+}
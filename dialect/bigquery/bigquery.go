@@ -0,0 +1,150 @@
+package bigquery
+
+import (
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+// DialectOptions returns the options for bigquery. Identifiers are quoted with backticks and placeholders are generated
+// as named parameters (e.g. @p1, @p2), when executing a statement the args are bound by name (e.g. sql.Named("p1", 1)).
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := goqu.DefaultDialectOptions()
+
+	opts.PlaceHolderFragment = []byte("@p")
+	opts.IncludePlaceholderNum = true
+	opts.NamedArgPrefix = "p"
+	opts.QuoteRune = '`'
+
+	opts.SupportsReturn = false
+	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.SupportsLateral = false
+	opts.SupportsDerivedColumnAliases = false
+	opts.SupportsConflict = false
+	opts.SupportsConflictTarget = false
+	opts.SupportsConflictUpdateWhere = false
+	opts.SupportsQualify = true
+	opts.RandomFunction = []byte("RAND()")
+	// bigquery loads data using LOAD DATA
+	opts.CopyFragment = nil
+
+	// temporary tables can only be created in a multi-statement query
+	opts.CreateTempTableFragment = []byte("CREATE TEMP TABLE ")
+	opts.AutoIncrementFragment = nil
+	// NOT NULL can only be dropped and constraints must be NOT ENFORCED
+	opts.ColumnTypeFragment = []byte(" SET DATA TYPE ")
+	opts.SetNotNullFragment = nil
+	opts.AddConstraintFragment = nil
+	// DATETIME is a timestamp without a time zone, TIMESTAMP is an absolute point in time
+	opts.DataTypeLookup = map[exp.DataTypeKind][]byte{
+		exp.SmallIntDataType:    []byte("INT64"),
+		exp.IntegerDataType:     []byte("INT64"),
+		exp.BigIntDataType:      []byte("INT64"),
+		exp.DecimalDataType:     []byte("NUMERIC"),
+		exp.RealDataType:        []byte("FLOAT64"),
+		exp.DoubleDataType:      []byte("FLOAT64"),
+		exp.BooleanDataType:     []byte("BOOL"),
+		exp.CharDataType:        []byte("STRING"),
+		exp.VarcharDataType:     []byte("STRING"),
+		exp.TextDataType:        []byte("STRING"),
+		exp.DateDataType:        []byte("DATE"),
+		exp.TimeDataType:        []byte("TIME"),
+		exp.TimestampDataType:   []byte("DATETIME"),
+		exp.TimestampTzDataType: []byte("TIMESTAMP"),
+		exp.BinaryDataType:      []byte("BYTES"),
+		exp.UUIDDataType:        []byte("STRING"),
+		exp.JSONDataType:        []byte("JSON"),
+	}
+
+	opts.EscapedRunes = map[rune][]byte{
+		'\'': []byte("\\'"),
+		'\\': []byte("\\\\"),
+		'\n': []byte("\\n"),
+		'\r': []byte("\\r"),
+	}
+
+	opts.TruncateClause = []byte("TRUNCATE TABLE")
+	opts.SupportsMultipleTruncateTables = false
+	opts.SupportsTruncateIdentity = false
+	opts.SupportsTruncateCascade = false
+
+	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
+		exp.EqOp:      []byte("="),
+		exp.NeqOp:     []byte("!="),
+		exp.GtOp:      []byte(">"),
+		exp.GteOp:     []byte(">="),
+		exp.LtOp:      []byte("<"),
+		exp.LteOp:     []byte("<="),
+		exp.InOp:      []byte("IN"),
+		exp.NotInOp:   []byte("NOT IN"),
+		exp.IsOp:      []byte("IS"),
+		exp.IsNotOp:   []byte("IS NOT"),
+		exp.LikeOp:    []byte("LIKE"),
+		exp.NotLikeOp: []byte("NOT LIKE"),
+
+		exp.IsDistinctFromOp:    []byte("IS DISTINCT FROM"),
+		exp.IsNotDistinctFromOp: []byte("IS NOT DISTINCT FROM"),
+	}
+	// LIKE patterns are always escaped with a backslash
+	opts.LikeEscapeFragment = nil
+
+	opts.AggregateFilterFragment = nil
+	// bigquery does not support AT TIME ZONE, time zones are passed to the DATETIME and TIMESTAMP functions
+	opts.AtTimeZoneFragment = nil
+
+	// bigquery does not support row locking
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.CommonTableSQLFragment,
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.GroupBySQLFragment,
+		sqlgen.HavingSQLFragment,
+		sqlgen.QualifySQLFragment,
+		sqlgen.WindowSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.LimitSQLFragment,
+		sqlgen.OffsetSQLFragment,
+	}
+	return opts
+}
+
+// StarExcept selects all columns except the provided columns
+//    dialect.From("users").Select(bigquery.StarExcept("password", "salt"))
+//    // SELECT * EXCEPT (`password`, `salt`) FROM `users`
+func StarExcept(cols ...string) exp.LiteralExpression {
+	return except("*", cols)
+}
+
+// TableStarExcept selects all columns of the table except the provided columns
+//    dialect.From(goqu.T("users").As("u")).Select(bigquery.TableStarExcept("u", "password"))
+//    // SELECT `u`.* EXCEPT (`password`) FROM `users` AS `u`
+func TableStarExcept(table string, cols ...string) exp.LiteralExpression {
+	return except("?.*", cols, goqu.T(table))
+}
+
+func except(star string, cols []string, args ...interface{}) exp.LiteralExpression {
+	for _, col := range cols {
+		args = append(args, goqu.C(col))
+	}
+	return goqu.L(star+" EXCEPT ("+placeholders(len(cols))+")", args...)
+}
+
+func placeholders(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strings.Repeat("?, ", n-1) + "?"
+}
+
+func init() {
+	goqu.RegisterDialect("bigquery", DialectOptions())
+}
@@ -0,0 +1,204 @@
+package bigquery_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/bigquery"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	bigqueryDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exec.Statement
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (bds *bigqueryDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("bigquery").From(table)
+}
+
+func (bds *bigqueryDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			bds.NoError(err, "test case %d failed", i)
+		} else {
+			bds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		bds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			bds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			bds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (bds *bigqueryDialectSuite) TestIdentifiers() {
+	bds.assertSQL(
+		sqlTestCase{
+			ds:  bds.GetDs("project.dataset.users").Select("id", goqu.I("users.name").As("user_name")),
+			sql: "SELECT `id`, `users`.`name` AS `user_name` FROM `project`.`dataset`.`users`",
+		},
+	)
+}
+
+func (bds *bigqueryDialectSuite) TestPlaceholders() {
+	bds.assertSQL(
+		sqlTestCase{
+			ds:         bds.GetDs("test").Prepared(true).Where(goqu.C("a").Eq(1), goqu.C("b").In([]string{"a", "b"})),
+			sql:        "SELECT * FROM `test` WHERE ((`a` = @p1) AND (`b` IN (@p2, @p3)))",
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", "b"},
+		},
+		sqlTestCase{
+			ds:         goqu.Dialect("bigquery").Insert("test").Prepared(true).Rows(goqu.Record{"a": 1, "b": "c"}),
+			sql:        "INSERT INTO `test` (`a`, `b`) VALUES (@p1, @p2)",
+			isPrepared: true,
+			args:       []interface{}{int64(1), "c"},
+		},
+	)
+}
+
+func (bds *bigqueryDialectSuite) TestQualify() {
+	ds := bds.GetDs("test").
+		Select("a", goqu.ROW_NUMBER().Over(goqu.W().PartitionBy("a").OrderBy("b")).As("rn"))
+	bds.assertSQL(
+		sqlTestCase{
+			ds: ds.Qualify(goqu.C("rn").Eq(1)),
+			sql: "SELECT `a`, ROW_NUMBER() OVER (PARTITION BY `a` ORDER BY `b`) AS `rn` FROM `test` " +
+				"QUALIFY (`rn` = 1)",
+		},
+		sqlTestCase{
+			ds: ds.Where(goqu.C("c").Gt(10)).Qualify(goqu.C("rn").Eq(1)).Order(goqu.C("a").Asc()).Limit(10),
+			sql: "SELECT `a`, ROW_NUMBER() OVER (PARTITION BY `a` ORDER BY `b`) AS `rn` FROM `test` " +
+				"WHERE (`c` > 10) QUALIFY (`rn` = 1) ORDER BY `a` ASC LIMIT 10",
+		},
+		sqlTestCase{
+			ds: ds.Prepared(true).Where(goqu.C("c").Gt(10)).Qualify(goqu.C("rn").Eq(1)),
+			sql: "SELECT `a`, ROW_NUMBER() OVER (PARTITION BY `a` ORDER BY `b`) AS `rn` FROM `test` " +
+				"WHERE (`c` > @p1) QUALIFY (`rn` = @p2)",
+			isPrepared: true,
+			args:       []interface{}{int64(10), int64(1)},
+		},
+	)
+}
+
+func (bds *bigqueryDialectSuite) TestStarExcept() {
+	bds.assertSQL(
+		sqlTestCase{
+			ds:  bds.GetDs("users").Select(bigquery.StarExcept("password", "salt")),
+			sql: "SELECT * EXCEPT (`password`, `salt`) FROM `users`",
+		},
+		sqlTestCase{
+			ds: goqu.Dialect("bigquery").From(goqu.T("users").As("u")).
+				Select(bigquery.TableStarExcept("u", "password"), goqu.I("o.id").As("order_id")).
+				Join(goqu.T("orders").As("o"), goqu.On(goqu.I("o.user_id").Eq(goqu.I("u.id")))),
+			sql: "SELECT `u`.* EXCEPT (`password`), `o`.`id` AS `order_id` FROM `users` AS `u` " +
+				"INNER JOIN `orders` AS `o` ON (`o`.`user_id` = `u`.`id`)",
+		},
+	)
+}
+
+func (bds *bigqueryDialectSuite) TestLiterals() {
+	bds.assertSQL(
+		sqlTestCase{
+			ds:  bds.GetDs("test").Where(goqu.C("name").Eq("it's a \\ test\n")),
+			sql: "SELECT * FROM `test` WHERE (`name` = 'it\\'s a \\\\ test\\n')",
+		},
+	)
+}
+
+func (bds *bigqueryDialectSuite) TestUnsupported() {
+	d := goqu.Dialect("bigquery")
+	bds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=bigquery]",
+		},
+		sqlTestCase{
+			ds:  bds.GetDs("test").Where(goqu.C("a").ILike("a%")),
+			err: "goqu: boolean operator 'ilike' not supported",
+		},
+		sqlTestCase{
+			ds:  bds.GetDs("test").Where(goqu.C("a").Like("100!%").Escape("!")),
+			err: `goqu: dialect does not support LIKE ESCAPE "!", only the default escape character \ can be used [dialect=bigquery]`,
+		},
+		sqlTestCase{
+			ds:  d.Truncate("test", "test2"),
+			err: "goqu: dialect does not support multiple tables in TRUNCATE [dialect=bigquery]",
+		},
+	)
+}
+
+func (bds *bigqueryDialectSuite) TestAtTimeZone() {
+	bds.assertSQL(
+		sqlTestCase{
+			ds:  bds.GetDs("test").Select(goqu.C("created_at").AtTimeZone("UTC")),
+			err: "goqu: dialect does not support AT TIME ZONE [dialect=bigquery]",
+		},
+	)
+}
+
+func (bds *bigqueryDialectSuite) TestCreateTable() {
+	d := goqu.Dialect("bigquery")
+	bds.assertSQL(
+		sqlTestCase{
+			ds: d.CreateTable("test").IfNotExists().Columns(
+				goqu.ColumnDef("id", goqu.BigIntType()).NotNull(),
+				goqu.ColumnDef("name", goqu.VarcharType(255)),
+				goqu.ColumnDef("created", goqu.TimestampType()),
+				goqu.ColumnDef("updated", goqu.TimestampTzType()),
+			),
+			sql: "CREATE TABLE IF NOT EXISTS `test` (`id` INT64 NOT NULL, `name` STRING(255), " +
+				"`created` DATETIME, `updated` TIMESTAMP)",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Temporary().Columns(goqu.ColumnDef("id", goqu.BigIntType())),
+			sql: "CREATE TEMP TABLE `test` (`id` INT64)",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Columns(goqu.ColumnDef("id", goqu.BigIntType()).AutoIncrement()),
+			err: "goqu: dialect does not support auto increment columns [dialect=bigquery]",
+		},
+	)
+}
+
+func (bds *bigqueryDialectSuite) TestCopy() {
+	d := goqu.Dialect("bigquery")
+	bds.assertSQL(
+		sqlTestCase{ds: d.CopyFrom("items"), err: "goqu: dialect does not support COPY [dialect=bigquery]"},
+		sqlTestCase{ds: d.CopyTo("items"), err: "goqu: dialect does not support COPY [dialect=bigquery]"},
+	)
+}
+
+func (bds *bigqueryDialectSuite) TestAlterTable() {
+	d := goqu.Dialect("bigquery")
+	bds.assertSQL(
+		sqlTestCase{
+			ds:  d.AlterTable("test").AlterColumnType("b", goqu.BigIntType()).DropNotNull("c"),
+			sql: "ALTER TABLE `test` ALTER COLUMN `b` SET DATA TYPE INT64, ALTER COLUMN `c` DROP NOT NULL",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").SetNotNull("b"),
+			err: "goqu: dialect does not support SET NOT NULL in ALTER TABLE [dialect=bigquery]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddConstraint(goqu.PrimaryKey("a")),
+			err: "goqu: dialect does not support ADD CONSTRAINT in ALTER TABLE [dialect=bigquery]",
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(bigqueryDialectSuite))
+}
@@ -0,0 +1,118 @@
+package clickhouse
+
+import (
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+// DialectOptions returns the options for clickhouse. Selects support the FINAL, SAMPLE, PREWHERE and SETTINGS clauses
+// (see SelectDataset#Final, SelectDataset#Sample, SelectDataset#Prewhere and SelectDataset#Settings).
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := goqu.DefaultDialectOptions()
+
+	opts.SupportsReturn = false
+	opts.SupportsConflict = false
+	opts.SupportsConflictTarget = false
+	opts.SupportsConflictUpdateWhere = false
+	opts.SupportsMultipleUpdateTables = false
+	opts.SupportsLateral = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.SupportsWindowFrameGroups = false
+	opts.SupportsWindowFrameExclusion = false
+	opts.MergeFragment = nil
+	opts.CallFragment = nil
+	opts.ExplainAnalyzeFragment = nil
+	opts.ExplainVerboseFragment = nil
+	opts.ExplainFormatFragment = nil
+	opts.CopyFragment = nil
+	opts.SetLocalFragment = nil
+	opts.ShowFragment = nil
+	opts.VacuumFragment = nil
+	opts.AnalyzeFragment = nil
+	opts.RandomFunction = []byte("rand()")
+	// clickhouse converts time zones with toTimeZone(<timestamp>, <time zone>)
+	opts.ConvertTimeZoneFunction = []byte("toTimeZone")
+	// every clickhouse table requires an ENGINE clause
+	opts.CreateTableFragment = nil
+
+	opts.EscapedRunes = map[rune][]byte{
+		'\'': []byte("\\'"),
+		'\\': []byte("\\\\"),
+	}
+	opts.TimeFormat = "2006-01-02 15:04:05.999999"
+
+	opts.TruncateClause = []byte("TRUNCATE TABLE")
+	opts.SupportsMultipleTruncateTables = false
+	opts.SupportsTruncateIdentity = false
+	opts.SupportsTruncateCascade = false
+
+	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
+		exp.EqOp:       []byte("="),
+		exp.NeqOp:      []byte("!="),
+		exp.GtOp:       []byte(">"),
+		exp.GteOp:      []byte(">="),
+		exp.LtOp:       []byte("<"),
+		exp.LteOp:      []byte("<="),
+		exp.InOp:       []byte("IN"),
+		exp.NotInOp:    []byte("NOT IN"),
+		exp.IsOp:       []byte("IS"),
+		exp.IsNotOp:    []byte("IS NOT"),
+		exp.LikeOp:     []byte("LIKE"),
+		exp.NotLikeOp:  []byte("NOT LIKE"),
+		exp.ILikeOp:    []byte("ILIKE"),
+		exp.NotILikeOp: []byte("NOT ILIKE"),
+	}
+	// LIKE patterns are always escaped with a backslash
+	opts.LikeEscapeFragment = nil
+	// clickhouse only supports bitwise operations through functions (e.g. bitAnd)
+	opts.BitwiseOperatorLookup = map[exp.BitwiseOperation][]byte{}
+
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.CommonTableSQLFragment,
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.FinalSQLFragment,
+		sqlgen.SampleSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.PrewhereSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.GroupBySQLFragment,
+		sqlgen.HavingSQLFragment,
+		sqlgen.WindowSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.LimitSQLFragment,
+		sqlgen.OffsetSQLFragment,
+		sqlgen.SettingsSQLFragment,
+	}
+	return opts
+}
+
+// Array creates an array literal from the values
+//    clickhouse.Array(1, 2, 3) // [1, 2, 3]
+func Array(vals ...interface{}) exp.LiteralExpression {
+	return goqu.L("["+placeholders(len(vals))+"]", vals...)
+}
+
+// Tuple creates a tuple literal from the values
+//    clickhouse.Tuple(1, "a") // (1, 'a')
+func Tuple(vals ...interface{}) exp.LiteralExpression {
+	return goqu.L("("+placeholders(len(vals))+")", vals...)
+}
+
+func placeholders(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strings.Repeat("?, ", n-1) + "?"
+}
+
+func init() {
+	goqu.RegisterDialect("clickhouse", DialectOptions())
+}
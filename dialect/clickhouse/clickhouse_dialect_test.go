@@ -0,0 +1,219 @@
+package clickhouse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/clickhouse"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	clickhouseDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exec.Statement
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (cds *clickhouseDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("clickhouse").From(table)
+}
+
+func (cds *clickhouseDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			cds.NoError(err, "test case %d failed", i)
+		} else {
+			cds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		cds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			cds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			cds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (cds *clickhouseDialectSuite) TestFinalAndSample() {
+	ds := cds.GetDs("events")
+	cds.assertSQL(
+		sqlTestCase{ds: ds.Final(), sql: `SELECT * FROM "events" FINAL`},
+		sqlTestCase{ds: ds.Sample(0.1), sql: `SELECT * FROM "events" SAMPLE 0.1`},
+		sqlTestCase{ds: ds.SampleOffset(0.1, 0.5), sql: `SELECT * FROM "events" SAMPLE 0.1 OFFSET 0.5`},
+		sqlTestCase{ds: ds.Final().Sample(1000), sql: `SELECT * FROM "events" FINAL SAMPLE 1000`},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Final().Sample(0.1),
+			sql:        `SELECT * FROM "events" FINAL SAMPLE ?`,
+			isPrepared: true,
+			args:       []interface{}{0.1},
+		},
+		sqlTestCase{
+			ds: ds.Final().Join(goqu.T("users"), goqu.On(goqu.I("events.user_id").Eq(goqu.I("users.id")))),
+			sql: `SELECT * FROM "events" FINAL ` +
+				`INNER JOIN "users" ON ("events"."user_id" = "users"."id")`,
+		},
+	)
+}
+
+func (cds *clickhouseDialectSuite) TestPrewhere() {
+	ds := cds.GetDs("events")
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Prewhere(goqu.C("date").Gte("2021-01-01")),
+			sql: `SELECT * FROM "events" PREWHERE ("date" >= '2021-01-01')`,
+		},
+		sqlTestCase{
+			ds: ds.Prewhere(goqu.C("date").Gte("2021-01-01")).
+				Prewhere(goqu.C("type").Eq("click")).
+				Where(goqu.C("user_id").Eq(1)),
+			sql: `SELECT * FROM "events" PREWHERE (("date" >= '2021-01-01') AND ("type" = 'click')) ` +
+				`WHERE ("user_id" = 1)`,
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Prewhere(goqu.C("date").Gte("2021-01-01")).Where(goqu.C("user_id").Eq(1)),
+			sql:        `SELECT * FROM "events" PREWHERE ("date" >= ?) WHERE ("user_id" = ?)`,
+			isPrepared: true,
+			args:       []interface{}{"2021-01-01", int64(1)},
+		},
+		sqlTestCase{
+			ds:  ds.Prewhere(goqu.C("date").Gte("2021-01-01")).ClearPrewhere(),
+			sql: `SELECT * FROM "events"`,
+		},
+	)
+}
+
+func (cds *clickhouseDialectSuite) TestSettings() {
+	ds := cds.GetDs("events")
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Settings(goqu.Record{"max_threads": 8}),
+			sql: `SELECT * FROM "events" SETTINGS max_threads=8`,
+		},
+		sqlTestCase{
+			ds: ds.Where(goqu.C("a").Eq(1)).Limit(10).
+				Settings(goqu.Record{"max_threads": 8}).
+				Settings(goqu.Record{"join_algorithm": "hash"}),
+			sql: `SELECT * FROM "events" WHERE ("a" = 1) LIMIT 10 SETTINGS join_algorithm='hash', max_threads=8`,
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(goqu.C("a").Eq(1)).Settings(goqu.Record{"max_threads": 8}),
+			sql:        `SELECT * FROM "events" WHERE ("a" = ?) SETTINGS max_threads=8`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		sqlTestCase{
+			ds:  ds.Settings(goqu.Record{"max_threads = 8 --": 8}),
+			err: `goqu: setting name "max_threads = 8 --" must only contain letters, digits and _ [dialect=clickhouse]`,
+		},
+	)
+}
+
+func (cds *clickhouseDialectSuite) TestArrayAndTupleLiterals() {
+	ds := cds.GetDs("events")
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(clickhouse.Array(1, 2, 3).As("a"), clickhouse.Tuple(1, "b").As("t")),
+			sql: `SELECT [1, 2, 3] AS "a", (1, 'b') AS "t" FROM "events"`,
+		},
+		sqlTestCase{
+			ds:  ds.Where(goqu.Func("has", goqu.C("tags"), "a").Eq(1), goqu.C("tags").Eq(clickhouse.Array("a", "b"))),
+			sql: `SELECT * FROM "events" WHERE ((has("tags", 'a') = 1) AND ("tags" = ['a', 'b']))`,
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(goqu.C("tags").Eq(clickhouse.Array("a", "b"))),
+			sql:        `SELECT * FROM "events" WHERE ("tags" = [?, ?])`,
+			isPrepared: true,
+			args:       []interface{}{"a", "b"},
+		},
+		sqlTestCase{
+			ds:  ds.Select(clickhouse.Array()),
+			sql: `SELECT [] FROM "events"`,
+		},
+	)
+}
+
+func (cds *clickhouseDialectSuite) TestLiterals() {
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 8000, time.UTC)
+	ds := cds.GetDs("events")
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(goqu.C("name").Eq(`it's a \ test`)),
+			sql: `SELECT * FROM "events" WHERE ("name" = 'it\'s a \\ test')`,
+		},
+		sqlTestCase{
+			ds:  ds.Where(goqu.C("created").Gt(ts)),
+			sql: `SELECT * FROM "events" WHERE ("created" > '2021-03-04 05:06:07.000008')`,
+		},
+	)
+}
+
+func (cds *clickhouseDialectSuite) TestMaintenance() {
+	d := goqu.Dialect("clickhouse")
+	cds.assertSQL(
+		sqlTestCase{ds: d.Vacuum("items"), err: "goqu: dialect does not support VACUUM [dialect=clickhouse]"},
+		sqlTestCase{ds: d.Analyze("items"), err: "goqu: dialect does not support ANALYZE [dialect=clickhouse]"},
+		sqlTestCase{ds: d.Optimize("items"), err: "goqu: dialect does not support OPTIMIZE [dialect=clickhouse]"},
+	)
+}
+
+func (cds *clickhouseDialectSuite) TestSetParam() {
+	d := goqu.Dialect("clickhouse")
+	cds.assertSQL(
+		sqlTestCase{ds: d.SetParam("max_threads", 8), sql: `SET "max_threads" = 8`},
+		sqlTestCase{
+			ds:  d.SetParam("max_threads", 8).Local(),
+			err: "goqu: dialect does not support SET LOCAL [dialect=clickhouse]",
+		},
+		sqlTestCase{ds: d.Show("max_threads"), err: "goqu: dialect does not support SHOW [dialect=clickhouse]"},
+	)
+}
+
+func (cds *clickhouseDialectSuite) TestUnsupported() {
+	d := goqu.Dialect("clickhouse")
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=clickhouse]",
+		},
+		sqlTestCase{
+			ds:  cds.GetDs("test").Where(goqu.C("a").RegexpLike("a.*")),
+			err: "goqu: boolean operator 'regexplike' not supported",
+		},
+		sqlTestCase{
+			ds:  d.Truncate("test", "test2"),
+			err: "goqu: dialect does not support multiple tables in TRUNCATE [dialect=clickhouse]",
+		},
+	)
+}
+
+func (cds *clickhouseDialectSuite) TestAtTimeZone() {
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  cds.GetDs("test").Select(goqu.C("created_at").AtTimeZone("UTC")),
+			sql: `SELECT toTimeZone("created_at", 'UTC') FROM "test"`,
+		},
+	)
+}
+
+func (cds *clickhouseDialectSuite) TestCreateTable() {
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  goqu.Dialect("clickhouse").CreateTable("test").Columns(goqu.ColumnDef("id", goqu.IntegerType())),
+			err: "goqu: dialect does not support CREATE TABLE [dialect=clickhouse]",
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(clickhouseDialectSuite))
+}
@@ -0,0 +1,71 @@
+package cockroachdb
+
+import (
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+// DialectOptions returns the postgres options with support for cockroachdb specific clauses
+// (e.g. AS OF SYSTEM TIME).
+func DialectOptions() *goqu.SQLDialectOptions {
+	do := postgres.DialectOptions()
+	do.SupportsTempTableOnCommit = false
+	do.SupportsListenNotify = false
+	// tables are created from a query using CREATE TABLE ... AS
+	do.SelectIntoFragment = nil
+	do.SelectIntoTempFragment = nil
+	do.SupportsFetchWithTies = false
+	// upserts use INSERT ... ON CONFLICT or UPSERT
+	do.MergeFragment = nil
+	do.ExplainFormatFragment = nil
+	// ANALYZE is an alias for CREATE STATISTICS on a single table, VACUUM is not supported
+	do.VacuumFragment = nil
+	do.SupportsMaintenanceWithoutTables = false
+	do.MaintenanceVerboseFragment = nil
+	do.MaintenanceSkipLockedFragment = nil
+	do.RollupFragment = nil
+	do.CubeFragment = nil
+	do.GroupingSetsFragment = nil
+	do.CTESearchFragment = nil
+	do.CTECycleFragment = nil
+	do.XMLTableFragment = nil
+	// cockroachdb does not support table inheritance
+	do.TableOnlyFragment = nil
+	// arrays can be accessed by index but not sliced
+	do.SupportsArraySlice = false
+	// cockroachdb does not support range types
+	do.SupportsRangeTypes = false
+	do.RangeTypeOperatorLookup = nil
+
+	do.SupportsAsOfSystemTime = true
+	do.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.CommonTableSQLFragment,
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.AsOfSystemTimeSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.GroupBySQLFragment,
+		sqlgen.HavingSQLFragment,
+		sqlgen.WindowSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.LimitSQLFragment,
+		sqlgen.OffsetSQLFragment,
+		sqlgen.ForSQLFragment,
+	}
+	return do
+}
+
+// Nothing can be passed to Returning to generate RETURNING NOTHING so the statement does not return any rows.
+//    db.Insert("users").Rows(goqu.Record{"name": "Bob"}).Returning(cockroachdb.Nothing())
+//    // INSERT INTO "users" ("name") VALUES ('Bob') RETURNING NOTHING
+func Nothing() exp.LiteralExpression {
+	return goqu.L("NOTHING")
+}
+
+func init() {
+	goqu.RegisterDialect("cockroachdb", DialectOptions())
+}
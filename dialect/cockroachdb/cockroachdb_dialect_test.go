@@ -0,0 +1,217 @@
+package cockroachdb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/cockroachdb"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	cockroachDBDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exec.Statement
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (cds *cockroachDBDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("cockroachdb").From(table)
+}
+
+func (cds *cockroachDBDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			cds.NoError(err, "test case %d failed", i)
+		} else {
+			cds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		cds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			cds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			cds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (cds *cockroachDBDialectSuite) TestPlaceholders() {
+	cds.assertSQL(
+		sqlTestCase{
+			ds:         cds.GetDs("test").Prepared(true).Where(goqu.C("a").Eq(1), goqu.C("b").Eq("c")),
+			sql:        `SELECT * FROM "test" WHERE (("a" = $1) AND ("b" = $2))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "c"},
+		},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestAsOfSystemTime() {
+	ds := cds.GetDs("test")
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  ds.AsOfSystemTime("-10s").Where(goqu.C("a").Eq(1)),
+			sql: `SELECT * FROM "test" AS OF SYSTEM TIME '-10s' WHERE ("a" = 1)`,
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).AsOfSystemTime(ts).Where(goqu.C("a").Eq(1)),
+			sql:        `SELECT * FROM "test" AS OF SYSTEM TIME '2021-03-04T05:06:07Z' WHERE ("a" = $1)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		sqlTestCase{
+			ds: ds.AsOfSystemTime(goqu.L("follower_read_timestamp()")).
+				Join(goqu.T("test2"), goqu.On(goqu.I("test.id").Eq(goqu.I("test2.test_id")))).
+				Order(goqu.C("a").Asc()).
+				Limit(10),
+			sql: `SELECT * FROM "test" INNER JOIN "test2" ON ("test"."id" = "test2"."test_id") ` +
+				`AS OF SYSTEM TIME follower_read_timestamp() ORDER BY "a" ASC LIMIT 10`,
+		},
+		sqlTestCase{
+			ds:  goqu.Dialect("postgres").From("test").AsOfSystemTime("-10s"),
+			err: "goqu: dialect does not support AS OF SYSTEM TIME [dialect=postgres]",
+		},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestFetch() {
+	ds := goqu.Dialect("cockroachdb").From("test").Order(goqu.C("score").Desc())
+	cds.assertSQL(
+		sqlTestCase{ds: ds.Fetch(goqu.FetchFirst(3)), sql: `SELECT * FROM "test" ORDER BY "score" DESC LIMIT 3`},
+		sqlTestCase{
+			ds:  ds.Fetch(goqu.FetchFirst(3).WithTies()),
+			err: "goqu: dialect does not support FETCH FIRST WITH TIES [dialect=cockroachdb]",
+		},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestSelectInto() {
+	ds := goqu.Dialect("cockroachdb").From("users")
+	cds.assertSQL(
+		sqlTestCase{ds: ds.Into("staging"), err: "goqu: dialect does not support SELECT INTO [dialect=cockroachdb]"},
+		sqlTestCase{
+			ds:  ds.IntoTemp("staging"),
+			err: "goqu: dialect does not support SELECT INTO a temporary table [dialect=cockroachdb]",
+		},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestGrouping() {
+	ds := goqu.Dialect("cockroachdb").From("sales")
+	cds.assertSQL(
+		sqlTestCase{ds: ds.GroupBy(goqu.Rollup("region")), err: "goqu: dialect does not support ROLLUP [dialect=cockroachdb]"},
+		sqlTestCase{ds: ds.GroupBy(goqu.Cube("region")), err: "goqu: dialect does not support CUBE [dialect=cockroachdb]"},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestMaintenance() {
+	d := goqu.Dialect("cockroachdb")
+	cds.assertSQL(
+		sqlTestCase{ds: d.Analyze("items"), sql: `ANALYZE "items"`},
+		sqlTestCase{ds: d.Analyze(), err: "goqu: at least one table is required for ANALYZE [dialect=cockroachdb]"},
+		sqlTestCase{ds: d.Vacuum("items"), err: "goqu: dialect does not support VACUUM [dialect=cockroachdb]"},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestSetParam() {
+	d := goqu.Dialect("cockroachdb")
+	cds.assertSQL(
+		sqlTestCase{ds: d.SetParam("statement_timeout", "5s").Local(), sql: `SET LOCAL "statement_timeout" = '5s'`},
+		sqlTestCase{ds: d.Show("search_path"), sql: `SHOW "search_path"`},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestReturningNothing() {
+	d := goqu.Dialect("cockroachdb")
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning(cockroachdb.Nothing()),
+			sql: `INSERT INTO "test" ("a") VALUES (1) RETURNING NOTHING`,
+		},
+		sqlTestCase{
+			ds:  d.Update("test").Set(goqu.Record{"a": 1}).Returning(cockroachdb.Nothing()),
+			sql: `UPDATE "test" SET "a"=1 RETURNING NOTHING`,
+		},
+		sqlTestCase{
+			ds:  d.Delete("test").Where(goqu.C("a").Eq(1)).Returning(cockroachdb.Nothing()),
+			sql: `DELETE FROM "test" WHERE ("a" = 1) RETURNING NOTHING`,
+		},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestXMLTable() {
+	xt := goqu.XMLTable("/rows/row", "data").Columns(goqu.XMLColumn("id", goqu.IntegerType()).Path("@id"))
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  goqu.Dialect("cockroachdb").From(goqu.T("docs"), xt),
+			err: "goqu: dialect does not support XMLTABLE [dialect=cockroachdb]",
+		},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestTableFunctions() {
+	d := goqu.Dialect("cockroachdb")
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  d.From(goqu.Func("generate_series", 1, 3).WithOrdinality().As("g").Columns("v", "n")),
+			sql: `SELECT * FROM generate_series(1, 3) WITH ORDINALITY AS "g"("v", "n")`,
+		},
+		sqlTestCase{
+			ds:  d.From(goqu.RowsFrom(goqu.Func("generate_series", 1, 3), goqu.Func("generate_series", 4, 6))),
+			sql: `SELECT * FROM ROWS FROM (generate_series(1, 3), generate_series(4, 6))`,
+		},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestTableOnly() {
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  goqu.Dialect("cockroachdb").From(goqu.HintTable(goqu.T("parent")).Only()),
+			err: "goqu: dialect does not support the ONLY table hint [dialect=cockroachdb]",
+		},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestArrays() {
+	d := goqu.Dialect("cockroachdb")
+	cds.assertSQL(
+		sqlTestCase{
+			ds: d.From("posts").
+				Select("id", goqu.Array("tags").Index(1).As("first_tag")).
+				Where(goqu.Array("tags").Overlaps(goqu.ArrayOf("go", "sql"))),
+			sql: `SELECT "id", "tags"[1] AS "first_tag" FROM "posts" WHERE ("tags" && ARRAY['go', 'sql'])`,
+		},
+		sqlTestCase{
+			ds:  d.From("posts").Select(goqu.Array("tags").Slice(1, 2)),
+			err: "goqu: dialect does not support array operation Slice [dialect=cockroachdb]",
+		},
+	)
+}
+
+func (cds *cockroachDBDialectSuite) TestRangeTypes() {
+	d := goqu.Dialect("cockroachdb")
+	cds.assertSQL(
+		sqlTestCase{
+			ds:  d.From("bookings").Where(goqu.RangeOf("during").Overlaps(goqu.C("other"))),
+			err: "goqu: dialect does not support range operation Overlap [dialect=cockroachdb]",
+		},
+		sqlTestCase{
+			ds:  d.From("bookings").Select(goqu.Int4Range(1, 10)),
+			err: "goqu: dialect does not support range types [dialect=cockroachdb]",
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(cockroachDBDialectSuite))
+}
@@ -0,0 +1,66 @@
+package db2
+
+import (
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := goqu.DefaultDialectOptions()
+
+	opts.SupportsReturn = false
+	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.SupportsConflictTarget = false
+	opts.SupportsConflictUpdateWhere = false
+	opts.RandomFunction = []byte("RAND()")
+	// a SELECT without a FROM (e.g. ExistsDataset) selects from the single row SYSIBM.SYSDUMMY1 table
+	opts.DualFromFragment = []byte(" FROM SYSIBM.SYSDUMMY1")
+	// db2 does not support FILTER on aggregates so they are rewritten using CASE
+	opts.AggregateFilterFragment = nil
+	opts.XMLTableFragment = []byte("XMLTABLE")
+	// db2 loads data using the LOAD and IMPORT commands
+	opts.CopyFragment = nil
+
+	// db2 folds unquoted identifiers to upper case
+	opts.UpperCaseIdentifiers = true
+	// db2 does not use the RECURSIVE keyword for recursive common table expressions
+	opts.RecursiveFragment = []byte("")
+	opts.TimeFormat = "2006-01-02 15:04:05.000000"
+
+	opts.TruncateClause = []byte("TRUNCATE TABLE")
+	opts.TruncateSuffixFragment = []byte(" IMMEDIATE")
+	opts.SupportsMultipleTruncateTables = false
+	opts.SupportsTruncateCascade = false
+
+	// the actions of an ALTER TABLE are not separated by commas and tables are renamed using RENAME TABLE
+	opts.SupportsMultipleAlterTableActions = false
+	opts.ColumnTypeFragment = []byte(" SET DATA TYPE ")
+	opts.RenameTableFragment = nil
+
+	opts.FetchFragment = []byte(" FETCH FIRST ")
+	// db2 does not support DEFAULT VALUES, a row without any values cannot be inserted
+	opts.DefaultValuesFragment = nil
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.CommonTableSQLFragment,
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.GroupBySQLFragment,
+		sqlgen.HavingSQLFragment,
+		sqlgen.WindowSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.OffsetFetchSQLFragment,
+		sqlgen.ForSQLFragment,
+	}
+	return opts
+}
+
+func init() {
+	goqu.RegisterDialect("db2", DialectOptions())
+}
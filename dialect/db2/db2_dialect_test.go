@@ -0,0 +1,264 @@
+package db2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/db2"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	db2DialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exec.Statement
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (dds *db2DialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("db2").From(table)
+}
+
+func (dds *db2DialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			dds.NoError(err, "test case %d failed", i)
+		} else {
+			dds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		dds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			dds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			dds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (dds *db2DialectSuite) TestIdentifiers() {
+	dds.assertSQL(
+		sqlTestCase{
+			ds:  dds.GetDs("myschema.users").Select("id", goqu.I("users.name").As("user_name")),
+			sql: `SELECT "ID", "USERS"."NAME" AS "USER_NAME" FROM "MYSCHEMA"."USERS"`,
+		},
+	)
+}
+
+func (dds *db2DialectSuite) TestPlaceholders() {
+	dds.assertSQL(
+		sqlTestCase{
+			ds:         dds.GetDs("test").Prepared(true).Where(goqu.C("a").Eq(1), goqu.C("b").In([]string{"a", "b"})),
+			sql:        `SELECT * FROM "TEST" WHERE (("A" = ?) AND ("B" IN (?, ?)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", "b"},
+		},
+		sqlTestCase{
+			ds:         goqu.Dialect("db2").Insert("test").Prepared(true).Rows(goqu.Record{"a": 1, "b": "c"}),
+			sql:        `INSERT INTO "TEST" ("A", "B") VALUES (?, ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "c"},
+		},
+	)
+}
+
+func (dds *db2DialectSuite) TestTime() {
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 8000, time.UTC)
+	dds.assertSQL(
+		sqlTestCase{
+			ds:  dds.GetDs("test").Where(goqu.C("created").Gt(ts)),
+			sql: `SELECT * FROM "TEST" WHERE ("CREATED" > '2021-03-04 05:06:07.000008')`,
+		},
+	)
+}
+
+func (dds *db2DialectSuite) TestLimitOffset() {
+	ds := dds.GetDs("test").Order(goqu.C("a").Asc())
+	dds.assertSQL(
+		sqlTestCase{ds: ds.Limit(10), sql: `SELECT * FROM "TEST" ORDER BY "A" ASC FETCH FIRST 10 ROWS ONLY`},
+		sqlTestCase{ds: ds.Offset(20), sql: `SELECT * FROM "TEST" ORDER BY "A" ASC OFFSET 20 ROWS`},
+		sqlTestCase{
+			ds:  ds.Limit(10).Offset(20),
+			sql: `SELECT * FROM "TEST" ORDER BY "A" ASC OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY`,
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Limit(10).Offset(20),
+			sql:        `SELECT * FROM "TEST" ORDER BY "A" ASC OFFSET ? ROWS FETCH FIRST ? ROWS ONLY`,
+			isPrepared: true,
+			args:       []interface{}{int64(20), int64(10)},
+		},
+	)
+}
+
+func (dds *db2DialectSuite) TestAggregateFilter() {
+	ds := dds.GetDs("orders")
+	dds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.COUNT("*").Filter(goqu.C("status").Eq("paid")).As("paid")),
+			sql: `SELECT SUM(CASE  WHEN ("STATUS" = 'paid') THEN 1 ELSE 0 END) AS "PAID" FROM "ORDERS"`,
+		},
+	)
+}
+
+func (dds *db2DialectSuite) TestCommonTables() {
+	dds.assertSQL(
+		sqlTestCase{
+			ds: goqu.Dialect("db2").From("nums").
+				WithRecursive("nums(n)", goqu.Dialect("db2").From("sysibm.sysdummy1").Select(goqu.L("1")).
+					UnionAll(goqu.Dialect("db2").From("nums").Select(goqu.L("n + 1")).Where(goqu.C("n").Lt(5)))),
+			sql: `WITH nums(n) AS (SELECT 1 FROM "SYSIBM"."SYSDUMMY1" UNION ALL ` +
+				`(SELECT n + 1 FROM "NUMS" WHERE ("N" < 5))) SELECT * FROM "NUMS"`,
+		},
+	)
+}
+
+func (dds *db2DialectSuite) TestUnsupported() {
+	d := goqu.Dialect("db2")
+	dds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=db2]",
+		},
+		sqlTestCase{
+			ds:  dds.GetDs("test").Distinct("a"),
+			err: "goqu: dialect does not support DISTINCT ON clause [dialect=db2]",
+		},
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{}),
+			err: "goqu: dialect does not support inserting a row of DEFAULT VALUES [dialect=db2]",
+		},
+	)
+}
+
+func (dds *db2DialectSuite) TestCountAndExists() {
+	ds := dds.GetDs("test").Where(goqu.C("a").Gt(10))
+	dds.assertSQL(
+		sqlTestCase{
+			ds:  ds.ExistsDataset(),
+			sql: `SELECT CASE  WHEN EXISTS (SELECT * FROM "TEST" WHERE ("A" > 10)) THEN 1 ELSE 0 END FROM SYSIBM.SYSDUMMY1`,
+		},
+		sqlTestCase{ds: goqu.Dialect("db2").Select(goqu.L("1")), sql: `SELECT 1 FROM SYSIBM.SYSDUMMY1`},
+	)
+}
+
+func (dds *db2DialectSuite) TestTruncate() {
+	d := goqu.Dialect("db2")
+	dds.assertSQL(
+		sqlTestCase{ds: d.Truncate("test"), sql: `TRUNCATE TABLE "TEST" IMMEDIATE`},
+		sqlTestCase{ds: d.Truncate("test").Identity("restart"), sql: `TRUNCATE TABLE "TEST" RESTART IDENTITY IMMEDIATE`},
+		sqlTestCase{
+			ds:  d.Truncate("test", "test2"),
+			err: "goqu: dialect does not support multiple tables in TRUNCATE [dialect=db2]",
+		},
+		sqlTestCase{
+			ds:  d.Truncate("test").Cascade(),
+			err: "goqu: dialect does not support CASCADE or RESTRICT in TRUNCATE [dialect=db2]",
+		},
+	)
+}
+
+func (dds *db2DialectSuite) TestMerge() {
+	d := goqu.Dialect("db2")
+	source := d.From("new_users").As("n")
+	dds.assertSQL(
+		sqlTestCase{
+			ds: d.Merge("users").
+				Using(source).
+				On(goqu.I("users.id").Eq(goqu.I("n.id"))).
+				WhenMatchedThenUpdate(goqu.Record{"name": goqu.I("n.name")}).
+				WhenNotMatchedThenInsert(goqu.Record{"id": goqu.I("n.id"), "name": goqu.I("n.name")}),
+			sql: `MERGE INTO "USERS" USING (SELECT * FROM "NEW_USERS") AS "N" ON ("USERS"."ID" = "N"."ID") ` +
+				`WHEN MATCHED THEN UPDATE SET "NAME"="N"."NAME" ` +
+				`WHEN NOT MATCHED THEN INSERT ("ID", "NAME") VALUES ("N"."ID", "N"."NAME")`,
+		},
+		sqlTestCase{
+			ds: d.Merge("users").
+				Prepared(true).
+				Using("archived_users").
+				On(goqu.I("users.id").Eq(goqu.I("archived_users.id")), goqu.I("archived_users.active").IsFalse()).
+				WhenMatchedThenDelete(),
+			sql: `MERGE INTO "USERS" USING "ARCHIVED_USERS" ` +
+				`ON (("USERS"."ID" = "ARCHIVED_USERS"."ID") AND ("ARCHIVED_USERS"."ACTIVE" IS FALSE)) ` +
+				`WHEN MATCHED THEN DELETE`,
+			isPrepared: true,
+		},
+		sqlTestCase{
+			ds: d.Merge("users").
+				Prepared(true).
+				Using(goqu.T("staged").As("s")).
+				On(goqu.I("users.id").Eq(goqu.I("s.id"))).
+				WhenMatchedThenUpdate(goqu.Record{"visits": goqu.L("? + 1", goqu.I("users.visits"))}).
+				WhenNotMatchedThenInsert(goqu.Record{"id": goqu.I("s.id"), "visits": 1}),
+			sql: `MERGE INTO "USERS" USING "STAGED" AS "S" ON ("USERS"."ID" = "S"."ID") ` +
+				`WHEN MATCHED THEN UPDATE SET "VISITS"="USERS"."VISITS" + 1 ` +
+				`WHEN NOT MATCHED THEN INSERT ("ID", "VISITS") VALUES ("S"."ID", ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		sqlTestCase{ds: d.Merge("users"), err: "goqu: a source is required when generating merge sql"},
+		sqlTestCase{
+			ds:  d.Merge("users").Using("staged"),
+			err: "goqu: an ON condition is required when generating merge sql",
+		},
+		sqlTestCase{
+			ds:  d.Merge("users").Using("staged").On(goqu.I("users.id").Eq(goqu.I("staged.id"))),
+			err: "goqu: at least one WHEN clause is required when generating merge sql",
+		},
+	)
+}
+
+func (dds *db2DialectSuite) TestXMLTable() {
+	xt := goqu.XMLTable("/rows/row", "data").Columns(
+		goqu.XMLColumn("id", goqu.IntegerType()).Path("@id"),
+		goqu.XMLOrdinalityColumn("n"),
+	).As("x")
+	dds.assertSQL(
+		sqlTestCase{
+			ds: goqu.Dialect("db2").From(goqu.T("docs"), xt).Select("x.id"),
+			sql: `SELECT "X"."ID" FROM "DOCS", ` +
+				`XMLTABLE('/rows/row' PASSING "DATA" COLUMNS "ID" INTEGER PATH '@id', "N" FOR ORDINALITY) AS "X"`,
+		},
+	)
+}
+
+func (dds *db2DialectSuite) TestCopy() {
+	d := goqu.Dialect("db2")
+	dds.assertSQL(
+		sqlTestCase{ds: d.CopyFrom("items"), err: "goqu: dialect does not support COPY [dialect=db2]"},
+		sqlTestCase{ds: d.CopyTo("items"), err: "goqu: dialect does not support COPY [dialect=db2]"},
+	)
+}
+
+func (dds *db2DialectSuite) TestAlterTable() {
+	d := goqu.Dialect("db2")
+	dds.assertSQL(
+		sqlTestCase{
+			ds:  d.AlterTable("test").AlterColumnType("b", goqu.BigIntType()),
+			sql: `ALTER TABLE "TEST" ALTER COLUMN "B" SET DATA TYPE BIGINT`,
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").SetNotNull("b"),
+			sql: `ALTER TABLE "TEST" ALTER COLUMN "B" SET NOT NULL`,
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.IntegerType())).DropColumn("c"),
+			err: "goqu: dialect does not support multiple actions in ALTER TABLE [dialect=db2]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").RenameTo("test2"),
+			err: "goqu: dialect does not support RENAME TO in ALTER TABLE [dialect=db2]",
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(db2DialectSuite))
+}
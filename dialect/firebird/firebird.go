@@ -0,0 +1,95 @@
+package firebird
+
+import (
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := goqu.DefaultDialectOptions()
+
+	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.ExceptFragment = nil
+	opts.ExceptAllFragment = nil
+	opts.RandomFunction = []byte("RAND()")
+	// a SELECT without a FROM (e.g. ExistsDataset) selects from the single row RDB$DATABASE table
+	opts.DualFromFragment = []byte(" FROM RDB$DATABASE")
+	opts.SupportsConflict = false
+	opts.SupportsConflictTarget = false
+	opts.SupportsConflictUpdateWhere = false
+	opts.SupportsMultipleUpdateTables = false
+	opts.SupportsWindowFrameGroups = false
+	opts.SupportsWindowFrameExclusion = false
+	opts.RollupFragment = nil
+	opts.CubeFragment = nil
+	opts.GroupingSetsFragment = nil
+	opts.CallFragment = []byte("EXECUTE PROCEDURE ")
+	opts.ExplainFragment = nil
+	opts.CopyFragment = nil
+	opts.SetParamFragment = nil
+	opts.ShowFragment = nil
+	opts.VacuumFragment = nil
+	opts.AnalyzeFragment = nil
+
+	// firebird folds unquoted identifiers to upper case
+	opts.UpperCaseIdentifiers = true
+	// firebird before 4.0 (and interbase) limits identifiers to 31 characters
+	opts.MaxIdentifierLength = 31
+	opts.TimeFormat = "2006-01-02 15:04:05.0000"
+
+	opts.SupportsCreateTableIfNotExists = false
+	opts.CreateTempTableFragment = []byte("CREATE GLOBAL TEMPORARY TABLE ")
+	// TIMESTAMP WITH TIME ZONE was added in firebird 4.0 (see DialectOptionsV4)
+	opts.DataTypeLookup = map[exp.DataTypeKind][]byte{
+		exp.SmallIntDataType:  []byte("SMALLINT"),
+		exp.IntegerDataType:   []byte("INTEGER"),
+		exp.BigIntDataType:    []byte("BIGINT"),
+		exp.DecimalDataType:   []byte("DECIMAL"),
+		exp.RealDataType:      []byte("FLOAT"),
+		exp.DoubleDataType:    []byte("DOUBLE PRECISION"),
+		exp.BooleanDataType:   []byte("BOOLEAN"),
+		exp.CharDataType:      []byte("CHAR"),
+		exp.VarcharDataType:   []byte("VARCHAR"),
+		exp.TextDataType:      []byte("BLOB SUB_TYPE TEXT"),
+		exp.DateDataType:      []byte("DATE"),
+		exp.TimeDataType:      []byte("TIME"),
+		exp.TimestampDataType: []byte("TIMESTAMP"),
+		exp.BinaryDataType:    []byte("BLOB"),
+		exp.UUIDDataType:      []byte("CHAR(16) CHARACTER SET OCTETS"),
+		exp.JSONDataType:      []byte("BLOB SUB_TYPE TEXT"),
+	}
+
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.CommonTableSQLFragment,
+		sqlgen.SelectWithFirstSkipSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.GroupBySQLFragment,
+		sqlgen.HavingSQLFragment,
+		sqlgen.WindowSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.ForSQLFragment,
+	}
+	return opts
+}
+
+// DialectOptionsV4 returns the options for firebird 4.0+ which allows identifiers of up to 63 characters and has a
+// TIMESTAMP WITH TIME ZONE data type.
+func DialectOptionsV4() *goqu.SQLDialectOptions {
+	opts := DialectOptions()
+	opts.MaxIdentifierLength = 63
+	opts.DataTypeLookup[exp.TimestampTzDataType] = []byte("TIMESTAMP WITH TIME ZONE")
+	return opts
+}
+
+func init() {
+	goqu.RegisterDialect("firebird", DialectOptions())
+	goqu.RegisterDialect("firebird4", DialectOptionsV4())
+}
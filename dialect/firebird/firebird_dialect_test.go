@@ -0,0 +1,235 @@
+package firebird_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/firebird"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	firebirdDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exp.SQLExpression
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (fds *firebirdDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("firebird").From(table)
+}
+
+func (fds *firebirdDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			fds.NoError(err, "test case %d failed", i)
+		} else {
+			fds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		fds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			fds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			fds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (fds *firebirdDialectSuite) TestIdentifiers() {
+	fds.assertSQL(
+		sqlTestCase{
+			ds:  fds.GetDs("customers").Select("id", goqu.I("customers.name").As("customer_name")),
+			sql: `SELECT "ID", "CUSTOMERS"."NAME" AS "CUSTOMER_NAME" FROM "CUSTOMERS"`,
+		},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestIdentifierLength() {
+	longName := "customer_orders_with_a_very_long_name"
+	fds.assertSQL(
+		sqlTestCase{
+			ds:  fds.GetDs("customer_orders_archive_2023_q4").Select("id"),
+			sql: `SELECT "ID" FROM "CUSTOMER_ORDERS_ARCHIVE_2023_Q4"`,
+		},
+		sqlTestCase{
+			ds: fds.GetDs(longName),
+			err: `goqu: identifier "customer_orders_with_a_very_long_name" is longer than the maximum identifier ` +
+				`length of 31 characters [dialect=firebird]`,
+		},
+		sqlTestCase{
+			ds: fds.GetDs("customers").Select(goqu.C("name").As(longName)),
+			err: `goqu: identifier "customer_orders_with_a_very_long_name" is longer than the maximum identifier ` +
+				`length of 31 characters [dialect=firebird]`,
+		},
+		sqlTestCase{
+			ds:  goqu.Dialect("firebird4").From(longName),
+			sql: `SELECT * FROM "CUSTOMER_ORDERS_WITH_A_VERY_LONG_NAME"`,
+		},
+		sqlTestCase{
+			ds: goqu.Dialect("firebird4").From(longName + longName),
+			err: `goqu: identifier "` + longName + longName + `" is longer than the maximum identifier ` +
+				`length of 63 characters [dialect=firebird4]`,
+		},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestFirstSkip() {
+	ds := fds.GetDs("test").Order(goqu.C("a").Asc())
+	fds.assertSQL(
+		sqlTestCase{ds: ds.Limit(10), sql: `SELECT FIRST 10 * FROM "TEST" ORDER BY "A" ASC`},
+		sqlTestCase{ds: ds.Offset(20), sql: `SELECT SKIP 20 * FROM "TEST" ORDER BY "A" ASC`},
+		sqlTestCase{ds: ds.Limit(10).Offset(20), sql: `SELECT FIRST 10 SKIP 20 * FROM "TEST" ORDER BY "A" ASC`},
+		sqlTestCase{
+			ds:  ds.Select("a").Distinct().Limit(10).Offset(20),
+			sql: `SELECT FIRST 10 SKIP 20 DISTINCT "A" FROM "TEST" ORDER BY "A" ASC`,
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(goqu.C("b").Eq(1)).Limit(10).Offset(20),
+			sql:        `SELECT FIRST (?) SKIP (?) * FROM "TEST" WHERE ("B" = ?) ORDER BY "A" ASC`,
+			isPrepared: true,
+			args:       []interface{}{int64(10), int64(20), int64(1)},
+		},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestReturning() {
+	d := goqu.Dialect("firebird")
+	fds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			sql: `INSERT INTO "TEST" ("A") VALUES (1) RETURNING "ID"`,
+		},
+		sqlTestCase{
+			ds:  d.Update("test").Set(goqu.Record{"a": 1}).Where(goqu.C("id").Eq(2)).Returning("a"),
+			sql: `UPDATE "TEST" SET "A"=1 WHERE ("ID" = 2) RETURNING "A"`,
+		},
+		sqlTestCase{
+			ds:  d.Delete("test").Where(goqu.C("id").Eq(2)).Returning("id"),
+			sql: `DELETE FROM "TEST" WHERE ("ID" = 2) RETURNING "ID"`,
+		},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestTime() {
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 123456789, time.UTC)
+	fds.assertSQL(
+		sqlTestCase{
+			ds:  fds.GetDs("test").Where(goqu.C("created").Gt(ts)),
+			sql: `SELECT * FROM "TEST" WHERE ("CREATED" > '2021-03-04 05:06:07.1234')`,
+		},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestUnsupported() {
+	d := goqu.Dialect("firebird")
+	fds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).OnConflict(goqu.DoNothing()),
+			err: "goqu: dialect does not support ON CONFLICT clause [dialect=firebird]",
+		},
+		sqlTestCase{
+			ds:  fds.GetDs("test").Distinct("a"),
+			err: "goqu: dialect does not support DISTINCT ON clause [dialect=firebird]",
+		},
+		sqlTestCase{
+			ds:  fds.GetDs("test").Except(fds.GetDs("test2")),
+			err: "goqu: dialect does not support EXCEPT [dialect=firebird]",
+		},
+		sqlTestCase{
+			ds:  fds.GetDs("test").Union(fds.GetDs("test2")).Parenthesize().Limit(10),
+			err: "goqu: dialect does not support limiting a parenthesized compound query [dialect=firebird]",
+		},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestCountAndExists() {
+	ds := fds.GetDs("test").Where(goqu.C("a").Gt(10))
+	fds.assertSQL(
+		sqlTestCase{
+			ds:  ds.ExistsDataset(),
+			sql: `SELECT CASE  WHEN EXISTS (SELECT * FROM "TEST" WHERE ("A" > 10)) THEN 1 ELSE 0 END FROM RDB$DATABASE`,
+		},
+		sqlTestCase{ds: goqu.Dialect("firebird").Select(goqu.L("1")), sql: `SELECT 1 FROM RDB$DATABASE`},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestMaintenance() {
+	d := goqu.Dialect("firebird")
+	fds.assertSQL(
+		sqlTestCase{ds: d.Vacuum("items"), err: "goqu: dialect does not support VACUUM [dialect=firebird]"},
+		sqlTestCase{ds: d.Analyze("items"), err: "goqu: dialect does not support ANALYZE [dialect=firebird]"},
+		sqlTestCase{ds: d.Optimize("items"), err: "goqu: dialect does not support OPTIMIZE [dialect=firebird]"},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestSetParam() {
+	d := goqu.Dialect("firebird")
+	fds.assertSQL(
+		sqlTestCase{ds: d.SetParam("a", 1), err: "goqu: dialect does not support SET [dialect=firebird]"},
+		sqlTestCase{ds: d.Show("a"), err: "goqu: dialect does not support SHOW [dialect=firebird]"},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestCopy() {
+	d := goqu.Dialect("firebird")
+	fds.assertSQL(
+		sqlTestCase{ds: d.CopyFrom("items"), err: "goqu: dialect does not support COPY [dialect=firebird]"},
+		sqlTestCase{ds: d.CopyTo("items"), err: "goqu: dialect does not support COPY [dialect=firebird]"},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestCall() {
+	d := goqu.Dialect("firebird")
+	fds.assertSQL(
+		sqlTestCase{ds: d.Call("user_totals", 10, "a"), sql: `EXECUTE PROCEDURE "USER_TOTALS"(10, 'a')`},
+		sqlTestCase{
+			ds:         d.Call("user_totals", 10, "a").Prepared(true),
+			sql:        `EXECUTE PROCEDURE "USER_TOTALS"(?, ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(10), "a"},
+		},
+	)
+}
+
+func (fds *firebirdDialectSuite) TestCreateTable() {
+	d := goqu.Dialect("firebird")
+	fds.assertSQL(
+		sqlTestCase{
+			ds: d.CreateTable("test").Columns(
+				goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey(),
+				goqu.ColumnDef("notes", goqu.TextType()),
+				goqu.ColumnDef("active", goqu.BooleanType()).Default(true),
+			),
+			sql: `CREATE TABLE "TEST" ("ID" BIGINT PRIMARY KEY, "NOTES" BLOB SUB_TYPE TEXT, "ACTIVE" BOOLEAN DEFAULT TRUE)`,
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Temporary().Columns(goqu.ColumnDef("id", goqu.IntegerType())),
+			sql: `CREATE GLOBAL TEMPORARY TABLE "TEST" ("ID" INTEGER)`,
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").IfNotExists().Columns(goqu.ColumnDef("id", goqu.IntegerType())),
+			err: "goqu: dialect does not support IF NOT EXISTS in CREATE TABLE [dialect=firebird]",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Columns(goqu.ColumnDef("ts", goqu.TimestampTzType())),
+			err: "goqu: dialect does not support data type TimestampTz [dialect=firebird]",
+		},
+		sqlTestCase{
+			ds:  goqu.Dialect("firebird4").CreateTable("test").Columns(goqu.ColumnDef("ts", goqu.TimestampTzType())),
+			sql: `CREATE TABLE "TEST" ("TS" TIMESTAMP WITH TIME ZONE)`,
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(firebirdDialectSuite))
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
 )
 
 func DialectOptions() *goqu.SQLDialectOptions {
@@ -19,8 +20,147 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.SupportsWithCTE = false
 	opts.SupportsWithCTERecursive = false
 	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
 	opts.SupportsWindowFunction = false
+	// mysql 8 and mariadb only support ROWS and RANGE frames
+	opts.SupportsWindowFrameGroups = false
+	opts.SupportsWindowFrameExclusion = false
+	// filtered aggregates are rewritten using CASE (e.g. SUM(CASE WHEN ... THEN 1 ELSE 0 END))
+	opts.AggregateFilterFragment = nil
 	opts.SupportsDeleteTableHint = true
+	opts.SupportsMultipleStatements = true
+	opts.SupportsStraightJoin = true
+	opts.SupportsOptimizerHints = true
+	// GROUP BY `a`, `b` WITH ROLLUP, CUBE and GROUPING SETS are not supported
+	opts.RollupFragment = nil
+	opts.WithRollupFragment = []byte(" WITH ROLLUP")
+	opts.CubeFragment = nil
+	opts.GroupingSetsFragment = nil
+	// upserts use INSERT ... ON DUPLICATE KEY UPDATE
+	opts.MergeFragment = nil
+	// EXPLAIN ANALYZE FORMAT=TREE
+	opts.WrapExplainOptionsInParens = false
+	opts.ExplainVerboseFragment = nil
+	opts.ExplainFormatFragment = []byte("FORMAT=")
+	opts.ExplainFormats = map[exp.ExplainFormat][]byte{
+		exp.ExplainFormatText: []byte("TRADITIONAL"),
+		exp.ExplainFormatJSON: []byte("JSON"),
+		exp.ExplainFormatTree: []byte("TREE"),
+	}
+	opts.CopyFragment = nil
+	// LOCAL is a synonym for SESSION, values are read using SELECT @@name instead of SHOW
+	opts.SetLocalFragment = nil
+	opts.ShowFragment = nil
+	opts.VacuumFragment = nil
+	opts.AnalyzeFragment = []byte("ANALYZE TABLE")
+	opts.OptimizeFragment = []byte("OPTIMIZE TABLE")
+	opts.SupportsMaintenanceWithoutTables = false
+	opts.MaintenanceFullFragment = nil
+	opts.MaintenanceFreezeFragment = nil
+	opts.MaintenanceVerboseFragment = nil
+	opts.MaintenanceAnalyzeFragment = nil
+	opts.MaintenanceSkipLockedFragment = nil
+	opts.JoinTypeLookup[exp.StraightJoinType] = []byte(" STRAIGHT_JOIN ")
+	opts.ValuesListRowFragment = []byte("ROW")
+	opts.AutoIncrementFragment = []byte(" AUTO_INCREMENT")
+	// MODIFY COLUMN replaces the whole column definition, NOT NULL can only be changed by modifying the column
+	opts.AlterColumnTypeFragment = []byte("MODIFY COLUMN ")
+	opts.ColumnTypeFragment = []byte(" ")
+	opts.SetNotNullFragment = nil
+	opts.DropNotNullFragment = nil
+	opts.ModifyColumnFragment = []byte("MODIFY COLUMN ")
+	// comments are part of the table and column definitions (ALTER TABLE `a` COMMENT = 'b', `c` INT COMMENT 'd')
+	opts.CommentOnFragment = nil
+	opts.TableCommentFragment = []byte(" COMMENT = ")
+	opts.ColumnCommentFragment = []byte(" COMMENT ")
+	opts.GrantOptionForFragment = nil
+	opts.SupportsRevokeCascade = false
+	// partitions are defined in the CREATE TABLE statement and added or dropped with ALTER TABLE
+	opts.PartitionCountFragment = []byte(" PARTITIONS ")
+	opts.PartitionFragment = []byte("PARTITION ")
+	opts.PartitionOfFragment = nil
+	opts.PartitionValuesFragment = []byte(" VALUES")
+	opts.PartitionBoundLookup = map[exp.PartitionBoundType][]byte{
+		exp.LessThanPartitionBound: []byte(" LESS THAN "),
+		exp.ListPartitionBound:     []byte(" IN "),
+	}
+	opts.AttachPartitionFragment = nil
+	opts.DetachPartitionFragment = nil
+	opts.AddPartitionFragment = []byte("ADD PARTITION ")
+	opts.DropPartitionFragment = []byte("DROP PARTITION ")
+	opts.ExcludeFragment = nil
+	// SET DEFAULT is parsed but tables using it are rejected by InnoDB
+	opts.ReferentialActionLookup = map[exp.ReferentialAction][]byte{
+		exp.RestrictReferentialAction: []byte("RESTRICT"),
+		exp.CascadeReferentialAction:  []byte("CASCADE"),
+		exp.SetNullReferentialAction:  []byte("SET NULL"),
+		exp.NoActionReferentialAction: []byte("NO ACTION"),
+	}
+	// indexes belong to a table (DROP INDEX `a` ON `b`) and the method of an index is after the columns
+	opts.SupportsCreateIndexIfNotExists = false
+	opts.SupportsDropIndexIfExists = false
+	opts.SupportsConcurrentIndex = false
+	opts.SupportsPartialIndex = false
+	opts.UseIndexMethodAfterColumns = true
+	opts.DropIndexRequiresTable = true
+	// CASCADE and RESTRICT are parsed but ignored by DROP TABLE and are not allowed by DROP INDEX
+	opts.SupportsDropCascade = false
+	opts.TemporaryViewFragment = nil
+	opts.MaterializedViewFragment = nil
+	opts.DropMaterializedViewFragment = nil
+	opts.RefreshMaterializedViewFragment = nil
+	opts.CreateSequenceFragment = nil
+	opts.AlterSequenceFragment = nil
+	opts.DropSequenceFragment = nil
+	opts.NextValFunction = nil
+	opts.CurrValFunction = nil
+	// a schema is a database in mysql and neither have an owner
+	opts.SupportsCreateDatabaseIfNotExists = true
+	opts.SchemaAuthorizationFragment = nil
+	opts.DatabaseOwnerFragment = nil
+	// a trigger fires for a single event and runs a BEGIN ... END block, the body of a function follows the
+	// characteristics (CREATE FUNCTION `a`() RETURNS INT LANGUAGE SQL RETURN 1)
+	opts.SupportsCreateTriggerIfNotExists = true
+	opts.SupportsMultipleTriggerEvents = false
+	opts.OrReplaceTriggerFragment = nil
+	opts.TriggerUpdateOfFragment = nil
+	opts.ForEachStatementFragment = nil
+	opts.TriggerWhenFragment = nil
+	opts.TriggerExecuteFragment = nil
+	opts.TriggerBodyFragment = []byte(" BEGIN ")
+	opts.TriggerBodyEndFragment = []byte(" END")
+	opts.DropTriggerRequiresTable = false
+	opts.TriggerTimingLookup = map[exp.TriggerTiming][]byte{
+		exp.BeforeTriggerTiming: []byte(" BEFORE "),
+		exp.AfterTriggerTiming:  []byte(" AFTER "),
+	}
+	opts.TriggerEventLookup = map[exp.TriggerEvent][]byte{
+		exp.InsertTriggerEvent: []byte("INSERT"),
+		exp.UpdateTriggerEvent: []byte("UPDATE"),
+		exp.DeleteTriggerEvent: []byte("DELETE"),
+	}
+	opts.OrReplaceFunctionFragment = nil
+	opts.FunctionBodyFragment = []byte(" ")
+	opts.FunctionBodyEndFragment = nil
+	opts.DataTypeLookup[exp.DoubleDataType] = []byte("DOUBLE")
+	opts.DataTypeLookup[exp.TimestampDataType] = []byte("DATETIME")
+	opts.DataTypeLookup[exp.TimestampTzDataType] = []byte("TIMESTAMP")
+	opts.DataTypeLookup[exp.UUIDDataType] = []byte("CHAR(36)")
+	// CAST only supports a subset of the column types
+	opts.CastTypeLookup = map[string][]byte{
+		"char":        []byte("CHAR"),
+		"varchar":     []byte("CHAR"),
+		"text":        []byte("CHAR"),
+		"smallint":    []byte("SIGNED"),
+		"integer":     []byte("SIGNED"),
+		"int":         []byte("SIGNED"),
+		"bigint":      []byte("SIGNED"),
+		"timestamptz": []byte("DATETIME"),
+		"binary":      []byte("BINARY"),
+	}
 
 	opts.UseFromClauseForMultipleUpdateTables = false
 
@@ -30,6 +170,33 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.DefaultValuesFragment = []byte("")
 	opts.True = []byte("1")
 	opts.False = []byte("0")
+	opts.RandomFunction = []byte("RAND()")
+	opts.UseIndexFragment = []byte(" USE INDEX ")
+	opts.ForceIndexFragment = []byte(" FORCE INDEX ")
+	opts.IgnoreIndexFragment = []byte(" IGNORE INDEX ")
+	opts.UseJSONFunctions = true
+	opts.JSONFunctionLookup = map[exp.JSONFunction][]byte{
+		exp.JSONPathQueryFunc:   []byte("JSON_EXTRACT"),
+		exp.JSONSetFunc:         []byte("JSON_SET"),
+		exp.JSONBuildObjectFunc: []byte("JSON_OBJECT"),
+		exp.JSONAggFunc:         []byte("JSON_ARRAYAGG"),
+		exp.JSONObjectAggFunc:   []byte("JSON_OBJECTAGG"),
+	}
+	opts.JSONValueCastType = []byte("JSON")
+	opts.IntervalStyle = sqlgen.UnquotedIntervalStyle
+	opts.IntervalUnitLookup = map[exp.IntervalUnit][]byte{
+		exp.MicrosecondsIntervalUnit: []byte("MICROSECOND"),
+		exp.SecondsIntervalUnit:      []byte("SECOND"),
+		exp.MinutesIntervalUnit:      []byte("MINUTE"),
+		exp.HoursIntervalUnit:        []byte("HOUR"),
+		exp.DaysIntervalUnit:         []byte("DAY"),
+		exp.WeeksIntervalUnit:        []byte("WEEK"),
+		exp.MonthsIntervalUnit:       []byte("MONTH"),
+		exp.YearsIntervalUnit:        []byte("YEAR"),
+	}
+	// TIMESTAMP values are returned in the time zone of the session so they are converted from it
+	opts.ConvertTimeZoneFunction = []byte("CONVERT_TZ")
+	opts.ConvertTimeZoneSourceFragment = []byte("@@session.time_zone")
 	opts.TimeFormat = "2006-01-02 15:04:05"
 	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
 		exp.EqOp:             []byte("="),
@@ -51,6 +218,7 @@ func DialectOptions() *goqu.SQLDialectOptions {
 		exp.RegexpILikeOp:    []byte("REGEXP"),
 		exp.RegexpNotILikeOp: []byte("NOT REGEXP"),
 	}
+	opts.NullSafeEqualFragment = []byte("<=>")
 	opts.BitwiseOperatorLookup = map[exp.BitwiseOperation][]byte{
 		exp.BitwiseInversionOp:  []byte("~"),
 		exp.BitwiseOrOp:         []byte("|"),
@@ -69,6 +237,10 @@ func DialectOptions() *goqu.SQLDialectOptions {
 		0x1a: []byte("\\x1a"),
 	}
 	opts.InsertIgnoreClause = []byte("INSERT IGNORE INTO")
+	opts.ConflictResolutionLookup = map[exp.ConflictResolution][]byte{
+		exp.IgnoreConflictResolution:  []byte("INSERT IGNORE INTO"),
+		exp.ReplaceConflictResolution: []byte("REPLACE INTO"),
+	}
 	opts.ConflictFragment = []byte("")
 	opts.ConflictDoUpdateFragment = []byte(" ON DUPLICATE KEY UPDATE ")
 	opts.ConflictDoNothingFragment = []byte("")
@@ -81,7 +253,24 @@ func DialectOptionsV8() *goqu.SQLDialectOptions {
 	return opts
 }
 
+// DialectOptionsMariaDB returns the options for mariadb 10.5+ which unlike mysql supports RETURNING on INSERT and
+// DELETE statements (but not UPDATE), common table expressions and window functions.
+func DialectOptionsMariaDB() *goqu.SQLDialectOptions {
+	opts := DialectOptions()
+	opts.SupportsReturn = true
+	opts.SupportsReturnOnUpdate = false
+	opts.SupportsWithCTE = true
+	opts.SupportsWithCTERecursive = true
+	opts.SupportsWindowFunction = true
+	// mariadb treats /*+ ... */ as a regular comment and ignores the optimizer hints
+	opts.SupportsOptimizerHints = false
+	// mariadb does not support the ROW keyword in table value constructors
+	opts.ValuesListRowFragment = nil
+	return opts
+}
+
 func init() {
 	goqu.RegisterDialect("mysql", DialectOptions())
 	goqu.RegisterDialect("mysql8", DialectOptionsV8())
+	goqu.RegisterDialect("mariadb", DialectOptionsMariaDB())
 }
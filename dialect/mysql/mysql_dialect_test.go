@@ -105,6 +105,10 @@ func (mds *mysqlDialectSuite) TestBooleanOperations() {
 		sqlTestCase{ds: ds.Where(col.NotLike("a%")), sql: "SELECT * FROM `test` WHERE (`a` NOT LIKE BINARY 'a%')"},
 		sqlTestCase{ds: ds.Where(col.ILike("a%")), sql: "SELECT * FROM `test` WHERE (`a` LIKE 'a%')"},
 		sqlTestCase{ds: ds.Where(col.NotILike("a%")), sql: "SELECT * FROM `test` WHERE (`a` NOT LIKE 'a%')"},
+		sqlTestCase{
+			ds:  ds.Where(col.Like("%" + goqu.EscapeLikePattern("50%_off") + "%").Escape(`\`)),
+			sql: "SELECT * FROM `test` WHERE (`a` LIKE BINARY '%50\\\\%\\\\_off%' ESCAPE '\\\\')",
+		},
 		sqlTestCase{ds: ds.Where(col.Like(regexp.MustCompile("[ab]"))), sql: "SELECT * FROM `test` WHERE (`a` REGEXP BINARY '[ab]')"},
 		sqlTestCase{ds: ds.Where(col.NotLike(regexp.MustCompile("[ab]"))), sql: "SELECT * FROM `test` WHERE (`a` NOT REGEXP BINARY '[ab]')"},
 		sqlTestCase{ds: ds.Where(col.ILike(regexp.MustCompile("[ab]"))), sql: "SELECT * FROM `test` WHERE (`a` REGEXP '[ab]')"},
@@ -138,6 +142,770 @@ func (mds *mysqlDialectSuite) TestUpdateSQL() {
 	)
 }
 
+func (mds *mysqlDialectSuite) TestForUpdateWait() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.ForUpdateWait(5),
+			err: "goqu: dialect does not support waiting a number of seconds for a lock [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestWindowFrame() {
+	ds := goqu.Dialect("mysql8").From("sales")
+	w := goqu.W().OrderBy("day")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.SUM("amount").Over(w.Rows(goqu.Preceding(1), goqu.CurrentRow()))),
+			sql: "SELECT SUM(`amount`) OVER (ORDER BY `day` ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) FROM `sales`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.SUM("amount").Over(w.Groups(goqu.Preceding(1), goqu.CurrentRow()))),
+			err: "goqu: dialect does not support GROUPS window frames [dialect=mysql8]",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.SUM("amount").Over(w.Rows(goqu.Preceding(1), nil).Exclude(goqu.ExcludeTies))),
+			err: "goqu: dialect does not support window frame exclusion [dialect=mysql8]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestHints() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  mds.GetDs("t1").Hint("INDEX(t1 idx_a)", "MAX_EXECUTION_TIME(1000)"),
+			sql: "SELECT /*+ INDEX(t1 idx_a) MAX_EXECUTION_TIME(1000) */ * FROM `t1`",
+		},
+		sqlTestCase{
+			ds:  d.From(mds.GetDs("t1").Hint("INDEX(t1 idx_a)").As("t")),
+			sql: "SELECT * FROM (SELECT /*+ INDEX(t1 idx_a) */ * FROM `t1`) AS `t`",
+		},
+		sqlTestCase{
+			ds:  mds.GetDs("t2").Where(goqu.C("id").In(mds.GetDs("t1").Hint("NO_ICP(t1)").Select("id"))),
+			sql: "SELECT * FROM `t2` WHERE (`id` IN ((SELECT /*+ NO_ICP(t1) */ `id` FROM `t1`)))",
+		},
+		sqlTestCase{
+			ds:  d.Update("t1").Hint("NO_ICP(t1)").Set(goqu.Record{"a": 1}).Where(goqu.C("b").Eq(2)),
+			sql: "UPDATE /*+ NO_ICP(t1) */ `t1` SET `a`=1 WHERE (`b` = 2)",
+		},
+		sqlTestCase{
+			ds:  d.Delete("t1").Hint("NO_ICP(t1)").Where(goqu.C("b").Eq(2)),
+			sql: "DELETE /*+ NO_ICP(t1) */ `t1` FROM `t1` WHERE (`b` = 2)",
+		},
+		sqlTestCase{
+			ds:  goqu.Dialect("mariadb").From("t1").Hint("INDEX(t1 idx_a)"),
+			err: "goqu: dialect does not support optimizer hints [dialect=mariadb]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestCommonTables() {
+	d := goqu.Dialect("mariadb")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  d.From("t").With("t", d.From("test")),
+			sql: "WITH t AS (SELECT * FROM `test`) SELECT * FROM `t`",
+		},
+		sqlTestCase{
+			ds:  d.From("t").WithMaterialized("t", d.From("test")),
+			err: "goqu: dialect does not support MATERIALIZED common table expressions [dialect=mariadb]",
+		},
+		sqlTestCase{
+			ds:  d.From("t").WithNotMaterialized("t", d.From("test")),
+			err: "goqu: dialect does not support NOT MATERIALIZED common table expressions [dialect=mariadb]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestFetch() {
+	ds := mds.GetDs("test").Order(goqu.C("score").Desc())
+	mds.assertSQL(
+		sqlTestCase{ds: ds.Fetch(goqu.FetchFirst(3)), sql: "SELECT * FROM `test` ORDER BY `score` DESC LIMIT 3"},
+		sqlTestCase{
+			ds:  ds.Fetch(goqu.FetchFirst(3).WithTies()),
+			err: "goqu: dialect does not support FETCH FIRST WITH TIES [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ds.Fetch(goqu.FetchFirst(3).Percent()),
+			err: "goqu: dialect does not support FETCH FIRST PERCENT [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestRandom() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{ds: ds.OrderRandom(10), sql: "SELECT * FROM `test` ORDER BY RAND() ASC LIMIT 10"},
+		sqlTestCase{ds: ds.Where(goqu.Random().Lt(0.1)), sql: "SELECT * FROM `test` WHERE (RAND() < 0.1)"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestGrouping() {
+	ds := mds.GetDs("sales").Select("region", "product", goqu.GROUPING("region"), goqu.SUM("amount"))
+	mds.assertSQL(
+		sqlTestCase{
+			ds: ds.GroupBy(goqu.Rollup("region", "product")),
+			sql: "SELECT `region`, `product`, GROUPING(`region`), SUM(`amount`) FROM `sales` " +
+				"GROUP BY `region`, `product` WITH ROLLUP",
+		},
+		sqlTestCase{
+			ds:  ds.GroupBy(goqu.Cube("region", "product")),
+			err: "goqu: dialect does not support CUBE [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ds.GroupBy(goqu.GroupingSets("region", "product")),
+			err: "goqu: dialect does not support GROUPING SETS [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestStraightJoin() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.ForceJoinOrder().InnerJoin(goqu.T("foo"), goqu.On(goqu.I("test.id").Eq(goqu.I("foo.test_id")))),
+			sql: "SELECT STRAIGHT_JOIN * FROM `test` INNER JOIN `foo` ON (`test`.`id` = `foo`.`test_id`)",
+		},
+		sqlTestCase{
+			ds:  ds.StraightJoin(goqu.T("foo"), goqu.On(goqu.I("test.id").Eq(goqu.I("foo.test_id")))),
+			sql: "SELECT * FROM `test` STRAIGHT_JOIN `foo` ON (`test`.`id` = `foo`.`test_id`)",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestValues() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.From(goqu.Values([]interface{}{1, "a"}, []interface{}{2, "b"}).As("v").Columns("id", "name")),
+			sql: "SELECT * FROM (VALUES ROW(1, 'a'), ROW(2, 'b')) AS `v`(`id`, `name`)",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestInsert_ConflictResolution() {
+	ds := goqu.Dialect("mysql").Insert("test").Rows(goqu.Record{"a": 1})
+	mds.assertSQL(
+		sqlTestCase{ds: ds.OrIgnore(), sql: "INSERT IGNORE INTO `test` (`a`) VALUES (1)"},
+		sqlTestCase{ds: ds.OrReplace(), sql: "REPLACE INTO `test` (`a`) VALUES (1)"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestMariaDBReturning() {
+	d := goqu.Dialect("mariadb")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id", "a"),
+			sql: "INSERT INTO `test` (`a`) VALUES (1) RETURNING `id`, `a`",
+		},
+		sqlTestCase{
+			ds:         d.Insert("test").Prepared(true).Rows(goqu.Record{"a": 1}).Returning(goqu.Star()),
+			sql:        "INSERT INTO `test` (`a`) VALUES (?) RETURNING *",
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		sqlTestCase{
+			ds: d.Insert("test").Rows(goqu.Record{"id": 1, "a": 1}).
+				OnConflict(goqu.DoUpdate("id", goqu.Record{"a": 2})).
+				Returning("id"),
+			sql: "INSERT IGNORE INTO `test` (`a`, `id`) VALUES (1, 1) ON DUPLICATE KEY UPDATE `a`=2 RETURNING `id`",
+		},
+		sqlTestCase{
+			ds:  d.Delete("test").Where(goqu.C("a").Eq(1)).Returning("id"),
+			sql: "DELETE FROM `test` WHERE (`a` = 1) RETURNING `id`",
+		},
+		sqlTestCase{
+			ds:  d.Delete("test").Where(goqu.C("a").Eq(1)),
+			sql: "DELETE `test` FROM `test` WHERE (`a` = 1)",
+		},
+		sqlTestCase{
+			ds:  d.Update("test").Set(goqu.Record{"a": 2}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause on UPDATE [dialect=mariadb]",
+		},
+		sqlTestCase{
+			ds:  d.Update("test").Set(goqu.Record{"a": 2}).Where(goqu.C("id").Eq(1)),
+			sql: "UPDATE `test` SET `a`=2 WHERE (`id` = 1)",
+		},
+	)
+
+	// mysql does not support RETURNING
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  goqu.Dialect("mysql").Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  goqu.Dialect("mysql8").Delete("test").Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=mysql8]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestMariaDBValues() {
+	mds.assertSQL(
+		sqlTestCase{
+			ds: goqu.Dialect("mariadb").From(
+				goqu.Values([]interface{}{1, "a"}, []interface{}{2, "b"}).As("v").Columns("id", "name"),
+			),
+			sql: "SELECT * FROM (VALUES (1, 'a'), (2, 'b')) AS `v`(`id`, `name`)",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestCreateTable() {
+	mds.assertSQL(
+		sqlTestCase{
+			ds: goqu.Dialect("mysql").CreateTable("test").IfNotExists().Columns(
+				goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey().AutoIncrement(),
+				goqu.ColumnDef("uuid", goqu.UUIDType()).NotNull().Unique(),
+				goqu.ColumnDef("created", goqu.TimestampType()),
+			),
+			sql: "CREATE TABLE IF NOT EXISTS `test` (`id` BIGINT AUTO_INCREMENT PRIMARY KEY, " +
+				"`uuid` CHAR(36) NOT NULL UNIQUE, `created` DATETIME)",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestAlterTable() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{
+			ds: d.AlterTable("test").
+				AddColumn(goqu.ColumnDef("a", goqu.IntegerType()).NotNull()).
+				AlterColumnType("b", goqu.BigIntType()).
+				SetDefault("c", 1).
+				RenameColumn("d", "e").
+				RenameTo("test2"),
+			sql: "ALTER TABLE `test` ADD COLUMN `a` INTEGER NOT NULL, MODIFY COLUMN `b` BIGINT, " +
+				"ALTER COLUMN `c` SET DEFAULT 1, RENAME COLUMN `d` TO `e`, RENAME TO `test2`",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").SetNotNull("a"),
+			err: "goqu: dialect does not support SET NOT NULL in ALTER TABLE [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestCreateIndex() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateIndex("test_idx").On("test").Unique().Using("BTREE").Columns("a", goqu.C("b").Desc()),
+			sql: "CREATE UNIQUE INDEX `test_idx` ON `test` (`a`, `b` DESC) USING BTREE",
+		},
+		sqlTestCase{
+			ds:  d.CreateIndex("test_idx").On("test").Columns(goqu.Func("lower", goqu.C("a"))),
+			sql: "CREATE INDEX `test_idx` ON `test` ((lower(`a`)))",
+		},
+		sqlTestCase{
+			ds:  d.CreateIndex("test_idx").On("test").Columns("a").IfNotExists(),
+			err: "goqu: dialect does not support IF NOT EXISTS in CREATE INDEX [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.CreateIndex("test_idx").On("test").Columns("a").Where(goqu.C("a").IsNotNull()),
+			err: "goqu: dialect does not support WHERE in CREATE INDEX [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestCreateView() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{
+			ds: d.CreateView("test_view").OrReplace().Columns("a").
+				As(goqu.From("test").Select("a").Where(goqu.C("a").Gt(1))).
+				WithLocalCheckOption(),
+			sql: "CREATE OR REPLACE VIEW `test_view` (`a`) AS SELECT `a` FROM `test` WHERE (`a` > 1) " +
+				"WITH LOCAL CHECK OPTION",
+		},
+		sqlTestCase{
+			ds:  d.CreateView("test_view").Temporary().As(goqu.From("test")),
+			err: "goqu: dialect does not support TEMPORARY in CREATE VIEW [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestMaterializedView() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateMaterializedView("test_view").As(goqu.From("test")),
+			err: "goqu: dialect does not support CREATE MATERIALIZED VIEW [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.RefreshMaterializedView("test_view"),
+			err: "goqu: dialect does not support REFRESH MATERIALIZED VIEW [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.DropMaterializedView("test_view"),
+			err: "goqu: dialect does not support DROP MATERIALIZED VIEW [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestConstraints() {
+	d := goqu.Dialect("mysql")
+	ct := d.CreateTable("order").Columns(goqu.ColumnDef("user_id", goqu.BigIntType()))
+	fk := goqu.ForeignKey("user_id").References("user", "id")
+	mds.assertSQL(
+		sqlTestCase{
+			ds: ct.Constraints(fk.OnDelete(exp.CascadeReferentialAction).OnUpdate(exp.RestrictReferentialAction)),
+			sql: "CREATE TABLE `order` (`user_id` BIGINT, FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) " +
+				"ON DELETE CASCADE ON UPDATE RESTRICT)",
+		},
+		sqlTestCase{
+			ds:  ct.Constraints(goqu.Check(goqu.C("user_id").Gt(0)).Named("order_user_check")),
+			sql: "CREATE TABLE `order` (`user_id` BIGINT, CONSTRAINT `order_user_check` CHECK (`user_id` > 0))",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("order").AddConstraint(fk.Named("order_user_fk").OnDelete(exp.SetNullReferentialAction)),
+			sql: "ALTER TABLE `order` ADD CONSTRAINT `order_user_fk` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) ON DELETE SET NULL",
+		},
+		sqlTestCase{
+			ds:  ct.Constraints(fk.OnDelete(exp.SetDefaultReferentialAction)),
+			err: "goqu: dialect does not support the SET DEFAULT referential action [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ct.Constraints(goqu.Exclude("gist", goqu.ExcludeWith("user_id", "="))),
+			err: "goqu: dialect does not support EXCLUDE constraints [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestTriggers() {
+	d := goqu.Dialect("mysql")
+	ct := d.CreateTrigger("user_audit").After().OnInsert().On("user").ForEachRow()
+	body := "INSERT INTO audit (user_id) VALUES (NEW.id);"
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ct.IfNotExists().Body(body),
+			sql: "CREATE TRIGGER IF NOT EXISTS `user_audit` AFTER INSERT ON `user` FOR EACH ROW BEGIN " + body + " END",
+		},
+		sqlTestCase{
+			ds:  ct.OnUpdate().Body(body),
+			err: "goqu: dialect does not support multiple events in CREATE TRIGGER [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ct.InsteadOf().Body(body),
+			err: "goqu: dialect does not support INSTEAD OF in CREATE TRIGGER [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ct.Execute("audit"),
+			err: "goqu: dialect does not support EXECUTE FUNCTION in CREATE TRIGGER [dialect=mysql]",
+		},
+		sqlTestCase{ds: d.DropTrigger("user_audit").IfExists(), sql: "DROP TRIGGER IF EXISTS `user_audit`"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestFunctions() {
+	d := goqu.Dialect("mysql")
+	cf := d.CreateFunction("add_one").Param("a", goqu.IntegerType()).Returns(goqu.IntegerType()).Body("RETURN a + 1")
+	mds.assertSQL(
+		sqlTestCase{ds: cf, sql: "CREATE FUNCTION `add_one`(`a` INTEGER) RETURNS INTEGER RETURN a + 1"},
+		sqlTestCase{
+			ds:  cf.Language("SQL"),
+			sql: "CREATE FUNCTION `add_one`(`a` INTEGER) RETURNS INTEGER LANGUAGE SQL RETURN a + 1",
+		},
+		sqlTestCase{
+			ds:  cf.OrReplace(),
+			err: "goqu: dialect does not support OR REPLACE in CREATE FUNCTION [dialect=mysql]",
+		},
+		sqlTestCase{ds: d.DropFunction("add_one"), sql: "DROP FUNCTION `add_one`"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestMaintenance() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{ds: d.Optimize("items", "users"), sql: "OPTIMIZE TABLE `items`, `users`"},
+		sqlTestCase{ds: d.Analyze("items"), sql: "ANALYZE TABLE `items`"},
+		sqlTestCase{ds: d.Analyze(), err: "goqu: at least one table is required for ANALYZE [dialect=mysql]"},
+		sqlTestCase{
+			ds:  d.Analyze("items").Options(exp.MaintenanceOptions{Verbose: true}),
+			err: "goqu: dialect does not support VERBOSE in ANALYZE [dialect=mysql]",
+		},
+		sqlTestCase{ds: d.Vacuum("items"), err: "goqu: dialect does not support VACUUM [dialect=mysql]"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestSetParam() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{ds: d.SetParam("sql_mode", "ANSI_QUOTES"), sql: "SET `sql_mode` = 'ANSI_QUOTES'"},
+		sqlTestCase{ds: d.SetParam("sql_mode", "a").Local(), err: "goqu: dialect does not support SET LOCAL [dialect=mysql]"},
+		sqlTestCase{ds: d.Show("sql_mode"), err: "goqu: dialect does not support SHOW [dialect=mysql]"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestCopy() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{ds: d.CopyFrom("items"), err: "goqu: dialect does not support COPY [dialect=mysql]"},
+		sqlTestCase{ds: d.CopyTo("items"), err: "goqu: dialect does not support COPY [dialect=mysql]"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestCall() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{ds: d.Call("user_totals", 10, "a"), sql: "CALL `user_totals`(10, 'a')"},
+		sqlTestCase{
+			ds:         d.Call("user_totals", 10, "a").Prepared(true),
+			sql:        "CALL `user_totals`(?, ?)",
+			isPrepared: true,
+			args:       []interface{}{int64(10), "a"},
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestMerge() {
+	ds := goqu.Dialect("mysql").Merge("user").
+		Using("staged_user").
+		On(goqu.I("user.id").Eq(goqu.I("staged_user.id"))).
+		WhenMatchedThenDelete()
+	mds.assertSQL(
+		sqlTestCase{ds: ds, err: "goqu: dialect does not support MERGE [dialect=mysql]"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestPartitions() {
+	d := goqu.Dialect("mysql")
+	ct := d.CreateTable("measurement").Columns(
+		goqu.ColumnDef("id", goqu.BigIntType()),
+		goqu.ColumnDef("logdate", goqu.DateType()),
+	)
+	mds.assertSQL(
+		sqlTestCase{
+			ds: ct.PartitionBy(goqu.PartitionByRange(goqu.L("YEAR(?)", goqu.C("logdate")))).Partitions(
+				goqu.Partition("p2023", goqu.ValuesLessThan(2024)),
+				goqu.Partition("pmax", goqu.ValuesLessThan(goqu.L("MAXVALUE"))),
+			),
+			sql: "CREATE TABLE `measurement` (`id` BIGINT, `logdate` DATE) PARTITION BY RANGE (YEAR(`logdate`)) " +
+				"(PARTITION `p2023` VALUES LESS THAN (2024), PARTITION `pmax` VALUES LESS THAN (MAXVALUE))",
+		},
+		sqlTestCase{
+			ds:  ct.PartitionBy(goqu.PartitionByList("id")).Partitions(goqu.Partition("p0", goqu.ValuesIn(1, 2))),
+			sql: "CREATE TABLE `measurement` (`id` BIGINT, `logdate` DATE) PARTITION BY LIST (`id`) (PARTITION `p0` VALUES IN (1, 2))",
+		},
+		sqlTestCase{
+			ds:  ct.PartitionBy(goqu.PartitionByHash("id").Partitions(4)),
+			sql: "CREATE TABLE `measurement` (`id` BIGINT, `logdate` DATE) PARTITION BY HASH (`id`) PARTITIONS 4",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("measurement").AddPartitions(goqu.Partition("p2024", goqu.ValuesLessThan(2025))),
+			sql: "ALTER TABLE `measurement` ADD PARTITION (PARTITION `p2024` VALUES LESS THAN (2025))",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("measurement").DropPartitions("p2022", "p2023"),
+			sql: "ALTER TABLE `measurement` DROP PARTITION `p2022`, `p2023`",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("measurement_2024").PartitionOf("measurement", goqu.ValuesFromTo("2024-01-01", "2025-01-01")),
+			err: "goqu: dialect does not support PARTITION OF in CREATE TABLE [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ct.PartitionBy(goqu.PartitionByRange("id")).Partitions(goqu.Partition("p0", goqu.DefaultPartition())),
+			err: "goqu: dialect does not support DEFAULT partition bounds [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("measurement").DetachPartition("measurement_2024"),
+			err: "goqu: dialect does not support DETACH PARTITION in ALTER TABLE [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestGrant() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  d.Grant("SELECT", "INSERT").On("app.user").To("tenant_a").WithGrantOption(),
+			sql: "GRANT SELECT, INSERT ON `app`.`user` TO `tenant_a` WITH GRANT OPTION",
+		},
+		sqlTestCase{ds: d.Revoke("INSERT").On("user").From("tenant_a"), sql: "REVOKE INSERT ON `user` FROM `tenant_a`"},
+		sqlTestCase{
+			ds:  d.Revoke("INSERT").On("user").From("tenant_a").WithGrantOption(),
+			err: "goqu: dialect does not support GRANT OPTION FOR in REVOKE [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.Revoke("INSERT").On("user").From("tenant_a").Cascade(),
+			err: "goqu: dialect does not support CASCADE in REVOKE [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestComment() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{ds: d.CommentOnTable("user").Is("the users"), sql: "ALTER TABLE `user` COMMENT = 'the users'"},
+		sqlTestCase{
+			ds:  d.AlterTable("user").ModifyColumn(goqu.ColumnDef("email", goqu.VarcharType(255)).NotNull().Comment("the login")),
+			sql: "ALTER TABLE `user` MODIFY COLUMN `email` VARCHAR(255) NOT NULL COMMENT 'the login'",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("user").Columns(goqu.ColumnDef("id", goqu.IntegerType()).Comment("the id")),
+			sql: "CREATE TABLE `user` (`id` INTEGER COMMENT 'the id')",
+		},
+		sqlTestCase{
+			ds:  d.CommentOnColumn("user.email").Is("the login"),
+			err: "goqu: dialect does not support COMMENT ON COLUMN [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.CommentOnView("active_user").Is("the active users"),
+			err: "goqu: dialect does not support COMMENT ON VIEW [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestSchema() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{ds: d.CreateSchema("tenant_1").IfNotExists(), sql: "CREATE SCHEMA IF NOT EXISTS `tenant_1`"},
+		sqlTestCase{ds: d.CreateDatabase("tenant_1").IfNotExists(), sql: "CREATE DATABASE IF NOT EXISTS `tenant_1`"},
+		sqlTestCase{
+			ds:  d.CreateSchema("tenant_1").Authorization("admin"),
+			err: "goqu: dialect does not support AUTHORIZATION in CREATE SCHEMA [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.CreateDatabase("tenant_1").Authorization("admin"),
+			err: "goqu: dialect does not support OWNER in CREATE DATABASE [dialect=mysql]",
+		},
+		sqlTestCase{ds: d.DropSchema("tenant_1").IfExists(), sql: "DROP SCHEMA IF EXISTS `tenant_1`"},
+		sqlTestCase{ds: d.DropDatabase("tenant_1").IfExists(), sql: "DROP DATABASE IF EXISTS `tenant_1`"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestSequence() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateSequence("test_seq").StartWith(10),
+			err: "goqu: dialect does not support sequences [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.AlterSequence("test_seq").RestartWith(1),
+			err: "goqu: dialect does not support sequences [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.DropSequence("test_seq"),
+			err: "goqu: dialect does not support DROP SEQUENCE [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"id": goqu.NextVal("test_seq")}),
+			err: "goqu: dialect does not support the NEXT VALUE of a sequence [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestDropTable() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{ds: d.DropTable("a", "b").IfExists(), sql: "DROP TABLE IF EXISTS `a`, `b`"},
+		sqlTestCase{ds: d.DropView("a").IfExists(), sql: "DROP VIEW IF EXISTS `a`"},
+		sqlTestCase{
+			ds:  d.DropTable("a").Cascade(),
+			err: "goqu: dialect does not support CASCADE or RESTRICT in DROP TABLE [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestDropIndex() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{ds: d.DropIndex("test_idx").On("test"), sql: "DROP INDEX `test_idx` ON `test`"},
+		sqlTestCase{
+			ds:  d.DropIndex("test_idx"),
+			err: "goqu: a table is required when dropping an index [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.DropIndex("test_idx").On("test").IfExists(),
+			err: "goqu: dialect does not support IF EXISTS in DROP INDEX [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestJSON() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(goqu.JSON("data").GetText("status").Eq("active")),
+			sql: "SELECT * FROM `test` WHERE (JSON_UNQUOTE(JSON_EXTRACT(`data`, '$.status')) = 'active')",
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(goqu.JSON("data").GetText("status").Eq("active")),
+			sql:        "SELECT * FROM `test` WHERE (JSON_UNQUOTE(JSON_EXTRACT(`data`, ?)) = ?)",
+			isPrepared: true,
+			args:       []interface{}{`$.status`, "active"},
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.JSON("data").GetPath("tags", "0").As("tag")),
+			sql: "SELECT JSON_EXTRACT(`data`, '$.tags[0]') AS `tag` FROM `test`",
+		},
+		sqlTestCase{
+			ds: ds.Where(goqu.JSON("data").Contains(`{"admin": true}`), goqu.JSON("data").HasAnyKey("a", "b")),
+			sql: "SELECT * FROM `test` WHERE (JSON_CONTAINS(`data`, '{\\\"admin\\\": true}') AND " +
+				"JSON_CONTAINS_PATH(`data`, 'one', '$.a', '$.b'))",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestAggregateFilter() {
+	ds := mds.GetDs("orders")
+	mds.assertSQL(
+		sqlTestCase{
+			ds: ds.Select(
+				goqu.COUNT("*").Filter(goqu.C("status").Eq("paid")).As("paid"),
+				goqu.SUM("total").Filter(goqu.C("status").Eq("refunded")).As("refunded"),
+			),
+			sql: "SELECT SUM(CASE  WHEN (`status` = 'paid') THEN 1 ELSE 0 END) AS `paid`, " +
+				"SUM(CASE  WHEN (`status` = 'refunded') THEN `total` END) AS `refunded` FROM `orders`",
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Select(goqu.COUNT("*").Filter(goqu.C("status").Eq("paid"))),
+			sql:        "SELECT SUM(CASE  WHEN (`status` = ?) THEN ? ELSE ? END) FROM `orders`",
+			isPrepared: true,
+			args:       []interface{}{"paid", int64(1), int64(0)},
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestDistinctFrom() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{ds: ds.Where(goqu.C("a").IsNotDistinctFrom(goqu.C("b"))), sql: "SELECT * FROM `test` WHERE (`a` <=> `b`)"},
+		sqlTestCase{ds: ds.Where(goqu.C("a").IsDistinctFrom(goqu.C("b"))), sql: "SELECT * FROM `test` WHERE NOT (`a` <=> `b`)"},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(goqu.C("a").IsDistinctFrom(1)),
+			sql:        "SELECT * FROM `test` WHERE NOT (`a` <=> ?)",
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		sqlTestCase{ds: ds.Where(goqu.C("a").IsNotDistinctFrom(nil)), sql: "SELECT * FROM `test` WHERE (`a` IS NULL)"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestIntervals() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(goqu.DateOf("created_at").Add(goqu.Interval(3, goqu.Days)).Lt(goqu.L("NOW()"))),
+			sql: "SELECT * FROM `test` WHERE ((`created_at` + INTERVAL 3 DAY) < NOW())",
+		},
+		sqlTestCase{
+			ds: ds.Prepared(true).
+				Where(goqu.C("created_at").Gte(goqu.DateOf(goqu.L("NOW()")).Sub(goqu.Interval(90, goqu.Minutes)))),
+			sql:        "SELECT * FROM `test` WHERE (`created_at` >= (NOW() - INTERVAL 90 MINUTE))",
+			isPrepared: true,
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.DateOf("created_at").Add(goqu.Interval(1, goqu.Weeks)).As("due")),
+			sql: "SELECT (`created_at` + INTERVAL 1 WEEK) AS `due` FROM `test`",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestAtTimeZone() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.C("created_at").AtTimeZone("Europe/Kyiv").As("local")),
+			sql: "SELECT CONVERT_TZ(`created_at`, @@session.time_zone, 'Europe/Kyiv') AS `local` FROM `test`",
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(goqu.C("created_at").AtTimeZone("UTC").Add(goqu.Interval(1, goqu.Days)).Lt(goqu.L("NOW()"))),
+			sql:        "SELECT * FROM `test` WHERE ((CONVERT_TZ(`created_at`, @@session.time_zone, ?) + INTERVAL 1 DAY) < NOW())",
+			isPrepared: true,
+			args:       []interface{}{"UTC"},
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestCastTypes() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.C("a").Cast("text"), goqu.C("b").Cast("bigint"), goqu.C("c").Cast("timestamptz")),
+			sql: "SELECT CAST(`a` AS CHAR), CAST(`b` AS SIGNED), CAST(`c` AS DATETIME) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.C("a").Cast("json"), goqu.C("b").Cast("uuid"), goqu.C("c").Cast("DECIMAL(10, 2)")),
+			sql: "SELECT CAST(`a` AS JSON), CAST(`b` AS CHAR(36)), CAST(`c` AS DECIMAL(10, 2)) FROM `test`",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestJSONFunctions() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{
+			ds: d.Update("users").Set(goqu.Record{
+				"profile": goqu.JSONSet("profile", []string{"tags", "0"}, map[string]string{"name": "a"}),
+			}),
+			sql: "UPDATE `users` SET `profile`=JSON_SET(`profile`, '$.tags[0]', CAST('{\\\"name\\\":\\\"a\\\"}' AS JSON))",
+		},
+		sqlTestCase{
+			ds: d.Update("users").Prepared(true).Set(goqu.Record{
+				"profile": goqu.JSONSet("profile", []string{"tags"}, []string{"a", "b"}),
+			}),
+			sql:        "UPDATE `users` SET `profile`=JSON_SET(`profile`, ?, CAST(? AS JSON))",
+			isPrepared: true,
+			args:       []interface{}{"$.tags", `["a","b"]`},
+		},
+		sqlTestCase{
+			ds: d.From("users").
+				Select(goqu.JSONBuildObject("id", goqu.I("id"), "name", goqu.I("name")).As("user")).
+				Where(goqu.JSONPathQuery("profile", "$.active").Eq(true)),
+			sql: "SELECT JSON_OBJECT('id', `id`, 'name', `name`) AS `user` FROM `users` " +
+				"WHERE (JSON_EXTRACT(`profile`, '$.active') IS TRUE)",
+		},
+		sqlTestCase{
+			ds:  d.From("users").Select(goqu.JSONAgg("name"), goqu.JSONObjectAgg("id", "name")),
+			sql: "SELECT JSON_ARRAYAGG(`name`), JSON_OBJECTAGG(`id`, `name`) FROM `users`",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestTableFunctions() {
+	d := goqu.Dialect("mysql")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  d.From(goqu.Func("generate_series", 1, 3).WithOrdinality()),
+			err: "goqu: dialect does not support WITH ORDINALITY [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  d.From(goqu.RowsFrom(goqu.Func("a"), goqu.Func("b"))),
+			err: "goqu: dialect does not support ROWS FROM [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestIndexHints() {
+	ds := mds.GetDs("orders")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  goqu.Dialect("mysql").From(goqu.HintTable(goqu.T("orders")).UseIndex("idx_user", "idx_date")),
+			sql: "SELECT * FROM `orders` USE INDEX (`idx_user`, `idx_date`)",
+		},
+		sqlTestCase{
+			ds: ds.Join(
+				goqu.HintTable(goqu.T("users").As("u")).ForceIndex("PRIMARY").IgnoreIndex("idx_email"),
+				goqu.On(goqu.I("u.id").Eq(goqu.I("orders.user_id"))),
+			),
+			sql: "SELECT * FROM `orders` INNER JOIN `users` AS `u` FORCE INDEX (`PRIMARY`) IGNORE INDEX (`idx_email`) " +
+				"ON (`u`.`id` = `orders`.`user_id`)",
+		},
+		sqlTestCase{
+			ds:  ds.Join(goqu.HintTable(goqu.T("users")).Only(), goqu.Using("id")),
+			err: "goqu: dialect does not support the ONLY table hint [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ds.Join(goqu.HintTable(goqu.T("users")).With("NOLOCK"), goqu.Using("id")),
+			err: "goqu: dialect does not support the WITH table hint [dialect=mysql]",
+		},
+	)
+}
+
 func TestDatasetAdapterSuite(t *testing.T) {
 	suite.Run(t, new(mysqlDialectSuite))
 }
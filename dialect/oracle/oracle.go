@@ -0,0 +1,177 @@
+package oracle
+
+import (
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+// DialectOptions returns the options for oracle. Placeholders are numbered (e.g. :1, :2) so they work with both
+// godror and go-ora, LIMIT and OFFSET are generated as OFFSET n ROWS FETCH FIRST n ROWS ONLY and identifiers are
+// upper cased before quoting them so they match tables created with unquoted names.
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := goqu.DefaultDialectOptions()
+
+	opts.SupportsReturn = false
+	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	// MINUS is supported by all versions, EXCEPT only since 21c
+	opts.ExceptFragment = []byte(" MINUS ")
+	opts.DualFromFragment = []byte(" FROM DUAL")
+	opts.SupportsConflict = false
+	opts.SupportsConflictTarget = false
+	opts.SupportsConflictUpdateWhere = false
+	opts.SupportsMultipleUpdateTables = false
+	opts.SupportsDerivedColumnAliases = false
+	opts.SupportsLockWaitSeconds = true
+	opts.SupportsOptimizerHints = true
+	// oracle only allows one WHEN MATCHED and one WHEN NOT MATCHED clause, the conditions of a clause are written
+	// after the action (e.g. WHEN MATCHED THEN UPDATE SET "A"=1 WHERE "B" > 1)
+	opts.SupportsMultipleMergeWhenClauses = false
+	opts.MergeConditionFragment = nil
+	opts.MergeActionWhereFragment = []byte(" WHERE ")
+	opts.MergeDeleteFragment = nil
+	// stored procedures are called in an anonymous PL/SQL block so OUT parameters can be bound
+	opts.CallFragment = []byte("BEGIN ")
+	opts.CallEndFragment = []byte("; END;")
+	// EXPLAIN PLAN FOR writes the plan to the PLAN_TABLE instead of returning it
+	opts.ExplainFragment = nil
+	opts.CopyFragment = nil
+	opts.SetParamFragment = nil
+	opts.ShowFragment = nil
+	opts.VacuumFragment = nil
+	opts.AnalyzeFragment = nil
+
+	opts.PlaceHolderFragment = []byte(":")
+	opts.IncludePlaceholderNum = true
+
+	// oracle folds unquoted identifiers to upper case
+	opts.UpperCaseIdentifiers = true
+	// oracle does not allow AS when aliasing tables
+	opts.TableAliasFragment = []byte(" ")
+	opts.PivotFragment = []byte(" PIVOT ")
+	opts.UnpivotFragment = []byte(" UNPIVOT ")
+	opts.UnpivotIncludeNullsFragment = []byte("INCLUDE NULLS ")
+	opts.XMLTableFragment = []byte("XMLTABLE")
+	// oracle does not use the RECURSIVE keyword for recursive common table expressions
+	opts.RecursiveFragment = []byte("")
+	// times are written as TIMESTAMP literals so they do not depend on the NLS settings of the session
+	opts.TimeFormat = "2006-01-02 15:04:05.000000"
+	opts.TimeLiteralFragment = []byte("TIMESTAMP ")
+	// a VALUES clause can only contain a single row
+	opts.SupportsMultipleInsertRows = false
+	// FOR UPDATE cannot be combined with FETCH FIRST or OFFSET (ORA-02014)
+	opts.SupportsLockWithLimit = false
+
+	// oracle does not have a boolean data type, booleans are stored as NUMBER(1)
+	opts.BooleanDataTypeSupported = false
+	opts.UseLiteralIsBools = false
+	opts.EmulateIsBools = true
+	opts.BoolArgsAsInts = true
+	opts.True = []byte("1")
+	opts.False = []byte("0")
+
+	opts.RandomFunction = []byte("DBMS_RANDOM.VALUE")
+
+	opts.SupportsCreateTableIfNotExists = false
+	// columns are changed using MODIFY and an ALTER TABLE statement only accepts a single action
+	opts.SupportsMultipleAlterTableActions = false
+	opts.AddColumnFragment = []byte("ADD ")
+	opts.AlterColumnTypeFragment = []byte("MODIFY ")
+	opts.ColumnTypeFragment = []byte(" ")
+	opts.ModifyColumnFragment = []byte("MODIFY ")
+	opts.AlterColumnFragment = []byte("MODIFY ")
+	opts.SetDefaultFragment = []byte(" DEFAULT ")
+	opts.DropDefaultFragment = []byte(" DEFAULT NULL")
+	opts.SetNotNullFragment = []byte(" NOT NULL")
+	opts.DropNotNullFragment = []byte(" NULL")
+	opts.CreateTempTableFragment = []byte("CREATE GLOBAL TEMPORARY TABLE ")
+	// oracle does not have a TIME data type
+	opts.DataTypeLookup = map[exp.DataTypeKind][]byte{
+		exp.SmallIntDataType:    []byte("NUMBER(5)"),
+		exp.IntegerDataType:     []byte("NUMBER(10)"),
+		exp.BigIntDataType:      []byte("NUMBER(19)"),
+		exp.DecimalDataType:     []byte("NUMBER"),
+		exp.RealDataType:        []byte("BINARY_FLOAT"),
+		exp.DoubleDataType:      []byte("BINARY_DOUBLE"),
+		exp.BooleanDataType:     []byte("NUMBER(1)"),
+		exp.CharDataType:        []byte("CHAR"),
+		exp.VarcharDataType:     []byte("VARCHAR2"),
+		exp.TextDataType:        []byte("CLOB"),
+		exp.DateDataType:        []byte("DATE"),
+		exp.TimestampDataType:   []byte("TIMESTAMP"),
+		exp.TimestampTzDataType: []byte("TIMESTAMP WITH TIME ZONE"),
+		exp.BinaryDataType:      []byte("BLOB"),
+		exp.UUIDDataType:        []byte("RAW(16)"),
+		exp.JSONDataType:        []byte("CLOB"),
+	}
+
+	opts.TruncateClause = []byte("TRUNCATE TABLE")
+	opts.SupportsMultipleTruncateTables = false
+	opts.SupportsTruncateIdentity = false
+	opts.SupportsTruncateCascade = false
+
+	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
+		exp.EqOp:      []byte("="),
+		exp.NeqOp:     []byte("!="),
+		exp.GtOp:      []byte(">"),
+		exp.GteOp:     []byte(">="),
+		exp.LtOp:      []byte("<"),
+		exp.LteOp:     []byte("<="),
+		exp.InOp:      []byte("IN"),
+		exp.NotInOp:   []byte("NOT IN"),
+		exp.IsOp:      []byte("IS"),
+		exp.IsNotOp:   []byte("IS NOT"),
+		exp.LikeOp:    []byte("LIKE"),
+		exp.NotLikeOp: []byte("NOT LIKE"),
+	}
+	// oracle only supports bitwise operations through functions (e.g. BITAND)
+	opts.BitwiseOperatorLookup = map[exp.BitwiseOperation][]byte{}
+
+	opts.FetchFragment = []byte(" FETCH FIRST ")
+	opts.SupportsFetchWithTies = true
+	opts.SupportsFetchPercent = true
+	opts.AggregateFilterFragment = nil
+	opts.AggregateOrderByFragment = nil
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.CommonTableSQLFragment,
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.ConnectBySQLFragment,
+		sqlgen.GroupBySQLFragment,
+		sqlgen.HavingSQLFragment,
+		sqlgen.WindowSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.OffsetFetchSQLFragment,
+		sqlgen.ForSQLFragment,
+	}
+	return opts
+}
+
+// Prior references the value of the parent row in the CONNECT BY condition of a hierarchical query, a string is
+// treated as a column
+//    dialect.From("employees").ConnectBy(oracle.Prior("id").Eq(goqu.C("manager_id")))
+//    // SELECT * FROM "EMPLOYEES" CONNECT BY (PRIOR "ID" = "MANAGER_ID")
+func Prior(col interface{}) exp.LiteralExpression {
+	if s, ok := col.(string); ok {
+		col = goqu.C(s)
+	}
+	return goqu.L("PRIOR ?", col)
+}
+
+// Level returns the LEVEL pseudo column of a hierarchical query, the root rows have a LEVEL of 1
+//    dialect.From("employees").Select("id", oracle.Level()).ConnectBy(oracle.Prior("id").Eq(goqu.C("manager_id")))
+//    // SELECT "ID", LEVEL FROM "EMPLOYEES" CONNECT BY (PRIOR "ID" = "MANAGER_ID")
+func Level() exp.LiteralExpression {
+	return goqu.L("LEVEL")
+}
+
+func init() {
+	goqu.RegisterDialect("oracle", DialectOptions())
+}
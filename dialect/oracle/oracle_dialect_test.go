@@ -0,0 +1,472 @@
+package oracle_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/oracle"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	oracleDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exec.Statement
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (ods *oracleDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("oracle").From(table)
+}
+
+func (ods *oracleDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			ods.NoError(err, "test case %d failed", i)
+		} else {
+			ods.EqualError(err, c.err, "test case %d failed", i)
+		}
+		ods.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			ods.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			ods.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (ods *oracleDialectSuite) TestIdentifiers() {
+	ods.assertSQL(
+		sqlTestCase{
+			ds:  ods.GetDs("hr.employees").Select("id", goqu.I("employees.name").As("emp_name")),
+			sql: `SELECT "ID", "EMPLOYEES"."NAME" AS "EMP_NAME" FROM "HR"."EMPLOYEES"`,
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestTableAliases() {
+	ods.assertSQL(
+		sqlTestCase{
+			ds: goqu.Dialect("oracle").From(goqu.T("employees").As("e")).
+				Join(goqu.T("departments").As("d"), goqu.On(goqu.I("e.dept_id").Eq(goqu.I("d.id")))).
+				Select(goqu.I("e.name"), goqu.I("d.name").As("dept")),
+			sql: `SELECT "E"."NAME", "D"."NAME" AS "DEPT" FROM "EMPLOYEES" "E" ` +
+				`INNER JOIN "DEPARTMENTS" "D" ON ("E"."DEPT_ID" = "D"."ID")`,
+		},
+		sqlTestCase{
+			ds:  goqu.Dialect("oracle").From(ods.GetDs("employees").Select("id").As("t")),
+			sql: `SELECT * FROM (SELECT "ID" FROM "EMPLOYEES") "T"`,
+		},
+		sqlTestCase{
+			ds:  goqu.Dialect("oracle").Update(goqu.T("employees").As("e")).Set(goqu.Record{"name": "x"}),
+			sql: `UPDATE "EMPLOYEES" "E" SET "NAME"='x'`,
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestPlaceholders() {
+	ods.assertSQL(
+		sqlTestCase{
+			ds:         ods.GetDs("test").Prepared(true).Where(goqu.C("a").Eq(1), goqu.C("b").In([]string{"a", "b"})),
+			sql:        `SELECT * FROM "TEST" WHERE (("A" = :1) AND ("B" IN (:2, :3)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", "b"},
+		},
+		sqlTestCase{
+			ds:         goqu.Dialect("oracle").Insert("test").Prepared(true).Rows(goqu.Record{"a": 1, "b": true}),
+			sql:        `INSERT INTO "TEST" ("A", "B") VALUES (:1, :2)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(1)},
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestBooleans() {
+	ds := ods.GetDs("test")
+	ods.assertSQL(
+		sqlTestCase{ds: ds.Where(goqu.C("a").IsTrue()), sql: `SELECT * FROM "TEST" WHERE ("A" = 1)`},
+		sqlTestCase{ds: ds.Where(goqu.C("a").IsNotFalse()), sql: `SELECT * FROM "TEST" WHERE (("A" != 0) OR ("A" IS NULL))`},
+		sqlTestCase{
+			ds:  goqu.Dialect("oracle").Update("test").Set(goqu.Record{"a": false}),
+			sql: `UPDATE "TEST" SET "A"=0`,
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestTime() {
+	ts := time.Date(2021, 3, 4, 5, 6, 7, 8000, time.UTC)
+	ods.assertSQL(
+		sqlTestCase{
+			ds:  ods.GetDs("test").Where(goqu.C("created").Gt(ts)),
+			sql: `SELECT * FROM "TEST" WHERE ("CREATED" > TIMESTAMP '2021-03-04 05:06:07.000008')`,
+		},
+		sqlTestCase{
+			ds:         ods.GetDs("test").Where(goqu.C("created").Gt(ts)).Prepared(true),
+			sql:        `SELECT * FROM "TEST" WHERE ("CREATED" > :1)`,
+			isPrepared: true,
+			args:       []interface{}{ts},
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestLimitOffset() {
+	ds := ods.GetDs("test").Order(goqu.C("a").Asc())
+	ods.assertSQL(
+		sqlTestCase{ds: ds.Limit(10), sql: `SELECT * FROM "TEST" ORDER BY "A" ASC FETCH FIRST 10 ROWS ONLY`},
+		sqlTestCase{ds: ds.Offset(20), sql: `SELECT * FROM "TEST" ORDER BY "A" ASC OFFSET 20 ROWS`},
+		sqlTestCase{
+			ds:  ds.Limit(10).Offset(20),
+			sql: `SELECT * FROM "TEST" ORDER BY "A" ASC OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY`,
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Limit(10).Offset(20),
+			sql:        `SELECT * FROM "TEST" ORDER BY "A" ASC OFFSET :1 ROWS FETCH FIRST :2 ROWS ONLY`,
+			isPrepared: true,
+			args:       []interface{}{int64(20), int64(10)},
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestFetch() {
+	ds := ods.GetDs("test").Order(goqu.C("score").Desc())
+	ods.assertSQL(
+		sqlTestCase{
+			ds:  ds.Fetch(goqu.FetchFirst(3).WithTies()),
+			sql: `SELECT * FROM "TEST" ORDER BY "SCORE" DESC FETCH FIRST 3 ROWS WITH TIES`,
+		},
+		sqlTestCase{
+			ds:  ds.Offset(5).Fetch(goqu.FetchFirst(10).Percent()),
+			sql: `SELECT * FROM "TEST" ORDER BY "SCORE" DESC OFFSET 5 ROWS FETCH FIRST 10 PERCENT ROWS ONLY`,
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestCompoundExpressions() {
+	ds1 := ods.GetDs("test").Select("a")
+	ds2 := ods.GetDs("test2").Select("b")
+	ods.assertSQL(
+		sqlTestCase{ds: ds1.Except(ds2), sql: `SELECT "A" FROM "TEST" MINUS (SELECT "B" FROM "TEST2")`},
+		sqlTestCase{ds: ds1.ExceptAll(ds2), sql: `SELECT "A" FROM "TEST" EXCEPT ALL (SELECT "B" FROM "TEST2")`},
+		sqlTestCase{
+			ds:  ds1.Union(ds2).Parenthesize().Intersect(ds2).Order(goqu.C("a").Asc()),
+			sql: `(SELECT "A" FROM "TEST" UNION (SELECT "B" FROM "TEST2")) INTERSECT (SELECT "B" FROM "TEST2") ORDER BY "A" ASC`,
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestCountAndExists() {
+	ds := ods.GetDs("test").Where(goqu.C("a").Gt(10))
+	ods.assertSQL(
+		sqlTestCase{ds: ds.CountDataset(), sql: `SELECT COUNT(*) AS "COUNT" FROM (SELECT 1 FROM "TEST" WHERE ("A" > 10)) "T1"`},
+		sqlTestCase{
+			ds:  ds.ExistsDataset(),
+			sql: `SELECT CASE  WHEN EXISTS (SELECT * FROM "TEST" WHERE ("A" > 10)) THEN 1 ELSE 0 END FROM DUAL`,
+		},
+		sqlTestCase{ds: goqu.Dialect("oracle").Select(goqu.L("1")), sql: `SELECT 1 FROM DUAL`},
+	)
+}
+
+func (ods *oracleDialectSuite) TestPivot() {
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{
+			ds: d.From(goqu.Pivot(goqu.T("sales"), goqu.SUM("amount").As("total"), goqu.COUNT(goqu.Star()).As("cnt")).
+				For("month").
+				In(goqu.V("JAN").As("jan"), goqu.V("FEB").As("feb"))),
+			sql: `SELECT * FROM "SALES" PIVOT (SUM("AMOUNT") "TOTAL", COUNT(*) "CNT" FOR "MONTH" IN ('JAN' "JAN", 'FEB' "FEB"))`,
+		},
+		sqlTestCase{
+			ds:  d.From(goqu.Unpivot(goqu.T("sales"), "amount").For("month").In("jan", "feb").As("u")),
+			sql: `SELECT * FROM "SALES" UNPIVOT ("AMOUNT" FOR "MONTH" IN ("JAN", "FEB")) "U"`,
+		},
+		sqlTestCase{
+			ds:  d.From(goqu.Unpivot(goqu.T("sales"), "amount").For("month").In("jan", "feb").IncludeNulls()),
+			sql: `SELECT * FROM "SALES" UNPIVOT INCLUDE NULLS ("AMOUNT" FOR "MONTH" IN ("JAN", "FEB"))`,
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestRandom() {
+	ods.assertSQL(
+		sqlTestCase{
+			ds:  ods.GetDs("test").OrderRandom(10),
+			sql: `SELECT * FROM "TEST" ORDER BY DBMS_RANDOM.VALUE ASC FETCH FIRST 10 ROWS ONLY`,
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestConnectBy() {
+	ds := ods.GetDs("employees").Select("id", oracle.Level())
+	ods.assertSQL(
+		sqlTestCase{
+			ds: ds.StartWith(goqu.C("manager_id").IsNull()).
+				ConnectBy(oracle.Prior("id").Eq(goqu.C("manager_id"))).
+				Order(goqu.C("id").Asc()),
+			sql: `SELECT "ID", LEVEL FROM "EMPLOYEES" START WITH ("MANAGER_ID" IS NULL) ` +
+				`CONNECT BY (PRIOR "ID" = "MANAGER_ID") ORDER BY "ID" ASC`,
+		},
+		sqlTestCase{
+			ds: ds.Where(goqu.C("active").Eq(1)).
+				ConnectByNoCycle(oracle.Prior("id").Eq(goqu.C("manager_id")), oracle.Level().Lte(3)),
+			sql: `SELECT "ID", LEVEL FROM "EMPLOYEES" WHERE ("ACTIVE" = 1) ` +
+				`CONNECT BY NOCYCLE ((PRIOR "ID" = "MANAGER_ID") AND (LEVEL <= 3))`,
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).StartWith(goqu.C("id").Eq(10)).ConnectBy(oracle.Prior("id").Eq(goqu.C("manager_id"))),
+			sql:        `SELECT "ID", LEVEL FROM "EMPLOYEES" START WITH ("ID" = :1) CONNECT BY (PRIOR "ID" = "MANAGER_ID")`,
+			isPrepared: true,
+			args:       []interface{}{int64(10)},
+		},
+		sqlTestCase{
+			ds:  ds.StartWith(goqu.C("manager_id").IsNull()),
+			err: "goqu: a CONNECT BY condition is required for a hierarchical query",
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestForUpdate() {
+	ds := ods.GetDs("test")
+	ods.assertSQL(
+		sqlTestCase{ds: ds.ForUpdate(goqu.SkipLocked), sql: `SELECT * FROM "TEST" FOR UPDATE SKIP LOCKED`},
+		sqlTestCase{ds: ds.ForUpdate(goqu.NoWait), sql: `SELECT * FROM "TEST" FOR UPDATE NOWAIT`},
+		sqlTestCase{ds: ds.ForUpdateWait(5), sql: `SELECT * FROM "TEST" FOR UPDATE WAIT 5`},
+		sqlTestCase{
+			ds:  ds.ForUpdate(goqu.Wait).Limit(10),
+			err: "goqu: dialect does not support locking rows with LIMIT, OFFSET or FETCH [dialect=oracle]",
+		},
+		sqlTestCase{
+			ds:  ds.ForUpdate(goqu.Wait).Offset(10),
+			err: "goqu: dialect does not support locking rows with LIMIT, OFFSET or FETCH [dialect=oracle]",
+		},
+		sqlTestCase{
+			ds:  ds.ForUpdate(goqu.Wait).Fetch(goqu.FetchFirst(10)),
+			err: "goqu: dialect does not support locking rows with LIMIT, OFFSET or FETCH [dialect=oracle]",
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestHints() {
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{
+			ds:  ods.GetDs("test").Hint("INDEX(test idx_a)"),
+			sql: `SELECT /*+ INDEX(test idx_a) */ * FROM "TEST"`,
+		},
+		sqlTestCase{
+			ds:  d.From("t").With("t", ods.GetDs("test").Hint("INDEX(test idx_a)").Where(goqu.C("a").Gt(1))),
+			sql: `WITH t AS (SELECT /*+ INDEX(test idx_a) */ * FROM "TEST" WHERE ("A" > 1)) SELECT * FROM "T"`,
+		},
+		sqlTestCase{
+			ds:  d.Update("test").Hint("PARALLEL(test 4)").Set(goqu.Record{"a": 1}),
+			sql: `UPDATE /*+ PARALLEL(test 4) */ "TEST" SET "A"=1`,
+		},
+		sqlTestCase{
+			ds:  d.Delete("test").Hint("PARALLEL(test 4)").Where(goqu.C("a").Eq(1)),
+			sql: `DELETE /*+ PARALLEL(test 4) */ FROM "TEST" WHERE ("A" = 1)`,
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestCommonTables() {
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{
+			ds: d.From("nums").
+				WithRecursive("nums(n)", d.From("dual").Select(goqu.L("1")).
+					UnionAll(d.From("nums").Select(goqu.L("n + 1")).Where(goqu.C("n").Lt(5)))),
+			sql: `WITH nums(n) AS (SELECT 1 FROM "DUAL" UNION ALL ` +
+				`(SELECT n + 1 FROM "NUMS" WHERE ("N" < 5))) SELECT * FROM "NUMS"`,
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestUnsupported() {
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=oracle]",
+		},
+		sqlTestCase{
+			ds:  ods.GetDs("test").Distinct("a"),
+			err: "goqu: dialect does not support DISTINCT ON clause [dialect=oracle]",
+		},
+		sqlTestCase{
+			ds:  ods.GetDs("test").Where(goqu.C("a").ILike("a%")),
+			err: "goqu: boolean operator 'ilike' not supported",
+		},
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}, goqu.Record{"a": 2}),
+			err: "goqu: dialect does not support inserting multiple rows in a single INSERT [dialect=oracle]",
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestTruncate() {
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{ds: d.Truncate("test"), sql: `TRUNCATE TABLE "TEST"`},
+		sqlTestCase{
+			ds:  d.Truncate("test", "test2"),
+			err: "goqu: dialect does not support multiple tables in TRUNCATE [dialect=oracle]",
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestMaintenance() {
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{ds: d.Vacuum("items"), err: "goqu: dialect does not support VACUUM [dialect=oracle]"},
+		sqlTestCase{ds: d.Analyze("items"), err: "goqu: dialect does not support ANALYZE [dialect=oracle]"},
+		sqlTestCase{ds: d.Optimize("items"), err: "goqu: dialect does not support OPTIMIZE [dialect=oracle]"},
+	)
+}
+
+func (ods *oracleDialectSuite) TestSetParam() {
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{ds: d.SetParam("a", 1), err: "goqu: dialect does not support SET [dialect=oracle]"},
+		sqlTestCase{ds: d.Show("a"), err: "goqu: dialect does not support SHOW [dialect=oracle]"},
+	)
+}
+
+func (ods *oracleDialectSuite) TestCopy() {
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{ds: d.CopyFrom("items"), err: "goqu: dialect does not support COPY [dialect=oracle]"},
+		sqlTestCase{ds: d.CopyTo("items"), err: "goqu: dialect does not support COPY [dialect=oracle]"},
+	)
+}
+
+func (ods *oracleDialectSuite) TestCall() {
+	var total int64
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{ds: d.Call("hr.refresh_totals"), sql: `BEGIN "HR"."REFRESH_TOTALS"(); END;`},
+		sqlTestCase{
+			ds:   d.Call("user_totals", 10, sql.Out{Dest: &total}),
+			sql:  `BEGIN "USER_TOTALS"(10, :1); END;`,
+			args: []interface{}{sql.Out{Dest: &total}},
+		},
+		sqlTestCase{
+			ds:         d.Call("user_totals", 10, sql.Out{Dest: &total}).Prepared(true),
+			sql:        `BEGIN "USER_TOTALS"(:1, :2); END;`,
+			isPrepared: true,
+			args:       []interface{}{int64(10), sql.Out{Dest: &total}},
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestXMLTable() {
+	xt := goqu.XMLTable("/rows/row", "data").Columns(
+		goqu.XMLColumn("id", goqu.IntegerType()).Path("@id"),
+		goqu.XMLColumn("name", goqu.VarcharType(100)).Path("name").Default("unknown"),
+	).As("x")
+	ds := goqu.Dialect("oracle").From(goqu.T("docs"), xt).Select("x.id", "x.name")
+	ods.assertSQL(
+		sqlTestCase{
+			ds: ds,
+			sql: `SELECT "X"."ID", "X"."NAME" FROM "DOCS", XMLTABLE('/rows/row' PASSING "DATA" COLUMNS ` +
+				`"ID" NUMBER(10) PATH '@id', "NAME" VARCHAR2(100) PATH 'name' DEFAULT 'unknown') "X"`,
+		},
+		sqlTestCase{
+			ds: ds.Prepared(true),
+			sql: `SELECT "X"."ID", "X"."NAME" FROM "DOCS", XMLTABLE('/rows/row' PASSING "DATA" COLUMNS ` +
+				`"ID" NUMBER(10) PATH '@id', "NAME" VARCHAR2(100) PATH 'name' DEFAULT :1) "X"`,
+			isPrepared: true,
+			args:       []interface{}{"unknown"},
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestMerge() {
+	ds := goqu.Dialect("oracle").Merge(goqu.T("user").As("u")).
+		Using(goqu.T("staged_user").As("s")).
+		On(goqu.I("u.id").Eq(goqu.I("s.id")))
+	ods.assertSQL(
+		sqlTestCase{
+			ds: ds.WhenMatchedThenUpdate(goqu.Record{"name": goqu.I("s.name")}, goqu.I("s.active").IsTrue()).
+				WhenNotMatchedThenInsert(goqu.Record{"id": goqu.I("s.id"), "name": goqu.I("s.name")}),
+			sql: `MERGE INTO "USER" "U" USING "STAGED_USER" "S" ON ("U"."ID" = "S"."ID") ` +
+				`WHEN MATCHED THEN UPDATE SET "NAME"="S"."NAME" WHERE ("S"."ACTIVE" = 1) ` +
+				`WHEN NOT MATCHED THEN INSERT ("ID", "NAME") VALUES ("S"."ID", "S"."NAME")`,
+		},
+		sqlTestCase{
+			ds:  ds.WhenMatchedThenDelete(),
+			err: "goqu: dialect does not support WHEN MATCHED THEN DELETE in MERGE [dialect=oracle]",
+		},
+		sqlTestCase{
+			ds: ds.WhenMatchedThenUpdate(goqu.Record{"name": goqu.I("s.name")}, goqu.I("s.active").IsTrue()).
+				WhenMatchedThenUpdate(goqu.Record{"name": "unknown"}),
+			err: "goqu: dialect does not support multiple WHEN MATCHED or WHEN NOT MATCHED clauses in MERGE [dialect=oracle]",
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestCreateTable() {
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{
+			ds: d.CreateTable("test").Columns(
+				goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey().AutoIncrement(),
+				goqu.ColumnDef("name", goqu.VarcharType(255)).NotNull(),
+				goqu.ColumnDef("notes", goqu.TextType()),
+				goqu.ColumnDef("active", goqu.BooleanType()).Default(true),
+			),
+			sql: `CREATE TABLE "TEST" ("ID" NUMBER(19) GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, ` +
+				`"NAME" VARCHAR2(255) NOT NULL, "NOTES" CLOB, "ACTIVE" NUMBER(1) DEFAULT 1)`,
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Temporary().Columns(goqu.ColumnDef("id", goqu.IntegerType())),
+			sql: `CREATE GLOBAL TEMPORARY TABLE "TEST" ("ID" NUMBER(10))`,
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").IfNotExists().Columns(goqu.ColumnDef("id", goqu.IntegerType())),
+			err: "goqu: dialect does not support IF NOT EXISTS in CREATE TABLE [dialect=oracle]",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Columns(goqu.ColumnDef("t", goqu.TimeType())),
+			err: "goqu: dialect does not support data type Time [dialect=oracle]",
+		},
+	)
+}
+
+func (ods *oracleDialectSuite) TestAlterTable() {
+	d := goqu.Dialect("oracle")
+	ods.assertSQL(
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.VarcharType(10)).NotNull()),
+			sql: `ALTER TABLE "TEST" ADD "A" VARCHAR2(10) NOT NULL`,
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AlterColumnType("b", goqu.BigIntType()),
+			sql: `ALTER TABLE "TEST" MODIFY "B" NUMBER(19)`,
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").ModifyColumn(goqu.ColumnDef("b", goqu.BigIntType()).NotNull()),
+			sql: `ALTER TABLE "TEST" MODIFY "B" NUMBER(19) NOT NULL`,
+		},
+		sqlTestCase{ds: d.AlterTable("test").SetDefault("b", 1), sql: `ALTER TABLE "TEST" MODIFY "B" DEFAULT 1`},
+		sqlTestCase{ds: d.AlterTable("test").DropDefault("b"), sql: `ALTER TABLE "TEST" MODIFY "B" DEFAULT NULL`},
+		sqlTestCase{ds: d.AlterTable("test").SetNotNull("b"), sql: `ALTER TABLE "TEST" MODIFY "B" NOT NULL`},
+		sqlTestCase{ds: d.AlterTable("test").DropNotNull("b"), sql: `ALTER TABLE "TEST" MODIFY "B" NULL`},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.IntegerType())).DropColumn("c"),
+			err: "goqu: dialect does not support multiple actions in ALTER TABLE [dialect=oracle]",
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(oracleDialectSuite))
+}
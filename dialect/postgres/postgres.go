@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
 )
 
 func DialectOptions() *goqu.SQLDialectOptions {
@@ -12,6 +14,67 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	do.StringSliceQuote = '"'
 	do.SinglePlaceholderForSlice = true
 	do.IncludePlaceholderNum = true
+	do.SupportsCursors = true
+	do.SupportsListenNotify = true
+	do.SupportsTempTableOnCommit = true
+	do.SelectIntoFragment = []byte(" INTO ")
+	do.SelectIntoTempFragment = []byte(" INTO TEMPORARY ")
+	do.XMLTableFragment = []byte("XMLTABLE")
+	do.TableOnlyFragment = []byte("ONLY ")
+	do.WithOrdinalityFragment = []byte(" WITH ORDINALITY")
+	do.RowsFromFragment = []byte("ROWS FROM ")
+	// postgres 13+
+	do.SupportsFetchWithTies = true
+	do.DataTypeLookup[exp.BinaryDataType] = []byte("BYTEA")
+	do.CastTypeLookup = map[string][]byte{
+		"timestamptz": []byte("TIMESTAMPTZ"),
+	}
+	do.JSONOperatorLookup = map[exp.JSONOperation][]byte{
+		exp.JSONGetOp:         []byte("->"),
+		exp.JSONGetTextOp:     []byte("->>"),
+		exp.JSONGetPathOp:     []byte("#>"),
+		exp.JSONGetPathTextOp: []byte("#>>"),
+		exp.JSONContainsOp:    []byte("@>"),
+		exp.JSONContainedByOp: []byte("<@"),
+		exp.JSONHasKeyOp:      []byte("?"),
+		exp.JSONHasAnyKeyOp:   []byte("?|"),
+		exp.JSONHasAllKeysOp:  []byte("?&"),
+		exp.JSONConcatOp:      []byte("||"),
+	}
+	do.JSONFunctionLookup = map[exp.JSONFunction][]byte{
+		exp.JSONPathQueryFunc:   []byte("jsonb_path_query"),
+		exp.JSONSetFunc:         []byte("jsonb_set"),
+		exp.JSONBuildObjectFunc: []byte("jsonb_build_object"),
+		exp.JSONAggFunc:         []byte("json_agg"),
+		exp.JSONObjectAggFunc:   []byte("json_object_agg"),
+	}
+	do.JSONValueCastType = []byte("JSONB")
+	do.ArrayOperatorLookup = map[exp.ArrayOperation][]byte{
+		exp.ArrayContainsOp:    []byte("@>"),
+		exp.ArrayContainedByOp: []byte("<@"),
+		exp.ArrayOverlapOp:     []byte("&&"),
+	}
+	do.ArrayConstructorFragment = []byte("ARRAY")
+	do.SupportsArrayIndex = true
+	do.SupportsArraySlice = true
+	do.SupportsRangeTypes = true
+	do.RangeTypeOperatorLookup = map[exp.RangeTypeOperation][]byte{
+		exp.RangeTypeContainsOp:    []byte("@>"),
+		exp.RangeTypeContainedByOp: []byte("<@"),
+		exp.RangeTypeOverlapOp:     []byte("&&"),
+		exp.RangeTypeAdjacentOp:    []byte("-|-"),
+	}
+	do.IntervalStyle = sqlgen.QuotedIntervalStyle
+	do.IntervalUnitLookup = map[exp.IntervalUnit][]byte{
+		exp.MicrosecondsIntervalUnit: []byte("microseconds"),
+		exp.SecondsIntervalUnit:      []byte("seconds"),
+		exp.MinutesIntervalUnit:      []byte("minutes"),
+		exp.HoursIntervalUnit:        []byte("hours"),
+		exp.DaysIntervalUnit:         []byte("days"),
+		exp.WeeksIntervalUnit:        []byte("weeks"),
+		exp.MonthsIntervalUnit:       []byte("months"),
+		exp.YearsIntervalUnit:        []byte("years"),
+	}
 	return do
 }
 
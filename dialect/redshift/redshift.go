@@ -0,0 +1,49 @@
+package redshift
+
+import (
+	"github.com/doug-martin/goqu/v9"
+)
+
+func DialectOptions() *goqu.SQLDialectOptions {
+	do := goqu.DefaultDialectOptions()
+	do.PlaceHolderFragment = []byte("$")
+	do.IncludePlaceholderNum = true
+	do.SupportsCursors = true
+
+	do.SupportsReturn = false
+	do.SupportsDistinctOn = false
+	do.MaterializedCTEFragment = nil
+	do.NotMaterializedCTEFragment = nil
+	do.CTESearchFragment = nil
+	do.CTECycleFragment = nil
+	do.SupportsLateral = false
+	do.AggregateFilterFragment = nil
+	do.AggregateOrderByFragment = nil
+	// redshift does not support ON CONFLICT, upserts are done with MERGE
+	do.SupportsConflict = false
+	do.SupportsConflictTarget = false
+	do.SupportsConflictUpdateWhere = false
+
+	// redshift loads data from a source (e.g. s3) and exports data using UNLOAD, see CopyFromS3
+	do.CopyFromStdinFragment = nil
+	do.CopyToStdoutFragment = nil
+	do.CopyWithFragment = nil
+
+	do.SupportsMultipleTruncateTables = false
+	do.SupportsTruncateIdentity = false
+	do.SupportsTruncateCascade = false
+
+	// redshift only accepts a single action in an ALTER TABLE, can only change the size of a VARCHAR column and does
+	// not support changing the DEFAULT or NOT NULL of a column
+	do.SupportsMultipleAlterTableActions = false
+	do.AlterColumnTypeFragment = nil
+	do.SetDefaultFragment = nil
+	do.DropDefaultFragment = nil
+	do.SetNotNullFragment = nil
+	do.DropNotNullFragment = nil
+	return do
+}
+
+func init() {
+	goqu.RegisterDialect("redshift", DialectOptions())
+}
@@ -0,0 +1,191 @@
+package redshift_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/redshift"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	redshiftDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exec.Statement
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (rds *redshiftDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("redshift").From(table)
+}
+
+func (rds *redshiftDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			rds.NoError(err, "test case %d failed", i)
+		} else {
+			rds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		rds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			rds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			rds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (rds *redshiftDialectSuite) TestPlaceholders() {
+	ds := rds.GetDs("test").Prepared(true)
+	rds.assertSQL(
+		sqlTestCase{
+			ds:         ds.Where(goqu.C("a").Eq(1), goqu.C("b").In([]string{"a", "b"})),
+			sql:        `SELECT * FROM "test" WHERE (("a" = $1) AND ("b" IN ($2, $3)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", "b"},
+		},
+	)
+}
+
+func (rds *redshiftDialectSuite) TestReturning() {
+	d := goqu.Dialect("redshift")
+	expectedErr := "goqu: dialect does not support RETURNING clause [dialect=redshift]"
+	rds.assertSQL(
+		sqlTestCase{ds: d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"), err: expectedErr},
+		sqlTestCase{ds: d.Update("test").Set(goqu.Record{"a": 1}).Returning("id"), err: expectedErr},
+		sqlTestCase{ds: d.Delete("test").Returning("id"), err: expectedErr},
+	)
+}
+
+func (rds *redshiftDialectSuite) TestOnConflict() {
+	d := goqu.Dialect("redshift")
+	expectedErr := "goqu: dialect does not support ON CONFLICT clause [dialect=redshift]"
+	rds.assertSQL(
+		sqlTestCase{ds: d.Insert("test").Rows(goqu.Record{"a": 1}).OnConflict(goqu.DoNothing()), err: expectedErr},
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).OnConflict(goqu.DoUpdate("a", goqu.Record{"a": 2})),
+			err: expectedErr,
+		},
+	)
+}
+
+func (rds *redshiftDialectSuite) TestDistinctOn() {
+	rds.assertSQL(
+		sqlTestCase{
+			ds:  rds.GetDs("test").Distinct("a"),
+			err: "goqu: dialect does not support DISTINCT ON clause [dialect=redshift]",
+		},
+	)
+}
+
+func (rds *redshiftDialectSuite) TestTruncate() {
+	d := goqu.Dialect("redshift")
+	rds.assertSQL(
+		sqlTestCase{ds: d.Truncate("test"), sql: `TRUNCATE "test"`},
+		sqlTestCase{
+			ds:  d.Truncate("test", "test2"),
+			err: "goqu: dialect does not support multiple tables in TRUNCATE [dialect=redshift]",
+		},
+		sqlTestCase{
+			ds:  d.Truncate("test").Cascade(),
+			err: "goqu: dialect does not support CASCADE or RESTRICT in TRUNCATE [dialect=redshift]",
+		},
+		sqlTestCase{
+			ds:  d.Truncate("test").Identity("restart"),
+			err: "goqu: dialect does not support IDENTITY in TRUNCATE [dialect=redshift]",
+		},
+	)
+}
+
+func (rds *redshiftDialectSuite) TestAlterTable() {
+	d := goqu.Dialect("redshift")
+	rds.assertSQL(
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.VarcharType(10))),
+			sql: `ALTER TABLE "test" ADD COLUMN "a" VARCHAR(10)`,
+		},
+		sqlTestCase{ds: d.AlterTable("test").RenameColumn("a", "b"), sql: `ALTER TABLE "test" RENAME COLUMN "a" TO "b"`},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AlterColumnType("a", goqu.BigIntType()),
+			err: "goqu: dialect does not support ALTER COLUMN TYPE in ALTER TABLE [dialect=redshift]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").SetNotNull("a"),
+			err: "goqu: dialect does not support SET NOT NULL in ALTER TABLE [dialect=redshift]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").SetDefault("a", 1),
+			err: "goqu: dialect does not support SET DEFAULT in ALTER TABLE [dialect=redshift]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.IntegerType())).DropColumn("c"),
+			err: "goqu: dialect does not support multiple actions in ALTER TABLE [dialect=redshift]",
+		},
+	)
+}
+
+func (rds *redshiftDialectSuite) TestCopyFromS3() {
+	rds.assertSQL(
+		sqlTestCase{
+			ds: redshift.CopyFromS3("public.users", "s3://bucket/users/").
+				IAMRole("arn:aws:iam::0123456789012:role/MyRedshiftRole"),
+			sql: `COPY "public"."users" FROM 's3://bucket/users/' ` +
+				`IAM_ROLE 'arn:aws:iam::0123456789012:role/MyRedshiftRole'`,
+		},
+		sqlTestCase{
+			ds: redshift.CopyFromS3("users", "s3://bucket/users/").
+				Columns("id", "name").
+				Credentials("aws_access_key_id=a;aws_secret_access_key=b").
+				Format(redshift.FormatCSV).
+				Options("IGNOREHEADER 1").
+				Options("REGION 'us-east-1'"),
+			sql: `COPY "users" ("id", "name") FROM 's3://bucket/users/' ` +
+				`CREDENTIALS 'aws_access_key_id=a;aws_secret_access_key=b' FORMAT AS CSV IGNOREHEADER 1 REGION 'us-east-1'`,
+		},
+		sqlTestCase{
+			ds:  redshift.CopyFromS3("users", "s3://it's/").IAMRole("role").Format(redshift.FormatJSON),
+			sql: `COPY "users" FROM 's3://it''s/' IAM_ROLE 'role' FORMAT AS JSON 'auto'`,
+		},
+		sqlTestCase{
+			ds:  redshift.CopyFromS3("", "s3://bucket/"),
+			err: "goqu: a table is required when generating COPY sql",
+		},
+		sqlTestCase{
+			ds:  redshift.CopyFromS3("users", ""),
+			err: "goqu: an s3 location is required when generating COPY sql",
+		},
+		sqlTestCase{
+			ds:  redshift.CopyFromS3("users", "s3://bucket/"),
+			err: "goqu: an IAM_ROLE or CREDENTIALS are required when generating COPY sql",
+		},
+	)
+}
+
+func (rds *redshiftDialectSuite) TestCopy() {
+	d := goqu.Dialect("redshift")
+	rds.assertSQL(
+		sqlTestCase{
+			ds:  d.CopyFrom("users").Source("s3://bucket/users/").Parameters(goqu.L("IAM_ROLE ?", "role")),
+			sql: `COPY "users" FROM 's3://bucket/users/' IAM_ROLE 'role'`,
+		},
+		sqlTestCase{ds: d.CopyFrom("users"), err: "goqu: dialect does not support FROM STDIN in COPY [dialect=redshift]"},
+		sqlTestCase{ds: d.CopyTo("users"), err: "goqu: dialect does not support TO STDOUT in COPY [dialect=redshift]"},
+		sqlTestCase{
+			ds:  d.CopyFrom("users").Source("s3://bucket/users/").Options(exp.CopyOptions{Header: true}),
+			err: "goqu: dialect does not support WITH options in COPY [dialect=redshift]",
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(redshiftDialectSuite))
+}
@@ -0,0 +1,136 @@
+package snowflake
+
+import (
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+// DialectOptions returns the options for snowflake. Selects support the QUALIFY and SAMPLE clauses (see
+// SelectDataset#Qualify and SelectDataset#Sample) and identifiers are upper cased before quoting them so they match
+// objects created with unquoted names.
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := goqu.DefaultDialectOptions()
+
+	opts.SupportsReturn = false
+	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.SupportsConflict = false
+	opts.SupportsConflictTarget = false
+	opts.SupportsConflictUpdateWhere = false
+	opts.SupportsDerivedColumnAliases = false
+	opts.SupportsQualify = true
+	opts.PivotFragment = []byte(" PIVOT ")
+	opts.UnpivotFragment = []byte(" UNPIVOT ")
+	opts.UnpivotIncludeNullsFragment = []byte("INCLUDE NULLS ")
+	// snowflake loads data from stages using COPY INTO
+	opts.CopyFragment = nil
+
+	// snowflake folds unquoted identifiers to upper case
+	opts.UpperCaseIdentifiers = true
+
+	opts.AutoIncrementFragment = []byte(" AUTOINCREMENT")
+	// semi-structured data (e.g. JSON) is stored as a VARIANT
+	opts.DataTypeLookup = map[exp.DataTypeKind][]byte{
+		exp.SmallIntDataType:    []byte("SMALLINT"),
+		exp.IntegerDataType:     []byte("INTEGER"),
+		exp.BigIntDataType:      []byte("BIGINT"),
+		exp.DecimalDataType:     []byte("NUMBER"),
+		exp.RealDataType:        []byte("REAL"),
+		exp.DoubleDataType:      []byte("DOUBLE PRECISION"),
+		exp.BooleanDataType:     []byte("BOOLEAN"),
+		exp.CharDataType:        []byte("CHAR"),
+		exp.VarcharDataType:     []byte("VARCHAR"),
+		exp.TextDataType:        []byte("TEXT"),
+		exp.DateDataType:        []byte("DATE"),
+		exp.TimeDataType:        []byte("TIME"),
+		exp.TimestampDataType:   []byte("TIMESTAMP_NTZ"),
+		exp.TimestampTzDataType: []byte("TIMESTAMP_TZ"),
+		exp.BinaryDataType:      []byte("BINARY"),
+		exp.UUIDDataType:        []byte("VARCHAR(36)"),
+		exp.JSONDataType:        []byte("VARIANT"),
+	}
+
+	opts.EscapedRunes = map[rune][]byte{
+		'\'': []byte("\\'"),
+		'\\': []byte("\\\\"),
+	}
+
+	opts.TruncateClause = []byte("TRUNCATE TABLE")
+	opts.SupportsMultipleTruncateTables = false
+	opts.SupportsTruncateIdentity = false
+	opts.SupportsTruncateCascade = false
+
+	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
+		exp.EqOp:       []byte("="),
+		exp.NeqOp:      []byte("!="),
+		exp.GtOp:       []byte(">"),
+		exp.GteOp:      []byte(">="),
+		exp.LtOp:       []byte("<"),
+		exp.LteOp:      []byte("<="),
+		exp.InOp:       []byte("IN"),
+		exp.NotInOp:    []byte("NOT IN"),
+		exp.IsOp:       []byte("IS"),
+		exp.IsNotOp:    []byte("IS NOT"),
+		exp.LikeOp:     []byte("LIKE"),
+		exp.NotLikeOp:  []byte("NOT LIKE"),
+		exp.ILikeOp:    []byte("ILIKE"),
+		exp.NotILikeOp: []byte("NOT ILIKE"),
+
+		exp.IsDistinctFromOp:    []byte("IS DISTINCT FROM"),
+		exp.IsNotDistinctFromOp: []byte("IS NOT DISTINCT FROM"),
+	}
+	// snowflake only supports bitwise operations through functions (e.g. BITAND)
+	opts.BitwiseOperatorLookup = map[exp.BitwiseOperation][]byte{}
+	opts.AggregateFilterFragment = nil
+	// snowflake converts time zones with CONVERT_TIMEZONE(<time zone>, <timestamp>)
+	opts.ConvertTimeZoneFunction = []byte("CONVERT_TIMEZONE")
+	opts.ConvertTimeZoneZoneFirst = true
+	opts.AggregateOrderByFragment = nil
+
+	// snowflake does not support row locking
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.CommonTableSQLFragment,
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.SampleSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.GroupBySQLFragment,
+		sqlgen.HavingSQLFragment,
+		sqlgen.QualifySQLFragment,
+		sqlgen.WindowSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.LimitSQLFragment,
+		sqlgen.OffsetSQLFragment,
+	}
+	return opts
+}
+
+// Path accesses an element of a semi-structured (VARIANT, OBJECT or ARRAY) column. The path is written as is so it
+// should not contain user input
+//    snowflake.Path("src", "salesperson.name") // "SRC":salesperson.name
+//    snowflake.Path("src", "items[0].price")   // "SRC":items[0].price
+func Path(col, path string) exp.LiteralExpression {
+	return goqu.L("?:"+path, goqu.I(col))
+}
+
+// Percent creates a sample size that returns roughly the percentage of rows, use it with SelectDataset#Sample
+//    dialect.From("users").Sample(snowflake.Percent(10)) // SELECT * FROM "USERS" SAMPLE (10)
+func Percent(percent interface{}) exp.LiteralExpression {
+	return goqu.L("(?)", percent)
+}
+
+// Rows creates a sample size that returns a fixed number of rows, use it with SelectDataset#Sample
+//    dialect.From("users").Sample(snowflake.Rows(100)) // SELECT * FROM "USERS" SAMPLE (100 ROWS)
+func Rows(rows interface{}) exp.LiteralExpression {
+	return goqu.L("(? ROWS)", rows)
+}
+
+func init() {
+	goqu.RegisterDialect("snowflake", DialectOptions())
+}
@@ -0,0 +1,199 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/snowflake"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	snowflakeDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exec.Statement
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (sds *snowflakeDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("snowflake").From(table)
+}
+
+func (sds *snowflakeDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			sds.NoError(err, "test case %d failed", i)
+		} else {
+			sds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		sds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			sds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			sds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (sds *snowflakeDialectSuite) TestIdentifiers() {
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  sds.GetDs("analytics.public.users").Select("id", goqu.I("users.name").As("user_name")),
+			sql: `SELECT "ID", "USERS"."NAME" AS "USER_NAME" FROM "ANALYTICS"."PUBLIC"."USERS"`,
+		},
+	)
+}
+
+func (sds *snowflakeDialectSuite) TestQualify() {
+	ds := sds.GetDs("events").
+		Select("user_id", goqu.ROW_NUMBER().Over(goqu.W().PartitionBy("user_id").OrderBy(goqu.C("ts").Desc())).As("rn"))
+	sds.assertSQL(
+		sqlTestCase{
+			ds: ds.Qualify(goqu.C("rn").Eq(1)),
+			sql: `SELECT "USER_ID", ROW_NUMBER() OVER (PARTITION BY "USER_ID" ORDER BY "TS" DESC) AS "RN" ` +
+				`FROM "EVENTS" QUALIFY ("RN" = 1)`,
+		},
+		sqlTestCase{
+			ds: ds.Prepared(true).Where(goqu.C("type").Eq("click")).Qualify(goqu.C("rn").Eq(1)),
+			sql: `SELECT "USER_ID", ROW_NUMBER() OVER (PARTITION BY "USER_ID" ORDER BY "TS" DESC) AS "RN" ` +
+				`FROM "EVENTS" WHERE ("TYPE" = ?) QUALIFY ("RN" = ?)`,
+			isPrepared: true,
+			args:       []interface{}{"click", int64(1)},
+		},
+	)
+}
+
+func (sds *snowflakeDialectSuite) TestILike() {
+	ds := sds.GetDs("users")
+	sds.assertSQL(
+		sqlTestCase{ds: ds.Where(goqu.C("name").ILike("bob%")), sql: `SELECT * FROM "USERS" WHERE ("NAME" ILIKE 'bob%')`},
+		sqlTestCase{
+			ds:  ds.Where(goqu.C("name").NotILike("bob%")),
+			sql: `SELECT * FROM "USERS" WHERE ("NAME" NOT ILIKE 'bob%')`,
+		},
+		sqlTestCase{
+			ds:  ds.Where(goqu.C("name").RegexpLike("bob.*")),
+			err: "goqu: boolean operator 'regexplike' not supported",
+		},
+	)
+}
+
+func (sds *snowflakeDialectSuite) TestPath() {
+	ds := sds.GetDs("sales")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(snowflake.Path("src", "salesperson.name").As("name")),
+			sql: `SELECT "SRC":salesperson.name AS "NAME" FROM "SALES"`,
+		},
+		sqlTestCase{
+			ds:  ds.Where(goqu.Cast(snowflake.Path("src", "items[0].price"), "NUMBER").Gt(10)),
+			sql: `SELECT * FROM "SALES" WHERE (CAST("SRC":items[0].price AS NUMBER) > 10)`,
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(snowflake.Path("s.src", "region").Eq("west")),
+			sql:        `SELECT * FROM "SALES" WHERE ("S"."SRC":region = ?)`,
+			isPrepared: true,
+			args:       []interface{}{"west"},
+		},
+	)
+}
+
+func (sds *snowflakeDialectSuite) TestSample() {
+	ds := sds.GetDs("users")
+	sds.assertSQL(
+		sqlTestCase{ds: ds.Sample(snowflake.Percent(10)), sql: `SELECT * FROM "USERS" SAMPLE (10)`},
+		sqlTestCase{ds: ds.Sample(snowflake.Rows(100)), sql: `SELECT * FROM "USERS" SAMPLE (100 ROWS)`},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Sample(snowflake.Percent(10)).Where(goqu.C("active").IsTrue()),
+			sql:        `SELECT * FROM "USERS" SAMPLE (?) WHERE ("ACTIVE" IS TRUE)`,
+			isPrepared: true,
+			args:       []interface{}{int64(10)},
+		},
+		sqlTestCase{
+			ds: ds.Sample(snowflake.Percent(10)).
+				Join(goqu.T("orders"), goqu.On(goqu.I("orders.user_id").Eq(goqu.I("users.id")))),
+			sql: `SELECT * FROM "USERS" SAMPLE (10) INNER JOIN "ORDERS" ON ("ORDERS"."USER_ID" = "USERS"."ID")`,
+		},
+	)
+}
+
+func (sds *snowflakeDialectSuite) TestPivot() {
+	d := goqu.Dialect("snowflake")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.From(goqu.Pivot(goqu.T("sales"), goqu.SUM("amount")).For("month").In("JAN", "FEB").As("p")),
+			sql: `SELECT * FROM "SALES" PIVOT (SUM("AMOUNT") FOR "MONTH" IN ('JAN', 'FEB')) AS "P"`,
+		},
+		sqlTestCase{
+			ds:         d.From(goqu.Pivot(goqu.T("sales"), goqu.SUM("amount")).For("month").In("JAN")).Prepared(true),
+			sql:        `SELECT * FROM "SALES" PIVOT (SUM("AMOUNT") FOR "MONTH" IN ('JAN'))`,
+			isPrepared: true,
+		},
+		sqlTestCase{
+			ds:  d.From(goqu.Unpivot(goqu.T("sales"), "amount").For("month").In("jan", "feb").IncludeNulls()),
+			sql: `SELECT * FROM "SALES" UNPIVOT INCLUDE NULLS ("AMOUNT" FOR "MONTH" IN ("JAN", "FEB"))`,
+		},
+	)
+}
+
+func (sds *snowflakeDialectSuite) TestUnsupported() {
+	d := goqu.Dialect("snowflake")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=snowflake]",
+		},
+		sqlTestCase{
+			ds:  d.Truncate("test", "test2"),
+			err: "goqu: dialect does not support multiple tables in TRUNCATE [dialect=snowflake]",
+		},
+	)
+}
+
+func (sds *snowflakeDialectSuite) TestAtTimeZone() {
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  sds.GetDs("test").Select(goqu.C("created_at").AtTimeZone("UTC")),
+			sql: `SELECT CONVERT_TIMEZONE('UTC', "CREATED_AT") FROM "TEST"`,
+		},
+	)
+}
+
+func (sds *snowflakeDialectSuite) TestCreateTable() {
+	d := goqu.Dialect("snowflake")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: d.CreateTable("test").IfNotExists().Columns(
+				goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey().AutoIncrement(),
+				goqu.ColumnDef("data", goqu.JSONType()),
+				goqu.ColumnDef("created", goqu.TimestampType()),
+			),
+			sql: `CREATE TABLE IF NOT EXISTS "TEST" ("ID" BIGINT AUTOINCREMENT PRIMARY KEY, "DATA" VARIANT, ` +
+				`"CREATED" TIMESTAMP_NTZ)`,
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Temporary().Columns(goqu.ColumnDef("id", goqu.IntegerType())),
+			sql: `CREATE TEMPORARY TABLE "TEST" ("ID" INTEGER)`,
+		},
+	)
+}
+
+func (sds *snowflakeDialectSuite) TestCopy() {
+	d := goqu.Dialect("snowflake")
+	sds.assertSQL(
+		sqlTestCase{ds: d.CopyFrom("items"), err: "goqu: dialect does not support COPY [dialect=snowflake]"},
+		sqlTestCase{ds: d.CopyTo("items"), err: "goqu: dialect does not support COPY [dialect=snowflake]"},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(snowflakeDialectSuite))
+}
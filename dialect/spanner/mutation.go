@@ -0,0 +1,192 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+)
+
+type (
+	// MutationOp is the type of write performed by a Mutation.
+	MutationOp int
+	// Mutation contains a single row to write using the spanner client (e.g. spanner.Insert(m.Table, m.Columns,
+	// m.Values)). Mutations can be applied through the database/sql driver by using sql.Conn#Raw and calling
+	// BufferWrite on the driver connection.
+	Mutation struct {
+		Op      MutationOp
+		Table   string
+		Columns []string
+		Values  []interface{}
+	}
+)
+
+const (
+	// Insert the row, the write fails if the row already exists.
+	InsertOp MutationOp = iota
+	// Insert the row or update the columns of the existing row.
+	InsertOrUpdateOp
+)
+
+var (
+	errMutationTableRequired = errors.New("a table is required when generating spanner mutations")
+	errMutationRowsRequired  = errors.New("rows or columns and values are required when generating spanner mutations")
+	errMutationDoNothing     = errors.New("spanner mutations do not support ON CONFLICT DO NOTHING")
+	errMutationUpdateWhere   = errors.New("spanner mutations do not support ON CONFLICT DO UPDATE with a WHERE")
+)
+
+func errUnsupportedMutationColumn(col interface{}) error {
+	return errors.New("unsupported spanner mutation column %T", col)
+}
+
+func errUnsupportedMutationValue(col string, val interface{}) error {
+	return errors.New("unsupported spanner mutation value %T [column=%s]", val, col)
+}
+
+func errMutationUpdateColumn(col string) error {
+	return errors.New(
+		"spanner mutations only support ON CONFLICT DO UPDATE setting each inserted column to its EXCLUDED value [column=%s]",
+		col,
+	)
+}
+
+func errMutationRowLength(expected, actual int) error {
+	return errors.New("rows with different value length expected %d got %d", expected, actual)
+}
+
+func (mo MutationOp) String() string {
+	switch mo {
+	case InsertOp:
+		return "Insert"
+	case InsertOrUpdateOp:
+		return "InsertOrUpdate"
+	}
+	return "Unknown"
+}
+
+// InsertMutations converts the rows of an InsertDataset into Mutations, one per row. The values are returned as is
+// so they are encoded by the spanner client instead of being interpolated. If the dataset has an ON CONFLICT DO UPDATE
+// the InsertOrUpdateOp is used, an InsertOrUpdate overwrites the existing row with the inserted values so the update
+// must set every inserted column, other than the conflict target, to its EXCLUDED value.
+//    goqu.DoUpdate("SingerId", goqu.Record{"FirstName": goqu.I("EXCLUDED.FirstName")})
+//    mutations, err := spanner.InsertMutations(
+//        goqu.Dialect("spanner").Insert("Singers").Rows(goqu.Record{"SingerId": 1, "FirstName": "Marc"}),
+//    )
+//    // []Mutation{{Op: InsertOp, Table: "Singers", Columns: []string{"FirstName", "SingerId"}, Values: ...}}
+func InsertMutations(ds *goqu.InsertDataset) ([]Mutation, error) {
+	clauses := ds.GetClauses()
+	table := mutationTable(clauses.Into())
+	if table == "" {
+		return nil, errMutationTableRequired
+	}
+	cols, vals, err := mutationRows(clauses)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]string, 0, len(cols.Columns()))
+	for _, c := range cols.Columns() {
+		if ident, ok := c.(exp.IdentifierExpression); ok {
+			if col, ok := ident.GetCol().(string); ok {
+				columns = append(columns, col)
+				continue
+			}
+		}
+		return nil, errUnsupportedMutationColumn(c)
+	}
+	op := InsertOp
+	if oc := clauses.OnConflict(); oc != nil {
+		if oc.Action() == exp.DoNothingConflictAction {
+			return nil, errMutationDoNothing
+		}
+		if cu, ok := oc.(exp.ConflictUpdateExpression); ok {
+			if err := checkMutationUpdate(cu, columns); err != nil {
+				return nil, err
+			}
+		}
+		op = InsertOrUpdateOp
+	}
+	mutations := make([]Mutation, 0, len(vals))
+	for _, row := range vals {
+		if len(row) != len(columns) {
+			return nil, errMutationRowLength(len(columns), len(row))
+		}
+		for i, v := range row {
+			if _, ok := v.(exp.Expression); ok {
+				return nil, errUnsupportedMutationValue(columns[i], v)
+			}
+		}
+		mutations = append(mutations, Mutation{
+			Op:      op,
+			Table:   table,
+			Columns: columns,
+			Values:  row,
+		})
+	}
+	return mutations, nil
+}
+
+// checks that the DO UPDATE writes the same values as an InsertOrUpdate, i.e. every inserted column that is not part
+// of the conflict target is set to its EXCLUDED value
+func checkMutationUpdate(cu exp.ConflictUpdateExpression, columns []string) error {
+	if w := cu.WhereClause(); w != nil && !w.IsEmpty() {
+		return errMutationUpdateWhere
+	}
+	updates, err := exp.NewUpdateExpressions(cu.Update())
+	if err != nil {
+		return err
+	}
+	set := make(map[string]bool, len(updates))
+	for _, u := range updates {
+		col, _ := u.Col().GetCol().(string)
+		val, ok := u.Val().(exp.IdentifierExpression)
+		if !ok || !strings.EqualFold(val.GetTable(), "excluded") || val.GetCol() != col {
+			return errMutationUpdateColumn(col)
+		}
+		set[col] = true
+	}
+	target := make(map[string]bool)
+	for _, t := range strings.Split(cu.TargetColumn(), ",") {
+		target[strings.TrimSpace(t)] = true
+	}
+	for _, col := range columns {
+		if !set[col] && !target[col] {
+			return errMutationUpdateColumn(col)
+		}
+		delete(set, col)
+	}
+	for col := range set {
+		return errMutationUpdateColumn(col)
+	}
+	return nil
+}
+
+// returns the table name of the INTO clause, tables in a named schema are returned as "schema.table"
+func mutationTable(into exp.Expression) string {
+	ident, ok := into.(exp.IdentifierExpression)
+	if !ok {
+		return ""
+	}
+	col, _ := ident.GetCol().(string)
+	var parts []string
+	for _, p := range []string{ident.GetSchema(), ident.GetTable(), col} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func mutationRows(clauses exp.InsertClauses) (exp.ColumnListExpression, []exp.Vals, error) {
+	switch {
+	case clauses.HasRows():
+		ie, err := exp.NewInsertExpression(clauses.Rows()...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ie.Cols(), ie.Vals(), nil
+	case clauses.HasCols() && clauses.HasVals():
+		return clauses.Cols(), clauses.Vals(), nil
+	}
+	return nil, nil, errMutationRowsRequired
+}
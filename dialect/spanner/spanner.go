@@ -0,0 +1,113 @@
+package spanner
+
+import (
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+// the FORCE_INDEX value that reads from the base table instead of an index
+const baseTableIndex = "_BASE_TABLE"
+
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := goqu.DefaultDialectOptions()
+
+	opts.PlaceHolderFragment = []byte("@p")
+	opts.IncludePlaceholderNum = true
+	// bind args by name so they match the @p1 placeholders when executing with the spanner driver
+	opts.NamedArgPrefix = "p"
+	opts.QuoteRune = '`'
+	opts.ReturningFragment = []byte(" THEN RETURN ")
+
+	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.SupportsLateral = false
+	opts.SupportsDerivedColumnAliases = false
+	opts.SupportsConflict = false
+	opts.SupportsConflictTarget = false
+	opts.SupportsConflictUpdateWhere = false
+	opts.SupportsMultipleUpdateTables = false
+	opts.SupportsWithCTERecursive = false
+	opts.RollupFragment = nil
+	opts.CubeFragment = nil
+	opts.GroupingSetsFragment = nil
+	// upserts use mutations (see InsertMutations)
+	opts.MergeFragment = nil
+	opts.CallFragment = nil
+	opts.ExplainFragment = nil
+	opts.CopyFragment = nil
+	opts.SetParamFragment = nil
+	opts.ShowFragment = nil
+	opts.VacuumFragment = nil
+	opts.AnalyzeFragment = nil
+	// spanner does not have a function that returns random values
+	opts.RandomFunction = nil
+	// LIKE patterns are always escaped with a backslash
+	opts.LikeEscapeFragment = nil
+	// spanner does not support FILTER on aggregates so they are rewritten using CASE
+	opts.AggregateFilterFragment = nil
+	// spanner does not support AT TIME ZONE, time zones are passed to the timestamp functions
+	opts.AtTimeZoneFragment = nil
+
+	// spanner does not have temporary tables, the primary key of a table is written after the column list and
+	// column defaults are expressions wrapped in parens
+	opts.CreateTempTableFragment = nil
+	opts.PrimaryKeyAfterColumns = true
+	opts.WrapColumnDefaults = true
+	opts.AutoIncrementFragment = nil
+	// ALTER COLUMN replaces the type and NOT NULL of a column, columns cannot be renamed and an ALTER TABLE statement
+	// only accepts a single action
+	opts.SupportsMultipleAlterTableActions = false
+	opts.ColumnTypeFragment = []byte(" ")
+	opts.ModifyColumnFragment = []byte("ALTER COLUMN ")
+	opts.RenameColumnFragment = nil
+	opts.SetNotNullFragment = nil
+	opts.DropNotNullFragment = nil
+	// STRING and BYTES require a length, spanner does not have a TIME data type
+	opts.DataTypeLookup = map[exp.DataTypeKind][]byte{
+		exp.SmallIntDataType:    []byte("INT64"),
+		exp.IntegerDataType:     []byte("INT64"),
+		exp.BigIntDataType:      []byte("INT64"),
+		exp.DecimalDataType:     []byte("NUMERIC"),
+		exp.RealDataType:        []byte("FLOAT32"),
+		exp.DoubleDataType:      []byte("FLOAT64"),
+		exp.BooleanDataType:     []byte("BOOL"),
+		exp.CharDataType:        []byte("STRING"),
+		exp.VarcharDataType:     []byte("STRING"),
+		exp.TextDataType:        []byte("STRING(MAX)"),
+		exp.DateDataType:        []byte("DATE"),
+		exp.TimestampDataType:   []byte("TIMESTAMP"),
+		exp.TimestampTzDataType: []byte("TIMESTAMP"),
+		exp.BinaryDataType:      []byte("BYTES(MAX)"),
+		exp.UUIDDataType:        []byte("STRING(36)"),
+		exp.JSONDataType:        []byte("JSON"),
+	}
+
+	opts.EscapedRunes = map[rune][]byte{
+		'\'': []byte("\\'"),
+		'"':  []byte("\\\""),
+		'\\': []byte("\\\\"),
+		'\n': []byte("\\n"),
+		'\r': []byte("\\r"),
+		0:    []byte("\\x00"),
+		0x1a: []byte("\\x1a"),
+	}
+	return opts
+}
+
+// ForceIndex creates a table reference with a FORCE_INDEX hint so the query reads from the provided index. The index
+// is quoted as an identifier except for the _BASE_TABLE keyword.
+//    dialect.From(spanner.ForceIndex("Singers", "SingersByLastName"))
+//    // SELECT * FROM `Singers`@{FORCE_INDEX=`SingersByLastName`}
+func ForceIndex(table, index string) exp.LiteralExpression {
+	if index == baseTableIndex {
+		return goqu.L("?@{FORCE_INDEX="+baseTableIndex+"}", exp.ParseIdentifier(table))
+	}
+	return goqu.L("?@{FORCE_INDEX=?}", exp.ParseIdentifier(table), exp.NewIdentifierExpression("", "", index))
+}
+
+func init() {
+	goqu.RegisterDialect("spanner", DialectOptions())
+}
@@ -0,0 +1,294 @@
+package spanner_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/spanner"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	spannerDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exp.SQLExpression
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (sds *spannerDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("spanner").From(table)
+}
+
+func (sds *spannerDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			sds.NoError(err, "test case %d failed", i)
+		} else {
+			sds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		sds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			sds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			sds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (sds *spannerDialectSuite) TestIdentifiers() {
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  sds.GetDs("Singers").Select("SingerId", goqu.I("Singers.FirstName")).Where(goqu.C("LastName").Eq("O'Brien")),
+			sql: "SELECT `SingerId`, `Singers`.`FirstName` FROM `Singers` WHERE (`LastName` = 'O\\'Brien')",
+		},
+	)
+}
+
+func (sds *spannerDialectSuite) TestPlaceholders() {
+	sds.assertSQL(
+		sqlTestCase{
+			ds:         sds.GetDs("Singers").Prepared(true).Where(goqu.C("a").Eq(1), goqu.C("b").In([]string{"a", "b"})),
+			sql:        "SELECT * FROM `Singers` WHERE ((`a` = @p1) AND (`b` IN (@p2, @p3)))",
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", "b"},
+		},
+		sqlTestCase{
+			ds:         goqu.Dialect("spanner").Insert("Singers").Prepared(true).Rows(goqu.Record{"SingerId": 1, "FirstName": "Marc"}),
+			sql:        "INSERT INTO `Singers` (`FirstName`, `SingerId`) VALUES (@p1, @p2)",
+			isPrepared: true,
+			args:       []interface{}{"Marc", int64(1)},
+		},
+	)
+}
+
+func (sds *spannerDialectSuite) TestNamedArgs() {
+	ds := goqu.New("spanner", nil).From("Singers").Prepared(true).
+		Where(goqu.C("a").Eq(1), goqu.C("b").In([]string{"a", "b"}))
+
+	actualSQL, actualArgs, err := ds.ToSQL()
+	sds.NoError(err)
+	sds.Equal("SELECT * FROM `Singers` WHERE ((`a` = @p1) AND (`b` IN (@p2, @p3)))", actualSQL)
+	sds.Equal([]interface{}{int64(1), "a", "b"}, actualArgs)
+
+	// the executor binds the args by name so they match the placeholders
+	actualSQL, actualArgs, err = ds.Executor().ToSQL()
+	sds.NoError(err)
+	sds.Equal("SELECT * FROM `Singers` WHERE ((`a` = @p1) AND (`b` IN (@p2, @p3)))", actualSQL)
+	sds.Equal([]interface{}{sql.Named("p1", int64(1)), sql.Named("p2", "a"), sql.Named("p3", "b")}, actualArgs)
+
+	_, actualArgs, err = goqu.New("spanner", nil).From("Singers").Where(goqu.C("a").Eq(1)).Executor().ToSQL()
+	sds.NoError(err)
+	sds.Empty(actualArgs)
+}
+
+func (sds *spannerDialectSuite) TestForceIndex() {
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  goqu.Dialect("spanner").From(spanner.ForceIndex("Singers", "SingersByLastName")),
+			sql: "SELECT * FROM `Singers`@{FORCE_INDEX=`SingersByLastName`}",
+
+		},
+		sqlTestCase{
+			ds: goqu.Dialect("spanner").
+				From(spanner.ForceIndex("Singers", "_BASE_TABLE").As("s")).
+				Where(goqu.I("s.LastName").Eq("Richards")),
+			sql: "SELECT * FROM `Singers`@{FORCE_INDEX=_BASE_TABLE} AS `s` WHERE (`s`.`LastName` = 'Richards')",
+		},
+	)
+}
+
+func (sds *spannerDialectSuite) TestAggregateFilter() {
+	ds := goqu.Dialect("spanner").From("orders")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.COUNT("*").Filter(goqu.C("status").Eq("paid")).As("paid")),
+			sql: "SELECT SUM(CASE  WHEN (`status` = 'paid') THEN 1 ELSE 0 END) AS `paid` FROM `orders`",
+		},
+	)
+}
+
+func (sds *spannerDialectSuite) TestReturning() {
+	d := goqu.Dialect("spanner")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("Singers").Rows(goqu.Record{"SingerId": 1}).Returning("SingerId"),
+			sql: "INSERT INTO `Singers` (`SingerId`) VALUES (1) THEN RETURN `SingerId`",
+		},
+		sqlTestCase{
+			ds:  d.Update("Singers").Set(goqu.Record{"FirstName": "Marc"}).Where(goqu.C("SingerId").Eq(1)).Returning("SingerId"),
+			sql: "UPDATE `Singers` SET `FirstName`='Marc' WHERE (`SingerId` = 1) THEN RETURN `SingerId`",
+		},
+	)
+}
+
+func (sds *spannerDialectSuite) TestUnsupported() {
+	d := goqu.Dialect("spanner")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("Singers").Rows(goqu.Record{"SingerId": 1}).OnConflict(goqu.DoNothing()),
+			err: "goqu: dialect does not support ON CONFLICT clause [dialect=spanner]",
+		},
+		sqlTestCase{
+			ds:  sds.GetDs("Singers").Distinct("a"),
+			err: "goqu: dialect does not support DISTINCT ON clause [dialect=spanner]",
+		},
+		sqlTestCase{
+			ds:  sds.GetDs("Singers").OrderRandom(10),
+			err: "goqu: dialect does not support random values [dialect=spanner]",
+		},
+	)
+}
+
+func (sds *spannerDialectSuite) TestInsertMutations() {
+	d := goqu.Dialect("spanner")
+	mutations, err := spanner.InsertMutations(d.Insert("Singers").Rows(
+		goqu.Record{"SingerId": 1, "FirstName": "Marc"},
+		goqu.Record{"SingerId": 2, "FirstName": "Catalina"},
+	))
+	sds.NoError(err)
+	sds.Equal([]spanner.Mutation{
+		{Op: spanner.InsertOp, Table: "Singers", Columns: []string{"FirstName", "SingerId"}, Values: []interface{}{"Marc", 1}},
+		{Op: spanner.InsertOp, Table: "Singers", Columns: []string{"FirstName", "SingerId"}, Values: []interface{}{"Catalina", 2}},
+	}, mutations)
+
+	mutations, err = spanner.InsertMutations(d.Insert("Singers").
+		Cols("SingerId", "FirstName").
+		Vals(goqu.Vals{1, "Marc"}).
+		OnConflict(goqu.DoUpdate("SingerId", goqu.Record{"FirstName": goqu.I("EXCLUDED.FirstName")})))
+	sds.NoError(err)
+	sds.Equal([]spanner.Mutation{
+		{Op: spanner.InsertOrUpdateOp, Table: "Singers", Columns: []string{"SingerId", "FirstName"}, Values: []interface{}{1, "Marc"}},
+	}, mutations)
+	sds.Equal("InsertOrUpdate", mutations[0].Op.String())
+
+	mutations, err = spanner.InsertMutations(d.Insert("music.Singers").Rows(goqu.Record{"SingerId": 1}))
+	sds.NoError(err)
+	sds.Equal([]spanner.Mutation{
+		{Op: spanner.InsertOp, Table: "music.Singers", Columns: []string{"SingerId"}, Values: []interface{}{1}},
+	}, mutations)
+
+	_, err = spanner.InsertMutations(d.Insert("Singers").Rows(goqu.Record{"SingerId": 1}).OnConflict(goqu.DoNothing()))
+	sds.EqualError(err, "goqu: spanner mutations do not support ON CONFLICT DO NOTHING")
+
+	row := goqu.Record{"SingerId": 1, "FirstName": "Marc", "LastName": "Richards"}
+	_, err = spanner.InsertMutations(d.Insert("Singers").Rows(row).
+		OnConflict(goqu.DoUpdate("SingerId", goqu.Record{"FirstName": goqu.L("FirstName || 'x'")})))
+	sds.EqualError(err, "goqu: spanner mutations only support ON CONFLICT DO UPDATE setting each inserted column "+
+		"to its EXCLUDED value [column=FirstName]")
+
+	_, err = spanner.InsertMutations(d.Insert("Singers").Rows(row).
+		OnConflict(goqu.DoUpdate("SingerId", goqu.Record{"FirstName": goqu.I("EXCLUDED.FirstName")})))
+	sds.EqualError(err, "goqu: spanner mutations only support ON CONFLICT DO UPDATE setting each inserted column "+
+		"to its EXCLUDED value [column=LastName]")
+
+	_, err = spanner.InsertMutations(d.Insert("Singers").Rows(row).
+		OnConflict(goqu.DoUpdate("SingerId", goqu.Record{
+			"FirstName": goqu.I("EXCLUDED.FirstName"),
+			"LastName":  goqu.I("EXCLUDED.LastName"),
+		}).Where(goqu.C("LastName").IsNull())))
+	sds.EqualError(err, "goqu: spanner mutations do not support ON CONFLICT DO UPDATE with a WHERE")
+
+	_, err = spanner.InsertMutations(d.Insert("Singers"))
+	sds.EqualError(err, "goqu: rows or columns and values are required when generating spanner mutations")
+
+	_, err = spanner.InsertMutations(d.Insert(goqu.L("Singers")).Rows(goqu.Record{"SingerId": 1}))
+	sds.EqualError(err, "goqu: a table is required when generating spanner mutations")
+
+	_, err = spanner.InsertMutations(d.Insert("Singers").Rows(goqu.Record{"CreatedAt": goqu.L("PENDING_COMMIT_TIMESTAMP()")}))
+	sds.EqualError(err, "goqu: unsupported spanner mutation value exp.literal [column=CreatedAt]")
+
+	_, err = spanner.InsertMutations(d.Insert("Singers").Cols("SingerId", "FirstName").Vals(goqu.Vals{1}))
+	sds.EqualError(err, "goqu: rows with different value length expected 2 got 1")
+}
+
+func (sds *spannerDialectSuite) TestAtTimeZone() {
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  goqu.Dialect("spanner").From("test").Select(goqu.C("created_at").AtTimeZone("UTC")),
+			err: "goqu: dialect does not support AT TIME ZONE [dialect=spanner]",
+		},
+	)
+}
+
+func (sds *spannerDialectSuite) TestCreateTable() {
+	d := goqu.Dialect("spanner")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: d.CreateTable("test").Columns(
+				goqu.ColumnDef("id", goqu.BigIntType()).NotNull().PrimaryKey(),
+				goqu.ColumnDef("name", goqu.VarcharType(255)).NotNull(),
+				goqu.ColumnDef("active", goqu.BooleanType()).Default(true),
+			),
+			sql: "CREATE TABLE `test` (`id` INT64 NOT NULL, `name` STRING(255) NOT NULL, " +
+				"`active` BOOL DEFAULT (TRUE)) PRIMARY KEY (`id`)",
+		},
+		sqlTestCase{
+			ds: d.CreateTable("test").IfNotExists().Columns(
+				goqu.ColumnDef("a", goqu.BigIntType()),
+				goqu.ColumnDef("b", goqu.TextType()),
+			).Constraints(goqu.PrimaryKey("a", "b")),
+			sql: "CREATE TABLE IF NOT EXISTS `test` (`a` INT64, `b` STRING(MAX)) PRIMARY KEY (`a`, `b`)",
+		},
+		sqlTestCase{
+			ds: d.CreateTable("test").
+				Columns(goqu.ColumnDef("a", goqu.BigIntType()).PrimaryKey()).
+				Constraints(goqu.PrimaryKey("a")),
+			err: "goqu: only one PRIMARY KEY can be defined when generating create table sql",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Temporary().Columns(goqu.ColumnDef("id", goqu.BigIntType())),
+			err: "goqu: dialect does not support TEMPORARY in CREATE TABLE [dialect=spanner]",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Columns(goqu.ColumnDef("id", goqu.BigIntType()).AutoIncrement()),
+			err: "goqu: dialect does not support auto increment columns [dialect=spanner]",
+		},
+	)
+}
+
+func (sds *spannerDialectSuite) TestAlterTable() {
+	d := goqu.Dialect("spanner")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.VarcharType(10))),
+			sql: "ALTER TABLE `test` ADD COLUMN `a` STRING(10)",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AlterColumnType("b", goqu.BigIntType()),
+			sql: "ALTER TABLE `test` ALTER COLUMN `b` INT64",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").ModifyColumn(goqu.ColumnDef("b", goqu.BigIntType()).NotNull()),
+			sql: "ALTER TABLE `test` ALTER COLUMN `b` INT64 NOT NULL",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").SetDefault("b", 1),
+			sql: "ALTER TABLE `test` ALTER COLUMN `b` SET DEFAULT (1)",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").RenameColumn("a", "b"),
+			err: "goqu: dialect does not support RENAME COLUMN in ALTER TABLE [dialect=spanner]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").SetNotNull("b"),
+			err: "goqu: dialect does not support SET NOT NULL in ALTER TABLE [dialect=spanner]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.IntegerType())).DropColumn("c"),
+			err: "goqu: dialect does not support multiple actions in ALTER TABLE [dialect=spanner]",
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(spannerDialectSuite))
+}
@@ -10,19 +10,45 @@ import (
 func DialectOptions() *goqu.SQLDialectOptions {
 	opts := goqu.DefaultDialectOptions()
 
-	opts.SupportsReturn = false
+	// RETURNING and ON CONFLICT DO UPDATE ... WHERE are supported since sqlite 3.35
+	opts.SupportsReturn = true
 	opts.SupportsOrderByOnUpdate = true
 	opts.SupportsLimitOnUpdate = true
 	opts.SupportsOrderByOnDelete = true
 	opts.SupportsLimitOnDelete = true
-	opts.SupportsConflictUpdateWhere = false
-	opts.SupportsInsertIgnoreSyntax = true
+	opts.SupportsConflictUpdateWhere = true
 	opts.SupportsConflictTarget = true
 	opts.SupportsMultipleUpdateTables = false
 	opts.WrapCompoundsInParens = false
 	opts.SupportsDistinctOn = false
 	opts.SupportsWindowFunction = false
 	opts.SupportsLateral = false
+	opts.SupportsDerivedColumnAliases = false
+	opts.RollupFragment = nil
+	opts.CubeFragment = nil
+	opts.GroupingSetsFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.IntersectAllFragment = nil
+	opts.ExceptAllFragment = nil
+	// upserts use INSERT ... ON CONFLICT
+	opts.MergeFragment = nil
+	// sqlite does not support stored procedures
+	opts.CallFragment = nil
+	opts.ExplainFragment = []byte("EXPLAIN QUERY PLAN ")
+	opts.ExplainAnalyzeFragment = nil
+	opts.ExplainVerboseFragment = nil
+	opts.ExplainFormatFragment = nil
+	opts.CopyFragment = nil
+	opts.SetParamFragment = nil
+	opts.ShowFragment = nil
+	// VACUUM only accepts a schema name
+	opts.SupportsVacuumTables = false
+	opts.MaintenanceFullFragment = nil
+	opts.MaintenanceFreezeFragment = nil
+	opts.MaintenanceVerboseFragment = nil
+	opts.MaintenanceAnalyzeFragment = nil
+	opts.MaintenanceSkipLockedFragment = nil
 
 	opts.PlaceHolderFragment = []byte("?")
 	opts.IncludePlaceholderNum = false
@@ -50,6 +76,9 @@ func DialectOptions() *goqu.SQLDialectOptions {
 		exp.RegexpNotLikeOp:  []byte("NOT REGEXP"),
 		exp.RegexpILikeOp:    []byte("REGEXP"),
 		exp.RegexpNotILikeOp: []byte("NOT REGEXP"),
+
+		exp.IsDistinctFromOp:    []byte("IS NOT"),
+		exp.IsNotDistinctFromOp: []byte("IS"),
 	}
 	opts.UseLiteralIsBools = false
 	opts.BitwiseOperatorLookup = map[exp.BitwiseOperation][]byte{
@@ -61,13 +90,94 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.EscapedRunes = map[rune][]byte{
 		'\'': []byte("''"),
 	}
-	opts.InsertIgnoreClause = []byte("INSERT OR IGNORE INTO ")
-	opts.ConflictFragment = []byte(" ON CONFLICT ")
-	opts.ConflictDoUpdateFragment = []byte(" DO UPDATE SET ")
-	opts.ConflictDoNothingFragment = []byte(" DO NOTHING ")
+	// sqlite 3.38+
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{
+		exp.JSONGetOp:     []byte("->"),
+		exp.JSONGetTextOp: []byte("->>"),
+	}
+	opts.ConflictResolutionLookup = map[exp.ConflictResolution][]byte{
+		exp.IgnoreConflictResolution:  []byte("INSERT OR IGNORE INTO"),
+		exp.ReplaceConflictResolution: []byte("INSERT OR REPLACE INTO"),
+	}
 	opts.ForUpdateFragment = []byte("")
+	// sqlite uses type affinity, AUTOINCREMENT is only allowed on an INTEGER PRIMARY KEY column
+	opts.AutoIncrementFragment = []byte(" AUTOINCREMENT")
+	opts.AutoIncrementAfterPrimaryKey = true
+	// sqlite only supports adding, dropping and renaming columns and renaming tables, one action at a time
+	opts.SupportsMultipleAlterTableActions = false
+	opts.AlterColumnTypeFragment = nil
+	opts.SetDefaultFragment = nil
+	opts.DropDefaultFragment = nil
+	opts.SetNotNullFragment = nil
+	opts.DropNotNullFragment = nil
+	opts.AddConstraintFragment = nil
+	opts.SupportsConcurrentIndex = false
+	opts.SupportsIndexMethod = false
+	opts.SupportsDropCascade = false
+	opts.SupportsMultipleDropObjects = false
+	opts.OrReplaceViewFragment = nil
+	opts.ViewCheckOptionLookup = map[exp.ViewCheckOption][]byte{}
+	opts.MaterializedViewFragment = nil
+	opts.DropMaterializedViewFragment = nil
+	opts.RefreshMaterializedViewFragment = nil
+	opts.CreateSequenceFragment = nil
+	opts.AlterSequenceFragment = nil
+	opts.DropSequenceFragment = nil
+	opts.NextValFunction = nil
+	opts.CurrValFunction = nil
+	// databases are attached to a connection (ATTACH DATABASE) instead of being created
+	opts.CreateSchemaFragment = nil
+	opts.DropSchemaFragment = nil
+	opts.CreateDatabaseFragment = nil
+	opts.DropDatabaseFragment = nil
+	opts.CommentOnFragment = nil
+	opts.GrantFragment = nil
+	opts.RevokeFragment = nil
+	opts.PartitionByFragment = nil
+	opts.PartitionOfFragment = nil
+	opts.AttachPartitionFragment = nil
+	opts.DetachPartitionFragment = nil
+	opts.ExcludeFragment = nil
+	// triggers always run a BEGIN ... END block and functions are registered with the connection
+	opts.SupportsCreateTriggerIfNotExists = true
+	opts.OrReplaceTriggerFragment = nil
+	opts.ForEachStatementFragment = nil
+	opts.TriggerExecuteFragment = nil
+	opts.TriggerBodyFragment = []byte(" BEGIN ")
+	opts.TriggerBodyEndFragment = []byte(" END")
+	opts.DropTriggerRequiresTable = false
+	opts.TriggerEventLookup = map[exp.TriggerEvent][]byte{
+		exp.InsertTriggerEvent: []byte("INSERT"),
+		exp.UpdateTriggerEvent: []byte("UPDATE"),
+		exp.DeleteTriggerEvent: []byte("DELETE"),
+	}
+	opts.FunctionFragment = nil
+	opts.DropFunctionFragment = nil
+	opts.DataTypeLookup = map[exp.DataTypeKind][]byte{
+		exp.SmallIntDataType:    []byte("INTEGER"),
+		exp.IntegerDataType:     []byte("INTEGER"),
+		exp.BigIntDataType:      []byte("INTEGER"),
+		exp.DecimalDataType:     []byte("NUMERIC"),
+		exp.RealDataType:        []byte("REAL"),
+		exp.DoubleDataType:      []byte("REAL"),
+		exp.BooleanDataType:     []byte("BOOLEAN"),
+		exp.CharDataType:        []byte("CHAR"),
+		exp.VarcharDataType:     []byte("VARCHAR"),
+		exp.TextDataType:        []byte("TEXT"),
+		exp.DateDataType:        []byte("DATE"),
+		exp.TimeDataType:        []byte("TIME"),
+		exp.TimestampDataType:   []byte("TIMESTAMP"),
+		exp.TimestampTzDataType: []byte("TIMESTAMP"),
+		exp.BinaryDataType:      []byte("BLOB"),
+		exp.UUIDDataType:        []byte("TEXT"),
+		exp.JSONDataType:        []byte("TEXT"),
+	}
 	opts.OfFragment = []byte("")
 	opts.NowaitFragment = []byte("")
+	// dates are modified with the date and time functions (e.g. datetime("a", '+3 days'))
+	opts.IntervalUnitLookup = nil
+	// sqlite3 only supports the utc and localtime modifiers of the date and time functions
+	opts.AtTimeZoneFragment = nil
 	return opts
 }
 
@@ -75,6 +75,19 @@ func (sds *sqlite3DialectSuite) TestCompoundExpressions() {
 		sqlTestCase{ds: ds1.Union(ds2), sql: "SELECT `a` FROM `test` UNION SELECT `b` FROM `test2`"},
 		sqlTestCase{ds: ds1.UnionAll(ds2), sql: "SELECT `a` FROM `test` UNION ALL SELECT `b` FROM `test2`"},
 		sqlTestCase{ds: ds1.Intersect(ds2), sql: "SELECT `a` FROM `test` INTERSECT SELECT `b` FROM `test2`"},
+		sqlTestCase{ds: ds1.Except(ds2), sql: "SELECT `a` FROM `test` EXCEPT SELECT `b` FROM `test2`"},
+		sqlTestCase{ds: ds1.IntersectAll(ds2), err: "goqu: dialect does not support INTERSECT ALL [dialect=sqlite3]"},
+		sqlTestCase{ds: ds1.ExceptAll(ds2), err: "goqu: dialect does not support EXCEPT ALL [dialect=sqlite3]"},
+		sqlTestCase{
+			ds:  ds1.Union(ds2).Parenthesize().Except(ds2),
+			err: "goqu: dialect does not support parenthesized compound queries [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestRandom() {
+	sds.assertSQL(
+		sqlTestCase{ds: sds.GetDs("test").OrderRandom(10), sql: "SELECT * FROM `test` ORDER BY RANDOM() ASC LIMIT 10"},
 	)
 }
 
@@ -121,10 +134,16 @@ func (sds *sqlite3DialectSuite) TestBooleanOperations() {
 		sqlTestCase{ds: ds.Where(goqu.C("a").IsNot(false)), sql: "SELECT * FROM `test` WHERE (`a` IS NOT 0)"},
 		sqlTestCase{ds: ds.Where(goqu.C("a").IsNotTrue()), sql: "SELECT * FROM `test` WHERE (`a` IS NOT 1)"},
 		sqlTestCase{ds: ds.Where(goqu.C("a").IsNotFalse()), sql: "SELECT * FROM `test` WHERE (`a` IS NOT 0)"},
+		sqlTestCase{ds: ds.Where(goqu.C("a").IsDistinctFrom(goqu.C("b"))), sql: "SELECT * FROM `test` WHERE (`a` IS NOT `b`)"},
+		sqlTestCase{ds: ds.Where(goqu.C("a").IsNotDistinctFrom(goqu.C("b"))), sql: "SELECT * FROM `test` WHERE (`a` IS `b`)"},
 		sqlTestCase{ds: ds.Where(goqu.C("a").Like("a%")), sql: "SELECT * FROM `test` WHERE (`a` LIKE 'a%')"},
 		sqlTestCase{ds: ds.Where(goqu.C("a").NotLike("a%")), sql: "SELECT * FROM `test` WHERE (`a` NOT LIKE 'a%')"},
 		sqlTestCase{ds: ds.Where(goqu.C("a").ILike("a%")), sql: "SELECT * FROM `test` WHERE (`a` LIKE 'a%')"},
 		sqlTestCase{ds: ds.Where(goqu.C("a").NotILike("a%")), sql: "SELECT * FROM `test` WHERE (`a` NOT LIKE 'a%')"},
+		sqlTestCase{
+			ds:  ds.Where(goqu.C("a").ILike("%" + goqu.EscapeLikePattern("50%_off") + "%").Escape(`\`)),
+			sql: "SELECT * FROM `test` WHERE (`a` LIKE '%50\\%\\_off%' ESCAPE '\\')",
+		},
 		sqlTestCase{ds: ds.Where(goqu.C("a").Like(regexp.MustCompile("[ab]"))), sql: "SELECT * FROM `test` WHERE (`a` REGEXP '[ab]')"},
 		sqlTestCase{ds: ds.Where(goqu.C("a").NotLike(regexp.MustCompile("[ab]"))), sql: "SELECT * FROM `test` WHERE (`a` NOT REGEXP '[ab]')"},
 		sqlTestCase{ds: ds.Where(goqu.C("a").ILike(regexp.MustCompile("[ab]"))), sql: "SELECT * FROM `test` WHERE (`a` REGEXP '[ab]')"},
@@ -153,6 +172,461 @@ func (sds *sqlite3DialectSuite) TestForUpdate() {
 	)
 }
 
+func (sds *sqlite3DialectSuite) TestGrouping() {
+	ds := sds.GetDs("sales")
+	sds.assertSQL(
+		sqlTestCase{ds: ds.GroupBy(goqu.Rollup("region")), err: "goqu: dialect does not support ROLLUP [dialect=sqlite3]"},
+		sqlTestCase{ds: ds.GroupBy(goqu.Cube("region")), err: "goqu: dialect does not support CUBE [dialect=sqlite3]"},
+		sqlTestCase{
+			ds:  ds.GroupBy(goqu.GroupingSets("region")),
+			err: "goqu: dialect does not support GROUPING SETS [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestCommonTables() {
+	cte := sds.GetDs("test").Where(goqu.C("a").Gt(1))
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  sds.GetDs("t").WithMaterialized("t", cte),
+			sql: "WITH t AS MATERIALIZED (SELECT * FROM `test` WHERE (`a` > 1)) SELECT * FROM `t`",
+		},
+		sqlTestCase{
+			ds:  sds.GetDs("t").WithNotMaterialized("t", cte),
+			sql: "WITH t AS NOT MATERIALIZED (SELECT * FROM `test` WHERE (`a` > 1)) SELECT * FROM `t`",
+		},
+		sqlTestCase{
+			ds:  sds.GetDs("t").WithRecursive("t(id)", cte, goqu.SearchDepthFirst("id").Set("ord")),
+			err: "goqu: dialect does not support SEARCH clause on common table expressions [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  sds.GetDs("t").WithRecursive("t(id)", cte, goqu.Cycle("id").Set("is_cycle").Using("path")),
+			err: "goqu: dialect does not support CYCLE clause on common table expressions [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestAsTable() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{ds: ds.From(ds.AsTable("t")), sql: "SELECT * FROM (SELECT * FROM `test`) AS `t`"},
+		sqlTestCase{
+			ds:  ds.From(ds.AsTable("t", "a", "b")),
+			err: "goqu: dialect does not support derived table column aliases [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestInsert_OnConflict() {
+	ds := goqu.Dialect("sqlite3").Insert("test").Rows(goqu.Record{"a": 1, "b": "b"})
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.OnConflict(goqu.DoNothing()),
+			sql: "INSERT INTO `test` (`a`, `b`) VALUES (1, 'b') ON CONFLICT DO NOTHING",
+		},
+		sqlTestCase{
+			ds:  ds.OnConflict(goqu.DoUpdate("a", goqu.Record{"b": goqu.I("excluded.b")})),
+			sql: "INSERT INTO `test` (`a`, `b`) VALUES (1, 'b') ON CONFLICT (a) DO UPDATE SET `b`=`excluded`.`b`",
+		},
+		sqlTestCase{
+			ds: ds.OnConflict(goqu.DoUpdate("a", goqu.Record{"b": "c"}).Where(goqu.C("b").Neq("c"))),
+			sql: "INSERT INTO `test` (`a`, `b`) VALUES (1, 'b') ON CONFLICT (a) DO UPDATE SET `b`='c' " +
+				"WHERE (`b` != 'c')",
+		},
+		sqlTestCase{
+			ds:  ds.OrIgnore(),
+			sql: "INSERT OR IGNORE INTO `test` (`a`, `b`) VALUES (1, 'b')",
+		},
+		sqlTestCase{
+			ds:  ds.OrReplace(),
+			sql: "INSERT OR REPLACE INTO `test` (`a`, `b`) VALUES (1, 'b')",
+		},
+		sqlTestCase{
+			ds:  ds.OrReplace().ClearConflictResolution(),
+			sql: "INSERT INTO `test` (`a`, `b`) VALUES (1, 'b')",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestReturning() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			sql: "INSERT INTO `test` (`a`) VALUES (1) RETURNING `id`",
+		},
+		sqlTestCase{
+			ds:  d.Update("test").Set(goqu.Record{"a": 1}).Returning("id"),
+			sql: "UPDATE `test` SET `a`=1 RETURNING `id`",
+		},
+		sqlTestCase{
+			ds:  d.Delete("test").Where(goqu.C("a").Eq(1)).Returning("id"),
+			sql: "DELETE FROM `test` WHERE (`a` = 1) RETURNING `id`",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestCreateTable() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: d.CreateTable("test").IfNotExists().Columns(
+				goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey().AutoIncrement(),
+				goqu.ColumnDef("price", goqu.DecimalType(10, 2)).NotNull().Default(0),
+				goqu.ColumnDef("data", goqu.JSONType()),
+			),
+			sql: "CREATE TABLE IF NOT EXISTS `test` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, " +
+				"`price` NUMERIC(10, 2) DEFAULT 0 NOT NULL, `data` TEXT)",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Temporary().Columns(goqu.ColumnDef("id", goqu.UUIDType())),
+			sql: "CREATE TEMPORARY TABLE `test` (`id` TEXT)",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestAlterTable() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.IntegerType())),
+			sql: "ALTER TABLE `test` ADD COLUMN `a` INTEGER",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").RenameTo("test2"),
+			sql: "ALTER TABLE `test` RENAME TO `test2`",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AlterColumnType("a", goqu.TextType()),
+			err: "goqu: dialect does not support ALTER COLUMN TYPE in ALTER TABLE [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").DropColumn("a").DropColumn("b"),
+			err: "goqu: dialect does not support multiple actions in ALTER TABLE [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestCreateIndex() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: d.CreateIndex("test_idx").On("test").IfNotExists().Columns("a").
+				Where(goqu.C("b").IsNull()),
+			sql: "CREATE INDEX IF NOT EXISTS `test_idx` ON `test` (`a`) WHERE (`b` IS NULL)",
+		},
+		sqlTestCase{
+			ds:  d.CreateIndex("test_idx").On("test").Columns("a").Using("btree"),
+			err: "goqu: dialect does not support USING in CREATE INDEX [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.CreateIndex("test_idx").On("test").Columns("a").Concurrently(),
+			err: "goqu: dialect does not support CONCURRENTLY in CREATE INDEX [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestCreateView() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateView("test_view").Temporary().As(goqu.From("test").Where(goqu.C("a").IsTrue())),
+			sql: "CREATE TEMPORARY VIEW `test_view` AS SELECT * FROM `test` WHERE (`a` IS 1)",
+		},
+		sqlTestCase{
+			ds:  d.CreateView("test_view").OrReplace().As(goqu.From("test")),
+			err: "goqu: dialect does not support OR REPLACE in CREATE VIEW [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.CreateView("test_view").As(goqu.From("test")).WithCheckOption(),
+			err: "goqu: dialect does not support WITH CHECK OPTION in CREATE VIEW [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestMaterializedView() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateMaterializedView("test_view").As(goqu.From("test")),
+			err: "goqu: dialect does not support CREATE MATERIALIZED VIEW [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.RefreshMaterializedView("test_view"),
+			err: "goqu: dialect does not support REFRESH MATERIALIZED VIEW [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.DropMaterializedView("test_view"),
+			err: "goqu: dialect does not support DROP MATERIALIZED VIEW [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestConstraints() {
+	d := goqu.Dialect("sqlite3")
+	ct := d.CreateTable("order").Columns(goqu.ColumnDef("user_id", goqu.BigIntType()))
+	fk := goqu.ForeignKey("user_id").References("user", "id")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: ct.Constraints(fk.OnDelete(exp.SetDefaultReferentialAction)),
+			sql: "CREATE TABLE `order` (`user_id` INTEGER, FOREIGN KEY (`user_id`) REFERENCES `user` (`id`) " +
+				"ON DELETE SET DEFAULT)",
+		},
+		sqlTestCase{
+			ds:  ct.Constraints(goqu.Check(goqu.C("user_id").Gt(0))),
+			sql: "CREATE TABLE `order` (`user_id` INTEGER, CHECK (`user_id` > 0))",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("order").AddConstraint(fk),
+			err: "goqu: dialect does not support ADD CONSTRAINT in ALTER TABLE [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  ct.Constraints(goqu.Exclude("gist", goqu.ExcludeWith("user_id", "="))),
+			err: "goqu: dialect does not support EXCLUDE constraints [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestTriggers() {
+	d := goqu.Dialect("sqlite3")
+	ct := d.CreateTrigger("user_audit").After().OnUpdate("email").On("user").ForEachRow()
+	body := "INSERT INTO audit (user_id) VALUES (NEW.id);"
+	sds.assertSQL(
+		sqlTestCase{
+			ds: ct.IfNotExists().When(goqu.L("NEW.email IS NOT NULL")).Body(body),
+			sql: "CREATE TRIGGER IF NOT EXISTS `user_audit` AFTER UPDATE OF `email` ON `user` FOR EACH ROW " +
+				"WHEN (NEW.email IS NOT NULL) BEGIN " + body + " END",
+		},
+		sqlTestCase{
+			ds:  ct.OrReplace().Body(body),
+			err: "goqu: dialect does not support OR REPLACE in CREATE TRIGGER [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  ct.ForEachStatement().Body(body),
+			err: "goqu: dialect does not support FOR EACH STATEMENT in CREATE TRIGGER [dialect=sqlite3]",
+		},
+		sqlTestCase{ds: d.DropTrigger("user_audit"), sql: "DROP TRIGGER `user_audit`"},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestFunctions() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateFunction("one").Returns(goqu.IntegerType()).Body("SELECT 1"),
+			err: "goqu: dialect does not support CREATE FUNCTION [dialect=sqlite3]",
+		},
+		sqlTestCase{ds: d.DropFunction("one"), err: "goqu: dialect does not support DROP FUNCTION [dialect=sqlite3]"},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestMaintenance() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{ds: d.Vacuum(), sql: "VACUUM"},
+		sqlTestCase{ds: d.Analyze("items"), sql: "ANALYZE `items`"},
+		sqlTestCase{
+			ds:  d.Vacuum("items"),
+			err: "goqu: dialect does not support VACUUM of specific tables [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.Vacuum().Options(exp.MaintenanceOptions{Full: true}),
+			err: "goqu: dialect does not support FULL in VACUUM [dialect=sqlite3]",
+		},
+		sqlTestCase{ds: d.Optimize("items"), err: "goqu: dialect does not support OPTIMIZE [dialect=sqlite3]"},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestSetParam() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{ds: d.SetParam("a", 1), err: "goqu: dialect does not support SET [dialect=sqlite3]"},
+		sqlTestCase{ds: d.Show("a"), err: "goqu: dialect does not support SHOW [dialect=sqlite3]"},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestCopy() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{ds: d.CopyFrom("items"), err: "goqu: dialect does not support COPY [dialect=sqlite3]"},
+		sqlTestCase{ds: d.CopyTo("items"), err: "goqu: dialect does not support COPY [dialect=sqlite3]"},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestCall() {
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  goqu.Dialect("sqlite3").Call("user_totals", 10),
+			err: "goqu: dialect does not support stored procedures [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestMerge() {
+	ds := goqu.Dialect("sqlite3").Merge("user").
+		Using("staged_user").
+		On(goqu.I("user.id").Eq(goqu.I("staged_user.id"))).
+		WhenMatchedThenDelete()
+	sds.assertSQL(
+		sqlTestCase{ds: ds, err: "goqu: dialect does not support MERGE [dialect=sqlite3]"},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestPartitions() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateTable("measurement").Columns(goqu.ColumnDef("id", goqu.IntegerType())).PartitionBy(goqu.PartitionByHash("id")),
+			err: "goqu: dialect does not support PARTITION BY [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("measurement_2024").PartitionOf("measurement", goqu.DefaultPartition()),
+			err: "goqu: dialect does not support PARTITION OF in CREATE TABLE [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("measurement").AttachPartition("measurement_2024", goqu.DefaultPartition()),
+			err: "goqu: dialect does not support ATTACH PARTITION in ALTER TABLE [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestGrant() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.Grant("SELECT").On("user").To("tenant_a"),
+			err: "goqu: dialect does not support GRANT/REVOKE [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.Revoke("SELECT").On("user").From("tenant_a"),
+			err: "goqu: dialect does not support GRANT/REVOKE [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestComment() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CommentOnTable("user").Is("the users"),
+			err: "goqu: dialect does not support COMMENT ON TABLE [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.CommentOnColumn("user.email").Is("the login"),
+			err: "goqu: dialect does not support COMMENT ON COLUMN [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("user").Columns(goqu.ColumnDef("id", goqu.IntegerType()).Comment("the id")),
+			err: "goqu: dialect does not support comments in column definitions [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("user").ModifyColumn(goqu.ColumnDef("id", goqu.BigIntType())),
+			err: "goqu: dialect does not support MODIFY COLUMN in ALTER TABLE [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestSchema() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateSchema("tenant_1"),
+			err: "goqu: dialect does not support CREATE SCHEMA [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.CreateDatabase("tenant_1"),
+			err: "goqu: dialect does not support CREATE DATABASE [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.DropSchema("tenant_1"),
+			err: "goqu: dialect does not support DROP SCHEMA [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.DropDatabase("tenant_1"),
+			err: "goqu: dialect does not support DROP DATABASE [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestSequence() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateSequence("test_seq").StartWith(10),
+			err: "goqu: dialect does not support sequences [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.AlterSequence("test_seq").RestartWith(1),
+			err: "goqu: dialect does not support sequences [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.DropSequence("test_seq"),
+			err: "goqu: dialect does not support DROP SEQUENCE [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"id": goqu.NextVal("test_seq")}),
+			err: "goqu: dialect does not support the NEXT VALUE of a sequence [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestDropTable() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{ds: d.DropTable("a").IfExists(), sql: "DROP TABLE IF EXISTS `a`"},
+		sqlTestCase{ds: d.DropView("a").IfExists(), sql: "DROP VIEW IF EXISTS `a`"},
+		sqlTestCase{
+			ds:  d.DropTable("a", "b"),
+			err: "goqu: dialect does not support multiple names in DROP TABLE [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  d.DropView("a").Restrict(),
+			err: "goqu: dialect does not support CASCADE or RESTRICT in DROP VIEW [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestDropIndex() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{ds: d.DropIndex("test_idx").IfExists(), sql: "DROP INDEX IF EXISTS `test_idx`"},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestJSON() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.From("test").Where(goqu.JSON("data").Get("a").GetText("b").Eq("c")),
+			sql: "SELECT * FROM `test` WHERE (((`data` -> 'a') ->> 'b') = 'c')",
+		},
+		sqlTestCase{
+			ds:  d.From("test").Where(goqu.JSON("data").HasKey("a")),
+			err: "goqu: dialect does not support JSON operation Has Key [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestIntervals() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.From("test").Where(goqu.DateOf("created_at").Add(goqu.Interval(3, goqu.Days)).Lt(goqu.L("CURRENT_TIMESTAMP"))),
+			err: "goqu: dialect does not support intervals [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestAtTimeZone() {
+	d := goqu.Dialect("sqlite3")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.From("test").Select(goqu.C("created_at").AtTimeZone("UTC")),
+			err: "goqu: dialect does not support AT TIME ZONE [dialect=sqlite3]",
+		},
+	)
+}
+
 func TestDatasetAdapterSuite(t *testing.T) {
 	suite.Run(t, new(sqlite3DialectSuite))
 }
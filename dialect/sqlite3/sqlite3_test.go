@@ -339,8 +339,11 @@ func (st *sqlite3Suite) TestInsert_returning() {
 	ds := st.db.From("entry")
 	now := time.Now()
 	e := entry{Int: 10, Float: 1.000000, String: "1.000000", Time: now, Bool: true, Bytes: []byte("1.000000")}
-	_, err := ds.Insert().Rows(e).Returning(goqu.Star()).Executor().ScanStruct(&e)
-	st.Error(err)
+	var id uint32
+	found, err := ds.Insert().Rows(e).Returning("id").Executor().ScanVal(&id)
+	st.NoError(err)
+	st.True(found)
+	st.NotEqual(uint32(0), id)
 }
 
 func (st *sqlite3Suite) TestUpdate() {
@@ -362,13 +365,13 @@ func (st *sqlite3Suite) TestUpdateReturning() {
 	ds := st.db.From("entry")
 	var id uint32
 	_, err := ds.
-		Where(goqu.C("int").Eq(11)).
+		Where(goqu.C("int").Eq(9)).
 		Update().
-		Set(map[string]interface{}{"int": 9}).
+		Set(map[string]interface{}{"int": 11}).
 		Returning("id").
 		Executor().ScanVal(&id)
-	st.Error(err)
-	st.EqualError(err, "goqu: dialect does not support RETURNING clause [dialect=sqlite3]")
+	st.NoError(err)
+	st.NotEqual(uint32(0), id)
 }
 
 func (st *sqlite3Suite) TestDelete() {
@@ -396,8 +399,10 @@ func (st *sqlite3Suite) TestDelete() {
 	st.NotEqual(int64(0), e.ID)
 
 	id = 0
-	_, err = ds.Where(goqu.C("id").Eq(e.ID)).Delete().Returning("id").Executor().ScanVal(&id)
-	st.EqualError(err, "goqu: dialect does not support RETURNING clause [dialect=sqlite3]")
+	found, err = ds.Where(goqu.C("id").Eq(e.ID)).Delete().Returning("id").Executor().ScanVal(&id)
+	st.NoError(err)
+	st.True(found)
+	st.Equal(e.ID, id)
 }
 
 func (st *sqlite3Suite) TestInsert_OnConflict() {
@@ -450,16 +455,169 @@ func (st *sqlite3Suite) TestInsert_OnConflict() {
 	st.NoError(err)
 	st.Equal("upsert", entryActual.String)
 
-	// UPDATE ... ON CONFLICT (...) WHERE ... SET ... should result in error for now
-	entries := []entry{
-		{Int: 8, Float: 6.100000, String: "6.100000", Time: now, Bytes: []byte("6.100000")},
-		{Int: 9, Float: 7.200000, String: "7.200000", Time: now, Bytes: []byte("7.200000")},
-	}
+	// UPSERT with a WHERE only updates the matching conflicting rows
 	_, err = ds.Insert().
-		Rows(entries).
+		Rows(
+			goqu.Record{"id": 9, "int": 8, "float": 6.1, "string": "6.100000", "time": now, "bool": false, "bytes": []byte("1.100000")},
+			goqu.Record{"id": 10, "int": 9, "float": 7.2, "string": "7.200000", "time": now, "bool": false, "bytes": []byte("1.100000")},
+		).
 		OnConflict(goqu.DoUpdate("id", goqu.Record{"string": "upsert"}).Where(goqu.C("id").Eq(9))).
 		Executor().Exec()
-	st.EqualError(err, "goqu: dialect does not support upsert with where clause [dialect=sqlite3]")
+	st.NoError(err)
+
+	var upserted []string
+	st.NoError(ds.Select("string").Where(goqu.C("id").In(9, 10)).Order(goqu.C("id").Asc()).ScanVals(&upserted))
+	st.Equal([]string{"upsert", "0.900000"}, upserted)
+
+	// INSERT OR IGNORE skips the conflicting row
+	_, err = ds.Insert().
+		Rows(goqu.Record{"id": 11, "int": 11, "float": 1.1, "string": "ignored", "time": now, "bool": false, "bytes": []byte("1.100000")}).
+		OrIgnore().
+		Executor().Exec()
+	st.NoError(err)
+	_, err = ds.Where(goqu.C("id").Eq(11)).ScanStruct(&entryActual)
+	st.NoError(err)
+	st.Equal("upsert", entryActual.String)
+
+	// INSERT OR REPLACE replaces the conflicting row
+	_, err = ds.Insert().
+		Rows(goqu.Record{"id": 11, "int": 11, "float": 1.1, "string": "replaced", "time": now, "bool": false, "bytes": []byte("1.100000")}).
+		OrReplace().
+		Executor().Exec()
+	st.NoError(err)
+	_, err = ds.Where(goqu.C("id").Eq(11)).ScanStruct(&entryActual)
+	st.NoError(err)
+	st.Equal("replaced", entryActual.String)
+}
+
+func (st *sqlite3Suite) TestCreateTable() {
+	ds := st.db.CreateTable("create_table_test").IfNotExists().Columns(
+		goqu.ColumnDef("id", goqu.IntegerType()).PrimaryKey().AutoIncrement(),
+		goqu.ColumnDef("name", goqu.VarcharType(255)).NotNull().Unique(),
+		goqu.ColumnDef("active", goqu.BooleanType()).NotNull().Default(true),
+	)
+	_, err := ds.Executor().Exec()
+	st.NoError(err)
+	defer func() {
+		_, err = st.db.Exec("DROP TABLE `create_table_test`")
+		st.NoError(err)
+	}()
+	// IF NOT EXISTS does not fail when the table exists
+	_, err = ds.Executor().Exec()
+	st.NoError(err)
+
+	_, err = st.db.Insert("create_table_test").Rows(goqu.Record{"name": "a"}).Executor().Exec()
+	st.NoError(err)
+	var active bool
+	found, err := st.db.From("create_table_test").Select("active").ScanVal(&active)
+	st.NoError(err)
+	st.True(found)
+	st.True(active)
+
+	// UNIQUE
+	_, err = st.db.Insert("create_table_test").Rows(goqu.Record{"name": "a"}).Executor().Exec()
+	st.Error(err)
+}
+
+func (st *sqlite3Suite) TestAlterTable() {
+	_, err := st.db.CreateTable("alter_table_test").Columns(
+		goqu.ColumnDef("id", goqu.IntegerType()).PrimaryKey(),
+	).Executor().Exec()
+	st.Require().NoError(err)
+	defer func() {
+		_, err = st.db.Exec("DROP TABLE `alter_table_test2`")
+		st.NoError(err)
+	}()
+
+	for _, ds := range []*goqu.AlterTableDataset{
+		st.db.AlterTable("alter_table_test").AddColumn(goqu.ColumnDef("name", goqu.TextType()).Default("a")),
+		st.db.AlterTable("alter_table_test").RenameColumn("name", "title"),
+		st.db.AlterTable("alter_table_test").RenameTo("alter_table_test2"),
+	} {
+		_, err = ds.Executor().Exec()
+		st.Require().NoError(err)
+	}
+
+	_, err = st.db.Insert("alter_table_test2").Rows(goqu.Record{"id": 1}).Executor().Exec()
+	st.NoError(err)
+	var title string
+	found, err := st.db.From("alter_table_test2").Select("title").ScanVal(&title)
+	st.NoError(err)
+	st.True(found)
+	st.Equal("a", title)
+}
+
+func (st *sqlite3Suite) TestCreateIndex() {
+	_, err := st.db.CreateTable("create_index_test").Columns(
+		goqu.ColumnDef("id", goqu.IntegerType()).PrimaryKey(),
+		goqu.ColumnDef("email", goqu.TextType()),
+		goqu.ColumnDef("deleted", goqu.BooleanType()).NotNull().Default(false),
+	).Executor().Exec()
+	st.Require().NoError(err)
+	defer func() {
+		_, err = st.db.Exec("DROP TABLE `create_index_test`")
+		st.NoError(err)
+	}()
+
+	_, err = st.db.CreateIndex("create_index_test_email_idx").
+		On("create_index_test").
+		Unique().
+		IfNotExists().
+		Columns(goqu.Func("lower", goqu.C("email"))).
+		Where(goqu.C("deleted").IsFalse()).
+		Executor().Exec()
+	st.Require().NoError(err)
+
+	_, err = st.db.Insert("create_index_test").Rows(
+		goqu.Record{"email": "a@example.com", "deleted": false},
+		goqu.Record{"email": "A@example.com", "deleted": true},
+	).Executor().Exec()
+	st.NoError(err)
+	// the index is unique on the lower case email of rows that are not deleted
+	_, err = st.db.Insert("create_index_test").Rows(goqu.Record{"email": "A@EXAMPLE.COM"}).Executor().Exec()
+	st.Error(err)
+
+	_, err = st.db.DropIndex("create_index_test_email_idx").IfExists().Executor().Exec()
+	st.NoError(err)
+	_, err = st.db.Insert("create_index_test").Rows(goqu.Record{"email": "A@EXAMPLE.COM"}).Executor().Exec()
+	st.NoError(err)
+}
+
+func (st *sqlite3Suite) TestCreateView() {
+	_, err := st.db.CreateView("create_view_test").
+		Columns("entry_id", "entry_int").
+		As(st.db.From("entry").Select("id", "int").Where(goqu.C("int").Gte(5))).
+		Executor().Exec()
+	st.Require().NoError(err)
+	defer func() {
+		_, err = st.db.DropView("create_view_test").Executor().Exec()
+		st.NoError(err)
+	}()
+
+	var ids []int64
+	st.NoError(st.db.From("create_view_test").Select("entry_id").Order(goqu.C("entry_id").Asc()).ScanVals(&ids))
+	st.Equal([]int64{6, 7, 8, 9, 10}, ids)
+}
+
+func (st *sqlite3Suite) TestDropTable() {
+	_, err := st.db.CreateTable("drop_table_test").Columns(
+		goqu.ColumnDef("id", goqu.IntegerType()).PrimaryKey(),
+	).Executor().Exec()
+	st.Require().NoError(err)
+	_, err = st.db.Exec("CREATE VIEW `drop_table_test_view` AS SELECT `id` FROM `drop_table_test`")
+	st.Require().NoError(err)
+
+	for _, ds := range []*goqu.DropDataset{
+		st.db.DropView("drop_table_test_view"),
+		st.db.DropTable("drop_table_test"),
+		st.db.DropTable("drop_table_test").IfExists(),
+	} {
+		_, err = ds.Executor().Exec()
+		st.Require().NoError(err)
+	}
+
+	_, err = st.db.DropTable("drop_table_test").Executor().Exec()
+	st.Error(err)
 }
 
 func TestSqlite3Suite(t *testing.T) {
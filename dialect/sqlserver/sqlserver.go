@@ -11,6 +11,7 @@ func DialectOptions() *goqu.SQLDialectOptions {
 
 	opts.BooleanDataTypeSupported = false
 	opts.UseLiteralIsBools = false
+	opts.EmulateIsBools = true
 
 	opts.SupportsReturn = false
 	opts.SupportsOrderByOnUpdate = false
@@ -23,8 +24,115 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.SupportsWithCTE = false
 	opts.SupportsWithCTERecursive = false
 	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.IntersectAllFragment = nil
+	opts.ExceptAllFragment = nil
+	opts.PivotFragment = []byte(" PIVOT ")
+	opts.UnpivotFragment = []byte(" UNPIVOT ")
+	opts.TableHintsFragment = []byte(" WITH ")
 	opts.SupportsWindowFunction = false
+	opts.SupportsWindowFrameGroups = false
+	opts.SupportsWindowFrameExclusion = false
+	opts.AggregateFilterFragment = nil
+	opts.AggregateOrderByFragment = nil
 	opts.SurroundLimitWithParentheses = true
+	opts.UseSelectIntoForTempTables = true
+	opts.TempTableNamePrefix = "#"
+	// temporary tables are created using SELECT ... INTO "#table"
+	opts.SelectIntoFragment = []byte(" INTO ")
+	opts.SelectIntoTempFragment = []byte(" INTO ")
+	opts.SupportsCreateTableIfNotExists = false
+	// a MERGE statement must be terminated by a semicolon
+	opts.MergeEndFragment = []byte(";")
+	// stored procedures are called using EXEC "proc" @p1, @p2 OUTPUT
+	opts.CallFragment = []byte("EXEC ")
+	opts.WrapCallArgsInParens = false
+	opts.CallOutputFragment = []byte(" OUTPUT")
+	// plans are returned using SET SHOWPLAN_XML ON instead of EXPLAIN
+	opts.ExplainFragment = nil
+	opts.CopyFragment = nil
+	opts.SetParamFragment = nil
+	opts.ShowFragment = nil
+	opts.VacuumFragment = nil
+	opts.AnalyzeFragment = nil
+	// temporary tables are created using the # prefix of the table name
+	opts.CreateTempTableFragment = []byte("CREATE TABLE ")
+	opts.AutoIncrementFragment = []byte(" IDENTITY(1,1)")
+	// columns and tables are renamed using sp_rename and defaults are constraints in sqlserver
+	opts.AddColumnFragment = []byte("ADD ")
+	opts.ColumnTypeFragment = []byte(" ")
+	opts.RenameColumnFragment = nil
+	opts.RenameTableFragment = nil
+	opts.SetDefaultFragment = nil
+	opts.DropDefaultFragment = nil
+	opts.SetNotNullFragment = nil
+	opts.DropNotNullFragment = nil
+	opts.SupportsCreateIndexIfNotExists = false
+	opts.SupportsConcurrentIndex = false
+	opts.SupportsIndexMethod = false
+	opts.DropIndexRequiresTable = true
+	opts.SupportsDropCascade = false
+	opts.OrReplaceViewFragment = []byte("OR ALTER ")
+	opts.TemporaryViewFragment = nil
+	opts.ViewCheckOptionLookup = map[exp.ViewCheckOption][]byte{
+		exp.DefaultViewCheckOption: []byte(" WITH CHECK OPTION"),
+	}
+	// sqlserver indexed views are created with CREATE VIEW ... WITH SCHEMABINDING and a clustered index
+	opts.MaterializedViewFragment = nil
+	opts.DropMaterializedViewFragment = nil
+	opts.RefreshMaterializedViewFragment = nil
+	// the next value of a sequence is selected using NEXT VALUE FOR "seq", the current value is only available from
+	// sys.sequences
+	opts.SupportsCreateSequenceIfNotExists = false
+	opts.SupportsAlterSequenceIfExists = false
+	opts.NextValueForFragment = []byte("NEXT VALUE FOR ")
+	opts.CurrValFunction = nil
+	// the owner of a database is changed using ALTER AUTHORIZATION
+	opts.SupportsCreateSchemaIfNotExists = false
+	opts.DatabaseOwnerFragment = nil
+	// comments are set using the sp_addextendedproperty procedure
+	opts.CommentOnFragment = nil
+	// tables are partitioned using partition functions and schemes
+	opts.PartitionByFragment = nil
+	opts.PartitionOfFragment = nil
+	opts.AttachPartitionFragment = nil
+	opts.DetachPartitionFragment = nil
+	opts.ExcludeFragment = nil
+	opts.ReferentialActionLookup = map[exp.ReferentialAction][]byte{
+		exp.CascadeReferentialAction:    []byte("CASCADE"),
+		exp.SetNullReferentialAction:    []byte("SET NULL"),
+		exp.SetDefaultReferentialAction: []byte("SET DEFAULT"),
+		exp.NoActionReferentialAction:   []byte("NO ACTION"),
+	}
+	// triggers are statement level and run a T-SQL batch (CREATE TRIGGER "a" ON "b" AFTER INSERT, UPDATE AS ...),
+	// the parameters of a function are variables (CREATE FUNCTION "a"(@b INT) RETURNS INT AS BEGIN ... END)
+	opts.OrReplaceTriggerFragment = []byte("OR ALTER ")
+	opts.TriggerTableBeforeTiming = true
+	opts.TriggerEventSeparatorFragment = []byte(", ")
+	opts.TriggerUpdateOfFragment = nil
+	opts.ForEachRowFragment = nil
+	opts.ForEachStatementFragment = nil
+	opts.TriggerWhenFragment = nil
+	opts.TriggerExecuteFragment = nil
+	opts.TriggerBodyFragment = []byte(" AS ")
+	opts.DropTriggerRequiresTable = false
+	opts.TriggerTimingLookup = map[exp.TriggerTiming][]byte{
+		exp.AfterTriggerTiming:     []byte(" AFTER "),
+		exp.InsteadOfTriggerTiming: []byte(" INSTEAD OF "),
+	}
+	opts.TriggerEventLookup = map[exp.TriggerEvent][]byte{
+		exp.InsertTriggerEvent: []byte("INSERT"),
+		exp.UpdateTriggerEvent: []byte("UPDATE"),
+		exp.DeleteTriggerEvent: []byte("DELETE"),
+	}
+	opts.OrReplaceFunctionFragment = []byte("OR ALTER ")
+	opts.FunctionParamPrefixFragment = []byte("@")
+	opts.FunctionLanguageFragment = nil
+	opts.FunctionBodyFragment = []byte(" AS ")
+	opts.FunctionBodyEndFragment = nil
 
 	opts.PlaceHolderFragment = []byte("@p")
 	opts.LimitFragment = []byte(" TOP ")
@@ -32,6 +140,11 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.DefaultValuesFragment = []byte("")
 	opts.True = []byte("1")
 	opts.False = []byte("0")
+	// RAND() returns the same value for every row of a query so NEWID() is used to order rows randomly
+	opts.RandomFunction = []byte("NEWID()")
+	// sqlserver names CEIL CEILING and does not have a MOD function
+	opts.FunctionNameLookup = map[string][]byte{"ceil": []byte("CEILING")}
+	opts.ModOperatorFragment = []byte(" % ")
 	opts.TimeFormat = "2006-01-02 15:04:05"
 	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
 		exp.EqOp:             []byte("="),
@@ -61,6 +174,28 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	}
 
 	opts.FetchFragment = []byte(" FETCH FIRST ")
+	// WITH TIES and PERCENT are only supported by SELECT TOP, not with an OFFSET
+	opts.SupportsFetchWithTies = true
+	opts.SupportsFetchPercent = true
+	opts.DataTypeLookup = map[exp.DataTypeKind][]byte{
+		exp.SmallIntDataType:    []byte("SMALLINT"),
+		exp.IntegerDataType:     []byte("INT"),
+		exp.BigIntDataType:      []byte("BIGINT"),
+		exp.DecimalDataType:     []byte("DECIMAL"),
+		exp.RealDataType:        []byte("REAL"),
+		exp.DoubleDataType:      []byte("FLOAT"),
+		exp.BooleanDataType:     []byte("BIT"),
+		exp.CharDataType:        []byte("NCHAR"),
+		exp.VarcharDataType:     []byte("NVARCHAR"),
+		exp.TextDataType:        []byte("NVARCHAR(MAX)"),
+		exp.DateDataType:        []byte("DATE"),
+		exp.TimeDataType:        []byte("TIME"),
+		exp.TimestampDataType:   []byte("DATETIME2"),
+		exp.TimestampTzDataType: []byte("DATETIMEOFFSET"),
+		exp.BinaryDataType:      []byte("VARBINARY(MAX)"),
+		exp.UUIDDataType:        []byte("UNIQUEIDENTIFIER"),
+		exp.JSONDataType:        []byte("NVARCHAR(MAX)"),
+	}
 
 	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
 		sqlgen.CommonTableSQLFragment,
@@ -76,14 +211,11 @@ func DialectOptions() *goqu.SQLDialectOptions {
 		sqlgen.ForSQLFragment,
 	}
 
+	// use unicode string literals so non-ASCII characters are not lost when interpolating, sqlserver does not
+	// support backslash escapes so only single quotes are escaped
+	opts.StringLiteralPrefix = []byte("N")
 	opts.EscapedRunes = map[rune][]byte{
-		'\'': []byte("\\'"),
-		'"':  []byte("\\\""),
-		'\\': []byte("\\\\"),
-		'\n': []byte("\\n"),
-		'\r': []byte("\\r"),
-		0:    []byte("\\x00"),
-		0x1a: []byte("\\x1a"),
+		'\'': []byte("''"),
 	}
 
 	opts.OfFragment = []byte("")
@@ -91,6 +223,18 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.ConflictDoUpdateFragment = []byte("")
 	opts.ConflictDoNothingFragment = []byte("")
 
+	opts.IntervalStyle = sqlgen.DateAddIntervalStyle
+	opts.IntervalUnitLookup = map[exp.IntervalUnit][]byte{
+		exp.MicrosecondsIntervalUnit: []byte("MICROSECOND"),
+		exp.SecondsIntervalUnit:      []byte("SECOND"),
+		exp.MinutesIntervalUnit:      []byte("MINUTE"),
+		exp.HoursIntervalUnit:        []byte("HOUR"),
+		exp.DaysIntervalUnit:         []byte("DAY"),
+		exp.WeeksIntervalUnit:        []byte("WEEK"),
+		exp.MonthsIntervalUnit:       []byte("MONTH"),
+		exp.YearsIntervalUnit:        []byte("YEAR"),
+	}
+
 	return opts
 }
 
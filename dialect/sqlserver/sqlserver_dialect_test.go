@@ -1,6 +1,7 @@
 package sqlserver_test
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/doug-martin/goqu/v9"
@@ -55,6 +56,625 @@ func (sds *sqlserverDialectSuite) TestBitwiseOperations() {
 	)
 }
 
+func (sds *sqlserverDialectSuite) TestBooleanOperations() {
+	col := goqu.C("a")
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{ds: ds.Where(col.IsTrue()), sql: `SELECT * FROM "test" WHERE ("a" = 1)`},
+		sqlTestCase{ds: ds.Where(col.IsFalse()), sql: `SELECT * FROM "test" WHERE ("a" = 0)`},
+		sqlTestCase{ds: ds.Where(col.IsNotTrue()), sql: `SELECT * FROM "test" WHERE (("a" != 1) OR ("a" IS NULL))`},
+		sqlTestCase{ds: ds.Where(col.IsNotFalse()), sql: `SELECT * FROM "test" WHERE (("a" != 0) OR ("a" IS NULL))`},
+		sqlTestCase{ds: ds.Where(goqu.Ex{"a": true}), sql: `SELECT * FROM "test" WHERE ("a" = 1)`},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(col.IsTrue(), goqu.C("b").Eq(false)),
+			sql:        `SELECT * FROM "test" WHERE (("a" = 1) AND ("b" = 0))`,
+			isPrepared: true,
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestStringLiterals() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{ds: ds.Where(goqu.C("a").Eq("Grüße")), sql: `SELECT * FROM "test" WHERE ("a" = N'Grüße')`},
+		sqlTestCase{ds: ds.Where(goqu.C("a").Eq("it's")), sql: `SELECT * FROM "test" WHERE ("a" = N'it''s')`},
+		sqlTestCase{ds: ds.Where(goqu.C("a").Eq(`C:\temp\"x"`)), sql: `SELECT * FROM "test" WHERE ("a" = N'C:\temp\"x"')`},
+		sqlTestCase{ds: ds.Where(goqu.C("a").In("a", "b")), sql: `SELECT * FROM "test" WHERE ("a" IN (N'a', N'b'))`},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(goqu.C("a").Eq("Grüße")),
+			sql:        `SELECT * FROM "test" WHERE ("a" = @p1)`,
+			isPrepared: true,
+			args:       []interface{}{"Grüße"},
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestCreateTable() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: d.CreateTable("test").Columns(
+				goqu.ColumnDef("id", goqu.IntegerType()).PrimaryKey().AutoIncrement(),
+				goqu.ColumnDef("name", goqu.VarcharType(255)).NotNull(),
+				goqu.ColumnDef("active", goqu.BooleanType()).Default(true),
+			),
+			sql: `CREATE TABLE "test" ("id" INT IDENTITY(1,1) PRIMARY KEY, "name" NVARCHAR(255) NOT NULL, ` +
+				`"active" BIT DEFAULT 1)`,
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").Temporary().Columns(goqu.ColumnDef("id", goqu.IntegerType())),
+			sql: `CREATE TABLE "#test" ("id" INT)`,
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("test").IfNotExists().Columns(goqu.ColumnDef("id", goqu.IntegerType())),
+			err: "goqu: dialect does not support IF NOT EXISTS in CREATE TABLE [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestAlterTable() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: d.AlterTable("test").
+				AddColumn(goqu.ColumnDef("a", goqu.VarcharType(10))).
+				AlterColumnType("b", goqu.BigIntType()).
+				DropColumn("c"),
+			sql: `ALTER TABLE "test" ADD "a" NVARCHAR(10), ALTER COLUMN "b" BIGINT, DROP COLUMN "c"`,
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").RenameColumn("a", "b"),
+			err: "goqu: dialect does not support RENAME COLUMN in ALTER TABLE [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestCreateIndex() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateIndex("test_idx").On("test").Unique().Columns("a").Where(goqu.C("a").IsNotNull()),
+			sql: `CREATE UNIQUE INDEX "test_idx" ON "test" ("a") WHERE ("a" IS NOT NULL)`,
+		},
+		sqlTestCase{
+			ds:  d.CreateIndex("test_idx").On("test").Columns("a").IfNotExists(),
+			err: "goqu: dialect does not support IF NOT EXISTS in CREATE INDEX [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestCreateView() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateView("test_view").OrReplace().As(goqu.From("test").Select("a")).WithCheckOption(),
+			sql: `CREATE OR ALTER VIEW "test_view" AS SELECT "a" FROM "test" WITH CHECK OPTION`,
+		},
+		sqlTestCase{
+			ds:  d.CreateView("test_view").As(goqu.From("test")).WithCascadedCheckOption(),
+			err: "goqu: dialect does not support WITH CASCADED CHECK OPTION in CREATE VIEW [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  d.CreateView("test_view").Temporary().As(goqu.From("test")),
+			err: "goqu: dialect does not support TEMPORARY in CREATE VIEW [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestConstraints() {
+	d := goqu.Dialect("sqlserver")
+	ct := d.CreateTable("order").Columns(goqu.ColumnDef("user_id", goqu.BigIntType()))
+	fk := goqu.ForeignKey("user_id").References("user", "id")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: ct.Constraints(fk.OnDelete(exp.CascadeReferentialAction).OnUpdate(exp.NoActionReferentialAction)),
+			sql: `CREATE TABLE "order" ("user_id" BIGINT, FOREIGN KEY ("user_id") REFERENCES "user" ("id") ` +
+				`ON DELETE CASCADE ON UPDATE NO ACTION)`,
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("order").AddConstraint(goqu.Check(goqu.C("user_id").Gt(0)).Named("order_user_check")),
+			sql: `ALTER TABLE "order" ADD CONSTRAINT "order_user_check" CHECK ("user_id" > 0)`,
+		},
+		sqlTestCase{
+			ds:  ct.Constraints(fk.OnDelete(exp.RestrictReferentialAction)),
+			err: "goqu: dialect does not support the RESTRICT referential action [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  ct.Constraints(goqu.Exclude("gist", goqu.ExcludeWith("user_id", "="))),
+			err: "goqu: dialect does not support EXCLUDE constraints [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestTriggers() {
+	d := goqu.Dialect("sqlserver")
+	ct := d.CreateTrigger("user_audit").After().OnInsert().OnUpdate().On("user")
+	body := `INSERT INTO "audit" ("user_id") SELECT "id" FROM inserted`
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ct.OrReplace().Body(body),
+			sql: `CREATE OR ALTER TRIGGER "user_audit" ON "user" AFTER INSERT, UPDATE AS ` + body,
+		},
+		sqlTestCase{
+			ds:  ct.Before().Body(body),
+			err: "goqu: dialect does not support BEFORE in CREATE TRIGGER [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  ct.ForEachRow().Body(body),
+			err: "goqu: dialect does not support FOR EACH ROW in CREATE TRIGGER [dialect=sqlserver]",
+		},
+		sqlTestCase{ds: d.DropTrigger("user_audit"), sql: `DROP TRIGGER "user_audit"`},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestFunctions() {
+	d := goqu.Dialect("sqlserver")
+	cf := d.CreateFunction("add_one").Param("a", goqu.IntegerType()).Returns(goqu.IntegerType()).
+		Body("BEGIN RETURN @a + 1 END")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  cf.OrReplace(),
+			sql: `CREATE OR ALTER FUNCTION "add_one"(@a INT) RETURNS INT AS BEGIN RETURN @a + 1 END`,
+		},
+		sqlTestCase{
+			ds:  cf.Language("sql"),
+			err: "goqu: dialect does not support LANGUAGE in CREATE FUNCTION [dialect=sqlserver]",
+		},
+		sqlTestCase{ds: d.DropFunction("add_one"), sql: `DROP FUNCTION "add_one"`},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestFetch() {
+	ds := goqu.Dialect("sqlserver").From("test").Order(goqu.C("score").Desc())
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Fetch(goqu.FetchFirst(3).WithTies()),
+			sql: `SELECT  TOP (3) WITH TIES * FROM "test" ORDER BY "score" DESC`,
+		},
+		sqlTestCase{
+			ds:  ds.Fetch(goqu.FetchFirst(10).Percent()),
+			sql: `SELECT  TOP (10) PERCENT * FROM "test" ORDER BY "score" DESC`,
+		},
+		sqlTestCase{
+			ds:  ds.Offset(5).Fetch(goqu.FetchFirst(10)),
+			sql: `SELECT * FROM "test" ORDER BY "score" DESC OFFSET 5 ROWS FETCH FIRST 10 ROWS ONLY`,
+		},
+		sqlTestCase{
+			ds:  ds.Offset(5).Fetch(goqu.FetchFirst(10).WithTies()),
+			err: "goqu: dialect does not support FETCH FIRST WITH TIES or PERCENT with an OFFSET [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestSelectInto() {
+	ds := goqu.Dialect("sqlserver").From("users").Select("id", "name").Where(goqu.C("active").IsTrue())
+	sds.assertSQL(
+		sqlTestCase{ds: ds.Into("staging"), sql: `SELECT "id", "name" INTO "staging" FROM "users" WHERE ("active" = 1)`},
+		sqlTestCase{ds: ds.IntoTemp("staging"), sql: `SELECT "id", "name" INTO "#staging" FROM "users" WHERE ("active" = 1)`},
+		sqlTestCase{
+			ds:  ds.IntoTemp("#staging").Limit(10),
+			sql: `SELECT  TOP (10) "id", "name" INTO "#staging" FROM "users" WHERE ("active" = 1)`,
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestMathFunctions() {
+	ds := goqu.Dialect("sqlserver").From("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.CEIL("a"), goqu.FLOOR("a"), goqu.MOD("b", 2)),
+			sql: `SELECT CEILING("a"), FLOOR("a"), ("b" % 2) FROM "test"`,
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(goqu.MOD(goqu.C("b"), goqu.C("c")).Eq(0)),
+			sql:        `SELECT * FROM "test" WHERE (("b" % "c") = @p1)`,
+			isPrepared: true,
+			args:       []interface{}{int64(0)},
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestRandom() {
+	ds := goqu.Dialect("sqlserver").From("test")
+	sds.assertSQL(
+		sqlTestCase{ds: ds.OrderRandom(10), sql: `SELECT  TOP (10) * FROM "test" ORDER BY NEWID() ASC`},
+		sqlTestCase{ds: ds.Order(goqu.Random().Desc()), sql: `SELECT * FROM "test" ORDER BY NEWID() DESC`},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestCompoundExpressions() {
+	ds1 := goqu.Dialect("sqlserver").From("test").Select("a")
+	ds2 := goqu.Dialect("sqlserver").From("test2").Select("b")
+	sds.assertSQL(
+		sqlTestCase{ds: ds1.Except(ds2), sql: `SELECT "a" FROM "test" EXCEPT (SELECT "b" FROM "test2")`},
+		sqlTestCase{ds: ds1.IntersectAll(ds2), err: "goqu: dialect does not support INTERSECT ALL [dialect=sqlserver]"},
+		sqlTestCase{ds: ds1.ExceptAll(ds2), err: "goqu: dialect does not support EXCEPT ALL [dialect=sqlserver]"},
+		sqlTestCase{
+			ds:  ds1.Union(ds2).Parenthesize().Except(ds2).Order(goqu.C("a").Asc()),
+			sql: `(SELECT "a" FROM "test" UNION (SELECT "b" FROM "test2")) EXCEPT (SELECT "b" FROM "test2") ORDER BY "a" ASC`,
+		},
+		sqlTestCase{
+			ds:  ds1.Union(ds2).Parenthesize().Order(goqu.C("a").Asc()).Limit(10),
+			err: "goqu: dialect does not support limiting a parenthesized compound query [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestCountAndExists() {
+	ds := goqu.Dialect("sqlserver").From("test").Where(goqu.C("a").Gt(10)).Order(goqu.C("a").Asc())
+	sds.assertSQL(
+		sqlTestCase{ds: ds.CountDataset(), sql: `SELECT COUNT(*) AS "count" FROM (SELECT 1 FROM "test" WHERE ("a" > 10)) AS "t1"`},
+		sqlTestCase{
+			ds:  ds.ExistsDataset(),
+			sql: `SELECT CASE  WHEN EXISTS (SELECT * FROM "test" WHERE ("a" > 10)) THEN 1 ELSE 0 END`,
+		},
+		sqlTestCase{
+			ds: ds.Limit(1).ExistsDataset(),
+			sql: `SELECT CASE  WHEN EXISTS (SELECT  TOP (1) * FROM "test" WHERE ("a" > 10) ORDER BY "a" ASC) ` +
+				`THEN 1 ELSE 0 END`,
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestPivot() {
+	d := goqu.Dialect("sqlserver")
+	src := d.From("sales").Select("month", "amount").As("s")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: d.From(goqu.Pivot(src, goqu.SUM("amount")).For("month").In(goqu.I("JAN"), goqu.I("FEB")).As("p")).
+				Select("JAN", "FEB"),
+			sql: `SELECT "JAN", "FEB" FROM (SELECT "month", "amount" FROM "sales") AS "s" ` +
+				`PIVOT (SUM("amount") FOR "month" IN ("JAN", "FEB")) AS "p"`,
+		},
+		sqlTestCase{
+			ds:  d.From(goqu.Unpivot(goqu.T("sales"), "amount").For("month").In("JAN", "FEB").As("u")),
+			sql: `SELECT * FROM "sales" UNPIVOT ("amount" FOR "month" IN ("JAN", "FEB")) AS "u"`,
+		},
+		sqlTestCase{
+			ds:  d.From(goqu.Unpivot(goqu.T("sales"), "amount").For("month").In("JAN").IncludeNulls().As("u")),
+			err: "goqu: dialect does not support UNPIVOT INCLUDE NULLS [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestGrouping() {
+	ds := goqu.Dialect("sqlserver").From("sales").Select("region", goqu.GROUPING("region"), goqu.SUM("amount"))
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.GroupBy(goqu.Rollup("region", "product")),
+			sql: `SELECT "region", GROUPING("region"), SUM("amount") FROM "sales" GROUP BY ROLLUP ("region", "product")`,
+		},
+		sqlTestCase{
+			ds:  ds.GroupBy(goqu.Cube("region", "product")),
+			sql: `SELECT "region", GROUPING("region"), SUM("amount") FROM "sales" GROUP BY CUBE ("region", "product")`,
+		},
+		sqlTestCase{
+			ds: ds.GroupBy(goqu.GroupingSets([]interface{}{"region", "product"}, "region", nil)),
+			sql: `SELECT "region", GROUPING("region"), SUM("amount") FROM "sales" ` +
+				`GROUP BY GROUPING SETS (("region", "product"), ("region"), ())`,
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestMaintenance() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{ds: d.Vacuum("items"), err: "goqu: dialect does not support VACUUM [dialect=sqlserver]"},
+		sqlTestCase{ds: d.Analyze("items"), err: "goqu: dialect does not support ANALYZE [dialect=sqlserver]"},
+		sqlTestCase{ds: d.Optimize("items"), err: "goqu: dialect does not support OPTIMIZE [dialect=sqlserver]"},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestSetParam() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{ds: d.SetParam("a", 1), err: "goqu: dialect does not support SET [dialect=sqlserver]"},
+		sqlTestCase{ds: d.Show("a"), err: "goqu: dialect does not support SHOW [dialect=sqlserver]"},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestCopy() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{ds: d.CopyFrom("items"), err: "goqu: dialect does not support COPY [dialect=sqlserver]"},
+		sqlTestCase{ds: d.CopyTo("items"), err: "goqu: dialect does not support COPY [dialect=sqlserver]"},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestCall() {
+	var total int64
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{ds: d.Call("dbo.refresh_totals"), sql: `EXEC "dbo"."refresh_totals"`},
+		sqlTestCase{
+			ds:   d.Call("user_totals", 10, sql.Out{Dest: &total}),
+			sql:  `EXEC "user_totals" 10, @p1 OUTPUT`,
+			args: []interface{}{sql.Out{Dest: &total}},
+		},
+		sqlTestCase{
+			ds:         d.Call("user_totals", 10, sql.Out{Dest: &total}).Prepared(true),
+			sql:        `EXEC "user_totals" @p1, @p2 OUTPUT`,
+			isPrepared: true,
+			args:       []interface{}{int64(10), sql.Out{Dest: &total}},
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestMerge() {
+	ds := goqu.Dialect("sqlserver").Merge("user").
+		Using(goqu.T("staged_user").As("s")).
+		On(goqu.I("user.id").Eq(goqu.I("s.id")))
+	sds.assertSQL(
+		sqlTestCase{
+			ds: ds.WhenMatchedThenDelete(goqu.I("s.deleted").IsTrue()).
+				WhenMatchedThenUpdate(goqu.Record{"name": goqu.I("s.name")}).
+				WhenNotMatchedThenInsert(goqu.Record{"id": goqu.I("s.id"), "name": goqu.I("s.name")}),
+			sql: `MERGE INTO "user" USING "staged_user" AS "s" ON ("user"."id" = "s"."id") ` +
+				`WHEN MATCHED AND ("s"."deleted" = 1) THEN DELETE ` +
+				`WHEN MATCHED THEN UPDATE SET "name"="s"."name" ` +
+				`WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("s"."id", "s"."name");`,
+		},
+		sqlTestCase{
+			ds: ds.WhenMatchedThenUpdate(goqu.Record{"name": "unknown"}).Prepared(true),
+			sql: `MERGE INTO "user" USING "staged_user" AS "s" ON ("user"."id" = "s"."id") ` +
+				`WHEN MATCHED THEN UPDATE SET "name"=@p1;`,
+			isPrepared: true,
+			args:       []interface{}{"unknown"},
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestPartitions() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateTable("measurement").Columns(goqu.ColumnDef("id", goqu.IntegerType())).PartitionBy(goqu.PartitionByHash("id")),
+			err: "goqu: dialect does not support PARTITION BY [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("measurement").DetachPartition("measurement_2024"),
+			err: "goqu: dialect does not support DETACH PARTITION in ALTER TABLE [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestGrant() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.Grant("SELECT", "INSERT").On("dbo.user").To("tenant_a", "tenant_b"),
+			sql: `GRANT SELECT, INSERT ON "dbo"."user" TO "tenant_a", "tenant_b"`,
+		},
+		sqlTestCase{
+			ds:  d.Revoke("INSERT").On("user").From("tenant_a").WithGrantOption().Cascade(),
+			sql: `REVOKE GRANT OPTION FOR INSERT ON "user" FROM "tenant_a" CASCADE`,
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestComment() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CommentOnTable("user").Is("the users"),
+			err: "goqu: dialect does not support COMMENT ON TABLE [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  d.CommentOnColumn("user.email").Is("the login"),
+			err: "goqu: dialect does not support COMMENT ON COLUMN [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  d.CreateTable("user").Columns(goqu.ColumnDef("id", goqu.IntegerType()).Comment("the id")),
+			err: "goqu: dialect does not support comments in column definitions [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("user").ModifyColumn(goqu.ColumnDef("id", goqu.BigIntType())),
+			err: "goqu: dialect does not support MODIFY COLUMN in ALTER TABLE [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestSchema() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateSchema("tenant_1").Authorization("admin"),
+			sql: `CREATE SCHEMA "tenant_1" AUTHORIZATION "admin"`,
+		},
+		sqlTestCase{
+			ds:  d.CreateSchema("tenant_1").IfNotExists(),
+			err: "goqu: dialect does not support IF NOT EXISTS in CREATE SCHEMA [dialect=sqlserver]",
+		},
+		sqlTestCase{ds: d.CreateDatabase("tenant_1"), sql: `CREATE DATABASE "tenant_1"`},
+		sqlTestCase{
+			ds:  d.CreateDatabase("tenant_1").Authorization("admin"),
+			err: "goqu: dialect does not support OWNER in CREATE DATABASE [dialect=sqlserver]",
+		},
+		sqlTestCase{ds: d.DropSchema("tenant_1").IfExists(), sql: `DROP SCHEMA IF EXISTS "tenant_1"`},
+		sqlTestCase{ds: d.DropDatabase("tenant_1").IfExists(), sql: `DROP DATABASE IF EXISTS "tenant_1"`},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestSequence() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateSequence("test_seq").IncrementBy(2).StartWith(10).NoCycle(),
+			sql: `CREATE SEQUENCE "test_seq" INCREMENT BY 2 START WITH 10 NO CYCLE`,
+		},
+		sqlTestCase{
+			ds:  d.CreateSequence("test_seq").IfNotExists(),
+			err: "goqu: dialect does not support IF NOT EXISTS in CREATE SEQUENCE [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  d.AlterSequence("test_seq").RestartWith(1),
+			sql: `ALTER SEQUENCE "test_seq" RESTART WITH 1`,
+		},
+		sqlTestCase{
+			ds:  d.AlterSequence("test_seq").IfExists().RestartWith(1),
+			err: "goqu: dialect does not support IF EXISTS in ALTER SEQUENCE [dialect=sqlserver]",
+		},
+		sqlTestCase{ds: d.DropSequence("test_seq"), sql: `DROP SEQUENCE "test_seq"`},
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"id": goqu.NextVal("test_seq")}),
+			sql: `INSERT INTO "test" ("id") VALUES (NEXT VALUE FOR "test_seq")`,
+		},
+		sqlTestCase{
+			ds:  d.From("test").Select(goqu.CurrVal("test_seq")),
+			err: "goqu: dialect does not support the CURRENT VALUE of a sequence [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestMaterializedView() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.CreateMaterializedView("test_view").As(goqu.From("test")),
+			err: "goqu: dialect does not support CREATE MATERIALIZED VIEW [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  d.RefreshMaterializedView("test_view"),
+			err: "goqu: dialect does not support REFRESH MATERIALIZED VIEW [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  d.DropMaterializedView("test_view"),
+			err: "goqu: dialect does not support DROP MATERIALIZED VIEW [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestDropTable() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{ds: d.DropTable("a", "b").IfExists(), sql: `DROP TABLE IF EXISTS "a", "b"`},
+		sqlTestCase{ds: d.DropView("a"), sql: `DROP VIEW "a"`},
+		sqlTestCase{
+			ds:  d.DropTable("a").Cascade(),
+			err: "goqu: dialect does not support CASCADE or RESTRICT in DROP TABLE [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestDropIndex() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.DropIndex("test_idx").On("test").IfExists(),
+			sql: `DROP INDEX IF EXISTS "test_idx" ON "test"`,
+		},
+		sqlTestCase{
+			ds:  d.DropIndex("test_idx"),
+			err: "goqu: a table is required when dropping an index [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestTableHints() {
+	d := goqu.Dialect("sqlserver")
+	users := goqu.HintTable(goqu.T("users").As("u")).With("NOLOCK")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.From(goqu.HintTable(goqu.T("orders")).With("NOLOCK", "INDEX(ix_user)")),
+			sql: `SELECT * FROM "orders" WITH (NOLOCK, INDEX(ix_user))`,
+		},
+		sqlTestCase{
+			ds: d.From(goqu.HintTable(goqu.T("orders")).With("NOLOCK")).
+				LeftJoin(users, goqu.On(goqu.I("u.id").Eq(goqu.I("orders.user_id")))),
+			sql: `SELECT * FROM "orders" WITH (NOLOCK) LEFT JOIN "users" AS "u" WITH (NOLOCK) ON ("u"."id" = "orders"."user_id")`,
+		},
+		sqlTestCase{
+			ds:  d.From(goqu.HintTable(goqu.T("orders")).UseIndex("ix_user")),
+			err: "goqu: dialect does not support the USE INDEX table hint [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestIntervals() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.From("orders").Where(goqu.DateOf("created_at").Add(goqu.Interval(3, goqu.Days)).Lt(goqu.L("GETDATE()"))),
+			sql: `SELECT * FROM "orders" WHERE (DATEADD(DAY, 3, "created_at") < GETDATE())`,
+		},
+		sqlTestCase{
+			ds:  d.From("orders").Select(goqu.DateOf(goqu.L("GETDATE()")).Sub(goqu.Interval(2, goqu.Weeks)).As("since")),
+			sql: `SELECT DATEADD(WEEK, -2, GETDATE()) AS "since" FROM "orders"`,
+		},
+		sqlTestCase{
+			ds:  d.From("orders").Select(goqu.Interval(3, goqu.Days)),
+			err: "goqu: dialect only supports intervals that are added to or subtracted from a date [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestAtTimeZone() {
+	d := goqu.Dialect("sqlserver")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  d.From("orders").Select(goqu.C("created_at").AtTimeZone("UTC").AtTimeZone("FLE Standard Time").As("local")),
+			sql: `SELECT (("created_at" AT TIME ZONE N'UTC') AT TIME ZONE N'FLE Standard Time') AS "local" FROM "orders"`,
+		},
+		sqlTestCase{
+			ds:  d.From("orders").Where(goqu.C("created_at").AtTimeZone("UTC").Add(goqu.Interval(1, goqu.Days)).Lt(goqu.L("GETDATE()"))),
+			sql: `SELECT * FROM "orders" WHERE (DATEADD(DAY, 1, ("created_at" AT TIME ZONE N'UTC')) < GETDATE())`,
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestCastTypes() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.C("a").Cast("text"), goqu.C("b").Cast("uuid"), goqu.C("c").Cast("timestamptz")),
+			sql: `SELECT CAST("a" AS NVARCHAR(MAX)), CAST("b" AS UNIQUEIDENTIFIER), CAST("c" AS DATETIMEOFFSET) FROM "test"`,
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.C("a").Cast("boolean"), goqu.C("b").Cast("json")),
+			sql: `SELECT CAST("a" AS BIT), CAST("b" AS NVARCHAR(MAX)) FROM "test"`,
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.C("a").Cast("VARCHAR"), goqu.C("b").Cast("TEXT")),
+			sql: `SELECT CAST("a" AS VARCHAR), CAST("b" AS TEXT) FROM "test"`,
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestDistinctFrom() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds: ds.Where(goqu.C("a").IsDistinctFrom(goqu.C("b"))),
+			sql: `SELECT * FROM "test" ` +
+				`WHERE (CASE  WHEN (("a" = "b") OR (("a" IS NULL) AND ("b" IS NULL))) THEN 1 ELSE 0 END = 0)`,
+		},
+		sqlTestCase{
+			ds: ds.Where(goqu.C("a").IsNotDistinctFrom("x")),
+			sql: `SELECT * FROM "test" ` +
+				`WHERE (CASE  WHEN (("a" = N'x') OR (("a" IS NULL) AND (N'x' IS NULL))) THEN 1 ELSE 0 END = 1)`,
+		},
+		sqlTestCase{ds: ds.Where(goqu.C("a").IsDistinctFrom(nil)), sql: `SELECT * FROM "test" WHERE ("a" IS NOT NULL)`},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestAggregateDistinctAndOrder() {
+	ds := sds.GetDs("orders")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.COUNT(goqu.DISTINCT("user_id"))),
+			sql: `SELECT COUNT(DISTINCT "user_id") FROM "orders"`,
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.SUM("total").Distinct().Filter(goqu.C("status").Eq("paid"))),
+			sql: `SELECT SUM(DISTINCT CASE  WHEN ("status" = N'paid') THEN "total" END) FROM "orders"`,
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.Func("STRING_AGG", goqu.C("name"), ",").OrderBy(goqu.C("name").Asc())),
+			err: "goqu: dialect does not support ORDER BY in aggregate functions [dialect=sqlserver]",
+		},
+	)
+}
+
 func TestDatasetAdapterSuite(t *testing.T) {
 	suite.Run(t, new(sqlserverDialectSuite))
 }
@@ -0,0 +1,86 @@
+package tidb
+
+import (
+	"strconv"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+// BatchCommand creates TiDB non-transactional DML statements (BATCH ON ... LIMIT ...) that split a large DELETE,
+// UPDATE or INSERT INTO ... SELECT into multiple batches.
+type BatchCommand struct {
+	column    string
+	limit     uint
+	dryRun    bool
+	statement exec.Statement
+}
+
+var (
+	errBatchStatementRequired = errors.New("a statement is required when generating BATCH sql")
+	errBatchLimitRequired     = errors.New("a limit greater than 0 is required when generating BATCH sql")
+)
+
+// Batch creates a new BatchCommand that executes the statement in batches of limit rows.
+//
+//	sql, args, err := tidb.Batch(1000, goqu.Dialect("tidb").Delete("t").Where(goqu.C("v").Lt(6))).
+//	    On("id").
+//	    ToSQL()
+//	// BATCH ON `id` LIMIT 1000 DELETE FROM `t` WHERE (`v` < 6)
+func Batch(limit uint, statement exec.Statement) *BatchCommand {
+	return &BatchCommand{limit: limit, statement: statement}
+}
+
+func (bc *BatchCommand) copy() *BatchCommand {
+	ret := *bc
+	return &ret
+}
+
+// On sets the column used to split the statement into batches. If no column is set TiDB chooses one.
+func (bc *BatchCommand) On(column string) *BatchCommand {
+	ret := bc.copy()
+	ret.column = column
+	return ret
+}
+
+// DryRun generates a BATCH ... DRY RUN statement which returns the statements that would be executed without
+// executing them.
+func (bc *BatchCommand) DryRun() *BatchCommand {
+	ret := bc.copy()
+	ret.dryRun = true
+	return ret
+}
+
+// ToSQL generates the BATCH sql. The arguments of the statement are returned as is so a prepared statement stays
+// prepared.
+func (bc *BatchCommand) ToSQL() (sql string, params []interface{}, err error) {
+	switch {
+	case bc.statement == nil:
+		return "", nil, errBatchStatementRequired
+	case bc.limit == 0:
+		return "", nil, errBatchLimitRequired
+	}
+	stmtSQL, args, err := bc.statement.ToSQL()
+	if err != nil {
+		return "", nil, err
+	}
+	do := DialectOptions()
+	b := sb.NewSQLBuilder(false)
+	b.WriteStrings("BATCH")
+	if bc.column != "" {
+		b.WriteStrings(" ON ")
+		sqlgen.NewExpressionSQLGenerator("tidb", do).Generate(b, exp.ParseIdentifier(bc.column))
+	}
+	b.WriteStrings(" LIMIT ", strconv.FormatUint(uint64(bc.limit), 10))
+	if bc.dryRun {
+		b.WriteStrings(" DRY RUN")
+	}
+	b.WriteRunes(do.SpaceRune).WriteStrings(stmtSQL)
+	if sql, _, err = b.ToSQL(); err != nil {
+		return "", nil, err
+	}
+	return sql, args, nil
+}
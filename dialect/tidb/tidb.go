@@ -0,0 +1,38 @@
+package tidb
+
+import (
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/mysql"
+)
+
+// DialectOptions returns the mysql 8 options with support for common table expressions. Optimizer hints
+// (e.g. SELECT /*+ TIDB_SMJ(t1, t2) */ ...) are supported by the mysql options.
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := mysql.DialectOptionsV8()
+	opts.SupportsWithCTE = true
+	opts.SupportsWithCTERecursive = true
+	// BATCH statements do not accept the multiple table DELETE syntax
+	opts.SupportsDeleteTableHint = false
+	return opts
+}
+
+// SMJ creates a TIDB_SMJ hint so the optimizer uses a sort merge join for the provided tables
+//    dialect.From("t1").Hint(tidb.SMJ("t1", "t2")).InnerJoin(...)
+//    // SELECT /*+ TIDB_SMJ(t1, t2) */ * FROM `t1` INNER JOIN ...
+func SMJ(tables ...string) string {
+	return "TIDB_SMJ(" + strings.Join(tables, ", ") + ")"
+}
+
+// INLJ creates a TIDB_INLJ hint so the optimizer uses an index nested loop join with the provided tables as the inner
+// tables
+//    dialect.From("t1").Hint(tidb.INLJ("t2")).InnerJoin(...)
+//    // SELECT /*+ TIDB_INLJ(t2) */ * FROM `t1` INNER JOIN ...
+func INLJ(tables ...string) string {
+	return "TIDB_INLJ(" + strings.Join(tables, ", ") + ")"
+}
+
+func init() {
+	goqu.RegisterDialect("tidb", DialectOptions())
+}
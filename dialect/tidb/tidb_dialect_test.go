@@ -0,0 +1,122 @@
+package tidb_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/tidb"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	tidbDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exec.Statement
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (tds *tidbDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("tidb").From(table)
+}
+
+func (tds *tidbDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			tds.NoError(err, "test case %d failed", i)
+		} else {
+			tds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		tds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			tds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			tds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (tds *tidbDialectSuite) TestHints() {
+	ds := tds.GetDs("t1").InnerJoin(goqu.T("t2"), goqu.On(goqu.I("t1.id").Eq(goqu.I("t2.id"))))
+	tds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Hint(tidb.SMJ("t1", "t2")),
+			sql: "SELECT /*+ TIDB_SMJ(t1, t2) */ * FROM `t1` INNER JOIN `t2` ON (`t1`.`id` = `t2`.`id`)",
+		},
+		sqlTestCase{
+			ds:  ds.Hint(tidb.INLJ("t2")).Select("a").Distinct(),
+			sql: "SELECT /*+ TIDB_INLJ(t2) */ DISTINCT `a` FROM `t1` INNER JOIN `t2` ON (`t1`.`id` = `t2`.`id`)",
+		},
+		sqlTestCase{
+			ds:  ds.Hint(tidb.SMJ("t1", "t2"), "MAX_EXECUTION_TIME(1000)").ClearHint(),
+			sql: "SELECT * FROM `t1` INNER JOIN `t2` ON (`t1`.`id` = `t2`.`id`)",
+		},
+		sqlTestCase{
+			ds:  tds.GetDs("t1").Hint(tidb.INLJ("t2")).Where(goqu.C("a").Eq(1)).Prepared(true),
+			sql: "SELECT /*+ TIDB_INLJ(t2) */ * FROM `t1` WHERE (`a` = ?)", isPrepared: true, args: []interface{}{int64(1)},
+		},
+		sqlTestCase{
+			ds:  goqu.Dialect("mariadb").From("t1").Hint(tidb.SMJ("t1", "t2")),
+			err: "goqu: dialect does not support optimizer hints [dialect=mariadb]",
+		},
+		sqlTestCase{
+			ds:  ds.Hint(tidb.SMJ("t1", "t2) */ DELETE FROM t1; /*")),
+			err: `goqu: optimizer hint "TIDB_SMJ(t1, t2) */ DELETE FROM t1; /*)" must not contain */ [dialect=tidb]`,
+		},
+	)
+}
+
+func (tds *tidbDialectSuite) TestCommonTables() {
+	tds.assertSQL(
+		sqlTestCase{
+			ds:  tds.GetDs("cte").With("cte", tds.GetDs("t1").Where(goqu.C("a").Gt(1))),
+			sql: "WITH cte AS (SELECT * FROM `t1` WHERE (`a` > 1)) SELECT * FROM `cte`",
+		},
+	)
+}
+
+func (tds *tidbDialectSuite) TestBatch() {
+	d := goqu.Dialect("tidb")
+	del := d.Delete("t").Where(goqu.C("v").Lt(6))
+	tds.assertSQL(
+		sqlTestCase{
+			ds:  tidb.Batch(1000, del),
+			sql: "BATCH LIMIT 1000 DELETE FROM `t` WHERE (`v` < 6)",
+		},
+		sqlTestCase{
+			ds:  tidb.Batch(1000, del).On("id"),
+			sql: "BATCH ON `id` LIMIT 1000 DELETE FROM `t` WHERE (`v` < 6)",
+		},
+		sqlTestCase{
+			ds:  tidb.Batch(1000, del).On("t.id").DryRun(),
+			sql: "BATCH ON `t`.`id` LIMIT 1000 DRY RUN DELETE FROM `t` WHERE (`v` < 6)",
+		},
+		sqlTestCase{
+			ds:         tidb.Batch(500, d.Update("t").Set(goqu.Record{"v": 1}).Where(goqu.C("v").Lt(6)).Prepared(true)).On("id"),
+			sql:        "BATCH ON `id` LIMIT 500 UPDATE `t` SET `v`=? WHERE (`v` < ?)",
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(6)},
+		},
+		sqlTestCase{
+			ds:  tidb.Batch(100, d.Insert("t2").FromQuery(d.From("t").Where(goqu.C("v").Lt(6)))).On("t.id"),
+			sql: "BATCH ON `t`.`id` LIMIT 100 INSERT INTO `t2` SELECT * FROM `t` WHERE (`v` < 6)",
+		},
+		sqlTestCase{
+			ds:  tidb.Batch(1000, d.Update("t")),
+			err: "goqu: no set values found when generating UPDATE sql",
+		},
+		sqlTestCase{ds: tidb.Batch(0, del), err: "goqu: a limit greater than 0 is required when generating BATCH sql"},
+		sqlTestCase{ds: tidb.Batch(1000, nil), err: "goqu: a statement is required when generating BATCH sql"},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(tidbDialectSuite))
+}
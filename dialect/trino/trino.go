@@ -0,0 +1,127 @@
+package trino
+
+import (
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+// DialectOptions returns the options for trino (and presto). Tables can be referenced across catalogs using three
+// part identifiers (e.g. goqu.From("hive.web.page_views") or goqu.I("postgresql.public.users")).
+func DialectOptions() *goqu.SQLDialectOptions {
+	opts := goqu.DefaultDialectOptions()
+
+	opts.SupportsReturn = false
+	opts.SupportsDistinctOn = false
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	opts.SupportsConflict = false
+	opts.SupportsConflictTarget = false
+	opts.SupportsConflictUpdateWhere = false
+	opts.SupportsMultipleUpdateTables = false
+	opts.CopyFragment = nil
+
+	opts.EscapedRunes = map[rune][]byte{
+		'\'': []byte("''"),
+	}
+	opts.TimeFormat = "2006-01-02 15:04:05.999999999"
+
+	opts.TruncateClause = []byte("TRUNCATE TABLE")
+	opts.SupportsMultipleTruncateTables = false
+	opts.SupportsTruncateIdentity = false
+	opts.SupportsTruncateCascade = false
+
+	// trino does not support column defaults, adding NOT NULL or constraints and only accepts a single action in an
+	// ALTER TABLE
+	opts.SupportsMultipleAlterTableActions = false
+	opts.ColumnTypeFragment = []byte(" SET DATA TYPE ")
+	opts.SetDefaultFragment = nil
+	opts.DropDefaultFragment = nil
+	opts.SetNotNullFragment = nil
+	opts.AddConstraintFragment = nil
+
+	// trino only supports regular expressions through functions (e.g. regexp_like)
+	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
+		exp.EqOp:      []byte("="),
+		exp.NeqOp:     []byte("!="),
+		exp.GtOp:      []byte(">"),
+		exp.GteOp:     []byte(">="),
+		exp.LtOp:      []byte("<"),
+		exp.LteOp:     []byte("<="),
+		exp.InOp:      []byte("IN"),
+		exp.NotInOp:   []byte("NOT IN"),
+		exp.IsOp:      []byte("IS"),
+		exp.IsNotOp:   []byte("IS NOT"),
+		exp.LikeOp:    []byte("LIKE"),
+		exp.NotLikeOp: []byte("NOT LIKE"),
+
+		exp.IsDistinctFromOp:    []byte("IS DISTINCT FROM"),
+		exp.IsNotDistinctFromOp: []byte("IS NOT DISTINCT FROM"),
+	}
+	// trino only supports bitwise operations through functions (e.g. bitwise_and)
+	opts.BitwiseOperatorLookup = map[exp.BitwiseOperation][]byte{}
+
+	// trino does not support row locking
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.CommonTableSQLFragment,
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.GroupBySQLFragment,
+		sqlgen.HavingSQLFragment,
+		sqlgen.WindowSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.OffsetSQLFragment,
+		sqlgen.LimitSQLFragment,
+	}
+	return opts
+}
+
+// Unnest expands one or more arrays (or maps) into a relation, use As and Columns to name the columns
+//    dialect.From("orders").
+//        CrossJoin(trino.Unnest(goqu.C("items")).As("t").Columns("item"))
+//    // SELECT * FROM "orders" CROSS JOIN UNNEST("items") AS "t"("item")
+func Unnest(arrays ...interface{}) exp.LiteralExpression {
+	return goqu.L("UNNEST("+placeholders(len(arrays))+")", arrays...)
+}
+
+// UnnestWithOrdinality expands one or more arrays into a relation with an additional ordinality column
+//    dialect.From("orders").
+//        CrossJoin(trino.UnnestWithOrdinality(goqu.C("items")).As("t").Columns("item", "n"))
+//    // SELECT * FROM "orders" CROSS JOIN UNNEST("items") WITH ORDINALITY AS "t"("item", "n")
+func UnnestWithOrdinality(arrays ...interface{}) exp.LiteralExpression {
+	return goqu.L("UNNEST("+placeholders(len(arrays))+") WITH ORDINALITY", arrays...)
+}
+
+// Lambda creates a lambda expression that can be passed to functions such as transform or filter, reference the
+// params in the body using goqu.C
+//    goqu.Func("transform", goqu.C("prices"), trino.Lambda([]string{"x"}, goqu.L("? * 2", goqu.C("x"))))
+//    // transform("prices", "x" -> "x" * 2)
+func Lambda(params []string, body exp.Expression) exp.LiteralExpression {
+	args := make([]interface{}, 0, len(params)+1)
+	for _, p := range params {
+		args = append(args, goqu.C(p))
+	}
+	args = append(args, body)
+	if len(params) == 1 {
+		return goqu.L("? -> ?", args...)
+	}
+	return goqu.L("("+placeholders(len(params))+") -> ?", args...)
+}
+
+func placeholders(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strings.Repeat("?, ", n-1) + "?"
+}
+
+func init() {
+	goqu.RegisterDialect("trino", DialectOptions())
+}
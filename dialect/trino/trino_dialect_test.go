@@ -0,0 +1,176 @@
+package trino_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/trino"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	trinoDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exec.Statement
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (tds *trinoDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("trino").From(table)
+}
+
+func (tds *trinoDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			tds.NoError(err, "test case %d failed", i)
+		} else {
+			tds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		tds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			tds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			tds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (tds *trinoDialectSuite) TestCrossCatalogIdentifiers() {
+	tds.assertSQL(
+		sqlTestCase{
+			ds: tds.GetDs("hive.web.page_views").
+				Join(goqu.I("postgresql.public.users").As("u"), goqu.On(goqu.I("u.id").Eq(goqu.I("page_views.user_id")))).
+				Select(goqu.I("u.name")),
+			sql: `SELECT "u"."name" FROM "hive"."web"."page_views" ` +
+				`INNER JOIN "postgresql"."public"."users" AS "u" ON ("u"."id" = "page_views"."user_id")`,
+		},
+	)
+}
+
+func (tds *trinoDialectSuite) TestPlaceholders() {
+	tds.assertSQL(
+		sqlTestCase{
+			ds:         tds.GetDs("test").Prepared(true).Where(goqu.C("a").Eq(1), goqu.C("b").In([]string{"a", "b"})),
+			sql:        `SELECT * FROM "test" WHERE (("a" = ?) AND ("b" IN (?, ?)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", "b"},
+		},
+		sqlTestCase{
+			ds:         tds.GetDs("test").Prepared(true).Order(goqu.C("a").Asc()).Limit(10).Offset(20),
+			sql:        `SELECT * FROM "test" ORDER BY "a" ASC OFFSET ? LIMIT ?`,
+			isPrepared: true,
+			args:       []interface{}{int64(20), int64(10)},
+		},
+	)
+}
+
+func (tds *trinoDialectSuite) TestUnnest() {
+	ds := tds.GetDs("orders")
+	tds.assertSQL(
+		sqlTestCase{
+			ds:  ds.CrossJoin(trino.Unnest(goqu.C("items")).As("t").Columns("item")),
+			sql: `SELECT * FROM "orders" CROSS JOIN UNNEST("items") AS "t"("item")`,
+		},
+		sqlTestCase{
+			ds: ds.CrossJoin(trino.UnnestWithOrdinality(goqu.C("items")).As("t").Columns("item", "n")).
+				Select(goqu.I("orders.id"), goqu.I("t.item"), goqu.I("t.n")),
+			sql: `SELECT "orders"."id", "t"."item", "t"."n" FROM "orders" ` +
+				`CROSS JOIN UNNEST("items") WITH ORDINALITY AS "t"("item", "n")`,
+		},
+		sqlTestCase{
+			ds: goqu.Dialect("trino").
+				From(trino.Unnest(goqu.C("keys"), goqu.C("vals")).As("t").Columns("k", "v")),
+			sql: `SELECT * FROM UNNEST("keys", "vals") AS "t"("k", "v")`,
+		},
+	)
+}
+
+func (tds *trinoDialectSuite) TestLambda() {
+	ds := tds.GetDs("products")
+	tds.assertSQL(
+		sqlTestCase{
+			ds: ds.Select(
+				goqu.Func("transform", goqu.C("prices"), trino.Lambda([]string{"x"}, goqu.L("? * 2", goqu.C("x")))),
+			),
+			sql: `SELECT transform("prices", "x" -> "x" * 2) FROM "products"`,
+		},
+		sqlTestCase{
+			ds: ds.Select(goqu.Func("reduce", goqu.C("prices"), 0,
+				trino.Lambda([]string{"s", "x"}, goqu.L("? + ?", goqu.C("s"), goqu.C("x"))),
+				trino.Lambda([]string{"s"}, goqu.C("s")),
+			)),
+			sql: `SELECT reduce("prices", 0, ("s", "x") -> "s" + "x", "s" -> "s") FROM "products"`,
+		},
+		sqlTestCase{
+			ds: ds.Prepared(true).Where(goqu.Func("any_match", goqu.C("prices"),
+				trino.Lambda([]string{"x"}, goqu.C("x").Gt(10)))),
+			sql:        `SELECT * FROM "products" WHERE any_match("prices", "x" -> ("x" > ?))`,
+			isPrepared: true,
+			args:       []interface{}{int64(10)},
+		},
+	)
+}
+
+func (tds *trinoDialectSuite) TestUnsupported() {
+	d := goqu.Dialect("trino")
+	tds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=trino]",
+		},
+		sqlTestCase{
+			ds:  tds.GetDs("test").Where(goqu.C("a").ILike("a%")),
+			err: "goqu: boolean operator 'ilike' not supported",
+		},
+		sqlTestCase{
+			ds:  d.Truncate("test", "test2"),
+			err: "goqu: dialect does not support multiple tables in TRUNCATE [dialect=trino]",
+		},
+	)
+}
+
+func (tds *trinoDialectSuite) TestCopy() {
+	d := goqu.Dialect("trino")
+	tds.assertSQL(
+		sqlTestCase{ds: d.CopyFrom("items"), err: "goqu: dialect does not support COPY [dialect=trino]"},
+		sqlTestCase{ds: d.CopyTo("items"), err: "goqu: dialect does not support COPY [dialect=trino]"},
+	)
+}
+
+func (tds *trinoDialectSuite) TestAlterTable() {
+	d := goqu.Dialect("trino")
+	tds.assertSQL(
+		sqlTestCase{
+			ds:  d.AlterTable("test").AlterColumnType("b", goqu.BigIntType()),
+			sql: `ALTER TABLE "test" ALTER COLUMN "b" SET DATA TYPE BIGINT`,
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").DropNotNull("b"),
+			sql: `ALTER TABLE "test" ALTER COLUMN "b" DROP NOT NULL`,
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").SetDefault("b", 1),
+			err: "goqu: dialect does not support SET DEFAULT in ALTER TABLE [dialect=trino]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").SetNotNull("b"),
+			err: "goqu: dialect does not support SET NOT NULL in ALTER TABLE [dialect=trino]",
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.IntegerType())).DropColumn("c"),
+			err: "goqu: dialect does not support multiple actions in ALTER TABLE [dialect=trino]",
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(trinoDialectSuite))
+}
@@ -0,0 +1,48 @@
+package vertica
+
+import (
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+func DialectOptions() *goqu.SQLDialectOptions {
+	do := goqu.DefaultDialectOptions()
+	do.SupportsReturn = false
+	do.SupportsDistinctOn = false
+	do.MaterializedCTEFragment = nil
+	do.NotMaterializedCTEFragment = nil
+	do.CTESearchFragment = nil
+	do.CTECycleFragment = nil
+	do.SupportsLateral = false
+	do.SupportsConflictTarget = false
+	do.SupportsConflictUpdateWhere = false
+	// vertica does not support FILTER on aggregates so they are rewritten using CASE
+	do.AggregateFilterFragment = nil
+
+	do.TruncateClause = []byte("TRUNCATE TABLE")
+	do.SupportsMultipleTruncateTables = false
+	do.SupportsTruncateIdentity = false
+	do.SupportsTruncateCascade = false
+
+	// ADD COLUMN and RENAME COLUMN cannot be combined with other actions of an ALTER TABLE
+	do.SupportsMultipleAlterTableActions = false
+	do.ColumnTypeFragment = []byte(" SET DATA TYPE ")
+	return do
+}
+
+// Projs creates a table reference with a PROJS hint so the optimizer uses the provided projections
+//    dialect.From(vertica.Projs("public.sales", "public.sales_p1"))
+//    // SELECT * FROM "public"."sales" /*+PROJS('public.sales_p1')*/
+func Projs(table string, projections ...string) exp.LiteralExpression {
+	quoted := make([]string, 0, len(projections))
+	for _, p := range projections {
+		quoted = append(quoted, "'"+strings.ReplaceAll(p, "'", "''")+"'")
+	}
+	return goqu.L("? /*+PROJS("+strings.Join(quoted, ", ")+")*/", exp.ParseIdentifier(table))
+}
+
+func init() {
+	goqu.RegisterDialect("vertica", DialectOptions())
+}
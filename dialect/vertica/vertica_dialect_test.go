@@ -0,0 +1,152 @@
+package vertica_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/vertica"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	verticaDialectSuite struct {
+		suite.Suite
+	}
+	sqlTestCase struct {
+		ds         exp.SQLExpression
+		sql        string
+		err        string
+		isPrepared bool
+		args       []interface{}
+	}
+)
+
+func (vds *verticaDialectSuite) GetDs(table string) *goqu.SelectDataset {
+	return goqu.Dialect("vertica").From(table)
+}
+
+func (vds *verticaDialectSuite) assertSQL(cases ...sqlTestCase) {
+	for i, c := range cases {
+		actualSQL, actualArgs, err := c.ds.ToSQL()
+		if c.err == "" {
+			vds.NoError(err, "test case %d failed", i)
+		} else {
+			vds.EqualError(err, c.err, "test case %d failed", i)
+		}
+		vds.Equal(c.sql, actualSQL, "test case %d failed", i)
+		if c.isPrepared && c.args != nil || len(c.args) > 0 {
+			vds.Equal(c.args, actualArgs, "test case %d failed", i)
+		} else {
+			vds.Empty(actualArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (vds *verticaDialectSuite) TestIdentifiers() {
+	vds.assertSQL(
+		sqlTestCase{
+			ds:  vds.GetDs("public.test").Select("a", goqu.I("test.b")),
+			sql: `SELECT "a", "test"."b" FROM "public"."test"`,
+		},
+	)
+}
+
+func (vds *verticaDialectSuite) TestPlaceholders() {
+	vds.assertSQL(
+		sqlTestCase{
+			ds:         vds.GetDs("test").Prepared(true).Where(goqu.C("a").Eq(1), goqu.C("b").In([]string{"a", "b"})),
+			sql:        `SELECT * FROM "test" WHERE (("a" = ?) AND ("b" IN (?, ?)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", "b"},
+		},
+	)
+}
+
+func (vds *verticaDialectSuite) TestProjs() {
+	vds.assertSQL(
+		sqlTestCase{
+			ds:  goqu.Dialect("vertica").From(vertica.Projs("public.sales", "public.sales_p1")),
+			sql: `SELECT * FROM "public"."sales" /*+PROJS('public.sales_p1')*/`,
+		},
+		sqlTestCase{
+			ds: goqu.Dialect("vertica").
+				From(vertica.Projs("sales", "sales_p1", "it's")).
+				Where(goqu.C("a").Eq(1)),
+			sql: `SELECT * FROM "sales" /*+PROJS('sales_p1', 'it''s')*/ WHERE ("a" = 1)`,
+		},
+	)
+}
+
+func (vds *verticaDialectSuite) TestLimitOver() {
+	w := goqu.W().PartitionBy("store_id").OrderBy(goqu.C("amount").Desc())
+	vds.assertSQL(
+		sqlTestCase{
+			ds:  vds.GetDs("sales").LimitOver(2, w),
+			sql: `SELECT * FROM "sales" LIMIT 2 OVER (PARTITION BY "store_id" ORDER BY "amount" DESC)`,
+		},
+		sqlTestCase{
+			ds:         vds.GetDs("sales").Prepared(true).LimitOver(2, w),
+			sql:        `SELECT * FROM "sales" LIMIT ? OVER (PARTITION BY "store_id" ORDER BY "amount" DESC)`,
+			isPrepared: true,
+			args:       []interface{}{int64(2)},
+		},
+	)
+}
+
+func (vds *verticaDialectSuite) TestAggregateFilter() {
+	ds := vds.GetDs("orders")
+	vds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.COUNT("*").Filter(goqu.C("status").Eq("paid")).As("paid")),
+			sql: `SELECT SUM(CASE  WHEN ("status" = 'paid') THEN 1 ELSE 0 END) AS "paid" FROM "orders"`,
+		},
+	)
+}
+
+func (vds *verticaDialectSuite) TestUnsupported() {
+	d := goqu.Dialect("vertica")
+	vds.assertSQL(
+		sqlTestCase{
+			ds:  d.Insert("test").Rows(goqu.Record{"a": 1}).Returning("id"),
+			err: "goqu: dialect does not support RETURNING clause [dialect=vertica]",
+		},
+		sqlTestCase{
+			ds:  vds.GetDs("test").Distinct("a"),
+			err: "goqu: dialect does not support DISTINCT ON clause [dialect=vertica]",
+		},
+	)
+}
+
+func (vds *verticaDialectSuite) TestTruncate() {
+	d := goqu.Dialect("vertica")
+	vds.assertSQL(
+		sqlTestCase{ds: d.Truncate("test"), sql: `TRUNCATE TABLE "test"`},
+		sqlTestCase{
+			ds:  d.Truncate("test", "test2"),
+			err: "goqu: dialect does not support multiple tables in TRUNCATE [dialect=vertica]",
+		},
+		sqlTestCase{
+			ds:  d.Truncate("test").Cascade(),
+			err: "goqu: dialect does not support CASCADE or RESTRICT in TRUNCATE [dialect=vertica]",
+		},
+	)
+}
+
+func (vds *verticaDialectSuite) TestAlterTable() {
+	d := goqu.Dialect("vertica")
+	vds.assertSQL(
+		sqlTestCase{
+			ds:  d.AlterTable("test").AlterColumnType("b", goqu.BigIntType()),
+			sql: `ALTER TABLE "test" ALTER COLUMN "b" SET DATA TYPE BIGINT`,
+		},
+		sqlTestCase{
+			ds:  d.AlterTable("test").AddColumn(goqu.ColumnDef("a", goqu.IntegerType())).DropColumn("c"),
+			err: "goqu: dialect does not support multiple actions in ALTER TABLE [dialect=vertica]",
+		},
+	)
+}
+
+func TestDatasetAdapterSuite(t *testing.T) {
+	suite.Run(t, new(verticaDialectSuite))
+}
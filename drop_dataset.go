@@ -0,0 +1,253 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// DropDataset for creating and/or executing DROP SQL statements.
+type DropDataset struct {
+	dialect      SQLDialect
+	clauses      exp.DropClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedDropTableType = errors.New(
+	"unsupported table type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newDropDataset(d string, queryFactory exec.QueryFactory) *DropDataset {
+	return &DropDataset{
+		clauses:      exp.NewDropClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// DropTable creates a DropDataset to drop one or more tables.
+//
+//	goqu.DropTable("user").IfExists().Cascade()
+func DropTable(tables ...interface{}) *DropDataset {
+	return newDropDataset("default", nil).objectNames(exp.TableDropObject, tables...)
+}
+
+// DropView creates a DropDataset to drop one or more views.
+//
+//	goqu.DropView("active_user").IfExists()
+func DropView(views ...interface{}) *DropDataset {
+	return newDropDataset("default", nil).objectNames(exp.ViewDropObject, views...)
+}
+
+// DropMaterializedView creates a DropDataset to drop one or more materialized views.
+//
+//	goqu.DropMaterializedView("user_totals").IfExists()
+func DropMaterializedView(views ...interface{}) *DropDataset {
+	return newDropDataset("default", nil).objectNames(exp.MaterializedViewDropObject, views...)
+}
+
+// DropSequence creates a DropDataset to drop one or more sequences.
+//
+//	goqu.DropSequence("user_id_seq").IfExists()
+func DropSequence(sequences ...interface{}) *DropDataset {
+	return newDropDataset("default", nil).objectNames(exp.SequenceDropObject, sequences...)
+}
+
+// DropSchema creates a DropDataset to drop one or more schemas.
+//
+//	goqu.DropSchema("tenant_1").IfExists().Cascade()
+func DropSchema(schemas ...interface{}) *DropDataset {
+	return newDropDataset("default", nil).objectNames(exp.SchemaDropObject, schemas...)
+}
+
+// DropDatabase creates a DropDataset to drop a database, databases can only be dropped one at a time.
+//
+//	goqu.DropDatabase("tenant_1").IfExists()
+func DropDatabase(databases ...interface{}) *DropDataset {
+	return newDropDataset("default", nil).objectNames(exp.DatabaseDropObject, databases...)
+}
+
+// DropTrigger creates a DropDataset to drop a trigger, use On to set the table of the trigger.
+//
+//	goqu.DropTrigger("user_audit").On("user").IfExists()
+func DropTrigger(triggers ...interface{}) *DropDataset {
+	return newDropDataset("default", nil).objectNames(exp.TriggerDropObject, triggers...)
+}
+
+// DropFunction creates a DropDataset to drop one or more functions.
+//
+//	goqu.DropFunction("user_audit_fn").IfExists()
+func DropFunction(functions ...interface{}) *DropDataset {
+	return newDropDataset("default", nil).objectNames(exp.FunctionDropObject, functions...)
+}
+
+// DropIndex creates a DropDataset to drop one or more indexes.
+//
+//	goqu.DropIndex("user_email_idx")
+func DropIndex(names ...interface{}) *DropDataset {
+	return newDropDataset("default", nil).objectNames(exp.IndexDropObject, names...)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (dd *DropDataset) WithDialect(dl string) *DropDataset {
+	ds := dd.copy(dd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (dd *DropDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the DropDataset.
+func (dd *DropDataset) Dialect() SQLDialect {
+	return dd.dialect
+}
+
+// SetDialect returns the current adapter on the DropDataset.
+func (dd *DropDataset) SetDialect(dialect SQLDialect) *DropDataset {
+	cd := dd.copy(dd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns DropDataset as exp.Expression.
+func (dd *DropDataset) Expression() exp.Expression {
+	return dd
+}
+
+// Clone clones the DropDataset.
+func (dd *DropDataset) Clone() exp.Expression {
+	return dd.copy(dd.clauses)
+}
+
+// GetClauses returns the current clauses on the DropDataset.
+func (dd *DropDataset) GetClauses() exp.DropClauses {
+	return dd.clauses
+}
+
+// used internally to copy the dataset.
+func (dd *DropDataset) copy(clauses exp.DropClauses) *DropDataset {
+	return &DropDataset{
+		dialect:      dd.dialect,
+		clauses:      clauses,
+		queryFactory: dd.queryFactory,
+		err:          dd.err,
+	}
+}
+
+// used internally to set the type and the names of the objects to drop.
+func (dd *DropDataset) objectNames(objectType exp.DropObjectType, names ...interface{}) *DropDataset {
+	return dd.copy(dd.clauses.SetObjectType(objectType).SetNames(exp.NewColumnListExpression(names...)))
+}
+
+// On sets the table of the dropped index or trigger, it is required by dialects that drop an index or a trigger of a
+// table (e.g. mysql DROP INDEX `a` ON `b`, postgres DROP TRIGGER "a" ON "b") and ignored by all other dialects. You can
+// pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (dd *DropDataset) On(table interface{}) *DropDataset {
+	switch t := table.(type) {
+	case exp.Expression:
+		return dd.copy(dd.clauses.SetTable(t))
+	case string:
+		return dd.copy(dd.clauses.SetTable(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedDropTableType)
+	}
+}
+
+// IfExists adds IF EXISTS to the DROP statement.
+func (dd *DropDataset) IfExists() *DropDataset {
+	return dd.copy(dd.clauses.SetIfExists(true))
+}
+
+// Concurrently drops the index without locking out writes on the table (e.g. postgres DROP INDEX CONCURRENTLY).
+func (dd *DropDataset) Concurrently() *DropDataset {
+	return dd.copy(dd.clauses.SetConcurrently(true))
+}
+
+// Cascade adds a CASCADE clause.
+func (dd *DropDataset) Cascade() *DropDataset {
+	opts := dd.clauses.Options()
+	opts.Cascade = true
+	return dd.copy(dd.clauses.SetOptions(opts))
+}
+
+// NoCascade clears the CASCADE clause.
+func (dd *DropDataset) NoCascade() *DropDataset {
+	opts := dd.clauses.Options()
+	opts.Cascade = false
+	return dd.copy(dd.clauses.SetOptions(opts))
+}
+
+// Restrict adds a RESTRICT clause.
+func (dd *DropDataset) Restrict() *DropDataset {
+	opts := dd.clauses.Options()
+	opts.Restrict = true
+	return dd.copy(dd.clauses.SetOptions(opts))
+}
+
+// NoRestrict clears the RESTRICT clause.
+func (dd *DropDataset) NoRestrict() *DropDataset {
+	opts := dd.clauses.Options()
+	opts.Restrict = false
+	return dd.copy(dd.clauses.SetOptions(opts))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (dd *DropDataset) Error() error {
+	return dd.err
+}
+
+// SetError sets an error on the DropDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (dd *DropDataset) SetError(err error) *DropDataset {
+	if dd.err == nil {
+		dd.err = err
+	}
+
+	return dd
+}
+
+// ToSQL generates a DROP sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There are no names
+//   - The dialect does not support a feature of the DROP statement (e.g. CONCURRENTLY, CASCADE)
+//   - There is an error generating the SQL
+func (dd *DropDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return dd.dropSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (dd *DropDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = dd.dropSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the DROP sql, and returns an Exec struct with the sql set to the DROP statement.
+//
+// db.DropIndex("test_a_idx").Executor().Exec()
+func (dd *DropDataset) Executor() exec.QueryExecutor {
+	return dd.queryFactory.FromSQLBuilder(dd.dropSQLBuilder())
+}
+
+func (dd *DropDataset) dropSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if dd.err != nil {
+		return buf.SetError(dd.err)
+	}
+	dd.dialect.ToDropSQL(buf, dd.clauses)
+	return buf
+}
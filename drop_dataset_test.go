@@ -0,0 +1,303 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	dropTestCase struct {
+		ds      *goqu.DropDataset
+		clauses exp.DropClauses
+	}
+	dropDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (dds *dropDatasetSuite) assertCases(cases ...dropTestCase) {
+	for _, s := range cases {
+		dds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (dds *dropDatasetSuite) TestClone() {
+	ds := goqu.DropIndex("test_idx")
+	dds.Equal(ds, ds.Clone())
+}
+
+func (dds *dropDatasetSuite) TestExpression() {
+	ds := goqu.DropIndex("test_idx")
+	dds.Equal(ds, ds.Expression())
+}
+
+func (dds *dropDatasetSuite) TestDialect() {
+	ds := goqu.DropIndex("test_idx")
+	dds.NotNil(ds.Dialect())
+}
+
+func (dds *dropDatasetSuite) TestWithDialect() {
+	ds := goqu.DropIndex("test_idx")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	dds.Equal(md, ds.Dialect())
+	dds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (dds *dropDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.DropIndex("test_idx")
+	dds.False(ds.IsPrepared())
+}
+
+func (dds *dropDatasetSuite) TestGetClauses() {
+	ds := goqu.DropIndex("test_idx")
+	ce := exp.NewDropClauses().
+		SetObjectType(exp.IndexDropObject).
+		SetNames(exp.NewColumnListExpression("test_idx"))
+	dds.Equal(ce, ds.GetClauses())
+}
+
+func (dds *dropDatasetSuite) TestDropTable() {
+	ce := exp.NewDropClauses().SetObjectType(exp.TableDropObject)
+	dds.assertCases(
+		dropTestCase{
+			ds:      goqu.DropTable("test", goqu.S("s").Table("test2")),
+			clauses: ce.SetNames(exp.NewColumnListExpression("test", goqu.S("s").Table("test2"))),
+		},
+	)
+}
+
+func (dds *dropDatasetSuite) TestDropView() {
+	ce := exp.NewDropClauses().SetObjectType(exp.ViewDropObject)
+	dds.assertCases(
+		dropTestCase{
+			ds:      goqu.DropView("test", goqu.S("s").Table("test2")),
+			clauses: ce.SetNames(exp.NewColumnListExpression("test", goqu.S("s").Table("test2"))),
+		},
+	)
+}
+
+func (dds *dropDatasetSuite) TestDropMaterializedView() {
+	ce := exp.NewDropClauses().SetObjectType(exp.MaterializedViewDropObject)
+	dds.assertCases(
+		dropTestCase{
+			ds:      goqu.DropMaterializedView("test", goqu.S("s").Table("test2")),
+			clauses: ce.SetNames(exp.NewColumnListExpression("test", goqu.S("s").Table("test2"))),
+		},
+	)
+}
+
+func (dds *dropDatasetSuite) TestDropSequence() {
+	ce := exp.NewDropClauses().SetObjectType(exp.SequenceDropObject)
+	dds.assertCases(
+		dropTestCase{
+			ds:      goqu.DropSequence("test_seq", goqu.S("s").Table("test2_seq")),
+			clauses: ce.SetNames(exp.NewColumnListExpression("test_seq", goqu.S("s").Table("test2_seq"))),
+		},
+	)
+}
+
+func (dds *dropDatasetSuite) TestDropSchema() {
+	ce := exp.NewDropClauses().SetObjectType(exp.SchemaDropObject)
+	dds.assertCases(
+		dropTestCase{
+			ds:      goqu.DropSchema("tenant_1", "tenant_2"),
+			clauses: ce.SetNames(exp.NewColumnListExpression("tenant_1", "tenant_2")),
+		},
+	)
+}
+
+func (dds *dropDatasetSuite) TestDropDatabase() {
+	ce := exp.NewDropClauses().SetObjectType(exp.DatabaseDropObject)
+	dds.assertCases(
+		dropTestCase{ds: goqu.DropDatabase("tenant_1"), clauses: ce.SetNames(exp.NewColumnListExpression("tenant_1"))},
+	)
+}
+
+func (dds *dropDatasetSuite) TestDropTrigger() {
+	ce := exp.NewDropClauses().SetObjectType(exp.TriggerDropObject)
+	dds.assertCases(
+		dropTestCase{ds: goqu.DropTrigger("user_audit"), clauses: ce.SetNames(exp.NewColumnListExpression("user_audit"))},
+		dropTestCase{
+			ds:      goqu.DropTrigger("user_audit").On("user"),
+			clauses: ce.SetNames(exp.NewColumnListExpression("user_audit")).SetTable(goqu.I("user")),
+		},
+	)
+}
+
+func (dds *dropDatasetSuite) TestDropFunction() {
+	ce := exp.NewDropClauses().SetObjectType(exp.FunctionDropObject)
+	dds.assertCases(
+		dropTestCase{
+			ds:      goqu.DropFunction("user_audit_fn", "add"),
+			clauses: ce.SetNames(exp.NewColumnListExpression("user_audit_fn", "add")),
+		},
+	)
+}
+
+func (dds *dropDatasetSuite) TestDropIndex() {
+	ce := exp.NewDropClauses().SetObjectType(exp.IndexDropObject)
+	dds.assertCases(
+		dropTestCase{
+			ds:      goqu.DropIndex("test_idx", goqu.S("s").Table("test2_idx")),
+			clauses: ce.SetNames(exp.NewColumnListExpression("test_idx", goqu.S("s").Table("test2_idx"))),
+		},
+	)
+}
+
+func (dds *dropDatasetSuite) TestOn() {
+	bd := goqu.DropIndex("test_idx")
+	ce := bd.GetClauses()
+	dds.assertCases(
+		dropTestCase{ds: bd.On("test"), clauses: ce.SetTable(goqu.I("test"))},
+		dropTestCase{ds: bd.On(goqu.S("s").Table("test")), clauses: ce.SetTable(goqu.S("s").Table("test"))},
+		dropTestCase{ds: bd, clauses: ce},
+	)
+	dds.PanicsWithValue(goqu.ErrUnsupportedDropTableType, func() {
+		goqu.DropIndex("test_idx").On(true)
+	})
+}
+
+func (dds *dropDatasetSuite) TestOptions() {
+	bd := goqu.DropIndex("test_idx")
+	ce := bd.GetClauses()
+	dds.assertCases(
+		dropTestCase{ds: bd.IfExists(), clauses: ce.SetIfExists(true)},
+		dropTestCase{ds: bd.Concurrently(), clauses: ce.SetConcurrently(true)},
+		dropTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (dds *dropDatasetSuite) TestCascade() {
+	bd := goqu.DropTable("test")
+	ce := bd.GetClauses()
+	dds.assertCases(
+		dropTestCase{ds: bd.Cascade(), clauses: ce.SetOptions(exp.DropOptions{Cascade: true})},
+		dropTestCase{ds: bd.Cascade().NoCascade(), clauses: ce.SetOptions(exp.DropOptions{})},
+		dropTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (dds *dropDatasetSuite) TestRestrict() {
+	bd := goqu.DropTable("test")
+	ce := bd.GetClauses()
+	dds.assertCases(
+		dropTestCase{ds: bd.Restrict(), clauses: ce.SetOptions(exp.DropOptions{Restrict: true})},
+		dropTestCase{ds: bd.Restrict().NoRestrict(), clauses: ce.SetOptions(exp.DropOptions{})},
+		dropTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (dds *dropDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.DropIndex("test_idx").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToDropSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	dds.NoError(err)
+	dds.Empty(sql)
+	dds.Empty(args)
+	md.AssertExpectations(dds.T())
+}
+
+func (dds *dropDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.DropIndex("test_idx").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToDropSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	dds.Empty(sql)
+	dds.Empty(args)
+	dds.Equal(ee, err)
+	md.AssertExpectations(dds.T())
+}
+
+func (dds *dropDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	dds.NoError(err)
+
+	ds := goqu.New("mock", mDB).DropIndex("test_idx").IfExists()
+
+	asql, args, err := ds.Executor().ToSQL()
+	dds.NoError(err)
+	dds.Empty(args)
+	dds.Equal(`DROP INDEX IF EXISTS "test_idx"`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	dds.NoError(err)
+	dds.Empty(args)
+	dds.Equal(`DROP INDEX IF EXISTS "test_idx"`, asql)
+}
+
+func (dds *dropDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.DropIndex("test_idx").SetDialect(md)
+	ds = ds.SetError(err1)
+	dds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	dds.Empty(sql)
+	dds.Empty(args)
+	dds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	dds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	dds.Empty(sql)
+	dds.Empty(args)
+	dds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.IfExists()
+	dds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	dds.Empty(sql)
+	dds.Empty(args)
+	dds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToDropSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	dds.Empty(sql)
+	dds.Empty(args)
+	dds.Equal(err1, err)
+}
+
+func TestDropDataset(t *testing.T) {
+	suite.Run(t, new(dropDatasetSuite))
+}
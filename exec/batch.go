@@ -0,0 +1,65 @@
+package exec
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9/internal/errors"
+)
+
+type (
+	// Statement is implemented by anything that can generate SQL, such as datasets and QueryExecutor.
+	Statement interface {
+		ToSQL() (sql string, args []interface{}, err error)
+	}
+	// BatchQuery is a single statement in a batch.
+	BatchQuery struct {
+		SQL  string
+		Args []interface{}
+	}
+	// BatchResult is the result of a single statement in a batch.
+	BatchResult struct {
+		RowsAffected int64
+		Err          error
+	}
+	// BatchSender sends multiple queries to the database in a single round trip.
+	//
+	// This is typically implemented by a small adapter around pgx that queues each query in a pgx.Batch and sends
+	// them using SendBatch
+	//    type pgxBatchSender struct{ conn *pgx.Conn }
+	//
+	//    func (s pgxBatchSender) SendBatch(ctx context.Context, queries []exec.BatchQuery) ([]exec.BatchResult, error) {
+	//        b := &pgx.Batch{}
+	//        for _, q := range queries {
+	//            b.Queue(q.SQL, q.Args...)
+	//        }
+	//        br := s.conn.SendBatch(ctx, b)
+	//        results := make([]exec.BatchResult, 0, len(queries))
+	//        for range queries {
+	//            tag, err := br.Exec()
+	//            results = append(results, exec.BatchResult{RowsAffected: tag.RowsAffected(), Err: err})
+	//        }
+	//        return results, br.Close()
+	//    }
+	BatchSender interface {
+		SendBatch(ctx context.Context, queries []BatchQuery) ([]BatchResult, error)
+	}
+)
+
+var errEmptyBatch = errors.New("at least one query is required when sending a batch")
+
+// SendBatch generates the SQL for each Statement and sends them using the BatchSender in a single round trip.
+// If any Statement returns an error no queries are sent.
+func SendBatch(ctx context.Context, sender BatchSender, statements ...Statement) ([]BatchResult, error) {
+	if len(statements) == 0 {
+		return nil, errEmptyBatch
+	}
+	queries := make([]BatchQuery, 0, len(statements))
+	for _, s := range statements {
+		query, args, err := s.ToSQL()
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, BatchQuery{SQL: query, Args: args})
+	}
+	return sender.SendBatch(ctx, queries)
+}
@@ -0,0 +1,76 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	testBatchSender struct {
+		queries []exec.BatchQuery
+	}
+	testStatement struct {
+		sql  string
+		args []interface{}
+		err  error
+	}
+	batchSuite struct {
+		suite.Suite
+	}
+)
+
+func (tbs *testBatchSender) SendBatch(_ context.Context, queries []exec.BatchQuery) ([]exec.BatchResult, error) {
+	tbs.queries = queries
+	results := make([]exec.BatchResult, 0, len(queries))
+	for range queries {
+		results = append(results, exec.BatchResult{RowsAffected: 1})
+	}
+	return results, nil
+}
+
+func (ts testStatement) ToSQL() (sql string, args []interface{}, err error) {
+	return ts.sql, ts.args, ts.err
+}
+
+func TestBatchSuite(t *testing.T) {
+	suite.Run(t, new(batchSuite))
+}
+
+func (bs *batchSuite) TestSendBatch() {
+	sender := new(testBatchSender)
+	results, err := exec.SendBatch(
+		context.Background(),
+		sender,
+		testStatement{sql: `INSERT INTO "items" ("name") VALUES ($1)`, args: []interface{}{"a"}},
+		testStatement{sql: `DELETE FROM "items" WHERE ("id" = $1)`, args: []interface{}{1}},
+	)
+	bs.NoError(err)
+	bs.Equal([]exec.BatchResult{{RowsAffected: 1}, {RowsAffected: 1}}, results)
+	bs.Equal([]exec.BatchQuery{
+		{SQL: `INSERT INTO "items" ("name") VALUES ($1)`, Args: []interface{}{"a"}},
+		{SQL: `DELETE FROM "items" WHERE ("id" = $1)`, Args: []interface{}{1}},
+	}, sender.queries)
+}
+
+func (bs *batchSuite) TestSendBatch_withStatementError() {
+	sender := new(testBatchSender)
+	results, err := exec.SendBatch(
+		context.Background(),
+		sender,
+		testStatement{sql: `DELETE FROM "items"`},
+		testStatement{err: errors.New("statement error")},
+	)
+	bs.EqualError(err, "statement error")
+	bs.Nil(results)
+	bs.Nil(sender.queries)
+}
+
+func (bs *batchSuite) TestSendBatch_empty() {
+	results, err := exec.SendBatch(context.Background(), new(testBatchSender))
+	bs.EqualError(err, "goqu: at least one query is required when sending a batch")
+	bs.Nil(results)
+}
@@ -0,0 +1,99 @@
+package exec
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type (
+	// CopyFromer streams data to the database using a COPY ... FROM STDIN statement.
+	//
+	// This is typically implemented by a small adapter around pgx that delegates to the underlying pgconn
+	//    type pgxCopier struct{ conn *pgx.Conn }
+	//
+	//    func (c pgxCopier) CopyFrom(ctx context.Context, r io.Reader, sql string) (int64, error) {
+	//        tag, err := c.conn.PgConn().CopyFrom(ctx, r, sql)
+	//        return tag.RowsAffected(), err
+	//    }
+	CopyFromer interface {
+		CopyFrom(ctx context.Context, r io.Reader, sql string) (int64, error)
+	}
+	// CopyToer streams data from the database using a COPY ... TO STDOUT statement.
+	//
+	// This is typically implemented by a small adapter around pgx that delegates to the underlying pgconn
+	//    func (c pgxCopier) CopyTo(ctx context.Context, w io.Writer, sql string) (int64, error) {
+	//        tag, err := c.conn.PgConn().CopyTo(ctx, w, sql)
+	//        return tag.RowsAffected(), err
+	//    }
+	CopyToer interface {
+		CopyTo(ctx context.Context, w io.Writer, sql string) (int64, error)
+	}
+)
+
+var copyTextReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"\t", `\t`,
+	"\n", `\n`,
+	"\r", `\r`,
+)
+
+// EncodeCopyText writes the rows to w using the COPY text format, columns are tab separated, rows are newline
+// terminated and nil values are written as \N.
+func EncodeCopyText(w io.Writer, rows [][]interface{}) error {
+	var sb strings.Builder
+	for _, row := range rows {
+		sb.Reset()
+		for i, v := range row {
+			if i > 0 {
+				sb.WriteByte('\t')
+			}
+			if err := encodeCopyTextValue(&sb, v); err != nil {
+				return err
+			}
+		}
+		sb.WriteByte('\n')
+		if _, err := io.WriteString(w, sb.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodeCopyTextValue(sb *strings.Builder, v interface{}) error {
+	switch t := v.(type) {
+	case nil:
+		sb.WriteString(`\N`)
+	case driver.Valuer:
+		dv, err := t.Value()
+		if err != nil {
+			return err
+		}
+		return encodeCopyTextValue(sb, dv)
+	case string:
+		sb.WriteString(copyTextReplacer.Replace(t))
+	case []byte:
+		if t == nil {
+			sb.WriteString(`\N`)
+			return nil
+		}
+		// bytea values use the hex format, the leading backslash must be escaped in the text format
+		sb.WriteString(`\\x`)
+		sb.WriteString(hex.EncodeToString(t))
+	case bool:
+		if t {
+			sb.WriteByte('t')
+		} else {
+			sb.WriteByte('f')
+		}
+	case time.Time:
+		sb.WriteString(t.Format(time.RFC3339Nano))
+	default:
+		sb.WriteString(copyTextReplacer.Replace(fmt.Sprint(t)))
+	}
+	return nil
+}
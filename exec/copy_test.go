@@ -0,0 +1,76 @@
+package exec_test
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	testCopyValuer struct {
+		val driver.Value
+		err error
+	}
+	errWriter struct {
+		err error
+	}
+	copySuite struct {
+		suite.Suite
+	}
+)
+
+func (tcv testCopyValuer) Value() (driver.Value, error) {
+	return tcv.val, tcv.err
+}
+
+func (ew errWriter) Write(_ []byte) (int, error) {
+	return 0, ew.err
+}
+
+func TestCopySuite(t *testing.T) {
+	suite.Run(t, new(copySuite))
+}
+
+func (cs *copySuite) TestEncodeCopyText() {
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	var buf bytes.Buffer
+	err := exec.EncodeCopyText(&buf, [][]interface{}{
+		{1, "a", true, 1.5},
+		{nil, "tab\there", false, ts},
+		{int64(2), "line\nbreak\r\\", []byte{0xde, 0xad}, testCopyValuer{val: "v"}},
+		{3, "", []byte(nil), testCopyValuer{}},
+	})
+	cs.NoError(err)
+	cs.Equal(
+		"1\ta\tt\t1.5\n"+
+			"\\N\ttab\\there\tf\t2020-01-02T03:04:05Z\n"+
+			"2\tline\\nbreak\\r\\\\\t\\\\xdead\tv\n"+
+			"3\t\t\\N\t\\N\n",
+		buf.String(),
+	)
+}
+
+func (cs *copySuite) TestEncodeCopyText_Empty() {
+	var buf bytes.Buffer
+	cs.NoError(exec.EncodeCopyText(&buf, nil))
+	cs.Empty(buf.String())
+}
+
+func (cs *copySuite) TestEncodeCopyText_ValuerError() {
+	var buf bytes.Buffer
+	expectedErr := errors.New("valuer error")
+	err := exec.EncodeCopyText(&buf, [][]interface{}{{1}, {testCopyValuer{err: expectedErr}}})
+	cs.Equal(expectedErr, err)
+	cs.Equal("1\n", buf.String())
+}
+
+func (cs *copySuite) TestEncodeCopyText_WriteError() {
+	expectedErr := errors.New("write error")
+	err := exec.EncodeCopyText(errWriter{err: expectedErr}, [][]interface{}{{1}})
+	cs.Equal(expectedErr, err)
+}
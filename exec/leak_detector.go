@@ -0,0 +1,80 @@
+package exec
+
+import (
+	gsql "database/sql"
+	"log"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+type (
+	// RowsLeak contains information about a *sql.Rows that was not closed within the configured window.
+	RowsLeak struct {
+		// The SQL that produced the rows
+		Query string
+		// The arguments used with the SQL
+		Args []interface{}
+		// The stack of the go routine that opened the rows
+		Stack []byte
+		// When the rows were opened
+		OpenedAt time.Time
+	}
+	// RowsLeakReporter is called for each *sql.Rows leak that is detected
+	RowsLeakReporter func(leak RowsLeak)
+
+	rowsLeakDetectorState struct {
+		mu       sync.RWMutex
+		window   time.Duration
+		reporter RowsLeakReporter
+	}
+)
+
+var rowsLeakDetector = &rowsLeakDetectorState{}
+
+// SetRowsLeakDetection enables leak detection for all *sql.Rows produced by a QueryExecutor. Any rows that are not
+// closed within the window are passed to the reporter along with the SQL and stack that opened them. If the reporter is
+// nil then leaks are written using the standard log package. A window <= 0 disables leak detection (DEFAULT).
+//
+// Only *sql.Rows are tracked. Statement handles are out of scope: the exec layer does not create them (prepared
+// datasets pass their arguments to QueryContext) and a *sql.Stmt returned by Database.Prepare cannot be checked without
+// executing it. Transactions from Database.Begin are not tracked either.
+//
+// NOTE: This is intended for development and testing, a stack is captured for every query while it is enabled.
+func SetRowsLeakDetection(window time.Duration, reporter RowsLeakReporter) {
+	rowsLeakDetector.mu.Lock()
+	defer rowsLeakDetector.mu.Unlock()
+	if reporter == nil {
+		reporter = logRowsLeak
+	}
+	rowsLeakDetector.window = window
+	rowsLeakDetector.reporter = reporter
+}
+
+func (ld *rowsLeakDetectorState) track(rows *gsql.Rows, query string, args []interface{}) {
+	ld.mu.RLock()
+	window, reporter := ld.window, ld.reporter
+	ld.mu.RUnlock()
+	if window <= 0 || rows == nil {
+		return
+	}
+	leak := RowsLeak{Query: query, Args: args, Stack: debug.Stack(), OpenedAt: time.Now()}
+	time.AfterFunc(window, func() {
+		if isRowsOpen(rows) {
+			reporter(leak)
+		}
+	})
+}
+
+// Columns returns an error once the rows have been closed.
+func isRowsOpen(rows *gsql.Rows) bool {
+	_, err := rows.Columns()
+	return err == nil
+}
+
+func logRowsLeak(leak RowsLeak) {
+	log.Printf(
+		"[goqu] rows not closed after %s [query:=`%s` args:=%+v]\n%s",
+		time.Since(leak.OpenedAt), leak.Query, leak.Args, leak.Stack,
+	)
+}
@@ -0,0 +1,93 @@
+package exec
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type leakDetectorSuite struct {
+	suite.Suite
+}
+
+func TestLeakDetectorSuite(t *testing.T) {
+	suite.Run(t, new(leakDetectorSuite))
+}
+
+func (lds *leakDetectorSuite) TearDownTest() {
+	SetRowsLeakDetection(0, nil)
+}
+
+func (lds *leakDetectorSuite) TestQueryContext_reportsLeak() {
+	db, mock, err := sqlmock.New()
+	lds.Require().NoError(err)
+	mock.ExpectQuery(`SELECT "id" FROM "items" WHERE "id" = ?`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	leaks := make(chan RowsLeak, 1)
+	SetRowsLeakDetection(time.Millisecond, func(leak RowsLeak) { leaks <- leak })
+
+	e := newQueryExecutor(db, nil, `SELECT "id" FROM "items" WHERE "id" = ?`, 1)
+	rows, err := e.QueryContext(context.Background())
+	lds.Require().NoError(err)
+	defer func() { _ = rows.Close() }()
+
+	select {
+	case leak := <-leaks:
+		lds.Equal(`SELECT "id" FROM "items" WHERE "id" = ?`, leak.Query)
+		lds.Equal([]interface{}{1}, leak.Args)
+		lds.Contains(string(leak.Stack), "TestQueryContext_reportsLeak")
+		lds.False(leak.OpenedAt.IsZero())
+	case <-time.After(time.Second):
+		lds.Fail("expected leak to be reported")
+	}
+}
+
+func (lds *leakDetectorSuite) TestQueryContext_closedRows() {
+	db, mock, err := sqlmock.New()
+	lds.Require().NoError(err)
+	mock.ExpectQuery(`SELECT "id" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	leaks := make(chan RowsLeak, 1)
+	SetRowsLeakDetection(10*time.Millisecond, func(leak RowsLeak) { leaks <- leak })
+
+	e := newQueryExecutor(db, nil, `SELECT "id" FROM "items"`)
+	var ids []int64
+	lds.NoError(e.ScanVals(&ids))
+	lds.Equal([]int64{1}, ids)
+
+	select {
+	case leak := <-leaks:
+		lds.Failf("unexpected leak reported", "%+v", leak)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func (lds *leakDetectorSuite) TestQueryContext_disabled() {
+	db, mock, err := sqlmock.New()
+	lds.Require().NoError(err)
+	mock.ExpectQuery(`SELECT "id" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	leaks := make(chan RowsLeak, 1)
+	SetRowsLeakDetection(time.Millisecond, func(leak RowsLeak) { leaks <- leak })
+	SetRowsLeakDetection(0, func(leak RowsLeak) { leaks <- leak })
+
+	e := newQueryExecutor(db, nil, `SELECT "id" FROM "items"`)
+	rows, err := e.QueryContext(context.Background())
+	lds.Require().NoError(err)
+	defer func() { _ = rows.Close() }()
+
+	select {
+	case leak := <-leaks:
+		lds.Failf("unexpected leak reported", "%+v", leak)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
@@ -0,0 +1,35 @@
+package exec
+
+import "context"
+
+type (
+	// Notification is a message sent to a channel using NOTIFY or pg_notify.
+	Notification struct {
+		// The process id of the session that sent the notification
+		PID     uint32
+		Channel string
+		Payload string
+	}
+	// Listener executes LISTEN/UNLISTEN statements on a dedicated connection and waits for notifications sent to the
+	// channels the connection is listening on.
+	//
+	// This is typically implemented by a small adapter around a pgx connection
+	//    type pgxListener struct{ conn *pgx.Conn }
+	//
+	//    func (l pgxListener) Exec(ctx context.Context, sql string) error {
+	//        _, err := l.conn.Exec(ctx, sql)
+	//        return err
+	//    }
+	//
+	//    func (l pgxListener) WaitForNotification(ctx context.Context) (*exec.Notification, error) {
+	//        n, err := l.conn.WaitForNotification(ctx)
+	//        if err != nil {
+	//            return nil, err
+	//        }
+	//        return &exec.Notification{PID: n.PID, Channel: n.Channel, Payload: n.Payload}, nil
+	//    }
+	Listener interface {
+		Exec(ctx context.Context, sql string) error
+		WaitForNotification(ctx context.Context) (*Notification, error)
+	}
+)
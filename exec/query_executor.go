@@ -19,6 +19,10 @@ type (
 )
 
 var (
+	// ErrNotFound is returned from ScanStruct and ScanVal when no rows were found and SetNotFoundError(true) has been
+	// called.
+	ErrNotFound = errors.New("no rows found")
+
 	errUnsupportedScanStructType  = errors.New("type must be a pointer to a struct when scanning into a struct")
 	errUnsupportedScanStructsType = errors.New("type must be a pointer to a slice when scanning into structs")
 	errUnsupportedScanValsType    = errors.New("type must be a pointer to a slice when scanning into vals")
@@ -26,6 +30,15 @@ var (
 	errScanValNonSlice            = errors.New("type cannot be a pointer to a slice when scanning into val")
 )
 
+// returnNotFoundError controls whether ScanStruct and ScanVal return ErrNotFound when no rows are found
+var returnNotFoundError = false
+
+// SetNotFoundError sets the behavior of ScanStruct and ScanVal when no rows are found. By default (false) they return
+// found=false with a nil error, if set to true they return found=false with ErrNotFound.
+func SetNotFoundError(enabled bool) {
+	returnNotFoundError = enabled
+}
+
 func newQueryExecutor(de DbExecutor, err error, query string, args ...interface{}) QueryExecutor {
 	return QueryExecutor{de: de, err: err, query: query, args: args}
 }
@@ -53,7 +66,12 @@ func (q QueryExecutor) QueryContext(ctx context.Context) (*gsql.Rows, error) {
 	if q.err != nil {
 		return nil, q.err
 	}
-	return q.de.QueryContext(ctx, q.query, q.args...)
+	rows, err := q.de.QueryContext(ctx, q.query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	rowsLeakDetector.track(rows, q.query, q.args)
+	return rows, nil
 }
 
 // This will execute the SQL and append results to the slice
@@ -141,7 +159,7 @@ func (q QueryExecutor) ScanStructContext(ctx context.Context, i interface{}) (bo
 		return true, scanner.Err()
 	}
 
-	return false, scanner.Err()
+	return false, notFound(scanner)
 }
 
 // This will execute the SQL and append results to the slice.
@@ -229,7 +247,7 @@ func (q QueryExecutor) ScanValContext(ctx context.Context, i interface{}) (bool,
 		return true, scanner.Err()
 	}
 
-	return false, scanner.Err()
+	return false, notFound(scanner)
 }
 
 // Scanner will return a Scanner that can be used for manually scanning rows.
@@ -245,3 +263,13 @@ func (q QueryExecutor) ScannerContext(ctx context.Context) (Scanner, error) {
 	}
 	return NewScanner(rows), nil
 }
+
+func notFound(rows interface{ Err() error }) error {
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if returnNotFoundError {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -1242,6 +1242,71 @@ func (qes *queryExecutorSuite) TestScanVal_withValuerSlice() {
 	qes.Equal(JSONBoolArray{true, false, true}, bools)
 }
 
+func (qes *queryExecutorSuite) TestScanStruct_withNotFoundError() {
+	defer SetNotFoundError(false)
+	type StructWithTags struct {
+		Address string `db:"address"`
+		Name    string `db:"name"`
+	}
+
+	db, mock, err := sqlmock.New()
+	qes.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}))
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).AddRow(testAddr1, testName1))
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).RowError(0, fmt.Errorf("row error")).
+			AddRow(testAddr1, testName1))
+
+	SetNotFoundError(true)
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	var item StructWithTags
+	found, err := e.ScanStruct(&item)
+	qes.Equal(ErrNotFound, err)
+	qes.False(found)
+
+	found, err = e.ScanStruct(&item)
+	qes.NoError(err)
+	qes.True(found)
+	qes.Equal(StructWithTags{Address: testAddr1, Name: testName1}, item)
+
+	found, err = e.ScanStruct(&item)
+	qes.EqualError(err, "row error")
+	qes.False(found)
+}
+
+func (qes *queryExecutorSuite) TestScanVal_withNotFoundError() {
+	defer SetNotFoundError(false)
+	db, mock, err := sqlmock.New()
+	qes.NoError(err)
+
+	mock.ExpectQuery(`SELECT "id" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`SELECT "id" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	e := newQueryExecutor(db, nil, `SELECT "id" FROM "items"`)
+
+	var id int64
+	found, err := e.ScanVal(&id)
+	qes.NoError(err)
+	qes.False(found)
+
+	SetNotFoundError(true)
+	found, err = e.ScanVal(&id)
+	qes.Equal(ErrNotFound, err)
+	qes.EqualError(err, "goqu: no rows found")
+	qes.False(found)
+}
+
 func TestQueryExecutorSuite(t *testing.T) {
 	suite.Run(t, new(queryExecutorSuite))
 }
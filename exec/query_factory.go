@@ -31,6 +31,6 @@ func (qs *querySupport) FromSQL(query string, args ...interface{}) QueryExecutor
 }
 
 func (qs *querySupport) FromSQLBuilder(b sb.SQLBuilder) QueryExecutor {
-	query, args, err := b.ToSQL()
+	query, args, err := b.ToNamedSQL()
 	return newQueryExecutor(qs.de, err, query, args...)
 }
@@ -0,0 +1,69 @@
+package exec
+
+import (
+	"context"
+)
+
+// ScanVal executes the query and scans the first column of the first row into a value of type T.
+// This method returns a boolean value that is false if no record was found
+//    count, _, err := exec.ScanVal[int64](db.From("test").Select(goqu.COUNT("*")).Executor())
+//    if err != nil{
+//        panic(err.Error()
+//    }
+func ScanVal[T any](q QueryExecutor) (T, bool, error) {
+	return ScanValContext[T](context.Background(), q)
+}
+
+// ScanValContext executes the query and scans the first column of the first row into a value of type T.
+// This method returns a boolean value that is false if no record was found
+//    count, _, err := exec.ScanValContext[int64](ctx, db.From("test").Select(goqu.COUNT("*")).Executor())
+//    if err != nil{
+//        panic(err.Error()
+//    }
+func ScanValContext[T any](ctx context.Context, q QueryExecutor) (val T, found bool, err error) {
+	rows, err := q.QueryContext(ctx)
+	if err != nil {
+		return val, false, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	if rows.Next() {
+		if err = rows.Scan(&val); err != nil {
+			return val, false, err
+		}
+		return val, true, rows.Err()
+	}
+	return val, false, notFound(rows)
+}
+
+// ScanVals executes the query and scans the first column of every row into a slice of T.
+//    ids, err := exec.ScanVals[int64](db.From("test").Select("id").Executor())
+//    if err != nil{
+//        panic(err.Error()
+//    }
+func ScanVals[T any](q QueryExecutor) ([]T, error) {
+	return ScanValsContext[T](context.Background(), q)
+}
+
+// ScanValsContext executes the query and scans the first column of every row into a slice of T.
+//    ids, err := exec.ScanValsContext[int64](ctx, db.From("test").Select("id").Executor())
+//    if err != nil{
+//        panic(err.Error()
+//    }
+func ScanValsContext[T any](ctx context.Context, q QueryExecutor) ([]T, error) {
+	rows, err := q.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var vals []T
+	for rows.Next() {
+		var val T
+		if err := rows.Scan(&val); err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	return vals, rows.Err()
+}
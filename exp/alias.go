@@ -6,6 +6,7 @@ type (
 	aliasExpression struct {
 		aliased Expression
 		alias   IdentifierExpression
+		columns ColumnListExpression
 	}
 )
 
@@ -22,7 +23,7 @@ func NewAliasExpression(exp Expression, alias interface{}) AliasedExpression {
 }
 
 func (ae aliasExpression) Clone() Expression {
-	return NewAliasExpression(ae.aliased, ae.alias.Clone())
+	return aliasExpression{aliased: ae.aliased, alias: ae.alias.Clone().(IdentifierExpression), columns: ae.columns}
 }
 
 func (ae aliasExpression) Expression() Expression {
@@ -37,6 +38,21 @@ func (ae aliasExpression) GetAs() IdentifierExpression {
 	return ae.alias
 }
 
+// Returns a new AliasedExpression that renames the columns of the aliased expression
+//   Values([]interface{}{1, "a"}).As("v").Columns("id", "name") -> (VALUES (1, 'a')) AS "v"("id", "name")
+func (ae aliasExpression) Columns(cols ...interface{}) AliasedExpression {
+	ret := ae
+	ret.columns = nil
+	if len(cols) > 0 {
+		ret.columns = NewColumnListExpression(cols...)
+	}
+	return ret
+}
+
+func (ae aliasExpression) GetAsColumns() ColumnListExpression {
+	return ae.columns
+}
+
 // Returns a new IdentifierExpression with the specified schema
 func (ae aliasExpression) Schema(schema string) IdentifierExpression {
 	return ae.alias.Schema(schema)
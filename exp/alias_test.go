@@ -36,6 +36,18 @@ func (aes *aliasExpressionSuite) TestGetAs() {
 	aes.Equal(exp.NewIdentifierExpression("", "", "c"), ae.GetAs())
 }
 
+func (aes *aliasExpressionSuite) TestColumns() {
+	ae := exp.NewAliasExpression(exp.NewIdentifierExpression("", "", "col"), "c")
+	aes.Nil(ae.GetAsColumns())
+
+	aeCols := ae.Columns("a", "b")
+	aes.Equal(exp.NewColumnListExpression("a", "b"), aeCols.GetAsColumns())
+	aes.Equal(ae.GetAs(), aeCols.GetAs())
+	aes.Equal(aeCols, aeCols.Clone())
+	aes.Nil(aeCols.Columns().GetAsColumns())
+	aes.Nil(ae.GetAsColumns())
+}
+
 func (aes *aliasExpressionSuite) TestSchema() {
 	si := exp.NewAliasExpression(
 		exp.NewIdentifierExpression("", "t", nil),
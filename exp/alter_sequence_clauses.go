@@ -0,0 +1,84 @@
+package exp
+
+type (
+	AlterSequenceClauses interface {
+		HasSequence() bool
+		clone() *alterSequenceClauses
+
+		Sequence() Expression
+		SetSequence(sequence Expression) AlterSequenceClauses
+
+		IsIfExists() bool
+		SetIfExists(ifExists bool) AlterSequenceClauses
+
+		Options() SequenceOptions
+		SetOptions(opts SequenceOptions) AlterSequenceClauses
+
+		// The value the sequence is restarted with (e.g. RESTART WITH 1), nil if the sequence is not restarted
+		RestartWith() *int64
+		SetRestartWith(restartWith *int64) AlterSequenceClauses
+	}
+	alterSequenceClauses struct {
+		sequence    Expression
+		ifExists    bool
+		options     SequenceOptions
+		restartWith *int64
+	}
+)
+
+func NewAlterSequenceClauses() AlterSequenceClauses {
+	return &alterSequenceClauses{}
+}
+
+func (asc *alterSequenceClauses) HasSequence() bool {
+	return asc.sequence != nil
+}
+
+func (asc *alterSequenceClauses) clone() *alterSequenceClauses {
+	return &alterSequenceClauses{
+		sequence:    asc.sequence,
+		ifExists:    asc.ifExists,
+		options:     asc.options,
+		restartWith: asc.restartWith,
+	}
+}
+
+func (asc *alterSequenceClauses) Sequence() Expression {
+	return asc.sequence
+}
+
+func (asc *alterSequenceClauses) SetSequence(sequence Expression) AlterSequenceClauses {
+	ret := asc.clone()
+	ret.sequence = sequence
+	return ret
+}
+
+func (asc *alterSequenceClauses) IsIfExists() bool {
+	return asc.ifExists
+}
+
+func (asc *alterSequenceClauses) SetIfExists(ifExists bool) AlterSequenceClauses {
+	ret := asc.clone()
+	ret.ifExists = ifExists
+	return ret
+}
+
+func (asc *alterSequenceClauses) Options() SequenceOptions {
+	return asc.options
+}
+
+func (asc *alterSequenceClauses) SetOptions(opts SequenceOptions) AlterSequenceClauses {
+	ret := asc.clone()
+	ret.options = opts
+	return ret
+}
+
+func (asc *alterSequenceClauses) RestartWith() *int64 {
+	return asc.restartWith
+}
+
+func (asc *alterSequenceClauses) SetRestartWith(restartWith *int64) AlterSequenceClauses {
+	ret := asc.clone()
+	ret.restartWith = restartWith
+	return ret
+}
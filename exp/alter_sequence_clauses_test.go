@@ -0,0 +1,65 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type alterSequenceClausesSuite struct {
+	suite.Suite
+}
+
+func TestAlterSequenceClausesSuite(t *testing.T) {
+	suite.Run(t, new(alterSequenceClausesSuite))
+}
+
+func (ascs *alterSequenceClausesSuite) TestHasSequence() {
+	c := exp.NewAlterSequenceClauses()
+	c2 := c.SetSequence(exp.NewIdentifierExpression("", "test", ""))
+
+	ascs.False(c.HasSequence())
+
+	ascs.True(c2.HasSequence())
+}
+
+func (ascs *alterSequenceClausesSuite) TestSetSequence() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewAlterSequenceClauses().SetSequence(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetSequence(ti2)
+
+	ascs.Equal(ti, c.Sequence())
+
+	ascs.Equal(ti2, c2.Sequence())
+}
+
+func (ascs *alterSequenceClausesSuite) TestSetIfExists() {
+	c := exp.NewAlterSequenceClauses()
+	c2 := c.SetIfExists(true)
+
+	ascs.False(c.IsIfExists())
+
+	ascs.True(c2.IsIfExists())
+}
+
+func (ascs *alterSequenceClausesSuite) TestSetOptions() {
+	maxVal := int64(100)
+	c := exp.NewAlterSequenceClauses()
+	c2 := c.SetOptions(exp.SequenceOptions{MaxValue: &maxVal, NoCycle: true})
+
+	ascs.Equal(exp.SequenceOptions{}, c.Options())
+
+	ascs.Equal(exp.SequenceOptions{MaxValue: &maxVal, NoCycle: true}, c2.Options())
+}
+
+func (ascs *alterSequenceClausesSuite) TestSetRestartWith() {
+	restart := int64(1)
+	c := exp.NewAlterSequenceClauses()
+	c2 := c.SetRestartWith(&restart)
+
+	ascs.Nil(c.RestartWith())
+
+	ascs.Equal(&restart, c2.RestartWith())
+}
@@ -0,0 +1,208 @@
+package exp
+
+import "fmt"
+
+type (
+	// The type of an action in an ALTER TABLE statement
+	AlterTableActionType int
+
+	// An action in an ALTER TABLE statement (e.g. ADD COLUMN, RENAME TO)
+	AlterTableAction interface {
+		Expression
+		// The type of the action
+		ActionType() AlterTableActionType
+		// The column to add for an AddColumnAction or the new definition of the column for a ModifyColumnAction
+		ColumnDefinition() ColumnDefinition
+		// The name of the column the action applies to
+		Name() string
+		// The new name of the column or table for a RenameColumnAction or RenameTableAction
+		NewName() string
+		// The new type of the column for an AlterColumnTypeAction
+		DataType() DataType
+		// The new default of the column for a SetColumnDefaultAction
+		Value() interface{}
+		// The constraint to add for an AddConstraintAction
+		Constraint() TableConstraint
+		// The partitions to attach, detach, add or drop for a partition action (e.g. AttachPartitionAction)
+		Partitions() []PartitionDefinition
+	}
+	alterTableAction struct {
+		actionType AlterTableActionType
+		column     ColumnDefinition
+		name       string
+		newName    string
+		dataType   DataType
+		value      interface{}
+		constraint TableConstraint
+		partitions []PartitionDefinition
+	}
+)
+
+const (
+	AddColumnAction AlterTableActionType = iota
+	DropColumnAction
+	RenameColumnAction
+	AlterColumnTypeAction
+	SetColumnDefaultAction
+	DropColumnDefaultAction
+	SetColumnNotNullAction
+	DropColumnNotNullAction
+	AddConstraintAction
+	RenameTableAction
+	ModifyColumnAction
+	AttachPartitionAction
+	DetachPartitionAction
+	AddPartitionAction
+	DropPartitionAction
+)
+
+func (t AlterTableActionType) String() string {
+	switch t {
+	case AddColumnAction:
+		return "ADD COLUMN"
+	case DropColumnAction:
+		return "DROP COLUMN"
+	case RenameColumnAction:
+		return "RENAME COLUMN"
+	case AlterColumnTypeAction:
+		return "ALTER COLUMN TYPE"
+	case SetColumnDefaultAction:
+		return "SET DEFAULT"
+	case DropColumnDefaultAction:
+		return "DROP DEFAULT"
+	case SetColumnNotNullAction:
+		return "SET NOT NULL"
+	case DropColumnNotNullAction:
+		return "DROP NOT NULL"
+	case AddConstraintAction:
+		return "ADD CONSTRAINT"
+	case RenameTableAction:
+		return "RENAME TO"
+	case ModifyColumnAction:
+		return "MODIFY COLUMN"
+	case AttachPartitionAction:
+		return "ATTACH PARTITION"
+	case DetachPartitionAction:
+		return "DETACH PARTITION"
+	case AddPartitionAction:
+		return "ADD PARTITION"
+	case DropPartitionAction:
+		return "DROP PARTITION"
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+// Creates an action to add a column
+//    NewAddColumnAction(NewColumnDefinition("a", NewDataType(IntegerDataType))) // ADD COLUMN "a" INTEGER
+func NewAddColumnAction(cd ColumnDefinition) AlterTableAction {
+	return alterTableAction{actionType: AddColumnAction, column: cd, name: cd.Name()}
+}
+
+// Creates an action to replace the definition of a column
+//    NewModifyColumnAction(NewColumnDefinition("a", NewDataType(IntegerDataType))) // mysql: MODIFY COLUMN `a` INT
+func NewModifyColumnAction(cd ColumnDefinition) AlterTableAction {
+	return alterTableAction{actionType: ModifyColumnAction, column: cd, name: cd.Name()}
+}
+
+// Creates an action to drop a column
+//    NewDropColumnAction("a") // DROP COLUMN "a"
+func NewDropColumnAction(name string) AlterTableAction {
+	return alterTableAction{actionType: DropColumnAction, name: name}
+}
+
+// Creates an action to rename a column
+//    NewRenameColumnAction("a", "b") // RENAME COLUMN "a" TO "b"
+func NewRenameColumnAction(name, newName string) AlterTableAction {
+	return alterTableAction{actionType: RenameColumnAction, name: name, newName: newName}
+}
+
+// Creates an action to change the type of a column
+//    NewAlterColumnTypeAction("a", NewDataType(BigIntDataType)) // ALTER COLUMN "a" TYPE BIGINT
+func NewAlterColumnTypeAction(name string, dt DataType) AlterTableAction {
+	return alterTableAction{actionType: AlterColumnTypeAction, name: name, dataType: dt}
+}
+
+// Creates an action to set the default of a column
+//    NewSetColumnDefaultAction("a", 1) // ALTER COLUMN "a" SET DEFAULT 1
+func NewSetColumnDefaultAction(name string, val interface{}) AlterTableAction {
+	return alterTableAction{actionType: SetColumnDefaultAction, name: name, value: val}
+}
+
+// Creates an action to drop the default of a column
+//    NewDropColumnDefaultAction("a") // ALTER COLUMN "a" DROP DEFAULT
+func NewDropColumnDefaultAction(name string) AlterTableAction {
+	return alterTableAction{actionType: DropColumnDefaultAction, name: name}
+}
+
+// Creates an action to add NOT NULL to a column
+//    NewSetColumnNotNullAction("a") // ALTER COLUMN "a" SET NOT NULL
+func NewSetColumnNotNullAction(name string) AlterTableAction {
+	return alterTableAction{actionType: SetColumnNotNullAction, name: name}
+}
+
+// Creates an action to drop NOT NULL from a column
+//    NewDropColumnNotNullAction("a") // ALTER COLUMN "a" DROP NOT NULL
+func NewDropColumnNotNullAction(name string) AlterTableAction {
+	return alterTableAction{actionType: DropColumnNotNullAction, name: name}
+}
+
+// Creates an action to add a table constraint
+//    NewAddConstraintAction(NewUniqueConstraint("a")) // ADD UNIQUE ("a")
+func NewAddConstraintAction(tc TableConstraint) AlterTableAction {
+	return alterTableAction{actionType: AddConstraintAction, constraint: tc}
+}
+
+// Creates an action to rename the table
+//    NewRenameTableAction("b") // RENAME TO "b"
+func NewRenameTableAction(newName string) AlterTableAction {
+	return alterTableAction{actionType: RenameTableAction, newName: newName}
+}
+
+// Creates an action to attach a table as a partition
+//    NewAttachPartitionAction(ParseIdentifier("b"), NewListPartitionBound(1)) // ATTACH PARTITION "b" FOR VALUES IN (1)
+func NewAttachPartitionAction(table IdentifierExpression, bound PartitionBound) AlterTableAction {
+	return alterTableAction{
+		actionType: AttachPartitionAction,
+		partitions: []PartitionDefinition{NewPartitionDefinition(table, bound)},
+	}
+}
+
+// Creates an action to detach a partition, the partition becomes a standalone table
+//    NewDetachPartitionAction(ParseIdentifier("b")) // DETACH PARTITION "b"
+func NewDetachPartitionAction(table IdentifierExpression) AlterTableAction {
+	return alterTableAction{
+		actionType: DetachPartitionAction,
+		partitions: []PartitionDefinition{NewPartitionDefinition(table, nil)},
+	}
+}
+
+// Creates an action to add partitions to a table that defines its partitions (e.g. mysql)
+//    NewAddPartitionAction(NewPartitionDefinition(ParseIdentifier("p1"), NewLessThanPartitionBound(20)))
+//    // ADD PARTITION (PARTITION `p1` VALUES LESS THAN (20))
+func NewAddPartitionAction(partitions ...PartitionDefinition) AlterTableAction {
+	return alterTableAction{actionType: AddPartitionAction, partitions: partitions}
+}
+
+// Creates an action to drop partitions and their data from a table that defines its partitions (e.g. mysql)
+//    NewDropPartitionAction(ParseIdentifier("p0"), ParseIdentifier("p1")) // DROP PARTITION `p0`, `p1`
+func NewDropPartitionAction(names ...IdentifierExpression) AlterTableAction {
+	partitions := make([]PartitionDefinition, 0, len(names))
+	for _, name := range names {
+		partitions = append(partitions, NewPartitionDefinition(name, nil))
+	}
+	return alterTableAction{actionType: DropPartitionAction, partitions: partitions}
+}
+
+func (ata alterTableAction) Clone() Expression {
+	return ata
+}
+
+func (ata alterTableAction) Expression() Expression             { return ata }
+func (ata alterTableAction) ActionType() AlterTableActionType   { return ata.actionType }
+func (ata alterTableAction) ColumnDefinition() ColumnDefinition { return ata.column }
+func (ata alterTableAction) Name() string                       { return ata.name }
+func (ata alterTableAction) NewName() string                    { return ata.newName }
+func (ata alterTableAction) DataType() DataType                 { return ata.dataType }
+func (ata alterTableAction) Value() interface{}                 { return ata.value }
+func (ata alterTableAction) Constraint() TableConstraint        { return ata.constraint }
+func (ata alterTableAction) Partitions() []PartitionDefinition  { return ata.partitions }
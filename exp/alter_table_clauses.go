@@ -0,0 +1,53 @@
+package exp
+
+type (
+	AlterTableClauses interface {
+		HasTable() bool
+		clone() *alterTableClauses
+
+		Table() Expression
+		SetTable(table Expression) AlterTableClauses
+
+		Actions() []AlterTableAction
+		ActionsAppend(actions ...AlterTableAction) AlterTableClauses
+	}
+	alterTableClauses struct {
+		table   Expression
+		actions []AlterTableAction
+	}
+)
+
+func NewAlterTableClauses() AlterTableClauses {
+	return &alterTableClauses{}
+}
+
+func (atc *alterTableClauses) HasTable() bool {
+	return atc.table != nil
+}
+
+func (atc *alterTableClauses) clone() *alterTableClauses {
+	return &alterTableClauses{
+		table:   atc.table,
+		actions: atc.actions[0:len(atc.actions):len(atc.actions)],
+	}
+}
+
+func (atc *alterTableClauses) Table() Expression {
+	return atc.table
+}
+
+func (atc *alterTableClauses) SetTable(table Expression) AlterTableClauses {
+	ret := atc.clone()
+	ret.table = table
+	return ret
+}
+
+func (atc *alterTableClauses) Actions() []AlterTableAction {
+	return atc.actions
+}
+
+func (atc *alterTableClauses) ActionsAppend(actions ...AlterTableAction) AlterTableClauses {
+	ret := atc.clone()
+	ret.actions = append(ret.actions, actions...)
+	return ret
+}
@@ -0,0 +1,47 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type alterTableClausesSuite struct {
+	suite.Suite
+}
+
+func TestAlterTableClausesSuite(t *testing.T) {
+	suite.Run(t, new(alterTableClausesSuite))
+}
+
+func (atcs *alterTableClausesSuite) TestHasTable() {
+	c := exp.NewAlterTableClauses()
+	c2 := c.SetTable(exp.NewIdentifierExpression("", "test", ""))
+
+	atcs.False(c.HasTable())
+
+	atcs.True(c2.HasTable())
+}
+
+func (atcs *alterTableClausesSuite) TestSetTable() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewAlterTableClauses().SetTable(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetTable(ti2)
+
+	atcs.Equal(ti, c.Table())
+
+	atcs.Equal(ti2, c2.Table())
+}
+
+func (atcs *alterTableClausesSuite) TestActionsAppend() {
+	a1 := exp.NewDropColumnAction("a")
+	a2 := exp.NewRenameTableAction("b")
+	c := exp.NewAlterTableClauses().ActionsAppend(a1)
+	c2 := c.ActionsAppend(a2)
+
+	atcs.Equal([]exp.AlterTableAction{a1}, c.Actions())
+
+	atcs.Equal([]exp.AlterTableAction{a1, a2}, c2.Actions())
+}
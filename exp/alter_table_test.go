@@ -0,0 +1,105 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type alterTableActionSuite struct {
+	suite.Suite
+}
+
+func TestAlterTableActionSuite(t *testing.T) {
+	suite.Run(t, new(alterTableActionSuite))
+}
+
+func (atas *alterTableActionSuite) TestAddColumnAction() {
+	cd := exp.NewColumnDefinition("a", exp.NewDataType(exp.IntegerDataType))
+	a := exp.NewAddColumnAction(cd)
+	atas.Equal(exp.AddColumnAction, a.ActionType())
+	atas.Equal(cd, a.ColumnDefinition())
+	atas.Equal("a", a.Name())
+	atas.Equal(a, a.Expression())
+	atas.Equal(a, a.Clone())
+}
+
+func (atas *alterTableActionSuite) TestModifyColumnAction() {
+	cd := exp.NewColumnDefinition("a", exp.NewDataType(exp.IntegerDataType)).Comment("b")
+	a := exp.NewModifyColumnAction(cd)
+	atas.Equal(exp.ModifyColumnAction, a.ActionType())
+	atas.Equal(cd, a.ColumnDefinition())
+	atas.Equal("a", a.Name())
+}
+
+func (atas *alterTableActionSuite) TestColumnActions() {
+	dt := exp.NewDataType(exp.BigIntDataType)
+	for _, tt := range []struct {
+		action     exp.AlterTableAction
+		actionType exp.AlterTableActionType
+	}{
+		{action: exp.NewDropColumnAction("a"), actionType: exp.DropColumnAction},
+		{action: exp.NewAlterColumnTypeAction("a", dt), actionType: exp.AlterColumnTypeAction},
+		{action: exp.NewSetColumnDefaultAction("a", 1), actionType: exp.SetColumnDefaultAction},
+		{action: exp.NewDropColumnDefaultAction("a"), actionType: exp.DropColumnDefaultAction},
+		{action: exp.NewSetColumnNotNullAction("a"), actionType: exp.SetColumnNotNullAction},
+		{action: exp.NewDropColumnNotNullAction("a"), actionType: exp.DropColumnNotNullAction},
+	} {
+		atas.Equal(tt.actionType, tt.action.ActionType())
+		atas.Equal("a", tt.action.Name())
+	}
+	atas.Equal(dt, exp.NewAlterColumnTypeAction("a", dt).DataType())
+	atas.Equal(1, exp.NewSetColumnDefaultAction("a", 1).Value())
+}
+
+func (atas *alterTableActionSuite) TestRenameActions() {
+	rc := exp.NewRenameColumnAction("a", "b")
+	atas.Equal(exp.RenameColumnAction, rc.ActionType())
+	atas.Equal("a", rc.Name())
+	atas.Equal("b", rc.NewName())
+
+	rt := exp.NewRenameTableAction("c")
+	atas.Equal(exp.RenameTableAction, rt.ActionType())
+	atas.Equal("c", rt.NewName())
+}
+
+func (atas *alterTableActionSuite) TestAddConstraintAction() {
+	tc := exp.NewUniqueConstraint("a")
+	a := exp.NewAddConstraintAction(tc)
+	atas.Equal(exp.AddConstraintAction, a.ActionType())
+	atas.Equal(tc, a.Constraint())
+}
+
+func (atas *alterTableActionSuite) TestPartitionActions() {
+	bound := exp.NewListPartitionBound(1)
+	ap := exp.NewAttachPartitionAction(exp.ParseIdentifier("b"), bound)
+	atas.Equal(exp.AttachPartitionAction, ap.ActionType())
+	atas.Equal([]exp.PartitionDefinition{exp.NewPartitionDefinition(exp.ParseIdentifier("b"), bound)}, ap.Partitions())
+
+	dp := exp.NewDetachPartitionAction(exp.ParseIdentifier("b"))
+	atas.Equal(exp.DetachPartitionAction, dp.ActionType())
+	atas.Equal([]exp.PartitionDefinition{exp.NewPartitionDefinition(exp.ParseIdentifier("b"), nil)}, dp.Partitions())
+
+	pd := exp.NewPartitionDefinition(exp.ParseIdentifier("p1"), exp.NewLessThanPartitionBound(20))
+	addp := exp.NewAddPartitionAction(pd)
+	atas.Equal(exp.AddPartitionAction, addp.ActionType())
+	atas.Equal([]exp.PartitionDefinition{pd}, addp.Partitions())
+
+	drp := exp.NewDropPartitionAction(exp.ParseIdentifier("p0"), exp.ParseIdentifier("p1"))
+	atas.Equal(exp.DropPartitionAction, drp.ActionType())
+	atas.Equal([]exp.PartitionDefinition{
+		exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), nil),
+		exp.NewPartitionDefinition(exp.ParseIdentifier("p1"), nil),
+	}, drp.Partitions())
+}
+
+func (atas *alterTableActionSuite) TestAlterTableActionType_String() {
+	atas.Equal("ADD COLUMN", exp.AddColumnAction.String())
+	atas.Equal("SET NOT NULL", exp.SetColumnNotNullAction.String())
+	atas.Equal("RENAME TO", exp.RenameTableAction.String())
+	atas.Equal("MODIFY COLUMN", exp.ModifyColumnAction.String())
+	atas.Equal("ATTACH PARTITION", exp.AttachPartitionAction.String())
+	atas.Equal("DROP PARTITION", exp.DropPartitionAction.String())
+	atas.Equal("100", exp.AlterTableActionType(100).String())
+}
@@ -0,0 +1,205 @@
+package exp
+
+import "fmt"
+
+// The operation of an ArrayExpression
+type ArrayOperation int
+
+const (
+	// Returns true if the array contains all elements of another array (e.g. postgres @>)
+	ArrayContainsOp ArrayOperation = iota
+	// Returns true if all elements of the array are in another array (e.g. postgres <@)
+	ArrayContainedByOp
+	// Returns true if the arrays have elements in common (e.g. postgres &&)
+	ArrayOverlapOp
+	// Returns the element at an index (e.g. "a"[1])
+	ArrayIndexOp
+	// Returns the elements between two indexes (e.g. "a"[1:3])
+	ArraySliceOp
+)
+
+type (
+	// The bounds of an ArraySliceOp
+	ArraySliceBounds struct {
+		From int
+		To   int
+	}
+	arrayAccessor struct {
+		value Expression
+	}
+	arrayExpression struct {
+		lhs Expression
+		op  ArrayOperation
+		rhs interface{}
+	}
+	arrayConstructor struct {
+		values []interface{}
+	}
+)
+
+// Creates a new ArrayAccessor that can be used to build ArrayExpressions for an array value (e.g. an array column)
+//
+//	NewArrayAccessor(NewIdentifierExpression("", "", "tags")).Overlaps([]string{"a"}) -> ("tags" && '{"a"}')
+func NewArrayAccessor(value Expression) ArrayAccessor {
+	return arrayAccessor{value: value}
+}
+
+// Creates a new ArrayExpression, the rhs depends on the operation
+//   - ArrayContainsOp, ArrayContainedByOp, ArrayOverlapOp: the other array
+//   - ArrayIndexOp: the index (int)
+//   - ArraySliceOp: the bounds (ArraySliceBounds)
+func NewArrayExpression(op ArrayOperation, lhs Expression, rhs interface{}) ArrayExpression {
+	return arrayExpression{lhs: lhs, op: op, rhs: rhs}
+}
+
+// Creates a new array from values
+//
+//	NewArrayConstructor(1, 2, 3) -> ARRAY[1, 2, 3]
+func NewArrayConstructor(values ...interface{}) ArrayConstructorExpression {
+	return arrayConstructor{values: values}
+}
+
+func (aa arrayAccessor) Contains(val interface{}) ArrayExpression {
+	return NewArrayExpression(ArrayContainsOp, aa.value, val)
+}
+
+func (aa arrayAccessor) ContainedBy(val interface{}) ArrayExpression {
+	return NewArrayExpression(ArrayContainedByOp, aa.value, val)
+}
+
+func (aa arrayAccessor) Overlaps(val interface{}) ArrayExpression {
+	return NewArrayExpression(ArrayOverlapOp, aa.value, val)
+}
+
+func (aa arrayAccessor) Index(i int) ArrayExpression {
+	return NewArrayExpression(ArrayIndexOp, aa.value, i)
+}
+
+func (aa arrayAccessor) Slice(from, to int) ArrayExpression {
+	return NewArrayExpression(ArraySliceOp, aa.value, ArraySliceBounds{From: from, To: to})
+}
+
+func (ae arrayExpression) Clone() Expression {
+	return NewArrayExpression(ae.op, ae.lhs.Clone(), ae.rhs)
+}
+
+func (ae arrayExpression) Expression() Expression { return ae }
+func (ae arrayExpression) LHS() Expression        { return ae.lhs }
+func (ae arrayExpression) Op() ArrayOperation     { return ae.op }
+func (ae arrayExpression) RHS() interface{}       { return ae.rhs }
+
+func (ae arrayExpression) Contains(val interface{}) ArrayExpression {
+	return NewArrayAccessor(ae).Contains(val)
+}
+
+func (ae arrayExpression) ContainedBy(val interface{}) ArrayExpression {
+	return NewArrayAccessor(ae).ContainedBy(val)
+}
+
+func (ae arrayExpression) Overlaps(val interface{}) ArrayExpression {
+	return NewArrayAccessor(ae).Overlaps(val)
+}
+
+func (ae arrayExpression) Index(i int) ArrayExpression { return NewArrayAccessor(ae).Index(i) }
+func (ae arrayExpression) Slice(from, to int) ArrayExpression {
+	return NewArrayAccessor(ae).Slice(from, to)
+}
+
+func (ae arrayExpression) As(val interface{}) AliasedExpression     { return NewAliasExpression(ae, val) }
+func (ae arrayExpression) Eq(val interface{}) BooleanExpression     { return eq(ae, val) }
+func (ae arrayExpression) Neq(val interface{}) BooleanExpression    { return neq(ae, val) }
+func (ae arrayExpression) Gt(val interface{}) BooleanExpression     { return gt(ae, val) }
+func (ae arrayExpression) Gte(val interface{}) BooleanExpression    { return gte(ae, val) }
+func (ae arrayExpression) Lt(val interface{}) BooleanExpression     { return lt(ae, val) }
+func (ae arrayExpression) Lte(val interface{}) BooleanExpression    { return lte(ae, val) }
+func (ae arrayExpression) Asc() OrderedExpression                   { return asc(ae) }
+func (ae arrayExpression) Desc() OrderedExpression                  { return desc(ae) }
+func (ae arrayExpression) Like(i interface{}) BooleanExpression     { return like(ae, i) }
+func (ae arrayExpression) NotLike(i interface{}) BooleanExpression  { return notLike(ae, i) }
+func (ae arrayExpression) ILike(i interface{}) BooleanExpression    { return iLike(ae, i) }
+func (ae arrayExpression) NotILike(i interface{}) BooleanExpression { return notILike(ae, i) }
+
+func (ae arrayExpression) RegexpLike(val interface{}) BooleanExpression {
+	return regexpLike(ae, val)
+}
+
+func (ae arrayExpression) RegexpNotLike(val interface{}) BooleanExpression {
+	return regexpNotLike(ae, val)
+}
+
+func (ae arrayExpression) RegexpILike(val interface{}) BooleanExpression {
+	return regexpILike(ae, val)
+}
+
+func (ae arrayExpression) RegexpNotILike(val interface{}) BooleanExpression {
+	return regexpNotILike(ae, val)
+}
+
+func (ae arrayExpression) In(i ...interface{}) BooleanExpression    { return in(ae, i...) }
+func (ae arrayExpression) NotIn(i ...interface{}) BooleanExpression { return notIn(ae, i...) }
+func (ae arrayExpression) Is(i interface{}) BooleanExpression       { return is(ae, i) }
+func (ae arrayExpression) IsNot(i interface{}) BooleanExpression    { return isNot(ae, i) }
+func (ae arrayExpression) IsNull() BooleanExpression                { return is(ae, nil) }
+func (ae arrayExpression) IsNotNull() BooleanExpression             { return isNot(ae, nil) }
+func (ae arrayExpression) IsTrue() BooleanExpression                { return is(ae, true) }
+func (ae arrayExpression) IsNotTrue() BooleanExpression             { return isNot(ae, true) }
+func (ae arrayExpression) IsFalse() BooleanExpression               { return is(ae, false) }
+func (ae arrayExpression) IsNotFalse() BooleanExpression            { return isNot(ae, false) }
+
+func (ae arrayExpression) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(ae, val)
+}
+
+func (ae arrayExpression) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(ae, val)
+}
+
+func (ac arrayConstructor) Clone() Expression {
+	return NewArrayConstructor(append([]interface{}(nil), ac.values...)...)
+}
+
+func (ac arrayConstructor) Expression() Expression { return ac }
+func (ac arrayConstructor) Values() []interface{}  { return ac.values }
+
+func (ac arrayConstructor) Contains(val interface{}) ArrayExpression {
+	return NewArrayAccessor(ac).Contains(val)
+}
+
+func (ac arrayConstructor) ContainedBy(val interface{}) ArrayExpression {
+	return NewArrayAccessor(ac).ContainedBy(val)
+}
+
+func (ac arrayConstructor) Overlaps(val interface{}) ArrayExpression {
+	return NewArrayAccessor(ac).Overlaps(val)
+}
+
+func (ac arrayConstructor) Index(i int) ArrayExpression { return NewArrayAccessor(ac).Index(i) }
+func (ac arrayConstructor) Slice(from, to int) ArrayExpression {
+	return NewArrayAccessor(ac).Slice(from, to)
+}
+
+func (ac arrayConstructor) As(val interface{}) AliasedExpression  { return NewAliasExpression(ac, val) }
+func (ac arrayConstructor) Eq(val interface{}) BooleanExpression  { return eq(ac, val) }
+func (ac arrayConstructor) Neq(val interface{}) BooleanExpression { return neq(ac, val) }
+func (ac arrayConstructor) Gt(val interface{}) BooleanExpression  { return gt(ac, val) }
+func (ac arrayConstructor) Gte(val interface{}) BooleanExpression { return gte(ac, val) }
+func (ac arrayConstructor) Lt(val interface{}) BooleanExpression  { return lt(ac, val) }
+func (ac arrayConstructor) Lte(val interface{}) BooleanExpression { return lte(ac, val) }
+func (ac arrayConstructor) Asc() OrderedExpression                { return asc(ac) }
+func (ac arrayConstructor) Desc() OrderedExpression               { return desc(ac) }
+
+func (ao ArrayOperation) String() string {
+	switch ao {
+	case ArrayContainsOp:
+		return "Contains"
+	case ArrayContainedByOp:
+		return "Contained By"
+	case ArrayOverlapOp:
+		return "Overlap"
+	case ArrayIndexOp:
+		return "Index"
+	case ArraySliceOp:
+		return "Slice"
+	}
+	return fmt.Sprintf("%d", ao)
+}
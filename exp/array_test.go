@@ -0,0 +1,146 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type arrayExpressionSuite struct {
+	suite.Suite
+}
+
+func TestArrayExpressionSuite(t *testing.T) {
+	suite.Run(t, new(arrayExpressionSuite))
+}
+
+func (aes *arrayExpressionSuite) TestClone() {
+	ae := exp.NewArrayAccessor(exp.NewIdentifierExpression("", "", "tags")).Slice(1, 2)
+	aes.Equal(ae, ae.Clone())
+	ac := exp.NewArrayConstructor(1, 2)
+	aes.Equal(ac, ac.Clone())
+}
+
+func (aes *arrayExpressionSuite) TestExpression() {
+	ae := exp.NewArrayAccessor(exp.NewIdentifierExpression("", "", "tags")).Index(1)
+	aes.Equal(ae, ae.Expression())
+	ac := exp.NewArrayConstructor(1, 2)
+	aes.Equal(ac, ac.Expression())
+}
+
+func (aes *arrayExpressionSuite) TestAccessor() {
+	col := exp.NewIdentifierExpression("", "", "tags")
+	aa := exp.NewArrayAccessor(col)
+	testCases := []struct {
+		Ex  exp.ArrayExpression
+		Op  exp.ArrayOperation
+		RHS interface{}
+	}{
+		{Ex: aa.Contains([]string{"a"}), Op: exp.ArrayContainsOp, RHS: []string{"a"}},
+		{Ex: aa.ContainedBy([]string{"a"}), Op: exp.ArrayContainedByOp, RHS: []string{"a"}},
+		{Ex: aa.Overlaps([]string{"a"}), Op: exp.ArrayOverlapOp, RHS: []string{"a"}},
+		{Ex: aa.Index(1), Op: exp.ArrayIndexOp, RHS: 1},
+		{Ex: aa.Slice(1, 3), Op: exp.ArraySliceOp, RHS: exp.ArraySliceBounds{From: 1, To: 3}},
+	}
+	for _, tc := range testCases {
+		aes.Equal(col, tc.Ex.LHS())
+		aes.Equal(tc.Op, tc.Ex.Op())
+		aes.Equal(tc.RHS, tc.Ex.RHS())
+	}
+}
+
+func (aes *arrayExpressionSuite) TestChaining() {
+	ae := exp.NewArrayAccessor(exp.NewIdentifierExpression("", "", "tags")).Index(1)
+	ac := exp.NewArrayConstructor(1, 2)
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: ae.Index(2), Expected: exp.NewArrayExpression(exp.ArrayIndexOp, ae, 2)},
+		{Ex: ae.Slice(1, 2), Expected: exp.NewArrayExpression(exp.ArraySliceOp, ae, exp.ArraySliceBounds{From: 1, To: 2})},
+		{Ex: ae.Contains(ac), Expected: exp.NewArrayExpression(exp.ArrayContainsOp, ae, ac)},
+		{Ex: ae.ContainedBy(ac), Expected: exp.NewArrayExpression(exp.ArrayContainedByOp, ae, ac)},
+		{Ex: ae.Overlaps(ac), Expected: exp.NewArrayExpression(exp.ArrayOverlapOp, ae, ac)},
+		{Ex: ac.Index(1), Expected: exp.NewArrayExpression(exp.ArrayIndexOp, ac, 1)},
+		{Ex: ac.Slice(1, 2), Expected: exp.NewArrayExpression(exp.ArraySliceOp, ac, exp.ArraySliceBounds{From: 1, To: 2})},
+		{Ex: ac.Contains(ae), Expected: exp.NewArrayExpression(exp.ArrayContainsOp, ac, ae)},
+		{Ex: ac.ContainedBy(ae), Expected: exp.NewArrayExpression(exp.ArrayContainedByOp, ac, ae)},
+		{Ex: ac.Overlaps(ae), Expected: exp.NewArrayExpression(exp.ArrayOverlapOp, ac, ae)},
+	}
+	for _, tc := range testCases {
+		aes.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+func (aes *arrayExpressionSuite) TestAllOthers() {
+	ae := exp.NewArrayAccessor(exp.NewIdentifierExpression("", "", "tags")).Index(1)
+	pattern := "array like%"
+	inVals := []interface{}{1, 2}
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: ae.As("a"), Expected: exp.NewAliasExpression(ae, "a")},
+		{Ex: ae.Asc(), Expected: exp.NewOrderedExpression(ae, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: ae.Desc(), Expected: exp.NewOrderedExpression(ae, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: ae.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, ae, 1)},
+		{Ex: ae.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, ae, 1)},
+		{Ex: ae.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, ae, 1)},
+		{Ex: ae.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, ae, 1)},
+		{Ex: ae.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, ae, 1)},
+		{Ex: ae.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, ae, 1)},
+		{Ex: ae.Like(pattern), Expected: exp.NewBooleanExpression(exp.LikeOp, ae, pattern)},
+		{Ex: ae.NotLike(pattern), Expected: exp.NewBooleanExpression(exp.NotLikeOp, ae, pattern)},
+		{Ex: ae.ILike(pattern), Expected: exp.NewBooleanExpression(exp.ILikeOp, ae, pattern)},
+		{Ex: ae.NotILike(pattern), Expected: exp.NewBooleanExpression(exp.NotILikeOp, ae, pattern)},
+		{Ex: ae.RegexpLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpLikeOp, ae, pattern)},
+		{Ex: ae.RegexpNotLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotLikeOp, ae, pattern)},
+		{Ex: ae.RegexpILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpILikeOp, ae, pattern)},
+		{Ex: ae.RegexpNotILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotILikeOp, ae, pattern)},
+		{Ex: ae.In(inVals), Expected: exp.NewBooleanExpression(exp.InOp, ae, inVals)},
+		{Ex: ae.NotIn(inVals), Expected: exp.NewBooleanExpression(exp.NotInOp, ae, inVals)},
+		{Ex: ae.Is(true), Expected: exp.NewBooleanExpression(exp.IsOp, ae, true)},
+		{Ex: ae.IsNot(true), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, true)},
+		{Ex: ae.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, ae, nil)},
+		{Ex: ae.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, nil)},
+		{Ex: ae.IsTrue(), Expected: exp.NewBooleanExpression(exp.IsOp, ae, true)},
+		{Ex: ae.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, true)},
+		{Ex: ae.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, ae, false)},
+		{Ex: ae.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, false)},
+		{Ex: ae.IsDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, ae, 1)},
+		{Ex: ae.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, ae, 1)},
+	}
+	for _, tc := range testCases {
+		aes.Equal(tc.Expected, tc.Ex)
+	}
+
+	ac := exp.NewArrayConstructor(1, 2)
+	testCases = []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: ac.As("a"), Expected: exp.NewAliasExpression(ac, "a")},
+		{Ex: ac.Asc(), Expected: exp.NewOrderedExpression(ac, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: ac.Desc(), Expected: exp.NewOrderedExpression(ac, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: ac.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, ac, 1)},
+		{Ex: ac.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, ac, 1)},
+		{Ex: ac.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, ac, 1)},
+		{Ex: ac.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, ac, 1)},
+		{Ex: ac.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, ac, 1)},
+		{Ex: ac.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, ac, 1)},
+	}
+	for _, tc := range testCases {
+		aes.Equal(tc.Expected, tc.Ex)
+	}
+	aes.Equal([]interface{}{1, 2}, ac.Values())
+}
+
+func (aes *arrayExpressionSuite) TestArrayOperation_String() {
+	aes.Equal("Contains", exp.ArrayContainsOp.String())
+	aes.Equal("Contained By", exp.ArrayContainedByOp.String())
+	aes.Equal("Overlap", exp.ArrayOverlapOp.String())
+	aes.Equal("Index", exp.ArrayIndexOp.String())
+	aes.Equal("Slice", exp.ArraySliceOp.String())
+	aes.Equal("100", exp.ArrayOperation(100).String())
+}
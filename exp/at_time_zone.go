@@ -0,0 +1,54 @@
+package exp
+
+type atTimeZone struct {
+	value Expression
+	tz    interface{}
+}
+
+// Creates a new AtTimeZoneExpression that converts the value to the time zone
+//
+//	NewAtTimeZoneExpression(NewIdentifierExpression("", "", "a"), "UTC") -> ("a" AT TIME ZONE 'UTC')
+func NewAtTimeZoneExpression(value Expression, tz interface{}) AtTimeZoneExpression {
+	return atTimeZone{value: value, tz: tz}
+}
+
+func (atz atTimeZone) Clone() Expression {
+	return NewAtTimeZoneExpression(atz.value.Clone(), atz.tz)
+}
+
+func (atz atTimeZone) Expression() Expression { return atz }
+func (atz atTimeZone) Value() Expression      { return atz.value }
+func (atz atTimeZone) TimeZone() interface{}  { return atz.tz }
+
+func (atz atTimeZone) As(val interface{}) AliasedExpression {
+	return NewAliasExpression(atz, val)
+}
+
+func (atz atTimeZone) AtTimeZone(tz interface{}) AtTimeZoneExpression {
+	return NewAtTimeZoneExpression(atz, tz)
+}
+
+func (atz atTimeZone) Add(i IntervalExpression) DateArithmeticExpression {
+	return NewDateAccessor(atz).Add(i)
+}
+
+func (atz atTimeZone) Sub(i IntervalExpression) DateArithmeticExpression {
+	return NewDateAccessor(atz).Sub(i)
+}
+
+func (atz atTimeZone) Eq(val interface{}) BooleanExpression  { return eq(atz, val) }
+func (atz atTimeZone) Neq(val interface{}) BooleanExpression { return neq(atz, val) }
+func (atz atTimeZone) Gt(val interface{}) BooleanExpression  { return gt(atz, val) }
+func (atz atTimeZone) Gte(val interface{}) BooleanExpression { return gte(atz, val) }
+func (atz atTimeZone) Lt(val interface{}) BooleanExpression  { return lt(atz, val) }
+func (atz atTimeZone) Lte(val interface{}) BooleanExpression { return lte(atz, val) }
+func (atz atTimeZone) Asc() OrderedExpression                { return asc(atz) }
+func (atz atTimeZone) Desc() OrderedExpression               { return desc(atz) }
+
+func (atz atTimeZone) Between(val RangeVal) RangeExpression {
+	return between(atz, val)
+}
+
+func (atz atTimeZone) NotBetween(val RangeVal) RangeExpression {
+	return notBetween(atz, val)
+}
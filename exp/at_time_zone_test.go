@@ -0,0 +1,65 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type atTimeZoneExpressionSuite struct {
+	suite.Suite
+}
+
+func TestAtTimeZoneExpressionSuite(t *testing.T) {
+	suite.Run(t, new(atTimeZoneExpressionSuite))
+}
+
+func (atzs *atTimeZoneExpressionSuite) TestClone() {
+	atz := exp.NewAtTimeZoneExpression(exp.NewIdentifierExpression("", "", "a"), "UTC")
+	atzs.Equal(atz, atz.Clone())
+}
+
+func (atzs *atTimeZoneExpressionSuite) TestExpression() {
+	atz := exp.NewAtTimeZoneExpression(exp.NewIdentifierExpression("", "", "a"), "UTC")
+	atzs.Equal(atz, atz.Expression())
+}
+
+func (atzs *atTimeZoneExpressionSuite) TestValue() {
+	col := exp.NewIdentifierExpression("", "", "a")
+	atz := exp.NewAtTimeZoneExpression(col, "UTC")
+	atzs.Equal(col, atz.Value())
+	atzs.Equal("UTC", atz.TimeZone())
+}
+
+func (atzs *atTimeZoneExpressionSuite) TestChaining() {
+	atz := exp.NewAtTimeZoneExpression(exp.NewIdentifierExpression("", "", "a"), "UTC")
+	days := exp.NewIntervalExpression(3, exp.DaysIntervalUnit)
+	atzs.Equal(exp.NewAtTimeZoneExpression(atz, "Europe/Kyiv"), atz.AtTimeZone("Europe/Kyiv"))
+	atzs.Equal(exp.NewDateArithmeticExpression(exp.DateAddOp, atz, days), atz.Add(days))
+	atzs.Equal(exp.NewDateArithmeticExpression(exp.DateSubOp, atz, days), atz.Sub(days))
+}
+
+func (atzs *atTimeZoneExpressionSuite) TestAllOthers() {
+	atz := exp.NewAtTimeZoneExpression(exp.NewIdentifierExpression("", "", "a"), "UTC")
+	rv := exp.NewRangeVal(1, 2)
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: atz.As("a"), Expected: exp.NewAliasExpression(atz, "a")},
+		{Ex: atz.Asc(), Expected: exp.NewOrderedExpression(atz, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: atz.Desc(), Expected: exp.NewOrderedExpression(atz, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: atz.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, atz, 1)},
+		{Ex: atz.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, atz, 1)},
+		{Ex: atz.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, atz, 1)},
+		{Ex: atz.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, atz, 1)},
+		{Ex: atz.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, atz, 1)},
+		{Ex: atz.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, atz, 1)},
+		{Ex: atz.Between(rv), Expected: exp.NewRangeExpression(exp.BetweenOp, atz, rv)},
+		{Ex: atz.NotBetween(rv), Expected: exp.NewRangeExpression(exp.NotBetweenOp, atz, rv)},
+	}
+	for _, tc := range testCases {
+		atzs.Equal(tc.Expected, tc.Ex)
+	}
+}
@@ -58,6 +58,14 @@ func (b bitwise) Distinct() SQLFunctionExpression                  { return NewS
 func (b bitwise) Between(val RangeVal) RangeExpression             { return between(b, val) }
 func (b bitwise) NotBetween(val RangeVal) RangeExpression          { return notBetween(b, val) }
 
+func (b bitwise) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(b, val)
+}
+
+func (b bitwise) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(b, val)
+}
+
 // used internally to create a Bitwise Inversion BitwiseExpression
 func bitwiseInversion(rhs Expression) BitwiseExpression {
 	return NewBitwiseExpression(BitwiseInversionOp, nil, rhs)
@@ -6,9 +6,10 @@ import (
 )
 
 type boolean struct {
-	lhs Expression
-	rhs interface{}
-	op  BooleanOperation
+	lhs    Expression
+	rhs    interface{}
+	op     BooleanOperation
+	escape string
 }
 
 func NewBooleanExpression(op BooleanOperation, lhs Expression, rhs interface{}) BooleanExpression {
@@ -16,7 +17,7 @@ func NewBooleanExpression(op BooleanOperation, lhs Expression, rhs interface{})
 }
 
 func (b boolean) Clone() Expression {
-	return NewBooleanExpression(b.op, b.lhs.Clone(), b.rhs)
+	return boolean{op: b.op, lhs: b.lhs.Clone(), rhs: b.rhs, escape: b.escape}
 }
 
 func (b boolean) Expression() Expression {
@@ -35,6 +36,18 @@ func (b boolean) Op() BooleanOperation {
 	return b.op
 }
 
+// Returns a copy of the LIKE or ILIKE expression that uses char as the escape character
+//
+//	I("a").Like(`100\%`).Escape(`\`) -> ("a" LIKE '100\%' ESCAPE '\')
+func (b boolean) Escape(char string) BooleanExpression {
+	b.escape = char
+	return b
+}
+
+func (b boolean) GetEscape() string {
+	return b.escape
+}
+
 func (b boolean) As(val interface{}) AliasedExpression {
 	return NewAliasExpression(b, val)
 }
@@ -95,6 +108,16 @@ func isNot(lhs Expression, val interface{}) BooleanExpression {
 	return checkBoolExpType(IsOp, lhs, val, true)
 }
 
+// used internally to create an IS DISTINCT FROM BooleanExpression
+func isDistinctFrom(lhs Expression, val interface{}) BooleanExpression {
+	return NewBooleanExpression(IsDistinctFromOp, lhs, val)
+}
+
+// used internally to create an IS NOT DISTINCT FROM BooleanExpression
+func isNotDistinctFrom(lhs Expression, val interface{}) BooleanExpression {
+	return NewBooleanExpression(IsNotDistinctFromOp, lhs, val)
+}
+
 // used internally to create a LIKE BooleanExpression
 func like(lhs Expression, val interface{}) BooleanExpression {
 	return checkLikeExp(LikeOp, lhs, val, false)
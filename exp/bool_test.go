@@ -0,0 +1,33 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type booleanExpressionSuite struct {
+	suite.Suite
+}
+
+func TestBooleanExpressionSuite(t *testing.T) {
+	suite.Run(t, new(booleanExpressionSuite))
+}
+
+func (bes *booleanExpressionSuite) TestEscape() {
+	like := exp.NewIdentifierExpression("", "", "a").Like(`100\%`)
+	bes.Equal("", like.GetEscape())
+
+	escaped := like.Escape(`\`)
+	bes.Equal(`\`, escaped.GetEscape())
+	bes.Equal(exp.LikeOp, escaped.Op())
+	bes.Equal(`100\%`, escaped.RHS())
+	bes.Equal("", like.GetEscape())
+}
+
+func (bes *booleanExpressionSuite) TestClone() {
+	be := exp.NewIdentifierExpression("", "", "a").Like(`100!%`).Escape("!")
+	bes.Equal(be, be.Clone())
+	bes.Equal("!", be.Clone().(exp.BooleanExpression).GetEscape())
+}
@@ -0,0 +1,53 @@
+package exp
+
+type (
+	CallClauses interface {
+		HasProcedure() bool
+		clone() *callClauses
+
+		Procedure() Expression
+		SetProcedure(procedure Expression) CallClauses
+
+		Args() []interface{}
+		SetArgs(args []interface{}) CallClauses
+	}
+	callClauses struct {
+		procedure Expression
+		args      []interface{}
+	}
+)
+
+func NewCallClauses() CallClauses {
+	return &callClauses{}
+}
+
+func (cc *callClauses) HasProcedure() bool {
+	return cc.procedure != nil
+}
+
+func (cc *callClauses) clone() *callClauses {
+	return &callClauses{
+		procedure: cc.procedure,
+		args:      cc.args,
+	}
+}
+
+func (cc *callClauses) Procedure() Expression {
+	return cc.procedure
+}
+
+func (cc *callClauses) SetProcedure(procedure Expression) CallClauses {
+	ret := cc.clone()
+	ret.procedure = procedure
+	return ret
+}
+
+func (cc *callClauses) Args() []interface{} {
+	return cc.args
+}
+
+func (cc *callClauses) SetArgs(args []interface{}) CallClauses {
+	ret := cc.clone()
+	ret.args = args
+	return ret
+}
@@ -0,0 +1,45 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type callClausesSuite struct {
+	suite.Suite
+}
+
+func TestCallClausesSuite(t *testing.T) {
+	suite.Run(t, new(callClausesSuite))
+}
+
+func (ccs *callClausesSuite) TestHasProcedure() {
+	c := exp.NewCallClauses()
+	c2 := c.SetProcedure(exp.NewIdentifierExpression("", "test", ""))
+
+	ccs.False(c.HasProcedure())
+
+	ccs.True(c2.HasProcedure())
+}
+
+func (ccs *callClausesSuite) TestSetProcedure() {
+	pi := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewCallClauses().SetProcedure(pi)
+	pi2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetProcedure(pi2)
+
+	ccs.Equal(pi, c.Procedure())
+
+	ccs.Equal(pi2, c2.Procedure())
+}
+
+func (ccs *callClausesSuite) TestSetArgs() {
+	c := exp.NewCallClauses()
+	c2 := c.SetArgs([]interface{}{1, "a"})
+
+	ccs.Nil(c.Args())
+
+	ccs.Equal([]interface{}{1, "a"}, c2.Args())
+}
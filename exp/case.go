@@ -65,7 +65,11 @@ func (c caseExpression) Value(value interface{}) CaseExpression {
 }
 
 func (c caseExpression) When(condition, result interface{}) CaseExpression {
-	c.whens = append(c.whens, NewCaseWhen(condition, result))
+	return c.Whens(NewCaseWhen(condition, result))
+}
+
+func (c caseExpression) Whens(whens ...CaseWhen) CaseExpression {
+	c.whens = append(c.whens[:len(c.whens):len(c.whens)], whens...)
 	return c
 }
 
@@ -74,5 +78,29 @@ func (c caseExpression) Else(result interface{}) CaseExpression {
 	return c
 }
 
-func (c caseExpression) Asc() OrderedExpression  { return asc(c) }
-func (c caseExpression) Desc() OrderedExpression { return desc(c) }
+func (c caseExpression) Asc() OrderedExpression                   { return asc(c) }
+func (c caseExpression) Desc() OrderedExpression                  { return desc(c) }
+func (c caseExpression) Eq(val interface{}) BooleanExpression     { return eq(c, val) }
+func (c caseExpression) Neq(val interface{}) BooleanExpression    { return neq(c, val) }
+func (c caseExpression) Gt(val interface{}) BooleanExpression     { return gt(c, val) }
+func (c caseExpression) Gte(val interface{}) BooleanExpression    { return gte(c, val) }
+func (c caseExpression) Lt(val interface{}) BooleanExpression     { return lt(c, val) }
+func (c caseExpression) Lte(val interface{}) BooleanExpression    { return lte(c, val) }
+func (c caseExpression) In(i ...interface{}) BooleanExpression    { return in(c, i...) }
+func (c caseExpression) NotIn(i ...interface{}) BooleanExpression { return notIn(c, i...) }
+func (c caseExpression) Is(i interface{}) BooleanExpression       { return is(c, i) }
+func (c caseExpression) IsNot(i interface{}) BooleanExpression    { return isNot(c, i) }
+func (c caseExpression) IsNull() BooleanExpression                { return is(c, nil) }
+func (c caseExpression) IsNotNull() BooleanExpression             { return isNot(c, nil) }
+func (c caseExpression) IsTrue() BooleanExpression                { return is(c, true) }
+func (c caseExpression) IsNotTrue() BooleanExpression             { return isNot(c, true) }
+func (c caseExpression) IsFalse() BooleanExpression               { return is(c, false) }
+func (c caseExpression) IsNotFalse() BooleanExpression            { return isNot(c, false) }
+
+func (c caseExpression) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(c, val)
+}
+
+func (c caseExpression) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(c, val)
+}
@@ -50,6 +50,53 @@ func (ces *caseExpressionSuite) TestWhen() {
 	ces.Empty(ce.GetWhens())
 }
 
+func (ces *caseExpressionSuite) TestWhens() {
+	condition1 := exp.NewIdentifierExpression("", "", "a").Eq(10)
+	condition2 := exp.NewIdentifierExpression("", "", "b").Eq(20)
+	ce := exp.NewCaseExpression().When(condition1, "a")
+	ces.Equal([]exp.CaseWhen{
+		exp.NewCaseWhen(condition1, "a"),
+		exp.NewCaseWhen(condition2, "b"),
+		exp.NewCaseWhen(true, "c"),
+	}, ce.Whens(exp.NewCaseWhen(condition2, "b"), exp.NewCaseWhen(true, "c")).GetWhens())
+
+	ces.Equal([]exp.CaseWhen{exp.NewCaseWhen(condition1, "a")}, ce.GetWhens())
+}
+
+func (ces *caseExpressionSuite) TestWhen_DoesNotShareBranches() {
+	base := exp.NewCaseExpression().When(true, "a").When(false, "b")
+	ce1 := base.When(1, "c")
+	ce2 := base.When(2, "d")
+	ces.Equal([]exp.CaseWhen{
+		exp.NewCaseWhen(true, "a"),
+		exp.NewCaseWhen(false, "b"),
+		exp.NewCaseWhen(1, "c"),
+	}, ce1.GetWhens())
+	ces.Equal([]exp.CaseWhen{
+		exp.NewCaseWhen(true, "a"),
+		exp.NewCaseWhen(false, "b"),
+		exp.NewCaseWhen(2, "d"),
+	}, ce2.GetWhens())
+}
+
+func (ces *caseExpressionSuite) TestComparisons() {
+	ce := exp.NewCaseExpression().When(true, 1)
+	ces.Equal(exp.NewBooleanExpression(exp.EqOp, ce, 1), ce.Eq(1))
+	ces.Equal(exp.NewBooleanExpression(exp.NeqOp, ce, 1), ce.Neq(1))
+	ces.Equal(exp.NewBooleanExpression(exp.GtOp, ce, 1), ce.Gt(1))
+	ces.Equal(exp.NewBooleanExpression(exp.GteOp, ce, 1), ce.Gte(1))
+	ces.Equal(exp.NewBooleanExpression(exp.LtOp, ce, 1), ce.Lt(1))
+	ces.Equal(exp.NewBooleanExpression(exp.LteOp, ce, 1), ce.Lte(1))
+	ces.Equal(exp.NewBooleanExpression(exp.InOp, ce, []interface{}{1, 2}), ce.In(1, 2))
+	ces.Equal(exp.NewBooleanExpression(exp.NotInOp, ce, []interface{}{1, 2}), ce.NotIn(1, 2))
+	ces.Equal(exp.NewBooleanExpression(exp.IsOp, ce, nil), ce.IsNull())
+	ces.Equal(exp.NewBooleanExpression(exp.IsNotOp, ce, nil), ce.IsNotNull())
+	ces.Equal(exp.NewBooleanExpression(exp.IsOp, ce, true), ce.IsTrue())
+	ces.Equal(exp.NewBooleanExpression(exp.IsNotOp, ce, false), ce.IsNotFalse())
+	ces.Equal(exp.NewBooleanExpression(exp.IsDistinctFromOp, ce, 1), ce.IsDistinctFrom(1))
+	ces.Equal(exp.NewBooleanExpression(exp.IsNotDistinctFromOp, ce, 1), ce.IsNotDistinctFrom(1))
+}
+
 func (ces *caseExpressionSuite) TestElse() {
 	ce := exp.NewCaseExpression()
 	ces.Equal(exp.NewCaseElse("a"), ce.Else("a").GetElse())
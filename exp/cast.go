@@ -54,3 +54,15 @@ func (c cast) IsNotFalse() BooleanExpression                    { return isNot(c
 func (c cast) Distinct() SQLFunctionExpression                  { return NewSQLFunctionExpression("DISTINCT", c) }
 func (c cast) Between(val RangeVal) RangeExpression             { return between(c, val) }
 func (c cast) NotBetween(val RangeVal) RangeExpression          { return notBetween(c, val) }
+
+func (c cast) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(c, val)
+}
+
+func (c cast) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(c, val)
+}
+
+func (c cast) AtTimeZone(tz interface{}) AtTimeZoneExpression {
+	return NewAtTimeZoneExpression(c, tz)
+}
@@ -72,6 +72,9 @@ func (ces *castExpressionSuite) TestAllOthers() {
 		{Ex: ce.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ce, true)},
 		{Ex: ce.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, ce, false)},
 		{Ex: ce.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ce, false)},
+		{Ex: ce.IsDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, ce, 1)},
+		{Ex: ce.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, ce, 1)},
+		{Ex: ce.AtTimeZone("UTC"), Expected: exp.NewAtTimeZoneExpression(ce, "UTC")},
 		{Ex: ce.Distinct(), Expected: exp.NewSQLFunctionExpression("DISTINCT", ce)},
 	}
 
@@ -0,0 +1,91 @@
+package exp
+
+import "fmt"
+
+type (
+	// The type of object a COMMENT ON statement sets the comment of
+	CommentObjectType int
+
+	CommentClauses interface {
+		HasObject() bool
+		clone() *commentClauses
+
+		ObjectType() CommentObjectType
+		SetObjectType(objectType CommentObjectType) CommentClauses
+
+		Object() Expression
+		SetObject(object Expression) CommentClauses
+
+		Comment() string
+		SetComment(comment string) CommentClauses
+	}
+	commentClauses struct {
+		objectType CommentObjectType
+		object     Expression
+		comment    string
+	}
+)
+
+const (
+	TableCommentObject CommentObjectType = iota
+	ColumnCommentObject
+	ViewCommentObject
+)
+
+func (t CommentObjectType) String() string {
+	switch t {
+	case TableCommentObject:
+		return "TABLE"
+	case ColumnCommentObject:
+		return "COLUMN"
+	case ViewCommentObject:
+		return "VIEW"
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+func NewCommentClauses() CommentClauses {
+	return &commentClauses{}
+}
+
+func (cc *commentClauses) HasObject() bool {
+	return cc.object != nil
+}
+
+func (cc *commentClauses) clone() *commentClauses {
+	return &commentClauses{
+		objectType: cc.objectType,
+		object:     cc.object,
+		comment:    cc.comment,
+	}
+}
+
+func (cc *commentClauses) ObjectType() CommentObjectType {
+	return cc.objectType
+}
+
+func (cc *commentClauses) SetObjectType(objectType CommentObjectType) CommentClauses {
+	ret := cc.clone()
+	ret.objectType = objectType
+	return ret
+}
+
+func (cc *commentClauses) Object() Expression {
+	return cc.object
+}
+
+func (cc *commentClauses) SetObject(object Expression) CommentClauses {
+	ret := cc.clone()
+	ret.object = object
+	return ret
+}
+
+func (cc *commentClauses) Comment() string {
+	return cc.comment
+}
+
+func (cc *commentClauses) SetComment(comment string) CommentClauses {
+	ret := cc.clone()
+	ret.comment = comment
+	return ret
+}
@@ -0,0 +1,61 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type commentClausesSuite struct {
+	suite.Suite
+}
+
+func TestCommentClausesSuite(t *testing.T) {
+	suite.Run(t, new(commentClausesSuite))
+}
+
+func (ccs *commentClausesSuite) TestCommentObjectType_String() {
+	ccs.Equal("TABLE", exp.TableCommentObject.String())
+	ccs.Equal("COLUMN", exp.ColumnCommentObject.String())
+	ccs.Equal("VIEW", exp.ViewCommentObject.String())
+	ccs.Equal("100", exp.CommentObjectType(100).String())
+}
+
+func (ccs *commentClausesSuite) TestHasObject() {
+	c := exp.NewCommentClauses()
+	c2 := c.SetObject(exp.NewIdentifierExpression("", "test", ""))
+
+	ccs.False(c.HasObject())
+
+	ccs.True(c2.HasObject())
+}
+
+func (ccs *commentClausesSuite) TestSetObject() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewCommentClauses().SetObject(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetObject(ti2)
+
+	ccs.Equal(ti, c.Object())
+
+	ccs.Equal(ti2, c2.Object())
+}
+
+func (ccs *commentClausesSuite) TestSetObjectType() {
+	c := exp.NewCommentClauses()
+	c2 := c.SetObjectType(exp.ColumnCommentObject)
+
+	ccs.Equal(exp.TableCommentObject, c.ObjectType())
+
+	ccs.Equal(exp.ColumnCommentObject, c2.ObjectType())
+}
+
+func (ccs *commentClausesSuite) TestSetComment() {
+	c := exp.NewCommentClauses().SetComment("a")
+	c2 := c.SetComment("b")
+
+	ccs.Equal("a", c.Comment())
+
+	ccs.Equal("b", c2.Comment())
+}
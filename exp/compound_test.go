@@ -0,0 +1,33 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type compoundExpressionSuite struct {
+	suite.Suite
+}
+
+func TestCompoundExpressionSuite(t *testing.T) {
+	suite.Run(t, new(compoundExpressionSuite))
+}
+
+func (ces *compoundExpressionSuite) TestType() {
+	rhs := newTestAppendableExpression("SELECT * FROM foo", []interface{}{})
+	ce := exp.NewCompoundExpression(exp.ExceptAllCompoundType, rhs)
+	ces.Equal(exp.ExceptAllCompoundType, ce.Type())
+	ces.Equal(rhs, ce.RHS())
+}
+
+func (ces *compoundExpressionSuite) TestCompoundType_String() {
+	ces.Equal("UNION", exp.UnionCompoundType.String())
+	ces.Equal("UNION ALL", exp.UnionAllCompoundType.String())
+	ces.Equal("INTERSECT", exp.IntersectCompoundType.String())
+	ces.Equal("INTERSECT ALL", exp.IntersectAllCompoundType.String())
+	ces.Equal("EXCEPT", exp.ExceptCompoundType.String())
+	ces.Equal("EXCEPT ALL", exp.ExceptAllCompoundType.String())
+	ces.Equal("100", exp.CompoundType(100).String())
+}
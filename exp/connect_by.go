@@ -0,0 +1,42 @@
+package exp
+
+type connectBy struct {
+	startWith ExpressionList
+	condition ExpressionList
+	noCycle   bool
+}
+
+// Creates a new hierarchical query clause (e.g. oracle), the conditions are AND'ed together
+//
+//	NewConnectByExpression(false, L(`PRIOR "id" = "parent_id"`)).StartWithAppend(C("parent_id").IsNull())
+//	// START WITH ("parent_id" IS NULL) CONNECT BY PRIOR "id" = "parent_id"
+func NewConnectByExpression(noCycle bool, condition ...Expression) ConnectByExpression {
+	return connectBy{condition: NewExpressionList(AndType, condition...), noCycle: noCycle}
+}
+
+func (cb connectBy) Clone() Expression {
+	ret := cb
+	if cb.startWith != nil {
+		ret.startWith = cb.startWith.Clone().(ExpressionList)
+	}
+	ret.condition = cb.condition.Clone().(ExpressionList)
+	return ret
+}
+
+func (cb connectBy) Expression() Expression { return cb }
+
+func (cb connectBy) StartWith() ExpressionList { return cb.startWith }
+
+func (cb connectBy) Condition() ExpressionList { return cb.condition }
+
+func (cb connectBy) IsNoCycle() bool { return cb.noCycle }
+
+// Appends to the START WITH conditions, the conditions are AND'ed together
+func (cb connectBy) StartWithAppend(expressions ...Expression) ConnectByExpression {
+	if cb.startWith == nil {
+		cb.startWith = NewExpressionList(AndType, expressions...)
+	} else {
+		cb.startWith = cb.startWith.Append(expressions...)
+	}
+	return cb
+}
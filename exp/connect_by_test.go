@@ -0,0 +1,46 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type connectByExpressionSuite struct {
+	suite.Suite
+}
+
+func TestConnectByExpressionSuite(t *testing.T) {
+	suite.Run(t, new(connectByExpressionSuite))
+}
+
+func (cbes *connectByExpressionSuite) TestConnectBy() {
+	cond := exp.Ex{"a": 1}
+	cb := exp.NewConnectByExpression(false, cond)
+	cbes.Equal(exp.NewExpressionList(exp.AndType, cond), cb.Condition())
+	cbes.Nil(cb.StartWith())
+	cbes.False(cb.IsNoCycle())
+	cbes.True(exp.NewConnectByExpression(true, cond).IsNoCycle())
+	cbes.True(exp.NewConnectByExpression(false).Condition().IsEmpty())
+}
+
+func (cbes *connectByExpressionSuite) TestStartWithAppend() {
+	w := exp.Ex{"b": 1}
+	w2 := exp.Ex{"c": 2}
+	cb := exp.NewConnectByExpression(false, exp.Ex{"a": 1})
+	cb2 := cb.StartWithAppend(w)
+	cb3 := cb2.StartWithAppend(w2)
+
+	cbes.Nil(cb.StartWith())
+	cbes.Equal(exp.NewExpressionList(exp.AndType, w), cb2.StartWith())
+	cbes.Equal(exp.NewExpressionList(exp.AndType, w, w2), cb3.StartWith())
+	cbes.Equal(cb.Condition(), cb3.Condition())
+}
+
+func (cbes *connectByExpressionSuite) TestClone() {
+	cb := exp.NewConnectByExpression(true, exp.Ex{"a": 1}).StartWithAppend(exp.Ex{"b": 1})
+	cbes.Equal(cb, cb.Clone())
+	cb2 := exp.NewConnectByExpression(false, exp.Ex{"a": 1})
+	cbes.Equal(cb2, cb2.Clone())
+}
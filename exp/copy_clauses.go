@@ -0,0 +1,162 @@
+package exp
+
+import "fmt"
+
+type (
+	// The format of the data of a COPY statement (e.g. FORMAT csv)
+	CopyFormat int
+
+	// Options to use when generating a COPY statement, empty values use the databases defaults
+	CopyOptions struct {
+		// The format of the data, the databases default format (text) is used if not set
+		Format CopyFormat
+		// The character that separates the columns of each row (e.g. DELIMITER ',')
+		Delimiter string
+		// The string that represents a NULL value (e.g. NULL 'null')
+		Null string
+		// Set to true if the first line of the data contains the column names (csv only)
+		Header bool
+		// The quoting character (csv only)
+		Quote string
+		// The character that escapes the quote character (csv only)
+		Escape string
+		// The encoding of the data (e.g. ENCODING 'UTF8')
+		Encoding string
+	}
+
+	CopyClauses interface {
+		HasTable() bool
+		clone() *copyClauses
+
+		// The table or query (e.g. COPY (SELECT ...) TO STDOUT) to copy
+		Table() Expression
+		SetTable(table Expression) CopyClauses
+
+		Columns() ColumnListExpression
+		SetColumns(cols ColumnListExpression) CopyClauses
+
+		// Returns true for COPY ... FROM STDIN and false for COPY ... TO STDOUT
+		IsFrom() bool
+		SetFrom(from bool) CopyClauses
+
+		// The source the data is loaded from instead of STDIN (e.g. redshift COPY "a" FROM 's3://bucket/a')
+		Source() Expression
+		SetSource(source Expression) CopyClauses
+
+		Options() CopyOptions
+		SetOptions(opts CopyOptions) CopyClauses
+
+		// Dialect specific parameters written after the options (e.g. redshift IAM_ROLE '...' FORMAT AS CSV)
+		Parameters() []Expression
+		ParametersAppend(params ...Expression) CopyClauses
+	}
+	copyClauses struct {
+		table      Expression
+		columns    ColumnListExpression
+		from       bool
+		source     Expression
+		opts       CopyOptions
+		parameters []Expression
+	}
+)
+
+const (
+	// Use the databases default format
+	CopyFormatDefault CopyFormat = iota
+	CopyFormatText
+	CopyFormatCSV
+	CopyFormatBinary
+)
+
+func (cf CopyFormat) String() string {
+	switch cf {
+	case CopyFormatDefault:
+		return "default"
+	case CopyFormatText:
+		return "text"
+	case CopyFormatCSV:
+		return "csv"
+	case CopyFormatBinary:
+		return "binary"
+	}
+	return fmt.Sprintf("%d", cf)
+}
+
+func NewCopyClauses() CopyClauses {
+	return &copyClauses{}
+}
+
+func (cc *copyClauses) HasTable() bool {
+	return cc.table != nil
+}
+
+func (cc *copyClauses) clone() *copyClauses {
+	return &copyClauses{
+		table:      cc.table,
+		columns:    cc.columns,
+		from:       cc.from,
+		source:     cc.source,
+		opts:       cc.opts,
+		parameters: cc.parameters,
+	}
+}
+
+func (cc *copyClauses) Table() Expression {
+	return cc.table
+}
+
+func (cc *copyClauses) SetTable(table Expression) CopyClauses {
+	ret := cc.clone()
+	ret.table = table
+	return ret
+}
+
+func (cc *copyClauses) Columns() ColumnListExpression {
+	return cc.columns
+}
+
+func (cc *copyClauses) SetColumns(cols ColumnListExpression) CopyClauses {
+	ret := cc.clone()
+	ret.columns = cols
+	return ret
+}
+
+func (cc *copyClauses) IsFrom() bool {
+	return cc.from
+}
+
+func (cc *copyClauses) SetFrom(from bool) CopyClauses {
+	ret := cc.clone()
+	ret.from = from
+	return ret
+}
+
+func (cc *copyClauses) Source() Expression {
+	return cc.source
+}
+
+func (cc *copyClauses) SetSource(source Expression) CopyClauses {
+	ret := cc.clone()
+	ret.source = source
+	return ret
+}
+
+func (cc *copyClauses) Options() CopyOptions {
+	return cc.opts
+}
+
+func (cc *copyClauses) SetOptions(opts CopyOptions) CopyClauses {
+	ret := cc.clone()
+	ret.opts = opts
+	return ret
+}
+
+func (cc *copyClauses) Parameters() []Expression {
+	return cc.parameters
+}
+
+func (cc *copyClauses) ParametersAppend(params ...Expression) CopyClauses {
+	ret := cc.clone()
+	ret.parameters = append(append(make([]Expression, 0, len(cc.parameters)+len(params)), cc.parameters...), params...)
+	return ret
+}
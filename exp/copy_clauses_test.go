@@ -0,0 +1,97 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type copyClausesSuite struct {
+	suite.Suite
+}
+
+func TestCopyClausesSuite(t *testing.T) {
+	suite.Run(t, new(copyClausesSuite))
+}
+
+func (ccs *copyClausesSuite) TestCopyFormat_String() {
+	ccs.Equal("default", exp.CopyFormatDefault.String())
+	ccs.Equal("text", exp.CopyFormatText.String())
+	ccs.Equal("csv", exp.CopyFormatCSV.String())
+	ccs.Equal("binary", exp.CopyFormatBinary.String())
+	ccs.Equal("100", exp.CopyFormat(100).String())
+}
+
+func (ccs *copyClausesSuite) TestHasTable() {
+	c := exp.NewCopyClauses()
+	c2 := c.SetTable(exp.NewIdentifierExpression("", "test", ""))
+
+	ccs.False(c.HasTable())
+
+	ccs.True(c2.HasTable())
+}
+
+func (ccs *copyClausesSuite) TestSetTable() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewCopyClauses().SetTable(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetTable(ti2)
+
+	ccs.Equal(ti, c.Table())
+
+	ccs.Equal(ti2, c2.Table())
+}
+
+func (ccs *copyClausesSuite) TestSetColumns() {
+	c := exp.NewCopyClauses()
+	cols := exp.NewColumnListExpression("a", "b")
+	c2 := c.SetColumns(cols)
+
+	ccs.Nil(c.Columns())
+
+	ccs.Equal(cols, c2.Columns())
+}
+
+func (ccs *copyClausesSuite) TestSetFrom() {
+	c := exp.NewCopyClauses()
+	c2 := c.SetFrom(true)
+
+	ccs.False(c.IsFrom())
+
+	ccs.True(c2.IsFrom())
+}
+
+func (ccs *copyClausesSuite) TestSetOptions() {
+	c := exp.NewCopyClauses()
+	opts := exp.CopyOptions{Format: exp.CopyFormatCSV, Header: true}
+	c2 := c.SetOptions(opts)
+
+	ccs.Equal(exp.CopyOptions{}, c.Options())
+
+	ccs.Equal(opts, c2.Options())
+}
+
+func (ccs *copyClausesSuite) TestSetSource() {
+	c := exp.NewCopyClauses()
+	source := exp.NewLiteralExpression("'s3://bucket/a'")
+	c2 := c.SetSource(source)
+
+	ccs.Nil(c.Source())
+
+	ccs.Equal(source, c2.Source())
+}
+
+func (ccs *copyClausesSuite) TestParametersAppend() {
+	p1 := exp.NewLiteralExpression("FORMAT AS CSV")
+	p2 := exp.NewLiteralExpression("IGNOREHEADER 1")
+	c := exp.NewCopyClauses()
+	c2 := c.ParametersAppend(p1)
+	c3 := c2.ParametersAppend(p2)
+
+	ccs.Nil(c.Parameters())
+
+	ccs.Equal([]exp.Expression{p1}, c2.Parameters())
+
+	ccs.Equal([]exp.Expression{p1, p2}, c3.Parameters())
+}
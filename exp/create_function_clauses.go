@@ -0,0 +1,121 @@
+package exp
+
+type (
+	// A parameter of a function created with a CREATE FUNCTION statement (e.g. "a" INTEGER)
+	FunctionParam struct {
+		// The name of the parameter
+		Name string
+		// The data type of the parameter
+		DataType DataType
+	}
+
+	CreateFunctionClauses interface {
+		HasFunction() bool
+		clone() *createFunctionClauses
+
+		Function() Expression
+		SetFunction(function Expression) CreateFunctionClauses
+
+		IsOrReplace() bool
+		SetOrReplace(orReplace bool) CreateFunctionClauses
+
+		Params() []FunctionParam
+		SetParams(params []FunctionParam) CreateFunctionClauses
+
+		Returns() DataType
+		SetReturns(returns DataType) CreateFunctionClauses
+
+		Language() string
+		SetLanguage(language string) CreateFunctionClauses
+
+		Body() string
+		SetBody(body string) CreateFunctionClauses
+	}
+	createFunctionClauses struct {
+		function  Expression
+		orReplace bool
+		params    []FunctionParam
+		returns   DataType
+		language  string
+		body      string
+	}
+)
+
+func NewCreateFunctionClauses() CreateFunctionClauses {
+	return &createFunctionClauses{}
+}
+
+func (cfc *createFunctionClauses) HasFunction() bool {
+	return cfc.function != nil
+}
+
+func (cfc *createFunctionClauses) clone() *createFunctionClauses {
+	return &createFunctionClauses{
+		function:  cfc.function,
+		orReplace: cfc.orReplace,
+		params:    cfc.params,
+		returns:   cfc.returns,
+		language:  cfc.language,
+		body:      cfc.body,
+	}
+}
+
+func (cfc *createFunctionClauses) Function() Expression {
+	return cfc.function
+}
+
+func (cfc *createFunctionClauses) SetFunction(function Expression) CreateFunctionClauses {
+	ret := cfc.clone()
+	ret.function = function
+	return ret
+}
+
+func (cfc *createFunctionClauses) IsOrReplace() bool {
+	return cfc.orReplace
+}
+
+func (cfc *createFunctionClauses) SetOrReplace(orReplace bool) CreateFunctionClauses {
+	ret := cfc.clone()
+	ret.orReplace = orReplace
+	return ret
+}
+
+func (cfc *createFunctionClauses) Params() []FunctionParam {
+	return cfc.params
+}
+
+func (cfc *createFunctionClauses) SetParams(params []FunctionParam) CreateFunctionClauses {
+	ret := cfc.clone()
+	ret.params = params
+	return ret
+}
+
+func (cfc *createFunctionClauses) Returns() DataType {
+	return cfc.returns
+}
+
+func (cfc *createFunctionClauses) SetReturns(returns DataType) CreateFunctionClauses {
+	ret := cfc.clone()
+	ret.returns = returns
+	return ret
+}
+
+func (cfc *createFunctionClauses) Language() string {
+	return cfc.language
+}
+
+func (cfc *createFunctionClauses) SetLanguage(language string) CreateFunctionClauses {
+	ret := cfc.clone()
+	ret.language = language
+	return ret
+}
+
+func (cfc *createFunctionClauses) Body() string {
+	return cfc.body
+}
+
+func (cfc *createFunctionClauses) SetBody(body string) CreateFunctionClauses {
+	ret := cfc.clone()
+	ret.body = body
+	return ret
+}
@@ -0,0 +1,83 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type createFunctionClausesSuite struct {
+	suite.Suite
+}
+
+func TestCreateFunctionClausesSuite(t *testing.T) {
+	suite.Run(t, new(createFunctionClausesSuite))
+}
+
+func (cfcs *createFunctionClausesSuite) TestHasFunction() {
+	c := exp.NewCreateFunctionClauses()
+	c2 := c.SetFunction(exp.NewIdentifierExpression("", "test", ""))
+
+	cfcs.False(c.HasFunction())
+
+	cfcs.True(c2.HasFunction())
+}
+
+func (cfcs *createFunctionClausesSuite) TestSetFunction() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewCreateFunctionClauses().SetFunction(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetFunction(ti2)
+
+	cfcs.Equal(ti, c.Function())
+
+	cfcs.Equal(ti2, c2.Function())
+}
+
+func (cfcs *createFunctionClausesSuite) TestSetOrReplace() {
+	c := exp.NewCreateFunctionClauses()
+	c2 := c.SetOrReplace(true)
+
+	cfcs.False(c.IsOrReplace())
+
+	cfcs.True(c2.IsOrReplace())
+}
+
+func (cfcs *createFunctionClausesSuite) TestSetParams() {
+	c := exp.NewCreateFunctionClauses()
+	params := []exp.FunctionParam{{Name: "a", DataType: exp.NewDataType(exp.IntegerDataType)}}
+	c2 := c.SetParams(params)
+
+	cfcs.Nil(c.Params())
+
+	cfcs.Equal(params, c2.Params())
+}
+
+func (cfcs *createFunctionClausesSuite) TestSetReturns() {
+	c := exp.NewCreateFunctionClauses()
+	dt := exp.NewCustomDataType("TRIGGER")
+	c2 := c.SetReturns(dt)
+
+	cfcs.Nil(c.Returns())
+
+	cfcs.Equal(dt, c2.Returns())
+}
+
+func (cfcs *createFunctionClausesSuite) TestSetLanguage() {
+	c := exp.NewCreateFunctionClauses()
+	c2 := c.SetLanguage("plpgsql")
+
+	cfcs.Empty(c.Language())
+
+	cfcs.Equal("plpgsql", c2.Language())
+}
+
+func (cfcs *createFunctionClausesSuite) TestSetBody() {
+	c := exp.NewCreateFunctionClauses()
+	c2 := c.SetBody("SELECT 1")
+
+	cfcs.Empty(c.Body())
+
+	cfcs.Equal("SELECT 1", c2.Body())
+}
@@ -0,0 +1,154 @@
+package exp
+
+type (
+	CreateIndexClauses interface {
+		HasTable() bool
+		clone() *createIndexClauses
+
+		Name() string
+		SetName(name string) CreateIndexClauses
+
+		Table() Expression
+		SetTable(table Expression) CreateIndexClauses
+
+		IsUnique() bool
+		SetUnique(unique bool) CreateIndexClauses
+
+		IsConcurrently() bool
+		SetConcurrently(concurrently bool) CreateIndexClauses
+
+		IsIfNotExists() bool
+		SetIfNotExists(ifNotExists bool) CreateIndexClauses
+
+		Method() string
+		SetMethod(method string) CreateIndexClauses
+
+		Columns() ColumnListExpression
+		ColumnsAppend(cols ColumnListExpression) CreateIndexClauses
+
+		Where() ExpressionList
+		WhereAppend(expressions ...Expression) CreateIndexClauses
+	}
+	createIndexClauses struct {
+		name         string
+		table        Expression
+		unique       bool
+		concurrently bool
+		ifNotExists  bool
+		method       string
+		columns      ColumnListExpression
+		where        ExpressionList
+	}
+)
+
+func NewCreateIndexClauses() CreateIndexClauses {
+	return &createIndexClauses{}
+}
+
+func (cic *createIndexClauses) HasTable() bool {
+	return cic.table != nil
+}
+
+func (cic *createIndexClauses) clone() *createIndexClauses {
+	return &createIndexClauses{
+		name:         cic.name,
+		table:        cic.table,
+		unique:       cic.unique,
+		concurrently: cic.concurrently,
+		ifNotExists:  cic.ifNotExists,
+		method:       cic.method,
+		columns:      cic.columns,
+		where:        cic.where,
+	}
+}
+
+func (cic *createIndexClauses) Name() string {
+	return cic.name
+}
+
+func (cic *createIndexClauses) SetName(name string) CreateIndexClauses {
+	ret := cic.clone()
+	ret.name = name
+	return ret
+}
+
+func (cic *createIndexClauses) Table() Expression {
+	return cic.table
+}
+
+func (cic *createIndexClauses) SetTable(table Expression) CreateIndexClauses {
+	ret := cic.clone()
+	ret.table = table
+	return ret
+}
+
+func (cic *createIndexClauses) IsUnique() bool {
+	return cic.unique
+}
+
+func (cic *createIndexClauses) SetUnique(unique bool) CreateIndexClauses {
+	ret := cic.clone()
+	ret.unique = unique
+	return ret
+}
+
+func (cic *createIndexClauses) IsConcurrently() bool {
+	return cic.concurrently
+}
+
+func (cic *createIndexClauses) SetConcurrently(concurrently bool) CreateIndexClauses {
+	ret := cic.clone()
+	ret.concurrently = concurrently
+	return ret
+}
+
+func (cic *createIndexClauses) IsIfNotExists() bool {
+	return cic.ifNotExists
+}
+
+func (cic *createIndexClauses) SetIfNotExists(ifNotExists bool) CreateIndexClauses {
+	ret := cic.clone()
+	ret.ifNotExists = ifNotExists
+	return ret
+}
+
+func (cic *createIndexClauses) Method() string {
+	return cic.method
+}
+
+func (cic *createIndexClauses) SetMethod(method string) CreateIndexClauses {
+	ret := cic.clone()
+	ret.method = method
+	return ret
+}
+
+func (cic *createIndexClauses) Columns() ColumnListExpression {
+	return cic.columns
+}
+
+func (cic *createIndexClauses) ColumnsAppend(cols ColumnListExpression) CreateIndexClauses {
+	ret := cic.clone()
+	if ret.columns == nil {
+		ret.columns = cols
+	} else {
+		ret.columns = ret.columns.Append(cols.Columns()...)
+	}
+	return ret
+}
+
+func (cic *createIndexClauses) Where() ExpressionList {
+	return cic.where
+}
+
+func (cic *createIndexClauses) WhereAppend(expressions ...Expression) CreateIndexClauses {
+	if len(expressions) == 0 {
+		return cic
+	}
+	ret := cic.clone()
+	if ret.where == nil {
+		ret.where = NewExpressionList(AndType, expressions...)
+	} else {
+		ret.where = ret.where.Append(expressions...)
+	}
+	return ret
+}
@@ -0,0 +1,108 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type createIndexClausesSuite struct {
+	suite.Suite
+}
+
+func TestCreateIndexClausesSuite(t *testing.T) {
+	suite.Run(t, new(createIndexClausesSuite))
+}
+
+func (cics *createIndexClausesSuite) TestHasTable() {
+	c := exp.NewCreateIndexClauses()
+	c2 := c.SetTable(exp.NewIdentifierExpression("", "test", ""))
+
+	cics.False(c.HasTable())
+
+	cics.True(c2.HasTable())
+}
+
+func (cics *createIndexClausesSuite) TestSetName() {
+	c := exp.NewCreateIndexClauses().SetName("a")
+	c2 := c.SetName("b")
+
+	cics.Equal("a", c.Name())
+
+	cics.Equal("b", c2.Name())
+}
+
+func (cics *createIndexClausesSuite) TestSetTable() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewCreateIndexClauses().SetTable(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetTable(ti2)
+
+	cics.Equal(ti, c.Table())
+
+	cics.Equal(ti2, c2.Table())
+}
+
+func (cics *createIndexClausesSuite) TestSetUnique() {
+	c := exp.NewCreateIndexClauses()
+	c2 := c.SetUnique(true)
+
+	cics.False(c.IsUnique())
+
+	cics.True(c2.IsUnique())
+}
+
+func (cics *createIndexClausesSuite) TestSetConcurrently() {
+	c := exp.NewCreateIndexClauses()
+	c2 := c.SetConcurrently(true)
+
+	cics.False(c.IsConcurrently())
+
+	cics.True(c2.IsConcurrently())
+}
+
+func (cics *createIndexClausesSuite) TestSetIfNotExists() {
+	c := exp.NewCreateIndexClauses()
+	c2 := c.SetIfNotExists(true)
+
+	cics.False(c.IsIfNotExists())
+
+	cics.True(c2.IsIfNotExists())
+}
+
+func (cics *createIndexClausesSuite) TestSetMethod() {
+	c := exp.NewCreateIndexClauses()
+	c2 := c.SetMethod("gin")
+
+	cics.Empty(c.Method())
+
+	cics.Equal("gin", c2.Method())
+}
+
+func (cics *createIndexClausesSuite) TestColumnsAppend() {
+	c := exp.NewCreateIndexClauses()
+	c2 := c.ColumnsAppend(exp.NewColumnListExpression("a"))
+	c3 := c2.ColumnsAppend(exp.NewColumnListExpression("b"))
+
+	cics.Nil(c.Columns())
+
+	cics.Equal(exp.NewColumnListExpression("a"), c2.Columns())
+
+	cics.Equal(exp.NewColumnListExpression("a", "b"), c3.Columns())
+}
+
+func (cics *createIndexClausesSuite) TestWhereAppend() {
+	w := exp.Ex{"a": 1}
+	w2 := exp.Ex{"b": 2}
+	c := exp.NewCreateIndexClauses()
+	c2 := c.WhereAppend(w)
+	c3 := c2.WhereAppend(w2)
+
+	cics.Nil(c.Where())
+	cics.Equal(c, c.WhereAppend())
+
+	cics.Equal(exp.NewExpressionList(exp.AndType, w), c2.Where())
+
+	cics.Equal(exp.NewExpressionList(exp.AndType, w, w2), c3.Where())
+}
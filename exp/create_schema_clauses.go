@@ -0,0 +1,83 @@
+package exp
+
+type (
+	CreateSchemaClauses interface {
+		HasName() bool
+		clone() *createSchemaClauses
+
+		Name() Expression
+		SetName(name Expression) CreateSchemaClauses
+
+		IsDatabase() bool
+		SetDatabase(database bool) CreateSchemaClauses
+
+		IsIfNotExists() bool
+		SetIfNotExists(ifNotExists bool) CreateSchemaClauses
+
+		Authorization() Expression
+		SetAuthorization(role Expression) CreateSchemaClauses
+	}
+	createSchemaClauses struct {
+		name          Expression
+		database      bool
+		ifNotExists   bool
+		authorization Expression
+	}
+)
+
+func NewCreateSchemaClauses() CreateSchemaClauses {
+	return &createSchemaClauses{}
+}
+
+func (csc *createSchemaClauses) HasName() bool {
+	return csc.name != nil
+}
+
+func (csc *createSchemaClauses) clone() *createSchemaClauses {
+	return &createSchemaClauses{
+		name:          csc.name,
+		database:      csc.database,
+		ifNotExists:   csc.ifNotExists,
+		authorization: csc.authorization,
+	}
+}
+
+func (csc *createSchemaClauses) Name() Expression {
+	return csc.name
+}
+
+func (csc *createSchemaClauses) SetName(name Expression) CreateSchemaClauses {
+	ret := csc.clone()
+	ret.name = name
+	return ret
+}
+
+func (csc *createSchemaClauses) IsDatabase() bool {
+	return csc.database
+}
+
+func (csc *createSchemaClauses) SetDatabase(database bool) CreateSchemaClauses {
+	ret := csc.clone()
+	ret.database = database
+	return ret
+}
+
+func (csc *createSchemaClauses) IsIfNotExists() bool {
+	return csc.ifNotExists
+}
+
+func (csc *createSchemaClauses) SetIfNotExists(ifNotExists bool) CreateSchemaClauses {
+	ret := csc.clone()
+	ret.ifNotExists = ifNotExists
+	return ret
+}
+
+func (csc *createSchemaClauses) Authorization() Expression {
+	return csc.authorization
+}
+
+func (csc *createSchemaClauses) SetAuthorization(role Expression) CreateSchemaClauses {
+	ret := csc.clone()
+	ret.authorization = role
+	return ret
+}
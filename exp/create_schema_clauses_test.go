@@ -0,0 +1,64 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type createSchemaClausesSuite struct {
+	suite.Suite
+}
+
+func TestCreateSchemaClausesSuite(t *testing.T) {
+	suite.Run(t, new(createSchemaClausesSuite))
+}
+
+func (cscs *createSchemaClausesSuite) TestHasName() {
+	c := exp.NewCreateSchemaClauses()
+	c2 := c.SetName(exp.NewIdentifierExpression("test", "", ""))
+
+	cscs.False(c.HasName())
+
+	cscs.True(c2.HasName())
+}
+
+func (cscs *createSchemaClausesSuite) TestSetName() {
+	ti := exp.NewIdentifierExpression("test", "", "")
+	c := exp.NewCreateSchemaClauses().SetName(ti)
+	ti2 := exp.NewIdentifierExpression("test2", "", "")
+	c2 := c.SetName(ti2)
+
+	cscs.Equal(ti, c.Name())
+
+	cscs.Equal(ti2, c2.Name())
+}
+
+func (cscs *createSchemaClausesSuite) TestSetDatabase() {
+	c := exp.NewCreateSchemaClauses()
+	c2 := c.SetDatabase(true)
+
+	cscs.False(c.IsDatabase())
+
+	cscs.True(c2.IsDatabase())
+}
+
+func (cscs *createSchemaClausesSuite) TestSetIfNotExists() {
+	c := exp.NewCreateSchemaClauses()
+	c2 := c.SetIfNotExists(true)
+
+	cscs.False(c.IsIfNotExists())
+
+	cscs.True(c2.IsIfNotExists())
+}
+
+func (cscs *createSchemaClausesSuite) TestSetAuthorization() {
+	c := exp.NewCreateSchemaClauses()
+	role := exp.NewIdentifierExpression("", "", "admin")
+	c2 := c.SetAuthorization(role)
+
+	cscs.Nil(c.Authorization())
+
+	cscs.Equal(role, c2.Authorization())
+}
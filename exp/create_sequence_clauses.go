@@ -0,0 +1,68 @@
+package exp
+
+type (
+	CreateSequenceClauses interface {
+		HasSequence() bool
+		clone() *createSequenceClauses
+
+		Sequence() Expression
+		SetSequence(sequence Expression) CreateSequenceClauses
+
+		IsIfNotExists() bool
+		SetIfNotExists(ifNotExists bool) CreateSequenceClauses
+
+		Options() SequenceOptions
+		SetOptions(opts SequenceOptions) CreateSequenceClauses
+	}
+	createSequenceClauses struct {
+		sequence    Expression
+		ifNotExists bool
+		options     SequenceOptions
+	}
+)
+
+func NewCreateSequenceClauses() CreateSequenceClauses {
+	return &createSequenceClauses{}
+}
+
+func (csc *createSequenceClauses) HasSequence() bool {
+	return csc.sequence != nil
+}
+
+func (csc *createSequenceClauses) clone() *createSequenceClauses {
+	return &createSequenceClauses{
+		sequence:    csc.sequence,
+		ifNotExists: csc.ifNotExists,
+		options:     csc.options,
+	}
+}
+
+func (csc *createSequenceClauses) Sequence() Expression {
+	return csc.sequence
+}
+
+func (csc *createSequenceClauses) SetSequence(sequence Expression) CreateSequenceClauses {
+	ret := csc.clone()
+	ret.sequence = sequence
+	return ret
+}
+
+func (csc *createSequenceClauses) IsIfNotExists() bool {
+	return csc.ifNotExists
+}
+
+func (csc *createSequenceClauses) SetIfNotExists(ifNotExists bool) CreateSequenceClauses {
+	ret := csc.clone()
+	ret.ifNotExists = ifNotExists
+	return ret
+}
+
+func (csc *createSequenceClauses) Options() SequenceOptions {
+	return csc.options
+}
+
+func (csc *createSequenceClauses) SetOptions(opts SequenceOptions) CreateSequenceClauses {
+	ret := csc.clone()
+	ret.options = opts
+	return ret
+}
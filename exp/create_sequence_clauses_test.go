@@ -0,0 +1,55 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type createSequenceClausesSuite struct {
+	suite.Suite
+}
+
+func TestCreateSequenceClausesSuite(t *testing.T) {
+	suite.Run(t, new(createSequenceClausesSuite))
+}
+
+func (cscs *createSequenceClausesSuite) TestHasSequence() {
+	c := exp.NewCreateSequenceClauses()
+	c2 := c.SetSequence(exp.NewIdentifierExpression("", "test", ""))
+
+	cscs.False(c.HasSequence())
+
+	cscs.True(c2.HasSequence())
+}
+
+func (cscs *createSequenceClausesSuite) TestSetSequence() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewCreateSequenceClauses().SetSequence(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetSequence(ti2)
+
+	cscs.Equal(ti, c.Sequence())
+
+	cscs.Equal(ti2, c2.Sequence())
+}
+
+func (cscs *createSequenceClausesSuite) TestSetIfNotExists() {
+	c := exp.NewCreateSequenceClauses()
+	c2 := c.SetIfNotExists(true)
+
+	cscs.False(c.IsIfNotExists())
+
+	cscs.True(c2.IsIfNotExists())
+}
+
+func (cscs *createSequenceClausesSuite) TestSetOptions() {
+	incr := int64(2)
+	c := exp.NewCreateSequenceClauses()
+	c2 := c.SetOptions(exp.SequenceOptions{IncrementBy: &incr, Cycle: true})
+
+	cscs.Equal(exp.SequenceOptions{}, c.Options())
+
+	cscs.Equal(exp.SequenceOptions{IncrementBy: &incr, Cycle: true}, c2.Options())
+}
@@ -0,0 +1,208 @@
+package exp
+
+type (
+	// Controls what happens to the rows of a temporary table at the end of a transaction
+	OnCommitAction int
+
+	CreateTableClauses interface {
+		HasTable() bool
+		clone() *createTableClauses
+
+		Table() Expression
+		SetTable(table Expression) CreateTableClauses
+
+		IsTemporary() bool
+		SetTemporary(temporary bool) CreateTableClauses
+
+		IsIfNotExists() bool
+		SetIfNotExists(ifNotExists bool) CreateTableClauses
+
+		Columns() []ColumnDefinition
+		ColumnsAppend(columns ...ColumnDefinition) CreateTableClauses
+
+		Constraints() []TableConstraint
+		ConstraintsAppend(constraints ...TableConstraint) CreateTableClauses
+
+		PartitionBy() PartitionBy
+		SetPartitionBy(partitionBy PartitionBy) CreateTableClauses
+
+		Partitions() []PartitionDefinition
+		PartitionsAppend(partitions ...PartitionDefinition) CreateTableClauses
+
+		PartitionOf() Expression
+		PartitionBound() PartitionBound
+		SetPartitionOf(parent Expression, bound PartitionBound) CreateTableClauses
+
+		// The query used to create and populate the table (e.g. CREATE TABLE ... AS SELECT)
+		AsSelect() AppendableExpression
+		SetAsSelect(query AppendableExpression) CreateTableClauses
+
+		OnCommit() OnCommitAction
+		SetOnCommit(action OnCommitAction) CreateTableClauses
+	}
+	createTableClauses struct {
+		table       Expression
+		temporary   bool
+		ifNotExists bool
+		columns     []ColumnDefinition
+		constraints []TableConstraint
+		partitionBy PartitionBy
+		partitions  []PartitionDefinition
+		partitionOf Expression
+		bound       PartitionBound
+		asSelect    AppendableExpression
+		onCommit    OnCommitAction
+	}
+)
+
+const (
+	// Use the databases default behavior
+	OnCommitDefault OnCommitAction = iota
+	// ON COMMIT PRESERVE ROWS
+	OnCommitPreserveRows
+	// ON COMMIT DELETE ROWS
+	OnCommitDeleteRows
+	// ON COMMIT DROP
+	OnCommitDrop
+)
+
+func (oca OnCommitAction) String() string {
+	switch oca {
+	case OnCommitPreserveRows:
+		return "PRESERVE ROWS"
+	case OnCommitDeleteRows:
+		return "DELETE ROWS"
+	case OnCommitDrop:
+		return "DROP"
+	}
+	return ""
+}
+
+func NewCreateTableClauses() CreateTableClauses {
+	return &createTableClauses{}
+}
+
+func (ctc *createTableClauses) HasTable() bool {
+	return ctc.table != nil
+}
+
+func (ctc *createTableClauses) clone() *createTableClauses {
+	return &createTableClauses{
+		table:       ctc.table,
+		temporary:   ctc.temporary,
+		ifNotExists: ctc.ifNotExists,
+		columns:     ctc.columns[0:len(ctc.columns):len(ctc.columns)],
+		constraints: ctc.constraints[0:len(ctc.constraints):len(ctc.constraints)],
+		partitionBy: ctc.partitionBy,
+		partitions:  ctc.partitions[0:len(ctc.partitions):len(ctc.partitions)],
+		partitionOf: ctc.partitionOf,
+		bound:       ctc.bound,
+		asSelect:    ctc.asSelect,
+		onCommit:    ctc.onCommit,
+	}
+}
+
+func (ctc *createTableClauses) Table() Expression {
+	return ctc.table
+}
+
+func (ctc *createTableClauses) SetTable(table Expression) CreateTableClauses {
+	ret := ctc.clone()
+	ret.table = table
+	return ret
+}
+
+func (ctc *createTableClauses) IsTemporary() bool {
+	return ctc.temporary
+}
+
+func (ctc *createTableClauses) SetTemporary(temporary bool) CreateTableClauses {
+	ret := ctc.clone()
+	ret.temporary = temporary
+	return ret
+}
+
+func (ctc *createTableClauses) IsIfNotExists() bool {
+	return ctc.ifNotExists
+}
+
+func (ctc *createTableClauses) SetIfNotExists(ifNotExists bool) CreateTableClauses {
+	ret := ctc.clone()
+	ret.ifNotExists = ifNotExists
+	return ret
+}
+
+func (ctc *createTableClauses) Columns() []ColumnDefinition {
+	return ctc.columns
+}
+
+func (ctc *createTableClauses) ColumnsAppend(columns ...ColumnDefinition) CreateTableClauses {
+	ret := ctc.clone()
+	ret.columns = append(ret.columns, columns...)
+	return ret
+}
+
+func (ctc *createTableClauses) Constraints() []TableConstraint {
+	return ctc.constraints
+}
+
+func (ctc *createTableClauses) ConstraintsAppend(constraints ...TableConstraint) CreateTableClauses {
+	ret := ctc.clone()
+	ret.constraints = append(ret.constraints, constraints...)
+	return ret
+}
+
+func (ctc *createTableClauses) PartitionBy() PartitionBy {
+	return ctc.partitionBy
+}
+
+func (ctc *createTableClauses) SetPartitionBy(partitionBy PartitionBy) CreateTableClauses {
+	ret := ctc.clone()
+	ret.partitionBy = partitionBy
+	return ret
+}
+
+func (ctc *createTableClauses) Partitions() []PartitionDefinition {
+	return ctc.partitions
+}
+
+func (ctc *createTableClauses) PartitionsAppend(partitions ...PartitionDefinition) CreateTableClauses {
+	ret := ctc.clone()
+	ret.partitions = append(ret.partitions, partitions...)
+	return ret
+}
+
+func (ctc *createTableClauses) PartitionOf() Expression {
+	return ctc.partitionOf
+}
+
+func (ctc *createTableClauses) PartitionBound() PartitionBound {
+	return ctc.bound
+}
+
+func (ctc *createTableClauses) SetPartitionOf(parent Expression, bound PartitionBound) CreateTableClauses {
+	ret := ctc.clone()
+	ret.partitionOf = parent
+	ret.bound = bound
+	return ret
+}
+
+func (ctc *createTableClauses) AsSelect() AppendableExpression {
+	return ctc.asSelect
+}
+
+func (ctc *createTableClauses) SetAsSelect(query AppendableExpression) CreateTableClauses {
+	ret := ctc.clone()
+	ret.asSelect = query
+	return ret
+}
+
+func (ctc *createTableClauses) OnCommit() OnCommitAction {
+	return ctc.onCommit
+}
+
+func (ctc *createTableClauses) SetOnCommit(action OnCommitAction) CreateTableClauses {
+	ret := ctc.clone()
+	ret.onCommit = action
+	return ret
+}
@@ -0,0 +1,129 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type createTableClausesSuite struct {
+	suite.Suite
+}
+
+func TestCreateTableClausesSuite(t *testing.T) {
+	suite.Run(t, new(createTableClausesSuite))
+}
+
+func (ctcs *createTableClausesSuite) TestHasTable() {
+	c := exp.NewCreateTableClauses()
+	c2 := c.SetTable(exp.NewIdentifierExpression("", "test", ""))
+
+	ctcs.False(c.HasTable())
+
+	ctcs.True(c2.HasTable())
+}
+
+func (ctcs *createTableClausesSuite) TestSetTable() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewCreateTableClauses().SetTable(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetTable(ti2)
+
+	ctcs.Equal(ti, c.Table())
+
+	ctcs.Equal(ti2, c2.Table())
+}
+
+func (ctcs *createTableClausesSuite) TestSetTemporary() {
+	c := exp.NewCreateTableClauses()
+	c2 := c.SetTemporary(true)
+
+	ctcs.False(c.IsTemporary())
+
+	ctcs.True(c2.IsTemporary())
+}
+
+func (ctcs *createTableClausesSuite) TestSetIfNotExists() {
+	c := exp.NewCreateTableClauses()
+	c2 := c.SetIfNotExists(true)
+
+	ctcs.False(c.IsIfNotExists())
+
+	ctcs.True(c2.IsIfNotExists())
+}
+
+func (ctcs *createTableClausesSuite) TestColumnsAppend() {
+	cd1 := exp.NewColumnDefinition("a", exp.NewDataType(exp.IntegerDataType))
+	cd2 := exp.NewColumnDefinition("b", exp.NewDataType(exp.TextDataType))
+	c := exp.NewCreateTableClauses().ColumnsAppend(cd1)
+	c2 := c.ColumnsAppend(cd2)
+
+	ctcs.Equal([]exp.ColumnDefinition{cd1}, c.Columns())
+
+	ctcs.Equal([]exp.ColumnDefinition{cd1, cd2}, c2.Columns())
+}
+
+func (ctcs *createTableClausesSuite) TestConstraintsAppend() {
+	tc1 := exp.NewPrimaryKeyConstraint("a")
+	tc2 := exp.NewUniqueConstraint("b")
+	c := exp.NewCreateTableClauses().ConstraintsAppend(tc1)
+	c2 := c.ConstraintsAppend(tc2)
+
+	ctcs.Equal([]exp.TableConstraint{tc1}, c.Constraints())
+
+	ctcs.Equal([]exp.TableConstraint{tc1, tc2}, c2.Constraints())
+}
+
+func (ctcs *createTableClausesSuite) TestSetPartitionBy() {
+	pb := exp.NewPartitionBy(exp.RangePartition, "a")
+	c := exp.NewCreateTableClauses()
+	c2 := c.SetPartitionBy(pb)
+
+	ctcs.Nil(c.PartitionBy())
+
+	ctcs.Equal(pb, c2.PartitionBy())
+}
+
+func (ctcs *createTableClausesSuite) TestPartitionsAppend() {
+	pd1 := exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), exp.NewLessThanPartitionBound(10))
+	pd2 := exp.NewPartitionDefinition(exp.ParseIdentifier("p1"), exp.NewLessThanPartitionBound(20))
+	c := exp.NewCreateTableClauses().PartitionsAppend(pd1)
+	c2 := c.PartitionsAppend(pd2)
+
+	ctcs.Equal([]exp.PartitionDefinition{pd1}, c.Partitions())
+
+	ctcs.Equal([]exp.PartitionDefinition{pd1, pd2}, c2.Partitions())
+}
+
+func (ctcs *createTableClausesSuite) TestSetPartitionOf() {
+	parent := exp.ParseIdentifier("a")
+	bound := exp.NewDefaultPartitionBound()
+	c := exp.NewCreateTableClauses()
+	c2 := c.SetPartitionOf(parent, bound)
+
+	ctcs.Nil(c.PartitionOf())
+	ctcs.Nil(c.PartitionBound())
+
+	ctcs.Equal(parent, c2.PartitionOf())
+	ctcs.Equal(bound, c2.PartitionBound())
+}
+
+func (ctcs *createTableClausesSuite) TestSetAsSelect() {
+	ae := newTestAppendableExpression(`SELECT * FROM "a"`, nil)
+	c := exp.NewCreateTableClauses()
+	c2 := c.SetAsSelect(ae)
+
+	ctcs.Nil(c.AsSelect())
+
+	ctcs.Equal(ae, c2.AsSelect())
+}
+
+func (ctcs *createTableClausesSuite) TestSetOnCommit() {
+	c := exp.NewCreateTableClauses()
+	c2 := c.SetOnCommit(exp.OnCommitDrop)
+
+	ctcs.Equal(exp.OnCommitDefault, c.OnCommit())
+
+	ctcs.Equal(exp.OnCommitDrop, c2.OnCommit())
+}
@@ -0,0 +1,266 @@
+package exp
+
+import "fmt"
+
+type (
+	// The time a trigger is fired (e.g. BEFORE, AFTER)
+	TriggerTiming int
+	// The statement a trigger is fired for (e.g. INSERT, UPDATE)
+	TriggerEvent int
+	// The level of a trigger (e.g. FOR EACH ROW)
+	TriggerLevel int
+
+	CreateTriggerClauses interface {
+		HasTrigger() bool
+		clone() *createTriggerClauses
+
+		Trigger() Expression
+		SetTrigger(trigger Expression) CreateTriggerClauses
+
+		IsOrReplace() bool
+		SetOrReplace(orReplace bool) CreateTriggerClauses
+
+		IsIfNotExists() bool
+		SetIfNotExists(ifNotExists bool) CreateTriggerClauses
+
+		Timing() TriggerTiming
+		SetTiming(timing TriggerTiming) CreateTriggerClauses
+
+		Events() []TriggerEvent
+		SetEvents(events []TriggerEvent) CreateTriggerClauses
+
+		UpdateColumns() ColumnListExpression
+		SetUpdateColumns(cols ColumnListExpression) CreateTriggerClauses
+
+		Table() Expression
+		SetTable(table Expression) CreateTriggerClauses
+
+		Level() TriggerLevel
+		SetLevel(level TriggerLevel) CreateTriggerClauses
+
+		When() Expression
+		SetWhen(when Expression) CreateTriggerClauses
+
+		Function() SQLFunctionExpression
+		SetFunction(function SQLFunctionExpression) CreateTriggerClauses
+
+		Body() string
+		SetBody(body string) CreateTriggerClauses
+	}
+	createTriggerClauses struct {
+		trigger       Expression
+		orReplace     bool
+		ifNotExists   bool
+		timing        TriggerTiming
+		events        []TriggerEvent
+		updateColumns ColumnListExpression
+		table         Expression
+		level         TriggerLevel
+		when          Expression
+		function      SQLFunctionExpression
+		body          string
+	}
+)
+
+const (
+	NoTriggerTiming TriggerTiming = iota
+	// BEFORE
+	BeforeTriggerTiming
+	// AFTER
+	AfterTriggerTiming
+	// INSTEAD OF
+	InsteadOfTriggerTiming
+)
+
+func (t TriggerTiming) String() string {
+	switch t {
+	case NoTriggerTiming:
+		return ""
+	case BeforeTriggerTiming:
+		return "BEFORE"
+	case AfterTriggerTiming:
+		return "AFTER"
+	case InsteadOfTriggerTiming:
+		return "INSTEAD OF"
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+const (
+	// INSERT
+	InsertTriggerEvent TriggerEvent = iota
+	// UPDATE
+	UpdateTriggerEvent
+	// DELETE
+	DeleteTriggerEvent
+	// TRUNCATE
+	TruncateTriggerEvent
+)
+
+func (e TriggerEvent) String() string {
+	switch e {
+	case InsertTriggerEvent:
+		return "INSERT"
+	case UpdateTriggerEvent:
+		return "UPDATE"
+	case DeleteTriggerEvent:
+		return "DELETE"
+	case TruncateTriggerEvent:
+		return "TRUNCATE"
+	}
+	return fmt.Sprintf("%d", e)
+}
+
+const (
+	NoTriggerLevel TriggerLevel = iota
+	// FOR EACH ROW
+	RowTriggerLevel
+	// FOR EACH STATEMENT
+	StatementTriggerLevel
+)
+
+func (l TriggerLevel) String() string {
+	switch l {
+	case NoTriggerLevel:
+		return ""
+	case RowTriggerLevel:
+		return "FOR EACH ROW"
+	case StatementTriggerLevel:
+		return "FOR EACH STATEMENT"
+	}
+	return fmt.Sprintf("%d", l)
+}
+
+func NewCreateTriggerClauses() CreateTriggerClauses {
+	return &createTriggerClauses{}
+}
+
+func (ctc *createTriggerClauses) HasTrigger() bool {
+	return ctc.trigger != nil
+}
+
+func (ctc *createTriggerClauses) clone() *createTriggerClauses {
+	return &createTriggerClauses{
+		trigger:       ctc.trigger,
+		orReplace:     ctc.orReplace,
+		ifNotExists:   ctc.ifNotExists,
+		timing:        ctc.timing,
+		events:        ctc.events,
+		updateColumns: ctc.updateColumns,
+		table:         ctc.table,
+		level:         ctc.level,
+		when:          ctc.when,
+		function:      ctc.function,
+		body:          ctc.body,
+	}
+}
+
+func (ctc *createTriggerClauses) Trigger() Expression {
+	return ctc.trigger
+}
+
+func (ctc *createTriggerClauses) SetTrigger(trigger Expression) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.trigger = trigger
+	return ret
+}
+
+func (ctc *createTriggerClauses) IsOrReplace() bool {
+	return ctc.orReplace
+}
+
+func (ctc *createTriggerClauses) SetOrReplace(orReplace bool) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.orReplace = orReplace
+	return ret
+}
+
+func (ctc *createTriggerClauses) IsIfNotExists() bool {
+	return ctc.ifNotExists
+}
+
+func (ctc *createTriggerClauses) SetIfNotExists(ifNotExists bool) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.ifNotExists = ifNotExists
+	return ret
+}
+
+func (ctc *createTriggerClauses) Timing() TriggerTiming {
+	return ctc.timing
+}
+
+func (ctc *createTriggerClauses) SetTiming(timing TriggerTiming) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.timing = timing
+	return ret
+}
+
+func (ctc *createTriggerClauses) Events() []TriggerEvent {
+	return ctc.events
+}
+
+func (ctc *createTriggerClauses) SetEvents(events []TriggerEvent) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.events = events
+	return ret
+}
+
+func (ctc *createTriggerClauses) UpdateColumns() ColumnListExpression {
+	return ctc.updateColumns
+}
+
+func (ctc *createTriggerClauses) SetUpdateColumns(cols ColumnListExpression) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.updateColumns = cols
+	return ret
+}
+
+func (ctc *createTriggerClauses) Table() Expression {
+	return ctc.table
+}
+
+func (ctc *createTriggerClauses) SetTable(table Expression) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.table = table
+	return ret
+}
+
+func (ctc *createTriggerClauses) Level() TriggerLevel {
+	return ctc.level
+}
+
+func (ctc *createTriggerClauses) SetLevel(level TriggerLevel) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.level = level
+	return ret
+}
+
+func (ctc *createTriggerClauses) When() Expression {
+	return ctc.when
+}
+
+func (ctc *createTriggerClauses) SetWhen(when Expression) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.when = when
+	return ret
+}
+
+func (ctc *createTriggerClauses) Function() SQLFunctionExpression {
+	return ctc.function
+}
+
+func (ctc *createTriggerClauses) SetFunction(function SQLFunctionExpression) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.function = function
+	return ret
+}
+
+func (ctc *createTriggerClauses) Body() string {
+	return ctc.body
+}
+
+func (ctc *createTriggerClauses) SetBody(body string) CreateTriggerClauses {
+	ret := ctc.clone()
+	ret.body = body
+	return ret
+}
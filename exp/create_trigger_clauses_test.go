@@ -0,0 +1,154 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type createTriggerClausesSuite struct {
+	suite.Suite
+}
+
+func TestCreateTriggerClausesSuite(t *testing.T) {
+	suite.Run(t, new(createTriggerClausesSuite))
+}
+
+func (ctcs *createTriggerClausesSuite) TestTriggerTiming_String() {
+	ctcs.Equal("", exp.NoTriggerTiming.String())
+	ctcs.Equal("BEFORE", exp.BeforeTriggerTiming.String())
+	ctcs.Equal("AFTER", exp.AfterTriggerTiming.String())
+	ctcs.Equal("INSTEAD OF", exp.InsteadOfTriggerTiming.String())
+	ctcs.Equal("100", exp.TriggerTiming(100).String())
+}
+
+func (ctcs *createTriggerClausesSuite) TestTriggerEvent_String() {
+	ctcs.Equal("INSERT", exp.InsertTriggerEvent.String())
+	ctcs.Equal("UPDATE", exp.UpdateTriggerEvent.String())
+	ctcs.Equal("DELETE", exp.DeleteTriggerEvent.String())
+	ctcs.Equal("TRUNCATE", exp.TruncateTriggerEvent.String())
+	ctcs.Equal("100", exp.TriggerEvent(100).String())
+}
+
+func (ctcs *createTriggerClausesSuite) TestTriggerLevel_String() {
+	ctcs.Equal("", exp.NoTriggerLevel.String())
+	ctcs.Equal("FOR EACH ROW", exp.RowTriggerLevel.String())
+	ctcs.Equal("FOR EACH STATEMENT", exp.StatementTriggerLevel.String())
+	ctcs.Equal("100", exp.TriggerLevel(100).String())
+}
+
+func (ctcs *createTriggerClausesSuite) TestHasTrigger() {
+	c := exp.NewCreateTriggerClauses()
+	c2 := c.SetTrigger(exp.NewIdentifierExpression("", "test", ""))
+
+	ctcs.False(c.HasTrigger())
+
+	ctcs.True(c2.HasTrigger())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetTrigger() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewCreateTriggerClauses().SetTrigger(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetTrigger(ti2)
+
+	ctcs.Equal(ti, c.Trigger())
+
+	ctcs.Equal(ti2, c2.Trigger())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetOrReplace() {
+	c := exp.NewCreateTriggerClauses()
+	c2 := c.SetOrReplace(true)
+
+	ctcs.False(c.IsOrReplace())
+
+	ctcs.True(c2.IsOrReplace())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetIfNotExists() {
+	c := exp.NewCreateTriggerClauses()
+	c2 := c.SetIfNotExists(true)
+
+	ctcs.False(c.IsIfNotExists())
+
+	ctcs.True(c2.IsIfNotExists())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetTiming() {
+	c := exp.NewCreateTriggerClauses()
+	c2 := c.SetTiming(exp.AfterTriggerTiming)
+
+	ctcs.Equal(exp.NoTriggerTiming, c.Timing())
+
+	ctcs.Equal(exp.AfterTriggerTiming, c2.Timing())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetEvents() {
+	c := exp.NewCreateTriggerClauses()
+	events := []exp.TriggerEvent{exp.InsertTriggerEvent, exp.UpdateTriggerEvent}
+	c2 := c.SetEvents(events)
+
+	ctcs.Nil(c.Events())
+
+	ctcs.Equal(events, c2.Events())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetUpdateColumns() {
+	c := exp.NewCreateTriggerClauses()
+	cols := exp.NewColumnListExpression("a", "b")
+	c2 := c.SetUpdateColumns(cols)
+
+	ctcs.Nil(c.UpdateColumns())
+
+	ctcs.Equal(cols, c2.UpdateColumns())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetTable() {
+	c := exp.NewCreateTriggerClauses()
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c2 := c.SetTable(ti)
+
+	ctcs.Nil(c.Table())
+
+	ctcs.Equal(ti, c2.Table())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetLevel() {
+	c := exp.NewCreateTriggerClauses()
+	c2 := c.SetLevel(exp.RowTriggerLevel)
+
+	ctcs.Equal(exp.NoTriggerLevel, c.Level())
+
+	ctcs.Equal(exp.RowTriggerLevel, c2.Level())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetWhen() {
+	c := exp.NewCreateTriggerClauses()
+	when := exp.NewIdentifierExpression("", "", "a").Gt(1)
+	c2 := c.SetWhen(when)
+
+	ctcs.Nil(c.When())
+
+	ctcs.Equal(when, c2.When())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetFunction() {
+	c := exp.NewCreateTriggerClauses()
+	fn := exp.NewSQLFunctionExpression("audit")
+	c2 := c.SetFunction(fn)
+
+	ctcs.Nil(c.Function())
+
+	ctcs.Equal(fn, c2.Function())
+}
+
+func (ctcs *createTriggerClausesSuite) TestSetBody() {
+	c := exp.NewCreateTriggerClauses()
+	c2 := c.SetBody("DELETE FROM a;")
+
+	ctcs.Empty(c.Body())
+
+	ctcs.Equal("DELETE FROM a;", c2.Body())
+}
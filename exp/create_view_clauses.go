@@ -0,0 +1,157 @@
+package exp
+
+import "fmt"
+
+type (
+	// The CHECK OPTION of a view (e.g. WITH LOCAL CHECK OPTION)
+	ViewCheckOption int
+
+	CreateViewClauses interface {
+		HasView() bool
+		clone() *createViewClauses
+
+		View() Expression
+		SetView(view Expression) CreateViewClauses
+
+		IsOrReplace() bool
+		SetOrReplace(orReplace bool) CreateViewClauses
+
+		IsTemporary() bool
+		SetTemporary(temporary bool) CreateViewClauses
+
+		IsMaterialized() bool
+		SetMaterialized(materialized bool) CreateViewClauses
+
+		Columns() ColumnListExpression
+		SetColumns(cols ColumnListExpression) CreateViewClauses
+
+		Query() AppendableExpression
+		SetQuery(query AppendableExpression) CreateViewClauses
+
+		CheckOption() ViewCheckOption
+		SetCheckOption(checkOption ViewCheckOption) CreateViewClauses
+	}
+	createViewClauses struct {
+		view         Expression
+		orReplace    bool
+		temporary    bool
+		materialized bool
+		columns      ColumnListExpression
+		query        AppendableExpression
+		checkOption  ViewCheckOption
+	}
+)
+
+const (
+	NoViewCheckOption ViewCheckOption = iota
+	// WITH CHECK OPTION
+	DefaultViewCheckOption
+	// WITH CASCADED CHECK OPTION
+	CascadedViewCheckOption
+	// WITH LOCAL CHECK OPTION
+	LocalViewCheckOption
+)
+
+func (o ViewCheckOption) String() string {
+	switch o {
+	case NoViewCheckOption:
+		return ""
+	case DefaultViewCheckOption:
+		return "WITH CHECK OPTION"
+	case CascadedViewCheckOption:
+		return "WITH CASCADED CHECK OPTION"
+	case LocalViewCheckOption:
+		return "WITH LOCAL CHECK OPTION"
+	}
+	return fmt.Sprintf("%d", o)
+}
+
+func NewCreateViewClauses() CreateViewClauses {
+	return &createViewClauses{}
+}
+
+func (cvc *createViewClauses) HasView() bool {
+	return cvc.view != nil
+}
+
+func (cvc *createViewClauses) clone() *createViewClauses {
+	return &createViewClauses{
+		view:         cvc.view,
+		orReplace:    cvc.orReplace,
+		temporary:    cvc.temporary,
+		materialized: cvc.materialized,
+		columns:      cvc.columns,
+		query:        cvc.query,
+		checkOption:  cvc.checkOption,
+	}
+}
+
+func (cvc *createViewClauses) View() Expression {
+	return cvc.view
+}
+
+func (cvc *createViewClauses) SetView(view Expression) CreateViewClauses {
+	ret := cvc.clone()
+	ret.view = view
+	return ret
+}
+
+func (cvc *createViewClauses) IsOrReplace() bool {
+	return cvc.orReplace
+}
+
+func (cvc *createViewClauses) SetOrReplace(orReplace bool) CreateViewClauses {
+	ret := cvc.clone()
+	ret.orReplace = orReplace
+	return ret
+}
+
+func (cvc *createViewClauses) IsTemporary() bool {
+	return cvc.temporary
+}
+
+func (cvc *createViewClauses) SetTemporary(temporary bool) CreateViewClauses {
+	ret := cvc.clone()
+	ret.temporary = temporary
+	return ret
+}
+
+func (cvc *createViewClauses) IsMaterialized() bool {
+	return cvc.materialized
+}
+
+func (cvc *createViewClauses) SetMaterialized(materialized bool) CreateViewClauses {
+	ret := cvc.clone()
+	ret.materialized = materialized
+	return ret
+}
+
+func (cvc *createViewClauses) Columns() ColumnListExpression {
+	return cvc.columns
+}
+
+func (cvc *createViewClauses) SetColumns(cols ColumnListExpression) CreateViewClauses {
+	ret := cvc.clone()
+	ret.columns = cols
+	return ret
+}
+
+func (cvc *createViewClauses) Query() AppendableExpression {
+	return cvc.query
+}
+
+func (cvc *createViewClauses) SetQuery(query AppendableExpression) CreateViewClauses {
+	ret := cvc.clone()
+	ret.query = query
+	return ret
+}
+
+func (cvc *createViewClauses) CheckOption() ViewCheckOption {
+	return cvc.checkOption
+}
+
+func (cvc *createViewClauses) SetCheckOption(checkOption ViewCheckOption) CreateViewClauses {
+	ret := cvc.clone()
+	ret.checkOption = checkOption
+	return ret
+}
@@ -0,0 +1,99 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type createViewClausesSuite struct {
+	suite.Suite
+}
+
+func TestCreateViewClausesSuite(t *testing.T) {
+	suite.Run(t, new(createViewClausesSuite))
+}
+
+func (cvcs *createViewClausesSuite) TestViewCheckOption_String() {
+	cvcs.Equal("", exp.NoViewCheckOption.String())
+	cvcs.Equal("WITH CHECK OPTION", exp.DefaultViewCheckOption.String())
+	cvcs.Equal("WITH CASCADED CHECK OPTION", exp.CascadedViewCheckOption.String())
+	cvcs.Equal("WITH LOCAL CHECK OPTION", exp.LocalViewCheckOption.String())
+	cvcs.Equal("100", exp.ViewCheckOption(100).String())
+}
+
+func (cvcs *createViewClausesSuite) TestHasView() {
+	c := exp.NewCreateViewClauses()
+	c2 := c.SetView(exp.NewIdentifierExpression("", "test", ""))
+
+	cvcs.False(c.HasView())
+
+	cvcs.True(c2.HasView())
+}
+
+func (cvcs *createViewClausesSuite) TestSetView() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewCreateViewClauses().SetView(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetView(ti2)
+
+	cvcs.Equal(ti, c.View())
+
+	cvcs.Equal(ti2, c2.View())
+}
+
+func (cvcs *createViewClausesSuite) TestSetOrReplace() {
+	c := exp.NewCreateViewClauses()
+	c2 := c.SetOrReplace(true)
+
+	cvcs.False(c.IsOrReplace())
+
+	cvcs.True(c2.IsOrReplace())
+}
+
+func (cvcs *createViewClausesSuite) TestSetTemporary() {
+	c := exp.NewCreateViewClauses()
+	c2 := c.SetTemporary(true)
+
+	cvcs.False(c.IsTemporary())
+
+	cvcs.True(c2.IsTemporary())
+}
+
+func (cvcs *createViewClausesSuite) TestSetMaterialized() {
+	c := exp.NewCreateViewClauses()
+	c2 := c.SetMaterialized(true)
+
+	cvcs.False(c.IsMaterialized())
+
+	cvcs.True(c2.IsMaterialized())
+}
+
+func (cvcs *createViewClausesSuite) TestSetColumns() {
+	c := exp.NewCreateViewClauses()
+	c2 := c.SetColumns(exp.NewColumnListExpression("a", "b"))
+
+	cvcs.Nil(c.Columns())
+
+	cvcs.Equal(exp.NewColumnListExpression("a", "b"), c2.Columns())
+}
+
+func (cvcs *createViewClausesSuite) TestSetQuery() {
+	q := newTestAppendableExpression(`SELECT * FROM "test"`, []interface{}{})
+	c := exp.NewCreateViewClauses()
+	c2 := c.SetQuery(q)
+
+	cvcs.Nil(c.Query())
+
+	cvcs.Equal(q, c2.Query())
+}
+
+func (cvcs *createViewClausesSuite) TestSetCheckOption() {
+	c := exp.NewCreateViewClauses()
+	c2 := c.SetCheckOption(exp.LocalViewCheckOption)
+
+	cvcs.Equal(exp.NoViewCheckOption, c.CheckOption())
+
+	cvcs.Equal(exp.LocalViewCheckOption, c2.CheckOption())
+}
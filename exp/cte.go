@@ -1,9 +1,25 @@
 package exp
 
+import "fmt"
+
+// Whether a common table expression is computed once or folded into the parent query (e.g. postgres 12+
+// MATERIALIZED, NOT MATERIALIZED)
+type CTEMaterialization int
+
+const (
+	// Let the database decide whether to materialize the CTE, no keyword is generated (DEFAULT)
+	DefaultCTEMaterialization CTEMaterialization = iota
+	MaterializedCTE
+	NotMaterializedCTE
+)
+
 type commonExpr struct {
-	recursive bool
-	name      LiteralExpression
-	subQuery  Expression
+	recursive       bool
+	materialization CTEMaterialization
+	name            LiteralExpression
+	subQuery        Expression
+	search          CTESearchExpression
+	cycle           CTECycleExpression
 }
 
 // Creates a new WITH common table expression for a SQLExpression, typically Datasets'. This function is used
@@ -12,12 +28,47 @@ func NewCommonTableExpression(recursive bool, name string, subQuery Expression)
 	return commonExpr{recursive: recursive, name: NewLiteralExpression(name), subQuery: subQuery}
 }
 
+func (m CTEMaterialization) String() string {
+	switch m {
+	case DefaultCTEMaterialization:
+		return ""
+	case MaterializedCTE:
+		return "MATERIALIZED"
+	case NotMaterializedCTE:
+		return "NOT MATERIALIZED"
+	}
+	return fmt.Sprintf("%d", m)
+}
+
 func (ce commonExpr) Expression() Expression { return ce }
 
 func (ce commonExpr) Clone() Expression {
-	return commonExpr{recursive: ce.recursive, name: ce.name, subQuery: ce.subQuery.Clone().(SQLExpression)}
+	ret := ce
+	ret.subQuery = ce.subQuery.Clone().(SQLExpression)
+	return ret
 }
 
-func (ce commonExpr) IsRecursive() bool       { return ce.recursive }
-func (ce commonExpr) Name() LiteralExpression { return ce.name }
-func (ce commonExpr) SubQuery() Expression    { return ce.subQuery }
+func (ce commonExpr) IsRecursive() bool                   { return ce.recursive }
+func (ce commonExpr) Materialization() CTEMaterialization { return ce.materialization }
+func (ce commonExpr) Name() LiteralExpression             { return ce.name }
+func (ce commonExpr) SubQuery() Expression                { return ce.subQuery }
+func (ce commonExpr) Search() CTESearchExpression         { return ce.search }
+func (ce commonExpr) Cycle() CTECycleExpression           { return ce.cycle }
+
+func (ce commonExpr) SetMaterialization(materialization CTEMaterialization) CommonTableExpression {
+	ret := ce
+	ret.materialization = materialization
+	return ret
+}
+
+func (ce commonExpr) SetSearch(search CTESearchExpression) CommonTableExpression {
+	ret := ce
+	ret.search = search
+	return ret
+}
+
+func (ce commonExpr) SetCycle(cycle CTECycleExpression) CommonTableExpression {
+	ret := ce
+	ret.cycle = cycle
+	return ret
+}
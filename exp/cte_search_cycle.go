@@ -0,0 +1,158 @@
+package exp
+
+import "fmt"
+
+type (
+	// The order rows of a recursive common table expression are returned in (e.g. SEARCH DEPTH FIRST)
+	CTESearchMode int
+
+	// A SEARCH clause of a recursive common table expression that adds a column used to order the rows
+	//    NewCTESearchExpression(DepthFirstSearch, "id").Set("ord")   // SEARCH DEPTH FIRST BY "id" SET "ord"
+	//    NewCTESearchExpression(BreadthFirstSearch, "id").Set("ord") // SEARCH BREADTH FIRST BY "id" SET "ord"
+	CTESearchExpression interface {
+		Expression
+		// The order the rows are searched in
+		Mode() CTESearchMode
+		// The columns the rows are searched by
+		By() ColumnListExpression
+		// The column that is added to the rows to order them by
+		SequenceColumn() IdentifierExpression
+		// Returns a new CTESearchExpression with the column that is added to the rows to order them by
+		Set(col string) CTESearchExpression
+	}
+	cteSearch struct {
+		mode           CTESearchMode
+		by             ColumnListExpression
+		sequenceColumn IdentifierExpression
+	}
+
+	// A CYCLE clause of a recursive common table expression that stops the recursion when a row is visited twice
+	//    NewCTECycleExpression("id").Set("is_cycle").Using("path")
+	//    // CYCLE "id" SET "is_cycle" USING "path"
+	//    NewCTECycleExpression("id").Set("is_cycle").To("Y", "N").Using("path")
+	//    // CYCLE "id" SET "is_cycle" TO 'Y' DEFAULT 'N' USING "path"
+	CTECycleExpression interface {
+		Expression
+		// The columns used to detect a cycle
+		Columns() ColumnListExpression
+		// The column that is set when a cycle is detected
+		MarkColumn() IdentifierExpression
+		// The value of the mark column when a cycle is detected, if nil the database default is used (e.g. true)
+		MarkValue() interface{}
+		// The value of the mark column when no cycle is detected, if nil the database default is used (e.g. false)
+		MarkDefault() interface{}
+		// The column that contains the rows visited so far
+		PathColumn() IdentifierExpression
+		// Returns a new CTECycleExpression with the column that is set when a cycle is detected
+		Set(col string) CTECycleExpression
+		// Returns a new CTECycleExpression with the values of the mark column
+		To(value, defaultValue interface{}) CTECycleExpression
+		// Returns a new CTECycleExpression with the column that contains the rows visited so far
+		Using(col string) CTECycleExpression
+	}
+	cteCycle struct {
+		columns     ColumnListExpression
+		markColumn  IdentifierExpression
+		markValue   interface{}
+		markDefault interface{}
+		pathColumn  IdentifierExpression
+	}
+)
+
+const (
+	DepthFirstSearch CTESearchMode = iota
+	BreadthFirstSearch
+)
+
+func (m CTESearchMode) String() string {
+	switch m {
+	case DepthFirstSearch:
+		return "DEPTH FIRST"
+	case BreadthFirstSearch:
+		return "BREADTH FIRST"
+	}
+	return fmt.Sprintf("%d", m)
+}
+
+// Creates a new SEARCH clause for a recursive common table expression
+func NewCTESearchExpression(mode CTESearchMode, by ...interface{}) CTESearchExpression {
+	return cteSearch{mode: mode, by: NewColumnListExpression(by...)}
+}
+
+func (s cteSearch) Clone() Expression {
+	return cteSearch{mode: s.mode, by: s.by.Clone().(ColumnListExpression), sequenceColumn: s.sequenceColumn}
+}
+
+func (s cteSearch) Expression() Expression {
+	return s
+}
+
+func (s cteSearch) Mode() CTESearchMode {
+	return s.mode
+}
+
+func (s cteSearch) By() ColumnListExpression {
+	return s.by
+}
+
+func (s cteSearch) SequenceColumn() IdentifierExpression {
+	return s.sequenceColumn
+}
+
+func (s cteSearch) Set(col string) CTESearchExpression {
+	return cteSearch{mode: s.mode, by: s.by, sequenceColumn: NewIdentifierExpression("", "", col)}
+}
+
+// Creates a new CYCLE clause for a recursive common table expression
+func NewCTECycleExpression(cols ...interface{}) CTECycleExpression {
+	return cteCycle{columns: NewColumnListExpression(cols...)}
+}
+
+func (c cteCycle) Clone() Expression {
+	ret := c
+	ret.columns = c.columns.Clone().(ColumnListExpression)
+	return ret
+}
+
+func (c cteCycle) Expression() Expression {
+	return c
+}
+
+func (c cteCycle) Columns() ColumnListExpression {
+	return c.columns
+}
+
+func (c cteCycle) MarkColumn() IdentifierExpression {
+	return c.markColumn
+}
+
+func (c cteCycle) MarkValue() interface{} {
+	return c.markValue
+}
+
+func (c cteCycle) MarkDefault() interface{} {
+	return c.markDefault
+}
+
+func (c cteCycle) PathColumn() IdentifierExpression {
+	return c.pathColumn
+}
+
+func (c cteCycle) Set(col string) CTECycleExpression {
+	ret := c
+	ret.markColumn = NewIdentifierExpression("", "", col)
+	return ret
+}
+
+func (c cteCycle) To(value, defaultValue interface{}) CTECycleExpression {
+	ret := c
+	ret.markValue = value
+	ret.markDefault = defaultValue
+	return ret
+}
+
+func (c cteCycle) Using(col string) CTECycleExpression {
+	ret := c
+	ret.pathColumn = NewIdentifierExpression("", "", col)
+	return ret
+}
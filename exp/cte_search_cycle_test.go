@@ -0,0 +1,61 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type cteSearchCycleSuite struct {
+	suite.Suite
+}
+
+func TestCTESearchCycleSuite(t *testing.T) {
+	suite.Run(t, new(cteSearchCycleSuite))
+}
+
+func (scs *cteSearchCycleSuite) TestSearch() {
+	s := exp.NewCTESearchExpression(exp.DepthFirstSearch, "a", "b")
+	scs.Equal(exp.DepthFirstSearch, s.Mode())
+	scs.Equal(exp.NewColumnListExpression("a", "b"), s.By())
+	scs.Nil(s.SequenceColumn())
+	scs.Equal(s, s.Expression())
+	scs.Equal(s, s.Clone())
+
+	ss := s.Set("ord")
+	scs.Equal(exp.NewIdentifierExpression("", "", "ord"), ss.SequenceColumn())
+	scs.Equal(exp.DepthFirstSearch, ss.Mode())
+	scs.Equal(ss, ss.Clone())
+
+	// the original is not modified
+	scs.Nil(s.SequenceColumn())
+}
+
+func (scs *cteSearchCycleSuite) TestCycle() {
+	c := exp.NewCTECycleExpression("a")
+	scs.Equal(exp.NewColumnListExpression("a"), c.Columns())
+	scs.Nil(c.MarkColumn())
+	scs.Nil(c.MarkValue())
+	scs.Nil(c.MarkDefault())
+	scs.Nil(c.PathColumn())
+	scs.Equal(c, c.Expression())
+	scs.Equal(c, c.Clone())
+
+	cc := c.Set("is_cycle").To("Y", "N").Using("path")
+	scs.Equal(exp.NewIdentifierExpression("", "", "is_cycle"), cc.MarkColumn())
+	scs.Equal("Y", cc.MarkValue())
+	scs.Equal("N", cc.MarkDefault())
+	scs.Equal(exp.NewIdentifierExpression("", "", "path"), cc.PathColumn())
+	scs.Equal(cc, cc.Clone())
+
+	// the original is not modified
+	scs.Nil(c.MarkColumn())
+	scs.Nil(c.PathColumn())
+}
+
+func (scs *cteSearchCycleSuite) TestCTESearchMode_String() {
+	scs.Equal("DEPTH FIRST", exp.DepthFirstSearch.String())
+	scs.Equal("BREADTH FIRST", exp.BreadthFirstSearch.String())
+	scs.Equal("10", exp.CTESearchMode(10).String())
+}
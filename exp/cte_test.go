@@ -0,0 +1,56 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type commonTableExpressionSuite struct {
+	suite.Suite
+}
+
+func TestCommonTableExpressionSuite(t *testing.T) {
+	suite.Run(t, new(commonTableExpressionSuite))
+}
+
+func (ctes *commonTableExpressionSuite) TestMaterialization() {
+	sq := exp.NewLiteralExpression("SELECT * FROM b")
+	cte := exp.NewCommonTableExpression(false, "a", sq)
+	ctes.Equal(exp.DefaultCTEMaterialization, cte.Materialization())
+
+	mcte := cte.SetMaterialization(exp.MaterializedCTE)
+	ctes.Equal(exp.MaterializedCTE, mcte.Materialization())
+	ctes.False(mcte.IsRecursive())
+	ctes.Equal(cte.Name(), mcte.Name())
+	ctes.Equal(sq, mcte.SubQuery())
+
+	// the original is not modified
+	ctes.Equal(exp.DefaultCTEMaterialization, cte.Materialization())
+}
+
+func (ctes *commonTableExpressionSuite) TestSearchCycle() {
+	sq := exp.NewLiteralExpression("SELECT * FROM b")
+	search := exp.NewCTESearchExpression(exp.DepthFirstSearch, "id").Set("ord")
+	cycle := exp.NewCTECycleExpression("id").Set("is_cycle").Using("path")
+	cte := exp.NewCommonTableExpression(true, "a", sq)
+	ctes.Nil(cte.Search())
+	ctes.Nil(cte.Cycle())
+
+	scte := cte.SetSearch(search).SetCycle(cycle)
+	ctes.Equal(search, scte.Search())
+	ctes.Equal(cycle, scte.Cycle())
+	ctes.True(scte.IsRecursive())
+
+	// the original is not modified
+	ctes.Nil(cte.Search())
+	ctes.Nil(cte.Cycle())
+}
+
+func (ctes *commonTableExpressionSuite) TestCTEMaterialization_String() {
+	ctes.Equal("", exp.DefaultCTEMaterialization.String())
+	ctes.Equal("MATERIALIZED", exp.MaterializedCTE.String())
+	ctes.Equal("NOT MATERIALIZED", exp.NotMaterializedCTE.String())
+	ctes.Equal("10", exp.CTEMaterialization(10).String())
+}
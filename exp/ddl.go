@@ -0,0 +1,452 @@
+package exp
+
+import "fmt"
+
+type (
+	// A data type that is mapped to the type of each dialect when generating DDL (see SQLDialectOptions.DataTypeLookup)
+	DataTypeKind int
+
+	// The type of a column in a DDL statement (e.g. CREATE TABLE)
+	DataType interface {
+		Expression
+		// The kind of the type, used to look up the type of the dialect
+		Kind() DataTypeKind
+		// The name of a CustomDataType which is written as is
+		Name() string
+		// The size or the precision and scale of the type (e.g. VARCHAR(255), DECIMAL(10, 2))
+		Params() []int
+	}
+	dataType struct {
+		kind   DataTypeKind
+		name   string
+		params []int
+	}
+
+	// A column in a DDL statement (e.g. CREATE TABLE)
+	//    NewColumnDefinition("id", NewDataType(BigIntDataType)).PrimaryKey().AutoIncrement()
+	ColumnDefinition interface {
+		Expression
+		// The name of the column
+		Name() string
+		// The type of the column
+		DataType() DataType
+		// Returns true if the column is NOT NULL
+		IsNotNull() bool
+		// Adds NOT NULL to the column
+		NotNull() ColumnDefinition
+		// Returns true if the column has a DEFAULT
+		HasDefault() bool
+		// The DEFAULT of the column
+		DefaultValue() interface{}
+		// Sets the DEFAULT of the column, the value is always interpolated
+		Default(val interface{}) ColumnDefinition
+		// Returns true if the column is the PRIMARY KEY
+		IsPrimaryKey() bool
+		// Adds PRIMARY KEY to the column
+		PrimaryKey() ColumnDefinition
+		// Returns true if the column is UNIQUE
+		IsUnique() bool
+		// Adds UNIQUE to the column
+		Unique() ColumnDefinition
+		// Returns true if the values of the column are generated (e.g. AUTO_INCREMENT, IDENTITY)
+		IsAutoIncrement() bool
+		// Generates the values of the column using the AutoIncrementFragment of the dialect
+		AutoIncrement() ColumnDefinition
+		// Returns true if the column has a COMMENT
+		HasComment() bool
+		// The COMMENT of the column
+		CommentValue() string
+		// Sets the COMMENT of the column (e.g. mysql `a` INT COMMENT 'b'), see ColumnCommentFragment
+		Comment(comment string) ColumnDefinition
+	}
+	columnDefinition struct {
+		name          string
+		dataType      DataType
+		notNull       bool
+		hasDefault    bool
+		defaultValue  interface{}
+		primaryKey    bool
+		unique        bool
+		autoIncrement bool
+		hasComment    bool
+		comment       string
+	}
+
+	// The type of a table constraint
+	TableConstraintType int
+
+	// The action of a FOREIGN KEY constraint when a referenced row is deleted or updated (e.g. ON DELETE CASCADE)
+	ReferentialAction int
+
+	// A table constraint in a DDL statement (e.g. PRIMARY KEY ("a", "b"))
+	TableConstraint interface {
+		Expression
+		// The type of the constraint
+		ConstraintType() TableConstraintType
+		// The name of the constraint, the constraint is not named if empty
+		Name() string
+		// Returns a copy of the constraint with the name set (e.g. CONSTRAINT "name" PRIMARY KEY ("a"))
+		Named(name string) TableConstraint
+		// The columns of the constraint
+		Columns() ColumnListExpression
+		// The table referenced by a FOREIGN KEY constraint
+		ReferencedTable() Expression
+		// The columns referenced by a FOREIGN KEY constraint, the PRIMARY KEY of the table is referenced if empty
+		ReferencedColumns() ColumnListExpression
+		// Returns a copy of the FOREIGN KEY constraint with the referenced table and columns set, strings are turned
+		// into identifiers
+		//    NewForeignKeyConstraint("a").References("b", "id") // FOREIGN KEY ("a") REFERENCES "b" ("id")
+		References(table interface{}, cols ...interface{}) TableConstraint
+		// The ON DELETE action of a FOREIGN KEY constraint
+		OnDeleteAction() ReferentialAction
+		// Returns a copy of the FOREIGN KEY constraint with the ON DELETE action set
+		OnDelete(action ReferentialAction) TableConstraint
+		// The ON UPDATE action of a FOREIGN KEY constraint
+		OnUpdateAction() ReferentialAction
+		// Returns a copy of the FOREIGN KEY constraint with the ON UPDATE action set
+		OnUpdate(action ReferentialAction) TableConstraint
+		// The condition of a CHECK constraint
+		CheckExpression() Expression
+		// The index method of an EXCLUDE constraint (e.g. gist), the default method is used if empty
+		IndexMethod() string
+		// The columns or expressions and operators of an EXCLUDE constraint
+		ExcludeElements() []ExcludeElement
+		// The predicate of an EXCLUDE constraint
+		WhereExpression() Expression
+		// Returns a copy of the EXCLUDE constraint with the predicate set, the constraint only applies to the rows
+		// that match the predicate
+		Where(expression Expression) TableConstraint
+	}
+	tableConstraint struct {
+		constraintType    TableConstraintType
+		name              string
+		columns           ColumnListExpression
+		referencedTable   Expression
+		referencedColumns ColumnListExpression
+		onDelete          ReferentialAction
+		onUpdate          ReferentialAction
+		check             Expression
+		indexMethod       string
+		excludeElements   []ExcludeElement
+		where             Expression
+	}
+
+	// A column or expression and the operator it is compared with in an EXCLUDE constraint
+	//    NewExcludeElement("during", "&&") // "during" WITH &&
+	ExcludeElement interface {
+		Expression
+		// The column or expression
+		Column() Expression
+		// The operator used to compare the values
+		Operator() string
+	}
+	excludeElement struct {
+		column   Expression
+		operator string
+	}
+)
+
+const (
+	CustomDataType DataTypeKind = iota
+	SmallIntDataType
+	IntegerDataType
+	BigIntDataType
+	DecimalDataType
+	RealDataType
+	DoubleDataType
+	BooleanDataType
+	CharDataType
+	VarcharDataType
+	TextDataType
+	DateDataType
+	TimeDataType
+	TimestampDataType
+	TimestampTzDataType
+	BinaryDataType
+	UUIDDataType
+	JSONDataType
+)
+
+const (
+	PrimaryKeyConstraintType TableConstraintType = iota
+	UniqueConstraintType
+	ForeignKeyConstraintType
+	CheckConstraintType
+	ExcludeConstraintType
+)
+
+const (
+	// The action is not set, the default of the database is used (NO ACTION)
+	NoReferentialAction ReferentialAction = iota
+	RestrictReferentialAction
+	CascadeReferentialAction
+	SetNullReferentialAction
+	SetDefaultReferentialAction
+	NoActionReferentialAction
+)
+
+func (k DataTypeKind) String() string {
+	switch k {
+	case CustomDataType:
+		return "Custom"
+	case SmallIntDataType:
+		return "SmallInt"
+	case IntegerDataType:
+		return "Integer"
+	case BigIntDataType:
+		return "BigInt"
+	case DecimalDataType:
+		return "Decimal"
+	case RealDataType:
+		return "Real"
+	case DoubleDataType:
+		return "Double"
+	case BooleanDataType:
+		return "Boolean"
+	case CharDataType:
+		return "Char"
+	case VarcharDataType:
+		return "Varchar"
+	case TextDataType:
+		return "Text"
+	case DateDataType:
+		return "Date"
+	case TimeDataType:
+		return "Time"
+	case TimestampDataType:
+		return "Timestamp"
+	case TimestampTzDataType:
+		return "TimestampTz"
+	case BinaryDataType:
+		return "Binary"
+	case UUIDDataType:
+		return "UUID"
+	case JSONDataType:
+		return "JSON"
+	}
+	return fmt.Sprintf("%d", k)
+}
+
+func (t TableConstraintType) String() string {
+	switch t {
+	case PrimaryKeyConstraintType:
+		return "PRIMARY KEY"
+	case UniqueConstraintType:
+		return "UNIQUE"
+	case ForeignKeyConstraintType:
+		return "FOREIGN KEY"
+	case CheckConstraintType:
+		return "CHECK"
+	case ExcludeConstraintType:
+		return "EXCLUDE"
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+func (ra ReferentialAction) String() string {
+	switch ra {
+	case NoReferentialAction:
+		return ""
+	case RestrictReferentialAction:
+		return "RESTRICT"
+	case CascadeReferentialAction:
+		return "CASCADE"
+	case SetNullReferentialAction:
+		return "SET NULL"
+	case SetDefaultReferentialAction:
+		return "SET DEFAULT"
+	case NoActionReferentialAction:
+		return "NO ACTION"
+	}
+	return fmt.Sprintf("%d", ra)
+}
+
+// Creates a new data type that is mapped to the type of the dialect
+//    NewDataType(VarcharDataType, 255) // postgres: VARCHAR(255), sqlserver: NVARCHAR(255)
+func NewDataType(kind DataTypeKind, params ...int) DataType {
+	return dataType{kind: kind, params: params}
+}
+
+// Creates a new data type that is written as is
+//    NewCustomDataType("CITEXT") // CITEXT
+func NewCustomDataType(name string) DataType {
+	return dataType{kind: CustomDataType, name: name}
+}
+
+func (dt dataType) Clone() Expression {
+	return dataType{kind: dt.kind, name: dt.name, params: dt.params}
+}
+
+func (dt dataType) Expression() Expression { return dt }
+func (dt dataType) Kind() DataTypeKind     { return dt.kind }
+func (dt dataType) Name() string           { return dt.name }
+func (dt dataType) Params() []int          { return dt.params }
+
+// Creates a new column definition
+func NewColumnDefinition(name string, dt DataType) ColumnDefinition {
+	return columnDefinition{name: name, dataType: dt}
+}
+
+func (cd columnDefinition) Clone() Expression {
+	return cd
+}
+
+func (cd columnDefinition) Expression() Expression { return cd }
+func (cd columnDefinition) Name() string           { return cd.name }
+func (cd columnDefinition) DataType() DataType     { return cd.dataType }
+func (cd columnDefinition) IsNotNull() bool        { return cd.notNull }
+func (cd columnDefinition) HasDefault() bool       { return cd.hasDefault }
+func (cd columnDefinition) DefaultValue() interface{} {
+	return cd.defaultValue
+}
+func (cd columnDefinition) IsPrimaryKey() bool    { return cd.primaryKey }
+func (cd columnDefinition) IsUnique() bool        { return cd.unique }
+func (cd columnDefinition) IsAutoIncrement() bool { return cd.autoIncrement }
+func (cd columnDefinition) HasComment() bool      { return cd.hasComment }
+func (cd columnDefinition) CommentValue() string  { return cd.comment }
+
+func (cd columnDefinition) NotNull() ColumnDefinition {
+	cd.notNull = true
+	return cd
+}
+
+func (cd columnDefinition) Default(val interface{}) ColumnDefinition {
+	cd.hasDefault = true
+	cd.defaultValue = val
+	return cd
+}
+
+func (cd columnDefinition) PrimaryKey() ColumnDefinition {
+	cd.primaryKey = true
+	return cd
+}
+
+func (cd columnDefinition) Unique() ColumnDefinition {
+	cd.unique = true
+	return cd
+}
+
+func (cd columnDefinition) AutoIncrement() ColumnDefinition {
+	cd.autoIncrement = true
+	return cd
+}
+
+func (cd columnDefinition) Comment(comment string) ColumnDefinition {
+	cd.hasComment = true
+	cd.comment = comment
+	return cd
+}
+
+// Creates a new PRIMARY KEY table constraint
+//    NewPrimaryKeyConstraint("a", "b") // PRIMARY KEY ("a", "b")
+func NewPrimaryKeyConstraint(cols ...interface{}) TableConstraint {
+	return tableConstraint{constraintType: PrimaryKeyConstraintType, columns: NewColumnListExpression(cols...)}
+}
+
+// Creates a new UNIQUE table constraint
+//    NewUniqueConstraint("a", "b") // UNIQUE ("a", "b")
+func NewUniqueConstraint(cols ...interface{}) TableConstraint {
+	return tableConstraint{constraintType: UniqueConstraintType, columns: NewColumnListExpression(cols...)}
+}
+
+// Creates a new FOREIGN KEY table constraint, use References to set the referenced table and columns
+//    NewForeignKeyConstraint("user_id").References("user", "id").OnDelete(CascadeReferentialAction)
+//    // FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE
+func NewForeignKeyConstraint(cols ...interface{}) TableConstraint {
+	return tableConstraint{constraintType: ForeignKeyConstraintType, columns: NewColumnListExpression(cols...)}
+}
+
+// Creates a new CHECK table constraint
+//    NewCheckConstraint(NewIdentifierExpression("", "", "age").Gte(0)) // CHECK ("age" >= 0)
+func NewCheckConstraint(expression Expression) TableConstraint {
+	return tableConstraint{constraintType: CheckConstraintType, check: expression}
+}
+
+// Creates a new EXCLUDE table constraint, the default index method is used if the method is empty
+//    NewExcludeConstraint("gist", NewExcludeElement("room", "="), NewExcludeElement("during", "&&"))
+//    // EXCLUDE USING gist ("room" WITH =, "during" WITH &&)
+func NewExcludeConstraint(method string, elements ...ExcludeElement) TableConstraint {
+	return tableConstraint{constraintType: ExcludeConstraintType, indexMethod: method, excludeElements: elements}
+}
+
+func (tc tableConstraint) Clone() Expression {
+	ret := tc
+	if tc.columns != nil {
+		ret.columns = tc.columns.Clone().(ColumnListExpression)
+	}
+	if tc.referencedColumns != nil {
+		ret.referencedColumns = tc.referencedColumns.Clone().(ColumnListExpression)
+	}
+	ret.excludeElements = tc.excludeElements[0:len(tc.excludeElements):len(tc.excludeElements)]
+	return ret
+}
+
+func (tc tableConstraint) Expression() Expression                  { return tc }
+func (tc tableConstraint) ConstraintType() TableConstraintType     { return tc.constraintType }
+func (tc tableConstraint) Name() string                            { return tc.name }
+func (tc tableConstraint) Columns() ColumnListExpression           { return tc.columns }
+func (tc tableConstraint) ReferencedTable() Expression             { return tc.referencedTable }
+func (tc tableConstraint) ReferencedColumns() ColumnListExpression { return tc.referencedColumns }
+func (tc tableConstraint) OnDeleteAction() ReferentialAction       { return tc.onDelete }
+func (tc tableConstraint) OnUpdateAction() ReferentialAction       { return tc.onUpdate }
+func (tc tableConstraint) CheckExpression() Expression             { return tc.check }
+func (tc tableConstraint) IndexMethod() string                     { return tc.indexMethod }
+func (tc tableConstraint) ExcludeElements() []ExcludeElement       { return tc.excludeElements }
+func (tc tableConstraint) WhereExpression() Expression             { return tc.where }
+
+func (tc tableConstraint) References(table interface{}, cols ...interface{}) TableConstraint {
+	switch t := table.(type) {
+	case string:
+		tc.referencedTable = ParseIdentifier(t)
+	case Expression:
+		tc.referencedTable = t
+	}
+	tc.referencedColumns = nil
+	if len(cols) > 0 {
+		tc.referencedColumns = NewColumnListExpression(cols...)
+	}
+	return tc
+}
+
+func (tc tableConstraint) OnDelete(action ReferentialAction) TableConstraint {
+	tc.onDelete = action
+	return tc
+}
+
+func (tc tableConstraint) OnUpdate(action ReferentialAction) TableConstraint {
+	tc.onUpdate = action
+	return tc
+}
+
+func (tc tableConstraint) Where(expression Expression) TableConstraint {
+	tc.where = expression
+	return tc
+}
+
+func (tc tableConstraint) Named(name string) TableConstraint {
+	tc.name = name
+	return tc
+}
+
+// Creates a new element of an EXCLUDE constraint, strings are turned into identifiers
+//    NewExcludeElement("during", "&&") // "during" WITH &&
+func NewExcludeElement(col interface{}, operator string) ExcludeElement {
+	var column Expression
+	switch c := col.(type) {
+	case string:
+		column = ParseIdentifier(c)
+	case Expression:
+		column = c
+	default:
+		column = NewLiteralExpression("?", c)
+	}
+	return excludeElement{column: column, operator: operator}
+}
+
+func (ee excludeElement) Clone() Expression {
+	return excludeElement{column: ee.column.Clone(), operator: ee.operator}
+}
+
+func (ee excludeElement) Expression() Expression { return ee }
+func (ee excludeElement) Column() Expression     { return ee.column }
+func (ee excludeElement) Operator() string       { return ee.operator }
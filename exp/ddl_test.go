@@ -0,0 +1,156 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type ddlExpressionSuite struct {
+	suite.Suite
+}
+
+func TestDDLExpressionSuite(t *testing.T) {
+	suite.Run(t, new(ddlExpressionSuite))
+}
+
+func (des *ddlExpressionSuite) TestDataType() {
+	dt := exp.NewDataType(exp.DecimalDataType, 10, 2)
+	des.Equal(exp.DecimalDataType, dt.Kind())
+	des.Equal("", dt.Name())
+	des.Equal([]int{10, 2}, dt.Params())
+	des.Equal(dt, dt.Expression())
+	des.Equal(dt, dt.Clone())
+
+	cdt := exp.NewCustomDataType("CITEXT")
+	des.Equal(exp.CustomDataType, cdt.Kind())
+	des.Equal("CITEXT", cdt.Name())
+	des.Empty(cdt.Params())
+}
+
+func (des *ddlExpressionSuite) TestDataTypeKind_String() {
+	des.Equal("Varchar", exp.VarcharDataType.String())
+	des.Equal("TimestampTz", exp.TimestampTzDataType.String())
+	des.Equal("100", exp.DataTypeKind(100).String())
+}
+
+func (des *ddlExpressionSuite) TestColumnDefinition() {
+	dt := exp.NewDataType(exp.BigIntDataType)
+	cd := exp.NewColumnDefinition("id", dt)
+	des.Equal("id", cd.Name())
+	des.Equal(dt, cd.DataType())
+	des.False(cd.IsNotNull())
+	des.False(cd.HasDefault())
+	des.Nil(cd.DefaultValue())
+	des.False(cd.IsPrimaryKey())
+	des.False(cd.IsUnique())
+	des.False(cd.IsAutoIncrement())
+	des.False(cd.HasComment())
+	des.Empty(cd.CommentValue())
+
+	cd2 := cd.NotNull().Default(1).PrimaryKey().Unique().AutoIncrement().Comment("the id")
+	des.True(cd2.IsNotNull())
+	des.True(cd2.HasDefault())
+	des.Equal(1, cd2.DefaultValue())
+	des.True(cd2.IsPrimaryKey())
+	des.True(cd2.IsUnique())
+	des.True(cd2.IsAutoIncrement())
+	des.True(cd2.HasComment())
+	des.Equal("the id", cd2.CommentValue())
+	des.Equal(cd2, cd2.Expression())
+	des.Equal(cd2, cd2.Clone())
+
+	// the original column definition is not modified
+	des.False(cd.IsNotNull())
+	des.False(cd.IsPrimaryKey())
+}
+
+func (des *ddlExpressionSuite) TestTableConstraint() {
+	pk := exp.NewPrimaryKeyConstraint("a", "b")
+	des.Equal(exp.PrimaryKeyConstraintType, pk.ConstraintType())
+	des.Equal("", pk.Name())
+	des.Equal(exp.NewColumnListExpression("a", "b"), pk.Columns())
+	des.Equal(pk, pk.Expression())
+	des.Equal(pk, pk.Clone())
+
+	u := exp.NewUniqueConstraint("a").Named("a_uniq")
+	des.Equal(exp.UniqueConstraintType, u.ConstraintType())
+	des.Equal("a_uniq", u.Name())
+}
+
+func (des *ddlExpressionSuite) TestTableConstraintType_String() {
+	des.Equal("PRIMARY KEY", exp.PrimaryKeyConstraintType.String())
+	des.Equal("UNIQUE", exp.UniqueConstraintType.String())
+	des.Equal("FOREIGN KEY", exp.ForeignKeyConstraintType.String())
+	des.Equal("CHECK", exp.CheckConstraintType.String())
+	des.Equal("EXCLUDE", exp.ExcludeConstraintType.String())
+	des.Equal("100", exp.TableConstraintType(100).String())
+}
+
+func (des *ddlExpressionSuite) TestReferentialAction_String() {
+	des.Equal("", exp.NoReferentialAction.String())
+	des.Equal("RESTRICT", exp.RestrictReferentialAction.String())
+	des.Equal("CASCADE", exp.CascadeReferentialAction.String())
+	des.Equal("SET NULL", exp.SetNullReferentialAction.String())
+	des.Equal("SET DEFAULT", exp.SetDefaultReferentialAction.String())
+	des.Equal("NO ACTION", exp.NoActionReferentialAction.String())
+	des.Equal("100", exp.ReferentialAction(100).String())
+}
+
+func (des *ddlExpressionSuite) TestForeignKeyConstraint() {
+	fk := exp.NewForeignKeyConstraint("a")
+	des.Equal(exp.ForeignKeyConstraintType, fk.ConstraintType())
+	des.Equal(exp.NewColumnListExpression("a"), fk.Columns())
+	des.Nil(fk.ReferencedTable())
+	des.Nil(fk.ReferencedColumns())
+	des.Equal(exp.NoReferentialAction, fk.OnDeleteAction())
+	des.Equal(exp.NoReferentialAction, fk.OnUpdateAction())
+
+	fk2 := fk.References("s.b", "id").OnDelete(exp.CascadeReferentialAction).OnUpdate(exp.SetNullReferentialAction)
+	des.Equal(exp.ParseIdentifier("s.b"), fk2.ReferencedTable())
+	des.Equal(exp.NewColumnListExpression("id"), fk2.ReferencedColumns())
+	des.Equal(exp.CascadeReferentialAction, fk2.OnDeleteAction())
+	des.Equal(exp.SetNullReferentialAction, fk2.OnUpdateAction())
+	des.Equal(fk2, fk2.Clone())
+
+	fk3 := fk.References(exp.NewIdentifierExpression("", "b", nil))
+	des.Equal(exp.NewIdentifierExpression("", "b", nil), fk3.ReferencedTable())
+	des.Nil(fk3.ReferencedColumns())
+
+	// the original constraint is not modified
+	des.Nil(fk.ReferencedTable())
+	des.Equal(exp.NoReferentialAction, fk.OnDeleteAction())
+}
+
+func (des *ddlExpressionSuite) TestCheckConstraint() {
+	check := exp.NewIdentifierExpression("", "", "a").Gt(0)
+	c := exp.NewCheckConstraint(check).Named("a_check")
+	des.Equal(exp.CheckConstraintType, c.ConstraintType())
+	des.Equal("a_check", c.Name())
+	des.Equal(check, c.CheckExpression())
+	des.Nil(c.Columns())
+	des.Equal(c, c.Clone())
+}
+
+func (des *ddlExpressionSuite) TestExcludeConstraint() {
+	el1 := exp.NewExcludeElement("room", "=")
+	el2 := exp.NewExcludeElement(exp.NewLiteralExpression("tsrange(a, b)"), "&&")
+	des.Equal(exp.ParseIdentifier("room"), el1.Column())
+	des.Equal("=", el1.Operator())
+	des.Equal(el1, el1.Expression())
+	des.Equal(el1, el1.Clone())
+	des.Equal(exp.NewLiteralExpression("tsrange(a, b)"), el2.Column())
+
+	where := exp.NewIdentifierExpression("", "", "active").IsTrue()
+	e := exp.NewExcludeConstraint("gist", el1, el2)
+	des.Equal(exp.ExcludeConstraintType, e.ConstraintType())
+	des.Equal("gist", e.IndexMethod())
+	des.Equal([]exp.ExcludeElement{el1, el2}, e.ExcludeElements())
+	des.Nil(e.WhereExpression())
+
+	e2 := e.Where(where)
+	des.Equal(where, e2.WhereExpression())
+	des.Equal(e2, e2.Clone())
+	des.Nil(e.WhereExpression())
+}
@@ -11,6 +11,9 @@ type (
 		From() IdentifierExpression
 		SetFrom(table IdentifierExpression) DeleteClauses
 
+		Hints() []string
+		SetHints(hints []string) DeleteClauses
+
 		Where() ExpressionList
 		ClearWhere() DeleteClauses
 		WhereAppend(expressions ...Expression) DeleteClauses
@@ -34,6 +37,7 @@ type (
 	deleteClauses struct {
 		commonTables []CommonTableExpression
 		from         IdentifierExpression
+		hints        []string
 		where        ExpressionList
 		order        ColumnListExpression
 		limit        interface{}
@@ -53,6 +57,7 @@ func (dc *deleteClauses) clone() *deleteClauses {
 	return &deleteClauses{
 		commonTables: dc.commonTables,
 		from:         dc.from,
+		hints:        dc.hints,
 
 		where:     dc.where,
 		order:     dc.order,
@@ -81,6 +86,16 @@ func (dc *deleteClauses) SetFrom(table IdentifierExpression) DeleteClauses {
 	return ret
 }
 
+func (dc *deleteClauses) Hints() []string {
+	return dc.hints
+}
+
+func (dc *deleteClauses) SetHints(hints []string) DeleteClauses {
+	ret := dc.clone()
+	ret.hints = hints
+	return ret
+}
+
 func (dc *deleteClauses) Where() ExpressionList {
 	return dc.where
 }
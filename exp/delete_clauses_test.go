@@ -44,6 +44,15 @@ func (dcs *deleteClausesSuite) TestSetFrom() {
 	dcs.Equal(ti, c2.From())
 }
 
+func (dcs *deleteClausesSuite) TestHints() {
+	c := exp.NewDeleteClauses()
+	c2 := c.SetHints([]string{"NO_ICP(a)"})
+
+	dcs.Nil(c.Hints())
+	dcs.Equal([]string{"NO_ICP(a)"}, c2.Hints())
+	dcs.Nil(c2.SetHints(nil).Hints())
+}
+
 func (dcs *deleteClausesSuite) TestWhere() {
 	w := exp.Ex{"a": 1}
 
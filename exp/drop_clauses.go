@@ -0,0 +1,163 @@
+package exp
+
+import "fmt"
+
+type (
+	// The type of the object dropped by a DROP statement
+	DropObjectType int
+
+	// Options to use when generating a DROP statement
+	DropOptions struct {
+		// Set to true to add CASCADE to the DROP statement
+		Cascade bool
+		// Set to true to add RESTRICT to the DROP statement
+		Restrict bool
+	}
+
+	DropClauses interface {
+		HasNames() bool
+		clone() *dropClauses
+
+		ObjectType() DropObjectType
+		SetObjectType(objectType DropObjectType) DropClauses
+
+		Names() ColumnListExpression
+		SetNames(names ColumnListExpression) DropClauses
+
+		// The table of a dropped index (e.g. DROP INDEX `a` ON `b`)
+		Table() Expression
+		SetTable(table Expression) DropClauses
+
+		IsIfExists() bool
+		SetIfExists(ifExists bool) DropClauses
+
+		IsConcurrently() bool
+		SetConcurrently(concurrently bool) DropClauses
+
+		Options() DropOptions
+		SetOptions(opts DropOptions) DropClauses
+	}
+	dropClauses struct {
+		objectType   DropObjectType
+		names        ColumnListExpression
+		table        Expression
+		ifExists     bool
+		concurrently bool
+		options      DropOptions
+	}
+)
+
+const (
+	IndexDropObject DropObjectType = iota
+	TableDropObject
+	ViewDropObject
+	MaterializedViewDropObject
+	SequenceDropObject
+	SchemaDropObject
+	DatabaseDropObject
+	TriggerDropObject
+	FunctionDropObject
+)
+
+func (t DropObjectType) String() string {
+	switch t {
+	case IndexDropObject:
+		return "INDEX"
+	case TableDropObject:
+		return "TABLE"
+	case ViewDropObject:
+		return "VIEW"
+	case MaterializedViewDropObject:
+		return "MATERIALIZED VIEW"
+	case SequenceDropObject:
+		return "SEQUENCE"
+	case SchemaDropObject:
+		return "SCHEMA"
+	case DatabaseDropObject:
+		return "DATABASE"
+	case TriggerDropObject:
+		return "TRIGGER"
+	case FunctionDropObject:
+		return "FUNCTION"
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+func NewDropClauses() DropClauses {
+	return &dropClauses{}
+}
+
+func (dc *dropClauses) HasNames() bool {
+	return dc.names != nil && !dc.names.IsEmpty()
+}
+
+func (dc *dropClauses) clone() *dropClauses {
+	return &dropClauses{
+		objectType:   dc.objectType,
+		names:        dc.names,
+		table:        dc.table,
+		ifExists:     dc.ifExists,
+		concurrently: dc.concurrently,
+		options:      dc.options,
+	}
+}
+
+func (dc *dropClauses) ObjectType() DropObjectType {
+	return dc.objectType
+}
+
+func (dc *dropClauses) SetObjectType(objectType DropObjectType) DropClauses {
+	ret := dc.clone()
+	ret.objectType = objectType
+	return ret
+}
+
+func (dc *dropClauses) Names() ColumnListExpression {
+	return dc.names
+}
+
+func (dc *dropClauses) SetNames(names ColumnListExpression) DropClauses {
+	ret := dc.clone()
+	ret.names = names
+	return ret
+}
+
+func (dc *dropClauses) Table() Expression {
+	return dc.table
+}
+
+func (dc *dropClauses) SetTable(table Expression) DropClauses {
+	ret := dc.clone()
+	ret.table = table
+	return ret
+}
+
+func (dc *dropClauses) IsIfExists() bool {
+	return dc.ifExists
+}
+
+func (dc *dropClauses) SetIfExists(ifExists bool) DropClauses {
+	ret := dc.clone()
+	ret.ifExists = ifExists
+	return ret
+}
+
+func (dc *dropClauses) IsConcurrently() bool {
+	return dc.concurrently
+}
+
+func (dc *dropClauses) SetConcurrently(concurrently bool) DropClauses {
+	ret := dc.clone()
+	ret.concurrently = concurrently
+	return ret
+}
+
+func (dc *dropClauses) Options() DropOptions {
+	return dc.options
+}
+
+func (dc *dropClauses) SetOptions(opts DropOptions) DropClauses {
+	ret := dc.clone()
+	ret.options = opts
+	return ret
+}
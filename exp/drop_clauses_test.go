@@ -0,0 +1,97 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type dropClausesSuite struct {
+	suite.Suite
+}
+
+func TestDropClausesSuite(t *testing.T) {
+	suite.Run(t, new(dropClausesSuite))
+}
+
+func (dcs *dropClausesSuite) TestDropObjectType_String() {
+	dcs.Equal("INDEX", exp.IndexDropObject.String())
+	dcs.Equal("TABLE", exp.TableDropObject.String())
+	dcs.Equal("VIEW", exp.ViewDropObject.String())
+	dcs.Equal("MATERIALIZED VIEW", exp.MaterializedViewDropObject.String())
+	dcs.Equal("SEQUENCE", exp.SequenceDropObject.String())
+	dcs.Equal("SCHEMA", exp.SchemaDropObject.String())
+	dcs.Equal("DATABASE", exp.DatabaseDropObject.String())
+	dcs.Equal("TRIGGER", exp.TriggerDropObject.String())
+	dcs.Equal("FUNCTION", exp.FunctionDropObject.String())
+	dcs.Equal("100", exp.DropObjectType(100).String())
+}
+
+func (dcs *dropClausesSuite) TestHasNames() {
+	c := exp.NewDropClauses()
+	c2 := c.SetNames(exp.NewColumnListExpression())
+	c3 := c.SetNames(exp.NewColumnListExpression("a"))
+
+	dcs.False(c.HasNames())
+	dcs.False(c2.HasNames())
+
+	dcs.True(c3.HasNames())
+}
+
+func (dcs *dropClausesSuite) TestSetObjectType() {
+	c := exp.NewDropClauses()
+	c2 := c.SetObjectType(exp.TableDropObject)
+
+	dcs.Equal(exp.IndexDropObject, c.ObjectType())
+
+	dcs.Equal(exp.TableDropObject, c2.ObjectType())
+}
+
+func (dcs *dropClausesSuite) TestSetNames() {
+	c := exp.NewDropClauses().SetNames(exp.NewColumnListExpression("a"))
+	c2 := c.SetNames(exp.NewColumnListExpression("b", "c"))
+
+	dcs.Equal(exp.NewColumnListExpression("a"), c.Names())
+
+	dcs.Equal(exp.NewColumnListExpression("b", "c"), c2.Names())
+}
+
+func (dcs *dropClausesSuite) TestSetTable() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewDropClauses().SetTable(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetTable(ti2)
+
+	dcs.Equal(ti, c.Table())
+
+	dcs.Equal(ti2, c2.Table())
+}
+
+func (dcs *dropClausesSuite) TestSetIfExists() {
+	c := exp.NewDropClauses()
+	c2 := c.SetIfExists(true)
+
+	dcs.False(c.IsIfExists())
+
+	dcs.True(c2.IsIfExists())
+}
+
+func (dcs *dropClausesSuite) TestSetConcurrently() {
+	c := exp.NewDropClauses()
+	c2 := c.SetConcurrently(true)
+
+	dcs.False(c.IsConcurrently())
+
+	dcs.True(c2.IsConcurrently())
+}
+
+func (dcs *dropClausesSuite) TestSetOptions() {
+	opts := exp.DropOptions{Cascade: true}
+	c := exp.NewDropClauses()
+	c2 := c.SetOptions(opts)
+
+	dcs.Equal(exp.DropOptions{}, c.Options())
+
+	dcs.Equal(opts, c2.Options())
+}
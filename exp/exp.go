@@ -24,6 +24,13 @@ type (
 		Cast(val string) CastExpression
 	}
 
+	// Interface that an expression should implement if it can be converted to another time zone.
+	TimeZoneable interface {
+		// Converts a timestamp to the specified time zone
+		//   I("created_at").AtTimeZone("UTC") //("created_at" AT TIME ZONE 'UTC')
+		AtTimeZone(tz interface{}) AtTimeZoneExpression
+	}
+
 	Inable interface {
 		// Creates a Boolean expression for IN clauses
 		//    I("col").In([]string{"a", "b", "c"}) //("col" IN ('a', 'b', 'c'))
@@ -56,6 +63,12 @@ type (
 		IsFalse() BooleanExpression
 		// Shortcut for IsNot(false)
 		IsNotFalse() BooleanExpression
+		// Creates a NULL safe inequality Boolean expression, NULL is not distinct from NULL
+		//   ds.Where(I("a").IsDistinctFrom(I("b"))) //("a" IS DISTINCT FROM "b")
+		IsDistinctFrom(interface{}) BooleanExpression
+		// Creates a NULL safe equality Boolean expression, NULL is not distinct from NULL
+		//   ds.Where(I("a").IsNotDistinctFrom(nil)) //("a" IS NOT DISTINCT FROM NULL)
+		IsNotDistinctFrom(interface{}) BooleanExpression
 	}
 
 	Likeable interface {
@@ -186,6 +199,13 @@ type (
 		// Used to determine if a Select, Update, Insert, or Delete query returns columns
 		ReturnsColumns() bool
 	}
+	// An AppendableExpression that renames the columns it returns when used as a derived table
+	//   From("test").AsTable("t", "a", "b") -> (SELECT * FROM "test") AS "t"("a", "b")
+	AliasedColumnsExpression interface {
+		AppendableExpression
+		// Returns the column aliases, nil if the columns are not renamed
+		GetAsColumns() ColumnListExpression
+	}
 	// Expression for Aliased expressions
 	//   I("a").As("b") -> "a" AS "b"
 	//   SUM("a").As(I("a_sum")) -> SUM("a") AS "a_sum"
@@ -195,6 +215,10 @@ type (
 		Aliased() Expression
 		// Returns the alias value as an identiier expression
 		GetAs() IdentifierExpression
+		// Returns a new AliasedExpression that renames the columns of the aliased expression (e.g. "v"("a", "b"))
+		Columns(cols ...interface{}) AliasedExpression
+		// Returns the column aliases, nil if the columns are not renamed
+		GetAsColumns() ColumnListExpression
 
 		// Returns a new IdentifierExpression with the specified schema
 		Schema(string) IdentifierExpression
@@ -217,6 +241,218 @@ type (
 		LHS() Expression
 		// The right hand side of the expression could be a primitive value, dataset, or expression
 		RHS() interface{}
+		// Returns a copy of a LIKE or ILIKE expression with an ESCAPE clause, an error is returned when generating the
+		// sql for any other operator
+		//   I("a").Like(`100\%`).Escape(`\`) -> ("a" LIKE '100\%' ESCAPE '\')
+		Escape(char string) BooleanExpression
+		// The escape character of a LIKE or ILIKE expression, empty if no ESCAPE clause is used
+		GetEscape() string
+	}
+
+	// Builds JSONExpressions for a JSON value
+	JSONAccessor interface {
+		// Returns the value of a key or an array element
+		//   JSON("data").Get("a") -> ("data" -> 'a')
+		Get(key interface{}) JSONExpression
+		// Returns the value of a key or an array element as text
+		//   JSON("data").GetText("a") -> ("data" ->> 'a')
+		GetText(key interface{}) JSONExpression
+		// Returns the value at a path
+		//   JSON("data").GetPath("a", "b") -> ("data" #> '{"a", "b"}')
+		GetPath(path ...string) JSONExpression
+		// Returns the value at a path as text
+		GetPathText(path ...string) JSONExpression
+		// Returns true if the value contains val
+		Contains(val interface{}) JSONExpression
+		// Returns true if the value is contained by val
+		ContainedBy(val interface{}) JSONExpression
+		// Returns true if the value has the key
+		HasKey(key string) JSONExpression
+		// Returns true if the value has any of the keys
+		HasAnyKey(keys ...string) JSONExpression
+		// Returns true if the value has all of the keys
+		HasAllKeys(keys ...string) JSONExpression
+		// Concatenates the value with val. NOTE: mysql uses JSON_MERGE_PRESERVE which concatenates arrays like postgres
+		// but keeps both values of a key that is in both objects in an array ({"a":1} and {"a":2} -> {"a":[1,2]})
+		// instead of replacing the value ({"a":2}), use Func("JSON_MERGE_PATCH", ...) to replace the keys of objects.
+		Concat(val interface{}) JSONExpression
+	}
+
+	JSONExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Isable
+		Inable
+		Likeable
+		Orderable
+		JSONAccessor
+		// Returns the operation of the expression
+		Op() JSONOperation
+		// The JSON value the operation is applied to
+		LHS() Expression
+		// The key, path or value of the operation
+		RHS() interface{}
+	}
+
+	// A JSON function that is mapped to the function of the dialect (e.g. postgres jsonb_set, mysql JSON_SET)
+	JSONFunctionExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Isable
+		Orderable
+		JSONAccessor
+		// Returns the function
+		Func() JSONFunction
+		// The arguments of the function, see NewJSONFunctionExpression
+		Args() []interface{}
+	}
+
+	// Builds ArrayExpressions for an array value
+	ArrayAccessor interface {
+		// Returns true if the value contains all elements of val
+		//   Array("tags").Contains([]string{"a", "b"}) -> ("tags" @> '{"a", "b"}')
+		Contains(val interface{}) ArrayExpression
+		// Returns true if all elements of the value are in val
+		ContainedBy(val interface{}) ArrayExpression
+		// Returns true if the value and val have elements in common
+		//   Array("tags").Overlaps(ArrayOf("a", "b")) -> ("tags" && ARRAY['a', 'b'])
+		Overlaps(val interface{}) ArrayExpression
+		// Returns the element at index i
+		//   Array("tags").Index(1) -> "tags"[1]
+		Index(i int) ArrayExpression
+		// Returns the elements from index from to index to
+		//   Array("tags").Slice(1, 3) -> "tags"[1:3]
+		Slice(from, to int) ArrayExpression
+	}
+
+	ArrayExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Isable
+		Inable
+		Likeable
+		Orderable
+		ArrayAccessor
+		// Returns the operation of the expression
+		Op() ArrayOperation
+		// The array the operation is applied to
+		LHS() Expression
+		// The other array, index or ArraySliceBounds of the operation
+		RHS() interface{}
+	}
+
+	// Builds RangeTypeExpressions for a range value (e.g. postgres int4range, tstzrange)
+	RangeTypeAccessor interface {
+		// Returns true if the value contains the range or element val, an element should be cast to its type so it is
+		// not parsed as a range (e.g. postgres)
+		//   RangeOf("during").Contains(Cast(V(t), "TIMESTAMPTZ")) -> ("during" @> CAST('2024-01-01T00:00:00Z' AS TIMESTAMPTZ))
+		Contains(val interface{}) RangeTypeExpression
+		// Returns true if the value is contained by the range val
+		ContainedBy(val interface{}) RangeTypeExpression
+		// Returns true if the value and val have points in common
+		//   RangeOf("during").Overlaps(TsTzRange(start, end)) -> ("during" && tstzrange(...))
+		Overlaps(val interface{}) RangeTypeExpression
+		// Returns true if the value and val are adjacent
+		//   RangeOf("during").Adjacent(TsTzRange(start, end)) -> ("during" -|- tstzrange(...))
+		Adjacent(val interface{}) RangeTypeExpression
+	}
+
+	RangeTypeExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Isable
+		Orderable
+		// Returns the operation of the expression
+		Op() RangeTypeOperation
+		// The range the operation is applied to
+		LHS() Expression
+		// The other range or element of the operation
+		RHS() interface{}
+	}
+
+	// A range built from its bounds using the constructor function of the range type (e.g. int4range(1, 10))
+	RangeLiteralExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Orderable
+		RangeTypeAccessor
+		// The name of the range type (e.g. int4range)
+		TypeName() string
+		// The lower bound, nil if unbounded
+		Lower() interface{}
+		// The upper bound, nil if unbounded
+		Upper() interface{}
+		// The inclusive and exclusive bounds (e.g. "[)"), empty if the default of the range type is used
+		Bounds() string
+		// Returns a copy of the range with the inclusive and exclusive bounds ("[)", "(]", "[]" or "()")
+		WithBounds(bounds string) RangeLiteralExpression
+	}
+
+	// An interval of time (e.g. postgres INTERVAL '3 days', mysql INTERVAL 3 DAY)
+	IntervalExpression interface {
+		Expression
+		Aliaseable
+		// The number of units in the interval
+		Quantity() int64
+		// The unit of the interval
+		Unit() IntervalUnit
+	}
+
+	// Builds DateArithmeticExpressions for a date value (e.g. a timestamp column)
+	DateAccessor interface {
+		// Adds the interval to the value
+		//   DateOf("created_at").Add(Interval(3, Days)) -> ("created_at" + INTERVAL '3 days')
+		Add(interval IntervalExpression) DateArithmeticExpression
+		// Subtracts the interval from the value
+		//   DateOf(L("NOW()")).Sub(Interval(1, Hours)) -> (NOW() - INTERVAL '1 hours')
+		Sub(interval IntervalExpression) DateArithmeticExpression
+	}
+
+	DateArithmeticExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Rangeable
+		Orderable
+		DateAccessor
+		TimeZoneable
+		// Returns the operation of the expression
+		Op() DateArithmeticOperation
+		// The date the interval is added to or subtracted from
+		LHS() Expression
+		// The interval that is added or subtracted
+		Interval() IntervalExpression
+	}
+
+	// A timestamp converted to a time zone (e.g. "a" AT TIME ZONE 'UTC', mysql CONVERT_TZ(`a`, @@session.time_zone, 'UTC'))
+	AtTimeZoneExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Rangeable
+		Orderable
+		DateAccessor
+		TimeZoneable
+		// The timestamp that is converted
+		Value() Expression
+		// The time zone the timestamp is converted to
+		TimeZone() interface{}
+	}
+
+	// An array built from values (e.g. ARRAY[1, 2, 3])
+	ArrayConstructorExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Orderable
+		ArrayAccessor
+		// The elements of the array
+		Values() []interface{}
 	}
 
 	BitwiseOperation  int
@@ -249,6 +485,7 @@ type (
 		Orderable
 		Distinctable
 		Rangeable
+		TimeZoneable
 		// The exression being casted
 		Casted() Expression
 		// The the SQL type to cast the expression to
@@ -286,6 +523,19 @@ type (
 	CommonTableExpression interface {
 		Expression
 		IsRecursive() bool
+		// Returns whether the CTE should be computed once (MATERIALIZED) or folded into the parent query
+		// (NOT MATERIALIZED)
+		Materialization() CTEMaterialization
+		// Returns a new CommonTableExpression with the materialization
+		SetMaterialization(materialization CTEMaterialization) CommonTableExpression
+		// Returns the SEARCH clause of a recursive CTE
+		Search() CTESearchExpression
+		// Returns a new CommonTableExpression with the SEARCH clause
+		SetSearch(search CTESearchExpression) CommonTableExpression
+		// Returns the CYCLE clause of a recursive CTE
+		Cycle() CTECycleExpression
+		// Returns a new CommonTableExpression with the CYCLE clause
+		SetCycle(cycle CTECycleExpression) CommonTableExpression
 		// Returns the alias name for the extracted expression
 		Name() LiteralExpression
 		// Returns the Expression being extracted
@@ -320,6 +570,7 @@ type (
 		Distinctable
 		Castable
 		Bitwiseable
+		TimeZoneable
 		// returns true if this identifier has more more than on part (Schema, Table or Col)
 		//	"schema" -> true //cant qualify anymore
 		//	"schema.table" -> true
@@ -377,6 +628,117 @@ type (
 		Table() AppendableExpression
 	}
 
+	// A table reference with hints that can be used in a FROM or a JOIN
+	//   ONLY "parent", `a` USE INDEX (`idx_a`), "a" WITH (NOLOCK)
+	TableHintExpression interface {
+		Expression
+		// The table being hinted, this may be an aliased table
+		Table() Expression
+		// Returns true if the rows of inheriting tables are excluded (e.g. postgres ONLY)
+		IsOnly() bool
+		IndexHints() []IndexHint
+		// The table hints written in the WITH of the table (e.g. sqlserver NOLOCK)
+		TableHints() []string
+		// Returns a new TableHintExpression that excludes the rows of inheriting tables
+		Only() TableHintExpression
+		// Returns a new TableHintExpression with a USE INDEX hint
+		UseIndex(indexes ...string) TableHintExpression
+		// Returns a new TableHintExpression with a FORCE INDEX hint
+		ForceIndex(indexes ...string) TableHintExpression
+		// Returns a new TableHintExpression with an IGNORE INDEX hint
+		IgnoreIndex(indexes ...string) TableHintExpression
+		// Returns a new TableHintExpression with the table hints appended
+		With(hints ...string) TableHintExpression
+	}
+
+	// Expression for the hierarchical query clause of a SELECT (e.g. oracle)
+	//   START WITH ("parent_id" IS NULL) CONNECT BY NOCYCLE (PRIOR "id" = "parent_id")
+	ConnectByExpression interface {
+		Expression
+		StartWith() ExpressionList
+		Condition() ExpressionList
+		IsNoCycle() bool
+		StartWithAppend(expressions ...Expression) ConnectByExpression
+	}
+
+	// Expression for the INTO clause of a SELECT that creates a table from the results of the query
+	//   SELECT * INTO TEMPORARY "staging" FROM "users"
+	SelectIntoExpression interface {
+		Expression
+		Table() IdentifierExpression
+		IsTemporary() bool
+	}
+
+	// Expression for a PIVOT table operator that rotates rows into columns
+	//   NewPivotExpression(T("sales"), SUM("amount")).For("month").In("JAN", "FEB")
+	//   // "sales" PIVOT (SUM("amount") FOR "month" IN ('JAN', 'FEB'))
+	PivotExpression interface {
+		Expression
+		Aliaseable
+		Table() Expression
+		// Returns the aggregates used to compute the values of the new columns
+		Aggregates() []Expression
+		ForColumn() IdentifierExpression
+		InValues() []interface{}
+		For(col string) PivotExpression
+		In(vals ...interface{}) PivotExpression
+	}
+
+	// Expression for an UNPIVOT table operator that rotates columns into rows
+	//   NewUnpivotExpression(T("sales"), "amount").For("month").In("jan", "feb")
+	//   // "sales" UNPIVOT ("amount" FOR "month" IN ("jan", "feb"))
+	UnpivotExpression interface {
+		Expression
+		Aliaseable
+		Table() Expression
+		ValueColumn() IdentifierExpression
+		ForColumn() IdentifierExpression
+		InColumns() []interface{}
+		IsIncludeNulls() bool
+		For(col string) UnpivotExpression
+		In(cols ...interface{}) UnpivotExpression
+		IncludeNulls() UnpivotExpression
+	}
+
+	// Expression for an XMLTABLE that maps the nodes of an XML document to rows
+	//   NewXMLTableExpression("/rows/row", ParseIdentifier("data")).
+	//     Columns(NewXMLTableColumn("id", NewDataType(IntegerDataType)).Path("@id"))
+	//   // XMLTABLE('/rows/row' PASSING "data" COLUMNS "id" INTEGER PATH '@id')
+	XMLTableExpression interface {
+		Expression
+		Aliaseable
+		// The XPath expression that selects the nodes used as rows
+		RowPath() string
+		// The XML document passed to the XMLTABLE
+		Document() interface{}
+		TableColumns() []XMLTableColumn
+		Columns(cols ...XMLTableColumn) XMLTableExpression
+	}
+
+	// A column of an XMLTABLE
+	//   NewXMLTableColumn("id", NewDataType(IntegerDataType)).Path("@id").Default(0) // "id" INTEGER PATH '@id' DEFAULT 0
+	//   NewXMLTableOrdinalityColumn("n") // "n" FOR ORDINALITY
+	XMLTableColumn interface {
+		Expression
+		Name() string
+		DataType() DataType
+		PathExpression() string
+		HasDefault() bool
+		DefaultValue() interface{}
+		IsForOrdinality() bool
+		Path(path string) XMLTableColumn
+		Default(val interface{}) XMLTableColumn
+	}
+
+	// Expression for a VALUES list that can be used as a table
+	//   NewValuesExpression([]interface{}{1, "a"}).As("v").Columns("id", "name") -> (VALUES (1, 'a')) AS "v"("id", "name")
+	ValuesExpression interface {
+		Expression
+		Aliaseable
+		// Returns the rows of values
+		Rows() [][]interface{}
+	}
+
 	// Expression for representing "literal" sql.
 	//  L("col = 1") -> col = 1)
 	//  L("? = ?", I("col"), 1) -> "col" = 1
@@ -390,6 +752,7 @@ type (
 		Rangeable
 		Orderable
 		Bitwiseable
+		TimeZoneable
 		// Returns the literal sql
 		Literal() string
 		// Arguments to be replaced within the sql
@@ -444,10 +807,43 @@ type (
 		Inable
 		Likeable
 		Windowable
+		TimeZoneable
 		// The function name
 		Name() string
 		// Arguments to be passed to the function
 		Args() Args
+		// Returns a TableFunctionExpression that numbers the rows returned by the function (e.g. WITH ORDINALITY)
+		WithOrdinality() TableFunctionExpression
+		// Returns a copy of the aggregate function that only aggregates distinct values
+		//   COUNT("a").Distinct() -> COUNT(DISTINCT "a")
+		Distinct() SQLFunctionExpression
+		// Returns true if the aggregate function only aggregates distinct values
+		IsDistinct() bool
+		// Returns a copy of the aggregate function that aggregates values in the given order
+		//   ARRAY_AGG("a").OrderBy(I("b").Desc()) -> ARRAY_AGG("a" ORDER BY "b" DESC)
+		OrderBy(order ...OrderedExpression) SQLFunctionExpression
+		// The ORDER BY of the aggregate function, nil if the function is not ordered
+		GetOrder() ColumnListExpression
+		// Returns a copy of the aggregate function that only aggregates the rows matching the conditions
+		//   COUNT("*").Filter(C("a").Gt(1)) -> COUNT(*) FILTER (WHERE ("a" > 1))
+		Filter(conditions ...Expression) SQLFunctionExpression
+		// The conditions of the FILTER clause, nil if the function is not filtered
+		GetFilter() ExpressionList
+	}
+
+	// A set returning function used as a table in a FROM or a JOIN
+	//   generate_series(1, 10) WITH ORDINALITY
+	//   ROWS FROM (generate_series(1, 3), unnest('{a,b}'))
+	TableFunctionExpression interface {
+		Expression
+		Aliaseable
+		Functions() []SQLFunctionExpression
+		// Returns true if the results of more than one function are combined (e.g. ROWS FROM)
+		IsRowsFrom() bool
+		// Returns true if a column numbering the rows is added
+		IsWithOrdinality() bool
+		// Returns a new TableFunctionExpression that numbers the rows returned by the functions
+		WithOrdinality() TableFunctionExpression
 	}
 
 	UpdateExpression interface {
@@ -485,10 +881,20 @@ type (
 		HasPartitionBy() bool
 		OrderCols() ColumnListExpression
 		HasOrder() bool
+		Frame() WindowFrame
+		HasFrame() bool
 
 		Inherit(parent string) WindowExpression
 		PartitionBy(cols ...interface{}) WindowExpression
 		OrderBy(cols ...interface{}) WindowExpression
+		// Sets a ROWS frame, if end is nil the frame ends at the current row (e.g. ROWS 2 PRECEDING)
+		Rows(start, end WindowFrameBound) WindowExpression
+		// Sets a RANGE frame, if end is nil the frame ends at the current row
+		Range(start, end WindowFrameBound) WindowExpression
+		// Sets a GROUPS frame, if end is nil the frame ends at the current row
+		Groups(start, end WindowFrameBound) WindowExpression
+		// Sets the rows excluded from the frame, this has no effect if a frame has not been set
+		Exclude(exclusion WindowFrameExclusion) WindowExpression
 	}
 	CaseElse interface {
 		Result() interface{}
@@ -500,14 +906,24 @@ type (
 	CaseExpression interface {
 		Expression
 		Aliaseable
+		Comparable
+		Inable
+		Isable
 		Orderable
 		GetValue() interface{}
 		GetWhens() []CaseWhen
 		GetElse() CaseElse
 		Value(val interface{}) CaseExpression
 		When(condition, result interface{}) CaseExpression
+		// Appends WHEN branches built with NewCaseWhen
+		Whens(whens ...CaseWhen) CaseExpression
 		Else(result interface{}) CaseExpression
 	}
+	// An expression that is written as the CaseExpression it builds (e.g. goqu.TypedCase)
+	CaseBuilder interface {
+		Expression
+		ToCase() CaseExpression
+	}
 )
 
 const (
@@ -515,6 +931,8 @@ const (
 	UnionAllCompoundType
 	IntersectCompoundType
 	IntersectAllCompoundType
+	ExceptCompoundType
+	ExceptAllCompoundType
 
 	DoNothingConflictAction ConflictAction = iota
 	DoUpdateConflictAction
@@ -534,6 +952,7 @@ const (
 	NaturalRightJoinType
 	NaturalFullJoinType
 	CrossJoinType
+	StraightJoinType
 
 	UsingJoinCondType JoinConditionType = iota
 	OnJoinCondType
@@ -590,6 +1009,10 @@ const (
 	RegexpILikeOp
 	// !~*, NOT REGEXP
 	RegexpNotILikeOp
+	// IS DISTINCT FROM
+	IsDistinctFromOp
+	// IS NOT DISTINCT FROM
+	IsNotDistinctFromOp
 
 	betweenStr = "between"
 
@@ -610,6 +1033,7 @@ var (
 		FullJoinType:       true,
 		RightJoinType:      true,
 		LeftJoinType:       true,
+		StraightJoinType:   true,
 	}
 	// used internally for inverting operators
 	operatorInversions = map[BooleanOperation]BooleanOperation{
@@ -631,6 +1055,9 @@ var (
 		NotILikeOp:       ILikeOp,
 		RegexpNotLikeOp:  RegexpLikeOp,
 		RegexpNotILikeOp: RegexpILikeOp,
+
+		IsDistinctFromOp:    IsNotDistinctFromOp,
+		IsNotDistinctFromOp: IsDistinctFromOp,
 	}
 )
 
@@ -672,6 +1099,10 @@ func (bo BooleanOperation) String() string {
 		return "regexpilike"
 	case RegexpNotILikeOp:
 		return "regexpnotilike"
+	case IsDistinctFromOp:
+		return "isdistinctfrom"
+	case IsNotDistinctFromOp:
+		return "isnotdistinctfrom"
 	}
 	return fmt.Sprintf("%d", bo)
 }
@@ -704,6 +1135,24 @@ func (ro RangeOperation) String() string {
 	return fmt.Sprintf("%d", ro)
 }
 
+func (ct CompoundType) String() string {
+	switch ct {
+	case UnionCompoundType:
+		return "UNION"
+	case UnionAllCompoundType:
+		return "UNION ALL"
+	case IntersectCompoundType:
+		return "INTERSECT"
+	case IntersectAllCompoundType:
+		return "INTERSECT ALL"
+	case ExceptCompoundType:
+		return "EXCEPT"
+	case ExceptAllCompoundType:
+		return "EXCEPT ALL"
+	}
+	return fmt.Sprintf("%d", ct)
+}
+
 func (jt JoinType) String() string {
 	switch jt {
 	case InnerJoinType:
@@ -730,6 +1179,8 @@ func (jt JoinType) String() string {
 		return "NaturalFullJoinType"
 	case CrossJoinType:
 		return "CrossJoinType"
+	case StraightJoinType:
+		return "StraightJoinType"
 	}
 	return fmt.Sprintf("%d", jt)
 }
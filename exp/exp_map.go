@@ -147,6 +147,10 @@ func createExpressionFromOp(lhs IdentifierExpression, opKey string, op Op) (exp
 		exp = lhs.RegexpILike(op[opKey])
 	case RegexpNotILikeOp.String():
 		exp = lhs.RegexpNotILike(op[opKey])
+	case IsDistinctFromOp.String():
+		exp = lhs.IsDistinctFrom(op[opKey])
+	case IsNotDistinctFromOp.String():
+		exp = lhs.IsNotDistinctFrom(op[opKey])
 	case betweenStr:
 		rangeVal, ok := op[opKey].(RangeVal)
 		if ok {
@@ -65,6 +65,14 @@ func (ets *exTestSuite) TestToExpression() {
 			ExMap: exp.Ex{"a": exp.Op{"isNot": nil}},
 			El:    exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, ident.IsNot(nil))),
 		},
+		{
+			ExMap: exp.Ex{"a": exp.Op{"isDistinctFrom": "b"}},
+			El:    exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, ident.IsDistinctFrom("b"))),
+		},
+		{
+			ExMap: exp.Ex{"a": exp.Op{"isNotDistinctFrom": "b"}},
+			El:    exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, ident.IsNotDistinctFrom("b"))),
+		},
 		{
 			ExMap: exp.Ex{"a": exp.Op{"gt": "b"}},
 			El:    exp.NewExpressionList(exp.AndType, exp.NewExpressionList(exp.OrType, ident.Gt("b"))),
@@ -0,0 +1,46 @@
+package exp
+
+import "fmt"
+
+type (
+	// The output format of an EXPLAIN statement (e.g. FORMAT JSON)
+	ExplainFormat int
+
+	// Options to use when generating an EXPLAIN statement
+	ExplainOptions struct {
+		// Set to true to execute the statement and include the actual run times (e.g. EXPLAIN ANALYZE)
+		Analyze bool
+		// Set to true to include additional information in the plan (e.g. EXPLAIN VERBOSE)
+		Verbose bool
+		// The format of the plan, the dialects default format is used if not set
+		Format ExplainFormat
+	}
+)
+
+const (
+	// Use the dialects default format
+	ExplainFormatDefault ExplainFormat = iota
+	ExplainFormatText
+	ExplainFormatJSON
+	ExplainFormatXML
+	ExplainFormatYAML
+	ExplainFormatTree
+)
+
+func (ef ExplainFormat) String() string {
+	switch ef {
+	case ExplainFormatDefault:
+		return "DEFAULT"
+	case ExplainFormatText:
+		return "TEXT"
+	case ExplainFormatJSON:
+		return "JSON"
+	case ExplainFormatXML:
+		return "XML"
+	case ExplainFormatYAML:
+		return "YAML"
+	case ExplainFormatTree:
+		return "TREE"
+	}
+	return fmt.Sprintf("%d", ef)
+}
@@ -0,0 +1,26 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type explainSuite struct {
+	suite.Suite
+}
+
+func TestExplainSuite(t *testing.T) {
+	suite.Run(t, new(explainSuite))
+}
+
+func (es *explainSuite) TestExplainFormat_String() {
+	es.Equal("DEFAULT", exp.ExplainFormatDefault.String())
+	es.Equal("TEXT", exp.ExplainFormatText.String())
+	es.Equal("JSON", exp.ExplainFormatJSON.String())
+	es.Equal("XML", exp.ExplainFormatXML.String())
+	es.Equal("YAML", exp.ExplainFormatYAML.String())
+	es.Equal("TREE", exp.ExplainFormatTree.String())
+	es.Equal("100", exp.ExplainFormat(100).String())
+}
@@ -0,0 +1,59 @@
+package exp
+
+type (
+	// A FETCH FIRST limit that can be used in place of a LIMIT to return the rows that tie with the last row or a
+	// percentage of the rows
+	//    NewFetchExpression(10).WithTies()  // FETCH FIRST 10 ROWS WITH TIES
+	//    NewFetchExpression(10).Percent()   // FETCH FIRST 10 PERCENT ROWS ONLY
+	FetchExpression interface {
+		Expression
+		// The number (or percentage) of rows to fetch
+		Count() interface{}
+		// Returns true if the count is a percentage of the rows
+		IsPercent() bool
+		// Returns true if rows that tie with the last row should also be returned
+		IsWithTies() bool
+		// Returns a new FetchExpression where the count is a percentage of the rows
+		Percent() FetchExpression
+		// Returns a new FetchExpression that also returns rows that tie with the last row
+		WithTies() FetchExpression
+	}
+	fetch struct {
+		count    interface{}
+		percent  bool
+		withTies bool
+	}
+)
+
+// Creates a new FETCH FIRST expression for the number of rows
+func NewFetchExpression(count interface{}) FetchExpression {
+	return fetch{count: count}
+}
+
+func (f fetch) Clone() Expression {
+	return fetch{count: f.count, percent: f.percent, withTies: f.withTies}
+}
+
+func (f fetch) Expression() Expression {
+	return f
+}
+
+func (f fetch) Count() interface{} {
+	return f.count
+}
+
+func (f fetch) IsPercent() bool {
+	return f.percent
+}
+
+func (f fetch) IsWithTies() bool {
+	return f.withTies
+}
+
+func (f fetch) Percent() FetchExpression {
+	return fetch{count: f.count, percent: true, withTies: f.withTies}
+}
+
+func (f fetch) WithTies() FetchExpression {
+	return fetch{count: f.count, percent: f.percent, withTies: true}
+}
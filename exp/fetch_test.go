@@ -0,0 +1,48 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type fetchExpressionSuite struct {
+	suite.Suite
+}
+
+func TestFetchExpressionSuite(t *testing.T) {
+	suite.Run(t, new(fetchExpressionSuite))
+}
+
+func (fes *fetchExpressionSuite) TestFetch() {
+	f := exp.NewFetchExpression(uint(10))
+	fes.Equal(uint(10), f.Count())
+	fes.False(f.IsPercent())
+	fes.False(f.IsWithTies())
+	fes.Equal(f, f.Expression())
+	fes.Equal(f, f.Clone())
+}
+
+func (fes *fetchExpressionSuite) TestPercent() {
+	f := exp.NewFetchExpression(uint(10))
+	pf := f.Percent()
+	fes.True(pf.IsPercent())
+	fes.False(pf.IsWithTies())
+	fes.Equal(uint(10), pf.Count())
+	fes.Equal(pf, pf.Clone())
+
+	// the original is not modified
+	fes.False(f.IsPercent())
+}
+
+func (fes *fetchExpressionSuite) TestWithTies() {
+	f := exp.NewFetchExpression(uint(10))
+	wf := f.Percent().WithTies()
+	fes.True(wf.IsPercent())
+	fes.True(wf.IsWithTies())
+	fes.Equal(wf, wf.Clone())
+
+	// the original is not modified
+	fes.False(f.IsWithTies())
+}
@@ -2,8 +2,11 @@ package exp
 
 type (
 	sqlFunctionExpression struct {
-		name string
-		args Args
+		name     string
+		args     Args
+		distinct bool
+		order    ColumnListExpression
+		filter   ExpressionList
 	}
 )
 
@@ -13,7 +16,14 @@ func NewSQLFunctionExpression(name string, args ...interface{}) SQLFunctionExpre
 }
 
 func (sfe sqlFunctionExpression) Clone() Expression {
-	return sqlFunctionExpression{name: sfe.name, args: sfe.args}
+	ret := sqlFunctionExpression{name: sfe.name, args: sfe.args, distinct: sfe.distinct}
+	if sfe.order != nil {
+		ret.order = sfe.order.Clone().(ColumnListExpression)
+	}
+	if sfe.filter != nil {
+		ret.filter = sfe.filter.Clone().(ExpressionList)
+	}
+	return ret
 }
 
 func (sfe sqlFunctionExpression) Expression() Expression { return sfe }
@@ -22,6 +32,48 @@ func (sfe sqlFunctionExpression) Args() Args { return sfe.args }
 
 func (sfe sqlFunctionExpression) Name() string { return sfe.name }
 
+// Returns a copy of the aggregate function that only aggregates distinct values
+//
+//	COUNT("a").Distinct() -> COUNT(DISTINCT "a")
+func (sfe sqlFunctionExpression) Distinct() SQLFunctionExpression {
+	sfe.distinct = true
+	return sfe
+}
+
+func (sfe sqlFunctionExpression) IsDistinct() bool { return sfe.distinct }
+
+// Sets the order the values are aggregated in, passing no columns removes the order
+//
+//	ARRAY_AGG("a").OrderBy(I("b").Desc()) -> ARRAY_AGG("a" ORDER BY "b" DESC)
+func (sfe sqlFunctionExpression) OrderBy(order ...OrderedExpression) SQLFunctionExpression {
+	if len(order) == 0 {
+		sfe.order = nil
+		return sfe
+	}
+	cols := make([]interface{}, 0, len(order))
+	for _, o := range order {
+		cols = append(cols, o)
+	}
+	sfe.order = NewColumnListExpression(cols...)
+	return sfe
+}
+
+func (sfe sqlFunctionExpression) GetOrder() ColumnListExpression { return sfe.order }
+
+// Adds conditions to the FILTER (WHERE ...) clause of an aggregate function, multiple conditions are ANDed together
+//
+//	COUNT(*).Filter(I("a").Gt(1)) -> COUNT(*) FILTER (WHERE ("a" > 1))
+func (sfe sqlFunctionExpression) Filter(conditions ...Expression) SQLFunctionExpression {
+	if sfe.filter == nil {
+		sfe.filter = NewExpressionList(AndType, conditions...)
+	} else {
+		sfe.filter = sfe.filter.Append(conditions...)
+	}
+	return sfe
+}
+
+func (sfe sqlFunctionExpression) GetFilter() ExpressionList { return sfe.filter }
+
 func (sfe sqlFunctionExpression) As(val interface{}) AliasedExpression {
 	return NewAliasExpression(sfe, val)
 }
@@ -77,6 +129,18 @@ func (sfe sqlFunctionExpression) IsNotTrue() BooleanExpression            { retu
 func (sfe sqlFunctionExpression) IsFalse() BooleanExpression              { return is(sfe, false) }
 func (sfe sqlFunctionExpression) IsNotFalse() BooleanExpression           { return isNot(sfe, false) }
 
+func (sfe sqlFunctionExpression) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(sfe, val)
+}
+
+func (sfe sqlFunctionExpression) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(sfe, val)
+}
+
+func (sfe sqlFunctionExpression) AtTimeZone(tz interface{}) AtTimeZoneExpression {
+	return NewAtTimeZoneExpression(sfe, tz)
+}
+
 func (sfe sqlFunctionExpression) Over(we WindowExpression) SQLWindowFunctionExpression {
 	return NewSQLWindowFunctionExpression(sfe, nil, we)
 }
@@ -85,5 +149,9 @@ func (sfe sqlFunctionExpression) OverName(windowName IdentifierExpression) SQLWi
 	return NewSQLWindowFunctionExpression(sfe, windowName, nil)
 }
 
+func (sfe sqlFunctionExpression) WithOrdinality() TableFunctionExpression {
+	return NewTableFunctionExpression(sfe).WithOrdinality()
+}
+
 func (sfe sqlFunctionExpression) Asc() OrderedExpression  { return asc(sfe) }
 func (sfe sqlFunctionExpression) Desc() OrderedExpression { return desc(sfe) }
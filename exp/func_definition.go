@@ -0,0 +1,58 @@
+package exp
+
+// Used as the maximum number of arguments of a FunctionDefinition that accepts any number of arguments
+const VariadicArgs = -1
+
+type (
+	// The definition of a known SQL function, once registered for a dialect calls to a function with the same name
+	// are validated against the expected number of arguments and written with the SQL name of the definition when the
+	// sql is generated
+	FunctionDefinition interface {
+		// The name of the function, names are case insensitive
+		Name() string
+		// The minimum number of arguments of the function
+		MinArgs() int
+		// The maximum number of arguments of the function or VariadicArgs
+		MaxArgs() int
+		// Returns true if the function accepts the number of arguments
+		AcceptsArgs(n int) bool
+		// Returns a copy of the definition that writes the function as name
+		//   NewFunctionDefinition("length", 1, 1).WithSQLName("LEN")
+		WithSQLName(name string) FunctionDefinition
+		// Returns the name the function is written as, defaults to Name
+		SQLName() string
+	}
+	functionDefinition struct {
+		name    string
+		minArgs int
+		maxArgs int
+		sqlName string
+	}
+)
+
+// Creates a new FunctionDefinition, use VariadicArgs as the maximum for functions that accept any number of arguments
+//
+//	NewFunctionDefinition("coalesce", 1, VariadicArgs)
+func NewFunctionDefinition(name string, minArgs, maxArgs int) FunctionDefinition {
+	return functionDefinition{name: name, minArgs: minArgs, maxArgs: maxArgs}
+}
+
+func (fd functionDefinition) Name() string { return fd.name }
+func (fd functionDefinition) MinArgs() int { return fd.minArgs }
+func (fd functionDefinition) MaxArgs() int { return fd.maxArgs }
+
+func (fd functionDefinition) AcceptsArgs(n int) bool {
+	return n >= fd.minArgs && (fd.maxArgs == VariadicArgs || n <= fd.maxArgs)
+}
+
+func (fd functionDefinition) WithSQLName(name string) FunctionDefinition {
+	fd.sqlName = name
+	return fd
+}
+
+func (fd functionDefinition) SQLName() string {
+	if fd.sqlName == "" {
+		return fd.name
+	}
+	return fd.sqlName
+}
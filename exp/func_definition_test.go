@@ -0,0 +1,42 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type functionDefinitionSuite struct {
+	suite.Suite
+}
+
+func TestFunctionDefinitionSuite(t *testing.T) {
+	suite.Run(t, new(functionDefinitionSuite))
+}
+
+func (fds *functionDefinitionSuite) TestArgs() {
+	def := exp.NewFunctionDefinition("substr", 2, 3)
+	fds.Equal("substr", def.Name())
+	fds.Equal(2, def.MinArgs())
+	fds.Equal(3, def.MaxArgs())
+	fds.False(def.AcceptsArgs(1))
+	fds.True(def.AcceptsArgs(2))
+	fds.True(def.AcceptsArgs(3))
+	fds.False(def.AcceptsArgs(4))
+
+	variadic := exp.NewFunctionDefinition("coalesce", 1, exp.VariadicArgs)
+	fds.False(variadic.AcceptsArgs(0))
+	fds.True(variadic.AcceptsArgs(1))
+	fds.True(variadic.AcceptsArgs(10))
+}
+
+func (fds *functionDefinitionSuite) TestWithSQLName() {
+	def := exp.NewFunctionDefinition("length", 1, 1)
+	withName := def.WithSQLName("LEN")
+
+	fds.Equal("LEN", withName.SQLName())
+	fds.Equal("length", withName.Name())
+	fds.Equal("length", def.SQLName())
+	fds.True(withName.AcceptsArgs(1))
+}
@@ -34,6 +34,48 @@ func (sfes *sqlFunctionExpressionSuite) TestName() {
 	sfes.Equal("COUNT", sfes.fn.Name())
 }
 
+func (sfes *sqlFunctionExpressionSuite) TestFilter() {
+	a := exp.NewIdentifierExpression("", "", "a").Gt(1)
+	b := exp.NewIdentifierExpression("", "", "b").Eq(2)
+	sfes.Nil(sfes.fn.GetFilter())
+
+	fn := sfes.fn.Filter(a)
+	sfes.Equal(exp.NewExpressionList(exp.AndType, a), fn.GetFilter())
+	sfes.Equal(exp.NewExpressionList(exp.AndType, a, b), fn.Filter(b).GetFilter())
+	sfes.Equal(exp.NewExpressionList(exp.AndType, a), fn.GetFilter())
+	sfes.Nil(sfes.fn.GetFilter())
+	sfes.Equal(fn, fn.Clone())
+}
+
+func (sfes *sqlFunctionExpressionSuite) TestDistinct() {
+	sfes.False(sfes.fn.IsDistinct())
+
+	fn := sfes.fn.Distinct()
+	sfes.True(fn.IsDistinct())
+	sfes.False(sfes.fn.IsDistinct())
+	sfes.Equal(fn.Args(), sfes.fn.Args())
+	sfes.Equal(fn, fn.Clone())
+}
+
+func (sfes *sqlFunctionExpressionSuite) TestOrderBy() {
+	a := exp.NewIdentifierExpression("", "", "a").Asc()
+	b := exp.NewIdentifierExpression("", "", "b").Desc()
+	sfes.Nil(sfes.fn.GetOrder())
+
+	fn := sfes.fn.OrderBy(a, b)
+	sfes.Equal(exp.NewColumnListExpression(a, b), fn.GetOrder())
+	sfes.Equal(exp.NewColumnListExpression(b), fn.OrderBy(b).GetOrder())
+	sfes.Nil(fn.OrderBy().GetOrder())
+	sfes.Nil(sfes.fn.GetOrder())
+	sfes.Equal(fn, fn.Clone())
+}
+
+func (sfes *sqlFunctionExpressionSuite) TestWithOrdinality() {
+	tf := sfes.fn.WithOrdinality()
+	sfes.Equal([]exp.SQLFunctionExpression{sfes.fn}, tf.Functions())
+	sfes.True(tf.IsWithOrdinality())
+}
+
 func (sfes *sqlFunctionExpressionSuite) TestAllOthers() {
 	fn := sfes.fn
 
@@ -71,6 +113,9 @@ func (sfes *sqlFunctionExpressionSuite) TestAllOthers() {
 		{Ex: fn.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, fn, true)},
 		{Ex: fn.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, fn, false)},
 		{Ex: fn.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, fn, false)},
+		{Ex: fn.IsDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, fn, 1)},
+		{Ex: fn.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, fn, 1)},
+		{Ex: fn.AtTimeZone("UTC"), Expected: exp.NewAtTimeZoneExpression(fn, "UTC")},
 		{Ex: fn.Desc(), Expected: exp.NewOrderedExpression(fn, exp.DescSortDir, exp.NoNullsSortType)},
 		{Ex: fn.Asc(), Expected: exp.NewOrderedExpression(fn, exp.AscDir, exp.NoNullsSortType)},
 	}
@@ -0,0 +1,113 @@
+package exp
+
+type (
+	GrantClauses interface {
+		HasObject() bool
+		clone() *grantClauses
+
+		IsRevoke() bool
+		SetRevoke(revoke bool) GrantClauses
+
+		Privileges() []string
+		SetPrivileges(privileges []string) GrantClauses
+
+		Object() Expression
+		SetObject(object Expression) GrantClauses
+
+		Roles() ColumnListExpression
+		SetRoles(roles ColumnListExpression) GrantClauses
+
+		IsGrantOption() bool
+		SetGrantOption(grantOption bool) GrantClauses
+
+		IsCascade() bool
+		SetCascade(cascade bool) GrantClauses
+	}
+	grantClauses struct {
+		revoke      bool
+		privileges  []string
+		object      Expression
+		roles       ColumnListExpression
+		grantOption bool
+		cascade     bool
+	}
+)
+
+func NewGrantClauses() GrantClauses {
+	return &grantClauses{}
+}
+
+func (gc *grantClauses) HasObject() bool {
+	return gc.object != nil
+}
+
+func (gc *grantClauses) clone() *grantClauses {
+	return &grantClauses{
+		revoke:      gc.revoke,
+		privileges:  gc.privileges,
+		object:      gc.object,
+		roles:       gc.roles,
+		grantOption: gc.grantOption,
+		cascade:     gc.cascade,
+	}
+}
+
+func (gc *grantClauses) IsRevoke() bool {
+	return gc.revoke
+}
+
+func (gc *grantClauses) SetRevoke(revoke bool) GrantClauses {
+	ret := gc.clone()
+	ret.revoke = revoke
+	return ret
+}
+
+func (gc *grantClauses) Privileges() []string {
+	return gc.privileges
+}
+
+func (gc *grantClauses) SetPrivileges(privileges []string) GrantClauses {
+	ret := gc.clone()
+	ret.privileges = privileges
+	return ret
+}
+
+func (gc *grantClauses) Object() Expression {
+	return gc.object
+}
+
+func (gc *grantClauses) SetObject(object Expression) GrantClauses {
+	ret := gc.clone()
+	ret.object = object
+	return ret
+}
+
+func (gc *grantClauses) Roles() ColumnListExpression {
+	return gc.roles
+}
+
+func (gc *grantClauses) SetRoles(roles ColumnListExpression) GrantClauses {
+	ret := gc.clone()
+	ret.roles = roles
+	return ret
+}
+
+func (gc *grantClauses) IsGrantOption() bool {
+	return gc.grantOption
+}
+
+func (gc *grantClauses) SetGrantOption(grantOption bool) GrantClauses {
+	ret := gc.clone()
+	ret.grantOption = grantOption
+	return ret
+}
+
+func (gc *grantClauses) IsCascade() bool {
+	return gc.cascade
+}
+
+func (gc *grantClauses) SetCascade(cascade bool) GrantClauses {
+	ret := gc.clone()
+	ret.cascade = cascade
+	return ret
+}
@@ -0,0 +1,82 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type grantClausesSuite struct {
+	suite.Suite
+}
+
+func TestGrantClausesSuite(t *testing.T) {
+	suite.Run(t, new(grantClausesSuite))
+}
+
+func (gcs *grantClausesSuite) TestHasObject() {
+	c := exp.NewGrantClauses()
+	c2 := c.SetObject(exp.NewIdentifierExpression("", "test", ""))
+
+	gcs.False(c.HasObject())
+
+	gcs.True(c2.HasObject())
+}
+
+func (gcs *grantClausesSuite) TestSetObject() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewGrantClauses().SetObject(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetObject(ti2)
+
+	gcs.Equal(ti, c.Object())
+
+	gcs.Equal(ti2, c2.Object())
+}
+
+func (gcs *grantClausesSuite) TestSetRevoke() {
+	c := exp.NewGrantClauses()
+	c2 := c.SetRevoke(true)
+
+	gcs.False(c.IsRevoke())
+
+	gcs.True(c2.IsRevoke())
+}
+
+func (gcs *grantClausesSuite) TestSetPrivileges() {
+	c := exp.NewGrantClauses().SetPrivileges([]string{"SELECT"})
+	c2 := c.SetPrivileges([]string{"SELECT", "INSERT"})
+
+	gcs.Equal([]string{"SELECT"}, c.Privileges())
+
+	gcs.Equal([]string{"SELECT", "INSERT"}, c2.Privileges())
+}
+
+func (gcs *grantClausesSuite) TestSetRoles() {
+	c := exp.NewGrantClauses()
+	roles := exp.NewColumnListExpression("a", "b")
+	c2 := c.SetRoles(roles)
+
+	gcs.Nil(c.Roles())
+
+	gcs.Equal(roles, c2.Roles())
+}
+
+func (gcs *grantClausesSuite) TestSetGrantOption() {
+	c := exp.NewGrantClauses()
+	c2 := c.SetGrantOption(true)
+
+	gcs.False(c.IsGrantOption())
+
+	gcs.True(c2.IsGrantOption())
+}
+
+func (gcs *grantClausesSuite) TestSetCascade() {
+	c := exp.NewGrantClauses()
+	c2 := c.SetCascade(true)
+
+	gcs.False(c.IsCascade())
+
+	gcs.True(c2.IsCascade())
+}
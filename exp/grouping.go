@@ -0,0 +1,93 @@
+package exp
+
+import "fmt"
+
+type (
+	// The type of a grouping expression used in a GROUP BY clause (e.g. ROLLUP, CUBE, GROUPING SETS)
+	GroupingType int
+
+	// A grouping expression used in a GROUP BY clause to generate subtotals
+	//    NewRollupExpression("a", "b")                                 // ROLLUP ("a", "b")
+	//    NewCubeExpression("a", "b")                                   // CUBE ("a", "b")
+	//    NewGroupingSetsExpression([]interface{}{"a", "b"}, "a", nil) // GROUPING SETS (("a", "b"), ("a"), ())
+	GroupingExpression interface {
+		Expression
+		// The type of the grouping
+		GroupingType() GroupingType
+		// The sets to group by. ROLLUP and CUBE have a single set containing the columns.
+		Sets() []ColumnListExpression
+	}
+	grouping struct {
+		groupingType GroupingType
+		sets         []ColumnListExpression
+	}
+)
+
+const (
+	RollupGrouping GroupingType = iota
+	CubeGrouping
+	GroupingSetsGrouping
+)
+
+func (gt GroupingType) String() string {
+	switch gt {
+	case RollupGrouping:
+		return "ROLLUP"
+	case CubeGrouping:
+		return "CUBE"
+	case GroupingSetsGrouping:
+		return "GROUPING SETS"
+	}
+	return fmt.Sprintf("%d", gt)
+}
+
+// Creates a new ROLLUP grouping of the columns
+func NewRollupExpression(cols ...interface{}) GroupingExpression {
+	return grouping{groupingType: RollupGrouping, sets: []ColumnListExpression{NewColumnListExpression(cols...)}}
+}
+
+// Creates a new CUBE grouping of the columns
+func NewCubeExpression(cols ...interface{}) GroupingExpression {
+	return grouping{groupingType: CubeGrouping, sets: []ColumnListExpression{NewColumnListExpression(cols...)}}
+}
+
+// Creates a new GROUPING SETS grouping. Each set may be a []interface{} of columns, a ColumnListExpression, a single
+// column, or nil for the empty grouping set.
+func NewGroupingSetsExpression(sets ...interface{}) GroupingExpression {
+	groupingSets := make([]ColumnListExpression, 0, len(sets))
+	for _, s := range sets {
+		switch t := s.(type) {
+		case []interface{}:
+			groupingSets = append(groupingSets, NewColumnListExpression(t...))
+		case []string:
+			cols := make([]interface{}, 0, len(t))
+			for _, c := range t {
+				cols = append(cols, c)
+			}
+			groupingSets = append(groupingSets, NewColumnListExpression(cols...))
+		default:
+			groupingSets = append(groupingSets, NewColumnListExpression(t))
+		}
+	}
+	return grouping{groupingType: GroupingSetsGrouping, sets: groupingSets}
+}
+
+func (g grouping) Clone() Expression {
+	sets := make([]ColumnListExpression, 0, len(g.sets))
+	for _, s := range g.sets {
+		sets = append(sets, s.Clone().(ColumnListExpression))
+	}
+	return grouping{groupingType: g.groupingType, sets: sets}
+}
+
+func (g grouping) Expression() Expression {
+	return g
+}
+
+func (g grouping) GroupingType() GroupingType {
+	return g.groupingType
+}
+
+func (g grouping) Sets() []ColumnListExpression {
+	return g.sets
+}
@@ -0,0 +1,61 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type groupingExpressionSuite struct {
+	suite.Suite
+}
+
+func TestGroupingExpressionSuite(t *testing.T) {
+	suite.Run(t, new(groupingExpressionSuite))
+}
+
+func (ges *groupingExpressionSuite) TestRollup() {
+	g := exp.NewRollupExpression("a", exp.NewIdentifierExpression("", "t", "b"))
+	ges.Equal(exp.RollupGrouping, g.GroupingType())
+	ges.Equal([]exp.ColumnListExpression{
+		exp.NewColumnListExpression("a", exp.NewIdentifierExpression("", "t", "b")),
+	}, g.Sets())
+	ges.Equal(g, g.Expression())
+	ges.Equal(g, g.Clone())
+}
+
+func (ges *groupingExpressionSuite) TestCube() {
+	g := exp.NewCubeExpression("a", "b")
+	ges.Equal(exp.CubeGrouping, g.GroupingType())
+	ges.Equal([]exp.ColumnListExpression{exp.NewColumnListExpression("a", "b")}, g.Sets())
+	ges.Equal(g, g.Expression())
+	ges.Equal(g, g.Clone())
+}
+
+func (ges *groupingExpressionSuite) TestGroupingSets() {
+	g := exp.NewGroupingSetsExpression(
+		[]interface{}{"a", "b"},
+		[]string{"a", "c"},
+		exp.NewColumnListExpression("b"),
+		"c",
+		nil,
+	)
+	ges.Equal(exp.GroupingSetsGrouping, g.GroupingType())
+	ges.Equal([]exp.ColumnListExpression{
+		exp.NewColumnListExpression("a", "b"),
+		exp.NewColumnListExpression("a", "c"),
+		exp.NewColumnListExpression("b"),
+		exp.NewColumnListExpression("c"),
+		exp.NewColumnListExpression(),
+	}, g.Sets())
+	ges.Equal(g, g.Expression())
+	ges.Equal(g, g.Clone())
+}
+
+func (ges *groupingExpressionSuite) TestGroupingType_String() {
+	ges.Equal("ROLLUP", exp.RollupGrouping.String())
+	ges.Equal("CUBE", exp.CubeGrouping.String())
+	ges.Equal("GROUPING SETS", exp.GroupingSetsGrouping.String())
+	ges.Equal("10", exp.GroupingType(10).String())
+}
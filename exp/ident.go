@@ -2,6 +2,7 @@ package exp
 
 import (
 	"strings"
+	"sync"
 )
 
 type (
@@ -17,7 +18,37 @@ var (
 	schemaTableAndColumnIdentifierParts = 3
 )
 
+// The maximum number of qualified identifiers cached by ParseIdentifier, once full new identifiers are parsed but not
+// cached so queries built from dynamic identifiers cannot grow the cache without bound.
+const maxParsedIdentifierCacheSize = 4096
+
+var (
+	parsedIdentifierCache     = make(map[string]IdentifierExpression)
+	parsedIdentifierCacheLock = sync.RWMutex{}
+)
+
+// Parses an identifier seperated by a . character (e.g. "schema.table.col"). Qualified identifiers are cached so hot
+// query paths do not split the same string every time the sql is generated.
 func ParseIdentifier(ident string) IdentifierExpression {
+	if strings.IndexByte(ident, '.') == -1 {
+		return NewIdentifierExpression("", "", ident)
+	}
+	parsedIdentifierCacheLock.RLock()
+	cached, ok := parsedIdentifierCache[ident]
+	parsedIdentifierCacheLock.RUnlock()
+	if ok {
+		return cached
+	}
+	parsed := parseIdentifier(ident)
+	parsedIdentifierCacheLock.Lock()
+	defer parsedIdentifierCacheLock.Unlock()
+	if len(parsedIdentifierCache) < maxParsedIdentifierCacheSize {
+		parsedIdentifierCache[ident] = parsed
+	}
+	return parsed
+}
+
+func parseIdentifier(ident string) IdentifierExpression {
 	parts := strings.Split(ident, ".")
 	switch len(parts) {
 	case tableAndColumnParts:
@@ -206,6 +237,18 @@ func (i identifier) Desc() OrderedExpression                          { return d
 func (i identifier) Distinct() SQLFunctionExpression                  { return NewSQLFunctionExpression("DISTINCT", i) }
 func (i identifier) Cast(t string) CastExpression                     { return NewCastExpression(i, t) }
 
+func (i identifier) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(i, val)
+}
+
+func (i identifier) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(i, val)
+}
+
+func (i identifier) AtTimeZone(tz interface{}) AtTimeZoneExpression {
+	return NewAtTimeZoneExpression(i, tz)
+}
+
 // Returns a RangeExpression for checking that a identifier is between two values (e.g "my_col" BETWEEN 1 AND 10)
 func (i identifier) Between(val RangeVal) RangeExpression { return between(i, val) }
 
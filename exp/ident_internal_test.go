@@ -0,0 +1,20 @@
+package exp
+
+import "testing"
+
+// compares ParseIdentifier with the cache to parsing the identifier every time
+func BenchmarkParseIdentifier(b *testing.B) {
+	const ident = "schema.table.col"
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ParseIdentifier(ident)
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			parseIdentifier(ident)
+		}
+	})
+}
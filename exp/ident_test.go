@@ -1,6 +1,7 @@
 package exp_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/doug-martin/goqu/v9/exp"
@@ -29,6 +30,39 @@ func (ies *identifierExpressionSuite) TestParseIdentifier() {
 	}
 }
 
+func (ies *identifierExpressionSuite) TestParseIdentifier_cached() {
+	cases := []struct {
+		ToParse  string
+		Expected exp.IdentifierExpression
+	}{
+		{ToParse: "cached.one", Expected: exp.NewIdentifierExpression("", "cached", "one")},
+		{ToParse: "cached.one.*", Expected: exp.NewIdentifierExpression("", "", "").Schema("cached").Table("one").All()},
+		{ToParse: "cached.one.two", Expected: exp.NewIdentifierExpression("cached", "one", "two")},
+	}
+	// parse concurrently and assert on the test goroutine, suite assertions are not safe to call from other goroutines
+	results := make([][]exp.IdentifierExpression, 10)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for _, tc := range cases {
+				results[i] = append(results[i], exp.ParseIdentifier(tc.ToParse))
+			}
+		}(i)
+	}
+	wg.Wait()
+	for _, parsed := range results {
+		for i, tc := range cases {
+			ies.Equal(tc.Expected, parsed[i])
+		}
+	}
+
+	// modifying a cached identifier should not modify the cache
+	ies.Equal(exp.NewIdentifierExpression("", "other", "one"), exp.ParseIdentifier("cached.one").Table("other"))
+	ies.Equal(exp.NewIdentifierExpression("", "cached", "one"), exp.ParseIdentifier("cached.one"))
+}
+
 func (ies *identifierExpressionSuite) TestClone() {
 	cases := []struct {
 		Expected exp.IdentifierExpression
@@ -232,6 +266,9 @@ func (ies *identifierExpressionSuite) TestAllOthers() {
 		{Ex: ident.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ident, true)},
 		{Ex: ident.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, ident, false)},
 		{Ex: ident.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ident, false)},
+		{Ex: ident.IsDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, ident, 1)},
+		{Ex: ident.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, ident, 1)},
+		{Ex: ident.AtTimeZone("UTC"), Expected: exp.NewAtTimeZoneExpression(ident, "UTC")},
 		{Ex: ident.Distinct(), Expected: exp.NewSQLFunctionExpression("DISTINCT", ident)},
 		{Ex: ident.BitwiseInversion(), Expected: exp.NewBitwiseExpression(exp.BitwiseInversionOp, nil, ident)},
 		{Ex: ident.BitwiseOr(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseOrOp, ident, bitwiseVals)},
@@ -39,6 +39,9 @@ type (
 
 		OnConflict() ConflictExpression
 		SetOnConflict(expression ConflictExpression) InsertClauses
+
+		ConflictResolution() ConflictResolution
+		SetConflictResolution(resolution ConflictResolution) InsertClauses
 	}
 	insertClauses struct {
 		commonTables []CommonTableExpression
@@ -50,9 +53,32 @@ type (
 		values       []Vals
 		from         AppendableExpression
 		conflict     ConflictExpression
+		resolution   ConflictResolution
 	}
+
+	// The conflict resolution algorithm of an INSERT statement (e.g. sqlite INSERT OR IGNORE)
+	ConflictResolution int
+)
+
+const (
+	// INSERT INTO
+	NoConflictResolution ConflictResolution = iota
+	// INSERT OR IGNORE INTO, INSERT IGNORE INTO
+	IgnoreConflictResolution
+	// INSERT OR REPLACE INTO, REPLACE INTO
+	ReplaceConflictResolution
 )
 
+func (cr ConflictResolution) String() string {
+	switch cr {
+	case IgnoreConflictResolution:
+		return "IGNORE"
+	case ReplaceConflictResolution:
+		return "REPLACE"
+	}
+	return ""
+}
+
 func NewInsertClauses() InsertClauses {
 	return &insertClauses{}
 }
@@ -72,6 +98,7 @@ func (ic *insertClauses) clone() *insertClauses {
 		values:       ic.values,
 		from:         ic.from,
 		conflict:     ic.conflict,
+		resolution:   ic.resolution,
 	}
 }
 
@@ -203,3 +230,13 @@ func (ic *insertClauses) SetOnConflict(expression ConflictExpression) InsertClau
 	ret.conflict = expression
 	return ret
 }
+
+func (ic *insertClauses) ConflictResolution() ConflictResolution {
+	return ic.resolution
+}
+
+func (ic *insertClauses) SetConflictResolution(resolution ConflictResolution) InsertClauses {
+	ret := ic.clone()
+	ret.resolution = resolution
+	return ret
+}
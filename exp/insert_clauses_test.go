@@ -207,6 +207,24 @@ func (ics *insertClausesSuite) TestSetOnConflict() {
 	ics.Equal(ce2, c2.OnConflict())
 }
 
+func (ics *insertClausesSuite) TestConflictResolution() {
+	c := exp.NewInsertClauses()
+	c2 := c.SetConflictResolution(exp.IgnoreConflictResolution)
+
+	ics.Equal(exp.NoConflictResolution, c.ConflictResolution())
+
+	ics.Equal(exp.IgnoreConflictResolution, c2.ConflictResolution())
+}
+
+func (ics *insertClausesSuite) TestSetConflictResolution() {
+	c := exp.NewInsertClauses().SetConflictResolution(exp.IgnoreConflictResolution)
+	c2 := c.SetConflictResolution(exp.ReplaceConflictResolution)
+
+	ics.Equal(exp.IgnoreConflictResolution, c.ConflictResolution())
+
+	ics.Equal(exp.ReplaceConflictResolution, c2.ConflictResolution())
+}
+
 func (ics *insertClausesSuite) TestReturning() {
 	cl := exp.NewColumnListExpression(exp.NewIdentifierExpression("", "", "col"))
 
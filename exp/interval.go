@@ -0,0 +1,147 @@
+package exp
+
+import "fmt"
+
+// The unit of an IntervalExpression
+type IntervalUnit int
+
+const (
+	MicrosecondsIntervalUnit IntervalUnit = iota
+	SecondsIntervalUnit
+	MinutesIntervalUnit
+	HoursIntervalUnit
+	DaysIntervalUnit
+	WeeksIntervalUnit
+	MonthsIntervalUnit
+	YearsIntervalUnit
+)
+
+// The operation of a DateArithmeticExpression
+type DateArithmeticOperation int
+
+const (
+	// Adds an interval to a date (e.g. "a" + INTERVAL '1 days')
+	DateAddOp DateArithmeticOperation = iota
+	// Subtracts an interval from a date (e.g. "a" - INTERVAL '1 days')
+	DateSubOp
+)
+
+type (
+	interval struct {
+		quantity int64
+		unit     IntervalUnit
+	}
+	dateAccessor struct {
+		value Expression
+	}
+	dateArithmeticExpression struct {
+		lhs      Expression
+		op       DateArithmeticOperation
+		interval IntervalExpression
+	}
+)
+
+// Creates a new IntervalExpression, the quantity is always written in the SQL (it is never a placeholder)
+//
+//	NewIntervalExpression(3, DaysIntervalUnit) -> postgres INTERVAL '3 days', mysql INTERVAL 3 DAY
+func NewIntervalExpression(quantity int64, unit IntervalUnit) IntervalExpression {
+	return interval{quantity: quantity, unit: unit}
+}
+
+// Creates a new DateAccessor that can be used to add intervals to or subtract intervals from a date value (e.g. a
+// timestamp column)
+//
+//	NewDateAccessor(NewIdentifierExpression("", "", "a")).Add(NewIntervalExpression(3, DaysIntervalUnit))
+func NewDateAccessor(value Expression) DateAccessor {
+	return dateAccessor{value: value}
+}
+
+// Creates a new DateArithmeticExpression
+func NewDateArithmeticExpression(
+	op DateArithmeticOperation,
+	lhs Expression,
+	interval IntervalExpression,
+) DateArithmeticExpression {
+	return dateArithmeticExpression{lhs: lhs, op: op, interval: interval}
+}
+
+func (i interval) Clone() Expression      { return i }
+func (i interval) Expression() Expression { return i }
+func (i interval) Quantity() int64        { return i.quantity }
+func (i interval) Unit() IntervalUnit     { return i.unit }
+
+func (i interval) As(val interface{}) AliasedExpression {
+	return NewAliasExpression(i, val)
+}
+
+func (da dateAccessor) Add(interval IntervalExpression) DateArithmeticExpression {
+	return NewDateArithmeticExpression(DateAddOp, da.value, interval)
+}
+
+func (da dateAccessor) Sub(interval IntervalExpression) DateArithmeticExpression {
+	return NewDateArithmeticExpression(DateSubOp, da.value, interval)
+}
+
+func (dae dateArithmeticExpression) Clone() Expression {
+	return NewDateArithmeticExpression(dae.op, dae.lhs.Clone(), dae.interval)
+}
+
+func (dae dateArithmeticExpression) Expression() Expression       { return dae }
+func (dae dateArithmeticExpression) LHS() Expression              { return dae.lhs }
+func (dae dateArithmeticExpression) Op() DateArithmeticOperation  { return dae.op }
+func (dae dateArithmeticExpression) Interval() IntervalExpression { return dae.interval }
+
+func (dae dateArithmeticExpression) Add(i IntervalExpression) DateArithmeticExpression {
+	return NewDateAccessor(dae).Add(i)
+}
+
+func (dae dateArithmeticExpression) Sub(i IntervalExpression) DateArithmeticExpression {
+	return NewDateAccessor(dae).Sub(i)
+}
+
+func (dae dateArithmeticExpression) AtTimeZone(tz interface{}) AtTimeZoneExpression {
+	return NewAtTimeZoneExpression(dae, tz)
+}
+
+func (dae dateArithmeticExpression) As(val interface{}) AliasedExpression {
+	return NewAliasExpression(dae, val)
+}
+
+func (dae dateArithmeticExpression) Eq(val interface{}) BooleanExpression  { return eq(dae, val) }
+func (dae dateArithmeticExpression) Neq(val interface{}) BooleanExpression { return neq(dae, val) }
+func (dae dateArithmeticExpression) Gt(val interface{}) BooleanExpression  { return gt(dae, val) }
+func (dae dateArithmeticExpression) Gte(val interface{}) BooleanExpression { return gte(dae, val) }
+func (dae dateArithmeticExpression) Lt(val interface{}) BooleanExpression  { return lt(dae, val) }
+func (dae dateArithmeticExpression) Lte(val interface{}) BooleanExpression { return lte(dae, val) }
+func (dae dateArithmeticExpression) Asc() OrderedExpression                { return asc(dae) }
+func (dae dateArithmeticExpression) Desc() OrderedExpression               { return desc(dae) }
+
+func (dae dateArithmeticExpression) Between(val RangeVal) RangeExpression {
+	return between(dae, val)
+}
+
+func (dae dateArithmeticExpression) NotBetween(val RangeVal) RangeExpression {
+	return notBetween(dae, val)
+}
+
+func (iu IntervalUnit) String() string {
+	switch iu {
+	case MicrosecondsIntervalUnit:
+		return "Microseconds"
+	case SecondsIntervalUnit:
+		return "Seconds"
+	case MinutesIntervalUnit:
+		return "Minutes"
+	case HoursIntervalUnit:
+		return "Hours"
+	case DaysIntervalUnit:
+		return "Days"
+	case WeeksIntervalUnit:
+		return "Weeks"
+	case MonthsIntervalUnit:
+		return "Months"
+	case YearsIntervalUnit:
+		return "Years"
+	}
+	return fmt.Sprintf("%d", iu)
+}
@@ -0,0 +1,104 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type intervalExpressionSuite struct {
+	suite.Suite
+}
+
+func TestIntervalExpressionSuite(t *testing.T) {
+	suite.Run(t, new(intervalExpressionSuite))
+}
+
+func (ies *intervalExpressionSuite) TestClone() {
+	i := exp.NewIntervalExpression(3, exp.DaysIntervalUnit)
+	ies.Equal(i, i.Clone())
+	dae := exp.NewDateAccessor(exp.NewIdentifierExpression("", "", "a")).Add(i)
+	ies.Equal(dae, dae.Clone())
+}
+
+func (ies *intervalExpressionSuite) TestExpression() {
+	i := exp.NewIntervalExpression(3, exp.DaysIntervalUnit)
+	ies.Equal(i, i.Expression())
+	dae := exp.NewDateAccessor(exp.NewIdentifierExpression("", "", "a")).Add(i)
+	ies.Equal(dae, dae.Expression())
+}
+
+func (ies *intervalExpressionSuite) TestInterval() {
+	i := exp.NewIntervalExpression(-3, exp.HoursIntervalUnit)
+	ies.Equal(int64(-3), i.Quantity())
+	ies.Equal(exp.HoursIntervalUnit, i.Unit())
+	ies.Equal(exp.NewAliasExpression(i, "a"), i.As("a"))
+}
+
+func (ies *intervalExpressionSuite) TestAccessor() {
+	col := exp.NewIdentifierExpression("", "", "a")
+	i := exp.NewIntervalExpression(3, exp.DaysIntervalUnit)
+	da := exp.NewDateAccessor(col)
+	testCases := []struct {
+		Ex  exp.DateArithmeticExpression
+		LHS exp.Expression
+		Op  exp.DateArithmeticOperation
+	}{
+		{Ex: da.Add(i), LHS: col, Op: exp.DateAddOp},
+		{Ex: da.Sub(i), LHS: col, Op: exp.DateSubOp},
+	}
+	for _, tc := range testCases {
+		ies.Equal(tc.LHS, tc.Ex.LHS())
+		ies.Equal(tc.Op, tc.Ex.Op())
+		ies.Equal(i, tc.Ex.Interval())
+	}
+}
+
+func (ies *intervalExpressionSuite) TestChaining() {
+	col := exp.NewIdentifierExpression("", "", "a")
+	days := exp.NewIntervalExpression(3, exp.DaysIntervalUnit)
+	hours := exp.NewIntervalExpression(1, exp.HoursIntervalUnit)
+	dae := exp.NewDateAccessor(col).Add(days).Sub(hours)
+	ies.Equal(exp.DateSubOp, dae.Op())
+	ies.Equal(hours, dae.Interval())
+	ies.Equal(exp.NewDateArithmeticExpression(exp.DateAddOp, col, days), dae.LHS())
+}
+
+func (ies *intervalExpressionSuite) TestAllOthers() {
+	dae := exp.NewDateAccessor(exp.NewIdentifierExpression("", "", "a")).
+		Add(exp.NewIntervalExpression(3, exp.DaysIntervalUnit))
+	rv := exp.NewRangeVal(1, 2)
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: dae.As("a"), Expected: exp.NewAliasExpression(dae, "a")},
+		{Ex: dae.Asc(), Expected: exp.NewOrderedExpression(dae, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: dae.Desc(), Expected: exp.NewOrderedExpression(dae, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: dae.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, dae, 1)},
+		{Ex: dae.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, dae, 1)},
+		{Ex: dae.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, dae, 1)},
+		{Ex: dae.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, dae, 1)},
+		{Ex: dae.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, dae, 1)},
+		{Ex: dae.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, dae, 1)},
+		{Ex: dae.Between(rv), Expected: exp.NewRangeExpression(exp.BetweenOp, dae, rv)},
+		{Ex: dae.NotBetween(rv), Expected: exp.NewRangeExpression(exp.NotBetweenOp, dae, rv)},
+		{Ex: dae.AtTimeZone("UTC"), Expected: exp.NewAtTimeZoneExpression(dae, "UTC")},
+	}
+	for _, tc := range testCases {
+		ies.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+func (ies *intervalExpressionSuite) TestIntervalUnit_String() {
+	ies.Equal("Microseconds", exp.MicrosecondsIntervalUnit.String())
+	ies.Equal("Seconds", exp.SecondsIntervalUnit.String())
+	ies.Equal("Minutes", exp.MinutesIntervalUnit.String())
+	ies.Equal("Hours", exp.HoursIntervalUnit.String())
+	ies.Equal("Days", exp.DaysIntervalUnit.String())
+	ies.Equal("Weeks", exp.WeeksIntervalUnit.String())
+	ies.Equal("Months", exp.MonthsIntervalUnit.String())
+	ies.Equal("Years", exp.YearsIntervalUnit.String())
+	ies.Equal("100", exp.IntervalUnit(100).String())
+}
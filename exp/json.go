@@ -0,0 +1,214 @@
+package exp
+
+import "fmt"
+
+// The operation of a JSONExpression
+type JSONOperation int
+
+const (
+	// Returns the value of a key or an array element (e.g. postgres ->, mysql JSON_EXTRACT)
+	JSONGetOp JSONOperation = iota
+	// Returns the value of a key or an array element as text (e.g. postgres ->>)
+	JSONGetTextOp
+	// Returns the value at a path (e.g. postgres #>)
+	JSONGetPathOp
+	// Returns the value at a path as text (e.g. postgres #>>)
+	JSONGetPathTextOp
+	// Returns true if the value contains another value (e.g. postgres @>, mysql JSON_CONTAINS)
+	JSONContainsOp
+	// Returns true if the value is contained by another value (e.g. postgres <@)
+	JSONContainedByOp
+	// Returns true if the value has a key (e.g. postgres ?, mysql JSON_CONTAINS_PATH)
+	JSONHasKeyOp
+	// Returns true if the value has any of the keys (e.g. postgres ?|)
+	JSONHasAnyKeyOp
+	// Returns true if the value has all of the keys (e.g. postgres ?&)
+	JSONHasAllKeysOp
+	// Concatenates two values (e.g. postgres ||, mysql JSON_MERGE_PRESERVE)
+	JSONConcatOp
+)
+
+type (
+	jsonAccessor struct {
+		value Expression
+	}
+	jsonExpression struct {
+		lhs Expression
+		op  JSONOperation
+		rhs interface{}
+	}
+)
+
+// Creates a new JSONAccessor that can be used to build JSONExpressions for a JSON value (e.g. a JSON column)
+//
+//	NewJSONAccessor(NewIdentifierExpression("", "", "data")).Get("a").GetText("b") -> (("data" -> 'a') ->> 'b')
+func NewJSONAccessor(value Expression) JSONAccessor {
+	return jsonAccessor{value: value}
+}
+
+// Creates a new JSONExpression, the rhs depends on the operation
+//   - JSONGetOp, JSONGetTextOp: the key (string) or array index (int)
+//   - JSONGetPathOp, JSONGetPathTextOp, JSONHasAnyKeyOp, JSONHasAllKeysOp: the path or keys ([]string)
+//   - JSONHasKeyOp: the key (string)
+//   - JSONContainsOp, JSONContainedByOp, JSONConcatOp: the other JSON value
+func NewJSONExpression(op JSONOperation, lhs Expression, rhs interface{}) JSONExpression {
+	return jsonExpression{lhs: lhs, op: op, rhs: rhs}
+}
+
+func (ja jsonAccessor) Get(key interface{}) JSONExpression {
+	return NewJSONExpression(JSONGetOp, ja.value, key)
+}
+
+func (ja jsonAccessor) GetText(key interface{}) JSONExpression {
+	return NewJSONExpression(JSONGetTextOp, ja.value, key)
+}
+
+func (ja jsonAccessor) GetPath(path ...string) JSONExpression {
+	return NewJSONExpression(JSONGetPathOp, ja.value, path)
+}
+
+func (ja jsonAccessor) GetPathText(path ...string) JSONExpression {
+	return NewJSONExpression(JSONGetPathTextOp, ja.value, path)
+}
+
+func (ja jsonAccessor) Contains(val interface{}) JSONExpression {
+	return NewJSONExpression(JSONContainsOp, ja.value, val)
+}
+
+func (ja jsonAccessor) ContainedBy(val interface{}) JSONExpression {
+	return NewJSONExpression(JSONContainedByOp, ja.value, val)
+}
+
+func (ja jsonAccessor) HasKey(key string) JSONExpression {
+	return NewJSONExpression(JSONHasKeyOp, ja.value, key)
+}
+
+func (ja jsonAccessor) HasAnyKey(keys ...string) JSONExpression {
+	return NewJSONExpression(JSONHasAnyKeyOp, ja.value, keys)
+}
+
+func (ja jsonAccessor) HasAllKeys(keys ...string) JSONExpression {
+	return NewJSONExpression(JSONHasAllKeysOp, ja.value, keys)
+}
+
+func (ja jsonAccessor) Concat(val interface{}) JSONExpression {
+	return NewJSONExpression(JSONConcatOp, ja.value, val)
+}
+
+func (je jsonExpression) Clone() Expression {
+	return NewJSONExpression(je.op, je.lhs.Clone(), je.rhs)
+}
+
+func (je jsonExpression) Expression() Expression { return je }
+func (je jsonExpression) LHS() Expression        { return je.lhs }
+func (je jsonExpression) Op() JSONOperation      { return je.op }
+func (je jsonExpression) RHS() interface{}       { return je.rhs }
+
+func (je jsonExpression) Get(key interface{}) JSONExpression { return NewJSONAccessor(je).Get(key) }
+func (je jsonExpression) GetText(key interface{}) JSONExpression {
+	return NewJSONAccessor(je).GetText(key)
+}
+func (je jsonExpression) GetPath(path ...string) JSONExpression {
+	return NewJSONAccessor(je).GetPath(path...)
+}
+
+func (je jsonExpression) GetPathText(path ...string) JSONExpression {
+	return NewJSONAccessor(je).GetPathText(path...)
+}
+
+func (je jsonExpression) Contains(val interface{}) JSONExpression {
+	return NewJSONAccessor(je).Contains(val)
+}
+
+func (je jsonExpression) ContainedBy(val interface{}) JSONExpression {
+	return NewJSONAccessor(je).ContainedBy(val)
+}
+
+func (je jsonExpression) HasKey(key string) JSONExpression { return NewJSONAccessor(je).HasKey(key) }
+
+func (je jsonExpression) HasAnyKey(keys ...string) JSONExpression {
+	return NewJSONAccessor(je).HasAnyKey(keys...)
+}
+
+func (je jsonExpression) HasAllKeys(keys ...string) JSONExpression {
+	return NewJSONAccessor(je).HasAllKeys(keys...)
+}
+
+func (je jsonExpression) Concat(val interface{}) JSONExpression {
+	return NewJSONAccessor(je).Concat(val)
+}
+
+func (je jsonExpression) As(val interface{}) AliasedExpression     { return NewAliasExpression(je, val) }
+func (je jsonExpression) Eq(val interface{}) BooleanExpression     { return eq(je, val) }
+func (je jsonExpression) Neq(val interface{}) BooleanExpression    { return neq(je, val) }
+func (je jsonExpression) Gt(val interface{}) BooleanExpression     { return gt(je, val) }
+func (je jsonExpression) Gte(val interface{}) BooleanExpression    { return gte(je, val) }
+func (je jsonExpression) Lt(val interface{}) BooleanExpression     { return lt(je, val) }
+func (je jsonExpression) Lte(val interface{}) BooleanExpression    { return lte(je, val) }
+func (je jsonExpression) Asc() OrderedExpression                   { return asc(je) }
+func (je jsonExpression) Desc() OrderedExpression                  { return desc(je) }
+func (je jsonExpression) Like(i interface{}) BooleanExpression     { return like(je, i) }
+func (je jsonExpression) NotLike(i interface{}) BooleanExpression  { return notLike(je, i) }
+func (je jsonExpression) ILike(i interface{}) BooleanExpression    { return iLike(je, i) }
+func (je jsonExpression) NotILike(i interface{}) BooleanExpression { return notILike(je, i) }
+
+func (je jsonExpression) RegexpLike(val interface{}) BooleanExpression {
+	return regexpLike(je, val)
+}
+
+func (je jsonExpression) RegexpNotLike(val interface{}) BooleanExpression {
+	return regexpNotLike(je, val)
+}
+
+func (je jsonExpression) RegexpILike(val interface{}) BooleanExpression {
+	return regexpILike(je, val)
+}
+
+func (je jsonExpression) RegexpNotILike(val interface{}) BooleanExpression {
+	return regexpNotILike(je, val)
+}
+
+func (je jsonExpression) In(i ...interface{}) BooleanExpression    { return in(je, i...) }
+func (je jsonExpression) NotIn(i ...interface{}) BooleanExpression { return notIn(je, i...) }
+func (je jsonExpression) Is(i interface{}) BooleanExpression       { return is(je, i) }
+func (je jsonExpression) IsNot(i interface{}) BooleanExpression    { return isNot(je, i) }
+func (je jsonExpression) IsNull() BooleanExpression                { return is(je, nil) }
+func (je jsonExpression) IsNotNull() BooleanExpression             { return isNot(je, nil) }
+func (je jsonExpression) IsTrue() BooleanExpression                { return is(je, true) }
+func (je jsonExpression) IsNotTrue() BooleanExpression             { return isNot(je, true) }
+func (je jsonExpression) IsFalse() BooleanExpression               { return is(je, false) }
+func (je jsonExpression) IsNotFalse() BooleanExpression            { return isNot(je, false) }
+
+func (je jsonExpression) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(je, val)
+}
+
+func (je jsonExpression) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(je, val)
+}
+
+func (jo JSONOperation) String() string {
+	switch jo {
+	case JSONGetOp:
+		return "Get"
+	case JSONGetTextOp:
+		return "Get Text"
+	case JSONGetPathOp:
+		return "Get Path"
+	case JSONGetPathTextOp:
+		return "Get Path Text"
+	case JSONContainsOp:
+		return "Contains"
+	case JSONContainedByOp:
+		return "Contained By"
+	case JSONHasKeyOp:
+		return "Has Key"
+	case JSONHasAnyKeyOp:
+		return "Has Any Key"
+	case JSONHasAllKeysOp:
+		return "Has All Keys"
+	case JSONConcatOp:
+		return "Concat"
+	}
+	return fmt.Sprintf("%d", jo)
+}
@@ -0,0 +1,118 @@
+package exp
+
+import "fmt"
+
+// A JSON function, the name of the function is provided by the dialect
+type JSONFunction int
+
+const (
+	// Returns the values matched by a JSON path (e.g. postgres jsonb_path_query, mysql JSON_EXTRACT)
+	JSONPathQueryFunc JSONFunction = iota
+	// Sets the value at a path (e.g. postgres jsonb_set, mysql JSON_SET)
+	JSONSetFunc
+	// Builds an object from keys and values (e.g. postgres jsonb_build_object, mysql JSON_OBJECT)
+	JSONBuildObjectFunc
+	// Aggregates values into an array (e.g. postgres json_agg, mysql JSON_ARRAYAGG)
+	JSONAggFunc
+	// Aggregates keys and values into an object (e.g. postgres json_object_agg, mysql JSON_OBJECTAGG)
+	JSONObjectAggFunc
+)
+
+type jsonFunction struct {
+	fn   JSONFunction
+	args []interface{}
+}
+
+// Creates a new JSONFunctionExpression, the args depend on the function
+//   - JSONPathQueryFunc: the JSON value and the path (string)
+//   - JSONSetFunc: the JSON value, the path ([]string) and the new value
+//   - JSONBuildObjectFunc: the keys and values
+//   - JSONAggFunc: the aggregated value
+//   - JSONObjectAggFunc: the aggregated key and value
+//
+// Maps, structs and slices used as values are serialized to JSON when the SQL is generated.
+func NewJSONFunctionExpression(fn JSONFunction, args ...interface{}) JSONFunctionExpression {
+	return jsonFunction{fn: fn, args: args}
+}
+
+func (jf jsonFunction) Clone() Expression {
+	return NewJSONFunctionExpression(jf.fn, append([]interface{}(nil), jf.args...)...)
+}
+
+func (jf jsonFunction) Expression() Expression { return jf }
+func (jf jsonFunction) Func() JSONFunction     { return jf.fn }
+func (jf jsonFunction) Args() []interface{}    { return jf.args }
+
+func (jf jsonFunction) Get(key interface{}) JSONExpression { return NewJSONAccessor(jf).Get(key) }
+func (jf jsonFunction) GetText(key interface{}) JSONExpression {
+	return NewJSONAccessor(jf).GetText(key)
+}
+func (jf jsonFunction) GetPath(path ...string) JSONExpression {
+	return NewJSONAccessor(jf).GetPath(path...)
+}
+
+func (jf jsonFunction) GetPathText(path ...string) JSONExpression {
+	return NewJSONAccessor(jf).GetPathText(path...)
+}
+
+func (jf jsonFunction) Contains(val interface{}) JSONExpression {
+	return NewJSONAccessor(jf).Contains(val)
+}
+
+func (jf jsonFunction) ContainedBy(val interface{}) JSONExpression {
+	return NewJSONAccessor(jf).ContainedBy(val)
+}
+
+func (jf jsonFunction) HasKey(key string) JSONExpression { return NewJSONAccessor(jf).HasKey(key) }
+
+func (jf jsonFunction) HasAnyKey(keys ...string) JSONExpression {
+	return NewJSONAccessor(jf).HasAnyKey(keys...)
+}
+
+func (jf jsonFunction) HasAllKeys(keys ...string) JSONExpression {
+	return NewJSONAccessor(jf).HasAllKeys(keys...)
+}
+
+func (jf jsonFunction) Concat(val interface{}) JSONExpression { return NewJSONAccessor(jf).Concat(val) }
+
+func (jf jsonFunction) As(val interface{}) AliasedExpression  { return NewAliasExpression(jf, val) }
+func (jf jsonFunction) Eq(val interface{}) BooleanExpression  { return eq(jf, val) }
+func (jf jsonFunction) Neq(val interface{}) BooleanExpression { return neq(jf, val) }
+func (jf jsonFunction) Gt(val interface{}) BooleanExpression  { return gt(jf, val) }
+func (jf jsonFunction) Gte(val interface{}) BooleanExpression { return gte(jf, val) }
+func (jf jsonFunction) Lt(val interface{}) BooleanExpression  { return lt(jf, val) }
+func (jf jsonFunction) Lte(val interface{}) BooleanExpression { return lte(jf, val) }
+func (jf jsonFunction) Asc() OrderedExpression                { return asc(jf) }
+func (jf jsonFunction) Desc() OrderedExpression               { return desc(jf) }
+func (jf jsonFunction) Is(i interface{}) BooleanExpression    { return is(jf, i) }
+func (jf jsonFunction) IsNot(i interface{}) BooleanExpression { return isNot(jf, i) }
+func (jf jsonFunction) IsNull() BooleanExpression             { return is(jf, nil) }
+func (jf jsonFunction) IsNotNull() BooleanExpression          { return isNot(jf, nil) }
+func (jf jsonFunction) IsTrue() BooleanExpression             { return is(jf, true) }
+func (jf jsonFunction) IsNotTrue() BooleanExpression          { return isNot(jf, true) }
+func (jf jsonFunction) IsFalse() BooleanExpression            { return is(jf, false) }
+func (jf jsonFunction) IsNotFalse() BooleanExpression         { return isNot(jf, false) }
+
+func (jf jsonFunction) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(jf, val)
+}
+
+func (jf jsonFunction) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(jf, val)
+}
+
+func (jf JSONFunction) String() string {
+	switch jf {
+	case JSONPathQueryFunc:
+		return "Path Query"
+	case JSONSetFunc:
+		return "Set"
+	case JSONBuildObjectFunc:
+		return "Build Object"
+	case JSONAggFunc:
+		return "Agg"
+	case JSONObjectAggFunc:
+		return "Object Agg"
+	}
+	return fmt.Sprintf("%d", jf)
+}
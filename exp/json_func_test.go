@@ -0,0 +1,83 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type jsonFunctionExpressionSuite struct {
+	suite.Suite
+}
+
+func TestJSONFunctionExpressionSuite(t *testing.T) {
+	suite.Run(t, new(jsonFunctionExpressionSuite))
+}
+
+func (jfes *jsonFunctionExpressionSuite) TestClone() {
+	jf := exp.NewJSONFunctionExpression(exp.JSONSetFunc, exp.NewIdentifierExpression("", "", "data"), []string{"a"}, 1)
+	jfes.Equal(jf, jf.Clone())
+}
+
+func (jfes *jsonFunctionExpressionSuite) TestExpression() {
+	jf := exp.NewJSONFunctionExpression(exp.JSONAggFunc, exp.NewIdentifierExpression("", "", "a"))
+	jfes.Equal(jf, jf.Expression())
+}
+
+func (jfes *jsonFunctionExpressionSuite) TestFuncAndArgs() {
+	col := exp.NewIdentifierExpression("", "", "data")
+	jf := exp.NewJSONFunctionExpression(exp.JSONPathQueryFunc, col, "$.a")
+	jfes.Equal(exp.JSONPathQueryFunc, jf.Func())
+	jfes.Equal([]interface{}{col, "$.a"}, jf.Args())
+}
+
+func (jfes *jsonFunctionExpressionSuite) TestAllOthers() {
+	jf := exp.NewJSONFunctionExpression(exp.JSONBuildObjectFunc, "a", 1)
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: jf.Get("a"), Expected: exp.NewJSONExpression(exp.JSONGetOp, jf, "a")},
+		{Ex: jf.GetText("a"), Expected: exp.NewJSONExpression(exp.JSONGetTextOp, jf, "a")},
+		{Ex: jf.GetPath("a", "b"), Expected: exp.NewJSONExpression(exp.JSONGetPathOp, jf, []string{"a", "b"})},
+		{Ex: jf.GetPathText("a", "b"), Expected: exp.NewJSONExpression(exp.JSONGetPathTextOp, jf, []string{"a", "b"})},
+		{Ex: jf.Contains(1), Expected: exp.NewJSONExpression(exp.JSONContainsOp, jf, 1)},
+		{Ex: jf.ContainedBy(1), Expected: exp.NewJSONExpression(exp.JSONContainedByOp, jf, 1)},
+		{Ex: jf.HasKey("a"), Expected: exp.NewJSONExpression(exp.JSONHasKeyOp, jf, "a")},
+		{Ex: jf.HasAnyKey("a"), Expected: exp.NewJSONExpression(exp.JSONHasAnyKeyOp, jf, []string{"a"})},
+		{Ex: jf.HasAllKeys("a"), Expected: exp.NewJSONExpression(exp.JSONHasAllKeysOp, jf, []string{"a"})},
+		{Ex: jf.Concat(1), Expected: exp.NewJSONExpression(exp.JSONConcatOp, jf, 1)},
+		{Ex: jf.As("a"), Expected: exp.NewAliasExpression(jf, "a")},
+		{Ex: jf.Asc(), Expected: exp.NewOrderedExpression(jf, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: jf.Desc(), Expected: exp.NewOrderedExpression(jf, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: jf.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, jf, 1)},
+		{Ex: jf.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, jf, 1)},
+		{Ex: jf.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, jf, 1)},
+		{Ex: jf.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, jf, 1)},
+		{Ex: jf.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, jf, 1)},
+		{Ex: jf.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, jf, 1)},
+		{Ex: jf.Is(true), Expected: exp.NewBooleanExpression(exp.IsOp, jf, true)},
+		{Ex: jf.IsNot(true), Expected: exp.NewBooleanExpression(exp.IsNotOp, jf, true)},
+		{Ex: jf.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, jf, nil)},
+		{Ex: jf.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, jf, nil)},
+		{Ex: jf.IsTrue(), Expected: exp.NewBooleanExpression(exp.IsOp, jf, true)},
+		{Ex: jf.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, jf, true)},
+		{Ex: jf.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, jf, false)},
+		{Ex: jf.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, jf, false)},
+		{Ex: jf.IsDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, jf, 1)},
+		{Ex: jf.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, jf, 1)},
+	}
+	for _, tc := range testCases {
+		jfes.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+func (jfes *jsonFunctionExpressionSuite) TestJSONFunction_String() {
+	jfes.Equal("Path Query", exp.JSONPathQueryFunc.String())
+	jfes.Equal("Set", exp.JSONSetFunc.String())
+	jfes.Equal("Build Object", exp.JSONBuildObjectFunc.String())
+	jfes.Equal("Agg", exp.JSONAggFunc.String())
+	jfes.Equal("Object Agg", exp.JSONObjectAggFunc.String())
+	jfes.Equal("100", exp.JSONFunction(100).String())
+}
@@ -0,0 +1,126 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type jsonExpressionSuite struct {
+	suite.Suite
+}
+
+func TestJSONExpressionSuite(t *testing.T) {
+	suite.Run(t, new(jsonExpressionSuite))
+}
+
+func (jes *jsonExpressionSuite) TestClone() {
+	je := exp.NewJSONAccessor(exp.NewIdentifierExpression("", "", "data")).Get("a")
+	jes.Equal(je, je.Clone())
+}
+
+func (jes *jsonExpressionSuite) TestExpression() {
+	je := exp.NewJSONAccessor(exp.NewIdentifierExpression("", "", "data")).Get("a")
+	jes.Equal(je, je.Expression())
+}
+
+func (jes *jsonExpressionSuite) TestAccessor() {
+	col := exp.NewIdentifierExpression("", "", "data")
+	ja := exp.NewJSONAccessor(col)
+	testCases := []struct {
+		Ex  exp.JSONExpression
+		Op  exp.JSONOperation
+		RHS interface{}
+	}{
+		{Ex: ja.Get("a"), Op: exp.JSONGetOp, RHS: "a"},
+		{Ex: ja.Get(1), Op: exp.JSONGetOp, RHS: 1},
+		{Ex: ja.GetText("a"), Op: exp.JSONGetTextOp, RHS: "a"},
+		{Ex: ja.GetPath("a", "b"), Op: exp.JSONGetPathOp, RHS: []string{"a", "b"}},
+		{Ex: ja.GetPathText("a", "b"), Op: exp.JSONGetPathTextOp, RHS: []string{"a", "b"}},
+		{Ex: ja.Contains(`{"a":1}`), Op: exp.JSONContainsOp, RHS: `{"a":1}`},
+		{Ex: ja.ContainedBy(`{"a":1}`), Op: exp.JSONContainedByOp, RHS: `{"a":1}`},
+		{Ex: ja.HasKey("a"), Op: exp.JSONHasKeyOp, RHS: "a"},
+		{Ex: ja.HasAnyKey("a", "b"), Op: exp.JSONHasAnyKeyOp, RHS: []string{"a", "b"}},
+		{Ex: ja.HasAllKeys("a", "b"), Op: exp.JSONHasAllKeysOp, RHS: []string{"a", "b"}},
+		{Ex: ja.Concat(`{"a":1}`), Op: exp.JSONConcatOp, RHS: `{"a":1}`},
+	}
+	for _, tc := range testCases {
+		jes.Equal(col, tc.Ex.LHS())
+		jes.Equal(tc.Op, tc.Ex.Op())
+		jes.Equal(tc.RHS, tc.Ex.RHS())
+	}
+}
+
+func (jes *jsonExpressionSuite) TestChaining() {
+	je := exp.NewJSONAccessor(exp.NewIdentifierExpression("", "", "data")).Get("a")
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: je.Get("b"), Expected: exp.NewJSONExpression(exp.JSONGetOp, je, "b")},
+		{Ex: je.GetText("b"), Expected: exp.NewJSONExpression(exp.JSONGetTextOp, je, "b")},
+		{Ex: je.GetPath("b", "c"), Expected: exp.NewJSONExpression(exp.JSONGetPathOp, je, []string{"b", "c"})},
+		{Ex: je.GetPathText("b", "c"), Expected: exp.NewJSONExpression(exp.JSONGetPathTextOp, je, []string{"b", "c"})},
+		{Ex: je.Contains(1), Expected: exp.NewJSONExpression(exp.JSONContainsOp, je, 1)},
+		{Ex: je.ContainedBy(1), Expected: exp.NewJSONExpression(exp.JSONContainedByOp, je, 1)},
+		{Ex: je.HasKey("b"), Expected: exp.NewJSONExpression(exp.JSONHasKeyOp, je, "b")},
+		{Ex: je.HasAnyKey("b"), Expected: exp.NewJSONExpression(exp.JSONHasAnyKeyOp, je, []string{"b"})},
+		{Ex: je.HasAllKeys("b"), Expected: exp.NewJSONExpression(exp.JSONHasAllKeysOp, je, []string{"b"})},
+		{Ex: je.Concat(1), Expected: exp.NewJSONExpression(exp.JSONConcatOp, je, 1)},
+	}
+	for _, tc := range testCases {
+		jes.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+func (jes *jsonExpressionSuite) TestAllOthers() {
+	je := exp.NewJSONAccessor(exp.NewIdentifierExpression("", "", "data")).GetText("a")
+	pattern := "json like%"
+	inVals := []interface{}{1, 2}
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: je.As("a"), Expected: exp.NewAliasExpression(je, "a")},
+		{Ex: je.Asc(), Expected: exp.NewOrderedExpression(je, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: je.Desc(), Expected: exp.NewOrderedExpression(je, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: je.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, je, 1)},
+		{Ex: je.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, je, 1)},
+		{Ex: je.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, je, 1)},
+		{Ex: je.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, je, 1)},
+		{Ex: je.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, je, 1)},
+		{Ex: je.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, je, 1)},
+		{Ex: je.Like(pattern), Expected: exp.NewBooleanExpression(exp.LikeOp, je, pattern)},
+		{Ex: je.NotLike(pattern), Expected: exp.NewBooleanExpression(exp.NotLikeOp, je, pattern)},
+		{Ex: je.ILike(pattern), Expected: exp.NewBooleanExpression(exp.ILikeOp, je, pattern)},
+		{Ex: je.NotILike(pattern), Expected: exp.NewBooleanExpression(exp.NotILikeOp, je, pattern)},
+		{Ex: je.RegexpLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpLikeOp, je, pattern)},
+		{Ex: je.RegexpNotLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotLikeOp, je, pattern)},
+		{Ex: je.RegexpILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpILikeOp, je, pattern)},
+		{Ex: je.RegexpNotILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotILikeOp, je, pattern)},
+		{Ex: je.In(inVals), Expected: exp.NewBooleanExpression(exp.InOp, je, inVals)},
+		{Ex: je.NotIn(inVals), Expected: exp.NewBooleanExpression(exp.NotInOp, je, inVals)},
+		{Ex: je.Is(true), Expected: exp.NewBooleanExpression(exp.IsOp, je, true)},
+		{Ex: je.IsNot(true), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, true)},
+		{Ex: je.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, je, nil)},
+		{Ex: je.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, nil)},
+		{Ex: je.IsTrue(), Expected: exp.NewBooleanExpression(exp.IsOp, je, true)},
+		{Ex: je.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, true)},
+		{Ex: je.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, je, false)},
+		{Ex: je.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, false)},
+		{Ex: je.IsDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, je, 1)},
+		{Ex: je.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, je, 1)},
+	}
+	for _, tc := range testCases {
+		jes.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+func (jes *jsonExpressionSuite) TestJSONOperation_String() {
+	jes.Equal("Get", exp.JSONGetOp.String())
+	jes.Equal("Get Path Text", exp.JSONGetPathTextOp.String())
+	jes.Equal("Has All Keys", exp.JSONHasAllKeysOp.String())
+	jes.Equal("Concat", exp.JSONConcatOp.String())
+	jes.Equal("100", exp.JSONOperation(100).String())
+}
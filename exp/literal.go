@@ -70,6 +70,18 @@ func (l literal) IsNotTrue() BooleanExpression                     { return isNo
 func (l literal) IsFalse() BooleanExpression                       { return is(l, false) }
 func (l literal) IsNotFalse() BooleanExpression                    { return isNot(l, false) }
 
+func (l literal) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(l, val)
+}
+
+func (l literal) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(l, val)
+}
+
+func (l literal) AtTimeZone(tz interface{}) AtTimeZoneExpression {
+	return NewAtTimeZoneExpression(l, tz)
+}
+
 func (l literal) BitwiseInversion() BitwiseExpression                { return bitwiseInversion(l) }
 func (l literal) BitwiseOr(val interface{}) BitwiseExpression        { return bitwiseOr(l, val) }
 func (l literal) BitwiseAnd(val interface{}) BitwiseExpression       { return bitwiseAnd(l, val) }
@@ -73,6 +73,9 @@ func (les *literalExpressionSuite) TestAllOthers() {
 		{Ex: le.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, le, true)},
 		{Ex: le.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, le, false)},
 		{Ex: le.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, le, false)},
+		{Ex: le.IsDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, le, 1)},
+		{Ex: le.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, le, 1)},
+		{Ex: le.AtTimeZone("UTC"), Expected: exp.NewAtTimeZoneExpression(le, "UTC")},
 		{Ex: le.BitwiseInversion(), Expected: exp.NewBitwiseExpression(exp.BitwiseInversionOp, nil, le)},
 		{Ex: le.BitwiseOr(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseOrOp, le, bitwiseVals)},
 		{Ex: le.BitwiseAnd(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseAndOp, le, bitwiseVals)},
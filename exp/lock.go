@@ -6,12 +6,15 @@ type (
 	Lock         interface {
 		Strength() LockStrength
 		WaitOption() WaitOption
+		// The number of seconds to wait for the lock when the WaitOption is WaitTimeout
+		WaitSeconds() uint
 		Of() []IdentifierExpression
 	}
 	lock struct {
-		strength   LockStrength
-		waitOption WaitOption
-		of         []IdentifierExpression
+		strength    LockStrength
+		waitOption  WaitOption
+		waitSeconds uint
+		of          []IdentifierExpression
 	}
 )
 
@@ -25,6 +28,8 @@ const (
 	Wait WaitOption = iota
 	NoWait
 	SkipLocked
+	// waits at most the number of seconds of the lock (see NewLockWithWaitSeconds)
+	WaitTimeout
 )
 
 func NewLock(strength LockStrength, option WaitOption, of ...IdentifierExpression) Lock {
@@ -35,6 +40,16 @@ func NewLock(strength LockStrength, option WaitOption, of ...IdentifierExpressio
 	}
 }
 
+// Creates a lock that waits at most the number of seconds to be acquired (e.g. FOR UPDATE WAIT 5)
+func NewLockWithWaitSeconds(strength LockStrength, seconds uint, of ...IdentifierExpression) Lock {
+	return lock{
+		strength:    strength,
+		waitOption:  WaitTimeout,
+		waitSeconds: seconds,
+		of:          of,
+	}
+}
+
 func (l lock) Strength() LockStrength {
 	return l.strength
 }
@@ -43,6 +58,10 @@ func (l lock) WaitOption() WaitOption {
 	return l.waitOption
 }
 
+func (l lock) WaitSeconds() uint {
+	return l.waitSeconds
+}
+
 func (l lock) Of() []IdentifierExpression {
 	return l.of
 }
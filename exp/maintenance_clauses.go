@@ -0,0 +1,108 @@
+package exp
+
+import "fmt"
+
+type (
+	// The maintenance operation to run on a table (e.g. VACUUM)
+	MaintenanceOperation int
+
+	// Options to use when generating a maintenance statement
+	MaintenanceOptions struct {
+		// Set to true to rewrite the table to reclaim all unused space (e.g. VACUUM (FULL))
+		Full bool
+		// Set to true to aggressively freeze rows (e.g. VACUUM (FREEZE))
+		Freeze bool
+		// Set to true to print a progress report (e.g. VACUUM (VERBOSE))
+		Verbose bool
+		// Set to true to update the statistics used by the planner after vacuuming (e.g. VACUUM (ANALYZE))
+		Analyze bool
+		// Set to true to skip tables that cannot be locked immediately (e.g. VACUUM (SKIP_LOCKED))
+		SkipLocked bool
+	}
+
+	MaintenanceClauses interface {
+		clone() *maintenanceClauses
+
+		Operation() MaintenanceOperation
+		SetOperation(op MaintenanceOperation) MaintenanceClauses
+
+		HasTables() bool
+		Tables() ColumnListExpression
+		SetTables(tables ColumnListExpression) MaintenanceClauses
+
+		Options() MaintenanceOptions
+		SetOptions(opts MaintenanceOptions) MaintenanceClauses
+	}
+	maintenanceClauses struct {
+		operation MaintenanceOperation
+		tables    ColumnListExpression
+		options   MaintenanceOptions
+	}
+)
+
+const (
+	// Reclaim storage occupied by dead rows (e.g. postgres VACUUM)
+	VacuumOperation MaintenanceOperation = iota
+	// Update the statistics used by the planner (e.g. postgres ANALYZE, mysql ANALYZE TABLE)
+	AnalyzeOperation
+	// Defragment the table and reclaim unused space (e.g. mysql OPTIMIZE TABLE)
+	OptimizeOperation
+)
+
+func (mo MaintenanceOperation) String() string {
+	switch mo {
+	case VacuumOperation:
+		return "VACUUM"
+	case AnalyzeOperation:
+		return "ANALYZE"
+	case OptimizeOperation:
+		return "OPTIMIZE"
+	}
+	return fmt.Sprintf("%d", mo)
+}
+
+func NewMaintenanceClauses() MaintenanceClauses {
+	return &maintenanceClauses{}
+}
+
+func (mc *maintenanceClauses) clone() *maintenanceClauses {
+	return &maintenanceClauses{
+		operation: mc.operation,
+		tables:    mc.tables,
+		options:   mc.options,
+	}
+}
+
+func (mc *maintenanceClauses) Operation() MaintenanceOperation {
+	return mc.operation
+}
+
+func (mc *maintenanceClauses) SetOperation(op MaintenanceOperation) MaintenanceClauses {
+	ret := mc.clone()
+	ret.operation = op
+	return ret
+}
+
+func (mc *maintenanceClauses) HasTables() bool {
+	return mc.tables != nil && !mc.tables.IsEmpty()
+}
+
+func (mc *maintenanceClauses) Tables() ColumnListExpression {
+	return mc.tables
+}
+
+func (mc *maintenanceClauses) SetTables(tables ColumnListExpression) MaintenanceClauses {
+	ret := mc.clone()
+	ret.tables = tables
+	return ret
+}
+
+func (mc *maintenanceClauses) Options() MaintenanceOptions {
+	return mc.options
+}
+
+func (mc *maintenanceClauses) SetOptions(opts MaintenanceOptions) MaintenanceClauses {
+	ret := mc.clone()
+	ret.options = opts
+	return ret
+}
@@ -0,0 +1,65 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type maintenanceClausesSuite struct {
+	suite.Suite
+}
+
+func TestMaintenanceClausesSuite(t *testing.T) {
+	suite.Run(t, new(maintenanceClausesSuite))
+}
+
+func (mcs *maintenanceClausesSuite) TestMaintenanceOperation_String() {
+	mcs.Equal("VACUUM", exp.VacuumOperation.String())
+	mcs.Equal("ANALYZE", exp.AnalyzeOperation.String())
+	mcs.Equal("OPTIMIZE", exp.OptimizeOperation.String())
+	mcs.Equal("10", exp.MaintenanceOperation(10).String())
+}
+
+func (mcs *maintenanceClausesSuite) TestSetOperation() {
+	c := exp.NewMaintenanceClauses()
+	c2 := c.SetOperation(exp.OptimizeOperation)
+
+	mcs.Equal(exp.VacuumOperation, c.Operation())
+
+	mcs.Equal(exp.OptimizeOperation, c2.Operation())
+}
+
+func (mcs *maintenanceClausesSuite) TestHasTables() {
+	c := exp.NewMaintenanceClauses()
+	c2 := c.SetTables(exp.NewColumnListExpression("test1", "test2"))
+	c3 := c.SetTables(exp.NewColumnListExpression())
+
+	mcs.False(c.HasTables())
+
+	mcs.True(c2.HasTables())
+
+	mcs.False(c3.HasTables())
+}
+
+func (mcs *maintenanceClausesSuite) TestSetTables() {
+	cle := exp.NewColumnListExpression("test1", "test2")
+	c := exp.NewMaintenanceClauses().SetTables(cle)
+	cle2 := exp.NewColumnListExpression("test3", "test4")
+	c2 := c.SetTables(cle2)
+
+	mcs.Equal(cle, c.Tables())
+
+	mcs.Equal(cle2, c2.Tables())
+}
+
+func (mcs *maintenanceClausesSuite) TestSetOptions() {
+	c := exp.NewMaintenanceClauses()
+	opts := exp.MaintenanceOptions{Full: true, Analyze: true}
+	c2 := c.SetOptions(opts)
+
+	mcs.Equal(exp.MaintenanceOptions{}, c.Options())
+
+	mcs.Equal(opts, c2.Options())
+}
@@ -0,0 +1,158 @@
+package exp
+
+import "fmt"
+
+type (
+	// The action of a WHEN clause of a MERGE statement (e.g. UPDATE, DELETE)
+	MergeAction int
+
+	// A WHEN [NOT] MATCHED clause of a MERGE statement
+	MergeWhenClause interface {
+		// Returns true for WHEN MATCHED clauses and false for WHEN NOT MATCHED clauses
+		IsMatched() bool
+		Action() MergeAction
+		// The additional conditions of the clause (e.g. WHEN MATCHED AND "a" > 1)
+		Condition() ExpressionList
+		// The values to update or the row to insert, see UpdateDataset#Set and InsertDataset#Rows
+		Value() interface{}
+	}
+	mergeWhenClause struct {
+		matched   bool
+		action    MergeAction
+		condition ExpressionList
+		value     interface{}
+	}
+
+	MergeClauses interface {
+		HasTarget() bool
+		clone() *mergeClauses
+
+		Target() Expression
+		SetTarget(target Expression) MergeClauses
+
+		Source() Expression
+		SetSource(source Expression) MergeClauses
+
+		On() ExpressionList
+		SetOn(on ExpressionList) MergeClauses
+
+		WhenClauses() []MergeWhenClause
+		WhenClausesAppend(clause MergeWhenClause) MergeClauses
+	}
+	mergeClauses struct {
+		target      Expression
+		source      Expression
+		on          ExpressionList
+		whenClauses []MergeWhenClause
+	}
+)
+
+const (
+	// UPDATE SET
+	UpdateMergeAction MergeAction = iota
+	// DELETE
+	DeleteMergeAction
+	// INSERT
+	InsertMergeAction
+)
+
+func (a MergeAction) String() string {
+	switch a {
+	case UpdateMergeAction:
+		return "UPDATE"
+	case DeleteMergeAction:
+		return "DELETE"
+	case InsertMergeAction:
+		return "INSERT"
+	}
+	return fmt.Sprintf("%d", a)
+}
+
+// Creates a WHEN MATCHED THEN UPDATE clause, the update can be anything accepted by NewUpdateExpressions
+func NewMergeUpdateClause(condition ExpressionList, update interface{}) MergeWhenClause {
+	return mergeWhenClause{matched: true, action: UpdateMergeAction, condition: condition, value: update}
+}
+
+// Creates a WHEN MATCHED THEN DELETE clause
+func NewMergeDeleteClause(condition ExpressionList) MergeWhenClause {
+	return mergeWhenClause{matched: true, action: DeleteMergeAction, condition: condition}
+}
+
+// Creates a WHEN NOT MATCHED THEN INSERT clause, the row can be anything accepted by NewInsertExpression
+func NewMergeInsertClause(condition ExpressionList, row interface{}) MergeWhenClause {
+	return mergeWhenClause{matched: false, action: InsertMergeAction, condition: condition, value: row}
+}
+
+func (mwc mergeWhenClause) IsMatched() bool {
+	return mwc.matched
+}
+
+func (mwc mergeWhenClause) Action() MergeAction {
+	return mwc.action
+}
+
+func (mwc mergeWhenClause) Condition() ExpressionList {
+	return mwc.condition
+}
+
+func (mwc mergeWhenClause) Value() interface{} {
+	return mwc.value
+}
+
+func NewMergeClauses() MergeClauses {
+	return &mergeClauses{}
+}
+
+func (mc *mergeClauses) HasTarget() bool {
+	return mc.target != nil
+}
+
+func (mc *mergeClauses) clone() *mergeClauses {
+	return &mergeClauses{
+		target:      mc.target,
+		source:      mc.source,
+		on:          mc.on,
+		whenClauses: mc.whenClauses,
+	}
+}
+
+func (mc *mergeClauses) Target() Expression {
+	return mc.target
+}
+
+func (mc *mergeClauses) SetTarget(target Expression) MergeClauses {
+	ret := mc.clone()
+	ret.target = target
+	return ret
+}
+
+func (mc *mergeClauses) Source() Expression {
+	return mc.source
+}
+
+func (mc *mergeClauses) SetSource(source Expression) MergeClauses {
+	ret := mc.clone()
+	ret.source = source
+	return ret
+}
+
+func (mc *mergeClauses) On() ExpressionList {
+	return mc.on
+}
+
+func (mc *mergeClauses) SetOn(on ExpressionList) MergeClauses {
+	ret := mc.clone()
+	ret.on = on
+	return ret
+}
+
+func (mc *mergeClauses) WhenClauses() []MergeWhenClause {
+	return mc.whenClauses
+}
+
+func (mc *mergeClauses) WhenClausesAppend(clause MergeWhenClause) MergeClauses {
+	ret := mc.clone()
+	whenClauses := make([]MergeWhenClause, 0, len(mc.whenClauses)+1)
+	ret.whenClauses = append(append(whenClauses, mc.whenClauses...), clause)
+	return ret
+}
@@ -0,0 +1,108 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type mergeClausesSuite struct {
+	suite.Suite
+}
+
+func TestMergeClausesSuite(t *testing.T) {
+	suite.Run(t, new(mergeClausesSuite))
+}
+
+func (mcs *mergeClausesSuite) TestMergeAction_String() {
+	mcs.Equal("UPDATE", exp.UpdateMergeAction.String())
+	mcs.Equal("DELETE", exp.DeleteMergeAction.String())
+	mcs.Equal("INSERT", exp.InsertMergeAction.String())
+	mcs.Equal("100", exp.MergeAction(100).String())
+}
+
+func (mcs *mergeClausesSuite) TestNewMergeUpdateClause() {
+	cond := exp.NewExpressionList(exp.AndType, exp.NewIdentifierExpression("", "", "a").Gt(1))
+	update := exp.Record{"a": 1}
+	c := exp.NewMergeUpdateClause(cond, update)
+
+	mcs.True(c.IsMatched())
+	mcs.Equal(exp.UpdateMergeAction, c.Action())
+	mcs.Equal(cond, c.Condition())
+	mcs.Equal(update, c.Value())
+}
+
+func (mcs *mergeClausesSuite) TestNewMergeDeleteClause() {
+	cond := exp.NewExpressionList(exp.AndType, exp.NewIdentifierExpression("", "", "a").Gt(1))
+	c := exp.NewMergeDeleteClause(cond)
+
+	mcs.True(c.IsMatched())
+	mcs.Equal(exp.DeleteMergeAction, c.Action())
+	mcs.Equal(cond, c.Condition())
+	mcs.Nil(c.Value())
+}
+
+func (mcs *mergeClausesSuite) TestNewMergeInsertClause() {
+	row := exp.Record{"a": 1}
+	c := exp.NewMergeInsertClause(nil, row)
+
+	mcs.False(c.IsMatched())
+	mcs.Equal(exp.InsertMergeAction, c.Action())
+	mcs.Nil(c.Condition())
+	mcs.Equal(row, c.Value())
+}
+
+func (mcs *mergeClausesSuite) TestHasTarget() {
+	c := exp.NewMergeClauses()
+	c2 := c.SetTarget(exp.NewIdentifierExpression("", "test", ""))
+
+	mcs.False(c.HasTarget())
+
+	mcs.True(c2.HasTarget())
+}
+
+func (mcs *mergeClausesSuite) TestSetTarget() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewMergeClauses().SetTarget(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetTarget(ti2)
+
+	mcs.Equal(ti, c.Target())
+
+	mcs.Equal(ti2, c2.Target())
+}
+
+func (mcs *mergeClausesSuite) TestSetSource() {
+	c := exp.NewMergeClauses()
+	si := exp.NewIdentifierExpression("", "test", "")
+	c2 := c.SetSource(si)
+
+	mcs.Nil(c.Source())
+
+	mcs.Equal(si, c2.Source())
+}
+
+func (mcs *mergeClausesSuite) TestSetOn() {
+	c := exp.NewMergeClauses()
+	on := exp.NewExpressionList(exp.AndType, exp.NewIdentifierExpression("", "a", "id").Eq(1))
+	c2 := c.SetOn(on)
+
+	mcs.Nil(c.On())
+
+	mcs.Equal(on, c2.On())
+}
+
+func (mcs *mergeClausesSuite) TestWhenClausesAppend() {
+	wc := exp.NewMergeDeleteClause(nil)
+	wc2 := exp.NewMergeInsertClause(nil, exp.Record{"a": 1})
+	c := exp.NewMergeClauses()
+	c2 := c.WhenClausesAppend(wc)
+	c3 := c2.WhenClausesAppend(wc2)
+
+	mcs.Nil(c.WhenClauses())
+
+	mcs.Equal([]exp.MergeWhenClause{wc}, c2.WhenClauses())
+
+	mcs.Equal([]exp.MergeWhenClause{wc, wc2}, c3.WhenClauses())
+}
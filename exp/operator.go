@@ -0,0 +1,128 @@
+package exp
+
+type (
+	// A custom operator (e.g. the pgvector <-> distance operator), the symbol can be overridden for a dialect (see
+	// SQLDialectOptions.CustomOperatorLookup)
+	Operator interface {
+		// The name of the operator used to look up the symbol of a dialect
+		Name() string
+		// The symbol written when the dialect does not override it
+		Symbol() string
+		// Returns true if the operator is a prefix operator (e.g. @ "a")
+		IsPrefix() bool
+	}
+	// An operator between two values (e.g. "a" <-> '[1,2,3]')
+	BinaryOperator interface {
+		Operator
+		// Creates an OperatorExpression that applies the operator to the values, a string lhs is treated as a column
+		Apply(lhs, rhs interface{}) OperatorExpression
+	}
+	// An operator in front of a value (e.g. @ "a")
+	PrefixOperator interface {
+		Operator
+		// Creates an OperatorExpression that applies the operator to the value
+		Apply(val interface{}) OperatorExpression
+	}
+	// A custom operator applied to values, it can be used as a condition or compared and ordered
+	//   BinaryOperator("l2_distance", "<->").Apply("embedding", "[1,2,3]").Asc() -> ("embedding" <-> '[1,2,3]') ASC
+	OperatorExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Orderable
+		Rangeable
+		// The operator that is applied
+		Operator() Operator
+		// The left hand side of a binary operator, nil for prefix operators
+		LHS() Expression
+		// The value on the right hand side of the operator
+		RHS() interface{}
+	}
+	operator struct {
+		name   string
+		symbol string
+		prefix bool
+	}
+	binaryOperator struct {
+		operator
+	}
+	prefixOperator struct {
+		operator
+	}
+	operatorExpression struct {
+		op  Operator
+		lhs Expression
+		rhs interface{}
+	}
+)
+
+// Creates a new BinaryOperator
+//
+//	NewBinaryOperator("l2_distance", "<->").Apply("embedding", "[1,2,3]") -> ("embedding" <-> '[1,2,3]')
+func NewBinaryOperator(name, symbol string) BinaryOperator {
+	return binaryOperator{operator: operator{name: name, symbol: symbol}}
+}
+
+// Creates a new PrefixOperator
+//
+//	NewPrefixOperator("abs", "@").Apply(NewIdentifierExpression("", "", "a")) -> (@ "a")
+func NewPrefixOperator(name, symbol string) PrefixOperator {
+	return prefixOperator{operator: operator{name: name, symbol: symbol, prefix: true}}
+}
+
+// Creates a new OperatorExpression, lhs should be nil for prefix operators
+func NewOperatorExpression(op Operator, lhs Expression, rhs interface{}) OperatorExpression {
+	return operatorExpression{op: op, lhs: lhs, rhs: rhs}
+}
+
+func (o operator) Name() string   { return o.name }
+func (o operator) Symbol() string { return o.symbol }
+func (o operator) IsPrefix() bool { return o.prefix }
+
+func (bo binaryOperator) Apply(lhs, rhs interface{}) OperatorExpression {
+	switch t := lhs.(type) {
+	case string:
+		return NewOperatorExpression(bo, ParseIdentifier(t), rhs)
+	case Expression:
+		return NewOperatorExpression(bo, t, rhs)
+	}
+	return NewOperatorExpression(bo, NewLiteralExpression("?", lhs), rhs)
+}
+
+func (po prefixOperator) Apply(val interface{}) OperatorExpression {
+	return NewOperatorExpression(po, nil, val)
+}
+
+func (oe operatorExpression) Clone() Expression {
+	var lhs Expression
+	if oe.lhs != nil {
+		lhs = oe.lhs.Clone()
+	}
+	return NewOperatorExpression(oe.op, lhs, oe.rhs)
+}
+
+func (oe operatorExpression) Expression() Expression { return oe }
+func (oe operatorExpression) Operator() Operator     { return oe.op }
+func (oe operatorExpression) LHS() Expression        { return oe.lhs }
+func (oe operatorExpression) RHS() interface{}       { return oe.rhs }
+
+func (oe operatorExpression) As(val interface{}) AliasedExpression {
+	return NewAliasExpression(oe, val)
+}
+
+func (oe operatorExpression) Eq(val interface{}) BooleanExpression  { return eq(oe, val) }
+func (oe operatorExpression) Neq(val interface{}) BooleanExpression { return neq(oe, val) }
+func (oe operatorExpression) Gt(val interface{}) BooleanExpression  { return gt(oe, val) }
+func (oe operatorExpression) Gte(val interface{}) BooleanExpression { return gte(oe, val) }
+func (oe operatorExpression) Lt(val interface{}) BooleanExpression  { return lt(oe, val) }
+func (oe operatorExpression) Lte(val interface{}) BooleanExpression { return lte(oe, val) }
+func (oe operatorExpression) Asc() OrderedExpression                { return asc(oe) }
+func (oe operatorExpression) Desc() OrderedExpression               { return desc(oe) }
+
+func (oe operatorExpression) Between(val RangeVal) RangeExpression {
+	return between(oe, val)
+}
+
+func (oe operatorExpression) NotBetween(val RangeVal) RangeExpression {
+	return notBetween(oe, val)
+}
@@ -0,0 +1,79 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type operatorExpressionSuite struct {
+	suite.Suite
+}
+
+func TestOperatorExpressionSuite(t *testing.T) {
+	suite.Run(t, new(operatorExpressionSuite))
+}
+
+func (oes *operatorExpressionSuite) TestBinaryOperator() {
+	op := exp.NewBinaryOperator("l2_distance", "<->")
+	oes.Equal("l2_distance", op.Name())
+	oes.Equal("<->", op.Symbol())
+	oes.False(op.IsPrefix())
+
+	col := exp.NewIdentifierExpression("", "", "a")
+	oes.Equal(exp.NewOperatorExpression(op, col, "[1,2]"), op.Apply("a", "[1,2]"))
+	oes.Equal(exp.NewOperatorExpression(op, exp.ParseIdentifier("t.a"), "[1,2]"), op.Apply("t.a", "[1,2]"))
+	oes.Equal(exp.NewOperatorExpression(op, col, col), op.Apply(col, col))
+	oes.Equal(exp.NewOperatorExpression(op, exp.NewLiteralExpression("?", 1), 2), op.Apply(1, 2))
+}
+
+func (oes *operatorExpressionSuite) TestPrefixOperator() {
+	op := exp.NewPrefixOperator("abs", "@")
+	oes.Equal("abs", op.Name())
+	oes.Equal("@", op.Symbol())
+	oes.True(op.IsPrefix())
+
+	col := exp.NewIdentifierExpression("", "", "a")
+	oe := op.Apply(col)
+	oes.Equal(exp.NewOperatorExpression(op, nil, col), oe)
+	oes.Nil(oe.LHS())
+	oes.Equal(col, oe.RHS())
+	oes.Equal(op, oe.Operator())
+}
+
+func (oes *operatorExpressionSuite) TestClone() {
+	oe := exp.NewBinaryOperator("l2_distance", "<->").Apply("a", "[1,2]")
+	oes.Equal(oe, oe.Clone())
+	poe := exp.NewPrefixOperator("abs", "@").Apply(1)
+	oes.Equal(poe, poe.Clone())
+}
+
+func (oes *operatorExpressionSuite) TestExpression() {
+	oe := exp.NewBinaryOperator("l2_distance", "<->").Apply("a", "[1,2]")
+	oes.Equal(oe, oe.Expression())
+}
+
+func (oes *operatorExpressionSuite) TestAllOthers() {
+	oe := exp.NewBinaryOperator("l2_distance", "<->").Apply("a", "[1,2]")
+	rv := exp.NewRangeVal(1, 2)
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: oe.As("a"), Expected: exp.NewAliasExpression(oe, "a")},
+		{Ex: oe.Asc(), Expected: exp.NewOrderedExpression(oe, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: oe.Desc(), Expected: exp.NewOrderedExpression(oe, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: oe.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, oe, 1)},
+		{Ex: oe.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, oe, 1)},
+		{Ex: oe.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, oe, 1)},
+		{Ex: oe.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, oe, 1)},
+		{Ex: oe.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, oe, 1)},
+		{Ex: oe.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, oe, 1)},
+		{Ex: oe.Between(rv), Expected: exp.NewRangeExpression(exp.BetweenOp, oe, rv)},
+		{Ex: oe.NotBetween(rv), Expected: exp.NewRangeExpression(exp.NotBetweenOp, oe, rv)},
+	}
+	for _, tc := range testCases {
+		oes.Equal(tc.Expected, tc.Ex)
+	}
+}
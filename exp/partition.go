@@ -0,0 +1,188 @@
+package exp
+
+import "fmt"
+
+type (
+	// The partitioning method of a table (e.g. RANGE, LIST, HASH)
+	PartitionType int
+
+	// The type of the values a partition holds (e.g. FOR VALUES FROM (1) TO (10), VALUES LESS THAN (10))
+	PartitionBoundType int
+
+	// The PARTITION BY clause of a CREATE TABLE statement
+	//    NewPartitionBy(RangePartition, "created_at") // PARTITION BY RANGE ("created_at")
+	PartitionBy interface {
+		Expression
+		// The partitioning method
+		PartitionType() PartitionType
+		// The columns or expressions the table is partitioned by
+		Columns() ColumnListExpression
+		// The number of partitions to create, 0 if not set
+		PartitionCount() int
+		// Sets the number of partitions to create (e.g. mysql PARTITION BY HASH (`id`) PARTITIONS 4)
+		Partitions(count int) PartitionBy
+	}
+	partitionBy struct {
+		partitionType PartitionType
+		columns       ColumnListExpression
+		count         int
+	}
+
+	// The values a partition holds, used when defining or attaching a partition
+	//    NewRangePartitionBound([]interface{}{1}, []interface{}{10}) // FOR VALUES FROM (1) TO (10)
+	PartitionBound interface {
+		Expression
+		// The type of the bound
+		BoundType() PartitionBoundType
+		// The lower bound of a RangePartitionBound
+		From() []interface{}
+		// The upper bound of a RangePartitionBound
+		To() []interface{}
+		// The values of a ListPartitionBound or the upper bound of a LessThanPartitionBound
+		Values() []interface{}
+		// The modulus of a HashPartitionBound
+		Modulus() int
+		// The remainder of a HashPartitionBound
+		Remainder() int
+	}
+	partitionBound struct {
+		boundType PartitionBoundType
+		from      []interface{}
+		to        []interface{}
+		values    []interface{}
+		modulus   int
+		remainder int
+	}
+
+	// A partition of a table, partitions are defined in the CREATE TABLE statement in some dialects
+	//    NewPartitionDefinition(ParseIdentifier("p0"), NewLessThanPartitionBound(10)) // PARTITION `p0` VALUES LESS THAN (10)
+	PartitionDefinition interface {
+		Expression
+		// The name of the partition
+		Name() IdentifierExpression
+		// The values of the partition, nil if the partition does not have a bound (e.g. a HASH partition)
+		Bound() PartitionBound
+	}
+	partitionDefinition struct {
+		name  IdentifierExpression
+		bound PartitionBound
+	}
+)
+
+const (
+	RangePartition PartitionType = iota
+	ListPartition
+	HashPartition
+)
+
+const (
+	RangePartitionBound PartitionBoundType = iota
+	LessThanPartitionBound
+	ListPartitionBound
+	HashPartitionBound
+	DefaultPartitionBound
+)
+
+func (t PartitionType) String() string {
+	switch t {
+	case RangePartition:
+		return "RANGE"
+	case ListPartition:
+		return "LIST"
+	case HashPartition:
+		return "HASH"
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+func (t PartitionBoundType) String() string {
+	switch t {
+	case RangePartitionBound:
+		return "FROM TO"
+	case LessThanPartitionBound:
+		return "LESS THAN"
+	case ListPartitionBound:
+		return "IN"
+	case HashPartitionBound:
+		return "WITH MODULUS"
+	case DefaultPartitionBound:
+		return "DEFAULT"
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+// Creates a new PARTITION BY clause, strings are turned into identifiers
+//    NewPartitionBy(ListPartition, "region") // PARTITION BY LIST ("region")
+func NewPartitionBy(t PartitionType, cols ...interface{}) PartitionBy {
+	return partitionBy{partitionType: t, columns: NewColumnListExpression(cols...)}
+}
+
+func (pb partitionBy) Clone() Expression {
+	return partitionBy{partitionType: pb.partitionType, columns: pb.columns.Clone().(ColumnListExpression), count: pb.count}
+}
+
+func (pb partitionBy) Expression() Expression        { return pb }
+func (pb partitionBy) PartitionType() PartitionType  { return pb.partitionType }
+func (pb partitionBy) Columns() ColumnListExpression { return pb.columns }
+func (pb partitionBy) PartitionCount() int           { return pb.count }
+
+func (pb partitionBy) Partitions(count int) PartitionBy {
+	pb.count = count
+	return pb
+}
+
+// Creates a bound for the values from the lower bound (inclusive) to the upper bound (exclusive)
+//    NewRangePartitionBound([]interface{}{"2024-01-01"}, []interface{}{"2025-01-01"})
+//    // FOR VALUES FROM ('2024-01-01') TO ('2025-01-01')
+func NewRangePartitionBound(from, to []interface{}) PartitionBound {
+	return partitionBound{boundType: RangePartitionBound, from: from, to: to}
+}
+
+// Creates a bound for the values less than the values
+//    NewLessThanPartitionBound(2024) // VALUES LESS THAN (2024)
+func NewLessThanPartitionBound(vals ...interface{}) PartitionBound {
+	return partitionBound{boundType: LessThanPartitionBound, values: vals}
+}
+
+// Creates a bound for a list of values
+//    NewListPartitionBound("eu", "us") // FOR VALUES IN ('eu', 'us')
+func NewListPartitionBound(vals ...interface{}) PartitionBound {
+	return partitionBound{boundType: ListPartitionBound, values: vals}
+}
+
+// Creates a bound for the values with a hash that has the remainder when divided by the modulus
+//    NewHashPartitionBound(4, 0) // FOR VALUES WITH (MODULUS 4, REMAINDER 0)
+func NewHashPartitionBound(modulus, remainder int) PartitionBound {
+	return partitionBound{boundType: HashPartitionBound, modulus: modulus, remainder: remainder}
+}
+
+// Creates a bound for the values that do not fit in any other partition
+//    NewDefaultPartitionBound() // DEFAULT
+func NewDefaultPartitionBound() PartitionBound {
+	return partitionBound{boundType: DefaultPartitionBound}
+}
+
+func (pb partitionBound) Clone() Expression {
+	return pb
+}
+
+func (pb partitionBound) Expression() Expression        { return pb }
+func (pb partitionBound) BoundType() PartitionBoundType { return pb.boundType }
+func (pb partitionBound) From() []interface{}           { return pb.from }
+func (pb partitionBound) To() []interface{}             { return pb.to }
+func (pb partitionBound) Values() []interface{}         { return pb.values }
+func (pb partitionBound) Modulus() int                  { return pb.modulus }
+func (pb partitionBound) Remainder() int                { return pb.remainder }
+
+// Creates a new partition, the bound may be nil
+func NewPartitionDefinition(name IdentifierExpression, bound PartitionBound) PartitionDefinition {
+	return partitionDefinition{name: name, bound: bound}
+}
+
+func (pd partitionDefinition) Clone() Expression {
+	return pd
+}
+
+func (pd partitionDefinition) Expression() Expression     { return pd }
+func (pd partitionDefinition) Name() IdentifierExpression { return pd.name }
+func (pd partitionDefinition) Bound() PartitionBound      { return pd.bound }
@@ -0,0 +1,85 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type partitionExpressionSuite struct {
+	suite.Suite
+}
+
+func TestPartitionExpressionSuite(t *testing.T) {
+	suite.Run(t, new(partitionExpressionSuite))
+}
+
+func (pes *partitionExpressionSuite) TestPartitionBy() {
+	pb := exp.NewPartitionBy(exp.HashPartition, "a", "b")
+	pes.Equal(exp.HashPartition, pb.PartitionType())
+	pes.Equal(exp.NewColumnListExpression("a", "b"), pb.Columns())
+	pes.Equal(0, pb.PartitionCount())
+	pes.Equal(pb, pb.Expression())
+	pes.Equal(pb, pb.Clone())
+
+	pb2 := pb.Partitions(4)
+	pes.Equal(4, pb2.PartitionCount())
+	pes.Equal(pb2, pb2.Clone())
+
+	// the original partition by is not modified
+	pes.Equal(0, pb.PartitionCount())
+}
+
+func (pes *partitionExpressionSuite) TestPartitionBound() {
+	rb := exp.NewRangePartitionBound([]interface{}{1}, []interface{}{10})
+	pes.Equal(exp.RangePartitionBound, rb.BoundType())
+	pes.Equal([]interface{}{1}, rb.From())
+	pes.Equal([]interface{}{10}, rb.To())
+	pes.Nil(rb.Values())
+	pes.Equal(rb, rb.Expression())
+	pes.Equal(rb, rb.Clone())
+
+	lt := exp.NewLessThanPartitionBound(10, 20)
+	pes.Equal(exp.LessThanPartitionBound, lt.BoundType())
+	pes.Equal([]interface{}{10, 20}, lt.Values())
+
+	lb := exp.NewListPartitionBound("a", "b")
+	pes.Equal(exp.ListPartitionBound, lb.BoundType())
+	pes.Equal([]interface{}{"a", "b"}, lb.Values())
+
+	hb := exp.NewHashPartitionBound(4, 1)
+	pes.Equal(exp.HashPartitionBound, hb.BoundType())
+	pes.Equal(4, hb.Modulus())
+	pes.Equal(1, hb.Remainder())
+
+	db := exp.NewDefaultPartitionBound()
+	pes.Equal(exp.DefaultPartitionBound, db.BoundType())
+}
+
+func (pes *partitionExpressionSuite) TestPartitionDefinition() {
+	bound := exp.NewLessThanPartitionBound(10)
+	pd := exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), bound)
+	pes.Equal(exp.ParseIdentifier("p0"), pd.Name())
+	pes.Equal(bound, pd.Bound())
+	pes.Equal(pd, pd.Expression())
+	pes.Equal(pd, pd.Clone())
+
+	pes.Nil(exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), nil).Bound())
+}
+
+func (pes *partitionExpressionSuite) TestPartitionType_String() {
+	pes.Equal("RANGE", exp.RangePartition.String())
+	pes.Equal("LIST", exp.ListPartition.String())
+	pes.Equal("HASH", exp.HashPartition.String())
+	pes.Equal("100", exp.PartitionType(100).String())
+}
+
+func (pes *partitionExpressionSuite) TestPartitionBoundType_String() {
+	pes.Equal("FROM TO", exp.RangePartitionBound.String())
+	pes.Equal("LESS THAN", exp.LessThanPartitionBound.String())
+	pes.Equal("IN", exp.ListPartitionBound.String())
+	pes.Equal("WITH MODULUS", exp.HashPartitionBound.String())
+	pes.Equal("DEFAULT", exp.DefaultPartitionBound.String())
+	pes.Equal("100", exp.PartitionBoundType(100).String())
+}
@@ -0,0 +1,97 @@
+package exp
+
+type (
+	pivot struct {
+		table      Expression
+		aggregates []Expression
+		forCol     IdentifierExpression
+		in         []interface{}
+	}
+	unpivot struct {
+		table        Expression
+		valueCol     IdentifierExpression
+		forCol       IdentifierExpression
+		in           []interface{}
+		includeNulls bool
+	}
+)
+
+// Creates a new PIVOT expression that rotates the rows of a table into columns
+//
+//	NewPivotExpression(T("sales"), SUM("amount")).For("month").In("JAN", "FEB")
+//	// "sales" PIVOT (SUM("amount") FOR "month" IN ('JAN', 'FEB'))
+func NewPivotExpression(table Expression, aggregates ...Expression) PivotExpression {
+	return pivot{table: table, aggregates: aggregates}
+}
+
+func (p pivot) Clone() Expression {
+	return pivot{table: p.table, aggregates: p.aggregates, forCol: p.forCol, in: p.in}
+}
+
+func (p pivot) Expression() Expression               { return p }
+func (p pivot) As(val interface{}) AliasedExpression { return NewAliasExpression(p, val) }
+
+func (p pivot) Table() Expression               { return p.table }
+func (p pivot) Aggregates() []Expression        { return p.aggregates }
+func (p pivot) ForColumn() IdentifierExpression { return p.forCol }
+func (p pivot) InValues() []interface{}         { return p.in }
+
+// Sets the column whose values become the new columns
+func (p pivot) For(col string) PivotExpression {
+	p.forCol = ParseIdentifier(col)
+	return p
+}
+
+// Sets the values of the FOR column that become the new columns. A value can be aliased to name the new column
+// (e.g. V("JAN").As("jan")).
+func (p pivot) In(vals ...interface{}) PivotExpression {
+	p.in = vals
+	return p
+}
+
+// Creates a new UNPIVOT expression that rotates the columns of a table into rows
+//
+//	NewUnpivotExpression(T("sales"), "amount").For("month").In("jan", "feb")
+//	// "sales" UNPIVOT ("amount" FOR "month" IN ("jan", "feb"))
+func NewUnpivotExpression(table Expression, valueCol string) UnpivotExpression {
+	return unpivot{table: table, valueCol: ParseIdentifier(valueCol)}
+}
+
+func (u unpivot) Clone() Expression {
+	return unpivot{table: u.table, valueCol: u.valueCol, forCol: u.forCol, in: u.in, includeNulls: u.includeNulls}
+}
+
+func (u unpivot) Expression() Expression               { return u }
+func (u unpivot) As(val interface{}) AliasedExpression { return NewAliasExpression(u, val) }
+
+func (u unpivot) Table() Expression                 { return u.table }
+func (u unpivot) ValueColumn() IdentifierExpression { return u.valueCol }
+func (u unpivot) ForColumn() IdentifierExpression   { return u.forCol }
+func (u unpivot) InColumns() []interface{}          { return u.in }
+func (u unpivot) IsIncludeNulls() bool              { return u.includeNulls }
+
+// Sets the column that holds the names of the unpivoted columns
+func (u unpivot) For(col string) UnpivotExpression {
+	u.forCol = ParseIdentifier(col)
+	return u
+}
+
+// Sets the columns to unpivot into rows, string values are parsed as identifiers
+func (u unpivot) In(cols ...interface{}) UnpivotExpression {
+	in := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if s, ok := col.(string); ok {
+			in = append(in, ParseIdentifier(s))
+		} else {
+			in = append(in, col)
+		}
+	}
+	u.in = in
+	return u
+}
+
+// Keeps the rows with NULL values which are excluded by default (INCLUDE NULLS)
+func (u unpivot) IncludeNulls() UnpivotExpression {
+	u.includeNulls = true
+	return u
+}
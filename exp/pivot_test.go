@@ -0,0 +1,58 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type pivotExpressionSuite struct {
+	suite.Suite
+}
+
+func TestPivotExpressionSuite(t *testing.T) {
+	suite.Run(t, new(pivotExpressionSuite))
+}
+
+func (pes *pivotExpressionSuite) TestPivot() {
+	table := exp.NewIdentifierExpression("", "sales", "")
+	sum := exp.NewSQLFunctionExpression("SUM", exp.NewIdentifierExpression("", "", "amount"))
+	pe := exp.NewPivotExpression(table, sum)
+	pes.Equal(table, pe.Table())
+	pes.Equal([]exp.Expression{sum}, pe.Aggregates())
+	pes.Nil(pe.ForColumn())
+	pes.Empty(pe.InValues())
+
+	pe2 := pe.For("month").In("JAN", "FEB")
+	pes.Equal(exp.NewIdentifierExpression("", "", "month"), pe2.ForColumn())
+	pes.Equal([]interface{}{"JAN", "FEB"}, pe2.InValues())
+	pes.Equal(pe2, pe2.Clone())
+	pes.Equal(exp.NewAliasExpression(pe2, "p"), pe2.As("p"))
+
+	// the original is not modified
+	pes.Nil(pe.ForColumn())
+	pes.Empty(pe.InValues())
+}
+
+func (pes *pivotExpressionSuite) TestUnpivot() {
+	table := exp.NewIdentifierExpression("", "sales", "")
+	ue := exp.NewUnpivotExpression(table, "amount")
+	pes.Equal(table, ue.Table())
+	pes.Equal(exp.NewIdentifierExpression("", "", "amount"), ue.ValueColumn())
+	pes.Nil(ue.ForColumn())
+	pes.Empty(ue.InColumns())
+	pes.False(ue.IsIncludeNulls())
+
+	feb := exp.NewLiteralExpression(`"feb" AS 'FEB'`)
+	ue2 := ue.For("month").In("jan", feb).IncludeNulls()
+	pes.Equal(exp.NewIdentifierExpression("", "", "month"), ue2.ForColumn())
+	pes.Equal([]interface{}{exp.NewIdentifierExpression("", "", "jan"), feb}, ue2.InColumns())
+	pes.True(ue2.IsIncludeNulls())
+	pes.Equal(ue2, ue2.Clone())
+	pes.Equal(exp.NewAliasExpression(ue2, "u"), ue2.As("u"))
+
+	// the original is not modified
+	pes.Nil(ue.ForColumn())
+	pes.False(ue.IsIncludeNulls())
+}
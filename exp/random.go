@@ -0,0 +1,32 @@
+package exp
+
+type (
+	// A random value generated using the random function of the dialect (e.g. RANDOM(), RAND())
+	RandomExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Orderable
+	}
+	random struct{}
+)
+
+// Creates a new expression for a random value, the function is provided by the dialect
+//
+//	NewRandomExpression() -> RANDOM()
+func NewRandomExpression() RandomExpression {
+	return random{}
+}
+
+func (r random) Clone() Expression { return r }
+
+func (r random) Expression() Expression                { return r }
+func (r random) As(val interface{}) AliasedExpression  { return NewAliasExpression(r, val) }
+func (r random) Eq(val interface{}) BooleanExpression  { return eq(r, val) }
+func (r random) Neq(val interface{}) BooleanExpression { return neq(r, val) }
+func (r random) Gt(val interface{}) BooleanExpression  { return gt(r, val) }
+func (r random) Gte(val interface{}) BooleanExpression { return gte(r, val) }
+func (r random) Lt(val interface{}) BooleanExpression  { return lt(r, val) }
+func (r random) Lte(val interface{}) BooleanExpression { return lte(r, val) }
+func (r random) Asc() OrderedExpression                { return asc(r) }
+func (r random) Desc() OrderedExpression               { return desc(r) }
@@ -0,0 +1,48 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type randomExpressionSuite struct {
+	suite.Suite
+}
+
+func TestRandomExpressionSuite(t *testing.T) {
+	suite.Run(t, new(randomExpressionSuite))
+}
+
+func (res *randomExpressionSuite) TestClone() {
+	r := exp.NewRandomExpression()
+	res.Equal(r, r.Clone())
+}
+
+func (res *randomExpressionSuite) TestExpression() {
+	r := exp.NewRandomExpression()
+	res.Equal(r, r.Expression())
+}
+
+func (res *randomExpressionSuite) TestAllOthers() {
+	r := exp.NewRandomExpression()
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: r.As("a"), Expected: exp.NewAliasExpression(r, "a")},
+		{Ex: r.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, r, 1)},
+		{Ex: r.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, r, 1)},
+		{Ex: r.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, r, 1)},
+		{Ex: r.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, r, 1)},
+		{Ex: r.Lt(0.5), Expected: exp.NewBooleanExpression(exp.LtOp, r, 0.5)},
+		{Ex: r.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, r, 1)},
+		{Ex: r.Asc(), Expected: exp.NewOrderedExpression(r, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: r.Desc(), Expected: exp.NewOrderedExpression(r, exp.DescSortDir, exp.NoNullsSortType)},
+	}
+
+	for _, tc := range testCases {
+		res.Equal(tc.Expected, tc.Ex)
+	}
+}
@@ -0,0 +1,164 @@
+package exp
+
+import "fmt"
+
+// The operation of a RangeTypeExpression
+type RangeTypeOperation int
+
+const (
+	// Returns true if the range contains a range or an element (e.g. postgres @>)
+	RangeTypeContainsOp RangeTypeOperation = iota
+	// Returns true if the range is contained by a range (e.g. postgres <@)
+	RangeTypeContainedByOp
+	// Returns true if the ranges have points in common (e.g. postgres &&)
+	RangeTypeOverlapOp
+	// Returns true if the ranges are adjacent (e.g. postgres -|-)
+	RangeTypeAdjacentOp
+)
+
+type (
+	rangeTypeAccessor struct {
+		value Expression
+	}
+	rangeTypeExpression struct {
+		lhs Expression
+		op  RangeTypeOperation
+		rhs interface{}
+	}
+	rangeLiteral struct {
+		typeName string
+		lower    interface{}
+		upper    interface{}
+		bounds   string
+	}
+)
+
+// Creates a new RangeTypeAccessor that can be used to build RangeTypeExpressions for a range value (e.g. a
+// tstzrange column)
+//
+//	NewRangeTypeAccessor(NewIdentifierExpression("", "", "during")).Overlaps(...) -> ("during" && ...)
+func NewRangeTypeAccessor(value Expression) RangeTypeAccessor {
+	return rangeTypeAccessor{value: value}
+}
+
+// Creates a new RangeTypeExpression, the rhs is the other range or an element of the range (e.g. a timestamp)
+func NewRangeTypeExpression(op RangeTypeOperation, lhs Expression, rhs interface{}) RangeTypeExpression {
+	return rangeTypeExpression{lhs: lhs, op: op, rhs: rhs}
+}
+
+// Creates a new range from the name of the range type and the bounds, nil bounds are unbounded. The inclusive and
+// exclusive bounds are set using WithBounds, the default of the dialect is used if they are not set.
+//
+//	NewRangeLiteral("int4range", 1, 10) -> int4range(1, 10)
+//	NewRangeLiteral("int4range", 1, 10).WithBounds("[]") -> int4range(1, 10, '[]')
+func NewRangeLiteral(typeName string, lower, upper interface{}) RangeLiteralExpression {
+	return rangeLiteral{typeName: typeName, lower: lower, upper: upper}
+}
+
+func (rta rangeTypeAccessor) Contains(val interface{}) RangeTypeExpression {
+	return NewRangeTypeExpression(RangeTypeContainsOp, rta.value, val)
+}
+
+func (rta rangeTypeAccessor) ContainedBy(val interface{}) RangeTypeExpression {
+	return NewRangeTypeExpression(RangeTypeContainedByOp, rta.value, val)
+}
+
+func (rta rangeTypeAccessor) Overlaps(val interface{}) RangeTypeExpression {
+	return NewRangeTypeExpression(RangeTypeOverlapOp, rta.value, val)
+}
+
+func (rta rangeTypeAccessor) Adjacent(val interface{}) RangeTypeExpression {
+	return NewRangeTypeExpression(RangeTypeAdjacentOp, rta.value, val)
+}
+
+func (rte rangeTypeExpression) Clone() Expression {
+	return NewRangeTypeExpression(rte.op, rte.lhs.Clone(), rte.rhs)
+}
+
+func (rte rangeTypeExpression) Expression() Expression { return rte }
+func (rte rangeTypeExpression) LHS() Expression        { return rte.lhs }
+func (rte rangeTypeExpression) Op() RangeTypeOperation { return rte.op }
+func (rte rangeTypeExpression) RHS() interface{}       { return rte.rhs }
+
+func (rte rangeTypeExpression) As(val interface{}) AliasedExpression {
+	return NewAliasExpression(rte, val)
+}
+
+func (rte rangeTypeExpression) Eq(val interface{}) BooleanExpression  { return eq(rte, val) }
+func (rte rangeTypeExpression) Neq(val interface{}) BooleanExpression { return neq(rte, val) }
+func (rte rangeTypeExpression) Gt(val interface{}) BooleanExpression  { return gt(rte, val) }
+func (rte rangeTypeExpression) Gte(val interface{}) BooleanExpression { return gte(rte, val) }
+func (rte rangeTypeExpression) Lt(val interface{}) BooleanExpression  { return lt(rte, val) }
+func (rte rangeTypeExpression) Lte(val interface{}) BooleanExpression { return lte(rte, val) }
+func (rte rangeTypeExpression) Asc() OrderedExpression                { return asc(rte) }
+func (rte rangeTypeExpression) Desc() OrderedExpression               { return desc(rte) }
+func (rte rangeTypeExpression) Is(i interface{}) BooleanExpression    { return is(rte, i) }
+func (rte rangeTypeExpression) IsNot(i interface{}) BooleanExpression { return isNot(rte, i) }
+func (rte rangeTypeExpression) IsNull() BooleanExpression             { return is(rte, nil) }
+func (rte rangeTypeExpression) IsNotNull() BooleanExpression          { return isNot(rte, nil) }
+func (rte rangeTypeExpression) IsTrue() BooleanExpression             { return is(rte, true) }
+func (rte rangeTypeExpression) IsNotTrue() BooleanExpression          { return isNot(rte, true) }
+func (rte rangeTypeExpression) IsFalse() BooleanExpression            { return is(rte, false) }
+func (rte rangeTypeExpression) IsNotFalse() BooleanExpression         { return isNot(rte, false) }
+
+func (rte rangeTypeExpression) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(rte, val)
+}
+
+func (rte rangeTypeExpression) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(rte, val)
+}
+
+func (rl rangeLiteral) Clone() Expression { return rl }
+
+func (rl rangeLiteral) Expression() Expression { return rl }
+func (rl rangeLiteral) TypeName() string       { return rl.typeName }
+func (rl rangeLiteral) Lower() interface{}     { return rl.lower }
+func (rl rangeLiteral) Upper() interface{}     { return rl.upper }
+func (rl rangeLiteral) Bounds() string         { return rl.bounds }
+
+func (rl rangeLiteral) WithBounds(bounds string) RangeLiteralExpression {
+	ret := rl
+	ret.bounds = bounds
+	return ret
+}
+
+func (rl rangeLiteral) Contains(val interface{}) RangeTypeExpression {
+	return NewRangeTypeAccessor(rl).Contains(val)
+}
+
+func (rl rangeLiteral) ContainedBy(val interface{}) RangeTypeExpression {
+	return NewRangeTypeAccessor(rl).ContainedBy(val)
+}
+
+func (rl rangeLiteral) Overlaps(val interface{}) RangeTypeExpression {
+	return NewRangeTypeAccessor(rl).Overlaps(val)
+}
+
+func (rl rangeLiteral) Adjacent(val interface{}) RangeTypeExpression {
+	return NewRangeTypeAccessor(rl).Adjacent(val)
+}
+
+func (rl rangeLiteral) As(val interface{}) AliasedExpression  { return NewAliasExpression(rl, val) }
+func (rl rangeLiteral) Eq(val interface{}) BooleanExpression  { return eq(rl, val) }
+func (rl rangeLiteral) Neq(val interface{}) BooleanExpression { return neq(rl, val) }
+func (rl rangeLiteral) Gt(val interface{}) BooleanExpression  { return gt(rl, val) }
+func (rl rangeLiteral) Gte(val interface{}) BooleanExpression { return gte(rl, val) }
+func (rl rangeLiteral) Lt(val interface{}) BooleanExpression  { return lt(rl, val) }
+func (rl rangeLiteral) Lte(val interface{}) BooleanExpression { return lte(rl, val) }
+func (rl rangeLiteral) Asc() OrderedExpression                { return asc(rl) }
+func (rl rangeLiteral) Desc() OrderedExpression               { return desc(rl) }
+
+func (rto RangeTypeOperation) String() string {
+	switch rto {
+	case RangeTypeContainsOp:
+		return "Contains"
+	case RangeTypeContainedByOp:
+		return "Contained By"
+	case RangeTypeOverlapOp:
+		return "Overlap"
+	case RangeTypeAdjacentOp:
+		return "Adjacent"
+	}
+	return fmt.Sprintf("%d", rto)
+}
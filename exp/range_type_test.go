@@ -0,0 +1,123 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type rangeTypeExpressionSuite struct {
+	suite.Suite
+}
+
+func TestRangeTypeExpressionSuite(t *testing.T) {
+	suite.Run(t, new(rangeTypeExpressionSuite))
+}
+
+func (rtes *rangeTypeExpressionSuite) TestClone() {
+	rte := exp.NewRangeTypeAccessor(exp.NewIdentifierExpression("", "", "during")).Overlaps(1)
+	rtes.Equal(rte, rte.Clone())
+	rl := exp.NewRangeLiteral("int4range", 1, 10).WithBounds("[]")
+	rtes.Equal(rl, rl.Clone())
+}
+
+func (rtes *rangeTypeExpressionSuite) TestExpression() {
+	rte := exp.NewRangeTypeAccessor(exp.NewIdentifierExpression("", "", "during")).Overlaps(1)
+	rtes.Equal(rte, rte.Expression())
+	rl := exp.NewRangeLiteral("int4range", 1, 10)
+	rtes.Equal(rl, rl.Expression())
+}
+
+func (rtes *rangeTypeExpressionSuite) TestAccessor() {
+	col := exp.NewIdentifierExpression("", "", "during")
+	rl := exp.NewRangeLiteral("int4range", 1, 10)
+	rta := exp.NewRangeTypeAccessor(col)
+	testCases := []struct {
+		Ex  exp.RangeTypeExpression
+		LHS exp.Expression
+		Op  exp.RangeTypeOperation
+	}{
+		{Ex: rta.Contains(rl), LHS: col, Op: exp.RangeTypeContainsOp},
+		{Ex: rta.ContainedBy(rl), LHS: col, Op: exp.RangeTypeContainedByOp},
+		{Ex: rta.Overlaps(rl), LHS: col, Op: exp.RangeTypeOverlapOp},
+		{Ex: rta.Adjacent(rl), LHS: col, Op: exp.RangeTypeAdjacentOp},
+		{Ex: rl.Contains(rl), LHS: rl, Op: exp.RangeTypeContainsOp},
+		{Ex: rl.ContainedBy(rl), LHS: rl, Op: exp.RangeTypeContainedByOp},
+		{Ex: rl.Overlaps(rl), LHS: rl, Op: exp.RangeTypeOverlapOp},
+		{Ex: rl.Adjacent(rl), LHS: rl, Op: exp.RangeTypeAdjacentOp},
+	}
+	for _, tc := range testCases {
+		rtes.Equal(tc.LHS, tc.Ex.LHS())
+		rtes.Equal(tc.Op, tc.Ex.Op())
+		rtes.Equal(rl, tc.Ex.RHS())
+	}
+}
+
+func (rtes *rangeTypeExpressionSuite) TestRangeLiteral() {
+	rl := exp.NewRangeLiteral("int4range", 1, nil)
+	rtes.Equal("int4range", rl.TypeName())
+	rtes.Equal(1, rl.Lower())
+	rtes.Nil(rl.Upper())
+	rtes.Empty(rl.Bounds())
+	rtes.Equal("[]", rl.WithBounds("[]").Bounds())
+	rtes.Empty(rl.Bounds())
+}
+
+func (rtes *rangeTypeExpressionSuite) TestAllOthers() {
+	rte := exp.NewRangeTypeAccessor(exp.NewIdentifierExpression("", "", "during")).Overlaps(1)
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: rte.As("a"), Expected: exp.NewAliasExpression(rte, "a")},
+		{Ex: rte.Asc(), Expected: exp.NewOrderedExpression(rte, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: rte.Desc(), Expected: exp.NewOrderedExpression(rte, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: rte.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, rte, 1)},
+		{Ex: rte.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, rte, 1)},
+		{Ex: rte.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, rte, 1)},
+		{Ex: rte.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, rte, 1)},
+		{Ex: rte.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, rte, 1)},
+		{Ex: rte.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, rte, 1)},
+		{Ex: rte.Is(true), Expected: exp.NewBooleanExpression(exp.IsOp, rte, true)},
+		{Ex: rte.IsNot(true), Expected: exp.NewBooleanExpression(exp.IsNotOp, rte, true)},
+		{Ex: rte.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, rte, nil)},
+		{Ex: rte.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, rte, nil)},
+		{Ex: rte.IsTrue(), Expected: exp.NewBooleanExpression(exp.IsOp, rte, true)},
+		{Ex: rte.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, rte, true)},
+		{Ex: rte.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, rte, false)},
+		{Ex: rte.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, rte, false)},
+		{Ex: rte.IsDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, rte, 1)},
+		{Ex: rte.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, rte, 1)},
+	}
+	for _, tc := range testCases {
+		rtes.Equal(tc.Expected, tc.Ex)
+	}
+
+	rl := exp.NewRangeLiteral("int4range", 1, 10)
+	testCases = []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: rl.As("a"), Expected: exp.NewAliasExpression(rl, "a")},
+		{Ex: rl.Asc(), Expected: exp.NewOrderedExpression(rl, exp.AscDir, exp.NoNullsSortType)},
+		{Ex: rl.Desc(), Expected: exp.NewOrderedExpression(rl, exp.DescSortDir, exp.NoNullsSortType)},
+		{Ex: rl.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, rl, 1)},
+		{Ex: rl.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, rl, 1)},
+		{Ex: rl.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, rl, 1)},
+		{Ex: rl.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, rl, 1)},
+		{Ex: rl.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, rl, 1)},
+		{Ex: rl.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, rl, 1)},
+	}
+	for _, tc := range testCases {
+		rtes.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+func (rtes *rangeTypeExpressionSuite) TestRangeTypeOperation_String() {
+	rtes.Equal("Contains", exp.RangeTypeContainsOp.String())
+	rtes.Equal("Contained By", exp.RangeTypeContainedByOp.String())
+	rtes.Equal("Overlap", exp.RangeTypeOverlapOp.String())
+	rtes.Equal("Adjacent", exp.RangeTypeAdjacentOp.String())
+	rtes.Equal("100", exp.RangeTypeOperation(100).String())
+}
@@ -47,13 +47,19 @@ func shouldSkipField(f util.ColumnData, forInsert, forUpdate bool) bool {
 }
 
 func getFieldValue(val reflect.Value, f util.ColumnData) (ok bool, fieldVal interface{}) {
-	if v, isAvailable := util.SafeGetFieldByIndex(val, f.FieldIndex); !isAvailable {
+	v, isAvailable := util.SafeGetFieldByIndex(val, f.FieldIndex)
+	switch {
+	case !isAvailable:
 		return false, nil
-	} else if f.DefaultIfEmpty && util.IsEmptyValue(v) {
+	case f.DefaultIfEmpty && util.IsEmptyValue(v):
 		return true, Default()
-	} else if v.IsValid() {
-		return true, v.Interface()
-	} else {
-		return true, reflect.Zero(f.GoType).Interface()
+	case v.IsValid():
+		fieldVal = v.Interface()
+	default:
+		fieldVal = reflect.Zero(f.GoType).Interface()
 	}
+	if f.Secret {
+		return true, NewSecret(fieldVal)
+	}
+	return true, fieldVal
 }
@@ -0,0 +1,53 @@
+package exp
+
+type (
+	RefreshClauses interface {
+		HasView() bool
+		clone() *refreshClauses
+
+		View() Expression
+		SetView(view Expression) RefreshClauses
+
+		IsConcurrently() bool
+		SetConcurrently(concurrently bool) RefreshClauses
+	}
+	refreshClauses struct {
+		view         Expression
+		concurrently bool
+	}
+)
+
+func NewRefreshClauses() RefreshClauses {
+	return &refreshClauses{}
+}
+
+func (rc *refreshClauses) HasView() bool {
+	return rc.view != nil
+}
+
+func (rc *refreshClauses) clone() *refreshClauses {
+	return &refreshClauses{
+		view:         rc.view,
+		concurrently: rc.concurrently,
+	}
+}
+
+func (rc *refreshClauses) View() Expression {
+	return rc.view
+}
+
+func (rc *refreshClauses) SetView(view Expression) RefreshClauses {
+	ret := rc.clone()
+	ret.view = view
+	return ret
+}
+
+func (rc *refreshClauses) IsConcurrently() bool {
+	return rc.concurrently
+}
+
+func (rc *refreshClauses) SetConcurrently(concurrently bool) RefreshClauses {
+	ret := rc.clone()
+	ret.concurrently = concurrently
+	return ret
+}
@@ -0,0 +1,45 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type refreshClausesSuite struct {
+	suite.Suite
+}
+
+func TestRefreshClausesSuite(t *testing.T) {
+	suite.Run(t, new(refreshClausesSuite))
+}
+
+func (rcs *refreshClausesSuite) TestHasView() {
+	c := exp.NewRefreshClauses()
+	c2 := c.SetView(exp.NewIdentifierExpression("", "test", ""))
+
+	rcs.False(c.HasView())
+
+	rcs.True(c2.HasView())
+}
+
+func (rcs *refreshClausesSuite) TestSetView() {
+	ti := exp.NewIdentifierExpression("", "test", "")
+	c := exp.NewRefreshClauses().SetView(ti)
+	ti2 := exp.NewIdentifierExpression("", "test2", "")
+	c2 := c.SetView(ti2)
+
+	rcs.Equal(ti, c.View())
+
+	rcs.Equal(ti2, c2.View())
+}
+
+func (rcs *refreshClausesSuite) TestSetConcurrently() {
+	c := exp.NewRefreshClauses()
+	c2 := c.SetConcurrently(true)
+
+	rcs.False(c.IsConcurrently())
+
+	rcs.True(c2.IsConcurrently())
+}
@@ -0,0 +1,54 @@
+package exp
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// The text used in place of a secret value when it is formatted for logs or error messages
+const RedactedText = "[REDACTED]"
+
+type (
+	// A value that should never be written to logs or error messages. When formatted with the fmt package the value
+	// is rendered as [REDACTED], when bound as an argument the wrapped value is sent to the driver.
+	SecretValue interface {
+		driver.Valuer
+		fmt.Formatter
+		fmt.Stringer
+		fmt.GoStringer
+		// Returns the wrapped value
+		Unwrap() interface{}
+	}
+	secret struct {
+		value interface{}
+	}
+)
+
+// Creates a new SecretValue wrapping the provided value
+func NewSecret(value interface{}) SecretValue {
+	if s, ok := value.(SecretValue); ok {
+		return s
+	}
+	return secret{value: value}
+}
+
+func (s secret) Unwrap() interface{} {
+	return s.value
+}
+
+// Returns the driver value of the wrapped value
+func (s secret) Value() (driver.Value, error) {
+	return driver.DefaultParameterConverter.ConvertValue(s.value)
+}
+
+func (s secret) String() string {
+	return RedactedText
+}
+
+func (s secret) GoString() string {
+	return RedactedText
+}
+
+func (s secret) Format(f fmt.State, _ rune) {
+	_, _ = f.Write([]byte(RedactedText))
+}
@@ -0,0 +1,49 @@
+package exp_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type secretSuite struct {
+	suite.Suite
+}
+
+func TestSecretSuite(t *testing.T) {
+	suite.Run(t, new(secretSuite))
+}
+
+func (ss *secretSuite) TestNewSecret() {
+	s := exp.NewSecret("pass")
+	ss.Equal("pass", s.Unwrap())
+	ss.Equal(s, exp.NewSecret(s))
+}
+
+func (ss *secretSuite) TestValue() {
+	v, err := exp.NewSecret("pass").Value()
+	ss.NoError(err)
+	ss.Equal(driver.Value("pass"), v)
+
+	v, err = exp.NewSecret(sql.NullString{String: "pass", Valid: true}).Value()
+	ss.NoError(err)
+	ss.Equal(driver.Value("pass"), v)
+
+	v, err = exp.NewSecret(nil).Value()
+	ss.NoError(err)
+	ss.Nil(v)
+}
+
+func (ss *secretSuite) TestFormat() {
+	s := exp.NewSecret("pass")
+	ss.Equal(exp.RedactedText, s.String())
+	ss.Equal(exp.RedactedText, fmt.Sprintf("%v", s))
+	ss.Equal(exp.RedactedText, fmt.Sprintf("%+v", s))
+	ss.Equal(exp.RedactedText, fmt.Sprintf("%#v", s))
+	ss.Equal(exp.RedactedText, fmt.Sprintf("%s", s))
+	ss.Equal("[[REDACTED] 1]", fmt.Sprintf("%+v", []interface{}{s, 1}))
+}
@@ -13,12 +13,42 @@ type (
 		Distinct() ColumnListExpression
 		SetDistinct(cle ColumnListExpression) SelectClauses
 
+		IsStraightJoin() bool
+		SetStraightJoin(straightJoin bool) SelectClauses
+
+		Hints() []string
+		SetHints(hints []string) SelectClauses
+
 		From() ColumnListExpression
 		SetFrom(cl ColumnListExpression) SelectClauses
 
+		AsOfSystemTime() interface{}
+		SetAsOfSystemTime(ts interface{}) SelectClauses
+
+		IsFinal() bool
+		SetFinal(final bool) SelectClauses
+
+		Sample() Expression
+		SetSample(sample Expression) SelectClauses
+
+		ConnectBy() ConnectByExpression
+		SetConnectBy(cb ConnectByExpression) SelectClauses
+
+		Into() SelectIntoExpression
+		SetInto(into SelectIntoExpression) SelectClauses
+
+		Prewhere() ExpressionList
+		ClearPrewhere() SelectClauses
+		PrewhereAppend(expressions ...Expression) SelectClauses
+
+		Settings() Record
+		SetSettings(settings Record) SelectClauses
+
 		HasAlias() bool
 		Alias() IdentifierExpression
 		SetAlias(ie IdentifierExpression) SelectClauses
+		AliasColumns() ColumnListExpression
+		SetAliasColumns(cl ColumnListExpression) SelectClauses
 
 		Joins() JoinExpressions
 		JoinsAppend(jc JoinExpression) SelectClauses
@@ -31,6 +61,10 @@ type (
 		ClearHaving() SelectClauses
 		HavingAppend(expressions ...Expression) SelectClauses
 
+		Qualify() ExpressionList
+		ClearQualify() SelectClauses
+		QualifyAppend(expressions ...Expression) SelectClauses
+
 		Order() ColumnListExpression
 		HasOrder() bool
 		ClearOrder() SelectClauses
@@ -54,6 +88,9 @@ type (
 		Compounds() []CompoundExpression
 		CompoundsAppend(ce CompoundExpression) SelectClauses
 
+		Parenthesized() AppendableExpression
+		SetParenthesized(ae AppendableExpression) SelectClauses
+
 		Lock() Lock
 		SetLock(l Lock) SelectClauses
 
@@ -66,21 +103,33 @@ type (
 		ClearWindows() SelectClauses
 	}
 	selectClauses struct {
-		commonTables  []CommonTableExpression
-		selectColumns ColumnListExpression
-		distinct      ColumnListExpression
-		from          ColumnListExpression
-		joins         JoinExpressions
-		where         ExpressionList
-		alias         IdentifierExpression
-		groupBy       ColumnListExpression
-		having        ExpressionList
-		order         ColumnListExpression
-		limit         interface{}
-		offset        uint
-		compounds     []CompoundExpression
-		lock          Lock
-		windows       []WindowExpression
+		commonTables   []CommonTableExpression
+		selectColumns  ColumnListExpression
+		distinct       ColumnListExpression
+		straightJoin   bool
+		hints          []string
+		from           ColumnListExpression
+		asOfSystemTime interface{}
+		final          bool
+		sample         Expression
+		prewhere       ExpressionList
+		connectBy      ConnectByExpression
+		into           SelectIntoExpression
+		settings       Record
+		joins          JoinExpressions
+		where          ExpressionList
+		alias          IdentifierExpression
+		aliasColumns   ColumnListExpression
+		groupBy        ColumnListExpression
+		having         ExpressionList
+		qualify        ExpressionList
+		order          ColumnListExpression
+		limit          interface{}
+		offset         uint
+		compounds      []CompoundExpression
+		parenthesized  AppendableExpression
+		lock           Lock
+		windows        []WindowExpression
 	}
 )
 
@@ -109,21 +158,33 @@ func (c *selectClauses) IsDefaultSelect() bool {
 
 func (c *selectClauses) clone() *selectClauses {
 	return &selectClauses{
-		commonTables:  c.commonTables,
-		selectColumns: c.selectColumns,
-		distinct:      c.distinct,
-		from:          c.from,
-		joins:         c.joins[0:len(c.joins):len(c.joins)],
-		where:         c.where,
-		alias:         c.alias,
-		groupBy:       c.groupBy,
-		having:        c.having,
-		order:         c.order,
-		limit:         c.limit,
-		offset:        c.offset,
-		compounds:     c.compounds,
-		lock:          c.lock,
-		windows:       c.windows,
+		commonTables:   c.commonTables,
+		selectColumns:  c.selectColumns,
+		distinct:       c.distinct,
+		straightJoin:   c.straightJoin,
+		hints:          c.hints,
+		from:           c.from,
+		asOfSystemTime: c.asOfSystemTime,
+		final:          c.final,
+		sample:         c.sample,
+		prewhere:       c.prewhere,
+		connectBy:      c.connectBy,
+		into:           c.into,
+		settings:       c.settings,
+		joins:          c.joins[0:len(c.joins):len(c.joins)],
+		where:          c.where,
+		alias:          c.alias,
+		aliasColumns:   c.aliasColumns,
+		groupBy:        c.groupBy,
+		having:         c.having,
+		qualify:        c.qualify,
+		order:          c.order,
+		limit:          c.limit,
+		offset:         c.offset,
+		compounds:      c.compounds,
+		parenthesized:  c.parenthesized,
+		lock:           c.lock,
+		windows:        c.windows,
 	}
 }
 
@@ -163,6 +224,26 @@ func (c *selectClauses) SetDistinct(cle ColumnListExpression) SelectClauses {
 	return ret
 }
 
+func (c *selectClauses) IsStraightJoin() bool {
+	return c.straightJoin
+}
+
+func (c *selectClauses) SetStraightJoin(straightJoin bool) SelectClauses {
+	ret := c.clone()
+	ret.straightJoin = straightJoin
+	return ret
+}
+
+func (c *selectClauses) Hints() []string {
+	return c.hints
+}
+
+func (c *selectClauses) SetHints(hints []string) SelectClauses {
+	ret := c.clone()
+	ret.hints = hints
+	return ret
+}
+
 func (c *selectClauses) From() ColumnListExpression {
 	return c.from
 }
@@ -173,6 +254,89 @@ func (c *selectClauses) SetFrom(cl ColumnListExpression) SelectClauses {
 	return ret
 }
 
+func (c *selectClauses) AsOfSystemTime() interface{} {
+	return c.asOfSystemTime
+}
+
+func (c *selectClauses) SetAsOfSystemTime(ts interface{}) SelectClauses {
+	ret := c.clone()
+	ret.asOfSystemTime = ts
+	return ret
+}
+
+func (c *selectClauses) IsFinal() bool {
+	return c.final
+}
+
+func (c *selectClauses) SetFinal(final bool) SelectClauses {
+	ret := c.clone()
+	ret.final = final
+	return ret
+}
+
+func (c *selectClauses) Sample() Expression {
+	return c.sample
+}
+
+func (c *selectClauses) SetSample(sample Expression) SelectClauses {
+	ret := c.clone()
+	ret.sample = sample
+	return ret
+}
+
+func (c *selectClauses) ConnectBy() ConnectByExpression {
+	return c.connectBy
+}
+
+func (c *selectClauses) SetConnectBy(cb ConnectByExpression) SelectClauses {
+	ret := c.clone()
+	ret.connectBy = cb
+	return ret
+}
+
+func (c *selectClauses) Into() SelectIntoExpression {
+	return c.into
+}
+
+func (c *selectClauses) SetInto(into SelectIntoExpression) SelectClauses {
+	ret := c.clone()
+	ret.into = into
+	return ret
+}
+
+func (c *selectClauses) Prewhere() ExpressionList {
+	return c.prewhere
+}
+
+func (c *selectClauses) ClearPrewhere() SelectClauses {
+	ret := c.clone()
+	ret.prewhere = nil
+	return ret
+}
+
+func (c *selectClauses) PrewhereAppend(expressions ...Expression) SelectClauses {
+	if len(expressions) == 0 {
+		return c
+	}
+	ret := c.clone()
+	if ret.prewhere == nil {
+		ret.prewhere = NewExpressionList(AndType, expressions...)
+	} else {
+		ret.prewhere = ret.prewhere.Append(expressions...)
+	}
+	return ret
+}
+
+func (c *selectClauses) Settings() Record {
+	return c.settings
+}
+
+func (c *selectClauses) SetSettings(settings Record) SelectClauses {
+	ret := c.clone()
+	ret.settings = settings
+	return ret
+}
+
 func (c *selectClauses) HasAlias() bool {
 	return c.alias != nil
 }
@@ -187,6 +351,16 @@ func (c *selectClauses) SetAlias(ie IdentifierExpression) SelectClauses {
 	return ret
 }
 
+func (c *selectClauses) AliasColumns() ColumnListExpression {
+	return c.aliasColumns
+}
+
+func (c *selectClauses) SetAliasColumns(cl ColumnListExpression) SelectClauses {
+	ret := c.clone()
+	ret.aliasColumns = cl
+	return ret
+}
+
 func (c *selectClauses) Joins() JoinExpressions {
 	return c.joins
 }
@@ -243,6 +417,29 @@ func (c *selectClauses) HavingAppend(expressions ...Expression) SelectClauses {
 	return ret
 }
 
+func (c *selectClauses) Qualify() ExpressionList {
+	return c.qualify
+}
+
+func (c *selectClauses) ClearQualify() SelectClauses {
+	ret := c.clone()
+	ret.qualify = nil
+	return ret
+}
+
+func (c *selectClauses) QualifyAppend(expressions ...Expression) SelectClauses {
+	if len(expressions) == 0 {
+		return c
+	}
+	ret := c.clone()
+	if ret.qualify == nil {
+		ret.qualify = NewExpressionList(AndType, expressions...)
+	} else {
+		ret.qualify = ret.qualify.Append(expressions...)
+	}
+	return ret
+}
+
 func (c *selectClauses) Lock() Lock {
 	return c.lock
 }
@@ -356,6 +553,16 @@ func (c *selectClauses) CompoundsAppend(ce CompoundExpression) SelectClauses {
 	return ret
 }
 
+func (c *selectClauses) Parenthesized() AppendableExpression {
+	return c.parenthesized
+}
+
+func (c *selectClauses) SetParenthesized(ae AppendableExpression) SelectClauses {
+	ret := c.clone()
+	ret.parenthesized = ae
+	return ret
+}
+
 func (c *selectClauses) Windows() []WindowExpression {
 	return c.windows
 }
@@ -98,6 +98,121 @@ func (scs *selectClausesSuite) TestSetSelectDistinct() {
 	scs.Equal(exp.NewColumnListExpression(exp.Star()), c.Select())
 }
 
+func (scs *selectClausesSuite) TestStraightJoin() {
+	c := exp.NewSelectClauses()
+	c2 := c.SetStraightJoin(true)
+
+	scs.False(c.IsStraightJoin())
+	scs.True(c2.IsStraightJoin())
+	scs.False(c2.SetStraightJoin(false).IsStraightJoin())
+}
+
+func (scs *selectClausesSuite) TestHints() {
+	c := exp.NewSelectClauses()
+	c2 := c.SetHints([]string{"TIDB_SMJ(a, b)"})
+
+	scs.Nil(c.Hints())
+	scs.Equal([]string{"TIDB_SMJ(a, b)"}, c2.Hints())
+	scs.Nil(c2.SetHints(nil).Hints())
+}
+
+func (scs *selectClausesSuite) TestAsOfSystemTime() {
+	c := exp.NewSelectClauses()
+	c2 := c.SetAsOfSystemTime("-10s")
+
+	scs.Nil(c.AsOfSystemTime())
+	scs.Equal("-10s", c2.AsOfSystemTime())
+	scs.Nil(c2.SetAsOfSystemTime(nil).AsOfSystemTime())
+}
+
+func (scs *selectClausesSuite) TestFinal() {
+	c := exp.NewSelectClauses()
+	c2 := c.SetFinal(true)
+
+	scs.False(c.IsFinal())
+	scs.True(c2.IsFinal())
+	scs.False(c2.SetFinal(false).IsFinal())
+}
+
+func (scs *selectClausesSuite) TestSample() {
+	s := exp.NewLiteralExpression("?", 0.1)
+	c := exp.NewSelectClauses()
+	c2 := c.SetSample(s)
+
+	scs.Nil(c.Sample())
+	scs.Equal(s, c2.Sample())
+	scs.Nil(c2.SetSample(nil).Sample())
+}
+
+func (scs *selectClausesSuite) TestConnectBy() {
+	cb := exp.NewConnectByExpression(false, exp.Ex{"a": 1})
+
+	c := exp.NewSelectClauses()
+	c2 := c.SetConnectBy(cb)
+
+	scs.Nil(c.ConnectBy())
+	scs.Equal(cb, c2.ConnectBy())
+	scs.Nil(c2.SetConnectBy(nil).ConnectBy())
+}
+
+func (scs *selectClausesSuite) TestInto() {
+	si := exp.NewSelectIntoExpression(exp.ParseIdentifier("staging"), true)
+
+	c := exp.NewSelectClauses()
+	c2 := c.SetInto(si)
+
+	scs.Nil(c.Into())
+	scs.Equal(si, c2.Into())
+	scs.Nil(c2.SetInto(nil).Into())
+}
+
+func (scs *selectClausesSuite) TestPrewhereAppend() {
+	w := exp.Ex{"a": 1}
+	w2 := exp.Ex{"b": 2}
+
+	c := exp.NewSelectClauses()
+	c2 := c.PrewhereAppend(w)
+	c3 := c.PrewhereAppend(w).PrewhereAppend(w2)
+
+	scs.Nil(c.Prewhere())
+	scs.Equal(exp.NewExpressionList(exp.AndType, w), c2.Prewhere())
+	scs.Equal(exp.NewExpressionList(exp.AndType, w).Append(w2), c3.Prewhere())
+	scs.Nil(c3.ClearPrewhere().Prewhere())
+	scs.Nil(c3.ClearWhere().Where())
+}
+
+func (scs *selectClausesSuite) TestQualifyAppend() {
+	w := exp.Ex{"a": 1}
+	w2 := exp.Ex{"b": 2}
+
+	c := exp.NewSelectClauses()
+	c2 := c.QualifyAppend(w)
+	c3 := c.QualifyAppend(w).QualifyAppend(w2)
+
+	scs.Nil(c.Qualify())
+	scs.Equal(exp.NewExpressionList(exp.AndType, w), c2.Qualify())
+	scs.Equal(exp.NewExpressionList(exp.AndType, w).Append(w2), c3.Qualify())
+	scs.Nil(c3.ClearQualify().Qualify())
+}
+
+func (scs *selectClausesSuite) TestSettings() {
+	s := exp.Record{"max_threads": 8}
+	c := exp.NewSelectClauses()
+	c2 := c.SetSettings(s)
+
+	scs.Nil(c.Settings())
+	scs.Equal(s, c2.Settings())
+}
+
+func (scs *selectClausesSuite) TestAliasColumns() {
+	c := exp.NewSelectClauses()
+	c2 := c.SetAlias(exp.NewIdentifierExpression("", "t", "")).SetAliasColumns(exp.NewColumnListExpression("a", "b"))
+
+	scs.Nil(c.AliasColumns())
+	scs.Equal(exp.NewColumnListExpression("a", "b"), c2.AliasColumns())
+	scs.Equal(exp.NewIdentifierExpression("", "t", ""), c2.Alias())
+}
+
 func (scs *selectClausesSuite) TestFrom() {
 	c := exp.NewSelectClauses()
 	c2 := c.SetFrom(exp.NewColumnListExpression("a"))
@@ -531,6 +646,17 @@ func (scs *selectClausesSuite) TestCompoundsAppend() {
 	scs.Equal([]exp.CompoundExpression{ce, ce2}, c2.Compounds())
 }
 
+func (scs *selectClausesSuite) TestParenthesized() {
+	ae := newTestAppendableExpression("SELECT * FROM foo", []interface{}{})
+
+	c := exp.NewSelectClauses()
+	c2 := c.SetParenthesized(ae)
+
+	scs.Nil(c.Parenthesized())
+
+	scs.Equal(ae, c2.Parenthesized())
+}
+
 func (scs *selectClausesSuite) TestLock() {
 	l := exp.NewLock(exp.ForUpdate, exp.Wait)
 
@@ -0,0 +1,25 @@
+package exp
+
+type selectInto struct {
+	table     IdentifierExpression
+	temporary bool
+}
+
+// Creates a new INTO clause for a SELECT that creates a table from the results of the query
+//
+//	NewSelectIntoExpression(ParseIdentifier("staging"), true)
+//	// postgres: SELECT * INTO TEMPORARY "staging" FROM ...
+//	// sqlserver: SELECT * INTO "#staging" FROM ...
+func NewSelectIntoExpression(table IdentifierExpression, temporary bool) SelectIntoExpression {
+	return selectInto{table: table, temporary: temporary}
+}
+
+func (si selectInto) Clone() Expression {
+	return selectInto{table: si.table.Clone().(IdentifierExpression), temporary: si.temporary}
+}
+
+func (si selectInto) Expression() Expression { return si }
+
+func (si selectInto) Table() IdentifierExpression { return si.table }
+
+func (si selectInto) IsTemporary() bool { return si.temporary }
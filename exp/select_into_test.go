@@ -0,0 +1,30 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type selectIntoExpressionSuite struct {
+	suite.Suite
+}
+
+func TestSelectIntoExpressionSuite(t *testing.T) {
+	suite.Run(t, new(selectIntoExpressionSuite))
+}
+
+func (sies *selectIntoExpressionSuite) TestSelectInto() {
+	table := exp.ParseIdentifier("staging")
+	si := exp.NewSelectIntoExpression(table, false)
+	sies.Equal(table, si.Table())
+	sies.False(si.IsTemporary())
+	sies.Equal(si, si.Expression())
+	sies.True(exp.NewSelectIntoExpression(table, true).IsTemporary())
+}
+
+func (sies *selectIntoExpressionSuite) TestClone() {
+	si := exp.NewSelectIntoExpression(exp.ParseIdentifier("public.staging"), true)
+	sies.Equal(si, si.Clone())
+}
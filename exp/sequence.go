@@ -0,0 +1,86 @@
+package exp
+
+import "fmt"
+
+type (
+	// The value of a sequence returned by a SequenceValueExpression
+	SequenceValueType int
+
+	// The next or current value of a sequence (e.g. nextval('"user_id_seq"'), NEXT VALUE FOR "user_id_seq")
+	SequenceValueExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		// The sequence
+		Sequence() IdentifierExpression
+		// The value of the sequence (e.g. NextSequenceValue)
+		ValueType() SequenceValueType
+	}
+	sequenceValue struct {
+		sequence  IdentifierExpression
+		valueType SequenceValueType
+	}
+
+	// Options to use when generating a CREATE SEQUENCE or ALTER SEQUENCE statement, nil values are not written
+	SequenceOptions struct {
+		// The value added to the sequence (e.g. INCREMENT BY 2)
+		IncrementBy *int64
+		// The minimum value of the sequence (e.g. MINVALUE 1)
+		MinValue *int64
+		// Set to true to add NO MINVALUE
+		NoMinValue bool
+		// The maximum value of the sequence (e.g. MAXVALUE 100)
+		MaxValue *int64
+		// Set to true to add NO MAXVALUE
+		NoMaxValue bool
+		// The starting value of the sequence (e.g. START WITH 10)
+		StartWith *int64
+		// Set to true to add CYCLE
+		Cycle bool
+		// Set to true to add NO CYCLE
+		NoCycle bool
+	}
+)
+
+const (
+	NextSequenceValue SequenceValueType = iota
+	CurrentSequenceValue
+)
+
+func (t SequenceValueType) String() string {
+	switch t {
+	case NextSequenceValue:
+		return "NEXT VALUE"
+	case CurrentSequenceValue:
+		return "CURRENT VALUE"
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+// Creates a new expression for the next or current value of a sequence
+//
+//	NewSequenceValueExpression(ParseIdentifier("user_id_seq"), NextSequenceValue) -> nextval('"user_id_seq"')
+func NewSequenceValueExpression(sequence IdentifierExpression, valueType SequenceValueType) SequenceValueExpression {
+	return sequenceValue{sequence: sequence, valueType: valueType}
+}
+
+func (sv sequenceValue) Sequence() IdentifierExpression {
+	return sv.sequence
+}
+
+func (sv sequenceValue) ValueType() SequenceValueType {
+	return sv.valueType
+}
+
+func (sv sequenceValue) Clone() Expression {
+	return sequenceValue{sequence: sv.sequence.Clone().(IdentifierExpression), valueType: sv.valueType}
+}
+
+func (sv sequenceValue) Expression() Expression                { return sv }
+func (sv sequenceValue) As(val interface{}) AliasedExpression  { return NewAliasExpression(sv, val) }
+func (sv sequenceValue) Eq(val interface{}) BooleanExpression  { return eq(sv, val) }
+func (sv sequenceValue) Neq(val interface{}) BooleanExpression { return neq(sv, val) }
+func (sv sequenceValue) Gt(val interface{}) BooleanExpression  { return gt(sv, val) }
+func (sv sequenceValue) Gte(val interface{}) BooleanExpression { return gte(sv, val) }
+func (sv sequenceValue) Lt(val interface{}) BooleanExpression  { return lt(sv, val) }
+func (sv sequenceValue) Lte(val interface{}) BooleanExpression { return lte(sv, val) }
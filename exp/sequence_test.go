@@ -0,0 +1,63 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type sequenceValueExpressionSuite struct {
+	suite.Suite
+	sv exp.SequenceValueExpression
+}
+
+func TestSequenceValueExpressionSuite(t *testing.T) {
+	suite.Run(t, &sequenceValueExpressionSuite{
+		sv: exp.NewSequenceValueExpression(exp.ParseIdentifier("a_seq"), exp.NextSequenceValue),
+	})
+}
+
+func (svs *sequenceValueExpressionSuite) TestSequenceValueType_String() {
+	svs.Equal("NEXT VALUE", exp.NextSequenceValue.String())
+	svs.Equal("CURRENT VALUE", exp.CurrentSequenceValue.String())
+	svs.Equal("100", exp.SequenceValueType(100).String())
+}
+
+func (svs *sequenceValueExpressionSuite) TestClone() {
+	svs.Equal(svs.sv, svs.sv.Clone())
+}
+
+func (svs *sequenceValueExpressionSuite) TestExpression() {
+	svs.Equal(svs.sv, svs.sv.Expression())
+}
+
+func (svs *sequenceValueExpressionSuite) TestSequence() {
+	svs.Equal(exp.ParseIdentifier("a_seq"), svs.sv.Sequence())
+}
+
+func (svs *sequenceValueExpressionSuite) TestValueType() {
+	svs.Equal(exp.NextSequenceValue, svs.sv.ValueType())
+	cv := exp.NewSequenceValueExpression(exp.ParseIdentifier("a_seq"), exp.CurrentSequenceValue)
+	svs.Equal(exp.CurrentSequenceValue, cv.ValueType())
+}
+
+func (svs *sequenceValueExpressionSuite) TestAllOthers() {
+	sv := svs.sv
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: sv.As("a"), Expected: exp.NewAliasExpression(sv, "a")},
+		{Ex: sv.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, sv, 1)},
+		{Ex: sv.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, sv, 1)},
+		{Ex: sv.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, sv, 1)},
+		{Ex: sv.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, sv, 1)},
+		{Ex: sv.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, sv, 1)},
+		{Ex: sv.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, sv, 1)},
+	}
+
+	for _, tc := range testCases {
+		svs.Equal(tc.Expected, tc.Ex)
+	}
+}
@@ -0,0 +1,68 @@
+package exp
+
+type (
+	SetParamClauses interface {
+		HasParam() bool
+		clone() *setParamClauses
+
+		Param() Expression
+		SetParam(param Expression) SetParamClauses
+
+		Values() []interface{}
+		SetValues(values []interface{}) SetParamClauses
+
+		IsLocal() bool
+		SetLocal(local bool) SetParamClauses
+	}
+	setParamClauses struct {
+		param  Expression
+		values []interface{}
+		local  bool
+	}
+)
+
+func NewSetParamClauses() SetParamClauses {
+	return &setParamClauses{}
+}
+
+func (spc *setParamClauses) HasParam() bool {
+	return spc.param != nil
+}
+
+func (spc *setParamClauses) clone() *setParamClauses {
+	return &setParamClauses{
+		param:  spc.param,
+		values: spc.values,
+		local:  spc.local,
+	}
+}
+
+func (spc *setParamClauses) Param() Expression {
+	return spc.param
+}
+
+func (spc *setParamClauses) SetParam(param Expression) SetParamClauses {
+	ret := spc.clone()
+	ret.param = param
+	return ret
+}
+
+func (spc *setParamClauses) Values() []interface{} {
+	return spc.values
+}
+
+func (spc *setParamClauses) SetValues(values []interface{}) SetParamClauses {
+	ret := spc.clone()
+	ret.values = values
+	return ret
+}
+
+func (spc *setParamClauses) IsLocal() bool {
+	return spc.local
+}
+
+func (spc *setParamClauses) SetLocal(local bool) SetParamClauses {
+	ret := spc.clone()
+	ret.local = local
+	return ret
+}
@@ -0,0 +1,54 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type setParamClausesSuite struct {
+	suite.Suite
+}
+
+func TestSetParamClausesSuite(t *testing.T) {
+	suite.Run(t, new(setParamClausesSuite))
+}
+
+func (spcs *setParamClausesSuite) TestHasParam() {
+	c := exp.NewSetParamClauses()
+	c2 := c.SetParam(exp.NewIdentifierExpression("", "search_path", ""))
+
+	spcs.False(c.HasParam())
+
+	spcs.True(c2.HasParam())
+}
+
+func (spcs *setParamClausesSuite) TestSetParam() {
+	ti := exp.NewIdentifierExpression("", "search_path", "")
+	c := exp.NewSetParamClauses().SetParam(ti)
+	ti2 := exp.NewIdentifierExpression("", "statement_timeout", "")
+	c2 := c.SetParam(ti2)
+
+	spcs.Equal(ti, c.Param())
+
+	spcs.Equal(ti2, c2.Param())
+}
+
+func (spcs *setParamClausesSuite) TestSetValues() {
+	c := exp.NewSetParamClauses()
+	c2 := c.SetValues([]interface{}{"public", "other"})
+
+	spcs.Nil(c.Values())
+
+	spcs.Equal([]interface{}{"public", "other"}, c2.Values())
+}
+
+func (spcs *setParamClausesSuite) TestSetLocal() {
+	c := exp.NewSetParamClauses()
+	c2 := c.SetLocal(true)
+
+	spcs.False(c.IsLocal())
+
+	spcs.True(c2.IsLocal())
+}
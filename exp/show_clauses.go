@@ -0,0 +1,38 @@
+package exp
+
+type (
+	ShowClauses interface {
+		HasParam() bool
+		clone() *showClauses
+
+		Param() Expression
+		SetParam(param Expression) ShowClauses
+	}
+	showClauses struct {
+		param Expression
+	}
+)
+
+func NewShowClauses() ShowClauses {
+	return &showClauses{}
+}
+
+func (sc *showClauses) HasParam() bool {
+	return sc.param != nil
+}
+
+func (sc *showClauses) clone() *showClauses {
+	return &showClauses{
+		param: sc.param,
+	}
+}
+
+func (sc *showClauses) Param() Expression {
+	return sc.param
+}
+
+func (sc *showClauses) SetParam(param Expression) ShowClauses {
+	ret := sc.clone()
+	ret.param = param
+	return ret
+}
@@ -0,0 +1,36 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type showClausesSuite struct {
+	suite.Suite
+}
+
+func TestShowClausesSuite(t *testing.T) {
+	suite.Run(t, new(showClausesSuite))
+}
+
+func (scs *showClausesSuite) TestHasParam() {
+	c := exp.NewShowClauses()
+	c2 := c.SetParam(exp.NewIdentifierExpression("", "search_path", ""))
+
+	scs.False(c.HasParam())
+
+	scs.True(c2.HasParam())
+}
+
+func (scs *showClausesSuite) TestSetParam() {
+	ti := exp.NewIdentifierExpression("", "search_path", "")
+	c := exp.NewShowClauses().SetParam(ti)
+	ti2 := exp.NewIdentifierExpression("", "statement_timeout", "")
+	c2 := c.SetParam(ti2)
+
+	scs.Equal(ti, c.Param())
+
+	scs.Equal(ti2, c2.Param())
+}
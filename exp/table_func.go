@@ -0,0 +1,38 @@
+package exp
+
+type (
+	tableFunction struct {
+		functions      []SQLFunctionExpression
+		withOrdinality bool
+	}
+)
+
+// Creates a new set returning function used as a table, if more than one function is given the results are combined
+// using ROWS FROM
+//
+//	NewTableFunctionExpression(NewSQLFunctionExpression("generate_series", 1, 3)) -> generate_series(1, 3)
+//	NewTableFunctionExpression(NewSQLFunctionExpression("a"), NewSQLFunctionExpression("b")) -> ROWS FROM (a(), b())
+func NewTableFunctionExpression(functions ...SQLFunctionExpression) TableFunctionExpression {
+	return tableFunction{functions: functions}
+}
+
+func (tf tableFunction) Clone() Expression {
+	ret := tf
+	ret.functions = make([]SQLFunctionExpression, 0, len(tf.functions))
+	for _, fn := range tf.functions {
+		ret.functions = append(ret.functions, fn.Clone().(SQLFunctionExpression))
+	}
+	return ret
+}
+
+func (tf tableFunction) Expression() Expression               { return tf }
+func (tf tableFunction) As(val interface{}) AliasedExpression { return NewAliasExpression(tf, val) }
+func (tf tableFunction) Functions() []SQLFunctionExpression   { return tf.functions }
+func (tf tableFunction) IsRowsFrom() bool                     { return len(tf.functions) > 1 }
+func (tf tableFunction) IsWithOrdinality() bool               { return tf.withOrdinality }
+
+func (tf tableFunction) WithOrdinality() TableFunctionExpression {
+	ret := tf
+	ret.withOrdinality = true
+	return ret
+}
@@ -0,0 +1,56 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type tableFunctionExpressionSuite struct {
+	suite.Suite
+	series exp.SQLFunctionExpression
+	unnest exp.SQLFunctionExpression
+}
+
+func TestTableFunctionExpressionSuite(t *testing.T) {
+	suite.Run(t, &tableFunctionExpressionSuite{
+		series: exp.NewSQLFunctionExpression("generate_series", 1, 3),
+		unnest: exp.NewSQLFunctionExpression("unnest", exp.NewIdentifierExpression("", "", "a")),
+	})
+}
+
+func (tfes *tableFunctionExpressionSuite) TestClone() {
+	tf := exp.NewTableFunctionExpression(tfes.series, tfes.unnest).WithOrdinality()
+	tfes.Equal(tf, tf.Clone())
+}
+
+func (tfes *tableFunctionExpressionSuite) TestExpression() {
+	tf := exp.NewTableFunctionExpression(tfes.series)
+	tfes.Equal(tf, tf.Expression())
+}
+
+func (tfes *tableFunctionExpressionSuite) TestAs() {
+	tf := exp.NewTableFunctionExpression(tfes.series)
+	tfes.Equal(exp.NewAliasExpression(tf, "g"), tf.As("g"))
+}
+
+func (tfes *tableFunctionExpressionSuite) TestFunctions() {
+	tfes.Equal([]exp.SQLFunctionExpression{tfes.series}, exp.NewTableFunctionExpression(tfes.series).Functions())
+	tfes.Equal(
+		[]exp.SQLFunctionExpression{tfes.series, tfes.unnest},
+		exp.NewTableFunctionExpression(tfes.series, tfes.unnest).Functions(),
+	)
+}
+
+func (tfes *tableFunctionExpressionSuite) TestIsRowsFrom() {
+	tfes.False(exp.NewTableFunctionExpression(tfes.series).IsRowsFrom())
+	tfes.True(exp.NewTableFunctionExpression(tfes.series, tfes.unnest).IsRowsFrom())
+}
+
+func (tfes *tableFunctionExpressionSuite) TestWithOrdinality() {
+	tf := exp.NewTableFunctionExpression(tfes.series)
+	tfes.False(tf.IsWithOrdinality())
+	tfes.True(tf.WithOrdinality().IsWithOrdinality())
+	tfes.False(tf.IsWithOrdinality())
+}
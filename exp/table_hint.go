@@ -0,0 +1,79 @@
+package exp
+
+// The kind of an index hint of a table reference (e.g. mysql USE INDEX, FORCE INDEX, IGNORE INDEX)
+type IndexHintType int
+
+const (
+	UseIndexHint IndexHintType = iota
+	ForceIndexHint
+	IgnoreIndexHint
+)
+
+type (
+	// An index hint of a table reference
+	//   IndexHint{Type: UseIndexHint, Indexes: []string{"idx_a"}} -> USE INDEX (`idx_a`)
+	IndexHint struct {
+		Type    IndexHintType
+		Indexes []string
+	}
+	tableHint struct {
+		table      Expression
+		only       bool
+		indexHints []IndexHint
+		tableHints []string
+	}
+)
+
+// Creates a new table reference with hints that can be used in a FROM or a JOIN
+//
+//	NewTableHintExpression(NewIdentifierExpression("", "a", "")).Only() -> ONLY "a"
+//	NewTableHintExpression(NewIdentifierExpression("", "a", "")).UseIndex("i") -> `a` USE INDEX (`i`)
+//	NewTableHintExpression(NewIdentifierExpression("", "a", "")).With("NOLOCK") -> "a" WITH (NOLOCK)
+func NewTableHintExpression(table Expression) TableHintExpression {
+	return tableHint{table: table}
+}
+
+func (th tableHint) Clone() Expression {
+	ret := th
+	ret.table = th.table.Clone()
+	ret.indexHints = append([]IndexHint(nil), th.indexHints...)
+	ret.tableHints = append([]string(nil), th.tableHints...)
+	return ret
+}
+
+func (th tableHint) Expression() Expression { return th }
+
+func (th tableHint) Table() Expression       { return th.table }
+func (th tableHint) IsOnly() bool            { return th.only }
+func (th tableHint) IndexHints() []IndexHint { return th.indexHints }
+func (th tableHint) TableHints() []string    { return th.tableHints }
+
+func (th tableHint) Only() TableHintExpression {
+	ret := th
+	ret.only = true
+	return ret
+}
+
+func (th tableHint) UseIndex(indexes ...string) TableHintExpression {
+	return th.indexHintAppend(UseIndexHint, indexes)
+}
+
+func (th tableHint) ForceIndex(indexes ...string) TableHintExpression {
+	return th.indexHintAppend(ForceIndexHint, indexes)
+}
+
+func (th tableHint) IgnoreIndex(indexes ...string) TableHintExpression {
+	return th.indexHintAppend(IgnoreIndexHint, indexes)
+}
+
+func (th tableHint) With(hints ...string) TableHintExpression {
+	ret := th
+	ret.tableHints = append(th.tableHints[:len(th.tableHints):len(th.tableHints)], hints...)
+	return ret
+}
+
+func (th tableHint) indexHintAppend(t IndexHintType, indexes []string) TableHintExpression {
+	ret := th
+	ret.indexHints = append(th.indexHints[:len(th.indexHints):len(th.indexHints)], IndexHint{Type: t, Indexes: indexes})
+	return ret
+}
@@ -0,0 +1,62 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type tableHintExpressionSuite struct {
+	suite.Suite
+}
+
+func TestTableHintExpressionSuite(t *testing.T) {
+	suite.Run(t, new(tableHintExpressionSuite))
+}
+
+func (thes *tableHintExpressionSuite) TestClone() {
+	th := exp.NewTableHintExpression(exp.NewIdentifierExpression("", "a", "")).UseIndex("i").With("NOLOCK")
+	thes.Equal(th, th.Clone())
+}
+
+func (thes *tableHintExpressionSuite) TestExpression() {
+	th := exp.NewTableHintExpression(exp.NewIdentifierExpression("", "a", ""))
+	thes.Equal(th, th.Expression())
+}
+
+func (thes *tableHintExpressionSuite) TestTable() {
+	table := exp.NewIdentifierExpression("", "a", "").As("b")
+	thes.Equal(table, exp.NewTableHintExpression(table).Table())
+}
+
+func (thes *tableHintExpressionSuite) TestOnly() {
+	th := exp.NewTableHintExpression(exp.NewIdentifierExpression("", "a", ""))
+	thes.False(th.IsOnly())
+	thes.True(th.Only().IsOnly())
+	thes.False(th.IsOnly())
+}
+
+func (thes *tableHintExpressionSuite) TestIndexHints() {
+	th := exp.NewTableHintExpression(exp.NewIdentifierExpression("", "a", ""))
+	thes.Empty(th.IndexHints())
+
+	th2 := th.UseIndex("i1", "i2").ForceIndex("i3").IgnoreIndex("i4")
+	thes.Equal([]exp.IndexHint{
+		{Type: exp.UseIndexHint, Indexes: []string{"i1", "i2"}},
+		{Type: exp.ForceIndexHint, Indexes: []string{"i3"}},
+		{Type: exp.IgnoreIndexHint, Indexes: []string{"i4"}},
+	}, th2.IndexHints())
+	thes.Empty(th.IndexHints())
+}
+
+func (thes *tableHintExpressionSuite) TestWith() {
+	th := exp.NewTableHintExpression(exp.NewIdentifierExpression("", "a", "")).With("NOLOCK")
+	thes.Equal([]string{"NOLOCK"}, th.TableHints())
+
+	th2 := th.With("INDEX(i1)")
+	th3 := th.With("READPAST")
+	thes.Equal([]string{"NOLOCK", "INDEX(i1)"}, th2.TableHints())
+	thes.Equal([]string{"NOLOCK", "READPAST"}, th3.TableHints())
+	thes.Equal([]string{"NOLOCK"}, th.TableHints())
+}
@@ -11,6 +11,9 @@ type (
 		Table() Expression
 		SetTable(table Expression) UpdateClauses
 
+		Hints() []string
+		SetHints(hints []string) UpdateClauses
+
 		SetValues() interface{}
 		HasSetValues() bool
 		SetSetValues(values interface{}) UpdateClauses
@@ -42,6 +45,7 @@ type (
 	updateClauses struct {
 		commonTables []CommonTableExpression
 		table        Expression
+		hints        []string
 		setValues    interface{}
 		from         ColumnListExpression
 		where        ExpressionList
@@ -63,6 +67,7 @@ func (uc *updateClauses) clone() *updateClauses {
 	return &updateClauses{
 		commonTables: uc.commonTables,
 		table:        uc.table,
+		hints:        uc.hints,
 		setValues:    uc.setValues,
 		from:         uc.from,
 		where:        uc.where,
@@ -92,6 +97,16 @@ func (uc *updateClauses) SetTable(table Expression) UpdateClauses {
 	return ret
 }
 
+func (uc *updateClauses) Hints() []string {
+	return uc.hints
+}
+
+func (uc *updateClauses) SetHints(hints []string) UpdateClauses {
+	ret := uc.clone()
+	ret.hints = hints
+	return ret
+}
+
 func (uc *updateClauses) SetValues() interface{} {
 	return uc.setValues
 }
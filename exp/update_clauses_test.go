@@ -44,6 +44,15 @@ func (ucs *updateClausesSuite) TestSetTable() {
 	ucs.Equal(ti, c2.Table())
 }
 
+func (ucs *updateClausesSuite) TestHints() {
+	c := exp.NewUpdateClauses()
+	c2 := c.SetHints([]string{"NO_ICP(a)"})
+
+	ucs.Nil(c.Hints())
+	ucs.Equal([]string{"NO_ICP(a)"}, c2.Hints())
+	ucs.Nil(c2.SetHints(nil).Hints())
+}
+
 func (ucs *updateClausesSuite) TestSetValues() {
 	c := exp.NewUpdateClauses()
 	r := exp.Record{"a": "a1", "b": "b1"}
@@ -0,0 +1,24 @@
+package exp
+
+type (
+	valuesExpression struct {
+		rows [][]interface{}
+	}
+)
+
+// Creates a new VALUES list expression that can be used as a table
+//   NewValuesExpression([]interface{}{1, "a"}, []interface{}{2, "b"}) -> (VALUES (1, 'a'), (2, 'b'))
+func NewValuesExpression(rows ...[]interface{}) ValuesExpression {
+	return valuesExpression{rows: rows}
+}
+
+func (v valuesExpression) Clone() Expression {
+	return NewValuesExpression(v.rows...)
+}
+
+func (v valuesExpression) Rows() [][]interface{} {
+	return v.rows
+}
+
+func (v valuesExpression) Expression() Expression               { return v }
+func (v valuesExpression) As(val interface{}) AliasedExpression { return NewAliasExpression(v, val) }
@@ -0,0 +1,36 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type valuesExpressionSuite struct {
+	suite.Suite
+}
+
+func TestValuesExpressionSuite(t *testing.T) {
+	suite.Run(t, &valuesExpressionSuite{})
+}
+
+func (ves *valuesExpressionSuite) TestClone() {
+	ve := exp.NewValuesExpression([]interface{}{1, "a"}, []interface{}{2, "b"})
+	ves.Equal(exp.NewValuesExpression([]interface{}{1, "a"}, []interface{}{2, "b"}), ve.Clone())
+}
+
+func (ves *valuesExpressionSuite) TestExpression() {
+	ve := exp.NewValuesExpression([]interface{}{1, "a"})
+	ves.Equal(ve, ve.Expression())
+}
+
+func (ves *valuesExpressionSuite) TestRows() {
+	ve := exp.NewValuesExpression([]interface{}{1, "a"}, []interface{}{2, "b"})
+	ves.Equal([][]interface{}{{1, "a"}, {2, "b"}}, ve.Rows())
+}
+
+func (ves *valuesExpressionSuite) TestAs() {
+	ve := exp.NewValuesExpression([]interface{}{1, "a"})
+	ves.Equal(exp.NewAliasExpression(ve, "v"), ve.As("v"))
+}
@@ -5,6 +5,7 @@ type sqlWindowExpression struct {
 	parent        IdentifierExpression
 	partitionCols ColumnListExpression
 	orderCols     ColumnListExpression
+	frame         WindowFrame
 }
 
 func NewWindowExpression(window, parent IdentifierExpression, partitionCols, orderCols ColumnListExpression) WindowExpression {
@@ -28,6 +29,7 @@ func (we sqlWindowExpression) clone() sqlWindowExpression {
 		parent:        we.parent,
 		partitionCols: we.partitionCols.Clone().(ColumnListExpression),
 		orderCols:     we.orderCols.Clone().(ColumnListExpression),
+		frame:         we.frame,
 	}
 }
 
@@ -88,3 +90,36 @@ func (we sqlWindowExpression) Inherit(parent string) WindowExpression {
 	ret.parent = ParseIdentifier(parent)
 	return ret
 }
+
+func (we sqlWindowExpression) Frame() WindowFrame {
+	return we.frame
+}
+
+func (we sqlWindowExpression) HasFrame() bool {
+	return we.frame != nil
+}
+
+func (we sqlWindowExpression) Rows(start, end WindowFrameBound) WindowExpression {
+	return we.withFrame(NewWindowFrame(RowsFrame, start, end))
+}
+
+func (we sqlWindowExpression) Range(start, end WindowFrameBound) WindowExpression {
+	return we.withFrame(NewWindowFrame(RangeFrame, start, end))
+}
+
+func (we sqlWindowExpression) Groups(start, end WindowFrameBound) WindowExpression {
+	return we.withFrame(NewWindowFrame(GroupsFrame, start, end))
+}
+
+func (we sqlWindowExpression) Exclude(exclusion WindowFrameExclusion) WindowExpression {
+	if we.frame == nil {
+		return we
+	}
+	return we.withFrame(we.frame.Exclude(exclusion))
+}
+
+func (we sqlWindowExpression) withFrame(frame WindowFrame) WindowExpression {
+	ret := we.clone()
+	ret.frame = frame
+	return ret
+}
@@ -0,0 +1,154 @@
+package exp
+
+import "fmt"
+
+type (
+	// The unit of a window frame (e.g. ROWS, RANGE, GROUPS)
+	WindowFrameMode int
+
+	// The type of a window frame boundary (e.g. UNBOUNDED PRECEDING, CURRENT ROW)
+	WindowFrameBoundType int
+
+	// The rows excluded from a window frame (e.g. EXCLUDE CURRENT ROW)
+	WindowFrameExclusion int
+
+	// A boundary of a window frame
+	//    NewWindowFrameBound(PrecedingBound, 1)                                // 1 PRECEDING
+	//    NewWindowFrameBound(PrecedingBound, NewLiteralExpression("INTERVAL '1 day'")) // INTERVAL '1 day' PRECEDING
+	WindowFrameBound interface {
+		// The type of the boundary
+		BoundType() WindowFrameBoundType
+		// The offset of a PRECEDING or FOLLOWING boundary, this may be a value or an Expression
+		Offset() interface{}
+	}
+	windowFrameBound struct {
+		boundType WindowFrameBoundType
+		offset    interface{}
+	}
+
+	// The frame of a window
+	//    NewWindowFrame(RowsFrame, NewWindowFrameBound(UnboundedPrecedingBound, nil), nil) // ROWS UNBOUNDED PRECEDING
+	WindowFrame interface {
+		// The unit of the frame
+		Mode() WindowFrameMode
+		// The start of the frame
+		Start() WindowFrameBound
+		// The end of the frame, if nil the frame ends at the current row
+		End() WindowFrameBound
+		// The rows excluded from the frame
+		Exclusion() WindowFrameExclusion
+		// Returns a new WindowFrame with the rows to exclude
+		Exclude(exclusion WindowFrameExclusion) WindowFrame
+	}
+	windowFrame struct {
+		mode      WindowFrameMode
+		start     WindowFrameBound
+		end       WindowFrameBound
+		exclusion WindowFrameExclusion
+	}
+)
+
+const (
+	RowsFrame WindowFrameMode = iota
+	RangeFrame
+	GroupsFrame
+)
+
+const (
+	UnboundedPrecedingBound WindowFrameBoundType = iota
+	PrecedingBound
+	CurrentRowBound
+	FollowingBound
+	UnboundedFollowingBound
+)
+
+const (
+	// Do not exclude any rows, no EXCLUDE clause is generated (DEFAULT)
+	NoFrameExclusion WindowFrameExclusion = iota
+	ExcludeCurrentRow
+	ExcludeGroup
+	ExcludeTies
+	ExcludeNoOthers
+)
+
+func (m WindowFrameMode) String() string {
+	switch m {
+	case RowsFrame:
+		return "ROWS"
+	case RangeFrame:
+		return "RANGE"
+	case GroupsFrame:
+		return "GROUPS"
+	}
+	return fmt.Sprintf("%d", m)
+}
+
+func (bt WindowFrameBoundType) String() string {
+	switch bt {
+	case UnboundedPrecedingBound:
+		return "UNBOUNDED PRECEDING"
+	case PrecedingBound:
+		return "PRECEDING"
+	case CurrentRowBound:
+		return "CURRENT ROW"
+	case FollowingBound:
+		return "FOLLOWING"
+	case UnboundedFollowingBound:
+		return "UNBOUNDED FOLLOWING"
+	}
+	return fmt.Sprintf("%d", bt)
+}
+
+func (e WindowFrameExclusion) String() string {
+	switch e {
+	case NoFrameExclusion:
+		return ""
+	case ExcludeCurrentRow:
+		return "EXCLUDE CURRENT ROW"
+	case ExcludeGroup:
+		return "EXCLUDE GROUP"
+	case ExcludeTies:
+		return "EXCLUDE TIES"
+	case ExcludeNoOthers:
+		return "EXCLUDE NO OTHERS"
+	}
+	return fmt.Sprintf("%d", e)
+}
+
+// Creates a new window frame boundary, the offset is only used by PrecedingBound and FollowingBound
+func NewWindowFrameBound(boundType WindowFrameBoundType, offset interface{}) WindowFrameBound {
+	return windowFrameBound{boundType: boundType, offset: offset}
+}
+
+func (wfb windowFrameBound) BoundType() WindowFrameBoundType {
+	return wfb.boundType
+}
+
+func (wfb windowFrameBound) Offset() interface{} {
+	return wfb.offset
+}
+
+// Creates a new window frame, if end is nil a frame without BETWEEN is created (e.g. ROWS 2 PRECEDING)
+func NewWindowFrame(mode WindowFrameMode, start, end WindowFrameBound) WindowFrame {
+	return windowFrame{mode: mode, start: start, end: end}
+}
+
+func (wf windowFrame) Mode() WindowFrameMode {
+	return wf.mode
+}
+
+func (wf windowFrame) Start() WindowFrameBound {
+	return wf.start
+}
+
+func (wf windowFrame) End() WindowFrameBound {
+	return wf.end
+}
+
+func (wf windowFrame) Exclusion() WindowFrameExclusion {
+	return wf.exclusion
+}
+
+func (wf windowFrame) Exclude(exclusion WindowFrameExclusion) WindowFrame {
+	return windowFrame{mode: wf.mode, start: wf.start, end: wf.end, exclusion: exclusion}
+}
@@ -0,0 +1,66 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type windowFrameTest struct {
+	suite.Suite
+}
+
+func TestWindowFrameSuite(t *testing.T) {
+	suite.Run(t, new(windowFrameTest))
+}
+
+func (wft *windowFrameTest) TestWindowFrameBound() {
+	b := exp.NewWindowFrameBound(exp.PrecedingBound, 1)
+	wft.Equal(exp.PrecedingBound, b.BoundType())
+	wft.Equal(1, b.Offset())
+
+	b = exp.NewWindowFrameBound(exp.CurrentRowBound, nil)
+	wft.Equal(exp.CurrentRowBound, b.BoundType())
+	wft.Nil(b.Offset())
+}
+
+func (wft *windowFrameTest) TestWindowFrame() {
+	start := exp.NewWindowFrameBound(exp.UnboundedPrecedingBound, nil)
+	end := exp.NewWindowFrameBound(exp.CurrentRowBound, nil)
+	f := exp.NewWindowFrame(exp.GroupsFrame, start, end)
+	wft.Equal(exp.GroupsFrame, f.Mode())
+	wft.Equal(start, f.Start())
+	wft.Equal(end, f.End())
+	wft.Equal(exp.NoFrameExclusion, f.Exclusion())
+
+	f2 := f.Exclude(exp.ExcludeGroup)
+	wft.Equal(exp.ExcludeGroup, f2.Exclusion())
+	wft.Equal(exp.GroupsFrame, f2.Mode())
+	wft.Equal(start, f2.Start())
+	wft.Equal(end, f2.End())
+
+	// the original frame is not modified
+	wft.Equal(exp.NoFrameExclusion, f.Exclusion())
+}
+
+func (wft *windowFrameTest) TestString() {
+	wft.Equal("ROWS", exp.RowsFrame.String())
+	wft.Equal("RANGE", exp.RangeFrame.String())
+	wft.Equal("GROUPS", exp.GroupsFrame.String())
+	wft.Equal("10", exp.WindowFrameMode(10).String())
+
+	wft.Equal("UNBOUNDED PRECEDING", exp.UnboundedPrecedingBound.String())
+	wft.Equal("PRECEDING", exp.PrecedingBound.String())
+	wft.Equal("CURRENT ROW", exp.CurrentRowBound.String())
+	wft.Equal("FOLLOWING", exp.FollowingBound.String())
+	wft.Equal("UNBOUNDED FOLLOWING", exp.UnboundedFollowingBound.String())
+	wft.Equal("10", exp.WindowFrameBoundType(10).String())
+
+	wft.Equal("", exp.NoFrameExclusion.String())
+	wft.Equal("EXCLUDE CURRENT ROW", exp.ExcludeCurrentRow.String())
+	wft.Equal("EXCLUDE GROUP", exp.ExcludeGroup.String())
+	wft.Equal("EXCLUDE TIES", exp.ExcludeTies.String())
+	wft.Equal("EXCLUDE NO OTHERS", exp.ExcludeNoOthers.String())
+	wft.Equal("10", exp.WindowFrameExclusion(10).String())
+}
@@ -100,6 +100,14 @@ func (swfe sqlWindowFunctionExpression) IsNotTrue() BooleanExpression  { return
 func (swfe sqlWindowFunctionExpression) IsFalse() BooleanExpression    { return is(swfe, false) }
 func (swfe sqlWindowFunctionExpression) IsNotFalse() BooleanExpression { return isNot(swfe, false) }
 
+func (swfe sqlWindowFunctionExpression) IsDistinctFrom(val interface{}) BooleanExpression {
+	return isDistinctFrom(swfe, val)
+}
+
+func (swfe sqlWindowFunctionExpression) IsNotDistinctFrom(val interface{}) BooleanExpression {
+	return isNotDistinctFrom(swfe, val)
+}
+
 func (swfe sqlWindowFunctionExpression) Asc() OrderedExpression  { return asc(swfe) }
 func (swfe sqlWindowFunctionExpression) Desc() OrderedExpression { return desc(swfe) }
 
@@ -97,6 +97,8 @@ func (swfet *sqlWindowFunctionExpressionTest) TestAllOthers() {
 		{Ex: wf.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, wf, true)},
 		{Ex: wf.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, wf, false)},
 		{Ex: wf.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, wf, false)},
+		{Ex: wf.IsDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsDistinctFromOp, wf, 1)},
+		{Ex: wf.IsNotDistinctFrom(1), Expected: exp.NewBooleanExpression(exp.IsNotDistinctFromOp, wf, 1)},
 		{Ex: wf.Desc(), Expected: exp.NewOrderedExpression(wf, exp.DescSortDir, exp.NoNullsSortType)},
 		{Ex: wf.Asc(), Expected: exp.NewOrderedExpression(wf, exp.AscDir, exp.NoNullsSortType)},
 	}
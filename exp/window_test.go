@@ -82,3 +82,28 @@ func (wet *windowExpressionTest) TestInherit() {
 	w = w.Inherit("w2")
 	wet.Equal(exp.NewIdentifierExpression("", "", "w2"), w.Parent())
 }
+
+func (wet *windowExpressionTest) TestFrame() {
+	start := exp.NewWindowFrameBound(exp.PrecedingBound, 1)
+	end := exp.NewWindowFrameBound(exp.FollowingBound, 1)
+	w := exp.NewWindowExpression(nil, nil, nil, nil)
+	wet.False(w.HasFrame())
+	wet.Nil(w.Frame())
+	// Exclude has no effect without a frame
+	wet.False(w.Exclude(exp.ExcludeTies).HasFrame())
+
+	rows := w.Rows(start, end)
+	wet.True(rows.HasFrame())
+	wet.Equal(exp.NewWindowFrame(exp.RowsFrame, start, end), rows.Frame())
+	wet.Equal(rows, rows.Clone())
+
+	wet.Equal(exp.NewWindowFrame(exp.RangeFrame, start, nil), w.Range(start, nil).Frame())
+	wet.Equal(
+		exp.NewWindowFrame(exp.GroupsFrame, start, end).Exclude(exp.ExcludeTies),
+		w.Groups(start, end).Exclude(exp.ExcludeTies).Frame(),
+	)
+	// the frame is kept when the window is modified
+	wet.Equal(rows.Frame(), rows.OrderBy("a").PartitionBy("b").Inherit("w1").Frame())
+	// the original window is not modified
+	wet.False(w.HasFrame())
+}
@@ -0,0 +1,78 @@
+package exp
+
+type (
+	xmlTable struct {
+		rowPath  string
+		document interface{}
+		columns  []XMLTableColumn
+	}
+	xmlTableColumn struct {
+		name       string
+		dataType   DataType
+		path       string
+		defaultVal interface{}
+		hasDefault bool
+		ordinality bool
+	}
+)
+
+// Creates a new XMLTABLE expression that produces a row for every node of the document matching the row path
+//
+//	NewXMLTableExpression("/rows/row", ParseIdentifier("data")).
+//		Columns(NewXMLTableColumn("id", NewDataType(IntegerDataType)).Path("@id"))
+//	// XMLTABLE('/rows/row' PASSING "data" COLUMNS "id" INTEGER PATH '@id')
+func NewXMLTableExpression(rowPath string, document interface{}) XMLTableExpression {
+	return xmlTable{rowPath: rowPath, document: document}
+}
+
+func (xt xmlTable) Clone() Expression {
+	return xmlTable{rowPath: xt.rowPath, document: xt.document, columns: xt.columns}
+}
+
+func (xt xmlTable) Expression() Expression               { return xt }
+func (xt xmlTable) As(val interface{}) AliasedExpression { return NewAliasExpression(xt, val) }
+
+func (xt xmlTable) RowPath() string                { return xt.rowPath }
+func (xt xmlTable) Document() interface{}          { return xt.document }
+func (xt xmlTable) TableColumns() []XMLTableColumn { return xt.columns }
+
+// Appends to the columns produced for every row
+func (xt xmlTable) Columns(cols ...XMLTableColumn) XMLTableExpression {
+	columns := make([]XMLTableColumn, 0, len(xt.columns)+len(cols))
+	xt.columns = append(append(columns, xt.columns...), cols...)
+	return xt
+}
+
+// Creates a new column of an XMLTABLE, the value is taken from the child element with the same name unless a path is
+// set
+func NewXMLTableColumn(name string, dataType DataType) XMLTableColumn {
+	return xmlTableColumn{name: name, dataType: dataType}
+}
+
+// Creates a new FOR ORDINALITY column of an XMLTABLE that numbers the rows starting at 1
+func NewXMLTableOrdinalityColumn(name string) XMLTableColumn {
+	return xmlTableColumn{name: name, ordinality: true}
+}
+
+func (xc xmlTableColumn) Clone() Expression      { return xc }
+func (xc xmlTableColumn) Expression() Expression { return xc }
+
+func (xc xmlTableColumn) Name() string              { return xc.name }
+func (xc xmlTableColumn) DataType() DataType        { return xc.dataType }
+func (xc xmlTableColumn) PathExpression() string    { return xc.path }
+func (xc xmlTableColumn) HasDefault() bool          { return xc.hasDefault }
+func (xc xmlTableColumn) DefaultValue() interface{} { return xc.defaultVal }
+func (xc xmlTableColumn) IsForOrdinality() bool     { return xc.ordinality }
+
+// Sets the path of the value of the column relative to the row (e.g. "@id", "name/text()")
+func (xc xmlTableColumn) Path(path string) XMLTableColumn {
+	xc.path = path
+	return xc
+}
+
+// Sets the value used when the path does not match any node
+func (xc xmlTableColumn) Default(val interface{}) XMLTableColumn {
+	xc.defaultVal = val
+	xc.hasDefault = true
+	return xc
+}
@@ -0,0 +1,60 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type xmlTableExpressionSuite struct {
+	suite.Suite
+}
+
+func TestXMLTableExpressionSuite(t *testing.T) {
+	suite.Run(t, new(xmlTableExpressionSuite))
+}
+
+func (xtes *xmlTableExpressionSuite) TestXMLTable() {
+	doc := exp.NewIdentifierExpression("", "", "data")
+	id := exp.NewXMLTableColumn("id", exp.NewDataType(exp.IntegerDataType)).Path("@id")
+	n := exp.NewXMLTableOrdinalityColumn("n")
+	xt := exp.NewXMLTableExpression("/rows/row", doc)
+	xtes.Equal("/rows/row", xt.RowPath())
+	xtes.Equal(doc, xt.Document())
+	xtes.Empty(xt.TableColumns())
+
+	xt2 := xt.Columns(id).Columns(n)
+	xtes.Equal([]exp.XMLTableColumn{id, n}, xt2.TableColumns())
+	xtes.Equal(xt2, xt2.Clone())
+	xtes.Equal(xt2, xt2.Expression())
+	xtes.Equal(exp.NewAliasExpression(xt2, "x"), xt2.As("x"))
+
+	// the original is not modified
+	xtes.Empty(xt.TableColumns())
+}
+
+func (xtes *xmlTableExpressionSuite) TestXMLTableColumn() {
+	dt := exp.NewDataType(exp.VarcharDataType, 100)
+	c := exp.NewXMLTableColumn("name", dt)
+	xtes.Equal("name", c.Name())
+	xtes.Equal(dt, c.DataType())
+	xtes.Empty(c.PathExpression())
+	xtes.False(c.HasDefault())
+	xtes.Nil(c.DefaultValue())
+	xtes.False(c.IsForOrdinality())
+	xtes.Equal(c, c.Clone())
+	xtes.Equal(c, c.Expression())
+
+	c2 := c.Path("name/text()").Default("unknown")
+	xtes.Equal("name/text()", c2.PathExpression())
+	xtes.True(c2.HasDefault())
+	xtes.Equal("unknown", c2.DefaultValue())
+	xtes.Empty(c.PathExpression())
+	xtes.False(c.HasDefault())
+
+	o := exp.NewXMLTableOrdinalityColumn("n")
+	xtes.Equal("n", o.Name())
+	xtes.Nil(o.DataType())
+	xtes.True(o.IsForOrdinality())
+}
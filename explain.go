@@ -0,0 +1,207 @@
+package goqu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// ExplainStatement wraps the SQL of a Select, Insert, Update or Delete dataset in the dialects EXPLAIN syntax.
+	ExplainStatement struct {
+		statement    exp.AppendableExpression
+		dialect      SQLDialect
+		queryFactory exec.QueryFactory
+		isPrepared   bool
+		opts         exp.ExplainOptions
+	}
+
+	// ExplainPlan is the plan returned by executing an ExplainStatement.
+	ExplainPlan struct {
+		// The format the plan was requested in
+		Format exp.ExplainFormat
+		// The columns returned by the database (e.g. postgres=QUERY PLAN)
+		Columns []string
+		// The rows of the plan, NULL values are returned as empty strings
+		Rows [][]string
+	}
+)
+
+func errExplainNotSupported(dialect string) error {
+	return errors.New("dialect does not support EXPLAIN [dialect=%s]", dialect)
+}
+
+func errExplainOptionNotSupported(dialect, option string) error {
+	return errors.New("dialect does not support %s in EXPLAIN [dialect=%s]", option, dialect)
+}
+
+var errExplainPlanNotJSON = errors.New("explain plan must use exp.ExplainFormatJSON to be decoded")
+
+func newExplainStatement(
+	statement exp.AppendableExpression,
+	dialect SQLDialect,
+	queryFactory exec.QueryFactory,
+	isPrepared bool,
+	opts exp.ExplainOptions,
+) *ExplainStatement {
+	return &ExplainStatement{
+		statement:    statement,
+		dialect:      dialect,
+		queryFactory: queryFactory,
+		isPrepared:   isPrepared,
+		opts:         opts,
+	}
+}
+
+// Options returns the options used when generating the EXPLAIN statement.
+func (es *ExplainStatement) Options() exp.ExplainOptions {
+	return es.opts
+}
+
+// ToSQL generates the EXPLAIN sql, if the dataset is prepared then the parameters will not be interpolated.
+//
+//	goqu.From("user").Where(goqu.C("id").Eq(1)).Explain(exp.ExplainOptions{Analyze: true}).ToSQL()
+//	// EXPLAIN (ANALYZE) SELECT * FROM "user" WHERE ("id" = 1)
+//
+// Errors:
+//   - The dialect does not support EXPLAIN or one of the options
+//   - There is an error generating the SQL of the dataset
+func (es *ExplainStatement) ToSQL() (sql string, args []interface{}, err error) {
+	return es.sqlBuilder().ToSQL()
+}
+
+// Executor creates a QueryExecutor to execute the EXPLAIN statement.
+func (es *ExplainStatement) Executor() exec.QueryExecutor {
+	return es.queryFactory.FromSQLBuilder(es.sqlBuilder())
+}
+
+// Plan executes the EXPLAIN statement and returns the plan.
+//
+// NOTE: When using exp.ExplainOptions.Analyze the statement is executed, wrap inserts, updates and deletes in a
+// transaction that is rolled back if the changes should not be kept.
+func (es *ExplainStatement) Plan() (*ExplainPlan, error) {
+	return es.PlanContext(context.Background())
+}
+
+// PlanContext see ExplainStatement#Plan
+func (es *ExplainStatement) PlanContext(ctx context.Context) (*ExplainPlan, error) {
+	if es.queryFactory == nil {
+		return nil, ErrQueryFactoryNotFoundError
+	}
+	rows, err := es.Executor().QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	plan := &ExplainPlan{Format: es.opts.Format, Columns: cols, Rows: [][]string{}}
+	for rows.Next() {
+		vals := make([]interface{}, len(cols))
+		ptrs := make([]interface{}, len(cols))
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		row := make([]string, len(cols))
+		for i, v := range vals {
+			row[i] = explainValueString(v)
+		}
+		plan.Rows = append(plan.Rows, row)
+	}
+	return plan, rows.Err()
+}
+
+func (es *ExplainStatement) sqlBuilder() sb.SQLBuilder {
+	b := sb.NewSQLBuilder(es.isPrepared)
+	dialect := es.dialect.Dialect()
+	opts := getDialectOptions(dialect)
+	if opts.ExplainFragment == nil {
+		return b.SetError(errExplainNotSupported(dialect))
+	}
+	options, err := es.optionsSQL(dialect, opts)
+	if err != nil {
+		return b.SetError(err)
+	}
+	b.Write(opts.ExplainFragment)
+	if len(options) > 0 {
+		if opts.WrapExplainOptionsInParens {
+			b.WriteRunes(opts.LeftParenRune).WriteStrings(strings.Join(options, ", ")).WriteRunes(opts.RightParenRune)
+		} else {
+			b.WriteStrings(strings.Join(options, " "))
+		}
+		b.WriteRunes(opts.SpaceRune)
+	}
+	es.statement.AppendSQL(b)
+	return b
+}
+
+// returns the options of the EXPLAIN statement (e.g. ANALYZE, FORMAT JSON)
+func (es *ExplainStatement) optionsSQL(dialect string, opts *SQLDialectOptions) ([]string, error) {
+	var options []string
+	if es.opts.Analyze {
+		if opts.ExplainAnalyzeFragment == nil {
+			return nil, errExplainOptionNotSupported(dialect, "ANALYZE")
+		}
+		options = append(options, string(opts.ExplainAnalyzeFragment))
+	}
+	if es.opts.Verbose {
+		if opts.ExplainVerboseFragment == nil {
+			return nil, errExplainOptionNotSupported(dialect, "VERBOSE")
+		}
+		options = append(options, string(opts.ExplainVerboseFragment))
+	}
+	if es.opts.Format != exp.ExplainFormatDefault {
+		format, ok := opts.ExplainFormats[es.opts.Format]
+		if opts.ExplainFormatFragment == nil || !ok {
+			return nil, errExplainOptionNotSupported(dialect, "FORMAT "+es.opts.Format.String())
+		}
+		options = append(options, string(opts.ExplainFormatFragment)+string(format))
+	}
+	return options, nil
+}
+
+func explainValueString(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(t)
+	case string:
+		return t
+	}
+	return fmt.Sprint(v)
+}
+
+// String returns the plan as text, the values of each row are separated by a tab and rows are separated by a new line.
+// This is useful for comparing the plan of a query to a known plan (e.g. postgres text plans or json plans).
+func (ep *ExplainPlan) String() string {
+	lines := make([]string, 0, len(ep.Rows))
+	for _, row := range ep.Rows {
+		lines = append(lines, strings.Join(row, "\t"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Decode unmarshals a plan requested with exp.ExplainFormatJSON into i.
+//
+//	var plan []map[string]interface{}
+//	if err := p.Decode(&plan); err != nil {
+//		return err
+//	}
+func (ep *ExplainPlan) Decode(i interface{}) error {
+	if ep.Format != exp.ExplainFormatJSON {
+		return errExplainPlanNotJSON
+	}
+	return json.Unmarshal([]byte(ep.String()), i)
+}
@@ -0,0 +1,181 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlserver"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+type explainSuite struct {
+	suite.Suite
+}
+
+func TestExplainSuite(t *testing.T) {
+	suite.Run(t, new(explainSuite))
+}
+
+func (es *explainSuite) assertSQL(stmt *goqu.ExplainStatement, expectedSQL string, expectedArgs ...interface{}) {
+	sql, args, err := stmt.ToSQL()
+	es.NoError(err)
+	es.Equal(expectedSQL, sql)
+	if len(expectedArgs) == 0 {
+		es.Empty(args)
+	} else {
+		es.Equal(expectedArgs, args)
+	}
+}
+
+func (es *explainSuite) TestOptions() {
+	opts := exp.ExplainOptions{Analyze: true, Format: exp.ExplainFormatJSON}
+	es.Equal(opts, goqu.From("users").Explain(opts).Options())
+}
+
+func (es *explainSuite) TestToSQL() {
+	ds := goqu.From("users").Where(goqu.C("id").Eq(10))
+	es.assertSQL(ds.Explain(exp.ExplainOptions{}), `EXPLAIN SELECT * FROM "users" WHERE ("id" = 10)`)
+	es.assertSQL(
+		ds.Explain(exp.ExplainOptions{Analyze: true, Verbose: true, Format: exp.ExplainFormatJSON}),
+		`EXPLAIN (ANALYZE, VERBOSE, FORMAT JSON) SELECT * FROM "users" WHERE ("id" = 10)`,
+	)
+	es.assertSQL(
+		ds.Prepared(true).Explain(exp.ExplainOptions{Format: exp.ExplainFormatYAML}),
+		`EXPLAIN (FORMAT YAML) SELECT * FROM "users" WHERE ("id" = ?)`,
+		int64(10),
+	)
+
+	_, _, err := ds.Explain(exp.ExplainOptions{Format: exp.ExplainFormatTree}).ToSQL()
+	es.EqualError(err, "goqu: dialect does not support FORMAT TREE in EXPLAIN [dialect=default]")
+
+	_, _, err = ds.SetError(errors.New("expected error")).Explain(exp.ExplainOptions{}).ToSQL()
+	es.EqualError(err, "goqu: expected error")
+}
+
+func (es *explainSuite) TestToSQL_Statements() {
+	opts := exp.ExplainOptions{Analyze: true}
+	es.assertSQL(
+		goqu.Insert("users").Rows(goqu.Record{"name": "Bob"}).Explain(opts),
+		`EXPLAIN (ANALYZE) INSERT INTO "users" ("name") VALUES ('Bob')`,
+	)
+	es.assertSQL(
+		goqu.Update("users").Set(goqu.Record{"name": "Bob"}).Where(goqu.C("id").Eq(1)).Explain(opts),
+		`EXPLAIN (ANALYZE) UPDATE "users" SET "name"='Bob' WHERE ("id" = 1)`,
+	)
+	es.assertSQL(
+		goqu.Delete("users").Where(goqu.C("id").Eq(1)).Prepared(true).Explain(opts),
+		`EXPLAIN (ANALYZE) DELETE FROM "users" WHERE ("id" = ?)`,
+		int64(1),
+	)
+}
+
+func (es *explainSuite) TestToSQL_postgres() {
+	ds := goqu.Dialect("postgres").From("users").Where(goqu.C("id").Eq(10)).Prepared(true)
+	es.assertSQL(
+		ds.Explain(exp.ExplainOptions{Analyze: true, Format: exp.ExplainFormatText}),
+		`EXPLAIN (ANALYZE, FORMAT TEXT) SELECT * FROM "users" WHERE ("id" = $1)`,
+		int64(10),
+	)
+}
+
+func (es *explainSuite) TestToSQL_mysql() {
+	ds := goqu.Dialect("mysql").From("users").Where(goqu.C("id").Eq(10))
+	es.assertSQL(ds.Explain(exp.ExplainOptions{}), "EXPLAIN SELECT * FROM `users` WHERE (`id` = 10)")
+	es.assertSQL(
+		ds.Explain(exp.ExplainOptions{Analyze: true, Format: exp.ExplainFormatTree}),
+		"EXPLAIN ANALYZE FORMAT=TREE SELECT * FROM `users` WHERE (`id` = 10)",
+	)
+	es.assertSQL(
+		ds.Explain(exp.ExplainOptions{Format: exp.ExplainFormatJSON}),
+		"EXPLAIN FORMAT=JSON SELECT * FROM `users` WHERE (`id` = 10)",
+	)
+
+	_, _, err := ds.Explain(exp.ExplainOptions{Verbose: true}).ToSQL()
+	es.EqualError(err, "goqu: dialect does not support VERBOSE in EXPLAIN [dialect=mysql]")
+}
+
+func (es *explainSuite) TestToSQL_sqlite3() {
+	ds := goqu.Dialect("sqlite3").From("users").Where(goqu.C("id").Eq(10))
+	es.assertSQL(ds.Explain(exp.ExplainOptions{}), "EXPLAIN QUERY PLAN SELECT * FROM `users` WHERE (`id` = 10)")
+
+	_, _, err := ds.Explain(exp.ExplainOptions{Analyze: true}).ToSQL()
+	es.EqualError(err, "goqu: dialect does not support ANALYZE in EXPLAIN [dialect=sqlite3]")
+}
+
+func (es *explainSuite) TestToSQL_sqlserver() {
+	_, _, err := goqu.Dialect("sqlserver").From("users").Explain(exp.ExplainOptions{}).ToSQL()
+	es.EqualError(err, "goqu: dialect does not support EXPLAIN [dialect=sqlserver]")
+}
+
+func (es *explainSuite) TestPlan() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	es.Require().NoError(err)
+	sqlMock.ExpectQuery(`EXPLAIN SELECT * FROM "users" WHERE ("id" = $1)`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"QUERY PLAN"}).
+			AddRow("Index Scan using users_pkey on users  (cost=0.15..8.17 rows=1 width=36)").
+			AddRow("  Index Cond: (id = 10)"))
+
+	db := goqu.New("postgres", mDB)
+	plan, err := db.From("users").Where(goqu.C("id").Eq(10)).Prepared(true).Explain(exp.ExplainOptions{}).Plan()
+	es.NoError(err)
+	es.Equal(exp.ExplainFormatDefault, plan.Format)
+	es.Equal([]string{"QUERY PLAN"}, plan.Columns)
+	es.Equal(
+		"Index Scan using users_pkey on users  (cost=0.15..8.17 rows=1 width=36)\n  Index Cond: (id = 10)",
+		plan.String(),
+	)
+	var decoded interface{}
+	es.EqualError(plan.Decode(&decoded), "goqu: explain plan must use exp.ExplainFormatJSON to be decoded")
+	es.NoError(sqlMock.ExpectationsWereMet())
+
+	_, err = goqu.From("users").Explain(exp.ExplainOptions{}).Plan()
+	es.Equal(goqu.ErrQueryFactoryNotFoundError, err)
+}
+
+func (es *explainSuite) TestPlan_JSON() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	es.Require().NoError(err)
+	sqlMock.ExpectQuery(`EXPLAIN (FORMAT JSON) SELECT * FROM "users"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"QUERY PLAN"}).
+			AddRow([]byte(`[{"Plan": {"Node Type": "Seq Scan", "Relation Name": "users"}}]`)))
+
+	db := goqu.New("postgres", mDB)
+	plan, err := db.From("users").Explain(exp.ExplainOptions{Format: exp.ExplainFormatJSON}).Plan()
+	es.NoError(err)
+	var decoded []struct {
+		Plan struct {
+			NodeType     string `json:"Node Type"`
+			RelationName string `json:"Relation Name"`
+		}
+	}
+	es.NoError(plan.Decode(&decoded))
+	es.Len(decoded, 1)
+	es.Equal("Seq Scan", decoded[0].Plan.NodeType)
+	es.Equal("users", decoded[0].Plan.RelationName)
+	es.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (es *explainSuite) TestPlan_MultipleColumns() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	es.Require().NoError(err)
+	sqlMock.ExpectQuery("EXPLAIN QUERY PLAN SELECT * FROM `users`").
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"id", "parent", "notused", "detail"}).
+			AddRow(int64(2), int64(0), nil, "SCAN users"))
+
+	db := goqu.New("sqlite3", mDB)
+	plan, err := db.From("users").Explain(exp.ExplainOptions{}).Plan()
+	es.NoError(err)
+	es.Equal([]string{"id", "parent", "notused", "detail"}, plan.Columns)
+	es.Equal([][]string{{"2", "0", "", "SCAN users"}}, plan.Rows)
+	es.Equal("2\t0\t\tSCAN users", plan.String())
+	es.NoError(sqlMock.ExpectationsWereMet())
+}
@@ -1,6 +1,9 @@
 package goqu
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/doug-martin/goqu/v9/exp"
 )
 
@@ -18,15 +21,53 @@ type (
 // emptyWindow is an empty WINDOW clause without name.
 var emptyWindow = exp.NewWindowExpression(nil, nil, nil, nil)
 
+// likePatternEscaper escapes the LIKE wildcards and the escape character itself with a backslash.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 const (
 	Wait       = exp.Wait
 	NoWait     = exp.NoWait
 	SkipLocked = exp.SkipLocked
 )
 
-// Cast creates a new Cast expression.
+const (
+	Microseconds = exp.MicrosecondsIntervalUnit
+	Seconds      = exp.SecondsIntervalUnit
+	Minutes      = exp.MinutesIntervalUnit
+	Hours        = exp.HoursIntervalUnit
+	Days         = exp.DaysIntervalUnit
+	Weeks        = exp.WeeksIntervalUnit
+	Months       = exp.MonthsIntervalUnit
+	Years        = exp.YearsIntervalUnit
+)
+
+const (
+	ExcludeCurrentRow = exp.ExcludeCurrentRow
+	ExcludeGroup      = exp.ExcludeGroup
+	ExcludeTies       = exp.ExcludeTies
+	ExcludeNoOthers   = exp.ExcludeNoOthers
+)
+
+// BinaryOperator creates a custom operator between two values (e.g. the pgvector distance operators), the symbol can
+// be overridden for a dialect using RegisterOperator.
+//    l2Distance := BinaryOperator("l2_distance", "<->")
+//    From("items").Order(l2Distance.Apply("embedding", "[1,2,3]").Asc()) // ORDER BY ("embedding" <-> '[1,2,3]') ASC
+func BinaryOperator(name, symbol string) exp.BinaryOperator {
+	return exp.NewBinaryOperator(name, symbol)
+}
+
+// PrefixOperator creates a custom operator in front of a value, the symbol can be overridden for a dialect using
+// RegisterOperator.
+//    PrefixOperator("abs", "@").Apply(C("a")) // (@ "a")
+func PrefixOperator(name, symbol string) exp.PrefixOperator {
+	return exp.NewPrefixOperator(name, symbol)
+}
+
+// Cast creates a new Cast expression, common type names (e.g. "text", "bigint", "timestamptz") are mapped to the type
+// of the dialect (see RegisterCastType).
 //
 // Cast(I("a"), "NUMERIC") -> `CAST("a" AS NUMERIC)`
+// Cast(I("a"), "text") -> `CAST("a" AS TEXT)`, mysql `CAST(`a` AS CHAR)`
 func Cast(e exp.Expression, t string) exp.CastExpression {
 	return exp.NewCastExpression(e, t)
 }
@@ -69,6 +110,14 @@ func Func(name string, args ...interface{}) exp.SQLFunctionExpression {
 	return exp.NewSQLFunctionExpression(name, args...)
 }
 
+// FuncDef creates the definition of a known SQL function that can be registered with RegisterFunction. Use
+// exp.VariadicArgs as maxArgs for functions that accept any number of arguments.
+//    FuncDef("length", 1, 1).WithSQLName("LEN")
+//    FuncDef("coalesce", 1, exp.VariadicArgs)
+func FuncDef(name string, minArgs, maxArgs int) exp.FunctionDefinition {
+	return exp.NewFunctionDefinition(name, minArgs, maxArgs)
+}
+
 // used internally to normalize the column name if passed in as a string it should be turned into an identifier
 func newIdentifierFunc(name string, col interface{}) exp.SQLFunctionExpression {
 	if s, ok := col.(string); ok {
@@ -126,6 +175,28 @@ func LAST(col interface{}) exp.SQLFunctionExpression { return newIdentifierFunc(
 // SUM(I("a")) -> `SUM("a")`
 func SUM(col interface{}) exp.SQLFunctionExpression { return newIdentifierFunc("SUM", col) }
 
+// ARRAY_AGG creates a new `ARRAY_AGG` sql function.
+//
+// ARRAY_AGG("a") -> `ARRAY_AGG("a")`
+// ARRAY_AGG(I("a")) -> `ARRAY_AGG("a")`
+//nolint:stylecheck,golint // sql function name
+func ARRAY_AGG(col interface{}) exp.SQLFunctionExpression { return newIdentifierFunc("ARRAY_AGG", col) }
+
+// UNNEST creates a new `UNNEST` sql function that expands arrays into rows, strings are treated as columns.
+//
+// UNNEST("a") -> `UNNEST("a")`
+// UNNEST("a", "b") -> `UNNEST("a", "b")`
+func UNNEST(vals ...interface{}) exp.SQLFunctionExpression {
+	args := make([]interface{}, 0, len(vals))
+	for _, val := range vals {
+		if s, ok := val.(string); ok {
+			val = I(s)
+		}
+		args = append(args, val)
+	}
+	return Func("UNNEST", args...)
+}
+
 // COALESCE creates a new `COALESCE` sql function.
 //
 // COALESCE(I("a"), "a") -> `COALESCE("a", 'a')`
@@ -134,6 +205,83 @@ func COALESCE(vals ...interface{}) exp.SQLFunctionExpression {
 	return Func("COALESCE", vals...)
 }
 
+// NULLIF creates a new `NULLIF` sql function that returns NULL if both values are equal.
+//
+// NULLIF("a", 0) -> `NULLIF("a", 0)`
+// NULLIF(I("a"), I("b")) -> `NULLIF("a", "b")`
+func NULLIF(col, val interface{}) exp.SQLFunctionExpression {
+	if s, ok := col.(string); ok {
+		col = I(s)
+	}
+	return Func("NULLIF", col, val)
+}
+
+// ROUND creates a new `ROUND` sql function, the number of decimal places is optional.
+//
+// ROUND("a") -> `ROUND("a")`
+// ROUND("a", 2) -> `ROUND("a", 2)`
+// ROUND(AVG("a"), 2) -> `ROUND(AVG("a"), 2)`
+func ROUND(col interface{}, places ...interface{}) exp.SQLFunctionExpression {
+	if s, ok := col.(string); ok {
+		col = I(s)
+	}
+	return Func("ROUND", append([]interface{}{col}, places...)...)
+}
+
+// CEIL creates a new `CEIL` sql function, the function is written as `CEILING` on dialects that name it differently
+// (e.g. sqlserver).
+//
+// CEIL("a") -> `CEIL("a")`
+// CEIL(I("a")) -> `CEIL("a")`
+func CEIL(col interface{}) exp.SQLFunctionExpression { return newIdentifierFunc("CEIL", col) }
+
+// FLOOR creates a new `FLOOR` sql function.
+//
+// FLOOR("a") -> `FLOOR("a")`
+// FLOOR(I("a")) -> `FLOOR("a")`
+func FLOOR(col interface{}) exp.SQLFunctionExpression { return newIdentifierFunc("FLOOR", col) }
+
+// ABS creates a new `ABS` sql function.
+//
+// ABS("a") -> `ABS("a")`
+// ABS(I("a")) -> `ABS("a")`
+func ABS(col interface{}) exp.SQLFunctionExpression { return newIdentifierFunc("ABS", col) }
+
+// POWER creates a new `POWER` sql function.
+//
+// POWER("a", 2) -> `POWER("a", 2)`
+// POWER(I("a"), I("b")) -> `POWER("a", "b")`
+func POWER(col, exponent interface{}) exp.SQLFunctionExpression {
+	if s, ok := col.(string); ok {
+		col = I(s)
+	}
+	return Func("POWER", col, exponent)
+}
+
+// MOD creates a new `MOD` sql function that returns the remainder of dividing col by divisor, dialects without a MOD
+// function (e.g. sqlserver) use the modulo operator instead.
+//
+// MOD("a", 2) -> `MOD("a", 2)`, `("a" % 2)` (e.g. sqlserver)
+// MOD(I("a"), I("b")) -> `MOD("a", "b")`
+func MOD(col, divisor interface{}) exp.SQLFunctionExpression {
+	if s, ok := col.(string); ok {
+		col = I(s)
+	}
+	return Func("MOD", col, divisor)
+}
+
+// SafeDivide divides numerator by denominator, the denominator is wrapped in NULLIF so dividing by zero returns NULL
+// instead of an error. Strings are treated as columns.
+//
+// SafeDivide("a", "b") -> `("a" / NULLIF("b", 0))`
+// SafeDivide(SUM("a"), COUNT("b")) -> `(SUM("a") / NULLIF(COUNT("b"), 0))`
+func SafeDivide(numerator, denominator interface{}) exp.LiteralExpression {
+	if s, ok := numerator.(string); ok {
+		numerator = I(s)
+	}
+	return L("(? / ?)", numerator, NULLIF(denominator, 0))
+}
+
 //nolint:stylecheck,golint // sql function name
 func ROW_NUMBER() exp.SQLFunctionExpression {
 	return Func("ROW_NUMBER")
@@ -162,6 +310,13 @@ func NTILE(n int) exp.SQLFunctionExpression {
 	return Func("NTILE", n)
 }
 
+// Random creates an expression for a random value using the random function of the dialect.
+//    Random() // RANDOM() in postgres and sqlite3, RAND() in mysql, NEWID() in sqlserver
+//    From("test").Where(Random().Lt(0.1)) // SELECT * FROM "test" WHERE (RANDOM() < 0.1)
+func Random() exp.RandomExpression {
+	return exp.NewRandomExpression()
+}
+
 //nolint:stylecheck,golint //sql function name
 func FIRST_VALUE(val interface{}) exp.SQLFunctionExpression {
 	return newIdentifierFunc("FIRST_VALUE", val)
@@ -265,6 +420,9 @@ func T(table string) exp.IdentifierExpression {
 // W("w").PartitionBy("a") -> `"w" AS (PARTITION BY "a")`
 // W("w", "w1").PartitionBy("a") -> `"w" AS ("w1" PARTITION BY "a")`
 // W("w", "w1").PartitionBy("a").OrderBy("b") -> `"w" AS ("w1" PARTITION BY "a" ORDER BY "b")`
+// W().OrderBy("b").Rows(Preceding(1), CurrentRow()) -> `(ORDER BY "b" ROWS BETWEEN 1 PRECEDING AND CURRENT ROW)`
+// W().OrderBy("b").Groups(Preceding(1), Following(1)).Exclude(ExcludeTies)
+//    -> `(ORDER BY "b" GROUPS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE TIES)`
 func W(ws ...string) exp.WindowExpression {
 	switch len(ws) {
 	case 0:
@@ -276,6 +434,44 @@ func W(ws ...string) exp.WindowExpression {
 	}
 }
 
+// UnboundedPreceding creates a window frame boundary that starts at the first row of the partition.
+//
+// UnboundedPreceding() -> `UNBOUNDED PRECEDING`
+func UnboundedPreceding() exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.UnboundedPrecedingBound, nil)
+}
+
+// Preceding creates a window frame boundary offset before the current row, the offset may be a value or an
+// expression.
+//
+// Preceding(1) -> `1 PRECEDING`
+// Preceding(L("INTERVAL '1 day'")) -> `INTERVAL '1 day' PRECEDING`
+func Preceding(offset interface{}) exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.PrecedingBound, offset)
+}
+
+// CurrentRow creates a window frame boundary at the current row.
+//
+// CurrentRow() -> `CURRENT ROW`
+func CurrentRow() exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.CurrentRowBound, nil)
+}
+
+// Following creates a window frame boundary offset after the current row, the offset may be a value or an
+// expression.
+//
+// Following(1) -> `1 FOLLOWING`
+func Following(offset interface{}) exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.FollowingBound, offset)
+}
+
+// UnboundedFollowing creates a window frame boundary that ends at the last row of the partition.
+//
+// UnboundedFollowing() -> `UNBOUNDED FOLLOWING`
+func UnboundedFollowing() exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.UnboundedFollowingBound, nil)
+}
+
 // On creates a new ON clause to be used within a join.
 //
 // ds.Join(goqu.T("my_table"), goqu.On( goqu.I("my_table.fkey").Eq(goqu.I("other_table.id"))))
@@ -313,6 +509,13 @@ func V(val interface{}) exp.LiteralExpression {
 	return exp.NewLiteralExpression("?", val)
 }
 
+// Secret wraps a value so that it is always bound as an argument and rendered as [REDACTED] when logged or formatted.
+//
+// goqu.From("users").Where(goqu.C("password").Eq(goqu.Secret(password)))
+func Secret(val interface{}) exp.SecretValue {
+	return exp.NewSecret(val)
+}
+
 // Range creates a new exp.RangeVal to be used with a Between expression.
 //
 // exp.C("col").Between(exp.Range(1, 10))
@@ -328,11 +531,242 @@ func Default() exp.LiteralExpression {
 	return exp.Default()
 }
 
+// EscapeLikePattern escapes the %, _ and \ characters of s with a backslash so a user supplied string is matched
+// literally by LIKE and ILIKE. Use Escape(`\`) on the expression for dialects without a default escape character
+// (e.g. sqlite3 and sqlserver).
+//    C("name").Like("%" + EscapeLikePattern("50%_off") + "%").Escape(`\`)
+//    // ("name" LIKE '%50\%\_off%' ESCAPE '\')
+func EscapeLikePattern(s string) string {
+	return likePatternEscaper.Replace(s)
+}
+
+// Exists creates an EXISTS condition that is true when the subquery returns any rows.
+//    From("users").Where(Exists(From("orders").Where(I("orders.user_id").Eq(I("users.id")))))
+//    // SELECT * FROM "users" WHERE EXISTS (SELECT * FROM "orders" WHERE ("orders"."user_id" = "users"."id"))
+func Exists(sub exp.AppendableExpression) exp.LiteralExpression {
+	return L("EXISTS ?", sub)
+}
+
+// NotExists creates a NOT EXISTS condition that is true when the subquery does not return any rows.
+func NotExists(sub exp.AppendableExpression) exp.LiteralExpression {
+	return L("NOT EXISTS ?", sub)
+}
+
 // Lateral returns a exp.LateralExpression for exp.AppendableExpression.
 func Lateral(table exp.AppendableExpression) exp.LateralExpression {
 	return exp.NewLateralExpression(table)
 }
 
+// Pivot returns a exp.PivotExpression that rotates the rows of table into columns (e.g. sqlserver, oracle, snowflake).
+//    From(Pivot(T("sales"), SUM("amount")).For("month").In("JAN", "FEB").As("p"))
+//    // SELECT * FROM "sales" PIVOT (SUM("amount") FOR "month" IN ('JAN', 'FEB')) AS "p"
+func Pivot(table exp.Expression, aggregates ...exp.Expression) exp.PivotExpression {
+	return exp.NewPivotExpression(table, aggregates...)
+}
+
+// Unpivot returns a exp.UnpivotExpression that rotates the columns of table into rows of valueCol
+// (e.g. sqlserver, oracle, snowflake).
+//    From(Unpivot(T("sales"), "amount").For("month").In("jan", "feb").As("u"))
+//    // SELECT * FROM "sales" UNPIVOT ("amount" FOR "month" IN ("jan", "feb")) AS "u"
+func Unpivot(table exp.Expression, valueCol string) exp.UnpivotExpression {
+	return exp.NewUnpivotExpression(table, valueCol)
+}
+
+// XMLTable returns a exp.XMLTableExpression that maps the nodes of an XML document matching rowPath to rows
+// (e.g. postgres, oracle, db2). If document is a string it is used as a column name.
+//    From(T("docs"), XMLTable("/rows/row", "data").Columns(XMLColumn("id", IntegerType()).Path("@id")).As("x"))
+//    // SELECT * FROM "docs", XMLTABLE('/rows/row' PASSING "data" COLUMNS "id" INTEGER PATH '@id') AS "x"
+func XMLTable(rowPath string, document interface{}) exp.XMLTableExpression {
+	if s, ok := document.(string); ok {
+		document = I(s)
+	}
+	return exp.NewXMLTableExpression(rowPath, document)
+}
+
+// XMLColumn returns a exp.XMLTableColumn of the given type for an XMLTable
+func XMLColumn(name string, dataType exp.DataType) exp.XMLTableColumn {
+	return exp.NewXMLTableColumn(name, dataType)
+}
+
+// XMLOrdinalityColumn returns a exp.XMLTableColumn that numbers the rows of an XMLTable
+func XMLOrdinalityColumn(name string) exp.XMLTableColumn {
+	return exp.NewXMLTableOrdinalityColumn(name)
+}
+
+// JSON returns a exp.JSONAccessor to build JSON operations on a JSON value (e.g. postgres ->, mysql JSON_EXTRACT), a
+// string is treated as a column and any other value that is not an expression is used as a value.
+//    Where(JSON("data").GetText("status").Eq("active"), JSON("data").Contains(`{"admin": true}`))
+//    // postgres: WHERE ((("data" ->> 'status') = 'active') AND ("data" @> '{"admin": true}'))
+//    // mysql: WHERE ((JSON_UNQUOTE(JSON_EXTRACT(`data`, '$.status')) = 'active') AND JSON_CONTAINS(`data`, ...))
+func JSON(value interface{}) exp.JSONAccessor {
+	switch t := value.(type) {
+	case string:
+		return exp.NewJSONAccessor(I(t))
+	case exp.Expression:
+		return exp.NewJSONAccessor(t)
+	}
+	return exp.NewJSONAccessor(V(value))
+}
+
+// JSONPathQuery returns the values of target matched by a JSON path (e.g. postgres jsonb_path_query, mysql
+// JSON_EXTRACT), a string target is treated as a column.
+//    JSONPathQuery("data", "$.tags[*]") // jsonb_path_query("data", '$.tags[*]')
+func JSONPathQuery(target interface{}, path string) exp.JSONFunctionExpression {
+	return exp.NewJSONFunctionExpression(exp.JSONPathQueryFunc, jsonTarget(target), path)
+}
+
+// JSONSet sets the value at path in target (e.g. postgres jsonb_set, mysql JSON_SET), a string target is treated as a
+// column. Maps, structs and slices are serialized to JSON.
+//    Update("users").Set(Record{"profile": JSONSet("profile", []string{"address"}, map[string]string{"city": "Kyiv"})})
+//    // postgres: UPDATE "users" SET "profile"=jsonb_set("profile", '{"address"}', CAST('{"city":"Kyiv"}' AS JSONB))
+//    // mysql: UPDATE `users` SET `profile`=JSON_SET(`profile`, '$.address', CAST('{\"city\":\"Kyiv\"}' AS JSON))
+func JSONSet(target interface{}, path []string, value interface{}) exp.JSONFunctionExpression {
+	return exp.NewJSONFunctionExpression(exp.JSONSetFunc, jsonTarget(target), path, value)
+}
+
+// JSONBuildObject builds a JSON object from alternating keys and values (e.g. postgres jsonb_build_object, mysql
+// JSON_OBJECT). Maps, structs and slices are serialized to JSON.
+//    JSONBuildObject("id", I("users.id"), "name", I("users.name"))
+//    // jsonb_build_object('id', "users"."id", 'name', "users"."name")
+func JSONBuildObject(keyValues ...interface{}) exp.JSONFunctionExpression {
+	return exp.NewJSONFunctionExpression(exp.JSONBuildObjectFunc, keyValues...)
+}
+
+// JSONAgg aggregates values into a JSON array (e.g. postgres json_agg, mysql JSON_ARRAYAGG), a string is treated as a
+// column.
+//    JSONAgg("name") // json_agg("name")
+func JSONAgg(val interface{}) exp.JSONFunctionExpression {
+	return exp.NewJSONFunctionExpression(exp.JSONAggFunc, jsonTarget(val))
+}
+
+// JSONObjectAgg aggregates keys and values into a JSON object (e.g. postgres json_object_agg, mysql JSON_OBJECTAGG),
+// strings are treated as columns.
+//    JSONObjectAgg("key", "value") // json_object_agg("key", "value")
+func JSONObjectAgg(key, val interface{}) exp.JSONFunctionExpression {
+	return exp.NewJSONFunctionExpression(exp.JSONObjectAggFunc, jsonTarget(key), jsonTarget(val))
+}
+
+func jsonTarget(val interface{}) interface{} {
+	if s, ok := val.(string); ok {
+		return I(s)
+	}
+	return val
+}
+
+// Array returns a exp.ArrayAccessor to build array operations and subscripts (e.g. postgres @>, &&, "tags"[1]), a
+// string is treated as a column and any other value that is not an expression is used as a value.
+//    Where(Array("tags").Overlaps([]string{"go", "sql"}), Array("tags").Index(1).Eq("go"))
+//    // WHERE (("tags" && '{"go", "sql"}') AND ("tags"[1] = 'go'))
+func Array(value interface{}) exp.ArrayAccessor {
+	switch t := value.(type) {
+	case string:
+		return exp.NewArrayAccessor(I(t))
+	case exp.Expression:
+		return exp.NewArrayAccessor(t)
+	}
+	return exp.NewArrayAccessor(V(value))
+}
+
+// ArrayOf returns a exp.ArrayConstructorExpression that builds an array from values (e.g. postgres ARRAY[...]).
+//    ArrayOf(1, 2, I("a")) // ARRAY[1, 2, "a"]
+func ArrayOf(values ...interface{}) exp.ArrayConstructorExpression {
+	return exp.NewArrayConstructor(values...)
+}
+
+// RangeOf returns a exp.RangeTypeAccessor to build range operations (e.g. postgres @>, &&, -|-), a string is treated
+// as a column and any other value that is not an expression is used as a value.
+//    Where(RangeOf("during").Overlaps(TsTzRange("2024-01-01 10:00", "2024-01-01 11:00")))
+//    // WHERE ("during" && tstzrange('2024-01-01 10:00', '2024-01-01 11:00'))
+func RangeOf(value interface{}) exp.RangeTypeAccessor {
+	switch t := value.(type) {
+	case string:
+		return exp.NewRangeTypeAccessor(I(t))
+	case exp.Expression:
+		return exp.NewRangeTypeAccessor(t)
+	}
+	return exp.NewRangeTypeAccessor(V(value))
+}
+
+// Int4Range creates an int4range, nil bounds are unbounded.
+//    Int4Range(1, 10) // int4range(1, 10)
+//    Int4Range(1, 10).WithBounds("[]") // int4range(1, 10, '[]')
+func Int4Range(lower, upper interface{}) exp.RangeLiteralExpression {
+	return exp.NewRangeLiteral("int4range", lower, upper)
+}
+
+// Int8Range creates an int8range, nil bounds are unbounded.
+func Int8Range(lower, upper interface{}) exp.RangeLiteralExpression {
+	return exp.NewRangeLiteral("int8range", lower, upper)
+}
+
+// NumRange creates a numrange, nil bounds are unbounded.
+func NumRange(lower, upper interface{}) exp.RangeLiteralExpression {
+	return exp.NewRangeLiteral("numrange", lower, upper)
+}
+
+// TsRange creates a tsrange, nil bounds are unbounded.
+func TsRange(lower, upper interface{}) exp.RangeLiteralExpression {
+	return exp.NewRangeLiteral("tsrange", lower, upper)
+}
+
+// TsTzRange creates a tstzrange, nil bounds are unbounded.
+//    TsTzRange(C("starts_at"), C("ends_at")) // tstzrange("starts_at", "ends_at")
+func TsTzRange(lower, upper interface{}) exp.RangeLiteralExpression {
+	return exp.NewRangeLiteral("tstzrange", lower, upper)
+}
+
+// DateRange creates a daterange, nil bounds are unbounded.
+func DateRange(lower, upper interface{}) exp.RangeLiteralExpression {
+	return exp.NewRangeLiteral("daterange", lower, upper)
+}
+
+// Interval creates an interval written in the syntax of the dialect, the quantity is always written in the SQL.
+//    Interval(3, Days) // INTERVAL '3' DAY
+//    Interval(3, Days) // postgres INTERVAL '3 days', mysql INTERVAL 3 DAY
+func Interval(quantity int64, unit exp.IntervalUnit) exp.IntervalExpression {
+	return exp.NewIntervalExpression(quantity, unit)
+}
+
+// DateOf creates a exp.DateAccessor to add intervals to or subtract intervals from a date, if the value is a string
+// it is treated as a column.
+//    DateOf("created_at").Add(Interval(3, Days)) // postgres ("created_at" + INTERVAL '3 days')
+//    DateOf(L("NOW()")).Sub(Interval(1, Hours)) // mysql (NOW() - INTERVAL 1 HOUR)
+//    DateOf("created_at").Add(Interval(3, Days)) // sqlserver DATEADD(DAY, 3, "created_at")
+func DateOf(value interface{}) exp.DateAccessor {
+	switch t := value.(type) {
+	case string:
+		return exp.NewDateAccessor(I(t))
+	case exp.Expression:
+		return exp.NewDateAccessor(t)
+	}
+	return exp.NewDateAccessor(V(value))
+}
+
+// RowsFrom returns a exp.TableFunctionExpression that combines the results of several set returning functions into
+// one table (e.g. postgres).
+//    From(RowsFrom(Func("generate_series", 1, 3), Func("unnest", L("'{a,b}'::text[]"))).As("t").Columns("n", "v"))
+//    // SELECT * FROM ROWS FROM (generate_series(1, 3), unnest('{a,b}'::text[])) AS "t"("n", "v")
+func RowsFrom(functions ...exp.SQLFunctionExpression) exp.TableFunctionExpression {
+	return exp.NewTableFunctionExpression(functions...)
+}
+
+// HintTable returns a exp.TableHintExpression that adds hints to a table in a FROM or a JOIN (e.g. postgres ONLY,
+// mysql index hints, sqlserver table hints).
+//    From(HintTable(T("users").As("u")).UseIndex("idx_email")) // mysql
+//    // SELECT * FROM `users` AS `u` USE INDEX (`idx_email`)
+//    From("orders").Join(HintTable(T("users")).With("NOLOCK"), On(...)) // sqlserver
+//    // SELECT * FROM "orders" INNER JOIN "users" WITH (NOLOCK) ON (...)
+func HintTable(table exp.Expression) exp.TableHintExpression {
+	return exp.NewTableHintExpression(table)
+}
+
+// Values returns a exp.ValuesExpression that can be used as a table, each row must have the same number of values.
+//    From(Values([]interface{}{1, "a"}, []interface{}{2, "b"}).As("v").Columns("id", "name"))
+//    // SELECT * FROM (VALUES (1, 'a'), (2, 'b')) AS "v"("id", "name")
+func Values(rows ...[]interface{}) exp.ValuesExpression {
+	return exp.NewValuesExpression(rows...)
+}
+
 // Any creates a new `ANY` comparison.
 func Any(val interface{}) exp.SQLFunctionExpression {
 	return Func("ANY ", val)
@@ -347,3 +781,328 @@ func All(val interface{}) exp.SQLFunctionExpression {
 func Case() exp.CaseExpression {
 	return exp.NewCaseExpression()
 }
+
+// ColumnDef creates a new exp.ColumnDefinition used to define a column in a CREATE TABLE statement.
+//    ColumnDef("id", BigIntType()).PrimaryKey().AutoIncrement() // "id" BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY
+//    ColumnDef("name", VarcharType(255)).NotNull().Default("") // "name" VARCHAR(255) DEFAULT '' NOT NULL
+func ColumnDef(name string, dataType exp.DataType) exp.ColumnDefinition {
+	return exp.NewColumnDefinition(name, dataType)
+}
+
+// SmallIntType creates a SMALLINT data type.
+func SmallIntType() exp.DataType {
+	return exp.NewDataType(exp.SmallIntDataType)
+}
+
+// IntegerType creates an INTEGER data type.
+func IntegerType() exp.DataType {
+	return exp.NewDataType(exp.IntegerDataType)
+}
+
+// BigIntType creates a BIGINT data type.
+func BigIntType() exp.DataType {
+	return exp.NewDataType(exp.BigIntDataType)
+}
+
+// DecimalType creates a DECIMAL data type with a precision and scale (e.g. DECIMAL(10, 2)).
+func DecimalType(precision, scale int) exp.DataType {
+	return exp.NewDataType(exp.DecimalDataType, precision, scale)
+}
+
+// RealType creates a REAL data type.
+func RealType() exp.DataType {
+	return exp.NewDataType(exp.RealDataType)
+}
+
+// DoubleType creates a DOUBLE PRECISION data type.
+func DoubleType() exp.DataType {
+	return exp.NewDataType(exp.DoubleDataType)
+}
+
+// BooleanType creates a BOOLEAN data type.
+func BooleanType() exp.DataType {
+	return exp.NewDataType(exp.BooleanDataType)
+}
+
+// CharType creates a CHAR data type with a size (e.g. CHAR(2)).
+func CharType(size int) exp.DataType {
+	return exp.NewDataType(exp.CharDataType, size)
+}
+
+// VarcharType creates a VARCHAR data type with a size (e.g. VARCHAR(255)).
+func VarcharType(size int) exp.DataType {
+	return exp.NewDataType(exp.VarcharDataType, size)
+}
+
+// TextType creates a TEXT data type.
+func TextType() exp.DataType {
+	return exp.NewDataType(exp.TextDataType)
+}
+
+// DateType creates a DATE data type.
+func DateType() exp.DataType {
+	return exp.NewDataType(exp.DateDataType)
+}
+
+// TimeType creates a TIME data type.
+func TimeType() exp.DataType {
+	return exp.NewDataType(exp.TimeDataType)
+}
+
+// TimestampType creates a TIMESTAMP data type.
+func TimestampType() exp.DataType {
+	return exp.NewDataType(exp.TimestampDataType)
+}
+
+// TimestampTzType creates a TIMESTAMP WITH TIME ZONE data type.
+func TimestampTzType() exp.DataType {
+	return exp.NewDataType(exp.TimestampTzDataType)
+}
+
+// BinaryType creates a binary data type (e.g. BLOB, BYTEA).
+func BinaryType() exp.DataType {
+	return exp.NewDataType(exp.BinaryDataType)
+}
+
+// UUIDType creates a UUID data type.
+func UUIDType() exp.DataType {
+	return exp.NewDataType(exp.UUIDDataType)
+}
+
+// JSONType creates a JSON data type.
+func JSONType() exp.DataType {
+	return exp.NewDataType(exp.JSONDataType)
+}
+
+// CustomType creates a data type that is written as is.
+//    CustomType("CITEXT") // CITEXT
+func CustomType(name string) exp.DataType {
+	return exp.NewCustomDataType(name)
+}
+
+// PrimaryKey creates a PRIMARY KEY table constraint.
+//    PrimaryKey("user_id", "role_id") // PRIMARY KEY ("user_id", "role_id")
+func PrimaryKey(cols ...string) exp.TableConstraint {
+	return exp.NewPrimaryKeyConstraint(stringsToInterfaces(cols)...)
+}
+
+// Unique creates a UNIQUE table constraint.
+//    Unique("email").Named("user_email_uniq") // CONSTRAINT "user_email_uniq" UNIQUE ("email")
+func Unique(cols ...string) exp.TableConstraint {
+	return exp.NewUniqueConstraint(stringsToInterfaces(cols)...)
+}
+
+// ForeignKey creates a FOREIGN KEY table constraint, use References to set the referenced table and columns.
+//    ForeignKey("user_id").References("user", "id").OnDelete(exp.CascadeReferentialAction)
+//    // FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE
+func ForeignKey(cols ...string) exp.TableConstraint {
+	return exp.NewForeignKeyConstraint(stringsToInterfaces(cols)...)
+}
+
+// Check creates a CHECK table constraint from an expression.
+//    Check(C("age").Gte(0)).Named("user_age_check") // CONSTRAINT "user_age_check" CHECK ("age" >= 0)
+func Check(expression exp.Expression) exp.TableConstraint {
+	return exp.NewCheckConstraint(expression)
+}
+
+// Exclude creates an EXCLUDE table constraint (e.g. postgres), the default index method is used if the method is
+// empty.
+//    Exclude("gist", ExcludeWith("room_id", "="), ExcludeWith("during", "&&"))
+//    // EXCLUDE USING gist ("room_id" WITH =, "during" WITH &&)
+func Exclude(method string, elements ...exp.ExcludeElement) exp.TableConstraint {
+	return exp.NewExcludeConstraint(method, elements...)
+}
+
+// ExcludeWith creates an element of an EXCLUDE constraint, strings are turned into identifiers.
+//    ExcludeWith("during", "&&") // "during" WITH &&
+func ExcludeWith(col interface{}, operator string) exp.ExcludeElement {
+	return exp.NewExcludeElement(col, operator)
+}
+
+// PartitionByRange creates a PARTITION BY RANGE clause, see CreateTableDataset#PartitionBy.
+//    PartitionByRange("created_at") // PARTITION BY RANGE ("created_at")
+func PartitionByRange(cols ...interface{}) exp.PartitionBy {
+	return exp.NewPartitionBy(exp.RangePartition, cols...)
+}
+
+// PartitionByList creates a PARTITION BY LIST clause, see CreateTableDataset#PartitionBy.
+//    PartitionByList("region") // PARTITION BY LIST ("region")
+func PartitionByList(cols ...interface{}) exp.PartitionBy {
+	return exp.NewPartitionBy(exp.ListPartition, cols...)
+}
+
+// PartitionByHash creates a PARTITION BY HASH clause, see CreateTableDataset#PartitionBy.
+//    PartitionByHash("id").Partitions(4) // mysql: PARTITION BY HASH (`id`) PARTITIONS 4
+func PartitionByHash(cols ...interface{}) exp.PartitionBy {
+	return exp.NewPartitionBy(exp.HashPartition, cols...)
+}
+
+// Partition creates a partition that is defined in a CREATE or ALTER TABLE statement (e.g. mysql), the bound is nil
+// for HASH partitions.
+//    Partition("p2023", ValuesLessThan(2024)) // PARTITION `p2023` VALUES LESS THAN (2024)
+func Partition(name string, bound exp.PartitionBound) exp.PartitionDefinition {
+	return exp.NewPartitionDefinition(exp.ParseIdentifier(name), bound)
+}
+
+// ValuesFromTo creates the bound of a RANGE partition from a value (inclusive) to a value (exclusive).
+//    ValuesFromTo("2024-01-01", "2025-01-01") // FOR VALUES FROM ('2024-01-01') TO ('2025-01-01')
+func ValuesFromTo(from, to interface{}) exp.PartitionBound {
+	return exp.NewRangePartitionBound([]interface{}{from}, []interface{}{to})
+}
+
+// ValuesLessThan creates the bound of a RANGE partition for the values less than the values.
+//    ValuesLessThan(2024) // VALUES LESS THAN (2024)
+//    ValuesLessThan(L("MAXVALUE")) // VALUES LESS THAN (MAXVALUE)
+func ValuesLessThan(vals ...interface{}) exp.PartitionBound {
+	return exp.NewLessThanPartitionBound(vals...)
+}
+
+// ValuesIn creates the bound of a LIST partition.
+//    ValuesIn("eu", "uk") // FOR VALUES IN ('eu', 'uk')
+func ValuesIn(vals ...interface{}) exp.PartitionBound {
+	return exp.NewListPartitionBound(vals...)
+}
+
+// ValuesWithModulus creates the bound of a HASH partition.
+//    ValuesWithModulus(4, 0) // FOR VALUES WITH (MODULUS 4, REMAINDER 0)
+func ValuesWithModulus(modulus, remainder int) exp.PartitionBound {
+	return exp.NewHashPartitionBound(modulus, remainder)
+}
+
+// DefaultPartition creates the bound of a partition for the values that do not fit in any other partition.
+//    DefaultPartition() // DEFAULT
+func DefaultPartition() exp.PartitionBound {
+	return exp.NewDefaultPartitionBound()
+}
+
+// NextVal creates an expression for the next value of a sequence, it can be used as a value in an insert.
+//    NextVal("user_id_seq") // nextval('"user_id_seq"'), NEXT VALUE FOR "user_id_seq" in sqlserver
+func NextVal(sequence string) exp.SequenceValueExpression {
+	return exp.NewSequenceValueExpression(exp.ParseIdentifier(sequence), exp.NextSequenceValue)
+}
+
+// CurrVal creates an expression for the current value of a sequence in the session.
+//    CurrVal("user_id_seq") // currval('"user_id_seq"')
+func CurrVal(sequence string) exp.SequenceValueExpression {
+	return exp.NewSequenceValueExpression(exp.ParseIdentifier(sequence), exp.CurrentSequenceValue)
+}
+
+// FetchFirst creates a FETCH FIRST limit that can be passed to SelectDataset#Fetch.
+//    FetchFirst(10)            // FETCH FIRST 10 ROWS ONLY
+//    FetchFirst(10).WithTies() // FETCH FIRST 10 ROWS WITH TIES
+//    FetchFirst(10).Percent()  // FETCH FIRST 10 PERCENT ROWS ONLY
+func FetchFirst(count uint) exp.FetchExpression {
+	return exp.NewFetchExpression(count)
+}
+
+// Rollup creates a ROLLUP grouping that can be used in a GROUP BY clause to generate subtotals. In mysql it is written
+// as WITH ROLLUP so it must be the last element of the GROUP BY clause.
+//    From("sales").GroupBy(Rollup("region", "product")) // GROUP BY ROLLUP ("region", "product")
+func Rollup(cols ...interface{}) exp.GroupingExpression {
+	return exp.NewRollupExpression(cols...)
+}
+
+// Cube creates a CUBE grouping that can be used in a GROUP BY clause to generate subtotals for every combination of
+// the columns.
+//    From("sales").GroupBy(Cube("region", "product")) // GROUP BY CUBE ("region", "product")
+func Cube(cols ...interface{}) exp.GroupingExpression {
+	return exp.NewCubeExpression(cols...)
+}
+
+// GroupingSets creates a GROUPING SETS grouping that can be used in a GROUP BY clause. Each set may be a
+// []interface{} of columns, a single column, or nil for the empty (grand total) set.
+//    From("sales").GroupBy(GroupingSets([]interface{}{"region", "product"}, "region", nil))
+//    // GROUP BY GROUPING SETS (("region", "product"), ("region"), ())
+func GroupingSets(sets ...interface{}) exp.GroupingExpression {
+	return exp.NewGroupingSetsExpression(sets...)
+}
+
+// GROUPING creates a new `GROUPING` sql function that can be used to tell subtotal rows apart when grouping by a
+// Rollup, Cube or GroupingSets.
+//
+// GROUPING("a") -> `GROUPING("a")`
+// GROUPING("a", "b") -> `GROUPING("a", "b")`
+func GROUPING(cols ...interface{}) exp.SQLFunctionExpression {
+	args := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if s, ok := col.(string); ok {
+			col = I(s)
+		}
+		args = append(args, col)
+	}
+	return Func("GROUPING", args...)
+}
+
+// SearchDepthFirst creates a SEARCH DEPTH FIRST clause that can be passed to WithRecursive, use Set to name the column
+// added to order the rows.
+//    WithRecursive("tree(id, parent_id)", ds, SearchDepthFirst("id").Set("ord"))
+//    // WITH RECURSIVE tree(id, parent_id) AS (...) SEARCH DEPTH FIRST BY "id" SET "ord"
+func SearchDepthFirst(by ...interface{}) exp.CTESearchExpression {
+	return exp.NewCTESearchExpression(exp.DepthFirstSearch, by...)
+}
+
+// SearchBreadthFirst creates a SEARCH BREADTH FIRST clause that can be passed to WithRecursive, use Set to name the
+// column added to order the rows.
+//    WithRecursive("tree(id, parent_id)", ds, SearchBreadthFirst("id").Set("ord"))
+//    // WITH RECURSIVE tree(id, parent_id) AS (...) SEARCH BREADTH FIRST BY "id" SET "ord"
+func SearchBreadthFirst(by ...interface{}) exp.CTESearchExpression {
+	return exp.NewCTESearchExpression(exp.BreadthFirstSearch, by...)
+}
+
+// Cycle creates a CYCLE clause that can be passed to WithRecursive to stop the recursion when the columns repeat.
+//    WithRecursive("tree(id, parent_id)", ds, Cycle("id").Set("is_cycle").Using("path"))
+//    // WITH RECURSIVE tree(id, parent_id) AS (...) CYCLE "id" SET "is_cycle" USING "path"
+func Cycle(cols ...interface{}) exp.CTECycleExpression {
+	return exp.NewCTECycleExpression(cols...)
+}
+
+// Recursive creates a recursive common table expression that can be passed to WithCTE. The step is called with an
+// identifier for the CTE so it can reference the rows of the previous iteration, the result is combined with base
+// using UNION (or UNION ALL if unionAll is true) and cols are used as the column list of the CTE.
+//    Recursive("nums", []string{"n"}, From().Select(L("1")), func(self exp.IdentifierExpression) *SelectDataset {
+//        return From(self).Select(L("? + 1", self.Col("n"))).Where(self.Col("n").Lt(10))
+//    }, true)
+//    // WITH RECURSIVE nums(n) AS (SELECT 1 UNION ALL (SELECT "nums"."n" + 1 FROM "nums" WHERE ("nums"."n" < 10)))
+func Recursive(
+	name string,
+	cols []string,
+	base *SelectDataset,
+	step func(self exp.IdentifierExpression) *SelectDataset,
+	unionAll bool,
+) exp.CommonTableExpression {
+	next := step(T(name))
+	var subquery *SelectDataset
+	if unionAll {
+		subquery = base.UnionAll(next)
+	} else {
+		subquery = base.Union(next)
+	}
+	if len(cols) > 0 {
+		name = fmt.Sprintf("%s(%s)", name, strings.Join(cols, ", "))
+	}
+	return exp.NewCommonTableExpression(true, name, subquery)
+}
+
+// newRecursiveCommonTable creates a recursive CTE with the SEARCH and CYCLE clauses passed to WithRecursive.
+func newRecursiveCommonTable(name string, subquery exp.Expression, clauses []exp.Expression) exp.CommonTableExpression {
+	cte := exp.NewCommonTableExpression(true, name, subquery)
+	for _, c := range clauses {
+		switch t := c.(type) {
+		case exp.CTESearchExpression:
+			cte = cte.SetSearch(t)
+		case exp.CTECycleExpression:
+			cte = cte.SetCycle(t)
+		default:
+			panic(ErrUnsupportedRecursiveCTEClause)
+		}
+	}
+	return cte
+}
+
+func stringsToInterfaces(ss []string) []interface{} {
+	is := make([]interface{}, 0, len(ss))
+	for _, s := range ss {
+		is = append(is, s)
+	}
+	return is
+}
@@ -4,8 +4,10 @@ package goqu_test
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlserver"
 	"github.com/doug-martin/goqu/v9/exp"
 )
 
@@ -299,6 +301,38 @@ func ExampleC_likeComparisons() {
 	// SELECT * FROM "test" WHERE ("a" !~* '[ab]')
 }
 
+func ExampleEscapeLikePattern() {
+	search := "50%_off"
+	ds := goqu.From("test").Where(goqu.C("name").Like("%" + goqu.EscapeLikePattern(search) + "%").Escape(`\`))
+
+	sql, _, _ := ds.ToSQL()
+	fmt.Println(sql)
+
+	sql, args, _ := ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE ("name" LIKE '%50\%\_off%' ESCAPE '\')
+	// SELECT * FROM "test" WHERE ("name" LIKE ? ESCAPE ?) [%50\%\_off% \]
+}
+
+func ExampleC_isDistinctFrom() {
+	ds := goqu.From("test").Where(
+		goqu.C("a").IsDistinctFrom(goqu.C("b")),
+		goqu.C("c").IsNotDistinctFrom(nil),
+	)
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	// mysql uses the NULL safe equality operator
+	sql, args, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE (("a" IS DISTINCT FROM "b") AND ("c" IS NOT DISTINCT FROM NULL)) []
+	// SELECT * FROM `test` WHERE (NOT (`a` <=> `b`) AND (`c` IS NULL)) []
+}
+
 func ExampleC_isComparisons() {
 	sql, args, _ := goqu.From("test").Where(goqu.C("a").Is(nil)).ToSQL()
 	fmt.Println(sql, args)
@@ -400,6 +434,46 @@ func ExampleCOALESCE_as() {
 	// SELECT COALESCE("a", 'a') AS "a" FROM "test"
 }
 
+func ExampleROUND() {
+	ds := goqu.From("orders").
+		Select(
+			goqu.ROUND("total"),
+			goqu.ROUND(goqu.AVG("total"), 2).As("avg_total"),
+			goqu.CEIL("weight"),
+			goqu.FLOOR("weight"),
+			goqu.ABS(goqu.C("balance")),
+			goqu.POWER("rate", 2),
+			goqu.MOD("id", 10),
+		).
+		GroupBy("total", "weight", "balance", "rate", "id")
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+	// Output:
+	// SELECT ROUND("total"), ROUND(AVG("total"), 2) AS "avg_total", CEIL("weight"), FLOOR("weight"), ABS("balance"), POWER("rate", 2), MOD("id", 10) FROM "orders" GROUP BY "total", "weight", "balance", "rate", "id" []
+	// SELECT ROUND("total"), ROUND(AVG("total"), ?) AS "avg_total", CEIL("weight"), FLOOR("weight"), ABS("balance"), POWER("rate", ?), MOD("id", ?) FROM "orders" GROUP BY "total", "weight", "balance", "rate", "id" [2 2 10]
+}
+
+func ExampleSafeDivide() {
+	ds := goqu.From("orders").
+		Select(
+			"user_id",
+			goqu.ROUND(goqu.SafeDivide(goqu.SUM("total"), goqu.COUNT("id")), 2).As("avg_total"),
+		).
+		Where(goqu.SafeDivide("refunded", "total").Gt(0.5)).
+		GroupBy("user_id")
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+	// Output:
+	// SELECT "user_id", ROUND((SUM("total") / NULLIF(COUNT("id"), 0)), 2) AS "avg_total" FROM "orders" WHERE (("refunded" / NULLIF("total", 0)) > 0.5) GROUP BY "user_id" []
+	// SELECT "user_id", ROUND((SUM("total") / NULLIF(COUNT("id"), ?)), ?) AS "avg_total" FROM "orders" WHERE (("refunded" / NULLIF("total", ?)) > ?) GROUP BY "user_id" [0 2 0 0.5]
+}
+
 func ExampleCOUNT() {
 	ds := goqu.From("test").Select(goqu.COUNT("*"))
 	sql, args, _ := ds.ToSQL()
@@ -438,6 +512,56 @@ func ExampleCOUNT_havingClause() {
 	// SELECT COUNT("a") AS "COUNT" FROM "test" GROUP BY "a" HAVING (COUNT("a") > ?) [10]
 }
 
+func ExampleCOUNT_filter() {
+	ds := goqu.
+		From("orders").
+		Select(
+			"user_id",
+			goqu.COUNT("*").Filter(goqu.C("status").Eq("paid")).As("paid"),
+			goqu.SUM("total").Filter(goqu.C("status").Eq("paid"), goqu.C("total").Gt(100)).As("large_paid_total"),
+		).
+		GroupBy("user_id")
+
+	sql, args, _ := ds.WithDialect("postgres").ToSQL()
+	fmt.Println(sql, args)
+
+	// mysql does not support FILTER so the aggregates are rewritten using CASE
+	sql, args, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT "user_id", COUNT(*) FILTER (WHERE ("status" = 'paid')) AS "paid", SUM("total") FILTER (WHERE (("status" = 'paid') AND ("total" > 100))) AS "large_paid_total" FROM "orders" GROUP BY "user_id" []
+	// SELECT `user_id`, SUM(CASE  WHEN (`status` = 'paid') THEN 1 ELSE 0 END) AS `paid`, SUM(CASE  WHEN ((`status` = 'paid') AND (`total` > 100)) THEN `total` END) AS `large_paid_total` FROM `orders` GROUP BY `user_id` []
+}
+
+func ExampleCOUNT_distinct() {
+	ds := goqu.
+		From("orders").
+		Select(
+			goqu.COUNT(goqu.DISTINCT("user_id")).As("users"),
+			goqu.SUM("total").Distinct().As("distinct_totals"),
+		)
+
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT COUNT(DISTINCT "user_id") AS "users", SUM(DISTINCT "total") AS "distinct_totals" FROM "orders" []
+}
+
+func ExampleFunc_orderBy() {
+	ds := goqu.
+		From("orders").
+		Select("user_id", goqu.ARRAY_AGG("id").OrderBy(goqu.C("created").Desc()).As("ids")).
+		GroupBy("user_id")
+
+	sql, args, _ := ds.WithDialect("postgres").ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT "user_id", ARRAY_AGG("id" ORDER BY "created" DESC) AS "ids" FROM "orders" GROUP BY "user_id" []
+}
+
 func ExampleCast() {
 	sql, _, _ := goqu.From("test").
 		Select(goqu.Cast(goqu.C("json1"), "TEXT").As("json_text")).
@@ -593,6 +717,28 @@ func ExampleFunc() {
 	// SELECT str_agg("col", |) FROM "test"
 }
 
+func ExampleRegisterFunction() {
+	goqu.RegisterFunction("default", goqu.FuncDef("char_length", 1, 1))
+	goqu.RegisterFunction("sqlserver", goqu.FuncDef("char_length", 1, 1).WithSQLName("LEN"))
+	defer goqu.DeregisterFunction("default", "char_length")
+	defer goqu.DeregisterFunction("sqlserver", "char_length")
+
+	ds := goqu.From("test").Select(goqu.Func("char_length", goqu.C("name")))
+	sql, _, _ := ds.ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = ds.WithDialect("sqlserver").ToSQL()
+	fmt.Println(sql)
+
+	_, _, err := goqu.From("test").Select(goqu.Func("char_length", goqu.C("name"), goqu.C("title"))).ToSQL()
+	fmt.Println(err)
+
+	// Output:
+	// SELECT char_length("name") FROM "test"
+	// SELECT LEN("name") FROM "test"
+	// goqu: function char_length expects 1 arguments but got 2 [dialect=default]
+}
+
 func ExampleI() {
 	ds := goqu.From("test").
 		Select(
@@ -1738,6 +1884,301 @@ func ExampleW() {
 	// SELECT ROW_NUMBER() OVER ("w" ORDER BY "b") FROM "test" WINDOW "w" AS (PARTITION BY "a") []
 }
 
+func ExampleValues() {
+	ds := goqu.From(
+		goqu.Values([]interface{}{1, "a"}, []interface{}{2, "b"}).As("v").Columns("id", "name"),
+	).Join(goqu.T("items"), goqu.On(goqu.I("items.id").Eq(goqu.I("v.id"))))
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+
+	query, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(query, args)
+
+	// Output:
+	// SELECT * FROM (VALUES (1, 'a'), (2, 'b')) AS "v"("id", "name") INNER JOIN "items" ON ("items"."id" = "v"."id") []
+	// SELECT * FROM (VALUES (?, ?), (?, ?)) AS "v"("id", "name") INNER JOIN "items" ON ("items"."id" = "v"."id") [1 a 2 b]
+}
+
+func ExamplePivot() {
+	src := goqu.Dialect("sqlserver").From("sales").Select("month", "amount").As("s")
+	ds := goqu.Dialect("sqlserver").
+		From(goqu.Pivot(src, goqu.SUM("amount")).For("month").In(goqu.I("JAN"), goqu.I("FEB")).As("p")).
+		Select("JAN", "FEB")
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+
+	// Output:
+	// SELECT "JAN", "FEB" FROM (SELECT "month", "amount" FROM "sales") AS "s" PIVOT (SUM("amount") FOR "month" IN ("JAN", "FEB")) AS "p" []
+}
+
+func ExampleUnpivot() {
+	ds := goqu.Dialect("sqlserver").
+		From(goqu.Unpivot(goqu.T("sales"), "amount").For("month").In("JAN", "FEB").As("u")).
+		Select("month", "amount")
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+
+	// Output:
+	// SELECT "month", "amount" FROM "sales" UNPIVOT ("amount" FOR "month" IN ("JAN", "FEB")) AS "u" []
+}
+
+func ExampleJSON() {
+	ds := goqu.From("users").
+		Select(goqu.JSON("profile").GetPathText("address", "city").As("city")).
+		Where(
+			goqu.JSON("profile").GetText("status").Eq("active"),
+			goqu.JSON("profile").Contains(`{"admin": true}`),
+		)
+
+	query, _, _ := ds.WithDialect("postgres").ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(query)
+
+	// Output:
+	// SELECT ("profile" #>> '{"address", "city"}') AS "city" FROM "users" WHERE ((("profile" ->> 'status') = 'active') AND ("profile" @> '{"admin": true}'))
+	// SELECT JSON_UNQUOTE(JSON_EXTRACT(`profile`, '$.address.city')) AS `city` FROM `users` WHERE ((JSON_UNQUOTE(JSON_EXTRACT(`profile`, '$.status')) = 'active') AND JSON_CONTAINS(`profile`, '{\"admin\": true}'))
+}
+
+func ExampleJSONSet() {
+	type address struct {
+		City string `json:"city"`
+	}
+	ds := goqu.Update("users").
+		Set(goqu.Record{"profile": goqu.JSONSet("profile", []string{"address"}, address{City: "Kyiv"})}).
+		Where(goqu.C("id").Eq(1))
+
+	query, _, _ := ds.WithDialect("postgres").ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(query)
+
+	// Output:
+	// UPDATE "users" SET "profile"=jsonb_set("profile", '{"address"}', CAST('{"city":"Kyiv"}' AS JSONB)) WHERE ("id" = 1)
+	// UPDATE `users` SET `profile`=JSON_SET(`profile`, '$.address', CAST('{\"city\":\"Kyiv\"}' AS JSON)) WHERE (`id` = 1)
+}
+
+func ExampleJSONBuildObject() {
+	ds := goqu.From("users").
+		Select(goqu.JSONAgg(goqu.JSONBuildObject("id", goqu.I("id"), "name", goqu.I("name"))).As("users")).
+		Where(goqu.C("active").IsTrue())
+
+	query, _, _ := ds.WithDialect("postgres").ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = goqu.Dialect("postgres").From("users").
+		Select("id", goqu.JSONPathQuery("profile", "$.tags[*]").As("tag")).
+		ToSQL()
+	fmt.Println(query)
+
+	// Output:
+	// SELECT json_agg(jsonb_build_object('id', "id", 'name', "name")) AS "users" FROM "users" WHERE ("active" IS TRUE)
+	// SELECT JSON_ARRAYAGG(JSON_OBJECT('id', `id`, 'name', `name`)) AS `users` FROM `users` WHERE (`active` IS TRUE)
+	// SELECT "id", jsonb_path_query("profile", '$.tags[*]') AS "tag" FROM "users"
+}
+
+func ExampleArray() {
+	query, _, _ := goqu.Dialect("postgres").
+		From("posts").
+		Select("id", goqu.Array("tags").Index(1).As("first_tag"), goqu.Array("tags").Slice(1, 3).As("top_tags")).
+		Where(
+			goqu.Array("tags").Overlaps([]string{"go", "sql"}),
+			goqu.Array("tags").Contains(goqu.ArrayOf("db")),
+			goqu.C("author_id").Eq(goqu.Any(goqu.ArrayOf(1, 2, 3))),
+		).
+		ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = goqu.Dialect("postgres").
+		From("posts").
+		Select("author_id", goqu.ARRAY_AGG("id").As("ids")).
+		GroupBy("author_id").
+		ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = goqu.Dialect("postgres").
+		From("posts", goqu.UNNEST("tags").As("tag")).
+		Select("posts.id", "tag").
+		ToSQL()
+	fmt.Println(query)
+
+	// Output:
+	// SELECT "id", "tags"[1] AS "first_tag", "tags"[1:3] AS "top_tags" FROM "posts" WHERE (("tags" && '{"go", "sql"}') AND ("tags" @> ARRAY['db']) AND ("author_id" = ANY (ARRAY[1, 2, 3])))
+	// SELECT "author_id", ARRAY_AGG("id") AS "ids" FROM "posts" GROUP BY "author_id"
+	// SELECT "posts"."id", "tag" FROM "posts", UNNEST("tags") AS "tag"
+}
+
+func ExampleRangeOf() {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	query, _, _ := goqu.Dialect("postgres").
+		From("bookings").
+		Where(
+			goqu.C("room_id").Eq(1),
+			goqu.RangeOf("during").Overlaps(goqu.TsTzRange(start, start.Add(time.Hour)).WithBounds("[)")),
+		).
+		ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = goqu.Dialect("postgres").
+		From("bookings").
+		Select("room_id", goqu.Int4Range(1, 5).Adjacent(goqu.Int4Range(5, 10)).As("adjacent")).
+		Where(goqu.RangeOf("during").Contains(goqu.Cast(goqu.V(start), "TIMESTAMPTZ"))).
+		ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = goqu.Dialect("postgres").
+		CreateTable("bookings").
+		Columns(
+			goqu.ColumnDef("room_id", goqu.BigIntType()).NotNull(),
+			goqu.ColumnDef("starts_at", goqu.CustomType("TIMESTAMPTZ")).NotNull(),
+			goqu.ColumnDef("ends_at", goqu.CustomType("TIMESTAMPTZ")).NotNull(),
+		).
+		Constraints(goqu.Exclude("gist",
+			goqu.ExcludeWith("room_id", "="),
+			goqu.ExcludeWith(goqu.TsTzRange(goqu.C("starts_at"), goqu.C("ends_at")), "&&"),
+		)).
+		ToSQL()
+	fmt.Println(query)
+
+	// Output:
+	// SELECT * FROM "bookings" WHERE (("room_id" = 1) AND ("during" && tstzrange('2024-01-01T10:00:00Z', '2024-01-01T11:00:00Z', '[)')))
+	// SELECT "room_id", (int4range(1, 5) -|- int4range(5, 10)) AS "adjacent" FROM "bookings" WHERE ("during" @> CAST('2024-01-01T10:00:00Z' AS TIMESTAMPTZ))
+	// CREATE TABLE "bookings" ("room_id" BIGINT NOT NULL, "starts_at" TIMESTAMPTZ NOT NULL, "ends_at" TIMESTAMPTZ NOT NULL, EXCLUDE USING gist ("room_id" WITH =, tstzrange("starts_at", "ends_at") WITH &&))
+}
+
+func ExampleInterval() {
+	ds := goqu.From("orders").
+		Select("id", goqu.DateOf("created_at").Add(goqu.Interval(30, goqu.Days)).As("due_at")).
+		Where(goqu.C("created_at").Gte(goqu.DateOf(goqu.L("NOW()")).Sub(goqu.Interval(12, goqu.Hours))))
+
+	sql, _, _ := ds.ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = ds.WithDialect("postgres").ToSQL()
+	fmt.Println(sql)
+
+	sql, args, _ := ds.WithDialect("postgres").Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	sql, _, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = goqu.Dialect("sqlserver").
+		From("orders").
+		Where(goqu.DateOf("created_at").Add(goqu.Interval(3, goqu.Days)).Lt(goqu.L("GETDATE()"))).
+		ToSQL()
+	fmt.Println(sql)
+
+	// Output:
+	// SELECT "id", ("created_at" + INTERVAL '30' DAY) AS "due_at" FROM "orders" WHERE ("created_at" >= (NOW() - INTERVAL '12' HOUR))
+	// SELECT "id", ("created_at" + INTERVAL '30 days') AS "due_at" FROM "orders" WHERE ("created_at" >= (NOW() - INTERVAL '12 hours'))
+	// SELECT "id", ("created_at" + INTERVAL '30 days') AS "due_at" FROM "orders" WHERE ("created_at" >= (NOW() - INTERVAL '12 hours')) []
+	// SELECT `id`, (`created_at` + INTERVAL 30 DAY) AS `due_at` FROM `orders` WHERE (`created_at` >= (NOW() - INTERVAL 12 HOUR))
+	// SELECT * FROM "orders" WHERE (DATEADD(DAY, 3, "created_at") < GETDATE())
+}
+
+func ExampleC_atTimeZone() {
+	ds := goqu.From("orders").
+		Select("id", goqu.C("created_at").AtTimeZone("Europe/Kyiv").As("local_created_at")).
+		Where(goqu.C("created_at").AtTimeZone("UTC").Add(goqu.Interval(1, goqu.Days)).Lt(goqu.L("NOW()")))
+
+	sql, _, _ := ds.WithDialect("postgres").ToSQL()
+	fmt.Println(sql)
+
+	sql, args, _ := ds.WithDialect("postgres").Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	sql, _, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql)
+
+	// Output:
+	// SELECT "id", ("created_at" AT TIME ZONE 'Europe/Kyiv') AS "local_created_at" FROM "orders" WHERE ((("created_at" AT TIME ZONE 'UTC') + INTERVAL '1 days') < NOW())
+	// SELECT "id", ("created_at" AT TIME ZONE $1) AS "local_created_at" FROM "orders" WHERE ((("created_at" AT TIME ZONE $2) + INTERVAL '1 days') < NOW()) [Europe/Kyiv UTC]
+	// SELECT `id`, CONVERT_TZ(`created_at`, @@session.time_zone, 'Europe/Kyiv') AS `local_created_at` FROM `orders` WHERE ((CONVERT_TZ(`created_at`, @@session.time_zone, 'UTC') + INTERVAL 1 DAY) < NOW())
+}
+
+func ExampleRowsFrom() {
+	series := goqu.Func("generate_series", goqu.L("'2024-01-01'::date"), goqu.L("'2024-01-03'::date"), goqu.L("'1 day'::interval"))
+	query, _, _ := goqu.Dialect("postgres").
+		From(series.WithOrdinality().As("d").Columns("day", "n")).
+		LeftJoin(goqu.T("events"), goqu.On(goqu.I("events.day").Eq(goqu.I("d.day")))).
+		Select("d.day", goqu.COUNT("events.id")).
+		GroupBy("d.day").
+		ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = goqu.Dialect("postgres").
+		From(goqu.RowsFrom(goqu.Func("generate_series", 1, 2), goqu.Func("unnest", goqu.L("'{a,b}'::text[]"))).
+			As("t").Columns("n", "v")).
+		ToSQL()
+	fmt.Println(query)
+
+	// Output:
+	// SELECT "d"."day", COUNT("events"."id") FROM generate_series('2024-01-01'::date, '2024-01-03'::date, '1 day'::interval) WITH ORDINALITY AS "d"("day", "n") LEFT JOIN "events" ON ("events"."day" = "d"."day") GROUP BY "d"."day"
+	// SELECT * FROM ROWS FROM (generate_series(1, 2), unnest('{a,b}'::text[])) AS "t"("n", "v")
+}
+
+func ExampleHintTable() {
+	query, _, _ := goqu.Dialect("postgres").From(goqu.HintTable(goqu.T("measurements")).Only()).ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = goqu.Dialect("mysql").
+		From(goqu.T("orders")).
+		Join(
+			goqu.HintTable(goqu.T("users").As("u")).UseIndex("idx_email"),
+			goqu.On(goqu.I("u.id").Eq(goqu.I("orders.user_id"))),
+		).
+		ToSQL()
+	fmt.Println(query)
+
+	query, _, _ = goqu.Dialect("sqlserver").
+		From(goqu.HintTable(goqu.T("orders")).With("NOLOCK")).
+		LeftJoin(goqu.HintTable(goqu.T("users")).With("NOLOCK"), goqu.On(goqu.I("users.id").Eq(goqu.I("orders.user_id")))).
+		ToSQL()
+	fmt.Println(query)
+
+	// Output:
+	// SELECT * FROM ONLY "measurements"
+	// SELECT * FROM `orders` INNER JOIN `users` AS `u` USE INDEX (`idx_email`) ON (`u`.`id` = `orders`.`user_id`)
+	// SELECT * FROM "orders" WITH (NOLOCK) LEFT JOIN "users" WITH (NOLOCK) ON ("users"."id" = "orders"."user_id")
+}
+
+func ExampleXMLTable() {
+	xt := goqu.XMLTable("/rows/row", "data").Columns(
+		goqu.XMLColumn("id", goqu.IntegerType()).Path("@id"),
+		goqu.XMLColumn("name", goqu.TextType()).Path("name").Default("unknown"),
+		goqu.XMLOrdinalityColumn("n"),
+	).As("x")
+	ds := goqu.Dialect("postgres").From(goqu.T("docs"), xt).Select("x.id", "x.name", "x.n")
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+
+	query, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(query, args)
+
+	// Output:
+	// SELECT "x"."id", "x"."name", "x"."n" FROM "docs", XMLTABLE('/rows/row' PASSING "data" COLUMNS "id" INTEGER PATH '@id', "name" TEXT PATH 'name' DEFAULT 'unknown', "n" FOR ORDINALITY) AS "x" []
+	// SELECT "x"."id", "x"."name", "x"."n" FROM "docs", XMLTABLE('/rows/row' PASSING "data" COLUMNS "id" INTEGER PATH '@id', "name" TEXT PATH 'name' DEFAULT $1, "n" FOR ORDINALITY) AS "x" [unknown]
+}
+
+func ExampleRandom() {
+	ds := goqu.From("test").Where(goqu.Random().Lt(0.1))
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+
+	query, args, _ = goqu.Dialect("sqlserver").From("test").OrderRandom(10).ToSQL()
+	fmt.Println(query, args)
+
+	// Output:
+	// SELECT * FROM "test" WHERE (RANDOM() < 0.1) []
+	// SELECT  TOP (10) * FROM "test" ORDER BY NEWID() ASC []
+}
+
 func ExampleLateral() {
 	maxEntry := goqu.From("entry").
 		Select(goqu.MAX("int").As("max_int")).
@@ -1901,3 +2342,42 @@ func ExampleCase_valueElse() {
 	// SELECT "col", CASE "str" WHEN 'foo' THEN 'FOO' WHEN 'bar' THEN 'BAR' ELSE 'Baz' END AS "foo_bar_upper" FROM "test" []
 	// SELECT "col", CASE "str" WHEN ? THEN ? WHEN ? THEN ? ELSE ? END AS "foo_bar_upper" FROM "test" [foo FOO bar BAR Baz]
 }
+
+func ExampleCaseOf() {
+	priority := goqu.CaseOf[int]().
+		When(goqu.C("status").Eq("urgent"), 0).
+		When(goqu.C("due").Lt(goqu.L("NOW()")), 1).
+		Else(2)
+	ds := goqu.From("tasks").
+		Select("id", priority.As("priority")).
+		Order(priority.Asc(), goqu.C("id").Asc())
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+	// Output:
+	// SELECT "id", CASE  WHEN ("status" = 'urgent') THEN 0 WHEN ("due" < NOW()) THEN 1 ELSE 2 END AS "priority" FROM "tasks" ORDER BY CASE  WHEN ("status" = 'urgent') THEN 0 WHEN ("due" < NOW()) THEN 1 ELSE 2 END ASC, "id" ASC []
+	// SELECT "id", CASE  WHEN ("status" = ?) THEN ? WHEN ("due" < NOW()) THEN ? ELSE ? END AS "priority" FROM "tasks" ORDER BY CASE  WHEN ("status" = ?) THEN ? WHEN ("due" < NOW()) THEN ? ELSE ? END ASC, "id" ASC [urgent 0 1 2 urgent 0 1 2]
+}
+
+func ExampleCaseMap() {
+	prices := map[int64]float64{1: 9.99, 2: 19.99, 3: 4.5}
+	ds := goqu.Update("items").
+		Set(goqu.Record{
+			"price": goqu.CaseMap("id", prices).ElseExpression(goqu.C("price")),
+			"status": goqu.CaseOfValue[string](goqu.C("id")).
+				When(1, "sale").
+				WhenExpression(2, goqu.CaseOf[string]().When(goqu.C("stock").Eq(0), "sold_out").Else("sale")).
+				ElseExpression(goqu.C("status")),
+		}).
+		Where(goqu.C("id").In(1, 2, 3))
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+	// Output:
+	// UPDATE "items" SET "price"=CASE "id" WHEN 1 THEN 9.99 WHEN 2 THEN 19.99 WHEN 3 THEN 4.5 ELSE "price" END,"status"=CASE "id" WHEN 1 THEN 'sale' WHEN 2 THEN CASE  WHEN ("stock" = 0) THEN 'sold_out' ELSE 'sale' END ELSE "status" END WHERE ("id" IN (1, 2, 3)) []
+	// UPDATE "items" SET "price"=CASE "id" WHEN ? THEN ? WHEN ? THEN ? WHEN ? THEN ? ELSE "price" END,"status"=CASE "id" WHEN ? THEN ? WHEN ? THEN CASE  WHEN ("stock" = ?) THEN ? ELSE ? END ELSE "status" END WHERE ("id" IN (?, ?, ?)) [1 9.99 2 19.99 3 4.5 1 sale 2 0 sold_out sale 1 2 3]
+}
@@ -42,6 +42,10 @@ func (ges *goquExpressionsSuite) TestFunc() {
 	ges.Equal(exp.NewSQLFunctionExpression("count", goqu.L("*")), goqu.Func("count", goqu.L("*")))
 }
 
+func (ges *goquExpressionsSuite) TestFuncDef() {
+	ges.Equal(exp.NewFunctionDefinition("length", 1, 1), goqu.FuncDef("length", 1, 1))
+}
+
 func (ges *goquExpressionsSuite) TestDISTINCT() {
 	ges.Equal(exp.NewSQLFunctionExpression("DISTINCT", goqu.I("col")), goqu.DISTINCT("col"))
 }
@@ -78,6 +82,49 @@ func (ges *goquExpressionsSuite) TestCOALESCE() {
 	ges.Equal(exp.NewSQLFunctionExpression("COALESCE", goqu.I("col"), nil), goqu.COALESCE(goqu.I("col"), nil))
 }
 
+func (ges *goquExpressionsSuite) TestNULLIF() {
+	ges.Equal(exp.NewSQLFunctionExpression("NULLIF", goqu.I("col"), 0), goqu.NULLIF("col", 0))
+	ges.Equal(exp.NewSQLFunctionExpression("NULLIF", goqu.SUM("col"), "a"), goqu.NULLIF(goqu.SUM("col"), "a"))
+}
+
+func (ges *goquExpressionsSuite) TestROUND() {
+	ges.Equal(exp.NewSQLFunctionExpression("ROUND", goqu.I("col")), goqu.ROUND("col"))
+	ges.Equal(exp.NewSQLFunctionExpression("ROUND", goqu.I("col"), 2), goqu.ROUND("col", 2))
+	ges.Equal(exp.NewSQLFunctionExpression("ROUND", goqu.AVG("col"), 2), goqu.ROUND(goqu.AVG("col"), 2))
+}
+
+func (ges *goquExpressionsSuite) TestCEIL() {
+	ges.Equal(exp.NewSQLFunctionExpression("CEIL", goqu.I("col")), goqu.CEIL("col"))
+}
+
+func (ges *goquExpressionsSuite) TestFLOOR() {
+	ges.Equal(exp.NewSQLFunctionExpression("FLOOR", goqu.I("col")), goqu.FLOOR("col"))
+}
+
+func (ges *goquExpressionsSuite) TestABS() {
+	ges.Equal(exp.NewSQLFunctionExpression("ABS", goqu.I("col")), goqu.ABS("col"))
+}
+
+func (ges *goquExpressionsSuite) TestPOWER() {
+	ges.Equal(exp.NewSQLFunctionExpression("POWER", goqu.I("col"), 2), goqu.POWER("col", 2))
+	ges.Equal(exp.NewSQLFunctionExpression("POWER", goqu.I("a"), goqu.I("b")), goqu.POWER(goqu.I("a"), goqu.I("b")))
+}
+
+func (ges *goquExpressionsSuite) TestMOD() {
+	ges.Equal(exp.NewSQLFunctionExpression("MOD", goqu.I("col"), 2), goqu.MOD("col", 2))
+}
+
+func (ges *goquExpressionsSuite) TestSafeDivide() {
+	ges.Equal(
+		exp.NewLiteralExpression("(? / ?)", goqu.I("a"), goqu.NULLIF(goqu.I("b"), 0)),
+		goqu.SafeDivide("a", "b"),
+	)
+	ges.Equal(
+		exp.NewLiteralExpression("(? / ?)", goqu.SUM("a"), goqu.NULLIF(goqu.COUNT("b"), 0)),
+		goqu.SafeDivide(goqu.SUM("a"), goqu.COUNT("b")),
+	)
+}
+
 func (ges *goquExpressionsSuite) TestROW_NUMBER() {
 	ges.Equal(exp.NewSQLFunctionExpression("ROW_NUMBER"), goqu.ROW_NUMBER())
 }
@@ -102,6 +149,10 @@ func (ges *goquExpressionsSuite) TestNTILE() {
 	ges.Equal(exp.NewSQLFunctionExpression("NTILE", 1), goqu.NTILE(1))
 }
 
+func (ges *goquExpressionsSuite) TestRandom() {
+	ges.Equal(exp.NewRandomExpression(), goqu.Random())
+}
+
 func (ges *goquExpressionsSuite) TestFIRST_VALUE() {
 	ges.Equal(exp.NewSQLFunctionExpression("FIRST_VALUE", goqu.I("col")), goqu.FIRST_VALUE("col"))
 }
@@ -170,11 +221,155 @@ func (ges *goquExpressionsSuite) TestDefault() {
 	ges.Equal(exp.Default(), goqu.Default())
 }
 
+func (ges *goquExpressionsSuite) TestEscapeLikePattern() {
+	ges.Equal("abc", goqu.EscapeLikePattern("abc"))
+	ges.Equal(`50\%\_off`, goqu.EscapeLikePattern("50%_off"))
+	ges.Equal(`a\\b`, goqu.EscapeLikePattern(`a\b`))
+	ges.Equal(`\\\%`, goqu.EscapeLikePattern(`\%`))
+}
+
+func (ges *goquExpressionsSuite) TestExists() {
+	ds := goqu.From("test")
+	ges.Equal(exp.NewLiteralExpression("EXISTS ?", ds), goqu.Exists(ds))
+	ges.Equal(exp.NewLiteralExpression("NOT EXISTS ?", ds), goqu.NotExists(ds))
+}
+
 func (ges *goquExpressionsSuite) TestLateral() {
 	ds := goqu.From("test")
 	ges.Equal(exp.NewLateralExpression(ds), goqu.Lateral(ds))
 }
 
+func (ges *goquExpressionsSuite) TestPivot() {
+	ges.Equal(exp.NewPivotExpression(goqu.T("sales"), goqu.SUM("amount")), goqu.Pivot(goqu.T("sales"), goqu.SUM("amount")))
+}
+
+func (ges *goquExpressionsSuite) TestUnpivot() {
+	ges.Equal(exp.NewUnpivotExpression(goqu.T("sales"), "amount"), goqu.Unpivot(goqu.T("sales"), "amount"))
+}
+
+func (ges *goquExpressionsSuite) TestRecursive() {
+	base := goqu.From("nodes").Where(goqu.C("parent_id").IsNull())
+	var self exp.IdentifierExpression
+	step := func(s exp.IdentifierExpression) *goqu.SelectDataset {
+		self = s
+		return goqu.From("nodes").Join(s, goqu.On(goqu.I("nodes.parent_id").Eq(s.Col("id"))))
+	}
+	next := goqu.From("nodes").Join(goqu.T("tree"), goqu.On(goqu.I("nodes.parent_id").Eq(goqu.T("tree").Col("id"))))
+
+	ges.Equal(
+		exp.NewCommonTableExpression(true, "tree(id, parent_id)", base.Union(next)),
+		goqu.Recursive("tree", []string{"id", "parent_id"}, base, step, false),
+	)
+	ges.Equal(goqu.T("tree"), self)
+	ges.Equal(
+		exp.NewCommonTableExpression(true, "tree", base.UnionAll(next)),
+		goqu.Recursive("tree", nil, base, step, true),
+	)
+}
+
+func (ges *goquExpressionsSuite) TestJSON() {
+	ges.Equal(exp.NewJSONAccessor(goqu.I("data")), goqu.JSON("data"))
+	ges.Equal(exp.NewJSONAccessor(goqu.L("'{}'::jsonb")), goqu.JSON(goqu.L("'{}'::jsonb")))
+	ges.Equal(exp.NewJSONAccessor(goqu.V(1)), goqu.JSON(1))
+}
+
+func (ges *goquExpressionsSuite) TestJSONFunctions() {
+	ges.Equal(
+		exp.NewJSONFunctionExpression(exp.JSONPathQueryFunc, goqu.I("data"), "$.a"),
+		goqu.JSONPathQuery("data", "$.a"),
+	)
+	ges.Equal(
+		exp.NewJSONFunctionExpression(exp.JSONSetFunc, goqu.L("'{}'::jsonb"), []string{"a"}, 1),
+		goqu.JSONSet(goqu.L("'{}'::jsonb"), []string{"a"}, 1),
+	)
+	ges.Equal(
+		exp.NewJSONFunctionExpression(exp.JSONBuildObjectFunc, "a", "b"),
+		goqu.JSONBuildObject("a", "b"),
+	)
+	ges.Equal(exp.NewJSONFunctionExpression(exp.JSONAggFunc, goqu.I("a")), goqu.JSONAgg("a"))
+	ges.Equal(
+		exp.NewJSONFunctionExpression(exp.JSONObjectAggFunc, goqu.I("k"), goqu.I("v")),
+		goqu.JSONObjectAgg("k", "v"),
+	)
+}
+
+func (ges *goquExpressionsSuite) TestArray() {
+	ges.Equal(exp.NewArrayAccessor(goqu.I("tags")), goqu.Array("tags"))
+	ges.Equal(exp.NewArrayAccessor(goqu.ArrayOf(1, 2)), goqu.Array(goqu.ArrayOf(1, 2)))
+	ges.Equal(exp.NewArrayAccessor(goqu.V([]int{1, 2})), goqu.Array([]int{1, 2}))
+}
+
+func (ges *goquExpressionsSuite) TestArrayOf() {
+	ges.Equal(exp.NewArrayConstructor(1, goqu.I("a")), goqu.ArrayOf(1, goqu.I("a")))
+}
+
+func (ges *goquExpressionsSuite) TestARRAY_AGG() {
+	ges.Equal(goqu.Func("ARRAY_AGG", goqu.I("a")), goqu.ARRAY_AGG("a"))
+	ges.Equal(goqu.Func("ARRAY_AGG", goqu.I("a")), goqu.ARRAY_AGG(goqu.I("a")))
+}
+
+func (ges *goquExpressionsSuite) TestUNNEST() {
+	ges.Equal(goqu.Func("UNNEST", goqu.I("a"), goqu.I("b")), goqu.UNNEST("a", "b"))
+	ges.Equal(goqu.Func("UNNEST", goqu.ArrayOf(1, 2)), goqu.UNNEST(goqu.ArrayOf(1, 2)))
+}
+
+func (ges *goquExpressionsSuite) TestRangeOf() {
+	ges.Equal(exp.NewRangeTypeAccessor(goqu.I("during")), goqu.RangeOf("during"))
+	ges.Equal(exp.NewRangeTypeAccessor(goqu.Int4Range(1, 2)), goqu.RangeOf(goqu.Int4Range(1, 2)))
+	ges.Equal(exp.NewRangeTypeAccessor(goqu.V(1)), goqu.RangeOf(1))
+}
+
+func (ges *goquExpressionsSuite) TestRangeLiterals() {
+	ges.Equal(exp.NewRangeLiteral("int4range", 1, 2), goqu.Int4Range(1, 2))
+	ges.Equal(exp.NewRangeLiteral("int8range", 1, 2), goqu.Int8Range(1, 2))
+	ges.Equal(exp.NewRangeLiteral("numrange", 1.5, nil), goqu.NumRange(1.5, nil))
+	ges.Equal(exp.NewRangeLiteral("tsrange", "a", "b"), goqu.TsRange("a", "b"))
+	ges.Equal(exp.NewRangeLiteral("tstzrange", goqu.C("a"), goqu.C("b")), goqu.TsTzRange(goqu.C("a"), goqu.C("b")))
+	ges.Equal(exp.NewRangeLiteral("daterange", "a", "b"), goqu.DateRange("a", "b"))
+}
+
+func (ges *goquExpressionsSuite) TestBinaryOperator() {
+	ges.Equal(exp.NewBinaryOperator("l2_distance", "<->"), goqu.BinaryOperator("l2_distance", "<->"))
+}
+
+func (ges *goquExpressionsSuite) TestPrefixOperator() {
+	ges.Equal(exp.NewPrefixOperator("abs", "@"), goqu.PrefixOperator("abs", "@"))
+}
+
+func (ges *goquExpressionsSuite) TestInterval() {
+	ges.Equal(exp.NewIntervalExpression(3, exp.DaysIntervalUnit), goqu.Interval(3, goqu.Days))
+	ges.Equal(exp.NewIntervalExpression(1, exp.MicrosecondsIntervalUnit), goqu.Interval(1, goqu.Microseconds))
+}
+
+func (ges *goquExpressionsSuite) TestDateOf() {
+	ges.Equal(exp.NewDateAccessor(goqu.I("created_at")), goqu.DateOf("created_at"))
+	ges.Equal(exp.NewDateAccessor(goqu.L("NOW()")), goqu.DateOf(goqu.L("NOW()")))
+	ges.Equal(exp.NewDateAccessor(goqu.V(1)), goqu.DateOf(1))
+}
+
+func (ges *goquExpressionsSuite) TestRowsFrom() {
+	a, b := goqu.Func("a"), goqu.Func("b", 1)
+	ges.Equal(exp.NewTableFunctionExpression(a, b), goqu.RowsFrom(a, b))
+}
+
+func (ges *goquExpressionsSuite) TestHintTable() {
+	ges.Equal(exp.NewTableHintExpression(goqu.T("a")), goqu.HintTable(goqu.T("a")))
+}
+
+func (ges *goquExpressionsSuite) TestXMLTable() {
+	ges.Equal(exp.NewXMLTableExpression("/rows/row", goqu.I("data")), goqu.XMLTable("/rows/row", "data"))
+	doc := goqu.L("?::xml", "<rows/>")
+	ges.Equal(exp.NewXMLTableExpression("/rows/row", doc), goqu.XMLTable("/rows/row", doc))
+}
+
+func (ges *goquExpressionsSuite) TestXMLColumn() {
+	ges.Equal(exp.NewXMLTableColumn("id", goqu.IntegerType()), goqu.XMLColumn("id", goqu.IntegerType()))
+}
+
+func (ges *goquExpressionsSuite) TestXMLOrdinalityColumn() {
+	ges.Equal(exp.NewXMLTableOrdinalityColumn("n"), goqu.XMLOrdinalityColumn("n"))
+}
+
 func (ges *goquExpressionsSuite) TestAny() {
 	ds := goqu.From("test").Select("id")
 	ges.Equal(exp.NewSQLFunctionExpression("ANY ", ds), goqu.Any(ds))
@@ -185,6 +380,93 @@ func (ges *goquExpressionsSuite) TestAll() {
 	ges.Equal(exp.NewSQLFunctionExpression("ALL ", ds), goqu.All(ds))
 }
 
+func (ges *goquExpressionsSuite) TestWindowFrameBounds() {
+	ges.Equal(exp.NewWindowFrameBound(exp.UnboundedPrecedingBound, nil), goqu.UnboundedPreceding())
+	ges.Equal(exp.NewWindowFrameBound(exp.PrecedingBound, 1), goqu.Preceding(1))
+	ges.Equal(exp.NewWindowFrameBound(exp.CurrentRowBound, nil), goqu.CurrentRow())
+	ges.Equal(exp.NewWindowFrameBound(exp.FollowingBound, 1), goqu.Following(1))
+	ges.Equal(exp.NewWindowFrameBound(exp.UnboundedFollowingBound, nil), goqu.UnboundedFollowing())
+}
+
+func (ges *goquExpressionsSuite) TestFetchFirst() {
+	ges.Equal(exp.NewFetchExpression(uint(10)), goqu.FetchFirst(10))
+}
+
+func (ges *goquExpressionsSuite) TestRollup() {
+	ges.Equal(exp.NewRollupExpression("a", "b"), goqu.Rollup("a", "b"))
+}
+
+func (ges *goquExpressionsSuite) TestCube() {
+	ges.Equal(exp.NewCubeExpression("a", "b"), goqu.Cube("a", "b"))
+}
+
+func (ges *goquExpressionsSuite) TestGroupingSets() {
+	ges.Equal(
+		exp.NewGroupingSetsExpression([]interface{}{"a", "b"}, "a", nil),
+		goqu.GroupingSets([]interface{}{"a", "b"}, "a", nil),
+	)
+}
+
+func (ges *goquExpressionsSuite) TestGROUPING() {
+	ges.Equal(exp.NewSQLFunctionExpression("GROUPING", goqu.I("a"), goqu.I("b")), goqu.GROUPING("a", goqu.I("b")))
+}
+
+func (ges *goquExpressionsSuite) TestNextVal() {
+	ges.Equal(
+		exp.NewSequenceValueExpression(exp.ParseIdentifier("s.a_seq"), exp.NextSequenceValue),
+		goqu.NextVal("s.a_seq"),
+	)
+}
+
+func (ges *goquExpressionsSuite) TestCurrVal() {
+	ges.Equal(
+		exp.NewSequenceValueExpression(exp.ParseIdentifier("a_seq"), exp.CurrentSequenceValue),
+		goqu.CurrVal("a_seq"),
+	)
+}
+
+func (ges *goquExpressionsSuite) TestForeignKey() {
+	ges.Equal(exp.NewForeignKeyConstraint("a", "b"), goqu.ForeignKey("a", "b"))
+	ges.Equal(
+		exp.NewForeignKeyConstraint("a").References("b", "id").OnDelete(exp.CascadeReferentialAction),
+		goqu.ForeignKey("a").References("b", "id").OnDelete(exp.CascadeReferentialAction),
+	)
+}
+
+func (ges *goquExpressionsSuite) TestCheck() {
+	ges.Equal(exp.NewCheckConstraint(goqu.C("a").Gt(0)), goqu.Check(goqu.C("a").Gt(0)))
+}
+
+func (ges *goquExpressionsSuite) TestExclude() {
+	ges.Equal(exp.NewExcludeElement("a", "&&"), goqu.ExcludeWith("a", "&&"))
+	ges.Equal(
+		exp.NewExcludeConstraint("gist", exp.NewExcludeElement("a", "="), exp.NewExcludeElement("b", "&&")),
+		goqu.Exclude("gist", goqu.ExcludeWith("a", "="), goqu.ExcludeWith("b", "&&")),
+	)
+}
+
+func (ges *goquExpressionsSuite) TestPartitionBy() {
+	ges.Equal(exp.NewPartitionBy(exp.RangePartition, "a"), goqu.PartitionByRange("a"))
+	ges.Equal(exp.NewPartitionBy(exp.ListPartition, "a", "b"), goqu.PartitionByList("a", "b"))
+	ges.Equal(exp.NewPartitionBy(exp.HashPartition, "a").Partitions(4), goqu.PartitionByHash("a").Partitions(4))
+}
+
+func (ges *goquExpressionsSuite) TestPartition() {
+	ges.Equal(
+		exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), exp.NewLessThanPartitionBound(10)),
+		goqu.Partition("p0", goqu.ValuesLessThan(10)),
+	)
+	ges.Equal(exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), nil), goqu.Partition("p0", nil))
+}
+
+func (ges *goquExpressionsSuite) TestPartitionBounds() {
+	ges.Equal(exp.NewRangePartitionBound([]interface{}{1}, []interface{}{10}), goqu.ValuesFromTo(1, 10))
+	ges.Equal(exp.NewLessThanPartitionBound(10, 20), goqu.ValuesLessThan(10, 20))
+	ges.Equal(exp.NewListPartitionBound("a", "b"), goqu.ValuesIn("a", "b"))
+	ges.Equal(exp.NewHashPartitionBound(4, 1), goqu.ValuesWithModulus(4, 1))
+	ges.Equal(exp.NewDefaultPartitionBound(), goqu.DefaultPartition())
+}
+
 func TestGoquExpressions(t *testing.T) {
 	suite.Run(t, new(goquExpressionsSuite))
 }
@@ -0,0 +1,234 @@
+/*
+Package fixtures loads seed data defined in YAML or JSON files into a database using goqu.
+
+A fixture file contains a list of tables, the rows to insert into each table and the tables each table depends on.
+Tables are inserted after the tables they depend on so foreign key constraints are satisfied.
+
+    - table: users
+      rows:
+        - id: 1
+          name: Bob
+    - table: posts
+      depends_on: [users]
+      rows:
+        - id: 1
+          user_id: 1
+          title: Hello
+
+Usage
+
+    err := fixtures.New(db).WithTruncate().LoadFiles("testdata/users.yml")
+*/
+package fixtures
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"gopkg.in/yaml.v3"
+)
+
+type (
+	// Fixture contains the rows to insert into a single table.
+	Fixture struct {
+		// The name of the table to insert into
+		Table string `json:"table" yaml:"table"`
+		// Tables that must be loaded before this table (e.g. tables referenced by foreign keys)
+		DependsOn []string `json:"depends_on" yaml:"depends_on"`
+		// The rows to insert
+		Rows []goqu.Record `json:"rows" yaml:"rows"`
+	}
+
+	clearMode int
+
+	// Loader loads fixtures into a database inside of a single transaction.
+	Loader struct {
+		db        *goqu.Database
+		clearMode clearMode
+	}
+)
+
+const (
+	clearNone clearMode = iota
+	clearTruncate
+	clearDelete
+)
+
+// New creates a new Loader for the database.
+func New(db *goqu.Database) *Loader {
+	return &Loader{db: db}
+}
+
+// WithTruncate returns a copy of the Loader that will TRUNCATE every table before inserting. All tables are truncated
+// in a single statement (with CASCADE if the dialect supports it) so tables referenced by foreign keys can be cleared,
+// dialects that can only truncate one table at a time truncate the tables in reverse dependency order.
+func (l *Loader) WithTruncate() *Loader {
+	return &Loader{db: l.db, clearMode: clearTruncate}
+}
+
+// WithDelete returns a copy of the Loader that will DELETE all rows from every table before inserting. Use this
+// instead of WithTruncate for databases that do not support TRUNCATE (e.g. sqlite3). Tables are cleared in reverse
+// dependency order.
+func (l *Loader) WithDelete() *Loader {
+	return &Loader{db: l.db, clearMode: clearDelete}
+}
+
+// LoadFiles reads each file and loads the fixtures. The format is determined by the file extension, .yml and .yaml
+// files are parsed as YAML, .json files are parsed as JSON.
+func (l *Loader) LoadFiles(paths ...string) error {
+	return l.LoadFilesContext(context.Background(), paths...)
+}
+
+// LoadFilesContext see Loader#LoadFiles
+func (l *Loader) LoadFilesContext(ctx context.Context, paths ...string) error {
+	var fixtures []Fixture
+	for _, path := range paths {
+		fs, err := ParseFile(path)
+		if err != nil {
+			return err
+		}
+		fixtures = append(fixtures, fs...)
+	}
+	return l.LoadContext(ctx, fixtures...)
+}
+
+// Load inserts the fixtures in dependency order inside of a single transaction.
+func (l *Loader) Load(fixtures ...Fixture) error {
+	return l.LoadContext(context.Background(), fixtures...)
+}
+
+// LoadContext see Loader#Load
+func (l *Loader) LoadContext(ctx context.Context, fixtures ...Fixture) error {
+	ordered, err := sortFixtures(fixtures)
+	if err != nil {
+		return err
+	}
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return tx.Wrap(func() error {
+		if err := l.clear(ctx, tx, ordered); err != nil {
+			return err
+		}
+		for _, f := range ordered {
+			if len(f.Rows) == 0 {
+				continue
+			}
+			rows := make([]interface{}, 0, len(f.Rows))
+			for _, r := range f.Rows {
+				rows = append(rows, r)
+			}
+			if _, err := tx.Insert(f.Table).Rows(rows...).Executor().ExecContext(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (l *Loader) clear(ctx context.Context, tx *goqu.TxDatabase, ordered []Fixture) error {
+	tables := make([]interface{}, 0, len(ordered))
+	for i := len(ordered) - 1; i >= 0; i-- {
+		tables = append(tables, ordered[i].Table)
+	}
+	switch l.clearMode {
+	case clearTruncate:
+		return l.truncate(ctx, tx, tables)
+	case clearDelete:
+		for _, table := range tables {
+			if _, err := tx.Delete(table).Executor().ExecContext(ctx); err != nil {
+				return err
+			}
+		}
+	case clearNone:
+	}
+	return nil
+}
+
+// truncates the tables in a single statement so tables referenced by foreign keys can be truncated, CASCADE is used
+// when the dialect supports it
+func (l *Loader) truncate(ctx context.Context, tx *goqu.TxDatabase, tables []interface{}) error {
+	if len(tables) == 0 {
+		return nil
+	}
+	ds := tx.Truncate(tables...)
+	caps := ds.Dialect().Capabilities()
+	if caps.TruncateCascade {
+		ds = ds.Cascade()
+	}
+	if caps.MultipleTruncateTables {
+		_, err := ds.Executor().ExecContext(ctx)
+		return err
+	}
+	for _, table := range tables {
+		if _, err := ds.Table(table).Executor().ExecContext(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseFile reads the fixtures from a YAML (.yml, .yaml) or JSON (.json) file.
+func ParseFile(path string) ([]Fixture, error) {
+	var parse func(r io.Reader) ([]Fixture, error)
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yml", ".yaml":
+		parse = ParseYAML
+	case ".json":
+		parse = ParseJSON
+	default:
+		return nil, errors.New("unsupported fixture file extension %q [path=%s]", ext, path)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parse(bytes.NewReader(data))
+}
+
+// ParseYAML reads a list of fixtures from YAML.
+func ParseYAML(r io.Reader) ([]Fixture, error) {
+	var fixtures []Fixture
+	if err := yaml.NewDecoder(r).Decode(&fixtures); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return fixtures, nil
+}
+
+// ParseJSON reads a list of fixtures from JSON. Numbers are converted to int64 when possible, otherwise float64.
+func ParseJSON(r io.Reader) ([]Fixture, error) {
+	var fixtures []Fixture
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	if err := d.Decode(&fixtures); err != nil && err != io.EOF {
+		return nil, err
+	}
+	for _, f := range fixtures {
+		for _, row := range f.Rows {
+			for col, val := range row {
+				if n, ok := val.(json.Number); ok {
+					row[col] = convertNumber(n)
+				}
+			}
+		}
+	}
+	return fixtures, nil
+}
+
+func convertNumber(n json.Number) interface{} {
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return n.String()
+}
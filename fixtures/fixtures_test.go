@@ -0,0 +1,160 @@
+package fixtures_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/fixtures"
+	"github.com/stretchr/testify/suite"
+)
+
+type fixturesSuite struct {
+	suite.Suite
+}
+
+func TestFixturesSuite(t *testing.T) {
+	suite.Run(t, new(fixturesSuite))
+}
+
+func (fs *fixturesSuite) TestParseYAML() {
+	fs1, err := fixtures.ParseYAML(strings.NewReader(`
+- table: users
+  rows:
+    - id: 1
+      name: Bob
+`))
+	fs.NoError(err)
+	fs.Equal([]fixtures.Fixture{
+		{Table: "users", Rows: []goqu.Record{{"id": 1, "name": "Bob"}}},
+	}, fs1)
+
+	_, err = fixtures.ParseYAML(strings.NewReader(`table: users`))
+	fs.Error(err)
+}
+
+func (fs *fixturesSuite) TestParseJSON() {
+	fs1, err := fixtures.ParseJSON(strings.NewReader(
+		`[{"table": "users", "depends_on": ["accounts"], "rows": [{"id": 1, "score": 1.5, "name": "Bob"}]}]`,
+	))
+	fs.NoError(err)
+	fs.Equal([]fixtures.Fixture{
+		{
+			Table:     "users",
+			DependsOn: []string{"accounts"},
+			Rows:      []goqu.Record{{"id": int64(1), "score": 1.5, "name": "Bob"}},
+		},
+	}, fs1)
+
+	_, err = fixtures.ParseJSON(strings.NewReader(`{"table": "users"}`))
+	fs.Error(err)
+}
+
+func (fs *fixturesSuite) TestParseFile() {
+	_, err := fixtures.ParseFile("testdata/users.txt")
+	fs.EqualError(err, `goqu: unsupported fixture file extension ".txt" [path=testdata/users.txt]`)
+
+	_, err = fixtures.ParseFile("testdata/missing.yml")
+	fs.Error(err)
+}
+
+func (fs *fixturesSuite) TestLoadFiles() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	fs.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`INSERT INTO "users" ("id", "name") VALUES (1, 'Bob'), (2, 'Sally')`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectExec(`INSERT INTO "posts" ("id", "title", "user_id") VALUES (1, 'Hello', 1)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`INSERT INTO "comments" ("id", "post_id", "score", "user_id") VALUES (1, 1, 1.5, 2)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	db := goqu.New("default", mDB)
+	fs.NoError(fixtures.New(db).LoadFiles("testdata/users.yml", "testdata/comments.json"))
+	fs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (fs *fixturesSuite) TestLoad_withTruncate() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	fs.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`TRUNCATE "posts", "users" CASCADE`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(`INSERT INTO "users" ("id") VALUES (1)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`INSERT INTO "posts" ("id", "user_id") VALUES (1, 1)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	// posts.user_id references users.id so the tables must be truncated in the same statement
+	db := goqu.New("default", mDB)
+	fs.NoError(fixtures.New(db).WithTruncate().Load(
+		fixtures.Fixture{Table: "posts", DependsOn: []string{"users"}, Rows: []goqu.Record{{"id": 1, "user_id": 1}}},
+		fixtures.Fixture{Table: "users", Rows: []goqu.Record{{"id": 1}}},
+	))
+	fs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (fs *fixturesSuite) TestLoad_withTruncateSingleTable() {
+	opts := goqu.DefaultDialectOptions()
+	opts.SupportsMultipleTruncateTables = false
+	opts.SupportsTruncateCascade = false
+	goqu.RegisterDialect("fixtures-single-truncate", opts)
+	defer goqu.DeregisterDialect("fixtures-single-truncate")
+
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	fs.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`TRUNCATE "posts"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(`TRUNCATE "users"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(`INSERT INTO "users" ("id") VALUES (1)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`INSERT INTO "posts" ("id", "user_id") VALUES (1, 1)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	db := goqu.New("fixtures-single-truncate", mDB)
+	fs.NoError(fixtures.New(db).WithTruncate().Load(
+		fixtures.Fixture{Table: "posts", DependsOn: []string{"users"}, Rows: []goqu.Record{{"id": 1, "user_id": 1}}},
+		fixtures.Fixture{Table: "users", Rows: []goqu.Record{{"id": 1}}},
+	))
+	fs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (fs *fixturesSuite) TestLoad_withDelete() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	fs.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`DELETE FROM "users"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(`INSERT INTO "users" ("id") VALUES (1), (2)`).WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectCommit()
+
+	db := goqu.New("default", mDB)
+	fs.NoError(fixtures.New(db).WithDelete().Load(
+		fixtures.Fixture{Table: "users", Rows: []goqu.Record{{"id": 1}}},
+		fixtures.Fixture{Table: "users", Rows: []goqu.Record{{"id": 2}}},
+	))
+	fs.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (fs *fixturesSuite) TestLoad_withCyclicDependency() {
+	mDB, _, err := sqlmock.New()
+	fs.Require().NoError(err)
+
+	db := goqu.New("default", mDB)
+	err = fixtures.New(db).Load(
+		fixtures.Fixture{Table: "a", DependsOn: []string{"b"}},
+		fixtures.Fixture{Table: "b", DependsOn: []string{"a"}},
+	)
+	fs.EqualError(err, "goqu: cyclic fixture dependency found [table=a]")
+}
+
+func (fs *fixturesSuite) TestLoad_withInsertError() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	fs.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`INSERT INTO "users" ("id") VALUES (1)`).WillReturnError(sqlmock.ErrCancelled)
+	sqlMock.ExpectRollback()
+
+	db := goqu.New("default", mDB)
+	err = fixtures.New(db).Load(fixtures.Fixture{Table: "users", Rows: []goqu.Record{{"id": 1}}})
+	fs.Equal(sqlmock.ErrCancelled, err)
+	fs.NoError(sqlMock.ExpectationsWereMet())
+}
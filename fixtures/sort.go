@@ -0,0 +1,70 @@
+package fixtures
+
+import (
+	"github.com/doug-martin/goqu/v9/internal/errors"
+)
+
+func cyclicDependencyError(table string) error {
+	return errors.New("cyclic fixture dependency found [table=%s]", table)
+}
+
+// sortFixtures merges fixtures for the same table and orders them so every table comes after the tables it depends
+// on. Dependencies that are not part of the fixtures are ignored. Tables without dependencies between them keep the
+// order they were provided in.
+func sortFixtures(fixtures []Fixture) ([]Fixture, error) {
+	byTable := make(map[string]*Fixture, len(fixtures))
+	tables := make([]string, 0, len(fixtures))
+	for _, f := range fixtures {
+		if existing, ok := byTable[f.Table]; ok {
+			existing.DependsOn = append(existing.DependsOn, f.DependsOn...)
+			existing.Rows = append(existing.Rows, f.Rows...)
+			continue
+		}
+		merged := Fixture{
+			Table:     f.Table,
+			DependsOn: append([]string(nil), f.DependsOn...),
+			Rows:      append(f.Rows[:0:0], f.Rows...),
+		}
+		byTable[f.Table] = &merged
+		tables = append(tables, f.Table)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(tables))
+	ordered := make([]Fixture, 0, len(tables))
+	var visit func(table string) error
+	visit = func(table string) error {
+		f, ok := byTable[table]
+		if !ok {
+			return nil
+		}
+		switch state[table] {
+		case visiting:
+			return cyclicDependencyError(table)
+		case visited:
+			return nil
+		}
+		state[table] = visiting
+		for _, dep := range f.DependsOn {
+			if dep == table {
+				continue
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[table] = visited
+		ordered = append(ordered, *f)
+		return nil
+	}
+	for _, table := range tables {
+		if err := visit(table); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
@@ -0,0 +1,95 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+// Fragment is a reusable set of SELECT columns, JOINs and WHERE conditions that can be applied to any SelectDataset
+// using SelectDataset#Apply (e.g. tenancy scoping or excluding soft deleted rows). A Fragment is immutable, every
+// method returns a new Fragment.
+//    notDeleted := NewFragment().Where(C("deleted_at").IsNull())
+//    From("users").Apply(notDeleted)
+//    // SELECT * FROM "users" WHERE ("deleted_at" IS NULL)
+type Fragment struct {
+	selects []interface{}
+	joins   []exp.JoinExpression
+	where   []exp.Expression
+}
+
+// NewFragment creates an empty Fragment.
+func NewFragment() Fragment {
+	return Fragment{}
+}
+
+// Select adds columns that are appended to the SELECT clause of the dataset (see SelectDataset#SelectAppend).
+func (f Fragment) Select(selects ...interface{}) Fragment {
+	ret := f
+	ret.selects = append(f.selects[:len(f.selects):len(f.selects)], selects...)
+	return ret
+}
+
+// Join adds an INNER JOIN clause.
+func (f Fragment) Join(table exp.Expression, condition exp.JoinCondition) Fragment {
+	return f.InnerJoin(table, condition)
+}
+
+// InnerJoin adds an INNER JOIN clause.
+func (f Fragment) InnerJoin(table exp.Expression, condition exp.JoinCondition) Fragment {
+	return f.joinTable(exp.NewConditionedJoinExpression(exp.InnerJoinType, table, condition))
+}
+
+// LeftJoin adds a LEFT JOIN clause.
+func (f Fragment) LeftJoin(table exp.Expression, condition exp.JoinCondition) Fragment {
+	return f.joinTable(exp.NewConditionedJoinExpression(exp.LeftJoinType, table, condition))
+}
+
+// Where adds conditions that are ANDed with the WHERE clause of the dataset.
+func (f Fragment) Where(expressions ...exp.Expression) Fragment {
+	ret := f
+	ret.where = append(f.where[:len(f.where):len(f.where)], expressions...)
+	return ret
+}
+
+// Merge returns a new Fragment with the clauses of this Fragment followed by the clauses of other.
+func (f Fragment) Merge(other Fragment) Fragment {
+	ret := f.Select(other.selects...).Where(other.where...)
+	ret.joins = append(ret.joins[:len(ret.joins):len(ret.joins)], other.joins...)
+	return ret
+}
+
+// Selects returns the columns added to the SELECT clause.
+func (f Fragment) Selects() []interface{} {
+	return f.selects
+}
+
+// Joins returns the JOIN clauses.
+func (f Fragment) Joins() []exp.JoinExpression {
+	return f.joins
+}
+
+// Wheres returns the conditions added to the WHERE clause.
+func (f Fragment) Wheres() []exp.Expression {
+	return f.where
+}
+
+// IsEmpty returns true if the Fragment does not have any clauses.
+func (f Fragment) IsEmpty() bool {
+	return len(f.selects) == 0 && len(f.joins) == 0 && len(f.where) == 0
+}
+
+func (f Fragment) joinTable(join exp.JoinExpression) Fragment {
+	ret := f
+	ret.joins = append(f.joins[:len(f.joins):len(f.joins)], join)
+	return ret
+}
+
+// applies the clauses of the Fragment to the clauses of a SelectDataset
+func (f Fragment) apply(c exp.SelectClauses) exp.SelectClauses {
+	if len(f.selects) > 0 {
+		c = c.SelectAppend(exp.NewColumnListExpression(f.selects...))
+	}
+	for _, j := range f.joins {
+		c = c.JoinsAppend(j)
+	}
+	return c.WhereAppend(f.where...)
+}
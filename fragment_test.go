@@ -0,0 +1,89 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type fragmentSuite struct {
+	suite.Suite
+}
+
+func TestFragmentSuite(t *testing.T) {
+	suite.Run(t, new(fragmentSuite))
+}
+
+func (fs *fragmentSuite) TestNewFragment() {
+	f := goqu.NewFragment()
+	fs.True(f.IsEmpty())
+	fs.Empty(f.Selects())
+	fs.Empty(f.Joins())
+	fs.Empty(f.Wheres())
+}
+
+func (fs *fragmentSuite) TestClauses() {
+	on := goqu.On(goqu.I("a.id").Eq(goqu.I("b.a_id")))
+	f := goqu.NewFragment().
+		Select("a", "b").
+		Join(goqu.T("b"), on).
+		LeftJoin(goqu.T("c"), on).
+		Where(goqu.C("x").Eq(1))
+	fs.False(f.IsEmpty())
+	fs.Equal([]interface{}{"a", "b"}, f.Selects())
+	fs.Equal([]exp.JoinExpression{
+		exp.NewConditionedJoinExpression(exp.InnerJoinType, goqu.T("b"), on),
+		exp.NewConditionedJoinExpression(exp.LeftJoinType, goqu.T("c"), on),
+	}, f.Joins())
+	fs.Equal([]exp.Expression{goqu.C("x").Eq(1)}, f.Wheres())
+}
+
+func (fs *fragmentSuite) TestImmutable() {
+	base := goqu.NewFragment().Where(goqu.C("a").Eq(1), goqu.C("b").Eq(2)).Select("a")
+	f1 := base.Where(goqu.C("c").Eq(3)).Select("b")
+	f2 := base.Where(goqu.C("d").Eq(4)).Select("c")
+	fs.Equal([]exp.Expression{goqu.C("a").Eq(1), goqu.C("b").Eq(2)}, base.Wheres())
+	fs.Equal([]exp.Expression{goqu.C("a").Eq(1), goqu.C("b").Eq(2), goqu.C("c").Eq(3)}, f1.Wheres())
+	fs.Equal([]exp.Expression{goqu.C("a").Eq(1), goqu.C("b").Eq(2), goqu.C("d").Eq(4)}, f2.Wheres())
+	fs.Equal([]interface{}{"a"}, base.Selects())
+	fs.Equal([]interface{}{"a", "b"}, f1.Selects())
+	fs.Equal([]interface{}{"a", "c"}, f2.Selects())
+}
+
+func (fs *fragmentSuite) TestMerge() {
+	on := goqu.On(goqu.I("a.id").Eq(goqu.I("b.a_id")))
+	f1 := goqu.NewFragment().Select("a").Where(goqu.C("x").Eq(1))
+	f2 := goqu.NewFragment().Select("b").Join(goqu.T("b"), on).Where(goqu.C("y").Eq(2))
+	m := f1.Merge(f2)
+	fs.Equal([]interface{}{"a", "b"}, m.Selects())
+	fs.Equal([]exp.JoinExpression{exp.NewConditionedJoinExpression(exp.InnerJoinType, goqu.T("b"), on)}, m.Joins())
+	fs.Equal([]exp.Expression{goqu.C("x").Eq(1), goqu.C("y").Eq(2)}, m.Wheres())
+	fs.Equal([]interface{}{"a"}, f1.Selects())
+}
+
+func (fs *fragmentSuite) TestApply() {
+	tenant := goqu.NewFragment().Where(goqu.I("orders.tenant_id").Eq(10))
+	notDeleted := goqu.NewFragment().Where(goqu.I("orders.deleted_at").IsNull())
+	withUser := goqu.NewFragment().
+		Select("users.name").
+		Join(goqu.T("users"), goqu.On(goqu.I("users.id").Eq(goqu.I("orders.user_id"))))
+
+	ds := goqu.From("orders").Select("orders.id").Where(goqu.I("orders.total").Gt(100))
+	sql, args, err := ds.Apply(tenant, notDeleted, withUser).ToSQL()
+	fs.NoError(err)
+	fs.Empty(args)
+	fs.Equal(`SELECT "orders"."id", "users"."name" FROM "orders" `+
+		`INNER JOIN "users" ON ("users"."id" = "orders"."user_id") `+
+		`WHERE (("orders"."total" > 100) AND ("orders"."tenant_id" = 10) AND ("orders"."deleted_at" IS NULL))`, sql)
+
+	sql, args, err = ds.Apply(tenant).Prepared(true).ToSQL()
+	fs.NoError(err)
+	fs.Equal([]interface{}{int64(100), int64(10)}, args)
+	fs.Equal(`SELECT "orders"."id" FROM "orders" WHERE (("orders"."total" > ?) AND ("orders"."tenant_id" = ?))`, sql)
+
+	// applying an empty fragment leaves the dataset unchanged
+	fs.Equal(ds.GetClauses(), ds.Apply(goqu.NewFragment()).GetClauses())
+	fs.Equal(ds.GetClauses(), ds.Apply().GetClauses())
+}
@@ -13,8 +13,10 @@ Please see https://github.com/doug-martin/goqu for an introduction to goqu.
 package goqu
 
 import (
+	"context"
 	"time"
 
+	"github.com/doug-martin/goqu/v9/exec"
 	"github.com/doug-martin/goqu/v9/internal/util"
 	"github.com/doug-martin/goqu/v9/sqlgen"
 )
@@ -53,11 +55,210 @@ func (dw DialectWrapper) Delete(table interface{}) *DeleteDataset {
 	return Delete(table).WithDialect(dw.dialect)
 }
 
+// Create a new dataset for creating MERGE sql statements
+func (dw DialectWrapper) Merge(target interface{}) *MergeDataset {
+	return Merge(target).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for calling a stored procedure
+func (dw DialectWrapper) Call(procedure interface{}, args ...interface{}) *CallDataset {
+	return Call(procedure, args...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating COPY ... FROM STDIN sql statements
+func (dw DialectWrapper) CopyFrom(table interface{}) *CopyDataset {
+	return CopyFrom(table).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating COPY ... TO STDOUT sql statements
+func (dw DialectWrapper) CopyTo(source interface{}) *CopyDataset {
+	return CopyTo(source).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating SET sql statements
+func (dw DialectWrapper) SetParam(param interface{}, values ...interface{}) *SetParamDataset {
+	return SetParam(param, values...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating SHOW sql statements
+func (dw DialectWrapper) Show(param interface{}) *ShowDataset {
+	return Show(param).WithDialect(dw.dialect)
+}
+
+// Create a new statement for sending a notification using NOTIFY or pg_notify
+func (dw DialectWrapper) Notify(channel, payload string) *NotifyStatement {
+	return newNotifyStatement(dw.dialect, nil, channel, payload)
+}
+
+// Create a new dataset for creating VACUUM sql statements
+func (dw DialectWrapper) Vacuum(tables ...interface{}) *MaintenanceDataset {
+	return Vacuum(tables...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating ANALYZE sql statements
+func (dw DialectWrapper) Analyze(tables ...interface{}) *MaintenanceDataset {
+	return Analyze(tables...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating OPTIMIZE sql statements
+func (dw DialectWrapper) Optimize(tables ...interface{}) *MaintenanceDataset {
+	return Optimize(tables...).WithDialect(dw.dialect)
+}
+
 // Create a new dataset for creating TRUNCATE sql statements
 func (dw DialectWrapper) Truncate(table ...interface{}) *TruncateDataset {
 	return Truncate(table...).WithDialect(dw.dialect)
 }
 
+// Create a new dataset for creating CREATE TABLE sql statements
+func (dw DialectWrapper) CreateTable(table interface{}) *CreateTableDataset {
+	return CreateTable(table).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating CREATE TABLE sql statements from a struct, see TableFromStruct
+func (dw DialectWrapper) TableFromStruct(i interface{}) *CreateTableDataset {
+	return TableFromStruct(i).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating ALTER TABLE sql statements
+func (dw DialectWrapper) AlterTable(table interface{}) *AlterTableDataset {
+	return AlterTable(table).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating CREATE INDEX sql statements
+func (dw DialectWrapper) CreateIndex(name string) *CreateIndexDataset {
+	return CreateIndex(name).WithDialect(dw.dialect)
+}
+
+// Create new datasets for creating the CREATE INDEX sql statements of a struct, see IndexesFromStruct
+func (dw DialectWrapper) IndexesFromStruct(i interface{}) ([]*CreateIndexDataset, error) {
+	return indexesFromStruct(i, dw.CreateIndex)
+}
+
+// Create a new dataset for creating CREATE VIEW sql statements
+func (dw DialectWrapper) CreateView(view interface{}) *CreateViewDataset {
+	return CreateView(view).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating CREATE MATERIALIZED VIEW sql statements
+func (dw DialectWrapper) CreateMaterializedView(view interface{}) *CreateViewDataset {
+	return CreateMaterializedView(view).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating REFRESH MATERIALIZED VIEW sql statements
+func (dw DialectWrapper) RefreshMaterializedView(view interface{}) *RefreshDataset {
+	return RefreshMaterializedView(view).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating CREATE SEQUENCE sql statements
+func (dw DialectWrapper) CreateSequence(sequence interface{}) *CreateSequenceDataset {
+	return CreateSequence(sequence).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating ALTER SEQUENCE sql statements
+func (dw DialectWrapper) AlterSequence(sequence interface{}) *AlterSequenceDataset {
+	return AlterSequence(sequence).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating CREATE SCHEMA sql statements
+func (dw DialectWrapper) CreateSchema(schema interface{}) *CreateSchemaDataset {
+	return CreateSchema(schema).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating CREATE DATABASE sql statements
+func (dw DialectWrapper) CreateDatabase(database interface{}) *CreateSchemaDataset {
+	return CreateDatabase(database).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating COMMENT ON TABLE sql statements
+func (dw DialectWrapper) CommentOnTable(table interface{}) *CommentDataset {
+	return CommentOnTable(table).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating COMMENT ON COLUMN sql statements
+func (dw DialectWrapper) CommentOnColumn(column interface{}) *CommentDataset {
+	return CommentOnColumn(column).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating COMMENT ON VIEW sql statements
+func (dw DialectWrapper) CommentOnView(view interface{}) *CommentDataset {
+	return CommentOnView(view).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating GRANT sql statements
+func (dw DialectWrapper) Grant(privileges ...string) *GrantDataset {
+	return Grant(privileges...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating REVOKE sql statements
+func (dw DialectWrapper) Revoke(privileges ...string) *GrantDataset {
+	return Revoke(privileges...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating CREATE TRIGGER sql statements
+func (dw DialectWrapper) CreateTrigger(trigger interface{}) *CreateTriggerDataset {
+	return CreateTrigger(trigger).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating CREATE FUNCTION sql statements
+func (dw DialectWrapper) CreateFunction(function interface{}) *CreateFunctionDataset {
+	return CreateFunction(function).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating DROP TABLE sql statements
+func (dw DialectWrapper) DropTable(tables ...interface{}) *DropDataset {
+	return DropTable(tables...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating DROP VIEW sql statements
+func (dw DialectWrapper) DropView(views ...interface{}) *DropDataset {
+	return DropView(views...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating DROP MATERIALIZED VIEW sql statements
+func (dw DialectWrapper) DropMaterializedView(views ...interface{}) *DropDataset {
+	return DropMaterializedView(views...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating DROP SEQUENCE sql statements
+func (dw DialectWrapper) DropSequence(sequences ...interface{}) *DropDataset {
+	return DropSequence(sequences...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating DROP SCHEMA sql statements
+func (dw DialectWrapper) DropSchema(schemas ...interface{}) *DropDataset {
+	return DropSchema(schemas...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating DROP DATABASE sql statements
+func (dw DialectWrapper) DropDatabase(databases ...interface{}) *DropDataset {
+	return DropDatabase(databases...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating DROP TRIGGER sql statements
+func (dw DialectWrapper) DropTrigger(triggers ...interface{}) *DropDataset {
+	return DropTrigger(triggers...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating DROP FUNCTION sql statements
+func (dw DialectWrapper) DropFunction(functions ...interface{}) *DropDataset {
+	return DropFunction(functions...).WithDialect(dw.dialect)
+}
+
+// Create a new dataset for creating DROP INDEX sql statements
+func (dw DialectWrapper) DropIndex(names ...interface{}) *DropDataset {
+	return DropIndex(names...).WithDialect(dw.dialect)
+}
+
+// Capabilities returns the features supported by the dialect so code shared between dialects can check for a feature
+// before using it.
+//    if goqu.Dialect("mysql").Capabilities().Returning {
+//        ...
+//    }
+func (dw DialectWrapper) Capabilities() DialectCapabilities {
+	return GetDialect(dw.dialect).Capabilities()
+}
+
 func (dw DialectWrapper) DB(db SQLDatabase) *Database {
 	return newDatabase(dw.dialect, db)
 }
@@ -84,3 +285,28 @@ func SetColumnRenameFunction(renameFunc func(string) string) {
 func SetTimeLocation(loc *time.Location) {
 	sqlgen.SetTimeLocation(loc)
 }
+
+// ErrNotFound is returned from ScanStruct and ScanVal methods when no rows are found and SetNotFoundError(true) has
+// been called.
+var ErrNotFound = exec.ErrNotFound
+
+// Set the behavior of ScanStruct and ScanVal methods when no rows are found.
+// By default this is false and they return false with a nil error; if set to true they will return false with
+// ErrNotFound.
+func SetNotFoundError(enabled bool) {
+	exec.SetNotFoundError(enabled)
+}
+
+// Enables reporting of *sql.Rows produced by goqu that are not closed within the window. If reporter is nil leaks are
+// written using the standard log package. A window <= 0 disables leak detection (DEFAULT). Statement handles (e.g.
+// Database.Prepare) and transactions are out of scope and are not tracked, see exec.SetRowsLeakDetection.
+// NOTE: This should only be used in development, a stack trace is captured for every query when enabled.
+func SetRowsLeakDetection(window time.Duration, reporter exec.RowsLeakReporter) {
+	exec.SetRowsLeakDetection(window, reporter)
+}
+
+// Generates the sql for each dataset and sends them in a single round trip using the exec.BatchSender, this is
+// typically an adapter around pgx SendBatch. See exec.BatchSender.
+func SendBatch(ctx context.Context, sender exec.BatchSender, datasets ...exec.Statement) ([]exec.BatchResult, error) {
+	return exec.SendBatch(ctx, sender, datasets...)
+}
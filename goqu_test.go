@@ -49,6 +49,200 @@ func (dws *dialectWrapperSuite) TestTruncate() {
 	dws.Equal(goqu.Truncate("table").WithDialect("test"), dw.Truncate("table"))
 }
 
+func (dws *dialectWrapperSuite) TestCreateTable() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CreateTable("table").WithDialect("test"), dw.CreateTable("table"))
+}
+
+func (dws *dialectWrapperSuite) TestAlterTable() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.AlterTable("table").WithDialect("test"), dw.AlterTable("table"))
+}
+
+func (dws *dialectWrapperSuite) TestCreateIndex() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CreateIndex("table_idx").WithDialect("test"), dw.CreateIndex("table_idx"))
+}
+
+func (dws *dialectWrapperSuite) TestTableFromStruct() {
+	type item struct {
+		ID int64 `db:"id"`
+	}
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.TableFromStruct(&item{}).WithDialect("test"), dw.TableFromStruct(&item{}))
+}
+
+func (dws *dialectWrapperSuite) TestIndexesFromStruct() {
+	type item struct {
+		ID int64 `db:"id" goqu:"index"`
+	}
+	dw := goqu.Dialect("test")
+	indexes, err := dw.IndexesFromStruct(&item{})
+	dws.NoError(err)
+	dws.Equal([]*goqu.CreateIndexDataset{
+		goqu.CreateIndex("item_id_idx").On("item").Columns("id").WithDialect("test"),
+	}, indexes)
+
+	_, err = dw.IndexesFromStruct("item")
+	dws.EqualError(err, "goqu: unsupported type string, a struct or a pointer to a struct is required")
+}
+
+func (dws *dialectWrapperSuite) TestCreateView() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CreateView("view").WithDialect("test"), dw.CreateView("view"))
+}
+
+func (dws *dialectWrapperSuite) TestCreateMaterializedView() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CreateMaterializedView("view").WithDialect("test"), dw.CreateMaterializedView("view"))
+}
+
+func (dws *dialectWrapperSuite) TestRefreshMaterializedView() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.RefreshMaterializedView("view").WithDialect("test"), dw.RefreshMaterializedView("view"))
+}
+
+func (dws *dialectWrapperSuite) TestCreateSequence() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CreateSequence("seq").WithDialect("test"), dw.CreateSequence("seq"))
+}
+
+func (dws *dialectWrapperSuite) TestAlterSequence() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.AlterSequence("seq").WithDialect("test"), dw.AlterSequence("seq"))
+}
+
+func (dws *dialectWrapperSuite) TestCreateSchema() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CreateSchema("tenant_1").WithDialect("test"), dw.CreateSchema("tenant_1"))
+}
+
+func (dws *dialectWrapperSuite) TestCreateDatabase() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CreateDatabase("tenant_1").WithDialect("test"), dw.CreateDatabase("tenant_1"))
+}
+
+func (dws *dialectWrapperSuite) TestCommentOn() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CommentOnTable("user").WithDialect("test"), dw.CommentOnTable("user"))
+	dws.Equal(goqu.CommentOnColumn("user.email").WithDialect("test"), dw.CommentOnColumn("user.email"))
+	dws.Equal(goqu.CommentOnView("active_user").WithDialect("test"), dw.CommentOnView("active_user"))
+}
+
+func (dws *dialectWrapperSuite) TestGrant() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.Grant("SELECT").WithDialect("test"), dw.Grant("SELECT"))
+	dws.Equal(goqu.Revoke("SELECT").WithDialect("test"), dw.Revoke("SELECT"))
+}
+
+func (dws *dialectWrapperSuite) TestCreateTrigger() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CreateTrigger("trigger").WithDialect("test"), dw.CreateTrigger("trigger"))
+}
+
+func (dws *dialectWrapperSuite) TestCall() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.Call("proc", 1).WithDialect("test"), dw.Call("proc", 1))
+}
+
+func (dws *dialectWrapperSuite) TestCopyFrom() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CopyFrom("table").WithDialect("test"), dw.CopyFrom("table"))
+}
+
+func (dws *dialectWrapperSuite) TestCopyTo() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CopyTo("table").WithDialect("test"), dw.CopyTo("table"))
+}
+
+func (dws *dialectWrapperSuite) TestSetParam() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.SetParam("param", 1).WithDialect("test"), dw.SetParam("param", 1))
+}
+
+func (dws *dialectWrapperSuite) TestShow() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.Show("param").WithDialect("test"), dw.Show("param"))
+}
+
+func (dws *dialectWrapperSuite) TestNotify() {
+	dw := goqu.Dialect("test")
+	sql, args, err := dw.Notify("events", "payload").ToSQL()
+	dws.EqualError(err, "goqu: dialect does not support LISTEN/NOTIFY [dialect=test]")
+	dws.Empty(sql)
+	dws.Empty(args)
+}
+
+func (dws *dialectWrapperSuite) TestVacuum() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.Vacuum("table").WithDialect("test"), dw.Vacuum("table"))
+}
+
+func (dws *dialectWrapperSuite) TestAnalyze() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.Analyze("table").WithDialect("test"), dw.Analyze("table"))
+}
+
+func (dws *dialectWrapperSuite) TestOptimize() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.Optimize("table").WithDialect("test"), dw.Optimize("table"))
+}
+
+func (dws *dialectWrapperSuite) TestMerge() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.Merge("table").WithDialect("test"), dw.Merge("table"))
+}
+
+func (dws *dialectWrapperSuite) TestCreateFunction() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.CreateFunction("fn").WithDialect("test"), dw.CreateFunction("fn"))
+}
+
+func (dws *dialectWrapperSuite) TestDropTable() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.DropTable("table").WithDialect("test"), dw.DropTable("table"))
+}
+
+func (dws *dialectWrapperSuite) TestDropView() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.DropView("view").WithDialect("test"), dw.DropView("view"))
+}
+
+func (dws *dialectWrapperSuite) TestDropMaterializedView() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.DropMaterializedView("view").WithDialect("test"), dw.DropMaterializedView("view"))
+}
+
+func (dws *dialectWrapperSuite) TestDropSequence() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.DropSequence("seq").WithDialect("test"), dw.DropSequence("seq"))
+}
+
+func (dws *dialectWrapperSuite) TestDropSchema() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.DropSchema("tenant_1").WithDialect("test"), dw.DropSchema("tenant_1"))
+}
+
+func (dws *dialectWrapperSuite) TestDropDatabase() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.DropDatabase("tenant_1").WithDialect("test"), dw.DropDatabase("tenant_1"))
+}
+
+func (dws *dialectWrapperSuite) TestDropTrigger() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.DropTrigger("trigger").WithDialect("test"), dw.DropTrigger("trigger"))
+}
+
+func (dws *dialectWrapperSuite) TestDropFunction() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.DropFunction("fn").WithDialect("test"), dw.DropFunction("fn"))
+}
+
+func (dws *dialectWrapperSuite) TestDropIndex() {
+	dw := goqu.Dialect("test")
+	dws.Equal(goqu.DropIndex("table_idx").WithDialect("test"), dw.DropIndex("table_idx"))
+}
+
 func (dws *dialectWrapperSuite) TestDB() {
 	mDB, _, err := sqlmock.New()
 	dws.Require().NoError(err)
@@ -0,0 +1,192 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// GrantDataset for creating and/or executing GRANT and REVOKE SQL statements.
+type GrantDataset struct {
+	dialect      SQLDialect
+	clauses      exp.GrantClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedGrantObjectType = errors.New(
+	"unsupported grant object type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newGrantDataset(d string, queryFactory exec.QueryFactory) *GrantDataset {
+	return &GrantDataset{
+		clauses:      exp.NewGrantClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// Grant creates a GrantDataset to grant privileges on an object to one or more roles. The privileges are written
+// as is (e.g. "SELECT", "UPDATE (name)", "ALL PRIVILEGES").
+//
+//	goqu.Grant("SELECT", "INSERT").On("user").To("tenant_a")
+func Grant(privileges ...string) *GrantDataset {
+	return newGrantDataset("default", nil).Privileges(privileges...)
+}
+
+// Revoke creates a GrantDataset to revoke privileges on an object from one or more roles.
+//
+//	goqu.Revoke("INSERT").On("user").From("tenant_a")
+func Revoke(privileges ...string) *GrantDataset {
+	return newGrantDataset("default", nil).revoke().Privileges(privileges...)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (gd *GrantDataset) WithDialect(dl string) *GrantDataset {
+	ds := gd.copy(gd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (gd *GrantDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the GrantDataset.
+func (gd *GrantDataset) Dialect() SQLDialect {
+	return gd.dialect
+}
+
+// SetDialect returns the current adapter on the GrantDataset.
+func (gd *GrantDataset) SetDialect(dialect SQLDialect) *GrantDataset {
+	cd := gd.copy(gd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns GrantDataset as exp.Expression.
+func (gd *GrantDataset) Expression() exp.Expression {
+	return gd
+}
+
+// Clone clones the GrantDataset.
+func (gd *GrantDataset) Clone() exp.Expression {
+	return gd.copy(gd.clauses)
+}
+
+// GetClauses returns the current clauses on the GrantDataset.
+func (gd *GrantDataset) GetClauses() exp.GrantClauses {
+	return gd.clauses
+}
+
+// used internally to copy the dataset.
+func (gd *GrantDataset) copy(clauses exp.GrantClauses) *GrantDataset {
+	return &GrantDataset{
+		dialect:      gd.dialect,
+		clauses:      clauses,
+		queryFactory: gd.queryFactory,
+		err:          gd.err,
+	}
+}
+
+// used internally to turn the dataset into a REVOKE statement.
+func (gd *GrantDataset) revoke() *GrantDataset {
+	return gd.copy(gd.clauses.SetRevoke(true))
+}
+
+// Privileges sets the privileges to grant or revoke, replacing any previously set privileges.
+func (gd *GrantDataset) Privileges(privileges ...string) *GrantDataset {
+	return gd.copy(gd.clauses.SetPrivileges(privileges))
+}
+
+// On sets the object the privileges apply to. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL (e.g. goqu.L("ALL TABLES IN SCHEMA ?", goqu.I("app")))
+func (gd *GrantDataset) On(object interface{}) *GrantDataset {
+	switch o := object.(type) {
+	case exp.Expression:
+		return gd.copy(gd.clauses.SetObject(o))
+	case string:
+		return gd.copy(gd.clauses.SetObject(exp.ParseIdentifier(o)))
+	default:
+		panic(ErrUnsupportedGrantObjectType)
+	}
+}
+
+// To sets the roles the privileges are granted to, replacing any previously set roles. Strings are turned into
+// identifiers, other expressions (e.g. goqu.L("PUBLIC")) are used as is.
+func (gd *GrantDataset) To(roles ...interface{}) *GrantDataset {
+	return gd.copy(gd.clauses.SetRoles(exp.NewColumnListExpression(roles...)))
+}
+
+// From sets the roles the privileges are revoked from, it is an alias for To that reads better with Revoke.
+func (gd *GrantDataset) From(roles ...interface{}) *GrantDataset {
+	return gd.To(roles...)
+}
+
+// WithGrantOption allows the roles to grant the privileges to other roles (e.g. GRANT ... WITH GRANT OPTION). When
+// revoking only the grant option is revoked (e.g. REVOKE GRANT OPTION FOR ...).
+func (gd *GrantDataset) WithGrantOption() *GrantDataset {
+	return gd.copy(gd.clauses.SetGrantOption(true))
+}
+
+// Cascade also revokes the privileges from roles the privileges were granted to by the roles.
+func (gd *GrantDataset) Cascade() *GrantDataset {
+	return gd.copy(gd.clauses.SetCascade(true))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (gd *GrantDataset) Error() error {
+	return gd.err
+}
+
+// SetError sets an error on the GrantDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (gd *GrantDataset) SetError(err error) *GrantDataset {
+	if gd.err == nil {
+		gd.err = err
+	}
+
+	return gd
+}
+
+// ToSQL generates a GRANT or REVOKE sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There are no privileges, object or roles
+//   - The dialect does not support GRANT and REVOKE or one of the options
+//   - There is an error generating the SQL
+func (gd *GrantDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return gd.grantSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (gd *GrantDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = gd.grantSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the GRANT or REVOKE sql, and returns an Exec struct with the sql set to the statement.
+//
+// db.Grant("SELECT").On("user").To("tenant_a").Executor().Exec()
+func (gd *GrantDataset) Executor() exec.QueryExecutor {
+	return gd.queryFactory.FromSQLBuilder(gd.grantSQLBuilder())
+}
+
+func (gd *GrantDataset) grantSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if gd.err != nil {
+		return buf.SetError(gd.err)
+	}
+	gd.dialect.ToGrantSQL(buf, gd.clauses)
+	return buf
+}
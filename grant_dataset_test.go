@@ -0,0 +1,239 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	grantTestCase struct {
+		ds      *goqu.GrantDataset
+		clauses exp.GrantClauses
+	}
+	grantDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (gds *grantDatasetSuite) assertCases(cases ...grantTestCase) {
+	for _, s := range cases {
+		gds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (gds *grantDatasetSuite) TestClone() {
+	ds := goqu.Grant("SELECT")
+	gds.Equal(ds, ds.Clone())
+}
+
+func (gds *grantDatasetSuite) TestExpression() {
+	ds := goqu.Grant("SELECT")
+	gds.Equal(ds, ds.Expression())
+}
+
+func (gds *grantDatasetSuite) TestDialect() {
+	ds := goqu.Grant("SELECT")
+	gds.NotNil(ds.Dialect())
+}
+
+func (gds *grantDatasetSuite) TestWithDialect() {
+	ds := goqu.Grant("SELECT")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	gds.Equal(md, ds.Dialect())
+	gds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (gds *grantDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.Grant("SELECT")
+	gds.False(ds.IsPrepared())
+}
+
+func (gds *grantDatasetSuite) TestGetClauses() {
+	ds := goqu.Grant("SELECT")
+	ce := exp.NewGrantClauses().SetPrivileges([]string{"SELECT"})
+	gds.Equal(ce, ds.GetClauses())
+}
+
+func (gds *grantDatasetSuite) TestRevoke() {
+	ce := exp.NewGrantClauses().SetPrivileges([]string{"SELECT", "INSERT"})
+	gds.assertCases(
+		grantTestCase{ds: goqu.Grant("SELECT", "INSERT"), clauses: ce},
+		grantTestCase{ds: goqu.Revoke("SELECT", "INSERT"), clauses: ce.SetRevoke(true)},
+		grantTestCase{ds: goqu.Revoke(), clauses: exp.NewGrantClauses().SetRevoke(true)},
+	)
+}
+
+func (gds *grantDatasetSuite) TestPrivileges() {
+	bd := goqu.Grant("SELECT")
+	ce := bd.GetClauses()
+	gds.assertCases(
+		grantTestCase{ds: bd.Privileges("INSERT", "UPDATE (name)"), clauses: ce.SetPrivileges([]string{"INSERT", "UPDATE (name)"})},
+		grantTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (gds *grantDatasetSuite) TestOn() {
+	bd := goqu.Grant("SELECT")
+	ce := bd.GetClauses()
+	gds.assertCases(
+		grantTestCase{ds: bd.On("user"), clauses: ce.SetObject(goqu.I("user"))},
+		grantTestCase{ds: bd.On("s.user"), clauses: ce.SetObject(goqu.I("s.user"))},
+		grantTestCase{ds: bd.On(goqu.S("s").Table("user")), clauses: ce.SetObject(goqu.S("s").Table("user"))},
+		grantTestCase{
+			ds:      bd.On(goqu.L("ALL TABLES IN SCHEMA ?", goqu.I("s"))),
+			clauses: ce.SetObject(goqu.L("ALL TABLES IN SCHEMA ?", goqu.I("s"))),
+		},
+		grantTestCase{ds: bd, clauses: ce},
+	)
+	gds.PanicsWithValue(goqu.ErrUnsupportedGrantObjectType, func() {
+		bd.On(true)
+	})
+}
+
+func (gds *grantDatasetSuite) TestTo() {
+	bd := goqu.Grant("SELECT").On("user")
+	ce := bd.GetClauses()
+	gds.assertCases(
+		grantTestCase{ds: bd.To("tenant_a"), clauses: ce.SetRoles(exp.NewColumnListExpression("tenant_a"))},
+		grantTestCase{
+			ds:      bd.To("tenant_a", goqu.L("PUBLIC")),
+			clauses: ce.SetRoles(exp.NewColumnListExpression("tenant_a", goqu.L("PUBLIC"))),
+		},
+		grantTestCase{ds: bd.To("tenant_a").To("tenant_b"), clauses: ce.SetRoles(exp.NewColumnListExpression("tenant_b"))},
+		grantTestCase{ds: bd.From("tenant_a"), clauses: ce.SetRoles(exp.NewColumnListExpression("tenant_a"))},
+		grantTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (gds *grantDatasetSuite) TestWithGrantOption() {
+	bd := goqu.Grant("SELECT")
+	ce := bd.GetClauses()
+	gds.assertCases(
+		grantTestCase{ds: bd.WithGrantOption(), clauses: ce.SetGrantOption(true)},
+		grantTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (gds *grantDatasetSuite) TestCascade() {
+	bd := goqu.Revoke("SELECT")
+	ce := bd.GetClauses()
+	gds.assertCases(
+		grantTestCase{ds: bd.Cascade(), clauses: ce.SetCascade(true)},
+		grantTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (gds *grantDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Grant("SELECT").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToGrantSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	gds.NoError(err)
+	gds.Empty(sql)
+	gds.Empty(args)
+	md.AssertExpectations(gds.T())
+}
+
+func (gds *grantDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Grant("SELECT").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToGrantSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	gds.Empty(sql)
+	gds.Empty(args)
+	gds.Equal(ee, err)
+	md.AssertExpectations(gds.T())
+}
+
+func (gds *grantDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	gds.NoError(err)
+
+	ds := goqu.New("mock", mDB).Grant("SELECT").On("user").To("tenant_a")
+
+	asql, args, err := ds.Executor().ToSQL()
+	gds.NoError(err)
+	gds.Empty(args)
+	gds.Equal(`GRANT SELECT ON "user" TO "tenant_a"`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	gds.NoError(err)
+	gds.Empty(args)
+	gds.Equal(`GRANT SELECT ON "user" TO "tenant_a"`, asql)
+}
+
+func (gds *grantDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.Grant("SELECT").SetDialect(md)
+	ds = ds.SetError(err1)
+	gds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	gds.Empty(sql)
+	gds.Empty(args)
+	gds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	gds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	gds.Empty(sql)
+	gds.Empty(args)
+	gds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.On("user").To("tenant_a")
+	gds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	gds.Empty(sql)
+	gds.Empty(args)
+	gds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToGrantSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	gds.Empty(sql)
+	gds.Empty(args)
+	gds.Equal(err1, err)
+}
+
+func TestGrantDataset(t *testing.T) {
+	suite.Run(t, new(grantDatasetSuite))
+}
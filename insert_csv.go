@@ -0,0 +1,224 @@
+package goqu
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/doug-martin/goqu/v9/internal/errors"
+)
+
+type (
+	// CSVConverter converts a single csv field into the value to insert.
+	CSVConverter func(field string) (interface{}, error)
+
+	// CSVInsert streams rows from a csv.Reader into batched INSERT statements. The first record read from the
+	// csv.Reader is used as the header.
+	CSVInsert struct {
+		ds         *InsertDataset
+		reader     *csv.Reader
+		mapping    map[string]string
+		converters map[string]CSVConverter
+		batchSize  int
+	}
+)
+
+// DefaultCSVBatchSize is the number of rows inserted per statement when using InsertDataset#FromCSV
+const DefaultCSVBatchSize = 1000
+
+var (
+	errInvalidCSVBatchSize = errors.New("csv batch size must be greater than 0")
+	errNoCSVColumns        = errors.New("no csv headers were mapped to columns")
+)
+
+func csvMissingFieldError(column string, line int) error {
+	return errors.New("csv record is missing a field [column=%s, line=%d]", column, line)
+}
+
+func csvConvertError(column string, line int, err error) error {
+	return errors.New("unable to convert csv field [column=%s, line=%d]: %s", column, line, err.Error())
+}
+
+// FromCSV creates a CSVInsert that will read rows from the csv.Reader and insert them into the table of this
+// InsertDataset in batches. The first record is used as the header, by default each header is used as the column name
+// and every field is inserted as a string.
+//    inserted, err := db.Insert("users").FromCSV(csv.NewReader(f)).
+//        WithColumnMapping(map[string]string{"First Name": "first_name", "Age": "age"}).
+//        WithConverter("age", goqu.CSVInt).
+//        Exec()
+func (id *InsertDataset) FromCSV(r *csv.Reader) *CSVInsert {
+	return &CSVInsert{ds: id, reader: r, batchSize: DefaultCSVBatchSize}
+}
+
+func (ci *CSVInsert) copy() *CSVInsert {
+	ret := *ci
+	return &ret
+}
+
+// WithColumnMapping maps csv headers to column names. When a mapping is provided any header that is not mapped will
+// be skipped.
+func (ci *CSVInsert) WithColumnMapping(mapping map[string]string) *CSVInsert {
+	ret := ci.copy()
+	ret.mapping = mapping
+	return ret
+}
+
+// WithConverter sets the CSVConverter to use for the column (after mapping headers).
+func (ci *CSVInsert) WithConverter(column string, converter CSVConverter) *CSVInsert {
+	ret := ci.copy()
+	ret.converters = make(map[string]CSVConverter, len(ci.converters)+1)
+	for c, conv := range ci.converters {
+		ret.converters[c] = conv
+	}
+	ret.converters[column] = converter
+	return ret
+}
+
+// WithBatchSize sets the number of rows inserted per statement. (DEFAULT=DefaultCSVBatchSize)
+func (ci *CSVInsert) WithBatchSize(size int) *CSVInsert {
+	ret := ci.copy()
+	ret.batchSize = size
+	return ret
+}
+
+// Exec reads all records from the csv.Reader and inserts them, returning the total number of rows affected.
+func (ci *CSVInsert) Exec() (int64, error) {
+	return ci.ExecContext(context.Background())
+}
+
+// ExecContext see CSVInsert#Exec
+func (ci *CSVInsert) ExecContext(ctx context.Context) (int64, error) {
+	if ci.batchSize <= 0 {
+		return 0, errInvalidCSVBatchSize
+	}
+	header, err := ci.reader.Read()
+	if err != nil {
+		return 0, err
+	}
+	cols, indexes, err := ci.columns(header)
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	line := 1
+	batch := make([]Vals, 0, ci.batchSize)
+	for {
+		record, readErr := ci.reader.Read()
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return total, readErr
+		}
+		line++
+		vals, convErr := ci.convert(cols, indexes, record, line)
+		if convErr != nil {
+			return total, convErr
+		}
+		batch = append(batch, vals)
+		if len(batch) == ci.batchSize {
+			affected, insertErr := ci.insert(ctx, cols, batch)
+			total += affected
+			if insertErr != nil {
+				return total, insertErr
+			}
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		affected, insertErr := ci.insert(ctx, cols, batch)
+		total += affected
+		if insertErr != nil {
+			return total, insertErr
+		}
+	}
+	return total, nil
+}
+
+// returns the columns to insert and the index of the csv field for each column
+func (ci *CSVInsert) columns(header []string) (cols []interface{}, indexes []int, err error) {
+	for i, h := range header {
+		col := h
+		if ci.mapping != nil {
+			mapped, ok := ci.mapping[h]
+			if !ok {
+				continue
+			}
+			col = mapped
+		}
+		cols = append(cols, col)
+		indexes = append(indexes, i)
+	}
+	if len(cols) == 0 {
+		return nil, nil, errNoCSVColumns
+	}
+	return cols, indexes, nil
+}
+
+func (ci *CSVInsert) convert(cols []interface{}, indexes []int, record []string, line int) (Vals, error) {
+	vals := make(Vals, 0, len(cols))
+	for i, idx := range indexes {
+		col := cols[i].(string)
+		if idx >= len(record) {
+			return nil, csvMissingFieldError(col, line)
+		}
+		field := record[idx]
+		conv, ok := ci.converters[col]
+		if !ok {
+			vals = append(vals, field)
+			continue
+		}
+		v, err := conv(field)
+		if err != nil {
+			return nil, csvConvertError(col, line, err)
+		}
+		vals = append(vals, v)
+	}
+	return vals, nil
+}
+
+func (ci *CSVInsert) insert(ctx context.Context, cols []interface{}, batch []Vals) (int64, error) {
+	res, err := ci.ds.Cols(cols...).Vals(batch...).Executor().ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// CSVInt converts a csv field to an int64.
+func CSVInt(field string) (interface{}, error) {
+	return strconv.ParseInt(field, 10, 64)
+}
+
+// CSVFloat converts a csv field to a float64.
+func CSVFloat(field string) (interface{}, error) {
+	return strconv.ParseFloat(field, 64)
+}
+
+// CSVBool converts a csv field to a bool. See strconv.ParseBool for accepted values.
+func CSVBool(field string) (interface{}, error) {
+	return strconv.ParseBool(field)
+}
+
+// CSVTime returns a CSVConverter that parses a csv field into a time.Time using the layout.
+func CSVTime(layout string) CSVConverter {
+	return func(field string) (interface{}, error) {
+		return time.Parse(layout, field)
+	}
+}
+
+// CSVNullIfEmpty returns a CSVConverter that converts empty fields to NULL and uses the converter for all other
+// fields. If converter is nil the field is inserted as a string.
+func CSVNullIfEmpty(converter CSVConverter) CSVConverter {
+	return func(field string) (interface{}, error) {
+		if field == "" {
+			return nil, nil
+		}
+		if converter == nil {
+			return field, nil
+		}
+		return converter(field)
+	}
+}
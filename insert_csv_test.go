@@ -0,0 +1,118 @@
+package goqu_test
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type csvInsertSuite struct {
+	suite.Suite
+}
+
+func TestCSVInsertSuite(t *testing.T) {
+	suite.Run(t, new(csvInsertSuite))
+}
+
+func (cis *csvInsertSuite) TestExec() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	cis.Require().NoError(err)
+	sqlMock.ExpectExec(`INSERT INTO "users" ("name", "age") VALUES ('Bob', '10'), ('Sally', '20')`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	db := goqu.New("default", mDB)
+	r := csv.NewReader(strings.NewReader("name,age\nBob,10\nSally,20\n"))
+	inserted, err := db.Insert("users").FromCSV(r).Exec()
+	cis.NoError(err)
+	cis.Equal(int64(2), inserted)
+	cis.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (cis *csvInsertSuite) TestExec_withMappingAndConverters() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	cis.Require().NoError(err)
+	sqlMock.ExpectExec(
+		`INSERT INTO "users" ("first_name", "age", "active", "created") ` +
+			`VALUES ('Bob', 10, TRUE, '2021-01-02T00:00:00Z'), ('Sally', NULL, FALSE, '2021-01-03T00:00:00Z')`,
+	).WillReturnResult(sqlmock.NewResult(0, 2))
+
+	db := goqu.New("default", mDB)
+	r := csv.NewReader(strings.NewReader(
+		"First Name,Ignored,Age,Active,Created\nBob,x,10,true,2021-01-02\nSally,y,,false,2021-01-03\n",
+	))
+	inserted, err := db.Insert("users").FromCSV(r).
+		WithColumnMapping(map[string]string{
+			"First Name": "first_name",
+			"Age":        "age",
+			"Active":     "active",
+			"Created":    "created",
+		}).
+		WithConverter("age", goqu.CSVNullIfEmpty(goqu.CSVInt)).
+		WithConverter("active", goqu.CSVBool).
+		WithConverter("created", goqu.CSVTime("2006-01-02")).
+		Exec()
+	cis.NoError(err)
+	cis.Equal(int64(2), inserted)
+	cis.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (cis *csvInsertSuite) TestExec_withBatchSize() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	cis.Require().NoError(err)
+	sqlMock.ExpectExec(`INSERT INTO "items" ("id") VALUES (?), (?)`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectExec(`INSERT INTO "items" ("id") VALUES (?)`).
+		WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	db := goqu.New("default", mDB)
+	r := csv.NewReader(strings.NewReader("id\n1\n2\n3\n"))
+	inserted, err := db.Insert("items").Prepared(true).FromCSV(r).
+		WithConverter("id", goqu.CSVInt).
+		WithBatchSize(2).
+		Exec()
+	cis.NoError(err)
+	cis.Equal(int64(3), inserted)
+	cis.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (cis *csvInsertSuite) TestExec_errors() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	cis.Require().NoError(err)
+	sqlMock.ExpectExec(`INSERT INTO "items" ("id") VALUES (1)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	db := goqu.New("default", mDB)
+
+	_, err = db.Insert("items").FromCSV(csv.NewReader(strings.NewReader("id\n1\n"))).WithBatchSize(0).Exec()
+	cis.EqualError(err, "goqu: csv batch size must be greater than 0")
+
+	_, err = db.Insert("items").FromCSV(csv.NewReader(strings.NewReader("id\n1\n"))).
+		WithColumnMapping(map[string]string{"other": "other"}).
+		Exec()
+	cis.EqualError(err, "goqu: no csv headers were mapped to columns")
+
+	inserted, err := db.Insert("items").FromCSV(csv.NewReader(strings.NewReader("id\n1\na\n"))).
+		WithConverter("id", goqu.CSVInt).
+		WithBatchSize(1).
+		Exec()
+	cis.EqualError(err, `goqu: unable to convert csv field [column=id, line=3]: strconv.ParseInt: parsing "a": invalid syntax`)
+	cis.Equal(int64(1), inserted)
+	cis.NoError(sqlMock.ExpectationsWereMet())
+
+	r := csv.NewReader(strings.NewReader("id,name\n1\n"))
+	r.FieldsPerRecord = -1
+	_, err = db.Insert("items").FromCSV(r).Exec()
+	cis.EqualError(err, "goqu: csv record is missing a field [column=name, line=2]")
+}
+
+func (cis *csvInsertSuite) TestCSVTime() {
+	v, err := goqu.CSVTime(time.RFC3339)("2021-01-02T03:04:05Z")
+	cis.NoError(err)
+	cis.Equal(time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC), v)
+}
@@ -112,8 +112,30 @@ func (id *InsertDataset) With(name string, subquery exp.Expression) *InsertDatas
 // The name will refer to the results of the specified subquery. The subquery for
 // a recursive query will always end with a UNION or UNION ALL with a clause that
 // refers to the CTE by name.
-func (id *InsertDataset) WithRecursive(name string, subquery exp.Expression) *InsertDataset {
-	return id.copy(id.clauses.CommonTablesAppend(exp.NewCommonTableExpression(true, name, subquery)))
+//
+// SEARCH and CYCLE clauses can be added by passing SearchDepthFirst, SearchBreadthFirst or Cycle expressions.
+func (id *InsertDataset) WithRecursive(name string, subquery exp.Expression, clauses ...exp.Expression) *InsertDataset {
+	return id.copy(id.clauses.CommonTablesAppend(newRecursiveCommonTable(name, subquery, clauses)))
+}
+
+// WithCTE adds a common table expression to the WITH clause, see SelectDataset#WithCTE.
+func (id *InsertDataset) WithCTE(cte exp.CommonTableExpression) *InsertDataset {
+	return id.copy(id.clauses.CommonTablesAppend(cte))
+}
+
+// WithMaterialized creates a WITH clause for a common table expression (CTE) that is computed once
+// (e.g. WITH name AS MATERIALIZED (...)). An error is returned when generating sql for dialects that do not support it.
+func (id *InsertDataset) WithMaterialized(name string, subquery exp.Expression) *InsertDataset {
+	cte := exp.NewCommonTableExpression(false, name, subquery).SetMaterialization(exp.MaterializedCTE)
+	return id.copy(id.clauses.CommonTablesAppend(cte))
+}
+
+// WithNotMaterialized creates a WITH clause for a common table expression (CTE) that may be folded into the parent
+// query (e.g. WITH name AS NOT MATERIALIZED (...)). An error is returned when generating sql for dialects that do not
+// support it.
+func (id *InsertDataset) WithNotMaterialized(name string, subquery exp.Expression) *InsertDataset {
+	cte := exp.NewCommonTableExpression(false, name, subquery).SetMaterialization(exp.NotMaterializedCTE)
+	return id.copy(id.clauses.CommonTablesAppend(cte))
 }
 
 // Into sets the table to insert INTO. This return a new InsertDataset with the original table replaced.
@@ -198,11 +220,41 @@ func (id *InsertDataset) ClearOnConflict() *InsertDataset {
 	return id.OnConflict(nil)
 }
 
+// OrIgnore generates an INSERT that ignores rows that would violate a constraint, the INSERT fragment is looked up
+// in the dialects ConflictResolutionLookup and an error is returned if the dialect does not support it.
+//    sqlite3: INSERT OR IGNORE INTO "items" ...
+//    mysql:   INSERT IGNORE INTO `items` ...
+func (id *InsertDataset) OrIgnore() *InsertDataset {
+	return id.copy(id.clauses.SetConflictResolution(exp.IgnoreConflictResolution))
+}
+
+// OrReplace generates an INSERT that replaces the rows that would violate a constraint, the INSERT fragment is looked
+// up in the dialects ConflictResolutionLookup and an error is returned if the dialect does not support it.
+//    sqlite3: INSERT OR REPLACE INTO "items" ...
+//    mysql:   REPLACE INTO `items` ...
+func (id *InsertDataset) OrReplace() *InsertDataset {
+	return id.copy(id.clauses.SetConflictResolution(exp.ReplaceConflictResolution))
+}
+
+// ClearConflictResolution removes the conflict resolution set with OrIgnore or OrReplace.
+func (id *InsertDataset) ClearConflictResolution() *InsertDataset {
+	return id.copy(id.clauses.SetConflictResolution(exp.NoConflictResolution))
+}
+
 // Error returns any error that has been set or nil if no error has been set.
 func (id *InsertDataset) Error() error {
 	return id.err
 }
 
+// ApplyIf returns the result of calling fn with the dataset if cond is true, see SelectDataset#ApplyIf.
+//    ds.ApplyIf(upsert, func(ds *InsertDataset) *InsertDataset { return ds.OnConflict(DoNothing()) })
+func (id *InsertDataset) ApplyIf(cond bool, fn func(*InsertDataset) *InsertDataset) *InsertDataset {
+	if !cond {
+		return id
+	}
+	return fn(id)
+}
+
 // SetError set an error on the InsertDataset if one has not already been set.
 // This error will be returned by a future call to Error or as part of ToSQL.
 // This can be used by end users to record errors while building up queries without having to track those separately.
@@ -245,6 +297,13 @@ func (id *InsertDataset) MustToSQL() (sql string, params []interface{}) {
 	return
 }
 
+// Explain creates an ExplainStatement that wraps the INSERT sql in the dialects EXPLAIN syntax.
+//
+//	plan, err := ds.Explain(exp.ExplainOptions{Analyze: true, Format: exp.ExplainFormatJSON}).Plan()
+func (id *InsertDataset) Explain(opts exp.ExplainOptions) *ExplainStatement {
+	return newExplainStatement(id, id.dialect, id.queryFactory, id.isPrepared.Bool(), opts)
+}
+
 // AppendSQL appends this InsertDataset's INSERT statement to the sb.SQLBuilder.
 // This is used internally when using inserts in CTEs.
 func (id *InsertDataset) AppendSQL(b sb.SQLBuilder) {
@@ -119,6 +119,48 @@ func (ids *insertDatasetSuite) TestWithRecursive() {
 	)
 }
 
+func (ids *insertDatasetSuite) TestWithCTE() {
+	cte := exp.NewCommonTableExpression(true, "test-cte", goqu.From("cte"))
+	bd := goqu.Insert("items")
+	ids.assertCases(
+		insertTestCase{
+			ds: bd.WithCTE(cte),
+			clauses: exp.NewInsertClauses().
+				SetInto(goqu.C("items")).
+				CommonTablesAppend(cte),
+		},
+		insertTestCase{
+			ds:      bd,
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")),
+		},
+	)
+}
+
+func (ids *insertDatasetSuite) TestWithMaterialized() {
+	from := goqu.From("cte")
+	bd := goqu.Insert("items")
+	ids.assertCases(
+		insertTestCase{
+			ds: bd.WithMaterialized("test-cte", from),
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")).
+				CommonTablesAppend(
+					exp.NewCommonTableExpression(false, "test-cte", from).SetMaterialization(exp.MaterializedCTE),
+				),
+		},
+		insertTestCase{
+			ds: bd.WithNotMaterialized("test-cte", from),
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")).
+				CommonTablesAppend(
+					exp.NewCommonTableExpression(false, "test-cte", from).SetMaterialization(exp.NotMaterializedCTE),
+				),
+		},
+		insertTestCase{
+			ds:      bd,
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")),
+		},
+	)
+}
+
 func (ids *insertDatasetSuite) TestInto() {
 	bd := goqu.Insert("items")
 	ids.assertCases(
@@ -416,6 +458,71 @@ func (ids *insertDatasetSuite) TestClearOnConflict() {
 	)
 }
 
+func (ids *insertDatasetSuite) TestOrIgnore() {
+	bd := goqu.Insert("items")
+	ids.assertCases(
+		insertTestCase{
+			ds: bd.OrIgnore(),
+			clauses: exp.NewInsertClauses().
+				SetInto(goqu.C("items")).
+				SetConflictResolution(exp.IgnoreConflictResolution),
+		},
+		insertTestCase{
+			ds:      bd,
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")),
+		},
+	)
+}
+
+func (ids *insertDatasetSuite) TestOrReplace() {
+	bd := goqu.Insert("items")
+	ids.assertCases(
+		insertTestCase{
+			ds: bd.OrReplace(),
+			clauses: exp.NewInsertClauses().
+				SetInto(goqu.C("items")).
+				SetConflictResolution(exp.ReplaceConflictResolution),
+		},
+		insertTestCase{
+			ds:      bd,
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")),
+		},
+	)
+}
+
+func (ids *insertDatasetSuite) TestClearConflictResolution() {
+	bd := goqu.Insert("items").OrReplace()
+	ids.assertCases(
+		insertTestCase{
+			ds:      bd.ClearConflictResolution(),
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")),
+		},
+		insertTestCase{
+			ds: bd,
+			clauses: exp.NewInsertClauses().
+				SetInto(goqu.C("items")).
+				SetConflictResolution(exp.ReplaceConflictResolution),
+		},
+	)
+}
+
+func (ids *insertDatasetSuite) TestApplyIf() {
+	bd := goqu.Insert("items")
+	returning := func(ds *goqu.InsertDataset) *goqu.InsertDataset { return ds.Returning("id") }
+	ids.assertCases(
+		insertTestCase{
+			ds: bd.ApplyIf(true, returning),
+			clauses: exp.NewInsertClauses().
+				SetInto(goqu.C("items")).
+				SetReturning(exp.NewColumnListExpression("id")),
+		},
+		insertTestCase{
+			ds:      bd.ApplyIf(false, returning),
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")),
+		},
+	)
+}
+
 func (ids *insertDatasetSuite) TestReturning() {
 	bd := goqu.Insert("items")
 	ids.assertCases(
@@ -2,6 +2,7 @@ package sb
 
 import (
 	"bytes"
+	"database/sql"
 )
 
 // Builder that is composed of a bytes.Buffer. It is used internally and by adapters to build SQL statements
@@ -10,12 +11,14 @@ type (
 		Error() error
 		SetError(err error) SQLBuilder
 		WriteArg(i ...interface{}) SQLBuilder
+		WriteNamedArg(name string, i interface{}) SQLBuilder
 		Write(p []byte) SQLBuilder
 		WriteStrings(ss ...string) SQLBuilder
 		WriteRunes(r ...rune) SQLBuilder
 		IsPrepared() bool
 		CurrentArgPosition() int
 		ToSQL() (sql string, args []interface{}, err error)
+		ToNamedSQL() (sql string, args []interface{}, err error)
 	}
 	sqlBuilder struct {
 		buf *bytes.Buffer
@@ -24,7 +27,9 @@ type (
 		// Current Number of arguments, used by adapters that need positional placeholders
 		currentArgPosition int
 		args               []interface{}
-		err                error
+		// The names of args written with WriteNamedArg keyed by their index in args
+		argNames map[int]string
+		err      error
 	}
 )
 
@@ -37,6 +42,20 @@ func NewSQLBuilder(isPrepared bool) SQLBuilder {
 	}
 }
 
+// Creates a new SQLBuilder that numbers positional placeholders starting at argPosition (e.g. $5), this is useful when
+// appending the generated SQL to SQL that already contains placeholders. Positions less than 1 start at 1.
+func NewSQLBuilderAt(isPrepared bool, argPosition int) SQLBuilder {
+	if argPosition < 1 {
+		argPosition = 1
+	}
+	return &sqlBuilder{
+		buf:                &bytes.Buffer{},
+		isPrepared:         isPrepared,
+		args:               make([]interface{}, 0),
+		currentArgPosition: argPosition,
+	}
+}
+
 func (b *sqlBuilder) Error() error {
 	return b.err
 }
@@ -92,6 +111,33 @@ func (b *sqlBuilder) WriteArg(i ...interface{}) SQLBuilder {
 	return b
 }
 
+// Adds an argument that should be bound by name (e.g. spanner @p1), the name is used by ToNamedSQL
+func (b *sqlBuilder) WriteNamedArg(name string, i interface{}) SQLBuilder {
+	if b.err == nil {
+		if b.argNames == nil {
+			b.argNames = make(map[int]string)
+		}
+		b.argNames[len(b.args)] = name
+		b.WriteArg(i)
+	}
+	return b
+}
+
+// Returns the sql string, and arguments. Arguments written with WriteNamedArg are returned as sql.NamedArg so they
+// can be bound by name when executing the statement.
+func (b *sqlBuilder) ToNamedSQL() (sql string, args []interface{}, err error) {
+	sql, args, err = b.ToSQL()
+	if err != nil || len(b.argNames) == 0 {
+		return sql, args, err
+	}
+	named := make([]interface{}, len(args))
+	copy(named, args)
+	for i, name := range b.argNames {
+		named[i] = namedArg(name, named[i])
+	}
+	return sql, named, nil
+}
+
 // Returns the sql string, and arguments.
 func (b *sqlBuilder) ToSQL() (sql string, args []interface{}, err error) {
 	if b.err != nil {
@@ -99,3 +145,7 @@ func (b *sqlBuilder) ToSQL() (sql string, args []interface{}, err error) {
 	}
 	return b.buf.String(), b.args, nil
 }
+
+func namedArg(name string, value interface{}) sql.NamedArg {
+	return sql.Named(name, value)
+}
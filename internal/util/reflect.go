@@ -13,6 +13,7 @@ const (
 	skipUpdateTagName     = "skipupdate"
 	skipInsertTagName     = "skipinsert"
 	defaultIfEmptyTagName = "defaultifempty"
+	secretTagName         = "secret"
 )
 
 var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
@@ -110,6 +111,11 @@ func SetColumnRenameFunction(newFunction func(string) string) {
 	columnRenameFunction = newFunction
 }
 
+// RenameColumn renames a name using the column rename function (see SetColumnRenameFunction).
+func RenameColumn(name string) string {
+	return columnRenameFunction(name)
+}
+
 // GetSliceElementType returns the type for a slices elements.
 func GetSliceElementType(val reflect.Value) reflect.Type {
 	elemType := val.Type().Elem()
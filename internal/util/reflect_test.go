@@ -402,6 +402,7 @@ func (rt *reflectTest) TestColumnRename() {
 	}
 	rt.Contains(upperKeys, "FIRSTUPPER")
 	rt.Contains(upperKeys, "LASTUPPER")
+	rt.Equal("FIRSTUPPER", util.RenameColumn("FirstUpper"))
 
 	util.SetColumnRenameFunction(util.DefaultColumnRenameFunction)
 }
@@ -686,6 +687,7 @@ func (rt *reflectTest) TestGetColumnMap_withStructGoquTags() {
 		Bool   bool   `goqu:"skipupdate"`
 		Empty  bool   `goqu:"defaultifempty"`
 		Valuer *sql.NullString
+		Secret string `goqu:"secret"`
 	}
 	var ts TestStruct
 	cm, err := util.GetColumnMap(&ts)
@@ -703,6 +705,14 @@ func (rt *reflectTest) TestGetColumnMap_withStructGoquTags() {
 			GoType:         reflect.TypeOf(true),
 		},
 		"valuer": {ColumnName: "valuer", FieldIndex: []int{4}, ShouldInsert: true, ShouldUpdate: true, GoType: reflect.TypeOf(&sql.NullString{})},
+		"secret": {
+			ColumnName:   "secret",
+			FieldIndex:   []int{5},
+			ShouldInsert: true,
+			ShouldUpdate: true,
+			Secret:       true,
+			GoType:       reflect.TypeOf(""),
+		},
 	}, cm)
 }
 
@@ -0,0 +1,159 @@
+package goqu
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+)
+
+type (
+	// NotifyStatement sends a notification to a channel (e.g. NOTIFY "events", 'payload'). When prepared the
+	// notification is sent using pg_notify so the channel and payload are passed as parameters
+	// (e.g. SELECT pg_notify($1, $2)).
+	NotifyStatement struct {
+		dialect      string
+		queryFactory exec.QueryFactory
+		channel      string
+		payload      string
+		isPrepared   prepared
+	}
+	// Subscription receives the notifications sent to the channels it is listening on. A Subscription is created
+	// using Database#Listen.
+	Subscription struct {
+		db       *Database
+		listener exec.Listener
+		channels []string
+	}
+)
+
+var errNoListenChannels = errors.New("at least one channel is required to listen")
+
+func errListenNotifyNotSupported(dialect string) error {
+	return errors.New("dialect does not support LISTEN/NOTIFY [dialect=%s]", dialect)
+}
+
+func newNotifyStatement(dialect string, queryFactory exec.QueryFactory, channel, payload string) *NotifyStatement {
+	return &NotifyStatement{
+		dialect:      dialect,
+		queryFactory: queryFactory,
+		channel:      channel,
+		payload:      payload,
+		isPrepared:   preparedNoPreference,
+	}
+}
+
+// Prepared set the parameter interpolation behavior.
+//
+// prepared: If true the notification is sent using pg_notify with the channel and payload as parameters.
+func (ns *NotifyStatement) Prepared(prepared bool) *NotifyStatement {
+	ret := *ns
+	ret.isPrepared = preparedFromBool(prepared)
+	return &ret
+}
+
+// IsPrepared returns true if Prepared(true) has been called on this NotifyStatement.
+func (ns *NotifyStatement) IsPrepared() bool {
+	return ns.isPrepared.Bool()
+}
+
+// ToSQL generates the NOTIFY sql, if Prepared has been called with true a SELECT pg_notify statement is generated
+// instead.
+//
+//	NOTIFY "events", 'payload'
+//	SELECT pg_notify($1, $2)
+func (ns *NotifyStatement) ToSQL() (sql string, args []interface{}, err error) {
+	opts := getDialectOptions(ns.dialect)
+	b := sb.NewSQLBuilder(false)
+	if !opts.SupportsListenNotify {
+		return b.SetError(errListenNotifyNotSupported(ns.dialect)).ToSQL()
+	}
+	if ns.IsPrepared() {
+		return Dialect(ns.dialect).Select(Func("pg_notify", ns.channel, ns.payload)).Prepared(true).ToSQL()
+	}
+	esg := sqlgen.NewExpressionSQLGenerator(ns.dialect, opts)
+	b.WriteStrings("NOTIFY ")
+	esg.Generate(b, I(ns.channel))
+	if ns.payload != "" {
+		b.WriteStrings(", ")
+		esg.Generate(b, ns.payload)
+	}
+	return b.ToSQL()
+}
+
+// Executor returns a QueryExecutor to send the notification.
+//
+//	db.Notify("events", `{"id": 1}`).Executor().Exec()
+func (ns *NotifyStatement) Executor() exec.QueryExecutor {
+	query, args, err := ns.ToSQL()
+	if err != nil {
+		return ns.queryFactory.FromSQLBuilder(sb.NewSQLBuilder(false).SetError(err))
+	}
+	return ns.queryFactory.FromSQL(query, args...)
+}
+
+// Channels returns the channels the Subscription is listening on.
+func (s *Subscription) Channels() []string {
+	return s.channels
+}
+
+// Next waits for the next notification sent to one of the channels or until the context is done.
+func (s *Subscription) Next(ctx context.Context) (*exec.Notification, error) {
+	return s.listener.WaitForNotification(ctx)
+}
+
+// Close stops listening on all channels (e.g. UNLISTEN "events").
+func (s *Subscription) Close(ctx context.Context) error {
+	for _, channel := range s.channels {
+		if err := s.db.execListen(ctx, s.listener, "UNLISTEN", channel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Listen starts listening on the channels (e.g. LISTEN "events") using the listener, which must wrap a dedicated
+// connection so notifications are not lost when the connection is returned to a pool. The statements are logged
+// using the Database logger.
+//
+//	sub, err := db.Listen(ctx, pgxListener{conn: conn}, "events")
+//	if err != nil {
+//		return err
+//	}
+//	defer sub.Close(ctx)
+//	for {
+//		n, err := sub.Next(ctx)
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(n.Channel, n.Payload)
+//	}
+func (d *Database) Listen(ctx context.Context, listener exec.Listener, channels ...string) (*Subscription, error) {
+	if len(channels) == 0 {
+		return nil, errNoListenChannels
+	}
+	for _, channel := range channels {
+		if err := d.execListen(ctx, listener, "LISTEN", channel); err != nil {
+			return nil, err
+		}
+	}
+	return &Subscription{db: d, listener: listener, channels: channels}, nil
+}
+
+func (d *Database) execListen(ctx context.Context, listener exec.Listener, op, channel string) error {
+	opts := getDialectOptions(d.dialect)
+	b := sb.NewSQLBuilder(false)
+	if !opts.SupportsListenNotify {
+		b.SetError(errListenNotifyNotSupported(d.dialect))
+	}
+	b.WriteStrings(op, " ")
+	sqlgen.NewExpressionSQLGenerator(d.dialect, opts).Generate(b, I(channel))
+	query, _, err := b.ToSQL()
+	if err != nil {
+		return err
+	}
+	d.Trace(op, query)
+	return listener.Exec(ctx, query)
+}
@@ -0,0 +1,147 @@
+package goqu_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	testListener struct {
+		queries       []string
+		notifications []*exec.Notification
+		err           error
+	}
+	listenNotifySuite struct {
+		suite.Suite
+	}
+)
+
+func (tl *testListener) Exec(_ context.Context, sql string) error {
+	if tl.err != nil {
+		return tl.err
+	}
+	tl.queries = append(tl.queries, sql)
+	return nil
+}
+
+func (tl *testListener) WaitForNotification(ctx context.Context) (*exec.Notification, error) {
+	if len(tl.notifications) == 0 {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	n := tl.notifications[0]
+	tl.notifications = tl.notifications[1:]
+	return n, nil
+}
+
+func TestListenNotifySuite(t *testing.T) {
+	suite.Run(t, new(listenNotifySuite))
+}
+
+func (lns *listenNotifySuite) TestNotify_ToSQL() {
+	d := goqu.Dialect("postgres")
+
+	query, args, err := d.Notify("events", "it's").ToSQL()
+	lns.NoError(err)
+	lns.Empty(args)
+	lns.Equal(`NOTIFY "events", 'it''s'`, query)
+
+	query, args, err = d.Notify("events", "").ToSQL()
+	lns.NoError(err)
+	lns.Empty(args)
+	lns.Equal(`NOTIFY "events"`, query)
+
+	ns := d.Notify("events", "it's").Prepared(true)
+	lns.True(ns.IsPrepared())
+	query, args, err = ns.ToSQL()
+	lns.NoError(err)
+	lns.Equal([]interface{}{"events", "it's"}, args)
+	lns.Equal(`SELECT pg_notify($1, $2)`, query)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+	query, _, err = d.Notify("events", "it's").ToSQL()
+	lns.NoError(err)
+	lns.Equal(`SELECT pg_notify($1, $2)`, query)
+	lns.False(d.Notify("events", "it's").Prepared(false).IsPrepared())
+}
+
+func (lns *listenNotifySuite) TestNotify_unsupportedDialect() {
+	_, _, err := goqu.Dialect("default").Notify("events", "payload").ToSQL()
+	lns.EqualError(err, "goqu: dialect does not support LISTEN/NOTIFY [dialect=default]")
+
+	_, _, err = goqu.Dialect("default").Notify("events", "payload").Prepared(true).ToSQL()
+	lns.EqualError(err, "goqu: dialect does not support LISTEN/NOTIFY [dialect=default]")
+}
+
+func (lns *listenNotifySuite) TestNotify_Executor() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	lns.Require().NoError(err)
+	sqlMock.ExpectExec(`NOTIFY "events", 'payload'`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`SELECT pg_notify($1, $2)`).
+		WithArgs("events", "payload").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	db := goqu.New("postgres", mDB)
+	_, err = db.Notify("events", "payload").Executor().Exec()
+	lns.NoError(err)
+
+	err = db.WithTx(func(tx *goqu.TxDatabase) error {
+		_, err := tx.Notify("events", "payload").Prepared(true).Executor().Exec()
+		return err
+	})
+	lns.NoError(err)
+	lns.NoError(sqlMock.ExpectationsWereMet())
+
+	_, err = goqu.New("default", mDB).Notify("events", "payload").Executor().Exec()
+	lns.EqualError(err, "goqu: dialect does not support LISTEN/NOTIFY [dialect=default]")
+}
+
+func (lns *listenNotifySuite) TestListen() {
+	mDB, _, err := sqlmock.New()
+	lns.Require().NoError(err)
+	db := goqu.New("postgres", mDB)
+
+	n := &exec.Notification{PID: 1, Channel: "events", Payload: "payload"}
+	l := &testListener{notifications: []*exec.Notification{n}}
+	sub, err := db.Listen(context.Background(), l, "events", "jobs")
+	lns.Require().NoError(err)
+	lns.Equal([]string{"events", "jobs"}, sub.Channels())
+	lns.Equal([]string{`LISTEN "events"`, `LISTEN "jobs"`}, l.queries)
+
+	actual, err := sub.Next(context.Background())
+	lns.NoError(err)
+	lns.Equal(n, actual)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = sub.Next(ctx)
+	lns.Equal(context.Canceled, err)
+
+	lns.NoError(sub.Close(context.Background()))
+	lns.Equal([]string{`LISTEN "events"`, `LISTEN "jobs"`, `UNLISTEN "events"`, `UNLISTEN "jobs"`}, l.queries)
+}
+
+func (lns *listenNotifySuite) TestListen_withError() {
+	mDB, _, err := sqlmock.New()
+	lns.Require().NoError(err)
+
+	_, err = goqu.New("postgres", mDB).Listen(context.Background(), &testListener{})
+	lns.EqualError(err, "goqu: at least one channel is required to listen")
+
+	_, err = goqu.New("default", mDB).Listen(context.Background(), &testListener{}, "events")
+	lns.EqualError(err, "goqu: dialect does not support LISTEN/NOTIFY [dialect=default]")
+
+	ee := errors.New("expected error")
+	_, err = goqu.New("postgres", mDB).Listen(context.Background(), &testListener{err: ee}, "events")
+	lns.Equal(ee, err)
+}
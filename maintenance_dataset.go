@@ -0,0 +1,167 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// MaintenanceDataset for creating and/or executing table maintenance statements (e.g. postgres VACUUM and ANALYZE,
+// mysql OPTIMIZE TABLE and ANALYZE TABLE).
+type MaintenanceDataset struct {
+	dialect      SQLDialect
+	clauses      exp.MaintenanceClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+// used internally by database to create a database with a specific adapter.
+func newMaintenanceDataset(d string, queryFactory exec.QueryFactory) *MaintenanceDataset {
+	return &MaintenanceDataset{
+		clauses:      exp.NewMaintenanceClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// Vacuum creates a MaintenanceDataset that reclaims the storage of the given tables, if no tables are given the
+// whole database is vacuumed.
+//
+//	goqu.Dialect("postgres").Vacuum("items").Options(exp.MaintenanceOptions{Analyze: true})
+func Vacuum(tables ...interface{}) *MaintenanceDataset {
+	return newMaintenanceDataset("default", nil).Operation(exp.VacuumOperation).Table(tables...)
+}
+
+// Analyze creates a MaintenanceDataset that updates the planner statistics of the given tables, if no tables are
+// given the whole database is analyzed.
+//
+//	goqu.Dialect("mysql").Analyze("items")
+func Analyze(tables ...interface{}) *MaintenanceDataset {
+	return newMaintenanceDataset("default", nil).Operation(exp.AnalyzeOperation).Table(tables...)
+}
+
+// Optimize creates a MaintenanceDataset that defragments the given tables.
+//
+//	goqu.Dialect("mysql").Optimize("items")
+func Optimize(tables ...interface{}) *MaintenanceDataset {
+	return newMaintenanceDataset("default", nil).Operation(exp.OptimizeOperation).Table(tables...)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (md *MaintenanceDataset) WithDialect(dl string) *MaintenanceDataset {
+	ds := md.copy(md.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, maintenance statements do not support placeholders.
+func (md *MaintenanceDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the MaintenanceDataset.
+func (md *MaintenanceDataset) Dialect() SQLDialect {
+	return md.dialect
+}
+
+// SetDialect sets the adapter on the MaintenanceDataset.
+func (md *MaintenanceDataset) SetDialect(dialect SQLDialect) *MaintenanceDataset {
+	ds := md.copy(md.GetClauses())
+	ds.dialect = dialect
+	return ds
+}
+
+// Expression returns MaintenanceDataset as exp.Expression.
+func (md *MaintenanceDataset) Expression() exp.Expression {
+	return md
+}
+
+// Clone clones the MaintenanceDataset.
+func (md *MaintenanceDataset) Clone() exp.Expression {
+	return md.copy(md.clauses)
+}
+
+// GetClauses returns the current clauses on the MaintenanceDataset.
+func (md *MaintenanceDataset) GetClauses() exp.MaintenanceClauses {
+	return md.clauses
+}
+
+// used internally to copy the dataset.
+func (md *MaintenanceDataset) copy(clauses exp.MaintenanceClauses) *MaintenanceDataset {
+	return &MaintenanceDataset{
+		dialect:      md.dialect,
+		clauses:      clauses,
+		queryFactory: md.queryFactory,
+		err:          md.err,
+	}
+}
+
+// Operation sets the maintenance operation to run (e.g. exp.VacuumOperation).
+func (md *MaintenanceDataset) Operation(op exp.MaintenanceOperation) *MaintenanceDataset {
+	return md.copy(md.clauses.SetOperation(op))
+}
+
+// Table sets the tables to run the maintenance operation on, any previously set tables are replaced.
+// You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+func (md *MaintenanceDataset) Table(tables ...interface{}) *MaintenanceDataset {
+	return md.copy(md.clauses.SetTables(exp.NewColumnListExpression(tables...)))
+}
+
+// Options sets the options of the maintenance operation (e.g. VACUUM (FULL, ANALYZE)).
+func (md *MaintenanceDataset) Options(opts exp.MaintenanceOptions) *MaintenanceDataset {
+	return md.copy(md.clauses.SetOptions(opts))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (md *MaintenanceDataset) Error() error {
+	return md.err
+}
+
+// SetError sets an error on the MaintenanceDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (md *MaintenanceDataset) SetError(err error) *MaintenanceDataset {
+	if md.err == nil {
+		md.err = err
+	}
+	return md
+}
+
+// ToSQL generates a VACUUM, ANALYZE or OPTIMIZE sql statement.
+//
+// Errors:
+//   - The dialect does not support the operation or one of the options
+//   - The dialect requires at least one table
+//   - There is an error generating the SQL
+func (md *MaintenanceDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return md.maintenanceSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (md *MaintenanceDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = md.maintenanceSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the maintenance sql, and returns an Exec struct with the sql set to the maintenance statement.
+// The statement is logged like any other statement executed by the Database.
+//
+// db.Vacuum("items").Options(exp.MaintenanceOptions{Analyze: true}).Executor().Exec()
+func (md *MaintenanceDataset) Executor() exec.QueryExecutor {
+	return md.queryFactory.FromSQLBuilder(md.maintenanceSQLBuilder())
+}
+
+func (md *MaintenanceDataset) maintenanceSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if md.err != nil {
+		return buf.SetError(md.err)
+	}
+	md.dialect.ToMaintenanceSQL(buf, md.clauses)
+	return buf
+}
@@ -0,0 +1,216 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	maintenanceTestCase struct {
+		ds      *goqu.MaintenanceDataset
+		clauses exp.MaintenanceClauses
+	}
+	maintenanceDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (mds *maintenanceDatasetSuite) assertCases(cases ...maintenanceTestCase) {
+	for _, s := range cases {
+		mds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (mds *maintenanceDatasetSuite) TestClone() {
+	ds := goqu.Vacuum("items")
+	mds.Equal(ds, ds.Clone())
+}
+
+func (mds *maintenanceDatasetSuite) TestExpression() {
+	ds := goqu.Vacuum("items")
+	mds.Equal(ds, ds.Expression())
+}
+
+func (mds *maintenanceDatasetSuite) TestDialect() {
+	ds := goqu.Vacuum("items")
+	mds.NotNil(ds.Dialect())
+}
+
+func (mds *maintenanceDatasetSuite) TestWithDialect() {
+	ds := goqu.Vacuum("items")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	mds.Equal(md, ds.Dialect())
+	mds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (mds *maintenanceDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.Vacuum("items")
+	mds.False(ds.IsPrepared())
+}
+
+func (mds *maintenanceDatasetSuite) TestGetClauses() {
+	ce := exp.NewMaintenanceClauses().SetTables(exp.NewColumnListExpression("items"))
+	mds.assertCases(
+		maintenanceTestCase{ds: goqu.Vacuum("items"), clauses: ce},
+		maintenanceTestCase{ds: goqu.Analyze("items"), clauses: ce.SetOperation(exp.AnalyzeOperation)},
+		maintenanceTestCase{ds: goqu.Optimize("items"), clauses: ce.SetOperation(exp.OptimizeOperation)},
+		maintenanceTestCase{
+			ds:      goqu.Vacuum(),
+			clauses: exp.NewMaintenanceClauses().SetTables(exp.NewColumnListExpression()),
+		},
+	)
+}
+
+func (mds *maintenanceDatasetSuite) TestOperation() {
+	bd := goqu.Vacuum("items")
+	ce := bd.GetClauses()
+	mds.assertCases(
+		maintenanceTestCase{ds: bd.Operation(exp.AnalyzeOperation), clauses: ce.SetOperation(exp.AnalyzeOperation)},
+		maintenanceTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (mds *maintenanceDatasetSuite) TestTable() {
+	bd := goqu.Vacuum("items")
+	ce := bd.GetClauses()
+	mds.assertCases(
+		maintenanceTestCase{
+			ds:      bd.Table("a", goqu.T("b").Schema("s")),
+			clauses: ce.SetTables(exp.NewColumnListExpression("a", goqu.T("b").Schema("s"))),
+		},
+		maintenanceTestCase{ds: bd.Table("a").Table("c"), clauses: ce.SetTables(exp.NewColumnListExpression("c"))},
+		maintenanceTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (mds *maintenanceDatasetSuite) TestOptions() {
+	bd := goqu.Vacuum("items")
+	ce := bd.GetClauses()
+	opts := exp.MaintenanceOptions{Full: true, Analyze: true}
+	mds.assertCases(
+		maintenanceTestCase{ds: bd.Options(opts), clauses: ce.SetOptions(opts)},
+		maintenanceTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (mds *maintenanceDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Vacuum("items").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToMaintenanceSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	mds.NoError(err)
+	mds.Empty(sql)
+	mds.Empty(args)
+	md.AssertExpectations(mds.T())
+}
+
+func (mds *maintenanceDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Vacuum("items").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToMaintenanceSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(ee, err)
+	md.AssertExpectations(mds.T())
+}
+
+func (mds *maintenanceDatasetSuite) TestMustToSQL() {
+	sql, args := goqu.Vacuum("items").Options(exp.MaintenanceOptions{Full: true, Analyze: true}).MustToSQL()
+	mds.Empty(args)
+	mds.Equal(`VACUUM (FULL, ANALYZE) "items"`, sql)
+
+	sql, args = goqu.Analyze().MustToSQL()
+	mds.Empty(args)
+	mds.Equal(`ANALYZE`, sql)
+
+	mds.Panics(func() {
+		goqu.Optimize("items").MustToSQL()
+	})
+}
+
+func (mds *maintenanceDatasetSuite) TestExecutor() {
+	mDB, sqlMock, err := sqlmock.New()
+	mds.NoError(err)
+
+	sqlMock.ExpectExec(`VACUUM \(ANALYZE\) "items"`).
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	ds := goqu.New("mock", mDB).Vacuum("items").Options(exp.MaintenanceOptions{Analyze: true})
+	_, err = ds.Executor().Exec()
+	mds.NoError(err)
+	mds.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (mds *maintenanceDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.Vacuum("items").SetDialect(md)
+	ds = ds.SetError(err1)
+	mds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	mds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.Table("a")
+	mds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToMaintenanceSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+}
+
+func TestMaintenanceDataset(t *testing.T) {
+	suite.Run(t, new(maintenanceDatasetSuite))
+}
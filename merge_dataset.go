@@ -0,0 +1,231 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// MergeDataset for creating and/or executing MERGE SQL statements.
+type MergeDataset struct {
+	dialect      SQLDialect
+	clauses      exp.MergeClauses
+	isPrepared   prepared
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var (
+	ErrUnsupportedMergeTargetType = errors.New(
+		"unsupported merge target type, a string or identifier expression is required",
+	)
+	ErrUnsupportedMergeSourceType = errors.New(
+		"unsupported merge source type, a string, identifier expression or dataset is required",
+	)
+)
+
+// used internally by database to create a database with a specific adapter
+func newMergeDataset(d string, queryFactory exec.QueryFactory) *MergeDataset {
+	return &MergeDataset{
+		clauses:      exp.NewMergeClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+		isPrepared:   preparedNoPreference,
+	}
+}
+
+// Merge creates a MergeDataset that merges rows into the target table.
+//
+//	goqu.Merge("user").
+//		Using(goqu.T("staged_user").As("s")).
+//		On(goqu.I("user.id").Eq(goqu.I("s.id"))).
+//		WhenMatchedThenUpdate(goqu.Record{"name": goqu.I("s.name")}).
+//		WhenNotMatchedThenInsert(goqu.Record{"id": goqu.I("s.id"), "name": goqu.I("s.name")})
+func Merge(target interface{}) *MergeDataset {
+	return newMergeDataset("default", nil).Target(target)
+}
+
+// Expression returns MergeDataset as exp.Expression.
+func (md *MergeDataset) Expression() exp.Expression {
+	return md
+}
+
+// Clone clones the MergeDataset.
+func (md *MergeDataset) Clone() exp.Expression {
+	return md.copy(md.clauses)
+}
+
+// Prepared set the parameter interpolation behavior.
+//
+// prepared: If true the dataset WILL NOT interpolate the parameters.
+func (md *MergeDataset) Prepared(prepared bool) *MergeDataset {
+	ret := md.copy(md.clauses)
+	ret.isPrepared = preparedFromBool(prepared)
+	return ret
+}
+
+// IsPrepared returns true if Prepared(true) has been called on this MergeDataset.
+func (md *MergeDataset) IsPrepared() bool {
+	return md.isPrepared.Bool()
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (md *MergeDataset) WithDialect(dl string) *MergeDataset {
+	ds := md.copy(md.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// Dialect returns the current SQLDialect on the MergeDataset.
+func (md *MergeDataset) Dialect() SQLDialect {
+	return md.dialect
+}
+
+// SetDialect sets the SQLDialect for this MergeDataset.
+func (md *MergeDataset) SetDialect(dialect SQLDialect) *MergeDataset {
+	cd := md.copy(md.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// GetClauses returns the current exp.MergeClauses on the MergeDataset.
+func (md *MergeDataset) GetClauses() exp.MergeClauses {
+	return md.clauses
+}
+
+// used internally to copy the MergeDataset.
+func (md *MergeDataset) copy(clauses exp.MergeClauses) *MergeDataset {
+	return &MergeDataset{
+		dialect:      md.dialect,
+		clauses:      clauses,
+		isPrepared:   md.isPrepared,
+		queryFactory: md.queryFactory,
+		err:          md.err,
+	}
+}
+
+// Target sets the table rows are merged into. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// AliasedExpression: (e.g. goqu.T("user").As("u"))
+func (md *MergeDataset) Target(target interface{}) *MergeDataset {
+	switch t := target.(type) {
+	case exp.IdentifierExpression, exp.AliasedExpression:
+		return md.copy(md.clauses.SetTarget(t.(exp.Expression)))
+	case string:
+		return md.copy(md.clauses.SetTarget(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedMergeTargetType)
+	}
+}
+
+// Using sets the source of the rows to merge. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// AliasedExpression: (e.g. goqu.T("staged_user").As("s"))
+// Dataset: Will be added as a sub select, use As to alias it (e.g. goqu.From("staged_user").As("s"))
+func (md *MergeDataset) Using(source interface{}) *MergeDataset {
+	switch s := source.(type) {
+	case exp.IdentifierExpression, exp.AliasedExpression, exp.AppendableExpression:
+		return md.copy(md.clauses.SetSource(s.(exp.Expression)))
+	case string:
+		return md.copy(md.clauses.SetSource(exp.ParseIdentifier(s)))
+	default:
+		panic(ErrUnsupportedMergeSourceType)
+	}
+}
+
+// On sets the conditions used to match the source rows to the target rows, multiple conditions are ANDed together.
+func (md *MergeDataset) On(conditions ...exp.Expression) *MergeDataset {
+	return md.copy(md.clauses.SetOn(exp.NewExpressionList(exp.AndType, conditions...)))
+}
+
+// WhenMatchedThenUpdate adds a WHEN MATCHED THEN UPDATE clause, the update can be anything accepted by
+// UpdateDataset#Set. If conditions are passed in the clause only applies to the matched rows that meet them
+// (e.g. WHEN MATCHED AND "s"."active" IS TRUE THEN UPDATE SET ...).
+func (md *MergeDataset) WhenMatchedThenUpdate(update interface{}, conditions ...exp.Expression) *MergeDataset {
+	return md.copy(md.clauses.WhenClausesAppend(exp.NewMergeUpdateClause(mergeCondition(conditions), update)))
+}
+
+// WhenMatchedThenDelete adds a WHEN MATCHED THEN DELETE clause. If conditions are passed in the clause only applies to
+// the matched rows that meet them (e.g. WHEN MATCHED AND "s"."deleted" IS TRUE THEN DELETE).
+func (md *MergeDataset) WhenMatchedThenDelete(conditions ...exp.Expression) *MergeDataset {
+	return md.copy(md.clauses.WhenClausesAppend(exp.NewMergeDeleteClause(mergeCondition(conditions))))
+}
+
+// WhenNotMatchedThenInsert adds a WHEN NOT MATCHED THEN INSERT clause, the row can be a single row accepted by
+// InsertDataset#Rows. If conditions are passed in the clause only applies to the source rows that meet them.
+func (md *MergeDataset) WhenNotMatchedThenInsert(row interface{}, conditions ...exp.Expression) *MergeDataset {
+	return md.copy(md.clauses.WhenClausesAppend(exp.NewMergeInsertClause(mergeCondition(conditions), row)))
+}
+
+// used internally to AND the conditions of a WHEN clause together
+func mergeCondition(conditions []exp.Expression) exp.ExpressionList {
+	if len(conditions) == 0 {
+		return nil
+	}
+	return exp.NewExpressionList(exp.AndType, conditions...)
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (md *MergeDataset) Error() error {
+	return md.err
+}
+
+// ApplyIf returns the result of calling fn with the dataset if cond is true, see SelectDataset#ApplyIf.
+//    ds.ApplyIf(deleteMatched, func(ds *MergeDataset) *MergeDataset { return ds.WhenMatchedThenDelete() })
+func (md *MergeDataset) ApplyIf(cond bool, fn func(*MergeDataset) *MergeDataset) *MergeDataset {
+	if !cond {
+		return md
+	}
+	return fn(md)
+}
+
+// SetError sets an error on the MergeDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (md *MergeDataset) SetError(err error) *MergeDataset {
+	if md.err == nil {
+		md.err = err
+	}
+	return md
+}
+
+// ToSQL generates a MERGE sql statement,
+// if Prepared has been called with true then the parameters will not be interpolated.
+//
+// Errors:
+//   - There is no target, source, ON condition or WHEN clause
+//   - The dialect does not support MERGE or one of the WHEN clauses
+//   - There is an error generating the SQL
+func (md *MergeDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return md.mergeSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (md *MergeDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = md.mergeSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor creates an QueryExecutor to execute the query.
+//
+// db.Merge("user").Using("staged_user").On(...).WhenMatchedThenDelete().Executor().Exec()
+func (md *MergeDataset) Executor() exec.QueryExecutor {
+	return md.queryFactory.FromSQLBuilder(md.mergeSQLBuilder())
+}
+
+func (md *MergeDataset) mergeSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(md.isPrepared.Bool())
+	if md.err != nil {
+		return buf.SetError(md.err)
+	}
+	md.dialect.ToMergeSQL(buf, md.clauses)
+	return buf
+}
@@ -0,0 +1,317 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	mergeTestCase struct {
+		ds      *goqu.MergeDataset
+		clauses exp.MergeClauses
+	}
+	mergeDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (mds *mergeDatasetSuite) assertCases(cases ...mergeTestCase) {
+	for _, s := range cases {
+		mds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (mds *mergeDatasetSuite) TestClone() {
+	ds := goqu.Merge("test")
+	mds.Equal(ds, ds.Clone())
+}
+
+func (mds *mergeDatasetSuite) TestExpression() {
+	ds := goqu.Merge("test")
+	mds.Equal(ds, ds.Expression())
+}
+
+func (mds *mergeDatasetSuite) TestDialect() {
+	ds := goqu.Merge("test")
+	mds.NotNil(ds.Dialect())
+}
+
+func (mds *mergeDatasetSuite) TestWithDialect() {
+	ds := goqu.Merge("test")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	mds.Equal(md, ds.Dialect())
+	mds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (mds *mergeDatasetSuite) TestPrepared() {
+	ds := goqu.Merge("test")
+	preparedDs := ds.Prepared(true)
+	mds.True(preparedDs.IsPrepared())
+	mds.False(ds.IsPrepared())
+	// should apply the prepared to any datasets created from the root
+	mds.True(preparedDs.Using("test2").IsPrepared())
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// should be prepared by default
+	ds = goqu.Merge("test")
+	mds.True(ds.IsPrepared())
+}
+
+func (mds *mergeDatasetSuite) TestGetClauses() {
+	ds := goqu.Merge("test")
+	ce := exp.NewMergeClauses().SetTarget(goqu.I("test"))
+	mds.Equal(ce, ds.GetClauses())
+}
+
+func (mds *mergeDatasetSuite) TestTarget() {
+	bd := goqu.Merge("test")
+	mds.assertCases(
+		mergeTestCase{ds: bd.Target("test2"), clauses: exp.NewMergeClauses().SetTarget(goqu.I("test2"))},
+		mergeTestCase{ds: bd.Target(goqu.T("test2")), clauses: exp.NewMergeClauses().SetTarget(goqu.T("test2"))},
+		mergeTestCase{
+			ds:      bd.Target(goqu.T("test2").As("t")),
+			clauses: exp.NewMergeClauses().SetTarget(goqu.T("test2").As("t")),
+		},
+		mergeTestCase{ds: bd, clauses: exp.NewMergeClauses().SetTarget(goqu.I("test"))},
+	)
+	mds.PanicsWithValue(goqu.ErrUnsupportedMergeTargetType, func() {
+		goqu.Merge(true)
+	})
+}
+
+func (mds *mergeDatasetSuite) TestUsing() {
+	bd := goqu.Merge("test")
+	source := goqu.From("staged").As("s")
+	mds.assertCases(
+		mergeTestCase{
+			ds:      bd.Using("staged"),
+			clauses: exp.NewMergeClauses().SetTarget(goqu.I("test")).SetSource(goqu.I("staged")),
+		},
+		mergeTestCase{
+			ds:      bd.Using(goqu.T("staged").As("s")),
+			clauses: exp.NewMergeClauses().SetTarget(goqu.I("test")).SetSource(goqu.T("staged").As("s")),
+		},
+		mergeTestCase{
+			ds:      bd.Using(source),
+			clauses: exp.NewMergeClauses().SetTarget(goqu.I("test")).SetSource(source),
+		},
+		mergeTestCase{ds: bd, clauses: exp.NewMergeClauses().SetTarget(goqu.I("test"))},
+	)
+	mds.PanicsWithValue(goqu.ErrUnsupportedMergeSourceType, func() {
+		bd.Using(true)
+	})
+}
+
+func (mds *mergeDatasetSuite) TestApplyIf() {
+	bd := goqu.Merge("test")
+	using := func(ds *goqu.MergeDataset) *goqu.MergeDataset { return ds.Using("staged") }
+	mds.assertCases(
+		mergeTestCase{
+			ds:      bd.ApplyIf(true, using),
+			clauses: exp.NewMergeClauses().SetTarget(goqu.I("test")).SetSource(goqu.I("staged")),
+		},
+		mergeTestCase{ds: bd.ApplyIf(false, using), clauses: exp.NewMergeClauses().SetTarget(goqu.I("test"))},
+	)
+}
+
+func (mds *mergeDatasetSuite) TestOn() {
+	bd := goqu.Merge("test")
+	on := goqu.I("test.id").Eq(goqu.I("staged.id"))
+	on2 := goqu.I("test.tenant_id").Eq(goqu.I("staged.tenant_id"))
+	mds.assertCases(
+		mergeTestCase{
+			ds:      bd.On(on, on2),
+			clauses: exp.NewMergeClauses().SetTarget(goqu.I("test")).SetOn(exp.NewExpressionList(exp.AndType, on, on2)),
+		},
+		mergeTestCase{
+			ds:      bd.On(on).On(on2),
+			clauses: exp.NewMergeClauses().SetTarget(goqu.I("test")).SetOn(exp.NewExpressionList(exp.AndType, on2)),
+		},
+		mergeTestCase{ds: bd, clauses: exp.NewMergeClauses().SetTarget(goqu.I("test"))},
+	)
+}
+
+func (mds *mergeDatasetSuite) TestWhenClauses() {
+	bd := goqu.Merge("test")
+	cond := goqu.I("staged.active").IsTrue()
+	cond2 := goqu.I("staged.deleted").IsFalse()
+	update := goqu.Record{"a": goqu.I("staged.a")}
+	row := goqu.Record{"id": goqu.I("staged.id")}
+	ec := exp.NewMergeClauses().SetTarget(goqu.I("test"))
+	mds.assertCases(
+		mergeTestCase{
+			ds:      bd.WhenMatchedThenUpdate(update),
+			clauses: ec.WhenClausesAppend(exp.NewMergeUpdateClause(nil, update)),
+		},
+		mergeTestCase{
+			ds: bd.WhenMatchedThenUpdate(update, cond, cond2),
+			clauses: ec.WhenClausesAppend(
+				exp.NewMergeUpdateClause(exp.NewExpressionList(exp.AndType, cond, cond2), update),
+			),
+		},
+		mergeTestCase{
+			ds: bd.WhenMatchedThenDelete(cond).WhenMatchedThenDelete(),
+			clauses: ec.
+				WhenClausesAppend(exp.NewMergeDeleteClause(exp.NewExpressionList(exp.AndType, cond))).
+				WhenClausesAppend(exp.NewMergeDeleteClause(nil)),
+		},
+		mergeTestCase{
+			ds:      bd.WhenNotMatchedThenInsert(row, cond),
+			clauses: ec.WhenClausesAppend(exp.NewMergeInsertClause(exp.NewExpressionList(exp.AndType, cond), row)),
+		},
+		mergeTestCase{ds: bd, clauses: ec},
+	)
+}
+
+func (mds *mergeDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Merge("test").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToMergeSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Nil(err)
+	md.AssertExpectations(mds.T())
+}
+
+func (mds *mergeDatasetSuite) TestToSQL_Prepared() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Merge("test").Prepared(true).SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(true)
+	md.On("ToMergeSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Nil(err)
+	md.AssertExpectations(mds.T())
+}
+
+func (mds *mergeDatasetSuite) TestToSQL_WithError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Merge("test").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToMergeSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(ee, err)
+	md.AssertExpectations(mds.T())
+}
+
+func (mds *mergeDatasetSuite) TestMustToSQL() {
+	ds := goqu.Merge("test").Using("staged").On(goqu.I("test.id").Eq(goqu.I("staged.id"))).WhenMatchedThenDelete()
+	sql, args := ds.MustToSQL()
+	mds.Empty(args)
+	mds.Equal(`MERGE INTO "test" USING "staged" ON ("test"."id" = "staged"."id") WHEN MATCHED THEN DELETE`, sql)
+
+	mds.Panics(func() {
+		goqu.Merge("test").MustToSQL()
+	})
+}
+
+func (mds *mergeDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	mds.NoError(err)
+
+	ds := goqu.New("mock", mDB).Merge("user").
+		Using(goqu.T("staged_user").As("s")).
+		On(goqu.I("user.id").Eq(goqu.I("s.id"))).
+		WhenMatchedThenUpdate(goqu.Record{"name": goqu.I("s.name")}).
+		WhenNotMatchedThenInsert(goqu.Record{"id": goqu.I("s.id"), "name": "unknown"})
+
+	msql, args, err := ds.Executor().ToSQL()
+	mds.NoError(err)
+	mds.Empty(args)
+	mds.Equal(`MERGE INTO "user" USING "staged_user" AS "s" ON ("user"."id" = "s"."id") `+
+		`WHEN MATCHED THEN UPDATE SET "name"="s"."name" `+
+		`WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("s"."id", 'unknown')`, msql)
+
+	msql, args, err = ds.Prepared(true).Executor().ToSQL()
+	mds.NoError(err)
+	mds.Equal([]interface{}{"unknown"}, args)
+	mds.Equal(`MERGE INTO "user" USING "staged_user" AS "s" ON ("user"."id" = "s"."id") `+
+		`WHEN MATCHED THEN UPDATE SET "name"="s"."name" `+
+		`WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("s"."id", ?)`, msql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	msql, args, err = ds.Executor().ToSQL()
+	mds.NoError(err)
+	mds.Equal([]interface{}{"unknown"}, args)
+	mds.Equal(`MERGE INTO "user" USING "staged_user" AS "s" ON ("user"."id" = "s"."id") `+
+		`WHEN MATCHED THEN UPDATE SET "name"="s"."name" `+
+		`WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("s"."id", ?)`, msql)
+}
+
+func (mds *mergeDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.Merge("test").SetDialect(md)
+	ds = ds.SetError(err1)
+	mds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	mds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.Using("staged")
+	mds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToMergeSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+}
+
+func TestMergeDataset(t *testing.T) {
+	suite.Run(t, new(mergeDatasetSuite))
+}
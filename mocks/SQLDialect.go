@@ -6,12 +6,27 @@ import exp "github.com/doug-martin/goqu/v9/exp"
 
 import mock "github.com/stretchr/testify/mock"
 import sb "github.com/doug-martin/goqu/v9/internal/sb"
+import sqlgen "github.com/doug-martin/goqu/v9/sqlgen"
 
 // SQLDialect is an autogenerated mock type for the SQLDialect type
 type SQLDialect struct {
 	mock.Mock
 }
 
+// Capabilities provides a mock function with given fields:
+func (_m *SQLDialect) Capabilities() sqlgen.DialectCapabilities {
+	ret := _m.Called()
+
+	var r0 sqlgen.DialectCapabilities
+	if rf, ok := ret.Get(0).(func() sqlgen.DialectCapabilities); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(sqlgen.DialectCapabilities)
+	}
+
+	return r0
+}
+
 // Dialect provides a mock function with given fields:
 func (_m *SQLDialect) Dialect() string {
 	ret := _m.Called()
@@ -26,21 +41,116 @@ func (_m *SQLDialect) Dialect() string {
 	return r0
 }
 
+// ToAlterSequenceSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToAlterSequenceSQL(b sb.SQLBuilder, clauses exp.AlterSequenceClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToAlterTableSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToAlterTableSQL(b sb.SQLBuilder, clauses exp.AlterTableClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToCallSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToCallSQL(b sb.SQLBuilder, clauses exp.CallClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToCommentSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToCommentSQL(b sb.SQLBuilder, clauses exp.CommentClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToCopySQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToCopySQL(b sb.SQLBuilder, clauses exp.CopyClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToCreateFunctionSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToCreateFunctionSQL(b sb.SQLBuilder, clauses exp.CreateFunctionClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToCreateIndexSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToCreateIndexSQL(b sb.SQLBuilder, clauses exp.CreateIndexClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToCreateSchemaSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToCreateSchemaSQL(b sb.SQLBuilder, clauses exp.CreateSchemaClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToCreateSequenceSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToCreateSequenceSQL(b sb.SQLBuilder, clauses exp.CreateSequenceClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToCreateTableSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToCreateTableSQL(b sb.SQLBuilder, clauses exp.CreateTableClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToCreateTriggerSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToCreateTriggerSQL(b sb.SQLBuilder, clauses exp.CreateTriggerClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToCreateViewSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToCreateViewSQL(b sb.SQLBuilder, clauses exp.CreateViewClauses) {
+	_m.Called(b, clauses)
+}
+
 // ToDeleteSQL provides a mock function with given fields: b, clauses
 func (_m *SQLDialect) ToDeleteSQL(b sb.SQLBuilder, clauses exp.DeleteClauses) {
 	_m.Called(b, clauses)
 }
 
+// ToDropSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToDropSQL(b sb.SQLBuilder, clauses exp.DropClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToGrantSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToGrantSQL(b sb.SQLBuilder, clauses exp.GrantClauses) {
+	_m.Called(b, clauses)
+}
+
 // ToInsertSQL provides a mock function with given fields: b, clauses
 func (_m *SQLDialect) ToInsertSQL(b sb.SQLBuilder, clauses exp.InsertClauses) {
 	_m.Called(b, clauses)
 }
 
+// ToMaintenanceSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToMaintenanceSQL(b sb.SQLBuilder, clauses exp.MaintenanceClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToMergeSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToMergeSQL(b sb.SQLBuilder, clauses exp.MergeClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToRefreshSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToRefreshSQL(b sb.SQLBuilder, clauses exp.RefreshClauses) {
+	_m.Called(b, clauses)
+}
+
 // ToSelectSQL provides a mock function with given fields: b, clauses
 func (_m *SQLDialect) ToSelectSQL(b sb.SQLBuilder, clauses exp.SelectClauses) {
 	_m.Called(b, clauses)
 }
 
+// ToSetParamSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToSetParamSQL(b sb.SQLBuilder, clauses exp.SetParamClauses) {
+	_m.Called(b, clauses)
+}
+
+// ToShowSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToShowSQL(b sb.SQLBuilder, clauses exp.ShowClauses) {
+	_m.Called(b, clauses)
+}
+
 // ToTruncateSQL provides a mock function with given fields: b, clauses
 func (_m *SQLDialect) ToTruncateSQL(b sb.SQLBuilder, clauses exp.TruncateClauses) {
 	_m.Called(b, clauses)
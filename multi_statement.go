@@ -0,0 +1,114 @@
+package goqu
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+)
+
+var errNoStatements = errors.New("at least one statement is required when executing multiple statements")
+
+func errMultipleStatementsNotSupported(dialect string) error {
+	return errors.New("dialect does not support multiple statements [dialect=%s]", dialect)
+}
+
+// Joins the sql for each statement into a single script using the dialects StatementSeparatorFragment. An error is
+// returned if the dialect does not support multiple statements or any statement returns an error.
+func multiStatementSQL(dialect string, statements []exec.Statement) (query string, args []interface{}, err error) {
+	opts := getDialectOptions(dialect)
+	if !opts.SupportsMultipleStatements {
+		return "", nil, errMultipleStatementsNotSupported(dialect)
+	}
+	if len(statements) == 0 {
+		return "", nil, errNoStatements
+	}
+	buf := make([]byte, 0, len(statements)*64)
+	for i, s := range statements {
+		stmtSQL, stmtArgs, stmtErr := s.ToSQL()
+		if stmtErr != nil {
+			return "", nil, stmtErr
+		}
+		if i > 0 {
+			buf = append(buf, opts.StatementSeparatorFragment...)
+		}
+		buf = append(buf, stmtSQL...)
+		args = append(args, stmtArgs...)
+	}
+	return string(buf), args, nil
+}
+
+// Joins the statements into a single script and executes it in one round trip. This is only supported by dialects
+// that set SupportsMultipleStatements (e.g. mysql).
+//
+// database/sql only reports a single result for a script and it is not a total, for mysql the driver resets the rows
+// affected and last insert id for each statement so the returned sql.Result is the result of the last statement.
+// Results for each statement are not returned, execute the statements one at a time or use a Batch with an
+// exec.BatchSender when they are needed, or QueryMulti to read the rows returned by each statement.
+//
+// NOTE: The connection must be configured to allow multiple statements, for mysql set multiStatements=true in the DSN.
+// If any of the statements are prepared you must also set interpolateParams=true.
+//
+// statements: the datasets to execute
+func (d *Database) ExecScript(statements ...exec.Statement) (sql.Result, error) {
+	return d.ExecScriptContext(context.Background(), statements...)
+}
+
+// See Database#ExecScript
+func (d *Database) ExecScriptContext(ctx context.Context, statements ...exec.Statement) (sql.Result, error) {
+	query, args, err := multiStatementSQL(d.dialect, statements)
+	if err != nil {
+		return nil, err
+	}
+	return d.ExecContext(ctx, query, args...)
+}
+
+// Joins the statements into a single script and executes it in one round trip, use sql.Rows#NextResultSet to move
+// to the results of the next statement. This is only supported by dialects that set SupportsMultipleStatements
+// (e.g. mysql).
+//
+// NOTE: The connection must be configured to allow multiple statements, for mysql set multiStatements=true in the DSN.
+// If any of the statements are prepared you must also set interpolateParams=true.
+//
+// statements: the datasets to execute
+func (d *Database) QueryMulti(statements ...exec.Statement) (*sql.Rows, error) {
+	return d.QueryMultiContext(context.Background(), statements...)
+}
+
+// See Database#QueryMulti
+func (d *Database) QueryMultiContext(ctx context.Context, statements ...exec.Statement) (*sql.Rows, error) {
+	query, args, err := multiStatementSQL(d.dialect, statements)
+	if err != nil {
+		return nil, err
+	}
+	return d.QueryContext(ctx, query, args...)
+}
+
+// See Database#ExecScript
+func (td *TxDatabase) ExecScript(statements ...exec.Statement) (sql.Result, error) {
+	return td.ExecScriptContext(context.Background(), statements...)
+}
+
+// See Database#ExecScriptContext
+func (td *TxDatabase) ExecScriptContext(ctx context.Context, statements ...exec.Statement) (sql.Result, error) {
+	query, args, err := multiStatementSQL(td.dialect, statements)
+	if err != nil {
+		return nil, err
+	}
+	return td.ExecContext(ctx, query, args...)
+}
+
+// See Database#QueryMulti
+func (td *TxDatabase) QueryMulti(statements ...exec.Statement) (*sql.Rows, error) {
+	return td.QueryMultiContext(context.Background(), statements...)
+}
+
+// See Database#QueryMultiContext
+func (td *TxDatabase) QueryMultiContext(ctx context.Context, statements ...exec.Statement) (*sql.Rows, error) {
+	query, args, err := multiStatementSQL(td.dialect, statements)
+	if err != nil {
+		return nil, err
+	}
+	return td.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,104 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	"github.com/stretchr/testify/suite"
+)
+
+type multiStatementSuite struct {
+	suite.Suite
+}
+
+func TestMultiStatementSuite(t *testing.T) {
+	suite.Run(t, new(multiStatementSuite))
+}
+
+func (mss *multiStatementSuite) TestExecScript() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	mss.NoError(err)
+	sqlMock.ExpectExec("INSERT INTO `items` (`name`) VALUES ('a'); UPDATE `items` SET `name`=? WHERE (`id` = ?)").
+		WithArgs("b", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	db := goqu.New("mysql", mDB)
+	res, err := db.ExecScript(
+		db.Insert("items").Rows(goqu.Record{"name": "a"}),
+		db.Update("items").Set(goqu.Record{"name": "b"}).Where(goqu.C("id").Eq(1)).Prepared(true),
+	)
+	mss.NoError(err)
+	rowsAffected, err := res.RowsAffected()
+	mss.NoError(err)
+	mss.Equal(int64(2), rowsAffected)
+	mss.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (mss *multiStatementSuite) TestQueryMulti() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	mss.NoError(err)
+	sqlMock.ExpectQuery("SELECT `id` FROM `items`; SELECT `name` FROM `users`").
+		WithArgs().
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id"}).AddRow(1),
+			sqlmock.NewRows([]string{"name"}).AddRow("Bob"),
+		)
+
+	db := goqu.New("mysql", mDB)
+	rows, err := db.QueryMulti(db.From("items").Select("id"), db.From("users").Select("name"))
+	mss.NoError(err)
+	defer func() { _ = rows.Close() }()
+
+	var id int64
+	mss.True(rows.Next())
+	mss.NoError(rows.Scan(&id))
+	mss.Equal(int64(1), id)
+	mss.True(rows.NextResultSet())
+
+	var name string
+	mss.True(rows.Next())
+	mss.NoError(rows.Scan(&name))
+	mss.Equal("Bob", name)
+	mss.False(rows.Next())
+}
+
+func (mss *multiStatementSuite) TestExecScript_withTx() {
+	mDB, sqlMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	mss.NoError(err)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec("DELETE `items` FROM `items`; DELETE `users` FROM `users`").
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	db := goqu.New("mysql", mDB)
+	mss.NoError(db.WithTx(func(tx *goqu.TxDatabase) error {
+		_, err := tx.ExecScript(tx.Delete("items"), tx.Delete("users"))
+		return err
+	}))
+	mss.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (mss *multiStatementSuite) TestExecScript_notSupported() {
+	mDB, _, err := sqlmock.New()
+	mss.NoError(err)
+
+	db := goqu.New("db-mock", mDB)
+	_, err = db.ExecScript(db.Delete("items"))
+	mss.EqualError(err, "goqu: dialect does not support multiple statements [dialect=db-mock]")
+	_, err = db.QueryMulti(db.From("items"))
+	mss.EqualError(err, "goqu: dialect does not support multiple statements [dialect=db-mock]")
+}
+
+func (mss *multiStatementSuite) TestExecScript_errors() {
+	mDB, _, err := sqlmock.New()
+	mss.NoError(err)
+
+	db := goqu.New("mysql", mDB)
+	_, err = db.ExecScript()
+	mss.EqualError(err, "goqu: at least one statement is required when executing multiple statements")
+	_, err = db.ExecScript(db.Delete("items"), db.Update("items"))
+	mss.EqualError(err, "goqu: no set values found when generating UPDATE sql")
+}
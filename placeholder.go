@@ -0,0 +1,29 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// PositionedExpression is implemented by datasets that can generate SQL starting at a placeholder position.
+type PositionedExpression interface {
+	exp.AppendableExpression
+	IsPrepared() bool
+}
+
+// ToSQLAt generates the SQL for a dataset numbering positional placeholders (e.g. postgres $1) starting at argPosition,
+// the position of the next placeholder is also returned. This is useful when appending the SQL generated by goqu to
+// SQL that was built externally and already contains placeholders.
+//    sql, args, next, err := goqu.ToSQLAt(
+//        goqu.Dialect("postgres").From("test").Where(goqu.C("a").Eq(1)).Prepared(true), 5,
+//    )
+//    // SELECT * FROM "test" WHERE ("a" = $5) [1] 6 <nil>
+func ToSQLAt(e PositionedExpression, argPosition int) (sql string, args []interface{}, nextArgPosition int, err error) {
+	b := sb.NewSQLBuilderAt(e.IsPrepared(), argPosition)
+	e.AppendSQL(b)
+	sql, args, err = b.ToSQL()
+	if err != nil {
+		return "", nil, 0, err
+	}
+	return sql, args, b.CurrentArgPosition(), nil
+}
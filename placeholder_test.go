@@ -0,0 +1,62 @@
+package goqu_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/stretchr/testify/suite"
+)
+
+type placeholderSuite struct {
+	suite.Suite
+}
+
+func TestPlaceholderSuite(t *testing.T) {
+	suite.Run(t, new(placeholderSuite))
+}
+
+func (ps *placeholderSuite) TestToSQLAt() {
+	pg := goqu.Dialect("postgres")
+
+	sql, args, next, err := goqu.ToSQLAt(pg.From("test").Where(goqu.C("a").Eq(1), goqu.C("b").Eq("x"), goqu.C("c").Gt(2)).Prepared(true), 5)
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "test" WHERE (("a" = $5) AND ("b" = $6) AND ("c" > $7))`, sql)
+	ps.Equal([]interface{}{int64(1), "x", int64(2)}, args)
+	ps.Equal(8, next)
+
+	sql, args, next, err = goqu.ToSQLAt(pg.Insert("test").Rows(goqu.Record{"a": 1}).Prepared(true), 2)
+	ps.NoError(err)
+	ps.Equal(`INSERT INTO "test" ("a") VALUES ($2)`, sql)
+	ps.Equal([]interface{}{int64(1)}, args)
+	ps.Equal(3, next)
+
+	sql, args, next, err = goqu.ToSQLAt(pg.Update("test").Set(goqu.Record{"a": 1}).Prepared(true), 3)
+	ps.NoError(err)
+	ps.Equal(`UPDATE "test" SET "a"=$3`, sql)
+	ps.Equal([]interface{}{int64(1)}, args)
+	ps.Equal(4, next)
+
+	sql, args, next, err = goqu.ToSQLAt(pg.Delete("test").Where(goqu.C("a").Eq(1)).Prepared(true), 0)
+	ps.NoError(err)
+	ps.Equal(`DELETE FROM "test" WHERE ("a" = $1)`, sql)
+	ps.Equal([]interface{}{int64(1)}, args)
+	ps.Equal(2, next)
+}
+
+func (ps *placeholderSuite) TestToSQLAt_notPrepared() {
+	sql, args, next, err := goqu.ToSQLAt(goqu.Dialect("postgres").From("test").Where(goqu.C("a").Eq(1)), 5)
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "test" WHERE ("a" = 1)`, sql)
+	ps.Empty(args)
+	ps.Equal(5, next)
+}
+
+func (ps *placeholderSuite) TestToSQLAt_withError() {
+	sql, args, next, err := goqu.ToSQLAt(goqu.From("test").SetError(errors.New("expected error")), 5)
+	ps.EqualError(err, "expected error")
+	ps.Empty(sql)
+	ps.Nil(args)
+	ps.Zero(next)
+}
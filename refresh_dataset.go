@@ -0,0 +1,159 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// RefreshDataset for creating and/or executing REFRESH MATERIALIZED VIEW SQL statements.
+type RefreshDataset struct {
+	dialect      SQLDialect
+	clauses      exp.RefreshClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedRefreshViewType = errors.New(
+	"unsupported view type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newRefreshDataset(d string, queryFactory exec.QueryFactory) *RefreshDataset {
+	return &RefreshDataset{
+		clauses:      exp.NewRefreshClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// RefreshMaterializedView creates a RefreshDataset to refresh the data of a materialized view.
+//
+//	goqu.RefreshMaterializedView("user_totals").Concurrently()
+func RefreshMaterializedView(view interface{}) *RefreshDataset {
+	return newRefreshDataset("default", nil).View(view)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (rd *RefreshDataset) WithDialect(dl string) *RefreshDataset {
+	ds := rd.copy(rd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, DDL statements do not support placeholders so the values are always interpolated.
+func (rd *RefreshDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the RefreshDataset.
+func (rd *RefreshDataset) Dialect() SQLDialect {
+	return rd.dialect
+}
+
+// SetDialect returns the current adapter on the RefreshDataset.
+func (rd *RefreshDataset) SetDialect(dialect SQLDialect) *RefreshDataset {
+	cd := rd.copy(rd.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Expression returns RefreshDataset as exp.Expression.
+func (rd *RefreshDataset) Expression() exp.Expression {
+	return rd
+}
+
+// Clone clones the RefreshDataset.
+func (rd *RefreshDataset) Clone() exp.Expression {
+	return rd.copy(rd.clauses)
+}
+
+// GetClauses returns the current clauses on the RefreshDataset.
+func (rd *RefreshDataset) GetClauses() exp.RefreshClauses {
+	return rd.clauses
+}
+
+// used internally to copy the dataset.
+func (rd *RefreshDataset) copy(clauses exp.RefreshClauses) *RefreshDataset {
+	return &RefreshDataset{
+		dialect:      rd.dialect,
+		clauses:      clauses,
+		queryFactory: rd.queryFactory,
+		err:          rd.err,
+	}
+}
+
+// View sets the materialized view to refresh. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier
+// IdentifierExpression
+// LiteralExpression: (See Literal) Will use the literal SQL
+func (rd *RefreshDataset) View(view interface{}) *RefreshDataset {
+	switch t := view.(type) {
+	case exp.Expression:
+		return rd.copy(rd.clauses.SetView(t))
+	case string:
+		return rd.copy(rd.clauses.SetView(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedRefreshViewType)
+	}
+}
+
+// Concurrently refreshes the view without locking out reads (e.g. postgres REFRESH MATERIALIZED VIEW CONCURRENTLY),
+// postgres requires a unique index on the view to refresh it concurrently.
+func (rd *RefreshDataset) Concurrently() *RefreshDataset {
+	return rd.copy(rd.clauses.SetConcurrently(true))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (rd *RefreshDataset) Error() error {
+	return rd.err
+}
+
+// SetError sets an error on the RefreshDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (rd *RefreshDataset) SetError(err error) *RefreshDataset {
+	if rd.err == nil {
+		rd.err = err
+	}
+
+	return rd
+}
+
+// ToSQL generates a REFRESH MATERIALIZED VIEW sql statement, DDL statements are always interpolated.
+//
+// Errors:
+//   - There is no view
+//   - The dialect does not support materialized views
+//   - There is an error generating the SQL
+func (rd *RefreshDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return rd.refreshSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (rd *RefreshDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = rd.refreshSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the REFRESH MATERIALIZED VIEW sql, and returns an Exec struct with the sql set to the
+// REFRESH MATERIALIZED VIEW statement.
+//
+// db.RefreshMaterializedView("test_view").Concurrently().Executor().Exec()
+func (rd *RefreshDataset) Executor() exec.QueryExecutor {
+	return rd.queryFactory.FromSQLBuilder(rd.refreshSQLBuilder())
+}
+
+func (rd *RefreshDataset) refreshSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if rd.err != nil {
+		return buf.SetError(rd.err)
+	}
+	rd.dialect.ToRefreshSQL(buf, rd.clauses)
+	return buf
+}
@@ -0,0 +1,194 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	refreshTestCase struct {
+		ds      *goqu.RefreshDataset
+		clauses exp.RefreshClauses
+	}
+	refreshDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (rds *refreshDatasetSuite) assertCases(cases ...refreshTestCase) {
+	for _, s := range cases {
+		rds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (rds *refreshDatasetSuite) TestClone() {
+	ds := goqu.RefreshMaterializedView("test_view")
+	rds.Equal(ds, ds.Clone())
+}
+
+func (rds *refreshDatasetSuite) TestExpression() {
+	ds := goqu.RefreshMaterializedView("test_view")
+	rds.Equal(ds, ds.Expression())
+}
+
+func (rds *refreshDatasetSuite) TestDialect() {
+	ds := goqu.RefreshMaterializedView("test_view")
+	rds.NotNil(ds.Dialect())
+}
+
+func (rds *refreshDatasetSuite) TestWithDialect() {
+	ds := goqu.RefreshMaterializedView("test_view")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	rds.Equal(md, ds.Dialect())
+	rds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (rds *refreshDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.RefreshMaterializedView("test_view")
+	rds.False(ds.IsPrepared())
+}
+
+func (rds *refreshDatasetSuite) TestGetClauses() {
+	ds := goqu.RefreshMaterializedView("test_view")
+	ce := exp.NewRefreshClauses().SetView(goqu.I("test_view"))
+	rds.Equal(ce, ds.GetClauses())
+}
+
+func (rds *refreshDatasetSuite) TestView() {
+	bd := goqu.RefreshMaterializedView("test")
+	rds.assertCases(
+		refreshTestCase{ds: bd.View("test2"), clauses: exp.NewRefreshClauses().SetView(goqu.I("test2"))},
+		refreshTestCase{
+			ds:      bd.View(goqu.S("s").Table("test2")),
+			clauses: exp.NewRefreshClauses().SetView(goqu.S("s").Table("test2")),
+		},
+		refreshTestCase{ds: bd, clauses: exp.NewRefreshClauses().SetView(goqu.I("test"))},
+	)
+	rds.PanicsWithValue(goqu.ErrUnsupportedRefreshViewType, func() {
+		goqu.RefreshMaterializedView(true)
+	})
+}
+
+func (rds *refreshDatasetSuite) TestConcurrently() {
+	bd := goqu.RefreshMaterializedView("test")
+	ce := bd.GetClauses()
+	rds.assertCases(
+		refreshTestCase{ds: bd.Concurrently(), clauses: ce.SetConcurrently(true)},
+		refreshTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (rds *refreshDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.RefreshMaterializedView("test_view").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToRefreshSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	rds.NoError(err)
+	rds.Empty(sql)
+	rds.Empty(args)
+	md.AssertExpectations(rds.T())
+}
+
+func (rds *refreshDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.RefreshMaterializedView("test_view").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToRefreshSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	rds.Empty(sql)
+	rds.Empty(args)
+	rds.Equal(ee, err)
+	md.AssertExpectations(rds.T())
+}
+
+func (rds *refreshDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	rds.NoError(err)
+
+	ds := goqu.New("mock", mDB).RefreshMaterializedView("test_view").Concurrently()
+
+	asql, args, err := ds.Executor().ToSQL()
+	rds.NoError(err)
+	rds.Empty(args)
+	rds.Equal(`REFRESH MATERIALIZED VIEW CONCURRENTLY "test_view"`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// DDL statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	rds.NoError(err)
+	rds.Empty(args)
+	rds.Equal(`REFRESH MATERIALIZED VIEW CONCURRENTLY "test_view"`, asql)
+}
+
+func (rds *refreshDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.RefreshMaterializedView("test_view").SetDialect(md)
+	ds = ds.SetError(err1)
+	rds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	rds.Empty(sql)
+	rds.Empty(args)
+	rds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	rds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	rds.Empty(sql)
+	rds.Empty(args)
+	rds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.Concurrently()
+	rds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	rds.Empty(sql)
+	rds.Empty(args)
+	rds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToRefreshSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	rds.Empty(sql)
+	rds.Empty(args)
+	rds.Equal(err1, err)
+}
+
+func TestRefreshDataset(t *testing.T) {
+	suite.Run(t, new(refreshDatasetSuite))
+}
@@ -0,0 +1,38 @@
+package goqu
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9/exec"
+)
+
+// Executable is implemented by any dataset that can create an exec.QueryExecutor
+type Executable interface {
+	Executor() exec.QueryExecutor
+}
+
+// ScanVal generates the sql for the dataset and scans the first column of the first row into a value of type T.
+// This method returns a boolean value that is false if no record was found
+//    count, _, err := goqu.ScanVal[int64](db.From("test").Select(goqu.COUNT("*")))
+func ScanVal[T any](ds Executable) (T, bool, error) {
+	return exec.ScanVal[T](ds.Executor())
+}
+
+// ScanValContext generates the sql for the dataset and scans the first column of the first row into a value of
+// type T. This method returns a boolean value that is false if no record was found
+//    count, _, err := goqu.ScanValContext[int64](ctx, db.From("test").Select(goqu.COUNT("*")))
+func ScanValContext[T any](ctx context.Context, ds Executable) (T, bool, error) {
+	return exec.ScanValContext[T](ctx, ds.Executor())
+}
+
+// ScanVals generates the sql for the dataset and scans the first column of every row into a slice of T.
+//    ids, err := goqu.ScanVals[int64](db.From("test").Select("id"))
+func ScanVals[T any](ds Executable) ([]T, error) {
+	return exec.ScanVals[T](ds.Executor())
+}
+
+// ScanValsContext generates the sql for the dataset and scans the first column of every row into a slice of T.
+//    ids, err := goqu.ScanValsContext[int64](ctx, db.From("test").Select("id"))
+func ScanValsContext[T any](ctx context.Context, ds Executable) ([]T, error) {
+	return exec.ScanValsContext[T](ctx, ds.Executor())
+}
@@ -0,0 +1,89 @@
+package goqu_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type scanGenericSuite struct {
+	suite.Suite
+}
+
+func TestScanGenericSuite(t *testing.T) {
+	suite.Run(t, new(scanGenericSuite))
+}
+
+func (sgs *scanGenericSuite) TestScanVal() {
+	mDB, sqlMock, err := sqlmock.New()
+	sgs.NoError(err)
+	sqlMock.ExpectQuery(`SELECT COUNT\(\*\) AS "count" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).FromCSVString("10"))
+	sqlMock.ExpectQuery(`SELECT "name" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+	sqlMock.ExpectQuery(`SELECT "name" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnError(fmt.Errorf("query error"))
+
+	db := goqu.New("mock", mDB)
+	count, found, err := goqu.ScanVal[int64](db.From("items").Select(goqu.COUNT("*").As("count")).Limit(1))
+	sgs.NoError(err)
+	sgs.True(found)
+	sgs.Equal(int64(10), count)
+
+	name, found, err := goqu.ScanValContext[string](context.Background(), db.From("items").Select("name").Limit(1))
+	sgs.NoError(err)
+	sgs.False(found)
+	sgs.Empty(name)
+
+	_, found, err = goqu.ScanVal[string](db.From("items").Select("name").Limit(1))
+	sgs.EqualError(err, "query error")
+	sgs.False(found)
+}
+
+func (sgs *scanGenericSuite) TestScanVal_withNotFoundError() {
+	defer goqu.SetNotFoundError(false)
+	goqu.SetNotFoundError(true)
+	mDB, sqlMock, err := sqlmock.New()
+	sgs.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "name" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+	db := goqu.New("mock", mDB)
+	_, found, err := goqu.ScanVal[string](db.From("items").Select("name").Limit(1))
+	sgs.Equal(goqu.ErrNotFound, err)
+	sgs.False(found)
+}
+
+func (sgs *scanGenericSuite) TestScanVals() {
+	mDB, sqlMock, err := sqlmock.New()
+	sgs.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).FromCSVString("1\n2\n3"))
+	sqlMock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Bob\nSally"))
+	sqlMock.ExpectQuery(`SELECT "id" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).FromCSVString("a"))
+
+	db := goqu.New("mock", mDB)
+	ids, err := goqu.ScanVals[int64](db.From("items").Select("id"))
+	sgs.NoError(err)
+	sgs.Equal([]int64{1, 2, 3}, ids)
+
+	names, err := goqu.ScanValsContext[string](context.Background(), db.From("items").Select("name"))
+	sgs.NoError(err)
+	sgs.Equal([]string{"Bob", "Sally"}, names)
+
+	_, err = goqu.ScanVals[int64](db.From("items").Select("id"))
+	sgs.Error(err)
+}
@@ -0,0 +1,227 @@
+package goqu
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+)
+
+// SchemaDiff compares two schemas and generates the statements to migrate from one to the other. A schema is
+// described by CreateTableDatasets (e.g. from TableFromStruct), tables are matched by their name.
+//
+// By default the diff is additive only:
+//   - A CREATE TABLE statement is generated for each table that does not exist
+//   - An ADD COLUMN action is generated for each column that does not exist
+//   - An ADD CONSTRAINT action is generated for each table constraint that does not exist
+//
+// Use DropColumns and AlterColumns to also generate the actions that drop or change existing columns. Tables are
+// never dropped, and changes to the PRIMARY KEY, UNIQUE and AUTO_INCREMENT of existing columns are not detected.
+type SchemaDiff struct {
+	from         []*CreateTableDataset
+	to           []*CreateTableDataset
+	dropColumns  bool
+	alterColumns bool
+}
+
+var (
+	errDiffTableRequired      = errors.New("a table is required to diff schemas")
+	errDiffTableNotIdentifier = errors.New("unable to diff a table that is not an identifier")
+)
+
+// DiffSchemas creates a SchemaDiff to migrate the tables in from to the tables in to.
+//
+//	statements, err := goqu.DiffSchemas(
+//		[]*goqu.CreateTableDataset{goqu.CreateTable("user").Columns(goqu.ColumnDef("id", goqu.BigIntType()))},
+//		[]*goqu.CreateTableDataset{goqu.TableFromStruct(&User{}), goqu.TableFromStruct(&Order{})},
+//	).Statements()
+func DiffSchemas(from, to []*CreateTableDataset) *SchemaDiff {
+	return &SchemaDiff{from: from, to: to}
+}
+
+// DiffTables creates a SchemaDiff to migrate a single table, from may be nil if the table does not exist.
+//
+//	goqu.DiffTables(
+//		goqu.CreateTable("user").Columns(goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey()),
+//		goqu.CreateTable("user").Columns(
+//			goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey(),
+//			goqu.ColumnDef("email", goqu.VarcharType(255)),
+//		),
+//	).Statements()
+//	// ALTER TABLE "user" ADD COLUMN "email" VARCHAR(255)
+func DiffTables(from, to *CreateTableDataset) *SchemaDiff {
+	if from == nil {
+		return DiffSchemas(nil, []*CreateTableDataset{to})
+	}
+	return DiffSchemas([]*CreateTableDataset{from}, []*CreateTableDataset{to})
+}
+
+// DropColumns generates a DROP COLUMN action for each column that does not exist in the new schema.
+func (sd *SchemaDiff) DropColumns() *SchemaDiff {
+	ret := *sd
+	ret.dropColumns = true
+	return &ret
+}
+
+// AlterColumns generates the actions to change the type, NOT NULL and DEFAULT of existing columns. Dialects that
+// replace the whole definition of a column (e.g. mysql MODIFY COLUMN) get a single action with the new definition of
+// the column.
+func (sd *SchemaDiff) AlterColumns() *SchemaDiff {
+	ret := *sd
+	ret.alterColumns = true
+	return &ret
+}
+
+// Statements returns the CREATE TABLE and ALTER TABLE statements to migrate the schema, in the order of the tables
+// of the new schema. The statements use the dialect and database of the tables of the new schema, if the dialect
+// does not support multiple actions in a single ALTER TABLE statement a statement is returned for each action.
+//
+// Errors:
+//   - A table of either schema has an error or its table is not an identifier
+func (sd *SchemaDiff) Statements() ([]exec.Statement, error) {
+	existing := make(map[string]*CreateTableDataset, len(sd.from))
+	for _, ctd := range sd.from {
+		key, err := diffTableKey(ctd)
+		if err != nil {
+			return nil, err
+		}
+		existing[key] = ctd
+	}
+	var statements []exec.Statement
+	for _, ctd := range sd.to {
+		key, err := diffTableKey(ctd)
+		if err != nil {
+			return nil, err
+		}
+		from, ok := existing[key]
+		if !ok {
+			statements = append(statements, ctd)
+			continue
+		}
+		caps := ctd.dialect.Capabilities()
+		actions := sd.tableActions(from.GetClauses(), ctd.GetClauses(), caps)
+		if len(actions) == 0 {
+			continue
+		}
+		atd := newAlterTableDataset("default", ctd.queryFactory).SetDialect(ctd.dialect).Table(ctd.GetClauses().Table())
+		if caps.MultipleAlterTableActions {
+			statements = append(statements, atd.Actions(actions...))
+			continue
+		}
+		for _, action := range actions {
+			statements = append(statements, atd.Actions(action))
+		}
+	}
+	return statements, nil
+}
+
+// returns the actions to migrate the columns and constraints of a table.
+func (sd *SchemaDiff) tableActions(from, to exp.CreateTableClauses, caps DialectCapabilities) []exp.AlterTableAction {
+	existing := make(map[string]exp.ColumnDefinition, len(from.Columns()))
+	for _, col := range from.Columns() {
+		existing[col.Name()] = col
+	}
+	var actions []exp.AlterTableAction
+	columns := make(map[string]bool, len(to.Columns()))
+	for _, col := range to.Columns() {
+		columns[col.Name()] = true
+		fromCol, ok := existing[col.Name()]
+		if !ok {
+			actions = append(actions, exp.NewAddColumnAction(col))
+		} else if sd.alterColumns {
+			actions = append(actions, alterColumnActions(fromCol, col, caps.ModifyColumn)...)
+		}
+	}
+	if sd.dropColumns {
+		for _, col := range from.Columns() {
+			if !columns[col.Name()] {
+				actions = append(actions, exp.NewDropColumnAction(col.Name()))
+			}
+		}
+	}
+	for _, constraint := range to.Constraints() {
+		if !hasConstraint(from.Constraints(), constraint) {
+			actions = append(actions, exp.NewAddConstraintAction(constraint))
+		}
+	}
+	return actions
+}
+
+// returns the actions to change the type, NOT NULL and DEFAULT of a column, when modifyColumn is true a single
+// MODIFY COLUMN action with the new definition is returned so the NOT NULL and DEFAULT are not lost.
+func alterColumnActions(from, to exp.ColumnDefinition, modifyColumn bool) []exp.AlterTableAction {
+	typeChanged := !reflect.DeepEqual(from.DataType(), to.DataType())
+	defaultChanged := from.HasDefault() != to.HasDefault() || !reflect.DeepEqual(from.DefaultValue(), to.DefaultValue())
+	if modifyColumn {
+		if !typeChanged && !defaultChanged && from.IsNotNull() == to.IsNotNull() {
+			return nil
+		}
+		return []exp.AlterTableAction{exp.NewModifyColumnAction(modifyColumnDefinition(to))}
+	}
+	var actions []exp.AlterTableAction
+	if typeChanged {
+		actions = append(actions, exp.NewAlterColumnTypeAction(to.Name(), to.DataType()))
+	}
+	if from.IsNotNull() != to.IsNotNull() {
+		if to.IsNotNull() {
+			actions = append(actions, exp.NewSetColumnNotNullAction(to.Name()))
+		} else {
+			actions = append(actions, exp.NewDropColumnNotNullAction(to.Name()))
+		}
+	}
+	switch {
+	case to.HasDefault() && (!from.HasDefault() || !reflect.DeepEqual(from.DefaultValue(), to.DefaultValue())):
+		actions = append(actions, exp.NewSetColumnDefaultAction(to.Name(), to.DefaultValue()))
+	case !to.HasDefault() && from.HasDefault():
+		actions = append(actions, exp.NewDropColumnDefaultAction(to.Name()))
+	}
+	return actions
+}
+
+// returns the definition of a column without the PRIMARY KEY and UNIQUE, which are kept by MODIFY COLUMN and would
+// otherwise be added a second time.
+func modifyColumnDefinition(col exp.ColumnDefinition) exp.ColumnDefinition {
+	cd := exp.NewColumnDefinition(col.Name(), col.DataType())
+	if col.IsNotNull() {
+		cd = cd.NotNull()
+	}
+	if col.HasDefault() {
+		cd = cd.Default(col.DefaultValue())
+	}
+	if col.IsAutoIncrement() {
+		cd = cd.AutoIncrement()
+	}
+	if col.HasComment() {
+		cd = cd.Comment(col.CommentValue())
+	}
+	return cd
+}
+
+func hasConstraint(constraints []exp.TableConstraint, constraint exp.TableConstraint) bool {
+	for _, c := range constraints {
+		if reflect.DeepEqual(c, constraint) {
+			return true
+		}
+	}
+	return false
+}
+
+// returns the key used to match the tables of two schemas.
+func diffTableKey(ctd *CreateTableDataset) (string, error) {
+	if ctd == nil {
+		return "", errDiffTableRequired
+	}
+	if err := ctd.Error(); err != nil {
+		return "", err
+	}
+	if !ctd.GetClauses().HasTable() {
+		return "", errDiffTableRequired
+	}
+	ident, ok := ctd.GetClauses().Table().(exp.IdentifierExpression)
+	if !ok {
+		return "", errDiffTableNotIdentifier
+	}
+	return fmt.Sprintf("%s.%s.%v", ident.GetSchema(), ident.GetTable(), ident.GetCol()), nil
+}
@@ -0,0 +1,178 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type schemaDiffSuite struct {
+	suite.Suite
+}
+
+func TestSchemaDiffSuite(t *testing.T) {
+	suite.Run(t, new(schemaDiffSuite))
+}
+
+func (sds *schemaDiffSuite) assertStatements(sd *goqu.SchemaDiff, expectedSQL ...string) {
+	statements, err := sd.Statements()
+	sds.Require().NoError(err)
+	sds.assertSQL(statements, expectedSQL...)
+}
+
+func (sds *schemaDiffSuite) assertSQL(statements []exec.Statement, expectedSQL ...string) {
+	actual := make([]string, 0, len(statements))
+	for _, s := range statements {
+		sql, args, err := s.ToSQL()
+		sds.NoError(err)
+		sds.Empty(args)
+		actual = append(actual, sql)
+	}
+	if len(expectedSQL) == 0 {
+		sds.Empty(actual)
+	} else {
+		sds.Equal(expectedSQL, actual)
+	}
+}
+
+func (sds *schemaDiffSuite) userTable(columns ...string) *goqu.CreateTableDataset {
+	defs := map[string]exp.ColumnDefinition{
+		"id":    goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey(),
+		"email": goqu.ColumnDef("email", goqu.VarcharType(255)).NotNull(),
+		"name":  goqu.ColumnDef("name", goqu.TextType()),
+	}
+	ds := goqu.CreateTable("user")
+	for _, col := range columns {
+		ds = ds.Columns(defs[col])
+	}
+	return ds
+}
+
+func (sds *schemaDiffSuite) TestDiffTables() {
+	sds.assertStatements(goqu.DiffTables(sds.userTable("id"), sds.userTable("id")))
+	sds.assertStatements(
+		goqu.DiffTables(sds.userTable("id"), sds.userTable("id", "email", "name")),
+		`ALTER TABLE "user" ADD COLUMN "email" VARCHAR(255) NOT NULL, ADD COLUMN "name" TEXT`,
+	)
+	sds.assertStatements(
+		goqu.DiffTables(nil, sds.userTable("id", "name")),
+		`CREATE TABLE "user" ("id" BIGINT PRIMARY KEY, "name" TEXT)`,
+	)
+	sds.assertStatements(
+		goqu.DiffTables(sds.userTable("id", "email"), sds.userTable("id", "email").Constraints(goqu.Unique("email"))),
+		`ALTER TABLE "user" ADD UNIQUE ("email")`,
+	)
+	sds.assertStatements(
+		goqu.DiffTables(
+			sds.userTable("id", "email").Constraints(goqu.Unique("email")),
+			sds.userTable("id", "email").Constraints(goqu.Unique("email")),
+		),
+	)
+}
+
+func (sds *schemaDiffSuite) TestDiffTables_additiveOnly() {
+	from := goqu.CreateTable("user").Columns(
+		goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey(),
+		goqu.ColumnDef("email", goqu.TextType()),
+		goqu.ColumnDef("legacy", goqu.TextType()),
+	)
+	to := goqu.CreateTable("user").Columns(
+		goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey(),
+		goqu.ColumnDef("email", goqu.VarcharType(255)).NotNull().Default(""),
+		goqu.ColumnDef("name", goqu.TextType()),
+	)
+	sds.assertStatements(goqu.DiffTables(from, to), `ALTER TABLE "user" ADD COLUMN "name" TEXT`)
+	sds.assertStatements(
+		goqu.DiffTables(from, to).DropColumns(),
+		`ALTER TABLE "user" ADD COLUMN "name" TEXT, DROP COLUMN "legacy"`,
+	)
+	sds.assertStatements(
+		goqu.DiffTables(from, to).AlterColumns(),
+		`ALTER TABLE "user" ALTER COLUMN "email" TYPE VARCHAR(255), ALTER COLUMN "email" SET NOT NULL, `+
+			`ALTER COLUMN "email" SET DEFAULT '', ADD COLUMN "name" TEXT`,
+	)
+	sds.assertStatements(
+		goqu.DiffTables(to, from).AlterColumns().DropColumns(),
+		`ALTER TABLE "user" ALTER COLUMN "email" TYPE TEXT, ALTER COLUMN "email" DROP NOT NULL, `+
+			`ALTER COLUMN "email" DROP DEFAULT, ADD COLUMN "legacy" TEXT, DROP COLUMN "name"`,
+	)
+}
+
+func (sds *schemaDiffSuite) TestDiffSchemas() {
+	type Order struct {
+		ID     int64 `db:"id" goqu:"primarykey"`
+		UserID int64 `db:"user_id"`
+	}
+	from := []*goqu.CreateTableDataset{
+		sds.userTable("id"),
+		goqu.CreateTable("audit").Columns(goqu.ColumnDef("id", goqu.BigIntType())),
+	}
+	to := []*goqu.CreateTableDataset{
+		sds.userTable("id", "name"),
+		goqu.TableFromStruct(&Order{}),
+		goqu.CreateTable("app.user").Columns(goqu.ColumnDef("id", goqu.BigIntType())),
+	}
+	sds.assertStatements(
+		goqu.DiffSchemas(from, to),
+		`ALTER TABLE "user" ADD COLUMN "name" TEXT`,
+		`CREATE TABLE "order" ("id" BIGINT PRIMARY KEY, "user_id" BIGINT NOT NULL)`,
+		`CREATE TABLE "app"."user" ("id" BIGINT)`,
+	)
+	sds.assertStatements(goqu.DiffSchemas(to, to))
+	sds.assertStatements(goqu.DiffSchemas(nil, nil))
+}
+
+func (sds *schemaDiffSuite) TestDiffSchemas_withDialect() {
+	from := sds.userTable("id").WithDialect("mysql")
+	to := sds.userTable("id", "email", "name").WithDialect("mysql")
+	sds.assertStatements(
+		goqu.DiffTables(from, to),
+		"ALTER TABLE `user` ADD COLUMN `email` VARCHAR(255) NOT NULL, ADD COLUMN `name` TEXT",
+	)
+
+	from = sds.userTable("id").WithDialect("sqlite3")
+	to = sds.userTable("id", "email", "name").WithDialect("sqlite3")
+	sds.assertStatements(
+		goqu.DiffTables(from, to),
+		"ALTER TABLE `user` ADD COLUMN `email` VARCHAR(255) NOT NULL",
+		"ALTER TABLE `user` ADD COLUMN `name` TEXT",
+	)
+}
+
+func (sds *schemaDiffSuite) TestDiffTables_modifyColumn() {
+	from := goqu.CreateTable("user").WithDialect("mysql").Columns(
+		goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey().AutoIncrement(),
+		goqu.ColumnDef("email", goqu.TextType()),
+		goqu.ColumnDef("name", goqu.TextType()).NotNull().Comment("display name"),
+	)
+	to := goqu.CreateTable("user").WithDialect("mysql").Columns(
+		goqu.ColumnDef("id", goqu.BigIntType()).PrimaryKey().AutoIncrement(),
+		goqu.ColumnDef("email", goqu.VarcharType(255)).NotNull().Default(""),
+		goqu.ColumnDef("name", goqu.TextType()).Comment("display name"),
+	)
+	sds.assertStatements(
+		goqu.DiffTables(from, to).AlterColumns(),
+		"ALTER TABLE `user` MODIFY COLUMN `email` VARCHAR(255) DEFAULT '' NOT NULL, "+
+			"MODIFY COLUMN `name` TEXT COMMENT 'display name'",
+	)
+	sds.assertStatements(goqu.DiffTables(to, to).AlterColumns())
+}
+
+func (sds *schemaDiffSuite) TestDiffSchemas_errors() {
+	_, err := goqu.DiffTables(sds.userTable("id"), nil).Statements()
+	sds.EqualError(err, "goqu: a table is required to diff schemas")
+
+	_, err = goqu.DiffSchemas([]*goqu.CreateTableDataset{nil}, nil).Statements()
+	sds.EqualError(err, "goqu: a table is required to diff schemas")
+
+	_, err = goqu.DiffTables(nil, goqu.CreateTable(goqu.L("user"))).Statements()
+	sds.EqualError(err, "goqu: unable to diff a table that is not an identifier")
+
+	_, err = goqu.DiffTables(sds.userTable("id"), goqu.TableFromStruct("user")).Statements()
+	sds.EqualError(err, "goqu: unsupported type string, a struct or a pointer to a struct is required")
+}
@@ -3,6 +3,8 @@ package goqu
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync/atomic"
 
 	"github.com/doug-martin/goqu/v9/exec"
 	"github.com/doug-martin/goqu/v9/exp"
@@ -10,6 +12,9 @@ import (
 	"github.com/doug-martin/goqu/v9/internal/sb"
 )
 
+// the number of aliases generated by AsUnique, used to keep the aliases unique within the process
+var uniqueAliasCount uint64
+
 // SelectDataset for creating and/or executing SELECT SQL statements.
 type SelectDataset struct {
 	dialect      SQLDialect
@@ -23,6 +28,12 @@ var ErrQueryFactoryNotFoundError = errors.New(
 	"unable to execute query did you use goqu.Database#From to create the dataset",
 )
 
+var ErrUnsupportedRecursiveCTEClause = errors.New(
+	"unsupported recursive common table expression clause, a SEARCH or CYCLE expression is required",
+)
+
+var errPaginatePerPage = errors.New("unable to paginate with less than 1 row per page")
+
 // used internally by database to create a database with a specific adapter.
 func newDataset(d string, queryFactory exec.QueryFactory) *SelectDataset {
 	return &SelectDataset{
@@ -198,8 +209,30 @@ func (sd *SelectDataset) With(name string, subquery exp.Expression) *SelectDatas
 // The name will refer to the results of the specified subquery. The subquery for
 // a recursive query will always end with a UNION or UNION ALL with a clause that
 // refers to the CTE by name.
-func (sd *SelectDataset) WithRecursive(name string, subquery exp.Expression) *SelectDataset {
-	return sd.copy(sd.clauses.CommonTablesAppend(exp.NewCommonTableExpression(true, name, subquery)))
+//
+// SEARCH and CYCLE clauses can be added by passing SearchDepthFirst, SearchBreadthFirst or Cycle expressions.
+func (sd *SelectDataset) WithRecursive(name string, subquery exp.Expression, clauses ...exp.Expression) *SelectDataset {
+	return sd.copy(sd.clauses.CommonTablesAppend(newRecursiveCommonTable(name, subquery, clauses)))
+}
+
+// WithCTE adds a common table expression (e.g. one created using Recursive) to the WITH clause.
+func (sd *SelectDataset) WithCTE(cte exp.CommonTableExpression) *SelectDataset {
+	return sd.copy(sd.clauses.CommonTablesAppend(cte))
+}
+
+// WithMaterialized creates a WITH clause for a common table expression (CTE) that is computed once
+// (e.g. WITH name AS MATERIALIZED (...)). An error is returned when generating sql for dialects that do not support it.
+func (sd *SelectDataset) WithMaterialized(name string, subquery exp.Expression) *SelectDataset {
+	cte := exp.NewCommonTableExpression(false, name, subquery).SetMaterialization(exp.MaterializedCTE)
+	return sd.copy(sd.clauses.CommonTablesAppend(cte))
+}
+
+// WithNotMaterialized creates a WITH clause for a common table expression (CTE) that may be folded into the parent
+// query (e.g. WITH name AS NOT MATERIALIZED (...)). An error is returned when generating sql for dialects that do not
+// support it.
+func (sd *SelectDataset) WithNotMaterialized(name string, subquery exp.Expression) *SelectDataset {
+	cte := exp.NewCommonTableExpression(false, name, subquery).SetMaterialization(exp.NotMaterializedCTE)
+	return sd.copy(sd.clauses.CommonTablesAppend(cte))
 }
 
 // Select adds columns to the SELECT clause.
@@ -260,6 +293,25 @@ func (sd *SelectDataset) Distinct(on ...interface{}) *SelectDataset {
 	return sd.copy(sd.clauses.SetDistinct(exp.NewColumnListExpression(on...)))
 }
 
+// ForceJoinOrder adds STRAIGHT_JOIN to the SELECT clause which forces the tables to be joined in the order they are
+// listed (e.g. mysql). An error is returned when generating sql for dialects that do not support it.
+//    From("a").ForceJoinOrder().InnerJoin(T("b"), On(...)) // SELECT STRAIGHT_JOIN * FROM `a` INNER JOIN `b` ON ...
+func (sd *SelectDataset) ForceJoinOrder() *SelectDataset {
+	return sd.copy(sd.clauses.SetStraightJoin(true))
+}
+
+// Hint appends optimizer hints which are written as is in a comment after the SELECT keyword (e.g. mysql, tidb). An
+// error is returned when generating sql for dialects that do not support optimizer hints.
+//    From("a").Hint("TIDB_SMJ(a, b)", "MAX_EXECUTION_TIME(1000)") // SELECT /*+ TIDB_SMJ(a, b) MAX_EXECUTION_TIME(1000) */ * FROM `a`
+func (sd *SelectDataset) Hint(hints ...string) *SelectDataset {
+	return sd.copy(sd.clauses.SetHints(append(append([]string(nil), sd.clauses.Hints()...), hints...)))
+}
+
+// ClearHint removes all optimizer hints.
+func (sd *SelectDataset) ClearHint() *SelectDataset {
+	return sd.copy(sd.clauses.SetHints(nil))
+}
+
 // From adds a FROM clause. This return a new SelectDataset with the original sources replaced.
 // You can pass in the following.
 //
@@ -280,6 +332,81 @@ func (sd *SelectDataset) From(from ...interface{}) *SelectDataset {
 	return sd.copy(sd.clauses.SetFrom(exp.NewColumnListExpression(sources...)))
 }
 
+// AsOfSystemTime adds an AS OF SYSTEM TIME clause to read the data as it was at the given time (e.g. cockroachdb). The
+// timestamp can be a time.Time, a string (e.g. "-10s") or an Expression (e.g. L("follower_read_timestamp()")) and is
+// always interpolated. An error is returned when generating sql for dialects that do not support it.
+//    From("users").AsOfSystemTime("-10s") // SELECT * FROM "users" AS OF SYSTEM TIME '-10s'
+func (sd *SelectDataset) AsOfSystemTime(ts interface{}) *SelectDataset {
+	return sd.copy(sd.clauses.SetAsOfSystemTime(ts))
+}
+
+// ClearAsOfSystemTime removes the AS OF SYSTEM TIME clause.
+func (sd *SelectDataset) ClearAsOfSystemTime() *SelectDataset {
+	return sd.copy(sd.clauses.SetAsOfSystemTime(nil))
+}
+
+// Final adds the FINAL modifier after the FROM clause so tables are fully merged before reading (e.g. clickhouse).
+// Dialects that do not include FinalSQLFragment in their SelectSQLOrder ignore it.
+//    From("events").Final() // SELECT * FROM "events" FINAL
+func (sd *SelectDataset) Final() *SelectDataset {
+	return sd.copy(sd.clauses.SetFinal(true))
+}
+
+// ClearFinal removes the FINAL modifier.
+func (sd *SelectDataset) ClearFinal() *SelectDataset {
+	return sd.copy(sd.clauses.SetFinal(false))
+}
+
+// Sample adds a SAMPLE clause (e.g. clickhouse). The size can be a ratio (e.g. 0.1) or a number of rows.
+// Dialects that do not include SampleSQLFragment in their SelectSQLOrder ignore it.
+//    From("events").Sample(0.1) // SELECT * FROM "events" SAMPLE 0.1
+func (sd *SelectDataset) Sample(size interface{}) *SelectDataset {
+	return sd.copy(sd.clauses.SetSample(exp.NewLiteralExpression("?", size)))
+}
+
+// SampleOffset adds a SAMPLE clause with an OFFSET (e.g. clickhouse).
+//    From("events").SampleOffset(0.1, 0.5) // SELECT * FROM "events" SAMPLE 0.1 OFFSET 0.5
+func (sd *SelectDataset) SampleOffset(size, offset interface{}) *SelectDataset {
+	return sd.copy(sd.clauses.SetSample(exp.NewLiteralExpression("? OFFSET ?", size, offset)))
+}
+
+// ClearSample removes the SAMPLE clause.
+func (sd *SelectDataset) ClearSample() *SelectDataset {
+	return sd.copy(sd.clauses.SetSample(nil))
+}
+
+// Prewhere adds a PREWHERE clause, the expressions are AND'ed together just like Where (e.g. clickhouse).
+// An error is returned when generating sql for dialects that do not include PrewhereSQLFragment in their SelectSQLOrder.
+//    Dialect("clickhouse").From("events").Prewhere(C("date").Gte("2021-01-01")) // SELECT * FROM "events" PREWHERE ("date" >= '2021-01-01')
+func (sd *SelectDataset) Prewhere(expressions ...exp.Expression) *SelectDataset {
+	return sd.copy(sd.clauses.PrewhereAppend(expressions...))
+}
+
+// ClearPrewhere removes the PREWHERE clause.
+func (sd *SelectDataset) ClearPrewhere() *SelectDataset {
+	return sd.copy(sd.clauses.ClearPrewhere())
+}
+
+// Settings adds query level settings (e.g. clickhouse). Settings are merged with any previously set settings, are
+// sorted by name and their values are always interpolated. An error is returned when generating sql for dialects that
+// do not include SettingsSQLFragment in their SelectSQLOrder or for names that are not made of letters, digits and _.
+//    Dialect("clickhouse").From("events").Settings(Record{"max_threads": 8}) // SELECT * FROM "events" SETTINGS max_threads=8
+func (sd *SelectDataset) Settings(settings exp.Record) *SelectDataset {
+	merged := exp.Record{}
+	for k, v := range sd.clauses.Settings() {
+		merged[k] = v
+	}
+	for k, v := range settings {
+		merged[k] = v
+	}
+	return sd.copy(sd.clauses.SetSettings(merged))
+}
+
+// ClearSettings removes all query level settings.
+func (sd *SelectDataset) ClearSettings() *SelectDataset {
+	return sd.copy(sd.clauses.SetSettings(nil))
+}
+
 // FromSelf returns a new SelectDataset with the current one as a source.
 // If the current SelectDataset is not aliased (See Dataset#As) then it will automatically be aliased.
 func (sd *SelectDataset) FromSelf() *SelectDataset {
@@ -351,6 +478,12 @@ func (sd *SelectDataset) CrossJoin(table exp.Expression) *SelectDataset {
 	return sd.joinTable(exp.NewUnConditionedJoinExpression(exp.CrossJoinType, table))
 }
 
+// StraightJoin adds a STRAIGHT_JOIN clause which reads the left table before the right table (e.g. mysql). An error is
+// returned when generating sql for dialects that do not support it.
+func (sd *SelectDataset) StraightJoin(table exp.Expression, condition exp.JoinCondition) *SelectDataset {
+	return sd.joinTable(exp.NewConditionedJoinExpression(exp.StraightJoinType, table, condition))
+}
+
 // Joins this Datasets table with another.
 func (sd *SelectDataset) joinTable(join exp.JoinExpression) *SelectDataset {
 	return sd.copy(sd.clauses.JoinsAppend(join))
@@ -361,12 +494,148 @@ func (sd *SelectDataset) Where(expressions ...exp.Expression) *SelectDataset {
 	return sd.copy(sd.clauses.WhereAppend(expressions...))
 }
 
+// WhereIf adds a WHERE clause if cond is true, otherwise the dataset is returned unchanged.
+//    ds.WhereIf(name != "", goqu.C("name").Eq(name))
+func (sd *SelectDataset) WhereIf(cond bool, expressions ...exp.Expression) *SelectDataset {
+	if !cond {
+		return sd
+	}
+	return sd.Where(expressions...)
+}
+
+// WhereExists adds a WHERE EXISTS clause for the subquery.
+//    From("users").WhereExists(From("orders").Correlate(map[string]string{"orders.user_id": "users.id"}))
+//    // SELECT * FROM "users" WHERE EXISTS (SELECT * FROM "orders" WHERE ("orders"."user_id" = "users"."id"))
+func (sd *SelectDataset) WhereExists(sub exp.AppendableExpression) *SelectDataset {
+	return sd.Where(Exists(sub))
+}
+
+// WhereNotExists adds a WHERE NOT EXISTS clause for the subquery (an anti-join).
+//    From("users").WhereNotExists(From("orders").Correlate(map[string]string{"orders.user_id": "users.id"}))
+//    // SELECT * FROM "users" WHERE NOT EXISTS (SELECT * FROM "orders" WHERE ("orders"."user_id" = "users"."id"))
+func (sd *SelectDataset) WhereNotExists(sub exp.AppendableExpression) *SelectDataset {
+	return sd.Where(NotExists(sub))
+}
+
+// Correlate adds a WHERE clause that correlates this dataset with an outer query, the keys of columns are the columns
+// of this dataset and the values are the columns of the outer query they must be equal to. Columns are parsed like I
+// and the conditions are sorted by key so the SQL is deterministic.
+//    From("orders").Correlate(map[string]string{"orders.user_id": "users.id", "orders.tenant_id": "users.tenant_id"})
+//    // SELECT * FROM "orders" WHERE (("orders"."tenant_id" = "users"."tenant_id") AND ("orders"."user_id" = "users"."id"))
+func (sd *SelectDataset) Correlate(columns map[string]string) *SelectDataset {
+	if len(columns) == 0 {
+		return sd
+	}
+	keys := make([]string, 0, len(columns))
+	for k := range columns {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	conditions := make([]exp.Expression, 0, len(keys))
+	for _, k := range keys {
+		conditions = append(conditions, I(k).Eq(I(columns[k])))
+	}
+	return sd.Where(conditions...)
+}
+
 // ClearWhere removes the WHERE clause.
 func (sd *SelectDataset) ClearWhere() *SelectDataset {
 	return sd.copy(sd.clauses.ClearWhere())
 }
 
-// ForUpdate adds a FOR UPDATE clause.
+// Qualify adds a QUALIFY clause to filter the results of window functions, the expressions are AND'ed together just
+// like Where (e.g. bigquery, snowflake). An error is returned when generating sql for dialects that do not support it.
+//    From("test").
+//        Select("a", goqu.ROW_NUMBER().Over(goqu.W().PartitionBy("a").OrderBy("b")).As("rn")).
+//        Qualify(goqu.C("rn").Eq(1))
+//    // SELECT "a", ROW_NUMBER() OVER (PARTITION BY "a" ORDER BY "b") AS "rn" FROM "test" QUALIFY ("rn" = 1)
+func (sd *SelectDataset) Qualify(expressions ...exp.Expression) *SelectDataset {
+	return sd.copy(sd.clauses.QualifyAppend(expressions...))
+}
+
+// ClearQualify removes the QUALIFY clause.
+func (sd *SelectDataset) ClearQualify() *SelectDataset {
+	return sd.copy(sd.clauses.ClearQualify())
+}
+
+// ConnectBy adds a CONNECT BY clause to create a hierarchical query, the conditions are AND'ed together just like
+// Where (e.g. oracle). If the CONNECT BY is currently set it replaces the conditions but keeps the START WITH.
+// An error is returned when generating sql for dialects that do not support it.
+//    From("employees").
+//        Select("id", oracle.Level()).
+//        StartWith(goqu.C("manager_id").IsNull()).
+//        ConnectBy(oracle.Prior("id").Eq(goqu.C("manager_id")))
+//    // SELECT "ID", LEVEL FROM "EMPLOYEES" START WITH ("MANAGER_ID" IS NULL) CONNECT BY (PRIOR "ID" = "MANAGER_ID")
+func (sd *SelectDataset) ConnectBy(condition ...exp.Expression) *SelectDataset {
+	return sd.connectBy(false, condition)
+}
+
+// ConnectByNoCycle adds a CONNECT BY NOCYCLE clause which returns rows even if there is a loop in the hierarchy.
+// See ConnectBy.
+func (sd *SelectDataset) ConnectByNoCycle(condition ...exp.Expression) *SelectDataset {
+	return sd.connectBy(true, condition)
+}
+
+// StartWith adds to the START WITH conditions of a hierarchical query that select the root rows, the conditions are
+// AND'ed together just like Where. See ConnectBy.
+func (sd *SelectDataset) StartWith(expressions ...exp.Expression) *SelectDataset {
+	cb := sd.clauses.ConnectBy()
+	if cb == nil {
+		cb = exp.NewConnectByExpression(false)
+	}
+	return sd.copy(sd.clauses.SetConnectBy(cb.StartWithAppend(expressions...)))
+}
+
+// ClearConnectBy removes the START WITH and CONNECT BY clauses.
+func (sd *SelectDataset) ClearConnectBy() *SelectDataset {
+	return sd.copy(sd.clauses.SetConnectBy(nil))
+}
+
+func (sd *SelectDataset) connectBy(noCycle bool, condition []exp.Expression) *SelectDataset {
+	cb := exp.NewConnectByExpression(noCycle, condition...)
+	if current := sd.clauses.ConnectBy(); current != nil && current.StartWith() != nil {
+		cb = cb.StartWithAppend(current.StartWith().Expressions()...)
+	}
+	return sd.copy(sd.clauses.SetConnectBy(cb))
+}
+
+// Into adds an INTO clause that creates a new table from the results of the query (e.g. postgres, sqlserver). The
+// table may be a string or an identifier.
+//    goqu.Dialect("postgres").From("users").Select("id", "name").Into("staging")
+//    // SELECT "id", "name" INTO "staging" FROM "users"
+func (sd *SelectDataset) Into(table interface{}) *SelectDataset {
+	return sd.copy(sd.clauses.SetInto(exp.NewSelectIntoExpression(selectIntoTable(table), false)))
+}
+
+// IntoTemp adds an INTO clause that creates a new temporary table from the results of the query. The
+// TempTableNamePrefix of the dialect is added to the name of the table.
+//    goqu.Dialect("postgres").From("users").Select("id", "name").IntoTemp("staging")
+//    // SELECT "id", "name" INTO TEMPORARY "staging" FROM "users"
+//    goqu.Dialect("sqlserver").From("users").Select("id", "name").IntoTemp("staging")
+//    // SELECT "id", "name" INTO "#staging" FROM "users"
+func (sd *SelectDataset) IntoTemp(table interface{}) *SelectDataset {
+	return sd.copy(sd.clauses.SetInto(exp.NewSelectIntoExpression(selectIntoTable(table), true)))
+}
+
+// ClearInto removes the INTO clause.
+func (sd *SelectDataset) ClearInto() *SelectDataset {
+	return sd.copy(sd.clauses.SetInto(nil))
+}
+
+func selectIntoTable(table interface{}) exp.IdentifierExpression {
+	switch t := table.(type) {
+	case exp.IdentifierExpression:
+		return t
+	case string:
+		return exp.ParseIdentifier(t)
+	default:
+		panic(ErrUnsupportedIntoType)
+	}
+}
+
+// ForUpdate adds a FOR UPDATE clause. The tables to lock may be provided if your dialect supports FOR UPDATE OF
+//    From("jobs").Join(T("queue"), On(I("queue.job_id").Eq(I("jobs.id")))).ForUpdate(SkipLocked, T("jobs"))
+//    // SELECT * FROM "jobs" INNER JOIN "queue" ON ("queue"."job_id" = "jobs"."id") FOR UPDATE OF "jobs" SKIP LOCKED
 func (sd *SelectDataset) ForUpdate(waitOption exp.WaitOption, of ...exp.IdentifierExpression) *SelectDataset {
 	return sd.withLock(exp.ForUpdate, waitOption, of...)
 }
@@ -386,6 +655,13 @@ func (sd *SelectDataset) ForShare(waitOption exp.WaitOption, of ...exp.Identifie
 	return sd.withLock(exp.ForShare, waitOption, of...)
 }
 
+// ForUpdateWait adds a FOR UPDATE clause that waits at most the number of seconds for the lock. An error is returned
+// when generating sql for dialects that do not support it.
+//    Dialect("oracle").From("test").ForUpdateWait(5) // SELECT * FROM "TEST" FOR UPDATE WAIT 5
+func (sd *SelectDataset) ForUpdateWait(seconds uint, of ...exp.IdentifierExpression) *SelectDataset {
+	return sd.copy(sd.clauses.SetLock(exp.NewLockWithWaitSeconds(exp.ForUpdate, seconds, of...)))
+}
+
 func (sd *SelectDataset) withLock(strength exp.LockStrength, option exp.WaitOption, of ...exp.IdentifierExpression) *SelectDataset {
 	return sd.copy(sd.clauses.SetLock(exp.NewLock(strength, option, of...)))
 }
@@ -427,6 +703,14 @@ func (sd *SelectDataset) ClearOrder() *SelectDataset {
 	return sd.copy(sd.clauses.ClearOrder())
 }
 
+// OrderRandom orders the rows randomly using the random function of the dialect and limits the number of rows
+// returned, a limit of 0 does not limit the rows. If the ORDER or LIMIT is currently set it is replaced.
+//    From("test").OrderRandom(10)
+//    // SELECT * FROM "test" ORDER BY RANDOM() ASC LIMIT 10
+func (sd *SelectDataset) OrderRandom(limit uint) *SelectDataset {
+	return sd.Order(Random().Asc()).Limit(limit)
+}
+
 // Limit adds a LIMIT clause. If the LIMIT is currently set it replaces it.
 func (sd *SelectDataset) Limit(limit uint) *SelectDataset {
 	if limit > 0 {
@@ -440,6 +724,23 @@ func (sd *SelectDataset) LimitAll() *SelectDataset {
 	return sd.copy(sd.clauses.SetLimit(L("ALL")))
 }
 
+// LimitOver adds a LIMIT ... OVER clause which limits the number of rows returned for each partition of the window
+// (e.g. vertica). If the LIMIT is currently set it replaces it.
+//    From("sales").LimitOver(2, W().PartitionBy("store_id").OrderBy(C("amount").Desc()))
+//    // SELECT * FROM "sales" LIMIT 2 OVER (PARTITION BY "store_id" ORDER BY "amount" DESC)
+func (sd *SelectDataset) LimitOver(limit uint, window exp.WindowExpression) *SelectDataset {
+	return sd.copy(sd.clauses.SetLimit(L("? OVER ?", limit, window)))
+}
+
+// Fetch limits the rows returned using a FETCH FIRST clause in place of a LIMIT. If the LIMIT is currently set it
+// replaces it. A plain FETCH FIRST is generated the same way as Limit, WITH TIES and PERCENT are only supported by
+// some dialects (e.g. postgres 13+, oracle, sqlserver).
+//    From("scores").Order(C("score").Desc()).Fetch(FetchFirst(3).WithTies())
+//    // SELECT * FROM "scores" ORDER BY "score" DESC FETCH FIRST 3 ROWS WITH TIES
+func (sd *SelectDataset) Fetch(fetch exp.FetchExpression) *SelectDataset {
+	return sd.copy(sd.clauses.SetLimit(fetch))
+}
+
 // ClearLimit removes the LIMIT clause.
 func (sd *SelectDataset) ClearLimit() *SelectDataset {
 	return sd.copy(sd.clauses.ClearLimit())
@@ -455,6 +756,21 @@ func (sd *SelectDataset) ClearOffset() *SelectDataset {
 	return sd.copy(sd.clauses.ClearOffset())
 }
 
+// Paginate adds the LIMIT and OFFSET clauses for the 1 based page of perPage rows. A page of 0 is treated as the first
+// page. If the LIMIT or OFFSET is currently set it replaces it. A perPage of 0 sets an error on the dataset.
+//
+//	From("items").Order(C("id").Asc()).Paginate(3, 20)
+//	// SELECT * FROM "items" ORDER BY "id" ASC LIMIT 20 OFFSET 40
+func (sd *SelectDataset) Paginate(page, perPage uint) *SelectDataset {
+	if perPage < 1 {
+		return sd.copy(sd.clauses).SetError(errPaginatePerPage)
+	}
+	if page > 0 {
+		page--
+	}
+	return sd.Limit(perPage).Offset(page * perPage)
+}
+
 // Union creates a UNION statement with another SelectDataset.
 // If this or the other SelectDataset has a limit or offset
 // it will use that SelectDataset as a sub-select in the FROM clause.
@@ -483,6 +799,30 @@ func (sd *SelectDataset) IntersectAll(other *SelectDataset) *SelectDataset {
 	return sd.withCompound(exp.IntersectAllCompoundType, other.CompoundFromSelf())
 }
 
+// Except creates an EXCEPT statement with another SelectDataset.
+// If this or the other SelectDataset has a limit or offset
+// it will use that dataset as a sub-select in the FROM clause.
+func (sd *SelectDataset) Except(other *SelectDataset) *SelectDataset {
+	return sd.withCompound(exp.ExceptCompoundType, other.CompoundFromSelf())
+}
+
+// ExceptAll creates an EXCEPT ALL statement with another SelectDataset.
+// If this or the other SelectDataset has a limit or offset
+// it will use that dataset as a sub-select in the FROM clause.
+func (sd *SelectDataset) ExceptAll(other *SelectDataset) *SelectDataset {
+	return sd.withCompound(exp.ExceptAllCompoundType, other.CompoundFromSelf())
+}
+
+// Parenthesize wraps this SelectDataset in parentheses so it can be used as the left hand side of a
+// compound statement. Any compound, ORDER, LIMIT or OFFSET added to the returned SelectDataset
+// applies to the full parenthesized query.
+//
+//	goqu.From("a").Union(goqu.From("b")).Parenthesize().Intersect(goqu.From("c"))
+//	// (SELECT * FROM "a" UNION (SELECT * FROM "b")) INTERSECT (SELECT * FROM "c")
+func (sd *SelectDataset) Parenthesize() *SelectDataset {
+	return sd.copy(exp.NewSelectClauses().SetParenthesized(sd))
+}
+
 func (sd *SelectDataset) withCompound(ct exp.CompoundType, other exp.AppendableExpression) *SelectDataset {
 	ce := exp.NewCompoundExpression(ct, other)
 	ret := sd.CompoundFromSelf()
@@ -509,6 +849,45 @@ func (sd *SelectDataset) GetAs() exp.IdentifierExpression {
 	return sd.clauses.Alias()
 }
 
+// AsUnique aliases this SelectDataset using a name that is unique within the process (e.g. "goqu_sq_1"). Use Col to
+// reference the columns of the aliased dataset, this allows correlated subqueries and derived tables built in
+// different places to be composed without their aliases colliding.
+//    orders := From("orders").Select("user_id", COUNT("*").As("cnt")).GroupBy("user_id").AsUnique()
+//    From("users").Join(orders, On(orders.Col("user_id").Eq(I("users.id")))).Select("users.name", orders.Col("cnt"))
+//    // SELECT "users"."name", "goqu_sq_1"."cnt" FROM "users" INNER JOIN (SELECT ...) AS "goqu_sq_1"
+//    //    ON ("goqu_sq_1"."user_id" = "users"."id")
+func (sd *SelectDataset) AsUnique() *SelectDataset {
+	return sd.As(fmt.Sprintf("goqu_sq_%d", atomic.AddUint64(&uniqueAliasCount, 1)))
+}
+
+// Col returns an identifier for a column of this SelectDataset qualified with its alias (see As and AsUnique), the
+// column is not qualified if the dataset is not aliased.
+func (sd *SelectDataset) Col(col interface{}) exp.IdentifierExpression {
+	if alias := sd.clauses.Alias(); alias != nil {
+		return alias.Col(col)
+	}
+	return exp.NewIdentifierExpression("", "", col)
+}
+
+// AsTable sets the alias for this dataset and renames the columns it returns. This is useful when self joining the
+// same sub select as the columns of each derived table can be referenced without colliding.
+//    ds := From("test").Select("id", "parent_id")
+//    From(ds.AsTable("c", "child_id", "id")).Join(ds.AsTable("p", "id", "grandparent_id"), Using("id"))
+//    // SELECT * FROM (SELECT "id", "parent_id" FROM "test") AS "c"("child_id", "id")
+//    //    INNER JOIN (SELECT "id", "parent_id" FROM "test") AS "p"("id", "grandparent_id") USING ("id")
+func (sd *SelectDataset) AsTable(alias string, columns ...interface{}) *SelectDataset {
+	var cols exp.ColumnListExpression
+	if len(columns) > 0 {
+		cols = exp.NewColumnListExpression(columns...)
+	}
+	return sd.copy(sd.clauses.SetAlias(T(alias)).SetAliasColumns(cols))
+}
+
+// GetAsColumns returns the column aliases set using AsTable, nil if the columns are not renamed.
+func (sd *SelectDataset) GetAsColumns() exp.ColumnListExpression {
+	return sd.clauses.AliasColumns()
+}
+
 // Window returns the WINDOW clauses.
 func (sd *SelectDataset) Window(ws ...exp.WindowExpression) *SelectDataset {
 	return sd.copy(sd.clauses.SetWindows(ws))
@@ -529,6 +908,27 @@ func (sd *SelectDataset) Error() error {
 	return sd.err
 }
 
+// Apply adds the SELECT columns, JOINs and WHERE conditions of each Fragment to the dataset in order.
+//    tenant := NewFragment().Where(C("tenant_id").Eq(tenantID))
+//    From("orders").Apply(tenant, notDeleted)
+func (sd *SelectDataset) Apply(fragments ...Fragment) *SelectDataset {
+	c := sd.clauses
+	for _, f := range fragments {
+		c = f.apply(c)
+	}
+	return sd.copy(c)
+}
+
+// ApplyIf calls fn with the dataset and returns the result if cond is true, otherwise the dataset is returned
+// unchanged. Useful when building a query from optional parameters without breaking the chain of calls.
+//    ds.ApplyIf(limit > 0, func(ds *SelectDataset) *SelectDataset { return ds.Limit(limit) })
+func (sd *SelectDataset) ApplyIf(cond bool, fn func(*SelectDataset) *SelectDataset) *SelectDataset {
+	if !cond {
+		return sd
+	}
+	return fn(sd)
+}
+
 // SetError sets an error on the dataset if one has not already been set.
 // This error will be returned by a future call to Error or as part of ToSQL.
 // This can be used by end users to record errors while building up queries without having to track those separately.
@@ -567,6 +967,13 @@ func (sd *SelectDataset) Executor() exec.QueryExecutor {
 	return sd.queryFactory.FromSQLBuilder(sd.selectSQLBuilder())
 }
 
+// Explain creates an ExplainStatement that wraps the SELECT sql in the dialects EXPLAIN syntax.
+//
+//	plan, err := ds.Explain(exp.ExplainOptions{Analyze: true, Format: exp.ExplainFormatJSON}).Plan()
+func (sd *SelectDataset) Explain(opts exp.ExplainOptions) *ExplainStatement {
+	return newExplainStatement(sd, sd.dialect, sd.queryFactory, sd.isPrepared.Bool(), opts)
+}
+
 // AppendSQL appends this SelectDataset's SELECT statement to the SQLBuilder
 // This is used internally for sub-selects by the dialect
 func (sd *SelectDataset) AppendSQL(b sb.SQLBuilder) {
@@ -692,6 +1099,85 @@ func (sd *SelectDataset) CountContext(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+// Exists generates the SELECT EXISTS(...) sql for this SelectDataset
+// and uses Exec#ScanVal to scan the result into a bool.
+func (sd *SelectDataset) Exists() (bool, error) {
+	return sd.ExistsContext(context.Background())
+}
+
+// ExistsContext generates the SELECT EXISTS(...) sql for this SelectDataset
+// and uses Exec#ScanValContext to scan the result into a bool.
+func (sd *SelectDataset) ExistsContext(ctx context.Context) (bool, error) {
+	if sd.queryFactory == nil {
+		return false, ErrQueryFactoryNotFoundError
+	}
+	var exists bool
+	_, err := sd.ExistsDataset().Executor().ScanValContext(ctx, &exists)
+	return exists, err
+}
+
+// ScanStructsAndCount generates the SELECT sql for this SelectDataset and uses Exec#ScanStructs to scan the results
+// into a slice of structs. It then counts the total number of rows the SelectDataset would return without a LIMIT
+// or OFFSET. This is typically used along with Paginate.
+//
+//	var items []Item
+//	total, err := db.From("items").Order(C("id").Asc()).Paginate(2, 20).ScanStructsAndCount(&items)
+//
+// i: A pointer to a slice of structs.
+func (sd *SelectDataset) ScanStructsAndCount(i interface{}) (int64, error) {
+	return sd.ScanStructsAndCountContext(context.Background(), i)
+}
+
+// ScanStructsAndCountContext generates the SELECT sql for this SelectDataset and uses Exec#ScanStructsContext to scan
+// the results into a slice of structs. It then counts the total number of rows the SelectDataset would return without
+// a LIMIT or OFFSET. The two queries are not run in a transaction unless the SelectDataset was created from a TxDatabase.
+//
+// i: A pointer to a slice of structs.
+func (sd *SelectDataset) ScanStructsAndCountContext(ctx context.Context, i interface{}) (int64, error) {
+	if err := sd.ScanStructsContext(ctx, i); err != nil {
+		return 0, err
+	}
+	var count int64
+	if _, err := sd.CountDataset().ScanValContext(ctx, &count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountDataset returns a SelectDataset that counts the total number of rows of this SelectDataset ignoring any
+// ORDER, LIMIT and OFFSET. The query is always counted as a sub-select so every row it returns is counted once
+// (e.g. a query that selects an aggregate is counted as a single row). When the query selects all columns and is not
+// grouped, DISTINCT or compound the sub-select selects 1 instead, so joined tables with columns of the same name can
+// be counted.
+//
+//	From("test").Where(C("a").Gt(10)).Order(C("a").Asc()).Limit(10).CountDataset()
+//	// SELECT COUNT(*) AS "count" FROM (SELECT 1 FROM "test" WHERE ("a" > 10)) AS "t1"
+//	From("test").Select(SUM("a")).CountDataset()
+//	// SELECT COUNT(*) AS "count" FROM (SELECT SUM("a") FROM "test") AS "t1"
+func (sd *SelectDataset) CountDataset() *SelectDataset {
+	sub := sd.ClearOrder().ClearLimit().ClearOffset()
+	c := sd.clauses
+	if c.IsDefaultSelect() && c.Distinct() == nil && c.GroupBy() == nil && c.Having() == nil && len(c.Compounds()) == 0 {
+		sub = sub.Select(L("1"))
+	}
+	return sub.FromSelf().Select(COUNT(Star()).As("count"))
+}
+
+// ExistsDataset returns a SelectDataset that returns 1 if this SelectDataset returns any rows and 0 otherwise. A
+// CASE is used so the query works on dialects that cannot select a boolean (e.g. sqlserver and oracle). The ORDER is
+// dropped when there is no LIMIT or OFFSET since it does not change the result and is not allowed in a sub-select on
+// some dialects.
+//
+//	From("test").Where(C("a").Gt(10)).Order(C("a").Asc()).ExistsDataset()
+//	// SELECT CASE  WHEN EXISTS (SELECT * FROM "test" WHERE ("a" > 10)) THEN 1 ELSE 0 END
+func (sd *SelectDataset) ExistsDataset() *SelectDataset {
+	sub := sd
+	if !sd.clauses.HasLimit() && sd.clauses.Offset() == 0 {
+		sub = sd.ClearOrder()
+	}
+	return sd.copy(exp.NewSelectClauses()).Select(Case().When(Exists(sub), L("1")).Else(L("0")))
+}
+
 // Pluck generates the SELECT sql only selecting the passed in column
 // and uses Exec#ScanVals to scan the result into a slice of primitive values.
 //
@@ -148,6 +148,16 @@ func ExampleSelectDataset_As() {
 	// Output: SELECT * FROM (SELECT * FROM "test") AS "t"
 }
 
+func ExampleSelectDataset_AsTable() {
+	ds := goqu.From("test").Select("id", "parent_id")
+	sql, _, _ := goqu.From(ds.AsTable("c", "child_id", "id")).
+		Join(ds.AsTable("p", "id", "grandparent_id"), goqu.Using("id")).
+		Select("child_id", "grandparent_id").
+		ToSQL()
+	fmt.Println(sql)
+	// Output: SELECT "child_id", "grandparent_id" FROM (SELECT "id", "parent_id" FROM "test") AS "c"("child_id", "id") INNER JOIN (SELECT "id", "parent_id" FROM "test") AS "p"("id", "grandparent_id") USING ("id")
+}
+
 func ExampleSelectDataset_Union() {
 	sql, _, _ := goqu.From("test").
 		Union(goqu.From("test2")).
@@ -286,6 +296,51 @@ func ExampleSelectDataset_WithRecursive() {
 	// WITH RECURSIVE nums(x) AS (SELECT 1 UNION ALL (SELECT x+1 FROM "nums" WHERE ("x" < 5))) SELECT * FROM "nums"
 }
 
+func ExampleSelectDataset_WithRecursive_searchCycle() {
+	tree := goqu.From("nodes").Select("id", "parent_id").Where(goqu.C("parent_id").IsNull()).
+		UnionAll(goqu.From(goqu.T("nodes").As("n")).
+			Select("n.id", "n.parent_id").
+			InnerJoin(goqu.T("tree").As("t"), goqu.On(goqu.I("n.parent_id").Eq(goqu.I("t.id")))))
+	sql, _, _ := goqu.From("tree").
+		WithRecursive("tree(id, parent_id)", tree,
+			goqu.SearchDepthFirst("id").Set("ord"),
+			goqu.Cycle("id").Set("is_cycle").Using("path"),
+		).
+		Order(goqu.C("ord").Asc()).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// WITH RECURSIVE tree(id, parent_id) AS (SELECT "id", "parent_id" FROM "nodes" WHERE ("parent_id" IS NULL) UNION ALL (SELECT "n"."id", "n"."parent_id" FROM "nodes" AS "n" INNER JOIN "tree" AS "t" ON ("n"."parent_id" = "t"."id"))) SEARCH DEPTH FIRST BY "id" SET "ord" CYCLE "id" SET "is_cycle" USING "path" SELECT * FROM "tree" ORDER BY "ord" ASC
+}
+
+func ExampleSelectDataset_WithCTE() {
+	nums := goqu.Recursive("nums", []string{"n"}, goqu.From().Select(goqu.L("1")),
+		func(self exp.IdentifierExpression) *goqu.SelectDataset {
+			return goqu.From(self).Select(goqu.L("? + 1", self.Col("n"))).Where(self.Col("n").Lt(5))
+		}, true)
+	sql, _, _ := goqu.From("nums").WithCTE(nums).ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// WITH RECURSIVE nums(n) AS (SELECT 1 UNION ALL (SELECT "nums"."n" + 1 FROM "nums" WHERE ("nums"."n" < 5))) SELECT * FROM "nums"
+}
+
+func ExampleSelectDataset_WithMaterialized() {
+	sql, _, _ := goqu.From("big").
+		WithMaterialized("big", goqu.From("test").Where(goqu.C("x").Gt(5))).
+		Where(goqu.C("y").Eq(1)).
+		ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = goqu.From("big").
+		WithNotMaterialized("big", goqu.From("test").Where(goqu.C("x").Gt(5))).
+		Where(goqu.C("y").Eq(1)).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// WITH big AS MATERIALIZED (SELECT * FROM "test" WHERE ("x" > 5)) SELECT * FROM "big" WHERE ("y" = 1)
+	// WITH big AS NOT MATERIALIZED (SELECT * FROM "test" WHERE ("x" > 5)) SELECT * FROM "big" WHERE ("y" = 1)
+}
+
 func ExampleSelectDataset_Intersect() {
 	sql, _, _ := goqu.From("test").
 		Intersect(goqu.From("test2")).
@@ -330,6 +385,49 @@ func ExampleSelectDataset_IntersectAll() {
 	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" INTERSECT ALL (SELECT * FROM (SELECT * FROM "test2" ORDER BY "id" DESC) AS "t1")
 }
 
+func ExampleSelectDataset_Except() {
+	sql, _, _ := goqu.From("test").
+		Except(goqu.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = goqu.From("test").
+		Limit(1).
+		Except(goqu.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "test" EXCEPT (SELECT * FROM "test2")
+	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" EXCEPT (SELECT * FROM "test2")
+}
+
+func ExampleSelectDataset_ExceptAll() {
+	sql, _, _ := goqu.From("test").
+		ExceptAll(goqu.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "test" EXCEPT ALL (SELECT * FROM "test2")
+}
+
+func ExampleSelectDataset_Parenthesize() {
+	sql, _, _ := goqu.From("a").
+		Union(goqu.From("b")).
+		Parenthesize().
+		Intersect(goqu.From("c")).
+		Order(goqu.C("id").Asc()).
+		Limit(10).
+		ToSQL()
+	fmt.Println(sql)
+	// the right hand side of a compound is always parenthesized
+	sql, _, _ = goqu.From("a").
+		Union(goqu.From("b").Intersect(goqu.From("c"))).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// (SELECT * FROM "a" UNION (SELECT * FROM "b")) INTERSECT (SELECT * FROM "c") ORDER BY "id" ASC LIMIT 10
+	// SELECT * FROM "a" UNION (SELECT * FROM "b" INTERSECT (SELECT * FROM "c"))
+}
+
 func ExampleSelectDataset_ClearOffset() {
 	ds := goqu.From("test").
 		Offset(2)
@@ -349,6 +447,14 @@ func ExampleSelectDataset_Offset() {
 	// SELECT * FROM "test" OFFSET 2
 }
 
+func ExampleSelectDataset_Paginate() {
+	ds := goqu.From("test").Order(goqu.C("id").Asc()).Paginate(3, 20)
+	sql, _, _ := ds.ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "test" ORDER BY "id" ASC LIMIT 20 OFFSET 40
+}
+
 func ExampleSelectDataset_Limit() {
 	ds := goqu.From("test").Limit(10)
 	sql, _, _ := ds.ToSQL()
@@ -357,6 +463,36 @@ func ExampleSelectDataset_Limit() {
 	// SELECT * FROM "test" LIMIT 10
 }
 
+func ExampleSelectDataset_Fetch() {
+	ds := goqu.Dialect("postgres").From("scores").Order(goqu.C("score").Desc())
+	sql, _, _ := ds.Fetch(goqu.FetchFirst(3).WithTies()).ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = goqu.Dialect("sqlserver").From("scores").Order(goqu.C("score").Desc()).
+		Fetch(goqu.FetchFirst(10).Percent()).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "scores" ORDER BY "score" DESC FETCH FIRST 3 ROWS WITH TIES
+	// SELECT  TOP (10) PERCENT * FROM "scores" ORDER BY "score" DESC
+}
+
+func ExampleSelectDataset_Into() {
+	ds := goqu.Dialect("postgres").From("users").Select("id", "name").Where(goqu.C("active").IsTrue())
+	sql, _, _ := ds.Into("active_users").ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = ds.IntoTemp("active_users").ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = goqu.Dialect("sqlserver").From("users").Select("id", "name").IntoTemp("active_users").ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT "id", "name" INTO "active_users" FROM "users" WHERE ("active" IS TRUE)
+	// SELECT "id", "name" INTO TEMPORARY "active_users" FROM "users" WHERE ("active" IS TRUE)
+	// SELECT "id", "name" INTO "#active_users" FROM "users"
+}
+
 func ExampleSelectDataset_LimitAll() {
 	ds := goqu.From("test").LimitAll()
 	sql, _, _ := ds.ToSQL()
@@ -423,6 +559,23 @@ func ExampleSelectDataset_GroupBy() {
 	// SELECT SUM("income") AS "income_sum" FROM "test" GROUP BY "age"
 }
 
+func ExampleSelectDataset_GroupBy_rollup() {
+	sql, _, _ := goqu.From("sales").
+		Select("region", "product", goqu.GROUPING("product"), goqu.SUM("amount")).
+		GroupBy(goqu.Rollup("region", "product")).
+		ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = goqu.From("sales").
+		Select("region", "product", goqu.SUM("amount")).
+		GroupBy(goqu.GroupingSets([]interface{}{"region", "product"}, "region", nil)).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT "region", "product", GROUPING("product"), SUM("amount") FROM "sales" GROUP BY ROLLUP ("region", "product")
+	// SELECT "region", "product", SUM("amount") FROM "sales" GROUP BY GROUPING SETS (("region", "product"), ("region"), ())
+}
+
 func ExampleSelectDataset_GroupByAppend() {
 	ds := goqu.From("test").
 		Select(goqu.SUM("income").As("income_sum")).
@@ -482,6 +635,27 @@ func ExampleSelectDataset_Window() {
 	// SELECT ROW_NUMBER() OVER ("w" ORDER BY "b") FROM "test" WINDOW "w" AS (PARTITION BY "a") []
 }
 
+func ExampleSelectDataset_Window_frame() {
+	ds := goqu.From("sales").Select(
+		goqu.SUM("amount").Over(
+			goqu.W().OrderBy("day").Range(goqu.Preceding(goqu.L("INTERVAL '7 days'")), goqu.CurrentRow()),
+		),
+	)
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+
+	ds = goqu.From("sales").Select(
+		goqu.AVG("amount").Over(
+			goqu.W().OrderBy("day").Groups(goqu.Preceding(1), goqu.Following(1)).Exclude(goqu.ExcludeCurrentRow),
+		),
+	)
+	query, args, _ = ds.ToSQL()
+	fmt.Println(query, args)
+	// Output:
+	// SELECT SUM("amount") OVER (ORDER BY "day" RANGE BETWEEN INTERVAL '7 days' PRECEDING AND CURRENT ROW) FROM "sales" []
+	// SELECT AVG("amount") OVER (ORDER BY "day" GROUPS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE CURRENT ROW) FROM "sales" []
+}
+
 func ExampleSelectDataset_Where() {
 	// By default everything is anded together
 	sql, _, _ := goqu.From("test").Where(goqu.Ex{
@@ -614,6 +788,65 @@ func ExampleSelectDataset_ClearWhere() {
 	// SELECT * FROM "test"
 }
 
+func ExampleSelectDataset_WhereIf() {
+	// optional filters, e.g. from the query parameters of a request
+	name, minAge, limit := "Bob", 0, 10
+	sql, _, _ := goqu.From("users").
+		WhereIf(name != "", goqu.C("name").Eq(name)).
+		WhereIf(minAge > 0, goqu.C("age").Gte(minAge)).
+		ApplyIf(limit > 0, func(ds *goqu.SelectDataset) *goqu.SelectDataset {
+			return ds.Order(goqu.C("id").Asc()).Limit(uint(limit))
+		}).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "users" WHERE ("name" = 'Bob') ORDER BY "id" ASC LIMIT 10
+}
+
+func ExampleSelectDataset_WhereExists() {
+	orders := goqu.From("orders").Correlate(map[string]string{"orders.user_id": "users.id"})
+
+	sql, _, _ := goqu.From("users").WhereExists(orders).ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = goqu.From("users").WhereNotExists(orders.Where(goqu.I("orders.status").Eq("open"))).ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "users" WHERE EXISTS (SELECT * FROM "orders" WHERE ("orders"."user_id" = "users"."id"))
+	// SELECT * FROM "users" WHERE NOT EXISTS (SELECT * FROM "orders" WHERE (("orders"."user_id" = "users"."id") AND ("orders"."status" = 'open')))
+}
+
+func ExampleSelectDataset_Apply() {
+	tenant := goqu.NewFragment().Where(goqu.I("orders.tenant_id").Eq(10))
+	notDeleted := goqu.NewFragment().Where(goqu.I("orders.deleted_at").IsNull())
+	withUser := goqu.NewFragment().
+		Select("users.name").
+		Join(goqu.T("users"), goqu.On(goqu.I("users.id").Eq(goqu.I("orders.user_id"))))
+
+	sql, _, _ := goqu.From("orders").Select("orders.id").Apply(tenant.Merge(notDeleted), withUser).ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT "orders"."id", "users"."name" FROM "orders" INNER JOIN "users" ON ("users"."id" = "orders"."user_id") WHERE (("orders"."tenant_id" = 10) AND ("orders"."deleted_at" IS NULL))
+}
+
+func ExampleSelectDataset_Merge() {
+	base := goqu.From("orders").Select("orders.id").Where(goqu.I("orders.status").Eq("open"))
+	// e.g. added by a feature flagged module
+	withUser := goqu.From("orders").
+		Select("users.name").
+		Join(goqu.T("users"), goqu.On(goqu.I("users.id").Eq(goqu.I("orders.user_id")))).
+		Where(goqu.I("users.active").IsTrue())
+
+	sql, _, _ := base.Merge(withUser, goqu.SelectMergeAppend).ToSQL()
+	fmt.Println(sql)
+
+	_, _, err := base.Merge(withUser, goqu.SelectMergeStrict).ToSQL()
+	fmt.Println(err)
+	// Output:
+	// SELECT "orders"."id", "users"."name" FROM "orders" INNER JOIN "users" ON ("users"."id" = "orders"."user_id") WHERE (("orders"."status" = 'open') AND ("users"."active" IS TRUE))
+	// goqu: unable to merge datasets, both datasets set the SELECT clause
+}
+
 func ExampleSelectDataset_Join() {
 	sql, _, _ := goqu.From("test").Join(
 		goqu.T("test2"),
@@ -978,6 +1211,24 @@ func ExampleSelectDataset_FromSelf() {
 	// SELECT * FROM (SELECT * FROM "test") AS "my_test_table"
 }
 
+func ExampleSelectDataset_CountDataset() {
+	ds := goqu.From("test").Where(goqu.C("a").Gt(10)).Order(goqu.C("a").Asc()).Limit(10)
+	sql, _, _ := ds.CountDataset().ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = ds.Select("b").GroupBy("b").CountDataset().ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT COUNT(*) AS "count" FROM (SELECT 1 FROM "test" WHERE ("a" > 10)) AS "t1"
+	// SELECT COUNT(*) AS "count" FROM (SELECT "b" FROM "test" WHERE ("a" > 10) GROUP BY "b") AS "t1"
+}
+
+func ExampleSelectDataset_ExistsDataset() {
+	sql, _, _ := goqu.From("test").Where(goqu.C("a").Gt(10)).ExistsDataset().ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT CASE  WHEN EXISTS (SELECT * FROM "test" WHERE ("a" > 10)) THEN 1 ELSE 0 END
+}
+
 func ExampleSelectDataset_From() {
 	ds := goqu.From("test")
 	sql, _, _ := ds.From("test2").ToSQL()
@@ -1669,6 +1920,22 @@ func ExampleForUpdate_of() {
 	// SELECT * FROM "test" FOR UPDATE OF "test"  []
 }
 
+func ExampleForUpdate_subquery() {
+	next := goqu.From("jobs").
+		Select("id").
+		Where(goqu.C("status").Eq("pending")).
+		Limit(1).
+		ForUpdate(exp.SkipLocked)
+	sql, args, _ := goqu.Update("jobs").
+		Set(goqu.Record{"status": "running"}).
+		Where(goqu.C("id").Eq(next)).
+		ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// UPDATE "jobs" SET "status"='running' WHERE ("id" IN (SELECT "id" FROM "jobs" WHERE ("status" = 'pending') LIMIT 1 FOR UPDATE SKIP LOCKED)) []
+}
+
 func ExampleForUpdate_ofMultiple() {
 	sql, args, _ := goqu.From("table1").Join(
 		goqu.T("table2"),
@@ -1683,3 +1950,14 @@ func ExampleForUpdate_ofMultiple() {
 	// Output:
 	// SELECT * FROM "table1" INNER JOIN "table2" ON ("table2"."id" = "table1"."id") FOR UPDATE OF "table1", "table2"  []
 }
+
+func ExampleForUpdate_ofSkipLocked() {
+	sql, args, _ := goqu.From("jobs").Join(
+		goqu.T("queue"),
+		goqu.On(goqu.I("queue.job_id").Eq(goqu.I("jobs.id"))),
+	).ForUpdate(goqu.SkipLocked, goqu.T("jobs")).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT * FROM "jobs" INNER JOIN "queue" ON ("queue"."job_id" = "jobs"."id") FOR UPDATE OF "jobs" SKIP LOCKED []
+}
@@ -194,6 +194,73 @@ func (sds *selectDatasetSuite) TestWithRecursive() {
 	)
 }
 
+func (sds *selectDatasetSuite) TestWithRecursive_searchCycle() {
+	from := goqu.From("cte")
+	bd := goqu.From("test")
+	search := goqu.SearchDepthFirst("id").Set("ord")
+	cycle := goqu.Cycle("id").Set("is_cycle").Using("path")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.WithRecursive("test-cte", from, search, cycle),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				CommonTablesAppend(exp.NewCommonTableExpression(true, "test-cte", from).SetSearch(search).SetCycle(cycle)),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestWithRecursive_withUnsupportedClause() {
+	sds.PanicsWithValue(goqu.ErrUnsupportedRecursiveCTEClause, func() {
+		goqu.From("test").WithRecursive("test-cte", goqu.From("cte"), goqu.C("id"))
+	})
+}
+
+func (sds *selectDatasetSuite) TestWithCTE() {
+	cte := exp.NewCommonTableExpression(true, "test-cte", goqu.From("cte"))
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.WithCTE(cte),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				CommonTablesAppend(cte),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestWithMaterialized() {
+	from := goqu.From("cte")
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.WithMaterialized("test-cte", from),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				CommonTablesAppend(
+					exp.NewCommonTableExpression(false, "test-cte", from).SetMaterialization(exp.MaterializedCTE),
+				),
+		},
+		selectTestCase{
+			ds: bd.WithNotMaterialized("test-cte", from),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				CommonTablesAppend(
+					exp.NewCommonTableExpression(false, "test-cte", from).SetMaterialization(exp.NotMaterializedCTE),
+				),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
 func (sds *selectDatasetSuite) TestSelect() {
 	bd := goqu.From("test")
 	sds.assertCases(
@@ -607,46 +674,444 @@ func (sds *selectDatasetSuite) TestNaturalFullJoin() {
 	)
 }
 
-func (sds *selectDatasetSuite) TestCrossJoin() {
+func (sds *selectDatasetSuite) TestCrossJoin() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.CrossJoin(goqu.T("foo")),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				JoinsAppend(
+					exp.NewUnConditionedJoinExpression(exp.CrossJoinType, goqu.T("foo")),
+				),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestStraightJoin() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.StraightJoin(goqu.T("foo"), goqu.On(goqu.C("a").IsNull())),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				JoinsAppend(
+					exp.NewConditionedJoinExpression(exp.StraightJoinType, goqu.T("foo"), goqu.On(goqu.C("a").IsNull())),
+				),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestForceJoinOrder() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.ForceJoinOrder(),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetStraightJoin(true),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestHint() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Hint("TIDB_SMJ(a, b)"),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetHints([]string{"TIDB_SMJ(a, b)"}),
+		},
+		selectTestCase{
+			ds: bd.Hint("TIDB_SMJ(a, b)").Hint("MAX_EXECUTION_TIME(1000)"),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetHints([]string{"TIDB_SMJ(a, b)", "MAX_EXECUTION_TIME(1000)"}),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestClearHint() {
+	bd := goqu.From("test").Hint("TIDB_SMJ(a, b)")
+	sds.assertCases(
+		selectTestCase{
+			ds:      bd.ClearHint(),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+		selectTestCase{
+			ds: bd,
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetHints([]string{"TIDB_SMJ(a, b)"}),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestAsOfSystemTime() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.AsOfSystemTime("-10s"),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetAsOfSystemTime("-10s"),
+		},
+		selectTestCase{
+			ds:      bd.AsOfSystemTime("-10s").ClearAsOfSystemTime(),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestQualify() {
+	w := goqu.Ex{"a": 1}
+	w2 := goqu.Ex{"b": "c"}
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Qualify(w),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				QualifyAppend(w),
+		},
+		selectTestCase{
+			ds: bd.Qualify(w).Qualify(w2),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				QualifyAppend(w).QualifyAppend(w2),
+		},
+		selectTestCase{
+			ds:      bd.Qualify(w).ClearQualify(),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestConnectBy() {
+	cond := goqu.L("PRIOR ?", goqu.C("id")).Eq(goqu.C("parent_id"))
+	cond2 := goqu.C("level").Lt(3)
+	sw := goqu.C("parent_id").IsNull()
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.ConnectBy(cond),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetConnectBy(exp.NewConnectByExpression(false, cond)),
+		},
+		selectTestCase{
+			ds: bd.ConnectByNoCycle(cond, cond2),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetConnectBy(exp.NewConnectByExpression(true, cond, cond2)),
+		},
+		selectTestCase{
+			ds: bd.StartWith(sw).ConnectBy(cond),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetConnectBy(exp.NewConnectByExpression(false, cond).StartWithAppend(sw)),
+		},
+		selectTestCase{
+			// replaces the CONNECT BY conditions and keeps the START WITH
+			ds: bd.ConnectBy(cond).StartWith(sw).ConnectByNoCycle(cond2),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetConnectBy(exp.NewConnectByExpression(true, cond2).StartWithAppend(sw)),
+		},
+		selectTestCase{
+			ds:      bd.StartWith(sw).ConnectBy(cond).ClearConnectBy(),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestInto() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Into("staging"),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetInto(exp.NewSelectIntoExpression(exp.ParseIdentifier("staging"), false)),
+		},
+		selectTestCase{
+			ds: bd.Into(goqu.T("staging").Schema("public")),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetInto(exp.NewSelectIntoExpression(goqu.T("staging").Schema("public"), false)),
+		},
+		selectTestCase{
+			ds: bd.IntoTemp("staging"),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetInto(exp.NewSelectIntoExpression(exp.ParseIdentifier("staging"), true)),
+		},
+		selectTestCase{
+			ds:      bd.IntoTemp("staging").ClearInto(),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+	sds.PanicsWithValue(goqu.ErrUnsupportedIntoType, func() {
+		bd.Into(true)
+	})
+}
+
+func (sds *selectDatasetSuite) TestFinal() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds:      bd.Final(),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).SetFinal(true),
+		},
+		selectTestCase{
+			ds:      bd.Final().ClearFinal(),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestSample() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Sample(0.1),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetSample(goqu.L("?", 0.1)),
+		},
+		selectTestCase{
+			ds: bd.SampleOffset(0.1, 0.5),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetSample(goqu.L("? OFFSET ?", 0.1, 0.5)),
+		},
+		selectTestCase{
+			ds:      bd.Sample(0.1).ClearSample(),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestPrewhere() {
+	w := goqu.Ex{"a": 1}
+	w2 := goqu.Ex{"b": "c"}
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Prewhere(w),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				PrewhereAppend(w),
+		},
+		selectTestCase{
+			ds: bd.Prewhere(w).Prewhere(w2),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				PrewhereAppend(w).PrewhereAppend(w2),
+		},
+		selectTestCase{
+			ds:      bd.Prewhere(w).ClearPrewhere(),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestSettings() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Settings(goqu.Record{"a": 1}).Settings(goqu.Record{"b": 2, "a": 3}),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetSettings(exp.Record{"a": 3, "b": 2}),
+		},
+		selectTestCase{
+			ds:      bd.Settings(goqu.Record{"a": 1}).ClearSettings(),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestWhere() {
+	w := goqu.Ex{"a": 1}
+	w2 := goqu.Ex{"b": "c"}
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Where(w),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				WhereAppend(w),
+		},
+		selectTestCase{
+			ds: bd.Where(w).Where(w2),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				WhereAppend(w).WhereAppend(w2),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestWhereIf() {
+	w := goqu.Ex{"a": 1}
+	w2 := goqu.Ex{"b": "c"}
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.WhereIf(true, w),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				WhereAppend(w),
+		},
+		selectTestCase{
+			ds:      bd.WhereIf(false, w),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+		selectTestCase{
+			ds: bd.WhereIf(true, w).WhereIf(false, w2),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				WhereAppend(w),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestWhereExists() {
+	bd := goqu.From("test")
+	sub := goqu.From("test2").Where(goqu.I("test2.id").Eq(goqu.I("test.id")))
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.WhereExists(sub),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				WhereAppend(goqu.Exists(sub)),
+		},
+		selectTestCase{
+			ds: bd.WhereNotExists(sub),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				WhereAppend(goqu.NotExists(sub)),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestWhereExists_ToSQL() {
+	sub := goqu.From("orders").Correlate(map[string]string{"orders.user_id": "users.id"})
+	sql, args, err := goqu.From("users").WhereExists(sub).ToSQL()
+	sds.NoError(err)
+	sds.Empty(args)
+	sds.Equal(`SELECT * FROM "users" WHERE EXISTS (SELECT * FROM "orders" WHERE ("orders"."user_id" = "users"."id"))`, sql)
+
+	sql, args, err = goqu.From("users").
+		Where(goqu.C("active").IsTrue()).
+		WhereNotExists(sub.Where(goqu.C("total").Gt(10))).
+		Prepared(true).
+		ToSQL()
+	sds.NoError(err)
+	sds.Equal([]interface{}{int64(10)}, args)
+	sds.Equal(`SELECT * FROM "users" WHERE (("active" IS TRUE) AND `+
+		`NOT EXISTS (SELECT * FROM "orders" WHERE (("orders"."user_id" = "users"."id") AND ("total" > ?))))`, sql)
+}
+
+func (sds *selectDatasetSuite) TestCorrelate() {
 	bd := goqu.From("test")
 	sds.assertCases(
 		selectTestCase{
-			ds: bd.CrossJoin(goqu.T("foo")),
+			ds: bd.Correlate(map[string]string{"test.b": "outer.b", "test.a": "outer.a"}),
 			clauses: exp.NewSelectClauses().
 				SetFrom(exp.NewColumnListExpression("test")).
-				JoinsAppend(
-					exp.NewUnConditionedJoinExpression(exp.CrossJoinType, goqu.T("foo")),
-				),
+				WhereAppend(goqu.I("test.a").Eq(goqu.I("outer.a")), goqu.I("test.b").Eq(goqu.I("outer.b"))),
 		},
 		selectTestCase{
-			ds:      bd,
+			ds:      bd.Correlate(nil),
 			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
 		},
 	)
 }
 
-func (sds *selectDatasetSuite) TestWhere() {
-	w := goqu.Ex{"a": 1}
-	w2 := goqu.Ex{"b": "c"}
+func (sds *selectDatasetSuite) TestApplyIf() {
 	bd := goqu.From("test")
+	limit := func(ds *goqu.SelectDataset) *goqu.SelectDataset { return ds.Limit(10) }
 	sds.assertCases(
 		selectTestCase{
-			ds: bd.Where(w),
+			ds: bd.ApplyIf(true, limit),
 			clauses: exp.NewSelectClauses().
 				SetFrom(exp.NewColumnListExpression("test")).
-				WhereAppend(w),
+				SetLimit(uint(10)),
 		},
 		selectTestCase{
-			ds: bd.Where(w).Where(w2),
-			clauses: exp.NewSelectClauses().
-				SetFrom(exp.NewColumnListExpression("test")).
-				WhereAppend(w).WhereAppend(w2),
+			ds:      bd.ApplyIf(false, limit),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
 		},
 		selectTestCase{
 			ds:      bd,
 			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
 		},
 	)
+	sds.NotPanics(func() {
+		bd.ApplyIf(false, nil)
+	})
 }
 
 func (sds *selectDatasetSuite) TestClearWhere() {
@@ -686,6 +1151,34 @@ func (sds *selectDatasetSuite) TestForUpdate() {
 				SetFrom(exp.NewColumnListExpression("test")).
 				SetLock(exp.NewLock(exp.ForUpdate, goqu.NoWait, goqu.T("table1"), goqu.T("table2"))),
 		},
+		selectTestCase{
+			ds: bd.ForUpdate(goqu.SkipLocked, goqu.T("jobs"), goqu.I("public.queue")),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetLock(exp.NewLock(exp.ForUpdate, goqu.SkipLocked, goqu.T("jobs"), goqu.I("public.queue"))),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestForUpdateWait() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.ForUpdateWait(5),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetLock(exp.NewLockWithWaitSeconds(exp.ForUpdate, 5)),
+		},
+		selectTestCase{
+			ds: bd.ForUpdateWait(0, goqu.T("table1")),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetLock(exp.NewLockWithWaitSeconds(exp.ForUpdate, 0, goqu.T("table1"))),
+		},
 		selectTestCase{
 			ds:      bd,
 			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
@@ -913,6 +1406,29 @@ func (sds *selectDatasetSuite) TestOrder() {
 	)
 }
 
+func (sds *selectDatasetSuite) TestOrderRandom() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.OrderRandom(10),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetOrder(goqu.Random().Asc()).
+				SetLimit(uint(10)),
+		},
+		selectTestCase{
+			ds: bd.Order(goqu.C("a").Asc()).Limit(5).OrderRandom(0),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetOrder(goqu.Random().Asc()),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
 func (sds *selectDatasetSuite) TestOrderAppend() {
 	bd := goqu.From("test").Order(goqu.C("a").Asc())
 	sds.assertCases(
@@ -963,6 +1479,34 @@ func (sds *selectDatasetSuite) TestClearOrder() {
 	)
 }
 
+func (sds *selectDatasetSuite) TestFetch() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Fetch(goqu.FetchFirst(10).WithTies()),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetLimit(exp.NewFetchExpression(uint(10)).WithTies()),
+		},
+		selectTestCase{
+			ds: bd.Limit(5).Fetch(goqu.FetchFirst(10)),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetLimit(exp.NewFetchExpression(uint(10))),
+		},
+		selectTestCase{
+			ds: bd.Fetch(goqu.FetchFirst(10)).Limit(2),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetLimit(uint(2)),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
 func (sds *selectDatasetSuite) TestLimit() {
 	bd := goqu.From("test")
 	sds.assertCases(
@@ -1017,6 +1561,29 @@ func (sds *selectDatasetSuite) TestLimitAll() {
 	)
 }
 
+func (sds *selectDatasetSuite) TestLimitOver() {
+	bd := goqu.From("test")
+	w := goqu.W().PartitionBy("a").OrderBy(goqu.C("b").Desc())
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.LimitOver(2, w),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetLimit(goqu.L("? OVER ?", uint(2), w)),
+		},
+		selectTestCase{
+			ds: bd.Limit(10).LimitOver(2, w),
+			clauses: exp.NewSelectClauses().
+				SetFrom(exp.NewColumnListExpression("test")).
+				SetLimit(goqu.L("? OVER ?", uint(2), w)),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
 func (sds *selectDatasetSuite) TestClearLimit() {
 	bd := goqu.From("test").Limit(10)
 	sds.assertCases(
@@ -1061,6 +1628,33 @@ func (sds *selectDatasetSuite) TestClearOffset() {
 	)
 }
 
+func (sds *selectDatasetSuite) TestPaginate() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds:      bd.Paginate(1, 20),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).SetLimit(uint(20)),
+		},
+		selectTestCase{
+			ds:      bd.Paginate(0, 20),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).SetLimit(uint(20)),
+		},
+		selectTestCase{
+			ds: bd.Paginate(3, 20),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				SetLimit(uint(20)).
+				SetOffset(40),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+
+	_, _, err := bd.Limit(10).Offset(10).Paginate(2, 0).ToSQL()
+	sds.EqualError(err, "goqu: unable to paginate with less than 1 row per page")
+}
+
 func (sds *selectDatasetSuite) TestUnion() {
 	uds := goqu.From("union_test")
 	bd := goqu.From("test")
@@ -1125,6 +1719,78 @@ func (sds *selectDatasetSuite) TestIntersectAll() {
 	)
 }
 
+func (sds *selectDatasetSuite) TestExcept() {
+	uds := goqu.From("except_test")
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Except(uds),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				CompoundsAppend(exp.NewCompoundExpression(exp.ExceptCompoundType, uds)),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestExceptAll() {
+	uds := goqu.From("except_test")
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.ExceptAll(uds),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				CompoundsAppend(exp.NewCompoundExpression(exp.ExceptAllCompoundType, uds)),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestParenthesize() {
+	uds := goqu.From("union_test")
+	ids := goqu.From("intersect_test")
+	bd := goqu.From("test").Union(uds)
+	pd := bd.Parenthesize()
+	sds.assertCases(
+		selectTestCase{
+			ds:      pd,
+			clauses: exp.NewSelectClauses().SetParenthesized(bd),
+		},
+		selectTestCase{
+			ds: pd.Intersect(ids).Order(goqu.C("id").Asc()),
+			clauses: exp.NewSelectClauses().SetParenthesized(bd).
+				CompoundsAppend(exp.NewCompoundExpression(exp.IntersectCompoundType, ids)).
+				SetOrder(goqu.C("id").Asc()),
+		},
+		selectTestCase{
+			ds: bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				CompoundsAppend(exp.NewCompoundExpression(exp.UnionCompoundType, uds)),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestParenthesize_ToSQL() {
+	ds := goqu.From("a").Union(goqu.From("b")).Parenthesize().
+		Intersect(goqu.From("c")).
+		Order(goqu.C("id").Asc()).
+		Limit(10)
+	sql, args, err := ds.ToSQL()
+	sds.NoError(err)
+	sds.Empty(args)
+	sds.Equal(`(SELECT * FROM "a" UNION (SELECT * FROM "b")) INTERSECT (SELECT * FROM "c") ORDER BY "id" ASC LIMIT 10`, sql)
+
+	sql, args, err = ds.Prepared(true).ToSQL()
+	sds.NoError(err)
+	sds.Equal([]interface{}{int64(10)}, args)
+	sds.Equal(`(SELECT * FROM "a" UNION (SELECT * FROM "b")) INTERSECT (SELECT * FROM "c") ORDER BY "id" ASC LIMIT ?`, sql)
+}
+
 func (sds *selectDatasetSuite) TestAs() {
 	bd := goqu.From("test")
 	sds.assertCases(
@@ -1140,6 +1806,57 @@ func (sds *selectDatasetSuite) TestAs() {
 	)
 }
 
+func (sds *selectDatasetSuite) TestAsUnique() {
+	bd := goqu.From("test")
+	sq1 := bd.AsUnique()
+	sq2 := bd.AsUnique()
+	sds.Regexp(`^goqu_sq_\d+$`, sq1.GetAs().GetTable())
+	sds.NotEqual(sq1.GetAs(), sq2.GetAs())
+	sds.Nil(bd.GetAs())
+
+	sql, _, err := goqu.From(goqu.T("users")).Join(sq1, goqu.On(sq1.Col("user_id").Eq(goqu.I("users.id")))).ToSQL()
+	sds.NoError(err)
+	alias := sq1.GetAs().GetTable()
+	sds.Equal(
+		`SELECT * FROM "users" INNER JOIN (SELECT * FROM "test") AS "`+alias+`" ON ("`+alias+`"."user_id" = "users"."id")`,
+		sql,
+	)
+}
+
+func (sds *selectDatasetSuite) TestCol() {
+	bd := goqu.From("test")
+	sds.Equal(goqu.T("t").Col("a"), bd.As("t").Col("a"))
+	sds.Equal(goqu.T("t").All(), bd.As("t").Col("*"))
+	sds.Equal(goqu.C("a"), bd.Col("a"))
+}
+
+func (sds *selectDatasetSuite) TestAsTable() {
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.AsTable("t", "a", "b"),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				SetAlias(goqu.T("t")).
+				SetAliasColumns(exp.NewColumnListExpression("a", "b")),
+		},
+		selectTestCase{
+			ds: bd.AsTable("t"),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				SetAlias(goqu.T("t")),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestGetAsColumns() {
+	ds := goqu.From("test")
+	sds.Nil(ds.GetAsColumns())
+	sds.Equal(exp.NewColumnListExpression("a", "b"), ds.AsTable("t", "a", "b").GetAsColumns())
+}
+
 func (sds *selectDatasetSuite) TestToSQL() {
 	md := new(mocks.SQLDialect)
 	ds := goqu.From("test").SetDialect(md)
@@ -1543,6 +2260,136 @@ func (sds *selectDatasetSuite) TestCount_WithPreparedStatement() {
 	sds.Equal(int64(10), count)
 }
 
+func (sds *selectDatasetSuite) TestScanStructsAndCount() {
+	mDB, sqlMock, err := sqlmock.New()
+	sds.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items" ORDER BY "name" ASC LIMIT 2 OFFSET 2`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			FromCSVString("111 Test Addr,Test3\n211 Test Addr,Test4"))
+	sqlMock.ExpectQuery(`SELECT COUNT\(\*\) AS "count" FROM \(SELECT 1 FROM "items"\) AS "t1" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).FromCSVString("5"))
+
+	sqlMock.ExpectQuery(`SELECT "name" FROM "items" GROUP BY "name" LIMIT 2`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Test1\nTest2"))
+	sqlMock.ExpectQuery(
+		`SELECT COUNT\(\*\) AS "count" FROM \(SELECT "name" FROM "items" GROUP BY "name"\) AS "t1" LIMIT 1`,
+	).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).FromCSVString("3"))
+
+	sqlMock.ExpectQuery(`SELECT "test" FROM "items" LIMIT 2`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"test"}).FromCSVString("test1\ntest2"))
+
+	db := goqu.New("mock", mDB)
+	var items []dsTestActionItem
+	total, err := db.From("items").Order(goqu.C("name").Asc()).Paginate(2, 2).ScanStructsAndCount(&items)
+	sds.NoError(err)
+	sds.Equal(int64(5), total)
+	sds.Equal([]dsTestActionItem{
+		{Address: "111 Test Addr", Name: "Test3"},
+		{Address: "211 Test Addr", Name: "Test4"},
+	}, items)
+
+	items = items[0:0]
+	total, err = db.From("items").Select("name").GroupBy("name").Paginate(1, 2).ScanStructsAndCount(&items)
+	sds.NoError(err)
+	sds.Equal(int64(3), total)
+	sds.Equal([]dsTestActionItem{{Name: "Test1"}, {Name: "Test2"}}, items)
+
+	items = items[0:0]
+	total, err = db.From("items").Select("test").Paginate(1, 2).ScanStructsAndCount(&items)
+	sds.EqualError(err, `goqu: unable to find corresponding field to column "test" returned by query`)
+	sds.Equal(int64(0), total)
+
+	_, err = goqu.From("items").ScanStructsAndCount(&items)
+	sds.Equal(goqu.ErrQueryFactoryNotFoundError, err)
+	sds.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (sds *selectDatasetSuite) TestCountDataset() {
+	ds := goqu.From("test").Where(goqu.C("a").Gt(10)).Order(goqu.C("a").Asc()).Limit(10).Offset(20)
+
+	sql, args, err := ds.CountDataset().ToSQL()
+	sds.NoError(err)
+	sds.Empty(args)
+	sds.Equal(`SELECT COUNT(*) AS "count" FROM (SELECT 1 FROM "test" WHERE ("a" > 10)) AS "t1"`, sql)
+
+	sql, args, err = ds.Prepared(true).CountDataset().ToSQL()
+	sds.NoError(err)
+	sds.Equal([]interface{}{int64(10)}, args)
+	sds.Equal(`SELECT COUNT(*) AS "count" FROM (SELECT 1 FROM "test" WHERE ("a" > ?)) AS "t1"`, sql)
+
+	sql, _, err = goqu.From("test").Select(goqu.SUM("a")).CountDataset().ToSQL()
+	sds.NoError(err)
+	sds.Equal(`SELECT COUNT(*) AS "count" FROM (SELECT SUM("a") FROM "test") AS "t1"`, sql)
+
+	sql, _, err = ds.Select("a").GroupBy("a").CountDataset().ToSQL()
+	sds.NoError(err)
+	sds.Equal(`SELECT COUNT(*) AS "count" FROM (SELECT "a" FROM "test" WHERE ("a" > 10) GROUP BY "a") AS "t1"`, sql)
+
+	sql, _, err = ds.Select("a").Distinct().CountDataset().ToSQL()
+	sds.NoError(err)
+	sds.Equal(`SELECT COUNT(*) AS "count" FROM (SELECT DISTINCT "a" FROM "test" WHERE ("a" > 10)) AS "t1"`, sql)
+
+	sql, _, err = goqu.From("a").Union(goqu.From("b")).Order(goqu.C("id").Asc()).CountDataset().ToSQL()
+	sds.NoError(err)
+	sds.Equal(`SELECT COUNT(*) AS "count" FROM (SELECT * FROM "a" UNION (SELECT * FROM "b")) AS "t1"`, sql)
+
+	sql, _, err = goqu.From("a").Join(goqu.T("b"), goqu.On(goqu.I("a.id").Eq(goqu.I("b.a_id")))).CountDataset().ToSQL()
+	sds.NoError(err)
+	sds.Equal(
+		`SELECT COUNT(*) AS "count" FROM (SELECT 1 FROM "a" INNER JOIN "b" ON ("a"."id" = "b"."a_id")) AS "t1"`,
+		sql,
+	)
+}
+
+func (sds *selectDatasetSuite) TestExistsDataset() {
+	ds := goqu.From("test").Where(goqu.C("a").Gt(10))
+
+	sql, args, err := ds.ExistsDataset().ToSQL()
+	sds.NoError(err)
+	sds.Empty(args)
+	sds.Equal(`SELECT CASE  WHEN EXISTS (SELECT * FROM "test" WHERE ("a" > 10)) THEN 1 ELSE 0 END`, sql)
+
+	sql, args, err = ds.Prepared(true).ExistsDataset().ToSQL()
+	sds.NoError(err)
+	sds.Equal([]interface{}{int64(10)}, args)
+	sds.Equal(`SELECT CASE  WHEN EXISTS (SELECT * FROM "test" WHERE ("a" > ?)) THEN 1 ELSE 0 END`, sql)
+
+	_, _, err = ds.SetError(errors.New("expected error")).ExistsDataset().ToSQL()
+	sds.EqualError(err, "goqu: expected error")
+}
+
+func (sds *selectDatasetSuite) TestExists() {
+	mDB, sqlMock, err := sqlmock.New()
+	sds.NoError(err)
+	sqlMock.ExpectQuery(
+		`SELECT CASE  WHEN EXISTS \(SELECT \* FROM "items" WHERE \("name" = 'Bob'\)\) THEN 1 ELSE 0 END`,
+	).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).FromCSVString("1"))
+	sqlMock.ExpectQuery(`SELECT CASE  WHEN EXISTS \(SELECT \* FROM "items" WHERE \("name" = \?\)\) THEN 1 ELSE 0 END`).
+		WithArgs("Sally").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).FromCSVString("0"))
+
+	db := goqu.New("mock", mDB)
+	exists, err := db.From("items").Where(goqu.C("name").Eq("Bob")).Exists()
+	sds.NoError(err)
+	sds.True(exists)
+
+	exists, err = db.From("items").Prepared(true).Where(goqu.C("name").Eq("Sally")).Exists()
+	sds.NoError(err)
+	sds.False(exists)
+
+	_, err = goqu.From("items").Exists()
+	sds.Equal(goqu.ErrQueryFactoryNotFoundError, err)
+	sds.NoError(sqlMock.ExpectationsWereMet())
+}
+
 func (sds *selectDatasetSuite) TestPluck() {
 	mDB, sqlMock, err := sqlmock.New()
 	sds.NoError(err)
@@ -0,0 +1,129 @@
+package goqu
+
+import (
+	"reflect"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+)
+
+// SelectMergePolicy determines how SelectDataset#Merge combines the clauses that are set on both datasets and can
+// only have a single value or where the order matters (SELECT, GROUP BY, ORDER, LIMIT and OFFSET).
+type SelectMergePolicy int
+
+const (
+	// Append the SELECT columns, GROUP BY and ORDER of the other dataset. An error is returned if both datasets set a
+	// different LIMIT or OFFSET.
+	SelectMergeAppend SelectMergePolicy = iota
+	// Replace the SELECT columns, GROUP BY, ORDER, LIMIT and OFFSET with the ones of the other dataset when it sets
+	// them.
+	SelectMergeOverride
+	// Return an error if both datasets set the SELECT columns, GROUP BY, ORDER, LIMIT or OFFSET.
+	SelectMergeStrict
+)
+
+var errMergeDifferentFrom = errors.New("unable to merge datasets with different FROM clauses")
+
+func errMergeConflict(clause string) error {
+	return errors.New("unable to merge datasets, both datasets set the %s clause", clause)
+}
+
+// Merge combines the clauses of other with the clauses of this dataset, this is useful when a query is built by
+// several independent modules.
+//
+// The WITH and JOIN clauses are concatenated and the WHERE and HAVING conditions are ANDed together. The FROM of other
+// is used if this dataset does not have one, an error is returned if both datasets select from different tables. The
+// SELECT columns, GROUP BY, ORDER, LIMIT and OFFSET are combined using the policy when they are set on both datasets.
+// Any other clause of other is ignored.
+//    ds := From("orders").Select("id").Where(C("status").Eq("open"))
+//    ds.Merge(From("orders").Select("total").Where(C("total").Gt(100)), SelectMergeAppend)
+//    // SELECT "id", "total" FROM "orders" WHERE (("status" = 'open') AND ("total" > 100))
+func (sd *SelectDataset) Merge(other *SelectDataset, policy SelectMergePolicy) *SelectDataset {
+	if other.err != nil {
+		return sd.copy(sd.clauses).SetError(other.err)
+	}
+	c, err := mergeSelectClauses(sd.clauses, other.clauses, policy)
+	if err != nil {
+		return sd.copy(sd.clauses).SetError(err)
+	}
+	return sd.copy(c)
+}
+
+func mergeSelectClauses(c, o exp.SelectClauses, policy SelectMergePolicy) (exp.SelectClauses, error) {
+	for _, cte := range o.CommonTables() {
+		c = c.CommonTablesAppend(cte)
+	}
+
+	if o.HasSources() {
+		if !c.HasSources() {
+			c = c.SetFrom(o.From())
+		} else if !reflect.DeepEqual(c.From(), o.From()) {
+			return nil, errMergeDifferentFrom
+		}
+	}
+
+	if !o.IsDefaultSelect() {
+		switch {
+		case c.IsDefaultSelect() || policy == SelectMergeOverride:
+			c = c.SetSelect(o.Select())
+		case policy == SelectMergeAppend:
+			c = c.SelectAppend(o.Select())
+		default:
+			return nil, errMergeConflict("SELECT")
+		}
+	}
+
+	for _, j := range o.Joins() {
+		c = c.JoinsAppend(j)
+	}
+	if o.Where() != nil {
+		c = c.WhereAppend(o.Where().Expressions()...)
+	}
+
+	if o.GroupBy() != nil {
+		switch {
+		case c.GroupBy() == nil || policy == SelectMergeOverride:
+			c = c.SetGroupBy(o.GroupBy())
+		case policy == SelectMergeAppend:
+			c = c.GroupByAppend(o.GroupBy())
+		default:
+			return nil, errMergeConflict("GROUP BY")
+		}
+	}
+	if o.Having() != nil {
+		c = c.HavingAppend(o.Having().Expressions()...)
+	}
+
+	if o.HasOrder() {
+		oes := make([]exp.OrderedExpression, 0, len(o.Order().Columns()))
+		for _, oe := range o.Order().Columns() {
+			oes = append(oes, oe.(exp.OrderedExpression))
+		}
+		switch {
+		case !c.HasOrder() || policy == SelectMergeOverride:
+			c = c.SetOrder(oes...)
+		case policy == SelectMergeAppend:
+			c = c.OrderAppend(oes...)
+		default:
+			return nil, errMergeConflict("ORDER")
+		}
+	}
+
+	if o.HasLimit() {
+		switch {
+		case !c.HasLimit() || policy == SelectMergeOverride:
+			c = c.SetLimit(o.Limit())
+		case policy == SelectMergeStrict || !reflect.DeepEqual(c.Limit(), o.Limit()):
+			return nil, errMergeConflict("LIMIT")
+		}
+	}
+	if o.Offset() > 0 {
+		switch {
+		case c.Offset() == 0 || policy == SelectMergeOverride:
+			c = c.SetOffset(o.Offset())
+		case policy == SelectMergeStrict || c.Offset() != o.Offset():
+			return nil, errMergeConflict("OFFSET")
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,150 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type selectMergeSuite struct {
+	suite.Suite
+}
+
+func TestSelectMergeSuite(t *testing.T) {
+	suite.Run(t, new(selectMergeSuite))
+}
+
+func (sms *selectMergeSuite) assertSQL(ds *goqu.SelectDataset, expectedSQL string) {
+	sql, args, err := ds.ToSQL()
+	sms.NoError(err)
+	sms.Empty(args)
+	sms.Equal(expectedSQL, sql)
+}
+
+func (sms *selectMergeSuite) assertError(ds *goqu.SelectDataset, expectedErr string) {
+	_, _, err := ds.ToSQL()
+	sms.EqualError(err, expectedErr)
+}
+
+func (sms *selectMergeSuite) TestMerge_combinesClauses() {
+	ds := goqu.From("orders").
+		With("open", goqu.From("status").Where(goqu.C("open").IsTrue())).
+		Select("orders.id").
+		Where(goqu.I("orders.status").Eq("open"))
+	other := goqu.From().
+		With("big", goqu.From("totals").Where(goqu.C("total").Gt(100))).
+		Join(goqu.T("users"), goqu.On(goqu.I("users.id").Eq(goqu.I("orders.user_id")))).
+		Where(goqu.I("orders.total").Gt(100), goqu.I("users.active").IsTrue()).
+		GroupBy("orders.id").
+		Having(goqu.COUNT("*").Gt(1))
+
+	sms.assertSQL(
+		ds.Merge(other, goqu.SelectMergeStrict),
+		`WITH open AS (SELECT * FROM "status" WHERE ("open" IS TRUE)), `+
+			`big AS (SELECT * FROM "totals" WHERE ("total" > 100)) `+
+			`SELECT "orders"."id" FROM "orders" INNER JOIN "users" ON ("users"."id" = "orders"."user_id") `+
+			`WHERE (("orders"."status" = 'open') AND ("orders"."total" > 100) AND ("users"."active" IS TRUE)) `+
+			`GROUP BY "orders"."id" HAVING (COUNT(*) > 1)`,
+	)
+	// the original datasets are not changed
+	sms.assertSQL(ds, `WITH open AS (SELECT * FROM "status" WHERE ("open" IS TRUE)) `+
+		`SELECT "orders"."id" FROM "orders" WHERE ("orders"."status" = 'open')`)
+}
+
+func (sms *selectMergeSuite) TestMerge_from() {
+	sms.assertSQL(
+		goqu.From().Where(goqu.C("a").Eq(1)).Merge(goqu.From("test"), goqu.SelectMergeStrict),
+		`SELECT * FROM "test" WHERE ("a" = 1)`,
+	)
+	sms.assertSQL(
+		goqu.From("test").Merge(goqu.From("test").Where(goqu.C("a").Eq(1)), goqu.SelectMergeStrict),
+		`SELECT * FROM "test" WHERE ("a" = 1)`,
+	)
+	for _, policy := range []goqu.SelectMergePolicy{
+		goqu.SelectMergeAppend, goqu.SelectMergeOverride, goqu.SelectMergeStrict,
+	} {
+		sms.assertError(
+			goqu.From("test").Merge(goqu.From("test2"), policy),
+			"goqu: unable to merge datasets with different FROM clauses",
+		)
+	}
+}
+
+func (sms *selectMergeSuite) TestMerge_select() {
+	ds := goqu.From("test").Select("a")
+	other := goqu.From("test").Select("b")
+
+	sms.assertSQL(ds.Merge(other, goqu.SelectMergeAppend), `SELECT "a", "b" FROM "test"`)
+	sms.assertSQL(ds.Merge(other, goqu.SelectMergeOverride), `SELECT "b" FROM "test"`)
+	sms.assertError(
+		ds.Merge(other, goqu.SelectMergeStrict),
+		"goqu: unable to merge datasets, both datasets set the SELECT clause",
+	)
+	// a default select is never a conflict
+	sms.assertSQL(ds.Merge(goqu.From("test"), goqu.SelectMergeStrict), `SELECT "a" FROM "test"`)
+	sms.assertSQL(goqu.From("test").Merge(other, goqu.SelectMergeStrict), `SELECT "b" FROM "test"`)
+}
+
+func (sms *selectMergeSuite) TestMerge_groupBy() {
+	ds := goqu.From("test").GroupBy("a")
+	other := goqu.From("test").GroupBy("b")
+
+	sms.assertSQL(ds.Merge(other, goqu.SelectMergeAppend), `SELECT * FROM "test" GROUP BY "a", "b"`)
+	sms.assertSQL(ds.Merge(other, goqu.SelectMergeOverride), `SELECT * FROM "test" GROUP BY "b"`)
+	sms.assertError(
+		ds.Merge(other, goqu.SelectMergeStrict),
+		"goqu: unable to merge datasets, both datasets set the GROUP BY clause",
+	)
+}
+
+func (sms *selectMergeSuite) TestMerge_order() {
+	ds := goqu.From("test").Order(goqu.C("a").Asc())
+	other := goqu.From("test").Order(goqu.C("b").Desc())
+
+	sms.assertSQL(ds.Merge(other, goqu.SelectMergeAppend), `SELECT * FROM "test" ORDER BY "a" ASC, "b" DESC`)
+	sms.assertSQL(ds.Merge(other, goqu.SelectMergeOverride), `SELECT * FROM "test" ORDER BY "b" DESC`)
+	sms.assertError(
+		ds.Merge(other, goqu.SelectMergeStrict),
+		"goqu: unable to merge datasets, both datasets set the ORDER clause",
+	)
+	sms.assertSQL(goqu.From("test").Merge(other, goqu.SelectMergeStrict), `SELECT * FROM "test" ORDER BY "b" DESC`)
+}
+
+func (sms *selectMergeSuite) TestMerge_limitOffset() {
+	ds := goqu.From("test").Limit(10).Offset(20)
+
+	sms.assertSQL(ds.Merge(goqu.From("test").Limit(10), goqu.SelectMergeAppend), `SELECT * FROM "test" LIMIT 10 OFFSET 20`)
+	sms.assertSQL(ds.Merge(goqu.From("test").Offset(20), goqu.SelectMergeAppend), `SELECT * FROM "test" LIMIT 10 OFFSET 20`)
+	sms.assertSQL(
+		ds.Merge(goqu.From("test").Limit(5).Offset(5), goqu.SelectMergeOverride),
+		`SELECT * FROM "test" LIMIT 5 OFFSET 5`,
+	)
+	sms.assertSQL(
+		goqu.From("test").Merge(goqu.From("test").Limit(5).Offset(5), goqu.SelectMergeStrict),
+		`SELECT * FROM "test" LIMIT 5 OFFSET 5`,
+	)
+	sms.assertError(
+		ds.Merge(goqu.From("test").Limit(5), goqu.SelectMergeAppend),
+		"goqu: unable to merge datasets, both datasets set the LIMIT clause",
+	)
+	sms.assertError(
+		ds.Merge(goqu.From("test").Limit(10), goqu.SelectMergeStrict),
+		"goqu: unable to merge datasets, both datasets set the LIMIT clause",
+	)
+	sms.assertError(
+		ds.Merge(goqu.From("test").Offset(5), goqu.SelectMergeAppend),
+		"goqu: unable to merge datasets, both datasets set the OFFSET clause",
+	)
+	sms.assertError(
+		ds.Merge(goqu.From("test").Offset(20), goqu.SelectMergeStrict),
+		"goqu: unable to merge datasets, both datasets set the OFFSET clause",
+	)
+}
+
+func (sms *selectMergeSuite) TestMerge_withError() {
+	ds := goqu.From("test")
+	other := goqu.From("test").SetError(goqu.ErrQueryFactoryNotFoundError)
+	sms.assertError(ds.Merge(other, goqu.SelectMergeAppend), goqu.ErrQueryFactoryNotFoundError.Error())
+	sms.NoError(ds.Error())
+}
@@ -0,0 +1,169 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// SetParamDataset for creating and/or executing SET statements that change a session or transaction scoped
+// parameter (e.g. SET "statement_timeout" = 5000).
+type SetParamDataset struct {
+	dialect      SQLDialect
+	clauses      exp.SetParamClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var ErrUnsupportedParamType = errors.New(
+	"unsupported parameter type, a string or identifier expression is required",
+)
+
+// used internally by database to create a database with a specific adapter.
+func newSetParamDataset(d string, queryFactory exec.QueryFactory) *SetParamDataset {
+	return &SetParamDataset{
+		clauses:      exp.NewSetParamClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// SetParam creates a SetParamDataset that sets a parameter to one or more values, the values are always interpolated
+// and escaped.
+//
+//	goqu.Dialect("postgres").SetParam("search_path", "my_schema", "public")
+//	goqu.Dialect("postgres").SetParam("statement_timeout", 5000).Local()
+func SetParam(param interface{}, values ...interface{}) *SetParamDataset {
+	return newSetParamDataset("default", nil).Param(param).Values(values...)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (spd *SetParamDataset) WithDialect(dl string) *SetParamDataset {
+	ds := spd.copy(spd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, SET statements do not support placeholders so the values are always interpolated.
+func (spd *SetParamDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the SetParamDataset.
+func (spd *SetParamDataset) Dialect() SQLDialect {
+	return spd.dialect
+}
+
+// SetDialect sets the adapter on the SetParamDataset.
+func (spd *SetParamDataset) SetDialect(dialect SQLDialect) *SetParamDataset {
+	ds := spd.copy(spd.GetClauses())
+	ds.dialect = dialect
+	return ds
+}
+
+// Expression returns SetParamDataset as exp.Expression.
+func (spd *SetParamDataset) Expression() exp.Expression {
+	return spd
+}
+
+// Clone clones the SetParamDataset.
+func (spd *SetParamDataset) Clone() exp.Expression {
+	return spd.copy(spd.clauses)
+}
+
+// GetClauses returns the current clauses on the SetParamDataset.
+func (spd *SetParamDataset) GetClauses() exp.SetParamClauses {
+	return spd.clauses
+}
+
+// used internally to copy the dataset.
+func (spd *SetParamDataset) copy(clauses exp.SetParamClauses) *SetParamDataset {
+	return &SetParamDataset{
+		dialect:      spd.dialect,
+		clauses:      clauses,
+		queryFactory: spd.queryFactory,
+		err:          spd.err,
+	}
+}
+
+// Param sets the parameter to set. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier (e.g. "app.user_id")
+// IdentifierExpression
+func (spd *SetParamDataset) Param(param interface{}) *SetParamDataset {
+	return spd.copy(spd.clauses.SetParam(parseParam(param)))
+}
+
+// Values sets the values of the parameter, any previously set values are replaced. Use Literal("DEFAULT") to reset
+// the parameter to its default value.
+func (spd *SetParamDataset) Values(values ...interface{}) *SetParamDataset {
+	return spd.copy(spd.clauses.SetValues(values))
+}
+
+// Local only sets the parameter for the current transaction (e.g. postgres SET LOCAL).
+func (spd *SetParamDataset) Local() *SetParamDataset {
+	return spd.copy(spd.clauses.SetLocal(true))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (spd *SetParamDataset) Error() error {
+	return spd.err
+}
+
+// SetError sets an error on the SetParamDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (spd *SetParamDataset) SetError(err error) *SetParamDataset {
+	if spd.err == nil {
+		spd.err = err
+	}
+	return spd
+}
+
+// ToSQL generates a SET sql statement, SET statements are always interpolated.
+//
+// Errors:
+//   - There is no parameter or there are no values
+//   - The dialect does not support SET or SET LOCAL
+//   - There is an error generating the SQL
+func (spd *SetParamDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return spd.setParamSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (spd *SetParamDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = spd.setParamSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the SET sql, and returns an Exec struct with the sql set to the SET statement.
+//
+// db.SetParam("statement_timeout", 5000).Local().Executor().Exec()
+func (spd *SetParamDataset) Executor() exec.QueryExecutor {
+	return spd.queryFactory.FromSQLBuilder(spd.setParamSQLBuilder())
+}
+
+func (spd *SetParamDataset) setParamSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if spd.err != nil {
+		return buf.SetError(spd.err)
+	}
+	spd.dialect.ToSetParamSQL(buf, spd.clauses)
+	return buf
+}
+
+// used internally to parse the parameter of a SET or SHOW statement
+func parseParam(param interface{}) exp.IdentifierExpression {
+	switch p := param.(type) {
+	case exp.IdentifierExpression:
+		return p
+	case string:
+		return exp.ParseIdentifier(p)
+	default:
+		panic(ErrUnsupportedParamType)
+	}
+}
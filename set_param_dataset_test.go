@@ -0,0 +1,212 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	setParamTestCase struct {
+		ds      *goqu.SetParamDataset
+		clauses exp.SetParamClauses
+	}
+	setParamDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (spds *setParamDatasetSuite) assertCases(cases ...setParamTestCase) {
+	for _, s := range cases {
+		spds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (spds *setParamDatasetSuite) TestClone() {
+	ds := goqu.SetParam("search_path", "public")
+	spds.Equal(ds, ds.Clone())
+}
+
+func (spds *setParamDatasetSuite) TestExpression() {
+	ds := goqu.SetParam("search_path", "public")
+	spds.Equal(ds, ds.Expression())
+}
+
+func (spds *setParamDatasetSuite) TestDialect() {
+	ds := goqu.SetParam("search_path", "public")
+	spds.NotNil(ds.Dialect())
+}
+
+func (spds *setParamDatasetSuite) TestWithDialect() {
+	ds := goqu.SetParam("search_path", "public")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	spds.Equal(md, ds.Dialect())
+	spds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (spds *setParamDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.SetParam("search_path", "public")
+	spds.False(ds.IsPrepared())
+}
+
+func (spds *setParamDatasetSuite) TestGetClauses() {
+	ds := goqu.SetParam("search_path", "public")
+	ce := exp.NewSetParamClauses().SetParam(goqu.I("search_path")).SetValues([]interface{}{"public"})
+	spds.Equal(ce, ds.GetClauses())
+}
+
+func (spds *setParamDatasetSuite) TestParam() {
+	bd := goqu.SetParam("search_path", "public")
+	ce := bd.GetClauses()
+	spds.assertCases(
+		setParamTestCase{ds: bd.Param("role"), clauses: ce.SetParam(goqu.I("role"))},
+		setParamTestCase{ds: bd.Param(goqu.I("app.user_id")), clauses: ce.SetParam(goqu.I("app.user_id"))},
+		setParamTestCase{ds: bd, clauses: ce},
+	)
+	spds.PanicsWithValue(goqu.ErrUnsupportedParamType, func() {
+		goqu.SetParam(true, "public")
+	})
+}
+
+func (spds *setParamDatasetSuite) TestValues() {
+	bd := goqu.SetParam("search_path", "public")
+	ce := bd.GetClauses()
+	spds.assertCases(
+		setParamTestCase{ds: bd.Values("a", "b"), clauses: ce.SetValues([]interface{}{"a", "b"})},
+		setParamTestCase{ds: bd.Values("a").Values("c"), clauses: ce.SetValues([]interface{}{"c"})},
+		setParamTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (spds *setParamDatasetSuite) TestLocal() {
+	bd := goqu.SetParam("search_path", "public")
+	ce := bd.GetClauses()
+	spds.assertCases(
+		setParamTestCase{ds: bd.Local(), clauses: ce.SetLocal(true)},
+		setParamTestCase{ds: bd, clauses: ce},
+	)
+}
+
+func (spds *setParamDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.SetParam("search_path", "public").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToSetParamSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	spds.NoError(err)
+	spds.Empty(sql)
+	spds.Empty(args)
+	md.AssertExpectations(spds.T())
+}
+
+func (spds *setParamDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.SetParam("search_path", "public").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToSetParamSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	spds.Empty(sql)
+	spds.Empty(args)
+	spds.Equal(ee, err)
+	md.AssertExpectations(spds.T())
+}
+
+func (spds *setParamDatasetSuite) TestMustToSQL() {
+	sql, args := goqu.SetParam("search_path", "my'schema", "public").MustToSQL()
+	spds.Empty(args)
+	spds.Equal(`SET "search_path" = 'my''schema', 'public'`, sql)
+
+	spds.Panics(func() {
+		goqu.SetParam("search_path").MustToSQL()
+	})
+}
+
+func (spds *setParamDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	spds.NoError(err)
+
+	ds := goqu.New("mock", mDB).SetParam("statement_timeout", 5000).Local()
+
+	asql, args, err := ds.Executor().ToSQL()
+	spds.NoError(err)
+	spds.Empty(args)
+	spds.Equal(`SET LOCAL "statement_timeout" = 5000`, asql)
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// SET statements are always interpolated
+	asql, args, err = ds.Executor().ToSQL()
+	spds.NoError(err)
+	spds.Empty(args)
+	spds.Equal(`SET LOCAL "statement_timeout" = 5000`, asql)
+}
+
+func (spds *setParamDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.SetParam("search_path", "public").SetDialect(md)
+	ds = ds.SetError(err1)
+	spds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	spds.Empty(sql)
+	spds.Empty(args)
+	spds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	spds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	spds.Empty(sql)
+	spds.Empty(args)
+	spds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.Local()
+	spds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	spds.Empty(sql)
+	spds.Empty(args)
+	spds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToSetParamSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	spds.Empty(sql)
+	spds.Empty(args)
+	spds.Equal(err1, err)
+}
+
+func TestSetParamDataset(t *testing.T) {
+	suite.Run(t, new(setParamDatasetSuite))
+}
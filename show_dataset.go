@@ -0,0 +1,141 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+// ShowDataset for creating and/or executing SHOW statements that return the current value of a parameter
+// (e.g. SHOW "search_path").
+type ShowDataset struct {
+	dialect      SQLDialect
+	clauses      exp.ShowClauses
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+// used internally by database to create a database with a specific adapter.
+func newShowDataset(d string, queryFactory exec.QueryFactory) *ShowDataset {
+	return &ShowDataset{
+		clauses:      exp.NewShowClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+	}
+}
+
+// Show creates a ShowDataset that returns the current value of a parameter.
+//
+//	var searchPath string
+//	db.Show("search_path").Executor().ScanVal(&searchPath)
+func Show(param interface{}) *ShowDataset {
+	return newShowDataset("default", nil).Param(param)
+}
+
+// WithDialect sets the adapter used to serialize values and create the SQL statement.
+func (sd *ShowDataset) WithDialect(dl string) *ShowDataset {
+	ds := sd.copy(sd.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// IsPrepared always returns false, SHOW statements do not support placeholders.
+func (sd *ShowDataset) IsPrepared() bool {
+	return false
+}
+
+// Dialect returns the current adapter on the ShowDataset.
+func (sd *ShowDataset) Dialect() SQLDialect {
+	return sd.dialect
+}
+
+// SetDialect sets the adapter on the ShowDataset.
+func (sd *ShowDataset) SetDialect(dialect SQLDialect) *ShowDataset {
+	ds := sd.copy(sd.GetClauses())
+	ds.dialect = dialect
+	return ds
+}
+
+// Expression returns ShowDataset as exp.Expression.
+func (sd *ShowDataset) Expression() exp.Expression {
+	return sd
+}
+
+// Clone clones the ShowDataset.
+func (sd *ShowDataset) Clone() exp.Expression {
+	return sd.copy(sd.clauses)
+}
+
+// GetClauses returns the current clauses on the ShowDataset.
+func (sd *ShowDataset) GetClauses() exp.ShowClauses {
+	return sd.clauses
+}
+
+// used internally to copy the dataset.
+func (sd *ShowDataset) copy(clauses exp.ShowClauses) *ShowDataset {
+	return &ShowDataset{
+		dialect:      sd.dialect,
+		clauses:      clauses,
+		queryFactory: sd.queryFactory,
+		err:          sd.err,
+	}
+}
+
+// Param sets the parameter to show. You can pass in the following.
+//
+// string: Will automatically be turned into an identifier (e.g. "app.user_id")
+// IdentifierExpression
+func (sd *ShowDataset) Param(param interface{}) *ShowDataset {
+	return sd.copy(sd.clauses.SetParam(parseParam(param)))
+}
+
+// Error returns any error that has been set or nil if no error has been set.
+func (sd *ShowDataset) Error() error {
+	return sd.err
+}
+
+// SetError sets an error on the ShowDataset if one has not already been set.
+// This error will be returned by a future call to Error or as part of ToSQL.
+// This can be used by end users to record errors while building up queries without having to track those separately.
+func (sd *ShowDataset) SetError(err error) *ShowDataset {
+	if sd.err == nil {
+		sd.err = err
+	}
+	return sd
+}
+
+// ToSQL generates a SHOW sql statement.
+//
+// Errors:
+//   - There is no parameter
+//   - The dialect does not support SHOW
+//   - There is an error generating the SQL
+func (sd *ShowDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return sd.showSQLBuilder().ToSQL()
+}
+
+// MustToSQL does the same as ToSQL, but panics instead of returning an error.
+func (sd *ShowDataset) MustToSQL() (sql string, params []interface{}) {
+	var err error
+	if sql, params, err = sd.showSQLBuilder().ToSQL(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// Executor generates the SHOW sql, and returns an Exec struct with the sql set to the SHOW statement. The value can
+// be scanned using ScanVal.
+//
+// db.Show("search_path").Executor().ScanVal(&searchPath)
+func (sd *ShowDataset) Executor() exec.QueryExecutor {
+	return sd.queryFactory.FromSQLBuilder(sd.showSQLBuilder())
+}
+
+func (sd *ShowDataset) showSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(false)
+	if sd.err != nil {
+		return buf.SetError(sd.err)
+	}
+	sd.dialect.ToShowSQL(buf, sd.clauses)
+	return buf
+}
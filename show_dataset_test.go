@@ -0,0 +1,174 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type showDatasetSuite struct {
+	suite.Suite
+}
+
+func (sds *showDatasetSuite) TestClone() {
+	ds := goqu.Show("search_path")
+	sds.Equal(ds, ds.Clone())
+}
+
+func (sds *showDatasetSuite) TestExpression() {
+	ds := goqu.Show("search_path")
+	sds.Equal(ds, ds.Expression())
+}
+
+func (sds *showDatasetSuite) TestDialect() {
+	ds := goqu.Show("search_path")
+	sds.NotNil(ds.Dialect())
+}
+
+func (sds *showDatasetSuite) TestWithDialect() {
+	ds := goqu.Show("search_path")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	sds.Equal(md, ds.Dialect())
+	sds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (sds *showDatasetSuite) TestIsPrepared() {
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	ds := goqu.Show("search_path")
+	sds.False(ds.IsPrepared())
+}
+
+func (sds *showDatasetSuite) TestGetClauses() {
+	ds := goqu.Show("search_path")
+	ce := exp.NewShowClauses().SetParam(goqu.I("search_path"))
+	sds.Equal(ce, ds.GetClauses())
+}
+
+func (sds *showDatasetSuite) TestParam() {
+	bd := goqu.Show("search_path")
+	sds.Equal(exp.NewShowClauses().SetParam(goqu.I("role")), bd.Param("role").GetClauses())
+	sds.Equal(exp.NewShowClauses().SetParam(goqu.I("app.user_id")), bd.Param(goqu.I("app.user_id")).GetClauses())
+	sds.Equal(exp.NewShowClauses().SetParam(goqu.I("search_path")), bd.GetClauses())
+	sds.PanicsWithValue(goqu.ErrUnsupportedParamType, func() {
+		goqu.Show(1)
+	})
+}
+
+func (sds *showDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Show("search_path").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToShowSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	sds.NoError(err)
+	sds.Empty(sql)
+	sds.Empty(args)
+	md.AssertExpectations(sds.T())
+}
+
+func (sds *showDatasetSuite) TestToSQL_withError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Show("search_path").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToShowSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	sds.Empty(sql)
+	sds.Empty(args)
+	sds.Equal(ee, err)
+	md.AssertExpectations(sds.T())
+}
+
+func (sds *showDatasetSuite) TestMustToSQL() {
+	sql, args := goqu.Show("search_path").MustToSQL()
+	sds.Empty(args)
+	sds.Equal(`SHOW "search_path"`, sql)
+
+	sds.Panics(func() {
+		goqu.Show("search_path").SetError(errors.New("expected error")).MustToSQL()
+	})
+}
+
+func (sds *showDatasetSuite) TestExecutor() {
+	mDB, sqlMock, err := sqlmock.New()
+	sds.NoError(err)
+
+	sqlMock.ExpectQuery(`SHOW "search_path"`).
+		WillReturnRows(sqlmock.NewRows([]string{"search_path"}).AddRow("public"))
+
+	ds := goqu.New("mock", mDB).Show("search_path")
+
+	var searchPath string
+	found, err := ds.Executor().ScanVal(&searchPath)
+	sds.NoError(err)
+	sds.True(found)
+	sds.Equal("public", searchPath)
+	sds.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (sds *showDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.Show("search_path").SetDialect(md)
+	ds = ds.SetError(err1)
+	sds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	sds.Empty(sql)
+	sds.Empty(args)
+	sds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	sds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	sds.Empty(sql)
+	sds.Empty(args)
+	sds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.Param("role")
+	sds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	sds.Empty(sql)
+	sds.Empty(args)
+	sds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToShowSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	sds.Empty(sql)
+	sds.Empty(args)
+	sds.Equal(err1, err)
+}
+
+func TestShowDataset(t *testing.T) {
+	suite.Run(t, new(showDatasetSuite))
+}
@@ -11,15 +11,37 @@ import (
 
 type (
 	SQLDialectOptions = sqlgen.SQLDialectOptions
+	// DialectCapabilities describes the features supported by a dialect.
+	DialectCapabilities = sqlgen.DialectCapabilities
 	// SQLDialect an adapter interface to be used by a Dataset to generate SQL for a specific dialect.
 	// See DefaultAdapter for a concrete implementation and examples.
 	SQLDialect interface {
 		Dialect() string
+		Capabilities() DialectCapabilities
 		ToSelectSQL(b sb.SQLBuilder, clauses exp.SelectClauses)
 		ToUpdateSQL(b sb.SQLBuilder, clauses exp.UpdateClauses)
 		ToInsertSQL(b sb.SQLBuilder, clauses exp.InsertClauses)
 		ToDeleteSQL(b sb.SQLBuilder, clauses exp.DeleteClauses)
+		ToMergeSQL(b sb.SQLBuilder, clauses exp.MergeClauses)
+		ToCallSQL(b sb.SQLBuilder, clauses exp.CallClauses)
+		ToCopySQL(b sb.SQLBuilder, clauses exp.CopyClauses)
+		ToSetParamSQL(b sb.SQLBuilder, clauses exp.SetParamClauses)
+		ToShowSQL(b sb.SQLBuilder, clauses exp.ShowClauses)
+		ToMaintenanceSQL(b sb.SQLBuilder, clauses exp.MaintenanceClauses)
 		ToTruncateSQL(b sb.SQLBuilder, clauses exp.TruncateClauses)
+		ToCreateTableSQL(b sb.SQLBuilder, clauses exp.CreateTableClauses)
+		ToAlterTableSQL(b sb.SQLBuilder, clauses exp.AlterTableClauses)
+		ToCreateIndexSQL(b sb.SQLBuilder, clauses exp.CreateIndexClauses)
+		ToDropSQL(b sb.SQLBuilder, clauses exp.DropClauses)
+		ToCreateViewSQL(b sb.SQLBuilder, clauses exp.CreateViewClauses)
+		ToRefreshSQL(b sb.SQLBuilder, clauses exp.RefreshClauses)
+		ToCreateSequenceSQL(b sb.SQLBuilder, clauses exp.CreateSequenceClauses)
+		ToAlterSequenceSQL(b sb.SQLBuilder, clauses exp.AlterSequenceClauses)
+		ToCreateSchemaSQL(b sb.SQLBuilder, clauses exp.CreateSchemaClauses)
+		ToCommentSQL(b sb.SQLBuilder, clauses exp.CommentClauses)
+		ToGrantSQL(b sb.SQLBuilder, clauses exp.GrantClauses)
+		ToCreateTriggerSQL(b sb.SQLBuilder, clauses exp.CreateTriggerClauses)
+		ToCreateFunctionSQL(b sb.SQLBuilder, clauses exp.CreateFunctionClauses)
 	}
 	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
 	// either override methods, or more typically update default values.
@@ -31,7 +53,26 @@ type (
 		updateGen      sqlgen.UpdateSQLGenerator
 		insertGen      sqlgen.InsertSQLGenerator
 		deleteGen      sqlgen.DeleteSQLGenerator
+		mergeGen       sqlgen.MergeSQLGenerator
+		callGen        sqlgen.CallSQLGenerator
+		copyGen        sqlgen.CopySQLGenerator
+		setParamGen    sqlgen.SetParamSQLGenerator
+		showGen        sqlgen.ShowSQLGenerator
+		maintenanceGen sqlgen.MaintenanceSQLGenerator
 		truncateGen    sqlgen.TruncateSQLGenerator
+		createTableGen sqlgen.CreateTableSQLGenerator
+		alterTableGen  sqlgen.AlterTableSQLGenerator
+		createIndexGen sqlgen.CreateIndexSQLGenerator
+		dropGen        sqlgen.DropSQLGenerator
+		createViewGen  sqlgen.CreateViewSQLGenerator
+		refreshGen     sqlgen.RefreshSQLGenerator
+		createSeqGen   sqlgen.CreateSequenceSQLGenerator
+		alterSeqGen    sqlgen.AlterSequenceSQLGenerator
+		schemaGen      sqlgen.CreateSchemaSQLGenerator
+		commentGen     sqlgen.CommentSQLGenerator
+		grantGen       sqlgen.GrantSQLGenerator
+		triggerGen     sqlgen.CreateTriggerSQLGenerator
+		functionGen    sqlgen.CreateFunctionSQLGenerator
 	}
 )
 
@@ -58,6 +99,83 @@ func DeregisterDialect(name string) {
 	delete(dialects, strings.ToLower(name))
 }
 
+// RegisterCastType maps a type name passed to Cast to the SQL type of a dialect, names are case insensitive. If the
+// dialect is not registered it is registered with the default options. Datasets that were already created keep using
+// the mappings of the dialect at the time they were created.
+//    RegisterCastType("postgres", "embedding", "VECTOR(1536)")
+//    From("items").Select(C("data").Cast("embedding")) // CAST("data" AS VECTOR(1536))
+func RegisterCastType(dialect, name, sqlType string) {
+	updateDialectOptions(dialect, func(do *SQLDialectOptions) {
+		do.CastTypeLookup = copyLookup(do.CastTypeLookup, strings.ToLower(name), []byte(sqlType))
+	})
+}
+
+// RegisterOperator overrides the symbol of a custom operator (see BinaryOperator and PrefixOperator) for a dialect,
+// an empty symbol marks the operator as not supported by the dialect. If the dialect is not registered it is registered
+// with the default options. Datasets that were already created keep using the symbols of the dialect at the time they
+// were created.
+//    RegisterOperator("cockroachdb", "cosine_distance", "<=>")
+//    RegisterOperator("sqlite3", "cosine_distance", "")
+func RegisterOperator(dialect, name, symbol string) {
+	updateDialectOptions(dialect, func(do *SQLDialectOptions) {
+		do.CustomOperatorLookup = copyLookup(do.CustomOperatorLookup, name, []byte(symbol))
+	})
+}
+
+// RegisterFunction registers the definition of a function (see FuncDef) for a dialect, names are case insensitive. When
+// the SQL is generated calls to the function with Func are validated against the number of arguments of the definition
+// and written with its SQL name. If the dialect is not registered it is registered with the default options. Datasets
+// that were already created keep using the definitions of the dialect at the time they were created.
+//    RegisterFunction("sqlserver", FuncDef("length", 1, 1).WithSQLName("LEN"))
+//    Dialect("sqlserver").From("test").Select(Func("length", C("a"))) // SELECT LEN("a") FROM "test"
+//    Dialect("sqlserver").From("test").Select(Func("length", C("a"), C("b"))) // error: function length expects 1 arguments but got 2
+func RegisterFunction(dialect string, def exp.FunctionDefinition) {
+	updateDialectOptions(dialect, func(do *SQLDialectOptions) {
+		defs := make(map[string]exp.FunctionDefinition, len(do.FunctionDefinitions)+1)
+		for k, v := range do.FunctionDefinitions {
+			defs[k] = v
+		}
+		defs[strings.ToLower(def.Name())] = def
+		do.FunctionDefinitions = defs
+	})
+}
+
+// DeregisterFunction removes the definition of a function registered with RegisterFunction from a dialect.
+func DeregisterFunction(dialect, name string) {
+	updateDialectOptions(dialect, func(do *SQLDialectOptions) {
+		defs := make(map[string]exp.FunctionDefinition, len(do.FunctionDefinitions))
+		for k, v := range do.FunctionDefinitions {
+			defs[k] = v
+		}
+		delete(defs, strings.ToLower(name))
+		do.FunctionDefinitions = defs
+	})
+}
+
+// registers a copy of the options of the dialect (or the default options) after applying the update
+func updateDialectOptions(dialect string, update func(do *SQLDialectOptions)) {
+	dialectsMu.Lock()
+	defer dialectsMu.Unlock()
+	lowerDialect := strings.ToLower(dialect)
+	do := DefaultDialectOptions()
+	if d, ok := dialects[lowerDialect].(*sqlDialect); ok {
+		opts := *d.dialectOptions
+		do = &opts
+	}
+	update(do)
+	dialects[lowerDialect] = newDialect(lowerDialect, do)
+}
+
+// returns a copy of the lookup with the key set to the value
+func copyLookup(lookup map[string][]byte, key string, val []byte) map[string][]byte {
+	cp := make(map[string][]byte, len(lookup)+1)
+	for k, v := range lookup {
+		cp[k] = v
+	}
+	cp[key] = val
+	return cp
+}
+
 func GetDialect(name string) SQLDialect {
 	name = strings.ToLower(name)
 	if d, ok := dialects[name]; ok {
@@ -66,6 +184,16 @@ func GetDialect(name string) SQLDialect {
 	return newDialect("default", DefaultDialectOptions())
 }
 
+// returns the options for the registered dialect or the default options if the dialect is not a *sqlDialect
+func getDialectOptions(name string) *SQLDialectOptions {
+	dialectsMu.RLock()
+	defer dialectsMu.RUnlock()
+	if d, ok := GetDialect(name).(*sqlDialect); ok {
+		return d.dialectOptions
+	}
+	return DefaultDialectOptions()
+}
+
 func newDialect(dialect string, do *SQLDialectOptions) SQLDialect {
 	return &sqlDialect{
 		dialect:        dialect,
@@ -74,7 +202,26 @@ func newDialect(dialect string, do *SQLDialectOptions) SQLDialect {
 		updateGen:      sqlgen.NewUpdateSQLGenerator(dialect, do),
 		insertGen:      sqlgen.NewInsertSQLGenerator(dialect, do),
 		deleteGen:      sqlgen.NewDeleteSQLGenerator(dialect, do),
+		mergeGen:       sqlgen.NewMergeSQLGenerator(dialect, do),
+		callGen:        sqlgen.NewCallSQLGenerator(dialect, do),
+		copyGen:        sqlgen.NewCopySQLGenerator(dialect, do),
+		setParamGen:    sqlgen.NewSetParamSQLGenerator(dialect, do),
+		showGen:        sqlgen.NewShowSQLGenerator(dialect, do),
+		maintenanceGen: sqlgen.NewMaintenanceSQLGenerator(dialect, do),
 		truncateGen:    sqlgen.NewTruncateSQLGenerator(dialect, do),
+		createTableGen: sqlgen.NewCreateTableSQLGenerator(dialect, do),
+		alterTableGen:  sqlgen.NewAlterTableSQLGenerator(dialect, do),
+		createIndexGen: sqlgen.NewCreateIndexSQLGenerator(dialect, do),
+		dropGen:        sqlgen.NewDropSQLGenerator(dialect, do),
+		createViewGen:  sqlgen.NewCreateViewSQLGenerator(dialect, do),
+		refreshGen:     sqlgen.NewRefreshSQLGenerator(dialect, do),
+		createSeqGen:   sqlgen.NewCreateSequenceSQLGenerator(dialect, do),
+		alterSeqGen:    sqlgen.NewAlterSequenceSQLGenerator(dialect, do),
+		schemaGen:      sqlgen.NewCreateSchemaSQLGenerator(dialect, do),
+		commentGen:     sqlgen.NewCommentSQLGenerator(dialect, do),
+		grantGen:       sqlgen.NewGrantSQLGenerator(dialect, do),
+		triggerGen:     sqlgen.NewCreateTriggerSQLGenerator(dialect, do),
+		functionGen:    sqlgen.NewCreateFunctionSQLGenerator(dialect, do),
 	}
 }
 
@@ -82,6 +229,11 @@ func (d *sqlDialect) Dialect() string {
 	return d.dialect
 }
 
+// Capabilities returns the features supported by the dialect (e.g. RETURNING, common table expressions).
+func (d *sqlDialect) Capabilities() DialectCapabilities {
+	return d.dialectOptions.Capabilities()
+}
+
 func (d *sqlDialect) ToSelectSQL(b sb.SQLBuilder, clauses exp.SelectClauses) {
 	d.selectGen.Generate(b, clauses)
 }
@@ -98,6 +250,82 @@ func (d *sqlDialect) ToDeleteSQL(b sb.SQLBuilder, clauses exp.DeleteClauses) {
 	d.deleteGen.Generate(b, clauses)
 }
 
+func (d *sqlDialect) ToMergeSQL(b sb.SQLBuilder, clauses exp.MergeClauses) {
+	d.mergeGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToCallSQL(b sb.SQLBuilder, clauses exp.CallClauses) {
+	d.callGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToCopySQL(b sb.SQLBuilder, clauses exp.CopyClauses) {
+	d.copyGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToSetParamSQL(b sb.SQLBuilder, clauses exp.SetParamClauses) {
+	d.setParamGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToShowSQL(b sb.SQLBuilder, clauses exp.ShowClauses) {
+	d.showGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToMaintenanceSQL(b sb.SQLBuilder, clauses exp.MaintenanceClauses) {
+	d.maintenanceGen.Generate(b, clauses)
+}
+
 func (d *sqlDialect) ToTruncateSQL(b sb.SQLBuilder, clauses exp.TruncateClauses) {
 	d.truncateGen.Generate(b, clauses)
 }
+
+func (d *sqlDialect) ToCreateTableSQL(b sb.SQLBuilder, clauses exp.CreateTableClauses) {
+	d.createTableGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToAlterTableSQL(b sb.SQLBuilder, clauses exp.AlterTableClauses) {
+	d.alterTableGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToCreateIndexSQL(b sb.SQLBuilder, clauses exp.CreateIndexClauses) {
+	d.createIndexGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToDropSQL(b sb.SQLBuilder, clauses exp.DropClauses) {
+	d.dropGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToCreateViewSQL(b sb.SQLBuilder, clauses exp.CreateViewClauses) {
+	d.createViewGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToRefreshSQL(b sb.SQLBuilder, clauses exp.RefreshClauses) {
+	d.refreshGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToCreateSequenceSQL(b sb.SQLBuilder, clauses exp.CreateSequenceClauses) {
+	d.createSeqGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToAlterSequenceSQL(b sb.SQLBuilder, clauses exp.AlterSequenceClauses) {
+	d.alterSeqGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToCreateSchemaSQL(b sb.SQLBuilder, clauses exp.CreateSchemaClauses) {
+	d.schemaGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToCommentSQL(b sb.SQLBuilder, clauses exp.CommentClauses) {
+	d.commentGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToGrantSQL(b sb.SQLBuilder, clauses exp.GrantClauses) {
+	d.grantGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToCreateTriggerSQL(b sb.SQLBuilder, clauses exp.CreateTriggerClauses) {
+	d.triggerGen.Generate(b, clauses)
+}
+
+func (d *sqlDialect) ToCreateFunctionSQL(b sb.SQLBuilder, clauses exp.CreateFunctionClauses) {
+	d.functionGen.Generate(b, clauses)
+}
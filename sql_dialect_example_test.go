@@ -6,6 +6,26 @@ import (
 	"github.com/doug-martin/goqu/v9"
 )
 
+func ExampleDialectWrapper_Capabilities() {
+	opts := goqu.DefaultDialectOptions()
+	opts.SupportsReturn = false
+	goqu.RegisterDialect("no-returning-dialect", opts)
+
+	for _, d := range []string{"default", "no-returning-dialect"} {
+		dialect := goqu.Dialect(d)
+		ds := dialect.Insert("test").Rows(goqu.Record{"a": 1})
+		if dialect.Capabilities().Returning {
+			ds = ds.Returning("id")
+		}
+		sql, _, _ := ds.ToSQL()
+		fmt.Println(sql)
+	}
+
+	// Output:
+	// INSERT INTO "test" ("a") VALUES (1) RETURNING "id"
+	// INSERT INTO "test" ("a") VALUES (1)
+}
+
 func ExampleRegisterDialect() {
 	opts := goqu.DefaultDialectOptions()
 	opts.QuoteRune = '`'
@@ -21,3 +41,39 @@ func ExampleRegisterDialect() {
 	// Output:
 	// SELECT * FROM `test` []
 }
+
+func ExampleRegisterCastType() {
+	opts := goqu.DefaultDialectOptions()
+	goqu.RegisterDialect("vector-dialect", opts)
+	goqu.RegisterCastType("vector-dialect", "embedding", "VECTOR(3)")
+
+	sql, _, _ := goqu.Dialect("vector-dialect").
+		From("items").
+		Select(goqu.C("data").Cast("embedding"), goqu.C("id").Cast("text")).
+		ToSQL()
+	fmt.Println(sql)
+
+	// Output:
+	// SELECT CAST("data" AS VECTOR(3)), CAST("id" AS TEXT) FROM "items"
+}
+
+func ExampleRegisterOperator() {
+	cosineDistance := goqu.BinaryOperator("cosine_distance", "<=>")
+	goqu.RegisterDialect("vector-operator-dialect", goqu.DefaultDialectOptions())
+	goqu.RegisterOperator("vector-operator-dialect", "cosine_distance", "<#>")
+
+	ds := goqu.From("items").
+		Where(cosineDistance.Apply("embedding", "[1,2,3]").Lt(0.5)).
+		Order(cosineDistance.Apply("embedding", "[1,2,3]").Asc()).
+		Limit(5)
+
+	sql, _, _ := ds.ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = ds.WithDialect("vector-operator-dialect").ToSQL()
+	fmt.Println(sql)
+
+	// Output:
+	// SELECT * FROM "items" WHERE (("embedding" <=> '[1,2,3]') < 0.5) ORDER BY ("embedding" <=> '[1,2,3]') ASC LIMIT 5
+	// SELECT * FROM "items" WHERE (("embedding" <#> '[1,2,3]') < 0.5) ORDER BY ("embedding" <#> '[1,2,3]') ASC LIMIT 5
+}
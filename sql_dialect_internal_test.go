@@ -21,6 +21,15 @@ func (dts *dialectTestSuite) TestDialect() {
 	dts.Equal("test", d.Dialect())
 }
 
+func (dts *dialectTestSuite) TestCapabilities() {
+	opts := DefaultDialectOptions()
+	opts.SupportsReturn = false
+	d := newDialect("test", opts)
+
+	dts.Equal(opts.Capabilities(), d.Capabilities())
+	dts.False(d.Capabilities().Returning)
+}
+
 func (dts *dialectTestSuite) TestToSelectSQL() {
 	opts := DefaultDialectOptions()
 	sm := new(mocks.SelectSQLGenerator)
@@ -86,6 +95,65 @@ func (dts *dialectTestSuite) TestToTruncateSQL() {
 	tm.AssertExpectations(dts.T())
 }
 
+func (dts *dialectTestSuite) TestRegisterCastType() {
+	opts := DefaultDialectOptions()
+	opts.CastTypeLookup = map[string][]byte{"text": []byte("CHAR")}
+	RegisterDialect("cast-type-test", opts)
+	defer DeregisterDialect("cast-type-test")
+	before := getDialectOptions("cast-type-test")
+
+	RegisterCastType("Cast-Type-Test", "Embedding", "VECTOR(3)")
+
+	after := getDialectOptions("cast-type-test")
+	dts.Equal(map[string][]byte{"text": []byte("CHAR"), "embedding": []byte("VECTOR(3)")}, after.CastTypeLookup)
+	dts.Equal(map[string][]byte{"text": []byte("CHAR")}, before.CastTypeLookup)
+	dts.Equal(opts.QuoteRune, after.QuoteRune)
+
+	RegisterCastType("cast-type-unregistered", "embedding", "VECTOR(3)")
+	defer DeregisterDialect("cast-type-unregistered")
+	dts.Equal(
+		map[string][]byte{"embedding": []byte("VECTOR(3)")},
+		getDialectOptions("cast-type-unregistered").CastTypeLookup,
+	)
+}
+
+func (dts *dialectTestSuite) TestRegisterOperator() {
+	opts := DefaultDialectOptions()
+	RegisterDialect("operator-test", opts)
+	defer DeregisterDialect("operator-test")
+
+	RegisterOperator("operator-test", "cosine_distance", "<=>")
+	RegisterOperator("Operator-Test", "ltree_ancestor", "")
+
+	dts.Equal(
+		map[string][]byte{"cosine_distance": []byte("<=>"), "ltree_ancestor": []byte("")},
+		getDialectOptions("operator-test").CustomOperatorLookup,
+	)
+	dts.Nil(opts.CustomOperatorLookup)
+}
+
+func (dts *dialectTestSuite) TestRegisterFunction() {
+	opts := DefaultDialectOptions()
+	RegisterDialect("function-test", opts)
+	defer DeregisterDialect("function-test")
+
+	length := exp.NewFunctionDefinition("Length", 1, 1).WithSQLName("LEN")
+	substr := exp.NewFunctionDefinition("substr", 2, 3)
+	RegisterFunction("function-test", length)
+	RegisterFunction("Function-Test", substr)
+	dts.Equal(
+		map[string]exp.FunctionDefinition{"length": length, "substr": substr},
+		getDialectOptions("function-test").FunctionDefinitions,
+	)
+	dts.Nil(opts.FunctionDefinitions)
+
+	DeregisterFunction("function-test", "LENGTH")
+	dts.Equal(
+		map[string]exp.FunctionDefinition{"substr": substr},
+		getDialectOptions("function-test").FunctionDefinitions,
+	)
+}
+
 func TestSQLDialect(t *testing.T) {
 	suite.Run(t, new(dialectTestSuite))
 }
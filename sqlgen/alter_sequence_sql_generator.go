@@ -0,0 +1,76 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	AlterSequenceSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.AlterSequenceClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	alterSequenceSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoSequenceForAlterSequence = errors.New("no sequence found when generating alter sequence sql")
+	errNoOptionsForAlterSequence  = errors.New("at least one option is required when generating alter sequence sql")
+)
+
+func NewAlterSequenceSQLGenerator(dialect string, do *SQLDialectOptions) AlterSequenceSQLGenerator {
+	return &alterSequenceSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (asg *alterSequenceSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.AlterSequenceClauses) {
+	if !clauses.HasSequence() {
+		b.SetError(errNoSequenceForAlterSequence)
+		return
+	}
+	if clauses.Options() == (exp.SequenceOptions{}) && clauses.RestartWith() == nil {
+		b.SetError(errNoOptionsForAlterSequence)
+		return
+	}
+	for _, f := range asg.DialectOptions().AlterSequenceSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case AlterSequenceSQLFragment:
+			asg.AlterSequenceSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("ALTER SEQUENCE", f))
+		}
+	}
+}
+
+// Generates an ALTER SEQUENCE statement
+func (asg *alterSequenceSQLGenerator) AlterSequenceSQL(b sb.SQLBuilder, clauses exp.AlterSequenceClauses) {
+	do := asg.DialectOptions()
+	switch {
+	case do.AlterSequenceFragment == nil:
+		b.SetError(errSequencesNotSupported(asg.Dialect()))
+		return
+	case clauses.IsIfExists() && !do.SupportsAlterSequenceIfExists:
+		b.SetError(errSequenceFeatureNotSupported(asg.Dialect(), "ALTER SEQUENCE", "IF EXISTS"))
+		return
+	}
+	b.Write(do.AlterSequenceFragment)
+	if clauses.IsIfExists() {
+		b.Write(do.IfExistsFragment)
+	}
+	asg.ExpressionSQLGenerator().Generate(b, clauses.Sequence())
+	if restartWith := clauses.RestartWith(); restartWith != nil {
+		b.Write(do.RestartWithFragment)
+		asg.ExpressionSQLGenerator().Generate(b, *restartWith)
+	}
+	sequenceOptionsSQL(b, asg.CommonSQLGenerator, clauses.Options())
+}
@@ -0,0 +1,126 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	alterSequenceTestCase struct {
+		clause exp.AlterSequenceClauses
+		sql    string
+		err    string
+	}
+	alterSequenceSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (asgs *alterSequenceSQLGeneratorSuite) assertCases(
+	asg sqlgen.AlterSequenceSQLGenerator,
+	testCases ...alterSequenceTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		asg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			asgs.assertErrorSQL(b, tc.err)
+		} else {
+			asgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (asgs *alterSequenceSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewAlterSequenceSQLGenerator("test", opts)
+	asgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewAlterSequenceSQLGenerator("test2", opts2)
+	asgs.Equal("test2", d2.Dialect())
+}
+
+func (asgs *alterSequenceSQLGeneratorSuite) TestGenerate() {
+	one, five := int64(1), int64(5)
+	as := exp.NewAlterSequenceClauses().SetSequence(exp.ParseIdentifier("a_seq"))
+	incr := as.SetOptions(exp.SequenceOptions{IncrementBy: &five})
+
+	asgs.assertCases(
+		sqlgen.NewAlterSequenceSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		alterSequenceTestCase{clause: incr, sql: `ALTER SEQUENCE "a_seq" INCREMENT BY 5`},
+		alterSequenceTestCase{clause: incr.SetIfExists(true), sql: `ALTER SEQUENCE IF EXISTS "a_seq" INCREMENT BY 5`},
+		alterSequenceTestCase{clause: as.SetRestartWith(&one), sql: `ALTER SEQUENCE "a_seq" RESTART WITH 1`},
+		alterSequenceTestCase{
+			clause: incr.SetRestartWith(&one).SetOptions(exp.SequenceOptions{NoMaxValue: true, NoCycle: true}),
+			sql:    `ALTER SEQUENCE "a_seq" RESTART WITH 1 NO MAXVALUE NO CYCLE`,
+		},
+
+		alterSequenceTestCase{
+			clause: exp.NewAlterSequenceClauses().SetOptions(exp.SequenceOptions{IncrementBy: &five}),
+			err:    "goqu: no sequence found when generating alter sequence sql",
+		},
+		alterSequenceTestCase{
+			clause: as,
+			err:    "goqu: at least one option is required when generating alter sequence sql",
+		},
+	)
+}
+
+func (asgs *alterSequenceSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	five := int64(5)
+	as := exp.NewAlterSequenceClauses().
+		SetSequence(exp.ParseIdentifier("a_seq")).
+		SetOptions(exp.SequenceOptions{IncrementBy: &five})
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsAlterSequenceIfExists = false
+	asgs.assertCases(
+		sqlgen.NewAlterSequenceSQLGenerator("test", opts),
+		alterSequenceTestCase{clause: as, sql: `ALTER SEQUENCE "a_seq" INCREMENT BY 5`},
+		alterSequenceTestCase{
+			clause: as.SetIfExists(true),
+			err:    "goqu: dialect does not support IF EXISTS in ALTER SEQUENCE [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.AlterSequenceFragment = nil
+	asgs.assertCases(
+		sqlgen.NewAlterSequenceSQLGenerator("test", opts),
+		alterSequenceTestCase{clause: as, err: "goqu: dialect does not support sequences [dialect=test]"},
+	)
+}
+
+func (asgs *alterSequenceSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	five := int64(5)
+	opts := sqlgen.DefaultDialectOptions()
+	opts.AlterSequenceSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	as := exp.NewAlterSequenceClauses().
+		SetSequence(exp.ParseIdentifier("a_seq")).
+		SetOptions(exp.SequenceOptions{IncrementBy: &five})
+	asgs.assertCases(
+		sqlgen.NewAlterSequenceSQLGenerator("test", opts),
+		alterSequenceTestCase{clause: as, err: "goqu: unsupported ALTER SEQUENCE SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (asgs *alterSequenceSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	five := int64(5)
+	d := sqlgen.NewAlterSequenceSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewAlterSequenceClauses().
+		SetSequence(exp.ParseIdentifier("a_seq")).
+		SetOptions(exp.SequenceOptions{IncrementBy: &five}))
+	asgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestAlterSequenceSQLGenerator(t *testing.T) {
+	suite.Run(t, new(alterSequenceSQLGeneratorSuite))
+}
@@ -0,0 +1,224 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	AlterTableSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.AlterTableClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	alterTableSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoTableForAlterTable      = errors.New("no table found when generating alter table sql")
+	errNoActionsForAlterTable    = errors.New("at least one action is required when generating alter table sql")
+	errNoBoundForAttachPartition = errors.New("a partition bound is required when attaching a partition")
+)
+
+func errAlterTableActionNotSupported(dialect string, t exp.AlterTableActionType) error {
+	return errors.New("dialect does not support %s in ALTER TABLE [dialect=%s]", t, dialect)
+}
+
+func errMultipleAlterTableActionsNotSupported(dialect string) error {
+	return errors.New("dialect does not support multiple actions in ALTER TABLE [dialect=%s]", dialect)
+}
+
+func NewAlterTableSQLGenerator(dialect string, do *SQLDialectOptions) AlterTableSQLGenerator {
+	return &alterTableSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (atsg *alterTableSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.AlterTableClauses) {
+	if !clauses.HasTable() {
+		b.SetError(errNoTableForAlterTable)
+		return
+	}
+	if len(clauses.Actions()) == 0 {
+		b.SetError(errNoActionsForAlterTable)
+		return
+	}
+	for _, f := range atsg.DialectOptions().AlterTableSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case AlterTableSQLFragment:
+			atsg.AlterTableSQL(b, clauses.Table(), clauses.Actions())
+		default:
+			b.SetError(ErrNotSupportedFragment("ALTER TABLE", f))
+		}
+	}
+}
+
+// Generates an ALTER TABLE statement
+func (atsg *alterTableSQLGenerator) AlterTableSQL(b sb.SQLBuilder, table exp.Expression, actions []exp.AlterTableAction) {
+	do := atsg.DialectOptions()
+	if !do.SupportsMultipleAlterTableActions && len(actions) > 1 {
+		b.SetError(errMultipleAlterTableActionsNotSupported(atsg.Dialect()))
+		return
+	}
+	b.Write(do.AlterTableFragment)
+	atsg.ExpressionSQLGenerator().Generate(b, table)
+	b.WriteRunes(do.SpaceRune)
+	for i, action := range actions {
+		if i > 0 {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		atsg.actionSQL(b, action)
+	}
+}
+
+// nolint:gocyclo // not complex just long
+func (atsg *alterTableSQLGenerator) actionSQL(b sb.SQLBuilder, action exp.AlterTableAction) {
+	do := atsg.DialectOptions()
+	esg := atsg.ExpressionSQLGenerator()
+	col := exp.NewIdentifierExpression("", "", action.Name())
+	switch action.ActionType() {
+	case exp.AddColumnAction:
+		if atsg.checkSupported(b, action, do.AddColumnFragment) {
+			b.Write(do.AddColumnFragment)
+			esg.Generate(b, action.ColumnDefinition())
+		}
+	case exp.ModifyColumnAction:
+		if atsg.checkSupported(b, action, do.ModifyColumnFragment) {
+			b.Write(do.ModifyColumnFragment)
+			esg.Generate(b, action.ColumnDefinition())
+		}
+	case exp.DropColumnAction:
+		if atsg.checkSupported(b, action, do.DropColumnFragment) {
+			b.Write(do.DropColumnFragment)
+			esg.Generate(b, col)
+		}
+	case exp.RenameColumnAction:
+		if atsg.checkSupported(b, action, do.RenameColumnFragment) {
+			b.Write(do.RenameColumnFragment)
+			esg.Generate(b, col)
+			b.Write(do.RenameColumnToFragment)
+			esg.Generate(b, exp.NewIdentifierExpression("", "", action.NewName()))
+		}
+	case exp.AlterColumnTypeAction:
+		if atsg.checkSupported(b, action, do.AlterColumnTypeFragment) {
+			b.Write(do.AlterColumnTypeFragment)
+			esg.Generate(b, col)
+			b.Write(do.ColumnTypeFragment)
+			esg.Generate(b, action.DataType())
+		}
+	case exp.SetColumnDefaultAction:
+		if atsg.checkSupported(b, action, do.SetDefaultFragment) {
+			b.Write(do.AlterColumnFragment)
+			esg.Generate(b, col)
+			b.Write(do.SetDefaultFragment)
+			if do.WrapColumnDefaults {
+				b.WriteRunes(do.LeftParenRune)
+				esg.Generate(b, action.Value())
+				b.WriteRunes(do.RightParenRune)
+			} else {
+				esg.Generate(b, action.Value())
+			}
+		}
+	case exp.DropColumnDefaultAction:
+		atsg.alterColumnSQL(b, action, col, do.DropDefaultFragment)
+	case exp.SetColumnNotNullAction:
+		atsg.alterColumnSQL(b, action, col, do.SetNotNullFragment)
+	case exp.DropColumnNotNullAction:
+		atsg.alterColumnSQL(b, action, col, do.DropNotNullFragment)
+	case exp.AddConstraintAction:
+		if atsg.checkSupported(b, action, do.AddConstraintFragment) {
+			b.Write(do.AddConstraintFragment)
+			esg.Generate(b, action.Constraint())
+		}
+	case exp.RenameTableAction:
+		if atsg.checkSupported(b, action, do.RenameTableFragment) {
+			b.Write(do.RenameTableFragment)
+			esg.Generate(b, exp.NewIdentifierExpression("", action.NewName(), nil))
+		}
+	case exp.AttachPartitionAction, exp.DetachPartitionAction, exp.AddPartitionAction, exp.DropPartitionAction:
+		atsg.partitionActionSQL(b, action)
+	default:
+		b.SetError(errAlterTableActionNotSupported(atsg.Dialect(), action.ActionType()))
+	}
+}
+
+// Generates an action that attaches, detaches, adds or drops partitions
+//
+//	ATTACH PARTITION "a" FOR VALUES IN (1)
+//	DETACH PARTITION "a"
+//	ADD PARTITION (PARTITION `p1` VALUES LESS THAN (20))
+//	DROP PARTITION `p0`, `p1`
+func (atsg *alterTableSQLGenerator) partitionActionSQL(b sb.SQLBuilder, action exp.AlterTableAction) {
+	do := atsg.DialectOptions()
+	esg := atsg.ExpressionSQLGenerator()
+	partitions := action.Partitions()
+	switch action.ActionType() {
+	case exp.AttachPartitionAction:
+		if atsg.checkSupported(b, action, do.AttachPartitionFragment) {
+			if partitions[0].Bound() == nil {
+				b.SetError(errNoBoundForAttachPartition)
+				return
+			}
+			b.Write(do.AttachPartitionFragment)
+			esg.Generate(b, partitions[0].Name())
+			esg.Generate(b, partitions[0].Bound())
+		}
+	case exp.DetachPartitionAction:
+		if atsg.checkSupported(b, action, do.DetachPartitionFragment) {
+			b.Write(do.DetachPartitionFragment)
+			esg.Generate(b, partitions[0].Name())
+		}
+	case exp.AddPartitionAction:
+		if atsg.checkSupported(b, action, do.AddPartitionFragment) {
+			b.Write(do.AddPartitionFragment).WriteRunes(do.LeftParenRune)
+			for i, partition := range partitions {
+				if i > 0 {
+					b.WriteRunes(do.CommaRune, do.SpaceRune)
+				}
+				esg.Generate(b, partition)
+			}
+			b.WriteRunes(do.RightParenRune)
+		}
+	case exp.DropPartitionAction:
+		if atsg.checkSupported(b, action, do.DropPartitionFragment) {
+			b.Write(do.DropPartitionFragment)
+			for i, partition := range partitions {
+				if i > 0 {
+					b.WriteRunes(do.CommaRune, do.SpaceRune)
+				}
+				esg.Generate(b, partition.Name())
+			}
+		}
+	}
+}
+
+// Generates an ALTER COLUMN action that does not have a value (e.g. ALTER COLUMN "a" DROP DEFAULT)
+func (atsg *alterTableSQLGenerator) alterColumnSQL(
+	b sb.SQLBuilder,
+	action exp.AlterTableAction,
+	col exp.IdentifierExpression,
+	fragment []byte,
+) {
+	if atsg.checkSupported(b, action, fragment) {
+		b.Write(atsg.DialectOptions().AlterColumnFragment)
+		atsg.ExpressionSQLGenerator().Generate(b, col)
+		b.Write(fragment)
+	}
+}
+
+// an action is not supported by the dialect if the fragment used to generate it is nil
+func (atsg *alterTableSQLGenerator) checkSupported(b sb.SQLBuilder, action exp.AlterTableAction, fragment []byte) bool {
+	if fragment == nil {
+		b.SetError(errAlterTableActionNotSupported(atsg.Dialect(), action.ActionType()))
+		return false
+	}
+	return true
+}
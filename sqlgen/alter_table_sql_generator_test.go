@@ -0,0 +1,294 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	alterTableTestCase struct {
+		clause exp.AlterTableClauses
+		sql    string
+		err    string
+	}
+	alterTableSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (atsgs *alterTableSQLGeneratorSuite) assertCases(
+	atsg sqlgen.AlterTableSQLGenerator,
+	testCases ...alterTableTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		atsg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			atsgs.assertErrorSQL(b, tc.err)
+		} else {
+			atsgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (atsgs *alterTableSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewAlterTableSQLGenerator("test", opts)
+	atsgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewAlterTableSQLGenerator("test2", opts2)
+	atsgs.Equal("test2", d2.Dialect())
+}
+
+func (atsgs *alterTableSQLGeneratorSuite) TestGenerate() {
+	at := exp.NewAlterTableClauses().SetTable(exp.ParseIdentifier("a"))
+	cd := exp.NewColumnDefinition("c", exp.NewDataType(exp.IntegerDataType)).NotNull()
+
+	atsgs.assertCases(
+		sqlgen.NewAlterTableSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAddColumnAction(cd)),
+			sql:    `ALTER TABLE "a" ADD COLUMN "c" INTEGER NOT NULL`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDropColumnAction("c")),
+			sql:    `ALTER TABLE "a" DROP COLUMN "c"`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewRenameColumnAction("c", "d")),
+			sql:    `ALTER TABLE "a" RENAME COLUMN "c" TO "d"`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAlterColumnTypeAction("c", exp.NewDataType(exp.VarcharDataType, 10))),
+			sql:    `ALTER TABLE "a" ALTER COLUMN "c" TYPE VARCHAR(10)`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewSetColumnDefaultAction("c", "x")),
+			sql:    `ALTER TABLE "a" ALTER COLUMN "c" SET DEFAULT 'x'`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDropColumnDefaultAction("c")),
+			sql:    `ALTER TABLE "a" ALTER COLUMN "c" DROP DEFAULT`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewSetColumnNotNullAction("c")),
+			sql:    `ALTER TABLE "a" ALTER COLUMN "c" SET NOT NULL`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDropColumnNotNullAction("c")),
+			sql:    `ALTER TABLE "a" ALTER COLUMN "c" DROP NOT NULL`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAddConstraintAction(exp.NewUniqueConstraint("c").Named("u"))),
+			sql:    `ALTER TABLE "a" ADD CONSTRAINT "u" UNIQUE ("c")`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewRenameTableAction("b")),
+			sql:    `ALTER TABLE "a" RENAME TO "b"`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAddColumnAction(cd), exp.NewDropColumnAction("d")),
+			sql:    `ALTER TABLE "a" ADD COLUMN "c" INTEGER NOT NULL, DROP COLUMN "d"`,
+		},
+
+		alterTableTestCase{
+			clause: exp.NewAlterTableClauses().ActionsAppend(exp.NewDropColumnAction("c")),
+			err:    "goqu: no table found when generating alter table sql",
+		},
+		alterTableTestCase{
+			clause: at,
+			err:    "goqu: at least one action is required when generating alter table sql",
+		},
+	)
+}
+
+func (atsgs *alterTableSQLGeneratorSuite) TestGenerate_ModifyColumn() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ModifyColumnFragment = []byte("MODIFY COLUMN ")
+	opts.ColumnCommentFragment = []byte(" COMMENT ")
+
+	at := exp.NewAlterTableClauses().SetTable(exp.ParseIdentifier("a"))
+	cd := exp.NewColumnDefinition("c", exp.NewDataType(exp.IntegerDataType)).NotNull().Comment("the c")
+	atsgs.assertCases(
+		sqlgen.NewAlterTableSQLGenerator("test", opts),
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewModifyColumnAction(cd)),
+			sql:    `ALTER TABLE "a" MODIFY COLUMN "c" INTEGER NOT NULL COMMENT 'the c'`,
+		},
+	)
+}
+
+func (atsgs *alterTableSQLGeneratorSuite) TestGenerate_WithWrapColumnDefaults() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.WrapColumnDefaults = true
+
+	at := exp.NewAlterTableClauses().SetTable(exp.ParseIdentifier("a"))
+	atsgs.assertCases(
+		sqlgen.NewAlterTableSQLGenerator("test", opts),
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewSetColumnDefaultAction("c", "x")),
+			sql:    `ALTER TABLE "a" ALTER COLUMN "c" SET DEFAULT ('x')`,
+		},
+	)
+}
+
+func (atsgs *alterTableSQLGeneratorSuite) TestGenerate_Partitions() {
+	at := exp.NewAlterTableClauses().SetTable(exp.ParseIdentifier("m"))
+	rb := exp.NewRangePartitionBound([]interface{}{1}, []interface{}{10})
+	pd := exp.NewPartitionDefinition(exp.ParseIdentifier("p1"), exp.NewLessThanPartitionBound(20))
+	atsgs.assertCases(
+		sqlgen.NewAlterTableSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAttachPartitionAction(exp.ParseIdentifier("s.m_1"), rb)),
+			sql:    `ALTER TABLE "m" ATTACH PARTITION "s"."m_1" FOR VALUES FROM (1) TO (10)`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDetachPartitionAction(exp.ParseIdentifier("m_1"))),
+			sql:    `ALTER TABLE "m" DETACH PARTITION "m_1"`,
+		},
+
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAttachPartitionAction(exp.ParseIdentifier("m_1"), nil)),
+			err:    "goqu: a partition bound is required when attaching a partition",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAddPartitionAction(pd)),
+			err:    "goqu: dialect does not support ADD PARTITION in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDropPartitionAction(exp.ParseIdentifier("p0"))),
+			err:    "goqu: dialect does not support DROP PARTITION in ALTER TABLE [dialect=test]",
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.PartitionFragment = []byte("PARTITION ")
+	opts.PartitionValuesFragment = []byte(" VALUES")
+	opts.PartitionBoundLookup = map[exp.PartitionBoundType][]byte{exp.LessThanPartitionBound: []byte(" LESS THAN ")}
+	opts.AddPartitionFragment = []byte("ADD PARTITION ")
+	opts.DropPartitionFragment = []byte("DROP PARTITION ")
+	opts.AttachPartitionFragment = nil
+	opts.DetachPartitionFragment = nil
+	atsgs.assertCases(
+		sqlgen.NewAlterTableSQLGenerator("test", opts),
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAddPartitionAction(pd, pd)),
+			sql:    `ALTER TABLE "m" ADD PARTITION (PARTITION "p1" VALUES LESS THAN (20), PARTITION "p1" VALUES LESS THAN (20))`,
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDropPartitionAction(exp.ParseIdentifier("p0"), exp.ParseIdentifier("p1"))),
+			sql:    `ALTER TABLE "m" DROP PARTITION "p0", "p1"`,
+		},
+
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAttachPartitionAction(exp.ParseIdentifier("m_1"), rb)),
+			err:    "goqu: dialect does not support ATTACH PARTITION in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDetachPartitionAction(exp.ParseIdentifier("m_1"))),
+			err:    "goqu: dialect does not support DETACH PARTITION in ALTER TABLE [dialect=test]",
+		},
+	)
+}
+
+func (atsgs *alterTableSQLGeneratorSuite) TestGenerate_WithUnsupportedActions() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsMultipleAlterTableActions = false
+	opts.AddColumnFragment = nil
+	opts.DropColumnFragment = nil
+	opts.RenameColumnFragment = nil
+	opts.AlterColumnTypeFragment = nil
+	opts.SetDefaultFragment = nil
+	opts.DropDefaultFragment = nil
+	opts.SetNotNullFragment = nil
+	opts.DropNotNullFragment = nil
+	opts.AddConstraintFragment = nil
+	opts.RenameTableFragment = nil
+	opts.ModifyColumnFragment = nil
+
+	at := exp.NewAlterTableClauses().SetTable(exp.ParseIdentifier("a"))
+	cd := exp.NewColumnDefinition("c", exp.NewDataType(exp.IntegerDataType))
+	atsgs.assertCases(
+		sqlgen.NewAlterTableSQLGenerator("test", opts),
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAddColumnAction(cd)),
+			err:    "goqu: dialect does not support ADD COLUMN in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDropColumnAction("c")),
+			err:    "goqu: dialect does not support DROP COLUMN in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewRenameColumnAction("c", "d")),
+			err:    "goqu: dialect does not support RENAME COLUMN in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAlterColumnTypeAction("c", exp.NewDataType(exp.BigIntDataType))),
+			err:    "goqu: dialect does not support ALTER COLUMN TYPE in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewModifyColumnAction(cd)),
+			err:    "goqu: dialect does not support MODIFY COLUMN in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewSetColumnDefaultAction("c", 1)),
+			err:    "goqu: dialect does not support SET DEFAULT in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDropColumnDefaultAction("c")),
+			err:    "goqu: dialect does not support DROP DEFAULT in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewSetColumnNotNullAction("c")),
+			err:    "goqu: dialect does not support SET NOT NULL in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDropColumnNotNullAction("c")),
+			err:    "goqu: dialect does not support DROP NOT NULL in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewAddConstraintAction(exp.NewUniqueConstraint("c"))),
+			err:    "goqu: dialect does not support ADD CONSTRAINT in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewRenameTableAction("b")),
+			err:    "goqu: dialect does not support RENAME TO in ALTER TABLE [dialect=test]",
+		},
+		alterTableTestCase{
+			clause: at.ActionsAppend(exp.NewDropColumnAction("c"), exp.NewDropColumnAction("d")),
+			err:    "goqu: dialect does not support multiple actions in ALTER TABLE [dialect=test]",
+		},
+	)
+}
+
+func (atsgs *alterTableSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.AlterTableSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	at := exp.NewAlterTableClauses().
+		SetTable(exp.ParseIdentifier("a")).
+		ActionsAppend(exp.NewDropColumnAction("c"))
+	atsgs.assertCases(
+		sqlgen.NewAlterTableSQLGenerator("test", opts),
+		alterTableTestCase{clause: at, err: "goqu: unsupported ALTER TABLE SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (atsgs *alterTableSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewAlterTableSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewAlterTableClauses().
+		SetTable(exp.ParseIdentifier("a")).
+		ActionsAppend(exp.NewDropColumnAction("c")))
+	atsgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestAlterTableSQLGenerator(t *testing.T) {
+	suite.Run(t, new(alterTableSQLGeneratorSuite))
+}
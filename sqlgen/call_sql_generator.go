@@ -0,0 +1,82 @@
+package sqlgen
+
+import (
+	"database/sql"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	CallSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.CallClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	callSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var errNoProcedureForCall = errors.New("no procedure found when generating call sql")
+
+func errCallNotSupported(dialect string) error {
+	return errors.New("dialect does not support stored procedures [dialect=%s]", dialect)
+}
+
+func NewCallSQLGenerator(dialect string, do *SQLDialectOptions) CallSQLGenerator {
+	return &callSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (csg *callSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.CallClauses) {
+	if !clauses.HasProcedure() {
+		b.SetError(errNoProcedureForCall)
+		return
+	}
+	for _, f := range csg.DialectOptions().CallSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CallSQLFragment:
+			csg.CallSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("CALL", f))
+		}
+	}
+}
+
+// Generates a stored procedure call (e.g. CALL "proc"(1, 2) or EXEC "proc" 1, 2)
+func (csg *callSQLGenerator) CallSQL(b sb.SQLBuilder, clauses exp.CallClauses) {
+	do := csg.DialectOptions()
+	if do.CallFragment == nil {
+		b.SetError(errCallNotSupported(csg.Dialect()))
+		return
+	}
+	b.Write(do.CallFragment)
+	csg.ExpressionSQLGenerator().Generate(b, clauses.Procedure())
+	args := clauses.Args()
+	if do.WrapCallArgsInParens {
+		b.WriteRunes(do.LeftParenRune)
+	} else if len(args) > 0 {
+		b.WriteRunes(do.SpaceRune)
+	}
+	for i, arg := range args {
+		if i > 0 {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		csg.ExpressionSQLGenerator().Generate(b, arg)
+		if _, ok := arg.(sql.Out); ok {
+			b.Write(do.CallOutputFragment)
+		}
+	}
+	if do.WrapCallArgsInParens {
+		b.WriteRunes(do.RightParenRune)
+	}
+	b.Write(do.CallEndFragment)
+}
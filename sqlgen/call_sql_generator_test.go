@@ -0,0 +1,133 @@
+package sqlgen_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	callTestCase struct {
+		clause     exp.CallClauses
+		sql        string
+		isPrepared bool
+		args       []interface{}
+		err        string
+	}
+	callSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (csgs *callSQLGeneratorSuite) assertCases(csg sqlgen.CallSQLGenerator, testCases ...callTestCase) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(tc.isPrepared)
+		csg.Generate(b, tc.clause)
+		switch {
+		case len(tc.err) > 0:
+			csgs.assertErrorSQL(b, tc.err)
+		case tc.isPrepared || tc.args != nil:
+			csgs.assertPreparedSQL(b, tc.sql, tc.args)
+		default:
+			csgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (csgs *callSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCallSQLGenerator("test", opts)
+	csgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewCallSQLGenerator("test2", opts2)
+	csgs.Equal("test2", d2.Dialect())
+}
+
+func (csgs *callSQLGeneratorSuite) TestGenerate() {
+	var out int64
+	cc := exp.NewCallClauses().SetProcedure(exp.ParseIdentifier("s.a"))
+	args := []interface{}{1, "b", sql.Out{Dest: &out}}
+
+	csgs.assertCases(
+		sqlgen.NewCallSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		callTestCase{clause: cc, sql: `CALL "s"."a"()`},
+		callTestCase{clause: cc.SetArgs([]interface{}{1, "b"}), sql: `CALL "s"."a"(1, 'b')`},
+		callTestCase{
+			clause: cc.SetArgs(args),
+			sql:    `CALL "s"."a"(1, 'b', ?)`,
+			args:   []interface{}{sql.Out{Dest: &out}},
+		},
+		callTestCase{
+			clause:     cc.SetArgs(args),
+			sql:        `CALL "s"."a"(?, ?, ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "b", sql.Out{Dest: &out}},
+		},
+
+		callTestCase{clause: exp.NewCallClauses(), err: "goqu: no procedure found when generating call sql"},
+	)
+}
+
+func (csgs *callSQLGeneratorSuite) TestGenerate_WithoutArgParens() {
+	var out int64
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CallFragment = []byte("EXEC ")
+	opts.WrapCallArgsInParens = false
+	opts.CallOutputFragment = []byte(" OUTPUT")
+	opts.CallEndFragment = []byte(";")
+	cc := exp.NewCallClauses().SetProcedure(exp.ParseIdentifier("a"))
+
+	csgs.assertCases(
+		sqlgen.NewCallSQLGenerator("test", opts),
+		callTestCase{clause: cc, sql: `EXEC "a";`},
+		callTestCase{clause: cc.SetArgs([]interface{}{1, "b"}), sql: `EXEC "a" 1, 'b';`},
+		callTestCase{
+			clause:     cc.SetArgs([]interface{}{1, sql.Out{Dest: &out}}),
+			sql:        `EXEC "a" ?, ? OUTPUT;`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), sql.Out{Dest: &out}},
+		},
+	)
+}
+
+func (csgs *callSQLGeneratorSuite) TestGenerate_WithUnsupportedCall() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CallFragment = nil
+	csgs.assertCases(
+		sqlgen.NewCallSQLGenerator("test", opts),
+		callTestCase{
+			clause: exp.NewCallClauses().SetProcedure(exp.ParseIdentifier("a")),
+			err:    "goqu: dialect does not support stored procedures [dialect=test]",
+		},
+	)
+}
+
+func (csgs *callSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CallSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	csgs.assertCases(
+		sqlgen.NewCallSQLGenerator("test", opts),
+		callTestCase{
+			clause: exp.NewCallClauses().SetProcedure(exp.ParseIdentifier("a")),
+			err:    "goqu: unsupported CALL SQL fragment UpdateBeginSQLFragment",
+		},
+	)
+}
+
+func (csgs *callSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewCallSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewCallClauses().SetProcedure(exp.ParseIdentifier("a")))
+	csgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestCallSQLGenerator(t *testing.T) {
+	suite.Run(t, new(callSQLGeneratorSuite))
+}
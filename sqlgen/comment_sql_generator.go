@@ -0,0 +1,73 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	CommentSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.CommentClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	commentSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var errNoObjectForComment = errors.New("no object found when generating comment sql")
+
+func errCommentNotSupported(dialect string, t exp.CommentObjectType) error {
+	return errors.New("dialect does not support COMMENT ON %s [dialect=%s]", t, dialect)
+}
+
+func NewCommentSQLGenerator(dialect string, do *SQLDialectOptions) CommentSQLGenerator {
+	return &commentSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (csg *commentSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.CommentClauses) {
+	if !clauses.HasObject() {
+		b.SetError(errNoObjectForComment)
+		return
+	}
+	for _, f := range csg.DialectOptions().CommentSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CommentSQLFragment:
+			csg.CommentSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("COMMENT", f))
+		}
+	}
+}
+
+// Generates a COMMENT ON statement, or an ALTER TABLE statement if the dialect sets the comment of a table using
+// the TableCommentFragment (e.g. mysql ALTER TABLE `a` COMMENT = 'b')
+func (csg *commentSQLGenerator) CommentSQL(b sb.SQLBuilder, clauses exp.CommentClauses) {
+	do := csg.DialectOptions()
+	esg := csg.ExpressionSQLGenerator()
+	t := clauses.ObjectType()
+	if t == exp.TableCommentObject && do.TableCommentFragment != nil {
+		b.Write(do.AlterTableFragment)
+		esg.Generate(b, clauses.Object())
+		b.Write(do.TableCommentFragment)
+		esg.Generate(b, clauses.Comment())
+		return
+	}
+	if do.CommentOnFragment == nil {
+		b.SetError(errCommentNotSupported(csg.Dialect(), t))
+		return
+	}
+	b.Write(do.CommentOnFragment).WriteStrings(t.String()).WriteRunes(do.SpaceRune)
+	esg.Generate(b, clauses.Object())
+	b.Write(do.CommentIsFragment)
+	esg.Generate(b, clauses.Comment())
+}
@@ -0,0 +1,132 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	commentTestCase struct {
+		clause exp.CommentClauses
+		sql    string
+		err    string
+	}
+	commentSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (csgs *commentSQLGeneratorSuite) assertCases(
+	csg sqlgen.CommentSQLGenerator,
+	testCases ...commentTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		csg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			csgs.assertErrorSQL(b, tc.err)
+		} else {
+			csgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (csgs *commentSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCommentSQLGenerator("test", opts)
+	csgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewCommentSQLGenerator("test2", opts2)
+	csgs.Equal("test2", d2.Dialect())
+}
+
+func (csgs *commentSQLGeneratorSuite) TestGenerate() {
+	cc := exp.NewCommentClauses().SetObject(exp.ParseIdentifier("s.user")).SetComment("the users")
+
+	csgs.assertCases(
+		sqlgen.NewCommentSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		commentTestCase{clause: cc, sql: `COMMENT ON TABLE "s"."user" IS 'the users'`},
+		commentTestCase{
+			clause: cc.SetObjectType(exp.ColumnCommentObject).SetObject(exp.ParseIdentifier("user.name")),
+			sql:    `COMMENT ON COLUMN "user"."name" IS 'the users'`,
+		},
+		commentTestCase{
+			clause: cc.SetObjectType(exp.ViewCommentObject).SetComment("it's a view"),
+			sql:    `COMMENT ON VIEW "s"."user" IS 'it''s a view'`,
+		},
+		commentTestCase{clause: cc.SetComment(""), sql: `COMMENT ON TABLE "s"."user" IS ''`},
+
+		commentTestCase{
+			clause: exp.NewCommentClauses(),
+			err:    "goqu: no object found when generating comment sql",
+		},
+	)
+}
+
+func (csgs *commentSQLGeneratorSuite) TestGenerate_WithTableCommentFragment() {
+	cc := exp.NewCommentClauses().SetObject(exp.ParseIdentifier("user")).SetComment("the users")
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.TableCommentFragment = []byte(" COMMENT = ")
+	csgs.assertCases(
+		sqlgen.NewCommentSQLGenerator("test", opts),
+		commentTestCase{clause: cc, sql: `ALTER TABLE "user" COMMENT = 'the users'`},
+		commentTestCase{
+			clause: cc.SetObjectType(exp.ColumnCommentObject).SetObject(exp.ParseIdentifier("user.name")),
+			sql:    `COMMENT ON COLUMN "user"."name" IS 'the users'`,
+		},
+	)
+
+	opts.CommentOnFragment = nil
+	csgs.assertCases(
+		sqlgen.NewCommentSQLGenerator("test", opts),
+		commentTestCase{clause: cc, sql: `ALTER TABLE "user" COMMENT = 'the users'`},
+		commentTestCase{
+			clause: cc.SetObjectType(exp.ColumnCommentObject),
+			err:    "goqu: dialect does not support COMMENT ON COLUMN [dialect=test]",
+		},
+	)
+}
+
+func (csgs *commentSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	cc := exp.NewCommentClauses().SetObject(exp.ParseIdentifier("user")).SetComment("the users")
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CommentOnFragment = nil
+	csgs.assertCases(
+		sqlgen.NewCommentSQLGenerator("test", opts),
+		commentTestCase{clause: cc, err: "goqu: dialect does not support COMMENT ON TABLE [dialect=test]"},
+		commentTestCase{
+			clause: cc.SetObjectType(exp.ViewCommentObject),
+			err:    "goqu: dialect does not support COMMENT ON VIEW [dialect=test]",
+		},
+	)
+}
+
+func (csgs *commentSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CommentSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	cc := exp.NewCommentClauses().SetObject(exp.ParseIdentifier("user"))
+	csgs.assertCases(
+		sqlgen.NewCommentSQLGenerator("test", opts),
+		commentTestCase{clause: cc, err: "goqu: unsupported COMMENT SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (csgs *commentSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewCommentSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewCommentClauses().SetObject(exp.ParseIdentifier("user")))
+	csgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestCommentSQLGenerator(t *testing.T) {
+	suite.Run(t, new(commentSQLGeneratorSuite))
+}
@@ -1,6 +1,9 @@
 package sqlgen
 
 import (
+	"bytes"
+	"strings"
+
 	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/doug-martin/goqu/v9/internal/errors"
 	"github.com/doug-martin/goqu/v9/internal/sb"
@@ -24,11 +27,43 @@ func ErrNotSupportedFragment(sqlType string, f SQLFragmentType) error {
 	return errors.New("unsupported %s SQL fragment %s", sqlType, f)
 }
 
+func errOptimizerHintsNotSupported(dialect string) error {
+	return errors.New("dialect does not support optimizer hints [dialect=%s]", dialect)
+}
+
+func errInvalidOptimizerHint(dialect, hint string) error {
+	return errors.New("optimizer hint %q must not contain */ [dialect=%s]", hint, dialect)
+}
+
+// returns an error for the first hint that would end the optimizer hint comment early
+func validateOptimizerHints(dialect string, hints []string) error {
+	for _, hint := range hints {
+		if strings.Contains(hint, "*/") {
+			return errInvalidOptimizerHint(dialect, hint)
+		}
+	}
+	return nil
+}
+
+func errFetchWithTiesNotSupported(dialect string) error {
+	return errors.New("dialect does not support FETCH FIRST WITH TIES [dialect=%s]", dialect)
+}
+
+func errFetchPercentNotSupported(dialect string) error {
+	return errors.New("dialect does not support FETCH FIRST PERCENT [dialect=%s]", dialect)
+}
+
+func errFetchWithOffsetNotSupported(dialect string) error {
+	return errors.New("dialect does not support FETCH FIRST WITH TIES or PERCENT with an OFFSET [dialect=%s]", dialect)
+}
+
 type (
 	CommonSQLGenerator interface {
 		Dialect() string
 		DialectOptions() *SQLDialectOptions
 		ExpressionSQLGenerator() ExpressionSQLGenerator
+		SourcesExpressionSQLGenerator() ExpressionSQLGenerator
+		HintsSQL(b sb.SQLBuilder, hints []string)
 		ReturningSQL(b sb.SQLBuilder, returns exp.ColumnListExpression)
 		FromSQL(b sb.SQLBuilder, from exp.ColumnListExpression)
 		SourcesSQL(b sb.SQLBuilder, from exp.ColumnListExpression)
@@ -36,17 +71,28 @@ type (
 		OrderSQL(b sb.SQLBuilder, order exp.ColumnListExpression)
 		OrderWithOffsetFetchSQL(b sb.SQLBuilder, order exp.ColumnListExpression, offset uint, limit interface{})
 		LimitSQL(b sb.SQLBuilder, limit interface{})
+		FetchSQL(b sb.SQLBuilder, limit interface{})
+		TopSQL(b sb.SQLBuilder, limit interface{})
 		UpdateExpressionSQL(b sb.SQLBuilder, updates ...exp.UpdateExpression)
 	}
 	commonSQLGenerator struct {
 		dialect        string
 		esg            ExpressionSQLGenerator
+		sourcesEsg     ExpressionSQLGenerator
 		dialectOptions *SQLDialectOptions
 	}
 )
 
 func NewCommonSQLGenerator(dialect string, do *SQLDialectOptions) CommonSQLGenerator {
-	return &commonSQLGenerator{dialect: dialect, esg: NewExpressionSQLGenerator(dialect, do), dialectOptions: do}
+	esg := NewExpressionSQLGenerator(dialect, do)
+	sourcesEsg := esg
+	if do.TableAliasFragment != nil && !bytes.Equal(do.TableAliasFragment, do.AsFragment) {
+		// tables are aliased with a different fragment than columns (e.g. oracle does not allow AS for tables)
+		sourceOpts := *do
+		sourceOpts.AsFragment = do.TableAliasFragment
+		sourcesEsg = NewExpressionSQLGenerator(dialect, &sourceOpts)
+	}
+	return &commonSQLGenerator{dialect: dialect, esg: esg, sourcesEsg: sourcesEsg, dialectOptions: do}
 }
 
 func (csg *commonSQLGenerator) Dialect() string {
@@ -61,6 +107,30 @@ func (csg *commonSQLGenerator) ExpressionSQLGenerator() ExpressionSQLGenerator {
 	return csg.esg
 }
 
+// SourcesExpressionSQLGenerator returns the ExpressionSQLGenerator used for the tables of FROM and JOIN clauses
+func (csg *commonSQLGenerator) SourcesExpressionSQLGenerator() ExpressionSQLGenerator {
+	return csg.sourcesEsg
+}
+
+// Adds optimizer hints after a statement keyword (e.g. UPDATE /*+ ... */)
+func (csg *commonSQLGenerator) HintsSQL(b sb.SQLBuilder, hints []string) {
+	if len(hints) == 0 {
+		return
+	}
+	if !csg.dialectOptions.SupportsOptimizerHints {
+		b.SetError(errOptimizerHintsNotSupported(csg.dialect))
+		return
+	}
+	if err := validateOptimizerHints(csg.dialect, hints); err != nil {
+		b.SetError(err)
+		return
+	}
+	b.WriteRunes(csg.dialectOptions.SpaceRune).
+		Write(csg.dialectOptions.HintBeginFragment).
+		WriteStrings(strings.Join(hints, " ")).
+		Write(bytes.TrimRight(csg.dialectOptions.HintEndFragment, " "))
+}
+
 func (csg *commonSQLGenerator) ReturningSQL(b sb.SQLBuilder, returns exp.ColumnListExpression) {
 	if returns != nil && len(returns.Columns()) > 0 {
 		if csg.dialectOptions.SupportsReturn {
@@ -83,7 +153,7 @@ func (csg *commonSQLGenerator) FromSQL(b sb.SQLBuilder, from exp.ColumnListExpre
 // Adds the generates the SQL for a column list
 func (csg *commonSQLGenerator) SourcesSQL(b sb.SQLBuilder, from exp.ColumnListExpression) {
 	b.WriteRunes(csg.dialectOptions.SpaceRune)
-	csg.esg.Generate(b, from)
+	csg.sourcesEsg.Generate(b, from)
 }
 
 // Generates the WHERE clause for an SQL statement
@@ -119,15 +189,85 @@ func (csg *commonSQLGenerator) OrderWithOffsetFetchSQL(
 		b.Write([]byte(" ROWS"))
 
 		if limit != nil {
+			if fe, ok := limit.(exp.FetchExpression); ok && (fe.IsWithTies() || fe.IsPercent()) {
+				b.SetError(errFetchWithOffsetNotSupported(csg.dialect))
+				return
+			}
 			b.Write(csg.dialectOptions.FetchFragment)
-			csg.esg.Generate(b, limit)
-			b.Write([]byte(" ROWS ONLY"))
+			csg.FetchSQL(b, limit)
 		}
 	}
 }
 
-// Generates the LIMIT clause for an SQL statement
+// Generates the count of a FETCH FIRST clause followed by ROWS ONLY or ROWS WITH TIES. The limit may be a value or an
+// exp.FetchExpression.
+func (csg *commonSQLGenerator) FetchSQL(b sb.SQLBuilder, limit interface{}) {
+	fe, ok := limit.(exp.FetchExpression)
+	if !ok {
+		csg.esg.Generate(b, limit)
+		b.Write([]byte(" ROWS ONLY"))
+		return
+	}
+	if !csg.checkFetch(b, fe) {
+		return
+	}
+	csg.esg.Generate(b, fe.Count())
+	if fe.IsPercent() {
+		b.Write([]byte(" PERCENT"))
+	}
+	if fe.IsWithTies() {
+		b.Write([]byte(" ROWS WITH TIES"))
+	} else {
+		b.Write([]byte(" ROWS ONLY"))
+	}
+}
+
+// Generates a SELECT TOP limit followed by PERCENT and WITH TIES (e.g. sqlserver SELECT TOP (10) PERCENT WITH TIES)
+func (csg *commonSQLGenerator) TopSQL(b sb.SQLBuilder, limit interface{}) {
+	fe, ok := limit.(exp.FetchExpression)
+	if !ok {
+		csg.LimitSQL(b, limit)
+		return
+	}
+	if !csg.checkFetch(b, fe) {
+		return
+	}
+	csg.LimitSQL(b, fe.Count())
+	if fe.IsPercent() {
+		b.Write([]byte(" PERCENT"))
+	}
+	if fe.IsWithTies() {
+		b.Write([]byte(" WITH TIES"))
+	}
+}
+
+// Returns false and sets an error on the builder if the dialect does not support the options of the FETCH
+func (csg *commonSQLGenerator) checkFetch(b sb.SQLBuilder, fe exp.FetchExpression) bool {
+	if fe.IsWithTies() && !csg.dialectOptions.SupportsFetchWithTies {
+		b.SetError(errFetchWithTiesNotSupported(csg.dialect))
+		return false
+	}
+	if fe.IsPercent() && !csg.dialectOptions.SupportsFetchPercent {
+		b.SetError(errFetchPercentNotSupported(csg.dialect))
+		return false
+	}
+	return true
+}
+
+// Generates the LIMIT clause for an SQL statement. If the limit is an exp.FetchExpression with WITH TIES or PERCENT
+// a FETCH FIRST clause is generated instead (e.g. postgres FETCH FIRST 10 ROWS WITH TIES).
 func (csg *commonSQLGenerator) LimitSQL(b sb.SQLBuilder, limit interface{}) {
+	if fe, ok := limit.(exp.FetchExpression); ok {
+		if !fe.IsWithTies() && !fe.IsPercent() {
+			csg.LimitSQL(b, fe.Count())
+			return
+		}
+		if csg.checkFetch(b, fe) {
+			b.Write([]byte(" FETCH FIRST "))
+			csg.FetchSQL(b, fe)
+		}
+		return
+	}
 	if limit != nil {
 		b.Write(csg.dialectOptions.LimitFragment)
 		if csg.dialectOptions.SurroundLimitWithParentheses {
@@ -0,0 +1,167 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	CopySQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.CopyClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	copySQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoTableForCopy      = errors.New("no table found when generating copy sql")
+	errCopyQueryFrom       = errors.New("a query can only be copied TO STDOUT")
+	errCopyQueryWithColumn = errors.New("columns cannot be used when copying a query")
+	errCopySourceTo        = errors.New("a source can only be used when copying into a table")
+)
+
+func errCopyNotSupported(dialect string) error {
+	return errors.New("dialect does not support COPY [dialect=%s]", dialect)
+}
+
+func errCopyFeatureNotSupported(dialect, feature string) error {
+	return errors.New("dialect does not support %s in COPY [dialect=%s]", feature, dialect)
+}
+
+func NewCopySQLGenerator(dialect string, do *SQLDialectOptions) CopySQLGenerator {
+	return &copySQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (csg *copySQLGenerator) Generate(b sb.SQLBuilder, clauses exp.CopyClauses) {
+	if !clauses.HasTable() {
+		b.SetError(errNoTableForCopy)
+		return
+	}
+	for _, f := range csg.DialectOptions().CopySQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CopySQLFragment:
+			csg.CopySQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("COPY", f))
+		}
+	}
+}
+
+// Generates a COPY statement (e.g. COPY "a" ("b", "c") FROM STDIN WITH (FORMAT csv))
+func (csg *copySQLGenerator) CopySQL(b sb.SQLBuilder, clauses exp.CopyClauses) {
+	do := csg.DialectOptions()
+	if do.CopyFragment == nil {
+		b.SetError(errCopyNotSupported(csg.Dialect()))
+		return
+	}
+	_, isQuery := clauses.Table().(exp.AppendableExpression)
+	hasColumns := clauses.Columns() != nil && !clauses.Columns().IsEmpty()
+	switch {
+	case isQuery && clauses.IsFrom():
+		b.SetError(errCopyQueryFrom)
+		return
+	case isQuery && hasColumns:
+		b.SetError(errCopyQueryWithColumn)
+		return
+	case clauses.Source() != nil && !clauses.IsFrom():
+		b.SetError(errCopySourceTo)
+		return
+	}
+	b.Write(do.CopyFragment)
+	csg.ExpressionSQLGenerator().Generate(b, clauses.Table())
+	if hasColumns {
+		b.WriteRunes(do.SpaceRune, do.LeftParenRune)
+		csg.ExpressionSQLGenerator().Generate(b, clauses.Columns())
+		b.WriteRunes(do.RightParenRune)
+	}
+	csg.directionSQL(b, clauses)
+	csg.optionsSQL(b, clauses.Options())
+	for _, param := range clauses.Parameters() {
+		b.WriteRunes(do.SpaceRune)
+		csg.ExpressionSQLGenerator().Generate(b, param)
+	}
+}
+
+// Generates where the data is copied from or to (e.g. FROM STDIN, FROM 's3://bucket/a' or TO STDOUT)
+func (csg *copySQLGenerator) directionSQL(b sb.SQLBuilder, clauses exp.CopyClauses) {
+	do := csg.DialectOptions()
+	switch {
+	case clauses.Source() != nil:
+		b.Write(do.CopyFromSourceFragment)
+		csg.ExpressionSQLGenerator().Generate(b, clauses.Source())
+	case clauses.IsFrom():
+		if do.CopyFromStdinFragment == nil {
+			b.SetError(errCopyFeatureNotSupported(csg.Dialect(), "FROM STDIN"))
+			return
+		}
+		b.Write(do.CopyFromStdinFragment)
+	default:
+		if do.CopyToStdoutFragment == nil {
+			b.SetError(errCopyFeatureNotSupported(csg.Dialect(), "TO STDOUT"))
+			return
+		}
+		b.Write(do.CopyToStdoutFragment)
+	}
+}
+
+// Generates the options of a COPY statement (e.g. WITH (FORMAT csv, HEADER))
+func (csg *copySQLGenerator) optionsSQL(b sb.SQLBuilder, opts exp.CopyOptions) {
+	do := csg.DialectOptions()
+	// options without a value (e.g. HEADER) have a nil value
+	type copyOption struct {
+		name  string
+		value interface{}
+	}
+	options := make([]copyOption, 0, 7)
+	if opts.Format != exp.CopyFormatDefault {
+		options = append(options, copyOption{name: "FORMAT", value: exp.NewLiteralExpression(opts.Format.String())})
+	}
+	if opts.Delimiter != "" {
+		options = append(options, copyOption{name: "DELIMITER", value: opts.Delimiter})
+	}
+	if opts.Null != "" {
+		options = append(options, copyOption{name: "NULL", value: opts.Null})
+	}
+	if opts.Header {
+		options = append(options, copyOption{name: "HEADER"})
+	}
+	if opts.Quote != "" {
+		options = append(options, copyOption{name: "QUOTE", value: opts.Quote})
+	}
+	if opts.Escape != "" {
+		options = append(options, copyOption{name: "ESCAPE", value: opts.Escape})
+	}
+	if opts.Encoding != "" {
+		options = append(options, copyOption{name: "ENCODING", value: opts.Encoding})
+	}
+	if len(options) == 0 {
+		return
+	}
+	if do.CopyWithFragment == nil {
+		b.SetError(errCopyFeatureNotSupported(csg.Dialect(), "WITH options"))
+		return
+	}
+	b.Write(do.CopyWithFragment).WriteRunes(do.LeftParenRune)
+	for i, o := range options {
+		if i > 0 {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		b.WriteStrings(o.name)
+		if o.value != nil {
+			b.WriteRunes(do.SpaceRune)
+			csg.ExpressionSQLGenerator().Generate(b, o.value)
+		}
+	}
+	b.WriteRunes(do.RightParenRune)
+}
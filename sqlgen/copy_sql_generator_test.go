@@ -0,0 +1,180 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	copyTestCase struct {
+		clause exp.CopyClauses
+		sql    string
+		err    string
+	}
+	copySQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (csgs *copySQLGeneratorSuite) assertCases(csg sqlgen.CopySQLGenerator, testCases ...copyTestCase) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		csg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			csgs.assertErrorSQL(b, tc.err)
+		} else {
+			csgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (csgs *copySQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCopySQLGenerator("test", opts)
+	csgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewCopySQLGenerator("test2", opts2)
+	csgs.Equal("test2", d2.Dialect())
+}
+
+func (csgs *copySQLGeneratorSuite) TestGenerate() {
+	cc := exp.NewCopyClauses().SetTable(exp.ParseIdentifier("s.a"))
+	cols := exp.NewColumnListExpression("b", "c")
+
+	csgs.assertCases(
+		sqlgen.NewCopySQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		copyTestCase{clause: cc, sql: `COPY "s"."a" TO STDOUT`},
+		copyTestCase{clause: cc.SetFrom(true), sql: `COPY "s"."a" FROM STDIN`},
+		copyTestCase{clause: cc.SetFrom(true).SetColumns(cols), sql: `COPY "s"."a" ("b", "c") FROM STDIN`},
+		copyTestCase{
+			clause: cc.SetFrom(true).SetColumns(cols).SetOptions(exp.CopyOptions{Format: exp.CopyFormatCSV, Header: true}),
+			sql:    `COPY "s"."a" ("b", "c") FROM STDIN WITH (FORMAT csv, HEADER)`,
+		},
+		copyTestCase{
+			clause: cc.SetOptions(exp.CopyOptions{
+				Format:    exp.CopyFormatCSV,
+				Delimiter: ";",
+				Null:      "null",
+				Header:    true,
+				Quote:     `"`,
+				Escape:    `'`,
+				Encoding:  "UTF8",
+			}),
+			sql: `COPY "s"."a" TO STDOUT WITH ` +
+				`(FORMAT csv, DELIMITER ';', NULL 'null', HEADER, QUOTE '"', ESCAPE '''', ENCODING 'UTF8')`,
+		},
+		copyTestCase{
+			clause: cc.SetOptions(exp.CopyOptions{Format: exp.CopyFormatBinary}),
+			sql:    `COPY "s"."a" TO STDOUT WITH (FORMAT binary)`,
+		},
+
+		copyTestCase{clause: exp.NewCopyClauses(), err: "goqu: no table found when generating copy sql"},
+	)
+}
+
+func (csgs *copySQLGeneratorSuite) TestGenerate_WithQuery() {
+	q := newTestAppendableExpression(`SELECT * FROM "a"`, emptyArgs, nil, nil)
+	cc := exp.NewCopyClauses().SetTable(q)
+
+	csgs.assertCases(
+		sqlgen.NewCopySQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		copyTestCase{
+			clause: cc.SetOptions(exp.CopyOptions{Format: exp.CopyFormatCSV}),
+			sql:    `COPY (SELECT * FROM "a") TO STDOUT WITH (FORMAT csv)`,
+		},
+		copyTestCase{clause: cc.SetFrom(true), err: "goqu: a query can only be copied TO STDOUT"},
+		copyTestCase{
+			clause: cc.SetColumns(exp.NewColumnListExpression("b")),
+			err:    "goqu: columns cannot be used when copying a query",
+		},
+	)
+}
+
+func (csgs *copySQLGeneratorSuite) TestGenerate_WithUnsupportedCopy() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CopyFragment = nil
+	csgs.assertCases(
+		sqlgen.NewCopySQLGenerator("test", opts),
+		copyTestCase{
+			clause: exp.NewCopyClauses().SetTable(exp.ParseIdentifier("a")),
+			err:    "goqu: dialect does not support COPY [dialect=test]",
+		},
+	)
+}
+
+func (csgs *copySQLGeneratorSuite) TestGenerate_WithSource() {
+	cc := exp.NewCopyClauses().SetTable(exp.ParseIdentifier("a")).SetFrom(true).
+		SetSource(exp.NewLiteralExpression("?", "s3://bucket/a/"))
+	params := []exp.Expression{
+		exp.NewLiteralExpression("IAM_ROLE ?", "role"),
+		exp.NewLiteralExpression("FORMAT AS CSV"),
+	}
+
+	csgs.assertCases(
+		sqlgen.NewCopySQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		copyTestCase{clause: cc, sql: `COPY "a" FROM 's3://bucket/a/'`},
+		copyTestCase{
+			clause: cc.ParametersAppend(params...),
+			sql:    `COPY "a" FROM 's3://bucket/a/' IAM_ROLE 'role' FORMAT AS CSV`,
+		},
+		copyTestCase{
+			clause: cc.SetOptions(exp.CopyOptions{Header: true}).ParametersAppend(params...),
+			sql:    `COPY "a" FROM 's3://bucket/a/' WITH (HEADER) IAM_ROLE 'role' FORMAT AS CSV`,
+		},
+		copyTestCase{clause: cc.SetFrom(false), err: "goqu: a source can only be used when copying into a table"},
+	)
+}
+
+func (csgs *copySQLGeneratorSuite) TestGenerate_WithUnsupportedStdio() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CopyFromStdinFragment = nil
+	opts.CopyToStdoutFragment = nil
+	opts.CopyWithFragment = nil
+	cc := exp.NewCopyClauses().SetTable(exp.ParseIdentifier("a"))
+
+	csgs.assertCases(
+		sqlgen.NewCopySQLGenerator("test", opts),
+		copyTestCase{
+			clause: cc.SetFrom(true).SetSource(exp.NewLiteralExpression("?", "s3://bucket/a/")),
+			sql:    `COPY "a" FROM 's3://bucket/a/'`,
+		},
+		copyTestCase{clause: cc.SetFrom(true), err: "goqu: dialect does not support FROM STDIN in COPY [dialect=test]"},
+		copyTestCase{clause: cc, err: "goqu: dialect does not support TO STDOUT in COPY [dialect=test]"},
+		copyTestCase{
+			clause: cc.SetFrom(true).
+				SetSource(exp.NewLiteralExpression("?", "s3://bucket/a/")).
+				SetOptions(exp.CopyOptions{Header: true}),
+			err: "goqu: dialect does not support WITH options in COPY [dialect=test]",
+		},
+	)
+}
+
+func (csgs *copySQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CopySQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	csgs.assertCases(
+		sqlgen.NewCopySQLGenerator("test", opts),
+		copyTestCase{
+			clause: exp.NewCopyClauses().SetTable(exp.ParseIdentifier("a")),
+			err:    "goqu: unsupported COPY SQL fragment UpdateBeginSQLFragment",
+		},
+	)
+}
+
+func (csgs *copySQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewCopySQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewCopyClauses().SetTable(exp.ParseIdentifier("a")))
+	csgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestCopySQLGenerator(t *testing.T) {
+	suite.Run(t, new(copySQLGeneratorSuite))
+}
@@ -0,0 +1,121 @@
+package sqlgen
+
+import (
+	"strings"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	CreateFunctionSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.CreateFunctionClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	createFunctionSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoFunctionForCreateFunction = errors.New("no function found when generating create function sql")
+	errNoBodyForCreateFunction     = errors.New("a body is required when generating create function sql")
+)
+
+func errCreateFunctionNotSupported(dialect string) error {
+	return errors.New("dialect does not support CREATE FUNCTION [dialect=%s]", dialect)
+}
+
+func errCreateFunctionFeatureNotSupported(dialect, feature string) error {
+	return errors.New("dialect does not support %s in CREATE FUNCTION [dialect=%s]", feature, dialect)
+}
+
+func errFunctionBodyContainsEnd(dialect, end string) error {
+	return errors.New("the body of a function cannot contain %s [dialect=%s]", end, dialect)
+}
+
+func NewCreateFunctionSQLGenerator(dialect string, do *SQLDialectOptions) CreateFunctionSQLGenerator {
+	return &createFunctionSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (cfsg *createFunctionSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.CreateFunctionClauses) {
+	if !clauses.HasFunction() {
+		b.SetError(errNoFunctionForCreateFunction)
+		return
+	}
+	if clauses.Body() == "" {
+		b.SetError(errNoBodyForCreateFunction)
+		return
+	}
+	for _, f := range cfsg.DialectOptions().CreateFunctionSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CreateFunctionSQLFragment:
+			cfsg.CreateFunctionSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("CREATE FUNCTION", f))
+		}
+	}
+}
+
+// Generates a CREATE FUNCTION statement, the body is written as is
+func (cfsg *createFunctionSQLGenerator) CreateFunctionSQL(b sb.SQLBuilder, clauses exp.CreateFunctionClauses) {
+	do := cfsg.DialectOptions()
+	end := string(do.FunctionBodyEndFragment)
+	switch {
+	case do.FunctionFragment == nil:
+		b.SetError(errCreateFunctionNotSupported(cfsg.Dialect()))
+		return
+	case clauses.IsOrReplace() && do.OrReplaceFunctionFragment == nil:
+		b.SetError(errCreateFunctionFeatureNotSupported(cfsg.Dialect(), "OR REPLACE"))
+		return
+	case clauses.Language() != "" && do.FunctionLanguageFragment == nil:
+		b.SetError(errCreateFunctionFeatureNotSupported(cfsg.Dialect(), "LANGUAGE"))
+		return
+	case end != "" && strings.Contains(clauses.Body(), end):
+		b.SetError(errFunctionBodyContainsEnd(cfsg.Dialect(), end))
+		return
+	}
+	b.Write(do.CreateFragment)
+	if clauses.IsOrReplace() {
+		b.Write(do.OrReplaceFunctionFragment)
+	}
+	b.Write(do.FunctionFragment)
+	cfsg.ExpressionSQLGenerator().Generate(b, clauses.Function())
+	cfsg.paramsSQL(b, clauses.Params())
+	if returns := clauses.Returns(); returns != nil {
+		b.Write(do.FunctionReturnsFragment)
+		cfsg.ExpressionSQLGenerator().Generate(b, returns)
+	}
+	if lang := clauses.Language(); lang != "" {
+		b.Write(do.FunctionLanguageFragment).WriteStrings(lang)
+	}
+	b.Write(do.FunctionBodyFragment).WriteStrings(clauses.Body()).Write(do.FunctionBodyEndFragment)
+}
+
+// Generates the parameters of a function (e.g. ("a" INTEGER, "b" TEXT))
+func (cfsg *createFunctionSQLGenerator) paramsSQL(b sb.SQLBuilder, params []exp.FunctionParam) {
+	do := cfsg.DialectOptions()
+	b.WriteRunes(do.LeftParenRune)
+	for i, p := range params {
+		if i > 0 {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		if do.FunctionParamPrefixFragment != nil {
+			b.Write(do.FunctionParamPrefixFragment).WriteStrings(p.Name)
+		} else {
+			cfsg.ExpressionSQLGenerator().Generate(b, exp.NewIdentifierExpression("", "", p.Name))
+		}
+		b.WriteRunes(do.SpaceRune)
+		cfsg.ExpressionSQLGenerator().Generate(b, p.DataType)
+	}
+	b.WriteRunes(do.RightParenRune)
+}
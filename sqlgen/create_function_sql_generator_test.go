@@ -0,0 +1,153 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createFunctionTestCase struct {
+		clause exp.CreateFunctionClauses
+		sql    string
+		err    string
+	}
+	createFunctionSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (cfgs *createFunctionSQLGeneratorSuite) assertCases(
+	cfg sqlgen.CreateFunctionSQLGenerator,
+	testCases ...createFunctionTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		cfg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			cfgs.assertErrorSQL(b, tc.err)
+		} else {
+			cfgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (cfgs *createFunctionSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCreateFunctionSQLGenerator("test", opts)
+	cfgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewCreateFunctionSQLGenerator("test2", opts2)
+	cfgs.Equal("test2", d2.Dialect())
+}
+
+func (cfgs *createFunctionSQLGeneratorSuite) TestGenerate() {
+	cf := exp.NewCreateFunctionClauses().SetFunction(exp.ParseIdentifier("add")).SetBody("SELECT a + b")
+	params := []exp.FunctionParam{
+		{Name: "a", DataType: exp.NewDataType(exp.IntegerDataType)},
+		{Name: "b", DataType: exp.NewDataType(exp.IntegerDataType)},
+	}
+
+	cfgs.assertCases(
+		sqlgen.NewCreateFunctionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		createFunctionTestCase{clause: cf, sql: `CREATE FUNCTION "add"() AS $$SELECT a + b$$`},
+		createFunctionTestCase{
+			clause: cf.SetOrReplace(true).SetParams(params).SetReturns(exp.NewDataType(exp.IntegerDataType)).
+				SetLanguage("sql"),
+			sql: `CREATE OR REPLACE FUNCTION "add"("a" INTEGER, "b" INTEGER) RETURNS INTEGER LANGUAGE sql AS $$SELECT a + b$$`,
+		},
+		createFunctionTestCase{
+			clause: cf.SetFunction(exp.ParseIdentifier("app.audit")).SetReturns(exp.NewCustomDataType("TRIGGER")).
+				SetLanguage("plpgsql").SetBody("BEGIN RETURN NEW; END;"),
+			sql: `CREATE FUNCTION "app"."audit"() RETURNS TRIGGER LANGUAGE plpgsql AS $$BEGIN RETURN NEW; END;$$`,
+		},
+		createFunctionTestCase{
+			clause: cf.SetBody("SELECT $$a$$"),
+			err:    "goqu: the body of a function cannot contain $$ [dialect=test]",
+		},
+		createFunctionTestCase{
+			clause: cf.SetParams([]exp.FunctionParam{{Name: "a", DataType: exp.NewDataType(exp.DataTypeKind(100))}}),
+			err:    "goqu: dialect does not support data type 100 [dialect=test]",
+		},
+
+		createFunctionTestCase{
+			clause: exp.NewCreateFunctionClauses(),
+			err:    "goqu: no function found when generating create function sql",
+		},
+		createFunctionTestCase{
+			clause: cf.SetBody(""),
+			err:    "goqu: a body is required when generating create function sql",
+		},
+	)
+}
+
+func (cfgs *createFunctionSQLGeneratorSuite) TestGenerate_WithParamPrefix() {
+	cf := exp.NewCreateFunctionClauses().
+		SetFunction(exp.ParseIdentifier("add")).
+		SetParams([]exp.FunctionParam{{Name: "a", DataType: exp.NewDataType(exp.IntegerDataType)}}).
+		SetReturns(exp.NewDataType(exp.IntegerDataType)).
+		SetBody("BEGIN RETURN @a + 1 END")
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.FunctionParamPrefixFragment = []byte("@")
+	opts.FunctionBodyFragment = []byte(" AS ")
+	opts.FunctionBodyEndFragment = nil
+	cfgs.assertCases(
+		sqlgen.NewCreateFunctionSQLGenerator("test", opts),
+		createFunctionTestCase{clause: cf, sql: `CREATE FUNCTION "add"(@a INTEGER) RETURNS INTEGER AS BEGIN RETURN @a + 1 END`},
+	)
+}
+
+func (cfgs *createFunctionSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	cf := exp.NewCreateFunctionClauses().SetFunction(exp.ParseIdentifier("one")).SetBody("SELECT 1")
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.OrReplaceFunctionFragment = nil
+	opts.FunctionLanguageFragment = nil
+	cfgs.assertCases(
+		sqlgen.NewCreateFunctionSQLGenerator("test", opts),
+		createFunctionTestCase{clause: cf, sql: `CREATE FUNCTION "one"() AS $$SELECT 1$$`},
+		createFunctionTestCase{
+			clause: cf.SetOrReplace(true),
+			err:    "goqu: dialect does not support OR REPLACE in CREATE FUNCTION [dialect=test]",
+		},
+		createFunctionTestCase{
+			clause: cf.SetLanguage("sql"),
+			err:    "goqu: dialect does not support LANGUAGE in CREATE FUNCTION [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.FunctionFragment = nil
+	cfgs.assertCases(
+		sqlgen.NewCreateFunctionSQLGenerator("test", opts),
+		createFunctionTestCase{clause: cf, err: "goqu: dialect does not support CREATE FUNCTION [dialect=test]"},
+	)
+}
+
+func (cfgs *createFunctionSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CreateFunctionSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	cf := exp.NewCreateFunctionClauses().SetFunction(exp.ParseIdentifier("one")).SetBody("SELECT 1")
+	cfgs.assertCases(
+		sqlgen.NewCreateFunctionSQLGenerator("test", opts),
+		createFunctionTestCase{clause: cf, err: "goqu: unsupported CREATE FUNCTION SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (cfgs *createFunctionSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewCreateFunctionSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewCreateFunctionClauses().SetFunction(exp.ParseIdentifier("one")).SetBody("SELECT 1"))
+	cfgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestCreateFunctionSQLGenerator(t *testing.T) {
+	suite.Run(t, new(createFunctionSQLGeneratorSuite))
+}
@@ -0,0 +1,148 @@
+package sqlgen
+
+import (
+	"bytes"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	CreateIndexSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.CreateIndexClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	createIndexSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoTableForCreateIndex   = errors.New("no table found when generating create index sql")
+	errNoColumnsForCreateIndex = errors.New("at least one column is required when generating create index sql")
+)
+
+func errCreateIndexFeatureNotSupported(dialect, feature string) error {
+	return errors.New("dialect does not support %s in CREATE INDEX [dialect=%s]", feature, dialect)
+}
+
+func NewCreateIndexSQLGenerator(dialect string, do *SQLDialectOptions) CreateIndexSQLGenerator {
+	return &createIndexSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (cisg *createIndexSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.CreateIndexClauses) {
+	if !clauses.HasTable() {
+		b.SetError(errNoTableForCreateIndex)
+		return
+	}
+	if clauses.Columns() == nil || clauses.Columns().IsEmpty() {
+		b.SetError(errNoColumnsForCreateIndex)
+		return
+	}
+	for _, f := range cisg.DialectOptions().CreateIndexSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CreateIndexSQLFragment:
+			cisg.CreateIndexSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("CREATE INDEX", f))
+		}
+	}
+}
+
+// Generates a CREATE INDEX statement
+func (cisg *createIndexSQLGenerator) CreateIndexSQL(b sb.SQLBuilder, clauses exp.CreateIndexClauses) {
+	do := cisg.DialectOptions()
+	if !cisg.checkSupported(b, clauses) {
+		return
+	}
+	if clauses.IsUnique() {
+		b.Write(do.CreateUniqueIndexFragment)
+	} else {
+		b.Write(do.CreateIndexFragment)
+	}
+	if clauses.IsConcurrently() {
+		b.Write(do.ConcurrentlyFragment)
+	}
+	if clauses.IsIfNotExists() {
+		b.Write(do.IfNotExistsFragment)
+	}
+	if name := clauses.Name(); name != "" {
+		cisg.ExpressionSQLGenerator().Generate(b, exp.NewIdentifierExpression("", name, nil))
+		b.Write(do.OnFragment)
+	} else {
+		// an unnamed index (e.g. postgres CREATE INDEX ON "table") follows the CREATE INDEX fragment directly
+		b.Write(bytes.TrimLeft(do.OnFragment, " "))
+	}
+	cisg.ExpressionSQLGenerator().Generate(b, clauses.Table())
+	method := clauses.Method()
+	if method != "" && !do.UseIndexMethodAfterColumns {
+		cisg.methodSQL(b, method)
+	}
+	b.WriteRunes(do.SpaceRune, do.LeftParenRune)
+	for i, col := range clauses.Columns().Columns() {
+		if i > 0 {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		cisg.indexColumnSQL(b, col)
+	}
+	b.WriteRunes(do.RightParenRune)
+	if method != "" && do.UseIndexMethodAfterColumns {
+		cisg.methodSQL(b, method)
+	}
+	cisg.WhereSQL(b, clauses.Where())
+}
+
+func (cisg *createIndexSQLGenerator) checkSupported(b sb.SQLBuilder, clauses exp.CreateIndexClauses) bool {
+	do := cisg.DialectOptions()
+	var feature string
+	switch {
+	case clauses.IsConcurrently() && !do.SupportsConcurrentIndex:
+		feature = "CONCURRENTLY"
+	case clauses.IsIfNotExists() && !do.SupportsCreateIndexIfNotExists:
+		feature = "IF NOT EXISTS"
+	case clauses.Method() != "" && !do.SupportsIndexMethod:
+		feature = "USING"
+	case clauses.Where() != nil && !clauses.Where().IsEmpty() && !do.SupportsPartialIndex:
+		feature = "WHERE"
+	default:
+		return true
+	}
+	b.SetError(errCreateIndexFeatureNotSupported(cisg.Dialect(), feature))
+	return false
+}
+
+func (cisg *createIndexSQLGenerator) methodSQL(b sb.SQLBuilder, method string) {
+	b.Write(cisg.DialectOptions().UsingFragment).WriteStrings(method)
+}
+
+// Generates a column of an index, expressions that are not identifiers are wrapped in parens
+// (e.g. lower("name") -> (lower("name")))
+func (cisg *createIndexSQLGenerator) indexColumnSQL(b sb.SQLBuilder, col exp.Expression) {
+	ordered, isOrdered := col.(exp.OrderedExpression)
+	sortExp := col
+	if isOrdered {
+		sortExp = ordered.SortExpression()
+	}
+	if _, isIdent := sortExp.(exp.IdentifierExpression); !isIdent {
+		sortExp = exp.NewLiteralExpression("(?)", sortExp)
+		if isOrdered {
+			dir := exp.DescSortDir
+			if ordered.IsAsc() {
+				dir = exp.AscDir
+			}
+			col = exp.NewOrderedExpression(sortExp, dir, ordered.NullSortType())
+		} else {
+			col = sortExp
+		}
+	}
+	cisg.ExpressionSQLGenerator().Generate(b, col)
+}
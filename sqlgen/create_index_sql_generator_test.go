@@ -0,0 +1,180 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createIndexTestCase struct {
+		clause exp.CreateIndexClauses
+		sql    string
+		err    string
+	}
+	createIndexSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (cisgs *createIndexSQLGeneratorSuite) assertCases(
+	cisg sqlgen.CreateIndexSQLGenerator,
+	testCases ...createIndexTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		cisg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			cisgs.assertErrorSQL(b, tc.err)
+		} else {
+			cisgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (cisgs *createIndexSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCreateIndexSQLGenerator("test", opts)
+	cisgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewCreateIndexSQLGenerator("test2", opts2)
+	cisgs.Equal("test2", d2.Dialect())
+}
+
+func (cisgs *createIndexSQLGeneratorSuite) TestGenerate() {
+	ci := exp.NewCreateIndexClauses().
+		SetName("a_idx").
+		SetTable(exp.ParseIdentifier("a")).
+		ColumnsAppend(exp.NewColumnListExpression("b"))
+
+	cisgs.assertCases(
+		sqlgen.NewCreateIndexSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		createIndexTestCase{clause: ci, sql: `CREATE INDEX "a_idx" ON "a" ("b")`},
+		createIndexTestCase{clause: ci.SetName(""), sql: `CREATE INDEX ON "a" ("b")`},
+		createIndexTestCase{
+			clause: ci.ColumnsAppend(exp.NewColumnListExpression(exp.ParseIdentifier("c").Desc())),
+			sql:    `CREATE INDEX "a_idx" ON "a" ("b", "c" DESC)`,
+		},
+		createIndexTestCase{clause: ci.SetUnique(true), sql: `CREATE UNIQUE INDEX "a_idx" ON "a" ("b")`},
+		createIndexTestCase{clause: ci.SetConcurrently(true), sql: `CREATE INDEX CONCURRENTLY "a_idx" ON "a" ("b")`},
+		createIndexTestCase{clause: ci.SetIfNotExists(true), sql: `CREATE INDEX IF NOT EXISTS "a_idx" ON "a" ("b")`},
+		createIndexTestCase{
+			clause: ci.SetUnique(true).SetConcurrently(true).SetIfNotExists(true),
+			sql:    `CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS "a_idx" ON "a" ("b")`,
+		},
+		createIndexTestCase{clause: ci.SetMethod("gin"), sql: `CREATE INDEX "a_idx" ON "a" USING gin ("b")`},
+		createIndexTestCase{
+			clause: ci.WhereAppend(exp.ParseIdentifier("c").IsNull()),
+			sql:    `CREATE INDEX "a_idx" ON "a" ("b") WHERE ("c" IS NULL)`,
+		},
+
+		createIndexTestCase{
+			clause: exp.NewCreateIndexClauses().ColumnsAppend(exp.NewColumnListExpression("b")),
+			err:    "goqu: no table found when generating create index sql",
+		},
+		createIndexTestCase{
+			clause: exp.NewCreateIndexClauses().SetTable(exp.ParseIdentifier("a")),
+			err:    "goqu: at least one column is required when generating create index sql",
+		},
+	)
+}
+
+func (cisgs *createIndexSQLGeneratorSuite) TestGenerate_WithExpressionColumns() {
+	ci := exp.NewCreateIndexClauses().SetName("a_idx").SetTable(exp.ParseIdentifier("a"))
+	lower := exp.NewSQLFunctionExpression("lower", exp.ParseIdentifier("b"))
+
+	cisgs.assertCases(
+		sqlgen.NewCreateIndexSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		createIndexTestCase{
+			clause: ci.ColumnsAppend(exp.NewColumnListExpression(lower)),
+			sql:    `CREATE INDEX "a_idx" ON "a" ((lower("b")))`,
+		},
+		createIndexTestCase{
+			clause: ci.ColumnsAppend(exp.NewColumnListExpression(lower.Desc().NullsLast(), lower.Asc())),
+			sql:    `CREATE INDEX "a_idx" ON "a" ((lower("b")) DESC NULLS LAST, (lower("b")) ASC)`,
+		},
+		createIndexTestCase{
+			clause: ci.ColumnsAppend(exp.NewColumnListExpression(exp.NewLiteralExpression(`"b" || "c"`))),
+			sql:    `CREATE INDEX "a_idx" ON "a" (("b" || "c"))`,
+		},
+	)
+}
+
+func (cisgs *createIndexSQLGeneratorSuite) TestGenerate_UseIndexMethodAfterColumns() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.UseIndexMethodAfterColumns = true
+	ci := exp.NewCreateIndexClauses().
+		SetName("a_idx").
+		SetTable(exp.ParseIdentifier("a")).
+		ColumnsAppend(exp.NewColumnListExpression("b")).
+		SetMethod("BTREE")
+
+	cisgs.assertCases(
+		sqlgen.NewCreateIndexSQLGenerator("test", opts),
+		createIndexTestCase{clause: ci, sql: `CREATE INDEX "a_idx" ON "a" ("b") USING BTREE`},
+	)
+}
+
+func (cisgs *createIndexSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsConcurrentIndex = false
+	opts.SupportsCreateIndexIfNotExists = false
+	opts.SupportsIndexMethod = false
+	opts.SupportsPartialIndex = false
+	ci := exp.NewCreateIndexClauses().
+		SetName("a_idx").
+		SetTable(exp.ParseIdentifier("a")).
+		ColumnsAppend(exp.NewColumnListExpression("b"))
+
+	cisgs.assertCases(
+		sqlgen.NewCreateIndexSQLGenerator("test", opts),
+		createIndexTestCase{clause: ci, sql: `CREATE INDEX "a_idx" ON "a" ("b")`},
+		createIndexTestCase{
+			clause: ci.SetConcurrently(true),
+			err:    "goqu: dialect does not support CONCURRENTLY in CREATE INDEX [dialect=test]",
+		},
+		createIndexTestCase{
+			clause: ci.SetIfNotExists(true),
+			err:    "goqu: dialect does not support IF NOT EXISTS in CREATE INDEX [dialect=test]",
+		},
+		createIndexTestCase{
+			clause: ci.SetMethod("gin"),
+			err:    "goqu: dialect does not support USING in CREATE INDEX [dialect=test]",
+		},
+		createIndexTestCase{
+			clause: ci.WhereAppend(exp.ParseIdentifier("c").IsNull()),
+			err:    "goqu: dialect does not support WHERE in CREATE INDEX [dialect=test]",
+		},
+	)
+}
+
+func (cisgs *createIndexSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CreateIndexSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	ci := exp.NewCreateIndexClauses().
+		SetTable(exp.ParseIdentifier("a")).
+		ColumnsAppend(exp.NewColumnListExpression("b"))
+	cisgs.assertCases(
+		sqlgen.NewCreateIndexSQLGenerator("test", opts),
+		createIndexTestCase{clause: ci, err: "goqu: unsupported CREATE INDEX SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (cisgs *createIndexSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewCreateIndexSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewCreateIndexClauses().
+		SetTable(exp.ParseIdentifier("a")).
+		ColumnsAppend(exp.NewColumnListExpression("b")))
+	cisgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestCreateIndexSQLGenerator(t *testing.T) {
+	suite.Run(t, new(createIndexSQLGeneratorSuite))
+}
@@ -0,0 +1,85 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	CreateSchemaSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.CreateSchemaClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	createSchemaSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var errNoNameForCreateSchema = errors.New("no name found when generating create schema sql")
+
+func errCreateSchemaNotSupported(dialect, stmt string) error {
+	return errors.New("dialect does not support %s [dialect=%s]", stmt, dialect)
+}
+
+func errCreateSchemaFeatureNotSupported(dialect, stmt, feature string) error {
+	return errors.New("dialect does not support %s in %s [dialect=%s]", feature, stmt, dialect)
+}
+
+func NewCreateSchemaSQLGenerator(dialect string, do *SQLDialectOptions) CreateSchemaSQLGenerator {
+	return &createSchemaSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (csg *createSchemaSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.CreateSchemaClauses) {
+	if !clauses.HasName() {
+		b.SetError(errNoNameForCreateSchema)
+		return
+	}
+	for _, f := range csg.DialectOptions().CreateSchemaSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CreateSchemaSQLFragment:
+			csg.CreateSchemaSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("CREATE SCHEMA", f))
+		}
+	}
+}
+
+// Generates a CREATE SCHEMA or CREATE DATABASE statement
+func (csg *createSchemaSQLGenerator) CreateSchemaSQL(b sb.SQLBuilder, clauses exp.CreateSchemaClauses) {
+	do := csg.DialectOptions()
+	stmt, fragment, supportsIfNotExists := "CREATE SCHEMA", do.CreateSchemaFragment, do.SupportsCreateSchemaIfNotExists
+	authFeature, authFragment := "AUTHORIZATION", do.SchemaAuthorizationFragment
+	if clauses.IsDatabase() {
+		stmt, fragment, supportsIfNotExists = "CREATE DATABASE", do.CreateDatabaseFragment, do.SupportsCreateDatabaseIfNotExists
+		authFeature, authFragment = "OWNER", do.DatabaseOwnerFragment
+	}
+	switch {
+	case fragment == nil:
+		b.SetError(errCreateSchemaNotSupported(csg.Dialect(), stmt))
+		return
+	case clauses.IsIfNotExists() && !supportsIfNotExists:
+		b.SetError(errCreateSchemaFeatureNotSupported(csg.Dialect(), stmt, "IF NOT EXISTS"))
+		return
+	case clauses.Authorization() != nil && authFragment == nil:
+		b.SetError(errCreateSchemaFeatureNotSupported(csg.Dialect(), stmt, authFeature))
+		return
+	}
+	b.Write(fragment)
+	if clauses.IsIfNotExists() {
+		b.Write(do.IfNotExistsFragment)
+	}
+	csg.ExpressionSQLGenerator().Generate(b, clauses.Name())
+	if auth := clauses.Authorization(); auth != nil {
+		b.Write(authFragment)
+		csg.ExpressionSQLGenerator().Generate(b, auth)
+	}
+}
@@ -0,0 +1,135 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createSchemaTestCase struct {
+		clause exp.CreateSchemaClauses
+		sql    string
+		err    string
+	}
+	createSchemaSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (csgs *createSchemaSQLGeneratorSuite) assertCases(
+	csg sqlgen.CreateSchemaSQLGenerator,
+	testCases ...createSchemaTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		csg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			csgs.assertErrorSQL(b, tc.err)
+		} else {
+			csgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (csgs *createSchemaSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCreateSchemaSQLGenerator("test", opts)
+	csgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewCreateSchemaSQLGenerator("test2", opts2)
+	csgs.Equal("test2", d2.Dialect())
+}
+
+func (csgs *createSchemaSQLGeneratorSuite) TestGenerate() {
+	cs := exp.NewCreateSchemaClauses().SetName(exp.ParseIdentifier("tenant_1"))
+	cd := cs.SetDatabase(true)
+	role := exp.ParseIdentifier("admin")
+
+	csgs.assertCases(
+		sqlgen.NewCreateSchemaSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		createSchemaTestCase{clause: cs, sql: `CREATE SCHEMA "tenant_1"`},
+		createSchemaTestCase{clause: cs.SetIfNotExists(true), sql: `CREATE SCHEMA IF NOT EXISTS "tenant_1"`},
+		createSchemaTestCase{clause: cs.SetAuthorization(role), sql: `CREATE SCHEMA "tenant_1" AUTHORIZATION "admin"`},
+		createSchemaTestCase{
+			clause: cs.SetIfNotExists(true).SetAuthorization(role),
+			sql:    `CREATE SCHEMA IF NOT EXISTS "tenant_1" AUTHORIZATION "admin"`,
+		},
+		createSchemaTestCase{clause: cd, sql: `CREATE DATABASE "tenant_1"`},
+		createSchemaTestCase{clause: cd.SetAuthorization(role), sql: `CREATE DATABASE "tenant_1" OWNER "admin"`},
+		createSchemaTestCase{
+			clause: cd.SetIfNotExists(true),
+			err:    "goqu: dialect does not support IF NOT EXISTS in CREATE DATABASE [dialect=test]",
+		},
+
+		createSchemaTestCase{
+			clause: exp.NewCreateSchemaClauses(),
+			err:    "goqu: no name found when generating create schema sql",
+		},
+	)
+}
+
+func (csgs *createSchemaSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	cs := exp.NewCreateSchemaClauses().SetName(exp.ParseIdentifier("tenant_1"))
+	cd := cs.SetDatabase(true)
+	role := exp.ParseIdentifier("admin")
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsCreateSchemaIfNotExists = false
+	opts.SupportsCreateDatabaseIfNotExists = true
+	opts.SchemaAuthorizationFragment = nil
+	opts.DatabaseOwnerFragment = nil
+	csgs.assertCases(
+		sqlgen.NewCreateSchemaSQLGenerator("test", opts),
+		createSchemaTestCase{clause: cs, sql: `CREATE SCHEMA "tenant_1"`},
+		createSchemaTestCase{clause: cd.SetIfNotExists(true), sql: `CREATE DATABASE IF NOT EXISTS "tenant_1"`},
+		createSchemaTestCase{
+			clause: cs.SetIfNotExists(true),
+			err:    "goqu: dialect does not support IF NOT EXISTS in CREATE SCHEMA [dialect=test]",
+		},
+		createSchemaTestCase{
+			clause: cs.SetAuthorization(role),
+			err:    "goqu: dialect does not support AUTHORIZATION in CREATE SCHEMA [dialect=test]",
+		},
+		createSchemaTestCase{
+			clause: cd.SetAuthorization(role),
+			err:    "goqu: dialect does not support OWNER in CREATE DATABASE [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.CreateSchemaFragment = nil
+	opts.CreateDatabaseFragment = nil
+	csgs.assertCases(
+		sqlgen.NewCreateSchemaSQLGenerator("test", opts),
+		createSchemaTestCase{clause: cs, err: "goqu: dialect does not support CREATE SCHEMA [dialect=test]"},
+		createSchemaTestCase{clause: cd, err: "goqu: dialect does not support CREATE DATABASE [dialect=test]"},
+	)
+}
+
+func (csgs *createSchemaSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CreateSchemaSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	cs := exp.NewCreateSchemaClauses().SetName(exp.ParseIdentifier("tenant_1"))
+	csgs.assertCases(
+		sqlgen.NewCreateSchemaSQLGenerator("test", opts),
+		createSchemaTestCase{clause: cs, err: "goqu: unsupported CREATE SCHEMA SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (csgs *createSchemaSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewCreateSchemaSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewCreateSchemaClauses().SetName(exp.ParseIdentifier("tenant_1")))
+	csgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestCreateSchemaSQLGenerator(t *testing.T) {
+	suite.Run(t, new(createSchemaSQLGeneratorSuite))
+}
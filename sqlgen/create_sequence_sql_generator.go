@@ -0,0 +1,101 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	CreateSequenceSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.CreateSequenceClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	createSequenceSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var errNoSequenceForCreateSequence = errors.New("no sequence found when generating create sequence sql")
+
+func errSequencesNotSupported(dialect string) error {
+	return errors.New("dialect does not support sequences [dialect=%s]", dialect)
+}
+
+func errSequenceFeatureNotSupported(dialect, stmt, feature string) error {
+	return errors.New("dialect does not support %s in %s [dialect=%s]", feature, stmt, dialect)
+}
+
+func NewCreateSequenceSQLGenerator(dialect string, do *SQLDialectOptions) CreateSequenceSQLGenerator {
+	return &createSequenceSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (csg *createSequenceSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.CreateSequenceClauses) {
+	if !clauses.HasSequence() {
+		b.SetError(errNoSequenceForCreateSequence)
+		return
+	}
+	for _, f := range csg.DialectOptions().CreateSequenceSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CreateSequenceSQLFragment:
+			csg.CreateSequenceSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("CREATE SEQUENCE", f))
+		}
+	}
+}
+
+// Generates a CREATE SEQUENCE statement
+func (csg *createSequenceSQLGenerator) CreateSequenceSQL(b sb.SQLBuilder, clauses exp.CreateSequenceClauses) {
+	do := csg.DialectOptions()
+	switch {
+	case do.CreateSequenceFragment == nil:
+		b.SetError(errSequencesNotSupported(csg.Dialect()))
+		return
+	case clauses.IsIfNotExists() && !do.SupportsCreateSequenceIfNotExists:
+		b.SetError(errSequenceFeatureNotSupported(csg.Dialect(), "CREATE SEQUENCE", "IF NOT EXISTS"))
+		return
+	}
+	b.Write(do.CreateSequenceFragment)
+	if clauses.IsIfNotExists() {
+		b.Write(do.IfNotExistsFragment)
+	}
+	csg.ExpressionSQLGenerator().Generate(b, clauses.Sequence())
+	sequenceOptionsSQL(b, csg.CommonSQLGenerator, clauses.Options())
+}
+
+// Generates the options of a CREATE SEQUENCE or ALTER SEQUENCE statement (e.g. INCREMENT BY 2 NO CYCLE)
+func sequenceOptionsSQL(b sb.SQLBuilder, csg CommonSQLGenerator, opts exp.SequenceOptions) {
+	do := csg.DialectOptions()
+	writeValue := func(fragment []byte, val *int64) {
+		if val != nil {
+			b.Write(fragment)
+			csg.ExpressionSQLGenerator().Generate(b, *val)
+		}
+	}
+	writeValue(do.IncrementByFragment, opts.IncrementBy)
+	if opts.NoMinValue {
+		b.Write(do.NoMinValueFragment)
+	} else {
+		writeValue(do.MinValueFragment, opts.MinValue)
+	}
+	if opts.NoMaxValue {
+		b.Write(do.NoMaxValueFragment)
+	} else {
+		writeValue(do.MaxValueFragment, opts.MaxValue)
+	}
+	writeValue(do.StartWithFragment, opts.StartWith)
+	if opts.Cycle {
+		b.Write(do.CycleFragment)
+	} else if opts.NoCycle {
+		b.Write(do.NoCycleFragment)
+	}
+}
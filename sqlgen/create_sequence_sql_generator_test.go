@@ -0,0 +1,125 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createSequenceTestCase struct {
+		clause exp.CreateSequenceClauses
+		sql    string
+		err    string
+	}
+	createSequenceSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (csgs *createSequenceSQLGeneratorSuite) assertCases(
+	csg sqlgen.CreateSequenceSQLGenerator,
+	testCases ...createSequenceTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		csg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			csgs.assertErrorSQL(b, tc.err)
+		} else {
+			csgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (csgs *createSequenceSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCreateSequenceSQLGenerator("test", opts)
+	csgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewCreateSequenceSQLGenerator("test2", opts2)
+	csgs.Equal("test2", d2.Dialect())
+}
+
+func (csgs *createSequenceSQLGeneratorSuite) TestGenerate() {
+	one, two, ten, hundred := int64(1), int64(2), int64(10), int64(100)
+	cs := exp.NewCreateSequenceClauses().SetSequence(exp.ParseIdentifier("s.a_seq"))
+
+	csgs.assertCases(
+		sqlgen.NewCreateSequenceSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		createSequenceTestCase{clause: cs, sql: `CREATE SEQUENCE "s"."a_seq"`},
+		createSequenceTestCase{clause: cs.SetIfNotExists(true), sql: `CREATE SEQUENCE IF NOT EXISTS "s"."a_seq"`},
+		createSequenceTestCase{
+			clause: cs.SetOptions(exp.SequenceOptions{
+				IncrementBy: &two,
+				MinValue:    &one,
+				MaxValue:    &hundred,
+				StartWith:   &ten,
+				Cycle:       true,
+			}),
+			sql: `CREATE SEQUENCE "s"."a_seq" INCREMENT BY 2 MINVALUE 1 MAXVALUE 100 START WITH 10 CYCLE`,
+		},
+		createSequenceTestCase{
+			clause: cs.SetOptions(exp.SequenceOptions{NoMinValue: true, NoMaxValue: true, NoCycle: true}),
+			sql:    `CREATE SEQUENCE "s"."a_seq" NO MINVALUE NO MAXVALUE NO CYCLE`,
+		},
+		createSequenceTestCase{
+			clause: cs.SetOptions(exp.SequenceOptions{MinValue: &one, NoMinValue: true, Cycle: true, NoCycle: true}),
+			sql:    `CREATE SEQUENCE "s"."a_seq" NO MINVALUE CYCLE`,
+		},
+
+		createSequenceTestCase{
+			clause: exp.NewCreateSequenceClauses(),
+			err:    "goqu: no sequence found when generating create sequence sql",
+		},
+	)
+}
+
+func (csgs *createSequenceSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	cs := exp.NewCreateSequenceClauses().SetSequence(exp.ParseIdentifier("a_seq"))
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsCreateSequenceIfNotExists = false
+	csgs.assertCases(
+		sqlgen.NewCreateSequenceSQLGenerator("test", opts),
+		createSequenceTestCase{clause: cs, sql: `CREATE SEQUENCE "a_seq"`},
+		createSequenceTestCase{
+			clause: cs.SetIfNotExists(true),
+			err:    "goqu: dialect does not support IF NOT EXISTS in CREATE SEQUENCE [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.CreateSequenceFragment = nil
+	csgs.assertCases(
+		sqlgen.NewCreateSequenceSQLGenerator("test", opts),
+		createSequenceTestCase{clause: cs, err: "goqu: dialect does not support sequences [dialect=test]"},
+	)
+}
+
+func (csgs *createSequenceSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CreateSequenceSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	cs := exp.NewCreateSequenceClauses().SetSequence(exp.ParseIdentifier("a_seq"))
+	csgs.assertCases(
+		sqlgen.NewCreateSequenceSQLGenerator("test", opts),
+		createSequenceTestCase{clause: cs, err: "goqu: unsupported CREATE SEQUENCE SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (csgs *createSequenceSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewCreateSequenceSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewCreateSequenceClauses().SetSequence(exp.ParseIdentifier("a_seq")))
+	csgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestCreateSequenceSQLGenerator(t *testing.T) {
+	suite.Run(t, new(createSequenceSQLGeneratorSuite))
+}
@@ -0,0 +1,295 @@
+package sqlgen
+
+import (
+	"strings"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	CreateTableSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.CreateTableClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	createTableSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoTableForCreateTable   = errors.New("no table found when generating create table sql")
+	errNoColumnsForCreateTable = errors.New("at least one column is required when generating create table sql")
+	errColumnsForPartitionOf   = errors.New(
+		"columns and constraints cannot be used with PARTITION OF when generating create table sql",
+	)
+	errNoBoundForPartitionOf = errors.New(
+		"a partition bound is required with PARTITION OF when generating create table sql",
+	)
+	errNoPartitionByForPartitions = errors.New(
+		"PARTITION BY is required with partition definitions when generating create table sql",
+	)
+	errMultiplePrimaryKeys = errors.New("only one PRIMARY KEY can be defined when generating create table sql")
+	errColumnsForAsSelect  = errors.New(
+		"columns, constraints and partitions cannot be used with AS SELECT when generating create table sql",
+	)
+)
+
+func errCreateTableNotSupported(dialect string) error {
+	return errors.New("dialect does not support CREATE TABLE [dialect=%s]", dialect)
+}
+
+func errCreateTableFeatureNotSupported(dialect, feature string) error {
+	return errors.New("dialect does not support %s in CREATE TABLE [dialect=%s]", feature, dialect)
+}
+
+func errTempTableOnCommitNotSupported(dialect string) error {
+	return errors.New("dialect does not support ON COMMIT for temporary tables [dialect=%s]", dialect)
+}
+
+func NewCreateTableSQLGenerator(dialect string, do *SQLDialectOptions) CreateTableSQLGenerator {
+	return &createTableSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (ctsg *createTableSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.CreateTableClauses) {
+	if !clauses.HasTable() {
+		b.SetError(errNoTableForCreateTable)
+		return
+	}
+	if clauses.AsSelect() != nil {
+		if !ctsg.checkAsSelect(b, clauses) {
+			return
+		}
+	} else if !ctsg.checkPartitions(b, clauses) {
+		return
+	}
+	for _, f := range ctsg.DialectOptions().CreateTableSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CreateTableSQLFragment:
+			ctsg.CreateTableSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("CREATE TABLE", f))
+		}
+	}
+}
+
+// Generates a CREATE TABLE statement
+func (ctsg *createTableSQLGenerator) CreateTableSQL(b sb.SQLBuilder, clauses exp.CreateTableClauses) {
+	do := ctsg.DialectOptions()
+	if do.CreateTableFragment == nil {
+		b.SetError(errCreateTableNotSupported(ctsg.Dialect()))
+		return
+	}
+	if clauses.IsTemporary() && do.CreateTempTableFragment == nil {
+		b.SetError(errCreateTableFeatureNotSupported(ctsg.Dialect(), "TEMPORARY"))
+		return
+	}
+	if clauses.IsIfNotExists() && !do.SupportsCreateTableIfNotExists {
+		b.SetError(errCreateTableFeatureNotSupported(ctsg.Dialect(), "IF NOT EXISTS"))
+		return
+	}
+	if clauses.AsSelect() != nil {
+		ctsg.createTableAsSQL(b, clauses)
+		return
+	}
+	if clauses.PartitionOf() != nil && do.PartitionOfFragment == nil {
+		b.SetError(errCreateTableFeatureNotSupported(ctsg.Dialect(), "PARTITION OF"))
+		return
+	}
+	table := clauses.Table()
+	if clauses.IsTemporary() {
+		b.Write(do.CreateTempTableFragment)
+		table = tempTableName(ctsg.DialectOptions().TempTableNamePrefix, table)
+	} else {
+		b.Write(do.CreateTableFragment)
+	}
+	if clauses.IsIfNotExists() {
+		b.Write(do.IfNotExistsFragment)
+	}
+	ctsg.ExpressionSQLGenerator().Generate(b, table)
+	if parent := clauses.PartitionOf(); parent != nil {
+		b.Write(do.PartitionOfFragment)
+		ctsg.ExpressionSQLGenerator().Generate(b, parent)
+		ctsg.ExpressionSQLGenerator().Generate(b, clauses.PartitionBound())
+	} else {
+		b.WriteRunes(do.SpaceRune, do.LeftParenRune)
+		for i, col := range clauses.Columns() {
+			if i > 0 {
+				b.WriteRunes(do.CommaRune, do.SpaceRune)
+			}
+			ctsg.ExpressionSQLGenerator().Generate(b, col)
+		}
+		for _, constraint := range clauses.Constraints() {
+			if do.PrimaryKeyAfterColumns && constraint.ConstraintType() == exp.PrimaryKeyConstraintType {
+				continue
+			}
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+			ctsg.ExpressionSQLGenerator().Generate(b, constraint)
+		}
+		b.WriteRunes(do.RightParenRune)
+		if do.PrimaryKeyAfterColumns {
+			ctsg.primaryKeyAfterColumnsSQL(b, clauses)
+		}
+	}
+	ctsg.partitionBySQL(b, clauses)
+}
+
+// Generates a CREATE TABLE ... AS SELECT statement, a temporary table is created using SELECT ... INTO when the dialect
+// sets UseSelectIntoForTempTables (e.g. sqlserver SELECT * INTO "#t" FROM (SELECT ...) AS "t")
+func (ctsg *createTableSQLGenerator) createTableAsSQL(b sb.SQLBuilder, clauses exp.CreateTableClauses) {
+	do := ctsg.DialectOptions()
+	onCommit := clauses.OnCommit()
+	if onCommit != exp.OnCommitDefault && (!clauses.IsTemporary() || !do.SupportsTempTableOnCommit) {
+		b.SetError(errTempTableOnCommitNotSupported(ctsg.Dialect()))
+		return
+	}
+	table := clauses.Table()
+	if clauses.IsTemporary() {
+		table = tempTableName(do.TempTableNamePrefix, table)
+		if do.UseSelectIntoForTempTables {
+			ctsg.selectIntoTempSQL(b, table, clauses.AsSelect())
+			return
+		}
+		b.Write(do.CreateTempTableFragment)
+	} else {
+		b.Write(do.CreateTableFragment)
+	}
+	if clauses.IsIfNotExists() {
+		b.Write(do.IfNotExistsFragment)
+	}
+	ctsg.ExpressionSQLGenerator().Generate(b, table)
+	if onCommit != exp.OnCommitDefault {
+		b.Write(do.OnCommitFragment).WriteStrings(onCommit.String())
+	}
+	b.Write(do.AsFragment)
+	clauses.AsSelect().AppendSQL(b)
+}
+
+// Generates a SELECT * INTO the temporary table from the query using the same INTO fragment as SelectDataset.IntoTemp
+func (ctsg *createTableSQLGenerator) selectIntoTempSQL(b sb.SQLBuilder, table exp.Expression, query exp.AppendableExpression) {
+	do := ctsg.DialectOptions()
+	if do.SelectIntoTempFragment == nil {
+		b.SetError(errSelectIntoTempNotSupported(ctsg.Dialect()))
+		return
+	}
+	b.Write(do.SelectClause).WriteRunes(do.SpaceRune, do.StarRune).Write(do.SelectIntoTempFragment)
+	ctsg.ExpressionSQLGenerator().Generate(b, table)
+	b.Write(do.FromFragment).WriteRunes(do.SpaceRune)
+	ctsg.ExpressionSQLGenerator().Generate(b, exp.NewAliasExpression(query, exp.ParseIdentifier("t")))
+}
+
+// Generates the PRIMARY KEY written after the column list (e.g. spanner CREATE TABLE `a` (...) PRIMARY KEY (`id`)),
+// the columns come from a PRIMARY KEY constraint or the columns defined as a primary key
+func (ctsg *createTableSQLGenerator) primaryKeyAfterColumnsSQL(b sb.SQLBuilder, clauses exp.CreateTableClauses) {
+	do := ctsg.DialectOptions()
+	var cols exp.ColumnListExpression
+	for _, constraint := range clauses.Constraints() {
+		if constraint.ConstraintType() != exp.PrimaryKeyConstraintType {
+			continue
+		}
+		if cols != nil {
+			b.SetError(errMultiplePrimaryKeys)
+			return
+		}
+		cols = constraint.Columns()
+	}
+	var pkCols []interface{}
+	for _, col := range clauses.Columns() {
+		if col.IsPrimaryKey() {
+			pkCols = append(pkCols, col.Name())
+		}
+	}
+	if len(pkCols) > 0 {
+		if cols != nil {
+			b.SetError(errMultiplePrimaryKeys)
+			return
+		}
+		cols = exp.NewColumnListExpression(pkCols...)
+	}
+	if cols == nil {
+		return
+	}
+	b.WriteRunes(do.SpaceRune).Write(do.PrimaryKeyFragment).WriteRunes(do.SpaceRune, do.LeftParenRune)
+	ctsg.ExpressionSQLGenerator().Generate(b, cols)
+	b.WriteRunes(do.RightParenRune)
+}
+
+// Generates the PARTITION BY clause and the partitions defined in the CREATE TABLE statement
+// (e.g. mysql PARTITION BY RANGE (`a`) (PARTITION `p0` VALUES LESS THAN (10)))
+func (ctsg *createTableSQLGenerator) partitionBySQL(b sb.SQLBuilder, clauses exp.CreateTableClauses) {
+	do := ctsg.DialectOptions()
+	if clauses.PartitionBy() == nil {
+		return
+	}
+	b.WriteRunes(do.SpaceRune)
+	ctsg.ExpressionSQLGenerator().Generate(b, clauses.PartitionBy())
+	partitions := clauses.Partitions()
+	if len(partitions) == 0 {
+		return
+	}
+	b.WriteRunes(do.SpaceRune, do.LeftParenRune)
+	for i, partition := range partitions {
+		if i > 0 {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		ctsg.ExpressionSQLGenerator().Generate(b, partition)
+	}
+	b.WriteRunes(do.RightParenRune)
+}
+
+// a table created from a query gets its columns from the query
+func (ctsg *createTableSQLGenerator) checkAsSelect(b sb.SQLBuilder, clauses exp.CreateTableClauses) bool {
+	if len(clauses.Columns()) > 0 || len(clauses.Constraints()) > 0 || clauses.PartitionBy() != nil ||
+		clauses.PartitionOf() != nil {
+		b.SetError(errColumnsForAsSelect)
+		return false
+	}
+	return true
+}
+
+// a partition of another table (e.g. postgres PARTITION OF) gets its columns from the parent table and requires a
+// bound, all other tables require at least one column
+func (ctsg *createTableSQLGenerator) checkPartitions(b sb.SQLBuilder, clauses exp.CreateTableClauses) bool {
+	hasColumns := len(clauses.Columns()) > 0
+	switch {
+	case clauses.PartitionOf() == nil && !hasColumns:
+		b.SetError(errNoColumnsForCreateTable)
+	case clauses.PartitionOf() != nil && (hasColumns || len(clauses.Constraints()) > 0):
+		b.SetError(errColumnsForPartitionOf)
+	case clauses.PartitionOf() != nil && clauses.PartitionBound() == nil:
+		b.SetError(errNoBoundForPartitionOf)
+	case len(clauses.Partitions()) > 0 && clauses.PartitionBy() == nil:
+		b.SetError(errNoPartitionByForPartitions)
+	default:
+		return true
+	}
+	return false
+}
+
+// adds the TempTableNamePrefix of the dialect to the name of a temporary table (e.g. sqlserver #table)
+func tempTableName(prefix string, table exp.Expression) exp.Expression {
+	ident, ok := table.(exp.IdentifierExpression)
+	if !ok || prefix == "" {
+		return table
+	}
+	// a table parsed from a string (e.g. "table" or "schema.table") has the name of the table as the last part
+	if col, isString := ident.GetCol().(string); isString && col != "" {
+		if !strings.HasPrefix(col, prefix) {
+			return ident.Col(prefix + col)
+		}
+		return ident
+	}
+	if name := ident.GetTable(); name != "" && !strings.HasPrefix(name, prefix) {
+		return ident.Table(prefix + name)
+	}
+	return ident
+}
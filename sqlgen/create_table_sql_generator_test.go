@@ -0,0 +1,393 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createTableTestCase struct {
+		clause exp.CreateTableClauses
+		sql    string
+		err    string
+	}
+	createTableSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (ctsgs *createTableSQLGeneratorSuite) assertCases(
+	ctsg sqlgen.CreateTableSQLGenerator,
+	testCases ...createTableTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		ctsg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			ctsgs.assertErrorSQL(b, tc.err)
+		} else {
+			ctsgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCreateTableSQLGenerator("test", opts)
+	ctsgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewCreateTableSQLGenerator("test2", opts2)
+	ctsgs.Equal("test2", d2.Dialect())
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestGenerate() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CreateTableFragment = []byte("create table ")
+	opts.CreateTempTableFragment = []byte("create temp table ")
+	opts.IfNotExistsFragment = []byte("if not exists ")
+
+	id := exp.NewColumnDefinition("id", exp.NewDataType(exp.IntegerDataType))
+	name := exp.NewColumnDefinition("name", exp.NewDataType(exp.VarcharDataType, 255))
+	ctNoTable := exp.NewCreateTableClauses().ColumnsAppend(id)
+	ctNoColumns := exp.NewCreateTableClauses().SetTable(exp.ParseIdentifier("a"))
+	ct := ctNoColumns.ColumnsAppend(id, name)
+
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{clause: ct, sql: `create table "a" ("id" INTEGER, "name" VARCHAR(255))`},
+		createTableTestCase{
+			clause: ct.SetTemporary(true),
+			sql:    `create temp table "a" ("id" INTEGER, "name" VARCHAR(255))`,
+		},
+		createTableTestCase{
+			clause: ct.SetIfNotExists(true),
+			sql:    `create table if not exists "a" ("id" INTEGER, "name" VARCHAR(255))`,
+		},
+		createTableTestCase{
+			clause: ct.ConstraintsAppend(exp.NewPrimaryKeyConstraint("id"), exp.NewUniqueConstraint("name").Named("u")),
+			sql:    `create table "a" ("id" INTEGER, "name" VARCHAR(255), PRIMARY KEY ("id"), CONSTRAINT "u" UNIQUE ("name"))`,
+		},
+
+		createTableTestCase{clause: ctNoTable, err: "goqu: no table found when generating create table sql"},
+		createTableTestCase{
+			clause: ctNoColumns,
+			err:    "goqu: at least one column is required when generating create table sql",
+		},
+	)
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CreateTableSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	ct := exp.NewCreateTableClauses().
+		SetTable(exp.ParseIdentifier("a")).
+		ColumnsAppend(exp.NewColumnDefinition("id", exp.NewDataType(exp.IntegerDataType)))
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{clause: ct, err: "goqu: unsupported CREATE TABLE SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCreateTableSQLGenerator("test", opts)
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewCreateTableClauses().
+		SetTable(exp.ParseIdentifier("a")).
+		ColumnsAppend(exp.NewColumnDefinition("id", exp.NewDataType(exp.IntegerDataType))))
+	ctsgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestGenerate_WithTempTableNamePrefix() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.TempTableNamePrefix = "#"
+	opts.CreateTempTableFragment = []byte("CREATE TABLE ")
+
+	ct := exp.NewCreateTableClauses().
+		ColumnsAppend(exp.NewColumnDefinition("id", exp.NewDataType(exp.IntegerDataType))).
+		SetTemporary(true)
+
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{clause: ct.SetTable(exp.ParseIdentifier("a")), sql: `CREATE TABLE "#a" ("id" INTEGER)`},
+		createTableTestCase{clause: ct.SetTable(exp.ParseIdentifier("#a")), sql: `CREATE TABLE "#a" ("id" INTEGER)`},
+		createTableTestCase{
+			clause: ct.SetTable(exp.NewIdentifierExpression("s", "a", nil)),
+			sql:    `CREATE TABLE "s"."#a" ("id" INTEGER)`,
+		},
+		createTableTestCase{
+			clause: ct.SetTable(exp.NewLiteralExpression("#a")),
+			sql:    `CREATE TABLE #a ("id" INTEGER)`,
+		},
+		createTableTestCase{
+			clause: ct.SetTemporary(false).SetTable(exp.ParseIdentifier("a")),
+			sql:    `CREATE TABLE "a" ("id" INTEGER)`,
+		},
+	)
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestGenerate_WithUnsupportedIfNotExists() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsCreateTableIfNotExists = false
+
+	ct := exp.NewCreateTableClauses().
+		SetTable(exp.ParseIdentifier("a")).
+		ColumnsAppend(exp.NewColumnDefinition("id", exp.NewDataType(exp.IntegerDataType))).
+		SetIfNotExists(true)
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{
+			clause: ct,
+			err:    "goqu: dialect does not support IF NOT EXISTS in CREATE TABLE [dialect=test]",
+		},
+	)
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestGenerate_WithUnsupportedCreateTable() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CreateTempTableFragment = nil
+
+	ct := exp.NewCreateTableClauses().
+		SetTable(exp.ParseIdentifier("a")).
+		ColumnsAppend(exp.NewColumnDefinition("id", exp.NewDataType(exp.IntegerDataType)))
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{clause: ct, sql: `CREATE TABLE "a" ("id" INTEGER)`},
+		createTableTestCase{
+			clause: ct.SetTemporary(true),
+			err:    "goqu: dialect does not support TEMPORARY in CREATE TABLE [dialect=test]",
+		},
+	)
+
+	opts.CreateTableFragment = nil
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{clause: ct, err: "goqu: dialect does not support CREATE TABLE [dialect=test]"},
+	)
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestGenerate_WithPrimaryKeyAfterColumns() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.PrimaryKeyAfterColumns = true
+	opts.WrapColumnDefaults = true
+
+	ct := exp.NewCreateTableClauses().SetTable(exp.ParseIdentifier("a"))
+	id := exp.NewColumnDefinition("id", exp.NewDataType(exp.IntegerDataType))
+	name := exp.NewColumnDefinition("name", exp.NewDataType(exp.VarcharDataType, 255)).Default("x")
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{
+			clause: ct.ColumnsAppend(id.PrimaryKey(), name),
+			sql:    `CREATE TABLE "a" ("id" INTEGER, "name" VARCHAR(255) DEFAULT ('x')) PRIMARY KEY ("id")`,
+		},
+		createTableTestCase{
+			clause: ct.ColumnsAppend(id, name).
+				ConstraintsAppend(exp.NewPrimaryKeyConstraint("id", "name"), exp.NewUniqueConstraint("name")),
+			sql: `CREATE TABLE "a" ("id" INTEGER, "name" VARCHAR(255) DEFAULT ('x'), UNIQUE ("name")) ` +
+				`PRIMARY KEY ("id", "name")`,
+		},
+		createTableTestCase{
+			clause: ct.ColumnsAppend(id.PrimaryKey()).ConstraintsAppend(exp.NewPrimaryKeyConstraint("id")),
+			err:    "goqu: only one PRIMARY KEY can be defined when generating create table sql",
+		},
+	)
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestGenerate_WithPartitions() {
+	id := exp.NewColumnDefinition("id", exp.NewDataType(exp.IntegerDataType))
+	at := exp.NewColumnDefinition("at", exp.NewDataType(exp.DateDataType))
+	ct := exp.NewCreateTableClauses().SetTable(exp.ParseIdentifier("m")).ColumnsAppend(id, at)
+	partOf := exp.NewCreateTableClauses().SetTable(exp.ParseIdentifier("m_2024"))
+
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		createTableTestCase{
+			clause: ct.SetPartitionBy(exp.NewPartitionBy(exp.RangePartition, "at")),
+			sql:    `CREATE TABLE "m" ("id" INTEGER, "at" DATE) PARTITION BY RANGE ("at")`,
+		},
+		createTableTestCase{
+			clause: ct.SetPartitionBy(exp.NewPartitionBy(exp.ListPartition, "id", exp.NewLiteralExpression("lower(?)", "a"))),
+			sql:    `CREATE TABLE "m" ("id" INTEGER, "at" DATE) PARTITION BY LIST ("id", lower('a'))`,
+		},
+		createTableTestCase{
+			clause: partOf.SetPartitionOf(
+				exp.ParseIdentifier("m"),
+				exp.NewRangePartitionBound([]interface{}{"2024-01-01"}, []interface{}{"2025-01-01"}),
+			),
+			sql: `CREATE TABLE "m_2024" PARTITION OF "m" FOR VALUES FROM ('2024-01-01') TO ('2025-01-01')`,
+		},
+		createTableTestCase{
+			clause: partOf.SetPartitionOf(
+				exp.ParseIdentifier("m"),
+				exp.NewRangePartitionBound([]interface{}{1, exp.NewLiteralExpression("MINVALUE")}, []interface{}{10, 20}),
+			),
+			sql: `CREATE TABLE "m_2024" PARTITION OF "m" FOR VALUES FROM (1, MINVALUE) TO (10, 20)`,
+		},
+		createTableTestCase{
+			clause: partOf.SetPartitionOf(exp.ParseIdentifier("m"), exp.NewListPartitionBound("eu", "us")),
+			sql:    `CREATE TABLE "m_2024" PARTITION OF "m" FOR VALUES IN ('eu', 'us')`,
+		},
+		createTableTestCase{
+			clause: partOf.SetPartitionOf(exp.ParseIdentifier("m"), exp.NewHashPartitionBound(4, 1)),
+			sql:    `CREATE TABLE "m_2024" PARTITION OF "m" FOR VALUES WITH (MODULUS 4, REMAINDER 1)`,
+		},
+		createTableTestCase{
+			clause: partOf.SetPartitionOf(exp.ParseIdentifier("m"), exp.NewDefaultPartitionBound()).
+				SetPartitionBy(exp.NewPartitionBy(exp.HashPartition, "id")),
+			sql: `CREATE TABLE "m_2024" PARTITION OF "m" DEFAULT PARTITION BY HASH ("id")`,
+		},
+
+		createTableTestCase{
+			clause: partOf.SetPartitionOf(exp.ParseIdentifier("m"), exp.NewLessThanPartitionBound(10)),
+			err:    "goqu: dialect does not support LESS THAN partition bounds [dialect=test]",
+		},
+		createTableTestCase{
+			clause: ct.SetPartitionBy(exp.NewPartitionBy(exp.HashPartition, "id").Partitions(4)),
+			err:    "goqu: dialect does not support PARTITIONS in partitioned tables [dialect=test]",
+		},
+		createTableTestCase{
+			clause: ct.SetPartitionBy(exp.NewPartitionBy(exp.RangePartition, "id")).
+				PartitionsAppend(exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), exp.NewLessThanPartitionBound(10))),
+			err: "goqu: dialect does not support partition definitions in partitioned tables [dialect=test]",
+		},
+		createTableTestCase{
+			clause: ct.SetPartitionOf(exp.ParseIdentifier("m"), exp.NewDefaultPartitionBound()),
+			err:    "goqu: columns and constraints cannot be used with PARTITION OF when generating create table sql",
+		},
+		createTableTestCase{
+			clause: partOf.SetPartitionOf(exp.ParseIdentifier("m"), nil),
+			err:    "goqu: a partition bound is required with PARTITION OF when generating create table sql",
+		},
+		createTableTestCase{
+			clause: ct.PartitionsAppend(exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), nil)),
+			err:    "goqu: PARTITION BY is required with partition definitions when generating create table sql",
+		},
+	)
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestGenerate_WithPartitionDefinitions() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.PartitionCountFragment = []byte(" PARTITIONS ")
+	opts.PartitionFragment = []byte("PARTITION ")
+	opts.PartitionOfFragment = nil
+	opts.PartitionValuesFragment = []byte(" VALUES")
+	opts.PartitionBoundLookup = map[exp.PartitionBoundType][]byte{
+		exp.LessThanPartitionBound: []byte(" LESS THAN "),
+		exp.ListPartitionBound:     []byte(" IN "),
+	}
+
+	ct := exp.NewCreateTableClauses().
+		SetTable(exp.ParseIdentifier("m")).
+		ColumnsAppend(exp.NewColumnDefinition("id", exp.NewDataType(exp.IntegerDataType)))
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{
+			clause: ct.SetPartitionBy(exp.NewPartitionBy(exp.RangePartition, "id")).PartitionsAppend(
+				exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), exp.NewLessThanPartitionBound(10)),
+				exp.NewPartitionDefinition(exp.ParseIdentifier("p1"), exp.NewLessThanPartitionBound(exp.NewLiteralExpression("MAXVALUE"))),
+			),
+			sql: `CREATE TABLE "m" ("id" INTEGER) PARTITION BY RANGE ("id") ` +
+				`(PARTITION "p0" VALUES LESS THAN (10), PARTITION "p1" VALUES LESS THAN (MAXVALUE))`,
+		},
+		createTableTestCase{
+			clause: ct.SetPartitionBy(exp.NewPartitionBy(exp.ListPartition, "id")).PartitionsAppend(
+				exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), exp.NewListPartitionBound(1, 2)),
+			),
+			sql: `CREATE TABLE "m" ("id" INTEGER) PARTITION BY LIST ("id") (PARTITION "p0" VALUES IN (1, 2))`,
+		},
+		createTableTestCase{
+			clause: ct.SetPartitionBy(exp.NewPartitionBy(exp.HashPartition, "id").Partitions(4)),
+			sql:    `CREATE TABLE "m" ("id" INTEGER) PARTITION BY HASH ("id") PARTITIONS 4`,
+		},
+		createTableTestCase{
+			clause: ct.SetPartitionBy(exp.NewPartitionBy(exp.HashPartition, "id")).PartitionsAppend(
+				exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), nil),
+				exp.NewPartitionDefinition(exp.ParseIdentifier("p1"), nil),
+			),
+			sql: `CREATE TABLE "m" ("id" INTEGER) PARTITION BY HASH ("id") (PARTITION "p0", PARTITION "p1")`,
+		},
+
+		createTableTestCase{
+			clause: exp.NewCreateTableClauses().
+				SetTable(exp.ParseIdentifier("m_0")).
+				SetPartitionOf(exp.ParseIdentifier("m"), exp.NewListPartitionBound(1)),
+			err: "goqu: dialect does not support PARTITION OF in CREATE TABLE [dialect=test]",
+		},
+		createTableTestCase{
+			clause: ct.SetPartitionBy(exp.NewPartitionBy(exp.RangePartition, "id")).PartitionsAppend(
+				exp.NewPartitionDefinition(exp.ParseIdentifier("p0"), exp.NewDefaultPartitionBound()),
+			),
+			err: "goqu: dialect does not support DEFAULT partition bounds [dialect=test]",
+		},
+	)
+
+	opts.PartitionByFragment = nil
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{
+			clause: ct.SetPartitionBy(exp.NewPartitionBy(exp.RangePartition, "id")),
+			err:    "goqu: dialect does not support PARTITION BY [dialect=test]",
+		},
+	)
+}
+
+func (ctsgs *createTableSQLGeneratorSuite) TestGenerate_WithAsSelect() {
+	q := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+	ct := exp.NewCreateTableClauses().SetTable(exp.ParseIdentifier("a")).SetAsSelect(q)
+	tmp := ct.SetTemporary(true)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsTempTableOnCommit = true
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{clause: ct, sql: `CREATE TABLE "a" AS SELECT * FROM "b"`},
+		createTableTestCase{clause: ct.SetIfNotExists(true), sql: `CREATE TABLE IF NOT EXISTS "a" AS SELECT * FROM "b"`},
+		createTableTestCase{clause: tmp, sql: `CREATE TEMPORARY TABLE "a" AS SELECT * FROM "b"`},
+		createTableTestCase{
+			clause: tmp.SetOnCommit(exp.OnCommitDrop),
+			sql:    `CREATE TEMPORARY TABLE "a" ON COMMIT DROP AS SELECT * FROM "b"`,
+		},
+		createTableTestCase{
+			clause: ct.SetOnCommit(exp.OnCommitDrop),
+			err:    "goqu: dialect does not support ON COMMIT for temporary tables [dialect=test]",
+		},
+		createTableTestCase{
+			clause: tmp.ColumnsAppend(exp.NewColumnDefinition("id", exp.NewDataType(exp.IntegerDataType))),
+			err:    "goqu: columns, constraints and partitions cannot be used with AS SELECT when generating create table sql",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.UseSelectIntoForTempTables = true
+	opts.TempTableNamePrefix = "#"
+	opts.SelectIntoTempFragment = []byte(" INTO ")
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{clause: ct, sql: `CREATE TABLE "a" AS SELECT * FROM "b"`},
+		createTableTestCase{clause: tmp, sql: `SELECT * INTO "#a" FROM (SELECT * FROM "b") AS "t"`},
+		createTableTestCase{
+			clause: tmp.SetOnCommit(exp.OnCommitDrop),
+			err:    "goqu: dialect does not support ON COMMIT for temporary tables [dialect=test]",
+		},
+	)
+
+	opts.SelectIntoTempFragment = nil
+	ctsgs.assertCases(
+		sqlgen.NewCreateTableSQLGenerator("test", opts),
+		createTableTestCase{
+			clause: tmp,
+			err:    "goqu: dialect does not support SELECT INTO a temporary table [dialect=test]",
+		},
+	)
+}
+
+func TestCreateTableSQLGenerator(t *testing.T) {
+	suite.Run(t, new(createTableSQLGeneratorSuite))
+}
@@ -0,0 +1,190 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	CreateTriggerSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.CreateTriggerClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	createTriggerSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoTriggerForCreateTrigger  = errors.New("no trigger found when generating create trigger sql")
+	errNoTableForCreateTrigger    = errors.New("a table is required when generating create trigger sql")
+	errNoEventsForCreateTrigger   = errors.New("a timing and an event are required when generating create trigger sql")
+	errNoActionForCreateTrigger   = errors.New("a function or a body is required when generating create trigger sql")
+	errMultipleActionsForTrigger  = errors.New("a trigger can either execute a function or have a body")
+	errUpdateColumnsWithoutUpdate = errors.New("the columns of an UPDATE event require an UPDATE event")
+)
+
+func errCreateTriggerNotSupported(dialect string) error {
+	return errors.New("dialect does not support CREATE TRIGGER [dialect=%s]", dialect)
+}
+
+func errCreateTriggerFeatureNotSupported(dialect, feature string) error {
+	return errors.New("dialect does not support %s in CREATE TRIGGER [dialect=%s]", feature, dialect)
+}
+
+func NewCreateTriggerSQLGenerator(dialect string, do *SQLDialectOptions) CreateTriggerSQLGenerator {
+	return &createTriggerSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (ctsg *createTriggerSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.CreateTriggerClauses) {
+	switch {
+	case !clauses.HasTrigger():
+		b.SetError(errNoTriggerForCreateTrigger)
+		return
+	case clauses.Table() == nil:
+		b.SetError(errNoTableForCreateTrigger)
+		return
+	case clauses.Timing() == exp.NoTriggerTiming || len(clauses.Events()) == 0:
+		b.SetError(errNoEventsForCreateTrigger)
+		return
+	case clauses.Function() == nil && clauses.Body() == "":
+		b.SetError(errNoActionForCreateTrigger)
+		return
+	case clauses.Function() != nil && clauses.Body() != "":
+		b.SetError(errMultipleActionsForTrigger)
+		return
+	}
+	for _, f := range ctsg.DialectOptions().CreateTriggerSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CreateTriggerSQLFragment:
+			ctsg.CreateTriggerSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("CREATE TRIGGER", f))
+		}
+	}
+}
+
+// Generates a CREATE TRIGGER statement
+func (ctsg *createTriggerSQLGenerator) CreateTriggerSQL(b sb.SQLBuilder, clauses exp.CreateTriggerClauses) {
+	do := ctsg.DialectOptions()
+	if !ctsg.checkSupported(b, clauses) {
+		return
+	}
+	b.Write(do.CreateFragment)
+	if clauses.IsOrReplace() {
+		b.Write(do.OrReplaceTriggerFragment)
+	}
+	b.Write(do.TriggerFragment)
+	if clauses.IsIfNotExists() {
+		b.Write(do.IfNotExistsFragment)
+	}
+	ctsg.ExpressionSQLGenerator().Generate(b, clauses.Trigger())
+	if do.TriggerTableBeforeTiming {
+		ctsg.tableSQL(b, clauses)
+		ctsg.eventsSQL(b, clauses)
+	} else {
+		ctsg.eventsSQL(b, clauses)
+		ctsg.tableSQL(b, clauses)
+	}
+	switch clauses.Level() {
+	case exp.RowTriggerLevel:
+		b.Write(do.ForEachRowFragment)
+	case exp.StatementTriggerLevel:
+		b.Write(do.ForEachStatementFragment)
+	}
+	if when := clauses.When(); when != nil {
+		b.Write(do.TriggerWhenFragment)
+		wrappedExpressionSQL(b, ctsg.ExpressionSQLGenerator(), do, when)
+	}
+	if fn := clauses.Function(); fn != nil {
+		b.Write(do.TriggerExecuteFragment)
+		ctsg.ExpressionSQLGenerator().Generate(b, fn)
+	} else {
+		b.Write(do.TriggerBodyFragment).WriteStrings(clauses.Body()).Write(do.TriggerBodyEndFragment)
+	}
+}
+
+func (ctsg *createTriggerSQLGenerator) tableSQL(b sb.SQLBuilder, clauses exp.CreateTriggerClauses) {
+	b.Write(ctsg.DialectOptions().OnFragment)
+	ctsg.ExpressionSQLGenerator().Generate(b, clauses.Table())
+}
+
+// Generates the timing and the events of a trigger (e.g. AFTER INSERT OR UPDATE OF "a")
+func (ctsg *createTriggerSQLGenerator) eventsSQL(b sb.SQLBuilder, clauses exp.CreateTriggerClauses) {
+	do := ctsg.DialectOptions()
+	b.Write(do.TriggerTimingLookup[clauses.Timing()])
+	for i, event := range clauses.Events() {
+		if i > 0 {
+			b.Write(do.TriggerEventSeparatorFragment)
+		}
+		b.Write(do.TriggerEventLookup[event])
+		if event == exp.UpdateTriggerEvent && hasUpdateColumns(clauses) {
+			b.Write(do.TriggerUpdateOfFragment)
+			ctsg.ExpressionSQLGenerator().Generate(b, clauses.UpdateColumns())
+		}
+	}
+}
+
+func (ctsg *createTriggerSQLGenerator) checkSupported(b sb.SQLBuilder, clauses exp.CreateTriggerClauses) bool {
+	hasUpdate := false
+	for _, event := range clauses.Events() {
+		hasUpdate = hasUpdate || event == exp.UpdateTriggerEvent
+	}
+	switch feature := ctsg.unsupportedFeature(clauses); {
+	case ctsg.DialectOptions().TriggerFragment == nil:
+		b.SetError(errCreateTriggerNotSupported(ctsg.Dialect()))
+	case feature != "":
+		b.SetError(errCreateTriggerFeatureNotSupported(ctsg.Dialect(), feature))
+	case hasUpdateColumns(clauses) && !hasUpdate:
+		b.SetError(errUpdateColumnsWithoutUpdate)
+	default:
+		return true
+	}
+	return false
+}
+
+// returns the first feature of the trigger that is not supported by the dialect or an empty string
+func (ctsg *createTriggerSQLGenerator) unsupportedFeature(clauses exp.CreateTriggerClauses) string {
+	do := ctsg.DialectOptions()
+	if _, ok := do.TriggerTimingLookup[clauses.Timing()]; !ok {
+		return clauses.Timing().String()
+	}
+	for _, event := range clauses.Events() {
+		if _, ok := do.TriggerEventLookup[event]; !ok {
+			return event.String()
+		}
+	}
+	switch {
+	case clauses.IsOrReplace() && do.OrReplaceTriggerFragment == nil:
+		return "OR REPLACE"
+	case clauses.IsIfNotExists() && !do.SupportsCreateTriggerIfNotExists:
+		return "IF NOT EXISTS"
+	case len(clauses.Events()) > 1 && !do.SupportsMultipleTriggerEvents:
+		return "multiple events"
+	case hasUpdateColumns(clauses) && do.TriggerUpdateOfFragment == nil:
+		return "UPDATE OF"
+	case clauses.Level() == exp.RowTriggerLevel && do.ForEachRowFragment == nil,
+		clauses.Level() == exp.StatementTriggerLevel && do.ForEachStatementFragment == nil:
+		return clauses.Level().String()
+	case clauses.When() != nil && do.TriggerWhenFragment == nil:
+		return "WHEN"
+	case clauses.Function() != nil && do.TriggerExecuteFragment == nil:
+		return "EXECUTE FUNCTION"
+	case clauses.Body() != "" && do.TriggerBodyFragment == nil:
+		return "a body"
+	}
+	return ""
+}
+
+func hasUpdateColumns(clauses exp.CreateTriggerClauses) bool {
+	return clauses.UpdateColumns() != nil && !clauses.UpdateColumns().IsEmpty()
+}
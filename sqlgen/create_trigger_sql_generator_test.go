@@ -0,0 +1,258 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createTriggerTestCase struct {
+		clause exp.CreateTriggerClauses
+		sql    string
+		err    string
+	}
+	createTriggerSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (ctgs *createTriggerSQLGeneratorSuite) assertCases(
+	ctg sqlgen.CreateTriggerSQLGenerator,
+	testCases ...createTriggerTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		ctg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			ctgs.assertErrorSQL(b, tc.err)
+		} else {
+			ctgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (ctgs *createTriggerSQLGeneratorSuite) baseClauses() exp.CreateTriggerClauses {
+	return exp.NewCreateTriggerClauses().
+		SetTrigger(exp.ParseIdentifier("a_audit")).
+		SetTable(exp.ParseIdentifier("a")).
+		SetTiming(exp.AfterTriggerTiming).
+		SetEvents([]exp.TriggerEvent{exp.InsertTriggerEvent}).
+		SetFunction(exp.NewSQLFunctionExpression("audit"))
+}
+
+func (ctgs *createTriggerSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCreateTriggerSQLGenerator("test", opts)
+	ctgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewCreateTriggerSQLGenerator("test2", opts2)
+	ctgs.Equal("test2", d2.Dialect())
+}
+
+func (ctgs *createTriggerSQLGeneratorSuite) TestGenerate() {
+	ct := ctgs.baseClauses()
+	events := []exp.TriggerEvent{exp.InsertTriggerEvent, exp.UpdateTriggerEvent, exp.DeleteTriggerEvent}
+
+	ctgs.assertCases(
+		sqlgen.NewCreateTriggerSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		createTriggerTestCase{clause: ct, sql: `CREATE TRIGGER "a_audit" AFTER INSERT ON "a" EXECUTE FUNCTION audit()`},
+		createTriggerTestCase{
+			clause: ct.SetOrReplace(true).SetTiming(exp.BeforeTriggerTiming).SetLevel(exp.RowTriggerLevel),
+			sql:    `CREATE OR REPLACE TRIGGER "a_audit" BEFORE INSERT ON "a" FOR EACH ROW EXECUTE FUNCTION audit()`,
+		},
+		createTriggerTestCase{
+			clause: ct.SetTiming(exp.InsteadOfTriggerTiming).SetTable(exp.ParseIdentifier("a_view")),
+			sql:    `CREATE TRIGGER "a_audit" INSTEAD OF INSERT ON "a_view" EXECUTE FUNCTION audit()`,
+		},
+		createTriggerTestCase{
+			clause: ct.SetEvents(events).SetLevel(exp.StatementTriggerLevel),
+			sql:    `CREATE TRIGGER "a_audit" AFTER INSERT OR UPDATE OR DELETE ON "a" FOR EACH STATEMENT EXECUTE FUNCTION audit()`,
+		},
+		createTriggerTestCase{
+			clause: ct.SetEvents([]exp.TriggerEvent{exp.UpdateTriggerEvent, exp.TruncateTriggerEvent}).
+				SetUpdateColumns(exp.NewColumnListExpression("b", "c")),
+			sql: `CREATE TRIGGER "a_audit" AFTER UPDATE OF "b", "c" OR TRUNCATE ON "a" EXECUTE FUNCTION audit()`,
+		},
+		createTriggerTestCase{
+			clause: ct.SetLevel(exp.RowTriggerLevel).SetWhen(exp.NewIdentifierExpression("", "NEW", "b").Gt(1)),
+			sql:    `CREATE TRIGGER "a_audit" AFTER INSERT ON "a" FOR EACH ROW WHEN ("NEW"."b" > 1) EXECUTE FUNCTION audit()`,
+		},
+		createTriggerTestCase{
+			clause: ct.SetWhen(exp.NewLiteralExpression("NEW.b IS NOT NULL")),
+			sql:    `CREATE TRIGGER "a_audit" AFTER INSERT ON "a" WHEN (NEW.b IS NOT NULL) EXECUTE FUNCTION audit()`,
+		},
+		createTriggerTestCase{
+			clause: ct.SetFunction(exp.NewSQLFunctionExpression("audit", "a")),
+			sql:    `CREATE TRIGGER "a_audit" AFTER INSERT ON "a" EXECUTE FUNCTION audit('a')`,
+		},
+		createTriggerTestCase{
+			clause: ct.SetIfNotExists(true),
+			err:    "goqu: dialect does not support IF NOT EXISTS in CREATE TRIGGER [dialect=test]",
+		},
+		createTriggerTestCase{
+			clause: ct.SetFunction(nil).SetBody("DELETE FROM b;"),
+			err:    "goqu: dialect does not support a body in CREATE TRIGGER [dialect=test]",
+		},
+		createTriggerTestCase{
+			clause: ct.SetUpdateColumns(exp.NewColumnListExpression("b")),
+			err:    "goqu: the columns of an UPDATE event require an UPDATE event",
+		},
+		createTriggerTestCase{
+			clause: ct.SetTiming(exp.TriggerTiming(100)),
+			err:    "goqu: dialect does not support 100 in CREATE TRIGGER [dialect=test]",
+		},
+
+		createTriggerTestCase{
+			clause: exp.NewCreateTriggerClauses(),
+			err:    "goqu: no trigger found when generating create trigger sql",
+		},
+		createTriggerTestCase{
+			clause: ct.SetTable(nil),
+			err:    "goqu: a table is required when generating create trigger sql",
+		},
+		createTriggerTestCase{
+			clause: ct.SetTiming(exp.NoTriggerTiming),
+			err:    "goqu: a timing and an event are required when generating create trigger sql",
+		},
+		createTriggerTestCase{
+			clause: ct.SetEvents(nil),
+			err:    "goqu: a timing and an event are required when generating create trigger sql",
+		},
+		createTriggerTestCase{
+			clause: ct.SetFunction(nil),
+			err:    "goqu: a function or a body is required when generating create trigger sql",
+		},
+		createTriggerTestCase{
+			clause: ct.SetBody("DELETE FROM b;"),
+			err:    "goqu: a trigger can either execute a function or have a body",
+		},
+	)
+}
+
+func (ctgs *createTriggerSQLGeneratorSuite) TestGenerate_WithBody() {
+	ct := ctgs.baseClauses().SetFunction(nil).SetBody("DELETE FROM b;").SetLevel(exp.RowTriggerLevel)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsCreateTriggerIfNotExists = true
+	opts.TriggerExecuteFragment = nil
+	opts.TriggerBodyFragment = []byte(" BEGIN ")
+	opts.TriggerBodyEndFragment = []byte(" END")
+	ctgs.assertCases(
+		sqlgen.NewCreateTriggerSQLGenerator("test", opts),
+		createTriggerTestCase{
+			clause: ct.SetIfNotExists(true),
+			sql:    `CREATE TRIGGER IF NOT EXISTS "a_audit" AFTER INSERT ON "a" FOR EACH ROW BEGIN DELETE FROM b; END`,
+		},
+		createTriggerTestCase{
+			clause: ct.SetBody("").SetFunction(exp.NewSQLFunctionExpression("audit")),
+			err:    "goqu: dialect does not support EXECUTE FUNCTION in CREATE TRIGGER [dialect=test]",
+		},
+	)
+}
+
+func (ctgs *createTriggerSQLGeneratorSuite) TestGenerate_TableBeforeTiming() {
+	ct := ctgs.baseClauses().
+		SetFunction(nil).
+		SetBody("DELETE FROM b;").
+		SetEvents([]exp.TriggerEvent{exp.InsertTriggerEvent, exp.UpdateTriggerEvent})
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.TriggerTableBeforeTiming = true
+	opts.TriggerEventSeparatorFragment = []byte(", ")
+	opts.TriggerBodyFragment = []byte(" AS ")
+	ctgs.assertCases(
+		sqlgen.NewCreateTriggerSQLGenerator("test", opts),
+		createTriggerTestCase{clause: ct, sql: `CREATE TRIGGER "a_audit" ON "a" AFTER INSERT, UPDATE AS DELETE FROM b;`},
+	)
+}
+
+func (ctgs *createTriggerSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	ct := ctgs.baseClauses()
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.OrReplaceTriggerFragment = nil
+	opts.SupportsMultipleTriggerEvents = false
+	opts.TriggerUpdateOfFragment = nil
+	opts.ForEachRowFragment = nil
+	opts.ForEachStatementFragment = nil
+	opts.TriggerWhenFragment = nil
+	opts.TriggerTimingLookup = map[exp.TriggerTiming][]byte{exp.AfterTriggerTiming: []byte(" AFTER ")}
+	opts.TriggerEventLookup = map[exp.TriggerEvent][]byte{
+		exp.InsertTriggerEvent: []byte("INSERT"),
+		exp.UpdateTriggerEvent: []byte("UPDATE"),
+	}
+	ctgs.assertCases(
+		sqlgen.NewCreateTriggerSQLGenerator("test", opts),
+		createTriggerTestCase{clause: ct, sql: `CREATE TRIGGER "a_audit" AFTER INSERT ON "a" EXECUTE FUNCTION audit()`},
+		createTriggerTestCase{
+			clause: ct.SetOrReplace(true),
+			err:    "goqu: dialect does not support OR REPLACE in CREATE TRIGGER [dialect=test]",
+		},
+		createTriggerTestCase{
+			clause: ct.SetTiming(exp.BeforeTriggerTiming),
+			err:    "goqu: dialect does not support BEFORE in CREATE TRIGGER [dialect=test]",
+		},
+		createTriggerTestCase{
+			clause: ct.SetEvents([]exp.TriggerEvent{exp.DeleteTriggerEvent}),
+			err:    "goqu: dialect does not support DELETE in CREATE TRIGGER [dialect=test]",
+		},
+		createTriggerTestCase{
+			clause: ct.SetEvents([]exp.TriggerEvent{exp.InsertTriggerEvent, exp.UpdateTriggerEvent}),
+			err:    "goqu: dialect does not support multiple events in CREATE TRIGGER [dialect=test]",
+		},
+		createTriggerTestCase{
+			clause: ct.SetEvents([]exp.TriggerEvent{exp.UpdateTriggerEvent}).
+				SetUpdateColumns(exp.NewColumnListExpression("b")),
+			err: "goqu: dialect does not support UPDATE OF in CREATE TRIGGER [dialect=test]",
+		},
+		createTriggerTestCase{
+			clause: ct.SetLevel(exp.RowTriggerLevel),
+			err:    "goqu: dialect does not support FOR EACH ROW in CREATE TRIGGER [dialect=test]",
+		},
+		createTriggerTestCase{
+			clause: ct.SetLevel(exp.StatementTriggerLevel),
+			err:    "goqu: dialect does not support FOR EACH STATEMENT in CREATE TRIGGER [dialect=test]",
+		},
+		createTriggerTestCase{
+			clause: ct.SetWhen(exp.NewLiteralExpression("TRUE")),
+			err:    "goqu: dialect does not support WHEN in CREATE TRIGGER [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.TriggerFragment = nil
+	ctgs.assertCases(
+		sqlgen.NewCreateTriggerSQLGenerator("test", opts),
+		createTriggerTestCase{clause: ct, err: "goqu: dialect does not support CREATE TRIGGER [dialect=test]"},
+	)
+}
+
+func (ctgs *createTriggerSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CreateTriggerSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	ctgs.assertCases(
+		sqlgen.NewCreateTriggerSQLGenerator("test", opts),
+		createTriggerTestCase{
+			clause: ctgs.baseClauses(),
+			err:    "goqu: unsupported CREATE TRIGGER SQL fragment UpdateBeginSQLFragment",
+		},
+	)
+}
+
+func (ctgs *createTriggerSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewCreateTriggerSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, ctgs.baseClauses())
+	ctgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestCreateTriggerSQLGenerator(t *testing.T) {
+	suite.Run(t, new(createTriggerSQLGeneratorSuite))
+}
@@ -0,0 +1,131 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	CreateViewSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.CreateViewClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	createViewSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoViewForCreateView  = errors.New("no view found when generating create view sql")
+	errNoQueryForCreateView = errors.New("a query is required when generating create view sql")
+)
+
+func errCreateViewFeatureNotSupported(dialect, feature string) error {
+	return errors.New("dialect does not support %s in CREATE VIEW [dialect=%s]", feature, dialect)
+}
+
+func errMaterializedViewNotSupported(dialect string) error {
+	return errors.New("dialect does not support CREATE MATERIALIZED VIEW [dialect=%s]", dialect)
+}
+
+func errMaterializedViewFeatureNotSupported(dialect, feature string) error {
+	return errors.New("dialect does not support %s in CREATE MATERIALIZED VIEW [dialect=%s]", feature, dialect)
+}
+
+func NewCreateViewSQLGenerator(dialect string, do *SQLDialectOptions) CreateViewSQLGenerator {
+	return &createViewSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (cvsg *createViewSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.CreateViewClauses) {
+	if !clauses.HasView() {
+		b.SetError(errNoViewForCreateView)
+		return
+	}
+	if clauses.Query() == nil {
+		b.SetError(errNoQueryForCreateView)
+		return
+	}
+	for _, f := range cvsg.DialectOptions().CreateViewSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CreateViewSQLFragment:
+			cvsg.CreateViewSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("CREATE VIEW", f))
+		}
+	}
+}
+
+// Generates a CREATE VIEW statement
+func (cvsg *createViewSQLGenerator) CreateViewSQL(b sb.SQLBuilder, clauses exp.CreateViewClauses) {
+	do := cvsg.DialectOptions()
+	if clauses.IsMaterialized() {
+		cvsg.createMaterializedViewSQL(b, clauses)
+		return
+	}
+	checkOption, hasCheckOption := do.ViewCheckOptionLookup[clauses.CheckOption()]
+	switch {
+	case clauses.IsOrReplace() && do.OrReplaceViewFragment == nil:
+		b.SetError(errCreateViewFeatureNotSupported(cvsg.Dialect(), "OR REPLACE"))
+		return
+	case clauses.IsTemporary() && do.TemporaryViewFragment == nil:
+		b.SetError(errCreateViewFeatureNotSupported(cvsg.Dialect(), "TEMPORARY"))
+		return
+	case clauses.CheckOption() != exp.NoViewCheckOption && !hasCheckOption:
+		b.SetError(errCreateViewFeatureNotSupported(cvsg.Dialect(), clauses.CheckOption().String()))
+		return
+	}
+	b.Write(do.CreateFragment)
+	if clauses.IsOrReplace() {
+		b.Write(do.OrReplaceViewFragment)
+	}
+	if clauses.IsTemporary() {
+		b.Write(do.TemporaryViewFragment)
+	}
+	b.Write(do.ViewFragment)
+	cvsg.viewDefinitionSQL(b, clauses)
+	b.Write(checkOption)
+}
+
+// Generates a CREATE MATERIALIZED VIEW statement, materialized views cannot be replaced, temporary or have a
+// check option
+func (cvsg *createViewSQLGenerator) createMaterializedViewSQL(b sb.SQLBuilder, clauses exp.CreateViewClauses) {
+	do := cvsg.DialectOptions()
+	switch {
+	case do.MaterializedViewFragment == nil:
+		b.SetError(errMaterializedViewNotSupported(cvsg.Dialect()))
+		return
+	case clauses.IsOrReplace():
+		b.SetError(errMaterializedViewFeatureNotSupported(cvsg.Dialect(), "OR REPLACE"))
+		return
+	case clauses.IsTemporary():
+		b.SetError(errMaterializedViewFeatureNotSupported(cvsg.Dialect(), "TEMPORARY"))
+		return
+	case clauses.CheckOption() != exp.NoViewCheckOption:
+		b.SetError(errMaterializedViewFeatureNotSupported(cvsg.Dialect(), clauses.CheckOption().String()))
+		return
+	}
+	b.Write(do.CreateFragment).Write(do.MaterializedViewFragment)
+	cvsg.viewDefinitionSQL(b, clauses)
+}
+
+// Generates the name, columns and query of a view (e.g. "v" ("a") AS SELECT ...)
+func (cvsg *createViewSQLGenerator) viewDefinitionSQL(b sb.SQLBuilder, clauses exp.CreateViewClauses) {
+	do := cvsg.DialectOptions()
+	cvsg.ExpressionSQLGenerator().Generate(b, clauses.View())
+	if cols := clauses.Columns(); cols != nil && !cols.IsEmpty() {
+		b.WriteRunes(do.SpaceRune, do.LeftParenRune)
+		cvsg.ExpressionSQLGenerator().Generate(b, cols)
+		b.WriteRunes(do.RightParenRune)
+	}
+	b.Write(do.AsFragment)
+	clauses.Query().AppendSQL(b)
+}
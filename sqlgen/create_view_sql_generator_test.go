@@ -0,0 +1,182 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	createViewTestCase struct {
+		clause exp.CreateViewClauses
+		sql    string
+		err    string
+	}
+	createViewSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (cvsgs *createViewSQLGeneratorSuite) assertCases(
+	cvsg sqlgen.CreateViewSQLGenerator,
+	testCases ...createViewTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		cvsg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			cvsgs.assertErrorSQL(b, tc.err)
+		} else {
+			cvsgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (cvsgs *createViewSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewCreateViewSQLGenerator("test", opts)
+	cvsgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewCreateViewSQLGenerator("test2", opts2)
+	cvsgs.Equal("test2", d2.Dialect())
+}
+
+func (cvsgs *createViewSQLGeneratorSuite) TestGenerate() {
+	q := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+	cv := exp.NewCreateViewClauses().SetView(exp.ParseIdentifier("a")).SetQuery(q)
+
+	cvsgs.assertCases(
+		sqlgen.NewCreateViewSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		createViewTestCase{clause: cv, sql: `CREATE VIEW "a" AS SELECT * FROM "b"`},
+		createViewTestCase{clause: cv.SetOrReplace(true), sql: `CREATE OR REPLACE VIEW "a" AS SELECT * FROM "b"`},
+		createViewTestCase{clause: cv.SetTemporary(true), sql: `CREATE TEMPORARY VIEW "a" AS SELECT * FROM "b"`},
+		createViewTestCase{
+			clause: cv.SetOrReplace(true).SetTemporary(true),
+			sql:    `CREATE OR REPLACE TEMPORARY VIEW "a" AS SELECT * FROM "b"`,
+		},
+		createViewTestCase{
+			clause: cv.SetColumns(exp.NewColumnListExpression("c", "d")),
+			sql:    `CREATE VIEW "a" ("c", "d") AS SELECT * FROM "b"`,
+		},
+		createViewTestCase{
+			clause: cv.SetColumns(exp.NewColumnListExpression()),
+			sql:    `CREATE VIEW "a" AS SELECT * FROM "b"`,
+		},
+		createViewTestCase{
+			clause: cv.SetCheckOption(exp.DefaultViewCheckOption),
+			sql:    `CREATE VIEW "a" AS SELECT * FROM "b" WITH CHECK OPTION`,
+		},
+		createViewTestCase{
+			clause: cv.SetCheckOption(exp.CascadedViewCheckOption),
+			sql:    `CREATE VIEW "a" AS SELECT * FROM "b" WITH CASCADED CHECK OPTION`,
+		},
+		createViewTestCase{
+			clause: cv.SetCheckOption(exp.LocalViewCheckOption),
+			sql:    `CREATE VIEW "a" AS SELECT * FROM "b" WITH LOCAL CHECK OPTION`,
+		},
+
+		createViewTestCase{
+			clause: exp.NewCreateViewClauses().SetQuery(q),
+			err:    "goqu: no view found when generating create view sql",
+		},
+		createViewTestCase{
+			clause: exp.NewCreateViewClauses().SetView(exp.ParseIdentifier("a")),
+			err:    "goqu: a query is required when generating create view sql",
+		},
+	)
+}
+
+func (cvsgs *createViewSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.OrReplaceViewFragment = nil
+	opts.TemporaryViewFragment = nil
+	opts.ViewCheckOptionLookup = map[exp.ViewCheckOption][]byte{
+		exp.DefaultViewCheckOption: []byte(" WITH CHECK OPTION"),
+	}
+	q := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+	cv := exp.NewCreateViewClauses().SetView(exp.ParseIdentifier("a")).SetQuery(q)
+
+	cvsgs.assertCases(
+		sqlgen.NewCreateViewSQLGenerator("test", opts),
+		createViewTestCase{
+			clause: cv.SetCheckOption(exp.DefaultViewCheckOption),
+			sql:    `CREATE VIEW "a" AS SELECT * FROM "b" WITH CHECK OPTION`,
+		},
+		createViewTestCase{
+			clause: cv.SetOrReplace(true),
+			err:    "goqu: dialect does not support OR REPLACE in CREATE VIEW [dialect=test]",
+		},
+		createViewTestCase{
+			clause: cv.SetTemporary(true),
+			err:    "goqu: dialect does not support TEMPORARY in CREATE VIEW [dialect=test]",
+		},
+		createViewTestCase{
+			clause: cv.SetCheckOption(exp.LocalViewCheckOption),
+			err:    "goqu: dialect does not support WITH LOCAL CHECK OPTION in CREATE VIEW [dialect=test]",
+		},
+	)
+}
+
+func (cvsgs *createViewSQLGeneratorSuite) TestGenerate_Materialized() {
+	q := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+	cv := exp.NewCreateViewClauses().SetView(exp.ParseIdentifier("a")).SetQuery(q).SetMaterialized(true)
+
+	cvsgs.assertCases(
+		sqlgen.NewCreateViewSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		createViewTestCase{clause: cv, sql: `CREATE MATERIALIZED VIEW "a" AS SELECT * FROM "b"`},
+		createViewTestCase{
+			clause: cv.SetColumns(exp.NewColumnListExpression("c", "d")),
+			sql:    `CREATE MATERIALIZED VIEW "a" ("c", "d") AS SELECT * FROM "b"`,
+		},
+
+		createViewTestCase{
+			clause: cv.SetOrReplace(true),
+			err:    "goqu: dialect does not support OR REPLACE in CREATE MATERIALIZED VIEW [dialect=test]",
+		},
+		createViewTestCase{
+			clause: cv.SetTemporary(true),
+			err:    "goqu: dialect does not support TEMPORARY in CREATE MATERIALIZED VIEW [dialect=test]",
+		},
+		createViewTestCase{
+			clause: cv.SetCheckOption(exp.DefaultViewCheckOption),
+			err:    "goqu: dialect does not support WITH CHECK OPTION in CREATE MATERIALIZED VIEW [dialect=test]",
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.MaterializedViewFragment = nil
+	cvsgs.assertCases(
+		sqlgen.NewCreateViewSQLGenerator("test", opts),
+		createViewTestCase{clause: cv.SetMaterialized(false), sql: `CREATE VIEW "a" AS SELECT * FROM "b"`},
+		createViewTestCase{clause: cv, err: "goqu: dialect does not support CREATE MATERIALIZED VIEW [dialect=test]"},
+	)
+}
+
+func (cvsgs *createViewSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CreateViewSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	q := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+	cv := exp.NewCreateViewClauses().SetView(exp.ParseIdentifier("a")).SetQuery(q)
+	cvsgs.assertCases(
+		sqlgen.NewCreateViewSQLGenerator("test", opts),
+		createViewTestCase{clause: cv, err: "goqu: unsupported CREATE VIEW SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (cvsgs *createViewSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewCreateViewSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	q := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+	d.Generate(b, exp.NewCreateViewClauses().SetView(exp.ParseIdentifier("a")).SetQuery(q))
+	cvsgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestCreateViewSQLGenerator(t *testing.T) {
+	suite.Run(t, new(createViewSQLGeneratorSuite))
+}
@@ -40,8 +40,12 @@ func (dsg *deleteSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.DeleteClaus
 		case CommonTableSQLFragment:
 			dsg.ExpressionSQLGenerator().Generate(b, clauses.CommonTables())
 		case DeleteBeginSQLFragment:
+			// the table hint uses the multiple table syntax which does not allow RETURNING (e.g. mariadb)
+			returns := clauses.Returning()
+			hasReturning := returns != nil && !returns.IsEmpty()
 			dsg.DeleteBeginSQL(
-				b, exp.NewColumnListExpression(clauses.From()), !(clauses.HasLimit() || clauses.HasOrder()),
+				b, clauses.Hints(), exp.NewColumnListExpression(clauses.From()),
+				!(clauses.HasLimit() || clauses.HasOrder() || hasReturning),
 			)
 		case FromSQLFragment:
 			dsg.FromSQL(b, exp.NewColumnListExpression(clauses.From()))
@@ -64,8 +68,11 @@ func (dsg *deleteSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.DeleteClaus
 }
 
 // Adds the correct fragment to being an DELETE statement
-func (dsg *deleteSQLGenerator) DeleteBeginSQL(b sb.SQLBuilder, from exp.ColumnListExpression, multiTable bool) {
+func (dsg *deleteSQLGenerator) DeleteBeginSQL(
+	b sb.SQLBuilder, hints []string, from exp.ColumnListExpression, multiTable bool,
+) {
 	b.Write(dsg.DialectOptions().DeleteClause)
+	dsg.HintsSQL(b, hints)
 	if multiTable && dsg.DialectOptions().SupportsDeleteTableHint {
 		dsg.SourcesSQL(b, from)
 	}
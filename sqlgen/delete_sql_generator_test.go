@@ -161,6 +161,38 @@ func (dsgs *deleteSQLGeneratorSuite) TestGenerate_withWhere() {
 	)
 }
 
+func (dsgs *deleteSQLGeneratorSuite) TestGenerate_withHints() {
+	dc := exp.NewDeleteClauses().
+		SetFrom(exp.NewIdentifierExpression("", "test", "")).
+		SetHints([]string{"a(b)", "c(d)"})
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsOptimizerHints = true
+	dsgs.assertCases(
+		sqlgen.NewDeleteSQLGenerator("test", opts),
+		deleteTestCase{clause: dc, sql: `DELETE /*+ a(b) c(d) */ FROM "test"`},
+		deleteTestCase{clause: dc, sql: `DELETE /*+ a(b) c(d) */ FROM "test"`, isPrepared: true},
+		deleteTestCase{
+			clause: dc.SetHints([]string{"a(b) */ FROM test; /*"}),
+			err:    `goqu: optimizer hint "a(b) */ FROM test; /*" must not contain */ [dialect=test]`,
+		},
+	)
+
+	opts.SupportsDeleteTableHint = true
+	dsgs.assertCases(
+		sqlgen.NewDeleteSQLGenerator("test", opts),
+		deleteTestCase{clause: dc, sql: `DELETE /*+ a(b) c(d) */ "test" FROM "test"`},
+	)
+
+	opts.SupportsOptimizerHints = false
+	expectedErr := "goqu: dialect does not support optimizer hints [dialect=test]"
+	dsgs.assertCases(
+		sqlgen.NewDeleteSQLGenerator("test", opts),
+		deleteTestCase{clause: dc, err: expectedErr},
+		deleteTestCase{clause: dc, err: expectedErr, isPrepared: true},
+	)
+}
+
 func (dsgs *deleteSQLGeneratorSuite) TestGenerate_withOrder() {
 	opts := sqlgen.DefaultDialectOptions()
 	opts.SupportsOrderByOnDelete = true
@@ -226,6 +258,15 @@ func (dsgs *deleteSQLGeneratorSuite) TestGenerate_withReturning() {
 		deleteTestCase{clause: dc, err: expectedErr},
 		deleteTestCase{clause: dc, err: expectedErr, isPrepared: true},
 	)
+
+	// the table hint is not used with RETURNING
+	opts.SupportsReturn = true
+	opts.SupportsDeleteTableHint = true
+	dsgs.assertCases(
+		sqlgen.NewDeleteSQLGenerator("test", opts),
+		deleteTestCase{clause: dc, sql: `DELETE FROM "test" RETURNING "a", "b"`},
+		deleteTestCase{clause: dc.SetReturning(nil), sql: `DELETE "test" FROM "test"`},
+	)
 }
 
 func TestDeleteSQLGenerator(t *testing.T) {
@@ -0,0 +1,298 @@
+package sqlgen
+
+import "github.com/doug-martin/goqu/v9/exp"
+
+// DialectCapabilities describes the features supported by a dialect so code that is shared between dialects can
+// check for a feature at runtime instead of handling the error returned when generating the sql.
+type DialectCapabilities struct {
+	// RETURNING clause on INSERT, UPDATE and DELETE statements
+	Returning bool
+	// RETURNING clause on UPDATE statements (e.g. mariadb only supports RETURNING on INSERT and DELETE)
+	ReturningOnUpdate bool
+	// WITH clause (common table expressions)
+	WithCTE bool
+	// WITH RECURSIVE clause
+	WithCTERecursive bool
+	// MATERIALIZED and NOT MATERIALIZED common table expressions
+	WithCTEMaterialization bool
+	// ON CONFLICT clause on INSERT statements (e.g. ON CONFLICT DO NOTHING, ON DUPLICATE KEY UPDATE)
+	OnConflict bool
+	// the conflict target of an ON CONFLICT clause (e.g. ON CONFLICT (id))
+	ConflictTarget bool
+	// WHERE clause of an ON CONFLICT DO UPDATE
+	ConflictUpdateWhere bool
+	// INSERT with the IGNORE conflict resolution (see InsertDataset#OrIgnore)
+	InsertOrIgnore bool
+	// INSERT with the REPLACE conflict resolution (see InsertDataset#OrReplace)
+	InsertOrReplace bool
+	// window functions and the WINDOW clause
+	WindowFunctions bool
+	// DISTINCT ON clause
+	DistinctOn bool
+	// LATERAL joins
+	Lateral bool
+	// ONLY to exclude the rows of inheriting tables from a table reference
+	TableOnly bool
+	// index hints on table references (e.g. USE INDEX)
+	IndexHints bool
+	// table hints on table references (e.g. WITH (NOLOCK))
+	TableHints bool
+	// JSON operators or the equivalent JSON functions (e.g. JSON("data").Get("a"))
+	JSONOperators bool
+	// JSON functions (e.g. JSONSet("data", []string{"a"}, 1))
+	JSONFunctions bool
+	// array operators (e.g. @>, &&) and ARRAY constructors
+	ArrayOperators bool
+	// array index access (e.g. "a"[1])
+	ArrayIndex bool
+	// array slices (e.g. "a"[1:3])
+	ArraySlice bool
+	// range types and their operators (e.g. int4range(1, 10), @>, &&)
+	RangeTypes bool
+	// intervals and date arithmetic (e.g. INTERVAL '3 days', "a" + INTERVAL '3 days')
+	Intervals bool
+	// converting timestamps to a time zone (e.g. "a" AT TIME ZONE 'UTC', mysql CONVERT_TZ)
+	AtTimeZone bool
+	// FILTER (WHERE ...) on aggregate functions, filtered aggregates are rewritten using CASE when false
+	AggregateFilter bool
+	// ORDER BY inside of aggregate functions (e.g. ARRAY_AGG("a" ORDER BY "b"))
+	AggregateOrderBy bool
+	// WITH ORDINALITY for table functions
+	WithOrdinality bool
+	// ROWS FROM to combine the results of table functions
+	RowsFrom bool
+	// PIVOT and UNPIVOT table operators
+	Pivot bool
+	// XMLTABLE table function
+	XMLTable bool
+	// SELECT ... INTO a new table
+	SelectInto bool
+	// SELECT ... INTO a new temporary table
+	SelectIntoTemp bool
+	// column aliases on derived tables (e.g. (SELECT ...) AS "t"("a", "b"))
+	DerivedColumnAliases bool
+	// ORDER BY clause on UPDATE statements
+	OrderByOnUpdate bool
+	// LIMIT clause on UPDATE statements
+	LimitOnUpdate bool
+	// ORDER BY clause on DELETE statements
+	OrderByOnDelete bool
+	// LIMIT clause on DELETE statements
+	LimitOnDelete bool
+	// updating multiple tables in a single UPDATE statement
+	MultipleUpdateTables bool
+	// MERGE statements
+	Merge bool
+	// CALL statements for stored procedures
+	Call bool
+	// EXPLAIN statements
+	Explain bool
+	// COPY statements for bulk loading and unloading data
+	Copy bool
+	// SET statements for session parameters
+	SetParam bool
+	// SET LOCAL statements for transaction scoped parameters
+	SetLocal bool
+	// SHOW statements for session parameters
+	Show bool
+	// VACUUM statements
+	Vacuum bool
+	// ANALYZE statements
+	Analyze bool
+	// OPTIMIZE statements
+	Optimize bool
+	// multiple statements separated by a semicolon in a single call
+	MultipleStatements bool
+	// DECLARE CURSOR and FETCH statements
+	Cursors bool
+	// LISTEN and NOTIFY statements
+	ListenNotify bool
+	// FOR UPDATE ... WAIT n
+	LockWaitSeconds bool
+	// FOR UPDATE combined with LIMIT, OFFSET or FETCH
+	LockWithLimit bool
+	// multiple rows in the VALUES clause of an INSERT
+	MultipleInsertRows bool
+	// SELECT STRAIGHT_JOIN and STRAIGHT_JOIN joins
+	StraightJoin bool
+	// optimizer hints (e.g. SELECT /*+ ... */)
+	OptimizerHints bool
+	// AS OF SYSTEM TIME clause
+	AsOfSystemTime bool
+	// QUALIFY clause
+	Qualify bool
+	// FINAL modifier of the FROM clause
+	Final bool
+	// SAMPLE clause
+	Sample bool
+	// PREWHERE clause
+	Prewhere bool
+	// SETTINGS clause
+	Settings bool
+	// START WITH and CONNECT BY hierarchical query clauses
+	ConnectBy bool
+	// placeholders for prepared statements, values are always interpolated if false
+	Placeholders bool
+	// truncating multiple tables in a single TRUNCATE statement
+	MultipleTruncateTables bool
+	// RESTART/CONTINUE IDENTITY option of TRUNCATE statements
+	TruncateIdentity bool
+	// CASCADE/RESTRICT option of TRUNCATE statements
+	TruncateCascade bool
+	// IF NOT EXISTS option of CREATE TABLE statements
+	CreateTableIfNotExists bool
+	// multiple actions in a single ALTER TABLE statement
+	MultipleAlterTableActions bool
+	// replacing the whole definition of a column in an ALTER TABLE statement (e.g. mysql MODIFY COLUMN)
+	ModifyColumn bool
+	// auto increment columns (e.g. AUTO_INCREMENT, IDENTITY)
+	AutoIncrement bool
+	// CONCURRENTLY option of CREATE INDEX and DROP INDEX statements
+	ConcurrentIndex bool
+	// partial indexes (e.g. CREATE INDEX ... WHERE)
+	PartialIndex bool
+	// the method of an index (e.g. USING gin)
+	IndexMethod bool
+	// OR REPLACE option of CREATE VIEW statements
+	CreateOrReplaceView bool
+	// TEMPORARY views
+	TemporaryView bool
+	// CREATE MATERIALIZED VIEW and REFRESH MATERIALIZED VIEW statements
+	MaterializedView bool
+	// CREATE SEQUENCE, ALTER SEQUENCE and DROP SEQUENCE statements
+	Sequences bool
+	// CREATE SCHEMA and DROP SCHEMA statements
+	Schemas bool
+	// CREATE DATABASE and DROP DATABASE statements
+	Databases bool
+	// COMMENT ON statements (or ALTER TABLE ... COMMENT in mysql)
+	Comments bool
+	// the COMMENT of a column in a column definition
+	ColumnComment bool
+	// GRANT and REVOKE statements
+	Grants bool
+	// PARTITION BY clause of CREATE TABLE statements
+	Partitions bool
+	// creating a table as a partition of another table and attaching or detaching partitions (e.g. PARTITION OF)
+	PartitionOf bool
+	// CHECK table constraints
+	CheckConstraints bool
+	// EXCLUDE table constraints
+	ExcludeConstraints bool
+	// CREATE TRIGGER and DROP TRIGGER statements
+	Triggers bool
+	// CREATE FUNCTION and DROP FUNCTION statements
+	Functions bool
+	// CASCADE/RESTRICT option of DROP statements
+	DropCascade bool
+	// The maximum number of characters in an identifier, 0 if identifiers are not validated
+	MaxIdentifierLength int
+}
+
+// Capabilities returns the features supported by dialects using these options.
+func (do *SQLDialectOptions) Capabilities() DialectCapabilities {
+	_, insertOrIgnore := do.ConflictResolutionLookup[exp.IgnoreConflictResolution]
+	_, insertOrReplace := do.ConflictResolutionLookup[exp.ReplaceConflictResolution]
+	return DialectCapabilities{
+		Returning:                 do.SupportsReturn,
+		ReturningOnUpdate:         do.SupportsReturn && do.SupportsReturnOnUpdate,
+		WithCTE:                   do.SupportsWithCTE,
+		WithCTERecursive:          do.SupportsWithCTE && do.SupportsWithCTERecursive,
+		WithCTEMaterialization:    do.SupportsWithCTE && do.MaterializedCTEFragment != nil,
+		OnConflict:                do.SupportsConflict,
+		ConflictTarget:            do.SupportsConflict && do.SupportsConflictTarget,
+		ConflictUpdateWhere:       do.SupportsConflict && do.SupportsConflictUpdateWhere,
+		InsertOrIgnore:            insertOrIgnore,
+		InsertOrReplace:           insertOrReplace,
+		WindowFunctions:           do.SupportsWindowFunction,
+		DistinctOn:                do.SupportsDistinctOn,
+		Lateral:                   do.SupportsLateral,
+		TableOnly:                 do.TableOnlyFragment != nil,
+		IndexHints:                do.UseIndexFragment != nil,
+		TableHints:                do.TableHintsFragment != nil,
+		JSONOperators:             do.UseJSONFunctions || len(do.JSONOperatorLookup) > 0,
+		JSONFunctions:             len(do.JSONFunctionLookup) > 0,
+		ArrayOperators:            len(do.ArrayOperatorLookup) > 0,
+		ArrayIndex:                do.SupportsArrayIndex,
+		ArraySlice:                do.SupportsArraySlice,
+		RangeTypes:                do.SupportsRangeTypes,
+		Intervals:                 len(do.IntervalUnitLookup) > 0,
+		AtTimeZone:                do.AtTimeZoneFragment != nil || do.ConvertTimeZoneFunction != nil,
+		AggregateFilter:           do.AggregateFilterFragment != nil,
+		AggregateOrderBy:          do.AggregateOrderByFragment != nil,
+		WithOrdinality:            do.WithOrdinalityFragment != nil,
+		RowsFrom:                  do.RowsFromFragment != nil,
+		Pivot:                     do.PivotFragment != nil,
+		XMLTable:                  do.XMLTableFragment != nil,
+		SelectInto:                do.SelectIntoFragment != nil,
+		SelectIntoTemp:            do.SelectIntoTempFragment != nil,
+		DerivedColumnAliases:      do.SupportsDerivedColumnAliases,
+		OrderByOnUpdate:           do.SupportsOrderByOnUpdate,
+		LimitOnUpdate:             do.SupportsLimitOnUpdate,
+		OrderByOnDelete:           do.SupportsOrderByOnDelete,
+		LimitOnDelete:             do.SupportsLimitOnDelete,
+		MultipleUpdateTables:      do.SupportsMultipleUpdateTables,
+		Merge:                     do.MergeFragment != nil,
+		Call:                      do.CallFragment != nil,
+		Explain:                   do.ExplainFragment != nil,
+		Copy:                      do.CopyFragment != nil,
+		SetParam:                  do.SetParamFragment != nil,
+		SetLocal:                  do.SetParamFragment != nil && do.SetLocalFragment != nil,
+		Show:                      do.ShowFragment != nil,
+		Vacuum:                    do.VacuumFragment != nil,
+		Analyze:                   do.AnalyzeFragment != nil,
+		Optimize:                  do.OptimizeFragment != nil,
+		MultipleStatements:        do.SupportsMultipleStatements,
+		Cursors:                   do.SupportsCursors,
+		ListenNotify:              do.SupportsListenNotify,
+		LockWaitSeconds:           do.SupportsLockWaitSeconds,
+		LockWithLimit:             do.SupportsLockWithLimit,
+		MultipleInsertRows:        do.SupportsMultipleInsertRows,
+		StraightJoin:              do.SupportsStraightJoin,
+		OptimizerHints:            do.SupportsOptimizerHints,
+		AsOfSystemTime:            do.SupportsAsOfSystemTime && do.hasSelectFragment(AsOfSystemTimeSQLFragment),
+		Qualify:                   do.SupportsQualify && do.hasSelectFragment(QualifySQLFragment),
+		Final:                     do.hasSelectFragment(FinalSQLFragment),
+		Sample:                    do.hasSelectFragment(SampleSQLFragment),
+		Prewhere:                  do.hasSelectFragment(PrewhereSQLFragment),
+		Settings:                  do.hasSelectFragment(SettingsSQLFragment),
+		ConnectBy:                 do.hasSelectFragment(ConnectBySQLFragment),
+		Placeholders:              do.SupportsPlaceholders,
+		MultipleTruncateTables:    do.SupportsMultipleTruncateTables,
+		TruncateIdentity:          do.SupportsTruncateIdentity,
+		TruncateCascade:           do.SupportsTruncateCascade,
+		CreateTableIfNotExists:    do.SupportsCreateTableIfNotExists,
+		MultipleAlterTableActions: do.SupportsMultipleAlterTableActions,
+		ModifyColumn:              do.ModifyColumnFragment != nil,
+		AutoIncrement:             do.AutoIncrementFragment != nil,
+		ConcurrentIndex:           do.SupportsConcurrentIndex,
+		PartialIndex:              do.SupportsPartialIndex,
+		IndexMethod:               do.SupportsIndexMethod,
+		CreateOrReplaceView:       do.OrReplaceViewFragment != nil,
+		TemporaryView:             do.TemporaryViewFragment != nil,
+		MaterializedView:          do.MaterializedViewFragment != nil,
+		Sequences:                 do.CreateSequenceFragment != nil,
+		Schemas:                   do.CreateSchemaFragment != nil,
+		Databases:                 do.CreateDatabaseFragment != nil,
+		Comments:                  do.CommentOnFragment != nil || do.TableCommentFragment != nil,
+		ColumnComment:             do.ColumnCommentFragment != nil,
+		Grants:                    do.GrantFragment != nil,
+		Partitions:                do.PartitionByFragment != nil,
+		PartitionOf:               do.PartitionOfFragment != nil,
+		CheckConstraints:          do.CheckFragment != nil,
+		ExcludeConstraints:        do.ExcludeFragment != nil,
+		Triggers:                  do.TriggerFragment != nil,
+		Functions:                 do.FunctionFragment != nil,
+		DropCascade:               do.SupportsDropCascade,
+		MaxIdentifierLength:       do.MaxIdentifierLength,
+	}
+}
+
+func (do *SQLDialectOptions) hasSelectFragment(fragment SQLFragmentType) bool {
+	for _, f := range do.SelectSQLOrder {
+		if f == fragment {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,275 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type dialectCapabilitiesSuite struct {
+	suite.Suite
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_defaults() {
+	caps := sqlgen.DefaultDialectOptions().Capabilities()
+	dcs.Equal(sqlgen.DialectCapabilities{
+		Returning:                 true,
+		ReturningOnUpdate:         true,
+		WithCTE:                   true,
+		WithCTERecursive:          true,
+		WithCTEMaterialization:    true,
+		OnConflict:                true,
+		ConflictTarget:            true,
+		ConflictUpdateWhere:       true,
+		WindowFunctions:           true,
+		DistinctOn:                true,
+		Lateral:                   true,
+		DerivedColumnAliases:      true,
+		MultipleUpdateTables:      true,
+		Merge:                     true,
+		Call:                      true,
+		Explain:                   true,
+		Copy:                      true,
+		SetParam:                  true,
+		SetLocal:                  true,
+		Show:                      true,
+		Vacuum:                    true,
+		Analyze:                   true,
+		Placeholders:              true,
+		LockWithLimit:             true,
+		MultipleInsertRows:        true,
+		Intervals:                 true,
+		AtTimeZone:                true,
+		AggregateFilter:           true,
+		AggregateOrderBy:          true,
+		MultipleTruncateTables:    true,
+		TruncateIdentity:          true,
+		TruncateCascade:           true,
+		CreateTableIfNotExists:    true,
+		MultipleAlterTableActions: true,
+		AutoIncrement:             true,
+		ConcurrentIndex:           true,
+		PartialIndex:              true,
+		IndexMethod:               true,
+		CreateOrReplaceView:       true,
+		TemporaryView:             true,
+		MaterializedView:          true,
+		Sequences:                 true,
+		Schemas:                   true,
+		Databases:                 true,
+		Comments:                  true,
+		Grants:                    true,
+		Partitions:                true,
+		PartitionOf:               true,
+		CheckConstraints:          true,
+		ExcludeConstraints:        true,
+		Triggers:                  true,
+		Functions:                 true,
+		DropCascade:               true,
+	}, caps)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_dependentFlags() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsReturn = false
+	opts.SupportsWithCTE = false
+	opts.SupportsConflict = false
+	caps := opts.Capabilities()
+	dcs.False(caps.Returning)
+	dcs.False(caps.ReturningOnUpdate)
+	dcs.False(caps.WithCTE)
+	dcs.False(caps.WithCTERecursive)
+	dcs.False(caps.WithCTEMaterialization)
+	dcs.False(caps.OnConflict)
+	dcs.False(caps.ConflictTarget)
+	dcs.False(caps.ConflictUpdateWhere)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_pivot() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.False(opts.Capabilities().Pivot)
+
+	opts.PivotFragment = []byte(" PIVOT ")
+	dcs.True(opts.Capabilities().Pivot)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_tableHints() {
+	opts := sqlgen.DefaultDialectOptions()
+	caps := opts.Capabilities()
+	dcs.False(caps.TableOnly)
+	dcs.False(caps.IndexHints)
+	dcs.False(caps.TableHints)
+
+	opts.TableOnlyFragment = []byte("ONLY ")
+	opts.UseIndexFragment = []byte(" USE INDEX ")
+	opts.TableHintsFragment = []byte(" WITH ")
+	caps = opts.Capabilities()
+	dcs.True(caps.TableOnly)
+	dcs.True(caps.IndexHints)
+	dcs.True(caps.TableHints)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_tableFunctions() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.False(opts.Capabilities().WithOrdinality)
+	dcs.False(opts.Capabilities().RowsFrom)
+
+	opts.WithOrdinalityFragment = []byte(" WITH ORDINALITY")
+	opts.RowsFromFragment = []byte("ROWS FROM ")
+	dcs.True(opts.Capabilities().WithOrdinality)
+	dcs.True(opts.Capabilities().RowsFrom)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_jsonOperators() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.False(opts.Capabilities().JSONOperators)
+
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{exp.JSONGetOp: []byte("->")}
+	dcs.True(opts.Capabilities().JSONOperators)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.UseJSONFunctions = true
+	dcs.True(opts.Capabilities().JSONOperators)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_arrays() {
+	opts := sqlgen.DefaultDialectOptions()
+	caps := opts.Capabilities()
+	dcs.False(caps.ArrayOperators)
+	dcs.False(caps.ArrayIndex)
+	dcs.False(caps.ArraySlice)
+
+	opts.ArrayOperatorLookup = map[exp.ArrayOperation][]byte{exp.ArrayOverlapOp: []byte("&&")}
+	opts.SupportsArrayIndex = true
+	opts.SupportsArraySlice = true
+	caps = opts.Capabilities()
+	dcs.True(caps.ArrayOperators)
+	dcs.True(caps.ArrayIndex)
+	dcs.True(caps.ArraySlice)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_rangeTypes() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.False(opts.Capabilities().RangeTypes)
+
+	opts.SupportsRangeTypes = true
+	dcs.True(opts.Capabilities().RangeTypes)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_aggregateFilter() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.True(opts.Capabilities().AggregateFilter)
+
+	opts.AggregateFilterFragment = nil
+	dcs.False(opts.Capabilities().AggregateFilter)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_aggregateOrderBy() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.True(opts.Capabilities().AggregateOrderBy)
+
+	opts.AggregateOrderByFragment = nil
+	dcs.False(opts.Capabilities().AggregateOrderBy)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_intervals() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.True(opts.Capabilities().Intervals)
+
+	opts.IntervalUnitLookup = nil
+	dcs.False(opts.Capabilities().Intervals)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_atTimeZone() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.True(opts.Capabilities().AtTimeZone)
+
+	opts.AtTimeZoneFragment = nil
+	dcs.False(opts.Capabilities().AtTimeZone)
+
+	opts.ConvertTimeZoneFunction = []byte("CONVERT_TZ")
+	dcs.True(opts.Capabilities().AtTimeZone)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_jsonFunctions() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.False(opts.Capabilities().JSONFunctions)
+
+	opts.JSONFunctionLookup = map[exp.JSONFunction][]byte{exp.JSONSetFunc: []byte("jsonb_set")}
+	dcs.True(opts.Capabilities().JSONFunctions)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_xmlTable() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.False(opts.Capabilities().XMLTable)
+
+	opts.XMLTableFragment = []byte("XMLTABLE")
+	dcs.True(opts.Capabilities().XMLTable)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_selectInto() {
+	opts := sqlgen.DefaultDialectOptions()
+	dcs.False(opts.Capabilities().SelectInto)
+	dcs.False(opts.Capabilities().SelectIntoTemp)
+
+	opts.SelectIntoFragment = []byte(" INTO ")
+	opts.SelectIntoTempFragment = []byte(" INTO TEMPORARY ")
+	dcs.True(opts.Capabilities().SelectInto)
+	dcs.True(opts.Capabilities().SelectIntoTemp)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_conflictResolution() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ConflictResolutionLookup = map[exp.ConflictResolution][]byte{
+		exp.IgnoreConflictResolution: []byte("INSERT OR IGNORE INTO"),
+	}
+	caps := opts.Capabilities()
+	dcs.True(caps.InsertOrIgnore)
+	dcs.False(caps.InsertOrReplace)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_selectFragments() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsQualify = true
+	opts.SupportsAsOfSystemTime = true
+	caps := opts.Capabilities()
+	// the clauses are only supported when they are included in the SelectSQLOrder
+	dcs.False(caps.Qualify)
+	dcs.False(caps.AsOfSystemTime)
+	dcs.False(caps.Final)
+	dcs.False(caps.ConnectBy)
+
+	opts.SelectSQLOrder = append(
+		opts.SelectSQLOrder,
+		sqlgen.QualifySQLFragment,
+		sqlgen.AsOfSystemTimeSQLFragment,
+		sqlgen.FinalSQLFragment,
+		sqlgen.SampleSQLFragment,
+		sqlgen.PrewhereSQLFragment,
+		sqlgen.SettingsSQLFragment,
+		sqlgen.ConnectBySQLFragment,
+	)
+	caps = opts.Capabilities()
+	dcs.True(caps.Qualify)
+	dcs.True(caps.AsOfSystemTime)
+	dcs.True(caps.Final)
+	dcs.True(caps.Sample)
+	dcs.True(caps.Prewhere)
+	dcs.True(caps.Settings)
+	dcs.True(caps.ConnectBy)
+
+	opts.SupportsQualify = false
+	dcs.False(opts.Capabilities().Qualify)
+}
+
+func (dcs *dialectCapabilitiesSuite) TestCapabilities_maxIdentifierLength() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.MaxIdentifierLength = 31
+	dcs.Equal(31, opts.Capabilities().MaxIdentifierLength)
+}
+
+func TestDialectCapabilities(t *testing.T) {
+	suite.Run(t, new(dialectCapabilitiesSuite))
+}
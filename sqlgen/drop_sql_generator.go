@@ -0,0 +1,164 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	DropSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.DropClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	dropSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var errNoNamesForDrop = errors.New("no names found when generating drop sql")
+
+func errDropFeatureNotSupported(dialect string, t exp.DropObjectType, feature string) error {
+	return errors.New("dialect does not support %s in DROP %s [dialect=%s]", feature, t, dialect)
+}
+
+func errNoTableForDropIndex(dialect string) error {
+	return errors.New("a table is required when dropping an index [dialect=%s]", dialect)
+}
+
+func errNoTableForDropTrigger(dialect string) error {
+	return errors.New("a table is required when dropping a trigger [dialect=%s]", dialect)
+}
+
+func errMultipleDropIndexNotSupported(dialect string) error {
+	return errors.New("dialect does not support dropping multiple indexes with a table [dialect=%s]", dialect)
+}
+
+func errDropObjectNotSupported(dialect string, t exp.DropObjectType) error {
+	return errors.New("dialect does not support DROP %s [dialect=%s]", t, dialect)
+}
+
+func NewDropSQLGenerator(dialect string, do *SQLDialectOptions) DropSQLGenerator {
+	return &dropSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (dsg *dropSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.DropClauses) {
+	if !clauses.HasNames() {
+		b.SetError(errNoNamesForDrop)
+		return
+	}
+	for _, f := range dsg.DialectOptions().DropSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case DropSQLFragment:
+			dsg.DropSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("DROP", f))
+		}
+	}
+}
+
+// Generates a DROP statement
+func (dsg *dropSQLGenerator) DropSQL(b sb.SQLBuilder, clauses exp.DropClauses) {
+	do := dsg.DialectOptions()
+	fragment := dsg.dropFragment(clauses.ObjectType())
+	if fragment == nil {
+		b.SetError(errDropObjectNotSupported(dsg.Dialect(), clauses.ObjectType()))
+		return
+	}
+	if !dsg.checkSupported(b, clauses) {
+		return
+	}
+	b.Write(fragment)
+	if clauses.IsConcurrently() {
+		b.Write(do.ConcurrentlyFragment)
+	}
+	if clauses.IsIfExists() {
+		b.Write(do.IfExistsFragment)
+	}
+	dsg.ExpressionSQLGenerator().Generate(b, clauses.Names())
+	if dsg.requiresTable(clauses.ObjectType()) {
+		b.Write(do.OnFragment)
+		dsg.ExpressionSQLGenerator().Generate(b, clauses.Table())
+	}
+	if opts := clauses.Options(); opts.Cascade {
+		b.Write(do.CascadeFragment)
+	} else if opts.Restrict {
+		b.Write(do.RestrictFragment)
+	}
+}
+
+// an object type is not supported by the dialect if the fragment used to drop it is nil
+func (dsg *dropSQLGenerator) dropFragment(t exp.DropObjectType) []byte {
+	do := dsg.DialectOptions()
+	switch t {
+	case exp.TableDropObject:
+		return do.DropTableFragment
+	case exp.ViewDropObject:
+		return do.DropViewFragment
+	case exp.MaterializedViewDropObject:
+		return do.DropMaterializedViewFragment
+	case exp.SequenceDropObject:
+		return do.DropSequenceFragment
+	case exp.SchemaDropObject:
+		return do.DropSchemaFragment
+	case exp.DatabaseDropObject:
+		return do.DropDatabaseFragment
+	case exp.TriggerDropObject:
+		return do.DropTriggerFragment
+	case exp.FunctionDropObject:
+		return do.DropFunctionFragment
+	case exp.IndexDropObject:
+		return do.DropIndexFragment
+	}
+	return nil
+}
+
+// returns true if the table of the object is written after its name (e.g. DROP TRIGGER "a" ON "b")
+func (dsg *dropSQLGenerator) requiresTable(t exp.DropObjectType) bool {
+	do := dsg.DialectOptions()
+	return (t == exp.IndexDropObject && do.DropIndexRequiresTable) || (t == exp.TriggerDropObject && do.DropTriggerRequiresTable)
+}
+
+func (dsg *dropSQLGenerator) checkSupported(b sb.SQLBuilder, clauses exp.DropClauses) bool {
+	do := dsg.DialectOptions()
+	t := clauses.ObjectType()
+	isIndex := t == exp.IndexDropObject
+	supportsIfExists := do.SupportsDropIfExists
+	if isIndex {
+		supportsIfExists = do.SupportsDropIndexIfExists
+	}
+	opts := clauses.Options()
+	isMultiple := len(clauses.Names().Columns()) > 1
+	// databases are dropped one at a time and do not support CASCADE or RESTRICT
+	isDatabase := t == exp.DatabaseDropObject
+	// triggers are dropped one at a time because most dialects only support dropping a single trigger
+	isTrigger := t == exp.TriggerDropObject
+	switch {
+	case clauses.IsConcurrently() && !(isIndex && do.SupportsConcurrentIndex):
+		b.SetError(errDropFeatureNotSupported(dsg.Dialect(), t, "CONCURRENTLY"))
+	case clauses.IsIfExists() && !supportsIfExists:
+		b.SetError(errDropFeatureNotSupported(dsg.Dialect(), t, "IF EXISTS"))
+	case (opts.Cascade || opts.Restrict) && (!do.SupportsDropCascade || isDatabase):
+		b.SetError(errDropFeatureNotSupported(dsg.Dialect(), t, "CASCADE or RESTRICT"))
+	case isMultiple && (!do.SupportsMultipleDropObjects || isDatabase || isTrigger):
+		b.SetError(errDropFeatureNotSupported(dsg.Dialect(), t, "multiple names"))
+	case isIndex && do.DropIndexRequiresTable && clauses.Table() == nil:
+		b.SetError(errNoTableForDropIndex(dsg.Dialect()))
+	case isIndex && do.DropIndexRequiresTable && isMultiple:
+		b.SetError(errMultipleDropIndexNotSupported(dsg.Dialect()))
+	case isTrigger && do.DropTriggerRequiresTable && clauses.Table() == nil:
+		b.SetError(errNoTableForDropTrigger(dsg.Dialect()))
+
+	default:
+		return true
+	}
+	return false
+}
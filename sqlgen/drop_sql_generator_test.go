@@ -0,0 +1,302 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	dropTestCase struct {
+		clause exp.DropClauses
+		sql    string
+		err    string
+	}
+	dropSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (dsgs *dropSQLGeneratorSuite) assertCases(dsg sqlgen.DropSQLGenerator, testCases ...dropTestCase) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		dsg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			dsgs.assertErrorSQL(b, tc.err)
+		} else {
+			dsgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (dsgs *dropSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewDropSQLGenerator("test", opts)
+	dsgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewDropSQLGenerator("test2", opts2)
+	dsgs.Equal("test2", d2.Dialect())
+}
+
+func (dsgs *dropSQLGeneratorSuite) TestGenerate() {
+	dc := exp.NewDropClauses().
+		SetObjectType(exp.TableDropObject).
+		SetNames(exp.NewColumnListExpression("a"))
+
+	dsgs.assertCases(
+		sqlgen.NewDropSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		dropTestCase{clause: dc, sql: `DROP TABLE "a"`},
+		dropTestCase{
+			clause: dc.SetNames(exp.NewColumnListExpression("s.a", "b")),
+			sql:    `DROP TABLE "s"."a", "b"`,
+		},
+		dropTestCase{clause: dc.SetIfExists(true), sql: `DROP TABLE IF EXISTS "a"`},
+		dropTestCase{clause: dc.SetOptions(exp.DropOptions{Cascade: true}), sql: `DROP TABLE "a" CASCADE`},
+		dropTestCase{clause: dc.SetOptions(exp.DropOptions{Restrict: true}), sql: `DROP TABLE "a" RESTRICT`},
+		dropTestCase{
+			clause: dc.SetIfExists(true).SetOptions(exp.DropOptions{Cascade: true, Restrict: true}),
+			sql:    `DROP TABLE IF EXISTS "a" CASCADE`,
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.ViewDropObject).SetIfExists(true).SetOptions(exp.DropOptions{Cascade: true}),
+			sql:    `DROP VIEW IF EXISTS "a" CASCADE`,
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.MaterializedViewDropObject).SetIfExists(true),
+			sql:    `DROP MATERIALIZED VIEW IF EXISTS "a"`,
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.SequenceDropObject).SetIfExists(true).SetOptions(exp.DropOptions{Cascade: true}),
+			sql:    `DROP SEQUENCE IF EXISTS "a" CASCADE`,
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.SchemaDropObject).SetNames(exp.NewColumnListExpression("a", "b")).
+				SetIfExists(true).SetOptions(exp.DropOptions{Cascade: true}),
+			sql: `DROP SCHEMA IF EXISTS "a", "b" CASCADE`,
+		},
+		dropTestCase{clause: dc.SetObjectType(exp.DatabaseDropObject).SetIfExists(true), sql: `DROP DATABASE IF EXISTS "a"`},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.IndexDropObject).SetOptions(exp.DropOptions{Restrict: true}),
+			sql:    `DROP INDEX "a" RESTRICT`,
+		},
+
+		dropTestCase{
+			clause: dc.SetConcurrently(true),
+			err:    "goqu: dialect does not support CONCURRENTLY in DROP TABLE [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.ViewDropObject).SetConcurrently(true),
+			err:    "goqu: dialect does not support CONCURRENTLY in DROP VIEW [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.DatabaseDropObject).SetOptions(exp.DropOptions{Cascade: true}),
+			err:    "goqu: dialect does not support CASCADE or RESTRICT in DROP DATABASE [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.DatabaseDropObject).SetNames(exp.NewColumnListExpression("a", "b")),
+			err:    "goqu: dialect does not support multiple names in DROP DATABASE [dialect=test]",
+		},
+	)
+}
+
+func (dsgs *dropSQLGeneratorSuite) TestGenerate_DropIndex() {
+	dc := exp.NewDropClauses().
+		SetObjectType(exp.IndexDropObject).
+		SetNames(exp.NewColumnListExpression("a_idx"))
+
+	dsgs.assertCases(
+		sqlgen.NewDropSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		dropTestCase{clause: dc, sql: `DROP INDEX "a_idx"`},
+		dropTestCase{
+			clause: dc.SetNames(exp.NewColumnListExpression("s.a_idx", "b_idx")),
+			sql:    `DROP INDEX "s"."a_idx", "b_idx"`,
+		},
+		dropTestCase{clause: dc.SetIfExists(true), sql: `DROP INDEX IF EXISTS "a_idx"`},
+		dropTestCase{clause: dc.SetConcurrently(true), sql: `DROP INDEX CONCURRENTLY "a_idx"`},
+		dropTestCase{
+			clause: dc.SetConcurrently(true).SetIfExists(true),
+			sql:    `DROP INDEX CONCURRENTLY IF EXISTS "a_idx"`,
+		},
+		// the table is ignored by dialects that do not drop the index of a table
+		dropTestCase{clause: dc.SetTable(exp.ParseIdentifier("a")), sql: `DROP INDEX "a_idx"`},
+
+		dropTestCase{
+			clause: exp.NewDropClauses(),
+			err:    "goqu: no names found when generating drop sql",
+		},
+	)
+}
+
+func (dsgs *dropSQLGeneratorSuite) TestGenerate_DropIndexRequiresTable() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.DropIndexRequiresTable = true
+	dc := exp.NewDropClauses().
+		SetObjectType(exp.IndexDropObject).
+		SetNames(exp.NewColumnListExpression("a_idx"))
+
+	dsgs.assertCases(
+		sqlgen.NewDropSQLGenerator("test", opts),
+		dropTestCase{clause: dc.SetTable(exp.ParseIdentifier("a")), sql: `DROP INDEX "a_idx" ON "a"`},
+		dropTestCase{
+			clause: dc,
+			err:    "goqu: a table is required when dropping an index [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetTable(exp.ParseIdentifier("a")).SetNames(exp.NewColumnListExpression("a_idx", "b_idx")),
+			err:    "goqu: dialect does not support dropping multiple indexes with a table [dialect=test]",
+		},
+	)
+}
+
+func (dsgs *dropSQLGeneratorSuite) TestGenerate_DropTrigger() {
+	dc := exp.NewDropClauses().
+		SetObjectType(exp.TriggerDropObject).
+		SetNames(exp.NewColumnListExpression("a_trigger"))
+
+	dsgs.assertCases(
+		sqlgen.NewDropSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		dropTestCase{clause: dc.SetTable(exp.ParseIdentifier("a")), sql: `DROP TRIGGER "a_trigger" ON "a"`},
+		dropTestCase{
+			clause: dc.SetTable(exp.ParseIdentifier("a")).SetIfExists(true).SetOptions(exp.DropOptions{Cascade: true}),
+			sql:    `DROP TRIGGER IF EXISTS "a_trigger" ON "a" CASCADE`,
+		},
+		dropTestCase{clause: dc, err: "goqu: a table is required when dropping a trigger [dialect=test]"},
+		dropTestCase{
+			clause: dc.SetTable(exp.ParseIdentifier("a")).SetNames(exp.NewColumnListExpression("a_trigger", "b_trigger")),
+			err:    "goqu: dialect does not support multiple names in DROP TRIGGER [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.FunctionDropObject).SetNames(exp.NewColumnListExpression("a_fn", "b_fn")).
+				SetIfExists(true),
+			sql: `DROP FUNCTION IF EXISTS "a_fn", "b_fn"`,
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.DropTriggerRequiresTable = false
+	dsgs.assertCases(
+		sqlgen.NewDropSQLGenerator("test", opts),
+		dropTestCase{clause: dc, sql: `DROP TRIGGER "a_trigger"`},
+		dropTestCase{clause: dc.SetTable(exp.ParseIdentifier("a")), sql: `DROP TRIGGER "a_trigger"`},
+	)
+}
+
+func (dsgs *dropSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsConcurrentIndex = false
+	opts.SupportsDropIndexIfExists = false
+	dc := exp.NewDropClauses().
+		SetObjectType(exp.IndexDropObject).
+		SetNames(exp.NewColumnListExpression("a_idx"))
+
+	dsgs.assertCases(
+		sqlgen.NewDropSQLGenerator("test", opts),
+		dropTestCase{
+			clause: dc.SetConcurrently(true),
+			err:    "goqu: dialect does not support CONCURRENTLY in DROP INDEX [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetIfExists(true),
+			err:    "goqu: dialect does not support IF EXISTS in DROP INDEX [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.DropObjectType(100)),
+			err:    "goqu: dialect does not support DROP 100 [dialect=test]",
+		},
+	)
+}
+
+func (dsgs *dropSQLGeneratorSuite) TestGenerate_WithUnsupportedOptions() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsDropIfExists = false
+	opts.SupportsDropCascade = false
+	opts.SupportsMultipleDropObjects = false
+	opts.DropViewFragment = nil
+	opts.DropMaterializedViewFragment = nil
+	opts.DropSequenceFragment = nil
+	opts.DropSchemaFragment = nil
+	opts.DropDatabaseFragment = nil
+	opts.DropTriggerFragment = nil
+	opts.DropFunctionFragment = nil
+	dc := exp.NewDropClauses().
+		SetObjectType(exp.TableDropObject).
+		SetNames(exp.NewColumnListExpression("a"))
+
+	dsgs.assertCases(
+		sqlgen.NewDropSQLGenerator("test", opts),
+		dropTestCase{clause: dc, sql: `DROP TABLE "a"`},
+		// IF EXISTS of indexes is configured with SupportsDropIndexIfExists
+		dropTestCase{clause: dc.SetObjectType(exp.IndexDropObject).SetIfExists(true), sql: `DROP INDEX IF EXISTS "a"`},
+		dropTestCase{
+			clause: dc.SetIfExists(true),
+			err:    "goqu: dialect does not support IF EXISTS in DROP TABLE [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetOptions(exp.DropOptions{Cascade: true}),
+			err:    "goqu: dialect does not support CASCADE or RESTRICT in DROP TABLE [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetOptions(exp.DropOptions{Restrict: true}),
+			err:    "goqu: dialect does not support CASCADE or RESTRICT in DROP TABLE [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetNames(exp.NewColumnListExpression("a", "b")),
+			err:    "goqu: dialect does not support multiple names in DROP TABLE [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.ViewDropObject),
+			err:    "goqu: dialect does not support DROP VIEW [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.MaterializedViewDropObject),
+			err:    "goqu: dialect does not support DROP MATERIALIZED VIEW [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.SequenceDropObject),
+			err:    "goqu: dialect does not support DROP SEQUENCE [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.SchemaDropObject),
+			err:    "goqu: dialect does not support DROP SCHEMA [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.DatabaseDropObject),
+			err:    "goqu: dialect does not support DROP DATABASE [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.TriggerDropObject),
+			err:    "goqu: dialect does not support DROP TRIGGER [dialect=test]",
+		},
+		dropTestCase{
+			clause: dc.SetObjectType(exp.FunctionDropObject),
+			err:    "goqu: dialect does not support DROP FUNCTION [dialect=test]",
+		},
+	)
+}
+
+func (dsgs *dropSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.DropSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	dc := exp.NewDropClauses().SetNames(exp.NewColumnListExpression("a_idx"))
+	dsgs.assertCases(
+		sqlgen.NewDropSQLGenerator("test", opts),
+		dropTestCase{clause: dc, err: "goqu: unsupported DROP SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (dsgs *dropSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewDropSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewDropClauses().SetNames(exp.NewColumnListExpression("a_idx")))
+	dsgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestDropSQLGenerator(t *testing.T) {
+	suite.Run(t, new(dropSQLGeneratorSuite))
+}
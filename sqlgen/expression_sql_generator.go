@@ -1,9 +1,13 @@
 package sqlgen
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -40,8 +44,39 @@ var (
 	)
 	ErrUnexpectedNamedWindow = errors.New(`unexpected named window function`)
 	ErrEmptyCaseWhens        = errors.New(`when conditions not found for case statement`)
+
+	errEmptyValuesList          = errors.New("at least one row is required when generating a VALUES list")
+	errMismatchedValuesListRows = errors.New("rows in a VALUES list must have the same number of values")
+	errPivotAggregateRequired   = errors.New("at least one aggregate is required for PIVOT")
+	errPivotForInRequired       = errors.New("a FOR column and at least one IN value are required for PIVOT and UNPIVOT")
+	errXMLTableColumnsRequired  = errors.New("at least one column is required for XMLTABLE")
+	errTableFunctionRequired    = errors.New("at least one function is required for a table function")
 )
 
+// The common type names that are mapped to the DataTypeLookup of the dialect when used in a CAST (e.g.
+// Cast("timestamptz") -> CAST(... AS DATETIMEOFFSET) on sqlserver)
+var castTypeKinds = map[string]exp.DataTypeKind{
+	"smallint":    exp.SmallIntDataType,
+	"integer":     exp.IntegerDataType,
+	"int":         exp.IntegerDataType,
+	"bigint":      exp.BigIntDataType,
+	"decimal":     exp.DecimalDataType,
+	"real":        exp.RealDataType,
+	"double":      exp.DoubleDataType,
+	"boolean":     exp.BooleanDataType,
+	"bool":        exp.BooleanDataType,
+	"char":        exp.CharDataType,
+	"varchar":     exp.VarcharDataType,
+	"text":        exp.TextDataType,
+	"date":        exp.DateDataType,
+	"time":        exp.TimeDataType,
+	"timestamp":   exp.TimestampDataType,
+	"timestamptz": exp.TimestampTzDataType,
+	"binary":      exp.BinaryDataType,
+	"uuid":        exp.UUIDDataType,
+	"json":        exp.JSONDataType,
+}
+
 func errUnsupportedExpressionType(e exp.Expression) error {
 	return errors.New("unsupported expression type %T", e)
 }
@@ -62,10 +97,242 @@ func errUnsupportedRangeExpressionOperator(op exp.RangeOperation) error {
 	return errors.New("range operator %+v not supported", op)
 }
 
+func errAggregateOrderByNotSupported(dialect string) error {
+	return errors.New("dialect does not support ORDER BY in aggregate functions [dialect=%s]", dialect)
+}
+
+func errWindowFrameGroupsNotSupported(dialect string) error {
+	return errors.New("dialect does not support GROUPS window frames [dialect=%s]", dialect)
+}
+
+var errWindowFrameStartRequired = errors.New("a start boundary is required for a window frame")
+
+func errWindowFrameExclusionNotSupported(dialect string) error {
+	return errors.New("dialect does not support window frame exclusion [dialect=%s]", dialect)
+}
+
+func errGroupingNotSupported(dialect string, t exp.GroupingType) error {
+	return errors.New("dialect does not support %s [dialect=%s]", t, dialect)
+}
+
+func errCompoundTypeNotSupported(dialect string, t exp.CompoundType) error {
+	return errors.New("dialect does not support %s [dialect=%s]", t, dialect)
+}
+
+func errCTEMaterializationNotSupported(dialect string, m exp.CTEMaterialization) error {
+	return errors.New("dialect does not support %s common table expressions [dialect=%s]", m, dialect)
+}
+
+func errCTESearchNotSupported(dialect string) error {
+	return errors.New("dialect does not support SEARCH clause on common table expressions [dialect=%s]", dialect)
+}
+
+func errCTESearchModeNotSupported(dialect string, m exp.CTESearchMode) error {
+	return errors.New("dialect does not support SEARCH %s [dialect=%s]", m, dialect)
+}
+
+func errCTECycleNotSupported(dialect string) error {
+	return errors.New("dialect does not support CYCLE clause on common table expressions [dialect=%s]", dialect)
+}
+
+var (
+	errCTESearchCycleRequiresRecursive = errors.New("SEARCH and CYCLE clauses require a recursive common table expression")
+	errCTESetColumnRequired            = errors.New("a SET column is required for SEARCH and CYCLE clauses")
+)
+
+func errPivotNotSupported(dialect string) error {
+	return errors.New("dialect does not support PIVOT [dialect=%s]", dialect)
+}
+
+func errUnpivotNotSupported(dialect string) error {
+	return errors.New("dialect does not support UNPIVOT [dialect=%s]", dialect)
+}
+
+func errUnpivotIncludeNullsNotSupported(dialect string) error {
+	return errors.New("dialect does not support UNPIVOT INCLUDE NULLS [dialect=%s]", dialect)
+}
+
+func errXMLTableNotSupported(dialect string) error {
+	return errors.New("dialect does not support XMLTABLE [dialect=%s]", dialect)
+}
+
+func errTableHintNotSupported(dialect, hint string) error {
+	return errors.New("dialect does not support the %s table hint [dialect=%s]", hint, dialect)
+}
+
+func errInvalidTableHint(dialect, hint string) error {
+	return errors.New(
+		"table hint %q must only contain letters, digits, _, =, commas, spaces and balanced parentheses [dialect=%s]",
+		hint, dialect,
+	)
+}
+
+// returns true if the hint cannot end the parentheses of the table hints early (e.g. NOLOCK, INDEX(ix_a), INDEX = ix_a)
+func isValidTableHint(hint string) bool {
+	depth := 0
+	for _, r := range hint {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		case r == '_' || r == '=' || r == ',' || r == ' ',
+			r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+	return hint != "" && depth == 0
+}
+
+func errWithOrdinalityNotSupported(dialect string) error {
+	return errors.New("dialect does not support WITH ORDINALITY [dialect=%s]", dialect)
+}
+
+func errRowsFromNotSupported(dialect string) error {
+	return errors.New("dialect does not support ROWS FROM [dialect=%s]", dialect)
+}
+
+func errUnsupportedJSONOperator(dialect string, op exp.JSONOperation) error {
+	return errors.New("dialect does not support JSON operation %s [dialect=%s]", op, dialect)
+}
+
+func errUnsupportedArrayOperation(dialect string, op exp.ArrayOperation) error {
+	return errors.New("dialect does not support array operation %s [dialect=%s]", op, dialect)
+}
+
+func errArrayConstructorNotSupported(dialect string) error {
+	return errors.New("dialect does not support ARRAY constructors [dialect=%s]", dialect)
+}
+
+func errRangeTypesNotSupported(dialect string) error {
+	return errors.New("dialect does not support range types [dialect=%s]", dialect)
+}
+
+func errUnsupportedRangeTypeOperation(dialect string, op exp.RangeTypeOperation) error {
+	return errors.New("dialect does not support range operation %s [dialect=%s]", op, dialect)
+}
+
+func errInvalidRangeBounds(bounds string) error {
+	return errors.New(`invalid range bounds %q, expected "[)", "(]", "[]" or "()"`, bounds)
+}
+
+func errIntervalsNotSupported(dialect string) error {
+	return errors.New("dialect does not support intervals [dialect=%s]", dialect)
+}
+
+func errUnsupportedIntervalUnit(dialect string, unit exp.IntervalUnit) error {
+	return errors.New("dialect does not support interval unit %s [dialect=%s]", unit, dialect)
+}
+
+func errIntervalRequiresDateArithmetic(dialect string) error {
+	return errors.New("dialect only supports intervals that are added to or subtracted from a date [dialect=%s]", dialect)
+}
+
+func errOperatorNotSupported(dialect string, op exp.Operator) error {
+	return errors.New("dialect does not support operator %s [dialect=%s]", op.Name(), dialect)
+}
+
+func errFunctionArgs(dialect, name string, def exp.FunctionDefinition, got int) error {
+	var expected string
+	switch {
+	case def.MaxArgs() == exp.VariadicArgs:
+		expected = fmt.Sprintf("at least %d", def.MinArgs())
+	case def.MinArgs() == def.MaxArgs():
+		expected = fmt.Sprintf("%d", def.MinArgs())
+	default:
+		expected = fmt.Sprintf("between %d and %d", def.MinArgs(), def.MaxArgs())
+	}
+	return errors.New("function %s expects %s arguments but got %d [dialect=%s]", name, expected, got, dialect)
+}
+
+func errLikeEscapeOperator(op exp.BooleanOperation) error {
+	return errors.New("ESCAPE can only be used with LIKE and ILIKE expressions, got %s", op)
+}
+
+func errLikeEscapeNotSupported(dialect, escape string) error {
+	return errors.New(
+		"dialect does not support LIKE ESCAPE %q, only the default escape character \\ can be used [dialect=%s]",
+		escape,
+		dialect,
+	)
+}
+
+func errAtTimeZoneNotSupported(dialect string) error {
+	return errors.New("dialect does not support AT TIME ZONE [dialect=%s]", dialect)
+}
+
+func errJSONFunctionNotSupported(dialect string, fn exp.JSONFunction) error {
+	return errors.New("dialect does not support JSON function %s [dialect=%s]", fn, dialect)
+}
+
 func errLateralNotSupported(dialect string) error {
 	return errors.New("dialect does not support lateral expressions [dialect=%s]", dialect)
 }
 
+func errDerivedColumnAliasesNotSupported(dialect string) error {
+	return errors.New("dialect does not support derived table column aliases [dialect=%s]", dialect)
+}
+
+func errIdentifierTooLong(dialect, ident string, maxLength int) error {
+	return errors.New(
+		"identifier %q is longer than the maximum identifier length of %d characters [dialect=%s]", ident, maxLength, dialect,
+	)
+}
+
+func errSequenceValueNotSupported(dialect string, t exp.SequenceValueType) error {
+	return errors.New("dialect does not support the %s of a sequence [dialect=%s]", t, dialect)
+}
+
+func errRandomNotSupported(dialect string) error {
+	return errors.New("dialect does not support random values [dialect=%s]", dialect)
+}
+
+func errUnsupportedDataType(dialect string, kind exp.DataTypeKind) error {
+	return errors.New("dialect does not support data type %s [dialect=%s]", kind, dialect)
+}
+
+func errAutoIncrementNotSupported(dialect string) error {
+	return errors.New("dialect does not support auto increment columns [dialect=%s]", dialect)
+}
+
+func errColumnCommentNotSupported(dialect string) error {
+	return errors.New("dialect does not support comments in column definitions [dialect=%s]", dialect)
+}
+
+func errPartitionByNotSupported(dialect string) error {
+	return errors.New("dialect does not support PARTITION BY [dialect=%s]", dialect)
+}
+
+func errPartitionFeatureNotSupported(dialect, feature string) error {
+	return errors.New("dialect does not support %s in partitioned tables [dialect=%s]", feature, dialect)
+}
+
+func errPartitionBoundNotSupported(dialect string, t exp.PartitionBoundType) error {
+	return errors.New("dialect does not support %s partition bounds [dialect=%s]", t, dialect)
+}
+
+func errUnsupportedTableConstraintType(t exp.TableConstraintType) error {
+	return errors.New("table constraint type %d not supported", t)
+}
+
+func errTableConstraintNotSupported(dialect string, t exp.TableConstraintType) error {
+	return errors.New("dialect does not support %s constraints [dialect=%s]", t, dialect)
+}
+
+func errReferentialActionNotSupported(dialect string, action exp.ReferentialAction) error {
+	return errors.New("dialect does not support the %s referential action [dialect=%s]", action, dialect)
+}
+
+var errNoReferencedTable = errors.New("a referenced table is required for a FOREIGN KEY constraint")
+
+func errPlaceholdersNotSupported(dialect string) error {
+	return errors.New("dialect does not support placeholders, values must be interpolated [dialect=%s]", dialect)
+}
+
 func NewExpressionSQLGenerator(dialect string, do *SQLDialectOptions) ExpressionSQLGenerator {
 	return &expressionSQLGenerator{dialect: dialect, dialectOptions: do}
 }
@@ -92,6 +359,12 @@ func (esg *expressionSQLGenerator) generate(b sb.SQLBuilder, val interface{}, sl
 	switch v := val.(type) {
 	case exp.Expression:
 		esg.expressionSQL(b, v)
+	case exp.SecretValue:
+		// secrets are always bound as arguments so the value never ends up in the sql string
+		esg.placeHolderSQL(b, v)
+	case sql.Out:
+		// out parameters are always bound as arguments so the driver can set the value
+		esg.placeHolderSQL(b, v)
 	case int:
 		esg.literalInt(b, int64(v))
 	case int32:
@@ -185,12 +458,44 @@ func (esg *expressionSQLGenerator) expressionSQL(b sb.SQLBuilder, expression exp
 		esg.identifierExpressionSQL(b, e)
 	case exp.LateralExpression:
 		esg.lateralExpressionSQL(b, e)
+	case exp.ValuesExpression:
+		esg.valuesExpressionSQL(b, e)
+	case exp.PivotExpression:
+		esg.pivotExpressionSQL(b, e)
+	case exp.UnpivotExpression:
+		esg.unpivotExpressionSQL(b, e)
+	case exp.XMLTableExpression:
+		esg.xmlTableExpressionSQL(b, e)
+	case exp.TableHintExpression:
+		esg.tableHintExpressionSQL(b, e)
+	case exp.TableFunctionExpression:
+		esg.tableFunctionExpressionSQL(b, e)
 	case exp.AliasedExpression:
 		esg.aliasedExpressionSQL(b, e)
 	case exp.BooleanExpression:
 		esg.booleanExpressionSQL(b, e)
 	case exp.BitwiseExpression:
 		esg.bitwiseExpressionSQL(b, e)
+	case exp.JSONExpression:
+		esg.jsonExpressionSQL(b, e)
+	case exp.JSONFunctionExpression:
+		esg.jsonFunctionExpressionSQL(b, e)
+	case exp.ArrayExpression:
+		esg.arrayExpressionSQL(b, e)
+	case exp.ArrayConstructorExpression:
+		esg.arrayConstructorSQL(b, e)
+	case exp.RangeTypeExpression:
+		esg.rangeTypeExpressionSQL(b, e)
+	case exp.RangeLiteralExpression:
+		esg.rangeLiteralSQL(b, e)
+	case exp.IntervalExpression:
+		esg.intervalSQL(b, e)
+	case exp.DateArithmeticExpression:
+		esg.dateArithmeticSQL(b, e)
+	case exp.AtTimeZoneExpression:
+		esg.atTimeZoneSQL(b, e)
+	case exp.OperatorExpression:
+		esg.operatorExpressionSQL(b, e)
 	case exp.RangeExpression:
 		esg.rangeExpressionSQL(b, e)
 	case exp.OrderedExpression:
@@ -213,10 +518,30 @@ func (esg *expressionSQLGenerator) expressionSQL(b sb.SQLBuilder, expression exp
 		esg.compoundExpressionSQL(b, e)
 	case exp.CaseExpression:
 		esg.caseExpressionSQL(b, e)
+	case exp.CaseBuilder:
+		esg.caseExpressionSQL(b, e.ToCase())
 	case exp.Ex:
 		esg.expressionMapSQL(b, e)
 	case exp.ExOr:
 		esg.expressionOrMapSQL(b, e)
+	case exp.DataType:
+		esg.dataTypeSQL(b, e)
+	case exp.ColumnDefinition:
+		esg.columnDefinitionSQL(b, e)
+	case exp.TableConstraint:
+		esg.tableConstraintSQL(b, e)
+	case exp.PartitionBy:
+		esg.partitionBySQL(b, e)
+	case exp.PartitionBound:
+		esg.partitionBoundSQL(b, e)
+	case exp.PartitionDefinition:
+		esg.partitionDefinitionSQL(b, e)
+	case exp.SequenceValueExpression:
+		esg.sequenceValueExpressionSQL(b, e)
+	case exp.RandomExpression:
+		esg.randomExpressionSQL(b)
+	case exp.GroupingExpression:
+		esg.groupingExpressionSQL(b, e)
 	default:
 		b.SetError(errUnsupportedExpressionType(e))
 	}
@@ -224,9 +549,18 @@ func (esg *expressionSQLGenerator) expressionSQL(b sb.SQLBuilder, expression exp
 
 // Generates a placeholder (e.g. ?, $1)
 func (esg *expressionSQLGenerator) placeHolderSQL(b sb.SQLBuilder, i interface{}) {
+	if !esg.dialectOptions.SupportsPlaceholders {
+		b.SetError(errPlaceholdersNotSupported(esg.dialect))
+		return
+	}
 	b.Write(esg.dialectOptions.PlaceHolderFragment)
+	pos := strconv.FormatInt(int64(b.CurrentArgPosition()), 10)
 	if esg.dialectOptions.IncludePlaceholderNum {
-		b.WriteStrings(strconv.FormatInt(int64(b.CurrentArgPosition()), 10))
+		b.WriteStrings(pos)
+	}
+	if prefix := esg.dialectOptions.NamedArgPrefix; prefix != "" {
+		b.WriteNamedArg(prefix+pos, i)
+		return
 	}
 	b.WriteArg(i)
 }
@@ -239,7 +573,21 @@ func (esg *expressionSQLGenerator) appendableExpressionSQL(b sb.SQLBuilder, a ex
 	if a.GetAs() != nil {
 		b.Write(esg.dialectOptions.AsFragment)
 		esg.Generate(b, a.GetAs())
+		if ac, ok := a.(exp.AliasedColumnsExpression); ok && ac.GetAsColumns() != nil {
+			esg.derivedColumnAliasesSQL(b, ac.GetAsColumns())
+		}
+	}
+}
+
+// Generates the column aliases for a derived table (e.g. "t"("a", "b"))
+func (esg *expressionSQLGenerator) derivedColumnAliasesSQL(b sb.SQLBuilder, cols exp.ColumnListExpression) {
+	if !esg.dialectOptions.SupportsDerivedColumnAliases {
+		b.SetError(errDerivedColumnAliasesNotSupported(esg.dialect))
+		return
 	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, cols)
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
 // Quotes an identifier (e.g. "col", "table"."col"
@@ -250,17 +598,13 @@ func (esg *expressionSQLGenerator) identifierExpressionSQL(b sb.SQLBuilder, iden
 	}
 	schema, table, col := ident.GetSchema(), ident.GetTable(), ident.GetCol()
 	if schema != esg.dialectOptions.EmptyString {
-		b.WriteRunes(esg.dialectOptions.QuoteRune).
-			WriteStrings(schema).
-			WriteRunes(esg.dialectOptions.QuoteRune)
+		esg.quoteIdentifier(b, schema)
 	}
 	if table != esg.dialectOptions.EmptyString {
 		if schema != esg.dialectOptions.EmptyString {
 			b.WriteRunes(esg.dialectOptions.PeriodRune)
 		}
-		b.WriteRunes(esg.dialectOptions.QuoteRune).
-			WriteStrings(table).
-			WriteRunes(esg.dialectOptions.QuoteRune)
+		esg.quoteIdentifier(b, table)
 	}
 	switch t := col.(type) {
 	case nil:
@@ -269,9 +613,7 @@ func (esg *expressionSQLGenerator) identifierExpressionSQL(b sb.SQLBuilder, iden
 			if table != esg.dialectOptions.EmptyString || schema != esg.dialectOptions.EmptyString {
 				b.WriteRunes(esg.dialectOptions.PeriodRune)
 			}
-			b.WriteRunes(esg.dialectOptions.QuoteRune).
-				WriteStrings(t).
-				WriteRunes(esg.dialectOptions.QuoteRune)
+			esg.quoteIdentifier(b, t)
 		}
 	case exp.LiteralExpression:
 		if table != esg.dialectOptions.EmptyString || schema != esg.dialectOptions.EmptyString {
@@ -283,6 +625,19 @@ func (esg *expressionSQLGenerator) identifierExpressionSQL(b sb.SQLBuilder, iden
 	}
 }
 
+func (esg *expressionSQLGenerator) quoteIdentifier(b sb.SQLBuilder, ident string) {
+	if maxLength := esg.dialectOptions.MaxIdentifierLength; maxLength > 0 && utf8.RuneCountInString(ident) > maxLength {
+		b.SetError(errIdentifierTooLong(esg.dialect, ident, maxLength))
+		return
+	}
+	if esg.dialectOptions.UpperCaseIdentifiers {
+		ident = strings.ToUpper(ident)
+	}
+	b.WriteRunes(esg.dialectOptions.QuoteRune).
+		WriteStrings(ident).
+		WriteRunes(esg.dialectOptions.QuoteRune)
+}
+
 func (esg *expressionSQLGenerator) lateralExpressionSQL(b sb.SQLBuilder, le exp.LateralExpression) {
 	if !esg.dialectOptions.SupportsLateral {
 		b.SetError(errLateralNotSupported(esg.dialect))
@@ -292,6 +647,266 @@ func (esg *expressionSQLGenerator) lateralExpressionSQL(b sb.SQLBuilder, le exp.
 	esg.Generate(b, le.Table())
 }
 
+// Generates the sql for a PIVOT table operator (e.g. "sales" PIVOT (SUM("amount") FOR "month" IN ('JAN', 'FEB')))
+func (esg *expressionSQLGenerator) pivotExpressionSQL(b sb.SQLBuilder, pe exp.PivotExpression) {
+	if esg.dialectOptions.PivotFragment == nil {
+		b.SetError(errPivotNotSupported(esg.dialect))
+		return
+	}
+	aggregates := pe.Aggregates()
+	if len(aggregates) == 0 {
+		b.SetError(errPivotAggregateRequired)
+		return
+	}
+	if pe.ForColumn() == nil || len(pe.InValues()) == 0 {
+		b.SetError(errPivotForInRequired)
+		return
+	}
+	esg.Generate(b, pe.Table())
+	b.Write(esg.dialectOptions.PivotFragment).WriteRunes(esg.dialectOptions.LeftParenRune)
+	for i, agg := range aggregates {
+		if i > 0 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		esg.Generate(b, agg)
+	}
+	esg.pivotForInSQL(b, pe.ForColumn(), pe.InValues())
+}
+
+// Generates the sql for an UNPIVOT table operator (e.g. "sales" UNPIVOT ("amount" FOR "month" IN ("jan", "feb")))
+func (esg *expressionSQLGenerator) unpivotExpressionSQL(b sb.SQLBuilder, ue exp.UnpivotExpression) {
+	if esg.dialectOptions.UnpivotFragment == nil {
+		b.SetError(errUnpivotNotSupported(esg.dialect))
+		return
+	}
+	if ue.ForColumn() == nil || len(ue.InColumns()) == 0 {
+		b.SetError(errPivotForInRequired)
+		return
+	}
+	esg.Generate(b, ue.Table())
+	b.Write(esg.dialectOptions.UnpivotFragment)
+	if ue.IsIncludeNulls() {
+		if esg.dialectOptions.UnpivotIncludeNullsFragment == nil {
+			b.SetError(errUnpivotIncludeNullsNotSupported(esg.dialect))
+			return
+		}
+		b.Write(esg.dialectOptions.UnpivotIncludeNullsFragment)
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, ue.ValueColumn())
+	esg.pivotForInSQL(b, ue.ForColumn(), ue.InColumns())
+}
+
+// Generates the sql for a set returning function used as a table
+//
+//	generate_series(1, 10) WITH ORDINALITY
+//	ROWS FROM (generate_series(1, 3), unnest('{a,b}')) WITH ORDINALITY
+func (esg *expressionSQLGenerator) tableFunctionExpressionSQL(b sb.SQLBuilder, tf exp.TableFunctionExpression) {
+	do := esg.dialectOptions
+	if tf.IsWithOrdinality() && do.WithOrdinalityFragment == nil {
+		b.SetError(errWithOrdinalityNotSupported(esg.dialect))
+		return
+	}
+	fns := tf.Functions()
+	if len(fns) == 0 {
+		b.SetError(errTableFunctionRequired)
+		return
+	}
+	if tf.IsRowsFrom() {
+		if do.RowsFromFragment == nil {
+			b.SetError(errRowsFromNotSupported(esg.dialect))
+			return
+		}
+		b.Write(do.RowsFromFragment).WriteRunes(do.LeftParenRune)
+		for i, fn := range fns {
+			if i > 0 {
+				b.WriteRunes(do.CommaRune, do.SpaceRune)
+			}
+			esg.Generate(b, fn)
+		}
+		b.WriteRunes(do.RightParenRune)
+	} else {
+		esg.Generate(b, fns[0])
+	}
+	if tf.IsWithOrdinality() {
+		b.Write(do.WithOrdinalityFragment)
+	}
+}
+
+// Generates the sql for a table reference with hints
+//
+//	ONLY "parent"
+//	`a` AS `b` USE INDEX (`idx_a`)
+//	"a" WITH (NOLOCK)
+func (esg *expressionSQLGenerator) tableHintExpressionSQL(b sb.SQLBuilder, th exp.TableHintExpression) {
+	do := esg.dialectOptions
+	if th.IsOnly() {
+		if do.TableOnlyFragment == nil {
+			b.SetError(errTableHintNotSupported(esg.dialect, "ONLY"))
+			return
+		}
+		b.Write(do.TableOnlyFragment)
+	}
+	esg.Generate(b, th.Table())
+	for _, ih := range th.IndexHints() {
+		var fragment []byte
+		var hint string
+		switch ih.Type {
+		case exp.UseIndexHint:
+			fragment, hint = do.UseIndexFragment, "USE INDEX"
+		case exp.ForceIndexHint:
+			fragment, hint = do.ForceIndexFragment, "FORCE INDEX"
+		case exp.IgnoreIndexHint:
+			fragment, hint = do.IgnoreIndexFragment, "IGNORE INDEX"
+		}
+		if fragment == nil {
+			b.SetError(errTableHintNotSupported(esg.dialect, hint))
+			return
+		}
+		b.Write(fragment).WriteRunes(do.LeftParenRune)
+		for i, idx := range ih.Indexes {
+			if i > 0 {
+				b.WriteRunes(do.CommaRune, do.SpaceRune)
+			}
+			esg.Generate(b, exp.NewIdentifierExpression("", "", idx))
+		}
+		b.WriteRunes(do.RightParenRune)
+	}
+	if hints := th.TableHints(); len(hints) > 0 {
+		if do.TableHintsFragment == nil {
+			b.SetError(errTableHintNotSupported(esg.dialect, "WITH"))
+			return
+		}
+		for _, hint := range hints {
+			if !isValidTableHint(hint) {
+				b.SetError(errInvalidTableHint(esg.dialect, hint))
+				return
+			}
+		}
+		b.Write(do.TableHintsFragment).
+			WriteRunes(do.LeftParenRune).
+			WriteStrings(strings.Join(hints, ", ")).
+			WriteRunes(do.RightParenRune)
+	}
+}
+
+// Generates the FOR ... IN (...) of a PIVOT or UNPIVOT and closes the parens. The IN list is always interpolated
+// because the values must be constants.
+func (esg *expressionSQLGenerator) pivotForInSQL(b sb.SQLBuilder, forCol exp.IdentifierExpression, in []interface{}) {
+	b.Write(esg.dialectOptions.PivotForFragment)
+	esg.Generate(b, forCol)
+	nb := sb.NewSQLBuilder(false)
+	for i, val := range in {
+		if i > 0 {
+			nb.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		esg.Generate(nb, val)
+	}
+	inSQL, _, err := nb.ToSQL()
+	if err != nil {
+		b.SetError(err)
+		return
+	}
+	b.Write(esg.dialectOptions.PivotInFragment).
+		WriteRunes(esg.dialectOptions.LeftParenRune).
+		WriteStrings(inSQL).
+		WriteRunes(esg.dialectOptions.RightParenRune, esg.dialectOptions.RightParenRune)
+}
+
+// Generates the sql for an XMLTABLE, the row path and the paths of the columns are always interpolated because they
+// must be constants
+//
+//	XMLTABLE('/rows/row' PASSING "data" COLUMNS "id" INTEGER PATH '@id', "n" FOR ORDINALITY)
+func (esg *expressionSQLGenerator) xmlTableExpressionSQL(b sb.SQLBuilder, xt exp.XMLTableExpression) {
+	do := esg.dialectOptions
+	if do.XMLTableFragment == nil {
+		b.SetError(errXMLTableNotSupported(esg.dialect))
+		return
+	}
+	cols := xt.TableColumns()
+	if len(cols) == 0 {
+		b.SetError(errXMLTableColumnsRequired)
+		return
+	}
+	b.Write(do.XMLTableFragment).WriteRunes(do.LeftParenRune)
+	esg.interpolatedSQL(b, xt.RowPath())
+	b.Write(do.XMLTablePassingFragment)
+	esg.Generate(b, xt.Document())
+	b.Write(do.XMLTableColumnsFragment)
+	for i, col := range cols {
+		if i > 0 {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		esg.Generate(b, exp.NewIdentifierExpression("", "", col.Name()))
+		if col.IsForOrdinality() {
+			b.Write(do.XMLTableForOrdinalityFragment)
+			continue
+		}
+		b.WriteRunes(do.SpaceRune)
+		esg.Generate(b, col.DataType())
+		if path := col.PathExpression(); path != "" {
+			b.Write(do.XMLTablePathFragment)
+			esg.interpolatedSQL(b, path)
+		}
+		if col.HasDefault() {
+			b.Write(do.ColumnDefaultFragment)
+			esg.Generate(b, col.DefaultValue())
+		}
+	}
+	b.WriteRunes(do.RightParenRune)
+}
+
+// Writes the interpolated sql of a value even when generating a prepared statement
+func (esg *expressionSQLGenerator) interpolatedSQL(b sb.SQLBuilder, val interface{}) {
+	nb := sb.NewSQLBuilder(false)
+	esg.Generate(nb, val)
+	sql, _, err := nb.ToSQL()
+	if err != nil {
+		b.SetError(err)
+		return
+	}
+	b.WriteStrings(sql)
+}
+
+// Generates the sql for a ROLLUP, CUBE or GROUPING SETS used in a GROUP BY clause
+func (esg *expressionSQLGenerator) groupingExpressionSQL(b sb.SQLBuilder, ge exp.GroupingExpression) {
+	var fragment []byte
+	switch ge.GroupingType() {
+	case exp.RollupGrouping:
+		fragment = esg.dialectOptions.RollupFragment
+		if len(fragment) == 0 && len(esg.dialectOptions.WithRollupFragment) > 0 {
+			esg.Generate(b, ge.Sets()[0])
+			b.Write(esg.dialectOptions.WithRollupFragment)
+			return
+		}
+	case exp.CubeGrouping:
+		fragment = esg.dialectOptions.CubeFragment
+	case exp.GroupingSetsGrouping:
+		fragment = esg.dialectOptions.GroupingSetsFragment
+	}
+	if len(fragment) == 0 {
+		b.SetError(errGroupingNotSupported(esg.dialect, ge.GroupingType()))
+		return
+	}
+	b.Write(fragment)
+	if ge.GroupingType() != exp.GroupingSetsGrouping {
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, ge.Sets()[0])
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	for i, set := range ge.Sets() {
+		if i > 0 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, set)
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
 // Generates SQL NULL value
 func (esg *expressionSQLGenerator) literalNil(b sb.SQLBuilder) {
 	if b.IsPrepared() {
@@ -304,7 +919,13 @@ func (esg *expressionSQLGenerator) literalNil(b sb.SQLBuilder) {
 // Generates SQL bool literal, (e.g. TRUE, FALSE, mysql 1, 0, sqlite3 1, 0)
 func (esg *expressionSQLGenerator) literalBool(b sb.SQLBuilder, bl bool) {
 	if b.IsPrepared() {
-		esg.placeHolderSQL(b, bl)
+		if !esg.dialectOptions.BoolArgsAsInts {
+			esg.placeHolderSQL(b, bl)
+		} else if bl {
+			esg.placeHolderSQL(b, int64(1))
+		} else {
+			esg.placeHolderSQL(b, int64(0))
+		}
 		return
 	}
 	if bl {
@@ -320,6 +941,7 @@ func (esg *expressionSQLGenerator) literalTime(b sb.SQLBuilder, t time.Time) {
 		esg.placeHolderSQL(b, t)
 		return
 	}
+	b.Write(esg.dialectOptions.TimeLiteralFragment)
 	esg.Generate(b, t.In(timeLocation).Format(esg.dialectOptions.TimeFormat))
 }
 
@@ -355,6 +977,10 @@ func (esg *expressionSQLGenerator) literalString(b sb.SQLBuilder, s string, slic
 		quote = esg.dialectOptions.StringSliceQuote
 	}
 
+	if prefix := esg.dialectOptions.StringLiteralPrefix; len(prefix) > 0 {
+		b.Write(prefix)
+	}
+
 	b.WriteRunes(quote)
 
 	for _, char := range s {
@@ -422,10 +1048,52 @@ func (esg *expressionSQLGenerator) aliasedExpressionSQL(b sb.SQLBuilder, aliased
 	esg.Generate(b, aliased.Aliased())
 	b.Write(esg.dialectOptions.AsFragment)
 	esg.Generate(b, aliased.GetAs())
+	if cols := aliased.GetAsColumns(); cols != nil {
+		esg.derivedColumnAliasesSQL(b, cols)
+	}
+}
+
+// Generates SQL for a VALUES list (e.g. (VALUES (1, 'a'), (2, 'b')))
+func (esg *expressionSQLGenerator) valuesExpressionSQL(b sb.SQLBuilder, values exp.ValuesExpression) {
+	rows := values.Rows()
+	if len(rows) == 0 {
+		b.SetError(errEmptyValuesList)
+		return
+	}
+	rowLen := len(rows[0])
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	b.Write(esg.dialectOptions.ValuesListFragment)
+	for i, row := range rows {
+		if len(row) == 0 || len(row) != rowLen {
+			b.SetError(errMismatchedValuesListRows)
+			return
+		}
+		if i > 0 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		b.Write(esg.dialectOptions.ValuesListRowFragment)
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		for j, val := range row {
+			if j > 0 {
+				b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+			}
+			esg.Generate(b, val)
+		}
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
 // Generates SQL for a BooleanExpresion (e.g. I("a").Eq(2) -> "a" = 2)
 func (esg *expressionSQLGenerator) booleanExpressionSQL(b sb.SQLBuilder, operator exp.BooleanExpression) {
+	if esg.emulateIsBool(operator) {
+		esg.emulatedIsBoolSQL(b, operator)
+		return
+	}
+	if esg.emulateDistinctFrom(operator) {
+		esg.emulatedDistinctFromSQL(b, operator)
+		return
+	}
 	b.WriteRunes(esg.dialectOptions.LeftParenRune)
 	esg.Generate(b, operator.LHS())
 	b.WriteRunes(esg.dialectOptions.SpaceRune)
@@ -462,15 +1130,157 @@ func (esg *expressionSQLGenerator) booleanExpressionSQL(b sb.SQLBuilder, operato
 	} else {
 		esg.Generate(b, rhs)
 	}
+	if escape := operator.GetEscape(); escape != "" {
+		esg.likeEscapeSQL(b, operatorOp, escape)
+	}
 
 	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
-// Generates SQL for a BitwiseExpresion (e.g. I("a").BitwiseOr(2) - > "a" | 2)
-func (esg *expressionSQLGenerator) bitwiseExpressionSQL(b sb.SQLBuilder, operator exp.BitwiseExpression) {
-	b.WriteRunes(esg.dialectOptions.LeftParenRune)
-
-	if operator.LHS() != nil {
+// Generates the ESCAPE clause of a LIKE or ILIKE expression
+//
+//	ESCAPE '\'
+func (esg *expressionSQLGenerator) likeEscapeSQL(b sb.SQLBuilder, op exp.BooleanOperation, escape string) {
+	switch op {
+	case exp.LikeOp, exp.NotLikeOp, exp.ILikeOp, exp.NotILikeOp:
+	default:
+		b.SetError(errLikeEscapeOperator(op))
+		return
+	}
+	if esg.dialectOptions.LikeEscapeFragment == nil {
+		if escape != `\` {
+			b.SetError(errLikeEscapeNotSupported(esg.dialect, escape))
+		}
+		return
+	}
+	b.Write(esg.dialectOptions.LikeEscapeFragment)
+	esg.Generate(b, escape)
+}
+
+// Returns true if the BooleanExpression is an IS TRUE or IS FALSE that should be emulated because the dialect does not
+// support a boolean data type.
+func (esg *expressionSQLGenerator) emulateIsBool(operator exp.BooleanExpression) bool {
+	if esg.dialectOptions.BooleanDataTypeSupported || !esg.dialectOptions.EmulateIsBools {
+		return false
+	}
+	if op := operator.Op(); op != exp.IsOp && op != exp.IsNotOp {
+		return false
+	}
+	_, ok := operator.RHS().(bool)
+	return ok
+}
+
+// Generates SQL for IS TRUE and IS FALSE using the dialects True and False values
+// (e.g. "a" IS TRUE -> ("a" = 1), "a" IS NOT FALSE -> (("a" != 0) OR ("a" IS NULL)))
+func (esg *expressionSQLGenerator) emulatedIsBoolSQL(b sb.SQLBuilder, operator exp.BooleanExpression) {
+	// the values are interpolated so the comparison is the same for prepared statements
+	val := exp.NewLiteralExpression(string(esg.dialectOptions.False))
+	if operator.RHS().(bool) {
+		val = exp.NewLiteralExpression(string(esg.dialectOptions.True))
+	}
+	lhs := operator.LHS()
+	if operator.Op() == exp.IsOp {
+		esg.Generate(b, exp.NewBooleanExpression(exp.EqOp, lhs, val))
+		return
+	}
+	esg.Generate(b, exp.NewExpressionList(
+		exp.OrType,
+		exp.NewBooleanExpression(exp.NeqOp, lhs, val),
+		exp.NewBooleanExpression(exp.IsOp, lhs, nil),
+	))
+}
+
+// Returns true if the BooleanExpression is an IS [NOT] DISTINCT FROM that should be emulated because the dialect does
+// not support it.
+func (esg *expressionSQLGenerator) emulateDistinctFrom(operator exp.BooleanExpression) bool {
+	op := operator.Op()
+	if op != exp.IsDistinctFromOp && op != exp.IsNotDistinctFromOp {
+		return false
+	}
+	_, ok := esg.dialectOptions.BooleanOperatorLookup[op]
+	return !ok
+}
+
+// Generates SQL for IS [NOT] DISTINCT FROM using the dialects NULL safe equality operator or a CASE expression
+// (e.g. "a" IS NOT DISTINCT FROM "b" -> ("a" <=> "b"), "a" IS DISTINCT FROM "b" -> NOT ("a" <=> "b"),
+// "a" IS DISTINCT FROM "b" -> (CASE WHEN (("a" = "b") OR (("a" IS NULL) AND ("b" IS NULL))) THEN 1 ELSE 0 END = 0))
+func (esg *expressionSQLGenerator) emulatedDistinctFromSQL(b sb.SQLBuilder, operator exp.BooleanExpression) {
+	lhs, rhs := operator.LHS(), operator.RHS()
+	distinct := operator.Op() == exp.IsDistinctFromOp
+	if rhs == nil {
+		if distinct {
+			esg.Generate(b, exp.NewBooleanExpression(exp.IsNotOp, lhs, nil))
+			return
+		}
+		esg.Generate(b, exp.NewBooleanExpression(exp.IsOp, lhs, nil))
+		return
+	}
+	if nullSafeEq := esg.dialectOptions.NullSafeEqualFragment; nullSafeEq != nil {
+		if distinct {
+			b.WriteStrings("NOT ")
+		}
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, lhs)
+		b.WriteRunes(esg.dialectOptions.SpaceRune)
+		b.Write(nullSafeEq)
+		b.WriteRunes(esg.dialectOptions.SpaceRune)
+		esg.Generate(b, rhs)
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+		return
+	}
+	rhsExp, ok := rhs.(exp.Expression)
+	if !ok {
+		rhsExp = exp.NewLiteralExpression("?", rhs)
+	}
+	equal := exp.NewExpressionList(
+		exp.OrType,
+		exp.NewBooleanExpression(exp.EqOp, lhs, rhsExp),
+		exp.NewExpressionList(
+			exp.AndType,
+			exp.NewBooleanExpression(exp.IsOp, lhs, nil),
+			exp.NewBooleanExpression(exp.IsOp, rhsExp, nil),
+		),
+	)
+	result := exp.NewLiteralExpression("1")
+	if distinct {
+		result = exp.NewLiteralExpression("0")
+	}
+	caseExp := exp.NewCaseExpression().
+		When(equal, exp.NewLiteralExpression("1")).
+		Else(exp.NewLiteralExpression("0"))
+	esg.Generate(b, exp.NewBooleanExpression(exp.EqOp, caseExp, result))
+}
+
+// Generates SQL for an OperatorExpression using the symbol in the CustomOperatorLookup of the dialect or the symbol
+// of the operator
+//
+//	BinaryOperator("l2_distance", "<->").Apply("a", "[1,2]") -> ("a" <-> '[1,2]')
+//	PrefixOperator("abs", "@").Apply(I("a")) -> (@ "a")
+func (esg *expressionSQLGenerator) operatorExpressionSQL(b sb.SQLBuilder, oe exp.OperatorExpression) {
+	op := oe.Operator()
+	symbol := []byte(op.Symbol())
+	if s, ok := esg.dialectOptions.CustomOperatorLookup[op.Name()]; ok {
+		symbol = s
+	}
+	if len(symbol) == 0 {
+		b.SetError(errOperatorNotSupported(esg.dialect, op))
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	if !op.IsPrefix() {
+		esg.Generate(b, oe.LHS())
+		b.WriteRunes(esg.dialectOptions.SpaceRune)
+	}
+	b.Write(symbol).WriteRunes(esg.dialectOptions.SpaceRune)
+	esg.Generate(b, oe.RHS())
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a BitwiseExpresion (e.g. I("a").BitwiseOr(2) - > "a" | 2)
+func (esg *expressionSQLGenerator) bitwiseExpressionSQL(b sb.SQLBuilder, operator exp.BitwiseExpression) {
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+
+	if operator.LHS() != nil {
 		esg.Generate(b, operator.LHS())
 		b.WriteRunes(esg.dialectOptions.SpaceRune)
 	}
@@ -488,6 +1298,384 @@ func (esg *expressionSQLGenerator) bitwiseExpressionSQL(b sb.SQLBuilder, operato
 	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
+// Generates SQL for a JSONExpression using the JSON operators of the dialect
+//
+//	JSON("data").Get("a") -> ("data" -> 'a')
+//	JSON("data").GetPath("a", "b") -> ("data" #> '{"a","b"}')
+func (esg *expressionSQLGenerator) jsonExpressionSQL(b sb.SQLBuilder, je exp.JSONExpression) {
+	if esg.dialectOptions.UseJSONFunctions {
+		esg.Generate(b, jsonFunction(je))
+		return
+	}
+	op, ok := esg.dialectOptions.JSONOperatorLookup[je.Op()]
+	if !ok {
+		b.SetError(errUnsupportedJSONOperator(esg.dialect, je.Op()))
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, je.LHS())
+	b.WriteRunes(esg.dialectOptions.SpaceRune).Write(op).WriteRunes(esg.dialectOptions.SpaceRune)
+	esg.Generate(b, je.RHS())
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a JSONFunctionExpression using the function name of the dialect, maps, structs and slices used as
+// values are serialized to JSON
+//
+//	JSONSet("data", []string{"a"}, map[string]int{"b": 1})
+//	  -> postgres: jsonb_set("data", '{"a"}', CAST('{"b":1}' AS JSONB))
+//	  -> mysql: JSON_SET(`data`, '$.a', CAST('{"b":1}' AS JSON))
+func (esg *expressionSQLGenerator) jsonFunctionExpressionSQL(b sb.SQLBuilder, jf exp.JSONFunctionExpression) {
+	name, ok := esg.dialectOptions.JSONFunctionLookup[jf.Func()]
+	if !ok {
+		b.SetError(errJSONFunctionNotSupported(esg.dialect, jf.Func()))
+		return
+	}
+	args := make([]interface{}, 0, len(jf.Args()))
+	for i, arg := range jf.Args() {
+		switch {
+		case i == 1 && jf.Func() == exp.JSONPathQueryFunc:
+			args = append(args, arg)
+		case i == 1 && jf.Func() == exp.JSONSetFunc:
+			if esg.dialectOptions.UseJSONFunctions {
+				arg = jsonPath(jsonPathKeys(arg)...)
+			}
+			args = append(args, arg)
+		default:
+			val, err := esg.jsonValue(arg)
+			if err != nil {
+				b.SetError(err)
+				return
+			}
+			args = append(args, val)
+		}
+	}
+	esg.Generate(b, exp.NewSQLFunctionExpression(string(name), args...))
+}
+
+// Serializes maps, structs and slices to JSON and casts them to the JSONValueCastType of the dialect, any other value
+// is returned as is
+func (esg *expressionSQLGenerator) jsonValue(val interface{}) (interface{}, error) {
+	switch val.(type) {
+	case nil, exp.Expression, driver.Valuer, time.Time, *time.Time, []byte:
+		return val, nil
+	}
+	switch reflect.Indirect(reflect.ValueOf(val)).Kind() {
+	case reflect.Map, reflect.Struct, reflect.Slice, reflect.Array:
+	default:
+		return val, nil
+	}
+	j, err := json.Marshal(val)
+	if err != nil {
+		return nil, errors.New("unable to serialize JSON value: %s", err)
+	}
+	if esg.dialectOptions.JSONValueCastType == nil {
+		return string(j), nil
+	}
+	return exp.NewCastExpression(exp.NewLiteralExpression("?", string(j)), string(esg.dialectOptions.JSONValueCastType)), nil
+}
+
+// Generates SQL for an ArrayExpression
+//
+//	Array("tags").Overlaps([]string{"a"}) -> ("tags" && '{"a"}')
+//	Array("tags").Slice(1, 3) -> "tags"[1:3]
+func (esg *expressionSQLGenerator) arrayExpressionSQL(b sb.SQLBuilder, ae exp.ArrayExpression) {
+	switch ae.Op() {
+	case exp.ArrayIndexOp, exp.ArraySliceOp:
+		if (ae.Op() == exp.ArrayIndexOp && !esg.dialectOptions.SupportsArrayIndex) ||
+			(ae.Op() == exp.ArraySliceOp && !esg.dialectOptions.SupportsArraySlice) {
+			b.SetError(errUnsupportedArrayOperation(esg.dialect, ae.Op()))
+			return
+		}
+		esg.arraySubscriptLHSSQL(b, ae.LHS())
+		b.WriteRunes('[')
+		if bounds, ok := ae.RHS().(exp.ArraySliceBounds); ok {
+			esg.Generate(b, bounds.From)
+			b.WriteRunes(':')
+			esg.Generate(b, bounds.To)
+		} else {
+			esg.Generate(b, ae.RHS())
+		}
+		b.WriteRunes(']')
+	default:
+		op, ok := esg.dialectOptions.ArrayOperatorLookup[ae.Op()]
+		if !ok {
+			b.SetError(errUnsupportedArrayOperation(esg.dialect, ae.Op()))
+			return
+		}
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, ae.LHS())
+		b.WriteRunes(esg.dialectOptions.SpaceRune).Write(op).WriteRunes(esg.dialectOptions.SpaceRune)
+		esg.Generate(b, ae.RHS())
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+}
+
+// Writes the array that is subscripted, anything other than a column, an ARRAY constructor or another subscript is
+// wrapped in parens (e.g. (ARRAY_AGG("a"))[1])
+func (esg *expressionSQLGenerator) arraySubscriptLHSSQL(b sb.SQLBuilder, lhs exp.Expression) {
+	switch t := lhs.(type) {
+	case exp.IdentifierExpression, exp.ArrayConstructorExpression:
+		esg.Generate(b, lhs)
+		return
+	case exp.ArrayExpression:
+		if t.Op() == exp.ArrayIndexOp || t.Op() == exp.ArraySliceOp {
+			esg.Generate(b, lhs)
+			return
+		}
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, lhs)
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for an ArrayConstructorExpression (e.g. ARRAY[1, 2, 3])
+func (esg *expressionSQLGenerator) arrayConstructorSQL(b sb.SQLBuilder, ac exp.ArrayConstructorExpression) {
+	if esg.dialectOptions.ArrayConstructorFragment == nil {
+		b.SetError(errArrayConstructorNotSupported(esg.dialect))
+		return
+	}
+	b.Write(esg.dialectOptions.ArrayConstructorFragment).WriteRunes('[')
+	for i, val := range ac.Values() {
+		if i > 0 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		esg.Generate(b, val)
+	}
+	b.WriteRunes(']')
+}
+
+// Generates SQL for a RangeTypeExpression (e.g. RangeOf("during").Overlaps(TsTzRange(a, b)) -> ("during" && tstzrange(a, b)))
+func (esg *expressionSQLGenerator) rangeTypeExpressionSQL(b sb.SQLBuilder, rte exp.RangeTypeExpression) {
+	op, ok := esg.dialectOptions.RangeTypeOperatorLookup[rte.Op()]
+	if !ok {
+		b.SetError(errUnsupportedRangeTypeOperation(esg.dialect, rte.Op()))
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, rte.LHS())
+	b.WriteRunes(esg.dialectOptions.SpaceRune).Write(op).WriteRunes(esg.dialectOptions.SpaceRune)
+	esg.Generate(b, rte.RHS())
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a RangeLiteralExpression using the constructor function of the range type
+//
+//	NewRangeLiteral("int4range", 1, 10) -> int4range(1, 10)
+//	NewRangeLiteral("int4range", 1, nil).WithBounds("[]") -> int4range(1, NULL, '[]')
+func (esg *expressionSQLGenerator) rangeLiteralSQL(b sb.SQLBuilder, rl exp.RangeLiteralExpression) {
+	if !esg.dialectOptions.SupportsRangeTypes {
+		b.SetError(errRangeTypesNotSupported(esg.dialect))
+		return
+	}
+	args := []interface{}{rl.Lower(), rl.Upper()}
+	switch rl.Bounds() {
+	case "":
+	case "[)", "(]", "[]", "()":
+		args = append(args, rl.Bounds())
+	default:
+		b.SetError(errInvalidRangeBounds(rl.Bounds()))
+		return
+	}
+	esg.Generate(b, exp.NewSQLFunctionExpression(rl.TypeName(), args...))
+}
+
+// Looks up the name of the unit of an interval, returns false and sets an error on the builder if the unit is not
+// supported
+func (esg *expressionSQLGenerator) intervalUnit(b sb.SQLBuilder, unit exp.IntervalUnit) ([]byte, bool) {
+	if len(esg.dialectOptions.IntervalUnitLookup) == 0 {
+		b.SetError(errIntervalsNotSupported(esg.dialect))
+		return nil, false
+	}
+	name, ok := esg.dialectOptions.IntervalUnitLookup[unit]
+	if !ok {
+		b.SetError(errUnsupportedIntervalUnit(esg.dialect, unit))
+		return nil, false
+	}
+	return name, true
+}
+
+// Generates SQL for an IntervalExpression, the quantity is always written in the SQL
+//
+//	Interval(3, Days) -> INTERVAL '3' DAY, INTERVAL '3 days' (e.g. postgres), INTERVAL 3 DAY (e.g. mysql)
+func (esg *expressionSQLGenerator) intervalSQL(b sb.SQLBuilder, i exp.IntervalExpression) {
+	unit, ok := esg.intervalUnit(b, i.Unit())
+	if !ok {
+		return
+	}
+	quantity := strconv.FormatInt(i.Quantity(), 10)
+	b.Write(esg.dialectOptions.IntervalFragment).WriteRunes(esg.dialectOptions.SpaceRune)
+	switch esg.dialectOptions.IntervalStyle {
+	case QuotedIntervalStyle:
+		b.WriteRunes(esg.dialectOptions.StringQuote).
+			WriteStrings(quantity).
+			WriteRunes(esg.dialectOptions.SpaceRune).
+			Write(unit).
+			WriteRunes(esg.dialectOptions.StringQuote)
+	case UnquotedIntervalStyle:
+		b.WriteStrings(quantity).WriteRunes(esg.dialectOptions.SpaceRune).Write(unit)
+	case DateAddIntervalStyle:
+		b.SetError(errIntervalRequiresDateArithmetic(esg.dialect))
+	default:
+		b.WriteRunes(esg.dialectOptions.StringQuote).
+			WriteStrings(quantity).
+			WriteRunes(esg.dialectOptions.StringQuote, esg.dialectOptions.SpaceRune).
+			Write(unit)
+	}
+}
+
+// Generates SQL for a DateArithmeticExpression
+//
+//	DateOf("a").Add(Interval(3, Days)) -> ("a" + INTERVAL '3 days'), DATEADD(DAY, 3, "a") (e.g. sqlserver)
+//	DateOf("a").Sub(Interval(3, Days)) -> ("a" - INTERVAL '3 days'), DATEADD(DAY, -3, "a") (e.g. sqlserver)
+func (esg *expressionSQLGenerator) dateArithmeticSQL(b sb.SQLBuilder, dae exp.DateArithmeticExpression) {
+	if esg.dialectOptions.IntervalStyle == DateAddIntervalStyle {
+		unit, ok := esg.intervalUnit(b, dae.Interval().Unit())
+		if !ok {
+			return
+		}
+		quantity := dae.Interval().Quantity()
+		if dae.Op() == exp.DateSubOp {
+			quantity = -quantity
+		}
+		b.Write(esg.dialectOptions.DateAddFragment).
+			WriteRunes(esg.dialectOptions.LeftParenRune).
+			Write(unit).
+			WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune).
+			WriteStrings(strconv.FormatInt(quantity, 10)).
+			WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		esg.Generate(b, dae.LHS())
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+		return
+	}
+	op := '+'
+	if dae.Op() == exp.DateSubOp {
+		op = '-'
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, dae.LHS())
+	b.WriteRunes(esg.dialectOptions.SpaceRune, op, esg.dialectOptions.SpaceRune)
+	esg.Generate(b, dae.Interval())
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for an AtTimeZoneExpression
+//
+//	I("a").AtTimeZone("UTC") -> ("a" AT TIME ZONE 'UTC'), CONVERT_TZ(`a`, @@session.time_zone, 'UTC') (e.g. mysql),
+//	CONVERT_TIMEZONE('UTC', "a") (e.g. snowflake)
+func (esg *expressionSQLGenerator) atTimeZoneSQL(b sb.SQLBuilder, atz exp.AtTimeZoneExpression) {
+	if fn := esg.dialectOptions.ConvertTimeZoneFunction; fn != nil {
+		b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+		if esg.dialectOptions.ConvertTimeZoneZoneFirst {
+			esg.Generate(b, atz.TimeZone())
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+			esg.Generate(b, atz.Value())
+			b.WriteRunes(esg.dialectOptions.RightParenRune)
+			return
+		}
+		esg.Generate(b, atz.Value())
+		if src := esg.dialectOptions.ConvertTimeZoneSourceFragment; src != nil {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune).Write(src)
+		}
+		b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		esg.Generate(b, atz.TimeZone())
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+		return
+	}
+	if esg.dialectOptions.AtTimeZoneFragment == nil {
+		b.SetError(errAtTimeZoneNotSupported(esg.dialect))
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, atz.Value())
+	b.Write(esg.dialectOptions.AtTimeZoneFragment)
+	esg.Generate(b, atz.TimeZone())
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Converts a JSONExpression to the equivalent JSON function call using JSON paths (e.g. mysql)
+//
+//	JSON("data").Get("a") -> JSON_EXTRACT("data", '$."a"')
+//	JSON("data").HasAnyKey("a", "b") -> JSON_CONTAINS_PATH("data", 'one', '$."a"', '$."b"')
+func jsonFunction(je exp.JSONExpression) exp.SQLFunctionExpression {
+	lhs, rhs := je.LHS(), je.RHS()
+	switch je.Op() {
+	case exp.JSONGetOp:
+		return exp.NewSQLFunctionExpression("JSON_EXTRACT", lhs, jsonPath(rhs))
+	case exp.JSONGetTextOp:
+		return exp.NewSQLFunctionExpression("JSON_UNQUOTE", exp.NewSQLFunctionExpression("JSON_EXTRACT", lhs, jsonPath(rhs)))
+	case exp.JSONGetPathOp:
+		return exp.NewSQLFunctionExpression("JSON_EXTRACT", lhs, jsonPath(jsonPathKeys(rhs)...))
+	case exp.JSONGetPathTextOp:
+		return exp.NewSQLFunctionExpression(
+			"JSON_UNQUOTE", exp.NewSQLFunctionExpression("JSON_EXTRACT", lhs, jsonPath(jsonPathKeys(rhs)...)),
+		)
+	case exp.JSONContainsOp:
+		return exp.NewSQLFunctionExpression("JSON_CONTAINS", lhs, rhs)
+	case exp.JSONContainedByOp:
+		return exp.NewSQLFunctionExpression("JSON_CONTAINS", rhs, lhs)
+	case exp.JSONHasKeyOp:
+		return exp.NewSQLFunctionExpression("JSON_CONTAINS_PATH", lhs, "one", jsonPath(rhs))
+	case exp.JSONHasAnyKeyOp, exp.JSONHasAllKeysOp:
+		mode := "one"
+		if je.Op() == exp.JSONHasAllKeysOp {
+			mode = "all"
+		}
+		args := []interface{}{lhs, mode}
+		for _, key := range jsonPathKeys(rhs) {
+			args = append(args, jsonPath(key))
+		}
+		return exp.NewSQLFunctionExpression("JSON_CONTAINS_PATH", args...)
+	default:
+		// JSON_MERGE_PATCH would replace the keys of objects like postgres || but it does not concatenate arrays, the
+		// difference for objects with the same keys is documented on Concat
+		return exp.NewSQLFunctionExpression("JSON_MERGE_PRESERVE", lhs, rhs)
+	}
+}
+
+func jsonPathKeys(rhs interface{}) []interface{} {
+	keys, _ := rhs.([]string)
+	ret := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		if i, err := strconv.Atoi(k); err == nil {
+			ret = append(ret, i)
+		} else {
+			ret = append(ret, k)
+		}
+	}
+	return ret
+}
+
+// Creates a JSON path from keys and array indexes, keys that are not identifiers are quoted
+// (e.g. "a", 0, "b c" -> $.a[0]."b c")
+func jsonPath(keys ...interface{}) string {
+	path := "$"
+	for _, k := range keys {
+		switch t := k.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			path += fmt.Sprintf("[%d]", t)
+		default:
+			key := fmt.Sprint(t)
+			if isJSONPathIdentifier(key) {
+				path += "." + key
+			} else {
+				path += `."` + strings.ReplaceAll(key, `"`, `\"`) + `"`
+			}
+		}
+	}
+	return path
+}
+
+func isJSONPathIdentifier(key string) bool {
+	for i, r := range key {
+		isLetter := r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if !isLetter && (i == 0 || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return key != ""
+}
+
 // Generates SQL for a RangeExpresion (e.g. I("a").Between(RangeVal{Start:2,End:5}) -> "a" BETWEEN 2 AND 5)
 func (esg *expressionSQLGenerator) rangeExpressionSQL(b sb.SQLBuilder, operator exp.RangeExpression) {
 	b.WriteRunes(esg.dialectOptions.LeftParenRune)
@@ -599,8 +1787,149 @@ func (esg *expressionSQLGenerator) literalExpressionSQL(b sb.SQLBuilder, literal
 //
 //	COUNT(I("a")) -> COUNT("a")
 func (esg *expressionSQLGenerator) sqlFunctionExpressionSQL(b sb.SQLBuilder, sqlFunc exp.SQLFunctionExpression) {
-	b.WriteStrings(sqlFunc.Name())
-	esg.Generate(b, sqlFunc.Args())
+	sqlFunc = unwrapDistinctArg(sqlFunc)
+	name := sqlFunc.Name()
+	if def, ok := esg.dialectOptions.FunctionDefinitions[strings.ToLower(name)]; ok {
+		if !def.AcceptsArgs(len(sqlFunc.Args())) {
+			b.SetError(errFunctionArgs(esg.dialect, name, def, len(sqlFunc.Args())))
+			return
+		}
+		name = def.SQLName()
+	}
+	if dialectName, ok := esg.dialectOptions.FunctionNameLookup[strings.ToLower(name)]; ok {
+		name = string(dialectName)
+	}
+	args := sqlFunc.Args()
+	if op := esg.dialectOptions.ModOperatorFragment; op != nil && strings.EqualFold(name, "MOD") && len(args) == 2 {
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, args[0])
+		b.Write(op)
+		esg.Generate(b, args[1])
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+		return
+	}
+	filter := sqlFunc.GetFilter()
+	hasFilter := filter != nil && !filter.IsEmpty()
+	if hasFilter && esg.dialectOptions.AggregateFilterFragment == nil {
+		esg.Generate(b, filteredAggregateCase(sqlFunc, filter))
+		return
+	}
+	b.WriteStrings(name)
+	order := sqlFunc.GetOrder()
+	if sqlFunc.IsDistinct() || (order != nil && !order.IsEmpty()) {
+		esg.aggregateArgsSQL(b, sqlFunc.Args(), sqlFunc.IsDistinct(), order)
+	} else {
+		esg.Generate(b, sqlFunc.Args())
+	}
+	if hasFilter {
+		b.Write(esg.dialectOptions.AggregateFilterFragment)
+		esg.Generate(b, filter)
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+}
+
+// Generates the arguments of an aggregate function with a DISTINCT and/or ORDER BY
+//
+//	("a" ORDER BY "b" DESC)
+//	(DISTINCT "a")
+func (esg *expressionSQLGenerator) aggregateArgsSQL(
+	b sb.SQLBuilder,
+	args exp.Args,
+	distinct bool,
+	order exp.ColumnListExpression,
+) {
+	hasOrder := order != nil && !order.IsEmpty()
+	if hasOrder && esg.dialectOptions.AggregateOrderByFragment == nil {
+		b.SetError(errAggregateOrderByNotSupported(esg.dialect))
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	if distinct {
+		b.Write(esg.dialectOptions.DistinctFragment).WriteRunes(esg.dialectOptions.SpaceRune)
+	}
+	for i, arg := range args {
+		if i > 0 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		esg.Generate(b, arg)
+	}
+	if hasOrder {
+		b.Write(esg.dialectOptions.AggregateOrderByFragment)
+		esg.Generate(b, order)
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Rewrites an aggregate whose only argument is a DISTINCT function so the DISTINCT is part of the aggregate call
+//
+//	COUNT(DISTINCT("a")) -> COUNT(DISTINCT "a")
+func unwrapDistinctArg(sqlFunc exp.SQLFunctionExpression) exp.SQLFunctionExpression {
+	args := sqlFunc.Args()
+	if len(args) != 1 {
+		return sqlFunc
+	}
+	inner, ok := args[0].(exp.SQLFunctionExpression)
+	if !ok || !strings.EqualFold(inner.Name(), "DISTINCT") || inner.IsDistinct() ||
+		inner.GetOrder() != nil || inner.GetFilter() != nil {
+		return sqlFunc
+	}
+	ret := exp.NewSQLFunctionExpression(sqlFunc.Name(), inner.Args()...).Distinct()
+	if order := sqlFunc.GetOrder(); order != nil {
+		ret = ret.OrderBy(orderedExpressions(order)...)
+	}
+	if filter := sqlFunc.GetFilter(); filter != nil {
+		ret = ret.Filter(filter.Expressions()...)
+	}
+	return ret
+}
+
+func orderedExpressions(order exp.ColumnListExpression) []exp.OrderedExpression {
+	cols := order.Columns()
+	ret := make([]exp.OrderedExpression, 0, len(cols))
+	for _, col := range cols {
+		if oe, ok := col.(exp.OrderedExpression); ok {
+			ret = append(ret, oe)
+		}
+	}
+	return ret
+}
+
+// Rewrites a filtered aggregate function using CASE for dialects that do not support FILTER, only the first argument
+// of the function is filtered
+//
+//	COUNT(*) FILTER (WHERE c) -> SUM(CASE WHEN c THEN 1 ELSE 0 END)
+//	SUM("a") FILTER (WHERE c) -> SUM(CASE WHEN c THEN "a" END)
+func filteredAggregateCase(sqlFunc exp.SQLFunctionExpression, filter exp.ExpressionList) exp.SQLFunctionExpression {
+	args := sqlFunc.Args()
+	if strings.EqualFold(sqlFunc.Name(), "COUNT") && (len(args) == 0 || isStar(args[0])) {
+		return exp.NewSQLFunctionExpression("SUM", exp.NewCaseExpression().When(filter, 1).Else(0))
+	}
+	if len(args) == 0 {
+		return exp.NewSQLFunctionExpression(sqlFunc.Name())
+	}
+	caseArgs := make([]interface{}, 0, len(args))
+	caseArgs = append(caseArgs, exp.NewCaseExpression().When(filter, args[0]))
+	caseArgs = append(caseArgs, args[1:]...)
+	ret := exp.NewSQLFunctionExpression(sqlFunc.Name(), caseArgs...)
+	if sqlFunc.IsDistinct() {
+		ret = ret.Distinct()
+	}
+	if order := sqlFunc.GetOrder(); order != nil {
+		ret = ret.OrderBy(orderedExpressions(order)...)
+	}
+	return ret
+}
+
+// Returns true if the value is * or a qualified * (e.g. "t".*)
+func isStar(val interface{}) bool {
+	switch t := val.(type) {
+	case exp.IdentifierExpression:
+		val = t.GetCol()
+	case string:
+		return t == "*"
+	}
+	l, ok := val.(exp.LiteralExpression)
+	return ok && l.Literal() == "*"
 }
 
 func (esg *expressionSQLGenerator) sqlWindowFunctionExpression(b sb.SQLBuilder, sqlWinFunc exp.SQLWindowFunctionExpression) {
@@ -656,21 +1985,89 @@ func (esg *expressionSQLGenerator) windowExpressionSQL(b sb.SQLBuilder, we exp.W
 		b.Write(esg.dialectOptions.WindowOrderByFragment)
 		esg.Generate(b, we.OrderCols())
 	}
+	if we.HasFrame() {
+		if we.HasParent() || hasPartition || hasOrder {
+			b.WriteRunes(esg.dialectOptions.SpaceRune)
+		}
+		esg.windowFrameSQL(b, we.Frame())
+	}
 
 	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
+// Generates the frame of a window
+//
+//	ROWS BETWEEN 1 PRECEDING AND CURRENT ROW EXCLUDE TIES
+func (esg *expressionSQLGenerator) windowFrameSQL(b sb.SQLBuilder, frame exp.WindowFrame) {
+	if frame.Mode() == exp.GroupsFrame && !esg.dialectOptions.SupportsWindowFrameGroups {
+		b.SetError(errWindowFrameGroupsNotSupported(esg.dialect))
+		return
+	}
+	if frame.Start() == nil {
+		b.SetError(errWindowFrameStartRequired)
+		return
+	}
+	b.WriteStrings(frame.Mode().String())
+	b.WriteRunes(esg.dialectOptions.SpaceRune)
+	if end := frame.End(); end != nil {
+		b.WriteStrings("BETWEEN ")
+		esg.windowFrameBoundSQL(b, frame.Start())
+		b.WriteStrings(" AND ")
+		esg.windowFrameBoundSQL(b, end)
+	} else {
+		esg.windowFrameBoundSQL(b, frame.Start())
+	}
+	if exclusion := frame.Exclusion(); exclusion != exp.NoFrameExclusion {
+		if !esg.dialectOptions.SupportsWindowFrameExclusion {
+			b.SetError(errWindowFrameExclusionNotSupported(esg.dialect))
+			return
+		}
+		b.WriteRunes(esg.dialectOptions.SpaceRune)
+		b.WriteStrings(exclusion.String())
+	}
+}
+
+func (esg *expressionSQLGenerator) windowFrameBoundSQL(b sb.SQLBuilder, bound exp.WindowFrameBound) {
+	switch bound.BoundType() {
+	case exp.PrecedingBound, exp.FollowingBound:
+		esg.Generate(b, bound.Offset())
+		b.WriteRunes(esg.dialectOptions.SpaceRune)
+	}
+	b.WriteStrings(bound.BoundType().String())
+}
+
 // Generates SQL for a CastExpression
 //
 //	I("a").Cast("NUMERIC") -> CAST("a" AS NUMERIC)
+//	I("a").Cast("text") -> CAST("a" AS TEXT), CAST(`a` AS CHAR) (e.g. mysql)
 func (esg *expressionSQLGenerator) castExpressionSQL(b sb.SQLBuilder, cast exp.CastExpression) {
 	b.Write(esg.dialectOptions.CastFragment).WriteRunes(esg.dialectOptions.LeftParenRune)
 	esg.Generate(b, cast.Casted())
 	b.Write(esg.dialectOptions.AsFragment)
-	esg.Generate(b, cast.Type())
+	esg.castTypeSQL(b, cast.Type())
 	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
+// Generates SQL for the type of a CastExpression, the type name is looked up in the CastTypeLookup and then, if it is
+// one of the lower case common type names, in the DataTypeLookup of the dialect. Types that are not found are written
+// as is so explicit SQL types (e.g. VARCHAR) are not rewritten.
+func (esg *expressionSQLGenerator) castTypeSQL(b sb.SQLBuilder, t exp.LiteralExpression) {
+	if len(t.Args()) == 0 {
+		name := t.Literal()
+		if sqlType, ok := esg.dialectOptions.CastTypeLookup[strings.ToLower(name)]; ok {
+			b.Write(sqlType)
+			return
+		}
+		if kind, ok := castTypeKinds[name]; ok {
+			if sqlType, ok := esg.dialectOptions.DataTypeLookup[kind]; ok {
+				b.Write(sqlType)
+				return
+			}
+		}
+	}
+	esg.Generate(b, t)
+}
+
 // Generates the sql for the WITH clauses for common table expressions (CTE)
 func (esg *expressionSQLGenerator) commonTablesSliceSQL(b sb.SQLBuilder, ctes []exp.CommonTableExpression) {
 	l := len(ctes)
@@ -706,21 +2103,112 @@ func (esg *expressionSQLGenerator) commonTablesSliceSQL(b sb.SQLBuilder, ctes []
 func (esg *expressionSQLGenerator) commonTableExpressionSQL(b sb.SQLBuilder, cte exp.CommonTableExpression) {
 	esg.Generate(b, cte.Name())
 	b.Write(esg.dialectOptions.AsFragment)
+	if m := cte.Materialization(); m != exp.DefaultCTEMaterialization {
+		var fragment []byte
+		switch m {
+		case exp.MaterializedCTE:
+			fragment = esg.dialectOptions.MaterializedCTEFragment
+		case exp.NotMaterializedCTE:
+			fragment = esg.dialectOptions.NotMaterializedCTEFragment
+		}
+		if fragment == nil {
+			b.SetError(errCTEMaterializationNotSupported(esg.dialect, m))
+			return
+		}
+		b.Write(fragment)
+	}
 	esg.Generate(b, cte.SubQuery())
+	if search := cte.Search(); search != nil {
+		esg.cteSearchSQL(b, cte, search)
+	}
+	if cycle := cte.Cycle(); cycle != nil {
+		esg.cteCycleSQL(b, cte, cycle)
+	}
+}
+
+// Generates the SEARCH clause of a recursive CommonTableExpression
+func (esg *expressionSQLGenerator) cteSearchSQL(
+	b sb.SQLBuilder, cte exp.CommonTableExpression, search exp.CTESearchExpression,
+) {
+	do := esg.dialectOptions
+	if do.CTESearchFragment == nil {
+		b.SetError(errCTESearchNotSupported(esg.dialect))
+		return
+	}
+	if !cte.IsRecursive() {
+		b.SetError(errCTESearchCycleRequiresRecursive)
+		return
+	}
+	mode, ok := do.CTESearchModeLookup[search.Mode()]
+	if !ok {
+		b.SetError(errCTESearchModeNotSupported(esg.dialect, search.Mode()))
+		return
+	}
+	if search.SequenceColumn() == nil {
+		b.SetError(errCTESetColumnRequired)
+		return
+	}
+	b.Write(do.CTESearchFragment).Write(mode)
+	esg.Generate(b, search.By())
+	b.Write(do.CTESetFragment)
+	esg.Generate(b, search.SequenceColumn())
+}
+
+// Generates the CYCLE clause of a recursive CommonTableExpression
+func (esg *expressionSQLGenerator) cteCycleSQL(
+	b sb.SQLBuilder, cte exp.CommonTableExpression, cycle exp.CTECycleExpression,
+) {
+	do := esg.dialectOptions
+	if do.CTECycleFragment == nil {
+		b.SetError(errCTECycleNotSupported(esg.dialect))
+		return
+	}
+	if !cte.IsRecursive() {
+		b.SetError(errCTESearchCycleRequiresRecursive)
+		return
+	}
+	if cycle.MarkColumn() == nil {
+		b.SetError(errCTESetColumnRequired)
+		return
+	}
+	b.Write(do.CTECycleFragment)
+	esg.Generate(b, cycle.Columns())
+	b.Write(do.CTESetFragment)
+	esg.Generate(b, cycle.MarkColumn())
+	if cycle.MarkValue() != nil || cycle.MarkDefault() != nil {
+		b.Write(do.CTECycleToFragment)
+		esg.Generate(b, cycle.MarkValue())
+		b.Write(do.CTECycleDefaultFragment)
+		esg.Generate(b, cycle.MarkDefault())
+	}
+	if path := cycle.PathColumn(); path != nil {
+		b.Write(do.CTECycleUsingFragment)
+		esg.Generate(b, path)
+	}
 }
 
 // Generates SQL for a CompoundExpression
 func (esg *expressionSQLGenerator) compoundExpressionSQL(b sb.SQLBuilder, compound exp.CompoundExpression) {
+	var fragment []byte
 	switch compound.Type() {
 	case exp.UnionCompoundType:
-		b.Write(esg.dialectOptions.UnionFragment)
+		fragment = esg.dialectOptions.UnionFragment
 	case exp.UnionAllCompoundType:
-		b.Write(esg.dialectOptions.UnionAllFragment)
+		fragment = esg.dialectOptions.UnionAllFragment
 	case exp.IntersectCompoundType:
-		b.Write(esg.dialectOptions.IntersectFragment)
+		fragment = esg.dialectOptions.IntersectFragment
 	case exp.IntersectAllCompoundType:
-		b.Write(esg.dialectOptions.IntersectAllFragment)
+		fragment = esg.dialectOptions.IntersectAllFragment
+	case exp.ExceptCompoundType:
+		fragment = esg.dialectOptions.ExceptFragment
+	case exp.ExceptAllCompoundType:
+		fragment = esg.dialectOptions.ExceptAllFragment
 	}
+	if fragment == nil {
+		b.SetError(errCompoundTypeNotSupported(esg.dialect, compound.Type()))
+		return
+	}
+	b.Write(fragment)
 	if esg.dialectOptions.WrapCompoundsInParens {
 		b.WriteRunes(esg.dialectOptions.LeftParenRune)
 		compound.RHS().AppendSQL(b)
@@ -730,6 +2218,331 @@ func (esg *expressionSQLGenerator) compoundExpressionSQL(b sb.SQLBuilder, compou
 	}
 }
 
+// Generates SQL for a DataType using the DataTypeLookup of the dialect
+//
+//	NewDataType(VarcharDataType, 255) -> VARCHAR(255)
+//	NewDataType(DecimalDataType, 10, 2) -> DECIMAL(10, 2)
+//	NewCustomDataType("CITEXT") -> CITEXT
+func (esg *expressionSQLGenerator) dataTypeSQL(b sb.SQLBuilder, dt exp.DataType) {
+	if dt.Kind() == exp.CustomDataType {
+		b.WriteStrings(dt.Name())
+	} else if val, ok := esg.dialectOptions.DataTypeLookup[dt.Kind()]; ok {
+		b.Write(val)
+	} else {
+		b.SetError(errUnsupportedDataType(esg.dialect, dt.Kind()))
+		return
+	}
+	params := dt.Params()
+	if len(params) == 0 {
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	for i, p := range params {
+		if i > 0 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		b.WriteStrings(strconv.Itoa(p))
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a ColumnDefinition
+//
+//	NewColumnDefinition("id", NewDataType(BigIntDataType)).PrimaryKey() -> "id" BIGINT PRIMARY KEY
+func (esg *expressionSQLGenerator) columnDefinitionSQL(b sb.SQLBuilder, cd exp.ColumnDefinition) {
+	esg.Generate(b, exp.NewIdentifierExpression("", "", cd.Name()))
+	b.WriteRunes(esg.dialectOptions.SpaceRune)
+	esg.Generate(b, cd.DataType())
+	if cd.HasDefault() {
+		b.Write(esg.dialectOptions.ColumnDefaultFragment)
+		if esg.dialectOptions.WrapColumnDefaults {
+			b.WriteRunes(esg.dialectOptions.LeftParenRune)
+			esg.Generate(b, cd.DefaultValue())
+			b.WriteRunes(esg.dialectOptions.RightParenRune)
+		} else {
+			esg.Generate(b, cd.DefaultValue())
+		}
+	}
+	if cd.IsAutoIncrement() && !esg.dialectOptions.AutoIncrementAfterPrimaryKey {
+		esg.autoIncrementSQL(b)
+	}
+	if cd.IsNotNull() {
+		b.Write(esg.dialectOptions.NotNullFragment)
+	}
+	// the primary key is written after the column list of the CREATE TABLE when PrimaryKeyAfterColumns is set
+	if cd.IsPrimaryKey() && !esg.dialectOptions.PrimaryKeyAfterColumns {
+		b.WriteRunes(esg.dialectOptions.SpaceRune).Write(esg.dialectOptions.PrimaryKeyFragment)
+	}
+	if cd.IsAutoIncrement() && esg.dialectOptions.AutoIncrementAfterPrimaryKey {
+		esg.autoIncrementSQL(b)
+	}
+	if cd.IsUnique() {
+		b.WriteRunes(esg.dialectOptions.SpaceRune).Write(esg.dialectOptions.UniqueFragment)
+	}
+	if cd.HasComment() {
+		if esg.dialectOptions.ColumnCommentFragment == nil {
+			b.SetError(errColumnCommentNotSupported(esg.dialect))
+			return
+		}
+		b.Write(esg.dialectOptions.ColumnCommentFragment)
+		esg.Generate(b, cd.CommentValue())
+	}
+}
+
+func (esg *expressionSQLGenerator) autoIncrementSQL(b sb.SQLBuilder) {
+	if esg.dialectOptions.AutoIncrementFragment == nil {
+		b.SetError(errAutoIncrementNotSupported(esg.dialect))
+		return
+	}
+	b.Write(esg.dialectOptions.AutoIncrementFragment)
+}
+
+// Generates SQL for a TableConstraint
+//
+//	NewPrimaryKeyConstraint("a", "b") -> PRIMARY KEY ("a", "b")
+//	NewUniqueConstraint("a").Named("a_uniq") -> CONSTRAINT "a_uniq" UNIQUE ("a")
+//	NewForeignKeyConstraint("a").References("b", "id") -> FOREIGN KEY ("a") REFERENCES "b" ("id")
+//	NewCheckConstraint(NewIdentifierExpression("", "", "a").Gt(0)) -> CHECK ("a" > 0)
+//	NewExcludeConstraint("gist", NewExcludeElement("a", "&&")) -> EXCLUDE USING gist ("a" WITH &&)
+func (esg *expressionSQLGenerator) tableConstraintSQL(b sb.SQLBuilder, tc exp.TableConstraint) {
+	do := esg.dialectOptions
+	if tc.Name() != "" {
+		b.Write(do.ConstraintFragment)
+		esg.Generate(b, exp.NewIdentifierExpression("", "", tc.Name()))
+		b.WriteRunes(do.SpaceRune)
+	}
+	switch tc.ConstraintType() {
+	case exp.PrimaryKeyConstraintType:
+		b.Write(do.PrimaryKeyFragment)
+	case exp.UniqueConstraintType:
+		b.Write(do.UniqueFragment)
+	case exp.ForeignKeyConstraintType:
+		esg.foreignKeyConstraintSQL(b, tc)
+		return
+	case exp.CheckConstraintType:
+		esg.checkConstraintSQL(b, tc)
+		return
+	case exp.ExcludeConstraintType:
+		esg.excludeConstraintSQL(b, tc)
+		return
+	default:
+		b.SetError(errUnsupportedTableConstraintType(tc.ConstraintType()))
+		return
+	}
+	b.WriteRunes(do.SpaceRune, do.LeftParenRune)
+	esg.Generate(b, tc.Columns())
+	b.WriteRunes(do.RightParenRune)
+}
+
+func (esg *expressionSQLGenerator) foreignKeyConstraintSQL(b sb.SQLBuilder, tc exp.TableConstraint) {
+	do := esg.dialectOptions
+	if tc.ReferencedTable() == nil {
+		b.SetError(errNoReferencedTable)
+		return
+	}
+	b.Write(do.ForeignKeyFragment).WriteRunes(do.SpaceRune, do.LeftParenRune)
+	esg.Generate(b, tc.Columns())
+	b.WriteRunes(do.RightParenRune).Write(do.ReferencesFragment)
+	esg.Generate(b, tc.ReferencedTable())
+	if cols := tc.ReferencedColumns(); cols != nil && !cols.IsEmpty() {
+		b.WriteRunes(do.SpaceRune, do.LeftParenRune)
+		esg.Generate(b, cols)
+		b.WriteRunes(do.RightParenRune)
+	}
+	esg.referentialActionSQL(b, do.OnDeleteFragment, tc.OnDeleteAction())
+	esg.referentialActionSQL(b, do.OnUpdateFragment, tc.OnUpdateAction())
+}
+
+func (esg *expressionSQLGenerator) referentialActionSQL(b sb.SQLBuilder, fragment []byte, action exp.ReferentialAction) {
+	if action == exp.NoReferentialAction {
+		return
+	}
+	actionSQL, ok := esg.dialectOptions.ReferentialActionLookup[action]
+	if !ok {
+		b.SetError(errReferentialActionNotSupported(esg.dialect, action))
+		return
+	}
+	b.Write(fragment).Write(actionSQL)
+}
+
+func (esg *expressionSQLGenerator) checkConstraintSQL(b sb.SQLBuilder, tc exp.TableConstraint) {
+	do := esg.dialectOptions
+	if do.CheckFragment == nil {
+		b.SetError(errTableConstraintNotSupported(esg.dialect, tc.ConstraintType()))
+		return
+	}
+	b.Write(do.CheckFragment)
+	esg.wrappedExpressionSQL(b, tc.CheckExpression())
+}
+
+func (esg *expressionSQLGenerator) excludeConstraintSQL(b sb.SQLBuilder, tc exp.TableConstraint) {
+	do := esg.dialectOptions
+	if do.ExcludeFragment == nil {
+		b.SetError(errTableConstraintNotSupported(esg.dialect, tc.ConstraintType()))
+		return
+	}
+	b.Write(do.ExcludeFragment)
+	if tc.IndexMethod() != "" {
+		b.Write(do.UsingFragment).WriteStrings(tc.IndexMethod())
+	}
+	b.WriteRunes(do.SpaceRune, do.LeftParenRune)
+	for i, el := range tc.ExcludeElements() {
+		if i > 0 {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		esg.Generate(b, el.Column())
+		b.Write(do.ExcludeWithFragment).WriteStrings(el.Operator())
+	}
+	b.WriteRunes(do.RightParenRune)
+	if tc.WhereExpression() != nil {
+		b.Write(do.WhereFragment)
+		esg.wrappedExpressionSQL(b, tc.WhereExpression())
+	}
+}
+
+// Generates SQL for an expression wrapped in parens, boolean, range and list expressions are already wrapped
+func (esg *expressionSQLGenerator) wrappedExpressionSQL(b sb.SQLBuilder, e exp.Expression) {
+	wrappedExpressionSQL(b, esg, esg.dialectOptions, e)
+}
+
+// used internally to generate an expression wrapped in parens (e.g. CHECK ("a" > 0), WHEN ("a" > 0)).
+func wrappedExpressionSQL(b sb.SQLBuilder, esg ExpressionSQLGenerator, do *SQLDialectOptions, e exp.Expression) {
+	switch e.(type) {
+	case exp.BooleanExpression, exp.RangeExpression, exp.ExpressionList:
+		esg.Generate(b, e)
+	default:
+		b.WriteRunes(do.LeftParenRune)
+		esg.Generate(b, e)
+		b.WriteRunes(do.RightParenRune)
+	}
+}
+
+// Generates SQL for the PARTITION BY clause of a table
+//
+//	NewPartitionBy(RangePartition, "a") -> PARTITION BY RANGE ("a")
+//	NewPartitionBy(HashPartition, "a").Partitions(4) -> PARTITION BY HASH (`a`) PARTITIONS 4
+func (esg *expressionSQLGenerator) partitionBySQL(b sb.SQLBuilder, pb exp.PartitionBy) {
+	do := esg.dialectOptions
+	if do.PartitionByFragment == nil {
+		b.SetError(errPartitionByNotSupported(esg.dialect))
+		return
+	}
+	if pb.PartitionCount() > 0 && do.PartitionCountFragment == nil {
+		b.SetError(errPartitionFeatureNotSupported(esg.dialect, "PARTITIONS"))
+		return
+	}
+	b.Write(do.PartitionByFragment).WriteStrings(pb.PartitionType().String())
+	b.WriteRunes(do.SpaceRune, do.LeftParenRune)
+	esg.Generate(b, pb.Columns())
+	b.WriteRunes(do.RightParenRune)
+	if pb.PartitionCount() > 0 {
+		b.Write(do.PartitionCountFragment).WriteStrings(strconv.Itoa(pb.PartitionCount()))
+	}
+}
+
+// Generates SQL for the bound of a partition using the PartitionBoundLookup of the dialect
+//
+//	NewRangePartitionBound([]interface{}{1}, []interface{}{10}) -> FOR VALUES FROM (1) TO (10)
+//	NewListPartitionBound("a", "b") -> FOR VALUES IN ('a', 'b')
+//	NewHashPartitionBound(4, 0) -> FOR VALUES WITH (MODULUS 4, REMAINDER 0)
+//	NewLessThanPartitionBound(10) -> VALUES LESS THAN (10)
+//	NewDefaultPartitionBound() -> DEFAULT
+func (esg *expressionSQLGenerator) partitionBoundSQL(b sb.SQLBuilder, bound exp.PartitionBound) {
+	do := esg.dialectOptions
+	fragment, ok := do.PartitionBoundLookup[bound.BoundType()]
+	if !ok {
+		b.SetError(errPartitionBoundNotSupported(esg.dialect, bound.BoundType()))
+		return
+	}
+	if bound.BoundType() == exp.DefaultPartitionBound {
+		b.Write(fragment)
+		return
+	}
+	b.Write(do.PartitionValuesFragment).Write(fragment)
+	switch bound.BoundType() {
+	case exp.RangePartitionBound:
+		esg.partitionValuesSQL(b, bound.From())
+		b.Write(do.PartitionRangeToFragment)
+		esg.partitionValuesSQL(b, bound.To())
+	case exp.HashPartitionBound:
+		b.WriteStrings(strconv.Itoa(bound.Modulus()))
+		b.Write(do.PartitionRemainderFragment).WriteStrings(strconv.Itoa(bound.Remainder()))
+		b.WriteRunes(do.RightParenRune)
+	default:
+		esg.partitionValuesSQL(b, bound.Values())
+	}
+}
+
+func (esg *expressionSQLGenerator) partitionValuesSQL(b sb.SQLBuilder, vals []interface{}) {
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	for i, val := range vals {
+		if i > 0 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		esg.Generate(b, val)
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a partition defined in a CREATE or ALTER TABLE statement
+//
+//	NewPartitionDefinition(ParseIdentifier("p0"), NewLessThanPartitionBound(10)) -> PARTITION `p0` VALUES LESS THAN (10)
+func (esg *expressionSQLGenerator) partitionDefinitionSQL(b sb.SQLBuilder, pd exp.PartitionDefinition) {
+	if esg.dialectOptions.PartitionFragment == nil {
+		b.SetError(errPartitionFeatureNotSupported(esg.dialect, "partition definitions"))
+		return
+	}
+	b.Write(esg.dialectOptions.PartitionFragment)
+	esg.Generate(b, pd.Name())
+	if pd.Bound() != nil {
+		esg.Generate(b, pd.Bound())
+	}
+}
+
+// Generates SQL for the next or current value of a sequence, the name of the sequence is passed as a string to
+// the NextValFunction and CurrValFunction of the dialect unless the dialect uses NEXT VALUE FOR
+//
+//	NewSequenceValueExpression(ParseIdentifier("a_seq"), NextSequenceValue) -> nextval('"a_seq"')
+//	NewSequenceValueExpression(ParseIdentifier("a_seq"), CurrentSequenceValue) -> currval('"a_seq"')
+func (esg *expressionSQLGenerator) sequenceValueExpressionSQL(b sb.SQLBuilder, sv exp.SequenceValueExpression) {
+	var fn []byte
+	switch sv.ValueType() {
+	case exp.NextSequenceValue:
+		if esg.dialectOptions.NextValueForFragment != nil {
+			b.Write(esg.dialectOptions.NextValueForFragment)
+			esg.Generate(b, sv.Sequence())
+			return
+		}
+		fn = esg.dialectOptions.NextValFunction
+	case exp.CurrentSequenceValue:
+		fn = esg.dialectOptions.CurrValFunction
+	}
+	if fn == nil {
+		b.SetError(errSequenceValueNotSupported(esg.dialect, sv.ValueType()))
+		return
+	}
+	// the identifier is quoted so the name of the sequence is case sensitive like any other identifier
+	nb := sb.NewSQLBuilder(false)
+	esg.Generate(nb, sv.Sequence())
+	name, _, err := nb.ToSQL()
+	if err != nil {
+		b.SetError(err)
+		return
+	}
+	b.Write(fn).WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, name)
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a random value using the RandomFunction of the dialect (e.g. RANDOM(), RAND())
+func (esg *expressionSQLGenerator) randomExpressionSQL(b sb.SQLBuilder) {
+	if esg.dialectOptions.RandomFunction == nil {
+		b.SetError(errRandomNotSupported(esg.dialect))
+		return
+	}
+	b.Write(esg.dialectOptions.RandomFunction)
+}
+
 // Generates SQL for a CaseExpression
 func (esg *expressionSQLGenerator) caseExpressionSQL(b sb.SQLBuilder, caseExpression exp.CaseExpression) {
 	caseVal := caseExpression.GetValue()
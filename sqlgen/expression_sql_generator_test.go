@@ -1,6 +1,7 @@
 package sqlgen_test
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"regexp"
@@ -59,6 +60,23 @@ func (tae *testAppendableExpression) AppendSQL(b sb.SQLBuilder) {
 	}
 }
 
+type testCaseBuilder struct {
+	c exp.CaseExpression
+}
+
+func (tcb testCaseBuilder) Expression() exp.Expression { return tcb }
+func (tcb testCaseBuilder) Clone() exp.Expression      { return tcb }
+func (tcb testCaseBuilder) ToCase() exp.CaseExpression { return tcb.c }
+
+type testAliasedColumnsExpression struct {
+	exp.AppendableExpression
+	asColumns exp.ColumnListExpression
+}
+
+func (tace *testAliasedColumnsExpression) GetAsColumns() exp.ColumnListExpression {
+	return tace.asColumns
+}
+
 type (
 	expressionTestCase struct {
 		val        interface{}
@@ -157,6 +175,41 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_IncludePlaceholderNum() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_NamedArgPrefix() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.IncludePlaceholderNum = true
+	opts.PlaceHolderFragment = []byte("@p")
+	opts.NamedArgPrefix = "p"
+	ex := exp.Ex{
+		"a": 1,
+		"d": []string{"a", "b"},
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: ex, sql: `(("a" = 1) AND ("d" IN ('a', 'b')))`},
+		expressionTestCase{
+			val:        ex,
+			sql:        `(("a" = @p1) AND ("d" IN (@p2, @p3)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", "b"},
+		},
+	)
+
+	b := sb.NewSQLBuilder(true)
+	sqlgen.NewExpressionSQLGenerator("test", opts).Generate(b, ex)
+	actualSQL, actualArgs, err := b.ToNamedSQL()
+	esgs.NoError(err)
+	esgs.Equal(`(("a" = @p1) AND ("d" IN (@p2, @p3)))`, actualSQL)
+	esgs.Equal([]interface{}{sql.Named("p1", int64(1)), sql.Named("p2", "a"), sql.Named("p3", "b")}, actualArgs)
+
+	b = sb.NewSQLBuilderAt(true, 5)
+	sqlgen.NewExpressionSQLGenerator("test", opts).Generate(b, ex)
+	actualSQL, actualArgs, err = b.ToNamedSQL()
+	esgs.NoError(err)
+	esgs.Equal(`(("a" = @p5) AND ("d" IN (@p6, @p7)))`, actualSQL)
+	esgs.Equal([]interface{}{sql.Named("p5", int64(1)), sql.Named("p6", "a"), sql.Named("p7", "b")}, actualArgs)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_FloatTypes() {
 	var float float64
 	esgs.assertCases(
@@ -213,6 +266,20 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_StringTypes() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_StringTypesWithStringLiteralPrefix() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.StringLiteralPrefix = []byte("N")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: "Héllo", sql: "N'Héllo'"},
+		expressionTestCase{val: "Héllo", sql: "?", isPrepared: true, args: []interface{}{"Héllo"}},
+
+		expressionTestCase{val: "Héllo'", sql: "N'Héllo'''"},
+		expressionTestCase{val: []string{"a", "b"}, sql: "(N'a', N'b')"},
+		expressionTestCase{val: []byte("Hello"), sql: "'Hello'"},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_BytesTypes() {
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
@@ -239,6 +306,21 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_BoolTypes() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_BoolTypesWithBoolArgsAsInts() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.True = []byte("1")
+	opts.False = []byte("0")
+	opts.BoolArgsAsInts = true
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: true, sql: "1"},
+		expressionTestCase{val: true, sql: "?", isPrepared: true, args: []interface{}{int64(1)}},
+
+		expressionTestCase{val: false, sql: "0"},
+		expressionTestCase{val: false, sql: "?", isPrepared: true, args: []interface{}{int64(0)}},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_TimeTypes() {
 	var nt *time.Time
 
@@ -274,6 +356,14 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_TimeTypes() {
 		expressionTestCase{val: nt, sql: "NULL"},
 		expressionTestCase{val: nt, sql: "?", isPrepared: true, args: []interface{}{nil}},
 	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.TimeLiteralFragment = []byte("TIMESTAMP ")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: ts, sql: "TIMESTAMP '2019-10-01T15:01:00Z'"},
+		expressionTestCase{val: ts, sql: "?", isPrepared: true, args: []interface{}{ts}},
+	)
 	sqlgen.SetTimeLocation(originalLoc)
 }
 
@@ -317,6 +407,32 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_Valuer() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_Secret() {
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: exp.NewSecret("pass"), sql: "?", args: []interface{}{exp.NewSecret("pass")}},
+		expressionTestCase{
+			val: exp.NewSecret("pass"), sql: "?", isPrepared: true, args: []interface{}{exp.NewSecret("pass")},
+		},
+		expressionTestCase{
+			val:  exp.NewIdentifierExpression("", "", "a").Eq(exp.NewSecret(10)),
+			sql:  `("a" = ?)`,
+			args: []interface{}{exp.NewSecret(10)},
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_Out() {
+	var out string
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: sql.Out{Dest: &out}, sql: "?", args: []interface{}{sql.Out{Dest: &out}}},
+		expressionTestCase{
+			val: sql.Out{Dest: &out, In: true}, sql: "?", isPrepared: true, args: []interface{}{sql.Out{Dest: &out, In: true}},
+		},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_Slice() {
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
@@ -397,6 +513,39 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_AppendableExpression() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_AliasedColumnsExpression() {
+	ti := exp.NewIdentifierExpression("", "b", "")
+	cols := exp.NewColumnListExpression("c", "d")
+	aliasedA := &testAliasedColumnsExpression{
+		AppendableExpression: newTestAppendableExpression(`select * from "a"`, []interface{}{}, nil, ti),
+		asColumns:            cols,
+	}
+	noAlias := &testAliasedColumnsExpression{
+		AppendableExpression: newTestAppendableExpression(`select * from "a"`, []interface{}{}, nil, nil),
+		asColumns:            cols,
+	}
+	noCols := &testAliasedColumnsExpression{
+		AppendableExpression: newTestAppendableExpression(`select * from "a"`, []interface{}{}, nil, ti),
+	}
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: aliasedA, sql: `(select * from "a") AS "b"("c", "d")`},
+		expressionTestCase{val: aliasedA, sql: `(select * from "a") AS "b"("c", "d")`, isPrepared: true},
+
+		expressionTestCase{val: noAlias, sql: `(select * from "a")`},
+		expressionTestCase{val: noCols, sql: `(select * from "a") AS "b"`},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsDerivedColumnAliases = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: aliasedA, err: "goqu: dialect does not support derived table column aliases [dialect=test]"},
+		expressionTestCase{val: noCols, sql: `(select * from "a") AS "b"`},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_ColumnList() {
 	cl := exp.NewColumnListExpression("a", exp.NewLiteralExpression("true"))
 	esgs.assertCases(
@@ -494,6 +643,64 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_AliasedExpression() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_AliasedExpressionWithColumns() {
+	aliasedL := exp.NewLiteralExpression("generate_series(1, 3)").As("g").Columns("n")
+	aliasedV := exp.NewValuesExpression([]interface{}{1, "a"}).As("v").Columns("id", "name")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: aliasedL, sql: `generate_series(1, 3) AS "g"("n")`},
+		expressionTestCase{val: aliasedL, sql: `generate_series(1, 3) AS "g"("n")`, isPrepared: true},
+
+		expressionTestCase{val: aliasedV, sql: `(VALUES (1, 'a')) AS "v"("id", "name")`},
+		expressionTestCase{
+			val:        aliasedV,
+			sql:        `(VALUES (?, ?)) AS "v"("id", "name")`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a"},
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsDerivedColumnAliases = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: aliasedV, err: "goqu: dialect does not support derived table column aliases [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ValuesExpression() {
+	ve := exp.NewValuesExpression([]interface{}{1, "a"}, []interface{}{2, nil})
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: ve, sql: `(VALUES (1, 'a'), (2, NULL))`},
+		expressionTestCase{
+			val:        ve,
+			sql:        `(VALUES (?, ?), (?, ?))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", int64(2), nil},
+		},
+
+		expressionTestCase{val: exp.NewValuesExpression(), err: "goqu: at least one row is required when generating a VALUES list"},
+		expressionTestCase{
+			val: exp.NewValuesExpression([]interface{}{1, "a"}, []interface{}{2}),
+			err: "goqu: rows in a VALUES list must have the same number of values",
+		},
+		expressionTestCase{
+			val: exp.NewValuesExpression([]interface{}{}),
+			err: "goqu: rows in a VALUES list must have the same number of values",
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ValuesListFragment = []byte("values ")
+	opts.ValuesListRowFragment = []byte("row")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: ve, sql: `(values row(1, 'a'), row(2, NULL))`},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_BooleanExpressionAliased() {
 	ident := exp.NewIdentifierExpression("", "", "a")
 
@@ -504,6 +711,180 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_BooleanExpressionAliased()
 			isPrepared: true, args: []interface{}{int64(1)}},
 	)
 }
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_BooleanExpressionWithEmulatedIsBools() {
+	ident := exp.NewIdentifierExpression("", "", "a")
+	opts := sqlgen.DefaultDialectOptions()
+	opts.BooleanDataTypeSupported = false
+	opts.UseLiteralIsBools = false
+	opts.True = []byte("1")
+	opts.False = []byte("0")
+	opts.EmulateIsBools = true
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: ident.IsTrue(), sql: `("a" = 1)`},
+		expressionTestCase{val: ident.IsTrue(), sql: `("a" = 1)`, isPrepared: true},
+
+		expressionTestCase{val: ident.IsFalse(), sql: `("a" = 0)`},
+		expressionTestCase{val: ident.IsFalse(), sql: `("a" = 0)`, isPrepared: true},
+
+		expressionTestCase{val: ident.IsNotTrue(), sql: `(("a" != 1) OR ("a" IS NULL))`},
+		expressionTestCase{val: ident.IsNotTrue(), sql: `(("a" != 1) OR ("a" IS NULL))`, isPrepared: true},
+
+		expressionTestCase{val: ident.IsNotFalse(), sql: `(("a" != 0) OR ("a" IS NULL))`},
+		expressionTestCase{val: ident.IsNotFalse(), sql: `(("a" != 0) OR ("a" IS NULL))`, isPrepared: true},
+
+		expressionTestCase{val: ident.Eq(true), sql: `("a" = 1)`},
+		expressionTestCase{val: ident.IsNull(), sql: `("a" IS NULL)`},
+		expressionTestCase{val: ident.IsNotNull(), sql: `("a" IS NOT NULL)`},
+	)
+
+	opts.EmulateIsBools = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: ident.IsTrue(), err: `goqu: boolean data type is not supported by dialect "test"`},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_BooleanExpressionDistinctFrom() {
+	a := exp.NewIdentifierExpression("", "", "a")
+	b := exp.NewIdentifierExpression("", "", "b")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: a.IsDistinctFrom(b), sql: `("a" IS DISTINCT FROM "b")`},
+		expressionTestCase{val: a.IsNotDistinctFrom(b), sql: `("a" IS NOT DISTINCT FROM "b")`},
+		expressionTestCase{val: a.IsNotDistinctFrom(nil), sql: `("a" IS NOT DISTINCT FROM NULL)`},
+		expressionTestCase{
+			val:        a.IsDistinctFrom(1),
+			sql:        `("a" IS DISTINCT FROM ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	delete(opts.BooleanOperatorLookup, exp.IsDistinctFromOp)
+	delete(opts.BooleanOperatorLookup, exp.IsNotDistinctFromOp)
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{
+			val: a.IsDistinctFrom(b),
+			sql: `(CASE  WHEN (("a" = "b") OR (("a" IS NULL) AND ("b" IS NULL))) THEN 1 ELSE 0 END = 0)`,
+		},
+		expressionTestCase{
+			val: a.IsNotDistinctFrom(b),
+			sql: `(CASE  WHEN (("a" = "b") OR (("a" IS NULL) AND ("b" IS NULL))) THEN 1 ELSE 0 END = 1)`,
+		},
+		expressionTestCase{
+			val: a.IsNotDistinctFrom(1),
+			sql: `(CASE  WHEN (("a" = 1) OR (("a" IS NULL) AND (1 IS NULL))) THEN 1 ELSE 0 END = 1)`,
+		},
+		expressionTestCase{
+			val:        a.IsNotDistinctFrom(1),
+			sql:        `(CASE  WHEN (("a" = ?) OR (("a" IS NULL) AND (? IS NULL))) THEN 1 ELSE 0 END = 1)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(1)},
+		},
+		expressionTestCase{val: a.IsDistinctFrom(nil), sql: `("a" IS NOT NULL)`},
+		expressionTestCase{val: a.IsNotDistinctFrom(nil), sql: `("a" IS NULL)`},
+	)
+
+	opts.NullSafeEqualFragment = []byte("<=>")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: a.IsDistinctFrom(b), sql: `NOT ("a" <=> "b")`},
+		expressionTestCase{val: a.IsNotDistinctFrom(b), sql: `("a" <=> "b")`},
+		expressionTestCase{
+			val:        a.IsNotDistinctFrom(1),
+			sql:        `("a" <=> ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		expressionTestCase{val: a.IsDistinctFrom(nil), sql: `("a" IS NOT NULL)`},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_OperatorExpression() {
+	l2Distance := exp.NewBinaryOperator("l2_distance", "<->")
+	ltreeAncestor := exp.NewBinaryOperator("ltree_ancestor", "@>")
+	abs := exp.NewPrefixOperator("abs", "@")
+	col := exp.NewIdentifierExpression("", "", "a")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: l2Distance.Apply(col, "[1,2]"), sql: `("a" <-> '[1,2]')`},
+		expressionTestCase{
+			val:        l2Distance.Apply(col, "[1,2]"),
+			sql:        `("a" <-> ?)`,
+			isPrepared: true,
+			args:       []interface{}{"[1,2]"},
+		},
+		expressionTestCase{val: l2Distance.Apply(col, "[1,2]").Lt(0.5), sql: `(("a" <-> '[1,2]') < 0.5)`},
+		expressionTestCase{val: l2Distance.Apply(col, "[1,2]").Asc(), sql: `("a" <-> '[1,2]') ASC`},
+		expressionTestCase{val: ltreeAncestor.Apply("path", "a.b"), sql: `("path" @> 'a.b')`},
+		expressionTestCase{val: abs.Apply(col), sql: `(@ "a")`},
+		expressionTestCase{val: abs.Apply(-1), sql: `(@ ?)`, isPrepared: true, args: []interface{}{int64(-1)}},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CustomOperatorLookup = map[string][]byte{
+		"l2_distance":    []byte("<=>"),
+		"ltree_ancestor": nil,
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: l2Distance.Apply(col, "[1,2]"), sql: `("a" <=> '[1,2]')`},
+		expressionTestCase{val: abs.Apply(col), sql: `(@ "a")`},
+		expressionTestCase{
+			val: ltreeAncestor.Apply("path", "a.b"),
+			err: "goqu: dialect does not support operator ltree_ancestor [dialect=test]",
+		},
+		expressionTestCase{
+			val: exp.NewBinaryOperator("empty", "").Apply(col, 1),
+			err: "goqu: dialect does not support operator empty [dialect=test]",
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_BooleanExpressionWithEscape() {
+	ident := exp.NewIdentifierExpression("", "", "a")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: ident.Like(`100\%`).Escape(`\`), sql: `("a" LIKE '100\%' ESCAPE '\')`},
+		expressionTestCase{
+			val:        ident.Like(`100\%`).Escape(`\`),
+			sql:        `("a" LIKE ? ESCAPE ?)`,
+			isPrepared: true,
+			args:       []interface{}{`100\%`, `\`},
+		},
+		expressionTestCase{val: ident.NotLike(`100!%`).Escape("!"), sql: `("a" NOT LIKE '100!%' ESCAPE '!')`},
+		expressionTestCase{val: ident.ILike(`100!%`).Escape("!"), sql: `("a" ILIKE '100!%' ESCAPE '!')`},
+		expressionTestCase{val: ident.NotILike(`100!%`).Escape("!"), sql: `("a" NOT ILIKE '100!%' ESCAPE '!')`},
+		expressionTestCase{
+			val: ident.Eq(1).Escape("!"),
+			err: "goqu: ESCAPE can only be used with LIKE and ILIKE expressions, got eq",
+		},
+		expressionTestCase{
+			val: ident.Like(regexp.MustCompile("[ab]")).Escape("!"),
+			err: "goqu: ESCAPE can only be used with LIKE and ILIKE expressions, got regexplike",
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.LikeEscapeFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: ident.Like(`100\%`).Escape(`\`), sql: `("a" LIKE '100\%')`},
+		expressionTestCase{
+			val: ident.Like(`100!%`).Escape("!"),
+			err: "goqu: dialect does not support LIKE ESCAPE \"!\", only the default escape character \\ can be used " +
+				"[dialect=test]",
+		},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_BooleanExpression() {
 	ae := newTestAppendableExpression(`SELECT "id" FROM "test2"`, emptyArgs, nil, nil)
 	re := regexp.MustCompile("[ab]")
@@ -788,69 +1169,245 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLFunctionExpression() {
 		expressionTestCase{val: coalesce, sql: `COALESCE("a", 'a')`},
 		expressionTestCase{val: coalesce, sql: `COALESCE("a", ?)`, isPrepared: true, args: []interface{}{"a"}},
 	)
-}
-
-func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLWindowFunctionExpression() {
-	sqlWinFunc := exp.NewSQLWindowFunctionExpression(
-		exp.NewSQLFunctionExpression("some_func"),
-		nil,
-		exp.NewWindowExpression(
-			nil,
-			exp.NewIdentifierExpression("", "", "win"),
-			nil,
-			nil,
-		),
-	)
-	sqlWinFuncFromWindow := exp.NewSQLWindowFunctionExpression(
-		exp.NewSQLFunctionExpression("some_func"),
-		exp.NewIdentifierExpression("", "", "win"),
-		nil,
-	)
-
-	emptyWinFunc := exp.NewSQLWindowFunctionExpression(
-		exp.NewSQLFunctionExpression("some_func"),
-		nil,
-		nil,
-	)
-	badNamedSQLWinFuncInherit := exp.NewSQLWindowFunctionExpression(
-		exp.NewSQLFunctionExpression("some_func"),
-		nil,
-		exp.NewWindowExpression(
-			exp.NewIdentifierExpression("", "", "w"),
-			nil,
-			nil,
-			nil,
-		),
-	)
-	esgs.assertCases(
-		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
-		expressionTestCase{val: sqlWinFunc, sql: `some_func() OVER ("win")`},
-		expressionTestCase{val: sqlWinFunc, sql: `some_func() OVER ("win")`, isPrepared: true},
-
-		expressionTestCase{val: sqlWinFuncFromWindow, sql: `some_func() OVER "win"`},
-		expressionTestCase{val: sqlWinFuncFromWindow, sql: `some_func() OVER "win"`, isPrepared: true},
-
-		expressionTestCase{val: emptyWinFunc, sql: `some_func() OVER ()`},
-		expressionTestCase{val: emptyWinFunc, sql: `some_func() OVER ()`, isPrepared: true},
 
-		expressionTestCase{val: badNamedSQLWinFuncInherit, err: sqlgen.ErrUnexpectedNamedWindow.Error()},
-		expressionTestCase{val: badNamedSQLWinFuncInherit, err: sqlgen.ErrUnexpectedNamedWindow.Error(), isPrepared: true},
-	)
+	col := exp.NewIdentifierExpression("", "", "a")
 	opts := sqlgen.DefaultDialectOptions()
-	opts.SupportsWindowFunction = false
+	opts.FunctionNameLookup = map[string][]byte{"ceil": []byte("CEILING")}
+	opts.ModOperatorFragment = []byte(" % ")
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", opts),
-		expressionTestCase{val: sqlWinFunc, err: sqlgen.ErrWindowNotSupported("test").Error()},
-		expressionTestCase{val: sqlWinFunc, err: sqlgen.ErrWindowNotSupported("test").Error(), isPrepared: true},
+		expressionTestCase{val: exp.NewSQLFunctionExpression("CEIL", col), sql: `CEILING("a")`},
+		expressionTestCase{val: exp.NewSQLFunctionExpression("ceil", col), sql: `CEILING("a")`},
+		expressionTestCase{val: exp.NewSQLFunctionExpression("MOD", col, 2), sql: `("a" % 2)`},
+		expressionTestCase{
+			val:        exp.NewSQLFunctionExpression("MOD", col, 2),
+			sql:        `("a" % ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(2)},
+		},
+		expressionTestCase{val: min, sql: `MIN("a")`},
 	)
 }
 
-func (esgs *expressionSQLGeneratorSuite) TestGenerate_WindowExpression() {
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLFunctionExpressionWithDefinition() {
 	opts := sqlgen.DefaultDialectOptions()
-	opts.WindowPartitionByFragment = []byte("partition by ")
-	opts.WindowOrderByFragment = []byte("order by ")
+	opts.FunctionDefinitions = map[string]exp.FunctionDefinition{
+		"test_length":   exp.NewFunctionDefinition("test_length", 1, 1).WithSQLName("TEST_LEN"),
+		"test_substr":   exp.NewFunctionDefinition("test_substr", 2, 3),
+		"test_coalesce": exp.NewFunctionDefinition("test_coalesce", 1, exp.VariadicArgs),
+	}
 
-	emptySQLWinFunc := exp.NewWindowExpression(nil, nil, nil, nil)
+	col := exp.NewIdentifierExpression("", "", "a")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: exp.NewSQLFunctionExpression("test_length", col), sql: `TEST_LEN("a")`},
+		expressionTestCase{val: exp.NewSQLFunctionExpression("TEST_LENGTH", col), sql: `TEST_LEN("a")`},
+		expressionTestCase{val: exp.NewSQLFunctionExpression("test_substr", col, 1), sql: `test_substr("a", 1)`},
+		expressionTestCase{
+			val:        exp.NewSQLFunctionExpression("test_substr", col, 1, 2),
+			sql:        `test_substr("a", ?, ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(2)},
+		},
+		expressionTestCase{val: exp.NewSQLFunctionExpression("test_coalesce", col, 1, 2), sql: `test_coalesce("a", 1, 2)`},
+		expressionTestCase{
+			val: exp.NewSQLFunctionExpression("test_length", col, 1),
+			err: "goqu: function test_length expects 1 arguments but got 2 [dialect=test]",
+		},
+		expressionTestCase{
+			val: exp.NewSQLFunctionExpression("test_substr", col),
+			err: "goqu: function test_substr expects between 2 and 3 arguments but got 1 [dialect=test]",
+		},
+		expressionTestCase{
+			val: exp.NewSQLFunctionExpression("test_coalesce"),
+			err: "goqu: function test_coalesce expects at least 1 arguments but got 0 [dialect=test]",
+		},
+	)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("other", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: exp.NewSQLFunctionExpression("test_length", col), sql: `test_length("a")`},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLFunctionExpressionFilter() {
+	a := exp.NewIdentifierExpression("", "", "a")
+	b := exp.NewIdentifierExpression("", "", "b")
+	countStar := exp.NewSQLFunctionExpression("COUNT", exp.NewIdentifierExpression("", "", "*"))
+	sum := exp.NewSQLFunctionExpression("SUM", a)
+	stringAgg := exp.NewSQLFunctionExpression("STRING_AGG", a, ",")
+	win := exp.NewWindowExpression(nil, nil, exp.NewColumnListExpression(b), nil)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: countStar.Filter(a.Gt(1)), sql: `COUNT(*) FILTER (WHERE ("a" > 1))`},
+		expressionTestCase{
+			val:        countStar.Filter(a.Gt(1)),
+			sql:        `COUNT(*) FILTER (WHERE ("a" > ?))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		expressionTestCase{
+			val: sum.Filter(a.Gt(1), b.Eq("x")),
+			sql: `SUM("a") FILTER (WHERE (("a" > 1) AND ("b" = 'x')))`,
+		},
+		expressionTestCase{
+			val: countStar.Filter(a.Gt(1)).Over(win),
+			sql: `COUNT(*) FILTER (WHERE ("a" > 1)) OVER (PARTITION BY "b")`,
+		},
+		expressionTestCase{val: sum.Filter(), sql: `SUM("a")`},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.AggregateFilterFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: countStar.Filter(a.Gt(1)), sql: `SUM(CASE  WHEN ("a" > 1) THEN 1 ELSE 0 END)`},
+		expressionTestCase{
+			val:        countStar.Filter(a.Gt(1)),
+			sql:        `SUM(CASE  WHEN ("a" > ?) THEN ? ELSE ? END)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(1), int64(0)},
+		},
+		expressionTestCase{
+			val: exp.NewSQLFunctionExpression("COUNT").Filter(a.Gt(1)),
+			sql: `SUM(CASE  WHEN ("a" > 1) THEN 1 ELSE 0 END)`,
+		},
+		expressionTestCase{
+			val: exp.NewSQLFunctionExpression("count", exp.Star()).Filter(a.Gt(1)),
+			sql: `SUM(CASE  WHEN ("a" > 1) THEN 1 ELSE 0 END)`,
+		},
+		expressionTestCase{
+			val: exp.NewSQLFunctionExpression("COUNT", b).Filter(a.Gt(1)),
+			sql: `COUNT(CASE  WHEN ("a" > 1) THEN "b" END)`,
+		},
+		expressionTestCase{
+			val: sum.Filter(a.Gt(1), b.Eq("x")),
+			sql: `SUM(CASE  WHEN (("a" > 1) AND ("b" = 'x')) THEN "a" END)`,
+		},
+		expressionTestCase{
+			val: stringAgg.Filter(b.IsNotNull()),
+			sql: `STRING_AGG(CASE  WHEN ("b" IS NOT NULL) THEN "a" END, ',')`,
+		},
+		expressionTestCase{
+			val: countStar.Filter(a.Gt(1)).Over(win),
+			sql: `SUM(CASE  WHEN ("a" > 1) THEN 1 ELSE 0 END) OVER (PARTITION BY "b")`,
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLFunctionExpressionDistinctAndOrder() {
+	a := exp.NewIdentifierExpression("", "", "a")
+	b := exp.NewIdentifierExpression("", "", "b")
+	count := exp.NewSQLFunctionExpression("COUNT", a)
+	countDistinct := exp.NewSQLFunctionExpression("COUNT", exp.NewSQLFunctionExpression("DISTINCT", a))
+	stringAgg := exp.NewSQLFunctionExpression("STRING_AGG", a, ",")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: count.Distinct(), sql: `COUNT(DISTINCT "a")`},
+		expressionTestCase{val: countDistinct, sql: `COUNT(DISTINCT "a")`},
+		expressionTestCase{
+			val: exp.NewSQLFunctionExpression("COUNT", exp.NewSQLFunctionExpression("distinct", a, b)),
+			sql: `COUNT(DISTINCT "a", "b")`,
+		},
+		expressionTestCase{val: exp.NewSQLFunctionExpression("DISTINCT", a), sql: `DISTINCT("a")`},
+		expressionTestCase{val: stringAgg.OrderBy(b.Desc()), sql: `STRING_AGG("a", ',' ORDER BY "b" DESC)`},
+		expressionTestCase{
+			val:        stringAgg.OrderBy(b.Desc()),
+			sql:        `STRING_AGG("a", ? ORDER BY "b" DESC)`,
+			isPrepared: true,
+			args:       []interface{}{","},
+		},
+		expressionTestCase{
+			val: stringAgg.Distinct().OrderBy(a.Asc(), b.Desc()),
+			sql: `STRING_AGG(DISTINCT "a", ',' ORDER BY "a" ASC, "b" DESC)`,
+		},
+		expressionTestCase{
+			val: countDistinct.OrderBy(b.Asc()).Filter(b.Gt(1)),
+			sql: `COUNT(DISTINCT "a" ORDER BY "b" ASC) FILTER (WHERE ("b" > 1))`,
+		},
+		expressionTestCase{val: count.OrderBy(), sql: `COUNT("a")`},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.AggregateOrderByFragment = nil
+	opts.AggregateFilterFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: countDistinct, sql: `COUNT(DISTINCT "a")`},
+		expressionTestCase{
+			val: countDistinct.Filter(b.Gt(1)),
+			sql: `COUNT(DISTINCT CASE  WHEN ("b" > 1) THEN "a" END)`,
+		},
+		expressionTestCase{
+			val: stringAgg.OrderBy(b.Desc()),
+			err: "goqu: dialect does not support ORDER BY in aggregate functions [dialect=test]",
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLWindowFunctionExpression() {
+	sqlWinFunc := exp.NewSQLWindowFunctionExpression(
+		exp.NewSQLFunctionExpression("some_func"),
+		nil,
+		exp.NewWindowExpression(
+			nil,
+			exp.NewIdentifierExpression("", "", "win"),
+			nil,
+			nil,
+		),
+	)
+	sqlWinFuncFromWindow := exp.NewSQLWindowFunctionExpression(
+		exp.NewSQLFunctionExpression("some_func"),
+		exp.NewIdentifierExpression("", "", "win"),
+		nil,
+	)
+
+	emptyWinFunc := exp.NewSQLWindowFunctionExpression(
+		exp.NewSQLFunctionExpression("some_func"),
+		nil,
+		nil,
+	)
+	badNamedSQLWinFuncInherit := exp.NewSQLWindowFunctionExpression(
+		exp.NewSQLFunctionExpression("some_func"),
+		nil,
+		exp.NewWindowExpression(
+			exp.NewIdentifierExpression("", "", "w"),
+			nil,
+			nil,
+			nil,
+		),
+	)
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: sqlWinFunc, sql: `some_func() OVER ("win")`},
+		expressionTestCase{val: sqlWinFunc, sql: `some_func() OVER ("win")`, isPrepared: true},
+
+		expressionTestCase{val: sqlWinFuncFromWindow, sql: `some_func() OVER "win"`},
+		expressionTestCase{val: sqlWinFuncFromWindow, sql: `some_func() OVER "win"`, isPrepared: true},
+
+		expressionTestCase{val: emptyWinFunc, sql: `some_func() OVER ()`},
+		expressionTestCase{val: emptyWinFunc, sql: `some_func() OVER ()`, isPrepared: true},
+
+		expressionTestCase{val: badNamedSQLWinFuncInherit, err: sqlgen.ErrUnexpectedNamedWindow.Error()},
+		expressionTestCase{val: badNamedSQLWinFuncInherit, err: sqlgen.ErrUnexpectedNamedWindow.Error(), isPrepared: true},
+	)
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsWindowFunction = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: sqlWinFunc, err: sqlgen.ErrWindowNotSupported("test").Error()},
+		expressionTestCase{val: sqlWinFunc, err: sqlgen.ErrWindowNotSupported("test").Error(), isPrepared: true},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_WindowExpression() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.WindowPartitionByFragment = []byte("partition by ")
+	opts.WindowOrderByFragment = []byte("order by ")
+
+	emptySQLWinFunc := exp.NewWindowExpression(nil, nil, nil, nil)
 	namedSQLWinFunc := exp.NewWindowExpression(
 		exp.NewIdentifierExpression("", "", "w"), nil, nil, nil,
 	)
@@ -914,73 +1471,434 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_WindowExpression() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_WindowExpressionWithFrame() {
+	unboundedPreceding := exp.NewWindowFrameBound(exp.UnboundedPrecedingBound, nil)
+	currentRow := exp.NewWindowFrameBound(exp.CurrentRowBound, nil)
+	orderWin := exp.NewWindowExpression(nil, nil, nil, exp.NewColumnListExpression("a"))
+
+	rowsWin := orderWin.Rows(exp.NewWindowFrameBound(exp.PrecedingBound, 2), nil)
+	rowsBetweenWin := orderWin.Rows(unboundedPreceding, currentRow)
+	rangeWin := orderWin.Range(
+		exp.NewWindowFrameBound(exp.PrecedingBound, exp.NewLiteralExpression("INTERVAL '1 day'")),
+		exp.NewWindowFrameBound(exp.UnboundedFollowingBound, nil),
+	)
+	groupsWin := orderWin.
+		Groups(exp.NewWindowFrameBound(exp.PrecedingBound, 1), exp.NewWindowFrameBound(exp.FollowingBound, 1)).
+		Exclude(exp.ExcludeTies)
+	frameOnlyWin := exp.NewWindowExpression(nil, nil, nil, nil).Rows(unboundedPreceding, nil)
+	namedWin := exp.NewWindowExpression(exp.NewIdentifierExpression("", "", "w"), nil, nil, nil).
+		Rows(currentRow, nil).
+		Exclude(exp.ExcludeCurrentRow)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: rowsWin, sql: `(ORDER BY "a" ROWS 2 PRECEDING)`},
+		expressionTestCase{
+			val:        rowsWin,
+			sql:        `(ORDER BY "a" ROWS ? PRECEDING)`,
+			isPrepared: true,
+			args:       []interface{}{int64(2)},
+		},
+		expressionTestCase{val: rowsBetweenWin, sql: `(ORDER BY "a" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`},
+		expressionTestCase{
+			val: rangeWin,
+			sql: `(ORDER BY "a" RANGE BETWEEN INTERVAL '1 day' PRECEDING AND UNBOUNDED FOLLOWING)`,
+		},
+		expressionTestCase{
+			val: groupsWin,
+			sql: `(ORDER BY "a" GROUPS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE TIES)`,
+		},
+		expressionTestCase{val: frameOnlyWin, sql: `(ROWS UNBOUNDED PRECEDING)`},
+		expressionTestCase{val: namedWin, sql: `"w" AS (ROWS CURRENT ROW EXCLUDE CURRENT ROW)`},
+		expressionTestCase{
+			val: orderWin.Rows(nil, currentRow),
+			err: "goqu: a start boundary is required for a window frame",
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsWindowFrameGroups = false
+	opts.SupportsWindowFrameExclusion = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: rowsBetweenWin, sql: `(ORDER BY "a" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`},
+		expressionTestCase{val: groupsWin, err: "goqu: dialect does not support GROUPS window frames [dialect=test]"},
+		expressionTestCase{val: namedWin, err: "goqu: dialect does not support window frame exclusion [dialect=test]"},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_CastExpression() {
-	cast := exp.NewIdentifierExpression("", "", "a").Cast("DATE")
+	col := exp.NewIdentifierExpression("", "", "a")
+	cast := col.Cast("DATE")
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
 		expressionTestCase{val: cast, sql: `CAST("a" AS DATE)`},
 		expressionTestCase{val: cast, sql: `CAST("a" AS DATE)`, isPrepared: true},
+		expressionTestCase{val: col.Cast("timestamptz"), sql: `CAST("a" AS TIMESTAMP WITH TIME ZONE)`},
+		expressionTestCase{val: col.Cast("Text"), sql: `CAST("a" AS Text)`},
+		expressionTestCase{val: col.Cast("TIMESTAMPTZ"), sql: `CAST("a" AS TIMESTAMPTZ)`},
+		expressionTestCase{val: col.Cast("numeric(10, 2)"), sql: `CAST("a" AS numeric(10, 2))`},
+		expressionTestCase{val: col.Cast("CITEXT"), sql: `CAST("a" AS CITEXT)`},
 	)
-}
-
-// Generates the sql for the WITH clauses for common table expressions (CTE)
-func (esgs *expressionSQLGeneratorSuite) TestGenerate_CommonTableExpressionSlice() {
-	ae := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
 
-	cteNoArgs := []exp.CommonTableExpression{
-		exp.NewCommonTableExpression(false, "a", ae),
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CastTypeLookup = map[string][]byte{
+		"text":      []byte("CHAR"),
+		"embedding": []byte("VECTOR(3)"),
 	}
-	cteArgs := []exp.CommonTableExpression{
-		exp.NewCommonTableExpression(false, "a(x,y)", ae),
+	opts.DataTypeLookup = map[exp.DataTypeKind][]byte{
+		exp.UUIDDataType: []byte("UNIQUEIDENTIFIER"),
 	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: col.Cast("TEXT"), sql: `CAST("a" AS CHAR)`},
+		expressionTestCase{val: col.Cast("embedding"), sql: `CAST("a" AS VECTOR(3))`},
+		expressionTestCase{val: col.Cast("uuid"), sql: `CAST("a" AS UNIQUEIDENTIFIER)`},
+		expressionTestCase{val: col.Cast("UUID"), sql: `CAST("a" AS UUID)`},
+		expressionTestCase{val: col.Cast("json"), sql: `CAST("a" AS json)`},
+		expressionTestCase{val: col.Cast("DATE"), sql: `CAST("a" AS DATE)`, isPrepared: true},
+	)
+}
 
-	cteRecursiveNoArgs := []exp.CommonTableExpression{
-		exp.NewCommonTableExpression(true, "a", ae),
-	}
-	cteRecursiveArgs := []exp.CommonTableExpression{
-		exp.NewCommonTableExpression(true, "a(x,y)", ae),
-	}
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_SequenceValueExpression() {
+	next := exp.NewSequenceValueExpression(exp.ParseIdentifier("s.a_seq"), exp.NextSequenceValue)
+	curr := exp.NewSequenceValueExpression(exp.ParseIdentifier("a_seq"), exp.CurrentSequenceValue)
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: next, sql: `nextval('"s"."a_seq"')`},
+		expressionTestCase{val: next, sql: `nextval(?)`, isPrepared: true, args: []interface{}{`"s"."a_seq"`}},
+		expressionTestCase{val: curr, sql: `currval('"a_seq"')`},
+		expressionTestCase{val: next.Eq(curr), sql: `(nextval('"s"."a_seq"') = currval('"a_seq"'))`},
+	)
 
-	allCtes := []exp.CommonTableExpression{
-		exp.NewCommonTableExpression(false, "a", ae),
-		exp.NewCommonTableExpression(false, "a(x,y)", ae),
-	}
+	opts := sqlgen.DefaultDialectOptions()
+	opts.NextValueForFragment = []byte("NEXT VALUE FOR ")
+	opts.CurrValFunction = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: next, sql: `NEXT VALUE FOR "s"."a_seq"`},
+		expressionTestCase{val: next, sql: `NEXT VALUE FOR "s"."a_seq"`, isPrepared: true},
+		expressionTestCase{
+			val: curr,
+			err: "goqu: dialect does not support the CURRENT VALUE of a sequence [dialect=test]",
+		},
+	)
 
-	allRecursiveCtes := []exp.CommonTableExpression{
-		exp.NewCommonTableExpression(true, "a", ae),
-		exp.NewCommonTableExpression(true, "a(x,y)", ae),
-	}
+	opts = sqlgen.DefaultDialectOptions()
+	opts.NextValFunction = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{
+			val: next,
+			err: "goqu: dialect does not support the NEXT VALUE of a sequence [dialect=test]",
+		},
+	)
+}
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_RandomExpression() {
+	r := exp.NewRandomExpression()
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
-		expressionTestCase{val: cteNoArgs, sql: `WITH a AS (SELECT * FROM "b") `},
-		expressionTestCase{val: cteNoArgs, sql: `WITH a AS (SELECT * FROM "b") `, isPrepared: true},
+		expressionTestCase{val: r, sql: `RANDOM()`},
+		expressionTestCase{val: r, sql: `RANDOM()`, isPrepared: true},
+		expressionTestCase{val: r.Asc(), sql: `RANDOM() ASC`},
+		expressionTestCase{val: r.Lt(0.1), sql: `(RANDOM() < 0.1)`},
+		expressionTestCase{val: r.Lt(0.1), sql: `(RANDOM() < ?)`, isPrepared: true, args: []interface{}{0.1}},
+	)
 
-		expressionTestCase{val: cteArgs, sql: `WITH a(x,y) AS (SELECT * FROM "b") `},
-		expressionTestCase{val: cteArgs, sql: `WITH a(x,y) AS (SELECT * FROM "b") `, isPrepared: true},
+	opts := sqlgen.DefaultDialectOptions()
+	opts.RandomFunction = []byte("RAND()")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: r, sql: `RAND()`},
+		expressionTestCase{val: r.As("r"), sql: `RAND() AS "r"`},
+	)
 
-		expressionTestCase{val: cteRecursiveNoArgs, sql: `WITH RECURSIVE a AS (SELECT * FROM "b") `},
-		expressionTestCase{val: cteRecursiveNoArgs, sql: `WITH RECURSIVE a AS (SELECT * FROM "b") `, isPrepared: true},
+	opts = sqlgen.DefaultDialectOptions()
+	opts.RandomFunction = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: r, err: "goqu: dialect does not support random values [dialect=test]"},
+	)
+}
 
-		expressionTestCase{val: cteRecursiveArgs, sql: `WITH RECURSIVE a(x,y) AS (SELECT * FROM "b") `},
-		expressionTestCase{val: cteRecursiveArgs, sql: `WITH RECURSIVE a(x,y) AS (SELECT * FROM "b") `, isPrepared: true},
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_GroupingExpression() {
+	rollup := exp.NewRollupExpression("a", "b")
+	cube := exp.NewCubeExpression("a", "b")
+	sets := exp.NewGroupingSetsExpression([]interface{}{"a", "b"}, "a", nil)
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: rollup, sql: `ROLLUP ("a", "b")`},
+		expressionTestCase{val: rollup, sql: `ROLLUP ("a", "b")`, isPrepared: true},
+		expressionTestCase{val: cube, sql: `CUBE ("a", "b")`},
+		expressionTestCase{val: sets, sql: `GROUPING SETS (("a", "b"), ("a"), ())`},
+		expressionTestCase{val: sets, sql: `GROUPING SETS (("a", "b"), ("a"), ())`, isPrepared: true},
+	)
 
-		expressionTestCase{val: allCtes, sql: `WITH a AS (SELECT * FROM "b"), a(x,y) AS (SELECT * FROM "b") `},
-		expressionTestCase{val: allCtes, sql: `WITH a AS (SELECT * FROM "b"), a(x,y) AS (SELECT * FROM "b") `, isPrepared: true},
+	opts := sqlgen.DefaultDialectOptions()
+	opts.RollupFragment = nil
+	opts.WithRollupFragment = []byte(" WITH ROLLUP")
+	opts.CubeFragment = nil
+	opts.GroupingSetsFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: rollup, sql: `"a", "b" WITH ROLLUP`},
+		expressionTestCase{val: cube, err: "goqu: dialect does not support CUBE [dialect=test]"},
+		expressionTestCase{val: sets, err: "goqu: dialect does not support GROUPING SETS [dialect=test]"},
+	)
 
-		expressionTestCase{val: allRecursiveCtes, sql: `WITH RECURSIVE a AS (SELECT * FROM "b"), a(x,y) AS (SELECT * FROM "b") `},
+	opts = sqlgen.DefaultDialectOptions()
+	opts.RollupFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: rollup, err: "goqu: dialect does not support ROLLUP [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_DataType() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.DataTypeLookup = map[exp.DataTypeKind][]byte{
+		exp.IntegerDataType: []byte("int"),
+		exp.VarcharDataType: []byte("varchar"),
+		exp.DecimalDataType: []byte("decimal"),
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: exp.NewDataType(exp.IntegerDataType), sql: `int`},
+		expressionTestCase{val: exp.NewDataType(exp.VarcharDataType, 255), sql: `varchar(255)`},
+		expressionTestCase{val: exp.NewDataType(exp.DecimalDataType, 10, 2), sql: `decimal(10, 2)`},
+		expressionTestCase{val: exp.NewCustomDataType("CITEXT"), sql: `CITEXT`},
 		expressionTestCase{
-			val:        allRecursiveCtes,
-			sql:        `WITH RECURSIVE a AS (SELECT * FROM "b"), a(x,y) AS (SELECT * FROM "b") `,
-			isPrepared: true,
+			val: exp.NewDataType(exp.JSONDataType),
+			err: "goqu: dialect does not support data type JSON [dialect=test]",
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ColumnDefinition() {
+	cd := exp.NewColumnDefinition("a", exp.NewDataType(exp.IntegerDataType))
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: cd, sql: `"a" INTEGER`},
+		expressionTestCase{val: cd.NotNull(), sql: `"a" INTEGER NOT NULL`},
+		expressionTestCase{val: cd.Default(1), sql: `"a" INTEGER DEFAULT 1`},
+		expressionTestCase{val: cd.PrimaryKey(), sql: `"a" INTEGER PRIMARY KEY`},
+		expressionTestCase{val: cd.Unique(), sql: `"a" INTEGER UNIQUE`},
+		expressionTestCase{val: cd.AutoIncrement(), sql: `"a" INTEGER GENERATED BY DEFAULT AS IDENTITY`},
+		expressionTestCase{
+			val: cd.NotNull().Default(exp.NewLiteralExpression("0")).PrimaryKey().Unique(),
+			sql: `"a" INTEGER DEFAULT 0 NOT NULL PRIMARY KEY UNIQUE`,
+		},
+		expressionTestCase{
+			val: cd.NotNull().PrimaryKey().AutoIncrement(),
+			sql: `"a" INTEGER GENERATED BY DEFAULT AS IDENTITY NOT NULL PRIMARY KEY`,
 		},
 	)
+
 	opts := sqlgen.DefaultDialectOptions()
-	opts.SupportsWithCTE = false
+	opts.AutoIncrementFragment = nil
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", opts),
-		expressionTestCase{val: cteNoArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]"},
-		expressionTestCase{val: cteNoArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]", isPrepared: true},
+		expressionTestCase{
+			val: cd.AutoIncrement(),
+			err: "goqu: dialect does not support auto increment columns [dialect=test]",
+		},
+		expressionTestCase{
+			val: cd.Comment("the a"),
+			err: "goqu: dialect does not support comments in column definitions [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.AutoIncrementFragment = []byte(" AUTOINCREMENT")
+	opts.AutoIncrementAfterPrimaryKey = true
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: cd.NotNull().PrimaryKey().AutoIncrement(), sql: `"a" INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT`},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.ColumnCommentFragment = []byte(" COMMENT ")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: cd.Comment("the a"), sql: `"a" INTEGER COMMENT 'the a'`},
+		expressionTestCase{val: cd.NotNull().Unique().Comment("it's a"), sql: `"a" INTEGER NOT NULL UNIQUE COMMENT 'it''s a'`},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_TableConstraint() {
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: exp.NewPrimaryKeyConstraint("a", "b"), sql: `PRIMARY KEY ("a", "b")`},
+		expressionTestCase{val: exp.NewUniqueConstraint("a"), sql: `UNIQUE ("a")`},
+		expressionTestCase{
+			val: exp.NewUniqueConstraint("a").Named("a_uniq"),
+			sql: `CONSTRAINT "a_uniq" UNIQUE ("a")`,
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ForeignKeyConstraint() {
+	fk := exp.NewForeignKeyConstraint("a_id").References("s.a", "id")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: fk, sql: `FOREIGN KEY ("a_id") REFERENCES "s"."a" ("id")`},
+		expressionTestCase{
+			val: exp.NewForeignKeyConstraint("a_id").References("a"),
+			sql: `FOREIGN KEY ("a_id") REFERENCES "a"`,
+		},
+		expressionTestCase{
+			val: exp.NewForeignKeyConstraint("a_id", "b_id").References("a", "a_id", "b_id").Named("a_fk"),
+			sql: `CONSTRAINT "a_fk" FOREIGN KEY ("a_id", "b_id") REFERENCES "a" ("a_id", "b_id")`,
+		},
+		expressionTestCase{
+			val: fk.OnDelete(exp.CascadeReferentialAction),
+			sql: `FOREIGN KEY ("a_id") REFERENCES "s"."a" ("id") ON DELETE CASCADE`,
+		},
+		expressionTestCase{
+			val: fk.OnDelete(exp.SetNullReferentialAction).OnUpdate(exp.RestrictReferentialAction),
+			sql: `FOREIGN KEY ("a_id") REFERENCES "s"."a" ("id") ON DELETE SET NULL ON UPDATE RESTRICT`,
+		},
+		expressionTestCase{
+			val: fk.OnDelete(exp.SetDefaultReferentialAction).OnUpdate(exp.NoActionReferentialAction),
+			sql: `FOREIGN KEY ("a_id") REFERENCES "s"."a" ("id") ON DELETE SET DEFAULT ON UPDATE NO ACTION`,
+		},
+		expressionTestCase{
+			val: exp.NewForeignKeyConstraint("a_id"),
+			err: "goqu: a referenced table is required for a FOREIGN KEY constraint",
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ReferentialActionLookup = map[exp.ReferentialAction][]byte{exp.CascadeReferentialAction: []byte("CASCADE")}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{
+			val: fk.OnDelete(exp.CascadeReferentialAction),
+			sql: `FOREIGN KEY ("a_id") REFERENCES "s"."a" ("id") ON DELETE CASCADE`,
+		},
+		expressionTestCase{
+			val: fk.OnUpdate(exp.RestrictReferentialAction),
+			err: "goqu: dialect does not support the RESTRICT referential action [dialect=test]",
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_CheckConstraint() {
+	a := exp.NewIdentifierExpression("", "", "a")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: exp.NewCheckConstraint(a.Gt(0)), sql: `CHECK ("a" > 0)`},
+		expressionTestCase{
+			val: exp.NewCheckConstraint(exp.NewExpressionList(exp.AndType, a.Gt(0), a.Lt(10))).Named("a_check"),
+			sql: `CONSTRAINT "a_check" CHECK (("a" > 0) AND ("a" < 10))`,
+		},
+		expressionTestCase{
+			val: exp.NewCheckConstraint(a.Between(exp.NewRangeVal(1, 10))),
+			sql: `CHECK ("a" BETWEEN 1 AND 10)`,
+		},
+		expressionTestCase{val: exp.NewCheckConstraint(exp.NewLiteralExpression("a > 0")), sql: `CHECK (a > 0)`},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CheckFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{
+			val: exp.NewCheckConstraint(a.Gt(0)),
+			err: "goqu: dialect does not support CHECK constraints [dialect=test]",
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ExcludeConstraint() {
+	room := exp.NewExcludeElement("room", "=")
+	during := exp.NewExcludeElement("during", "&&")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{
+			val: exp.NewExcludeConstraint("gist", room, during),
+			sql: `EXCLUDE USING gist ("room" WITH =, "during" WITH &&)`,
+		},
+		expressionTestCase{
+			val: exp.NewExcludeConstraint("", room).Named("room_excl"),
+			sql: `CONSTRAINT "room_excl" EXCLUDE ("room" WITH =)`,
+		},
+		expressionTestCase{
+			val: exp.NewExcludeConstraint("gist", during).Where(exp.NewIdentifierExpression("", "", "active").IsTrue()),
+			sql: `EXCLUDE USING gist ("during" WITH &&) WHERE ("active" IS TRUE)`,
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ExcludeFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{
+			val: exp.NewExcludeConstraint("gist", during),
+			err: "goqu: dialect does not support EXCLUDE constraints [dialect=test]",
+		},
+	)
+}
+
+// Generates the sql for the WITH clauses for common table expressions (CTE)
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_CommonTableExpressionSlice() {
+	ae := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+
+	cteNoArgs := []exp.CommonTableExpression{
+		exp.NewCommonTableExpression(false, "a", ae),
+	}
+	cteArgs := []exp.CommonTableExpression{
+		exp.NewCommonTableExpression(false, "a(x,y)", ae),
+	}
+
+	cteRecursiveNoArgs := []exp.CommonTableExpression{
+		exp.NewCommonTableExpression(true, "a", ae),
+	}
+	cteRecursiveArgs := []exp.CommonTableExpression{
+		exp.NewCommonTableExpression(true, "a(x,y)", ae),
+	}
+
+	allCtes := []exp.CommonTableExpression{
+		exp.NewCommonTableExpression(false, "a", ae),
+		exp.NewCommonTableExpression(false, "a(x,y)", ae),
+	}
+
+	allRecursiveCtes := []exp.CommonTableExpression{
+		exp.NewCommonTableExpression(true, "a", ae),
+		exp.NewCommonTableExpression(true, "a(x,y)", ae),
+	}
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: cteNoArgs, sql: `WITH a AS (SELECT * FROM "b") `},
+		expressionTestCase{val: cteNoArgs, sql: `WITH a AS (SELECT * FROM "b") `, isPrepared: true},
+
+		expressionTestCase{val: cteArgs, sql: `WITH a(x,y) AS (SELECT * FROM "b") `},
+		expressionTestCase{val: cteArgs, sql: `WITH a(x,y) AS (SELECT * FROM "b") `, isPrepared: true},
+
+		expressionTestCase{val: cteRecursiveNoArgs, sql: `WITH RECURSIVE a AS (SELECT * FROM "b") `},
+		expressionTestCase{val: cteRecursiveNoArgs, sql: `WITH RECURSIVE a AS (SELECT * FROM "b") `, isPrepared: true},
+
+		expressionTestCase{val: cteRecursiveArgs, sql: `WITH RECURSIVE a(x,y) AS (SELECT * FROM "b") `},
+		expressionTestCase{val: cteRecursiveArgs, sql: `WITH RECURSIVE a(x,y) AS (SELECT * FROM "b") `, isPrepared: true},
+
+		expressionTestCase{val: allCtes, sql: `WITH a AS (SELECT * FROM "b"), a(x,y) AS (SELECT * FROM "b") `},
+		expressionTestCase{val: allCtes, sql: `WITH a AS (SELECT * FROM "b"), a(x,y) AS (SELECT * FROM "b") `, isPrepared: true},
+
+		expressionTestCase{val: allRecursiveCtes, sql: `WITH RECURSIVE a AS (SELECT * FROM "b"), a(x,y) AS (SELECT * FROM "b") `},
+		expressionTestCase{
+			val:        allRecursiveCtes,
+			sql:        `WITH RECURSIVE a AS (SELECT * FROM "b"), a(x,y) AS (SELECT * FROM "b") `,
+			isPrepared: true,
+		},
+	)
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsWithCTE = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: cteNoArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]"},
+		expressionTestCase{val: cteNoArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]", isPrepared: true},
 
 		expressionTestCase{val: cteArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]"},
 		expressionTestCase{val: cteArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]", isPrepared: true},
@@ -988,223 +1906,1011 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_CommonTableExpressionSlice
 		expressionTestCase{val: cteRecursiveNoArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]"},
 		expressionTestCase{val: cteRecursiveNoArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]", isPrepared: true},
 
-		expressionTestCase{val: cteRecursiveArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]"},
-		expressionTestCase{val: cteRecursiveArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]", isPrepared: true},
+		expressionTestCase{val: cteRecursiveArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]"},
+		expressionTestCase{val: cteRecursiveArgs, err: "goqu: dialect does not support CTE WITH clause [dialect=test]", isPrepared: true},
+	)
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SupportsWithCTERecursive = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: cteNoArgs, sql: `WITH a AS (SELECT * FROM "b") `},
+		expressionTestCase{val: cteNoArgs, sql: `WITH a AS (SELECT * FROM "b") `, isPrepared: true},
+
+		expressionTestCase{val: cteArgs, sql: `WITH a(x,y) AS (SELECT * FROM "b") `},
+		expressionTestCase{val: cteArgs, sql: `WITH a(x,y) AS (SELECT * FROM "b") `, isPrepared: true},
+
+		expressionTestCase{
+			val: cteRecursiveNoArgs,
+			err: "goqu: dialect does not support CTE WITH RECURSIVE clause [dialect=test]",
+		},
+		expressionTestCase{
+			val:        cteRecursiveNoArgs,
+			err:        "goqu: dialect does not support CTE WITH RECURSIVE clause [dialect=test]",
+			isPrepared: true,
+		},
+
+		expressionTestCase{
+			val: cteRecursiveArgs,
+			err: "goqu: dialect does not support CTE WITH RECURSIVE clause [dialect=test]",
+		},
+		expressionTestCase{
+			val:        cteRecursiveArgs,
+			err:        "goqu: dialect does not support CTE WITH RECURSIVE clause [dialect=test]",
+			isPrepared: true,
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_CommonTableExpression() {
+	ae := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+
+	cteNoArgs := exp.NewCommonTableExpression(false, "a", ae)
+	cteArgs := exp.NewCommonTableExpression(false, "a(x,y)", ae)
+
+	cteRecursiveNoArgs := exp.NewCommonTableExpression(true, "a", ae)
+	cteRecursiveArgs := exp.NewCommonTableExpression(true, "a(x,y)", ae)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: cteNoArgs, sql: `a AS (SELECT * FROM "b")`},
+		expressionTestCase{val: cteNoArgs, sql: `a AS (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: cteArgs, sql: `a(x,y) AS (SELECT * FROM "b")`},
+		expressionTestCase{val: cteArgs, sql: `a(x,y) AS (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: cteRecursiveNoArgs, sql: `a AS (SELECT * FROM "b")`},
+		expressionTestCase{val: cteRecursiveNoArgs, sql: `a AS (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: cteRecursiveArgs, sql: `a(x,y) AS (SELECT * FROM "b")`},
+		expressionTestCase{val: cteRecursiveArgs, sql: `a(x,y) AS (SELECT * FROM "b")`, isPrepared: true},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_CommonTableExpressionMaterialization() {
+	ae := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+
+	cteMaterialized := exp.NewCommonTableExpression(false, "a", ae).SetMaterialization(exp.MaterializedCTE)
+	cteNotMaterialized := exp.NewCommonTableExpression(false, "a", ae).SetMaterialization(exp.NotMaterializedCTE)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: cteMaterialized, sql: `a AS MATERIALIZED (SELECT * FROM "b")`},
+		expressionTestCase{val: cteMaterialized, sql: `a AS MATERIALIZED (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: cteNotMaterialized, sql: `a AS NOT MATERIALIZED (SELECT * FROM "b")`},
+		expressionTestCase{val: cteNotMaterialized, sql: `a AS NOT MATERIALIZED (SELECT * FROM "b")`, isPrepared: true},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.MaterializedCTEFragment = nil
+	opts.NotMaterializedCTEFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{
+			val: cteMaterialized,
+			err: "goqu: dialect does not support MATERIALIZED common table expressions [dialect=test]",
+		},
+		expressionTestCase{
+			val: cteNotMaterialized,
+			err: "goqu: dialect does not support NOT MATERIALIZED common table expressions [dialect=test]",
+		},
+		expressionTestCase{val: exp.NewCommonTableExpression(false, "a", ae), sql: `a AS (SELECT * FROM "b")`},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_CommonTableExpressionSearchCycle() {
+	ae := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+
+	cte := exp.NewCommonTableExpression(true, "a(id)", ae)
+	depthFirst := cte.SetSearch(exp.NewCTESearchExpression(exp.DepthFirstSearch, "id").Set("ord"))
+	breadthFirst := cte.SetSearch(exp.NewCTESearchExpression(exp.BreadthFirstSearch, "id", "name").Set("ord"))
+	cycle := cte.SetCycle(exp.NewCTECycleExpression("id").Set("is_cycle").Using("path"))
+	cycleTo := cte.SetCycle(exp.NewCTECycleExpression("id").Set("is_cycle").To("Y", "N").Using("path"))
+	searchCycle := depthFirst.SetCycle(exp.NewCTECycleExpression("id").Set("is_cycle").Using("path"))
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: depthFirst, sql: `a(id) AS (SELECT * FROM "b") SEARCH DEPTH FIRST BY "id" SET "ord"`},
+		expressionTestCase{
+			val: breadthFirst,
+			sql: `a(id) AS (SELECT * FROM "b") SEARCH BREADTH FIRST BY "id", "name" SET "ord"`,
+		},
+		expressionTestCase{val: cycle, sql: `a(id) AS (SELECT * FROM "b") CYCLE "id" SET "is_cycle" USING "path"`},
+		expressionTestCase{
+			val: cycleTo,
+			sql: `a(id) AS (SELECT * FROM "b") CYCLE "id" SET "is_cycle" TO 'Y' DEFAULT 'N' USING "path"`,
+		},
+		expressionTestCase{
+			val:        cycleTo,
+			sql:        `a(id) AS (SELECT * FROM "b") CYCLE "id" SET "is_cycle" TO ? DEFAULT ? USING "path"`,
+			isPrepared: true,
+			args:       []interface{}{"Y", "N"},
+		},
+		expressionTestCase{
+			val: searchCycle,
+			sql: `a(id) AS (SELECT * FROM "b") SEARCH DEPTH FIRST BY "id" SET "ord" CYCLE "id" SET "is_cycle" USING "path"`,
+		},
+
+		expressionTestCase{
+			val: exp.NewCommonTableExpression(false, "a", ae).SetSearch(exp.NewCTESearchExpression(exp.DepthFirstSearch, "id").Set("ord")),
+			err: "goqu: SEARCH and CYCLE clauses require a recursive common table expression",
+		},
+		expressionTestCase{
+			val: cte.SetCycle(exp.NewCTECycleExpression("id")),
+			err: "goqu: a SET column is required for SEARCH and CYCLE clauses",
+		},
+		expressionTestCase{
+			val: cte.SetSearch(exp.NewCTESearchExpression(exp.DepthFirstSearch, "id")),
+			err: "goqu: a SET column is required for SEARCH and CYCLE clauses",
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.CTESearchModeLookup = map[exp.CTESearchMode][]byte{exp.DepthFirstSearch: []byte("DEPTH FIRST BY ")}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: breadthFirst, err: "goqu: dialect does not support SEARCH BREADTH FIRST [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.CTESearchFragment = nil
+	opts.CTECycleFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{
+			val: depthFirst,
+			err: "goqu: dialect does not support SEARCH clause on common table expressions [dialect=test]",
+		},
+		expressionTestCase{
+			val: cycle,
+			err: "goqu: dialect does not support CYCLE clause on common table expressions [dialect=test]",
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_CompoundExpression() {
+	ae := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
+
+	u := exp.NewCompoundExpression(exp.UnionCompoundType, ae)
+	ua := exp.NewCompoundExpression(exp.UnionAllCompoundType, ae)
+
+	i := exp.NewCompoundExpression(exp.IntersectCompoundType, ae)
+	ia := exp.NewCompoundExpression(exp.IntersectAllCompoundType, ae)
+
+	e := exp.NewCompoundExpression(exp.ExceptCompoundType, ae)
+	ea := exp.NewCompoundExpression(exp.ExceptAllCompoundType, ae)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: u, sql: ` UNION (SELECT * FROM "b")`},
+		expressionTestCase{val: u, sql: ` UNION (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: ua, sql: ` UNION ALL (SELECT * FROM "b")`},
+		expressionTestCase{val: ua, sql: ` UNION ALL (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: i, sql: ` INTERSECT (SELECT * FROM "b")`},
+		expressionTestCase{val: i, sql: ` INTERSECT (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: ia, sql: ` INTERSECT ALL (SELECT * FROM "b")`},
+		expressionTestCase{val: ia, sql: ` INTERSECT ALL (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: e, sql: ` EXCEPT (SELECT * FROM "b")`},
+		expressionTestCase{val: e, sql: ` EXCEPT (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL (SELECT * FROM "b")`},
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL (SELECT * FROM "b")`, isPrepared: true},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.WrapCompoundsInParens = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: u, sql: ` UNION SELECT * FROM "b"`},
+		expressionTestCase{val: u, sql: ` UNION SELECT * FROM "b"`, isPrepared: true},
+
+		expressionTestCase{val: ua, sql: ` UNION ALL SELECT * FROM "b"`},
+		expressionTestCase{val: ua, sql: ` UNION ALL SELECT * FROM "b"`, isPrepared: true},
+
+		expressionTestCase{val: i, sql: ` INTERSECT SELECT * FROM "b"`},
+		expressionTestCase{val: i, sql: ` INTERSECT SELECT * FROM "b"`, isPrepared: true},
+
+		expressionTestCase{val: ia, sql: ` INTERSECT ALL SELECT * FROM "b"`},
+		expressionTestCase{val: ia, sql: ` INTERSECT ALL SELECT * FROM "b"`, isPrepared: true},
+
+		expressionTestCase{val: e, sql: ` EXCEPT SELECT * FROM "b"`},
+		expressionTestCase{val: e, sql: ` EXCEPT SELECT * FROM "b"`, isPrepared: true},
+
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL SELECT * FROM "b"`},
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL SELECT * FROM "b"`, isPrepared: true},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.IntersectAllFragment = nil
+	opts.ExceptFragment = nil
+	opts.ExceptAllFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: i, sql: ` INTERSECT (SELECT * FROM "b")`},
+		expressionTestCase{val: ia, err: "goqu: dialect does not support INTERSECT ALL [dialect=test]"},
+		expressionTestCase{val: e, err: "goqu: dialect does not support EXCEPT [dialect=test]"},
+		expressionTestCase{val: ea, err: "goqu: dialect does not support EXCEPT ALL [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_IdentifierExpression() {
+	col := exp.NewIdentifierExpression("", "", "col")
+	colStar := exp.NewIdentifierExpression("", "", "*")
+	table := exp.NewIdentifierExpression("", "table", "")
+	schema := exp.NewIdentifierExpression("schema", "", "")
+	tableCol := exp.NewIdentifierExpression("", "table", "col")
+	schemaTableCol := exp.NewIdentifierExpression("schema", "table", "col")
+
+	parsedCol := exp.ParseIdentifier("col")
+	parsedTableCol := exp.ParseIdentifier("table.col")
+	parsedSchemaTableCol := exp.ParseIdentifier("schema.table.col")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{
+			val: exp.NewIdentifierExpression("", "", ""),
+			err: `goqu: a empty identifier was encountered, please specify a "schema", "table" or "column"`,
+		},
+		expressionTestCase{
+			val: exp.NewIdentifierExpression("", "", nil),
+			err: `goqu: a empty identifier was encountered, please specify a "schema", "table" or "column"`,
+		},
+		expressionTestCase{
+			val: exp.NewIdentifierExpression("", "", false),
+			err: `goqu: unexpected col type must be string or LiteralExpression received bool`,
+		},
+
+		expressionTestCase{val: col, sql: `"col"`},
+		expressionTestCase{val: col, sql: `"col"`, isPrepared: true},
+
+		expressionTestCase{val: col.Table("table"), sql: `"table"."col"`},
+		expressionTestCase{val: col.Table("table"), sql: `"table"."col"`, isPrepared: true},
+
+		expressionTestCase{val: col.Table("table").Schema("schema"), sql: `"schema"."table"."col"`},
+		expressionTestCase{val: col.Table("table").Schema("schema"), sql: `"schema"."table"."col"`, isPrepared: true},
+
+		expressionTestCase{val: colStar, sql: `*`},
+		expressionTestCase{val: colStar, sql: `*`, isPrepared: true},
+
+		expressionTestCase{val: colStar.Table("table"), sql: `"table".*`},
+		expressionTestCase{val: colStar.Table("table"), sql: `"table".*`, isPrepared: true},
+
+		expressionTestCase{val: colStar.Table("table").Schema("schema"), sql: `"schema"."table".*`},
+		expressionTestCase{val: colStar.Table("table").Schema("schema"), sql: `"schema"."table".*`, isPrepared: true},
+
+		expressionTestCase{val: table, sql: `"table"`},
+		expressionTestCase{val: table, sql: `"table"`, isPrepared: true},
+
+		expressionTestCase{val: table.Col("col"), sql: `"table"."col"`},
+		expressionTestCase{val: table.Col("col"), sql: `"table"."col"`, isPrepared: true},
+
+		expressionTestCase{val: table.Col(nil), sql: `"table"`},
+		expressionTestCase{val: table.Col(nil), sql: `"table"`, isPrepared: true},
+
+		expressionTestCase{val: table.Col("*"), sql: `"table".*`},
+		expressionTestCase{val: table.Col("*"), sql: `"table".*`, isPrepared: true},
+
+		expressionTestCase{val: table.Schema("schema").Col("col"), sql: `"schema"."table"."col"`},
+		expressionTestCase{val: table.Schema("schema").Col("col"), sql: `"schema"."table"."col"`, isPrepared: true},
+
+		expressionTestCase{val: schema, sql: `"schema"`},
+		expressionTestCase{val: schema, sql: `"schema"`, isPrepared: true},
+
+		expressionTestCase{val: schema.Table("table"), sql: `"schema"."table"`},
+		expressionTestCase{val: schema.Table("table"), sql: `"schema"."table"`, isPrepared: true},
+
+		expressionTestCase{val: schema.Table("table").Col("col"), sql: `"schema"."table"."col"`},
+		expressionTestCase{val: schema.Table("table").Col("col"), sql: `"schema"."table"."col"`, isPrepared: true},
+
+		expressionTestCase{val: schema.Table("table").Col(nil), sql: `"schema"."table"`},
+		expressionTestCase{val: schema.Table("table").Col(nil), sql: `"schema"."table"`, isPrepared: true},
+
+		expressionTestCase{val: schema.Table("table").Col("*"), sql: `"schema"."table".*`},
+		expressionTestCase{val: schema.Table("table").Col("*"), sql: `"schema"."table".*`, isPrepared: true},
+
+		expressionTestCase{val: tableCol, sql: `"table"."col"`},
+		expressionTestCase{val: tableCol, sql: `"table"."col"`, isPrepared: true},
+
+		expressionTestCase{val: schemaTableCol, sql: `"schema"."table"."col"`},
+		expressionTestCase{val: schemaTableCol, sql: `"schema"."table"."col"`, isPrepared: true},
+
+		expressionTestCase{val: parsedCol, sql: `"col"`},
+		expressionTestCase{val: parsedCol, sql: `"col"`, isPrepared: true},
+
+		expressionTestCase{val: parsedTableCol, sql: `"table"."col"`},
+		expressionTestCase{val: parsedTableCol, sql: `"table"."col"`, isPrepared: true},
+
+		expressionTestCase{val: parsedSchemaTableCol, sql: `"schema"."table"."col"`},
+		expressionTestCase{val: parsedSchemaTableCol, sql: `"schema"."table"."col"`, isPrepared: true},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_PlaceholdersNotSupported() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsPlaceholders = false
+	expectedErr := "goqu: dialect does not support placeholders, values must be interpolated [dialect=test]"
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: 1, sql: `1`},
+		expressionTestCase{val: "a", sql: `'a'`},
+		expressionTestCase{val: exp.NewIdentifierExpression("", "", "a").Eq(1), sql: `("a" = 1)`},
+		expressionTestCase{val: 1, err: expectedErr, isPrepared: true},
+		expressionTestCase{val: exp.NewIdentifierExpression("", "", "a").Eq(1), err: expectedErr, isPrepared: true},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_IdentifierExpressionUpperCase() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.UpperCaseIdentifiers = true
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: exp.ParseIdentifier("col"), sql: `"COL"`},
+		expressionTestCase{val: exp.ParseIdentifier("schema.table.col"), sql: `"SCHEMA"."TABLE"."COL"`},
+		expressionTestCase{val: exp.ParseIdentifier("schema.table.col"), sql: `"SCHEMA"."TABLE"."COL"`, isPrepared: true},
+		expressionTestCase{val: exp.NewIdentifierExpression("", "table", "*"), sql: `"TABLE".*`},
+		expressionTestCase{val: exp.NewIdentifierExpression("", "table", exp.NewLiteralExpression("col")), sql: `"TABLE".col`},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_IdentifierExpressionMaxLength() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.MaxIdentifierLength = 5
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: exp.ParseIdentifier("abcde.çolùm"), sql: `"abcde"."çolùm"`},
+		expressionTestCase{
+			val: exp.ParseIdentifier("abcdef.col"),
+			err: `goqu: identifier "abcdef" is longer than the maximum identifier length of 5 characters [dialect=test]`,
+		},
+		expressionTestCase{
+			val:        exp.ParseIdentifier("table.column"),
+			err:        `goqu: identifier "column" is longer than the maximum identifier length of 5 characters [dialect=test]`,
+			isPrepared: true,
+		},
+		expressionTestCase{
+			val: exp.NewIdentifierExpression("", "table", exp.NewLiteralExpression("long_column")),
+			sql: `"table".long_column`,
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_LateralExpression() {
+	lateralExp := exp.NewLateralExpression(newTestAppendableExpression(`SELECT * FROM "test"`, emptyArgs, nil, nil))
+
+	do := sqlgen.DefaultDialectOptions()
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: lateralExp, sql: `LATERAL (SELECT * FROM "test")`},
+		expressionTestCase{val: lateralExp, sql: `LATERAL (SELECT * FROM "test")`, isPrepared: true},
+	)
+
+	do = sqlgen.DefaultDialectOptions()
+	do.LateralFragment = []byte("lateral ")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: lateralExp, sql: `lateral (SELECT * FROM "test")`},
+		expressionTestCase{val: lateralExp, sql: `lateral (SELECT * FROM "test")`, isPrepared: true},
+	)
+	do = sqlgen.DefaultDialectOptions()
+	do.SupportsLateral = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: lateralExp, err: "goqu: dialect does not support lateral expressions [dialect=test]"},
+		expressionTestCase{val: lateralExp, err: "goqu: dialect does not support lateral expressions [dialect=test]", isPrepared: true},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_PivotExpression() {
+	table := exp.NewIdentifierExpression("", "sales", "")
+	sum := exp.NewSQLFunctionExpression("SUM", exp.NewIdentifierExpression("", "", "amount"))
+	cnt := exp.NewSQLFunctionExpression("COUNT", exp.Star())
+	pe := exp.NewPivotExpression(table, sum).For("month").In("JAN", exp.NewLiteralExpression("?", "FEB").As("feb"))
+	mpe := exp.NewPivotExpression(table, sum.As("total"), cnt.As("cnt")).For("month").In("JAN")
+
+	do := sqlgen.DefaultDialectOptions()
+	do.PivotFragment = []byte(" PIVOT ")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: pe, sql: `"sales" PIVOT (SUM("amount") FOR "month" IN ('JAN', 'FEB' AS "feb"))`},
+		// the IN values are always interpolated
+		expressionTestCase{
+			val:        pe,
+			sql:        `"sales" PIVOT (SUM("amount") FOR "month" IN ('JAN', 'FEB' AS "feb"))`,
+			isPrepared: true,
+		},
+		expressionTestCase{
+			val: mpe,
+			sql: `"sales" PIVOT (SUM("amount") AS "total", COUNT(*) AS "cnt" FOR "month" IN ('JAN'))`,
+		},
+		expressionTestCase{
+			val: exp.NewPivotExpression(table).For("month").In("JAN"),
+			err: "goqu: at least one aggregate is required for PIVOT",
+		},
+		expressionTestCase{
+			val: exp.NewPivotExpression(table, sum).In("JAN"),
+			err: "goqu: a FOR column and at least one IN value are required for PIVOT and UNPIVOT",
+		},
+		expressionTestCase{
+			val: exp.NewPivotExpression(table, sum).For("month"),
+			err: "goqu: a FOR column and at least one IN value are required for PIVOT and UNPIVOT",
+		},
+	)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: pe, err: "goqu: dialect does not support PIVOT [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_UnpivotExpression() {
+	table := exp.NewIdentifierExpression("", "sales", "")
+	ue := exp.NewUnpivotExpression(table, "amount").For("month").In("jan", exp.NewIdentifierExpression("", "", "feb"))
+
+	do := sqlgen.DefaultDialectOptions()
+	do.UnpivotFragment = []byte(" UNPIVOT ")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: ue, sql: `"sales" UNPIVOT ("amount" FOR "month" IN ("jan", "feb"))`},
+		expressionTestCase{val: ue, sql: `"sales" UNPIVOT ("amount" FOR "month" IN ("jan", "feb"))`, isPrepared: true},
+		expressionTestCase{
+			val: ue.IncludeNulls(),
+			err: "goqu: dialect does not support UNPIVOT INCLUDE NULLS [dialect=test]",
+		},
+		expressionTestCase{
+			val: exp.NewUnpivotExpression(table, "amount").For("month"),
+			err: "goqu: a FOR column and at least one IN value are required for PIVOT and UNPIVOT",
+		},
+	)
+
+	do.UnpivotIncludeNullsFragment = []byte("INCLUDE NULLS ")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: ue, sql: `"sales" UNPIVOT ("amount" FOR "month" IN ("jan", "feb"))`},
+		expressionTestCase{
+			val: ue.IncludeNulls(),
+			sql: `"sales" UNPIVOT INCLUDE NULLS ("amount" FOR "month" IN ("jan", "feb"))`,
+		},
+	)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: ue, err: "goqu: dialect does not support UNPIVOT [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_JSONExpression() {
+	data := exp.NewJSONAccessor(exp.NewIdentifierExpression("", "", "data"))
+
+	do := sqlgen.DefaultDialectOptions()
+	do.JSONOperatorLookup = map[exp.JSONOperation][]byte{
+		exp.JSONGetOp:         []byte("->"),
+		exp.JSONGetTextOp:     []byte("->>"),
+		exp.JSONGetPathOp:     []byte("#>"),
+		exp.JSONGetPathTextOp: []byte("#>>"),
+		exp.JSONContainsOp:    []byte("@>"),
+		exp.JSONContainedByOp: []byte("<@"),
+		exp.JSONHasKeyOp:      []byte("?"),
+		exp.JSONHasAnyKeyOp:   []byte("?|"),
+		exp.JSONHasAllKeysOp:  []byte("?&"),
+		exp.JSONConcatOp:      []byte("||"),
+	}
+	do.LeftSliceFragment = []byte("'{")
+	do.RightSliceFragment = []byte("}'")
+	do.StringSliceQuote = '"'
+	do.SinglePlaceholderForSlice = true
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: data.Get("a"), sql: `("data" -> 'a')`},
+		expressionTestCase{val: data.Get(0), sql: `("data" -> 0)`},
+		expressionTestCase{val: data.Get("a").GetText("b"), sql: `(("data" -> 'a') ->> 'b')`},
+		expressionTestCase{
+			val:        data.GetText("a").Eq("b"),
+			sql:        `(("data" ->> ?) = ?)`,
+			isPrepared: true,
+			args:       []interface{}{"a", "b"},
+		},
+		expressionTestCase{val: data.GetPath("a", "0"), sql: `("data" #> '{"a", "0"}')`},
+		expressionTestCase{val: data.GetPathText("a", "b"), sql: `("data" #>> '{"a", "b"}')`},
+		expressionTestCase{val: data.Contains(`{"a":1}`), sql: `("data" @> '{"a":1}')`},
+		expressionTestCase{val: data.ContainedBy(`{"a":1}`), sql: `("data" <@ '{"a":1}')`},
+		expressionTestCase{val: data.HasKey("a"), sql: `("data" ? 'a')`},
+		expressionTestCase{val: data.HasAnyKey("a", "b"), sql: `("data" ?| '{"a", "b"}')`},
+		expressionTestCase{val: data.HasAllKeys("a", "b"), sql: `("data" ?& '{"a", "b"}')`},
+		expressionTestCase{val: data.Concat(`{"a":1}`), sql: `("data" || '{"a":1}')`},
+	)
+
+	do = sqlgen.DefaultDialectOptions()
+	do.UseJSONFunctions = true
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: data.Get("a"), sql: `JSON_EXTRACT("data", '$.a')`},
+		expressionTestCase{val: data.Get(0), sql: `JSON_EXTRACT("data", '$[0]')`},
+		expressionTestCase{val: data.Get(`a"b`), sql: `JSON_EXTRACT("data", '$."a\"b"')`},
+		expressionTestCase{
+			val: data.Get("a").GetText("b"),
+			sql: `JSON_UNQUOTE(JSON_EXTRACT(JSON_EXTRACT("data", '$.a'), '$.b'))`,
+		},
+		expressionTestCase{
+			val:        data.GetText("a").Eq("b"),
+			sql:        `(JSON_UNQUOTE(JSON_EXTRACT("data", ?)) = ?)`,
+			isPrepared: true,
+			args:       []interface{}{`$.a`, "b"},
+		},
+		expressionTestCase{val: data.GetPath("a", "0"), sql: `JSON_EXTRACT("data", '$.a[0]')`},
+		expressionTestCase{val: data.GetPathText("a", "b"), sql: `JSON_UNQUOTE(JSON_EXTRACT("data", '$.a.b'))`},
+		expressionTestCase{val: data.Contains(`{"a":1}`), sql: `JSON_CONTAINS("data", '{"a":1}')`},
+		expressionTestCase{val: data.ContainedBy(`{"a":1}`), sql: `JSON_CONTAINS('{"a":1}', "data")`},
+		expressionTestCase{val: data.HasKey("a"), sql: `JSON_CONTAINS_PATH("data", 'one', '$.a')`},
+		expressionTestCase{val: data.HasAnyKey("a", "b"), sql: `JSON_CONTAINS_PATH("data", 'one', '$.a', '$.b')`},
+		expressionTestCase{val: data.HasAllKeys("a", "b"), sql: `JSON_CONTAINS_PATH("data", 'all', '$.a', '$.b')`},
+		expressionTestCase{val: data.Concat(`{"a":1}`), sql: `JSON_MERGE_PRESERVE("data", '{"a":1}')`},
+	)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: data.Get("a"), err: "goqu: dialect does not support JSON operation Get [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ArrayExpression() {
+	tags := exp.NewArrayAccessor(exp.NewIdentifierExpression("", "", "tags"))
+	agg := exp.NewArrayAccessor(exp.NewSQLFunctionExpression("ARRAY_AGG", exp.NewIdentifierExpression("", "", "a")))
+
+	do := sqlgen.DefaultDialectOptions()
+	do.ArrayOperatorLookup = map[exp.ArrayOperation][]byte{
+		exp.ArrayContainsOp:    []byte("@>"),
+		exp.ArrayContainedByOp: []byte("<@"),
+		exp.ArrayOverlapOp:     []byte("&&"),
+	}
+	do.ArrayConstructorFragment = []byte("ARRAY")
+	do.SupportsArrayIndex = true
+	do.SupportsArraySlice = true
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: exp.NewArrayConstructor(1, "a", nil), sql: `ARRAY[1, 'a', NULL]`},
+		expressionTestCase{val: exp.NewArrayConstructor(), sql: `ARRAY[]`},
+		expressionTestCase{
+			val:        exp.NewArrayConstructor(1, 2),
+			sql:        `ARRAY[?, ?]`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(2)},
+		},
+		expressionTestCase{val: tags.Contains(exp.NewArrayConstructor("a")), sql: `("tags" @> ARRAY['a'])`},
+		expressionTestCase{val: tags.ContainedBy(exp.NewArrayConstructor("a")), sql: `("tags" <@ ARRAY['a'])`},
+		expressionTestCase{val: tags.Overlaps(exp.NewArrayConstructor("a")), sql: `("tags" && ARRAY['a'])`},
+		expressionTestCase{val: tags.Index(1), sql: `"tags"[1]`},
+		expressionTestCase{val: tags.Index(1).Index(2), sql: `"tags"[1][2]`},
+		expressionTestCase{val: tags.Slice(1, 3), sql: `"tags"[1:3]`},
+		expressionTestCase{
+			val:        tags.Slice(1, 3).Eq(exp.NewArrayConstructor("a")),
+			sql:        `("tags"[?:?] = ARRAY[?])`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(3), "a"},
+		},
+		expressionTestCase{val: exp.NewArrayConstructor(1, 2).Index(1), sql: `ARRAY[1, 2][1]`},
+		expressionTestCase{val: agg.Index(1), sql: `(ARRAY_AGG("a"))[1]`},
+		expressionTestCase{val: tags.Overlaps(exp.NewArrayConstructor("a")).Index(1), sql: `(("tags" && ARRAY['a']))[1]`},
+	)
+
+	do.SupportsArraySlice = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: tags.Index(1), sql: `"tags"[1]`},
+		expressionTestCase{val: tags.Slice(1, 3), err: "goqu: dialect does not support array operation Slice [dialect=test]"},
+	)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: exp.NewArrayConstructor(1), err: "goqu: dialect does not support ARRAY constructors [dialect=test]"},
+		expressionTestCase{val: tags.Overlaps(1), err: "goqu: dialect does not support array operation Overlap [dialect=test]"},
+		expressionTestCase{val: tags.Index(1), err: "goqu: dialect does not support array operation Index [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_RangeTypeExpression() {
+	during := exp.NewRangeTypeAccessor(exp.NewIdentifierExpression("", "", "during"))
+	ts := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	rl := exp.NewRangeLiteral("tstzrange", ts, ts.Add(time.Hour))
+
+	do := sqlgen.DefaultDialectOptions()
+	do.SupportsRangeTypes = true
+	do.RangeTypeOperatorLookup = map[exp.RangeTypeOperation][]byte{
+		exp.RangeTypeContainsOp:    []byte("@>"),
+		exp.RangeTypeContainedByOp: []byte("<@"),
+		exp.RangeTypeOverlapOp:     []byte("&&"),
+		exp.RangeTypeAdjacentOp:    []byte("-|-"),
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: exp.NewRangeLiteral("int4range", 1, 10), sql: `int4range(1, 10)`},
+		expressionTestCase{val: exp.NewRangeLiteral("int4range", 1, nil).WithBounds("[]"), sql: `int4range(1, NULL, '[]')`},
+		expressionTestCase{
+			val:        exp.NewRangeLiteral("int4range", 1, 10).WithBounds("(]"),
+			sql:        `int4range(?, ?, ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(10), "(]"},
+		},
+		expressionTestCase{
+			val: during.Overlaps(rl.WithBounds("[)")),
+			sql: `("during" && tstzrange('2024-01-01T10:00:00Z', '2024-01-01T11:00:00Z', '[)'))`,
+		},
+		expressionTestCase{val: during.Contains(rl), sql: `("during" @> tstzrange('2024-01-01T10:00:00Z', '2024-01-01T11:00:00Z'))`},
+		expressionTestCase{val: during.ContainedBy(rl), sql: `("during" <@ tstzrange('2024-01-01T10:00:00Z', '2024-01-01T11:00:00Z'))`},
+		expressionTestCase{val: during.Adjacent(rl), sql: `("during" -|- tstzrange('2024-01-01T10:00:00Z', '2024-01-01T11:00:00Z'))`},
+		expressionTestCase{
+			val:        exp.NewRangeLiteral("int4range", 1, 10).Contains(5),
+			sql:        `(int4range(?, ?) @> ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(10), int64(5)},
+		},
+		expressionTestCase{
+			val: exp.NewExcludeConstraint("gist",
+				exp.NewExcludeElement("room_id", "="),
+				exp.NewExcludeElement(exp.NewRangeLiteral(
+					"tstzrange", exp.NewIdentifierExpression("", "", "starts_at"), exp.NewIdentifierExpression("", "", "ends_at"),
+				).WithBounds("[)"), "&&"),
+			),
+			sql: `EXCLUDE USING gist ("room_id" WITH =, tstzrange("starts_at", "ends_at", '[)') WITH &&)`,
+		},
+		expressionTestCase{
+			val: exp.NewRangeLiteral("int4range", 1, 10).WithBounds("[["),
+			err: `goqu: invalid range bounds "[[", expected "[)", "(]", "[]" or "()"`,
+		},
+	)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: exp.NewRangeLiteral("int4range", 1, 10), err: "goqu: dialect does not support range types [dialect=test]"},
+		expressionTestCase{val: during.Overlaps(1), err: "goqu: dialect does not support range operation Overlap [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_IntervalExpression() {
+	col := exp.NewDateAccessor(exp.NewIdentifierExpression("", "", "a"))
+	days := exp.NewIntervalExpression(3, exp.DaysIntervalUnit)
+	hours := exp.NewIntervalExpression(1, exp.HoursIntervalUnit)
+	weeks := exp.NewIntervalExpression(2, exp.WeeksIntervalUnit)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: days, sql: `INTERVAL '3' DAY`},
+		expressionTestCase{val: days, sql: `INTERVAL '3' DAY`, isPrepared: true},
+		expressionTestCase{val: col.Add(days), sql: `("a" + INTERVAL '3' DAY)`},
+		expressionTestCase{val: col.Sub(days).Add(hours), sql: `(("a" - INTERVAL '3' DAY) + INTERVAL '1' HOUR)`},
+		expressionTestCase{
+			val:        col.Add(days).Gt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			sql:        `(("a" + INTERVAL '3' DAY) > ?)`,
+			isPrepared: true,
+			args:       []interface{}{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		expressionTestCase{val: weeks, err: "goqu: dialect does not support interval unit Weeks [dialect=test]"},
+	)
+
+	do := sqlgen.DefaultDialectOptions()
+	do.IntervalStyle = sqlgen.QuotedIntervalStyle
+	do.IntervalUnitLookup = map[exp.IntervalUnit][]byte{
+		exp.DaysIntervalUnit:  []byte("days"),
+		exp.HoursIntervalUnit: []byte("hours"),
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: days, sql: `INTERVAL '3 days'`},
+		expressionTestCase{val: exp.NewIntervalExpression(-1, exp.HoursIntervalUnit), sql: `INTERVAL '-1 hours'`},
+		expressionTestCase{val: col.Sub(hours), sql: `("a" - INTERVAL '1 hours')`, isPrepared: true},
+	)
+
+	do = sqlgen.DefaultDialectOptions()
+	do.IntervalStyle = sqlgen.UnquotedIntervalStyle
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: days, sql: `INTERVAL 3 DAY`},
+		expressionTestCase{val: col.Add(days).Sub(hours), sql: `(("a" + INTERVAL 3 DAY) - INTERVAL 1 HOUR)`},
+	)
+
+	do = sqlgen.DefaultDialectOptions()
+	do.IntervalStyle = sqlgen.DateAddIntervalStyle
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: col.Add(days), sql: `DATEADD(DAY, 3, "a")`},
+		expressionTestCase{val: col.Sub(days).Add(hours), sql: `DATEADD(HOUR, 1, DATEADD(DAY, -3, "a"))`},
+		expressionTestCase{val: col.Add(days), sql: `DATEADD(DAY, 3, "a")`, isPrepared: true},
+		expressionTestCase{val: col.Add(weeks), err: "goqu: dialect does not support interval unit Weeks [dialect=test]"},
+		expressionTestCase{
+			val: days,
+			err: "goqu: dialect only supports intervals that are added to or subtracted from a date [dialect=test]",
+		},
+	)
+
+	do = sqlgen.DefaultDialectOptions()
+	do.IntervalUnitLookup = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: days, err: "goqu: dialect does not support intervals [dialect=test]"},
+		expressionTestCase{val: col.Add(days), err: "goqu: dialect does not support intervals [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_AtTimeZoneExpression() {
+	col := exp.NewIdentifierExpression("", "", "a")
+	days := exp.NewIntervalExpression(3, exp.DaysIntervalUnit)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: col.AtTimeZone("UTC"), sql: `("a" AT TIME ZONE 'UTC')`},
+		expressionTestCase{val: col.AtTimeZone("UTC"), sql: `("a" AT TIME ZONE ?)`, isPrepared: true, args: []interface{}{"UTC"}},
+		expressionTestCase{
+			val: col.AtTimeZone("UTC").AtTimeZone("Europe/Kyiv"),
+			sql: `(("a" AT TIME ZONE 'UTC') AT TIME ZONE 'Europe/Kyiv')`,
+		},
+		expressionTestCase{val: col.AtTimeZone("UTC").Add(days), sql: `(("a" AT TIME ZONE 'UTC') + INTERVAL '3' DAY)`},
+		expressionTestCase{
+			val: exp.NewDateAccessor(col).Add(days).AtTimeZone(exp.NewIdentifierExpression("", "", "tz")),
+			sql: `(("a" + INTERVAL '3' DAY) AT TIME ZONE "tz")`,
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ConvertTimeZoneFunction = []byte("CONVERT_TZ")
+	opts.ConvertTimeZoneSourceFragment = []byte("@@session.time_zone")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: col.AtTimeZone("UTC"), sql: `CONVERT_TZ("a", @@session.time_zone, 'UTC')`},
+		expressionTestCase{
+			val:        col.AtTimeZone("UTC"),
+			sql:        `CONVERT_TZ("a", @@session.time_zone, ?)`,
+			isPrepared: true,
+			args:       []interface{}{"UTC"},
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.ConvertTimeZoneFunction = []byte("toTimeZone")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: col.AtTimeZone("UTC"), sql: `toTimeZone("a", 'UTC')`},
 	)
+
 	opts = sqlgen.DefaultDialectOptions()
-	opts.SupportsWithCTERecursive = false
+	opts.ConvertTimeZoneFunction = []byte("CONVERT_TIMEZONE")
+	opts.ConvertTimeZoneZoneFirst = true
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", opts),
-		expressionTestCase{val: cteNoArgs, sql: `WITH a AS (SELECT * FROM "b") `},
-		expressionTestCase{val: cteNoArgs, sql: `WITH a AS (SELECT * FROM "b") `, isPrepared: true},
+		expressionTestCase{val: col.AtTimeZone("UTC"), sql: `CONVERT_TIMEZONE('UTC', "a")`},
+		expressionTestCase{
+			val:        col.AtTimeZone("UTC"),
+			sql:        `CONVERT_TIMEZONE(?, "a")`,
+			isPrepared: true,
+			args:       []interface{}{"UTC"},
+		},
+	)
 
-		expressionTestCase{val: cteArgs, sql: `WITH a(x,y) AS (SELECT * FROM "b") `},
-		expressionTestCase{val: cteArgs, sql: `WITH a(x,y) AS (SELECT * FROM "b") `, isPrepared: true},
+	opts = sqlgen.DefaultDialectOptions()
+	opts.AtTimeZoneFragment = nil
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: col.AtTimeZone("UTC"), err: "goqu: dialect does not support AT TIME ZONE [dialect=test]"},
+	)
+}
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_JSONFunctionExpression() {
+	type profile struct {
+		City string `json:"city"`
+	}
+	data := exp.NewIdentifierExpression("", "", "data")
+	lookup := map[exp.JSONFunction][]byte{
+		exp.JSONPathQueryFunc:   []byte("jsonb_path_query"),
+		exp.JSONSetFunc:         []byte("jsonb_set"),
+		exp.JSONBuildObjectFunc: []byte("jsonb_build_object"),
+		exp.JSONAggFunc:         []byte("json_agg"),
+		exp.JSONObjectAggFunc:   []byte("json_object_agg"),
+	}
+
+	do := sqlgen.DefaultDialectOptions()
+	do.JSONFunctionLookup = lookup
+	do.JSONValueCastType = []byte("JSONB")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
 		expressionTestCase{
-			val: cteRecursiveNoArgs,
-			err: "goqu: dialect does not support CTE WITH RECURSIVE clause [dialect=test]",
+			val: exp.NewJSONFunctionExpression(exp.JSONPathQueryFunc, data, "$.a[*]"),
+			sql: `jsonb_path_query("data", '$.a[*]')`,
 		},
 		expressionTestCase{
-			val:        cteRecursiveNoArgs,
-			err:        "goqu: dialect does not support CTE WITH RECURSIVE clause [dialect=test]",
-			isPrepared: true,
+			val: exp.NewJSONFunctionExpression(exp.JSONSetFunc, data, []string{"a", "b"}, 1),
+			sql: `jsonb_set("data", ('a', 'b'), 1)`,
 		},
-
 		expressionTestCase{
-			val: cteRecursiveArgs,
-			err: "goqu: dialect does not support CTE WITH RECURSIVE clause [dialect=test]",
+			val: exp.NewJSONFunctionExpression(exp.JSONSetFunc, data, []string{"a"}, profile{City: "Kyiv"}),
+			sql: `jsonb_set("data", ('a'), CAST('{"city":"Kyiv"}' AS JSONB))`,
 		},
 		expressionTestCase{
-			val:        cteRecursiveArgs,
-			err:        "goqu: dialect does not support CTE WITH RECURSIVE clause [dialect=test]",
+			val:        exp.NewJSONFunctionExpression(exp.JSONSetFunc, data, []string{"a"}, map[string]int{"b": 1}),
+			sql:        `jsonb_set("data", (?), CAST(? AS JSONB))`,
 			isPrepared: true,
+			args:       []interface{}{"a", `{"b":1}`},
+		},
+		expressionTestCase{
+			val: exp.NewJSONFunctionExpression(exp.JSONBuildObjectFunc, "a", data, "b", []int{1, 2}, "c", nil),
+			sql: `jsonb_build_object('a', "data", 'b', CAST('[1,2]' AS JSONB), 'c', NULL)`,
+		},
+		expressionTestCase{
+			val: exp.NewJSONFunctionExpression(exp.JSONBuildObjectFunc, "a", []byte("b")),
+			sql: `jsonb_build_object('a', 'b')`,
+		},
+		expressionTestCase{val: exp.NewJSONFunctionExpression(exp.JSONAggFunc, data), sql: `json_agg("data")`},
+		expressionTestCase{
+			val: exp.NewJSONFunctionExpression(exp.JSONObjectAggFunc, data, data),
+			sql: `json_object_agg("data", "data")`,
+		},
+		expressionTestCase{
+			val: exp.NewJSONFunctionExpression(exp.JSONBuildObjectFunc, "a", map[string]interface{}{"b": make(chan int)}),
+			err: "goqu: unable to serialize JSON value: json: unsupported type: chan int",
 		},
 	)
-}
-
-func (esgs *expressionSQLGeneratorSuite) TestGenerate_CommonTableExpression() {
-	ae := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
 
-	cteNoArgs := exp.NewCommonTableExpression(false, "a", ae)
-	cteArgs := exp.NewCommonTableExpression(false, "a(x,y)", ae)
-
-	cteRecursiveNoArgs := exp.NewCommonTableExpression(true, "a", ae)
-	cteRecursiveArgs := exp.NewCommonTableExpression(true, "a(x,y)", ae)
+	do = sqlgen.DefaultDialectOptions()
+	do.JSONFunctionLookup = lookup
+	do.UseJSONFunctions = true
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{
+			val: exp.NewJSONFunctionExpression(exp.JSONSetFunc, data, []string{"a", "0"}, map[string]int{"b": 1}),
+			sql: `jsonb_set("data", '$.a[0]', '{"b":1}')`,
+		},
+	)
 
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
-		expressionTestCase{val: cteNoArgs, sql: `a AS (SELECT * FROM "b")`},
-		expressionTestCase{val: cteNoArgs, sql: `a AS (SELECT * FROM "b")`, isPrepared: true},
-
-		expressionTestCase{val: cteArgs, sql: `a(x,y) AS (SELECT * FROM "b")`},
-		expressionTestCase{val: cteArgs, sql: `a(x,y) AS (SELECT * FROM "b")`, isPrepared: true},
-
-		expressionTestCase{val: cteRecursiveNoArgs, sql: `a AS (SELECT * FROM "b")`},
-		expressionTestCase{val: cteRecursiveNoArgs, sql: `a AS (SELECT * FROM "b")`, isPrepared: true},
-
-		expressionTestCase{val: cteRecursiveArgs, sql: `a(x,y) AS (SELECT * FROM "b")`},
-		expressionTestCase{val: cteRecursiveArgs, sql: `a(x,y) AS (SELECT * FROM "b")`, isPrepared: true},
+		expressionTestCase{
+			val: exp.NewJSONFunctionExpression(exp.JSONAggFunc, data),
+			err: "goqu: dialect does not support JSON function Agg [dialect=test]",
+		},
 	)
 }
 
-func (esgs *expressionSQLGeneratorSuite) TestGenerate_CompoundExpression() {
-	ae := newTestAppendableExpression(`SELECT * FROM "b"`, emptyArgs, nil, nil)
-
-	u := exp.NewCompoundExpression(exp.UnionCompoundType, ae)
-	ua := exp.NewCompoundExpression(exp.UnionAllCompoundType, ae)
-
-	i := exp.NewCompoundExpression(exp.IntersectCompoundType, ae)
-	ia := exp.NewCompoundExpression(exp.IntersectAllCompoundType, ae)
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_TableFunctionExpression() {
+	series := exp.NewSQLFunctionExpression("generate_series", 1, 3)
+	unnest := exp.NewSQLFunctionExpression("unnest", exp.NewLiteralExpression("'{a,b}'::text[]"))
+	rf := exp.NewTableFunctionExpression(series, unnest)
 
+	do := sqlgen.DefaultDialectOptions()
+	do.WithOrdinalityFragment = []byte(" WITH ORDINALITY")
+	do.RowsFromFragment = []byte("ROWS FROM ")
 	esgs.assertCases(
-		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
-		expressionTestCase{val: u, sql: ` UNION (SELECT * FROM "b")`},
-		expressionTestCase{val: u, sql: ` UNION (SELECT * FROM "b")`, isPrepared: true},
-
-		expressionTestCase{val: ua, sql: ` UNION ALL (SELECT * FROM "b")`},
-		expressionTestCase{val: ua, sql: ` UNION ALL (SELECT * FROM "b")`, isPrepared: true},
-
-		expressionTestCase{val: i, sql: ` INTERSECT (SELECT * FROM "b")`},
-		expressionTestCase{val: i, sql: ` INTERSECT (SELECT * FROM "b")`, isPrepared: true},
-
-		expressionTestCase{val: ia, sql: ` INTERSECT ALL (SELECT * FROM "b")`},
-		expressionTestCase{val: ia, sql: ` INTERSECT ALL (SELECT * FROM "b")`, isPrepared: true},
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: exp.NewTableFunctionExpression(series), sql: `generate_series(1, 3)`},
+		expressionTestCase{val: series.WithOrdinality(), sql: `generate_series(1, 3) WITH ORDINALITY`},
+		expressionTestCase{
+			val:        series.WithOrdinality(),
+			sql:        `generate_series(?, ?) WITH ORDINALITY`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(3)},
+		},
+		expressionTestCase{
+			val: series.WithOrdinality().As("g").Columns("v", "n"),
+			sql: `generate_series(1, 3) WITH ORDINALITY AS "g"("v", "n")`,
+		},
+		expressionTestCase{val: rf, sql: `ROWS FROM (generate_series(1, 3), unnest('{a,b}'::text[]))`},
+		expressionTestCase{
+			val: rf.WithOrdinality(),
+			sql: `ROWS FROM (generate_series(1, 3), unnest('{a,b}'::text[])) WITH ORDINALITY`,
+		},
+		expressionTestCase{
+			val: exp.NewTableFunctionExpression(),
+			err: "goqu: at least one function is required for a table function",
+		},
 	)
 
-	opts := sqlgen.DefaultDialectOptions()
-	opts.WrapCompoundsInParens = false
 	esgs.assertCases(
-		sqlgen.NewExpressionSQLGenerator("test", opts),
-		expressionTestCase{val: u, sql: ` UNION SELECT * FROM "b"`},
-		expressionTestCase{val: u, sql: ` UNION SELECT * FROM "b"`, isPrepared: true},
-
-		expressionTestCase{val: ua, sql: ` UNION ALL SELECT * FROM "b"`},
-		expressionTestCase{val: ua, sql: ` UNION ALL SELECT * FROM "b"`, isPrepared: true},
-
-		expressionTestCase{val: i, sql: ` INTERSECT SELECT * FROM "b"`},
-		expressionTestCase{val: i, sql: ` INTERSECT SELECT * FROM "b"`, isPrepared: true},
-
-		expressionTestCase{val: ia, sql: ` INTERSECT ALL SELECT * FROM "b"`},
-		expressionTestCase{val: ia, sql: ` INTERSECT ALL SELECT * FROM "b"`, isPrepared: true},
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: exp.NewTableFunctionExpression(series), sql: `generate_series(1, 3)`},
+		expressionTestCase{
+			val: series.WithOrdinality(),
+			err: "goqu: dialect does not support WITH ORDINALITY [dialect=test]",
+		},
+		expressionTestCase{val: rf, err: "goqu: dialect does not support ROWS FROM [dialect=test]"},
 	)
 }
 
-func (esgs *expressionSQLGeneratorSuite) TestGenerate_IdentifierExpression() {
-	col := exp.NewIdentifierExpression("", "", "col")
-	colStar := exp.NewIdentifierExpression("", "", "*")
-	table := exp.NewIdentifierExpression("", "table", "")
-	schema := exp.NewIdentifierExpression("schema", "", "")
-	tableCol := exp.NewIdentifierExpression("", "table", "col")
-	schemaTableCol := exp.NewIdentifierExpression("schema", "table", "col")
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_TableHintExpression() {
+	table := exp.NewIdentifierExpression("", "a", "")
+	th := exp.NewTableHintExpression(table)
 
-	parsedCol := exp.ParseIdentifier("col")
-	parsedTableCol := exp.ParseIdentifier("table.col")
-	parsedSchemaTableCol := exp.ParseIdentifier("schema.table.col")
+	do := sqlgen.DefaultDialectOptions()
+	do.TableOnlyFragment = []byte("ONLY ")
+	do.UseIndexFragment = []byte(" USE INDEX ")
+	do.ForceIndexFragment = []byte(" FORCE INDEX ")
+	do.IgnoreIndexFragment = []byte(" IGNORE INDEX ")
+	do.TableHintsFragment = []byte(" WITH ")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", do),
+		expressionTestCase{val: th, sql: `"a"`},
+		expressionTestCase{val: th.Only(), sql: `ONLY "a"`},
+		expressionTestCase{
+			val: exp.NewTableHintExpression(table.As("b")).Only(),
+			sql: `ONLY "a" AS "b"`,
+		},
+		expressionTestCase{
+			val: th.UseIndex("i1", "i2").ForceIndex("i3").IgnoreIndex("i4"),
+			sql: `"a" USE INDEX ("i1", "i2") FORCE INDEX ("i3") IGNORE INDEX ("i4")`,
+		},
+		expressionTestCase{val: th.With("NOLOCK", "INDEX(i1)"), sql: `"a" WITH (NOLOCK, INDEX(i1))`},
+		expressionTestCase{val: th.With("NOLOCK"), sql: `"a" WITH (NOLOCK)`, isPrepared: true},
+		expressionTestCase{val: th.With("INDEX = i1", "FORCESEEK(i1 (c1, c2))"), sql: `"a" WITH (INDEX = i1, FORCESEEK(i1 (c1, c2)))`},
+		expressionTestCase{
+			val: th.With("NOLOCK); DROP TABLE a; --"),
+			err: `goqu: table hint "NOLOCK); DROP TABLE a; --" must only contain letters, digits, _, =, commas, spaces ` +
+				`and balanced parentheses [dialect=test]`,
+		},
+		expressionTestCase{
+			val: th.With("INDEX(i1"),
+			err: `goqu: table hint "INDEX(i1" must only contain letters, digits, _, =, commas, spaces ` +
+				`and balanced parentheses [dialect=test]`,
+		},
+		expressionTestCase{
+			val: th.With("NOLOCK */"),
+			err: `goqu: table hint "NOLOCK */" must only contain letters, digits, _, =, commas, spaces ` +
+				`and balanced parentheses [dialect=test]`,
+		},
+	)
 
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: th, sql: `"a"`},
+		expressionTestCase{val: th.Only(), err: "goqu: dialect does not support the ONLY table hint [dialect=test]"},
 		expressionTestCase{
-			val: exp.NewIdentifierExpression("", "", ""),
-			err: `goqu: a empty identifier was encountered, please specify a "schema", "table" or "column"`,
+			val: th.UseIndex("i1"),
+			err: "goqu: dialect does not support the USE INDEX table hint [dialect=test]",
 		},
 		expressionTestCase{
-			val: exp.NewIdentifierExpression("", "", nil),
-			err: `goqu: a empty identifier was encountered, please specify a "schema", "table" or "column"`,
+			val: th.ForceIndex("i1"),
+			err: "goqu: dialect does not support the FORCE INDEX table hint [dialect=test]",
 		},
 		expressionTestCase{
-			val: exp.NewIdentifierExpression("", "", false),
-			err: `goqu: unexpected col type must be string or LiteralExpression received bool`,
+			val: th.IgnoreIndex("i1"),
+			err: "goqu: dialect does not support the IGNORE INDEX table hint [dialect=test]",
 		},
-
-		expressionTestCase{val: col, sql: `"col"`},
-		expressionTestCase{val: col, sql: `"col"`, isPrepared: true},
-
-		expressionTestCase{val: col.Table("table"), sql: `"table"."col"`},
-		expressionTestCase{val: col.Table("table"), sql: `"table"."col"`, isPrepared: true},
-
-		expressionTestCase{val: col.Table("table").Schema("schema"), sql: `"schema"."table"."col"`},
-		expressionTestCase{val: col.Table("table").Schema("schema"), sql: `"schema"."table"."col"`, isPrepared: true},
-
-		expressionTestCase{val: colStar, sql: `*`},
-		expressionTestCase{val: colStar, sql: `*`, isPrepared: true},
-
-		expressionTestCase{val: colStar.Table("table"), sql: `"table".*`},
-		expressionTestCase{val: colStar.Table("table"), sql: `"table".*`, isPrepared: true},
-
-		expressionTestCase{val: colStar.Table("table").Schema("schema"), sql: `"schema"."table".*`},
-		expressionTestCase{val: colStar.Table("table").Schema("schema"), sql: `"schema"."table".*`, isPrepared: true},
-
-		expressionTestCase{val: table, sql: `"table"`},
-		expressionTestCase{val: table, sql: `"table"`, isPrepared: true},
-
-		expressionTestCase{val: table.Col("col"), sql: `"table"."col"`},
-		expressionTestCase{val: table.Col("col"), sql: `"table"."col"`, isPrepared: true},
-
-		expressionTestCase{val: table.Col(nil), sql: `"table"`},
-		expressionTestCase{val: table.Col(nil), sql: `"table"`, isPrepared: true},
-
-		expressionTestCase{val: table.Col("*"), sql: `"table".*`},
-		expressionTestCase{val: table.Col("*"), sql: `"table".*`, isPrepared: true},
-
-		expressionTestCase{val: table.Schema("schema").Col("col"), sql: `"schema"."table"."col"`},
-		expressionTestCase{val: table.Schema("schema").Col("col"), sql: `"schema"."table"."col"`, isPrepared: true},
-
-		expressionTestCase{val: schema, sql: `"schema"`},
-		expressionTestCase{val: schema, sql: `"schema"`, isPrepared: true},
-
-		expressionTestCase{val: schema.Table("table"), sql: `"schema"."table"`},
-		expressionTestCase{val: schema.Table("table"), sql: `"schema"."table"`, isPrepared: true},
-
-		expressionTestCase{val: schema.Table("table").Col("col"), sql: `"schema"."table"."col"`},
-		expressionTestCase{val: schema.Table("table").Col("col"), sql: `"schema"."table"."col"`, isPrepared: true},
-
-		expressionTestCase{val: schema.Table("table").Col(nil), sql: `"schema"."table"`},
-		expressionTestCase{val: schema.Table("table").Col(nil), sql: `"schema"."table"`, isPrepared: true},
-
-		expressionTestCase{val: schema.Table("table").Col("*"), sql: `"schema"."table".*`},
-		expressionTestCase{val: schema.Table("table").Col("*"), sql: `"schema"."table".*`, isPrepared: true},
-
-		expressionTestCase{val: tableCol, sql: `"table"."col"`},
-		expressionTestCase{val: tableCol, sql: `"table"."col"`, isPrepared: true},
-
-		expressionTestCase{val: schemaTableCol, sql: `"schema"."table"."col"`},
-		expressionTestCase{val: schemaTableCol, sql: `"schema"."table"."col"`, isPrepared: true},
-
-		expressionTestCase{val: parsedCol, sql: `"col"`},
-		expressionTestCase{val: parsedCol, sql: `"col"`, isPrepared: true},
-
-		expressionTestCase{val: parsedTableCol, sql: `"table"."col"`},
-		expressionTestCase{val: parsedTableCol, sql: `"table"."col"`, isPrepared: true},
-
-		expressionTestCase{val: parsedSchemaTableCol, sql: `"schema"."table"."col"`},
-		expressionTestCase{val: parsedSchemaTableCol, sql: `"schema"."table"."col"`, isPrepared: true},
+		expressionTestCase{val: th.With("NOLOCK"), err: "goqu: dialect does not support the WITH table hint [dialect=test]"},
 	)
 }
 
-func (esgs *expressionSQLGeneratorSuite) TestGenerate_LateralExpression() {
-	lateralExp := exp.NewLateralExpression(newTestAppendableExpression(`SELECT * FROM "test"`, emptyArgs, nil, nil))
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_XMLTableExpression() {
+	xt := exp.NewXMLTableExpression("/rows/row", exp.NewIdentifierExpression("", "", "data")).Columns(
+		exp.NewXMLTableColumn("id", exp.NewDataType(exp.IntegerDataType)).Path("@id"),
+		exp.NewXMLTableColumn("name", exp.NewDataType(exp.TextDataType)).Path("name").Default("unknown"),
+		exp.NewXMLTableColumn("price", exp.NewDataType(exp.IntegerDataType)),
+		exp.NewXMLTableOrdinalityColumn("n"),
+	)
 
 	do := sqlgen.DefaultDialectOptions()
+	do.XMLTableFragment = []byte("XMLTABLE")
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", do),
-		expressionTestCase{val: lateralExp, sql: `LATERAL (SELECT * FROM "test")`},
-		expressionTestCase{val: lateralExp, sql: `LATERAL (SELECT * FROM "test")`, isPrepared: true},
+		expressionTestCase{
+			val: xt,
+			sql: `XMLTABLE('/rows/row' PASSING "data" COLUMNS "id" INTEGER PATH '@id', ` +
+				`"name" TEXT PATH 'name' DEFAULT 'unknown', "price" INTEGER, "n" FOR ORDINALITY)`,
+		},
+		// the row path and the column paths are always interpolated
+		expressionTestCase{
+			val: xt,
+			sql: `XMLTABLE('/rows/row' PASSING "data" COLUMNS "id" INTEGER PATH '@id', ` +
+				`"name" TEXT PATH 'name' DEFAULT ?, "price" INTEGER, "n" FOR ORDINALITY)`,
+			isPrepared: true,
+			args:       []interface{}{"unknown"},
+		},
+		expressionTestCase{
+			val: exp.NewXMLTableExpression("/rows/row", exp.NewIdentifierExpression("", "", "data")),
+			err: "goqu: at least one column is required for XMLTABLE",
+		},
 	)
 
-	do = sqlgen.DefaultDialectOptions()
-	do.LateralFragment = []byte("lateral ")
-	esgs.assertCases(
-		sqlgen.NewExpressionSQLGenerator("test", do),
-		expressionTestCase{val: lateralExp, sql: `lateral (SELECT * FROM "test")`},
-		expressionTestCase{val: lateralExp, sql: `lateral (SELECT * FROM "test")`, isPrepared: true},
-	)
-	do = sqlgen.DefaultDialectOptions()
-	do.SupportsLateral = false
 	esgs.assertCases(
-		sqlgen.NewExpressionSQLGenerator("test", do),
-		expressionTestCase{val: lateralExp, err: "goqu: dialect does not support lateral expressions [dialect=test]"},
-		expressionTestCase{val: lateralExp, err: "goqu: dialect does not support lateral expressions [dialect=test]", isPrepared: true},
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: xt, err: "goqu: dialect does not support XMLTABLE [dialect=test]"},
 	)
 }
 
@@ -1312,6 +3018,36 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_CaseExpression() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_CaseBuilder() {
+	ident := exp.NewIdentifierExpression("", "", "col")
+	inner := exp.NewCaseExpression().When(ident.Gt(10), "big").Else("small")
+	builder := testCaseBuilder{c: exp.NewCaseExpression().
+		Value(ident).
+		Whens(exp.NewCaseWhen(1, "one"), exp.NewCaseWhen(2, testCaseBuilder{c: inner}))}
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{
+			val: builder,
+			sql: `CASE "col" WHEN 1 THEN 'one' WHEN 2 THEN CASE  WHEN ("col" > 10) THEN 'big' ELSE 'small' END END`,
+		},
+		expressionTestCase{
+			val:        builder,
+			sql:        `CASE "col" WHEN ? THEN ? WHEN ? THEN CASE  WHEN ("col" > ?) THEN ? ELSE ? END END`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "one", int64(2), int64(10), "big", "small"},
+		},
+		expressionTestCase{
+			val: exp.NewCaseExpression().When(true, 1).Gt(0),
+			sql: `(CASE  WHEN TRUE THEN 1 END > 0)`,
+		},
+		expressionTestCase{
+			val: testCaseBuilder{c: exp.NewCaseExpression()},
+			err: "goqu: when conditions not found for case statement",
+		},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_ExpressionMap() {
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
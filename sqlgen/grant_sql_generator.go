@@ -0,0 +1,123 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	GrantSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.GrantClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	grantSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoPrivilegesForGrant = errors.New("at least one privilege is required when generating grant sql")
+	errNoObjectForGrant     = errors.New("no object found when generating grant sql")
+	errNoRolesForGrant      = errors.New("at least one role is required when generating grant sql")
+)
+
+func errGrantNotSupported(dialect string) error {
+	return errors.New("dialect does not support GRANT/REVOKE [dialect=%s]", dialect)
+}
+
+func errGrantFeatureNotSupported(dialect, feature, statement string) error {
+	return errors.New("dialect does not support %s in %s [dialect=%s]", feature, statement, dialect)
+}
+
+func NewGrantSQLGenerator(dialect string, do *SQLDialectOptions) GrantSQLGenerator {
+	return &grantSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (gsg *grantSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.GrantClauses) {
+	if len(clauses.Privileges()) == 0 {
+		b.SetError(errNoPrivilegesForGrant)
+		return
+	}
+	if !clauses.HasObject() {
+		b.SetError(errNoObjectForGrant)
+		return
+	}
+	if clauses.Roles() == nil || clauses.Roles().IsEmpty() {
+		b.SetError(errNoRolesForGrant)
+		return
+	}
+	for _, f := range gsg.DialectOptions().GrantSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case GrantSQLFragment:
+			gsg.GrantSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("GRANT", f))
+		}
+	}
+}
+
+// Generates a GRANT or REVOKE statement (e.g. GRANT SELECT, INSERT ON "a" TO "b" or REVOKE SELECT ON "a" FROM "b")
+func (gsg *grantSQLGenerator) GrantSQL(b sb.SQLBuilder, clauses exp.GrantClauses) {
+	do := gsg.DialectOptions()
+	if do.GrantFragment == nil {
+		b.SetError(errGrantNotSupported(gsg.Dialect()))
+		return
+	}
+	if !gsg.checkSupported(b, clauses) {
+		return
+	}
+	if clauses.IsRevoke() {
+		b.Write(do.RevokeFragment)
+		if clauses.IsGrantOption() {
+			b.Write(do.GrantOptionForFragment)
+		}
+	} else {
+		b.Write(do.GrantFragment)
+	}
+	for i, privilege := range clauses.Privileges() {
+		if i > 0 {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		b.WriteStrings(privilege)
+	}
+	b.Write(do.OnFragment)
+	gsg.ExpressionSQLGenerator().Generate(b, clauses.Object())
+	if clauses.IsRevoke() {
+		b.Write(do.RevokeFromFragment)
+	} else {
+		b.Write(do.GrantToFragment)
+	}
+	gsg.ExpressionSQLGenerator().Generate(b, clauses.Roles())
+	switch {
+	case clauses.IsRevoke() && clauses.IsCascade():
+		b.Write(do.CascadeFragment)
+	case !clauses.IsRevoke() && clauses.IsGrantOption():
+		b.Write(do.WithGrantOptionFragment)
+	}
+}
+
+func (gsg *grantSQLGenerator) checkSupported(b sb.SQLBuilder, clauses exp.GrantClauses) bool {
+	do := gsg.DialectOptions()
+	switch {
+	case clauses.IsRevoke() && clauses.IsGrantOption() && do.GrantOptionForFragment == nil:
+		b.SetError(errGrantFeatureNotSupported(gsg.Dialect(), "GRANT OPTION FOR", "REVOKE"))
+	case clauses.IsRevoke() && clauses.IsCascade() && !do.SupportsRevokeCascade:
+		b.SetError(errGrantFeatureNotSupported(gsg.Dialect(), "CASCADE", "REVOKE"))
+	case !clauses.IsRevoke() && clauses.IsGrantOption() && do.WithGrantOptionFragment == nil:
+		b.SetError(errGrantFeatureNotSupported(gsg.Dialect(), "WITH GRANT OPTION", "GRANT"))
+	case !clauses.IsRevoke() && clauses.IsCascade():
+		b.SetError(errGrantFeatureNotSupported(gsg.Dialect(), "CASCADE", "GRANT"))
+	default:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	grantTestCase struct {
+		clause exp.GrantClauses
+		sql    string
+		err    string
+	}
+	grantSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (gsgs *grantSQLGeneratorSuite) assertCases(
+	gsg sqlgen.GrantSQLGenerator,
+	testCases ...grantTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		gsg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			gsgs.assertErrorSQL(b, tc.err)
+		} else {
+			gsgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (gsgs *grantSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewGrantSQLGenerator("test", opts)
+	gsgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewGrantSQLGenerator("test2", opts2)
+	gsgs.Equal("test2", d2.Dialect())
+}
+
+func (gsgs *grantSQLGeneratorSuite) TestGenerate() {
+	gc := exp.NewGrantClauses().
+		SetPrivileges([]string{"SELECT", "INSERT"}).
+		SetObject(exp.ParseIdentifier("s.user")).
+		SetRoles(exp.NewColumnListExpression("tenant_a", "tenant_b"))
+
+	gsgs.assertCases(
+		sqlgen.NewGrantSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		grantTestCase{clause: gc, sql: `GRANT SELECT, INSERT ON "s"."user" TO "tenant_a", "tenant_b"`},
+		grantTestCase{
+			clause: gc.SetGrantOption(true),
+			sql:    `GRANT SELECT, INSERT ON "s"."user" TO "tenant_a", "tenant_b" WITH GRANT OPTION`,
+		},
+		grantTestCase{
+			clause: gc.SetPrivileges([]string{"ALL PRIVILEGES"}).SetRoles(exp.NewColumnListExpression("tenant_a")),
+			sql:    `GRANT ALL PRIVILEGES ON "s"."user" TO "tenant_a"`,
+		},
+		grantTestCase{clause: gc.SetRevoke(true), sql: `REVOKE SELECT, INSERT ON "s"."user" FROM "tenant_a", "tenant_b"`},
+		grantTestCase{
+			clause: gc.SetRevoke(true).SetGrantOption(true),
+			sql:    `REVOKE GRANT OPTION FOR SELECT, INSERT ON "s"."user" FROM "tenant_a", "tenant_b"`,
+		},
+		grantTestCase{
+			clause: gc.SetRevoke(true).SetCascade(true),
+			sql:    `REVOKE SELECT, INSERT ON "s"."user" FROM "tenant_a", "tenant_b" CASCADE`,
+		},
+
+		grantTestCase{
+			clause: gc.SetCascade(true),
+			err:    "goqu: dialect does not support CASCADE in GRANT [dialect=test]",
+		},
+		grantTestCase{
+			clause: gc.SetPrivileges(nil),
+			err:    "goqu: at least one privilege is required when generating grant sql",
+		},
+		grantTestCase{
+			clause: exp.NewGrantClauses().SetPrivileges([]string{"SELECT"}),
+			err:    "goqu: no object found when generating grant sql",
+		},
+		grantTestCase{
+			clause: gc.SetRoles(nil),
+			err:    "goqu: at least one role is required when generating grant sql",
+		},
+		grantTestCase{
+			clause: gc.SetRoles(exp.NewColumnListExpression()),
+			err:    "goqu: at least one role is required when generating grant sql",
+		},
+	)
+}
+
+func (gsgs *grantSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	gc := exp.NewGrantClauses().
+		SetPrivileges([]string{"SELECT"}).
+		SetObject(exp.ParseIdentifier("user")).
+		SetRoles(exp.NewColumnListExpression("tenant"))
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsRevokeCascade = false
+	opts.GrantOptionForFragment = nil
+	opts.WithGrantOptionFragment = nil
+	gsgs.assertCases(
+		sqlgen.NewGrantSQLGenerator("test", opts),
+		grantTestCase{clause: gc, sql: `GRANT SELECT ON "user" TO "tenant"`},
+		grantTestCase{clause: gc.SetRevoke(true), sql: `REVOKE SELECT ON "user" FROM "tenant"`},
+		grantTestCase{
+			clause: gc.SetGrantOption(true),
+			err:    "goqu: dialect does not support WITH GRANT OPTION in GRANT [dialect=test]",
+		},
+		grantTestCase{
+			clause: gc.SetRevoke(true).SetGrantOption(true),
+			err:    "goqu: dialect does not support GRANT OPTION FOR in REVOKE [dialect=test]",
+		},
+		grantTestCase{
+			clause: gc.SetRevoke(true).SetCascade(true),
+			err:    "goqu: dialect does not support CASCADE in REVOKE [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.GrantFragment = nil
+	gsgs.assertCases(
+		sqlgen.NewGrantSQLGenerator("test", opts),
+		grantTestCase{clause: gc, err: "goqu: dialect does not support GRANT/REVOKE [dialect=test]"},
+		grantTestCase{clause: gc.SetRevoke(true), err: "goqu: dialect does not support GRANT/REVOKE [dialect=test]"},
+	)
+}
+
+func (gsgs *grantSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.GrantSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	gc := exp.NewGrantClauses().
+		SetPrivileges([]string{"SELECT"}).
+		SetObject(exp.ParseIdentifier("user")).
+		SetRoles(exp.NewColumnListExpression("tenant"))
+	gsgs.assertCases(
+		sqlgen.NewGrantSQLGenerator("test", opts),
+		grantTestCase{clause: gc, err: "goqu: unsupported GRANT SQL fragment UpdateBeginSQLFragment"},
+	)
+}
+
+func (gsgs *grantSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewGrantSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewGrantClauses().
+		SetPrivileges([]string{"SELECT"}).
+		SetObject(exp.ParseIdentifier("user")).
+		SetRoles(exp.NewColumnListExpression("tenant")))
+	gsgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestGrantSQLGenerator(t *testing.T) {
+	suite.Run(t, new(grantSQLGeneratorSuite))
+}
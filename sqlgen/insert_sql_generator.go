@@ -36,6 +36,22 @@ func errUpsertWithWhereNotSupported(dialect string) error {
 	return errors.New("dialect does not support upsert with where clause [dialect=%s]", dialect)
 }
 
+func errDefaultValuesNotSupported(dialect string) error {
+	return errors.New("dialect does not support inserting a row of DEFAULT VALUES [dialect=%s]", dialect)
+}
+
+func errMultipleInsertRowsNotSupported(dialect string) error {
+	return errors.New("dialect does not support inserting multiple rows in a single INSERT [dialect=%s]", dialect)
+}
+
+func errConflictNotSupported(dialect string) error {
+	return errors.New("dialect does not support ON CONFLICT clause [dialect=%s]", dialect)
+}
+
+func errConflictResolutionNotSupported(dialect string, r exp.ConflictResolution) error {
+	return errors.New("dialect does not support INSERT OR %s [dialect=%s]", r, dialect)
+}
+
 func NewInsertSQLGenerator(dialect string, do *SQLDialectOptions) InsertSQLGenerator {
 	return &insertSQLGenerator{NewCommonSQLGenerator(dialect, do)}
 }
@@ -56,7 +72,11 @@ func (isg *insertSQLGenerator) Generate(
 		case CommonTableSQLFragment:
 			isg.ExpressionSQLGenerator().Generate(b, clauses.CommonTables())
 		case InsertBeingSQLFragment:
-			isg.InsertBeginSQL(b, clauses.OnConflict())
+			if r := clauses.ConflictResolution(); r != exp.NoConflictResolution {
+				isg.conflictResolutionBeginSQL(b, r)
+			} else {
+				isg.InsertBeginSQL(b, clauses.OnConflict())
+			}
 		case IntoSQLFragment:
 			b.WriteRunes(isg.DialectOptions().SpaceRune)
 			isg.ExpressionSQLGenerator().Generate(b, clauses.Into())
@@ -79,6 +99,16 @@ func (isg *insertSQLGenerator) InsertBeginSQL(b sb.SQLBuilder, o exp.ConflictExp
 	}
 }
 
+// Adds the INSERT fragment for the conflict resolution (e.g. INSERT OR REPLACE INTO)
+func (isg *insertSQLGenerator) conflictResolutionBeginSQL(b sb.SQLBuilder, r exp.ConflictResolution) {
+	clause, ok := isg.DialectOptions().ConflictResolutionLookup[r]
+	if !ok {
+		b.SetError(errConflictResolutionNotSupported(isg.Dialect(), r))
+		return
+	}
+	b.Write(clause)
+}
+
 // Adds the columns list to an insert statement
 func (isg *insertSQLGenerator) InsertSQL(b sb.SQLBuilder, ic exp.InsertClauses) {
 	switch {
@@ -121,6 +151,10 @@ func (isg *insertSQLGenerator) InsertExpressionSQL(b sb.SQLBuilder, ie exp.Inser
 
 // Adds the DefaultValuesFragment to an SQL statement
 func (isg *insertSQLGenerator) defaultValuesSQL(b sb.SQLBuilder) {
+	if isg.DialectOptions().DefaultValuesFragment == nil {
+		b.SetError(errDefaultValuesNotSupported(isg.Dialect()))
+		return
+	}
 	b.Write(isg.DialectOptions().DefaultValuesFragment)
 }
 
@@ -138,9 +172,13 @@ func (isg *insertSQLGenerator) insertColumnsSQL(b sb.SQLBuilder, cols exp.Column
 
 // Adds the values clause to an SQL statement
 func (isg *insertSQLGenerator) insertValuesSQL(b sb.SQLBuilder, values []exp.Vals) {
+	valueLen := len(values)
+	if valueLen > 1 && !isg.DialectOptions().SupportsMultipleInsertRows {
+		b.SetError(errMultipleInsertRowsNotSupported(isg.Dialect()))
+		return
+	}
 	b.Write(isg.DialectOptions().ValuesFragment)
 	rowLen := len(values[0])
-	valueLen := len(values)
 	for i, row := range values {
 		if len(row) != rowLen {
 			b.SetError(errMisMatchedRowLength(rowLen, len(row)))
@@ -160,6 +198,10 @@ func (isg *insertSQLGenerator) onConflictSQL(b sb.SQLBuilder, o exp.ConflictExpr
 	if o == nil {
 		return
 	}
+	if !isg.DialectOptions().SupportsConflict {
+		b.SetError(errConflictNotSupported(isg.Dialect()))
+		return
+	}
 	b.Write(isg.DialectOptions().ConflictFragment)
 	switch t := o.(type) {
 	case exp.ConflictUpdateExpression:
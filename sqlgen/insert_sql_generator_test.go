@@ -130,6 +130,16 @@ func (igs *insertSQLGeneratorSuite) TestGenerate_colsAndVals() {
 		insertTestCase{clause: bic, err: `goqu: rows with different value length expected 1 got 2`},
 		insertTestCase{clause: bic, err: `goqu: rows with different value length expected 1 got 2`, isPrepared: true},
 	)
+
+	opts.SupportsMultipleInsertRows = false
+	expectedErr := "goqu: dialect does not support inserting multiple rows in a single INSERT [dialect=test]"
+	sic := ic.SetVals([]exp.Vals{{"a1", "b1"}})
+	igs.assertCases(
+		sqlgen.NewInsertSQLGenerator("test", opts),
+		insertTestCase{clause: sic, sql: `INSERT INTO "test" {"a"; "b"} values {'a1'; 'b1'}`},
+		insertTestCase{clause: ic, err: expectedErr},
+		insertTestCase{clause: ic, err: expectedErr, isPrepared: true},
+	)
 }
 
 func (igs *insertSQLGeneratorSuite) TestGenerate_withNoInto() {
@@ -212,6 +222,15 @@ func (igs *insertSQLGeneratorSuite) TestGenerate_withEmptyRows() {
 		insertTestCase{clause: ic, sql: `INSERT INTO "test" default values`},
 		insertTestCase{clause: ic, sql: `INSERT INTO "test" default values`, isPrepared: true},
 	)
+
+	opts3 := sqlgen.DefaultDialectOptions()
+	opts3.DefaultValuesFragment = nil
+	expectedErr := "goqu: dialect does not support inserting a row of DEFAULT VALUES [dialect=test]"
+	igs.assertCases(
+		sqlgen.NewInsertSQLGenerator("test", opts3),
+		insertTestCase{clause: ic, err: expectedErr},
+		insertTestCase{clause: ic, err: expectedErr, isPrepared: true},
+	)
 }
 
 func (igs *insertSQLGeneratorSuite) TestGenerate_withRowsAppendableExpression() {
@@ -378,6 +397,46 @@ func (igs *insertSQLGeneratorSuite) TestGenerate_onConflict() {
 		insertTestCase{clause: icDuw, err: expectedErr},
 		insertTestCase{clause: icDuw, err: expectedErr, isPrepared: true},
 	)
+
+	opts.SupportsConflict = false
+	expectedErr = "goqu: dialect does not support ON CONFLICT clause [dialect=test]"
+	igs.assertCases(
+		sqlgen.NewInsertSQLGenerator("test", opts),
+		insertTestCase{clause: icDn, err: expectedErr},
+		insertTestCase{clause: icDu, err: expectedErr, isPrepared: true},
+		insertTestCase{clause: ic, sql: `INSERT INTO "test" ("a") VALUES ('a1')`},
+	)
+}
+
+func (igs *insertSQLGeneratorSuite) TestGenerate_withConflictResolution() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ConflictResolutionLookup = map[exp.ConflictResolution][]byte{
+		exp.IgnoreConflictResolution: []byte("insert or ignore into"),
+	}
+
+	ic := exp.NewInsertClauses().
+		SetInto(exp.NewIdentifierExpression("", "test", "")).
+		SetCols(exp.NewColumnListExpression("a")).
+		SetVals([]exp.Vals{
+			{"a1"},
+		})
+	icOi := ic.SetConflictResolution(exp.IgnoreConflictResolution)
+	icOr := ic.SetConflictResolution(exp.ReplaceConflictResolution)
+
+	expectedErr := "goqu: dialect does not support INSERT OR REPLACE [dialect=test]"
+	igs.assertCases(
+		sqlgen.NewInsertSQLGenerator("test", opts),
+		insertTestCase{clause: icOi, sql: `insert or ignore into "test" ("a") VALUES ('a1')`},
+		insertTestCase{
+			clause:     icOi,
+			sql:        `insert or ignore into "test" ("a") VALUES (?)`,
+			isPrepared: true,
+			args:       []interface{}{"a1"},
+		},
+
+		insertTestCase{clause: icOr, err: expectedErr},
+		insertTestCase{clause: icOr, err: expectedErr, isPrepared: true},
+	)
 }
 
 func (igs *insertSQLGeneratorSuite) TestGenerate_withCommonTables() {
@@ -0,0 +1,136 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	MaintenanceSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.MaintenanceClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	maintenanceSQLGenerator struct {
+		CommonSQLGenerator
+	}
+	maintenanceOption struct {
+		name       string
+		enabled    bool
+		fragment   []byte
+		vacuumOnly bool
+	}
+)
+
+func errMaintenanceNotSupported(op exp.MaintenanceOperation, dialect string) error {
+	return errors.New("dialect does not support %s [dialect=%s]", op, dialect)
+}
+
+func errMaintenanceTablesRequired(op exp.MaintenanceOperation, dialect string) error {
+	return errors.New("at least one table is required for %s [dialect=%s]", op, dialect)
+}
+
+func errVacuumTablesNotSupported(dialect string) error {
+	return errors.New("dialect does not support VACUUM of specific tables [dialect=%s]", dialect)
+}
+
+func errMaintenanceOptionNotSupported(option string, op exp.MaintenanceOperation, dialect string) error {
+	return errors.New("dialect does not support %s in %s [dialect=%s]", option, op, dialect)
+}
+
+func errMaintenanceOptionVacuumOnly(option string, op exp.MaintenanceOperation) error {
+	return errors.New("%s can only be used with VACUUM not %s", option, op)
+}
+
+func NewMaintenanceSQLGenerator(dialect string, do *SQLDialectOptions) MaintenanceSQLGenerator {
+	return &maintenanceSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (msg *maintenanceSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.MaintenanceClauses) {
+	for _, f := range msg.DialectOptions().MaintenanceSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case MaintenanceSQLFragment:
+			msg.MaintenanceSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment(clauses.Operation().String(), f))
+		}
+	}
+}
+
+// Generates a VACUUM, ANALYZE or OPTIMIZE statement (e.g. VACUUM (FULL, ANALYZE) "a", "b")
+func (msg *maintenanceSQLGenerator) MaintenanceSQL(b sb.SQLBuilder, clauses exp.MaintenanceClauses) {
+	do := msg.DialectOptions()
+	op := clauses.Operation()
+	var fragment []byte
+	switch op {
+	case exp.VacuumOperation:
+		fragment = do.VacuumFragment
+	case exp.AnalyzeOperation:
+		fragment = do.AnalyzeFragment
+	case exp.OptimizeOperation:
+		fragment = do.OptimizeFragment
+	}
+	switch {
+	case fragment == nil:
+		b.SetError(errMaintenanceNotSupported(op, msg.Dialect()))
+		return
+	case !clauses.HasTables() && !do.SupportsMaintenanceWithoutTables:
+		b.SetError(errMaintenanceTablesRequired(op, msg.Dialect()))
+		return
+	case clauses.HasTables() && op == exp.VacuumOperation && !do.SupportsVacuumTables:
+		b.SetError(errVacuumTablesNotSupported(msg.Dialect()))
+		return
+	}
+	b.Write(fragment)
+	msg.optionsSQL(b, op, clauses.Options())
+	if clauses.HasTables() {
+		b.WriteRunes(do.SpaceRune)
+		msg.ExpressionSQLGenerator().Generate(b, clauses.Tables())
+	}
+}
+
+func (msg *maintenanceSQLGenerator) optionsSQL(b sb.SQLBuilder, op exp.MaintenanceOperation, opts exp.MaintenanceOptions) {
+	if b.Error() != nil {
+		return
+	}
+	do := msg.DialectOptions()
+	options := []maintenanceOption{
+		{name: "FULL", enabled: opts.Full, fragment: do.MaintenanceFullFragment, vacuumOnly: true},
+		{name: "FREEZE", enabled: opts.Freeze, fragment: do.MaintenanceFreezeFragment, vacuumOnly: true},
+		{name: "VERBOSE", enabled: opts.Verbose, fragment: do.MaintenanceVerboseFragment},
+		{name: "ANALYZE", enabled: opts.Analyze, fragment: do.MaintenanceAnalyzeFragment, vacuumOnly: true},
+		{name: "SKIP_LOCKED", enabled: opts.SkipLocked, fragment: do.MaintenanceSkipLockedFragment},
+	}
+	written := 0
+	for _, o := range options {
+		if !o.enabled {
+			continue
+		}
+		switch {
+		case o.vacuumOnly && op != exp.VacuumOperation:
+			b.SetError(errMaintenanceOptionVacuumOnly(o.name, op))
+			return
+		case o.fragment == nil:
+			b.SetError(errMaintenanceOptionNotSupported(o.name, op, msg.Dialect()))
+			return
+		}
+		if written == 0 {
+			b.WriteRunes(do.SpaceRune, do.LeftParenRune)
+		} else {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		b.Write(o.fragment)
+		written++
+	}
+	if written > 0 {
+		b.WriteRunes(do.RightParenRune)
+	}
+}
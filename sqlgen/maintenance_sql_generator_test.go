@@ -0,0 +1,169 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	maintenanceTestCase struct {
+		clause exp.MaintenanceClauses
+		sql    string
+		err    string
+	}
+	maintenanceSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (msgs *maintenanceSQLGeneratorSuite) assertCases(
+	msg sqlgen.MaintenanceSQLGenerator,
+	testCases ...maintenanceTestCase,
+) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		msg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			msgs.assertErrorSQL(b, tc.err)
+		} else {
+			msgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (msgs *maintenanceSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewMaintenanceSQLGenerator("test", opts)
+	msgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewMaintenanceSQLGenerator("test2", opts2)
+	msgs.Equal("test2", d2.Dialect())
+}
+
+func (msgs *maintenanceSQLGeneratorSuite) TestGenerate_Vacuum() {
+	mc := exp.NewMaintenanceClauses()
+	tables := exp.NewColumnListExpression("a", "s.b")
+
+	msgs.assertCases(
+		sqlgen.NewMaintenanceSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		maintenanceTestCase{clause: mc, sql: `VACUUM`},
+		maintenanceTestCase{clause: mc.SetTables(tables), sql: `VACUUM "a", "s"."b"`},
+		maintenanceTestCase{
+			clause: mc.SetOptions(exp.MaintenanceOptions{Analyze: true}),
+			sql:    `VACUUM (ANALYZE)`,
+		},
+		maintenanceTestCase{
+			clause: mc.SetTables(tables).SetOptions(exp.MaintenanceOptions{
+				Full:       true,
+				Freeze:     true,
+				Verbose:    true,
+				Analyze:    true,
+				SkipLocked: true,
+			}),
+			sql: `VACUUM (FULL, FREEZE, VERBOSE, ANALYZE, SKIP_LOCKED) "a", "s"."b"`,
+		},
+	)
+}
+
+func (msgs *maintenanceSQLGeneratorSuite) TestGenerate_Analyze() {
+	mc := exp.NewMaintenanceClauses().SetOperation(exp.AnalyzeOperation)
+
+	msgs.assertCases(
+		sqlgen.NewMaintenanceSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		maintenanceTestCase{clause: mc, sql: `ANALYZE`},
+		maintenanceTestCase{clause: mc.SetTables(exp.NewColumnListExpression("a")), sql: `ANALYZE "a"`},
+		maintenanceTestCase{
+			clause: mc.SetTables(exp.NewColumnListExpression("a")).
+				SetOptions(exp.MaintenanceOptions{Verbose: true, SkipLocked: true}),
+			sql: `ANALYZE (VERBOSE, SKIP_LOCKED) "a"`,
+		},
+		maintenanceTestCase{
+			clause: mc.SetOptions(exp.MaintenanceOptions{Full: true}),
+			err:    "goqu: FULL can only be used with VACUUM not ANALYZE",
+		},
+		maintenanceTestCase{
+			clause: mc.SetOptions(exp.MaintenanceOptions{Analyze: true}),
+			err:    "goqu: ANALYZE can only be used with VACUUM not ANALYZE",
+		},
+	)
+}
+
+func (msgs *maintenanceSQLGeneratorSuite) TestGenerate_Optimize() {
+	mc := exp.NewMaintenanceClauses().SetOperation(exp.OptimizeOperation).SetTables(exp.NewColumnListExpression("a"))
+
+	msgs.assertCases(
+		sqlgen.NewMaintenanceSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		maintenanceTestCase{clause: mc, err: "goqu: dialect does not support OPTIMIZE [dialect=test]"},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.OptimizeFragment = []byte("OPTIMIZE TABLE")
+	opts.SupportsMaintenanceWithoutTables = false
+	msgs.assertCases(
+		sqlgen.NewMaintenanceSQLGenerator("test", opts),
+		maintenanceTestCase{clause: mc, sql: `OPTIMIZE TABLE "a"`},
+		maintenanceTestCase{
+			clause: mc.SetTables(nil),
+			err:    "goqu: at least one table is required for OPTIMIZE [dialect=test]",
+		},
+	)
+}
+
+func (msgs *maintenanceSQLGeneratorSuite) TestGenerate_UnsupportedOptions() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.MaintenanceFreezeFragment = nil
+	opts.SupportsVacuumTables = false
+	mc := exp.NewMaintenanceClauses()
+	msgs.assertCases(
+		sqlgen.NewMaintenanceSQLGenerator("test", opts),
+		maintenanceTestCase{
+			clause: mc.SetOptions(exp.MaintenanceOptions{Freeze: true}),
+			err:    "goqu: dialect does not support FREEZE in VACUUM [dialect=test]",
+		},
+		maintenanceTestCase{
+			clause: mc.SetTables(exp.NewColumnListExpression("a")),
+			err:    "goqu: dialect does not support VACUUM of specific tables [dialect=test]",
+		},
+		maintenanceTestCase{
+			clause: mc.SetOperation(exp.AnalyzeOperation).SetTables(exp.NewColumnListExpression("a")),
+			sql:    `ANALYZE "a"`,
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.VacuumFragment = nil
+	msgs.assertCases(
+		sqlgen.NewMaintenanceSQLGenerator("test", opts),
+		maintenanceTestCase{clause: mc, err: "goqu: dialect does not support VACUUM [dialect=test]"},
+	)
+}
+
+func (msgs *maintenanceSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.MaintenanceSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	msgs.assertCases(
+		sqlgen.NewMaintenanceSQLGenerator("test", opts),
+		maintenanceTestCase{
+			clause: exp.NewMaintenanceClauses().SetOperation(exp.AnalyzeOperation),
+			err:    "goqu: unsupported ANALYZE SQL fragment UpdateBeginSQLFragment",
+		},
+	)
+}
+
+func (msgs *maintenanceSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewMaintenanceSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewMaintenanceClauses())
+	msgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestMaintenanceSQLGenerator(t *testing.T) {
+	suite.Run(t, new(maintenanceSQLGeneratorSuite))
+}
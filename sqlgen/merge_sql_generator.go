@@ -0,0 +1,189 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	MergeSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.MergeClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	mergeSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoTargetForMerge   = errors.New("no target found when generating merge sql")
+	errNoSourceForMerge   = errors.New("a source is required when generating merge sql")
+	errNoOnForMerge       = errors.New("an ON condition is required when generating merge sql")
+	errNoWhenForMerge     = errors.New("at least one WHEN clause is required when generating merge sql")
+	errInvalidRowForMerge = errors.New("a single row of values is required to insert in a merge")
+)
+
+func errMergeNotSupported(dialect string) error {
+	return errors.New("dialect does not support MERGE [dialect=%s]", dialect)
+}
+
+func errMergeFeatureNotSupported(dialect, feature string) error {
+	return errors.New("dialect does not support %s in MERGE [dialect=%s]", feature, dialect)
+}
+
+func NewMergeSQLGenerator(dialect string, do *SQLDialectOptions) MergeSQLGenerator {
+	return &mergeSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (msg *mergeSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.MergeClauses) {
+	switch {
+	case !clauses.HasTarget():
+		b.SetError(errNoTargetForMerge)
+		return
+	case clauses.Source() == nil:
+		b.SetError(errNoSourceForMerge)
+		return
+	case clauses.On() == nil || clauses.On().IsEmpty():
+		b.SetError(errNoOnForMerge)
+		return
+	case len(clauses.WhenClauses()) == 0:
+		b.SetError(errNoWhenForMerge)
+		return
+	}
+	for _, f := range msg.DialectOptions().MergeSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case MergeSQLFragment:
+			msg.MergeSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("MERGE", f))
+		}
+	}
+}
+
+// Generates a MERGE statement
+func (msg *mergeSQLGenerator) MergeSQL(b sb.SQLBuilder, clauses exp.MergeClauses) {
+	do := msg.DialectOptions()
+	if !msg.checkSupported(b, clauses) {
+		return
+	}
+	b.Write(do.MergeFragment)
+	msg.SourcesExpressionSQLGenerator().Generate(b, clauses.Target())
+	b.Write(do.UsingFragment)
+	msg.SourcesExpressionSQLGenerator().Generate(b, clauses.Source())
+	b.Write(do.OnFragment)
+	msg.onSQL(b, clauses.On())
+	for _, wc := range clauses.WhenClauses() {
+		if b.Error() != nil {
+			return
+		}
+		msg.whenClauseSQL(b, wc)
+	}
+	b.Write(do.MergeEndFragment)
+}
+
+// Generates the ON condition of a MERGE statement, the condition is always wrapped in parens
+func (msg *mergeSQLGenerator) onSQL(b sb.SQLBuilder, on exp.ExpressionList) {
+	if exps := on.Expressions(); len(exps) == 1 {
+		wrappedExpressionSQL(b, msg.ExpressionSQLGenerator(), msg.DialectOptions(), exps[0])
+		return
+	}
+	msg.ExpressionSQLGenerator().Generate(b, on)
+}
+
+// Generates a WHEN [NOT] MATCHED clause (e.g. WHEN MATCHED AND "a" > 1 THEN DELETE)
+func (msg *mergeSQLGenerator) whenClauseSQL(b sb.SQLBuilder, wc exp.MergeWhenClause) {
+	do := msg.DialectOptions()
+	if wc.IsMatched() {
+		b.Write(do.MergeWhenMatchedFragment)
+	} else {
+		b.Write(do.MergeWhenNotMatchedFragment)
+	}
+	hasCondition := wc.Condition() != nil && !wc.Condition().IsEmpty()
+	if hasCondition && do.MergeConditionFragment != nil {
+		b.Write(do.MergeConditionFragment)
+		msg.ExpressionSQLGenerator().Generate(b, wc.Condition())
+	}
+	b.Write(do.MergeThenFragment)
+	switch wc.Action() {
+	case exp.UpdateMergeAction:
+		updates, err := exp.NewUpdateExpressions(wc.Value())
+		if err != nil {
+			b.SetError(err)
+			return
+		}
+		b.Write(do.MergeUpdateFragment)
+		msg.UpdateExpressionSQL(b, updates...)
+	case exp.DeleteMergeAction:
+		b.Write(do.MergeDeleteFragment)
+	case exp.InsertMergeAction:
+		msg.insertSQL(b, wc.Value())
+	}
+	if hasCondition && do.MergeConditionFragment == nil {
+		b.Write(do.MergeActionWhereFragment)
+		msg.ExpressionSQLGenerator().Generate(b, wc.Condition())
+	}
+}
+
+// Generates the INSERT action of a WHEN NOT MATCHED clause (e.g. INSERT ("a", "b") VALUES (1, 2))
+func (msg *mergeSQLGenerator) insertSQL(b sb.SQLBuilder, row interface{}) {
+	do := msg.DialectOptions()
+	ie, err := exp.NewInsertExpression(row)
+	if err != nil {
+		b.SetError(err)
+		return
+	}
+	if ie.IsInsertFrom() || ie.IsEmpty() || len(ie.Vals()) != 1 {
+		b.SetError(errInvalidRowForMerge)
+		return
+	}
+	b.Write(do.MergeInsertFragment).WriteRunes(do.LeftParenRune)
+	msg.ExpressionSQLGenerator().Generate(b, ie.Cols())
+	b.WriteRunes(do.RightParenRune).Write(do.ValuesFragment).WriteRunes(do.LeftParenRune)
+	msg.ExpressionSQLGenerator().Generate(b, ie.Vals()[0])
+	b.WriteRunes(do.RightParenRune)
+}
+
+func (msg *mergeSQLGenerator) checkSupported(b sb.SQLBuilder, clauses exp.MergeClauses) bool {
+	switch feature := msg.unsupportedFeature(clauses); {
+	case msg.DialectOptions().MergeFragment == nil:
+		b.SetError(errMergeNotSupported(msg.Dialect()))
+	case feature != "":
+		b.SetError(errMergeFeatureNotSupported(msg.Dialect(), feature))
+	default:
+		return true
+	}
+	return false
+}
+
+// returns the first feature of the merge that is not supported by the dialect or an empty string
+func (msg *mergeSQLGenerator) unsupportedFeature(clauses exp.MergeClauses) string {
+	do := msg.DialectOptions()
+	matched, notMatched := 0, 0
+	for _, wc := range clauses.WhenClauses() {
+		hasCondition := wc.Condition() != nil && !wc.Condition().IsEmpty()
+		switch {
+		case wc.Action() == exp.DeleteMergeAction && do.MergeDeleteFragment == nil:
+			return "WHEN MATCHED THEN DELETE"
+		case hasCondition && do.MergeConditionFragment == nil && do.MergeActionWhereFragment == nil:
+			return "conditions on WHEN clauses"
+		}
+		if wc.IsMatched() {
+			matched++
+		} else {
+			notMatched++
+		}
+	}
+	if !do.SupportsMultipleMergeWhenClauses && (matched > 1 || notMatched > 1) {
+		return "multiple WHEN MATCHED or WHEN NOT MATCHED clauses"
+	}
+	return ""
+}
@@ -0,0 +1,201 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	mergeTestCase struct {
+		clause     exp.MergeClauses
+		sql        string
+		isPrepared bool
+		args       []interface{}
+		err        string
+	}
+	mergeSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (msgs *mergeSQLGeneratorSuite) assertCases(msg sqlgen.MergeSQLGenerator, testCases ...mergeTestCase) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(tc.isPrepared)
+		msg.Generate(b, tc.clause)
+		switch {
+		case len(tc.err) > 0:
+			msgs.assertErrorSQL(b, tc.err)
+		case tc.isPrepared:
+			msgs.assertPreparedSQL(b, tc.sql, tc.args)
+		default:
+			msgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (msgs *mergeSQLGeneratorSuite) baseClauses() exp.MergeClauses {
+	return exp.NewMergeClauses().
+		SetTarget(exp.ParseIdentifier("a")).
+		SetSource(exp.ParseIdentifier("b")).
+		SetOn(exp.NewExpressionList(exp.AndType, exp.ParseIdentifier("a.id").Eq(exp.ParseIdentifier("b.id"))))
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewMergeSQLGenerator("test", opts)
+	msgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewMergeSQLGenerator("test2", opts2)
+	msgs.Equal("test2", d2.Dialect())
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate() {
+	mc := msgs.baseClauses()
+	update := exp.NewMergeUpdateClause(nil, exp.Record{"c": exp.ParseIdentifier("b.c")})
+	insert := exp.NewMergeInsertClause(nil, exp.Record{"id": exp.ParseIdentifier("b.id"), "c": 1})
+	cond := exp.NewExpressionList(exp.AndType, exp.ParseIdentifier("b.d").IsTrue())
+
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(update),
+			sql:    `MERGE INTO "a" USING "b" ON ("a"."id" = "b"."id") WHEN MATCHED THEN UPDATE SET "c"="b"."c"`,
+		},
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeDeleteClause(cond)).WhenClausesAppend(update).WhenClausesAppend(insert),
+			sql: `MERGE INTO "a" USING "b" ON ("a"."id" = "b"."id") WHEN MATCHED AND ("b"."d" IS TRUE) THEN DELETE ` +
+				`WHEN MATCHED THEN UPDATE SET "c"="b"."c" WHEN NOT MATCHED THEN INSERT ("c", "id") VALUES (1, "b"."id")`,
+		},
+		mergeTestCase{
+			clause:     mc.WhenClausesAppend(exp.NewMergeInsertClause(cond, exp.Record{"id": 1})),
+			sql:        `MERGE INTO "a" USING "b" ON ("a"."id" = "b"."id") WHEN NOT MATCHED AND ("b"."d" IS TRUE) THEN INSERT ("id") VALUES (?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		mergeTestCase{
+			clause: mc.SetTarget(exp.ParseIdentifier("a").As("t")).
+				SetSource(exp.NewLiteralExpression("(VALUES (1))").As("s")).
+				SetOn(exp.NewExpressionList(exp.AndType, exp.NewLiteralExpression("t.id = s.id"), exp.ParseIdentifier("t.c").Gt(1))).
+				WhenClausesAppend(exp.NewMergeDeleteClause(nil)),
+			sql: `MERGE INTO "a" AS "t" USING (VALUES (1)) AS "s" ON (t.id = s.id AND ("t"."c" > 1)) WHEN MATCHED THEN DELETE`,
+		},
+		mergeTestCase{
+			clause: mc.SetOn(exp.NewExpressionList(exp.AndType, exp.NewLiteralExpression("a.id = b.id"))).
+				WhenClausesAppend(exp.NewMergeDeleteClause(nil)),
+			sql: `MERGE INTO "a" USING "b" ON (a.id = b.id) WHEN MATCHED THEN DELETE`,
+		},
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeUpdateClause(nil, exp.Record{})),
+			err:    "goqu: no update values provided",
+		},
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeUpdateClause(nil, true)),
+			err:    "goqu: unsupported update interface type bool",
+		},
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeInsertClause(nil, []exp.Record{{"id": 1}, {"id": 2}})),
+			err:    "goqu: a single row of values is required to insert in a merge",
+		},
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeInsertClause(nil, exp.Record{})),
+			err:    "goqu: a single row of values is required to insert in a merge",
+		},
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeInsertClause(nil, true)),
+			err:    "goqu: unsupported insert must be map, goqu.Record, or struct type got: bool",
+		},
+
+		mergeTestCase{clause: exp.NewMergeClauses(), err: "goqu: no target found when generating merge sql"},
+		mergeTestCase{clause: mc.SetSource(nil), err: "goqu: a source is required when generating merge sql"},
+		mergeTestCase{
+			clause: mc.SetOn(exp.NewExpressionList(exp.AndType)),
+			err:    "goqu: an ON condition is required when generating merge sql",
+		},
+		mergeTestCase{clause: mc, err: "goqu: at least one WHEN clause is required when generating merge sql"},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_WithActionWhere() {
+	mc := msgs.baseClauses()
+	cond := exp.NewExpressionList(exp.AndType, exp.ParseIdentifier("b.d").IsTrue())
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsMultipleMergeWhenClauses = false
+	opts.MergeConditionFragment = nil
+	opts.MergeActionWhereFragment = []byte(" WHERE ")
+	opts.MergeEndFragment = []byte(";")
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeUpdateClause(cond, exp.Record{"c": 1})).
+				WhenClausesAppend(exp.NewMergeInsertClause(cond, exp.Record{"id": 1})),
+			sql: `MERGE INTO "a" USING "b" ON ("a"."id" = "b"."id") WHEN MATCHED THEN UPDATE SET "c"=1 WHERE ("b"."d" IS TRUE) ` +
+				`WHEN NOT MATCHED THEN INSERT ("id") VALUES (1) WHERE ("b"."d" IS TRUE);`,
+		},
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeUpdateClause(cond, exp.Record{"c": 1})).
+				WhenClausesAppend(exp.NewMergeDeleteClause(nil)),
+			err: "goqu: dialect does not support multiple WHEN MATCHED or WHEN NOT MATCHED clauses in MERGE [dialect=test]",
+		},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_WithUnsupportedFeatures() {
+	mc := msgs.baseClauses()
+	cond := exp.NewExpressionList(exp.AndType, exp.ParseIdentifier("b.d").IsTrue())
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.MergeConditionFragment = nil
+	opts.MergeDeleteFragment = nil
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeDeleteClause(nil)),
+			err:    "goqu: dialect does not support WHEN MATCHED THEN DELETE in MERGE [dialect=test]",
+		},
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeInsertClause(cond, exp.Record{"id": 1})),
+			err:    "goqu: dialect does not support conditions on WHEN clauses in MERGE [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.MergeFragment = nil
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{
+			clause: mc.WhenClausesAppend(exp.NewMergeDeleteClause(nil)),
+			err:    "goqu: dialect does not support MERGE [dialect=test]",
+		},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.MergeSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{
+			clause: msgs.baseClauses().WhenClausesAppend(exp.NewMergeDeleteClause(nil)),
+			err:    "goqu: unsupported MERGE SQL fragment UpdateBeginSQLFragment",
+		},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewMergeSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, msgs.baseClauses().WhenClausesAppend(exp.NewMergeDeleteClause(nil)))
+	msgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestMergeSQLGenerator(t *testing.T) {
+	suite.Run(t, new(mergeSQLGeneratorSuite))
+}
@@ -0,0 +1,64 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	RefreshSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.RefreshClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	refreshSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var errNoViewForRefresh = errors.New("no view found when generating refresh sql")
+
+func errRefreshNotSupported(dialect string) error {
+	return errors.New("dialect does not support REFRESH MATERIALIZED VIEW [dialect=%s]", dialect)
+}
+
+func NewRefreshSQLGenerator(dialect string, do *SQLDialectOptions) RefreshSQLGenerator {
+	return &refreshSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (rsg *refreshSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.RefreshClauses) {
+	if !clauses.HasView() {
+		b.SetError(errNoViewForRefresh)
+		return
+	}
+	for _, f := range rsg.DialectOptions().RefreshSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case RefreshSQLFragment:
+			rsg.RefreshSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("REFRESH", f))
+		}
+	}
+}
+
+// Generates a REFRESH MATERIALIZED VIEW statement
+func (rsg *refreshSQLGenerator) RefreshSQL(b sb.SQLBuilder, clauses exp.RefreshClauses) {
+	do := rsg.DialectOptions()
+	if do.RefreshMaterializedViewFragment == nil {
+		b.SetError(errRefreshNotSupported(rsg.Dialect()))
+		return
+	}
+	b.Write(do.RefreshMaterializedViewFragment)
+	if clauses.IsConcurrently() {
+		b.Write(do.ConcurrentlyFragment)
+	}
+	rsg.ExpressionSQLGenerator().Generate(b, clauses.View())
+}
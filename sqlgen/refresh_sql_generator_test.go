@@ -0,0 +1,92 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	refreshTestCase struct {
+		clause exp.RefreshClauses
+		sql    string
+		err    string
+	}
+	refreshSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (rsgs *refreshSQLGeneratorSuite) assertCases(rsg sqlgen.RefreshSQLGenerator, testCases ...refreshTestCase) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		rsg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			rsgs.assertErrorSQL(b, tc.err)
+		} else {
+			rsgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (rsgs *refreshSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewRefreshSQLGenerator("test", opts)
+	rsgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewRefreshSQLGenerator("test2", opts2)
+	rsgs.Equal("test2", d2.Dialect())
+}
+
+func (rsgs *refreshSQLGeneratorSuite) TestGenerate() {
+	rc := exp.NewRefreshClauses().SetView(exp.ParseIdentifier("s.a"))
+
+	rsgs.assertCases(
+		sqlgen.NewRefreshSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		refreshTestCase{clause: rc, sql: `REFRESH MATERIALIZED VIEW "s"."a"`},
+		refreshTestCase{clause: rc.SetConcurrently(true), sql: `REFRESH MATERIALIZED VIEW CONCURRENTLY "s"."a"`},
+
+		refreshTestCase{clause: exp.NewRefreshClauses(), err: "goqu: no view found when generating refresh sql"},
+	)
+}
+
+func (rsgs *refreshSQLGeneratorSuite) TestGenerate_WithUnsupportedRefresh() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.RefreshMaterializedViewFragment = nil
+	rsgs.assertCases(
+		sqlgen.NewRefreshSQLGenerator("test", opts),
+		refreshTestCase{
+			clause: exp.NewRefreshClauses().SetView(exp.ParseIdentifier("a")),
+			err:    "goqu: dialect does not support REFRESH MATERIALIZED VIEW [dialect=test]",
+		},
+	)
+}
+
+func (rsgs *refreshSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.RefreshSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	rsgs.assertCases(
+		sqlgen.NewRefreshSQLGenerator("test", opts),
+		refreshTestCase{
+			clause: exp.NewRefreshClauses().SetView(exp.ParseIdentifier("a")),
+			err:    "goqu: unsupported REFRESH SQL fragment UpdateBeginSQLFragment",
+		},
+	)
+}
+
+func (rsgs *refreshSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewRefreshSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewRefreshClauses().SetView(exp.ParseIdentifier("a")))
+	rsgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestRefreshSQLGenerator(t *testing.T) {
+	suite.Run(t, new(refreshSQLGeneratorSuite))
+}
@@ -1,6 +1,10 @@
 package sqlgen
 
 import (
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/doug-martin/goqu/v9/internal/errors"
 	"github.com/doug-martin/goqu/v9/internal/sb"
@@ -37,13 +41,94 @@ func ErrWindowNotSupported(dialect string) error {
 	return errors.New("dialect does not support WINDOW clause [dialect=%s]", dialect)
 }
 
-var ErrNoWindowName = errors.New("window expresion has no valid name")
+func errStraightJoinNotSupported(dialect string) error {
+	return errors.New("dialect does not support STRAIGHT_JOIN [dialect=%s]", dialect)
+}
+
+func errAsOfSystemTimeNotSupported(dialect string) error {
+	return errors.New("dialect does not support AS OF SYSTEM TIME [dialect=%s]", dialect)
+}
+
+func errQualifyNotSupported(dialect string) error {
+	return errors.New("dialect does not support QUALIFY clause [dialect=%s]", dialect)
+}
+
+func errConnectByNotSupported(dialect string) error {
+	return errors.New("dialect does not support CONNECT BY clause [dialect=%s]", dialect)
+}
+
+func errPrewhereNotSupported(dialect string) error {
+	return errors.New("dialect does not support PREWHERE clause [dialect=%s]", dialect)
+}
+
+func errSettingsNotSupported(dialect string) error {
+	return errors.New("dialect does not support SETTINGS clause [dialect=%s]", dialect)
+}
+
+func errInvalidSettingName(dialect, name string) error {
+	return errors.New("setting name %q must only contain letters, digits and _ [dialect=%s]", name, dialect)
+}
+
+func errSelectIntoNotSupported(dialect string) error {
+	return errors.New("dialect does not support SELECT INTO [dialect=%s]", dialect)
+}
+
+func errSelectIntoTempNotSupported(dialect string) error {
+	return errors.New("dialect does not support SELECT INTO a temporary table [dialect=%s]", dialect)
+}
+
+func errLockWaitSecondsNotSupported(dialect string) error {
+	return errors.New("dialect does not support waiting a number of seconds for a lock [dialect=%s]", dialect)
+}
+
+func errLockWithLimitNotSupported(dialect string) error {
+	return errors.New("dialect does not support locking rows with LIMIT, OFFSET or FETCH [dialect=%s]", dialect)
+}
+
+func errParenthesizedCompoundNotSupported(dialect string) error {
+	return errors.New("dialect does not support parenthesized compound queries [dialect=%s]", dialect)
+}
+
+func errParenthesizedCompoundLimitNotSupported(dialect string) error {
+	return errors.New("dialect does not support limiting a parenthesized compound query [dialect=%s]", dialect)
+}
+
+var (
+	ErrNoWindowName = errors.New("window expresion has no valid name")
+
+	errConnectByConditionRequired = errors.New("a CONNECT BY condition is required for a hierarchical query")
+)
 
 func NewSelectSQLGenerator(dialect string, do *SQLDialectOptions) SelectSQLGenerator {
 	return &selectSQLGenerator{NewCommonSQLGenerator(dialect, do)}
 }
 
 func (ssg *selectSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.SelectClauses) {
+	if clauses.AsOfSystemTime() != nil && !ssg.DialectOptions().SupportsAsOfSystemTime {
+		b.SetError(errAsOfSystemTimeNotSupported(ssg.Dialect()))
+		return
+	}
+	if q := clauses.Qualify(); q != nil && !q.IsEmpty() && !ssg.DialectOptions().SupportsQualify {
+		b.SetError(errQualifyNotSupported(ssg.Dialect()))
+		return
+	}
+	if clauses.ConnectBy() != nil && !ssg.DialectOptions().hasSelectFragment(ConnectBySQLFragment) {
+		b.SetError(errConnectByNotSupported(ssg.Dialect()))
+		return
+	}
+	if p := clauses.Prewhere(); p != nil && !p.IsEmpty() && !ssg.DialectOptions().hasSelectFragment(PrewhereSQLFragment) {
+		b.SetError(errPrewhereNotSupported(ssg.Dialect()))
+		return
+	}
+	if len(clauses.Settings()) > 0 && !ssg.DialectOptions().hasSelectFragment(SettingsSQLFragment) {
+		b.SetError(errSettingsNotSupported(ssg.Dialect()))
+		return
+	}
+	if !ssg.DialectOptions().SupportsLockWithLimit && isLocked(clauses.Lock()) &&
+		(clauses.HasLimit() || clauses.Offset() > 0) {
+		b.SetError(errLockWithLimitNotSupported(ssg.Dialect()))
+		return
+	}
 	for _, f := range ssg.DialectOptions().SelectSQLOrder {
 		if b.Error() != nil {
 			return
@@ -55,16 +140,32 @@ func (ssg *selectSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.SelectClaus
 			ssg.SelectSQL(b, clauses)
 		case SelectWithLimitSQLFragment:
 			ssg.SelectWithLimitSQL(b, clauses)
+		case SelectWithFirstSkipSQLFragment:
+			ssg.SelectWithFirstSkipSQL(b, clauses)
 		case FromSQLFragment:
-			ssg.FromSQL(b, clauses.From())
+			ssg.selectFromSQL(b, clauses)
 		case JoinSQLFragment:
 			ssg.JoinSQL(b, clauses.Joins())
+		case AsOfSystemTimeSQLFragment:
+			ssg.AsOfSystemTimeSQL(b, clauses.AsOfSystemTime())
+		case FinalSQLFragment:
+			ssg.FinalSQL(b, clauses.IsFinal())
+		case SampleSQLFragment:
+			ssg.SampleSQL(b, clauses.Sample())
+		case PrewhereSQLFragment:
+			ssg.PrewhereSQL(b, clauses.Prewhere())
+		case SettingsSQLFragment:
+			ssg.SettingsSQL(b, clauses.Settings())
 		case WhereSQLFragment:
 			ssg.WhereSQL(b, clauses.Where())
+		case ConnectBySQLFragment:
+			ssg.ConnectBySQL(b, clauses.ConnectBy())
 		case GroupBySQLFragment:
 			ssg.GroupBySQL(b, clauses.GroupBy())
 		case HavingSQLFragment:
 			ssg.HavingSQL(b, clauses.Having())
+		case QualifySQLFragment:
+			ssg.QualifySQL(b, clauses.Qualify())
 		case WindowSQLFragment:
 			ssg.WindowSQL(b, clauses.Windows())
 		case CompoundsSQLFragment:
@@ -77,6 +178,8 @@ func (ssg *selectSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.SelectClaus
 			ssg.LimitSQL(b, clauses.Limit())
 		case OffsetSQLFragment:
 			ssg.OffsetSQL(b, clauses.Offset())
+		case OffsetFetchSQLFragment:
+			ssg.OffsetFetchSQL(b, clauses.Offset(), clauses.Limit())
 		case ForSQLFragment:
 			ssg.ForSQL(b, clauses.Lock())
 		default:
@@ -86,6 +189,20 @@ func (ssg *selectSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.SelectClaus
 }
 
 func (ssg *selectSQLGenerator) selectSQLCommon(b sb.SQLBuilder, clauses exp.SelectClauses) {
+	if hints := clauses.Hints(); len(hints) > 0 {
+		if !ssg.DialectOptions().SupportsOptimizerHints {
+			b.SetError(errOptimizerHintsNotSupported(ssg.Dialect()))
+			return
+		}
+		if err := validateOptimizerHints(ssg.Dialect(), hints); err != nil {
+			b.SetError(err)
+			return
+		}
+		b.Write(ssg.DialectOptions().HintBeginFragment).
+			WriteStrings(strings.Join(hints, " ")).
+			Write(ssg.DialectOptions().HintEndFragment)
+	}
+
 	dc := clauses.Distinct()
 	if dc != nil {
 		b.Write(ssg.DialectOptions().DistinctFragment)
@@ -103,29 +220,140 @@ func (ssg *selectSQLGenerator) selectSQLCommon(b sb.SQLBuilder, clauses exp.Sele
 		}
 	}
 
+	if clauses.IsStraightJoin() {
+		if !ssg.DialectOptions().SupportsStraightJoin {
+			b.SetError(errStraightJoinNotSupported(ssg.Dialect()))
+			return
+		}
+		b.Write(ssg.DialectOptions().StraightJoinFragment)
+	}
+
 	if cols := clauses.Select(); clauses.IsDefaultSelect() || len(cols.Columns()) == 0 {
 		b.WriteRunes(ssg.DialectOptions().StarRune)
 	} else {
 		ssg.ExpressionSQLGenerator().Generate(b, cols)
 	}
+	ssg.SelectIntoSQL(b, clauses.Into())
+}
+
+// Adds the INTO clause that creates a table from the results of a SELECT (e.g. SELECT * INTO TEMPORARY "t" ...)
+func (ssg *selectSQLGenerator) SelectIntoSQL(b sb.SQLBuilder, into exp.SelectIntoExpression) {
+	if into == nil {
+		return
+	}
+	if !into.IsTemporary() {
+		if ssg.DialectOptions().SelectIntoFragment == nil {
+			b.SetError(errSelectIntoNotSupported(ssg.Dialect()))
+			return
+		}
+		b.Write(ssg.DialectOptions().SelectIntoFragment)
+		ssg.ExpressionSQLGenerator().Generate(b, into.Table())
+		return
+	}
+	if ssg.DialectOptions().SelectIntoTempFragment == nil {
+		b.SetError(errSelectIntoTempNotSupported(ssg.Dialect()))
+		return
+	}
+	b.Write(ssg.DialectOptions().SelectIntoTempFragment)
+	ssg.ExpressionSQLGenerator().Generate(b, tempTableName(ssg.DialectOptions().TempTableNamePrefix, into.Table()))
 }
 
 // Adds the SELECT clause and columns to a sql statement
 func (ssg *selectSQLGenerator) SelectSQL(b sb.SQLBuilder, clauses exp.SelectClauses) {
+	if p := clauses.Parenthesized(); p != nil {
+		ssg.parenthesizedSQL(b, p)
+		return
+	}
 	b.Write(ssg.DialectOptions().SelectClause).WriteRunes(ssg.DialectOptions().SpaceRune)
 	ssg.selectSQLCommon(b, clauses)
 }
 
 // Adds the SELECT clause along with LIMIT to a SQL statement (e.g. MSSQL dialect: SELECT TOP 10 ...)
 func (ssg *selectSQLGenerator) SelectWithLimitSQL(b sb.SQLBuilder, clauses exp.SelectClauses) {
+	if p := clauses.Parenthesized(); p != nil {
+		if clauses.Offset() == 0 && clauses.Limit() != nil {
+			b.SetError(errParenthesizedCompoundLimitNotSupported(ssg.Dialect()))
+			return
+		}
+		ssg.parenthesizedSQL(b, p)
+		return
+	}
 	b.Write(ssg.DialectOptions().SelectClause).WriteRunes(ssg.DialectOptions().SpaceRune)
 	if clauses.Offset() == 0 && clauses.Limit() != nil {
-		ssg.LimitSQL(b, clauses.Limit())
+		ssg.TopSQL(b, clauses.Limit())
 		b.WriteRunes(ssg.DialectOptions().SpaceRune)
 	}
 	ssg.selectSQLCommon(b, clauses)
 }
 
+// Adds the SELECT clause along with FIRST and SKIP to a SQL statement (e.g. firebird: SELECT FIRST 10 SKIP 20 ...)
+// Prepared values are wrapped in parentheses since parameters are only accepted as an expression.
+func (ssg *selectSQLGenerator) SelectWithFirstSkipSQL(b sb.SQLBuilder, clauses exp.SelectClauses) {
+	if p := clauses.Parenthesized(); p != nil {
+		if clauses.Limit() != nil || clauses.Offset() > 0 {
+			b.SetError(errParenthesizedCompoundLimitNotSupported(ssg.Dialect()))
+			return
+		}
+		ssg.parenthesizedSQL(b, p)
+		return
+	}
+	b.Write(ssg.DialectOptions().SelectClause).WriteRunes(ssg.DialectOptions().SpaceRune)
+	if limit := clauses.Limit(); limit != nil {
+		if fe, ok := limit.(exp.FetchExpression); ok {
+			switch {
+			case fe.IsWithTies():
+				b.SetError(errFetchWithTiesNotSupported(ssg.Dialect()))
+				return
+			case fe.IsPercent():
+				b.SetError(errFetchPercentNotSupported(ssg.Dialect()))
+				return
+			}
+			limit = fe.Count()
+		}
+		b.Write(ssg.DialectOptions().FirstFragment)
+		ssg.firstSkipValueSQL(b, limit)
+	}
+	if offset := clauses.Offset(); offset > 0 {
+		b.Write(ssg.DialectOptions().SkipFragment)
+		ssg.firstSkipValueSQL(b, offset)
+	}
+	ssg.selectSQLCommon(b, clauses)
+}
+
+// Generates the FROM clause, writing the DualFromFragment when the SELECT has no source
+func (ssg *selectSQLGenerator) selectFromSQL(b sb.SQLBuilder, clauses exp.SelectClauses) {
+	from := clauses.From()
+	if (from == nil || from.IsEmpty()) && clauses.Parenthesized() == nil &&
+		ssg.DialectOptions().DualFromFragment != nil {
+		b.Write(ssg.DialectOptions().DualFromFragment)
+		return
+	}
+	ssg.FromSQL(b, from)
+}
+
+// Adds a parenthesized query in place of the SELECT clause so compounds can be grouped
+// (e.g. (SELECT * FROM "a" UNION (SELECT * FROM "b")) INTERSECT (SELECT * FROM "c"))
+func (ssg *selectSQLGenerator) parenthesizedSQL(b sb.SQLBuilder, p exp.AppendableExpression) {
+	if !ssg.DialectOptions().WrapCompoundsInParens {
+		b.SetError(errParenthesizedCompoundNotSupported(ssg.Dialect()))
+		return
+	}
+	b.WriteRunes(ssg.DialectOptions().LeftParenRune)
+	p.AppendSQL(b)
+	b.WriteRunes(ssg.DialectOptions().RightParenRune)
+}
+
+func (ssg *selectSQLGenerator) firstSkipValueSQL(b sb.SQLBuilder, val interface{}) {
+	if b.IsPrepared() {
+		b.WriteRunes(ssg.DialectOptions().LeftParenRune)
+		ssg.ExpressionSQLGenerator().Generate(b, val)
+		b.WriteRunes(ssg.DialectOptions().RightParenRune)
+	} else {
+		ssg.ExpressionSQLGenerator().Generate(b, val)
+	}
+	b.WriteRunes(ssg.DialectOptions().SpaceRune)
+}
+
 // Generates the JOIN clauses for an SQL statement
 func (ssg *selectSQLGenerator) JoinSQL(b sb.SQLBuilder, joins exp.JoinExpressions) {
 	if len(joins) > 0 {
@@ -136,7 +364,7 @@ func (ssg *selectSQLGenerator) JoinSQL(b sb.SQLBuilder, joins exp.JoinExpression
 				return
 			}
 			b.Write(joinType)
-			ssg.ExpressionSQLGenerator().Generate(b, j.Table())
+			ssg.SourcesExpressionSQLGenerator().Generate(b, j.Table())
 			if t, ok := j.(exp.ConditionedJoinExpression); ok {
 				if t.IsConditionEmpty() {
 					b.SetError(ErrJoinConditionRequired(j))
@@ -148,6 +376,109 @@ func (ssg *selectSQLGenerator) JoinSQL(b sb.SQLBuilder, joins exp.JoinExpression
 	}
 }
 
+// Generates the AS OF SYSTEM TIME clause for an SQL statement. The timestamp is always interpolated because older
+// versions of cockroachdb do not accept placeholders in AS OF SYSTEM TIME.
+func (ssg *selectSQLGenerator) AsOfSystemTimeSQL(b sb.SQLBuilder, ts interface{}) {
+	if ts == nil {
+		return
+	}
+	tsb := sb.NewSQLBuilder(false)
+	ssg.ExpressionSQLGenerator().Generate(tsb, ts)
+	tsSQL, _, err := tsb.ToSQL()
+	if err != nil {
+		b.SetError(err)
+		return
+	}
+	b.Write(ssg.DialectOptions().AsOfSystemTimeFragment).WriteStrings(tsSQL)
+}
+
+// Generates the FINAL modifier for the tables of an SQL statement (e.g. clickhouse)
+func (ssg *selectSQLGenerator) FinalSQL(b sb.SQLBuilder, final bool) {
+	if final {
+		b.Write(ssg.DialectOptions().FinalFragment)
+	}
+}
+
+// Generates the SAMPLE clause for an SQL statement (e.g. clickhouse)
+func (ssg *selectSQLGenerator) SampleSQL(b sb.SQLBuilder, sample exp.Expression) {
+	if sample != nil {
+		b.Write(ssg.DialectOptions().SampleFragment)
+		ssg.ExpressionSQLGenerator().Generate(b, sample)
+	}
+}
+
+// Generates the START WITH and CONNECT BY clauses of a hierarchical query (e.g. oracle)
+func (ssg *selectSQLGenerator) ConnectBySQL(b sb.SQLBuilder, cb exp.ConnectByExpression) {
+	if cb == nil {
+		return
+	}
+	if cb.Condition().IsEmpty() {
+		b.SetError(errConnectByConditionRequired)
+		return
+	}
+	if sw := cb.StartWith(); sw != nil && !sw.IsEmpty() {
+		b.Write(ssg.DialectOptions().ConnectByStartWithFragment)
+		ssg.ExpressionSQLGenerator().Generate(b, sw)
+	}
+	b.Write(ssg.DialectOptions().ConnectByFragment)
+	if cb.IsNoCycle() {
+		b.Write(ssg.DialectOptions().ConnectByNoCycleFragment)
+	}
+	ssg.ExpressionSQLGenerator().Generate(b, cb.Condition())
+}
+
+// Generates the PREWHERE clause for an SQL statement (e.g. clickhouse)
+func (ssg *selectSQLGenerator) PrewhereSQL(b sb.SQLBuilder, prewhere exp.ExpressionList) {
+	if prewhere != nil && !prewhere.IsEmpty() {
+		b.Write(ssg.DialectOptions().PrewhereFragment)
+		ssg.ExpressionSQLGenerator().Generate(b, prewhere)
+	}
+}
+
+// Generates the SETTINGS clause for an SQL statement (e.g. clickhouse), the settings are sorted by name and the
+// values are always interpolated.
+func (ssg *selectSQLGenerator) SettingsSQL(b sb.SQLBuilder, settings exp.Record) {
+	if len(settings) == 0 {
+		return
+	}
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		if !isSettingName(name) {
+			b.SetError(errInvalidSettingName(ssg.Dialect(), name))
+			return
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	b.Write(ssg.DialectOptions().SettingsFragment)
+	for i, name := range names {
+		if i > 0 {
+			b.WriteRunes(ssg.DialectOptions().CommaRune, ssg.DialectOptions().SpaceRune)
+		}
+		vb := sb.NewSQLBuilder(false)
+		ssg.ExpressionSQLGenerator().Generate(vb, settings[name])
+		val, _, err := vb.ToSQL()
+		if err != nil {
+			b.SetError(err)
+			return
+		}
+		b.WriteStrings(name).WriteRunes(ssg.DialectOptions().SetOperatorRune).WriteStrings(val)
+	}
+}
+
+// returns true if the name of a setting only contains letters, digits and _ (e.g. max_threads)
+func isSettingName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r != '_' && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
 // Generates the GROUP BY clause for an SQL statement
 func (ssg *selectSQLGenerator) GroupBySQL(b sb.SQLBuilder, groupBy exp.ColumnListExpression) {
 	if groupBy != nil && len(groupBy.Columns()) > 0 {
@@ -164,6 +495,14 @@ func (ssg *selectSQLGenerator) HavingSQL(b sb.SQLBuilder, having exp.ExpressionL
 	}
 }
 
+// Generates the QUALIFY clause for an SQL statement
+func (ssg *selectSQLGenerator) QualifySQL(b sb.SQLBuilder, qualify exp.ExpressionList) {
+	if qualify != nil && len(qualify.Expressions()) > 0 {
+		b.Write(ssg.DialectOptions().QualifyFragment)
+		ssg.ExpressionSQLGenerator().Generate(b, qualify)
+	}
+}
+
 // Generates the OFFSET clause for an SQL statement
 func (ssg *selectSQLGenerator) OffsetSQL(b sb.SQLBuilder, offset uint) {
 	if offset > 0 {
@@ -172,6 +511,19 @@ func (ssg *selectSQLGenerator) OffsetSQL(b sb.SQLBuilder, offset uint) {
 	}
 }
 
+// Generates the OFFSET n ROWS and FETCH FIRST n ROWS ONLY clauses for an SQL statement (e.g. db2, oracle)
+func (ssg *selectSQLGenerator) OffsetFetchSQL(b sb.SQLBuilder, offset uint, limit interface{}) {
+	if offset > 0 {
+		b.Write(ssg.DialectOptions().OffsetFragment)
+		ssg.ExpressionSQLGenerator().Generate(b, offset)
+		b.Write(ssg.DialectOptions().OffsetRowsFragment)
+	}
+	if limit != nil {
+		b.Write(ssg.DialectOptions().FetchFragment)
+		ssg.FetchSQL(b, limit)
+	}
+}
+
 // Generates the compound sql clause for an SQL statement (e.g. UNION, INTERSECT)
 func (ssg *selectSQLGenerator) CompoundsSQL(b sb.SQLBuilder, compounds []exp.CompoundExpression) {
 	for _, compound := range compounds {
@@ -179,6 +531,11 @@ func (ssg *selectSQLGenerator) CompoundsSQL(b sb.SQLBuilder, compounds []exp.Com
 	}
 }
 
+// Returns true if the locking clause locks rows
+func isLocked(lockingClause exp.Lock) bool {
+	return lockingClause != nil && lockingClause.Strength() != exp.ForNolock
+}
+
 // Generates the FOR (aka "locking") clause for an SQL statement
 func (ssg *selectSQLGenerator) ForSQL(b sb.SQLBuilder, lockingClause exp.Lock) {
 	if lockingClause == nil {
@@ -220,6 +577,13 @@ func (ssg *selectSQLGenerator) ForSQL(b sb.SQLBuilder, lockingClause exp.Lock) {
 		b.Write(ssg.DialectOptions().NowaitFragment)
 	case exp.SkipLocked:
 		b.Write(ssg.DialectOptions().SkipLockedFragment)
+	case exp.WaitTimeout:
+		if !ssg.DialectOptions().SupportsLockWaitSeconds {
+			b.SetError(errLockWaitSecondsNotSupported(ssg.Dialect()))
+			return
+		}
+		b.Write(ssg.DialectOptions().WaitFragment).
+			WriteStrings(strconv.FormatUint(uint64(lockingClause.WaitSeconds()), 10))
 	}
 }
 
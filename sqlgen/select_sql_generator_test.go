@@ -2,6 +2,7 @@ package sqlgen_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
@@ -206,6 +207,39 @@ func (ssgs *selectSQLGeneratorSuite) TestGenerate_withFromSQL() {
 		selectTestCase{clause: scFrom, sql: `SELECT * from "a", "b"`},
 		selectTestCase{clause: scFrom, sql: `SELECT * from "a", "b"`, isPrepared: true},
 	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.DualFromFragment = []byte(" FROM DUAL")
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT * FROM DUAL`},
+		selectTestCase{clause: sc, sql: `SELECT * FROM DUAL`, isPrepared: true},
+
+		selectTestCase{clause: scFrom, sql: `SELECT * FROM "a", "b"`},
+		selectTestCase{clause: scFrom, sql: `SELECT * FROM "a", "b"`, isPrepared: true},
+	)
+}
+
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withTableAliasFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.TableAliasFragment = []byte(" ")
+
+	ti := exp.NewIdentifierExpression("", "test2", "")
+	sc := exp.NewSelectClauses().
+		SetFrom(exp.NewColumnListExpression(exp.NewIdentifierExpression("", "test", "").As("t"))).
+		SetSelect(exp.NewColumnListExpression(exp.NewIdentifierExpression("", "t", "a").As("b"))).
+		JoinsAppend(exp.NewConditionedJoinExpression(
+			exp.InnerJoinType,
+			ti.As("t2"),
+			exp.NewJoinOnCondition(exp.NewIdentifierExpression("", "t", "a").Eq(exp.NewIdentifierExpression("", "t2", "a"))),
+		))
+
+	expectedSQL := `SELECT "t"."a" AS "b" FROM "test" "t" INNER JOIN "test2" "t2" ON ("t"."a" = "t2"."a")`
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: expectedSQL},
+		selectTestCase{clause: sc, sql: expectedSQL, isPrepared: true},
+	)
 }
 
 func (ssgs *selectSQLGeneratorSuite) TestGenerate_withJoin() {
@@ -479,6 +513,109 @@ func (ssgs *selectSQLGeneratorSuite) TestGenerate_withLimit() {
 	)
 }
 
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withFetch() {
+	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+		SetOrder(exp.NewIdentifierExpression("", "", "a").Desc())
+	scFetch := sc.SetLimit(exp.NewFetchExpression(10))
+	scWithTies := sc.SetLimit(exp.NewFetchExpression(10).WithTies())
+	scPercent := sc.SetLimit(exp.NewFetchExpression(10).Percent())
+	scWithTiesOffset := scWithTies.SetOffset(5)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsFetchWithTies = true
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scFetch, sql: `SELECT * FROM "test" ORDER BY "a" DESC LIMIT 10`},
+		selectTestCase{clause: scWithTies, sql: `SELECT * FROM "test" ORDER BY "a" DESC FETCH FIRST 10 ROWS WITH TIES`},
+		selectTestCase{
+			clause:     scWithTies,
+			sql:        `SELECT * FROM "test" ORDER BY "a" DESC FETCH FIRST ? ROWS WITH TIES`,
+			isPrepared: true,
+			args:       []interface{}{int64(10)},
+		},
+		selectTestCase{
+			clause: scWithTiesOffset,
+			sql:    `SELECT * FROM "test" ORDER BY "a" DESC FETCH FIRST 10 ROWS WITH TIES OFFSET 5`,
+		},
+		selectTestCase{clause: scPercent, err: "goqu: dialect does not support FETCH FIRST PERCENT [dialect=test]"},
+	)
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		selectTestCase{clause: scWithTies, err: "goqu: dialect does not support FETCH FIRST WITH TIES [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SupportsFetchWithTies = true
+	opts.SupportsFetchPercent = true
+	opts.FetchFragment = []byte(" FETCH FIRST ")
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.OffsetFetchSQLFragment,
+	}
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scFetch, sql: `SELECT * FROM "test" ORDER BY "a" DESC FETCH FIRST 10 ROWS ONLY`},
+		selectTestCase{clause: scPercent, sql: `SELECT * FROM "test" ORDER BY "a" DESC FETCH FIRST 10 PERCENT ROWS ONLY`},
+		selectTestCase{
+			clause: scWithTiesOffset,
+			sql:    `SELECT * FROM "test" ORDER BY "a" DESC OFFSET 5 ROWS FETCH FIRST 10 ROWS WITH TIES`,
+		},
+	)
+
+	opts.OffsetRowsFragment = []byte(" ROW")
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{
+			clause: scWithTiesOffset,
+			sql:    `SELECT * FROM "test" ORDER BY "a" DESC OFFSET 5 ROW FETCH FIRST 10 ROWS WITH TIES`,
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SupportsFetchWithTies = true
+	opts.SupportsFetchPercent = true
+	opts.LimitFragment = []byte("TOP ")
+	opts.SurroundLimitWithParentheses = true
+	opts.FetchFragment = []byte(" FETCH FIRST ")
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectWithLimitSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.OrderWithOffsetFetchSQLFragment,
+	}
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scFetch, sql: `SELECT TOP (10) * FROM "test" ORDER BY "a" DESC`},
+		selectTestCase{
+			clause: sc.SetLimit(exp.NewFetchExpression(10).Percent().WithTies()),
+			sql:    `SELECT TOP (10) PERCENT WITH TIES * FROM "test" ORDER BY "a" DESC`,
+		},
+		selectTestCase{
+			clause: scFetch.SetOffset(5),
+			sql:    `SELECT * FROM "test" ORDER BY "a" DESC OFFSET 5 ROWS FETCH FIRST 10 ROWS ONLY`,
+		},
+		selectTestCase{
+			clause: scWithTiesOffset,
+			err:    "goqu: dialect does not support FETCH FIRST WITH TIES or PERCENT with an OFFSET [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectWithFirstSkipSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.OrderSQLFragment,
+	}
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scFetch, sql: `SELECT FIRST 10 * FROM "test" ORDER BY "a" DESC`},
+		selectTestCase{clause: scWithTies, err: "goqu: dialect does not support FETCH FIRST WITH TIES [dialect=test]"},
+		selectTestCase{clause: scPercent, err: "goqu: dialect does not support FETCH FIRST PERCENT [dialect=test]"},
+	)
+}
+
 func (ssgs *selectSQLGeneratorSuite) TestGenerate_withOffset() {
 	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
 		SetOffset(10)
@@ -489,6 +626,78 @@ func (ssgs *selectSQLGeneratorSuite) TestGenerate_withOffset() {
 	)
 }
 
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withOffsetFetch() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.FetchFragment = []byte(" FETCH FIRST ")
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.OrderSQLFragment,
+		sqlgen.OffsetFetchSQLFragment,
+	}
+	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test"))
+	scLimit := sc.SetLimit(10)
+	scOffset := sc.SetOffset(20)
+	scOrderOffsetLimit := sc.SetOrder(exp.NewIdentifierExpression("", "", "a").Asc()).SetOffset(20).SetLimit(10)
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT * FROM "test"`},
+		selectTestCase{clause: scLimit, sql: `SELECT * FROM "test" FETCH FIRST 10 ROWS ONLY`},
+		selectTestCase{
+			clause:     scLimit,
+			sql:        `SELECT * FROM "test" FETCH FIRST ? ROWS ONLY`,
+			isPrepared: true,
+			args:       []interface{}{int64(10)},
+		},
+		selectTestCase{clause: scOffset, sql: `SELECT * FROM "test" OFFSET 20 ROWS`},
+		selectTestCase{
+			clause: scOrderOffsetLimit,
+			sql:    `SELECT * FROM "test" ORDER BY "a" ASC OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY`,
+		},
+		selectTestCase{
+			clause:     scOrderOffsetLimit,
+			sql:        `SELECT * FROM "test" ORDER BY "a" ASC OFFSET ? ROWS FETCH FIRST ? ROWS ONLY`,
+			isPrepared: true,
+			args:       []interface{}{int64(20), int64(10)},
+		},
+	)
+}
+
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withFirstSkip() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectWithFirstSkipSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.OrderSQLFragment,
+	}
+	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test"))
+	scLimit := sc.SetLimit(10)
+	scOffset := sc.SetOffset(20)
+	scLimitOffset := sc.SetSelect(exp.NewColumnListExpression("a")).
+		SetDistinct(exp.NewColumnListExpression()).
+		SetLimit(10).
+		SetOffset(20)
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT * FROM "test"`},
+		selectTestCase{clause: scLimit, sql: `SELECT FIRST 10 * FROM "test"`},
+		selectTestCase{
+			clause:     scLimit,
+			sql:        `SELECT FIRST (?) * FROM "test"`,
+			isPrepared: true,
+			args:       []interface{}{int64(10)},
+		},
+		selectTestCase{clause: scOffset, sql: `SELECT SKIP 20 * FROM "test"`},
+		selectTestCase{clause: scLimitOffset, sql: `SELECT FIRST 10 SKIP 20 DISTINCT "a" FROM "test"`},
+		selectTestCase{
+			clause:     scLimitOffset,
+			sql:        `SELECT FIRST (?) SKIP (?) DISTINCT "a" FROM "test"`,
+			isPrepared: true,
+			args:       []interface{}{int64(10), int64(20)},
+		},
+	)
+}
+
 func (ssgs *selectSQLGeneratorSuite) TestGenerate_withCommonTables() {
 	tse := newTestAppendableExpression("select * from foo", emptyArgs, nil, nil)
 
@@ -520,6 +729,67 @@ func (ssgs *selectSQLGeneratorSuite) TestGenerate_withCompounds() {
 	)
 }
 
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withParenthesized() {
+	tse := newTestAppendableExpression(`SELECT * FROM "a" UNION (SELECT * FROM "b")`, emptyArgs, nil, nil)
+	tse2 := newTestAppendableExpression(`SELECT * FROM "c"`, emptyArgs, nil, nil)
+	sc := exp.NewSelectClauses().SetParenthesized(tse).
+		CompoundsAppend(exp.NewCompoundExpression(exp.ExceptCompoundType, tse2))
+	scOrderLimit := sc.SetOrder(exp.NewIdentifierExpression("", "", "id").Asc()).SetLimit(10)
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		selectTestCase{clause: sc, sql: `(SELECT * FROM "a" UNION (SELECT * FROM "b")) EXCEPT (SELECT * FROM "c")`},
+		selectTestCase{
+			clause:     sc,
+			sql:        `(SELECT * FROM "a" UNION (SELECT * FROM "b")) EXCEPT (SELECT * FROM "c")`,
+			isPrepared: true,
+		},
+		selectTestCase{
+			clause: scOrderLimit,
+			sql:    `(SELECT * FROM "a" UNION (SELECT * FROM "b")) EXCEPT (SELECT * FROM "c") ORDER BY "id" ASC LIMIT 10`,
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.WrapCompoundsInParens = false
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, err: "goqu: dialect does not support parenthesized compound queries [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectWithLimitSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+	}
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `(SELECT * FROM "a" UNION (SELECT * FROM "b")) EXCEPT (SELECT * FROM "c")`},
+		selectTestCase{
+			clause: scOrderLimit,
+			err:    "goqu: dialect does not support limiting a parenthesized compound query [dialect=test]",
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectWithFirstSkipSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.CompoundsSQLFragment,
+		sqlgen.OrderSQLFragment,
+	}
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `(SELECT * FROM "a" UNION (SELECT * FROM "b")) EXCEPT (SELECT * FROM "c")`},
+		selectTestCase{
+			clause: sc.SetOffset(10),
+			err:    "goqu: dialect does not support limiting a parenthesized compound query [dialect=test]",
+		},
+	)
+}
+
 func (ssgs *selectSQLGeneratorSuite) TestToSelectSQL_withFor() {
 	opts := sqlgen.DefaultDialectOptions()
 	opts.ForUpdateFragment = []byte(" for update ")
@@ -610,6 +880,351 @@ func (ssgs *selectSQLGeneratorSuite) TestToSelectSQL_withFor() {
 	)
 }
 
+func (ssgs *selectSQLGeneratorSuite) TestToSelectSQL_withForWaitSeconds() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsLockWaitSeconds = true
+	opts.WaitFragment = []byte("wait ")
+
+	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test"))
+	scFuWs := sc.SetLock(exp.NewLockWithWaitSeconds(exp.ForUpdate, 5))
+	scFuWsOf := sc.SetLock(exp.NewLockWithWaitSeconds(exp.ForUpdate, 0, goqu.T("my_table")))
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scFuWs, sql: `SELECT * FROM "test" FOR UPDATE wait 5`},
+		selectTestCase{clause: scFuWs, sql: `SELECT * FROM "test" FOR UPDATE wait 5`, isPrepared: true},
+
+		selectTestCase{clause: scFuWsOf, sql: `SELECT * FROM "test" FOR UPDATE OF "my_table" wait 0`},
+	)
+
+	opts.SupportsLockWaitSeconds = false
+	expectedErr := "goqu: dialect does not support waiting a number of seconds for a lock [dialect=test]"
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scFuWs, err: expectedErr},
+		selectTestCase{clause: scFuWs, err: expectedErr, isPrepared: true},
+	)
+}
+
+func (ssgs *selectSQLGeneratorSuite) TestToSelectSQL_withForAndLimit() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsLockWithLimit = false
+
+	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test"))
+	scFu := sc.SetLock(exp.NewLock(exp.ForUpdate, exp.Wait))
+	scFuLimit := scFu.SetLimit(10)
+	scFuOffset := scFu.SetOffset(10)
+	scNolockLimit := sc.SetLock(exp.NewLock(exp.ForNolock, exp.Wait)).SetLimit(10)
+
+	expectedErr := "goqu: dialect does not support locking rows with LIMIT, OFFSET or FETCH [dialect=test]"
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scFu, sql: `SELECT * FROM "test" FOR UPDATE `},
+		selectTestCase{clause: scNolockLimit, sql: `SELECT * FROM "test" LIMIT 10`},
+
+		selectTestCase{clause: scFuLimit, err: expectedErr},
+		selectTestCase{clause: scFuLimit, err: expectedErr, isPrepared: true},
+		selectTestCase{clause: scFuOffset, err: expectedErr},
+	)
+}
+
+func (ssgs *selectSQLGeneratorSuite) TestToSelectSQL_withStraightJoin() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsStraightJoin = true
+	opts.StraightJoinFragment = []byte("straight_join ")
+
+	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).SetStraightJoin(true)
+	scDistinct := sc.SetDistinct(exp.NewColumnListExpression()).SetSelect(exp.NewColumnListExpression("a"))
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT straight_join * FROM "test"`},
+		selectTestCase{clause: sc, sql: `SELECT straight_join * FROM "test"`, isPrepared: true},
+
+		selectTestCase{clause: scDistinct, sql: `SELECT DISTINCT straight_join "a" FROM "test"`},
+	)
+
+	opts.SupportsStraightJoin = false
+	expectedErr := "goqu: dialect does not support STRAIGHT_JOIN [dialect=test]"
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, err: expectedErr},
+		selectTestCase{clause: sc, err: expectedErr, isPrepared: true},
+	)
+}
+
+func (ssgs *selectSQLGeneratorSuite) TestToSelectSQL_withHints() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsOptimizerHints = true
+	opts.HintBeginFragment = []byte("/*+")
+	opts.HintEndFragment = []byte("*/ ")
+
+	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).SetHints([]string{"a(b)", "c(d)"})
+	scDistinct := sc.SetDistinct(exp.NewColumnListExpression()).SetSelect(exp.NewColumnListExpression("a"))
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT /*+a(b) c(d)*/ * FROM "test"`},
+		selectTestCase{clause: sc, sql: `SELECT /*+a(b) c(d)*/ * FROM "test"`, isPrepared: true},
+
+		selectTestCase{clause: scDistinct, sql: `SELECT /*+a(b) c(d)*/ DISTINCT "a" FROM "test"`},
+	)
+
+	scInvalid := sc.SetHints([]string{"a(b)", "c */ DROP TABLE test; /*"})
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scInvalid, err: `goqu: optimizer hint "c */ DROP TABLE test; /*" must not contain */ [dialect=test]`},
+	)
+
+	opts.SupportsOptimizerHints = false
+	expectedErr := "goqu: dialect does not support optimizer hints [dialect=test]"
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, err: expectedErr},
+		selectTestCase{clause: sc, err: expectedErr, isPrepared: true},
+	)
+}
+
+func (ssgs *selectSQLGeneratorSuite) TestToSelectSQL_withAsOfSystemTime() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsAsOfSystemTime = true
+	opts.AsOfSystemTimeFragment = []byte(" as of system time ")
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.JoinSQLFragment,
+		sqlgen.AsOfSystemTimeSQLFragment,
+		sqlgen.WhereSQLFragment,
+	}
+
+	sc := exp.NewSelectClauses().
+		SetFrom(exp.NewColumnListExpression("test")).
+		WhereAppend(exp.NewIdentifierExpression("", "", "a").Eq(1))
+	scString := sc.SetAsOfSystemTime("-10s")
+	scExp := sc.SetAsOfSystemTime(exp.NewLiteralExpression("follower_read_timestamp()"))
+	scTime := sc.SetAsOfSystemTime(time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC))
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT * FROM "test" WHERE ("a" = 1)`},
+
+		selectTestCase{clause: scString, sql: `SELECT * FROM "test" as of system time '-10s' WHERE ("a" = 1)`},
+		selectTestCase{
+			clause:     scString,
+			sql:        `SELECT * FROM "test" as of system time '-10s' WHERE ("a" = ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+
+		selectTestCase{
+			clause: scExp,
+			sql:    `SELECT * FROM "test" as of system time follower_read_timestamp() WHERE ("a" = 1)`,
+		},
+		selectTestCase{
+			clause: scTime,
+			sql:    `SELECT * FROM "test" as of system time '2021-03-04T05:06:07Z' WHERE ("a" = 1)`,
+		},
+	)
+
+	opts.SupportsAsOfSystemTime = false
+	expectedErr := "goqu: dialect does not support AS OF SYSTEM TIME [dialect=test]"
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scString, err: expectedErr},
+		selectTestCase{clause: scString, err: expectedErr, isPrepared: true},
+	)
+}
+
+func (ssgs *selectSQLGeneratorSuite) TestToSelectSQL_withQualify() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsQualify = true
+	opts.QualifyFragment = []byte(" qualify ")
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.QualifySQLFragment,
+	}
+
+	sc := exp.NewSelectClauses().
+		SetFrom(exp.NewColumnListExpression("test")).
+		WhereAppend(exp.NewIdentifierExpression("", "", "a").Eq(1))
+	scQualify := sc.QualifyAppend(exp.NewIdentifierExpression("", "", "rn").Eq(1))
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT * FROM "test" WHERE ("a" = 1)`},
+		selectTestCase{clause: scQualify, sql: `SELECT * FROM "test" WHERE ("a" = 1) qualify ("rn" = 1)`},
+		selectTestCase{
+			clause:     scQualify,
+			sql:        `SELECT * FROM "test" WHERE ("a" = ?) qualify ("rn" = ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(1)},
+		},
+	)
+
+	opts.SupportsQualify = false
+	expectedErr := "goqu: dialect does not support QUALIFY clause [dialect=test]"
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT * FROM "test" WHERE ("a" = 1)`},
+		selectTestCase{clause: scQualify, err: expectedErr},
+		selectTestCase{clause: scQualify, err: expectedErr, isPrepared: true},
+	)
+}
+
+func (ssgs *selectSQLGeneratorSuite) TestToSelectSQL_withFinalSamplePrewhereAndSettings() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.FinalFragment = []byte(" final")
+	opts.SampleFragment = []byte(" sample ")
+	opts.PrewhereFragment = []byte(" prewhere ")
+	opts.SettingsFragment = []byte(" settings ")
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.FinalSQLFragment,
+		sqlgen.SampleSQLFragment,
+		sqlgen.PrewhereSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.SettingsSQLFragment,
+	}
+
+	sc := exp.NewSelectClauses().
+		SetFrom(exp.NewColumnListExpression("test")).
+		WhereAppend(exp.NewIdentifierExpression("", "", "a").Eq(1))
+	scAll := sc.SetFinal(true).
+		SetSample(exp.NewLiteralExpression("?", 0.1)).
+		PrewhereAppend(exp.NewIdentifierExpression("", "", "b").Eq("c")).
+		SetSettings(exp.Record{"max_threads": 8, "join_algorithm": "hash"})
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT * FROM "test" WHERE ("a" = 1)`},
+		selectTestCase{
+			clause: scAll,
+			sql: `SELECT * FROM "test" final sample 0.1 prewhere ("b" = 'c') WHERE ("a" = 1) ` +
+				`settings join_algorithm='hash', max_threads=8`,
+		},
+		selectTestCase{
+			clause: scAll,
+			sql: `SELECT * FROM "test" final sample ? prewhere ("b" = ?) WHERE ("a" = ?) ` +
+				`settings join_algorithm='hash', max_threads=8`,
+			isPrepared: true,
+			args:       []interface{}{0.1, "c", int64(1)},
+		},
+	)
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{
+			clause: sc.SetSettings(exp.Record{"max_threads=1; DROP TABLE test; --": 8}),
+			err:    `goqu: setting name "max_threads=1; DROP TABLE test; --" must only contain letters, digits and _ [dialect=test]`,
+		},
+	)
+
+	// dialects that do not include the fragments ignore FINAL and SAMPLE and return an error for PREWHERE and SETTINGS
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		selectTestCase{clause: sc.SetFinal(true).SetSample(exp.NewLiteralExpression("0.1")), sql: `SELECT * FROM "test" WHERE ("a" = 1)`},
+		selectTestCase{clause: scAll, err: "goqu: dialect does not support PREWHERE clause [dialect=test]"},
+		selectTestCase{
+			clause: sc.SetSettings(exp.Record{"max_threads": 8}),
+			err:    "goqu: dialect does not support SETTINGS clause [dialect=test]",
+		},
+	)
+}
+
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withConnectBy() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SelectSQLOrder = []sqlgen.SQLFragmentType{
+		sqlgen.SelectSQLFragment,
+		sqlgen.FromSQLFragment,
+		sqlgen.WhereSQLFragment,
+		sqlgen.ConnectBySQLFragment,
+		sqlgen.OrderSQLFragment,
+	}
+	prior := exp.NewLiteralExpression("PRIOR ?", exp.NewIdentifierExpression("", "", "id")).
+		Eq(exp.NewIdentifierExpression("", "", "parent_id"))
+	sc := exp.NewSelectClauses().
+		SetFrom(exp.NewColumnListExpression("test")).
+		WhereAppend(exp.NewIdentifierExpression("", "", "a").Eq(1))
+	scConnectBy := sc.SetConnectBy(exp.NewConnectByExpression(false, prior))
+	scStartWith := sc.SetConnectBy(
+		exp.NewConnectByExpression(true, prior).StartWithAppend(exp.NewIdentifierExpression("", "", "parent_id").IsNull()),
+	)
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: sc, sql: `SELECT * FROM "test" WHERE ("a" = 1)`},
+		selectTestCase{
+			clause: scConnectBy,
+			sql:    `SELECT * FROM "test" WHERE ("a" = 1) CONNECT BY (PRIOR "id" = "parent_id")`,
+		},
+		selectTestCase{
+			clause: scStartWith,
+			sql:    `SELECT * FROM "test" WHERE ("a" = 1) START WITH ("parent_id" IS NULL) CONNECT BY NOCYCLE (PRIOR "id" = "parent_id")`,
+		},
+		selectTestCase{
+			clause:     scStartWith,
+			sql:        `SELECT * FROM "test" WHERE ("a" = ?) START WITH ("parent_id" IS NULL) CONNECT BY NOCYCLE (PRIOR "id" = "parent_id")`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		selectTestCase{
+			clause: sc.SetConnectBy(exp.NewConnectByExpression(false)),
+			err:    "goqu: a CONNECT BY condition is required for a hierarchical query",
+		},
+	)
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		selectTestCase{clause: scConnectBy, err: "goqu: dialect does not support CONNECT BY clause [dialect=test]"},
+	)
+}
+
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withInto() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SelectIntoFragment = []byte(" INTO ")
+	opts.SelectIntoTempFragment = []byte(" INTO TEMPORARY ")
+
+	prefixOpts := sqlgen.DefaultDialectOptions()
+	prefixOpts.SelectIntoFragment = []byte(" INTO ")
+	prefixOpts.SelectIntoTempFragment = []byte(" INTO ")
+	prefixOpts.TempTableNamePrefix = "#"
+
+	sc := exp.NewSelectClauses().
+		SetFrom(exp.NewColumnListExpression("test")).
+		SetSelect(exp.NewColumnListExpression("a", "b")).
+		WhereAppend(exp.NewIdentifierExpression("", "", "a").Eq(1))
+	scInto := sc.SetInto(exp.NewSelectIntoExpression(exp.ParseIdentifier("staging"), false))
+	scIntoTemp := sc.SetInto(exp.NewSelectIntoExpression(exp.ParseIdentifier("staging"), true))
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scInto, sql: `SELECT "a", "b" INTO "staging" FROM "test" WHERE ("a" = 1)`},
+		selectTestCase{clause: scIntoTemp, sql: `SELECT "a", "b" INTO TEMPORARY "staging" FROM "test" WHERE ("a" = 1)`},
+		selectTestCase{
+			clause:     scIntoTemp,
+			sql:        `SELECT "a", "b" INTO TEMPORARY "staging" FROM "test" WHERE ("a" = ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+		selectTestCase{
+			clause: sc.SetInto(exp.NewSelectIntoExpression(exp.ParseIdentifier("public.staging"), false)),
+			sql:    `SELECT "a", "b" INTO "public"."staging" FROM "test" WHERE ("a" = 1)`,
+		},
+	)
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", prefixOpts),
+		selectTestCase{clause: scInto, sql: `SELECT "a", "b" INTO "staging" FROM "test" WHERE ("a" = 1)`},
+		selectTestCase{clause: scIntoTemp, sql: `SELECT "a", "b" INTO "#staging" FROM "test" WHERE ("a" = 1)`},
+		selectTestCase{
+			clause: sc.SetInto(exp.NewSelectIntoExpression(exp.ParseIdentifier("#staging"), true)),
+			sql:    `SELECT "a", "b" INTO "#staging" FROM "test" WHERE ("a" = 1)`,
+		},
+	)
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		selectTestCase{clause: scInto, err: "goqu: dialect does not support SELECT INTO [dialect=test]"},
+		selectTestCase{clause: scIntoTemp, err: "goqu: dialect does not support SELECT INTO a temporary table [dialect=test]"},
+	)
+}
+
 func TestSelectSQLGenerator(t *testing.T) {
 	suite.Run(t, new(selectSQLGeneratorSuite))
 }
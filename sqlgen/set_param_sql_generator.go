@@ -0,0 +1,86 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	SetParamSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.SetParamClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	setParamSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	errNoParamForSet  = errors.New("no parameter found when generating set sql")
+	errNoValuesForSet = errors.New("no values found when generating set sql")
+)
+
+func errSetParamNotSupported(dialect string) error {
+	return errors.New("dialect does not support SET [dialect=%s]", dialect)
+}
+
+func errSetLocalNotSupported(dialect string) error {
+	return errors.New("dialect does not support SET LOCAL [dialect=%s]", dialect)
+}
+
+func NewSetParamSQLGenerator(dialect string, do *SQLDialectOptions) SetParamSQLGenerator {
+	return &setParamSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (spsg *setParamSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.SetParamClauses) {
+	if !clauses.HasParam() {
+		b.SetError(errNoParamForSet)
+		return
+	}
+	if len(clauses.Values()) == 0 {
+		b.SetError(errNoValuesForSet)
+		return
+	}
+	for _, f := range spsg.DialectOptions().SetParamSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case SetParamSQLFragment:
+			spsg.SetParamSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("SET", f))
+		}
+	}
+}
+
+// Generates a SET statement (e.g. SET LOCAL "statement_timeout" = 5000)
+func (spsg *setParamSQLGenerator) SetParamSQL(b sb.SQLBuilder, clauses exp.SetParamClauses) {
+	do := spsg.DialectOptions()
+	if do.SetParamFragment == nil {
+		b.SetError(errSetParamNotSupported(spsg.Dialect()))
+		return
+	}
+	b.Write(do.SetParamFragment)
+	if clauses.IsLocal() {
+		if do.SetLocalFragment == nil {
+			b.SetError(errSetLocalNotSupported(spsg.Dialect()))
+			return
+		}
+		b.Write(do.SetLocalFragment)
+	}
+	spsg.ExpressionSQLGenerator().Generate(b, clauses.Param())
+	b.WriteRunes(do.SpaceRune, do.SetOperatorRune, do.SpaceRune)
+	for i, v := range clauses.Values() {
+		if i > 0 {
+			b.WriteRunes(do.CommaRune, do.SpaceRune)
+		}
+		spsg.ExpressionSQLGenerator().Generate(b, v)
+	}
+}
@@ -0,0 +1,112 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	setParamTestCase struct {
+		clause exp.SetParamClauses
+		sql    string
+		err    string
+	}
+	setParamSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (spsgs *setParamSQLGeneratorSuite) assertCases(spsg sqlgen.SetParamSQLGenerator, testCases ...setParamTestCase) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		spsg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			spsgs.assertErrorSQL(b, tc.err)
+		} else {
+			spsgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (spsgs *setParamSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewSetParamSQLGenerator("test", opts)
+	spsgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewSetParamSQLGenerator("test2", opts2)
+	spsgs.Equal("test2", d2.Dialect())
+}
+
+func (spsgs *setParamSQLGeneratorSuite) TestGenerate() {
+	spc := exp.NewSetParamClauses().SetParam(exp.ParseIdentifier("statement_timeout")).SetValues([]interface{}{5000})
+
+	spsgs.assertCases(
+		sqlgen.NewSetParamSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		setParamTestCase{clause: spc, sql: `SET "statement_timeout" = 5000`},
+		setParamTestCase{clause: spc.SetLocal(true), sql: `SET LOCAL "statement_timeout" = 5000`},
+		setParamTestCase{
+			clause: spc.SetParam(exp.ParseIdentifier("search_path")).SetValues([]interface{}{"my'schema", "public"}),
+			sql:    `SET "search_path" = 'my''schema', 'public'`,
+		},
+		setParamTestCase{
+			clause: spc.SetParam(exp.ParseIdentifier("app.user_id")).SetValues([]interface{}{"10"}),
+			sql:    `SET "app"."user_id" = '10'`,
+		},
+		setParamTestCase{
+			clause: spc.SetValues([]interface{}{exp.NewLiteralExpression("DEFAULT")}),
+			sql:    `SET "statement_timeout" = DEFAULT`,
+		},
+
+		setParamTestCase{clause: exp.NewSetParamClauses(), err: "goqu: no parameter found when generating set sql"},
+		setParamTestCase{clause: spc.SetValues(nil), err: "goqu: no values found when generating set sql"},
+	)
+}
+
+func (spsgs *setParamSQLGeneratorSuite) TestGenerate_WithUnsupportedSet() {
+	spc := exp.NewSetParamClauses().SetParam(exp.ParseIdentifier("statement_timeout")).SetValues([]interface{}{5000})
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SetLocalFragment = nil
+	spsgs.assertCases(
+		sqlgen.NewSetParamSQLGenerator("test", opts),
+		setParamTestCase{clause: spc, sql: `SET "statement_timeout" = 5000`},
+		setParamTestCase{clause: spc.SetLocal(true), err: "goqu: dialect does not support SET LOCAL [dialect=test]"},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SetParamFragment = nil
+	spsgs.assertCases(
+		sqlgen.NewSetParamSQLGenerator("test", opts),
+		setParamTestCase{clause: spc, err: "goqu: dialect does not support SET [dialect=test]"},
+	)
+}
+
+func (spsgs *setParamSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SetParamSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	spsgs.assertCases(
+		sqlgen.NewSetParamSQLGenerator("test", opts),
+		setParamTestCase{
+			clause: exp.NewSetParamClauses().SetParam(exp.ParseIdentifier("a")).SetValues([]interface{}{1}),
+			err:    "goqu: unsupported SET SQL fragment UpdateBeginSQLFragment",
+		},
+	)
+}
+
+func (spsgs *setParamSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewSetParamSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewSetParamClauses().SetParam(exp.ParseIdentifier("a")).SetValues([]interface{}{1}))
+	spsgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestSetParamSQLGenerator(t *testing.T) {
+	suite.Run(t, new(setParamSQLGeneratorSuite))
+}
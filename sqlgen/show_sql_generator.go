@@ -0,0 +1,61 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	ShowSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.ShowClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	showSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var errNoParamForShow = errors.New("no parameter found when generating show sql")
+
+func errShowNotSupported(dialect string) error {
+	return errors.New("dialect does not support SHOW [dialect=%s]", dialect)
+}
+
+func NewShowSQLGenerator(dialect string, do *SQLDialectOptions) ShowSQLGenerator {
+	return &showSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (ssg *showSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.ShowClauses) {
+	if !clauses.HasParam() {
+		b.SetError(errNoParamForShow)
+		return
+	}
+	for _, f := range ssg.DialectOptions().ShowSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case ShowSQLFragment:
+			ssg.ShowSQL(b, clauses)
+		default:
+			b.SetError(ErrNotSupportedFragment("SHOW", f))
+		}
+	}
+}
+
+// Generates a SHOW statement (e.g. SHOW "search_path")
+func (ssg *showSQLGenerator) ShowSQL(b sb.SQLBuilder, clauses exp.ShowClauses) {
+	do := ssg.DialectOptions()
+	if do.ShowFragment == nil {
+		b.SetError(errShowNotSupported(ssg.Dialect()))
+		return
+	}
+	b.Write(do.ShowFragment)
+	ssg.ExpressionSQLGenerator().Generate(b, clauses.Param())
+}
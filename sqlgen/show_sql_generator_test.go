@@ -0,0 +1,87 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	showTestCase struct {
+		clause exp.ShowClauses
+		sql    string
+		err    string
+	}
+	showSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (ssgs *showSQLGeneratorSuite) assertCases(ssg sqlgen.ShowSQLGenerator, testCases ...showTestCase) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(false)
+		ssg.Generate(b, tc.clause)
+		if len(tc.err) > 0 {
+			ssgs.assertErrorSQL(b, tc.err)
+		} else {
+			ssgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (ssgs *showSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewShowSQLGenerator("test", opts)
+	ssgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewShowSQLGenerator("test2", opts2)
+	ssgs.Equal("test2", d2.Dialect())
+}
+
+func (ssgs *showSQLGeneratorSuite) TestGenerate() {
+	sc := exp.NewShowClauses().SetParam(exp.ParseIdentifier("search_path"))
+
+	ssgs.assertCases(
+		sqlgen.NewShowSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		showTestCase{clause: sc, sql: `SHOW "search_path"`},
+		showTestCase{clause: sc.SetParam(exp.ParseIdentifier("app.user_id")), sql: `SHOW "app"."user_id"`},
+
+		showTestCase{clause: exp.NewShowClauses(), err: "goqu: no parameter found when generating show sql"},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ShowFragment = nil
+	ssgs.assertCases(
+		sqlgen.NewShowSQLGenerator("test", opts),
+		showTestCase{clause: sc, err: "goqu: dialect does not support SHOW [dialect=test]"},
+	)
+}
+
+func (ssgs *showSQLGeneratorSuite) TestGenerate_UnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ShowSQLOrder = []sqlgen.SQLFragmentType{sqlgen.UpdateBeginSQLFragment}
+	ssgs.assertCases(
+		sqlgen.NewShowSQLGenerator("test", opts),
+		showTestCase{
+			clause: exp.NewShowClauses().SetParam(exp.ParseIdentifier("a")),
+			err:    "goqu: unsupported SHOW SQL fragment UpdateBeginSQLFragment",
+		},
+	)
+}
+
+func (ssgs *showSQLGeneratorSuite) TestGenerate_WithErroredBuilder() {
+	d := sqlgen.NewShowSQLGenerator("test", sqlgen.DefaultDialectOptions())
+
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, exp.NewShowClauses().SetParam(exp.ParseIdentifier("a")))
+	ssgs.assertErrorSQL(b, `goqu: expected error`)
+}
+
+func TestShowSQLGenerator(t *testing.T) {
+	suite.Run(t, new(showSQLGeneratorSuite))
+}
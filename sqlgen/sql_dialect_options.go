@@ -8,7 +8,9 @@ import (
 )
 
 type (
-	SQLFragmentType   int
+	SQLFragmentType int
+	// How intervals are written, see SQLDialectOptions.IntervalStyle
+	IntervalStyle     int
 	SQLDialectOptions struct {
 		// Set to true if the dialect supports ORDER BY expressions in DELETE statements (DEFAULT=false)
 		SupportsOrderByOnDelete bool
@@ -22,6 +24,11 @@ type (
 		SupportsLimitOnUpdate bool
 		// Set to true if the dialect supports RETURN expressions (DEFAULT=true)
 		SupportsReturn bool
+		// Set to false if the dialect only supports RETURN expressions on INSERT and DELETE statements (e.g. mariadb)
+		// (DEFAULT=true)
+		SupportsReturnOnUpdate bool
+		// Set to false if the dialect does not support ON CONFLICT (or an equivalent) when inserting (DEFAULT=true)
+		SupportsConflict bool
 		// Set to true if the dialect supports Conflict Target (DEFAULT=true)
 		SupportsConflictTarget bool
 		// Set to true if the dialect supports Conflict Target (DEFAULT=true)
@@ -38,44 +45,238 @@ type (
 		SupportsDistinctOn bool
 		// Set to true if LATERAL queries are supported (DEFAULT=true)
 		SupportsLateral bool
-		// Set to false if the dialect does not require expressions to be wrapped in parens (DEFAULT=true)
+		// Set to true if column aliases are supported on derived tables (e.g. (SELECT ...) AS "t"("a", "b"))
+		// (DEFAULT=true)
+		SupportsDerivedColumnAliases bool
+		// Set to false if the dialect does not require expressions to be wrapped in parens. Dialects that do not wrap
+		// compounds in parens also do not support parenthesized compound queries (DEFAULT=true)
 		WrapCompoundsInParens bool
 
 		// Set to true if window function are supported in SELECT statement. (DEFAULT=true)
 		SupportsWindowFunction bool
 
+		// Set to false if GROUPS window frames are not supported (e.g. GROUPS BETWEEN 1 PRECEDING AND CURRENT ROW).
+		// (DEFAULT=true)
+		SupportsWindowFrameGroups bool
+
+		// Set to false if window frame exclusions are not supported (e.g. EXCLUDE CURRENT ROW). (DEFAULT=true)
+		SupportsWindowFrameExclusion bool
+
+		// Set to true if multiple statements can be executed in a single round trip. (DEFAULT=false)
+		SupportsMultipleStatements bool
+
+		// Set to true if server-side cursors (DECLARE/FETCH/CLOSE) are supported. (DEFAULT=false)
+		SupportsCursors bool
+
+		// Set to true if LISTEN/NOTIFY and pg_notify are supported. (DEFAULT=false)
+		SupportsListenNotify bool
+
+		// Set to true if ON COMMIT is supported when creating temporary tables. (DEFAULT=false)
+		SupportsTempTableOnCommit bool
+		// Set to true if temporary tables are created using SELECT ... INTO (e.g. sqlserver). (DEFAULT=false)
+		UseSelectIntoForTempTables bool
+		// The prefix to add to the name of temporary tables (e.g. sqlserver="#"). (DEFAULT="")
+		TempTableNamePrefix string
+		// Set to true if the dialect supports CREATE TABLE IF NOT EXISTS. (DEFAULT=true)
+		SupportsCreateTableIfNotExists bool
+		// Set to true if the PRIMARY KEY of a table is written after the column list of a CREATE TABLE instead of in
+		// a column definition or table constraint (e.g. spanner). (DEFAULT=false)
+		PrimaryKeyAfterColumns bool
+		// Set to true if the dialect requires the DEFAULT value of a column definition or of an ALTER COLUMN SET DEFAULT
+		// to be wrapped in parens (e.g. spanner). (DEFAULT=false)
+		WrapColumnDefaults bool
+		// Set to true if the AutoIncrementFragment of a column definition must be written after the PRIMARY KEY instead
+		// of after the DEFAULT (e.g. sqlite3). (DEFAULT=false)
+		AutoIncrementAfterPrimaryKey bool
+		// Set to true if the dialect supports multiple actions in a single ALTER TABLE statement. (DEFAULT=true)
+		SupportsMultipleAlterTableActions bool
+		// Set to true if the dialect supports CREATE INDEX IF NOT EXISTS. (DEFAULT=true)
+		SupportsCreateIndexIfNotExists bool
+		// Set to true if the dialect supports DROP INDEX IF EXISTS. (DEFAULT=true)
+		SupportsDropIndexIfExists bool
+		// Set to true if the dialect supports creating and dropping indexes CONCURRENTLY. (DEFAULT=true)
+		SupportsConcurrentIndex bool
+		// Set to true if the dialect supports the method of an index (e.g. USING gin). (DEFAULT=true)
+		SupportsIndexMethod bool
+		// Set to true if the dialect supports partial indexes (e.g. CREATE INDEX ... WHERE). (DEFAULT=true)
+		SupportsPartialIndex bool
+		// Set to true if the method of an index is after the columns (e.g. mysql CREATE INDEX ... (`a`) USING BTREE)
+		// (DEFAULT=false)
+		UseIndexMethodAfterColumns bool
+		// Set to true if the table of the index is required when dropping an index (e.g. DROP INDEX `a` ON `b`)
+		// (DEFAULT=false)
+		DropIndexRequiresTable bool
+		// Set to true if the dialect supports IF EXISTS when dropping objects other than indexes (e.g. DROP TABLE IF EXISTS)
+		// (DEFAULT=true)
+		SupportsDropIfExists bool
+		// Set to true if the dialect supports CASCADE and RESTRICT in DROP statements. (DEFAULT=true)
+		SupportsDropCascade bool
+		// Set to true if the dialect supports dropping multiple objects in a single DROP statement (e.g. DROP TABLE "a", "b")
+		// (DEFAULT=true)
+		SupportsMultipleDropObjects bool
+		// Set to true if the dialect supports CREATE SEQUENCE IF NOT EXISTS. (DEFAULT=true)
+		SupportsCreateSequenceIfNotExists bool
+		// Set to true if the dialect supports ALTER SEQUENCE IF EXISTS. (DEFAULT=true)
+		SupportsAlterSequenceIfExists bool
+		// Set to true if the dialect supports CREATE SCHEMA IF NOT EXISTS. (DEFAULT=true)
+		SupportsCreateSchemaIfNotExists bool
+		// Set to true if the dialect supports CREATE DATABASE IF NOT EXISTS. (DEFAULT=false)
+		SupportsCreateDatabaseIfNotExists bool
+		// Set to true if the dialect supports CASCADE in REVOKE statements. (DEFAULT=true)
+		SupportsRevokeCascade bool
+		// Set to true if the dialect supports CREATE TRIGGER IF NOT EXISTS. (DEFAULT=false)
+		SupportsCreateTriggerIfNotExists bool
+		// Set to true if the dialect supports firing a trigger for multiple events (e.g. AFTER INSERT OR UPDATE)
+		// (DEFAULT=true)
+		SupportsMultipleTriggerEvents bool
+		// Set to true if the table of the trigger is written before the timing of the trigger
+		// (e.g. sqlserver CREATE TRIGGER "a" ON "b" AFTER INSERT) (DEFAULT=false)
+		TriggerTableBeforeTiming bool
+		// Set to true if the table of the trigger is required when dropping a trigger (e.g. DROP TRIGGER "a" ON "b")
+		// (DEFAULT=true)
+		DropTriggerRequiresTable bool
+
+		// Set to true if the dialect supports forcing the join order using SELECT STRAIGHT_JOIN (DEFAULT=false)
+		SupportsStraightJoin bool
+
+		// Set to true if the dialect supports optimizer hints after the SELECT, UPDATE and DELETE keywords
+		// (e.g. SELECT /*+ ... */) (DEFAULT=false)
+		SupportsOptimizerHints bool
+
+		// Set to true if the dialect supports reading historical data using AS OF SYSTEM TIME (e.g. cockroachdb). The
+		// AsOfSystemTimeSQLFragment must also be included in the SelectSQLOrder. (DEFAULT=false)
+		SupportsAsOfSystemTime bool
+
+		// Set to true if the dialect supports waiting a number of seconds for a lock (e.g. FOR UPDATE WAIT 5).
+		// (DEFAULT=false)
+		SupportsLockWaitSeconds bool
+
+		// Set to false if the dialect does not support locking rows when the rows are limited (e.g. oracle raises
+		// ORA-02014 for FETCH FIRST with FOR UPDATE), an error is returned when generating the sql. (DEFAULT=true)
+		SupportsLockWithLimit bool
+
+		// Set to true if the dialect supports returning the rows that tie with the last row of a limit
+		// (e.g. FETCH FIRST 10 ROWS WITH TIES, SELECT TOP (10) WITH TIES). (DEFAULT=false)
+		SupportsFetchWithTies bool
+
+		// Set to true if the dialect supports limiting the rows to a percentage
+		// (e.g. FETCH FIRST 10 PERCENT ROWS ONLY, SELECT TOP (10) PERCENT). (DEFAULT=false)
+		SupportsFetchPercent bool
+
+		// Set to false if the dialect does not support placeholders and all values must be interpolated, an error is
+		// returned when generating prepared statements. (DEFAULT=true)
+		SupportsPlaceholders bool
+
+		// Set to false if the dialect does not support inserting multiple rows with a single VALUES clause
+		// (e.g. VALUES (1), (2)), an error is returned when generating the sql. (DEFAULT=true)
+		SupportsMultipleInsertRows bool
+
+		// Set to false if the dialect does not support truncating multiple tables in a single statement. (DEFAULT=true)
+		SupportsMultipleTruncateTables bool
+		// Set to false if the dialect does not support RESTART/CONTINUE IDENTITY in TRUNCATE. (DEFAULT=true)
+		SupportsTruncateIdentity bool
+		// Set to false if the dialect does not support CASCADE/RESTRICT in TRUNCATE. (DEFAULT=true)
+		SupportsTruncateCascade bool
+		// Set to false if the dialect only supports one WHEN MATCHED and one WHEN NOT MATCHED clause in a MERGE statement
+		// (e.g. oracle). (DEFAULT=true)
+		SupportsMultipleMergeWhenClauses bool
+		// Set to false if the arguments of a stored procedure call are not wrapped in parens
+		// (e.g. sqlserver EXEC "proc" @p1, @p2). (DEFAULT=true)
+		WrapCallArgsInParens bool
+		// Set to false if the options of an EXPLAIN statement are not wrapped in parens
+		// (e.g. mysql EXPLAIN ANALYZE FORMAT=TREE). (DEFAULT=true)
+		WrapExplainOptionsInParens bool
+		// Set to false if the dialect requires at least one table in a maintenance statement
+		// (e.g. mysql OPTIMIZE TABLE). (DEFAULT=true)
+		SupportsMaintenanceWithoutTables bool
+		// Set to false if the dialect can only VACUUM the whole database (e.g. sqlite3). (DEFAULT=true)
+		SupportsVacuumTables bool
+
 		// Set to true if the dialect requires join tables in UPDATE to be in a FROM clause (DEFAULT=true).
 		UseFromClauseForMultipleUpdateTables bool
 
 		// Surround LIMIT parameter with parentheses, like in MSSQL: SELECT TOP (10) ...
 		SurroundLimitWithParentheses bool
 
+		// Set to true to upper case identifiers before quoting them. This should be used by dialects that fold unquoted
+		// identifiers to upper case (e.g. db2) so quoted identifiers match unquoted ones. (DEFAULT=false)
+		UpperCaseIdentifiers bool
+
+		// The maximum number of characters in an identifier, an error is returned when generating sql with a longer
+		// identifier (e.g. firebird). A value of 0 means identifiers are not validated. (DEFAULT=0)
+		MaxIdentifierLength int
+
+		// The fragment used to separate multiple statements. (DEFAULT=[]byte("; "))
+		StatementSeparatorFragment []byte
 		// The UPDATE fragment to use when generating sql. (DEFAULT=[]byte("UPDATE"))
 		UpdateClause []byte
 		// The INSERT fragment to use when generating sql. (DEFAULT=[]byte("INSERT INTO"))
 		InsertClause []byte
 		// The INSERT IGNORE INTO fragment to use when generating sql. (DEFAULT=[]byte("INSERT IGNORE INTO"))
 		InsertIgnoreClause []byte
+		// A map used to look up the INSERT fragment for a conflict resolution set with InsertDataset#OrIgnore or
+		// InsertDataset#OrReplace, an error is returned if the resolution is not in the map (e.g. sqlite)
+		// 	map[exp.ConflictResolution][]byte{
+		// 		exp.IgnoreConflictResolution:  []byte("INSERT OR IGNORE INTO"),
+		// 		exp.ReplaceConflictResolution: []byte("INSERT OR REPLACE INTO"),
+		// 	}
+		// (DEFAULT=empty map)
+		ConflictResolutionLookup map[exp.ConflictResolution][]byte
 		// The SELECT fragment to use when generating sql. (DEFAULT=[]byte("SELECT"))
 		SelectClause []byte
 		// The DELETE fragment to use when generating sql. (DEFAULT=[]byte("DELETE"))
 		DeleteClause []byte
 		// The TRUNCATE fragment to use when generating sql. (DEFAULT=[]byte("TRUNCATE"))
 		TruncateClause []byte
+		// The fragment to append to the end of a TRUNCATE statement (e.g. db2 requires " IMMEDIATE"). (DEFAULT=nil)
+		TruncateSuffixFragment []byte
 		// The WITH fragment to use when generating sql. (DEFAULT=[]byte("WITH "))
 		WithFragment []byte
 		// The RECURSIVE fragment to use when generating sql (after WITH). (DEFAULT=[]byte("RECURSIVE "))
 		RecursiveFragment []byte
+		// The fragment used to force a common table expression to be computed once, if nil an error is returned when
+		// generating sql for a materialized CTE (DEFAULT=[]byte("MATERIALIZED "))
+		MaterializedCTEFragment []byte
+		// The fragment used to fold a common table expression into the parent query, if nil an error is returned when
+		// generating sql for a not materialized CTE (DEFAULT=[]byte("NOT MATERIALIZED "))
+		NotMaterializedCTEFragment []byte
+		// The fragment used to start the SEARCH clause of a recursive common table expression, if nil an error is
+		// returned when generating sql for a CTE with a SEARCH clause (DEFAULT=[]byte(" SEARCH "))
+		CTESearchFragment []byte
+		// The fragments used for each search order of a SEARCH clause
+		// (DEFAULT=map[exp.CTESearchMode][]byte{
+		// 		exp.DepthFirstSearch:   []byte("DEPTH FIRST BY "),
+		// 		exp.BreadthFirstSearch: []byte("BREADTH FIRST BY "),
+		// })
+		CTESearchModeLookup map[exp.CTESearchMode][]byte
+		// The fragment used to start the CYCLE clause of a recursive common table expression, if nil an error is
+		// returned when generating sql for a CTE with a CYCLE clause (DEFAULT=[]byte(" CYCLE "))
+		CTECycleFragment []byte
+		// The fragment used before the column added by a SEARCH or CYCLE clause (DEFAULT=[]byte(" SET "))
+		CTESetFragment []byte
+		// The fragment used before the value of the mark column of a CYCLE clause (DEFAULT=[]byte(" TO "))
+		CTECycleToFragment []byte
+		// The fragment used before the default value of the mark column of a CYCLE clause
+		// (DEFAULT=[]byte(" DEFAULT "))
+		CTECycleDefaultFragment []byte
+		// The fragment used before the path column of a CYCLE clause (DEFAULT=[]byte(" USING "))
+		CTECycleUsingFragment []byte
 		// The CASCADE fragment to use when generating sql. (DEFAULT=[]byte(" CASCADE"))
 		CascadeFragment []byte
 		// The RESTRICT fragment to use when generating sql. (DEFAULT=[]byte(" RESTRICT"))
 		RestrictFragment []byte
 		// The SQL fragment to use when generating insert sql and using
-		// DEFAULT VALUES (e.g. postgres="DEFAULT VALUES", mysql="", sqlite3=""). (DEFAULT=[]byte(" DEFAULT VALUES"))
+		// DEFAULT VALUES (e.g. postgres="DEFAULT VALUES", mysql="", sqlite3=""), inserting a row without any values is
+		// not supported if nil. (DEFAULT=[]byte(" DEFAULT VALUES"))
 		DefaultValuesFragment []byte
 		// The SQL fragment to use when generating insert sql and listing columns using a VALUES clause
 		// (DEFAULT=[]byte(" VALUES "))
 		ValuesFragment []byte
+		// The SQL fragment to use when generating a VALUES list used as a table (DEFAULT=[]byte("VALUES "))
+		ValuesListFragment []byte
+		// The SQL fragment to prefix each row of a VALUES list used as a table with (e.g. mysql="ROW")
+		// (DEFAULT=nil)
+		ValuesListRowFragment []byte
 		// The SQL fragment to use when generating truncate sql and using the IDENTITY clause
 		// (DEFAULT=[]byte(" IDENTITY"))
 		IdentityFragment []byte
@@ -87,6 +288,8 @@ type (
 		ReturningFragment []byte
 		// The SQL FROM clause fragment (DEFAULT=[]byte(" FROM"))
 		FromFragment []byte
+		// The FROM clause written for a SELECT without a source, e.g. []byte(" FROM DUAL") on oracle (DEFAULT=nil)
+		DualFromFragment []byte
 		// The SQL USING join clause fragment (DEFAULT=[]byte(" USING "))
 		UsingFragment []byte
 		// The SQL ON join clause fragment (DEFAULT=[]byte(" ON "))
@@ -105,6 +308,14 @@ type (
 		WindowOrderByFragment []byte
 		// The SQL WINDOW clause OVER fragment(DEFAULT=[]byte(" OVER "))
 		WindowOverFragment []byte
+		// The SQL FILTER clause fragment of aggregate functions, if nil filtered aggregates are rewritten using CASE
+		// (e.g. COUNT(*) FILTER (WHERE "a") -> SUM(CASE WHEN "a" THEN 1 ELSE 0 END))
+		// (DEFAULT=[]byte(" FILTER (WHERE "))
+		AggregateFilterFragment []byte
+		// The SQL ORDER BY fragment used inside of aggregate functions, if nil ordered aggregates are not supported
+		// (e.g. ARRAY_AGG("a" ORDER BY "b"))
+		// (DEFAULT=[]byte(" ORDER BY "))
+		AggregateOrderByFragment []byte
 		// The SQL ORDER BY clause fragment(DEFAULT=[]byte(" ORDER BY "))
 		OrderByFragment []byte
 		// The SQL FETCH fragment(DEFAULT=[]byte(" "))
@@ -113,6 +324,12 @@ type (
 		LimitFragment []byte
 		// The SQL OFFSET BY clause fragment(DEFAULT=[]byte(" OFFSET "))
 		OffsetFragment []byte
+		// The fragment written after the OFFSET value by OffsetFetchSQLFragment(DEFAULT=[]byte(" ROWS"))
+		OffsetRowsFragment []byte
+		// The SQL FIRST fragment used by SelectWithFirstSkipSQLFragment(DEFAULT=[]byte("FIRST "))
+		FirstFragment []byte
+		// The SQL SKIP fragment used by SelectWithFirstSkipSQLFragment(DEFAULT=[]byte("SKIP "))
+		SkipFragment []byte
 		// The SQL FOR UPDATE fragment(DEFAULT=[]byte(" FOR UPDATE "))
 		ForUpdateFragment []byte
 		// The SQL FOR NO KEY UPDATE fragment(DEFAULT=[]byte(" FOR NO KEY UPDATE "))
@@ -127,10 +344,381 @@ type (
 		NowaitFragment []byte
 		// The SQL SKIP LOCKED fragment(DEFAULT=[]byte("SKIP LOCKED"))
 		SkipLockedFragment []byte
+		// The SQL WAIT fragment used with exp.WaitTimeout(DEFAULT=[]byte("WAIT "))
+		WaitFragment []byte
+		// The SQL STRAIGHT_JOIN fragment used to force the join order of a SELECT(DEFAULT=[]byte("STRAIGHT_JOIN "))
+		StraightJoinFragment []byte
+		// The SQL fragment used to start optimizer hints (DEFAULT=[]byte("/*+ "))
+		HintBeginFragment []byte
+		// The SQL fragment used to end optimizer hints (DEFAULT=[]byte(" */ "))
+		HintEndFragment []byte
+		// The SQL AS OF SYSTEM TIME fragment(DEFAULT=[]byte(" AS OF SYSTEM TIME "))
+		AsOfSystemTimeFragment []byte
+		// Set to true if the dialect supports the QUALIFY clause (e.g. bigquery, snowflake)(DEFAULT=false)
+		SupportsQualify bool
+		// The SQL QUALIFY clause fragment(DEFAULT=[]byte(" QUALIFY "))
+		QualifyFragment []byte
+		// The SQL FINAL fragment used by FinalSQLFragment(DEFAULT=[]byte(" FINAL"))
+		FinalFragment []byte
+		// The SQL SAMPLE fragment used by SampleSQLFragment(DEFAULT=[]byte(" SAMPLE "))
+		SampleFragment []byte
+		// The SQL PREWHERE clause fragment used by PrewhereSQLFragment(DEFAULT=[]byte(" PREWHERE "))
+		PrewhereFragment []byte
+		// The SQL SETTINGS clause fragment used by SettingsSQLFragment(DEFAULT=[]byte(" SETTINGS "))
+		SettingsFragment []byte
+		// The SQL START WITH fragment of a hierarchical query used by ConnectBySQLFragment
+		// (DEFAULT=[]byte(" START WITH "))
+		ConnectByStartWithFragment []byte
+		// The SQL CONNECT BY fragment of a hierarchical query used by ConnectBySQLFragment
+		// (DEFAULT=[]byte(" CONNECT BY "))
+		ConnectByFragment []byte
+		// The SQL NOCYCLE fragment written after CONNECT BY (DEFAULT=[]byte("NOCYCLE "))
+		ConnectByNoCycleFragment []byte
+		// The SQL INTO fragment written after the columns of a SELECT that creates a table from the results, an error
+		// is returned if nil (e.g. postgres=[]byte(" INTO ")) (DEFAULT=nil)
+		SelectIntoFragment []byte
+		// The SQL INTO fragment written after the columns of a SELECT that creates a temporary table from the results,
+		// the TempTableNamePrefix is added to the name of the table. An error is returned if nil
+		// (e.g. postgres=[]byte(" INTO TEMPORARY "), sqlserver=[]byte(" INTO ")) (DEFAULT=nil)
+		SelectIntoTempFragment []byte
+		// The SQL fragment used to create a temporary table, an error is returned when creating a temporary table if nil
+		// (DEFAULT=[]byte("CREATE TEMPORARY TABLE "))
+		CreateTempTableFragment []byte
+		// The SQL ON COMMIT fragment written after the name of a temporary table created from a query, only used when
+		// SupportsTempTableOnCommit is true (DEFAULT=[]byte(" ON COMMIT "))
+		OnCommitFragment []byte
+		// The SQL fragment used to create a table, an error is returned when creating a table if nil
+		// (DEFAULT=[]byte("CREATE TABLE "))
+		CreateTableFragment []byte
+		// The SQL IF NOT EXISTS fragment used in DDL statements (DEFAULT=[]byte("IF NOT EXISTS "))
+		IfNotExistsFragment []byte
+		// The SQL NOT NULL fragment used in column definitions (DEFAULT=[]byte(" NOT NULL"))
+		NotNullFragment []byte
+		// The SQL DEFAULT fragment used in column definitions (DEFAULT=[]byte(" DEFAULT "))
+		ColumnDefaultFragment []byte
+		// The SQL PRIMARY KEY fragment used in column definitions and table constraints
+		// (DEFAULT=[]byte("PRIMARY KEY"))
+		PrimaryKeyFragment []byte
+		// The SQL UNIQUE fragment used in column definitions and table constraints (DEFAULT=[]byte("UNIQUE"))
+		UniqueFragment []byte
+		// The SQL CONSTRAINT fragment used to name table constraints (DEFAULT=[]byte("CONSTRAINT "))
+		ConstraintFragment []byte
+		// The SQL FOREIGN KEY fragment used in table constraints (DEFAULT=[]byte("FOREIGN KEY"))
+		ForeignKeyFragment []byte
+		// The SQL fragment before the table referenced by a FOREIGN KEY constraint (DEFAULT=[]byte(" REFERENCES "))
+		ReferencesFragment []byte
+		// The SQL ON DELETE fragment of a FOREIGN KEY constraint (DEFAULT=[]byte(" ON DELETE "))
+		OnDeleteFragment []byte
+		// The SQL ON UPDATE fragment of a FOREIGN KEY constraint (DEFAULT=[]byte(" ON UPDATE "))
+		OnUpdateFragment []byte
+		// The SQL CHECK fragment used in table constraints, an error is returned when generating a CHECK constraint if
+		// nil (DEFAULT=[]byte("CHECK "))
+		CheckFragment []byte
+		// The SQL EXCLUDE fragment used in table constraints, an error is returned when generating an EXCLUDE
+		// constraint if nil (DEFAULT=[]byte("EXCLUDE"))
+		ExcludeFragment []byte
+		// The SQL fragment between a column and its operator in an EXCLUDE constraint (DEFAULT=[]byte(" WITH "))
+		ExcludeWithFragment []byte
+		// The SQL fragment used for columns with generated values (e.g. mysql=[]byte(" AUTO_INCREMENT")), an error is
+		// returned when generating an auto increment column if nil
+		// (DEFAULT=[]byte(" GENERATED BY DEFAULT AS IDENTITY"))
+		AutoIncrementFragment []byte
+		// The SQL fragment used to set the comment of a column in a column definition (e.g. mysql=[]byte(" COMMENT ")),
+		// an error is returned when generating a column with a comment if nil (DEFAULT=nil)
+		ColumnCommentFragment []byte
+		// The SQL fragment used to alter a table (DEFAULT=[]byte("ALTER TABLE "))
+		AlterTableFragment []byte
+		// The SQL fragment used to add a column in an ALTER TABLE statement, an error is returned if nil
+		// (DEFAULT=[]byte("ADD COLUMN "))
+		AddColumnFragment []byte
+		// The SQL fragment used to drop a column in an ALTER TABLE statement, an error is returned if nil
+		// (DEFAULT=[]byte("DROP COLUMN "))
+		DropColumnFragment []byte
+		// The SQL fragment used to rename a column in an ALTER TABLE statement, an error is returned if nil
+		// (DEFAULT=[]byte("RENAME COLUMN "))
+		RenameColumnFragment []byte
+		// The SQL fragment between the old and the new name of a renamed column (DEFAULT=[]byte(" TO "))
+		RenameColumnToFragment []byte
+		// The SQL fragment used to rename a table in an ALTER TABLE statement, an error is returned if nil
+		// (DEFAULT=[]byte("RENAME TO "))
+		RenameTableFragment []byte
+		// The SQL fragment used to change the type of a column (e.g. mysql=[]byte("MODIFY COLUMN ")), an error is
+		// returned if nil (DEFAULT=[]byte("ALTER COLUMN "))
+		AlterColumnTypeFragment []byte
+		// The SQL fragment between the column and the new type of the column (e.g. mysql=[]byte(" "))
+		// (DEFAULT=[]byte(" TYPE "))
+		ColumnTypeFragment []byte
+		// The SQL fragment used to replace the definition of a column (e.g. mysql=[]byte("MODIFY COLUMN ")), an error is
+		// returned if nil (DEFAULT=nil)
+		ModifyColumnFragment []byte
+		// The SQL fragment used to change the DEFAULT or NOT NULL of a column (DEFAULT=[]byte("ALTER COLUMN "))
+		AlterColumnFragment []byte
+		// The SQL fragment used to set the DEFAULT of a column, an error is returned if nil
+		// (DEFAULT=[]byte(" SET DEFAULT "))
+		SetDefaultFragment []byte
+		// The SQL fragment used to drop the DEFAULT of a column, an error is returned if nil
+		// (DEFAULT=[]byte(" DROP DEFAULT"))
+		DropDefaultFragment []byte
+		// The SQL fragment used to add NOT NULL to a column, an error is returned if nil
+		// (DEFAULT=[]byte(" SET NOT NULL"))
+		SetNotNullFragment []byte
+		// The SQL fragment used to drop NOT NULL from a column, an error is returned if nil
+		// (DEFAULT=[]byte(" DROP NOT NULL"))
+		DropNotNullFragment []byte
+		// The SQL fragment used to add a constraint in an ALTER TABLE statement, an error is returned if nil
+		// (DEFAULT=[]byte("ADD "))
+		AddConstraintFragment []byte
+		// The SQL fragment used to partition a table, an error is returned when generating a partitioned table if nil
+		// (DEFAULT=[]byte("PARTITION BY "))
+		PartitionByFragment []byte
+		// The SQL fragment used to set the number of partitions of a table (e.g. mysql=[]byte(" PARTITIONS ")), an
+		// error is returned if nil (DEFAULT=nil)
+		PartitionCountFragment []byte
+		// The SQL fragment used to define a partition in a CREATE or ALTER TABLE statement
+		// (e.g. mysql=[]byte("PARTITION ")), an error is returned if nil (DEFAULT=nil)
+		PartitionFragment []byte
+		// The SQL fragment used to create a table as a partition of another table, an error is returned if nil
+		// (DEFAULT=[]byte(" PARTITION OF "))
+		PartitionOfFragment []byte
+		// The SQL fragment before the bound of a partition (e.g. mysql=[]byte(" VALUES"))
+		// (DEFAULT=[]byte(" FOR VALUES"))
+		PartitionValuesFragment []byte
+		// The SQL fragment between the lower and upper bound of a range partition (DEFAULT=[]byte(" TO "))
+		PartitionRangeToFragment []byte
+		// The SQL fragment between the modulus and remainder of a hash partition (DEFAULT=[]byte(", REMAINDER "))
+		PartitionRemainderFragment []byte
+		// The SQL fragment used to attach a partition in an ALTER TABLE statement, an error is returned if nil
+		// (DEFAULT=[]byte("ATTACH PARTITION "))
+		AttachPartitionFragment []byte
+		// The SQL fragment used to detach a partition in an ALTER TABLE statement, an error is returned if nil
+		// (DEFAULT=[]byte("DETACH PARTITION "))
+		DetachPartitionFragment []byte
+		// The SQL fragment used to add partitions in an ALTER TABLE statement (e.g. mysql=[]byte("ADD PARTITION ")), an
+		// error is returned if nil (DEFAULT=nil)
+		AddPartitionFragment []byte
+		// The SQL fragment used to drop partitions in an ALTER TABLE statement (e.g. mysql=[]byte("DROP PARTITION ")),
+		// an error is returned if nil (DEFAULT=nil)
+		DropPartitionFragment []byte
+		// The SQL fragment used to create an index (DEFAULT=[]byte("CREATE INDEX "))
+		CreateIndexFragment []byte
+		// The SQL fragment used to create a unique index (DEFAULT=[]byte("CREATE UNIQUE INDEX "))
+		CreateUniqueIndexFragment []byte
+		// The SQL CONCURRENTLY fragment used when creating and dropping indexes (DEFAULT=[]byte("CONCURRENTLY "))
+		ConcurrentlyFragment []byte
+		// The SQL fragment used to drop an index (DEFAULT=[]byte("DROP INDEX "))
+		DropIndexFragment []byte
+		// The SQL fragment used to drop a table (DEFAULT=[]byte("DROP TABLE "))
+		DropTableFragment []byte
+		// The SQL fragment used to drop a view (DEFAULT=[]byte("DROP VIEW "))
+		DropViewFragment []byte
+		// The SQL fragment used to start a CREATE statement with modifiers (e.g. CREATE OR REPLACE VIEW)
+		// (DEFAULT=[]byte("CREATE "))
+		CreateFragment []byte
+		// The SQL OR REPLACE fragment used when creating a view, set to nil if the dialect does not support it
+		// (DEFAULT=[]byte("OR REPLACE "))
+		OrReplaceViewFragment []byte
+		// The SQL TEMPORARY fragment used when creating a view, set to nil if the dialect does not support it
+		// (DEFAULT=[]byte("TEMPORARY "))
+		TemporaryViewFragment []byte
+		// The SQL VIEW fragment used when creating a view (DEFAULT=[]byte("VIEW "))
+		ViewFragment []byte
+		// The SQL fragment used when creating a materialized view, set to nil if the dialect does not support
+		// materialized views (DEFAULT=[]byte("MATERIALIZED VIEW "))
+		MaterializedViewFragment []byte
+		// The SQL fragment used to drop a materialized view (DEFAULT=[]byte("DROP MATERIALIZED VIEW "))
+		DropMaterializedViewFragment []byte
+		// The SQL fragment used to refresh a materialized view (DEFAULT=[]byte("REFRESH MATERIALIZED VIEW "))
+		RefreshMaterializedViewFragment []byte
+		// The SQL fragment used to create a sequence, set to nil if the dialect does not support sequences
+		// (DEFAULT=[]byte("CREATE SEQUENCE "))
+		CreateSequenceFragment []byte
+		// The SQL fragment used to alter a sequence (DEFAULT=[]byte("ALTER SEQUENCE "))
+		AlterSequenceFragment []byte
+		// The SQL fragment used to drop a sequence (DEFAULT=[]byte("DROP SEQUENCE "))
+		DropSequenceFragment []byte
+		// The SQL INCREMENT BY fragment of a sequence (DEFAULT=[]byte(" INCREMENT BY "))
+		IncrementByFragment []byte
+		// The SQL MINVALUE fragment of a sequence (DEFAULT=[]byte(" MINVALUE "))
+		MinValueFragment []byte
+		// The SQL NO MINVALUE fragment of a sequence (DEFAULT=[]byte(" NO MINVALUE"))
+		NoMinValueFragment []byte
+		// The SQL MAXVALUE fragment of a sequence (DEFAULT=[]byte(" MAXVALUE "))
+		MaxValueFragment []byte
+		// The SQL NO MAXVALUE fragment of a sequence (DEFAULT=[]byte(" NO MAXVALUE"))
+		NoMaxValueFragment []byte
+		// The SQL START WITH fragment of a sequence (DEFAULT=[]byte(" START WITH "))
+		StartWithFragment []byte
+		// The SQL CYCLE fragment of a sequence (DEFAULT=[]byte(" CYCLE"))
+		CycleFragment []byte
+		// The SQL NO CYCLE fragment of a sequence (DEFAULT=[]byte(" NO CYCLE"))
+		NoCycleFragment []byte
+		// The SQL RESTART WITH fragment used when altering a sequence (DEFAULT=[]byte(" RESTART WITH "))
+		RestartWithFragment []byte
+		// The function used to get the next value of a sequence, the name of the sequence is passed as a string. Set to
+		// nil if the dialect does not support it (DEFAULT=[]byte("nextval"))
+		NextValFunction []byte
+		// The function used to get the current value of a sequence, the name of the sequence is passed as a string. Set
+		// to nil if the dialect does not support it (DEFAULT=[]byte("currval"))
+		CurrValFunction []byte
+		// The SQL fragment used to get the next value of a sequence by its identifier (e.g. sqlserver
+		// NEXT VALUE FOR "a"), NextValFunction is used if nil (DEFAULT=nil)
+		NextValueForFragment []byte
+		// The SQL fragment used to create a schema, set to nil if the dialect does not support schemas
+		// (DEFAULT=[]byte("CREATE SCHEMA "))
+		CreateSchemaFragment []byte
+		// The SQL fragment used to drop a schema (DEFAULT=[]byte("DROP SCHEMA "))
+		DropSchemaFragment []byte
+		// The SQL fragment used to set the owner of a schema, set to nil if the dialect does not support it
+		// (DEFAULT=[]byte(" AUTHORIZATION "))
+		SchemaAuthorizationFragment []byte
+		// The SQL fragment used to create a database, set to nil if the dialect does not support creating databases
+		// (DEFAULT=[]byte("CREATE DATABASE "))
+		CreateDatabaseFragment []byte
+		// The SQL fragment used to drop a database (DEFAULT=[]byte("DROP DATABASE "))
+		DropDatabaseFragment []byte
+		// The SQL fragment used to set the owner of a database, set to nil if the dialect does not support it
+		// (DEFAULT=[]byte(" OWNER "))
+		DatabaseOwnerFragment []byte
+		// The SQL fragment used to set the comment of an object, set to nil if the dialect does not support
+		// COMMENT ON statements (DEFAULT=[]byte("COMMENT ON "))
+		CommentOnFragment []byte
+		// The SQL fragment between the object and the comment of a COMMENT ON statement (DEFAULT=[]byte(" IS "))
+		CommentIsFragment []byte
+		// The SQL fragment used to set the comment of a table in an ALTER TABLE statement
+		// (e.g. mysql=[]byte(" COMMENT = ")), COMMENT ON TABLE is used if nil (DEFAULT=nil)
+		TableCommentFragment []byte
+		// The SQL fragment used to grant privileges, set to nil if the dialect does not support GRANT and REVOKE
+		// statements (DEFAULT=[]byte("GRANT "))
+		GrantFragment []byte
+		// The SQL fragment used to revoke privileges (DEFAULT=[]byte("REVOKE "))
+		RevokeFragment []byte
+		// The SQL fragment before the roles privileges are granted to (DEFAULT=[]byte(" TO "))
+		GrantToFragment []byte
+		// The SQL fragment before the roles privileges are revoked from (DEFAULT=[]byte(" FROM "))
+		RevokeFromFragment []byte
+		// The SQL fragment used to allow the roles to grant the privileges to others
+		// (DEFAULT=[]byte(" WITH GRANT OPTION"))
+		WithGrantOptionFragment []byte
+		// The SQL fragment used to only revoke the grant option of privileges, an error is returned when revoking the
+		// grant option if nil (DEFAULT=[]byte("GRANT OPTION FOR "))
+		GrantOptionForFragment []byte
+		// The SQL TRIGGER fragment used when creating a trigger, set to nil if the dialect does not support triggers
+		// (DEFAULT=[]byte("TRIGGER "))
+		TriggerFragment []byte
+		// The SQL OR REPLACE fragment used when creating a trigger, set to nil if the dialect does not support it
+		// (e.g. sqlserver=[]byte("OR ALTER ")) (DEFAULT=[]byte("OR REPLACE "))
+		OrReplaceTriggerFragment []byte
+		// The SQL fragment used to drop a trigger (DEFAULT=[]byte("DROP TRIGGER "))
+		DropTriggerFragment []byte
+		// The SQL fragment between the events of a trigger (e.g. sqlserver=[]byte(", ")) (DEFAULT=[]byte(" OR "))
+		TriggerEventSeparatorFragment []byte
+		// The SQL fragment before the columns of an UPDATE event of a trigger, set to nil if the dialect does not
+		// support it (DEFAULT=[]byte(" OF "))
+		TriggerUpdateOfFragment []byte
+		// The SQL fragment used to fire a trigger for each row, set to nil if the dialect does not support it
+		// (DEFAULT=[]byte(" FOR EACH ROW"))
+		ForEachRowFragment []byte
+		// The SQL fragment used to fire a trigger once for each statement, set to nil if the dialect does not support
+		// it (DEFAULT=[]byte(" FOR EACH STATEMENT"))
+		ForEachStatementFragment []byte
+		// The SQL fragment before the condition of a trigger, set to nil if the dialect does not support it
+		// (DEFAULT=[]byte(" WHEN "))
+		TriggerWhenFragment []byte
+		// The SQL fragment used to execute a function when a trigger is fired, set to nil if the dialect does not
+		// support it (DEFAULT=[]byte(" EXECUTE FUNCTION "))
+		TriggerExecuteFragment []byte
+		// The SQL fragment before the body of a trigger, set to nil if the dialect does not support trigger bodies
+		// (e.g. mysql=[]byte(" BEGIN "), sqlserver=[]byte(" AS ")) (DEFAULT=nil)
+		TriggerBodyFragment []byte
+		// The SQL fragment after the body of a trigger (e.g. mysql=[]byte(" END")) (DEFAULT=nil)
+		TriggerBodyEndFragment []byte
+		// The SQL FUNCTION fragment used when creating a function, set to nil if the dialect does not support
+		// functions (DEFAULT=[]byte("FUNCTION "))
+		FunctionFragment []byte
+		// The SQL OR REPLACE fragment used when creating a function, set to nil if the dialect does not support it
+		// (e.g. sqlserver=[]byte("OR ALTER ")) (DEFAULT=[]byte("OR REPLACE "))
+		OrReplaceFunctionFragment []byte
+		// The SQL fragment used to drop a function (DEFAULT=[]byte("DROP FUNCTION "))
+		DropFunctionFragment []byte
+		// The SQL fragment before the name of the parameters of a function, the name is quoted if nil
+		// (e.g. sqlserver=[]byte("@")) (DEFAULT=nil)
+		FunctionParamPrefixFragment []byte
+		// The SQL fragment before the return type of a function (DEFAULT=[]byte(" RETURNS "))
+		FunctionReturnsFragment []byte
+		// The SQL fragment before the language of a function, set to nil if the dialect does not support it
+		// (DEFAULT=[]byte(" LANGUAGE "))
+		FunctionLanguageFragment []byte
+		// The SQL fragment before the body of a function (e.g. mysql=[]byte(" ")) (DEFAULT=[]byte(" AS $$"))
+		FunctionBodyFragment []byte
+		// The SQL fragment after the body of a function, an error is returned if the body contains it
+		// (DEFAULT=[]byte("$$"))
+		FunctionBodyEndFragment []byte
+		// The SQL IF EXISTS fragment used in DDL statements (DEFAULT=[]byte("IF EXISTS "))
+		IfExistsFragment []byte
 		// The SQL AS fragment when aliasing an Expression(DEFAULT=[]byte(" AS "))
 		AsFragment []byte
+		// The SQL fragment used when aliasing a table in a FROM or JOIN clause, oracle does not allow AS when aliasing
+		// tables (e.g. oracle=[]byte(" ")) (DEFAULT=[]byte(" AS "))
+		TableAliasFragment []byte
 		// The SQL LATERAL fragment used for LATERAL joins
 		LateralFragment []byte
+		// The SQL fragment written before a table to exclude the rows of inheriting tables, an error is returned if nil
+		// (e.g. postgres=[]byte("ONLY ")) (DEFAULT=nil)
+		TableOnlyFragment []byte
+		// The SQL fragment written after a table for a USE INDEX hint, an error is returned if nil
+		// (e.g. mysql=[]byte(" USE INDEX ")) (DEFAULT=nil)
+		UseIndexFragment []byte
+		// The SQL fragment written after a table for a FORCE INDEX hint, an error is returned if nil
+		// (e.g. mysql=[]byte(" FORCE INDEX ")) (DEFAULT=nil)
+		ForceIndexFragment []byte
+		// The SQL fragment written after a table for an IGNORE INDEX hint, an error is returned if nil
+		// (e.g. mysql=[]byte(" IGNORE INDEX ")) (DEFAULT=nil)
+		IgnoreIndexFragment []byte
+		// The SQL fragment written after a table before its table hints, an error is returned if nil
+		// (e.g. sqlserver=[]byte(" WITH ")) (DEFAULT=nil)
+		TableHintsFragment []byte
+		// The SQL fragment written after a table function to number the rows it returns, an error is returned if nil
+		// (e.g. postgres=[]byte(" WITH ORDINALITY")) (DEFAULT=nil)
+		WithOrdinalityFragment []byte
+		// The SQL fragment used to combine the results of several table functions, an error is returned if nil
+		// (e.g. postgres=[]byte("ROWS FROM ")) (DEFAULT=nil)
+		RowsFromFragment []byte
+		// The SQL PIVOT fragment used when rotating the rows of a table into columns, an error is returned if nil
+		// (e.g. sqlserver=[]byte(" PIVOT ")) (DEFAULT=nil)
+		PivotFragment []byte
+		// The SQL UNPIVOT fragment used when rotating the columns of a table into rows, an error is returned if nil
+		// (e.g. sqlserver=[]byte(" UNPIVOT ")) (DEFAULT=nil)
+		UnpivotFragment []byte
+		// The SQL fragment used to keep rows with NULL values when unpivoting, an error is returned if nil
+		// (e.g. oracle=[]byte("INCLUDE NULLS ")) (DEFAULT=nil)
+		UnpivotIncludeNullsFragment []byte
+		// The SQL fragment written before the column of a PIVOT or UNPIVOT (DEFAULT=[]byte(" FOR "))
+		PivotForFragment []byte
+		// The SQL fragment written before the values of a PIVOT or columns of an UNPIVOT (DEFAULT=[]byte(" IN "))
+		PivotInFragment []byte
+		// The SQL XMLTABLE function used to map the nodes of an XML document to rows, an error is returned if nil
+		// (e.g. postgres=[]byte("XMLTABLE")) (DEFAULT=nil)
+		XMLTableFragment []byte
+		// The SQL fragment written before the document of an XMLTABLE (DEFAULT=[]byte(" PASSING "))
+		XMLTablePassingFragment []byte
+		// The SQL fragment written before the columns of an XMLTABLE (DEFAULT=[]byte(" COLUMNS "))
+		XMLTableColumnsFragment []byte
+		// The SQL fragment written before the path of an XMLTABLE column (DEFAULT=[]byte(" PATH "))
+		XMLTablePathFragment []byte
+		// The SQL fragment used for an XMLTABLE column that numbers the rows (DEFAULT=[]byte(" FOR ORDINALITY"))
+		XMLTableForOrdinalityFragment []byte
+		// The SQL ROLLUP fragment used when grouping by a ROLLUP, if nil WithRollupFragment is used instead
+		// (e.g. mysql=nil) (DEFAULT=[]byte("ROLLUP "))
+		RollupFragment []byte
+		// The SQL fragment written after the columns of a ROLLUP when RollupFragment is nil, the ROLLUP must be the
+		// last element of the GROUP BY clause. An error is returned if both are nil (e.g. mysql=[]byte(" WITH ROLLUP"))
+		// (DEFAULT=nil)
+		WithRollupFragment []byte
+		// The SQL CUBE fragment used when grouping by a CUBE, an error is returned if nil (DEFAULT=[]byte("CUBE "))
+		CubeFragment []byte
+		// The SQL GROUPING SETS fragment used when grouping by GROUPING SETS, an error is returned if nil
+		// (DEFAULT=[]byte("GROUPING SETS "))
+		GroupingSetsFragment []byte
 		// The quote rune to use when quoting identifiers(DEFAULT='"')
 		QuoteRune rune
 		// The NULL literal to use when interpolating nulls values (DEFAULT=[]byte("NULL"))
@@ -157,10 +745,32 @@ type (
 		UnionAllFragment []byte
 		// The INTERSECT keyword used when creating compound statements (DEFAULT=[]byte(" INTERSECT "))
 		IntersectFragment []byte
-		// The INTERSECT ALL keyword used when creating compound statements (DEFAULT=[]byte(" INTERSECT ALL "))
+		// The INTERSECT ALL keyword used when creating compound statements. Set to nil if INTERSECT ALL is not
+		// supported (DEFAULT=[]byte(" INTERSECT ALL "))
 		IntersectAllFragment []byte
+		// The EXCEPT keyword used when creating compound statements. Set to nil if EXCEPT is not supported
+		// (DEFAULT=[]byte(" EXCEPT "))
+		ExceptFragment []byte
+		// The EXCEPT ALL keyword used when creating compound statements. Set to nil if EXCEPT ALL is not supported
+		// (DEFAULT=[]byte(" EXCEPT ALL "))
+		ExceptAllFragment []byte
 		// The CAST keyword to use when casting a value (DEFAULT=[]byte("CAST"))
 		CastFragment []byte
+		// The SQL function used to generate a random value, an error is returned if nil
+		// (e.g. mysql=[]byte("RAND()")) (DEFAULT=[]byte("RANDOM()"))
+		RandomFunction []byte
+		// A map used to look up the name of a function in the dialect by the lower case name passed to Func, functions
+		// that are not in the map are written as is
+		// (e.g. sqlserver=map[string][]byte{"ceil": []byte("CEILING")}) (DEFAULT=nil)
+		FunctionNameLookup map[string][]byte
+		// A map used to look up the definition of a function by the lower case name passed to Func, calls to a function
+		// in the map are validated against the number of arguments of the definition and written with its SQL name
+		// (e.g. sqlserver=map[string]exp.FunctionDefinition{"length": exp.NewFunctionDefinition("length", 1, 1).WithSQLName("LEN")})
+		// (DEFAULT=nil)
+		FunctionDefinitions map[string]exp.FunctionDefinition
+		// The operator used to write MOD(a, b) as (a % b) for dialects that do not have a MOD function
+		// (e.g. sqlserver=[]byte(" % ")) (DEFAULT=nil)
+		ModOperatorFragment []byte
 		// The CASE keyword to use when when creating a CASE statement (DEFAULT=[]byte("CASE "))
 		CaseFragment []byte
 		// The WHEN keyword to use when when creating a CASE statement (DEFAULT=[]byte(" WHEN "))
@@ -175,6 +785,9 @@ type (
 		StringQuote rune
 		// The quote rune to use when quoting string literals in slice context (DEFAULT='\'')
 		StringSliceQuote rune
+		// The prefix to write before an interpolated string literal, this is used by dialects that require a prefix
+		// for unicode string literals (e.g. []byte("N") for sqlserver N'...') (DEFAULT=nil)
+		StringLiteralPrefix []byte
 		// The operator to use when setting values in an update statement (DEFAULT='=')
 		SetOperatorRune rune
 		// The placeholder fragment to use when generating a non interpolated statement (DEFAULT=[]byte"?")
@@ -199,10 +812,17 @@ type (
 		PeriodRune rune
 		// Set to true to include positional argument numbers when creating a prepared statement (Default=false)
 		IncludePlaceholderNum bool
+		// When set the args of a prepared statement are bound by name when the statement is executed, the name is the
+		// prefix followed by the position of the arg (e.g. "p" -> p1, p2) so the PlaceHolderFragment should end with the
+		// same prefix and IncludePlaceholderNum should be true (e.g. spanner @p1) (DEFAULT="")
+		NamedArgPrefix string
 		// Set to true if single placeholder required for slice type (DEFAULT=false)
 		SinglePlaceholderForSlice bool
 		// The time format to use when serializing time.Time (DEFAULT=time.RFC3339Nano)
 		TimeFormat string
+		// The fragment written before an interpolated time.Time so it is parsed as a timestamp literal regardless of
+		// the session settings (e.g. oracle TIMESTAMP '2006-01-02 15:04:05.000000') (DEFAULT=nil)
+		TimeLiteralFragment []byte
 		// A map used to look up BooleanOperations and their SQL equivalents
 		// (Default= map[exp.BooleanOperation][]byte{
 		// 		exp.EqOp:             []byte("="),
@@ -223,8 +843,21 @@ type (
 		// 		exp.RegexpNotLikeOp:  []byte("!~"),
 		// 		exp.RegexpILikeOp:    []byte("~*"),
 		// 		exp.RegexpNotILikeOp: []byte("!~*"),
+		// 		exp.IsDistinctFromOp:    []byte("IS DISTINCT FROM"),
+		// 		exp.IsNotDistinctFromOp: []byte("IS NOT DISTINCT FROM"),
 		// })
+		// If IsDistinctFromOp or IsNotDistinctFromOp are not in the map they are emulated using NullSafeEqualFragment
+		// or a CASE expression
 		BooleanOperatorLookup map[exp.BooleanOperation][]byte
+		// The NULL safe equality operator used to emulate IS [NOT] DISTINCT FROM (e.g. <=> for mysql), if nil a CASE
+		// expression is used
+		// (e.g. ("a" IS DISTINCT FROM "b") -> (CASE WHEN (("a" = "b") OR (("a" IS NULL) AND ("b" IS NULL))) THEN 1 ELSE 0 END = 0))
+		// (DEFAULT=nil)
+		NullSafeEqualFragment []byte
+		// The fragment used to set the escape character of a LIKE or ILIKE expression, if nil the escape character
+		// can only be a backslash which is then assumed to be the default escape character of the dialect
+		// (e.g. ("a" LIKE '100\%' ESCAPE '\')) (DEFAULT=[]byte(" ESCAPE "))
+		LikeEscapeFragment []byte
 		// A map used to look up BitwiseOperations and their SQL equivalents
 		// (Default=map[exp.BitwiseOperation][]byte{
 		// 		exp.BitwiseInversionOp:  []byte("~"),
@@ -235,12 +868,181 @@ type (
 		// 		exp.BitwiseRightShiftOp: []byte(">>"),
 		// }),
 		BitwiseOperatorLookup map[exp.BitwiseOperation][]byte
+		// A map used to look up the symbols of custom operators (see exp.NewBinaryOperator) by name, operators that are
+		// not in the map use their own symbol and operators mapped to nil or an empty symbol are not supported
+		// (e.g. map[string][]byte{"l2_distance": []byte("<->")}) (DEFAULT=nil)
+		CustomOperatorLookup map[string][]byte
+		// A map used to look up JSONOperations and their SQL operators, an error is returned for operations that are not
+		// in the map
+		// (e.g. postgres=map[exp.JSONOperation][]byte{
+		// 		exp.JSONGetOp:     []byte("->"),
+		// 		exp.JSONGetTextOp: []byte("->>"),
+		// 		...
+		// }) (DEFAULT=nil)
+		JSONOperatorLookup map[exp.JSONOperation][]byte
+		// Set to true if JSON values are accessed using the JSON functions with a JSON path (e.g. JSON_EXTRACT("data",
+		// '$.a')) instead of operators, JSONOperatorLookup is ignored. The path of a JSONSetFunc is also written as a JSON
+		// path instead of an array (e.g. mysql=true) (DEFAULT=false)
+		UseJSONFunctions bool
+		// A map used to look up JSONFunctions and their SQL function names, an error is returned for functions that are
+		// not in the map
+		// (e.g. postgres=map[exp.JSONFunction][]byte{
+		// 		exp.JSONSetFunc:         []byte("jsonb_set"),
+		// 		exp.JSONBuildObjectFunc: []byte("jsonb_build_object"),
+		// 		...
+		// }) (DEFAULT=nil)
+		JSONFunctionLookup map[exp.JSONFunction][]byte
+		// The type that maps, structs and slices serialized to JSON are cast to when used in a JSONFunction, if nil the
+		// serialized value is written without a cast (e.g. postgres=[]byte("JSONB"), mysql=[]byte("JSON")) (DEFAULT=nil)
+		JSONValueCastType []byte
+		// A map used to look up the ArrayOperations that are written as operators, an error is returned for operations
+		// that are not in the map
+		// (e.g. postgres=map[exp.ArrayOperation][]byte{
+		// 		exp.ArrayContainsOp:    []byte("@>"),
+		// 		exp.ArrayContainedByOp: []byte("<@"),
+		// 		exp.ArrayOverlapOp:     []byte("&&"),
+		// }) (DEFAULT=nil)
+		ArrayOperatorLookup map[exp.ArrayOperation][]byte
+		// The keyword used to construct an array from values, if nil an error is returned
+		// (e.g. postgres=[]byte("ARRAY") -> ARRAY[1, 2]) (DEFAULT=nil)
+		ArrayConstructorFragment []byte
+		// Set to true if array elements can be accessed by index (e.g. "a"[1]) (DEFAULT=false)
+		SupportsArrayIndex bool
+		// Set to true if arrays can be sliced (e.g. "a"[1:3]) (DEFAULT=false)
+		SupportsArraySlice bool
+		// Set to true if the dialect supports range types built with their constructor functions
+		// (e.g. postgres=true -> int4range(1, 10, '[]')) (DEFAULT=false)
+		SupportsRangeTypes bool
+		// A map used to look up RangeTypeOperations and their SQL operators, an error is returned for operations that
+		// are not in the map
+		// (e.g. postgres=map[exp.RangeTypeOperation][]byte{
+		// 		exp.RangeTypeContainsOp: []byte("@>"),
+		// 		exp.RangeTypeOverlapOp:  []byte("&&"),
+		// 		...
+		// }) (DEFAULT=nil)
+		RangeTypeOperatorLookup map[exp.RangeTypeOperation][]byte
+		// How intervals and date arithmetic are written
+		// 		QuotedQuantityIntervalStyle -> INTERVAL '3' DAY, ("a" + INTERVAL '3' DAY)
+		// 		QuotedIntervalStyle         -> INTERVAL '3 days', ("a" + INTERVAL '3 days') (e.g. postgres)
+		// 		UnquotedIntervalStyle       -> INTERVAL 3 DAY, ("a" + INTERVAL 3 DAY) (e.g. mysql)
+		// 		DateAddIntervalStyle        -> DATEADD(DAY, 3, "a") (e.g. sqlserver), intervals can only be used in date
+		// 		                               arithmetic
+		// (DEFAULT=QuotedQuantityIntervalStyle)
+		IntervalStyle IntervalStyle
+		// The keyword that starts an interval (DEFAULT=[]byte("INTERVAL"))
+		IntervalFragment []byte
+		// The function used to add intervals to dates when IntervalStyle is DateAddIntervalStyle
+		// (DEFAULT=[]byte("DATEADD"))
+		DateAddFragment []byte
+		// A map used to look up the names of IntervalUnits, an error is returned for units that are not in the map and
+		// intervals are not supported if the map is empty
+		// (e.g. postgres=map[exp.IntervalUnit][]byte{
+		// 		exp.DaysIntervalUnit:  []byte("days"),
+		// 		exp.HoursIntervalUnit: []byte("hours"),
+		// 		...
+		// })
+		// (DEFAULT=map[exp.IntervalUnit][]byte{
+		// 		exp.SecondsIntervalUnit: []byte("SECOND"),
+		// 		exp.MinutesIntervalUnit: []byte("MINUTE"),
+		// 		exp.HoursIntervalUnit:   []byte("HOUR"),
+		// 		exp.DaysIntervalUnit:    []byte("DAY"),
+		// 		exp.MonthsIntervalUnit:  []byte("MONTH"),
+		// 		exp.YearsIntervalUnit:   []byte("YEAR"),
+		// })
+		IntervalUnitLookup map[exp.IntervalUnit][]byte
+		// The fragment used to convert a timestamp to a time zone, AT TIME ZONE is not supported if nil and
+		// ConvertTimeZoneFunction is not set
+		// (e.g. ("a" AT TIME ZONE 'UTC')) (DEFAULT=[]byte(" AT TIME ZONE "))
+		AtTimeZoneFragment []byte
+		// The function used to convert a timestamp to a time zone instead of AtTimeZoneFragment, the function is called
+		// with the timestamp, ConvertTimeZoneSourceFragment if set and the time zone
+		// (e.g. mysql CONVERT_TZ(`a`, @@session.time_zone, 'UTC'), clickhouse toTimeZone(`a`, 'UTC')) (DEFAULT=nil)
+		ConvertTimeZoneFunction []byte
+		// The time zone the timestamp is converted from when using ConvertTimeZoneFunction
+		// (e.g. mysql []byte("@@session.time_zone")) (DEFAULT=nil)
+		ConvertTimeZoneSourceFragment []byte
+		// Set to true if the time zone is the first argument of ConvertTimeZoneFunction
+		// (e.g. snowflake CONVERT_TIMEZONE('UTC', "a")) (DEFAULT=false)
+		ConvertTimeZoneZoneFirst bool
 		// A map used to look up RangeOperations and their SQL equivalents
 		// (Default=map[exp.RangeOperation][]byte{
 		// 		exp.BetweenOp:    []byte("BETWEEN"),
 		// 		exp.NotBetweenOp: []byte("NOT BETWEEN"),
 		// 	})
 		RangeOperatorLookup map[exp.RangeOperation][]byte
+		// A map used to look up the DataTypeKind of a column definition and the type of the dialect, the size or the
+		// precision and scale of the data type are appended (e.g. VARCHAR(255)). An error is returned when generating a
+		// column with a type that is not in the map.
+		// (Default=map[exp.DataTypeKind][]byte{
+		// 		exp.SmallIntDataType:    []byte("SMALLINT"),
+		// 		exp.IntegerDataType:     []byte("INTEGER"),
+		// 		exp.BigIntDataType:      []byte("BIGINT"),
+		// 		exp.DecimalDataType:     []byte("DECIMAL"),
+		// 		exp.RealDataType:        []byte("REAL"),
+		// 		exp.DoubleDataType:      []byte("DOUBLE PRECISION"),
+		// 		exp.BooleanDataType:     []byte("BOOLEAN"),
+		// 		exp.CharDataType:        []byte("CHAR"),
+		// 		exp.VarcharDataType:     []byte("VARCHAR"),
+		// 		exp.TextDataType:        []byte("TEXT"),
+		// 		exp.DateDataType:        []byte("DATE"),
+		// 		exp.TimeDataType:        []byte("TIME"),
+		// 		exp.TimestampDataType:   []byte("TIMESTAMP"),
+		// 		exp.TimestampTzDataType: []byte("TIMESTAMP WITH TIME ZONE"),
+		// 		exp.BinaryDataType:      []byte("BLOB"),
+		// 		exp.UUIDDataType:        []byte("UUID"),
+		// 		exp.JSONDataType:        []byte("JSON"),
+		// 	})
+		DataTypeLookup map[exp.DataTypeKind][]byte
+		// A map used to look up the SQL type of a CAST by the lower case type name passed to Cast. Names that are not in
+		// the map and are a lower case common type name (e.g. "text", "bigint", "json", "uuid", "timestamptz") are looked
+		// up in the DataTypeLookup, any other name (e.g. "VARCHAR") is written as is
+		// (e.g. mysql=map[string][]byte{
+		// 		"text":   []byte("CHAR"),
+		// 		"bigint": []byte("SIGNED"),
+		// 		...
+		// })
+		// (DEFAULT=nil)
+		CastTypeLookup map[string][]byte
+		// A map used to look up the CHECK OPTION of a view, options that are not in the map are not supported
+		// (Default= map[exp.ViewCheckOption][]byte{
+		// 		exp.DefaultViewCheckOption:  []byte(" WITH CHECK OPTION"),
+		// 		exp.CascadedViewCheckOption: []byte(" WITH CASCADED CHECK OPTION"),
+		// 		exp.LocalViewCheckOption:    []byte(" WITH LOCAL CHECK OPTION"),
+		// 	})
+		ViewCheckOptionLookup map[exp.ViewCheckOption][]byte
+		// A map used to look up the bound of a partition, bounds that are not in the map are not supported
+		// (Default= map[exp.PartitionBoundType][]byte{
+		// 		exp.RangePartitionBound:   []byte(" FROM "),
+		// 		exp.ListPartitionBound:    []byte(" IN "),
+		// 		exp.HashPartitionBound:    []byte(" WITH (MODULUS "),
+		// 		exp.DefaultPartitionBound: []byte(" DEFAULT"),
+		// 	})
+		PartitionBoundLookup map[exp.PartitionBoundType][]byte
+		// A map used to look up the ON DELETE and ON UPDATE actions of a FOREIGN KEY constraint, actions that are not
+		// in the map are not supported
+		// (Default= map[exp.ReferentialAction][]byte{
+		// 		exp.RestrictReferentialAction:   []byte("RESTRICT"),
+		// 		exp.CascadeReferentialAction:    []byte("CASCADE"),
+		// 		exp.SetNullReferentialAction:    []byte("SET NULL"),
+		// 		exp.SetDefaultReferentialAction: []byte("SET DEFAULT"),
+		// 		exp.NoActionReferentialAction:   []byte("NO ACTION"),
+		// 	})
+		ReferentialActionLookup map[exp.ReferentialAction][]byte
+		// A map used to look up the timing of a trigger, timings that are not in the map are not supported
+		// (Default= map[exp.TriggerTiming][]byte{
+		// 		exp.BeforeTriggerTiming:    []byte(" BEFORE "),
+		// 		exp.AfterTriggerTiming:     []byte(" AFTER "),
+		// 		exp.InsteadOfTriggerTiming: []byte(" INSTEAD OF "),
+		// 	})
+		TriggerTimingLookup map[exp.TriggerTiming][]byte
+		// A map used to look up the events of a trigger, events that are not in the map are not supported
+		// (Default= map[exp.TriggerEvent][]byte{
+		// 		exp.InsertTriggerEvent:   []byte("INSERT"),
+		// 		exp.UpdateTriggerEvent:   []byte("UPDATE"),
+		// 		exp.DeleteTriggerEvent:   []byte("DELETE"),
+		// 		exp.TruncateTriggerEvent: []byte("TRUNCATE"),
+		// 	})
+		TriggerEventLookup map[exp.TriggerEvent][]byte
 		// A map used to look up JoinTypes and their SQL equivalents
 		// (Default= map[exp.JoinType][]byte{
 		// 		exp.InnerJoinType:        []byte(" INNER JOIN "),
@@ -261,6 +1063,12 @@ type (
 		BooleanDataTypeSupported bool
 		// Whether or not to use literal TRUE or FALSE for IS statements (e.g. IS TRUE or IS 0)
 		UseLiteralIsBools bool
+		// Set to true to emulate IS TRUE and IS FALSE using the True and False values when BooleanDataTypeSupported is
+		// false (e.g. "a" IS TRUE -> ("a" = 1), "a" IS NOT TRUE -> (("a" != 1) OR ("a" IS NULL))) (DEFAULT=false)
+		EmulateIsBools bool
+		// Set to true to pass bool arguments as the ints 1 and 0 in prepared statements for drivers that cannot bind
+		// bools (e.g. oracle) (DEFAULT=false)
+		BoolArgsAsInts bool
 		// EscapedRunes is a map of a rune and the corresponding escape sequence in bytes. Used when escaping text
 		// types.
 		// (Default= map[rune][]byte{
@@ -274,6 +1082,105 @@ type (
 		ConflictDoNothingFragment []byte
 		// The SQL fragment to use for CONFLICT DO UPDATE (Default=[]byte(" DO UPDATE SET"))
 		ConflictDoUpdateFragment []byte
+		// The SQL fragment used to start a MERGE statement, set to nil if the dialect does not support MERGE
+		// (DEFAULT=[]byte("MERGE INTO "))
+		MergeFragment []byte
+		// The SQL fragment used for the WHEN MATCHED clauses of a MERGE statement (DEFAULT=[]byte(" WHEN MATCHED"))
+		MergeWhenMatchedFragment []byte
+		// The SQL fragment used for the WHEN NOT MATCHED clauses of a MERGE statement
+		// (DEFAULT=[]byte(" WHEN NOT MATCHED"))
+		MergeWhenNotMatchedFragment []byte
+		// The SQL fragment before the condition of a WHEN clause of a MERGE statement (e.g. WHEN MATCHED AND "a" > 1),
+		// set to nil if the condition is written after the action (DEFAULT=[]byte(" AND "))
+		MergeConditionFragment []byte
+		// The SQL fragment before the condition of a WHEN clause when the condition is written after the action
+		// (e.g. oracle=[]byte(" WHERE ")) (DEFAULT=nil)
+		MergeActionWhereFragment []byte
+		// The SQL fragment before the action of a WHEN clause of a MERGE statement (DEFAULT=[]byte(" THEN "))
+		MergeThenFragment []byte
+		// The SQL fragment used to update the matched rows of a MERGE statement (DEFAULT=[]byte("UPDATE SET "))
+		MergeUpdateFragment []byte
+		// The SQL fragment used to delete the matched rows of a MERGE statement, set to nil if the dialect does not
+		// support it (DEFAULT=[]byte("DELETE"))
+		MergeDeleteFragment []byte
+		// The SQL fragment used to insert the rows that are not matched by a MERGE statement
+		// (DEFAULT=[]byte("INSERT "))
+		MergeInsertFragment []byte
+		// The SQL fragment used to end a MERGE statement (e.g. sqlserver=[]byte(";")) (DEFAULT=nil)
+		MergeEndFragment []byte
+		// The SQL fragment used to call a stored procedure, set to nil if the dialect does not support stored procedures
+		// (e.g. sqlserver=[]byte("EXEC ")) (DEFAULT=[]byte("CALL "))
+		CallFragment []byte
+		// The SQL fragment written after OUT parameters (sql.Out) of a stored procedure call
+		// (e.g. sqlserver=[]byte(" OUTPUT")) (DEFAULT=nil)
+		CallOutputFragment []byte
+		// The SQL fragment used to end a stored procedure call (e.g. oracle=[]byte("; END;")) (DEFAULT=nil)
+		CallEndFragment []byte
+		// The SQL fragment used to explain a statement, set to nil if the dialect does not support EXPLAIN
+		// (e.g. sqlite3=[]byte("EXPLAIN QUERY PLAN ")) (DEFAULT=[]byte("EXPLAIN "))
+		ExplainFragment []byte
+		// The SQL fragment used for the ANALYZE option of an EXPLAIN statement, set to nil if not supported
+		// (DEFAULT=[]byte("ANALYZE"))
+		ExplainAnalyzeFragment []byte
+		// The SQL fragment used for the VERBOSE option of an EXPLAIN statement, set to nil if not supported
+		// (DEFAULT=[]byte("VERBOSE"))
+		ExplainVerboseFragment []byte
+		// The SQL fragment used for the FORMAT option of an EXPLAIN statement, set to nil if not supported
+		// (e.g. mysql=[]byte("FORMAT=")) (DEFAULT=[]byte("FORMAT "))
+		ExplainFormatFragment []byte
+		// A map used to look up the formats of an EXPLAIN statement, formats that are not in the map are not supported
+		// (DEFAULT=map[exp.ExplainFormat][]byte{
+		// 		exp.ExplainFormatText: []byte("TEXT"),
+		// 		exp.ExplainFormatJSON: []byte("JSON"),
+		// 		exp.ExplainFormatXML:  []byte("XML"),
+		// 		exp.ExplainFormatYAML: []byte("YAML"),
+		// })
+		ExplainFormats map[exp.ExplainFormat][]byte
+		// The SQL fragment used to start a COPY statement, set to nil if the dialect does not support COPY
+		// (DEFAULT=[]byte("COPY "))
+		CopyFragment []byte
+		// The SQL fragment used to copy data into a table, an error is returned when copying from STDIN if nil
+		// (DEFAULT=[]byte(" FROM STDIN"))
+		CopyFromStdinFragment []byte
+		// The SQL fragment used before the source data is copied from (e.g. redshift COPY "a" FROM 's3://bucket/a')
+		// (DEFAULT=[]byte(" FROM "))
+		CopyFromSourceFragment []byte
+		// The SQL fragment used to copy data out of a table or query, an error is returned when copying to STDOUT if
+		// nil (DEFAULT=[]byte(" TO STDOUT"))
+		CopyToStdoutFragment []byte
+		// The SQL fragment used before the options of a COPY statement, an error is returned when generating a COPY
+		// with options if nil (DEFAULT=[]byte(" WITH "))
+		CopyWithFragment []byte
+		// The SQL fragment used to set a session parameter, set to nil if the dialect does not support SET
+		// (DEFAULT=[]byte("SET "))
+		SetParamFragment []byte
+		// The SQL fragment used to set a parameter for the current transaction only, set to nil if the dialect does
+		// not support SET LOCAL (DEFAULT=[]byte("LOCAL "))
+		SetLocalFragment []byte
+		// The SQL fragment used to show the value of a session parameter, set to nil if the dialect does not support
+		// SHOW (DEFAULT=[]byte("SHOW "))
+		ShowFragment []byte
+		// The SQL fragment used to VACUUM a table, set to nil if the dialect does not support VACUUM
+		// (DEFAULT=[]byte("VACUUM"))
+		VacuumFragment []byte
+		// The SQL fragment used to ANALYZE a table, set to nil if the dialect does not support ANALYZE
+		// (e.g. mysql=[]byte("ANALYZE TABLE")) (DEFAULT=[]byte("ANALYZE"))
+		AnalyzeFragment []byte
+		// The SQL fragment used to OPTIMIZE a table, set to nil if the dialect does not support OPTIMIZE
+		// (e.g. mysql=[]byte("OPTIMIZE TABLE")) (DEFAULT=nil)
+		OptimizeFragment []byte
+		// The SQL fragments used for the options of a maintenance statement, set to nil if the dialect does not
+		// support the option (e.g. VACUUM (FULL, FREEZE, VERBOSE, ANALYZE, SKIP_LOCKED) "table")
+		// (DEFAULT=[]byte("FULL"))
+		MaintenanceFullFragment []byte
+		// (DEFAULT=[]byte("FREEZE"))
+		MaintenanceFreezeFragment []byte
+		// (DEFAULT=[]byte("VERBOSE"))
+		MaintenanceVerboseFragment []byte
+		// (DEFAULT=[]byte("ANALYZE"))
+		MaintenanceAnalyzeFragment []byte
+		// (DEFAULT=[]byte("SKIP_LOCKED"))
+		MaintenanceSkipLockedFragment []byte
 
 		// The order of SQL fragments when creating a SELECT statement
 		// (Default=[]SQLFragmentType{
@@ -332,9 +1239,134 @@ type (
 		// 		TruncateSQLFragment,
 		// 	})
 		TruncateSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a MERGE statement
+		// (Default=[]SQLFragmentType{
+		// 		MergeSQLFragment,
+		// 	})
+		MergeSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a CALL statement
+		// (Default=[]SQLFragmentType{
+		// 		CallSQLFragment,
+		// 	})
+		CallSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a COPY statement
+		// (Default=[]SQLFragmentType{
+		// 		CopySQLFragment,
+		// 	})
+		CopySQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a SET statement
+		// (Default=[]SQLFragmentType{
+		// 		SetParamSQLFragment,
+		// 	})
+		SetParamSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a SHOW statement
+		// (Default=[]SQLFragmentType{
+		// 		ShowSQLFragment,
+		// 	})
+		ShowSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a VACUUM, ANALYZE or OPTIMIZE statement
+		// (Default=[]SQLFragmentType{
+		// 		MaintenanceSQLFragment,
+		// 	})
+		MaintenanceSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a CREATE TABLE statement
+		// (Default=[]SQLFragmentType{
+		// 		CreateTableSQLFragment,
+		// 	})
+		CreateTableSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating an ALTER TABLE statement
+		// (Default=[]SQLFragmentType{
+		// 		AlterTableSQLFragment,
+		// 	})
+		AlterTableSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a CREATE INDEX statement
+		// (Default=[]SQLFragmentType{
+		// 		CreateIndexSQLFragment,
+		// 	})
+		CreateIndexSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a CREATE VIEW statement
+		// (Default=[]SQLFragmentType{
+		// 		CreateViewSQLFragment,
+		// 	})
+		CreateViewSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a REFRESH MATERIALIZED VIEW statement
+		// (Default=[]SQLFragmentType{
+		// 		RefreshSQLFragment,
+		// 	})
+		RefreshSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a CREATE SEQUENCE statement
+		// (Default=[]SQLFragmentType{
+		// 		CreateSequenceSQLFragment,
+		// 	})
+		CreateSequenceSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating an ALTER SEQUENCE statement
+		// (Default=[]SQLFragmentType{
+		// 		AlterSequenceSQLFragment,
+		// 	})
+		AlterSequenceSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a CREATE SCHEMA or CREATE DATABASE statement
+		// (Default=[]SQLFragmentType{
+		// 		CreateSchemaSQLFragment,
+		// 	})
+		CreateSchemaSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a COMMENT ON statement
+		// (Default=[]SQLFragmentType{
+		// 		CommentSQLFragment,
+		// 	})
+		CommentSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a GRANT or REVOKE statement
+		// (Default=[]SQLFragmentType{
+		// 		GrantSQLFragment,
+		// 	})
+		GrantSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a CREATE TRIGGER statement
+		// (Default=[]SQLFragmentType{
+		// 		CreateTriggerSQLFragment,
+		// 	})
+		CreateTriggerSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a CREATE FUNCTION statement
+		// (Default=[]SQLFragmentType{
+		// 		CreateFunctionSQLFragment,
+		// 	})
+		CreateFunctionSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a DROP statement
+		// (Default=[]SQLFragmentType{
+		// 		DropSQLFragment,
+		// 	})
+		DropSQLOrder []SQLFragmentType
 	}
 )
 
+const (
+	// INTERVAL '3' DAY (ANSI)
+	QuotedQuantityIntervalStyle IntervalStyle = iota
+	// INTERVAL '3 days' (e.g. postgres)
+	QuotedIntervalStyle
+	// INTERVAL 3 DAY (e.g. mysql)
+	UnquotedIntervalStyle
+	// DATEADD(DAY, 3, "a") (e.g. sqlserver)
+	DateAddIntervalStyle
+)
+
 const (
 	CommonTableSQLFragment = iota
 	SelectSQLFragment
@@ -361,6 +1393,34 @@ const (
 	DeleteBeginSQLFragment
 	TruncateSQLFragment
 	WindowSQLFragment
+	OffsetFetchSQLFragment
+	SelectWithFirstSkipSQLFragment
+	AsOfSystemTimeSQLFragment
+	FinalSQLFragment
+	SampleSQLFragment
+	PrewhereSQLFragment
+	SettingsSQLFragment
+	QualifySQLFragment
+	CreateTableSQLFragment
+	AlterTableSQLFragment
+	CreateIndexSQLFragment
+	DropSQLFragment
+	CreateViewSQLFragment
+	RefreshSQLFragment
+	CreateSequenceSQLFragment
+	AlterSequenceSQLFragment
+	CreateSchemaSQLFragment
+	CommentSQLFragment
+	GrantSQLFragment
+	CreateTriggerSQLFragment
+	CreateFunctionSQLFragment
+	MergeSQLFragment
+	CallSQLFragment
+	CopySQLFragment
+	SetParamSQLFragment
+	ShowSQLFragment
+	MaintenanceSQLFragment
+	ConnectBySQLFragment
 )
 
 // nolint:gocyclo // simple type to string conversion
@@ -410,6 +1470,62 @@ func (sf SQLFragmentType) String() string {
 		return "TruncateSQLFragment"
 	case WindowSQLFragment:
 		return "WindowSQLFragment"
+	case OffsetFetchSQLFragment:
+		return "OffsetFetchSQLFragment"
+	case SelectWithFirstSkipSQLFragment:
+		return "SelectWithFirstSkipSQLFragment"
+	case AsOfSystemTimeSQLFragment:
+		return "AsOfSystemTimeSQLFragment"
+	case FinalSQLFragment:
+		return "FinalSQLFragment"
+	case SampleSQLFragment:
+		return "SampleSQLFragment"
+	case PrewhereSQLFragment:
+		return "PrewhereSQLFragment"
+	case SettingsSQLFragment:
+		return "SettingsSQLFragment"
+	case QualifySQLFragment:
+		return "QualifySQLFragment"
+	case CreateTableSQLFragment:
+		return "CreateTableSQLFragment"
+	case AlterTableSQLFragment:
+		return "AlterTableSQLFragment"
+	case CreateIndexSQLFragment:
+		return "CreateIndexSQLFragment"
+	case DropSQLFragment:
+		return "DropSQLFragment"
+	case CreateViewSQLFragment:
+		return "CreateViewSQLFragment"
+	case RefreshSQLFragment:
+		return "RefreshSQLFragment"
+	case CreateSequenceSQLFragment:
+		return "CreateSequenceSQLFragment"
+	case AlterSequenceSQLFragment:
+		return "AlterSequenceSQLFragment"
+	case CreateSchemaSQLFragment:
+		return "CreateSchemaSQLFragment"
+	case CommentSQLFragment:
+		return "CommentSQLFragment"
+	case GrantSQLFragment:
+		return "GrantSQLFragment"
+	case CreateTriggerSQLFragment:
+		return "CreateTriggerSQLFragment"
+	case CreateFunctionSQLFragment:
+		return "CreateFunctionSQLFragment"
+	case MergeSQLFragment:
+		return "MergeSQLFragment"
+	case CallSQLFragment:
+		return "CallSQLFragment"
+	case CopySQLFragment:
+		return "CopySQLFragment"
+	case SetParamSQLFragment:
+		return "SetParamSQLFragment"
+	case ShowSQLFragment:
+		return "ShowSQLFragment"
+	case MaintenanceSQLFragment:
+		return "MaintenanceSQLFragment"
+	case ConnectBySQLFragment:
+		return "ConnectBySQLFragment"
 	}
 	return fmt.Sprintf("%d", sf)
 }
@@ -423,8 +1539,10 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		SupportsLimitOnDelete:       false,
 		SupportsLimitOnUpdate:       false,
 		SupportsReturn:              true,
+		SupportsReturnOnUpdate:      true,
 		SupportsConflictUpdateWhere: true,
 		SupportsInsertIgnoreSyntax:  false,
+		SupportsConflict:            true,
 		SupportsConflictTarget:      true,
 		SupportsWithCTE:             true,
 		SupportsWithCTERecursive:    true,
@@ -433,12 +1551,56 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		SupportsWindowFunction:      true,
 		SupportsLateral:             true,
 
+		SupportsDerivedColumnAliases: true,
+
+		SupportsWindowFrameGroups:    true,
+		SupportsWindowFrameExclusion: true,
+
 		SupportsMultipleUpdateTables:         true,
 		UseFromClauseForMultipleUpdateTables: true,
 
+		SupportsLockWithLimit:      true,
+		SupportsMultipleInsertRows: true,
+
+		SupportsMultipleTruncateTables: true,
+		SupportsTruncateIdentity:       true,
+		SupportsTruncateCascade:        true,
+
+		SupportsMultipleMergeWhenClauses: true,
+
+		WrapCallArgsInParens:       true,
+		WrapExplainOptionsInParens: true,
+
+		SupportsMaintenanceWithoutTables: true,
+		SupportsVacuumTables:             true,
+
+		SupportsCreateTableIfNotExists:    true,
+		SupportsMultipleAlterTableActions: true,
+		SupportsCreateIndexIfNotExists:    true,
+		SupportsDropIndexIfExists:         true,
+		SupportsConcurrentIndex:           true,
+		SupportsIndexMethod:               true,
+		SupportsPartialIndex:              true,
+		SupportsDropIfExists:              true,
+		SupportsDropCascade:               true,
+		SupportsMultipleDropObjects:       true,
+		SupportsCreateSequenceIfNotExists: true,
+		SupportsAlterSequenceIfExists:     true,
+		SupportsCreateSchemaIfNotExists:   true,
+		SupportsCreateDatabaseIfNotExists: false,
+		SupportsRevokeCascade:             true,
+		SupportsCreateTriggerIfNotExists:  false,
+		SupportsMultipleTriggerEvents:     true,
+		DropTriggerRequiresTable:          true,
+
+		SupportsPlaceholders: true,
+
+		StatementSeparatorFragment: []byte("; "),
+
 		UpdateClause:              []byte("UPDATE"),
 		InsertClause:              []byte("INSERT INTO"),
 		InsertIgnoreClause:        []byte("INSERT IGNORE INTO"),
+		ConflictResolutionLookup:  map[exp.ConflictResolution][]byte{},
 		SelectClause:              []byte("SELECT"),
 		DeleteClause:              []byte("DELETE"),
 		TruncateClause:            []byte("TRUNCATE"),
@@ -448,6 +1610,7 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		RestrictFragment:          []byte(" RESTRICT"),
 		DefaultValuesFragment:     []byte(" DEFAULT VALUES"),
 		ValuesFragment:            []byte(" VALUES "),
+		ValuesListFragment:        []byte("VALUES "),
 		IdentityFragment:          []byte(" IDENTITY"),
 		SetFragment:               []byte(" SET "),
 		DistinctFragment:          []byte("DISTINCT"),
@@ -462,10 +1625,15 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		WindowPartitionByFragment: []byte("PARTITION BY "),
 		WindowOrderByFragment:     []byte("ORDER BY "),
 		WindowOverFragment:        []byte(" OVER "),
+		AggregateFilterFragment:   []byte(" FILTER (WHERE "),
+		AggregateOrderByFragment:  []byte(" ORDER BY "),
 		OrderByFragment:           []byte(" ORDER BY "),
 		FetchFragment:             []byte(" "),
 		LimitFragment:             []byte(" LIMIT "),
 		OffsetFragment:            []byte(" OFFSET "),
+		OffsetRowsFragment:        []byte(" ROWS"),
+		FirstFragment:             []byte("FIRST "),
+		SkipFragment:              []byte("SKIP "),
 		ForUpdateFragment:         []byte(" FOR UPDATE "),
 		ForNoKeyUpdateFragment:    []byte(" FOR NO KEY UPDATE "),
 		ForShareFragment:          []byte(" FOR SHARE "),
@@ -473,8 +1641,156 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		OfFragment:                []byte("OF "),
 		NowaitFragment:            []byte("NOWAIT"),
 		SkipLockedFragment:        []byte("SKIP LOCKED"),
+		WaitFragment:              []byte("WAIT "),
+		StraightJoinFragment:      []byte("STRAIGHT_JOIN "),
+		HintBeginFragment:         []byte("/*+ "),
+		HintEndFragment:           []byte(" */ "),
+		AsOfSystemTimeFragment:    []byte(" AS OF SYSTEM TIME "),
+		SupportsQualify:           false,
+		QualifyFragment:           []byte(" QUALIFY "),
+		FinalFragment:             []byte(" FINAL"),
+		SampleFragment:            []byte(" SAMPLE "),
+		PrewhereFragment:          []byte(" PREWHERE "),
+		SettingsFragment:          []byte(" SETTINGS "),
+		CreateTempTableFragment:   []byte("CREATE TEMPORARY TABLE "),
+		OnCommitFragment:          []byte(" ON COMMIT "),
+		CreateTableFragment:       []byte("CREATE TABLE "),
+		IfNotExistsFragment:       []byte("IF NOT EXISTS "),
+		NotNullFragment:           []byte(" NOT NULL"),
+		ColumnDefaultFragment:     []byte(" DEFAULT "),
+		PrimaryKeyFragment:        []byte("PRIMARY KEY"),
+		UniqueFragment:            []byte("UNIQUE"),
+		ConstraintFragment:        []byte("CONSTRAINT "),
+		ForeignKeyFragment:        []byte("FOREIGN KEY"),
+		ReferencesFragment:        []byte(" REFERENCES "),
+		OnDeleteFragment:          []byte(" ON DELETE "),
+		OnUpdateFragment:          []byte(" ON UPDATE "),
+		CheckFragment:             []byte("CHECK "),
+		ExcludeFragment:           []byte("EXCLUDE"),
+		ExcludeWithFragment:       []byte(" WITH "),
+		AutoIncrementFragment:     []byte(" GENERATED BY DEFAULT AS IDENTITY"),
+		AlterTableFragment:        []byte("ALTER TABLE "),
+		AddColumnFragment:         []byte("ADD COLUMN "),
+		DropColumnFragment:        []byte("DROP COLUMN "),
+		RenameColumnFragment:      []byte("RENAME COLUMN "),
+		RenameColumnToFragment:    []byte(" TO "),
+		RenameTableFragment:       []byte("RENAME TO "),
+		AlterColumnTypeFragment:   []byte("ALTER COLUMN "),
+		ColumnTypeFragment:        []byte(" TYPE "),
+		AlterColumnFragment:       []byte("ALTER COLUMN "),
+		SetDefaultFragment:        []byte(" SET DEFAULT "),
+		DropDefaultFragment:       []byte(" DROP DEFAULT"),
+		SetNotNullFragment:        []byte(" SET NOT NULL"),
+		DropNotNullFragment:       []byte(" DROP NOT NULL"),
+		AddConstraintFragment:     []byte("ADD "),
+		PartitionByFragment:       []byte("PARTITION BY "),
+		PartitionOfFragment:       []byte(" PARTITION OF "),
+		PartitionValuesFragment:   []byte(" FOR VALUES"),
+		PartitionRangeToFragment:  []byte(" TO "),
+		AttachPartitionFragment:   []byte("ATTACH PARTITION "),
+		DetachPartitionFragment:   []byte("DETACH PARTITION "),
+		CreateIndexFragment:       []byte("CREATE INDEX "),
+		CreateUniqueIndexFragment: []byte("CREATE UNIQUE INDEX "),
+		ConcurrentlyFragment:      []byte("CONCURRENTLY "),
+		DropIndexFragment:         []byte("DROP INDEX "),
+		DropTableFragment:         []byte("DROP TABLE "),
+		DropViewFragment:          []byte("DROP VIEW "),
+		CreateFragment:            []byte("CREATE "),
+		OrReplaceViewFragment:     []byte("OR REPLACE "),
+		TemporaryViewFragment:     []byte("TEMPORARY "),
+		ViewFragment:              []byte("VIEW "),
+
+		PartitionRemainderFragment: []byte(", REMAINDER "),
+
+		MaterializedViewFragment:        []byte("MATERIALIZED VIEW "),
+		DropMaterializedViewFragment:    []byte("DROP MATERIALIZED VIEW "),
+		RefreshMaterializedViewFragment: []byte("REFRESH MATERIALIZED VIEW "),
+
+		CreateSequenceFragment: []byte("CREATE SEQUENCE "),
+		AlterSequenceFragment:  []byte("ALTER SEQUENCE "),
+		DropSequenceFragment:   []byte("DROP SEQUENCE "),
+		IncrementByFragment:    []byte(" INCREMENT BY "),
+		MinValueFragment:       []byte(" MINVALUE "),
+		NoMinValueFragment:     []byte(" NO MINVALUE"),
+		MaxValueFragment:       []byte(" MAXVALUE "),
+		NoMaxValueFragment:     []byte(" NO MAXVALUE"),
+		StartWithFragment:      []byte(" START WITH "),
+		CycleFragment:          []byte(" CYCLE"),
+		NoCycleFragment:        []byte(" NO CYCLE"),
+		RestartWithFragment:    []byte(" RESTART WITH "),
+		NextValFunction:        []byte("nextval"),
+		CurrValFunction:        []byte("currval"),
+
+		CreateSchemaFragment:        []byte("CREATE SCHEMA "),
+		DropSchemaFragment:          []byte("DROP SCHEMA "),
+		SchemaAuthorizationFragment: []byte(" AUTHORIZATION "),
+		CreateDatabaseFragment:      []byte("CREATE DATABASE "),
+		DropDatabaseFragment:        []byte("DROP DATABASE "),
+		DatabaseOwnerFragment:       []byte(" OWNER "),
+
+		CommentOnFragment: []byte("COMMENT ON "),
+		CommentIsFragment: []byte(" IS "),
+
+		GrantFragment:           []byte("GRANT "),
+		RevokeFragment:          []byte("REVOKE "),
+		GrantToFragment:         []byte(" TO "),
+		RevokeFromFragment:      []byte(" FROM "),
+		WithGrantOptionFragment: []byte(" WITH GRANT OPTION"),
+		GrantOptionForFragment:  []byte("GRANT OPTION FOR "),
+
+		TriggerFragment:               []byte("TRIGGER "),
+		OrReplaceTriggerFragment:      []byte("OR REPLACE "),
+		DropTriggerFragment:           []byte("DROP TRIGGER "),
+		TriggerEventSeparatorFragment: []byte(" OR "),
+		TriggerUpdateOfFragment:       []byte(" OF "),
+		ForEachRowFragment:            []byte(" FOR EACH ROW"),
+		ForEachStatementFragment:      []byte(" FOR EACH STATEMENT"),
+		TriggerWhenFragment:           []byte(" WHEN "),
+		TriggerExecuteFragment:        []byte(" EXECUTE FUNCTION "),
+
+		FunctionFragment:          []byte("FUNCTION "),
+		OrReplaceFunctionFragment: []byte("OR REPLACE "),
+		DropFunctionFragment:      []byte("DROP FUNCTION "),
+		FunctionReturnsFragment:   []byte(" RETURNS "),
+		FunctionLanguageFragment:  []byte(" LANGUAGE "),
+		FunctionBodyFragment:      []byte(" AS $$"),
+		FunctionBodyEndFragment:   []byte("$$"),
+
+		MaterializedCTEFragment:    []byte("MATERIALIZED "),
+		NotMaterializedCTEFragment: []byte("NOT MATERIALIZED "),
+		CTESearchFragment:          []byte(" SEARCH "),
+		CTESearchModeLookup: map[exp.CTESearchMode][]byte{
+			exp.DepthFirstSearch:   []byte("DEPTH FIRST BY "),
+			exp.BreadthFirstSearch: []byte("BREADTH FIRST BY "),
+		},
+		CTECycleFragment:        []byte(" CYCLE "),
+		CTESetFragment:          []byte(" SET "),
+		CTECycleToFragment:      []byte(" TO "),
+		CTECycleDefaultFragment: []byte(" DEFAULT "),
+		CTECycleUsingFragment:   []byte(" USING "),
+
+		ExceptFragment:    []byte(" EXCEPT "),
+		ExceptAllFragment: []byte(" EXCEPT ALL "),
+
+		PivotForFragment: []byte(" FOR "),
+		PivotInFragment:  []byte(" IN "),
+
+		XMLTablePassingFragment:       []byte(" PASSING "),
+		XMLTableColumnsFragment:       []byte(" COLUMNS "),
+		XMLTablePathFragment:          []byte(" PATH "),
+		XMLTableForOrdinalityFragment: []byte(" FOR ORDINALITY"),
+
+		ConnectByStartWithFragment: []byte(" START WITH "),
+		ConnectByFragment:          []byte(" CONNECT BY "),
+		ConnectByNoCycleFragment:   []byte("NOCYCLE "),
+
+		IfExistsFragment:          []byte("IF EXISTS "),
 		LateralFragment:           []byte("LATERAL "),
+		RollupFragment:            []byte("ROLLUP "),
+		CubeFragment:              []byte("CUBE "),
+		GroupingSetsFragment:      []byte("GROUPING SETS "),
 		AsFragment:                []byte(" AS "),
+		TableAliasFragment:        []byte(" AS "),
 		AscFragment:               []byte(" ASC"),
 		DescFragment:              []byte(" DESC"),
 		NullsFirstFragment:        []byte(" NULLS FIRST"),
@@ -489,6 +1805,7 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		ConflictDoUpdateFragment:  []byte(" DO UPDATE SET "),
 		ConflictDoNothingFragment: []byte(" DO NOTHING"),
 		CastFragment:              []byte("CAST"),
+		RandomFunction:            []byte("RANDOM()"),
 		CaseFragment:              []byte("CASE "),
 		WhenFragment:              []byte(" WHEN "),
 		ThenFragment:              []byte(" THEN "),
@@ -498,6 +1815,46 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		True:                      []byte("TRUE"),
 		False:                     []byte("FALSE"),
 
+		MergeFragment:               []byte("MERGE INTO "),
+		MergeWhenMatchedFragment:    []byte(" WHEN MATCHED"),
+		MergeWhenNotMatchedFragment: []byte(" WHEN NOT MATCHED"),
+		MergeConditionFragment:      []byte(" AND "),
+		MergeThenFragment:           []byte(" THEN "),
+		MergeUpdateFragment:         []byte("UPDATE SET "),
+		MergeDeleteFragment:         []byte("DELETE"),
+		MergeInsertFragment:         []byte("INSERT "),
+
+		CallFragment: []byte("CALL "),
+
+		ExplainFragment:        []byte("EXPLAIN "),
+		ExplainAnalyzeFragment: []byte("ANALYZE"),
+		ExplainVerboseFragment: []byte("VERBOSE"),
+		ExplainFormatFragment:  []byte("FORMAT "),
+		ExplainFormats: map[exp.ExplainFormat][]byte{
+			exp.ExplainFormatText: []byte("TEXT"),
+			exp.ExplainFormatJSON: []byte("JSON"),
+			exp.ExplainFormatXML:  []byte("XML"),
+			exp.ExplainFormatYAML: []byte("YAML"),
+		},
+
+		CopyFragment:           []byte("COPY "),
+		CopyFromStdinFragment:  []byte(" FROM STDIN"),
+		CopyFromSourceFragment: []byte(" FROM "),
+		CopyToStdoutFragment:   []byte(" TO STDOUT"),
+		CopyWithFragment:       []byte(" WITH "),
+
+		SetParamFragment: []byte("SET "),
+		SetLocalFragment: []byte("LOCAL "),
+		ShowFragment:     []byte("SHOW "),
+
+		VacuumFragment:                []byte("VACUUM"),
+		AnalyzeFragment:               []byte("ANALYZE"),
+		MaintenanceFullFragment:       []byte("FULL"),
+		MaintenanceFreezeFragment:     []byte("FREEZE"),
+		MaintenanceVerboseFragment:    []byte("VERBOSE"),
+		MaintenanceAnalyzeFragment:    []byte("ANALYZE"),
+		MaintenanceSkipLockedFragment: []byte("SKIP_LOCKED"),
+
 		PlaceHolderFragment: []byte("?"),
 		QuoteRune:           '"',
 		StringQuote:         '\'',
@@ -532,7 +1889,11 @@ func DefaultDialectOptions() *SQLDialectOptions {
 			exp.RegexpNotLikeOp:  []byte("!~"),
 			exp.RegexpILikeOp:    []byte("~*"),
 			exp.RegexpNotILikeOp: []byte("!~*"),
+
+			exp.IsDistinctFromOp:    []byte("IS DISTINCT FROM"),
+			exp.IsNotDistinctFromOp: []byte("IS NOT DISTINCT FROM"),
 		},
+		LikeEscapeFragment: []byte(" ESCAPE "),
 		BitwiseOperatorLookup: map[exp.BitwiseOperation][]byte{
 			exp.BitwiseInversionOp:  []byte("~"),
 			exp.BitwiseOrOp:         []byte("|"),
@@ -545,6 +1906,66 @@ func DefaultDialectOptions() *SQLDialectOptions {
 			exp.BetweenOp:    []byte("BETWEEN"),
 			exp.NotBetweenOp: []byte("NOT BETWEEN"),
 		},
+		IntervalStyle:      QuotedQuantityIntervalStyle,
+		IntervalFragment:   []byte("INTERVAL"),
+		DateAddFragment:    []byte("DATEADD"),
+		AtTimeZoneFragment: []byte(" AT TIME ZONE "),
+		IntervalUnitLookup: map[exp.IntervalUnit][]byte{
+			exp.SecondsIntervalUnit: []byte("SECOND"),
+			exp.MinutesIntervalUnit: []byte("MINUTE"),
+			exp.HoursIntervalUnit:   []byte("HOUR"),
+			exp.DaysIntervalUnit:    []byte("DAY"),
+			exp.MonthsIntervalUnit:  []byte("MONTH"),
+			exp.YearsIntervalUnit:   []byte("YEAR"),
+		},
+		DataTypeLookup: map[exp.DataTypeKind][]byte{
+			exp.SmallIntDataType:    []byte("SMALLINT"),
+			exp.IntegerDataType:     []byte("INTEGER"),
+			exp.BigIntDataType:      []byte("BIGINT"),
+			exp.DecimalDataType:     []byte("DECIMAL"),
+			exp.RealDataType:        []byte("REAL"),
+			exp.DoubleDataType:      []byte("DOUBLE PRECISION"),
+			exp.BooleanDataType:     []byte("BOOLEAN"),
+			exp.CharDataType:        []byte("CHAR"),
+			exp.VarcharDataType:     []byte("VARCHAR"),
+			exp.TextDataType:        []byte("TEXT"),
+			exp.DateDataType:        []byte("DATE"),
+			exp.TimeDataType:        []byte("TIME"),
+			exp.TimestampDataType:   []byte("TIMESTAMP"),
+			exp.TimestampTzDataType: []byte("TIMESTAMP WITH TIME ZONE"),
+			exp.BinaryDataType:      []byte("BLOB"),
+			exp.UUIDDataType:        []byte("UUID"),
+			exp.JSONDataType:        []byte("JSON"),
+		},
+		ViewCheckOptionLookup: map[exp.ViewCheckOption][]byte{
+			exp.DefaultViewCheckOption:  []byte(" WITH CHECK OPTION"),
+			exp.CascadedViewCheckOption: []byte(" WITH CASCADED CHECK OPTION"),
+			exp.LocalViewCheckOption:    []byte(" WITH LOCAL CHECK OPTION"),
+		},
+		PartitionBoundLookup: map[exp.PartitionBoundType][]byte{
+			exp.RangePartitionBound:   []byte(" FROM "),
+			exp.ListPartitionBound:    []byte(" IN "),
+			exp.HashPartitionBound:    []byte(" WITH (MODULUS "),
+			exp.DefaultPartitionBound: []byte(" DEFAULT"),
+		},
+		ReferentialActionLookup: map[exp.ReferentialAction][]byte{
+			exp.RestrictReferentialAction:   []byte("RESTRICT"),
+			exp.CascadeReferentialAction:    []byte("CASCADE"),
+			exp.SetNullReferentialAction:    []byte("SET NULL"),
+			exp.SetDefaultReferentialAction: []byte("SET DEFAULT"),
+			exp.NoActionReferentialAction:   []byte("NO ACTION"),
+		},
+		TriggerTimingLookup: map[exp.TriggerTiming][]byte{
+			exp.BeforeTriggerTiming:    []byte(" BEFORE "),
+			exp.AfterTriggerTiming:     []byte(" AFTER "),
+			exp.InsteadOfTriggerTiming: []byte(" INSTEAD OF "),
+		},
+		TriggerEventLookup: map[exp.TriggerEvent][]byte{
+			exp.InsertTriggerEvent:   []byte("INSERT"),
+			exp.UpdateTriggerEvent:   []byte("UPDATE"),
+			exp.DeleteTriggerEvent:   []byte("DELETE"),
+			exp.TruncateTriggerEvent: []byte("TRUNCATE"),
+		},
 		JoinTypeLookup: map[exp.JoinType][]byte{
 			exp.InnerJoinType:        []byte(" INNER JOIN "),
 			exp.FullOuterJoinType:    []byte(" FULL OUTER JOIN "),
@@ -564,6 +1985,8 @@ func DefaultDialectOptions() *SQLDialectOptions {
 
 		BooleanDataTypeSupported: true,
 		UseLiteralIsBools:        true,
+		EmulateIsBools:           false,
+		BoolArgsAsInts:           false,
 
 		EscapedRunes: map[rune][]byte{
 			'\'': []byte("''"),
@@ -614,5 +2037,62 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		TruncateSQLOrder: []SQLFragmentType{
 			TruncateSQLFragment,
 		},
+		MergeSQLOrder: []SQLFragmentType{
+			MergeSQLFragment,
+		},
+		CallSQLOrder: []SQLFragmentType{
+			CallSQLFragment,
+		},
+		CopySQLOrder: []SQLFragmentType{
+			CopySQLFragment,
+		},
+		SetParamSQLOrder: []SQLFragmentType{
+			SetParamSQLFragment,
+		},
+		ShowSQLOrder: []SQLFragmentType{
+			ShowSQLFragment,
+		},
+		MaintenanceSQLOrder: []SQLFragmentType{
+			MaintenanceSQLFragment,
+		},
+		CreateTableSQLOrder: []SQLFragmentType{
+			CreateTableSQLFragment,
+		},
+		AlterTableSQLOrder: []SQLFragmentType{
+			AlterTableSQLFragment,
+		},
+		CreateIndexSQLOrder: []SQLFragmentType{
+			CreateIndexSQLFragment,
+		},
+		DropSQLOrder: []SQLFragmentType{
+			DropSQLFragment,
+		},
+		CreateViewSQLOrder: []SQLFragmentType{
+			CreateViewSQLFragment,
+		},
+		RefreshSQLOrder: []SQLFragmentType{
+			RefreshSQLFragment,
+		},
+		CreateSequenceSQLOrder: []SQLFragmentType{
+			CreateSequenceSQLFragment,
+		},
+		AlterSequenceSQLOrder: []SQLFragmentType{
+			AlterSequenceSQLFragment,
+		},
+		CreateSchemaSQLOrder: []SQLFragmentType{
+			CreateSchemaSQLFragment,
+		},
+		CommentSQLOrder: []SQLFragmentType{
+			CommentSQLFragment,
+		},
+		GrantSQLOrder: []SQLFragmentType{
+			GrantSQLFragment,
+		},
+		CreateTriggerSQLOrder: []SQLFragmentType{
+			CreateTriggerSQLFragment,
+		},
+		CreateFunctionSQLOrder: []SQLFragmentType{
+			CreateFunctionSQLFragment,
+		},
 	}
 }
@@ -38,6 +38,34 @@ func (sfts *sqlFragmentTypeSuite) TestOptions_SQLFragmentType() {
 		{typ: sqlgen.DeleteBeginSQLFragment, expectedStr: "DeleteBeginSQLFragment"},
 		{typ: sqlgen.TruncateSQLFragment, expectedStr: "TruncateSQLFragment"},
 		{typ: sqlgen.WindowSQLFragment, expectedStr: "WindowSQLFragment"},
+		{typ: sqlgen.OffsetFetchSQLFragment, expectedStr: "OffsetFetchSQLFragment"},
+		{typ: sqlgen.SelectWithFirstSkipSQLFragment, expectedStr: "SelectWithFirstSkipSQLFragment"},
+		{typ: sqlgen.AsOfSystemTimeSQLFragment, expectedStr: "AsOfSystemTimeSQLFragment"},
+		{typ: sqlgen.FinalSQLFragment, expectedStr: "FinalSQLFragment"},
+		{typ: sqlgen.SampleSQLFragment, expectedStr: "SampleSQLFragment"},
+		{typ: sqlgen.PrewhereSQLFragment, expectedStr: "PrewhereSQLFragment"},
+		{typ: sqlgen.SettingsSQLFragment, expectedStr: "SettingsSQLFragment"},
+		{typ: sqlgen.QualifySQLFragment, expectedStr: "QualifySQLFragment"},
+		{typ: sqlgen.CreateTableSQLFragment, expectedStr: "CreateTableSQLFragment"},
+		{typ: sqlgen.AlterTableSQLFragment, expectedStr: "AlterTableSQLFragment"},
+		{typ: sqlgen.CreateIndexSQLFragment, expectedStr: "CreateIndexSQLFragment"},
+		{typ: sqlgen.DropSQLFragment, expectedStr: "DropSQLFragment"},
+		{typ: sqlgen.CreateViewSQLFragment, expectedStr: "CreateViewSQLFragment"},
+		{typ: sqlgen.RefreshSQLFragment, expectedStr: "RefreshSQLFragment"},
+		{typ: sqlgen.CreateSequenceSQLFragment, expectedStr: "CreateSequenceSQLFragment"},
+		{typ: sqlgen.AlterSequenceSQLFragment, expectedStr: "AlterSequenceSQLFragment"},
+		{typ: sqlgen.CreateSchemaSQLFragment, expectedStr: "CreateSchemaSQLFragment"},
+		{typ: sqlgen.CommentSQLFragment, expectedStr: "CommentSQLFragment"},
+		{typ: sqlgen.GrantSQLFragment, expectedStr: "GrantSQLFragment"},
+		{typ: sqlgen.CreateTriggerSQLFragment, expectedStr: "CreateTriggerSQLFragment"},
+		{typ: sqlgen.CreateFunctionSQLFragment, expectedStr: "CreateFunctionSQLFragment"},
+		{typ: sqlgen.MergeSQLFragment, expectedStr: "MergeSQLFragment"},
+		{typ: sqlgen.CallSQLFragment, expectedStr: "CallSQLFragment"},
+		{typ: sqlgen.CopySQLFragment, expectedStr: "CopySQLFragment"},
+		{typ: sqlgen.SetParamSQLFragment, expectedStr: "SetParamSQLFragment"},
+		{typ: sqlgen.ShowSQLFragment, expectedStr: "ShowSQLFragment"},
+		{typ: sqlgen.MaintenanceSQLFragment, expectedStr: "MaintenanceSQLFragment"},
+		{typ: sqlgen.ConnectBySQLFragment, expectedStr: "ConnectBySQLFragment"},
 		{typ: sqlgen.SQLFragmentType(10000), expectedStr: "10000"},
 	} {
 		sfts.Equal(tt.expectedStr, tt.typ.String())
@@ -25,6 +25,18 @@ type (
 
 var errNoSourceForTruncate = errors.New("no source found when generating truncate sql")
 
+func errMultipleTruncateTablesNotSupported(dialect string) error {
+	return errors.New("dialect does not support multiple tables in TRUNCATE [dialect=%s]", dialect)
+}
+
+func errTruncateIdentityNotSupported(dialect string) error {
+	return errors.New("dialect does not support IDENTITY in TRUNCATE [dialect=%s]", dialect)
+}
+
+func errTruncateCascadeNotSupported(dialect string) error {
+	return errors.New("dialect does not support CASCADE or RESTRICT in TRUNCATE [dialect=%s]", dialect)
+}
+
 func NewTruncateSQLGenerator(dialect string, do *SQLDialectOptions) TruncateSQLGenerator {
 	return &truncateSQLGenerator{NewCommonSQLGenerator(dialect, do)}
 }
@@ -49,6 +61,18 @@ func (tsg *truncateSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.TruncateC
 
 // Generates a TRUNCATE statement
 func (tsg *truncateSQLGenerator) TruncateSQL(b sb.SQLBuilder, from exp.ColumnListExpression, opts exp.TruncateOptions) {
+	do := tsg.DialectOptions()
+	switch {
+	case !do.SupportsMultipleTruncateTables && len(from.Columns()) > 1:
+		b.SetError(errMultipleTruncateTablesNotSupported(tsg.Dialect()))
+		return
+	case !do.SupportsTruncateIdentity && opts.Identity != do.EmptyString:
+		b.SetError(errTruncateIdentityNotSupported(tsg.Dialect()))
+		return
+	case !do.SupportsTruncateCascade && (opts.Cascade || opts.Restrict):
+		b.SetError(errTruncateCascadeNotSupported(tsg.Dialect()))
+		return
+	}
 	b.Write(tsg.DialectOptions().TruncateClause)
 	tsg.SourcesSQL(b, from)
 	if opts.Identity != tsg.DialectOptions().EmptyString {
@@ -61,4 +85,5 @@ func (tsg *truncateSQLGenerator) TruncateSQL(b sb.SQLBuilder, from exp.ColumnLis
 	} else if opts.Restrict {
 		b.Write(tsg.DialectOptions().RestrictFragment)
 	}
+	b.Write(tsg.DialectOptions().TruncateSuffixFragment)
 }
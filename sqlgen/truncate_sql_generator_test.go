@@ -116,6 +116,53 @@ func (tsgs *truncateSQLGeneratorSuite) TestGenerate_WithCascade() {
 	)
 }
 
+func (tsgs *truncateSQLGeneratorSuite) TestGenerate_WithSuffix() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.TruncateSuffixFragment = []byte(" immediate")
+
+	tc := exp.NewTruncateClauses().SetTable(exp.NewColumnListExpression("a"))
+	tcCascade := tc.SetOptions(exp.TruncateOptions{Cascade: true})
+
+	tsgs.assertCases(
+		sqlgen.NewTruncateSQLGenerator("test", opts),
+		truncateTestCase{clause: tc, sql: `TRUNCATE "a" immediate`},
+		truncateTestCase{clause: tc, sql: `TRUNCATE "a" immediate`, isPrepared: true},
+
+		truncateTestCase{clause: tcCascade, sql: `TRUNCATE "a" CASCADE immediate`},
+	)
+}
+
+func (tsgs *truncateSQLGeneratorSuite) TestGenerate_WithUnsupportedOptions() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsMultipleTruncateTables = false
+	opts.SupportsTruncateIdentity = false
+	opts.SupportsTruncateCascade = false
+
+	tc := exp.NewTruncateClauses().SetTable(exp.NewColumnListExpression("a"))
+	tcMulti := exp.NewTruncateClauses().SetTable(exp.NewColumnListExpression("a", "b"))
+	tcCascade := tc.SetOptions(exp.TruncateOptions{Cascade: true})
+	tcRestrict := tc.SetOptions(exp.TruncateOptions{Restrict: true})
+	tcRestart := tc.SetOptions(exp.TruncateOptions{Identity: "restart"})
+
+	expectedMultiErr := "goqu: dialect does not support multiple tables in TRUNCATE [dialect=test]"
+	expectedCascadeErr := "goqu: dialect does not support CASCADE or RESTRICT in TRUNCATE [dialect=test]"
+	expectedIdentityErr := "goqu: dialect does not support IDENTITY in TRUNCATE [dialect=test]"
+	tsgs.assertCases(
+		sqlgen.NewTruncateSQLGenerator("test", opts),
+		truncateTestCase{clause: tc, sql: `TRUNCATE "a"`},
+		truncateTestCase{clause: tc, sql: `TRUNCATE "a"`, isPrepared: true},
+
+		truncateTestCase{clause: tcMulti, err: expectedMultiErr},
+		truncateTestCase{clause: tcMulti, err: expectedMultiErr, isPrepared: true},
+
+		truncateTestCase{clause: tcCascade, err: expectedCascadeErr},
+		truncateTestCase{clause: tcRestrict, err: expectedCascadeErr, isPrepared: true},
+
+		truncateTestCase{clause: tcRestart, err: expectedIdentityErr},
+		truncateTestCase{clause: tcRestart, err: expectedIdentityErr, isPrepared: true},
+	)
+}
+
 func TestTruncateSQLGenerator(t *testing.T) {
 	suite.Run(t, new(truncateSQLGeneratorSuite))
 }
@@ -55,7 +55,7 @@ func (usg *updateSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.UpdateClaus
 		case CommonTableSQLFragment:
 			usg.ExpressionSQLGenerator().Generate(b, clauses.CommonTables())
 		case UpdateBeginSQLFragment:
-			usg.UpdateBeginSQL(b)
+			usg.UpdateBeginSQL(b, clauses.Hints())
 		case SourcesSQLFragment:
 			usg.updateTableSQL(b, clauses)
 		case UpdateSQLFragment:
@@ -73,16 +73,25 @@ func (usg *updateSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.UpdateClaus
 				usg.LimitSQL(b, clauses.Limit())
 			}
 		case ReturningSQLFragment:
-			usg.ReturningSQL(b, clauses.Returning())
+			usg.updateReturningSQL(b, clauses.Returning())
 		default:
 			b.SetError(ErrNotSupportedFragment("UPDATE", f))
 		}
 	}
 }
 
+func (usg *updateSQLGenerator) updateReturningSQL(b sb.SQLBuilder, returns exp.ColumnListExpression) {
+	if !usg.DialectOptions().SupportsReturnOnUpdate && returns != nil && !returns.IsEmpty() {
+		b.SetError(errors.New("dialect does not support RETURNING clause on UPDATE [dialect=%s]", usg.Dialect()))
+		return
+	}
+	usg.ReturningSQL(b, returns)
+}
+
 // Adds the correct fragment to being an UPDATE statement
-func (usg *updateSQLGenerator) UpdateBeginSQL(b sb.SQLBuilder) {
+func (usg *updateSQLGenerator) UpdateBeginSQL(b sb.SQLBuilder, hints []string) {
 	b.Write(usg.DialectOptions().UpdateClause)
+	usg.HintsSQL(b, hints)
 }
 
 // Adds column setters in an update SET clause
@@ -93,11 +102,11 @@ func (usg *updateSQLGenerator) UpdateExpressionsSQL(b sb.SQLBuilder, updates ...
 
 func (usg *updateSQLGenerator) updateTableSQL(b sb.SQLBuilder, uc exp.UpdateClauses) {
 	b.WriteRunes(usg.DialectOptions().SpaceRune)
-	usg.ExpressionSQLGenerator().Generate(b, uc.Table())
+	usg.SourcesExpressionSQLGenerator().Generate(b, uc.Table())
 	if uc.HasFrom() {
 		if !usg.DialectOptions().UseFromClauseForMultipleUpdateTables {
 			b.WriteRunes(usg.DialectOptions().CommaRune)
-			usg.ExpressionSQLGenerator().Generate(b, uc.From())
+			usg.SourcesExpressionSQLGenerator().Generate(b, uc.From())
 		}
 	}
 }
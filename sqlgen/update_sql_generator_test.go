@@ -220,6 +220,57 @@ func (usgs *updateSQLGeneratorSuite) TestGenerate_withLimit() {
 	)
 }
 
+func (usgs *updateSQLGeneratorSuite) TestGenerate_withHints() {
+	uc := exp.NewUpdateClauses().
+		SetTable(exp.NewIdentifierExpression("", "test", "")).
+		SetSetValues(exp.Record{"a": "b"}).
+		SetHints([]string{"a(b)", "c(d)"})
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsOptimizerHints = true
+	usgs.assertCases(
+		sqlgen.NewUpdateSQLGenerator("test", opts),
+		updateTestCase{clause: uc, sql: `UPDATE /*+ a(b) c(d) */ "test" SET "a"='b'`},
+		updateTestCase{clause: uc, sql: `UPDATE /*+ a(b) c(d) */ "test" SET "a"=?`, isPrepared: true, args: []interface{}{"b"}},
+		updateTestCase{
+			clause: uc.SetHints([]string{"a(b) */ SET x = 1; /*"}),
+			err:    `goqu: optimizer hint "a(b) */ SET x = 1; /*" must not contain */ [dialect=test]`,
+		},
+	)
+
+	opts.SupportsOptimizerHints = false
+	expectedErr := "goqu: dialect does not support optimizer hints [dialect=test]"
+	usgs.assertCases(
+		sqlgen.NewUpdateSQLGenerator("test", opts),
+		updateTestCase{clause: uc, err: expectedErr},
+		updateTestCase{clause: uc, err: expectedErr, isPrepared: true},
+		updateTestCase{clause: uc.SetHints(nil), sql: `UPDATE "test" SET "a"='b'`},
+	)
+}
+
+func (usgs *updateSQLGeneratorSuite) TestGenerate_withReturning() {
+	uc := exp.NewUpdateClauses().
+		SetTable(exp.NewIdentifierExpression("", "test", "")).
+		SetSetValues(exp.Record{"a": "b"}).
+		SetReturning(exp.NewColumnListExpression("a", "b"))
+
+	opts := sqlgen.DefaultDialectOptions()
+	usgs.assertCases(
+		sqlgen.NewUpdateSQLGenerator("test", opts),
+		updateTestCase{clause: uc, sql: `UPDATE "test" SET "a"='b' RETURNING "a", "b"`},
+		updateTestCase{clause: uc, sql: `UPDATE "test" SET "a"=? RETURNING "a", "b"`, isPrepared: true, args: []interface{}{"b"}},
+	)
+
+	opts.SupportsReturnOnUpdate = false
+	expectedErr := `goqu: dialect does not support RETURNING clause on UPDATE [dialect=test]`
+	usgs.assertCases(
+		sqlgen.NewUpdateSQLGenerator("test", opts),
+		updateTestCase{clause: uc, err: expectedErr},
+		updateTestCase{clause: uc, err: expectedErr, isPrepared: true},
+		updateTestCase{clause: uc.SetReturning(nil), sql: `UPDATE "test" SET "a"='b'`},
+	)
+}
+
 func (usgs *updateSQLGeneratorSuite) TestGenerate_withCommonTables() {
 	tse := newTestAppendableExpression("select * from foo", emptyArgs, nil, nil)
 	uc := exp.NewUpdateClauses().
@@ -0,0 +1,311 @@
+package goqu
+
+import (
+	"database/sql"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/tag"
+	"github.com/doug-martin/goqu/v9/internal/util"
+)
+
+type (
+	// TableNamer can be implemented by a struct to set the name of the table created by TableFromStruct and
+	// IndexesFromStruct. If a struct does not implement TableNamer the name of the struct is used, renamed using the
+	// column rename function (see SetColumnRenameFunction).
+	TableNamer interface {
+		TableName() string
+	}
+
+	// used internally to describe the table of a struct.
+	structTable struct {
+		name        string
+		columns     []exp.ColumnDefinition
+		primaryKeys []string
+		indexes     []structIndex
+	}
+	// used internally to describe an index of a struct.
+	structIndex struct {
+		name    string
+		unique  bool
+		columns []string
+	}
+)
+
+const (
+	sqlTypeTagName       = "sqltype"
+	primaryKeyTagName    = "primarykey"
+	autoIncrementTagName = "autoincrement"
+	notNullTagName       = "notnull"
+	nullTagName          = "null"
+	uniqueTagName        = "unique"
+	indexTagName         = "index"
+	uniqueIndexTagName   = "uniqueindex"
+)
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	bytesType     = reflect.TypeOf([]byte{})
+	nullDataTypes = map[reflect.Type]exp.DataType{
+		reflect.TypeOf(sql.NullBool{}):    BooleanType(),
+		reflect.TypeOf(sql.NullByte{}):    SmallIntType(),
+		reflect.TypeOf(sql.NullInt16{}):   SmallIntType(),
+		reflect.TypeOf(sql.NullInt32{}):   IntegerType(),
+		reflect.TypeOf(sql.NullInt64{}):   BigIntType(),
+		reflect.TypeOf(sql.NullFloat64{}): DoubleType(),
+		reflect.TypeOf(sql.NullString{}):  VarcharType(255),
+		reflect.TypeOf(sql.NullTime{}):    TimestampType(),
+	}
+)
+
+func errUnsupportedStructType(t reflect.Type) error {
+	return errors.New("unsupported type %v, a struct or a pointer to a struct is required", t)
+}
+
+func errUnsupportedFieldType(field string, t reflect.Type) error {
+	return errors.New("unable to determine the data type of field %s with type %v, use the sqltype tag", field, t)
+}
+
+// TableFromStruct creates a CreateTableDataset with a column for each field of the struct. The columns are named
+// and ignored using the db tag, the same way as when inserting or scanning a struct.
+//
+// The data type of a column is determined by the go type of the field (e.g. int64 is BIGINT, string is
+// VARCHAR(255), time.Time is TIMESTAMP), use the sqltype tag to set the type as is (e.g. sqltype:"NUMERIC(10, 2)").
+// Columns are NOT NULL unless the field is a pointer, a slice or one of the sql.Null types.
+//
+// The goqu tag supports the following options.
+//
+// primarykey: The column is part of the PRIMARY KEY, a PRIMARY KEY constraint is added if there are several
+// autoincrement: The values of the column are generated (e.g. AUTO_INCREMENT, IDENTITY)
+// notnull: Adds NOT NULL to the column
+// null: Removes NOT NULL from the column
+// unique: Adds UNIQUE to the column
+// index, uniqueindex: See IndexesFromStruct
+//
+//	type User struct {
+//		ID        int64     `db:"id" goqu:"primarykey,autoincrement"`
+//		Email     string    `db:"email" goqu:"unique"`
+//		Name      *string   `db:"name"`
+//		Balance   float64   `db:"balance" sqltype:"NUMERIC(10, 2)"`
+//		CreatedAt time.Time `db:"created_at"`
+//	}
+//	goqu.TableFromStruct(&User{})
+//	// CREATE TABLE "user" ("id" BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, "email" VARCHAR(255) NOT NULL UNIQUE,
+//	// "name" VARCHAR(255), "balance" NUMERIC(10, 2) NOT NULL, "created_at" TIMESTAMP NOT NULL)
+func TableFromStruct(i interface{}) *CreateTableDataset {
+	return tableFromStruct(newCreateTableDataset("default", nil), i)
+}
+
+// IndexesFromStruct creates a CreateIndexDataset for each index of the struct, use TableFromStruct to create the
+// table. An index is added to a column with the index and uniqueindex options of the goqu tag.
+//
+// index: Creates an index on the column named <table>_<column>_idx
+// index=<name>: Creates an index with the name, the columns of the fields with the same name are added in the order of
+// the fields
+// uniqueindex, uniqueindex=<name>: Same as index but creates a UNIQUE index
+//
+//	type Order struct {
+//		ID         int64     `db:"id" goqu:"primarykey"`
+//		CustomerID int64     `db:"customer_id" goqu:"index=order_customer_created_idx"`
+//		CreatedAt  time.Time `db:"created_at" goqu:"index=order_customer_created_idx"`
+//		Number     string    `db:"number" goqu:"uniqueindex"`
+//	}
+//	goqu.IndexesFromStruct(&Order{})
+//	// CREATE INDEX "order_customer_created_idx" ON "order" ("customer_id", "created_at")
+//	// CREATE UNIQUE INDEX "order_number_idx" ON "order" ("number")
+func IndexesFromStruct(i interface{}) ([]*CreateIndexDataset, error) {
+	return indexesFromStruct(i, func(name string) *CreateIndexDataset {
+		return newCreateIndexDataset("default", nil).Name(name)
+	})
+}
+
+// used internally to set the table, columns and constraints of a CreateTableDataset from a struct.
+func tableFromStruct(ctd *CreateTableDataset, i interface{}) *CreateTableDataset {
+	st, err := getStructTable(i)
+	if err != nil {
+		return ctd.SetError(err)
+	}
+	ctd = ctd.Table(st.name).Columns(st.columns...)
+	if len(st.primaryKeys) > 1 {
+		ctd = ctd.Constraints(PrimaryKey(st.primaryKeys...))
+	}
+	return ctd
+}
+
+// used internally to create the indexes of a struct using the create function.
+func indexesFromStruct(i interface{}, create func(name string) *CreateIndexDataset) ([]*CreateIndexDataset, error) {
+	st, err := getStructTable(i)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make([]*CreateIndexDataset, 0, len(st.indexes))
+	for _, idx := range st.indexes {
+		cols := make([]interface{}, 0, len(idx.columns))
+		for _, col := range idx.columns {
+			cols = append(cols, col)
+		}
+		ds := create(idx.name).On(st.name).Columns(cols...)
+		if idx.unique {
+			ds = ds.Unique()
+		}
+		indexes = append(indexes, ds)
+	}
+	return indexes, nil
+}
+
+func getStructTable(i interface{}) (*structTable, error) {
+	t := reflect.TypeOf(i)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, errUnsupportedStructType(reflect.TypeOf(i))
+	}
+	cm, err := util.GetColumnMap(reflect.New(t).Interface())
+	if err != nil {
+		return nil, err
+	}
+	st := &structTable{name: structTableName(t)}
+	for _, cd := range columnsInFieldOrder(cm) {
+		f := t.FieldByIndex(cd.FieldIndex)
+		col, err := structColumn(&f, cd.ColumnName)
+		if err != nil {
+			return nil, err
+		}
+		goquTag := tag.New("goqu", f.Tag)
+		if goquTag.Contains(primaryKeyTagName) {
+			st.primaryKeys = append(st.primaryKeys, cd.ColumnName)
+		}
+		st.columns = append(st.columns, col)
+		st.addIndexes(cd.ColumnName, goquTag)
+	}
+	if len(st.primaryKeys) == 1 {
+		for i, col := range st.columns {
+			if col.Name() == st.primaryKeys[0] {
+				st.columns[i] = col.PrimaryKey()
+			}
+		}
+	}
+	return st, nil
+}
+
+// returns the name of the table of a struct, see TableNamer.
+func structTableName(t reflect.Type) string {
+	if tn, ok := reflect.New(t).Interface().(TableNamer); ok {
+		return tn.TableName()
+	}
+	return util.RenameColumn(t.Name())
+}
+
+// returns the columns in the order the fields are declared in the struct.
+func columnsInFieldOrder(cm util.ColumnMap) []util.ColumnData {
+	cols := make([]util.ColumnData, 0, len(cm))
+	for _, cd := range cm {
+		cols = append(cols, cd)
+	}
+	sort.Slice(cols, func(i, j int) bool {
+		a, b := cols[i].FieldIndex, cols[j].FieldIndex
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+	return cols
+}
+
+func structColumn(f *reflect.StructField, name string) (exp.ColumnDefinition, error) {
+	goquTag := tag.New("goqu", f.Tag)
+	dt, nullable := structDataType(f.Type)
+	if sqlType := f.Tag.Get(sqlTypeTagName); sqlType != "" {
+		dt = CustomType(sqlType)
+	}
+	if dt == nil {
+		return nil, errUnsupportedFieldType(f.Name, f.Type)
+	}
+	col := ColumnDef(name, dt)
+	notNull := !nullable && !goquTag.Contains(primaryKeyTagName)
+	if goquTag.Contains(notNullTagName) {
+		notNull = true
+	} else if goquTag.Contains(nullTagName) {
+		notNull = false
+	}
+	if notNull {
+		col = col.NotNull()
+	}
+	if goquTag.Contains(uniqueTagName) {
+		col = col.Unique()
+	}
+	if goquTag.Contains(autoIncrementTagName) {
+		col = col.AutoIncrement()
+	}
+	return col, nil
+}
+
+// returns the data type of a go type and true if the column can contain NULL values, the data type is nil if the
+// type cannot be mapped.
+func structDataType(t reflect.Type) (dt exp.DataType, nullable bool) {
+	if t.Kind() == reflect.Ptr {
+		dt, _ = structDataType(t.Elem())
+		return dt, true
+	}
+	if dt, ok := nullDataTypes[t]; ok {
+		return dt, true
+	}
+	switch {
+	case t == timeType:
+		return TimestampType(), false
+	case t.ConvertibleTo(bytesType) && t.Kind() == reflect.Slice:
+		return BinaryType(), true
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return BooleanType(), false
+	case reflect.Int8, reflect.Int16, reflect.Uint8:
+		return SmallIntType(), false
+	case reflect.Int32, reflect.Uint16:
+		return IntegerType(), false
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return BigIntType(), false
+	case reflect.Float32:
+		return RealType(), false
+	case reflect.Float64:
+		return DoubleType(), false
+	case reflect.String:
+		return VarcharType(255), false
+	default:
+		return nil, false
+	}
+}
+
+// adds the column to the indexes of the index and uniqueindex options.
+func (st *structTable) addIndexes(column string, goquTag tag.Options) {
+	for _, opt := range goquTag.Values() {
+		key, name := opt, ""
+		if idx := strings.Index(opt, "="); idx >= 0 {
+			key, name = opt[:idx], opt[idx+1:]
+		}
+		if key != indexTagName && key != uniqueIndexTagName {
+			continue
+		}
+		if name == "" {
+			name = st.name + "_" + column + "_idx"
+		}
+		st.addIndex(name, key == uniqueIndexTagName, column)
+	}
+}
+
+func (st *structTable) addIndex(name string, unique bool, column string) {
+	for i := range st.indexes {
+		if st.indexes[i].name == name {
+			st.indexes[i].columns = append(st.indexes[i].columns, column)
+			return
+		}
+	}
+	st.indexes = append(st.indexes, structIndex{name: name, unique: unique, columns: []string{column}})
+}
@@ -0,0 +1,191 @@
+package goqu_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	tableFromStructSuite struct {
+		suite.Suite
+	}
+	tfsBase struct {
+		ID        int64     `db:"id" goqu:"primarykey,autoincrement"`
+		CreatedAt time.Time `db:"created_at" goqu:"skipinsert"`
+	}
+	tfsNamedTable struct {
+		Name string `db:"name"`
+	}
+)
+
+func (tfsNamedTable) TableName() string {
+	return "named_table"
+}
+
+func TestTableFromStructSuite(t *testing.T) {
+	suite.Run(t, new(tableFromStructSuite))
+}
+
+func (tfs *tableFromStructSuite) assertSQL(ds *goqu.CreateTableDataset, expectedSQL string) {
+	sql, args, err := ds.ToSQL()
+	tfs.NoError(err)
+	tfs.Empty(args)
+	tfs.Equal(expectedSQL, sql)
+}
+
+func (tfs *tableFromStructSuite) TestTableFromStruct() {
+	type User struct {
+		ID      int64   `db:"id" goqu:"primarykey,autoincrement"`
+		Email   string  `db:"email" goqu:"unique"`
+		Name    *string `db:"name"`
+		Balance float64 `db:"balance" sqltype:"NUMERIC(10, 2)"`
+		Ignored string  `db:"-"`
+		Active  bool
+	}
+	tfs.assertSQL(
+		goqu.TableFromStruct(&User{}),
+		`CREATE TABLE "user" ("id" BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, "email" VARCHAR(255) NOT NULL UNIQUE, `+
+			`"name" VARCHAR(255), "balance" NUMERIC(10, 2) NOT NULL, "active" BOOLEAN NOT NULL)`,
+	)
+	tfs.assertSQL(goqu.TableFromStruct(User{}).Table("users").IfNotExists(),
+		`CREATE TABLE IF NOT EXISTS "users" ("id" BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, `+
+			`"email" VARCHAR(255) NOT NULL UNIQUE, "name" VARCHAR(255), "balance" NUMERIC(10, 2) NOT NULL, "active" BOOLEAN NOT NULL)`,
+	)
+}
+
+func (tfs *tableFromStructSuite) TestTableFromStruct_dataTypes() {
+	type item struct {
+		Bool    bool            `db:"bool"`
+		Int8    int8            `db:"int8"`
+		Int16   int16           `db:"int16"`
+		Int32   int32           `db:"int32"`
+		Int     int             `db:"int"`
+		Uint64  uint64          `db:"uint64"`
+		Float32 float32         `db:"float32"`
+		Float64 float64         `db:"float64"`
+		String  string          `db:"string"`
+		Time    time.Time       `db:"time"`
+		Bytes   []byte          `db:"bytes"`
+		IntPtr  *int64          `db:"int_ptr"`
+		TimePtr *time.Time      `db:"time_ptr"`
+		NullStr sql.NullString  `db:"null_str"`
+		NullInt sql.NullInt64   `db:"null_int"`
+		NullF64 sql.NullFloat64 `db:"null_f64"`
+		NullT   sql.NullTime    `db:"null_t"`
+		NullB   sql.NullBool    `db:"null_b"`
+	}
+	tfs.assertSQL(
+		goqu.TableFromStruct(&item{}),
+		`CREATE TABLE "item" ("bool" BOOLEAN NOT NULL, "int8" SMALLINT NOT NULL, "int16" SMALLINT NOT NULL, `+
+			`"int32" INTEGER NOT NULL, "int" BIGINT NOT NULL, "uint64" BIGINT NOT NULL, "float32" REAL NOT NULL, `+
+			`"float64" DOUBLE PRECISION NOT NULL, "string" VARCHAR(255) NOT NULL, "time" TIMESTAMP NOT NULL, "bytes" BLOB, `+
+			`"int_ptr" BIGINT, "time_ptr" TIMESTAMP, "null_str" VARCHAR(255), "null_int" BIGINT, "null_f64" DOUBLE PRECISION, `+
+			`"null_t" TIMESTAMP, "null_b" BOOLEAN)`,
+	)
+}
+
+func (tfs *tableFromStructSuite) TestTableFromStruct_nullability() {
+	type item struct {
+		Name   string  `db:"name" goqu:"null"`
+		Email  *string `db:"email" goqu:"notnull"`
+		Custom string  `db:"custom" sqltype:"CITEXT" goqu:"null"`
+	}
+	tfs.assertSQL(
+		goqu.TableFromStruct(&item{}),
+		`CREATE TABLE "item" ("name" VARCHAR(255), "email" VARCHAR(255) NOT NULL, "custom" CITEXT)`,
+	)
+}
+
+func (tfs *tableFromStructSuite) TestTableFromStruct_compositePrimaryKey() {
+	type userRole struct {
+		UserID int64  `db:"user_id" goqu:"primarykey"`
+		RoleID int64  `db:"role_id" goqu:"primarykey"`
+		Note   string `db:"note"`
+	}
+	tfs.assertSQL(
+		goqu.TableFromStruct(&userRole{}),
+		`CREATE TABLE "userrole" ("user_id" BIGINT, "role_id" BIGINT, "note" VARCHAR(255) NOT NULL, `+
+			`PRIMARY KEY ("user_id", "role_id"))`,
+	)
+}
+
+func (tfs *tableFromStructSuite) TestTableFromStruct_embeddedStruct() {
+	type item struct {
+		tfsBase
+		Name string `db:"name"`
+	}
+	tfs.assertSQL(
+		goqu.TableFromStruct(&item{}),
+		`CREATE TABLE "item" ("id" BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, "created_at" TIMESTAMP NOT NULL, `+
+			`"name" VARCHAR(255) NOT NULL)`,
+	)
+}
+
+func (tfs *tableFromStructSuite) TestTableFromStruct_tableNamer() {
+	tfs.assertSQL(goqu.TableFromStruct(&tfsNamedTable{}), `CREATE TABLE "named_table" ("name" VARCHAR(255) NOT NULL)`)
+	tfs.assertSQL(goqu.TableFromStruct(tfsNamedTable{}), `CREATE TABLE "named_table" ("name" VARCHAR(255) NOT NULL)`)
+}
+
+func (tfs *tableFromStructSuite) TestTableFromStruct_withDialect() {
+	type item struct {
+		ID   int64     `db:"id" goqu:"primarykey,autoincrement"`
+		Name string    `db:"name"`
+		At   time.Time `db:"at"`
+	}
+	sql, _, err := goqu.Dialect("mysql").TableFromStruct(&item{}).ToSQL()
+	tfs.NoError(err)
+	tfs.Equal("CREATE TABLE `item` (`id` BIGINT AUTO_INCREMENT PRIMARY KEY, `name` VARCHAR(255) NOT NULL, `at` DATETIME NOT NULL)", sql)
+}
+
+func (tfs *tableFromStructSuite) TestTableFromStruct_errors() {
+	_, _, err := goqu.TableFromStruct("item").ToSQL()
+	tfs.EqualError(err, "goqu: unsupported type string, a struct or a pointer to a struct is required")
+
+	_, _, err = goqu.TableFromStruct(nil).ToSQL()
+	tfs.EqualError(err, "goqu: unsupported type <nil>, a struct or a pointer to a struct is required")
+
+	type item struct {
+		Tags map[string]string `db:"tags"`
+	}
+	_, _, err = goqu.TableFromStruct(&item{}).ToSQL()
+	tfs.EqualError(err, "goqu: unable to determine the data type of field Tags with type map[string]string, use the sqltype tag")
+
+	type itemWithType struct {
+		Tags map[string]string `db:"tags" sqltype:"JSONB"`
+	}
+	tfs.assertSQL(goqu.TableFromStruct(&itemWithType{}), `CREATE TABLE "itemwithtype" ("tags" JSONB NOT NULL)`)
+}
+
+func (tfs *tableFromStructSuite) TestIndexesFromStruct() {
+	type order struct {
+		ID         int64     `db:"id" goqu:"primarykey"`
+		CustomerID int64     `db:"customer_id" goqu:"index=order_customer_created_idx"`
+		CreatedAt  time.Time `db:"created_at" goqu:"index=order_customer_created_idx"`
+		Number     string    `db:"number" goqu:"uniqueindex"`
+		Status     string    `db:"status" goqu:"index,skipupdate"`
+	}
+	indexes, err := goqu.IndexesFromStruct(&order{})
+	tfs.NoError(err)
+	tfs.Len(indexes, 3)
+	expected := []string{
+		`CREATE INDEX "order_customer_created_idx" ON "order" ("customer_id", "created_at")`,
+		`CREATE UNIQUE INDEX "order_number_idx" ON "order" ("number")`,
+		`CREATE INDEX "order_status_idx" ON "order" ("status")`,
+	}
+	for i, idx := range indexes {
+		sql, _, err := idx.ToSQL()
+		tfs.NoError(err)
+		tfs.Equal(expected[i], sql)
+	}
+
+	indexes, err = goqu.IndexesFromStruct(&tfsNamedTable{})
+	tfs.NoError(err)
+	tfs.Empty(indexes)
+
+	_, err = goqu.IndexesFromStruct([]order{})
+	tfs.EqualError(err, "goqu: unsupported type []goqu_test.order, a struct or a pointer to a struct is required")
+}
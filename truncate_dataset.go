@@ -138,6 +138,15 @@ func (td *TruncateDataset) Error() error {
 	return td.err
 }
 
+// ApplyIf returns the result of calling fn with the dataset if cond is true, see SelectDataset#ApplyIf.
+//    ds.ApplyIf(cascade, func(ds *TruncateDataset) *TruncateDataset { return ds.Cascade() })
+func (td *TruncateDataset) ApplyIf(cond bool, fn func(*TruncateDataset) *TruncateDataset) *TruncateDataset {
+	if !cond {
+		return td
+	}
+	return fn(td)
+}
+
 // SetError sets an error on the TruncateDataset if one has not already been set.
 // This error will be returned by a future call to Error or as part of ToSQL.
 // This can be used by end users to record errors while building up queries without having to track those separately.
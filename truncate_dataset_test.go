@@ -121,6 +121,24 @@ func (tds *truncateDatasetSuite) TestCascade() {
 	)
 }
 
+func (tds *truncateDatasetSuite) TestApplyIf() {
+	bd := goqu.Truncate("test")
+	cascade := func(ds *goqu.TruncateDataset) *goqu.TruncateDataset { return ds.Cascade() }
+	tds.assertCases(
+		truncateTestCase{
+			ds: bd.ApplyIf(true, cascade),
+			clauses: exp.NewTruncateClauses().
+				SetTable(exp.NewColumnListExpression("test")).
+				SetOptions(exp.TruncateOptions{Cascade: true}),
+		},
+		truncateTestCase{
+			ds: bd.ApplyIf(false, cascade),
+			clauses: exp.NewTruncateClauses().
+				SetTable(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
 func (tds *truncateDatasetSuite) TestNoCascade() {
 	bd := goqu.Truncate("test").Cascade()
 	tds.assertCases(
@@ -109,8 +109,30 @@ func (ud *UpdateDataset) With(name string, subquery exp.Expression) *UpdateDatas
 // The name will refer to the results of the specified subquery. The subquery for
 // a recursive query will always end with a UNION or UNION ALL with a clause that
 // refers to the CTE by name.
-func (ud *UpdateDataset) WithRecursive(name string, subquery exp.Expression) *UpdateDataset {
-	return ud.copy(ud.clauses.CommonTablesAppend(exp.NewCommonTableExpression(true, name, subquery)))
+//
+// SEARCH and CYCLE clauses can be added by passing SearchDepthFirst, SearchBreadthFirst or Cycle expressions.
+func (ud *UpdateDataset) WithRecursive(name string, subquery exp.Expression, clauses ...exp.Expression) *UpdateDataset {
+	return ud.copy(ud.clauses.CommonTablesAppend(newRecursiveCommonTable(name, subquery, clauses)))
+}
+
+// WithCTE adds a common table expression to the WITH clause, see SelectDataset#WithCTE.
+func (ud *UpdateDataset) WithCTE(cte exp.CommonTableExpression) *UpdateDataset {
+	return ud.copy(ud.clauses.CommonTablesAppend(cte))
+}
+
+// WithMaterialized creates a WITH clause for a common table expression (CTE) that is computed once
+// (e.g. WITH name AS MATERIALIZED (...)). An error is returned when generating sql for dialects that do not support it.
+func (ud *UpdateDataset) WithMaterialized(name string, subquery exp.Expression) *UpdateDataset {
+	cte := exp.NewCommonTableExpression(false, name, subquery).SetMaterialization(exp.MaterializedCTE)
+	return ud.copy(ud.clauses.CommonTablesAppend(cte))
+}
+
+// WithNotMaterialized creates a WITH clause for a common table expression (CTE) that may be folded into the parent
+// query (e.g. WITH name AS NOT MATERIALIZED (...)). An error is returned when generating sql for dialects that do not
+// support it.
+func (ud *UpdateDataset) WithNotMaterialized(name string, subquery exp.Expression) *UpdateDataset {
+	cte := exp.NewCommonTableExpression(false, name, subquery).SetMaterialization(exp.NotMaterializedCTE)
+	return ud.copy(ud.clauses.CommonTablesAppend(cte))
 }
 
 // Table sets the table to update.
@@ -125,6 +147,18 @@ func (ud *UpdateDataset) Table(table interface{}) *UpdateDataset {
 	}
 }
 
+// Hint appends optimizer hints which are written as is in a comment after the UPDATE keyword (e.g. mysql, oracle). An
+// error is returned when generating sql for dialects that do not support optimizer hints.
+//    Update("a").Hint("NO_ICP(a)").Set(...) // UPDATE /*+ NO_ICP(a) */ `a` SET ...
+func (ud *UpdateDataset) Hint(hints ...string) *UpdateDataset {
+	return ud.copy(ud.clauses.SetHints(append(append([]string(nil), ud.clauses.Hints()...), hints...)))
+}
+
+// ClearHint removes all optimizer hints.
+func (ud *UpdateDataset) ClearHint() *UpdateDataset {
+	return ud.copy(ud.clauses.SetHints(nil))
+}
+
 // Set sets the values to use in the SET clause.
 func (ud *UpdateDataset) Set(values interface{}) *UpdateDataset {
 	return ud.copy(ud.clauses.SetSetValues(values))
@@ -140,6 +174,15 @@ func (ud *UpdateDataset) Where(expressions ...exp.Expression) *UpdateDataset {
 	return ud.copy(ud.clauses.WhereAppend(expressions...))
 }
 
+// WhereIf adds a WHERE clause if cond is true, otherwise the dataset is returned unchanged.
+//    ds.WhereIf(name != "", goqu.C("name").Eq(name))
+func (ud *UpdateDataset) WhereIf(cond bool, expressions ...exp.Expression) *UpdateDataset {
+	if !cond {
+		return ud
+	}
+	return ud.Where(expressions...)
+}
+
 // ClearWhere removes the WHERE clause.
 func (ud *UpdateDataset) ClearWhere() *UpdateDataset {
 	return ud.copy(ud.clauses.ClearWhere())
@@ -195,6 +238,15 @@ func (ud *UpdateDataset) Error() error {
 	return ud.err
 }
 
+// ApplyIf returns the result of calling fn with the dataset if cond is true, see SelectDataset#ApplyIf.
+//    ds.ApplyIf(returnIDs, func(ds *UpdateDataset) *UpdateDataset { return ds.Returning("id") })
+func (ud *UpdateDataset) ApplyIf(cond bool, fn func(*UpdateDataset) *UpdateDataset) *UpdateDataset {
+	if !cond {
+		return ud
+	}
+	return fn(ud)
+}
+
 // SetError sets an error on the UpdateDataset if one has not already been set.
 // This error will be returned by a future call to Error or as part of ToSQL.
 // This can be used by end users to record errors while building up queries without having to track those separately.
@@ -224,6 +276,13 @@ func (ud *UpdateDataset) MustToSQL() (sql string, params []interface{}) {
 	return
 }
 
+// Explain creates an ExplainStatement that wraps the UPDATE sql in the dialects EXPLAIN syntax.
+//
+//	plan, err := ds.Explain(exp.ExplainOptions{Analyze: true, Format: exp.ExplainFormatJSON}).Plan()
+func (ud *UpdateDataset) Explain(opts exp.ExplainOptions) *ExplainStatement {
+	return newExplainStatement(ud, ud.dialect, ud.queryFactory, ud.isPrepared.Bool(), opts)
+}
+
 // AppendSQL appends this UpdateDataset's UPDATE statement to the SQLBuilder.
 // This is used internally when using updates in CTEs.
 func (ud *UpdateDataset) AppendSQL(b sb.SQLBuilder) {
@@ -521,6 +521,20 @@ func ExampleUpdateDataset_Set_withDefaultIfEmptyTag() {
 	// UPDATE "items" SET "address"='111 Test Addr',"name"='Bob Yukon' []
 }
 
+func ExampleUpdateDataset_Set_withSecretTag() {
+	type user struct {
+		Name     string `db:"name"`
+		Password string `db:"password" goqu:"secret"`
+	}
+	sql, args, _ := goqu.Update("users").Set(
+		user{Name: "Bob Yukon", Password: "hunter2"},
+	).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// UPDATE "users" SET "name"='Bob Yukon',"password"=? [[REDACTED]]
+}
+
 func ExampleUpdateDataset_Set_withNoTags() {
 	type item struct {
 		Address string
@@ -118,6 +118,48 @@ func (uds *updateDatasetSuite) TestWithRecursive() {
 	)
 }
 
+func (uds *updateDatasetSuite) TestWithCTE() {
+	cte := exp.NewCommonTableExpression(true, "test-cte", goqu.From("cte"))
+	bd := goqu.Update("items")
+	uds.assertCases(
+		updateTestCase{
+			ds: bd.WithCTE(cte),
+			clauses: exp.NewUpdateClauses().
+				SetTable(goqu.C("items")).
+				CommonTablesAppend(cte),
+		},
+		updateTestCase{
+			ds:      bd,
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")),
+		},
+	)
+}
+
+func (uds *updateDatasetSuite) TestWithMaterialized() {
+	from := goqu.From("cte")
+	bd := goqu.Update("items")
+	uds.assertCases(
+		updateTestCase{
+			ds: bd.WithMaterialized("test-cte", from),
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")).
+				CommonTablesAppend(
+					exp.NewCommonTableExpression(false, "test-cte", from).SetMaterialization(exp.MaterializedCTE),
+				),
+		},
+		updateTestCase{
+			ds: bd.WithNotMaterialized("test-cte", from),
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")).
+				CommonTablesAppend(
+					exp.NewCommonTableExpression(false, "test-cte", from).SetMaterialization(exp.NotMaterializedCTE),
+				),
+		},
+		updateTestCase{
+			ds:      bd,
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")),
+		},
+	)
+}
+
 func (uds *updateDatasetSuite) TestTable() {
 	bd := goqu.Update("items")
 	uds.assertCases(
@@ -139,6 +181,44 @@ func (uds *updateDatasetSuite) TestTable() {
 	})
 }
 
+func (uds *updateDatasetSuite) TestHint() {
+	bd := goqu.Update("items")
+	uds.assertCases(
+		updateTestCase{
+			ds: bd.Hint("NO_ICP(items)"),
+			clauses: exp.NewUpdateClauses().
+				SetTable(goqu.C("items")).
+				SetHints([]string{"NO_ICP(items)"}),
+		},
+		updateTestCase{
+			ds: bd.Hint("NO_ICP(items)").Hint("MAX_EXECUTION_TIME(1000)"),
+			clauses: exp.NewUpdateClauses().
+				SetTable(goqu.C("items")).
+				SetHints([]string{"NO_ICP(items)", "MAX_EXECUTION_TIME(1000)"}),
+		},
+		updateTestCase{
+			ds:      bd,
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")),
+		},
+	)
+}
+
+func (uds *updateDatasetSuite) TestClearHint() {
+	bd := goqu.Update("items").Hint("NO_ICP(items)")
+	uds.assertCases(
+		updateTestCase{
+			ds:      bd.ClearHint(),
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")),
+		},
+		updateTestCase{
+			ds: bd,
+			clauses: exp.NewUpdateClauses().
+				SetTable(goqu.C("items")).
+				SetHints([]string{"NO_ICP(items)"}),
+		},
+	)
+}
+
 func (uds *updateDatasetSuite) TestSet() {
 	type item struct {
 		Address string `db:"address"`
@@ -212,6 +292,39 @@ func (uds *updateDatasetSuite) TestWhere() {
 	)
 }
 
+func (uds *updateDatasetSuite) TestWhereIf() {
+	bd := goqu.Update("items")
+	uds.assertCases(
+		updateTestCase{
+			ds:      bd.WhereIf(true, goqu.Ex{"a": 1}),
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")).WhereAppend(goqu.Ex{"a": 1}),
+		},
+		updateTestCase{
+			ds:      bd.WhereIf(false, goqu.Ex{"a": 1}),
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")),
+		},
+		updateTestCase{
+			ds:      bd,
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")),
+		},
+	)
+}
+
+func (uds *updateDatasetSuite) TestApplyIf() {
+	bd := goqu.Update("items")
+	returning := func(ds *goqu.UpdateDataset) *goqu.UpdateDataset { return ds.Returning("id") }
+	uds.assertCases(
+		updateTestCase{
+			ds:      bd.ApplyIf(true, returning),
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")).SetReturning(exp.NewColumnListExpression("id")),
+		},
+		updateTestCase{
+			ds:      bd.ApplyIf(false, returning),
+			clauses: exp.NewUpdateClauses().SetTable(goqu.C("items")),
+		},
+	)
+}
+
 func (uds *updateDatasetSuite) TestClearWhere() {
 	bd := goqu.Update("items").Where(goqu.Ex{"a": 1})
 	uds.assertCases(